@@ -13,6 +13,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	"golang.org/x/time/rate"
 
+	"github.com/sensu/sensu-go/agent/discovery"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/backend/agentd"
@@ -28,6 +30,7 @@ import (
 	"github.com/sensu/sensu-go/handler"
 	"github.com/sensu/sensu-go/process"
 	"github.com/sensu/sensu-go/system"
+	"github.com/sensu/sensu-go/tracing"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/util/retry"
 	utilstrings "github.com/sensu/sensu-go/util/strings"
@@ -46,28 +49,49 @@ func GetDefaultAgentName() string {
 
 // An Agent receives and acts on messages from a Sensu Backend.
 type Agent struct {
-	allowList       []allowList
-	api             *http.Server
-	assetGetter     asset.Getter
-	backendSelector BackendSelector
-	config          *Config
-	connected       bool
-	connectedMu     sync.RWMutex
-	contentType     string
-	entity          *corev2.Entity
-	executor        command.Executor
-	handler         *handler.MessageHandler
-	header          http.Header
-	inProgress      map[string]*corev2.CheckConfig
-	inProgressMu    *sync.Mutex
-	statsdServer    StatsdServer
-	sendq           chan *transport.Message
-	systemInfo      *corev2.System
-	systemInfoMu    sync.RWMutex
-	wg              sync.WaitGroup
-	apiQueue        queue
-	marshal         agentd.MarshalFunc
-	unmarshal       agentd.UnmarshalFunc
+	// eventsQueueSize is accessed atomically, it must be the first field to
+	// be 64-bit aligned on 32-bit platforms
+	eventsQueueSize int64
+
+	allowList         []allowList
+	api               *http.Server
+	assetGetter       asset.Getter
+	assetManager      *asset.Manager
+	backendSelector   BackendSelector
+	config            *Config
+	configMu          sync.RWMutex
+	connected         bool
+	connectedMu       sync.RWMutex
+	contentType       string
+	disconnect        context.CancelFunc
+	discoveredLabels  map[string]string
+	draining          bool
+	entity            *corev2.Entity
+	entityMu          sync.Mutex
+	paused            bool
+	eventsQueue       queue
+	eventsSendLimiter *rate.Limiter
+	executor          command.Executor
+	handler           *handler.MessageHandler
+	header            http.Header
+	inProgress        map[string]*corev2.CheckConfig
+	inProgressMu      *sync.Mutex
+	labelSources      []discovery.Source
+	results           *resultsBuffer
+	statsdServer      StatsdServer
+	sendq             chan *transport.Message
+	systemInfo        *corev2.System
+	systemInfoMu      sync.RWMutex
+	tracer            *tracing.Tracer
+	wg                sync.WaitGroup
+	apiQueue          queue
+	marshal           agentd.MarshalFunc
+	unmarshal         agentd.UnmarshalFunc
+	proxy             transport.ProxyFunc
+	batching          bool
+	reconnectDelay    time.Duration
+	backpressureMu    sync.Mutex
+	backpressureUntil time.Time
 
 	// ProcessGetter gets information about local agent processes.
 	ProcessGetter process.Getter
@@ -88,20 +112,35 @@ func NewAgentContext(ctx context.Context, config *Config) (*Agent, error) {
 	if to := config.KeepaliveWarningTimeout; to > 0 && to <= config.KeepaliveInterval {
 		return nil, errors.New("keepalive warning timeout must be greater than keepalive interval")
 	}
-	agent := &Agent{
-		backendSelector: &RandomBackendSelector{Backends: config.BackendURLs},
-		connected:       false,
-		config:          config,
-		executor:        command.NewExecutor(),
-		handler:         handler.NewMessageHandler(),
-		inProgress:      make(map[string]*corev2.CheckConfig),
-		inProgressMu:    &sync.Mutex{},
-		sendq:           make(chan *transport.Message, 10),
-		systemInfo:      &corev2.System{},
-		unmarshal:       agentd.UnmarshalJSON,
-		marshal:         agentd.MarshalJSON,
-		ProcessGetter:   &process.NoopProcessGetter{},
+	if err := validateOversizedOutputPolicy(config.OversizedOutputPolicy); err != nil {
+		return nil, err
+	}
+	if err := validateEventsSendPolicy(config.EventsSendPolicy); err != nil {
+		return nil, err
 	}
+	proxy, err := transport.NewProxyFunc(config.BackendProxy, config.BackendNoProxy)
+	if err != nil {
+		return nil, err
+	}
+	agent := &Agent{
+		backendSelector:   &RandomBackendSelector{Backends: config.BackendURLs},
+		connected:         false,
+		config:            config,
+		eventsSendLimiter: newEventsSendLimiter(config.EventsSendRateLimit, config.EventsSendBurstLimit),
+		executor:          command.NewExecutor(),
+		handler:           handler.NewMessageHandler(),
+		inProgress:        make(map[string]*corev2.CheckConfig),
+		inProgressMu:      &sync.Mutex{},
+		results:           newResultsBuffer(ResultsBufferSize),
+		sendq:             make(chan *transport.Message, 10),
+		systemInfo:        &corev2.System{},
+		unmarshal:         agentd.UnmarshalJSON,
+		marshal:           agentd.MarshalJSON,
+		ProcessGetter:     &process.NoopProcessGetter{},
+		proxy:             proxy,
+	}
+
+	registerMetrics()
 
 	agent.statsdServer = NewStatsdServer(agent)
 	agent.handler.AddHandler(corev2.CheckRequestType, agent.handleCheck)
@@ -114,22 +153,59 @@ func NewAgentContext(ctx context.Context, config *Config) (*Agent, error) {
 	if err := systemInfoCtx.Err(); err != nil {
 		logger.WithError(err).Error("couldn't refresh all system information within deadline")
 	}
-	var err error
+	agent.labelSources, err = newLabelSources(config, agent.executor)
+	if err != nil {
+		return nil, fmt.Errorf("error creating agent: %s", err)
+	}
+	if len(agent.labelSources) > 0 {
+		agent.discoverLabels(ctx)
+	}
+
 	agent.apiQueue, err = newQueue(config.CacheDir)
 	if err != nil {
 		return nil, fmt.Errorf("error creating agent: %s", err)
 	}
 
+	if config.EventsQueueMaxSize > 0 {
+		agent.eventsQueue, agent.eventsQueueSize, err = newEventsQueue(config.CacheDir, config.EventsQueueMaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("error creating agent: %s", err)
+		}
+		EventsQueued.Set(float64(agent.eventsQueueSize))
+	}
+
 	allowList, err := readAllowList(config.AllowList, ioutil.ReadFile)
 	if err != nil {
 		return nil, err
 	}
 	agent.allowList = allowList
 
+	agent.tracer, err = tracing.New(tracing.Config{
+		OTLPEndpoint: config.TracingOTLPEndpoint,
+		ServiceName:  "sensu-agent",
+		SampleRate:   config.TracingSampleRate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating agent: %s", err)
+	}
+
 	return agent, nil
 }
 
 func (a *Agent) sendMessage(msg *transport.Message) {
+	if msg.Type == transport.MessageTypeEvent && !a.allowEvent() {
+		return
+	}
+	// Keep the events in the events queue while the backend is unreachable.
+	// The events with a callback already come from a queue.
+	if msg.Type == transport.MessageTypeEvent && msg.SendCallback == nil && a.eventsQueue != nil && !a.Connected() {
+		a.queueEvent(msg.Payload)
+		return
+	}
+	// Hold the events while the backend asks the agent to slow down
+	if msg.Type == transport.MessageTypeEvent {
+		a.waitBackpressure()
+	}
 	logger.WithFields(logrus.Fields{
 		"type":         msg.Type,
 		"content_type": a.contentType,
@@ -145,7 +221,7 @@ func (a *Agent) RefreshSystemInfo(ctx context.Context) error {
 		return err
 	}
 
-	if a.config.DetectCloudProvider {
+	if a.getConfig().DetectCloudProvider {
 		info.CloudProvider = system.GetCloudProvider(ctx)
 	}
 
@@ -182,19 +258,28 @@ func (a *Agent) refreshSystemInfoPeriodically(ctx context.Context) {
 }
 
 func (a *Agent) buildTransportHeaderMap() http.Header {
+	config := a.getConfig()
 	header := http.Header{}
-	header.Set(transport.HeaderKeyNamespace, a.config.Namespace)
-	header.Set(transport.HeaderKeyAgentName, a.config.AgentName)
-	if tls := a.config.TLS; tls == nil || len(tls.CertFile) == 0 && len(tls.KeyFile) == 0 {
+	header.Set(transport.HeaderKeyNamespace, config.Namespace)
+	header.Set(transport.HeaderKeyAgentName, config.AgentName)
+	token, err := a.bearerToken()
+	if err != nil {
+		logger.WithError(err).Error("could not read the token, falling back to password auth")
+	}
+	if token != "" {
+		logger.Info("using token auth")
+		header.Set("Authorization", "Bearer "+token)
+	} else if tls := config.TLS; tls == nil || len(tls.CertFile) == 0 && len(tls.KeyFile) == 0 {
 		logger.Info("using password auth")
-		header.Set(transport.HeaderKeyUser, a.config.User)
-		userCredentials := fmt.Sprintf("%s:%s", a.config.User, a.config.Password)
+		header.Set(transport.HeaderKeyUser, config.User)
+		userCredentials := fmt.Sprintf("%s:%s", config.User, config.Password)
 		userCredentials = base64.StdEncoding.EncodeToString([]byte(userCredentials))
 		header.Set("Authorization", "Basic "+userCredentials)
 	} else {
 		logger.Info("using tls client auth")
 	}
-	header.Set(transport.HeaderKeySubscriptions, strings.Join(a.config.Subscriptions, ","))
+	header.Set(transport.HeaderKeySubscriptions, strings.Join(config.Subscriptions, ","))
+	header.Set(transport.HeaderKeyBackpressure, "true")
 
 	return header
 }
@@ -211,66 +296,126 @@ func (a *Agent) buildTransportHeaderMap() http.Header {
 // 8. Start sending periodic keepalives.
 // 9. Start the API server, shutdown the agent if doing so fails.
 func (a *Agent) Run(ctx context.Context) error {
+	config := a.getConfig()
 	defer func() {
 		if err := a.apiQueue.Close(); err != nil {
 			logger.WithError(err).Error("error closing API queue")
 		}
+		if a.eventsQueue != nil {
+			if err := a.eventsQueue.Close(); err != nil {
+				logger.WithError(err).Error("error closing events queue")
+			}
+		}
+		a.tracer.Stop()
 	}()
-	a.header = a.buildTransportHeaderMap()
-
 	// Fail the agent after startup if the id is invalid
-	if err := corev2.ValidateName(a.config.AgentName); err != nil {
+	if err := corev2.ValidateName(config.AgentName); err != nil {
 		return fmt.Errorf("invalid agent name: %v", err)
 	}
-	if timeout := a.config.KeepaliveWarningTimeout; timeout < 5 {
+	if timeout := config.KeepaliveWarningTimeout; timeout < 5 {
 		return fmt.Errorf("bad keepalive timeout: %d (minimum value is 5 seconds)", timeout)
 	}
-	if timeout := a.config.KeepaliveCriticalTimeout; timeout > 0 && timeout < 5 {
+	if timeout := config.KeepaliveCriticalTimeout; timeout > 0 && timeout < 5 {
 		return fmt.Errorf("bad keepalive critical timeout: %d (minimum value is 5 seconds)", timeout)
 	}
 
-	if !a.config.DisableAssets {
-		assetManager := asset.NewManager(a.config.CacheDir, a.getAgentEntity(), &a.wg)
-		var err error
-		limit := a.config.AssetsRateLimit
+	if !config.DisableAssets {
+		assetManager := asset.NewManager(config.CacheDir, a.getAgentEntity(), &a.wg)
+		mirrors, err := asset.ParseMirrors(config.AssetsMirrors)
+		if err != nil {
+			return err
+		}
+		assetManager.Mirrors = mirrors
+		assetManager.BundleDir = config.AssetsBundleDir
+		if len(config.AssetsTrustedKeys) > 0 {
+			if assetManager.TrustedKeys, err = asset.LoadTrustedKeys(config.AssetsTrustedKeys); err != nil {
+				return err
+			}
+		}
+		assetManager.RequireSignatures = config.AssetsRequireSignatures
+		assetManager.Parallelism = config.AssetsParallelism
+		assetManager.BandwidthLimit = config.AssetsBandwidthLimit
+		assetManager.FetchRetries = config.AssetsFetchRetries
+		assetManager.CacheQuota = config.AssetsCacheQuota
+		assetManager.GCInterval = time.Duration(config.AssetsGCInterval) * time.Second
+		assetManager.GCGracePeriod = time.Duration(config.AssetsGCGracePeriod) * time.Second
+		limit := config.AssetsRateLimit
 		if limit == 0 {
 			limit = rate.Limit(asset.DefaultAssetsRateLimit)
 		}
-		a.assetGetter, err = assetManager.StartAssetManager(ctx, rate.NewLimiter(limit, a.config.AssetsBurstLimit))
+		getter, err := assetManager.StartAssetManager(ctx, rate.NewLimiter(limit, config.AssetsBurstLimit))
 		if err != nil {
 			return err
 		}
+		a.assetGetter = instrumentedGetter{getter: getter}
+		a.assetManager = assetManager
 	}
 
 	// Start the statsd listener only if the agent configuration has it enabled
-	if !a.config.StatsdServer.Disable {
+	if !config.StatsdServer.Disable {
 		a.StartStatsd(ctx)
 	}
 
-	if !a.config.DisableAPI {
+	if !config.DisableAPI {
 		a.StartAPI(ctx)
 	}
 
-	if !a.config.DisableSockets {
+	if !config.DisableSockets {
 		// Agent TCP/UDP sockets are deprecated in favor of the agent rest api
 		a.StartSocketListeners(ctx)
 	}
 
+	if config.DeregisterOnShutdown {
+		// Wait for the deregistration to be sent before returning
+		a.wg.Add(1)
+	}
 	go a.connectionManager(ctx)
 	go a.refreshSystemInfoPeriodically(ctx)
+	if len(a.labelSources) > 0 && config.LabelDiscoveryInterval > 0 {
+		go a.discoverLabelsPeriodically(ctx)
+	}
 	go a.handleAPIQueue(ctx)
+	if a.eventsQueue != nil {
+		go a.handleEventsQueue(ctx)
+	}
 
 	a.wg.Wait()
 	return nil
 }
 
 func (a *Agent) connectionManager(ctx context.Context) {
+	config := a.getConfig()
 	defer logger.Debug("shutting down connection manager")
-	for {
+	if config.DeregisterOnShutdown {
+		defer a.wg.Done()
+	}
+	shutdown := ctx.Done()
+	for connections := 0; ; connections++ {
 		a.connectedMu.Lock()
 		a.connected = false
 		a.connectedMu.Unlock()
 
+		// The header is rebuilt on every connection, so a reloaded
+		// configuration is used by the new session
+		a.entityMu.Lock()
+		a.header = a.buildTransportHeaderMap()
+		a.entityMu.Unlock()
+
+		// The backend asked the agent to reconnect, e.g. to another backend,
+		// after a delay spreading the reconnections of its agents
+		a.connectedMu.Lock()
+		delay := a.reconnectDelay
+		a.reconnectDelay = 0
+		a.connectedMu.Unlock()
+		if delay > 0 {
+			logger.WithField("delay", delay).Info("waiting before reconnecting to the backend")
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+		}
+
 		conn, err := a.connectWithBackoff(ctx)
 		if err != nil {
 			if err == ctx.Err() {
@@ -279,17 +424,22 @@ func (a *Agent) connectionManager(ctx context.Context) {
 			log.Fatal(err)
 		}
 
+		if connections > 0 {
+			Reconnections.Inc()
+		}
+
 		ctx, cancel := context.WithCancel(ctx)
 
 		// Start sending hearbeats to the backend
-		conn.Heartbeat(ctx, a.config.BackendHeartbeatInterval, a.config.BackendHeartbeatTimeout)
+		conn.Heartbeat(ctx, config.BackendHeartbeatInterval, config.BackendHeartbeatTimeout)
 
 		a.connectedMu.Lock()
 		a.connected = true
+		a.disconnect = cancel
 		a.connectedMu.Unlock()
 
 		go a.receiveLoop(ctx, cancel, conn)
-		if err := a.sendLoop(ctx, cancel, conn); err != nil && err != ctx.Err() {
+		if err := a.sendLoop(ctx, cancel, conn, shutdown); err != nil && err != ctx.Err() {
 			logger.WithError(err).Error("error sending messages")
 		}
 	}
@@ -304,6 +454,17 @@ func (a *Agent) receiveLoop(ctx context.Context, cancel context.CancelFunc, conn
 			return
 		}
 
+		// The reconnect directive is handled before the connection is
+		// closed, so the delay applies to the next connection
+		if m.Type == transport.MessageTypeReconnect {
+			a.handleReconnect(m.Payload)
+			return
+		}
+		if m.Type == transport.MessageTypeBackpressure {
+			a.handleBackpressure(m.Payload)
+			continue
+		}
+
 		go func(msg *transport.Message) {
 			logger.WithFields(logrus.Fields{
 				"type":         msg.Type,
@@ -318,6 +479,22 @@ func (a *Agent) receiveLoop(ctx context.Context, cancel context.CancelFunc, conn
 	}
 }
 
+// handleReconnect handles the directive of the backend asking the agent to
+// reconnect after the delay in milliseconds of payload.
+func (a *Agent) handleReconnect(payload []byte) {
+	ms, err := strconv.ParseInt(string(payload), 10, 64)
+	if err != nil || ms < 0 {
+		logger.WithField("payload", string(payload)).Error("invalid reconnect delay")
+		ms = 0
+	}
+	delay := time.Duration(ms) * time.Millisecond
+	logger.WithField("delay", delay).Warn("backend is draining, reconnecting")
+
+	a.connectedMu.Lock()
+	a.reconnectDelay = delay
+	a.connectedMu.Unlock()
+}
+
 func logEvent(e *corev2.Event) {
 	fields := logrus.Fields{
 		"event_uuid": e.GetUUID().String(),
@@ -332,10 +509,19 @@ func logEvent(e *corev2.Event) {
 	logger.WithFields(fields).Info("sending event to backend")
 }
 
-func (a *Agent) sendLoop(ctx context.Context, cancel context.CancelFunc, conn transport.Transport) error {
+// sendLoop sends the queued messages and the keepalives over conn until ctx is
+// done. The agent deregisters its entity first if shutdown is closed, meaning
+// that the agent is shutting down, and it is configured to do so.
+func (a *Agent) sendLoop(ctx context.Context, cancel context.CancelFunc, conn transport.Transport, shutdown <-chan struct{}) error {
+	config := a.getConfig()
 	defer cancel()
-	keepalive := time.NewTicker(time.Duration(a.config.KeepaliveInterval) * time.Second)
+	keepalive := time.NewTicker(time.Duration(config.KeepaliveInterval) * time.Second)
 	defer keepalive.Stop()
+	// The small messages are batched if enabled and accepted by the backend
+	var batch *messageBatch
+	if a.batching && config.BackendBatchDelay > 0 {
+		batch = newMessageBatch(time.Duration(config.BackendBatchDelay) * time.Millisecond)
+	}
 	if err := conn.Send(a.newKeepalive()); err != nil {
 		logger.WithError(err).Error("error sending message over websocket")
 		return err
@@ -343,18 +529,36 @@ func (a *Agent) sendLoop(ctx context.Context, cancel context.CancelFunc, conn tr
 	for {
 		select {
 		case <-ctx.Done():
+			if err := batch.flush(conn); err != nil {
+				logger.WithError(err).Error("error sending message over websocket")
+			}
+			select {
+			case <-shutdown:
+				if config.DeregisterOnShutdown {
+					logger.Info("deregistering entity before shutting down")
+					if err := conn.Send(a.newDeregistration()); err != nil {
+						logger.WithError(err).Error("error sending deregistration over websocket")
+					}
+				}
+			default:
+			}
 			if err := conn.Close(); err != nil {
 				logger.WithError(err).Error("error closing websocket connection")
 				return err
 			}
 			return nil
 		case msg := <-a.sendq:
-			if err := conn.Send(msg); err != nil {
+			if err := batch.send(conn, msg); err != nil {
 				logger.WithError(err).Error("error sending message over websocket")
 				return err
 			}
 		case <-keepalive.C:
-			if err := conn.Send(a.newKeepalive()); err != nil {
+			if err := batch.send(conn, a.newKeepalive()); err != nil {
+				logger.WithError(err).Error("error sending message over websocket")
+				return err
+			}
+		case <-batch.C():
+			if err := batch.flush(conn); err != nil {
 				logger.WithError(err).Error("error sending message over websocket")
 				return err
 			}
@@ -363,6 +567,7 @@ func (a *Agent) sendLoop(ctx context.Context, cancel context.CancelFunc, conn tr
 }
 
 func (a *Agent) newKeepalive() *transport.Message {
+	config := a.getConfig()
 	msg := &transport.Message{
 		Type: transport.MessageTypeKeepalive,
 	}
@@ -377,9 +582,9 @@ func (a *Agent) newKeepalive() *transport.Message {
 
 	keepalive.Check = &corev2.Check{
 		ObjectMeta: corev2.NewObjectMeta("keepalive", entity.Namespace),
-		Interval:   a.config.KeepaliveInterval,
-		Timeout:    a.config.KeepaliveWarningTimeout,
-		Ttl:        int64(a.config.KeepaliveCriticalTimeout),
+		Interval:   config.KeepaliveInterval,
+		Timeout:    config.KeepaliveWarningTimeout,
+		Ttl:        int64(config.KeepaliveCriticalTimeout),
 	}
 	keepalive.Entity = a.getAgentEntity()
 	keepalive.Timestamp = time.Now().Unix()
@@ -396,6 +601,14 @@ func (a *Agent) newKeepalive() *transport.Message {
 	return msg
 }
 
+// newDeregistration returns a message asking the backend to deregister the
+// entity of the agent.
+func (a *Agent) newDeregistration() *transport.Message {
+	msg := a.newKeepalive()
+	msg.Type = transport.MessageTypeDeregistration
+	return msg
+}
+
 // Connected returns true if the agent is connected to a backend.
 func (a *Agent) Connected() bool {
 	a.connectedMu.RLock()
@@ -403,6 +616,83 @@ func (a *Agent) Connected() bool {
 	return a.connected
 }
 
+// Reload applies the subscriptions, labels, annotations, keepalive handlers
+// and redacted fields of config to the running agent. The agent reconnects to
+// the backend so the new subscriptions take effect. Other settings require the
+// agent to be restarted.
+func (a *Agent) Reload(config *Config) {
+	a.entityMu.Lock()
+	a.configMu.Lock()
+	// The configuration is replaced rather than modified, so it can be read
+	// without holding the lock once retrieved with getConfig
+	reloaded := *a.config
+	reloaded.Subscriptions = config.Subscriptions
+	reloaded.Labels = config.Labels
+	reloaded.Annotations = config.Annotations
+	reloaded.KeepaliveHandlers = config.KeepaliveHandlers
+	reloaded.Redact = config.Redact
+	a.config = &reloaded
+	a.configMu.Unlock()
+	// The entity is built again from the configuration on the next use
+	a.entity = nil
+	a.entityMu.Unlock()
+
+	logger.WithField("subscriptions", config.Subscriptions).Info("configuration reloaded, reconnecting to the backend")
+
+	a.connectedMu.RLock()
+	disconnect := a.disconnect
+	a.connectedMu.RUnlock()
+	if disconnect != nil {
+		disconnect()
+	}
+}
+
+// getConfig returns the current configuration of the agent, which must not be
+// modified.
+func (a *Agent) getConfig() *Config {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.config
+}
+
+// SetPaused pauses or resumes the agent. A paused agent doesn't execute the
+// checks it receives, and its entity is annotated with PausedAnnotation so
+// the pause is visible in the keepalives.
+func (a *Agent) SetPaused(paused bool) {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+	if a.paused == paused {
+		return
+	}
+	a.paused = paused
+	// The entity is built again with the new annotation on the next use
+	a.entity = nil
+	if paused {
+		logger.Warn("agent paused, checks will not be executed")
+	} else {
+		logger.Warn("agent resumed")
+	}
+}
+
+// Paused returns true if the agent is paused.
+func (a *Agent) Paused() bool {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+	return a.paused
+}
+
+// Draining returns true if the agent is draining.
+func (a *Agent) Draining() bool {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+	return a.draining
+}
+
+// Ready returns true if the agent is connected to a backend and not draining.
+func (a *Agent) Ready() bool {
+	return a.Connected() && !a.Draining()
+}
+
 // StartAPI starts the Agent HTTP API. After attempting to start the API, if the
 // HTTP server encounters a fatal error, it will shutdown the rest of the agent.
 func (a *Agent) StartAPI(ctx context.Context) {
@@ -463,6 +753,7 @@ func (a *Agent) StartStatsd(ctx context.Context) {
 }
 
 func (a *Agent) connectWithBackoff(ctx context.Context) (transport.Transport, error) {
+	config := a.getConfig()
 	var conn transport.Transport
 
 	backoff := retry.ExponentialBackoff{
@@ -478,7 +769,7 @@ func (a *Agent) connectWithBackoff(ctx context.Context) (transport.Transport, er
 		logger.Infof("connecting to backend URL %q", url)
 		a.header.Set("Accept", agentd.ProtobufSerializationHeader)
 		logger.WithField("header", fmt.Sprintf("Accept: %s", agentd.ProtobufSerializationHeader)).Debug("setting header")
-		c, respHeader, err := transport.Connect(url, a.config.TLS, a.header, a.config.BackendHandshakeTimeout)
+		c, respHeader, err := transport.Connect(url, config.TLS, a.header, config.BackendHandshakeTimeout, a.proxy, config.BackendCompression)
 		if err != nil {
 			logger.WithError(err).Error("reconnection attempt failed")
 			return false, nil
@@ -489,6 +780,7 @@ func (a *Agent) connectWithBackoff(ctx context.Context) (transport.Transport, er
 		conn = c
 
 		logger.WithField("header", fmt.Sprintf("Accept: %s", respHeader["Accept"])).Debug("received header")
+		a.batching = respHeader.Get(transport.HeaderKeyBatching) != ""
 		if utilstrings.InArray(agentd.ProtobufSerializationHeader, respHeader["Accept"]) {
 			a.contentType = agentd.ProtobufSerializationHeader
 			a.unmarshal = proto.Unmarshal
@@ -502,6 +794,7 @@ func (a *Agent) connectWithBackoff(ctx context.Context) (transport.Transport, er
 		}
 		a.header.Set("Content-Type", a.contentType)
 		logger.WithField("header", fmt.Sprintf("Content-Type: %s", a.contentType)).Debug("setting header")
+		setSerialization(a.contentType)
 
 		return true, nil
 	})
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -14,6 +15,7 @@ import (
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/version"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/time/rate"
 )
 
@@ -28,13 +30,19 @@ type sensuVersion struct {
 	Version string `json:"version"`
 }
 
+// logLevel contains the API request and response for the log level
+type logLevel struct {
+	Level string `json:"level"`
+}
+
 // newServer returns a new HTTP server
 func newServer(a *Agent) *http.Server {
+	config := a.getConfig()
 	router := mux.NewRouter()
 	registerRoutes(a, router)
 
 	server := &http.Server{
-		Addr:         fmt.Sprintf("%s:%d", a.config.API.Host, a.config.API.Port),
+		Addr:         fmt.Sprintf("%s:%d", config.API.Host, config.API.Port),
 		Handler:      router,
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
@@ -46,8 +54,16 @@ func newServer(a *Agent) *http.Server {
 func registerRoutes(a *Agent, r *mux.Router) {
 	r.HandleFunc("/events", addEvent(a)).Methods(http.MethodPost)
 	r.HandleFunc("/healthz", healthz(a.Connected)).Methods(http.MethodGet)
+	r.HandleFunc("/livez", livez()).Methods(http.MethodGet)
+	r.HandleFunc("/readyz", readyz(a.Ready)).Methods(http.MethodGet)
 	r.HandleFunc("/version", versionShow()).Methods(http.MethodGet)
-	r.Handle("/metrics", promhttp.Handler())
+	r.HandleFunc("/loglevel", requireAgentCredentials(a, logLevelShow())).Methods(http.MethodGet)
+	r.HandleFunc("/loglevel", requireAgentCredentials(a, logLevelUpdate())).Methods(http.MethodPut)
+	r.HandleFunc("/results", requireAgentCredentials(a, resultsShow(a))).Methods(http.MethodGet)
+	r.HandleFunc("/assets/gc", requireAgentCredentials(a, assetsGC(a))).Methods(http.MethodPost)
+	if !a.getConfig().DisableMetrics {
+		r.Handle("/metrics", promhttp.Handler())
+	}
 }
 
 // healthz returns an OK status if the agent is up and connected to a backend.
@@ -63,6 +79,28 @@ func healthz(connected func() bool) http.HandlerFunc {
 	}
 }
 
+// livez returns an OK status while the agent API is up, regardless of the
+// backend connection, so a liveness probe does not restart the agent when the
+// backend is unreachable.
+func livez() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "ok")
+	}
+}
+
+// readyz returns an OK status if the agent is connected to a backend and not
+// draining. Otherwise, it returns service unavailable.
+func readyz(ready func() bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprint(w, "agent not ready")
+			return
+		}
+		_, _ = fmt.Fprint(w, "ok")
+	}
+}
+
 // sensuVersion returns the version of Sensu
 func versionShow() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -84,14 +122,61 @@ func versionShow() http.HandlerFunc {
 	}
 }
 
+// requireAgentCredentials only lets through requests that provide the
+// username and password of the agent using HTTP basic authentication.
+func requireAgentCredentials(a *Agent, next http.HandlerFunc) http.HandlerFunc {
+	config := a.getConfig()
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(user), []byte(config.User)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(config.Password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="sensu-agent"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// logLevelShow returns the current log level of the agent
+func logLevelShow() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(logLevel{Level: logrus.GetLevel().String()})
+	}
+}
+
+// logLevelUpdate changes the log level of the agent
+func logLevelUpdate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body logLevel
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		level, err := logrus.ParseLevel(body.Level)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logrus.SetLevel(level)
+		logger.Warnf("set log level to %s", level)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(logLevel{Level: level.String()})
+	}
+}
+
 func (a *Agent) handleAPIQueue(ctx context.Context) {
+	config := a.getConfig()
 	ch := make(chan *lasr.Message, 1)
 	go func() {
-		limit := a.config.EventsAPIRateLimit
+		limit := config.EventsAPIRateLimit
 		if limit == 0 {
 			limit = rate.Limit(math.Inf(1))
 		}
-		limiter := rate.NewLimiter(limit, a.config.EventsAPIBurstLimit)
+		limiter := rate.NewLimiter(limit, config.EventsAPIBurstLimit)
 		for {
 			if err := limiter.Wait(ctx); err != nil {
 				// context canceled
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -111,6 +112,48 @@ func TestHealthz(t *testing.T) {
 	}
 }
 
+func TestReadyz(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		connected        bool
+		draining         bool
+		expectedResponse int
+	}{
+		{"connected", true, false, http.StatusOK},
+		{"disconnected", false, false, http.StatusServiceUnavailable},
+		{"draining", true, true, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			config, cleanup := FixtureConfig()
+			defer cleanup()
+			agent, err := NewAgent(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			agent.connected = tc.connected
+			agent.draining = tc.draining
+
+			router := mux.NewRouter()
+			registerRoutes(agent, router)
+
+			// The agent is alive regardless of the backend connection
+			r, err := http.NewRequest("GET", "/livez", nil)
+			assert.NoError(t, err)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			r, err = http.NewRequest("GET", "/readyz", nil)
+			assert.NoError(t, err)
+			w = httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			assert.Equal(t, tc.expectedResponse, w.Code)
+		})
+	}
+}
+
 func TestVersion(t *testing.T) {
 	var (
 		versionResponse = `{"version":""}`
@@ -157,3 +200,91 @@ func TestVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestLogLevel(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	logrus.SetLevel(logrus.WarnLevel)
+
+	testCases := []struct {
+		desc             string
+		method           string
+		body             string
+		user             string
+		password         string
+		expectedResponse int
+		expectedLevel    logrus.Level
+	}{
+		{
+			"get without credentials",
+			http.MethodGet,
+			"",
+			"",
+			"",
+			http.StatusUnauthorized,
+			logrus.WarnLevel,
+		},
+		{
+			"update with bad credentials",
+			http.MethodPut,
+			`{"level":"debug"}`,
+			DefaultUser,
+			"hunter2",
+			http.StatusUnauthorized,
+			logrus.WarnLevel,
+		},
+		{
+			"get with credentials",
+			http.MethodGet,
+			"",
+			DefaultUser,
+			DefaultPassword,
+			http.StatusOK,
+			logrus.WarnLevel,
+		},
+		{
+			"update with an invalid level",
+			http.MethodPut,
+			`{"level":"chatty"}`,
+			DefaultUser,
+			DefaultPassword,
+			http.StatusBadRequest,
+			logrus.WarnLevel,
+		},
+		{
+			"update with credentials",
+			http.MethodPut,
+			`{"level":"debug"}`,
+			DefaultUser,
+			DefaultPassword,
+			http.StatusOK,
+			logrus.DebugLevel,
+		},
+	}
+
+	for _, tc := range testCases {
+		testName := fmt.Sprintf("log level %s", tc.desc)
+
+		t.Run(testName, func(t *testing.T) {
+			config, cleanup := FixtureConfig()
+			defer cleanup()
+			agent, err := NewAgent(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := http.NewRequest(tc.method, "/loglevel", bytes.NewBufferString(tc.body))
+			assert.NoError(t, err)
+			if tc.user != "" {
+				r.SetBasicAuth(tc.user, tc.password)
+			}
+
+			router := mux.NewRouter()
+			registerRoutes(agent, router)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			assert.Equal(t, tc.expectedResponse, w.Code)
+			assert.Equal(t, tc.expectedLevel, logrus.GetLevel())
+		})
+	}
+}
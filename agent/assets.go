@@ -0,0 +1,48 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// assetsGC removes the cached assets unused for the grace period of the
+// agent, or for the one given in seconds by the grace_period query parameter,
+// and returns them. The assets are only listed if the dry_run query parameter
+// is true.
+func assetsGC(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if a.assetManager == nil {
+			http.Error(w, "assets are disabled", http.StatusServiceUnavailable)
+			return
+		}
+		query := r.URL.Query()
+		dryRun := false
+		if value := query.Get("dry_run"); value != "" {
+			var err error
+			dryRun, err = strconv.ParseBool(value)
+			if err != nil {
+				http.Error(w, "dry_run must be a boolean", http.StatusBadRequest)
+				return
+			}
+		}
+		gracePeriod := time.Duration(a.getConfig().AssetsGCGracePeriod) * time.Second
+		if value := query.Get("grace_period"); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err != nil || seconds < 0 {
+				http.Error(w, "grace_period must be a positive integer", http.StatusBadRequest)
+				return
+			}
+			gracePeriod = time.Duration(seconds) * time.Second
+		}
+
+		garbage, err := a.assetManager.CollectGarbage(gracePeriod, dryRun)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(garbage)
+	}
+}
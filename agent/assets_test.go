@@ -0,0 +1,73 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/asset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestAssetsGC(t *testing.T) {
+	config, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := mux.NewRouter()
+	registerRoutes(agent, router)
+
+	request := func(query string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest(http.MethodPost, "/assets/gc"+query, nil)
+		require.NoError(t, err)
+		r.SetBasicAuth(DefaultUser, DefaultPassword)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	// The assets are disabled
+	assert.Equal(t, http.StatusServiceUnavailable, request("").Code)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+	manager := asset.NewManager(config.CacheDir, agent.getAgentEntity(), &wg)
+	_, err = manager.StartAssetManager(ctx, rate.NewLimiter(rate.Inf, 1))
+	require.NoError(t, err)
+	agent.assetManager = manager
+
+	testCases := []struct {
+		desc             string
+		query            string
+		expectedResponse int
+	}{
+		{"default grace period", "", http.StatusOK},
+		{"dry run", "?dry_run=true&grace_period=0", http.StatusOK},
+		{"invalid dry run", "?dry_run=maybe", http.StatusBadRequest},
+		{"invalid grace period", "?grace_period=-1", http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			w := request(tc.query)
+			assert.Equal(t, tc.expectedResponse, w.Code)
+			if w.Code != http.StatusOK {
+				return
+			}
+			var garbage []asset.GarbageAsset
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&garbage))
+			assert.Empty(t, garbage)
+		})
+	}
+}
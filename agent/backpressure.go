@@ -0,0 +1,62 @@
+package agent
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// BackpressureDelayCounter is the name of the prometheus counter used to
+	// count the time the events were held at the request of the backend.
+	BackpressureDelayCounter = "sensu_go_agent_backpressure_delay_seconds"
+
+	// maxBackpressureDelay bounds the delay the backend can ask the agent to
+	// hold its events for.
+	maxBackpressureDelay = time.Minute
+)
+
+// BackpressureDelay counts the time the events were held because the backend
+// asked the agent to slow down.
+var BackpressureDelay = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: BackpressureDelayCounter,
+		Help: "The total time, in seconds, the events were held because the backend asked the agent to slow down",
+	},
+)
+
+// handleBackpressure handles the directive of the backend asking the agent to
+// hold its events for the delay in milliseconds of payload.
+func (a *Agent) handleBackpressure(payload []byte) {
+	ms, err := strconv.ParseInt(string(payload), 10, 64)
+	if err != nil || ms < 0 {
+		logger.WithField("payload", string(payload)).Error("invalid backpressure delay")
+		return
+	}
+	delay := time.Duration(ms) * time.Millisecond
+	if delay > maxBackpressureDelay {
+		delay = maxBackpressureDelay
+	}
+	logger.WithField("delay", delay).Warn("backend is congested, holding events")
+
+	until := time.Now().Add(delay)
+	a.backpressureMu.Lock()
+	if until.After(a.backpressureUntil) {
+		a.backpressureUntil = until
+	}
+	a.backpressureMu.Unlock()
+}
+
+// waitBackpressure blocks until the delay the backend asked the agent to hold
+// its events for expires.
+func (a *Agent) waitBackpressure() {
+	a.backpressureMu.Lock()
+	delay := time.Until(a.backpressureUntil)
+	a.backpressureMu.Unlock()
+	if delay <= 0 {
+		return
+	}
+	BackpressureDelay.Add(delay.Seconds())
+	time.Sleep(delay)
+}
@@ -0,0 +1,88 @@
+package agent
+
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+)
+
+const (
+	// batchMessageMaxSize is the size of the largest message added to a
+	// batch, so that only the small messages, such as the keepalives and the
+	// metric events, are delayed.
+	batchMessageMaxSize = 16 * 1024
+
+	// batchMaxSize is the size after which a batch is sent without waiting
+	// for the batch delay.
+	batchMaxSize = 256 * 1024
+)
+
+// messageBatch accumulates the small messages sent to the backend within the
+// batch delay, which are then sent as a single batch message.
+type messageBatch struct {
+	delay    time.Duration
+	messages []*transport.Message
+	size     int
+	timer    *time.Timer
+}
+
+func newMessageBatch(delay time.Duration) *messageBatch {
+	return &messageBatch{delay: delay}
+}
+
+// C returns the channel receiving once the batch must be sent, which is nil
+// while the batch is empty or disabled.
+func (b *messageBatch) C() <-chan time.Time {
+	if b == nil || b.timer == nil {
+		return nil
+	}
+	return b.timer.C
+}
+
+// send adds the message to the batch if it is small enough, or sends it over
+// conn after the messages of the batch, so that the messages keep their
+// order. The nil batch sends all the messages directly.
+func (b *messageBatch) send(conn transport.Transport, msg *transport.Message) error {
+	if b == nil || len(msg.Payload) > batchMessageMaxSize || msg.Type == transport.MessageTypeDeregistration {
+		if err := b.flush(conn); err != nil {
+			return err
+		}
+		return conn.Send(msg)
+	}
+
+	b.messages = append(b.messages, msg)
+	b.size += len(msg.Payload)
+	if b.timer == nil {
+		b.timer = time.NewTimer(b.delay)
+	}
+	if b.size >= batchMaxSize {
+		return b.flush(conn)
+	}
+	return nil
+}
+
+// flush sends the messages of the batch over conn, calling their send
+// callbacks with the result.
+func (b *messageBatch) flush(conn transport.Transport) error {
+	if b == nil || len(b.messages) == 0 {
+		return nil
+	}
+	b.timer.Stop()
+	b.timer = nil
+	messages := b.messages
+	b.messages = nil
+	b.size = 0
+
+	if len(messages) == 1 {
+		return conn.Send(messages[0])
+	}
+	batch := transport.NewMessage(transport.MessageTypeBatch, transport.EncodeBatch(messages))
+	batch.SendCallback = func(err error) {
+		for _, msg := range messages {
+			if msg.SendCallback != nil {
+				msg.SendCallback(err)
+			}
+		}
+	}
+	return conn.Send(batch)
+}
@@ -0,0 +1,75 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTransport records the messages sent over it.
+type recordingTransport struct {
+	sent []transport.Message
+}
+
+func (t *recordingTransport) Close() error                                         { return nil }
+func (t *recordingTransport) Closed() bool                                         { return false }
+func (t *recordingTransport) Heartbeat(ctx context.Context, interval, timeout int) {}
+func (t *recordingTransport) Receive() (*transport.Message, error)                 { return nil, nil }
+
+func (t *recordingTransport) Send(m *transport.Message) error {
+	t.sent = append(t.sent, transport.Message{Type: m.Type, Payload: m.Payload})
+	if m.SendCallback != nil {
+		m.SendCallback(nil)
+	}
+	return nil
+}
+
+func TestMessageBatch(t *testing.T) {
+	conn := &recordingTransport{}
+	batch := newMessageBatch(time.Hour)
+	assert.Nil(t, batch.C())
+
+	var callbacks int
+	callback := func(err error) {
+		assert.NoError(t, err)
+		callbacks++
+	}
+	require.NoError(t, batch.send(conn, &transport.Message{Type: transport.MessageTypeKeepalive, Payload: []byte("keepalive")}))
+	require.NoError(t, batch.send(conn, &transport.Message{Type: transport.MessageTypeEvent, Payload: []byte("metrics"), SendCallback: callback}))
+	assert.Empty(t, conn.sent)
+	assert.NotNil(t, batch.C())
+
+	// A large message is sent after the batch
+	large := bytes.Repeat([]byte("x"), batchMessageMaxSize+1)
+	require.NoError(t, batch.send(conn, &transport.Message{Type: transport.MessageTypeEvent, Payload: large}))
+	require.Len(t, conn.sent, 2)
+	assert.Equal(t, transport.MessageTypeBatch, conn.sent[0].Type)
+	assert.Equal(t, 1, callbacks)
+	messages, err := transport.DecodeBatch(conn.sent[0].Payload)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "keepalive", string(messages[0].Payload))
+	assert.Equal(t, "metrics", string(messages[1].Payload))
+	assert.Equal(t, large, conn.sent[1].Payload)
+	assert.Nil(t, batch.C())
+
+	// A single message is not wrapped in a batch
+	require.NoError(t, batch.send(conn, &transport.Message{Type: transport.MessageTypeKeepalive, Payload: []byte("keepalive")}))
+	require.NoError(t, batch.flush(conn))
+	require.Len(t, conn.sent, 3)
+	assert.Equal(t, transport.MessageTypeKeepalive, conn.sent[2].Type)
+}
+
+func TestMessageBatchDisabled(t *testing.T) {
+	conn := &recordingTransport{}
+	var batch *messageBatch
+	require.NoError(t, batch.send(conn, &transport.Message{Type: transport.MessageTypeKeepalive, Payload: []byte("keepalive")}))
+	require.Len(t, conn.sent, 1)
+	assert.Nil(t, batch.C())
+	assert.NoError(t, batch.flush(conn))
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +18,7 @@ import (
 	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/token"
+	"github.com/sensu/sensu-go/tracing"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/util/environment"
 	"github.com/sirupsen/logrus"
@@ -35,6 +38,15 @@ func (a *Agent) handleCheck(ctx context.Context, payload []byte) error {
 	}
 
 	checkConfig := request.Config
+	if a.Paused() {
+		logger.WithField("check", checkConfig.Name).Info("agent is paused, not executing check")
+		return nil
+	}
+	if a.Draining() {
+		logger.WithField("check", checkConfig.Name).Info("agent is draining, not executing check")
+		return nil
+	}
+
 	sendFailure := func(err error) {
 		check := corev2.NewCheck(checkConfig)
 		check.Executed = time.Now().Unix()
@@ -45,7 +57,7 @@ func (a *Agent) handleCheck(ctx context.Context, payload []byte) error {
 		a.sendFailure(event, err)
 	}
 
-	if a.config.DisableAssets && len(request.Assets) > 0 {
+	if a.getConfig().DisableAssets && len(request.Assets) > 0 {
 		err := errors.New("check requested assets, but they are disabled on this agent")
 		sendFailure(err)
 		return nil
@@ -86,6 +98,52 @@ func checkKey(request *corev2.CheckRequest) string {
 	return strings.Join(parts, "/")
 }
 
+// splayDelay returns the offset of entity in the splay window of request. The
+// offset is derived from the entity and check names, so that an agent executes
+// a check at the same time in every interval.
+func splayDelay(request *corev2.CheckRequest, entity string) time.Duration {
+	if request.Splay == 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(path.Join(request.Config.Namespace, request.Config.Name, entity)))
+	window := uint64(time.Duration(request.Splay) * time.Second / time.Millisecond)
+	return time.Duration(h.Sum64()%window) * time.Millisecond
+}
+
+// drainPollInterval is the interval at which a draining agent checks if the
+// checks in progress are complete.
+const drainPollInterval = 100 * time.Millisecond
+
+// Drain stops the execution of new checks and waits for the checks in
+// progress to complete, or for ctx to be done. The agent is not ready anymore
+// once draining, so it can be removed from the service endpoints before it is
+// shut down.
+func (a *Agent) Drain(ctx context.Context) {
+	a.entityMu.Lock()
+	a.draining = true
+	a.entityMu.Unlock()
+	logger.Info("draining the agent, new checks will not be executed")
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		a.inProgressMu.Lock()
+		inProgress := len(a.inProgress)
+		a.inProgressMu.Unlock()
+		if inProgress == 0 {
+			logger.Info("agent drained")
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			logger.WithField("checks", inProgress).Warn("agent drain timed out with checks in progress")
+			return
+		}
+	}
+}
+
 func (a *Agent) addInProgress(request *corev2.CheckRequest) {
 	a.inProgressMu.Lock()
 	a.inProgress[checkKey(request)] = request.Config
@@ -102,8 +160,27 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 	a.addInProgress(request)
 	defer a.removeInProgress(request)
 
+	// Wait for the offset of the entity in the splay window of the check, if
+	// any, so that the agents do not all execute the check at the same time
+	if delay := splayDelay(request, entity.Name); delay > 0 {
+		logger.WithField("check", request.Config.Name).Debugf("delaying check execution by %s", delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+
 	checkAssets := request.Assets
 	checkConfig := request.Config
+
+	// Start the trace of the event, propagated to the backend in its trace
+	// parent
+	span := a.tracer.Start(tracing.SpanContext{}, "agent.check")
+	defer span.End()
+	span.SetAttribute(tracing.AttributeNamespace, checkConfig.Namespace)
+	span.SetAttribute(tracing.AttributeEntity, entity.Name)
+	span.SetAttribute(tracing.AttributeCheck, checkConfig.Name)
 	checkHooks := request.Hooks
 	hookAssets := request.HookAssets
 	secrets := request.Secrets
@@ -116,6 +193,7 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 		event.Check = corev2.NewCheck(checkConfig)
 		event.Check.Executed = time.Now().Unix()
 		event.Check.Issued = request.Issued
+		event.TraceParent = span.Context().TraceParent()
 
 		// To guard against publishing sensitive/redacted client attribute values
 		// the original command value is reinstated.
@@ -126,6 +204,7 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 
 	// Perform token substitution on the check configuration
 	if err := token.SubstituteCheck(checkConfig, entity); err != nil {
+		TokenSubstitutionFailures.Inc()
 		a.sendFailure(createEvent(), fmt.Errorf("error while substituting check tokens: %s", err))
 		return
 	}
@@ -216,12 +295,18 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 		ex.Input = string(input)
 	}
 
+	execSpan := a.tracer.Start(span.Context(), "agent.check.execute")
 	checkExec, err := a.executor.Execute(context.Background(), ex)
+	execSpan.SetError(err)
+	execSpan.End()
 	if err != nil {
 		event.Check.Output = err.Error()
 		checkExec.Status = 3
+		CheckExecutions.WithLabelValues(StatusFailure).Inc()
 	} else {
 		event.Check.Output = checkExec.Output
+		CheckExecutions.WithLabelValues(StatusSuccess).Inc()
+		CheckExecutionDuration.Observe(checkExec.Duration)
 	}
 
 	event.Check.Duration = checkExec.Duration
@@ -252,15 +337,28 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 		event.Check.Hooks = a.ExecuteHooks(ctx, request, event, hookAssets)
 	}
 
+	// Mask the secrets and the sensitive values of the environment, before
+	// the output is truncated so that no value is partially kept
+	event.RedactOutput(redactedValues(event, env, secrets)...)
+
+	// Protect the transport and the backend from oversized output
+	a.limitOutput(event.Check)
+
 	// The check requested that we discard its output before writing back
 	// the result.
 	if event.Check.DiscardOutput {
 		event.Check.Output = ""
 	}
 
+	a.recordResult(event.Check)
+
+	tracing.SetEventAttributes(span, event)
+	span.SetAttribute(tracing.AttributeCheckStatus, event.Check.Status)
+
 	msg, err := a.marshal(event)
 	if err != nil {
 		logger.WithError(err).Error("error marshaling check result")
+		span.SetError(err)
 		return
 	}
 
@@ -274,6 +372,14 @@ func (a *Agent) executeCheck(ctx context.Context, request *corev2.CheckRequest,
 	a.sendMessage(tm)
 }
 
+// redactedValues returns the values of the secrets of the check, formatted as
+// KEY=VALUE, which are always masked in its output, and of the variables of
+// its execution environment matching the redact fields.
+func redactedValues(event *corev2.Event, env []string, secrets []string) []string {
+	values := corev2.SensitiveValues(event.Check.RedactFields(event.Entity), env, nil)
+	return append(values, corev2.EnvValues(secrets)...)
+}
+
 func (a *Agent) sendFailure(event *corev2.Event, err error) {
 	event.Check.Output = err.Error()
 	event.Check.Status = 3
@@ -289,6 +395,8 @@ func (a *Agent) sendFailure(event *corev2.Event, err error) {
 		}
 	}
 
+	a.recordResult(event.Check)
+
 	if msg, err := a.marshal(event); err != nil {
 		logger.WithError(err).Error("error marshaling check failure")
 	} else {
@@ -316,6 +424,8 @@ func extractMetrics(event *corev2.Event) []*corev2.MetricPoint {
 		transformer = transformers.ParseNagios(event)
 	case corev2.OpenTSDBOutputMetricFormat:
 		transformer = transformers.ParseOpenTSDB(event)
+	case corev2.PrometheusOutputMetricFormat:
+		transformer = transformers.ParsePrometheus(event)
 	}
 
 	if transformer == nil {
@@ -310,6 +310,42 @@ func TestExecuteCheckDiscardOutput(t *testing.T) {
 	}
 }
 
+func TestExecuteCheckRedactOutput(t *testing.T) {
+	checkConfig := corev2.FixtureCheckConfig("check")
+	checkConfig.EnvVars = []string{"API_TOKEN=s3cr3t-token", "REGION=eu-west-1"}
+	checkConfig.Redact = []string{"region"}
+	request := &corev2.CheckRequest{
+		Config:  checkConfig,
+		Issued:  time.Now().Unix(),
+		Secrets: []string{"SLACK_WEBHOOK=https://hooks.slack.com/T0"},
+	}
+
+	config, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch := make(chan *transport.Message, 1)
+	agent.sendq = ch
+	ex := &mockexecutor.MockExecutor{}
+	agent.executor = ex
+	output := "posted to https://hooks.slack.com/T0 with s3cr3t-token in eu-west-1"
+	ex.Return(command.FixtureExecutionResponse(0, output), nil)
+
+	agent.executeCheck(context.TODO(), request, agent.getAgentEntity())
+	msg := <-ch
+
+	event := &corev2.Event{}
+	if err := json.Unmarshal(msg.Payload, event); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := event.Check.Output, "posted to REDACTED with REDACTED in REDACTED"; got != want {
+		t.Fatalf("bad check output: got %q, want %q", got, want)
+	}
+}
+
 func TestHandleTokenSubstitution(t *testing.T) {
 	assert := assert.New(t)
 
@@ -517,3 +553,22 @@ func TestFailOnAssetCheckWithDisabledAssets(t *testing.T) {
 		t.Errorf("bad status: got %d, want %d", got, want)
 	}
 }
+
+func TestSplayDelay(t *testing.T) {
+	request := &corev2.CheckRequest{Config: corev2.FixtureCheckConfig("check")}
+	assert.Equal(t, time.Duration(0), splayDelay(request, "entity1"))
+
+	request.Splay = 54
+	delay := splayDelay(request, "entity1")
+	assert.True(t, delay >= 0 && delay < 54*time.Second)
+
+	// The delay of an entity is the same for every request
+	assert.Equal(t, delay, splayDelay(request, "entity1"))
+
+	// The delays of the entities are spread across the splay window
+	delays := make(map[time.Duration]struct{})
+	for i := 0; i < 100; i++ {
+		delays[splayDelay(request, fmt.Sprintf("entity%d", i))] = struct{}{}
+	}
+	assert.True(t, len(delays) > 90)
+}
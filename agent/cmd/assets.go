@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/asset"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagDryRun      = "dry-run"
+	flagGracePeriod = "grace-period"
+)
+
+// AssetsCommand manages the assets cached by a running agent.
+func AssetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets",
+		Short: "Manage the assets cached by the sensu-agent",
+	}
+
+	cmd.AddCommand(assetsGCCommand())
+
+	return cmd
+}
+
+// assetsGCCommand removes the cached assets unused for a grace period, with
+// the API of the running agent.
+func assetsGCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "gc",
+		Short:        "Remove the cached assets unused for a grace period",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			host, _ := flags.GetString(flagAPIHost)
+			port, _ := flags.GetInt(flagAPIPort)
+			user, _ := flags.GetString(flagUser)
+			password, _ := flags.GetString(flagPassword)
+			dryRun, _ := flags.GetBool(flagDryRun)
+
+			query := url.Values{}
+			query.Set("dry_run", strconv.FormatBool(dryRun))
+			if flags.Changed(flagGracePeriod) {
+				gracePeriod, _ := flags.GetInt(flagGracePeriod)
+				query.Set("grace_period", strconv.Itoa(gracePeriod))
+			}
+			u := url.URL{
+				Scheme:   "http",
+				Host:     net.JoinHostPort(host, strconv.Itoa(port)),
+				Path:     "/assets/gc",
+				RawQuery: query.Encode(),
+			}
+			req, err := http.NewRequest(http.MethodPost, u.String(), nil)
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth(user, password)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("couldn't reach the agent API: %s", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				body, _ := ioutil.ReadAll(resp.Body)
+				return fmt.Errorf("the agent API returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+			}
+			var garbage []asset.GarbageAsset
+			if err := json.NewDecoder(resp.Body).Decode(&garbage); err != nil {
+				return err
+			}
+
+			verb := "removed"
+			if dryRun {
+				verb = "would remove"
+			}
+			var total int64
+			for _, a := range garbage {
+				total += a.Size
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s (%d bytes, last used %s)\n",
+					verb, a.Path, a.Size, time.Unix(a.LastUsed, 0).Format(time.RFC3339))
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %d asset(s), %d bytes\n", verb, len(garbage), total)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAPIHost, agent.DefaultAPIHost, "address of the agent API")
+	cmd.Flags().Int(flagAPIPort, agent.DefaultAPIPort, "port of the agent API")
+	cmd.Flags().String(flagUser, agent.DefaultUser, "agent user")
+	cmd.Flags().String(flagPassword, agent.DefaultPassword, "agent password")
+	cmd.Flags().Bool(flagDryRun, false, "only list the assets which would be removed")
+	cmd.Flags().Int(flagGracePeriod, 0, "time in seconds after which an unused asset is removed (defaults to the grace period of the agent)")
+
+	return cmd
+}
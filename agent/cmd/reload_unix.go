@@ -0,0 +1,20 @@
+// +build !windows
+
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyReload requests a configuration reload on every SIGHUP.
+func notifyReload() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	go func() {
+		for range sigs {
+			requestReload()
+		}
+	}()
+}
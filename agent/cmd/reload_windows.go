@@ -0,0 +1,7 @@
+// +build windows
+
+package cmd
+
+// notifyReload does nothing on Windows, where the reloads are requested by the
+// service control manager with a parameter change control.
+func notifyReload() {}
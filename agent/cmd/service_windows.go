@@ -6,30 +6,68 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	runtimedebug "runtime/debug"
 
 	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/util/service"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/windows/svc"
 	"golang.org/x/sys/windows/svc/debug"
 	"golang.org/x/sys/windows/svc/eventlog"
 )
 
+// serviceAccepts are the controls accepted by the service.
+const serviceAccepts = svc.AcceptShutdown | svc.AcceptStop | svc.AcceptParamChange | svc.AcceptPauseAndContinue
+
 var (
 	_            svc.Handler = &Service{}
 	elog         debug.Log
 	AgentNewFunc = agent.NewAgentContext
 )
 
-func NewService(args []string) *Service {
-	return &Service{args: args}
+func NewService(args []string, policy RestartPolicy) *Service {
+	return &Service{args: args, policy: policy}
 }
 
 type Service struct {
-	args []string
-	wg   sync.WaitGroup
-	mu   sync.Mutex
+	args   []string
+	policy RestartPolicy
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+}
+
+// RestartPolicy configures how the service restarts sensu-agent when it exits
+// with an error.
+type RestartPolicy struct {
+	// InitialDelay is the time to wait before the first restart.
+	InitialDelay time.Duration
+
+	// Multiplier is applied to the delay after every consecutive failure.
+	Multiplier float64
+
+	// MaxDelay is the maximal time to wait before a restart. A failure that
+	// occurs after the agent has been running for at least MaxDelay is not
+	// consecutive to the previous ones.
+	MaxDelay time.Duration
+
+	// MaxFailures is the number of consecutive failures after which the
+	// service stops with a non-zero exit code. Zero means no limit.
+	MaxFailures int
+}
+
+// next returns the delay to wait before the restart following delay.
+func (p RestartPolicy) next(delay time.Duration) time.Duration {
+	if delay == 0 {
+		delay = p.InitialDelay
+	} else {
+		delay = time.Duration(float64(delay) * p.Multiplier)
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
 }
 
 func (s *Service) start(ctx context.Context, args []string, changes chan<- svc.Status) chan error {
@@ -49,7 +87,7 @@ func (s *Service) start(ctx context.Context, args []string, changes chan<- svc.S
 		defer s.wg.Done()
 		changes <- svc.Status{State: svc.StartPending}
 		// Start service here
-		binPath, err := exePath()
+		binPath, err := service.ExePath()
 		if err != nil {
 			panic(err)
 		}
@@ -69,8 +107,11 @@ func (s *Service) start(ctx context.Context, args []string, changes chan<- svc.S
 
 		args = []string{binPath, "start", "-c", configFile}
 		command := StartCommand(AgentNewFunc)
-		accepts := svc.AcceptShutdown | svc.AcceptStop
-		changes <- svc.Status{State: svc.Running, Accepts: accepts}
+		state := svc.Running
+		if isPaused() {
+			state = svc.Paused
+		}
+		changes <- svc.Status{State: state, Accepts: serviceAccepts}
 
 		if err := command.Execute(); err != nil {
 			logger.WithError(err).Error("sensu-agent exited with error")
@@ -83,8 +124,15 @@ func (s *Service) start(ctx context.Context, args []string, changes chan<- svc.S
 func (s *Service) Execute(_ []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
 	ctx, cancel := context.WithCancel(context.Background())
 	errs := s.start(ctx, s.args, changes)
+	started := time.Now()
 	elog, _ := eventlog.Open(serviceName)
 	defer elog.Close()
+
+	var (
+		failures int
+		delay    time.Duration
+		restart  <-chan time.Time
+	)
 	for {
 		select {
 		case req := <-r:
@@ -96,23 +144,52 @@ func (s *Service) Execute(_ []string, r <-chan svc.ChangeRequest, changes chan<-
 				s.wg.Wait()
 				changes <- svc.Status{State: svc.Stopped}
 				return false, 0
+			case svc.ParamChange:
+				elog.Info(1, "reloading configuration")
+				requestReload()
+				changes <- req.CurrentStatus
+			case svc.Pause:
+				elog.Info(1, "pausing check execution")
+				requestPause(true)
+				changes <- svc.Status{State: svc.Paused, Accepts: serviceAccepts}
+			case svc.Continue:
+				elog.Info(1, "resuming check execution")
+				requestPause(false)
+				changes <- svc.Status{State: svc.Running, Accepts: serviceAccepts}
+			case svc.Interrogate:
+				changes <- req.CurrentStatus
 			}
 		case err := <-errs:
-			elog.Error(1, fmt.Sprintf("restarting due to error (%v) %s", s.args, err))
-			s.start(ctx, s.args, changes)
+			if time.Since(started) >= s.policy.MaxDelay {
+				// The agent ran long enough, the failures are not consecutive
+				failures = 0
+				delay = 0
+			}
+			failures++
+			if s.policy.MaxFailures > 0 && failures >= s.policy.MaxFailures {
+				elog.Error(1, fmt.Sprintf("stopping after %d consecutive failures (%v): %s", failures, s.args, err))
+				cancel()
+				return false, 1
+			}
+			delay = s.policy.next(delay)
+			elog.Error(1, fmt.Sprintf("restarting in %s due to error (%v) %s", delay, s.args, err))
+			restart = time.After(delay)
+		case <-restart:
+			restart = nil
+			errs = s.start(ctx, s.args, changes)
+			started = time.Now()
 		}
 	}
-	return false, 0
 }
 
-func runService(args []string) error {
+func runService(args []string, policy RestartPolicy) error {
 	elog, err := eventlog.Open(serviceName)
 	if err != nil {
 		return err
 	}
 	defer elog.Close()
 	elog.Info(1, fmt.Sprintf("starting %s service (%v)", serviceName, args))
-	if err := svc.Run(serviceName, NewService(args)); err != nil {
+	if err := svc.Run(serviceName, NewService(args, policy)); err != nil {
 		return err
 	}
 	elog.Info(1, fmt.Sprintf("%s service terminated", serviceName))
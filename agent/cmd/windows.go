@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/sensu/sensu-go/util/path"
+	"github.com/sensu/sensu-go/util/service"
 	"github.com/spf13/cobra"
 )
 
@@ -16,9 +18,12 @@ const (
 	serviceName        = "SensuAgent"
 	serviceDisplayName = "Sensu Agent"
 	serviceDescription = "The monitoring agent for sensu-go (https://sensu.io)"
-	serviceUser        = "LocalSystem"
 
-	flagLogPath = "log-file"
+	flagLogPath             = "log-file"
+	flagBackoffInitialDelay = "backoff-initial-delay"
+	flagBackoffMultiplier   = "backoff-multiplier"
+	flagBackoffMaxDelay     = "backoff-max-delay"
+	flagMaxFailures         = "max-failures"
 )
 
 // NewWindowsServiceCommand creates a cobra command that offers subcommands
@@ -72,7 +77,19 @@ func NewWindowsInstallServiceCommand() *cobra.Command {
 				return errors.New("error reading log file: not a regular file")
 			}
 
-			return installService(serviceName, serviceDisplayName, serviceDescription, "service", "run", configFile, logFile)
+			opts, err := service.InstallOptions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			policy, err := restartPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			args = append([]string{"service", "run"}, restartPolicyArgs(policy)...)
+			args = append(args, configFile, logFile)
+
+			return service.Install(serviceName, serviceDisplayName, serviceDescription, opts, args...)
 		},
 	}
 
@@ -81,6 +98,8 @@ func NewWindowsInstallServiceCommand() *cobra.Command {
 
 	cmd.Flags().StringP(flagConfigFile, "c", defaultConfigPath, "path to sensu-agent config file")
 	cmd.Flags().StringP(flagLogPath, "", defaultLogPath, "path to the sensu-agent log file")
+	service.AddInstallFlags(cmd.Flags())
+	addRestartPolicyFlags(cmd)
 
 	return cmd
 }
@@ -94,7 +113,7 @@ func NewWindowsUninstallServiceCommand() *cobra.Command {
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return removeService(serviceName)
+			return service.Remove(serviceName)
 		},
 	}
 }
@@ -106,8 +125,63 @@ func NewWindowsRunServiceCommand() *cobra.Command {
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runService(args)
+			policy, err := restartPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			return runService(args, policy)
 		},
 	}
+	addRestartPolicyFlags(command)
 	return command
 }
+
+// addRestartPolicyFlags adds the flags configuring the RestartPolicy of the
+// service to cmd.
+func addRestartPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Duration(flagBackoffInitialDelay, time.Second, "time to wait before restarting sensu-agent after a failure")
+	cmd.Flags().Float64(flagBackoffMultiplier, 2, "multiplier applied to the restart delay after every consecutive failure")
+	cmd.Flags().Duration(flagBackoffMaxDelay, 5*time.Minute, "maximal time to wait before restarting sensu-agent")
+	cmd.Flags().Int(flagMaxFailures, 0, "number of consecutive failures after which the service stops (0 for no limit)")
+}
+
+// restartPolicy returns the RestartPolicy set with the flags of cmd.
+func restartPolicy(cmd *cobra.Command) (RestartPolicy, error) {
+	var (
+		policy RestartPolicy
+		err    error
+	)
+	if policy.InitialDelay, err = cmd.Flags().GetDuration(flagBackoffInitialDelay); err != nil {
+		return policy, err
+	}
+	if policy.Multiplier, err = cmd.Flags().GetFloat64(flagBackoffMultiplier); err != nil {
+		return policy, err
+	}
+	if policy.MaxDelay, err = cmd.Flags().GetDuration(flagBackoffMaxDelay); err != nil {
+		return policy, err
+	}
+	if policy.MaxFailures, err = cmd.Flags().GetInt(flagMaxFailures); err != nil {
+		return policy, err
+	}
+	if policy.InitialDelay < 0 || policy.MaxDelay < policy.InitialDelay {
+		return policy, fmt.Errorf("--%s must be greater than --%s", flagBackoffMaxDelay, flagBackoffInitialDelay)
+	}
+	if policy.Multiplier < 1 {
+		return policy, fmt.Errorf("--%s must be at least 1", flagBackoffMultiplier)
+	}
+	if policy.MaxFailures < 0 {
+		return policy, fmt.Errorf("--%s can't be negative", flagMaxFailures)
+	}
+	return policy, nil
+}
+
+// restartPolicyArgs returns the command line arguments of the run command
+// for policy.
+func restartPolicyArgs(policy RestartPolicy) []string {
+	return []string{
+		fmt.Sprintf("--%s=%s", flagBackoffInitialDelay, policy.InitialDelay),
+		fmt.Sprintf("--%s=%g", flagBackoffMultiplier, policy.Multiplier),
+		fmt.Sprintf("--%s=%s", flagBackoffMaxDelay, policy.MaxDelay),
+		fmt.Sprintf("--%s=%d", flagMaxFailures, policy.MaxFailures),
+	}
+}
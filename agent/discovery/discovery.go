@@ -0,0 +1,177 @@
+// Package discovery discovers the labels of the agent entity from the cloud
+// metadata services, the Kubernetes downward API or a local script.
+package discovery
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/command"
+)
+
+const (
+	// SourceEC2 discovers the labels from the EC2 instance metadata service
+	SourceEC2 = "ec2"
+
+	// SourceGCE discovers the labels from the GCE metadata server
+	SourceGCE = "gce"
+
+	// SourceAzure discovers the labels from the Azure instance metadata
+	// service
+	SourceAzure = "azure"
+
+	// SourceKubernetes discovers the labels from the pod labels file of the
+	// Kubernetes downward API
+	SourceKubernetes = "kubernetes"
+
+	// SourceScript discovers the labels from the output of a local script
+	SourceScript = "script"
+
+	// DefaultPodinfoPath is the default path of the pod labels file
+	// projected by the Kubernetes downward API
+	DefaultPodinfoPath = "/etc/podinfo/labels"
+
+	// requestTimeout is the timeout of each request made to a metadata
+	// service
+	requestTimeout = 5 * time.Second
+
+	// scriptTimeout is the timeout of the discovery script, in seconds
+	scriptTimeout = 30
+)
+
+// Sources are the supported discovery sources
+var Sources = []string{SourceEC2, SourceGCE, SourceAzure, SourceKubernetes, SourceScript}
+
+// Source discovers entity labels
+type Source interface {
+	// Name returns the name of the source
+	Name() string
+
+	// Discover returns the labels discovered by the source
+	Discover(ctx context.Context) (map[string]string, error)
+}
+
+// Config configures the discovery sources
+type Config struct {
+	// Script is the command executed by the script source
+	Script string
+
+	// PodinfoPath is the path of the pod labels file read by the kubernetes
+	// source
+	PodinfoPath string
+
+	// Executor executes the script
+	Executor command.Executor
+}
+
+// New returns the named discovery source.
+func New(name string, cfg Config) (Source, error) {
+	switch name {
+	case SourceEC2:
+		return &ec2Source{client: http.DefaultClient, endpoint: "http://169.254.169.254"}, nil
+	case SourceGCE:
+		return &gceSource{client: http.DefaultClient, endpoint: "http://metadata.google.internal"}, nil
+	case SourceAzure:
+		return &azureSource{client: http.DefaultClient, endpoint: "http://169.254.169.254"}, nil
+	case SourceKubernetes:
+		path := cfg.PodinfoPath
+		if path == "" {
+			path = DefaultPodinfoPath
+		}
+		return &kubernetesSource{path: path}, nil
+	case SourceScript:
+		if cfg.Script == "" {
+			return nil, errors.New("the script discovery source requires a script")
+		}
+		executor := cfg.Executor
+		if executor == nil {
+			executor = command.NewExecutor()
+		}
+		return &scriptSource{command: cfg.Script, executor: executor}, nil
+	}
+	return nil, fmt.Errorf("unknown discovery source %q, must be one of %s", name, strings.Join(Sources, ", "))
+}
+
+// Discover returns the labels discovered by all the sources. The labels of a
+// source take precedence over the ones of the sources before it. The errors
+// of the sources are returned along with the labels of the other sources.
+func Discover(ctx context.Context, sources []Source) (map[string]string, error) {
+	labels := make(map[string]string)
+	var errs []string
+	for _, source := range sources {
+		discovered, err := source.Discover(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", source.Name(), err))
+			continue
+		}
+		for key, value := range discovered {
+			labels[key] = value
+		}
+	}
+	if len(errs) > 0 {
+		return labels, fmt.Errorf("label discovery failed: %s", strings.Join(errs, "; "))
+	}
+	return labels, nil
+}
+
+// request performs a metadata service request and returns the response body.
+func request(ctx context.Context, client *http.Client, method, url string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// parseLabels parses the lines of key=value pairs of a pod labels file or of
+// the output of a script. The values can be quoted.
+func parseLabels(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid label %q, must be key=value", line)
+		}
+		value := parts[1]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		labels[parts[0]] = value
+	}
+	return labels, scanner.Err()
+}
+
+// parseJSONLabels parses a JSON object of labels.
+func parseJSONLabels(b []byte) (map[string]string, error) {
+	var labels map[string]string
+	if err := json.Unmarshal(b, &labels); err != nil {
+		return nil, fmt.Errorf("invalid labels: %s", err)
+	}
+	return labels, nil
+}
@@ -0,0 +1,169 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/testing/mockexecutor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEC2Source(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			assert.Equal(t, http.MethodPut, r.Method)
+			fmt.Fprint(w, "token")
+			return
+		}
+		assert.Equal(t, "token", r.Header.Get("X-Aws-Ec2-Metadata-Token"))
+		switch r.URL.Path {
+		case "/latest/dynamic/instance-identity/document":
+			fmt.Fprint(w, `{"region": "us-east-1", "availabilityZone": "us-east-1a", "instanceType": "t3.micro", "instanceId": "i-1"}`)
+		case "/latest/meta-data/tags/instance":
+			fmt.Fprint(w, "team\nenv")
+		case "/latest/meta-data/tags/instance/team":
+			fmt.Fprint(w, "web")
+		case "/latest/meta-data/tags/instance/env":
+			fmt.Fprint(w, "prod")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	source := &ec2Source{client: ts.Client(), endpoint: ts.URL}
+	labels, err := source.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"region":        "us-east-1",
+		"zone":          "us-east-1a",
+		"instance_type": "t3.micro",
+		"instance_id":   "i-1",
+		"team":          "web",
+		"env":           "prod",
+	}, labels)
+}
+
+func TestGCESource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		fmt.Fprint(w, `{"id": 42, "machineType": "projects/1/machineTypes/n1-standard-1", "zone": "projects/1/zones/us-central1-a"}`)
+	}))
+	defer ts.Close()
+
+	source := &gceSource{client: ts.Client(), endpoint: ts.URL}
+	labels, err := source.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"region":        "us-central1",
+		"zone":          "us-central1-a",
+		"instance_type": "n1-standard-1",
+		"instance_id":   "42",
+	}, labels)
+}
+
+func TestAzureSource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.Header.Get("Metadata"))
+		fmt.Fprint(w, `{"location": "westeurope", "zone": "1", "vmSize": "Standard_B1s", "vmId": "a",
+			"tagsList": [{"name": "team", "value": "web"}]}`)
+	}))
+	defer ts.Close()
+
+	source := &azureSource{client: ts.Client(), endpoint: ts.URL}
+	labels, err := source.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"region":        "westeurope",
+		"zone":          "1",
+		"instance_type": "Standard_B1s",
+		"instance_id":   "a",
+		"team":          "web",
+	}, labels)
+}
+
+func TestKubernetesSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "podinfo")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "labels")
+	require.NoError(t, ioutil.WriteFile(path, []byte("app=\"web\"\npod-template-hash=\"5d8f\"\n"), 0644))
+
+	source, err := New(SourceKubernetes, Config{PodinfoPath: path})
+	require.NoError(t, err)
+	labels, err := source.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "web", "pod-template-hash": "5d8f"}, labels)
+}
+
+func TestScriptSource(t *testing.T) {
+	_, err := New(SourceScript, Config{})
+	assert.Error(t, err)
+
+	ex := &mockexecutor.MockExecutor{}
+	source, err := New(SourceScript, Config{Script: "discover.sh", Executor: ex})
+	require.NoError(t, err)
+
+	ex.Return(command.FixtureExecutionResponse(0, `{"team": "web"}`), nil)
+	labels, err := source.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "web"}, labels)
+
+	ex.Return(command.FixtureExecutionResponse(0, "team=web\n# comment\nenv=prod\n"), nil)
+	labels, err = source.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "web", "env": "prod"}, labels)
+
+	ex.Return(command.FixtureExecutionResponse(1, "boom"), nil)
+	_, err = source.Discover(context.Background())
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New("oracle", Config{})
+	assert.Error(t, err)
+}
+
+func TestParseLabels(t *testing.T) {
+	_, err := parseLabels("team")
+	assert.Error(t, err)
+
+	labels, err := parseLabels("url=http://example.com/?a=b")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"url": "http://example.com/?a=b"}, labels)
+}
+
+type fixtureSource struct {
+	name   string
+	labels map[string]string
+	err    error
+}
+
+func (s fixtureSource) Name() string {
+	return s.name
+}
+
+func (s fixtureSource) Discover(context.Context) (map[string]string, error) {
+	return s.labels, s.err
+}
+
+func TestDiscover(t *testing.T) {
+	sources := []Source{
+		fixtureSource{name: "a", labels: map[string]string{"team": "web", "region": "us-east-1"}},
+		fixtureSource{name: "b", err: errors.New("unreachable")},
+		fixtureSource{name: "c", labels: map[string]string{"team": "ops"}},
+	}
+	labels, err := Discover(context.Background(), sources)
+	assert.EqualError(t, err, "label discovery failed: b: unreachable")
+	assert.Equal(t, map[string]string{"team": "ops", "region": "us-east-1"}, labels)
+}
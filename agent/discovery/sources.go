@@ -0,0 +1,220 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/sensu/sensu-go/command"
+)
+
+// ec2Source discovers the region, availability zone, instance type and id of
+// an EC2 instance, and its tags if they are exposed in the instance metadata.
+type ec2Source struct {
+	client   *http.Client
+	endpoint string
+}
+
+// Name returns the name of the source
+func (s *ec2Source) Name() string {
+	return SourceEC2
+}
+
+type ec2IdentityDocument struct {
+	Region           string `json:"region"`
+	AvailabilityZone string `json:"availabilityZone"`
+	InstanceType     string `json:"instanceType"`
+	InstanceID       string `json:"instanceId"`
+}
+
+// Discover returns the labels of the instance
+func (s *ec2Source) Discover(ctx context.Context) (map[string]string, error) {
+	// IMDSv2 requires a session token
+	token, err := request(ctx, s.client, http.MethodPut, s.endpoint+"/latest/api/token", http.Header{
+		"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": []string{"60"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{"X-Aws-Ec2-Metadata-Token": []string{string(token)}}
+
+	b, err := request(ctx, s.client, http.MethodGet, s.endpoint+"/latest/dynamic/instance-identity/document", header)
+	if err != nil {
+		return nil, err
+	}
+	var doc ec2IdentityDocument
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	labels := map[string]string{
+		"region":        doc.Region,
+		"zone":          doc.AvailabilityZone,
+		"instance_type": doc.InstanceType,
+		"instance_id":   doc.InstanceID,
+	}
+
+	// The tags are only exposed when allowed in the instance metadata options
+	keys, err := request(ctx, s.client, http.MethodGet, s.endpoint+"/latest/meta-data/tags/instance", header)
+	if err != nil {
+		return labels, nil
+	}
+	for _, key := range strings.Fields(string(keys)) {
+		value, err := request(ctx, s.client, http.MethodGet, s.endpoint+"/latest/meta-data/tags/instance/"+key, header)
+		if err != nil {
+			return nil, err
+		}
+		labels[key] = string(value)
+	}
+	return labels, nil
+}
+
+// gceSource discovers the region, zone, machine type and id of a GCE instance.
+type gceSource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// Name returns the name of the source
+func (s *gceSource) Name() string {
+	return SourceGCE
+}
+
+type gceInstance struct {
+	ID          json.Number `json:"id"`
+	MachineType string      `json:"machineType"`
+	Zone        string      `json:"zone"`
+}
+
+// Discover returns the labels of the instance
+func (s *gceSource) Discover(ctx context.Context) (map[string]string, error) {
+	b, err := request(ctx, s.client, http.MethodGet, s.endpoint+"/computeMetadata/v1/instance/?recursive=true", http.Header{
+		"Metadata-Flavor": []string{"Google"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var instance gceInstance
+	if err := json.Unmarshal(b, &instance); err != nil {
+		return nil, err
+	}
+
+	zone := path.Base(instance.Zone)
+	labels := map[string]string{
+		"zone":          zone,
+		"instance_type": path.Base(instance.MachineType),
+		"instance_id":   instance.ID.String(),
+	}
+	// The region is the zone without its suffix, e.g. us-central1 for
+	// us-central1-a
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		labels["region"] = zone[:i]
+	}
+	return labels, nil
+}
+
+// azureSource discovers the location, zone, VM size, id and tags of an Azure
+// virtual machine.
+type azureSource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// Name returns the name of the source
+func (s *azureSource) Name() string {
+	return SourceAzure
+}
+
+type azureCompute struct {
+	Location string `json:"location"`
+	Zone     string `json:"zone"`
+	VMSize   string `json:"vmSize"`
+	VMID     string `json:"vmId"`
+	TagsList []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"tagsList"`
+}
+
+// Discover returns the labels of the virtual machine
+func (s *azureSource) Discover(ctx context.Context) (map[string]string, error) {
+	b, err := request(ctx, s.client, http.MethodGet, s.endpoint+"/metadata/instance/compute?api-version=2021-02-01", http.Header{
+		"Metadata": []string{"true"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var compute azureCompute
+	if err := json.Unmarshal(b, &compute); err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{
+		"region":        compute.Location,
+		"instance_type": compute.VMSize,
+		"instance_id":   compute.VMID,
+	}
+	if compute.Zone != "" {
+		labels["zone"] = compute.Zone
+	}
+	for _, tag := range compute.TagsList {
+		labels[tag.Name] = tag.Value
+	}
+	return labels, nil
+}
+
+// kubernetesSource discovers the labels of the pod from the labels file
+// projected by the Kubernetes downward API.
+type kubernetesSource struct {
+	path string
+}
+
+// Name returns the name of the source
+func (s *kubernetesSource) Name() string {
+	return SourceKubernetes
+}
+
+// Discover returns the labels of the pod
+func (s *kubernetesSource) Discover(ctx context.Context) (map[string]string, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return parseLabels(string(b))
+}
+
+// scriptSource discovers the labels printed by a script, either as a JSON
+// object or as lines of key=value pairs.
+type scriptSource struct {
+	command  string
+	executor command.Executor
+}
+
+// Name returns the name of the source
+func (s *scriptSource) Name() string {
+	return SourceScript
+}
+
+// Discover returns the labels printed by the script
+func (s *scriptSource) Discover(ctx context.Context) (map[string]string, error) {
+	resp, err := s.executor.Execute(ctx, command.ExecutionRequest{
+		Command: s.command,
+		Timeout: scriptTimeout,
+		Name:    "label-discovery",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("script exited with status %d: %s", resp.Status, strings.TrimSpace(resp.Output))
+	}
+
+	output := strings.TrimSpace(resp.Output)
+	if strings.HasPrefix(output, "{") {
+		return parseJSONLabels([]byte(output))
+	}
+	return parseLabels(output)
+}
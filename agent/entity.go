@@ -7,26 +7,43 @@ import (
 	"github.com/sensu/sensu-go/version"
 )
 
+// PausedAnnotation is the entity annotation set to "true" while the agent is
+// paused.
+const PausedAnnotation = "sensu.io/paused"
+
 func (a *Agent) getAgentEntity() *corev2.Entity {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
 	if a.entity == nil {
-		meta := corev2.NewObjectMeta(a.config.AgentName, a.config.Namespace)
-		meta.Labels = a.config.Labels
-		meta.Annotations = a.config.Annotations
+		config := a.getConfig()
+		meta := corev2.NewObjectMeta(config.AgentName, config.Namespace)
+		meta.Labels = a.entityLabels()
+		meta.Annotations = config.Annotations
+		if a.paused {
+			// Copy the annotations so the configuration is left untouched
+			meta.Annotations = make(map[string]string, len(config.Annotations)+1)
+			for k, v := range config.Annotations {
+				meta.Annotations[k] = v
+			}
+			meta.Annotations[PausedAnnotation] = "true"
+		}
 		e := &corev2.Entity{
 			EntityClass:       corev2.EntityAgentClass,
-			Deregister:        a.config.Deregister,
+			Deregister:        config.Deregister,
 			LastSeen:          time.Now().Unix(),
-			Redact:            a.config.Redact,
-			Subscriptions:     a.config.Subscriptions,
-			User:              a.config.User,
+			Redact:            config.Redact,
+			Subscriptions:     config.Subscriptions,
+			User:              config.User,
 			ObjectMeta:        meta,
 			SensuAgentVersion: version.Semver(),
-			KeepaliveHandlers: a.config.KeepaliveHandlers,
+			KeepaliveHandlers: config.KeepaliveHandlers,
 		}
 
-		if a.config.DeregistrationHandler != "" {
+		if config.DeregistrationHandler != "" || len(config.DeregistrationHandlers) > 0 {
 			e.Deregistration = corev2.Deregistration{
-				Handler: a.config.DeregistrationHandler,
+				Handler:  config.DeregistrationHandler,
+				Handlers: config.DeregistrationHandlers,
+				Payload:  config.DeregistrationPayload,
 			}
 		}
 
@@ -47,7 +64,7 @@ func (a *Agent) getAgentEntity() *corev2.Entity {
 func (a *Agent) getEntities(event *corev2.Event) {
 	// Verify if we have an entity in the event, and that it is different from the
 	// agent's entity
-	if event.Entity != nil && event.HasCheck() && event.Entity.Name != a.config.AgentName {
+	if event.Entity != nil && event.HasCheck() && event.Entity.Name != a.getConfig().AgentName {
 		// Identify the event's source as the provided entity so it can be properly
 		// handled by the backend
 		event.Check.ProxyEntityName = event.Entity.Name
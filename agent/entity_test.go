@@ -1,8 +1,14 @@
 package agent
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/sensu/sensu-go/agent/discovery"
+	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,6 +50,21 @@ func TestGetAgentEntity(t *testing.T) {
 	}
 }
 
+func TestGetAgentEntityDeregistration(t *testing.T) {
+	agent := &Agent{
+		config: &Config{
+			AgentName:              "foo",
+			DeregistrationHandlers: []string{"cmdb"},
+			DeregistrationPayload:  "{{ .name }}",
+		},
+		systemInfo: &types.System{},
+	}
+
+	entity := agent.getAgentEntity()
+	assert.Equal(t, []string{"cmdb"}, entity.Deregistration.Handlers)
+	assert.Equal(t, "{{ .name }}", entity.Deregistration.Payload)
+}
+
 func TestGetEntities(t *testing.T) {
 	assert := assert.New(t)
 
@@ -88,3 +109,167 @@ func TestGetEntities(t *testing.T) {
 		})
 	}
 }
+
+func TestReloadRebuildsEntity(t *testing.T) {
+	disconnected := false
+	agent := &Agent{
+		config: &Config{
+			AgentName:     "foo",
+			Subscriptions: []string{"linux"},
+		},
+		disconnect: func() { disconnected = true },
+		systemInfo: &types.System{},
+	}
+	assert.Equal(t, []string{"linux"}, agent.getAgentEntity().Subscriptions)
+
+	agent.Reload(&Config{
+		Subscriptions: []string{"linux", "web"},
+		Labels:        map[string]string{"region": "us-west-2"},
+	})
+
+	entity := agent.getAgentEntity()
+	assert.Equal(t, []string{"linux", "web"}, entity.Subscriptions)
+	assert.Equal(t, map[string]string{"region": "us-west-2"}, entity.Labels)
+	assert.Equal(t, "foo", entity.Name)
+	assert.True(t, disconnected)
+}
+
+func TestReloadConcurrentReads(t *testing.T) {
+	agent := &Agent{
+		config: &Config{
+			AgentName:     "foo",
+			Subscriptions: []string{"linux"},
+		},
+		systemInfo: &types.System{},
+	}
+	config := agent.getConfig()
+
+	// Run with -race: the configuration is read while it is reloaded
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			agent.Reload(&Config{Subscriptions: []string{"linux", "web"}})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = agent.getAgentEntity()
+			_ = agent.buildTransportHeaderMap()
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, []string{"linux", "web"}, agent.getAgentEntity().Subscriptions)
+	// The configurations are replaced, not modified
+	assert.Equal(t, []string{"linux"}, config.Subscriptions)
+}
+
+func TestPausedAnnotation(t *testing.T) {
+	agent := &Agent{
+		config: &Config{
+			AgentName:   "foo",
+			Annotations: map[string]string{"team": "ops"},
+		},
+		systemInfo: &types.System{},
+	}
+	assert.NotContains(t, agent.getAgentEntity().Annotations, PausedAnnotation)
+
+	agent.SetPaused(true)
+	assert.True(t, agent.Paused())
+	assert.Equal(t, "true", agent.getAgentEntity().Annotations[PausedAnnotation])
+	assert.NotContains(t, agent.config.Annotations, PausedAnnotation)
+
+	agent.SetPaused(false)
+	assert.False(t, agent.Paused())
+	assert.Equal(t, map[string]string{"team": "ops"}, agent.getAgentEntity().Annotations)
+}
+
+type labelSource map[string]string
+
+func (s labelSource) Name() string {
+	return "fixture"
+}
+
+func (s labelSource) Discover(context.Context) (map[string]string, error) {
+	return s, nil
+}
+
+func TestDiscoverLabels(t *testing.T) {
+	agent := &Agent{
+		config: &Config{
+			AgentName: "foo",
+			Labels:    map[string]string{"team": "ops"},
+		},
+		labelSources: []discovery.Source{labelSource{"team": "web", "region": "us-east-1"}},
+		systemInfo:   &types.System{},
+	}
+	assert.Equal(t, map[string]string{"team": "ops"}, agent.getAgentEntity().Labels)
+
+	// The configured labels take precedence over the discovered ones
+	agent.discoverLabels(context.Background())
+	assert.Equal(t, map[string]string{"team": "ops", "region": "us-east-1"}, agent.getAgentEntity().Labels)
+	assert.Equal(t, map[string]string{"team": "ops"}, agent.config.Labels)
+}
+
+func TestNewDeregistration(t *testing.T) {
+	cfg, cleanup := FixtureConfig()
+	defer cleanup()
+	cfg.AgentName = "foo"
+	agent, err := NewAgent(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	agent.marshal = proto.Marshal
+	agent.unmarshal = proto.Unmarshal
+
+	msg := agent.newDeregistration()
+	assert.Equal(t, transport.MessageTypeDeregistration, msg.Type)
+
+	var event types.Event
+	if err := agent.unmarshal(msg.Payload, &event); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "foo", event.Entity.Name)
+	assert.Equal(t, "keepalive", event.Check.Name)
+}
+
+func TestHandleReconnect(t *testing.T) {
+	cfg, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agent.handleReconnect([]byte("1500"))
+	assert.Equal(t, 1500*time.Millisecond, agent.reconnectDelay)
+
+	agent.handleReconnect([]byte("soon"))
+	assert.Equal(t, time.Duration(0), agent.reconnectDelay)
+}
+
+func TestHandleBackpressure(t *testing.T) {
+	cfg, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agent.handleBackpressure([]byte("soon"))
+	assert.True(t, agent.backpressureUntil.IsZero())
+
+	agent.handleBackpressure([]byte("50"))
+	until := agent.backpressureUntil
+	assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), until, 20*time.Millisecond)
+
+	// A shorter delay doesn't shorten the current one
+	agent.handleBackpressure([]byte("1"))
+	assert.Equal(t, until, agent.backpressureUntil)
+
+	agent.waitBackpressure()
+	assert.False(t, time.Now().Before(until))
+}
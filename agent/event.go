@@ -14,6 +14,7 @@ import (
 // attributes so it can pass validation. An error is returned if it still can't
 // pass validation after all these changes
 func prepareEvent(a *Agent, event *corev2.Event) error {
+	config := a.getConfig()
 	if event == nil {
 		return fmt.Errorf("an event must be provided")
 	}
@@ -32,11 +33,11 @@ func prepareEvent(a *Agent, event *corev2.Event) error {
 	}
 
 	if event.Check.Namespace == "" {
-		event.Check.Namespace = a.config.Namespace
+		event.Check.Namespace = config.Namespace
 	}
 
 	if event.ObjectMeta.Namespace == "" {
-		event.ObjectMeta.Namespace = a.config.Namespace
+		event.ObjectMeta.Namespace = config.Namespace
 	}
 
 	if event.Check.Executed == 0 {
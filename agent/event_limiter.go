@@ -0,0 +1,79 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// EventsSendPolicyQueue makes the events exceeding the send rate limit
+	// wait until they can be sent.
+	EventsSendPolicyQueue = "queue"
+
+	// EventsSendPolicyDrop drops the events exceeding the send rate limit.
+	EventsSendPolicyDrop = "drop"
+
+	// EventsDroppedCounter is the name of the prometheus counter used to count
+	// the events dropped by the send rate limiter.
+	EventsDroppedCounter = "sensu_go_agent_events_dropped"
+)
+
+var (
+	// EventsDropped counts the events dropped because they exceeded the send
+	// rate limit.
+	EventsDropped = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: EventsDroppedCounter,
+			Help: "The total number of events dropped because they exceeded the send rate limit",
+		},
+	)
+)
+
+// validateEventsSendPolicy returns an error if policy is not a valid events
+// send policy. An empty policy is valid and means queue.
+func validateEventsSendPolicy(policy string) error {
+	switch policy {
+	case "", EventsSendPolicyQueue, EventsSendPolicyDrop:
+		return nil
+	default:
+		return fmt.Errorf("invalid events send policy %q, expected %q or %q", policy, EventsSendPolicyQueue, EventsSendPolicyDrop)
+	}
+}
+
+// newEventsSendLimiter returns the token bucket limiting the events sent to
+// the backend. A limit of zero means no limit.
+func newEventsSendLimiter(limit rate.Limit, burst int) *rate.Limiter {
+	if limit == 0 {
+		limit = rate.Limit(math.Inf(1))
+	}
+	if burst < 1 {
+		// A limiter with no burst never allows any event
+		burst = 1
+	}
+	return rate.NewLimiter(limit, burst)
+}
+
+// allowEvent applies the send rate limit to an event. It returns false if the
+// event must be dropped, and blocks until the event can be sent if the
+// overflowing events are queued.
+func (a *Agent) allowEvent() bool {
+	if a.eventsSendLimiter == nil {
+		return true
+	}
+	if a.getConfig().EventsSendPolicy == EventsSendPolicyDrop {
+		if a.eventsSendLimiter.Allow() {
+			return true
+		}
+		logger.Warn("events send rate limit exceeded, dropping event")
+		EventsDropped.Inc()
+		return false
+	}
+	// The wait can't fail, the burst is at least one and the context is
+	// never canceled
+	_ = a.eventsSendLimiter.Wait(context.Background())
+	return true
+}
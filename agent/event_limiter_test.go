@@ -0,0 +1,35 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestAllowEventDrop(t *testing.T) {
+	agent := &Agent{
+		config:            &Config{EventsSendPolicy: EventsSendPolicyDrop},
+		eventsSendLimiter: newEventsSendLimiter(rate.Limit(0.001), 2),
+	}
+	assert.True(t, agent.allowEvent())
+	assert.True(t, agent.allowEvent())
+	assert.False(t, agent.allowEvent())
+}
+
+func TestAllowEventNoLimit(t *testing.T) {
+	agent := &Agent{
+		config:            &Config{EventsSendPolicy: EventsSendPolicyDrop},
+		eventsSendLimiter: newEventsSendLimiter(0, 0),
+	}
+	for i := 0; i < 100; i++ {
+		assert.True(t, agent.allowEvent())
+	}
+}
+
+func TestValidateEventsSendPolicy(t *testing.T) {
+	assert.NoError(t, validateEventsSendPolicy(""))
+	assert.NoError(t, validateEventsSendPolicy(EventsSendPolicyQueue))
+	assert.NoError(t, validateEventsSendPolicy(EventsSendPolicyDrop))
+	assert.Error(t, validateEventsSendPolicy("bogus"))
+}
@@ -0,0 +1,76 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+
+	"github.com/sensu/lasr"
+	"github.com/sensu/sensu-go/transport"
+)
+
+const (
+	// DefaultEventsQueueMaxSize is the default maximum number of events held
+	// in the events queue while the agent is disconnected from the backend.
+	DefaultEventsQueueMaxSize = 1000
+
+	eventsQueueName = "event-buffer"
+)
+
+// newEventsQueue opens the queue holding the events produced while the agent
+// is disconnected from the backend, and returns it along with the number of
+// events it already holds.
+func newEventsQueue(path string, maxSize int) (queue, int64, error) {
+	if path == os.DevNull {
+		return newMemoryQueue(maxSize), 0, nil
+	}
+	return openQueue(path, "events.db", eventsQueueName)
+}
+
+// queueEvent stores an event in the events queue, so it can be sent once the
+// agent is connected to the backend. The event is dropped if the queue is
+// full.
+func (a *Agent) queueEvent(payload []byte) {
+	if atomic.LoadInt64(&a.eventsQueueSize) >= int64(a.getConfig().EventsQueueMaxSize) {
+		logger.Warn("events queue is full, dropping event")
+		return
+	}
+	if _, err := a.eventsQueue.Send(compressMessage(payload)); err != nil {
+		logger.WithError(err).Error("error queueing event")
+		return
+	}
+	a.addQueuedEvents(1)
+	logger.Info("backend is unreachable, event queued")
+}
+
+// handleEventsQueue sends the queued events to the backend, until ctx is
+// done. The events are removed from the queue once they are sent.
+func (a *Agent) handleEventsQueue(ctx context.Context) {
+	for {
+		message, err := a.eventsQueue.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.WithError(err).Error("error receiving message from events queue")
+			continue
+		}
+		a.sendMessage(a.queuedEventMessage(message))
+	}
+}
+
+func (a *Agent) queuedEventMessage(message *lasr.Message) *transport.Message {
+	return &transport.Message{
+		Type:    transport.MessageTypeEvent,
+		Payload: decompressMessage(message.Body),
+		SendCallback: func(err error) {
+			if err != nil {
+				logger.WithError(err).Error("couldn't send queued event, retrying")
+				_ = message.Nack(true)
+				return
+			}
+			a.addQueuedEvents(-1)
+			_ = message.Ack()
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package agent
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "events-queue")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	q, size, err := newEventsQueue(dir, 2)
+	require.NoError(t, err)
+	defer q.Close()
+	assert.EqualValues(t, 0, size)
+
+	agent := &Agent{
+		config:          &Config{EventsQueueMaxSize: 2},
+		eventsQueue:     q,
+		eventsQueueSize: size,
+		sendq:           make(chan *transport.Message, 10),
+	}
+
+	// The agent is disconnected, the events are queued until the queue is
+	// full
+	for _, payload := range []string{"a", "b", "c"} {
+		agent.sendMessage(&transport.Message{Type: transport.MessageTypeEvent, Payload: []byte(payload)})
+	}
+	assert.Len(t, agent.sendq, 0)
+	assert.EqualValues(t, 2, agent.eventsQueueSize)
+
+	// The queued events are sent and removed from the queue
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go agent.handleEventsQueue(ctx)
+
+	for _, want := range []string{"a", "b"} {
+		select {
+		case msg := <-agent.sendq:
+			assert.Equal(t, want, string(msg.Payload))
+			msg.SendCallback(nil)
+		case <-time.After(5 * time.Second):
+			t.Fatal("queued event not sent")
+		}
+	}
+	assert.EqualValues(t, 0, agent.eventsQueueSize)
+}
@@ -164,6 +164,7 @@ func (a *Agent) prepareHook(hookConfig *corev2.HookConfig) bool {
 	}
 
 	if err := token.SubstituteHook(hookConfig, a.getAgentEntity()); err != nil {
+		TokenSubstitutionFailures.Inc()
 		a.sendFailure(event, fmt.Errorf("error while substituting hook config tokens: %s", err))
 		return false
 	}
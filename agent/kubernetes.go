@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const (
+	// KubernetesModeSidecar runs the agent as a sidecar container, the
+	// entity represents the pod.
+	KubernetesModeSidecar = "sidecar"
+
+	// KubernetesModeDaemonSet runs the agent in a DaemonSet, the entity
+	// represents the node.
+	KubernetesModeDaemonSet = "daemonset"
+
+	// The environment variables set from the downward API in the agent
+	// container spec.
+	envPodName      = "POD_NAME"
+	envPodNamespace = "POD_NAMESPACE"
+	envNodeName     = "NODE_NAME"
+)
+
+// ConfigureKubernetes applies the Kubernetes mode of the configuration. The
+// entity name is derived from the pod or node name unless nameSet is true,
+// and the pod namespace, pod name and node name are added to the labels
+// unless they are configured already.
+func ConfigureKubernetes(config *Config, nameSet bool) error {
+	var name string
+	switch config.KubernetesMode {
+	case "":
+		return nil
+	case KubernetesModeSidecar:
+		name = os.Getenv(envPodName)
+	case KubernetesModeDaemonSet:
+		name = os.Getenv(envNodeName)
+	default:
+		return fmt.Errorf("invalid kubernetes mode %q, must be %s or %s",
+			config.KubernetesMode, KubernetesModeSidecar, KubernetesModeDaemonSet)
+	}
+	if !nameSet && name != "" {
+		config.AgentName = name
+	}
+
+	labels := map[string]string{
+		"kubernetes_namespace": os.Getenv(envPodNamespace),
+		"kubernetes_node":      os.Getenv(envNodeName),
+	}
+	if config.KubernetesMode == KubernetesModeSidecar {
+		labels["kubernetes_pod"] = os.Getenv(envPodName)
+	}
+	for key, value := range labels {
+		if value == "" {
+			continue
+		}
+		if config.Labels == nil {
+			config.Labels = make(map[string]string)
+		}
+		if _, ok := config.Labels[key]; !ok {
+			config.Labels[key] = value
+		}
+	}
+	return nil
+}
+
+// bearerToken reads the bearer token of the agent, if configured. It is read
+// on every connection since projected service account tokens are rotated by
+// the kubelet.
+func (a *Agent) bearerToken() (string, error) {
+	path := a.getConfig().KubernetesTokenPath
+	if path == "" {
+		return "", nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("empty token in %s", path)
+	}
+	return token, nil
+}
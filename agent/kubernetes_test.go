@@ -0,0 +1,112 @@
+package agent
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setenv(t *testing.T, env map[string]string) func() {
+	for key, value := range env {
+		require.NoError(t, os.Setenv(key, value))
+	}
+	return func() {
+		for key := range env {
+			_ = os.Unsetenv(key)
+		}
+	}
+}
+
+func TestConfigureKubernetes(t *testing.T) {
+	defer setenv(t, map[string]string{
+		envPodName:      "web-5d8f",
+		envPodNamespace: "shop",
+		envNodeName:     "node-1",
+	})()
+
+	config := &Config{AgentName: "hostname", KubernetesMode: KubernetesModeSidecar}
+	require.NoError(t, ConfigureKubernetes(config, false))
+	assert.Equal(t, "web-5d8f", config.AgentName)
+	assert.Equal(t, map[string]string{
+		"kubernetes_namespace": "shop",
+		"kubernetes_node":      "node-1",
+		"kubernetes_pod":       "web-5d8f",
+	}, config.Labels)
+
+	// The configured name and labels take precedence
+	config = &Config{
+		AgentName:      "node",
+		KubernetesMode: KubernetesModeDaemonSet,
+		Labels:         map[string]string{"kubernetes_node": "custom"},
+	}
+	require.NoError(t, ConfigureKubernetes(config, true))
+	assert.Equal(t, "node", config.AgentName)
+	assert.Equal(t, map[string]string{
+		"kubernetes_namespace": "shop",
+		"kubernetes_node":      "custom",
+	}, config.Labels)
+
+	config = &Config{AgentName: "hostname", KubernetesMode: KubernetesModeDaemonSet}
+	require.NoError(t, ConfigureKubernetes(config, false))
+	assert.Equal(t, "node-1", config.AgentName)
+
+	assert.Error(t, ConfigureKubernetes(&Config{KubernetesMode: "statefulset"}, false))
+	assert.NoError(t, ConfigureKubernetes(&Config{}, false))
+}
+
+func TestBearerTokenHeader(t *testing.T) {
+	f, err := ioutil.TempFile("", "token")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("token\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	agent := &Agent{config: &Config{AgentName: "foo", KubernetesTokenPath: f.Name()}}
+	header := agent.buildTransportHeaderMap()
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+
+	// The agent falls back to the password if the token cannot be read
+	agent.config.KubernetesTokenPath = f.Name() + ".missing"
+	header = agent.buildTransportHeaderMap()
+	assert.Contains(t, header.Get("Authorization"), "Basic ")
+}
+
+func TestDrain(t *testing.T) {
+	agent := &Agent{
+		config:       &Config{},
+		inProgress:   map[string]*corev2.CheckConfig{"check": corev2.FixtureCheckConfig("check")},
+		inProgressMu: &sync.Mutex{},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		agent.Drain(context.Background())
+		close(done)
+	}()
+
+	assert.Eventually(t, agent.Draining, time.Second, 10*time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("drain returned with a check in progress")
+	default:
+	}
+
+	agent.inProgressMu.Lock()
+	delete(agent.inProgress, "check")
+	agent.inProgressMu.Unlock()
+	<-done
+
+	// Drain returns once ctx is done
+	agent.inProgress["check"] = corev2.FixtureCheckConfig("check")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	agent.Drain(ctx)
+}
@@ -0,0 +1,87 @@
+package agent
+
+import (
+	"context"
+	"reflect"
+
+	time "github.com/echlebek/timeproxy"
+	"github.com/sensu/sensu-go/agent/discovery"
+	"github.com/sensu/sensu-go/command"
+)
+
+// labelDiscoveryTimeout is the time given to the label discovery sources to
+// discover the labels.
+const labelDiscoveryTimeout = time.Minute
+
+// newLabelSources returns the label discovery sources configured.
+func newLabelSources(config *Config, executor command.Executor) ([]discovery.Source, error) {
+	cfg := discovery.Config{
+		Script:      config.LabelDiscoveryScript,
+		PodinfoPath: config.LabelDiscoveryPodinfoPath,
+		Executor:    executor,
+	}
+	sources := make([]discovery.Source, 0, len(config.LabelDiscovery))
+	for _, name := range config.LabelDiscovery {
+		source, err := discovery.New(name, cfg)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// discoverLabels discovers the entity labels from the label discovery sources.
+// The entity is built again with them if they changed.
+func (a *Agent) discoverLabels(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, labelDiscoveryTimeout)
+	defer cancel()
+
+	labels, err := discovery.Discover(ctx, a.labelSources)
+	if err != nil {
+		// Keep the labels discovered by the other sources
+		logger.WithError(err).Error("failed to discover entity labels")
+	}
+
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+	if reflect.DeepEqual(labels, a.discoveredLabels) {
+		return
+	}
+	logger.WithField("labels", labels).Info("discovered entity labels")
+	a.discoveredLabels = labels
+	// The entity is built again with the new labels on the next use
+	a.entity = nil
+}
+
+func (a *Agent) discoverLabelsPeriodically(ctx context.Context) {
+	defer logger.Debug("shutting down label discovery")
+	ticker := time.NewTicker(time.Duration(a.getConfig().LabelDiscoveryInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			a.discoverLabels(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// entityLabels returns the labels of the entity: the discovered labels, and
+// the configured ones which take precedence. It assumes entityMu is locked.
+func (a *Agent) entityLabels() map[string]string {
+	config := a.getConfig()
+	if len(a.discoveredLabels) == 0 {
+		return config.Labels
+	}
+	labels := make(map[string]string, len(a.discoveredLabels)+len(config.Labels))
+	for k, v := range a.discoveredLabels {
+		labels[k] = v
+	}
+	for k, v := range config.Labels {
+		labels[k] = v
+	}
+	return labels
+}
@@ -0,0 +1,166 @@
+package agent
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/asset"
+	"github.com/sensu/sensu-go/backend/agentd"
+)
+
+const (
+	// CheckExecutionsCounterVec is the name of the prometheus counter vec
+	// used to count the check executions.
+	CheckExecutionsCounterVec = "sensu_go_agent_check_executions"
+
+	// CheckExecutionDurationHistogram is the name of the prometheus histogram
+	// used to measure the duration of the check executions.
+	CheckExecutionDurationHistogram = "sensu_go_agent_check_execution_duration_seconds"
+
+	// ReconnectionsCounter is the name of the prometheus counter used to count
+	// the reconnections to the backend.
+	ReconnectionsCounter = "sensu_go_agent_reconnections"
+
+	// EventsQueuedGauge is the name of the prometheus gauge used to report the
+	// number of events waiting in the events queue.
+	EventsQueuedGauge = "sensu_go_agent_events_queued"
+
+	// AssetFetchesCounterVec is the name of the prometheus counter vec used to
+	// count the asset fetches.
+	AssetFetchesCounterVec = "sensu_go_agent_asset_fetches"
+
+	// TokenSubstitutionFailuresCounter is the name of the prometheus counter
+	// used to count the token substitution failures.
+	TokenSubstitutionFailuresCounter = "sensu_go_agent_token_substitution_failures"
+
+	// SerializationGaugeVec is the name of the prometheus gauge vec used to
+	// report the serialization format negotiated with the backend.
+	SerializationGaugeVec = "sensu_go_agent_serialization"
+
+	// FormatLabelName is the name of the label which stores a serialization
+	// format.
+	FormatLabelName = "format"
+
+	// StatusLabelName is the name of the label which stores the outcome of an
+	// operation.
+	StatusLabelName = "status"
+
+	// StatusSuccess is the status label value of a successful operation.
+	StatusSuccess = "success"
+
+	// StatusFailure is the status label value of a failed operation.
+	StatusFailure = "failure"
+)
+
+var (
+	// CheckExecutions counts the check executions, by status. A check
+	// execution fails if the agent couldn't run the check command.
+	CheckExecutions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: CheckExecutionsCounterVec,
+			Help: "The total number of check executions",
+		},
+		[]string{StatusLabelName},
+	)
+
+	// CheckExecutionDuration measures the duration of the check executions.
+	CheckExecutionDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    CheckExecutionDurationHistogram,
+			Help:    "The duration of the check executions, in seconds",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 9),
+		},
+	)
+
+	// Reconnections counts the reconnections to the backend.
+	Reconnections = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: ReconnectionsCounter,
+			Help: "The total number of reconnections to the backend",
+		},
+	)
+
+	// EventsQueued reports the number of events waiting in the events queue.
+	EventsQueued = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: EventsQueuedGauge,
+			Help: "The number of events queued while the backend is unreachable",
+		},
+	)
+
+	// AssetFetches counts the asset fetches, by status.
+	AssetFetches = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: AssetFetchesCounterVec,
+			Help: "The total number of asset fetches",
+		},
+		[]string{StatusLabelName},
+	)
+
+	// TokenSubstitutionFailures counts the checks and hooks that couldn't be
+	// executed because of a token substitution error.
+	TokenSubstitutionFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: TokenSubstitutionFailuresCounter,
+			Help: "The total number of token substitution failures",
+		},
+	)
+
+	// Serialization reports the serialization format negotiated with the
+	// backend, set to 1 for the format in use and 0 for the other one.
+	Serialization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: SerializationGaugeVec,
+			Help: "The serialization format negotiated with the backend",
+		},
+		[]string{FormatLabelName},
+	)
+)
+
+func registerMetrics() {
+	_ = prometheus.Register(OutputTruncations)
+	_ = prometheus.Register(EventsDropped)
+	_ = prometheus.Register(CheckExecutions)
+	_ = prometheus.Register(CheckExecutionDuration)
+	_ = prometheus.Register(Reconnections)
+	_ = prometheus.Register(EventsQueued)
+	_ = prometheus.Register(AssetFetches)
+	_ = prometheus.Register(TokenSubstitutionFailures)
+	_ = prometheus.Register(Serialization)
+	_ = prometheus.Register(BackpressureDelay)
+}
+
+// setSerialization reports the serialization format of the content type
+// negotiated with the backend.
+func setSerialization(contentType string) {
+	for _, format := range []string{agentd.ProtobufSerializationFormat, agentd.JSONSerializationFormat} {
+		value := 0.0
+		if format == agentd.SerializationFormat(contentType) {
+			value = 1
+		}
+		Serialization.WithLabelValues(format).Set(value)
+	}
+}
+
+// addQueuedEvents adds delta to the number of queued events.
+func (a *Agent) addQueuedEvents(delta int64) {
+	EventsQueued.Set(float64(atomic.AddInt64(&a.eventsQueueSize, delta)))
+}
+
+// instrumentedGetter is an asset.Getter counting the asset fetches.
+type instrumentedGetter struct {
+	getter asset.Getter
+}
+
+// Get gets the runtime asset with the underlying getter.
+func (g instrumentedGetter) Get(ctx context.Context, a *corev2.Asset) (*asset.RuntimeAsset, error) {
+	runtimeAsset, err := g.getter.Get(ctx, a)
+	if err != nil {
+		AssetFetches.WithLabelValues(StatusFailure).Inc()
+	} else {
+		AssetFetches.WithLabelValues(StatusSuccess).Inc()
+	}
+	return runtimeAsset, err
+}
@@ -0,0 +1,76 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/asset"
+	"github.com/sensu/sensu-go/backend/agentd"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeGetter struct {
+	err error
+}
+
+func (g fakeGetter) Get(context.Context, *corev2.Asset) (*asset.RuntimeAsset, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+	return &asset.RuntimeAsset{}, nil
+}
+
+func TestInstrumentedGetter(t *testing.T) {
+	successes := testutil.ToFloat64(AssetFetches.WithLabelValues(StatusSuccess))
+	failures := testutil.ToFloat64(AssetFetches.WithLabelValues(StatusFailure))
+
+	_, _ = instrumentedGetter{getter: fakeGetter{}}.Get(context.Background(), corev2.FixtureAsset("a"))
+	_, err := instrumentedGetter{getter: fakeGetter{err: errors.New("boom")}}.Get(context.Background(), corev2.FixtureAsset("b"))
+	assert.Error(t, err)
+
+	assert.Equal(t, successes+1, testutil.ToFloat64(AssetFetches.WithLabelValues(StatusSuccess)))
+	assert.Equal(t, failures+1, testutil.ToFloat64(AssetFetches.WithLabelValues(StatusFailure)))
+}
+
+func TestAddQueuedEvents(t *testing.T) {
+	agent := &Agent{}
+	agent.addQueuedEvents(2)
+	assert.Equal(t, float64(2), testutil.ToFloat64(EventsQueued))
+	agent.addQueuedEvents(-1)
+	assert.Equal(t, float64(1), testutil.ToFloat64(EventsQueued))
+	assert.EqualValues(t, 1, agent.eventsQueueSize)
+}
+
+func TestSetSerialization(t *testing.T) {
+	setSerialization(agentd.ProtobufSerializationHeader)
+	assert.Equal(t, float64(1), testutil.ToFloat64(Serialization.WithLabelValues("protobuf")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(Serialization.WithLabelValues("json")))
+
+	setSerialization(agentd.JSONSerializationHeader)
+	assert.Equal(t, float64(0), testutil.ToFloat64(Serialization.WithLabelValues("protobuf")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(Serialization.WithLabelValues("json")))
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	for _, disabled := range []bool{false, true} {
+		agent := &Agent{config: &Config{DisableMetrics: disabled}}
+		router := mux.NewRouter()
+		registerRoutes(agent, router)
+
+		r, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		if disabled {
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		} else {
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package agent
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// OversizedOutputTruncate truncates check output larger than the maximum
+	// output size.
+	OversizedOutputTruncate = "truncate"
+
+	// OversizedOutputDiscard discards check output larger than the maximum
+	// output size.
+	OversizedOutputDiscard = "discard"
+
+	// DefaultOutputTruncationMarker is appended to truncated check output.
+	DefaultOutputTruncationMarker = "\n...[output truncated]"
+
+	// OutputTruncationsCounterVec is the name of the prometheus counter vec
+	// used to count the oversized check outputs.
+	OutputTruncationsCounterVec = "sensu_go_agent_check_output_truncations"
+
+	// OutputTruncationsLabelName is the name of the label which stores the
+	// policy applied to the oversized check output.
+	OutputTruncationsLabelName = "policy"
+)
+
+var (
+	// OutputTruncations counts the check outputs truncated or discarded
+	// because of their size.
+	OutputTruncations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: OutputTruncationsCounterVec,
+			Help: "The total number of check outputs truncated or discarded because of their size",
+		},
+		[]string{OutputTruncationsLabelName},
+	)
+)
+
+// validateOversizedOutputPolicy returns an error if policy is not a valid
+// oversized output policy. An empty policy is valid and means truncate.
+func validateOversizedOutputPolicy(policy string) error {
+	switch policy {
+	case "", OversizedOutputTruncate, OversizedOutputDiscard:
+		return nil
+	default:
+		return fmt.Errorf("invalid oversized output policy %q, expected %q or %q", policy, OversizedOutputTruncate, OversizedOutputDiscard)
+	}
+}
+
+// limitOutput truncates or discards the output of check if it is larger than
+// the maximum output size of the agent or the check, whichever is smaller.
+func (a *Agent) limitOutput(check *corev2.Check) {
+	config := a.getConfig()
+	limit := config.MaxOutputSize
+	if size := check.MaxOutputSize; size > 0 && (limit <= 0 || size < limit) {
+		limit = size
+	}
+	policy := config.OversizedOutputPolicy
+	if policy == "" {
+		policy = OversizedOutputTruncate
+	}
+
+	output, limited := limitOutput(check.Output, limit, policy, config.OutputTruncationMarker)
+	if !limited {
+		return
+	}
+	logger.WithFields(logrus.Fields{
+		"check":       check.Name,
+		"output_size": len(check.Output),
+		"max_size":    limit,
+		"policy":      policy,
+	}).Warn("check output is too large")
+	OutputTruncations.WithLabelValues(policy).Inc()
+	check.Output = output
+}
+
+// limitOutput returns output limited to limit bytes according to policy, and
+// whether it had to be limited. Truncated output ends with marker and is never
+// cut in the middle of a UTF-8 character. A limit of zero means no limit.
+func limitOutput(output string, limit int64, policy, marker string) (string, bool) {
+	if limit <= 0 || int64(len(output)) <= limit {
+		return output, false
+	}
+	if policy == OversizedOutputDiscard {
+		return "", true
+	}
+	if int64(len(marker)) >= limit {
+		// The marker doesn't fit, keep as much output as possible
+		marker = ""
+	}
+	end := int(limit) - len(marker)
+	for end > 0 && !utf8.RuneStart(output[end]) {
+		end--
+	}
+	return output[:end] + marker, true
+}
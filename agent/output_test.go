@@ -0,0 +1,91 @@
+package agent
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		limit    int64
+		policy   string
+		marker   string
+		expected string
+		limited  bool
+	}{
+		{
+			name:     "no limit",
+			output:   "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "output within the limit",
+			output:   "hello",
+			limit:    5,
+			policy:   OversizedOutputTruncate,
+			marker:   "!",
+			expected: "hello",
+		},
+		{
+			name:     "truncated with marker",
+			output:   "hello world",
+			limit:    8,
+			policy:   OversizedOutputTruncate,
+			marker:   "...",
+			expected: "hello...",
+			limited:  true,
+		},
+		{
+			name:     "marker larger than the limit",
+			output:   "hello world",
+			limit:    2,
+			policy:   OversizedOutputTruncate,
+			marker:   "...",
+			expected: "he",
+			limited:  true,
+		},
+		{
+			name:     "not cut in a character",
+			output:   "héllo",
+			limit:    2,
+			policy:   OversizedOutputTruncate,
+			expected: "h",
+			limited:  true,
+		},
+		{
+			name:     "discarded",
+			output:   "hello world",
+			limit:    8,
+			policy:   OversizedOutputDiscard,
+			marker:   "...",
+			expected: "",
+			limited:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, limited := limitOutput(tt.output, tt.limit, tt.policy, tt.marker)
+			assert.Equal(t, tt.expected, output)
+			assert.Equal(t, tt.limited, limited)
+		})
+	}
+}
+
+func TestAgentLimitOutputUsesSmallestLimit(t *testing.T) {
+	agent := &Agent{config: &Config{MaxOutputSize: 8, OutputTruncationMarker: "..."}}
+
+	check := corev2.FixtureCheck("check")
+	check.Output = "hello world"
+	agent.limitOutput(check)
+	assert.Equal(t, "hello...", check.Output)
+
+	check = corev2.FixtureCheck("check")
+	check.Output = "hello world"
+	check.MaxOutputSize = 6
+	agent.limitOutput(check)
+	assert.Equal(t, "hel...", check.Output)
+}
@@ -68,24 +68,44 @@ func newQueue(path string) (queue, error) {
 	if path == os.DevNull {
 		return newMemoryQueue(1000), nil
 	}
+	queue, _, err := openQueue(path, "queue.db", "api-buffer")
+	return queue, err
+}
+
+// openQueue opens the persistent queue name, stored in the file filename under
+// path, and returns it along with the number of messages it holds. A bolt
+// database can only hold a single queue.
+func openQueue(path, filename, name string) (*lasr.Q, int64, error) {
 	if err := os.MkdirAll(path, 0744|os.ModeDir); err != nil {
-		return nil, fmt.Errorf("could not create directory for api queue (%s): %s", path, err)
+		return nil, 0, fmt.Errorf("could not create directory for %s queue (%s): %s", name, path, err)
 	}
-	queuePath := filepath.Join(path, "queue.db")
+	queuePath := filepath.Join(path, filename)
 	// Create and open the database for the queue. The FileMode given here (0600)
 	// is only temporary since it will be enforced to 0600 when the queue is
 	// compacted below by the queue.Compact method
 	db, err := bolt.Open(queuePath, 0600, &bolt.Options{Timeout: 60 * time.Second})
 	if err != nil {
-		return nil, fmt.Errorf("could not open api queue (%s): %s", queuePath, err)
+		return nil, 0, fmt.Errorf("could not open %s queue (%s): %s", name, queuePath, err)
 	}
-	queue, err := lasr.NewQ(db, "api-buffer")
+	queue, err := lasr.NewQ(db, name)
 	if err != nil {
-		return nil, fmt.Errorf("error creating api queue: %s", err)
+		return nil, 0, fmt.Errorf("error creating %s queue: %s", name, err)
 	}
-	logger.Info("compacting api queue")
-	defer logger.Info("finished api queue compaction")
-	return queue, queue.Compact()
+	// The messages that were not acked are moved back to the ready bucket of
+	// the queue when it is created. The database must be read before the
+	// compaction, which replaces it.
+	var size int64
+	_ = db.View(func(tx *bolt.Tx) error {
+		if root := tx.Bucket([]byte(name)); root != nil {
+			if ready := root.Bucket([]byte("ready")); ready != nil {
+				size = int64(ready.Stats().KeyN)
+			}
+		}
+		return nil
+	})
+	logger.Infof("compacting %s queue", name)
+	defer logger.Infof("finished %s queue compaction", name)
+	return queue, size, queue.Compact()
 }
 
 func compressMessage(message []byte) []byte {
@@ -0,0 +1,117 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// ResultsBufferSize is the number of check results kept by the agent for
+	// the /results API.
+	ResultsBufferSize = 100
+
+	// resultOutputSize is the maximum size of the output of a result returned
+	// by the /results API.
+	resultOutputSize = 1024
+)
+
+// checkResult summarizes the execution of a check by the agent.
+type checkResult struct {
+	Check           string  `json:"check"`
+	ProxyEntityName string  `json:"proxy_entity_name,omitempty"`
+	Status          uint32  `json:"status"`
+	Issued          int64   `json:"issued"`
+	Executed        int64   `json:"executed"`
+	Duration        float64 `json:"duration"`
+	Output          string  `json:"output"`
+	OutputTruncated bool    `json:"output_truncated"`
+}
+
+// resultsBuffer is a ring buffer holding the latest check results.
+type resultsBuffer struct {
+	mu      sync.Mutex
+	results []checkResult
+	next    int
+	full    bool
+}
+
+func newResultsBuffer(size int) *resultsBuffer {
+	return &resultsBuffer{results: make([]checkResult, size)}
+}
+
+// add records the result of check, replacing the oldest result if the buffer
+// is full.
+func (b *resultsBuffer) add(check *corev2.Check) {
+	output, truncated := limitOutput(check.Output, resultOutputSize, OversizedOutputTruncate, "")
+	result := checkResult{
+		Check:           check.Name,
+		ProxyEntityName: check.ProxyEntityName,
+		Status:          check.Status,
+		Issued:          check.Issued,
+		Executed:        check.Executed,
+		Duration:        check.Duration,
+		Output:          output,
+		OutputTruncated: truncated,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.results[b.next] = result
+	b.next = (b.next + 1) % len(b.results)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// latest returns up to n results, the most recent first. A negative n returns
+// all the results.
+func (b *resultsBuffer) latest(n int) []checkResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	count := b.next
+	if b.full {
+		count = len(b.results)
+	}
+	if n < 0 || n > count {
+		n = count
+	}
+	results := make([]checkResult, 0, n)
+	for i := 1; i <= n; i++ {
+		results = append(results, b.results[(b.next-i+len(b.results))%len(b.results)])
+	}
+	return results
+}
+
+// recordResult keeps the result of check for the /results API.
+func (a *Agent) recordResult(check *corev2.Check) {
+	if a.results == nil || check == nil {
+		return
+	}
+	a.results.add(check)
+}
+
+// resultsShow returns the latest check results executed by the agent. The
+// number of results can be limited with the limit query parameter.
+func resultsShow(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := -1
+		if value := r.URL.Query().Get("limit"); value != "" {
+			var err error
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit < 0 {
+				http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+				return
+			}
+		}
+		results := []checkResult{}
+		if a.results != nil {
+			results = a.results.latest(limit)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(results)
+	}
+}
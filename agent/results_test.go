@@ -0,0 +1,95 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultsBuffer(t *testing.T) {
+	buffer := newResultsBuffer(3)
+	assert.Empty(t, buffer.latest(-1))
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		check := corev2.FixtureCheck(name)
+		buffer.add(check)
+	}
+
+	names := func(results []checkResult) []string {
+		var names []string
+		for _, result := range results {
+			names = append(names, result.Check)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"d", "c", "b"}, names(buffer.latest(-1)))
+	assert.Equal(t, []string{"d", "c"}, names(buffer.latest(2)))
+	assert.Equal(t, []string{"d", "c", "b"}, names(buffer.latest(10)))
+}
+
+func TestResultsBufferTruncatesOutput(t *testing.T) {
+	buffer := newResultsBuffer(1)
+	check := corev2.FixtureCheck("check-cpu")
+	check.Output = strings.Repeat("x", resultOutputSize+1)
+	buffer.add(check)
+
+	results := buffer.latest(1)
+	require.Len(t, results, 1)
+	assert.Len(t, results[0].Output, resultOutputSize)
+	assert.True(t, results[0].OutputTruncated)
+}
+
+func TestResultsShow(t *testing.T) {
+	config, cleanup := FixtureConfig()
+	defer cleanup()
+	agent, err := NewAgent(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	agent.recordResult(corev2.FixtureCheck("check-cpu"))
+	agent.recordResult(corev2.FixtureCheck("check-mem"))
+
+	router := mux.NewRouter()
+	registerRoutes(agent, router)
+
+	testCases := []struct {
+		desc             string
+		query            string
+		authenticate     bool
+		expectedResponse int
+		expectedResults  int
+	}{
+		{"without credentials", "", false, http.StatusUnauthorized, 0},
+		{"all results", "", true, http.StatusOK, 2},
+		{"limited results", "?limit=1", true, http.StatusOK, 1},
+		{"invalid limit", "?limit=many", true, http.StatusBadRequest, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r, err := http.NewRequest(http.MethodGet, "/results"+tc.query, nil)
+			require.NoError(t, err)
+			if tc.authenticate {
+				r.SetBasicAuth(DefaultUser, DefaultPassword)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			assert.Equal(t, tc.expectedResponse, w.Code)
+			if w.Code != http.StatusOK {
+				return
+			}
+			var results []checkResult
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&results))
+			assert.Len(t, results, tc.expectedResults)
+			assert.Equal(t, "check-mem", results[0].Check)
+		})
+	}
+}
@@ -24,10 +24,11 @@ var (
 // createListenSockets UDP and TCP socket listeners on port 3030 for external check
 // events.
 func (a *Agent) createListenSockets(ctx context.Context) (string, string, error) {
+	config := a.getConfig()
 	// we have two listeners that we want to shut down before agent.Stop() returns.
 	a.wg.Add(2)
 
-	addr := fmt.Sprintf("%s:%d", a.config.Socket.Host, a.config.Socket.Port)
+	addr := fmt.Sprintf("%s:%d", config.Socket.Host, config.Socket.Port)
 
 	// Setup UDP socket listener
 	UDPServerAddr, err := net.ResolveUDPAddr("udp", addr)
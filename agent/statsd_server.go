@@ -32,7 +32,7 @@ func GetMetricsAddr(s StatsdServer) string {
 
 // NewStatsdServer provides a new statsd server for the sensu-agent.
 func NewStatsdServer(a *Agent) *statsd.Server {
-	c := a.config.StatsdServer
+	c := a.getConfig().StatsdServer
 	s := NewServer()
 	backend, err := NewClientFromViper(s.Viper, a)
 	if err != nil {
@@ -154,7 +154,7 @@ func (c Client) sendMetrics(points []*types.MetricPoint) (retErr error) {
 
 	metrics := &types.Metrics{
 		Points:   points,
-		Handlers: c.agent.config.StatsdServer.Handlers,
+		Handlers: c.agent.getConfig().StatsdServer.Handlers,
 	}
 	event := &types.Event{
 		Entity:    c.agent.getAgentEntity(),
@@ -0,0 +1,136 @@
+package transformers
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
+)
+
+// PrometheusList contains a list of Prometheus metrics
+type PrometheusList []Prometheus
+
+// Prometheus contains values of a Prometheus metric sample
+type Prometheus struct {
+	Name      string
+	Value     float64
+	TagSet    []*types.MetricTag
+	Timestamp int64
+}
+
+// Transform transforms metrics in Prometheus exposition format to Sensu Metric
+// Format
+func (p PrometheusList) Transform() []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, metric := range p {
+		mp := &types.MetricPoint{
+			Name:      metric.Name,
+			Value:     metric.Value,
+			Timestamp: metric.Timestamp,
+			Tags:      metric.TagSet,
+		}
+		points = append(points, mp)
+	}
+	return points
+}
+
+// ParsePrometheus parses metrics in the Prometheus text exposition format into
+// a list of Prometheus structs. The labels of the samples are kept as tags.
+// Summaries and histograms are split into their _sum, _count and quantile or
+// _bucket samples, like they are exposed.
+func ParsePrometheus(event *types.Event) PrometheusList {
+	var prometheusList PrometheusList
+	fields := logrus.Fields{
+		"namespace": event.Check.Namespace,
+		"check":     event.Check.Name,
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(event.Check.Output))
+	if err != nil {
+		logger.WithFields(fields).WithError(ErrMetricExtraction).Errorf("invalid prometheus metrics: %s", err)
+		return nil
+	}
+
+	// Sort the metric families so the output is stable
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		family := families[name]
+		for _, metric := range family.Metric {
+			timestamp := event.Check.Executed
+			if metric.TimestampMs != nil {
+				timestamp = metric.GetTimestampMs() / 1000
+			}
+			tags := prometheusTags(metric.Label)
+			sample := func(name string, value float64, tags []*types.MetricTag) {
+				prometheusList = append(prometheusList, Prometheus{
+					Name:      name,
+					Value:     value,
+					TagSet:    tags,
+					Timestamp: timestamp,
+				})
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				sample(name, metric.GetCounter().GetValue(), tags)
+			case dto.MetricType_GAUGE:
+				sample(name, metric.GetGauge().GetValue(), tags)
+			case dto.MetricType_SUMMARY:
+				summary := metric.GetSummary()
+				for _, quantile := range summary.Quantile {
+					sample(name, quantile.GetValue(), appendTag(tags, "quantile", formatFloat(quantile.GetQuantile())))
+				}
+				sample(name+"_sum", summary.GetSampleSum(), tags)
+				sample(name+"_count", float64(summary.GetSampleCount()), tags)
+			case dto.MetricType_HISTOGRAM:
+				histogram := metric.GetHistogram()
+				for _, bucket := range histogram.Bucket {
+					sample(name+"_bucket", float64(bucket.GetCumulativeCount()), appendTag(tags, "le", formatFloat(bucket.GetUpperBound())))
+				}
+				sample(name+"_sum", histogram.GetSampleSum(), tags)
+				sample(name+"_count", float64(histogram.GetSampleCount()), tags)
+			default:
+				sample(name, metric.GetUntyped().GetValue(), tags)
+			}
+		}
+	}
+
+	return prometheusList
+}
+
+func prometheusTags(labels []*dto.LabelPair) []*types.MetricTag {
+	tags := make([]*types.MetricTag, 0, len(labels))
+	for _, label := range labels {
+		tags = append(tags, &types.MetricTag{
+			Name:  label.GetName(),
+			Value: label.GetValue(),
+		})
+	}
+	return tags
+}
+
+// appendTag returns a copy of tags with an additional tag, so the tags of the
+// samples of a summary or histogram don't share the same backing array.
+func appendTag(tags []*types.MetricTag, name, value string) []*types.MetricTag {
+	result := make([]*types.MetricTag, len(tags), len(tags)+1)
+	copy(result, tags)
+	return append(result, &types.MetricTag{Name: name, Value: value})
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
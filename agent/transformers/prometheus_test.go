@@ -0,0 +1,104 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrometheus(t *testing.T) {
+	testCases := []struct {
+		name   string
+		output string
+		want   PrometheusList
+	}{
+		{
+			name: "counter with labels and timestamp",
+			output: `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+`,
+			want: PrometheusList{
+				{
+					Name:  "http_requests_total",
+					Value: 1027,
+					TagSet: []*types.MetricTag{
+						{Name: "method", Value: "post"},
+						{Name: "code", Value: "200"},
+					},
+					Timestamp: 1395066363,
+				},
+			},
+		},
+		{
+			name:   "untyped metric without timestamp",
+			output: "temperature 21.5\n",
+			want: PrometheusList{
+				{
+					Name:      "temperature",
+					Value:     21.5,
+					TagSet:    []*types.MetricTag{},
+					Timestamp: 1234,
+				},
+			},
+		},
+		{
+			name: "histogram",
+			output: `# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.5"} 3
+latency_seconds_bucket{le="+Inf"} 5
+latency_seconds_sum 4.2
+latency_seconds_count 5
+`,
+			want: PrometheusList{
+				{Name: "latency_seconds_bucket", Value: 3, TagSet: []*types.MetricTag{{Name: "le", Value: "0.5"}}, Timestamp: 1234},
+				{Name: "latency_seconds_bucket", Value: 5, TagSet: []*types.MetricTag{{Name: "le", Value: "+Inf"}}, Timestamp: 1234},
+				{Name: "latency_seconds_sum", Value: 4.2, TagSet: []*types.MetricTag{}, Timestamp: 1234},
+				{Name: "latency_seconds_count", Value: 5, TagSet: []*types.MetricTag{}, Timestamp: 1234},
+			},
+		},
+		{
+			name: "summary",
+			output: `# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{service="a",quantile="0.99"} 0.3
+rpc_duration_seconds_sum{service="a"} 12
+rpc_duration_seconds_count{service="a"} 40
+`,
+			want: PrometheusList{
+				{Name: "rpc_duration_seconds", Value: 0.3, TagSet: []*types.MetricTag{{Name: "service", Value: "a"}, {Name: "quantile", Value: "0.99"}}, Timestamp: 1234},
+				{Name: "rpc_duration_seconds_sum", Value: 12, TagSet: []*types.MetricTag{{Name: "service", Value: "a"}}, Timestamp: 1234},
+				{Name: "rpc_duration_seconds_count", Value: 40, TagSet: []*types.MetricTag{{Name: "service", Value: "a"}}, Timestamp: 1234},
+			},
+		},
+		{
+			name:   "invalid output",
+			output: "not a metric at all",
+			want:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event := types.FixtureEvent("test", "test")
+			event.Check.Output = tc.output
+			event.Check.Executed = 1234
+			assert.Equal(t, tc.want, ParsePrometheus(event))
+		})
+	}
+}
+
+func TestParseAndTransformPrometheus(t *testing.T) {
+	event := types.FixtureEvent("test", "test")
+	event.Check.Output = "up{job=\"node\"} 1 1395066363000\n"
+	got := ParsePrometheus(event).Transform()
+	want := []*types.MetricPoint{
+		{
+			Name:      "up",
+			Value:     1,
+			Timestamp: 1395066363,
+			Tags:      []*types.MetricTag{{Name: "job", Value: "node"}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
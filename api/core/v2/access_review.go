@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// AccessReviewPath is the path of the can-i API, relative to URLPrefix.
+	AccessReviewPath = "can-i"
+)
+
+// AccessReview is the result of the evaluation of the permissions of a user
+// for a verb on a resource, as returned by the can-i API.
+type AccessReview struct {
+	// User is the name of the user whose permissions are evaluated.
+	User string `json:"user"`
+
+	// Verb, Resource, ResourceName and Namespace describe the evaluated
+	// request.
+	Verb         string `json:"verb"`
+	Resource     string `json:"resource"`
+	ResourceName string `json:"resource_name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+
+	// Allowed indicates whether the user is allowed to make the request.
+	Allowed bool `json:"allowed"`
+
+	// Reason explains why the request is allowed or denied.
+	Reason string `json:"reason"`
+
+	// Binding is the binding that granted or denied the request, if any.
+	Binding *AccessReviewBinding `json:"binding,omitempty"`
+}
+
+// AccessReviewBinding describes the binding, and the rule of its role, that
+// decided an access review.
+type AccessReviewBinding struct {
+	// Type is either "ClusterRoleBinding" or "RoleBinding".
+	Type string `json:"type"`
+
+	// Name and Namespace identify the binding.
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+
+	// RoleRef is the role referenced by the binding.
+	RoleRef RoleRef `json:"role_ref"`
+
+	// Rule is the rule of the role that matched the request.
+	Rule Rule `json:"rule"`
+}
+
+// Validate returns an error if the access review does not describe a request.
+func (r *AccessReview) Validate() error {
+	if r.Verb == "" {
+		return errors.New("access review verb must be set")
+	}
+	if r.Resource == "" {
+		return errors.New("access review resource must be set")
+	}
+	return nil
+}
+
+// String returns the binding as "ClusterRoleBinding admin" or
+// "RoleBinding ops/admin".
+func (b *AccessReviewBinding) String() string {
+	if b.Namespace == "" {
+		return fmt.Sprintf("%s %s", b.Type, b.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", b.Type, b.Namespace, b.Name)
+}
@@ -0,0 +1,147 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// AdmissionWebhooksResource is the name of this resource type
+	AdmissionWebhooksResource = "admission-webhooks"
+
+	// AdmissionFailurePolicyFail rejects the resources when an admission
+	// webhook can't be reached
+	AdmissionFailurePolicyFail = "fail"
+
+	// AdmissionFailurePolicyIgnore admits the resources when an admission
+	// webhook can't be reached
+	AdmissionFailurePolicyIgnore = "ignore"
+
+	// AdmissionOperationCreate is the operation of the requests creating a
+	// resource (POST)
+	AdmissionOperationCreate = "create"
+
+	// AdmissionOperationUpdate is the operation of the requests creating or
+	// replacing a resource (PUT and PATCH)
+	AdmissionOperationUpdate = "update"
+)
+
+// AdmissionReview is the payload posted to the admission webhooks.
+type AdmissionReview struct {
+	// Operation is either "create" or "update".
+	Operation string `json:"operation"`
+
+	// User is the name of the user making the request.
+	User string `json:"user,omitempty"`
+
+	// Type and APIVersion identify the type of the resource.
+	Type       string `json:"type"`
+	APIVersion string `json:"api_version"`
+
+	// Resource is the resource about to be stored.
+	Resource Resource `json:"resource"`
+}
+
+// AdmissionResponse is the response of the admission webhooks.
+type AdmissionResponse struct {
+	// Allowed indicates whether the resource is admitted.
+	Allowed bool `json:"allowed"`
+
+	// Reason explains why the resource is rejected.
+	Reason string `json:"reason,omitempty"`
+
+	// Resource replaces the resource of the review if not empty, so that the
+	// webhooks can modify the resources.
+	Resource json.RawMessage `json:"resource,omitempty"`
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (w *AdmissionWebhook) StorePrefix() string {
+	return AdmissionWebhooksResource
+}
+
+// URIPath returns the path component of an admission webhook URI.
+func (w *AdmissionWebhook) URIPath() string {
+	return path.Join(URLPrefix, AdmissionWebhooksResource, url.PathEscape(w.Name))
+}
+
+// Validate returns an error if the admission webhook does not pass validation
+// tests.
+func (w *AdmissionWebhook) Validate() error {
+	if err := ValidateName(w.Name); err != nil {
+		return errors.New("admission webhook name " + err.Error())
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("admission webhook url is invalid: %s", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("admission webhook url must be an https url")
+	}
+
+	switch w.FailurePolicy {
+	case "", AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore:
+	default:
+		return fmt.Errorf("admission webhook failure policy %q is not valid", w.FailurePolicy)
+	}
+
+	if w.Namespace != "" {
+		return errors.New("admission webhooks are not namespaced")
+	}
+
+	return nil
+}
+
+// Handles returns whether the webhook is called for the resources of the
+// given RBAC name.
+func (w *AdmissionWebhook) Handles(resource string) bool {
+	if len(w.Resources) == 0 {
+		return true
+	}
+	for _, r := range w.Resources {
+		if r == resource || r == ResourceAll {
+			return true
+		}
+	}
+	return false
+}
+
+// NewAdmissionWebhook creates a new AdmissionWebhook.
+func NewAdmissionWebhook(meta ObjectMeta) *AdmissionWebhook {
+	return &AdmissionWebhook{ObjectMeta: meta}
+}
+
+// FixtureAdmissionWebhook returns an AdmissionWebhook fixture for testing.
+func FixtureAdmissionWebhook(name string) *AdmissionWebhook {
+	return &AdmissionWebhook{
+		ObjectMeta: NewObjectMeta(name, ""),
+		URL:        "https://127.0.0.1:8443/admit",
+		Timeout:    10,
+	}
+}
+
+// AdmissionWebhookFields returns a set of fields that represent that resource
+func AdmissionWebhookFields(r Resource) map[string]string {
+	resource := r.(*AdmissionWebhook)
+	return map[string]string{
+		"admission_webhook.name":           resource.ObjectMeta.Name,
+		"admission_webhook.failure_policy": resource.FailurePolicy,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (w *AdmissionWebhook) SetNamespace(namespace string) {
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (w *AdmissionWebhook) SetObjectMeta(meta ObjectMeta) {
+	w.ObjectMeta = meta
+}
+
+func (w *AdmissionWebhook) RBACName() string {
+	return AdmissionWebhooksResource
+}
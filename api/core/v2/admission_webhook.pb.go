@@ -0,0 +1,937 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: admission_webhook.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// An AdmissionWebhook is an HTTPS endpoint called when resources are created
+// or updated through the API. The endpoint receives the resource and can
+// reject it, e.g. to enforce naming conventions, or modify it, e.g. to add
+// mandatory labels, before it is stored.
+type AdmissionWebhook struct {
+	// Metadata contains the name, labels and annotations of the webhook
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// URL is the HTTPS URL of the endpoint, to which the admission reviews are
+	// posted as JSON.
+	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Headers are the HTTP headers sent to the endpoint.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Timeout is the request timeout in seconds.
+	Timeout uint32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout"`
+	// Resources are the resource types the webhook is called for, as named in
+	// the RBAC rules (e.g. "checks"), or all of them if empty or "*".
+	Resources []string `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources"`
+	// FailurePolicy is either "fail" to reject the resources when the endpoint
+	// can't be reached, or "ignore" to admit them. Defaults to "fail".
+	FailurePolicy string `protobuf:"bytes,6,opt,name=failure_policy,json=failurePolicy,proto3" json:"failure_policy,omitempty"`
+	// CABundle is the PEM-encoded CA certificates used to verify the
+	// certificate of the endpoint, instead of the system ones.
+	CABundle             string   `protobuf:"bytes,7,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdmissionWebhook) Reset()         { *m = AdmissionWebhook{} }
+func (m *AdmissionWebhook) String() string { return proto.CompactTextString(m) }
+func (*AdmissionWebhook) ProtoMessage()    {}
+func (*AdmissionWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e950b6ec4c57f35, []int{0}
+}
+func (m *AdmissionWebhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdmissionWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdmissionWebhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdmissionWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdmissionWebhook.Merge(m, src)
+}
+func (m *AdmissionWebhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdmissionWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdmissionWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdmissionWebhook proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AdmissionWebhook)(nil), "sensu.core.v2.AdmissionWebhook")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.AdmissionWebhook.HeadersEntry")
+}
+
+func init() { proto.RegisterFile("admission_webhook.proto", fileDescriptor_5e950b6ec4c57f35) }
+
+var fileDescriptor_5e950b6ec4c57f35 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xae, 0x1b, 0xb6, 0x36, 0x2e, 0x45, 0x93, 0x41, 0x22, 0xab, 0x50, 0x1c, 0x81, 0x90, 0x22,
+	0x31, 0x79, 0x5a, 0xc7, 0x01, 0xf5, 0xb4, 0x65, 0x02, 0x71, 0x00, 0x81, 0x22, 0x4d, 0x48, 0x5c,
+	0x2a, 0x27, 0xf5, 0xda, 0xb0, 0xa4, 0xae, 0x1c, 0xbb, 0x28, 0x6f, 0xc0, 0x23, 0x70, 0xdc, 0x71,
+	0x8f, 0xc0, 0x23, 0xec, 0xb8, 0x27, 0xb0, 0x20, 0xdc, 0x22, 0x71, 0xe7, 0x88, 0xea, 0x90, 0xad,
+	0xeb, 0xed, 0xf3, 0xf7, 0x7f, 0xff, 0xff, 0xf9, 0xfb, 0x6d, 0xf8, 0x98, 0x4e, 0xb2, 0x24, 0xcf,
+	0x13, 0x3e, 0x1f, 0x7f, 0x65, 0xd1, 0x8c, 0xf3, 0x73, 0xb2, 0x10, 0x5c, 0x72, 0xd4, 0xcf, 0xd9,
+	0x3c, 0x57, 0x24, 0xe6, 0x82, 0x91, 0xe5, 0x70, 0xf0, 0x72, 0x9a, 0xc8, 0x99, 0x8a, 0x48, 0xcc,
+	0xb3, 0xfd, 0x29, 0x9f, 0xf2, 0x7d, 0xa3, 0x8a, 0xd4, 0xd9, 0xd1, 0xf2, 0x80, 0x1c, 0x92, 0x03,
+	0x43, 0x1a, 0xce, 0xa0, 0x7a, 0xc8, 0x00, 0x66, 0x4c, 0xd2, 0x1a, 0x3f, 0xfd, 0x63, 0xc1, 0x9d,
+	0xe3, 0xc6, 0xec, 0x53, 0xed, 0x85, 0x4e, 0x61, 0x77, 0x25, 0x99, 0x50, 0x49, 0x1d, 0xe0, 0x01,
+	0xbf, 0x37, 0xdc, 0x25, 0x77, 0x8c, 0xc9, 0x87, 0xe8, 0x0b, 0x8b, 0xe5, 0x7b, 0x26, 0x69, 0xe0,
+	0x5e, 0x69, 0xdc, 0xba, 0xd6, 0x18, 0x54, 0x1a, 0xa3, 0xa6, 0x6d, 0x8f, 0x67, 0x89, 0x64, 0xd9,
+	0x42, 0x16, 0xe1, 0xcd, 0x28, 0xb4, 0x0b, 0x2d, 0x25, 0x52, 0xa7, 0xed, 0x01, 0xdf, 0x0e, 0x3a,
+	0xa5, 0xc6, 0xd6, 0x69, 0xf8, 0x2e, 0x5c, 0x71, 0xe8, 0x0d, 0xec, 0xcc, 0x18, 0x9d, 0x30, 0x91,
+	0x3b, 0x96, 0x67, 0xf9, 0xbd, 0xe1, 0xde, 0x86, 0xe1, 0xe6, 0x1d, 0xc9, 0xdb, 0x5a, 0xfe, 0x7a,
+	0x2e, 0x45, 0x11, 0x36, 0xcd, 0xe8, 0x39, 0xec, 0xc8, 0x24, 0x63, 0x5c, 0x49, 0xe7, 0x9e, 0x07,
+	0xfc, 0x7e, 0xd0, 0xab, 0x34, 0x6e, 0xa8, 0xb0, 0x01, 0xe8, 0x05, 0xb4, 0x05, 0xcb, 0xb9, 0x12,
+	0x31, 0xcb, 0x9d, 0x2d, 0xcf, 0xf2, 0xed, 0xa0, 0x5f, 0x69, 0x7c, 0x4b, 0x86, 0xb7, 0x10, 0x9d,
+	0xc0, 0x07, 0x67, 0x34, 0x49, 0x95, 0x60, 0xe3, 0x05, 0x4f, 0x93, 0xb8, 0x70, 0xb6, 0x4d, 0x82,
+	0x27, 0x95, 0xc6, 0xce, 0xdd, 0xca, 0x5a, 0xec, 0xfe, 0xff, 0xca, 0x47, 0x53, 0x40, 0x47, 0xd0,
+	0x8e, 0xe9, 0x38, 0x52, 0xf3, 0x49, 0xca, 0x9c, 0x8e, 0xe9, 0x7f, 0x56, 0x6a, 0xdc, 0x3d, 0x39,
+	0x0e, 0x0c, 0x57, 0x69, 0xfc, 0xf0, 0x46, 0xb0, 0xbe, 0xbd, 0x98, 0xd6, 0x82, 0xc1, 0x08, 0xde,
+	0x5f, 0xcf, 0x8c, 0x76, 0xa0, 0x75, 0xce, 0x0a, 0xf3, 0x3e, 0x76, 0xb8, 0x82, 0xe8, 0x11, 0xdc,
+	0x5a, 0xd2, 0x54, 0xb1, 0x7a, 0xc3, 0x61, 0x7d, 0x18, 0xb5, 0x5f, 0x81, 0x51, 0xf7, 0xdb, 0x05,
+	0x6e, 0x5d, 0x5e, 0x60, 0x10, 0x78, 0x7f, 0x7f, 0xb9, 0xe0, 0xb2, 0x74, 0xc1, 0x8f, 0xd2, 0x05,
+	0x57, 0xa5, 0x0b, 0xae, 0x4b, 0x17, 0xfc, 0x2c, 0x5d, 0xf0, 0xfd, 0xb7, 0xdb, 0xfa, 0xdc, 0x5e,
+	0x0e, 0xa3, 0x6d, 0xf3, 0x31, 0x0e, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x9d, 0x5f, 0xa6,
+	0x84, 0x02, 0x00, 0x00,
+}
+
+func (this *AdmissionWebhook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AdmissionWebhook)
+	if !ok {
+		that2, ok := that.(AdmissionWebhook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if len(this.Resources) != len(that1.Resources) {
+		return false
+	}
+	for i := range this.Resources {
+		if this.Resources[i] != that1.Resources[i] {
+			return false
+		}
+	}
+	if this.FailurePolicy != that1.FailurePolicy {
+		return false
+	}
+	if this.CABundle != that1.CABundle {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type AdmissionWebhookFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetURL() string
+	GetHeaders() map[string]string
+	GetTimeout() uint32
+	GetResources() []string
+	GetFailurePolicy() string
+	GetCABundle() string
+}
+
+func (this *AdmissionWebhook) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *AdmissionWebhook) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewAdmissionWebhookFromFace(this)
+}
+
+func (this *AdmissionWebhook) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *AdmissionWebhook) GetURL() string {
+	return this.URL
+}
+
+func (this *AdmissionWebhook) GetHeaders() map[string]string {
+	return this.Headers
+}
+
+func (this *AdmissionWebhook) GetTimeout() uint32 {
+	return this.Timeout
+}
+
+func (this *AdmissionWebhook) GetResources() []string {
+	return this.Resources
+}
+
+func (this *AdmissionWebhook) GetFailurePolicy() string {
+	return this.FailurePolicy
+}
+
+func (this *AdmissionWebhook) GetCABundle() string {
+	return this.CABundle
+}
+
+func NewAdmissionWebhookFromFace(that AdmissionWebhookFace) *AdmissionWebhook {
+	this := &AdmissionWebhook{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.URL = that.GetURL()
+	this.Headers = that.GetHeaders()
+	this.Timeout = that.GetTimeout()
+	this.Resources = that.GetResources()
+	this.FailurePolicy = that.GetFailurePolicy()
+	this.CABundle = that.GetCABundle()
+	return this
+}
+
+func (m *AdmissionWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdmissionWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdmissionWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CABundle) > 0 {
+		i -= len(m.CABundle)
+		copy(dAtA[i:], m.CABundle)
+		i = encodeVarintAdmissionWebhook(dAtA, i, uint64(len(m.CABundle)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FailurePolicy) > 0 {
+		i -= len(m.FailurePolicy)
+		copy(dAtA[i:], m.FailurePolicy)
+		i = encodeVarintAdmissionWebhook(dAtA, i, uint64(len(m.FailurePolicy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintAdmissionWebhook(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintAdmissionWebhook(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmissionWebhook(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmissionWebhook(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmissionWebhook(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAdmissionWebhook(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmissionWebhook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmissionWebhook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmissionWebhook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedAdmissionWebhook(r randyAdmissionWebhook, easy bool) *AdmissionWebhook {
+	this := &AdmissionWebhook{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.URL = string(randStringAdmissionWebhook(r))
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.Headers[randStringAdmissionWebhook(r)] = randStringAdmissionWebhook(r)
+		}
+	}
+	this.Timeout = uint32(r.Uint32())
+	v3 := r.Intn(10)
+	this.Resources = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Resources[i] = string(randStringAdmissionWebhook(r))
+	}
+	this.FailurePolicy = string(randStringAdmissionWebhook(r))
+	this.CABundle = string(randStringAdmissionWebhook(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedAdmissionWebhook(r, 8)
+	}
+	return this
+}
+
+type randyAdmissionWebhook interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneAdmissionWebhook(r randyAdmissionWebhook) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringAdmissionWebhook(r randyAdmissionWebhook) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RuneAdmissionWebhook(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedAdmissionWebhook(r randyAdmissionWebhook, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldAdmissionWebhook(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldAdmissionWebhook(dAtA []byte, r randyAdmissionWebhook, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateAdmissionWebhook(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulateAdmissionWebhook(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulateAdmissionWebhook(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateAdmissionWebhook(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateAdmissionWebhook(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateAdmissionWebhook(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateAdmissionWebhook(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *AdmissionWebhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovAdmissionWebhook(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAdmissionWebhook(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmissionWebhook(uint64(len(k))) + 1 + len(v) + sovAdmissionWebhook(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmissionWebhook(uint64(mapEntrySize))
+		}
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovAdmissionWebhook(uint64(m.Timeout))
+	}
+	if len(m.Resources) > 0 {
+		for _, s := range m.Resources {
+			l = len(s)
+			n += 1 + l + sovAdmissionWebhook(uint64(l))
+		}
+	}
+	l = len(m.FailurePolicy)
+	if l > 0 {
+		n += 1 + l + sovAdmissionWebhook(uint64(l))
+	}
+	l = len(m.CABundle)
+	if l > 0 {
+		n += 1 + l + sovAdmissionWebhook(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmissionWebhook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmissionWebhook(x uint64) (n int) {
+	return sovAdmissionWebhook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AdmissionWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmissionWebhook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdmissionWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdmissionWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmissionWebhook
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmissionWebhook
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmissionWebhook
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmissionWebhook
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmissionWebhook
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmissionWebhook
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmissionWebhook
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmissionWebhook(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdmissionWebhook
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailurePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CABundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmissionWebhook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmissionWebhook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmissionWebhook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmissionWebhook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmissionWebhook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmissionWebhook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmissionWebhook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmissionWebhook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmissionWebhook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmissionWebhook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmissionWebhook = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureAdmissionWebhook(t *testing.T) {
+	fixture := FixtureAdmissionWebhook("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+}
+
+func TestAdmissionWebhookValidate(t *testing.T) {
+	var w AdmissionWebhook
+
+	// Invalid name
+	assert.Error(t, w.Validate())
+	w.Name = "foo"
+
+	// Invalid url
+	assert.Error(t, w.Validate())
+	w.URL = "http://admission.example.com"
+	assert.Error(t, w.Validate())
+	w.URL = "https://admission.example.com/admit"
+
+	// Invalid failure policy
+	w.FailurePolicy = "foo"
+	assert.Error(t, w.Validate())
+	w.FailurePolicy = AdmissionFailurePolicyIgnore
+
+	// Invalid namespace
+	w.Namespace = "default"
+	assert.Error(t, w.Validate())
+	w.Namespace = ""
+
+	// Valid admission webhook
+	assert.NoError(t, w.Validate())
+}
+
+func TestAdmissionWebhookHandles(t *testing.T) {
+	var w AdmissionWebhook
+	assert.True(t, w.Handles("checks"))
+
+	w.Resources = []string{"checks", "handlers"}
+	assert.True(t, w.Handles("checks"))
+	assert.False(t, w.Handles("assets"))
+
+	w.Resources = []string{ResourceAll}
+	assert.True(t, w.Handles("assets"))
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/google/uuid"
+	stringsutil "github.com/sensu/sensu-go/util/strings"
 )
 
 const (
@@ -38,9 +40,30 @@ func (a *APIKey) Validate() error {
 		return fmt.Errorf("api key name: %s", err)
 	}
 
+	if a.ExpiresAt < 0 {
+		return fmt.Errorf("api key expiration can't be negative")
+	}
+
+	for _, namespace := range a.Namespaces {
+		if err := ValidateName(namespace); err != nil {
+			return fmt.Errorf("api key namespace %q: %s", namespace, err)
+		}
+	}
+
+	for _, verb := range a.Verbs {
+		if !stringsutil.InArray(verb, allowedVerbs) {
+			return fmt.Errorf("api key verb %q is not valid", verb)
+		}
+	}
+
 	return nil
 }
 
+// Expired returns whether the API key is expired at the given time.
+func (a *APIKey) Expired(now time.Time) bool {
+	return a.ExpiresAt > 0 && now.Unix() >= a.ExpiresAt
+}
+
 // FixtureAPIKey returns a testing fixture for an APIKey struct.
 func FixtureAPIKey(name string, username string) *APIKey {
 	return &APIKey{
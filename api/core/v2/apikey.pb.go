@@ -32,7 +32,19 @@ type APIKey struct {
 	// Username is the username associated with the API key.
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// CreatedAt is a timestamp which the API key was created.
-	CreatedAt            int64    `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ExpiresAt is a timestamp after which the API key can't be used anymore,
+	// or 0 if the API key does not expire.
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Namespaces restricts the requests of the API key to these namespaces. The
+	// requests are not restricted to namespaces if empty.
+	Namespaces []string `protobuf:"bytes,5,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Verbs restricts the requests of the API key to these verbs. The requests
+	// are not restricted to verbs if empty.
+	Verbs []string `protobuf:"bytes,6,rep,name=verbs,proto3" json:"verbs,omitempty"`
+	// LastUsedAt is a timestamp at which the API key was last used, with a
+	// precision of a minute, or 0 if the API key was never used.
+	LastUsedAt           int64    `protobuf:"varint,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -78,24 +90,31 @@ func init() {
 func init() { proto.RegisterFile("apikey.proto", fileDescriptor_c99fd356877382bd) }
 
 var fileDescriptor_c99fd356877382bd = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0x2c, 0xc8, 0xcc,
-	0x4e, 0xad, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2d, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5,
-	0x4b, 0xce, 0x2f, 0x4a, 0xd5, 0x2b, 0x33, 0x92, 0x32, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x4a, 0x2a, 0x4d, 0x73, 0x28,
-	0x33, 0xd4, 0x33, 0xd6, 0x33, 0x04, 0x0b, 0x82, 0xc5, 0xc0, 0x2c, 0x88, 0x21, 0x52, 0x5c, 0xb9,
-	0xa9, 0x25, 0x89, 0x10, 0xb6, 0xd2, 0x12, 0x46, 0x2e, 0x36, 0xc7, 0x00, 0x4f, 0xef, 0xd4, 0x4a,
-	0xa1, 0x50, 0x2e, 0x0e, 0x90, 0x44, 0x4a, 0x62, 0x49, 0xa2, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0xa4, 0x1e, 0x8a, 0x75, 0x7a, 0xfe, 0x49, 0x59, 0xa9, 0xc9, 0x25, 0xbe, 0xa9, 0x25, 0x89,
-	0x4e, 0x72, 0x27, 0xee, 0xc9, 0x33, 0x5c, 0xb8, 0x27, 0xcf, 0xf8, 0xea, 0x9e, 0xbc, 0x10, 0x4c,
-	0x9b, 0x4e, 0x7e, 0x6e, 0x66, 0x49, 0x6a, 0x6e, 0x41, 0x49, 0x65, 0x10, 0xdc, 0x28, 0x21, 0x29,
-	0x2e, 0x8e, 0xd2, 0xe2, 0xd4, 0xa2, 0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce,
-	0x20, 0x38, 0x5f, 0x48, 0x96, 0x8b, 0x2b, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0x35, 0x25, 0x3e, 0xb1,
-	0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0x39, 0x88, 0x13, 0x2a, 0xe2, 0x58, 0x62, 0xc5, 0xd1, 0xb1,
-	0x40, 0x9e, 0x61, 0xc5, 0x02, 0x79, 0x46, 0x27, 0x85, 0x1f, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92,
-	0x63, 0xdc, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf1, 0x58, 0x8e, 0x21, 0x8a, 0xa9, 0xcc, 0x28, 0x89, 0x0d, 0xec, 0x1f, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xd4, 0xa7, 0xdc, 0x30, 0x01, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0xaa, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0x8d, 0xad, 0xcd, 0x58, 0x11, 0x46, 0x91, 0x18, 0x70, 0x26, 0xb8, 0x8a, 0x20,
+	0x53, 0xda, 0x0a, 0x8a, 0xb8, 0xb0, 0xdd, 0x89, 0x88, 0x52, 0xe8, 0xc6, 0x4d, 0x99, 0xa4, 0xc7,
+	0x1a, 0x35, 0x4d, 0xc8, 0x4c, 0x82, 0xdd, 0xb9, 0xf4, 0x11, 0x5c, 0x76, 0xd9, 0x47, 0xf0, 0x11,
+	0xba, 0xec, 0x13, 0x04, 0x6f, 0xee, 0x2e, 0x4f, 0x70, 0x97, 0x97, 0x4c, 0x7a, 0xdb, 0xdc, 0xdd,
+	0xc9, 0xef, 0xfc, 0x3f, 0x4e, 0x18, 0xdc, 0x17, 0x71, 0xf0, 0x03, 0x36, 0x3c, 0x4e, 0x22, 0x15,
+	0x91, 0xfb, 0x12, 0xd6, 0x32, 0xe5, 0x7e, 0x94, 0x00, 0xcf, 0x46, 0xf6, 0xcb, 0x55, 0xa0, 0xbe,
+	0xa5, 0x1e, 0xf7, 0xa3, 0x70, 0xb0, 0x8a, 0x56, 0xd1, 0x40, 0xab, 0xbc, 0xf4, 0xeb, 0xbb, 0x6c,
+	0xc8, 0xc7, 0x7c, 0xa8, 0xa1, 0x66, 0x7a, 0xaa, 0x43, 0x6c, 0x1c, 0x82, 0x12, 0xf5, 0xfc, 0xec,
+	0xb7, 0x81, 0xbb, 0x93, 0xcf, 0xef, 0x3f, 0xc0, 0x86, 0xcc, 0x71, 0xaf, 0x5a, 0x2c, 0x85, 0x12,
+	0x16, 0x72, 0x90, 0x7b, 0x6f, 0xf4, 0x84, 0xdf, 0xaa, 0xe3, 0x9f, 0xbc, 0xef, 0xe0, 0xab, 0x8f,
+	0xa0, 0xc4, 0x94, 0xee, 0x73, 0xd6, 0x3a, 0xe4, 0x0c, 0x95, 0x39, 0x23, 0x37, 0xb6, 0x17, 0x51,
+	0x18, 0x28, 0x08, 0x63, 0xb5, 0x99, 0x9d, 0xa2, 0x88, 0x8d, 0x7b, 0xa9, 0x84, 0x64, 0x2d, 0x42,
+	0xb0, 0xda, 0x0e, 0x72, 0xcd, 0xd9, 0xe9, 0x9b, 0x3c, 0xc5, 0xd8, 0x4f, 0x40, 0x28, 0x58, 0x2e,
+	0x84, 0xb2, 0x0c, 0x07, 0xb9, 0xc6, 0xcc, 0x3c, 0x92, 0x89, 0x22, 0xaf, 0x30, 0x86, 0x5f, 0x71,
+	0x90, 0x80, 0xac, 0xd6, 0x77, 0xaa, 0xf5, 0xd4, 0x2a, 0x73, 0xf6, 0xe8, 0x4c, 0x1b, 0x95, 0xe6,
+	0x91, 0x4e, 0x14, 0x79, 0x8d, 0x71, 0x95, 0x2f, 0x63, 0xe1, 0x83, 0xb4, 0x3a, 0x8e, 0xe1, 0x9a,
+	0xb5, 0xf1, 0x4c, 0x1b, 0xc6, 0x86, 0x96, 0x3c, 0xc7, 0x9d, 0x0c, 0x12, 0x4f, 0x5a, 0x5d, 0x6d,
+	0x7a, 0x58, 0xe6, 0xec, 0x81, 0x06, 0x0d, 0x7d, 0xad, 0x20, 0x6f, 0x71, 0xff, 0xa7, 0x90, 0x6a,
+	0x91, 0xca, 0xfa, 0xfc, 0xbb, 0xfa, 0x3e, 0xbb, 0xcc, 0xd9, 0xe3, 0x26, 0x6f, 0x16, 0x55, 0x7c,
+	0x2e, 0xab, 0x7f, 0x7b, 0xd3, 0xfb, 0xb3, 0x65, 0xad, 0xdd, 0x96, 0xa1, 0xa9, 0x73, 0x75, 0x41,
+	0xd1, 0xae, 0xa0, 0xe8, 0x5f, 0x41, 0xd1, 0xbe, 0xa0, 0xe8, 0x50, 0x50, 0xf4, 0xbf, 0xa0, 0xe8,
+	0xef, 0x25, 0x6d, 0x7d, 0x69, 0x67, 0x23, 0xaf, 0xab, 0xdf, 0x6a, 0x7c, 0x1d, 0x00, 0x00, 0xff,
+	0xff, 0xd3, 0x2d, 0x5f, 0x10, 0x0c, 0x02, 0x00, 0x00,
 }
 
 func (this *APIKey) Equal(that interface{}) bool {
@@ -126,6 +145,28 @@ func (this *APIKey) Equal(that interface{}) bool {
 	if this.CreatedAt != that1.CreatedAt {
 		return false
 	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if len(this.Verbs) != len(that1.Verbs) {
+		return false
+	}
+	for i := range this.Verbs {
+		if this.Verbs[i] != that1.Verbs[i] {
+			return false
+		}
+	}
+	if this.LastUsedAt != that1.LastUsedAt {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -137,6 +178,10 @@ type APIKeyFace interface {
 	GetObjectMeta() ObjectMeta
 	GetUsername() string
 	GetCreatedAt() int64
+	GetExpiresAt() int64
+	GetNamespaces() []string
+	GetVerbs() []string
+	GetLastUsedAt() int64
 }
 
 func (this *APIKey) Proto() github_com_golang_protobuf_proto.Message {
@@ -159,11 +204,31 @@ func (this *APIKey) GetCreatedAt() int64 {
 	return this.CreatedAt
 }
 
+func (this *APIKey) GetExpiresAt() int64 {
+	return this.ExpiresAt
+}
+
+func (this *APIKey) GetNamespaces() []string {
+	return this.Namespaces
+}
+
+func (this *APIKey) GetVerbs() []string {
+	return this.Verbs
+}
+
+func (this *APIKey) GetLastUsedAt() int64 {
+	return this.LastUsedAt
+}
+
 func NewAPIKeyFromFace(that APIKeyFace) *APIKey {
 	this := &APIKey{}
 	this.ObjectMeta = that.GetObjectMeta()
 	this.Username = that.GetUsername()
 	this.CreatedAt = that.GetCreatedAt()
+	this.ExpiresAt = that.GetExpiresAt()
+	this.Namespaces = that.GetNamespaces()
+	this.Verbs = that.GetVerbs()
+	this.LastUsedAt = that.GetLastUsedAt()
 	return this
 }
 
@@ -191,6 +256,34 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LastUsedAt != 0 {
+		i = encodeVarintApikey(dAtA, i, uint64(m.LastUsedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Verbs) > 0 {
+		for iNdEx := len(m.Verbs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verbs[iNdEx])
+			copy(dAtA[i:], m.Verbs[iNdEx])
+			i = encodeVarintApikey(dAtA, i, uint64(len(m.Verbs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintApikey(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintApikey(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintApikey(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -236,8 +329,26 @@ func NewPopulatedAPIKey(r randyApikey, easy bool) *APIKey {
 	if r.Intn(2) == 0 {
 		this.CreatedAt *= -1
 	}
+	this.ExpiresAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ExpiresAt *= -1
+	}
+	v2 := r.Intn(10)
+	this.Namespaces = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Namespaces[i] = string(randStringApikey(r))
+	}
+	v3 := r.Intn(10)
+	this.Verbs = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Verbs[i] = string(randStringApikey(r))
+	}
+	this.LastUsedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LastUsedAt *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedApikey(r, 4)
+		this.XXX_unrecognized = randUnrecognizedApikey(r, 8)
 	}
 	return this
 }
@@ -261,9 +372,9 @@ func randUTF8RuneApikey(r randyApikey) rune {
 	return rune(ru + 61)
 }
 func randStringApikey(r randyApikey) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
 		tmps[i] = randUTF8RuneApikey(r)
 	}
 	return string(tmps)
@@ -285,11 +396,11 @@ func randFieldApikey(dAtA []byte, r randyApikey, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApikey(dAtA, uint64(key))
-		v3 := r.Int63()
+		v5 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v5 *= -1
 		}
-		dAtA = encodeVarintPopulateApikey(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateApikey(dAtA, uint64(v5))
 	case 1:
 		dAtA = encodeVarintPopulateApikey(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -329,6 +440,24 @@ func (m *APIKey) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovApikey(uint64(m.CreatedAt))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovApikey(uint64(m.ExpiresAt))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
+	if len(m.Verbs) > 0 {
+		for _, s := range m.Verbs {
+			l = len(s)
+			n += 1 + l + sovApikey(uint64(l))
+		}
+	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovApikey(uint64(m.LastUsedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -454,6 +583,108 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verbs = append(m.Verbs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApikey(dAtA[iNdEx:])
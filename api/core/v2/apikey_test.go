@@ -2,6 +2,7 @@ package v2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,3 +40,50 @@ func TestAPIKeyValidate(t *testing.T) {
 	assert.Equal(t, "bar", a.Username)
 	assert.Equal(t, "", a.Namespace)
 }
+
+func TestAPIKeyValidateScope(t *testing.T) {
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+
+	a.ExpiresAt = -1
+	assert.Error(t, a.Validate())
+	a.ExpiresAt = 0
+
+	a.Namespaces = []string{"default", "dev/null"}
+	assert.Error(t, a.Validate())
+	a.Namespaces = []string{"default"}
+
+	a.Verbs = []string{"get", "steal"}
+	assert.Error(t, a.Validate())
+	a.Verbs = []string{"get", "list"}
+
+	assert.NoError(t, a.Validate())
+}
+
+func TestAPIKeyExpired(t *testing.T) {
+	now := time.Now()
+	a := FixtureAPIKey("226f9e06-9d54-45c6-a9f6-4206bfa7ccf6", "bar")
+	assert.False(t, a.Expired(now))
+
+	a.ExpiresAt = now.Add(time.Minute).Unix()
+	assert.False(t, a.Expired(now))
+
+	a.ExpiresAt = now.Unix()
+	assert.True(t, a.Expired(now))
+}
+
+func TestClaimsAllows(t *testing.T) {
+	claims := FixtureClaims("bar", nil)
+	assert.True(t, claims.Allows("", "delete"))
+
+	claims.Namespaces = []string{"default"}
+	assert.True(t, claims.Allows("default", "delete"))
+	assert.False(t, claims.Allows("dev", "delete"))
+	assert.False(t, claims.Allows("", "delete"))
+
+	claims.Verbs = []string{"get", "list"}
+	assert.True(t, claims.Allows("default", "list"))
+	assert.False(t, claims.Allows("default", "delete"))
+
+	claims.Verbs = []string{VerbAll}
+	assert.True(t, claims.Allows("default", "delete"))
+}
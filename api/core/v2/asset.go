@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/url"
 	"path"
 	"regexp"
@@ -15,6 +16,14 @@ import (
 const (
 	// AssetsResource is the name of this resource type
 	AssetsResource = "assets"
+
+	// AssetSignatureGPG is the type of the detached GPG signatures of assets,
+	// armored or binary.
+	AssetSignatureGPG = "gpg"
+
+	// AssetSignatureCosign is the type of the signatures of assets created by
+	// cosign sign-blob, base64 encoded.
+	AssetSignatureCosign = "cosign"
 )
 
 var (
@@ -61,6 +70,10 @@ func (a *Asset) Validate() error {
 			return errors.New("URL cannot be empty")
 		}
 
+		if err := a.Signature.Validate(); err != nil {
+			return err
+		}
+
 		return js.ParseExpressions(a.Filters)
 	}
 	for _, build := range a.Builds {
@@ -86,9 +99,33 @@ func (a *AssetBuild) Validate() error {
 		return errors.New("URL cannot be empty")
 	}
 
+	if err := a.Signature.Validate(); err != nil {
+		return err
+	}
+
 	return js.ParseExpressions(a.Filters)
 }
 
+// Validate returns an error if the signature contains invalid values. A nil
+// signature is valid.
+func (s *AssetSignature) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case AssetSignatureGPG, AssetSignatureCosign:
+	default:
+		return fmt.Errorf("signature type must be %s or %s", AssetSignatureGPG, AssetSignatureCosign)
+	}
+
+	if s.URL == "" {
+		return errors.New("signature URL cannot be empty")
+	}
+
+	return nil
+}
+
 // ValidateAssetName validates that asset's name is valid
 func ValidateAssetName(name string) error {
 	if name == "" {
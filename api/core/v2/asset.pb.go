@@ -41,10 +41,13 @@ type Asset struct {
 	ObjectMeta `protobuf:"bytes,8,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
 	// Headers is a collection of key/value string pairs used as HTTP headers
 	// for asset retrieval.
-	Headers              map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Headers map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Signature references the signature of the asset, verified against the
+	// trusted keys of the agents and backends before the asset is installed.
+	Signature            *AssetSignature `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -92,10 +95,13 @@ type AssetBuild struct {
 	Filters []string `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters"`
 	// Headers is a collection of key/value string pairs used as HTTP headers
 	// for asset retrieval.
-	Headers              map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Headers map[string]string `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Signature references the signature of the asset, verified against the
+	// trusted keys of the agents and backends before the asset is installed.
+	Signature            *AssetSignature `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *AssetBuild) Reset()         { *m = AssetBuild{} }
@@ -131,43 +137,95 @@ func (m *AssetBuild) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AssetBuild proto.InternalMessageInfo
 
+// AssetSignature references the detached signature of an asset archive, which
+// proves its provenance in addition to its SHA-512 checksum.
+type AssetSignature struct {
+	// Type is the type of the signature, either gpg or cosign.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type"`
+	// URL is the location of the signature, retrieved with the headers of the
+	// asset.
+	URL                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssetSignature) Reset()         { *m = AssetSignature{} }
+func (m *AssetSignature) String() string { return proto.CompactTextString(m) }
+func (*AssetSignature) ProtoMessage()    {}
+func (*AssetSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4785e5163229d617, []int{2}
+}
+func (m *AssetSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetSignature.Merge(m, src)
+}
+func (m *AssetSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetSignature proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Asset)(nil), "sensu.core.v2.Asset")
 	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.Asset.HeadersEntry")
 	proto.RegisterType((*AssetBuild)(nil), "sensu.core.v2.AssetBuild")
 	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.AssetBuild.HeadersEntry")
+	proto.RegisterType((*AssetSignature)(nil), "sensu.core.v2.AssetSignature")
 }
 
 func init() { proto.RegisterFile("asset.proto", fileDescriptor_4785e5163229d617) }
 
 var fileDescriptor_4785e5163229d617 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x4f, 0xea, 0xd3, 0x40,
-	0x18, 0xed, 0x34, 0x34, 0x6d, 0xa7, 0x0a, 0x32, 0x88, 0xa4, 0x5d, 0xcc, 0xc4, 0x82, 0xd2, 0x85,
-	0x4c, 0x69, 0xaa, 0x20, 0x05, 0x41, 0x03, 0x42, 0x17, 0x16, 0x61, 0xa0, 0x1b, 0x77, 0x93, 0x76,
-	0xda, 0x46, 0x93, 0xa6, 0x24, 0x93, 0x40, 0x4f, 0xa0, 0x47, 0x70, 0xd9, 0x65, 0x8f, 0xe0, 0x11,
-	0xba, 0xec, 0x09, 0x82, 0xc6, 0x5d, 0x4f, 0x20, 0xb8, 0x91, 0x4c, 0x12, 0xff, 0x51, 0x97, 0xfe,
-	0x56, 0x79, 0xf3, 0xf2, 0xe6, 0xfb, 0xde, 0xfb, 0xbe, 0x81, 0x1d, 0x1e, 0x45, 0x42, 0xd2, 0x5d,
-	0x18, 0xc8, 0x00, 0xdd, 0x8e, 0xc4, 0x36, 0x8a, 0xe9, 0x22, 0x08, 0x05, 0x4d, 0xac, 0xde, 0xe3,
-	0xb5, 0x2b, 0x37, 0xb1, 0x43, 0x17, 0x81, 0x3f, 0x5c, 0x07, 0xeb, 0x60, 0xa8, 0x54, 0x4e, 0xbc,
-	0x7a, 0x9e, 0x8c, 0xe8, 0x98, 0x8e, 0x14, 0xa9, 0x38, 0x85, 0x8a, 0x22, 0x3d, 0xe8, 0x0b, 0xc9,
-	0x0b, 0xdc, 0x7f, 0xaf, 0xc1, 0xc6, 0x8b, 0xbc, 0x01, 0xea, 0x42, 0x2d, 0x0e, 0x3d, 0xa3, 0x6e,
-	0x82, 0x41, 0xdb, 0x6e, 0x66, 0x29, 0xd1, 0xe6, 0xec, 0x15, 0xcb, 0x39, 0x74, 0x0f, 0xea, 0xd1,
-	0x86, 0x3f, 0x19, 0x59, 0x86, 0x96, 0xff, 0x65, 0xe5, 0x09, 0x3d, 0x80, 0xcd, 0x95, 0xeb, 0x49,
-	0x11, 0x46, 0x46, 0xc3, 0xd4, 0x06, 0x6d, 0xbb, 0x73, 0x49, 0x49, 0x45, 0xb1, 0x0a, 0xa0, 0x67,
-	0x50, 0x77, 0x62, 0xd7, 0x5b, 0x46, 0x86, 0x6e, 0x6a, 0x83, 0x8e, 0xd5, 0xa5, 0x7f, 0xa4, 0xa0,
-	0xaa, 0xbf, 0x9d, 0x2b, 0x6c, 0x78, 0x49, 0x49, 0x29, 0x66, 0xe5, 0x17, 0xcd, 0x61, 0x2b, 0x37,
-	0xbc, 0xe4, 0x92, 0x1b, 0x2d, 0x13, 0x5c, 0x29, 0xf0, 0xda, 0x79, 0x2b, 0x16, 0x72, 0x26, 0x24,
-	0xb7, 0xf1, 0x29, 0x25, 0xb5, 0x73, 0x4a, 0xc0, 0x25, 0x25, 0xa8, 0xba, 0xf6, 0x28, 0xf0, 0x5d,
-	0x29, 0xfc, 0x9d, 0xdc, 0xb3, 0x9f, 0xa5, 0xd0, 0x14, 0x36, 0x37, 0x82, 0x2f, 0x73, 0xf3, 0x6d,
-	0x65, 0xeb, 0xfe, 0x35, 0x5b, 0x74, 0x5a, 0x68, 0x5e, 0x6e, 0x65, 0xb8, 0x2f, 0xf2, 0x95, 0xb7,
-	0x58, 0x05, 0x7a, 0x13, 0x78, 0xeb, 0x77, 0x15, 0xba, 0x03, 0xb5, 0x77, 0x62, 0x6f, 0x00, 0x35,
-	0xab, 0x1c, 0xa2, 0xbb, 0xb0, 0x91, 0x70, 0x2f, 0x16, 0xc5, 0x74, 0x59, 0x71, 0x98, 0xd4, 0x9f,
-	0x82, 0x49, 0xeb, 0xc3, 0x81, 0xd4, 0x8e, 0x07, 0x02, 0xfa, 0xdf, 0x01, 0x84, 0xbf, 0x26, 0xf1,
-	0x1f, 0xd7, 0x31, 0xfb, 0x3b, 0xf8, 0xc3, 0x7f, 0xee, 0xe3, 0xc6, 0xd2, 0xdb, 0xe6, 0xb7, 0x2f,
-	0x18, 0x1c, 0x33, 0x0c, 0x3e, 0x65, 0x18, 0x9c, 0x32, 0x0c, 0xce, 0x19, 0x06, 0x9f, 0x33, 0x0c,
-	0x3e, 0x7e, 0xc5, 0xb5, 0x37, 0xf5, 0xc4, 0x72, 0x74, 0xf5, 0x60, 0xc7, 0x3f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x49, 0x21, 0x16, 0x06, 0x10, 0x03, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0xce, 0xc4, 0xe4, 0xef, 0x05, 0x2a, 0x34, 0x20, 0x70, 0x23, 0xf0, 0x98, 0x4a, 0xa0, 0x2c,
+	0xd0, 0x54, 0x49, 0x41, 0x42, 0x91, 0x90, 0xc0, 0x12, 0x52, 0x17, 0x54, 0x48, 0x03, 0xdd, 0x74,
+	0x37, 0x4e, 0xa6, 0x89, 0x21, 0x89, 0x23, 0x7b, 0x1c, 0xc9, 0x37, 0xe0, 0x08, 0x2c, 0xbb, 0xec,
+	0x11, 0x38, 0x42, 0x97, 0xe5, 0x00, 0x8c, 0xc0, 0xec, 0x7c, 0x02, 0x96, 0xc8, 0x63, 0xbb, 0x49,
+	0x50, 0x58, 0xb2, 0xe8, 0xc6, 0x7e, 0xf3, 0xf9, 0x7b, 0xdf, 0xfb, 0xe6, 0x7b, 0x32, 0xb4, 0x79,
+	0x18, 0x0a, 0x49, 0x17, 0x81, 0x2f, 0x7d, 0x7c, 0x2b, 0x14, 0xf3, 0x30, 0xa2, 0x43, 0x3f, 0x10,
+	0x74, 0xd9, 0xef, 0x3c, 0x1b, 0x7b, 0x72, 0x12, 0xb9, 0x74, 0xe8, 0xcf, 0xf6, 0xc7, 0xfe, 0xd8,
+	0xdf, 0xd7, 0x2c, 0x37, 0x3a, 0x7d, 0xb5, 0xec, 0xd1, 0x03, 0xda, 0xd3, 0xa0, 0xc6, 0x74, 0x95,
+	0x8b, 0x74, 0x60, 0x26, 0x24, 0xcf, 0xeb, 0xbd, 0xef, 0x06, 0xd4, 0x5e, 0x67, 0x03, 0xf0, 0x2e,
+	0x18, 0x51, 0x30, 0x35, 0xab, 0x36, 0xea, 0xb6, 0x9c, 0x46, 0xa2, 0x88, 0x71, 0xcc, 0xde, 0xb2,
+	0x0c, 0xc3, 0xf7, 0xa0, 0x1e, 0x4e, 0xf8, 0xf3, 0x5e, 0xdf, 0x34, 0xb2, 0xaf, 0xac, 0x38, 0xe1,
+	0xc7, 0xd0, 0x38, 0xf5, 0xa6, 0x52, 0x04, 0xa1, 0x59, 0xb3, 0x8d, 0x6e, 0xcb, 0x69, 0xa7, 0x8a,
+	0x94, 0x10, 0x2b, 0x0b, 0xfc, 0x12, 0xea, 0x6e, 0xe4, 0x4d, 0x47, 0xa1, 0x59, 0xb7, 0x8d, 0x6e,
+	0xbb, 0xbf, 0x4b, 0x37, 0x6e, 0x41, 0xf5, 0x7c, 0x27, 0x63, 0x38, 0x90, 0x2a, 0x52, 0x90, 0x59,
+	0xf1, 0xc6, 0xc7, 0xd0, 0xcc, 0x0c, 0x8f, 0xb8, 0xe4, 0x66, 0xd3, 0x46, 0x5b, 0x04, 0xde, 0xb9,
+	0x1f, 0xc5, 0x50, 0x1e, 0x09, 0xc9, 0x1d, 0xeb, 0x42, 0x91, 0xca, 0xa5, 0x22, 0x28, 0x55, 0x04,
+	0x97, 0x6d, 0x4f, 0xfd, 0x99, 0x27, 0xc5, 0x6c, 0x21, 0x63, 0x76, 0x25, 0x85, 0x0f, 0xa1, 0x31,
+	0x11, 0x7c, 0x94, 0x99, 0x6f, 0x69, 0x5b, 0x8f, 0xb6, 0xd9, 0xa2, 0x87, 0x39, 0xe7, 0xcd, 0x5c,
+	0x06, 0x71, 0x7e, 0xbf, 0xa2, 0x8b, 0x95, 0x05, 0xfe, 0x00, 0xad, 0xd0, 0x1b, 0xcf, 0xb9, 0x8c,
+	0x02, 0x61, 0x82, 0x76, 0xf8, 0x70, 0x9b, 0xd6, 0xfb, 0x92, 0xe4, 0xdc, 0x4f, 0x15, 0xb9, 0x73,
+	0xd5, 0xb3, 0x66, 0x6f, 0x25, 0xd4, 0x19, 0xc0, 0xcd, 0xf5, 0xd9, 0xf8, 0x36, 0x18, 0x9f, 0x44,
+	0x6c, 0x22, 0xbd, 0x81, 0xac, 0xc4, 0x77, 0xa1, 0xb6, 0xe4, 0xd3, 0x48, 0xe4, 0x3b, 0x63, 0xf9,
+	0x61, 0x50, 0x7d, 0x81, 0x06, 0xcd, 0xcf, 0x67, 0xa4, 0x72, 0x7e, 0x46, 0xd0, 0xde, 0xb7, 0x2a,
+	0xc0, 0x2a, 0xdf, 0xff, 0xb8, 0xe4, 0xa3, 0xbf, 0xe3, 0x7c, 0xf2, 0xcf, 0x2d, 0x5f, 0xf7, 0x4c,
+	0x4f, 0x60, 0x67, 0x73, 0x36, 0x7e, 0x00, 0x37, 0x64, 0xbc, 0x10, 0xb9, 0x90, 0xd3, 0x4c, 0x15,
+	0xd1, 0x67, 0xa6, 0x9f, 0xd8, 0x5e, 0x0f, 0x7d, 0xa7, 0x08, 0x3d, 0x55, 0x24, 0x43, 0x75, 0xf6,
+	0x2b, 0x6d, 0xc7, 0xfe, 0xfd, 0xd3, 0x42, 0xe7, 0x89, 0x85, 0xbe, 0x26, 0x16, 0xba, 0x48, 0x2c,
+	0x74, 0x99, 0x58, 0xe8, 0x47, 0x62, 0xa1, 0x2f, 0xbf, 0xac, 0xca, 0x49, 0x75, 0xd9, 0x77, 0xeb,
+	0xfa, 0xc7, 0x3d, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0x20, 0x58, 0xb8, 0xe1, 0x18, 0x04, 0x00,
+	0x00,
 }
 
 func (this *Asset) Equal(that interface{}) bool {
@@ -222,6 +280,9 @@ func (this *Asset) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Signature.Equal(that1.Signature) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -268,6 +329,39 @@ func (this *AssetBuild) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Signature.Equal(that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AssetSignature) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AssetSignature)
+	if !ok {
+		that2, ok := that.(AssetSignature)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -282,6 +376,7 @@ type AssetFace interface {
 	GetBuilds() []*AssetBuild
 	GetObjectMeta() ObjectMeta
 	GetHeaders() map[string]string
+	GetSignature() *AssetSignature
 }
 
 func (this *Asset) Proto() github_com_golang_protobuf_proto.Message {
@@ -316,6 +411,10 @@ func (this *Asset) GetHeaders() map[string]string {
 	return this.Headers
 }
 
+func (this *Asset) GetSignature() *AssetSignature {
+	return this.Signature
+}
+
 func NewAssetFromFace(that AssetFace) *Asset {
 	this := &Asset{}
 	this.URL = that.GetURL()
@@ -324,6 +423,7 @@ func NewAssetFromFace(that AssetFace) *Asset {
 	this.Builds = that.GetBuilds()
 	this.ObjectMeta = that.GetObjectMeta()
 	this.Headers = that.GetHeaders()
+	this.Signature = that.GetSignature()
 	return this
 }
 
@@ -333,6 +433,7 @@ type AssetBuildFace interface {
 	GetSha512() string
 	GetFilters() []string
 	GetHeaders() map[string]string
+	GetSignature() *AssetSignature
 }
 
 func (this *AssetBuild) Proto() github_com_golang_protobuf_proto.Message {
@@ -359,12 +460,46 @@ func (this *AssetBuild) GetHeaders() map[string]string {
 	return this.Headers
 }
 
+func (this *AssetBuild) GetSignature() *AssetSignature {
+	return this.Signature
+}
+
 func NewAssetBuildFromFace(that AssetBuildFace) *AssetBuild {
 	this := &AssetBuild{}
 	this.URL = that.GetURL()
 	this.Sha512 = that.GetSha512()
 	this.Filters = that.GetFilters()
 	this.Headers = that.GetHeaders()
+	this.Signature = that.GetSignature()
+	return this
+}
+
+type AssetSignatureFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetType() string
+	GetURL() string
+}
+
+func (this *AssetSignature) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *AssetSignature) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewAssetSignatureFromFace(this)
+}
+
+func (this *AssetSignature) GetType() string {
+	return this.Type
+}
+
+func (this *AssetSignature) GetURL() string {
+	return this.URL
+}
+
+func NewAssetSignatureFromFace(that AssetSignatureFace) *AssetSignature {
+	this := &AssetSignature{}
+	this.Type = that.GetType()
+	this.URL = that.GetURL()
 	return this
 }
 
@@ -392,6 +527,18 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Signature != nil {
+		{
+			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAsset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Headers) > 0 {
 		for k := range m.Headers {
 			v := m.Headers[k]
@@ -485,6 +632,18 @@ func (m *AssetBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Signature != nil {
+		{
+			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAsset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Headers) > 0 {
 		for k := range m.Headers {
 			v := m.Headers[k]
@@ -530,6 +689,47 @@ func (m *AssetBuild) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AssetSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintAsset(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAsset(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAsset(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAsset(v)
 	base := offset
@@ -566,8 +766,11 @@ func NewPopulatedAsset(r randyAsset, easy bool) *Asset {
 			this.Headers[randStringAsset(r)] = randStringAsset(r)
 		}
 	}
+	if r.Intn(5) != 0 {
+		this.Signature = NewPopulatedAssetSignature(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedAsset(r, 10)
+		this.XXX_unrecognized = randUnrecognizedAsset(r, 11)
 	}
 	return this
 }
@@ -588,8 +791,21 @@ func NewPopulatedAssetBuild(r randyAsset, easy bool) *AssetBuild {
 			this.Headers[randStringAsset(r)] = randStringAsset(r)
 		}
 	}
+	if r.Intn(5) != 0 {
+		this.Signature = NewPopulatedAssetSignature(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedAsset(r, 10)
+		this.XXX_unrecognized = randUnrecognizedAsset(r, 11)
+	}
+	return this
+}
+
+func NewPopulatedAssetSignature(r randyAsset, easy bool) *AssetSignature {
+	this := &AssetSignature{}
+	this.Type = string(randStringAsset(r))
+	this.URL = string(randStringAsset(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedAsset(r, 3)
 	}
 	return this
 }
@@ -702,6 +918,10 @@ func (m *Asset) Size() (n int) {
 			n += mapEntrySize + 1 + sovAsset(uint64(mapEntrySize))
 		}
 	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovAsset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -736,6 +956,30 @@ func (m *AssetBuild) Size() (n int) {
 			n += mapEntrySize + 1 + sovAsset(uint64(mapEntrySize))
 		}
 	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovAsset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssetSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAsset(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAsset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1067,6 +1311,42 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			}
 			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &AssetSignature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -1344,6 +1624,160 @@ func (m *AssetBuild) Unmarshal(dAtA []byte) error {
 			}
 			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &AssetSignature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAsset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAsset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAsset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -49,6 +49,24 @@ func TestValidator(t *testing.T) {
 	asset.Sha512 = "nope"
 	assert.Error(asset.Validate())
 
+	// Given asset with a valid signature it should pass
+	asset = FixtureAsset("name")
+	asset.Signature = &AssetSignature{Type: AssetSignatureCosign, URL: "https://localhost/asset.sig"}
+	assert.NoError(asset.Validate())
+
+	// Given asset with an unknown signature type it should not pass
+	asset.Signature.Type = "x509"
+	assert.Error(asset.Validate())
+
+	// Given asset build with a signature without URL it should not pass
+	asset = FixtureAsset("name")
+	asset.Builds = []*AssetBuild{{
+		URL:       asset.URL,
+		Sha512:    asset.Sha512,
+		Signature: &AssetSignature{Type: AssetSignatureGPG},
+	}}
+	assert.Error(asset.Validate())
+
 	// Bonsai assets with uppercases should pass
 	asset = FixtureAsset("Username/asset_name:0.0.1")
 	assert.NoError(asset.Validate())
@@ -0,0 +1,21 @@
+package v2
+
+// BulkResult is the outcome of the operation on one of the resources of a
+// bulk request. The results of a bulk request are in the order of its
+// resources.
+type BulkResult struct {
+	// Type and APIVersion identify the type of the resource.
+	Type       string `json:"type,omitempty"`
+	APIVersion string `json:"api_version,omitempty"`
+
+	// Namespace and Name identify the resource.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+
+	// Status is the HTTP status code the operation would have had as a single
+	// request.
+	Status int `json:"status"`
+
+	// Error is the error message of the operation, if it failed.
+	Error string `json:"error,omitempty"`
+}
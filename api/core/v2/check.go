@@ -38,6 +38,10 @@ const (
 	// InfluxDB Line
 	InfluxDBOutputMetricFormat = "influxdb_line"
 
+	// PrometheusOutputMetricFormat is the accepted string to represent the output metric format of
+	// Prometheus Text
+	PrometheusOutputMetricFormat = "prometheus_text"
+
 	// KeepaliveCheckName is the name of the check that is created when a
 	// keepalive timeout occurs.
 	KeepaliveCheckName = "keepalive"
@@ -48,7 +52,7 @@ const (
 )
 
 // OutputMetricFormats represents all the accepted output_metric_format's a check can have
-var OutputMetricFormats = []string{NagiosOutputMetricFormat, GraphiteOutputMetricFormat, OpenTSDBOutputMetricFormat, InfluxDBOutputMetricFormat}
+var OutputMetricFormats = []string{NagiosOutputMetricFormat, GraphiteOutputMetricFormat, OpenTSDBOutputMetricFormat, InfluxDBOutputMetricFormat, PrometheusOutputMetricFormat}
 
 // FixtureCheck returns a fixture for a Check object.
 func FixtureCheck(id string) *Check {
@@ -97,8 +101,12 @@ func NewCheck(c *CheckConfig) *Check {
 		CheckHooks:           c.CheckHooks,
 		Stdin:                c.Stdin,
 		Subdue:               c.Subdue,
+		Blackouts:            c.Blackouts,
 		Cron:                 c.Cron,
+		CronTimezone:         c.CronTimezone,
+		AgentSplay:           c.AgentSplay,
 		Ttl:                  c.Ttl,
+		AutoResolveAfter:     c.AutoResolveAfter,
 		Timeout:              c.Timeout,
 		ProxyRequests:        c.ProxyRequests,
 		RoundRobin:           c.RoundRobin,
@@ -107,6 +115,9 @@ func NewCheck(c *CheckConfig) *Check {
 		EnvVars:              c.EnvVars,
 		DiscardOutput:        c.DiscardOutput,
 		MaxOutputSize:        c.MaxOutputSize,
+		Executor:             c.Executor,
+		Probe:                c.Probe,
+		Redact:               c.Redact,
 	}
 	if check.Labels == nil {
 		check.Labels = make(map[string]string)
@@ -117,6 +128,12 @@ func NewCheck(c *CheckConfig) *Check {
 	return check
 }
 
+// CronSchedule returns the cron schedule of the check, qualified with its cron
+// timezone if any, as understood by cron.ParseStandard.
+func (c *Check) CronSchedule() string {
+	return cronSchedule(c.Cron, c.CronTimezone)
+}
+
 // SetNamespace sets the namespace of the resource.
 func (c *Check) SetNamespace(namespace string) {
 	c.Namespace = namespace
@@ -159,6 +176,10 @@ func (c *Check) Validate() error {
 			if _, err := cron.ParseStandard(c.Cron); err != nil {
 				return errors.New("check cron string is invalid")
 			}
+
+			if err := validateCronTimezone(c.Cron, c.CronTimezone); err != nil {
+				return err
+			}
 		} else {
 			if c.Interval < 1 {
 				return errors.New("check interval must be greater than or equal to 1")
@@ -172,6 +193,12 @@ func (c *Check) Validate() error {
 	if c.Ttl > 0 && c.Ttl < 5 {
 		return errors.New("minimum ttl is 5 seconds")
 	}
+	if err := validateAutoResolveAfter(c.AutoResolveAfter, c.Interval); err != nil {
+		return err
+	}
+	if err := validateCheckExecutor(c.Executor, c.Probe, c.ProxyEntityName, c.ProxyRequests, c.RoundRobin); err != nil {
+		return err
+	}
 
 	for _, assetName := range c.RuntimeAssets {
 		if err := ValidateAssetName(assetName); err != nil {
@@ -217,6 +244,10 @@ func (c *Check) Validate() error {
 		return fmt.Errorf("MaxOutputSize must be >= 0")
 	}
 
+	if err := validateBlackouts(c.Blackouts); err != nil {
+		return err
+	}
+
 	return c.Subdue.Validate()
 }
 
@@ -39,7 +39,11 @@ type CheckRequest struct {
 	// HookAssets is a map of assets required to execute hooks.
 	HookAssets map[string]*AssetList `protobuf:"bytes,5,rep,name=hook_assets,json=hookAssets,proto3" json:"hook_assets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Secrets is a list of kv to be added to the env vars of a check.
-	Secrets              []string `protobuf:"bytes,6,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Secrets []string `protobuf:"bytes,6,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Splay is the window, in seconds, across which the agents spread the
+	// execution of the check, each from an offset derived from its entity
+	// name. The check is executed immediately if zero.
+	Splay                uint32   `protobuf:"varint,7,opt,name=splay,proto3" json:"splay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -120,6 +124,13 @@ func (m *CheckRequest) GetSecrets() []string {
 	return nil
 }
 
+func (m *CheckRequest) GetSplay() uint32 {
+	if m != nil {
+		return m.Splay
+	}
+	return 0
+}
+
 // An AssetList represents a list of assets for a CheckRequest.
 type AssetList struct {
 	// Assets are a list of assets required to execute check or hook.
@@ -239,6 +250,135 @@ func (m *ProxyRequests) GetSplayCoverage() uint32 {
 	return 0
 }
 
+// A CheckBlackout is a recurring period during which a check is not
+// scheduled.
+type CheckBlackout struct {
+	// Cron is the cron string at which the blackout period begins.
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Duration is the length of the blackout period, in seconds.
+	Duration             uint32   `protobuf:"varint,2,opt,name=duration,proto3" json:"duration"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckBlackout) Reset()         { *m = CheckBlackout{} }
+func (m *CheckBlackout) String() string { return proto.CompactTextString(m) }
+func (*CheckBlackout) ProtoMessage()    {}
+func (*CheckBlackout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8d3c606fb107336, []int{3}
+}
+func (m *CheckBlackout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckBlackout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckBlackout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckBlackout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckBlackout.Merge(m, src)
+}
+func (m *CheckBlackout) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckBlackout) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckBlackout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckBlackout proto.InternalMessageInfo
+
+func (m *CheckBlackout) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *CheckBlackout) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// A CheckProbe is a probe performed by the backend against the proxy entities
+// of a check executed by the backend.
+type CheckProbe struct {
+	// Type is the type of the probe, one of "http", "tcp" or "icmp".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Target is the URL for an HTTP probe, the host:port address for a TCP
+	// probe, or the host for an ICMP probe.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// ExpectedStatus is the HTTP status code expected from an HTTP probe. Any
+	// status lower than 400 is expected if not set.
+	ExpectedStatus       uint32   `protobuf:"varint,3,opt,name=expected_status,json=expectedStatus,proto3" json:"expected_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckProbe) Reset()         { *m = CheckProbe{} }
+func (m *CheckProbe) String() string { return proto.CompactTextString(m) }
+func (*CheckProbe) ProtoMessage()    {}
+func (*CheckProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8d3c606fb107336, []int{4}
+}
+func (m *CheckProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckProbe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckProbe.Merge(m, src)
+}
+func (m *CheckProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckProbe proto.InternalMessageInfo
+
+func (m *CheckProbe) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CheckProbe) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CheckProbe) GetExpectedStatus() uint32 {
+	if m != nil {
+		return m.ExpectedStatus
+	}
+	return 0
+}
+
 // CheckConfig is the specification of a check.
 type CheckConfig struct {
 	// Command is the command to be executed.
@@ -305,17 +445,40 @@ type CheckConfig struct {
 	DiscardOutput bool `protobuf:"varint,28,opt,name=discard_output,json=discardOutput,proto3" json:"discard_output,omitempty"`
 	// Secrets is the list of Sensu secrets to set for the check's
 	// execution environment.
-	Secrets              []*Secret `protobuf:"bytes,29,rep,name=secrets,proto3" json:"secrets"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Secrets []*Secret `protobuf:"bytes,29,rep,name=secrets,proto3" json:"secrets"`
+	// CronTimezone is the IANA time zone name in which the cron schedule of
+	// the check is evaluated, instead of the local time of the backend.
+	CronTimezone string `protobuf:"bytes,30,opt,name=cron_timezone,json=cronTimezone,proto3" json:"cron_timezone,omitempty"`
+	// AgentSplay spreads the executions of an interval check across the
+	// agents, so that they do not all execute it at the same time.
+	AgentSplay bool `protobuf:"varint,31,opt,name=agent_splay,json=agentSplay,proto3" json:"agent_splay"`
+	// AutoResolveAfter is the length of time in seconds after which a failing
+	// event of the check is automatically resolved if no new check result is
+	// received.
+	AutoResolveAfter int64 `protobuf:"varint,32,opt,name=auto_resolve_after,json=autoResolveAfter,proto3" json:"auto_resolve_after,omitempty"`
+	// Blackouts are the recurring periods during which the check is not
+	// scheduled at all.
+	Blackouts []*CheckBlackout `protobuf:"bytes,33,rep,name=blackouts,proto3" json:"blackouts,omitempty"`
+	// Executor is what executes the check, either "agent" (the default) or
+	// "backend".
+	Executor string `protobuf:"bytes,34,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Probe is the probe performed by the backend when the check is executed
+	// by the backend.
+	Probe *CheckProbe `protobuf:"bytes,35,opt,name=probe,proto3" json:"probe,omitempty"`
+	// Redact contains the keys of the environment variables, and of the labels
+	// and annotations of the entity, whose values are masked in the output of
+	// the check, in addition to the redact fields of the entity.
+	Redact               []string `protobuf:"bytes,36,rep,name=redact,proto3" json:"redact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CheckConfig) Reset()         { *m = CheckConfig{} }
 func (m *CheckConfig) String() string { return proto.CompactTextString(m) }
 func (*CheckConfig) ProtoMessage()    {}
 func (*CheckConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8d3c606fb107336, []int{3}
+	return fileDescriptor_d8d3c606fb107336, []int{5}
 }
 func (m *CheckConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,6 +604,33 @@ type Check struct {
 	// Secrets is the list of Sensu secrets to set for the check's
 	// execution environment.
 	Secrets []*Secret `protobuf:"bytes,41,rep,name=secrets,proto3" json:"secrets"`
+	// CronTimezone is the IANA time zone name in which the cron schedule of
+	// the check is evaluated, instead of the local time of the backend.
+	CronTimezone string `protobuf:"bytes,42,opt,name=cron_timezone,json=cronTimezone,proto3" json:"cron_timezone,omitempty"`
+	// AgentSplay spreads the executions of an interval check across the
+	// agents, so that they do not all execute it at the same time.
+	AgentSplay bool `protobuf:"varint,43,opt,name=agent_splay,json=agentSplay,proto3" json:"agent_splay"`
+	// Maintenance is the list of maintenance window names that were active
+	// when the check result was received, preventing the event from being
+	// handled.
+	Maintenance []string `protobuf:"bytes,44,rep,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// AutoResolveAfter is the length of time in seconds after which a failing
+	// event of the check is automatically resolved if no new check result is
+	// received.
+	AutoResolveAfter int64 `protobuf:"varint,45,opt,name=auto_resolve_after,json=autoResolveAfter,proto3" json:"auto_resolve_after,omitempty"`
+	// Blackouts are the recurring periods during which the check is not
+	// scheduled at all.
+	Blackouts []*CheckBlackout `protobuf:"bytes,46,rep,name=blackouts,proto3" json:"blackouts,omitempty"`
+	// Executor is what executes the check, either "agent" (the default) or
+	// "backend".
+	Executor string `protobuf:"bytes,47,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Probe is the probe performed by the backend when the check is executed
+	// by the backend.
+	Probe *CheckProbe `protobuf:"bytes,48,opt,name=probe,proto3" json:"probe,omitempty"`
+	// Redact contains the keys of the environment variables, and of the labels
+	// and annotations of the entity, whose values are masked in the output of
+	// the check, in addition to the redact fields of the entity.
+	Redact []string `protobuf:"bytes,49,rep,name=redact,proto3" json:"redact,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
 	ExtendedAttributes   []byte   `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3" json:"-"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -452,7 +642,7 @@ func (m *Check) Reset()         { *m = Check{} }
 func (m *Check) String() string { return proto.CompactTextString(m) }
 func (*Check) ProtoMessage()    {}
 func (*Check) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8d3c606fb107336, []int{4}
+	return fileDescriptor_d8d3c606fb107336, []int{6}
 }
 func (m *Check) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +691,7 @@ func (m *CheckHistory) Reset()         { *m = CheckHistory{} }
 func (m *CheckHistory) String() string { return proto.CompactTextString(m) }
 func (*CheckHistory) ProtoMessage()    {}
 func (*CheckHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8d3c606fb107336, []int{5}
+	return fileDescriptor_d8d3c606fb107336, []int{7}
 }
 func (m *CheckHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,6 +746,8 @@ func init() {
 	proto.RegisterMapType((map[string]*AssetList)(nil), "sensu.core.v2.CheckRequest.HookAssetsEntry")
 	proto.RegisterType((*AssetList)(nil), "sensu.core.v2.AssetList")
 	proto.RegisterType((*ProxyRequests)(nil), "sensu.core.v2.ProxyRequests")
+	proto.RegisterType((*CheckBlackout)(nil), "sensu.core.v2.CheckBlackout")
+	proto.RegisterType((*CheckProbe)(nil), "sensu.core.v2.CheckProbe")
 	proto.RegisterType((*CheckConfig)(nil), "sensu.core.v2.CheckConfig")
 	proto.RegisterType((*Check)(nil), "sensu.core.v2.Check")
 	proto.RegisterType((*CheckHistory)(nil), "sensu.core.v2.CheckHistory")
@@ -564,100 +756,120 @@ func init() {
 func init() { proto.RegisterFile("check.proto", fileDescriptor_d8d3c606fb107336) }
 
 var fileDescriptor_d8d3c606fb107336 = []byte{
-	// 1480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x73, 0x13, 0x47,
-	0x16, 0xf7, 0x58, 0x58, 0x96, 0x5a, 0x96, 0x65, 0xb7, 0x6d, 0xdc, 0x16, 0xa0, 0x11, 0xde, 0x05,
-	0xb4, 0xb5, 0xbb, 0x62, 0x31, 0x4b, 0x2d, 0x4b, 0x71, 0x58, 0xe4, 0x85, 0x98, 0x04, 0x30, 0xd5,
-	0x90, 0xb8, 0x2a, 0x55, 0xa9, 0xa9, 0xd6, 0xa8, 0x2d, 0x4d, 0x2c, 0x4d, 0x2b, 0xd3, 0x3d, 0xb2,
-	0xcd, 0x25, 0xd7, 0x7c, 0x84, 0x1c, 0x39, 0x92, 0x53, 0xae, 0xb9, 0xe4, 0xce, 0x91, 0x4f, 0x30,
-	0x95, 0x38, 0x87, 0x54, 0xe9, 0x13, 0xe4, 0x98, 0xea, 0x37, 0x3d, 0xb2, 0x24, 0xcb, 0x40, 0xaa,
-	0x48, 0x55, 0x2a, 0xc5, 0xc5, 0xfd, 0xde, 0xaf, 0xdf, 0xeb, 0x3f, 0xaf, 0xdf, 0xfb, 0xbd, 0x91,
-	0x51, 0xce, 0x6d, 0x71, 0x77, 0xaf, 0xda, 0x0d, 0x84, 0x12, 0x38, 0x2f, 0xb9, 0x2f, 0xc3, 0xaa,
-	0x2b, 0x02, 0x5e, 0xed, 0x6d, 0x14, 0xff, 0xdd, 0xf4, 0x54, 0x2b, 0xac, 0x57, 0x5d, 0xd1, 0xb9,
-	0xda, 0x14, 0x4d, 0x71, 0x15, 0xac, 0xea, 0xe1, 0xee, 0xff, 0x7a, 0xd7, 0xaa, 0xd7, 0xab, 0xd7,
-	0x00, 0x04, 0x0c, 0xa4, 0x78, 0x91, 0x62, 0x8e, 0x49, 0xc9, 0x95, 0x51, 0x50, 0x4b, 0x88, 0xbd,
-	0x44, 0xee, 0x70, 0xc5, 0x8c, 0xbc, 0xa8, 0xbc, 0x0e, 0x77, 0xf6, 0x3d, 0xbf, 0x21, 0xf6, 0x0d,
-	0x34, 0x27, 0xb9, 0x1b, 0x24, 0x8e, 0xeb, 0xdf, 0xa4, 0xd0, 0xdc, 0xa6, 0x3e, 0x1a, 0xe5, 0x5f,
-	0x84, 0x5c, 0x2a, 0x7c, 0x13, 0xa5, 0x5d, 0xe1, 0xef, 0x7a, 0x4d, 0x62, 0x95, 0xad, 0x4a, 0x6e,
-	0xa3, 0x58, 0x1d, 0x39, 0x6c, 0x15, 0x8c, 0x37, 0xc1, 0xa2, 0x76, 0xe6, 0x65, 0x64, 0x5b, 0xd4,
-	0xd8, 0xe3, 0x0d, 0x94, 0x86, 0x23, 0x49, 0x32, 0x5d, 0x4e, 0x55, 0x72, 0x1b, 0xcb, 0x63, 0x9e,
-	0x77, 0xf4, 0x24, 0xf8, 0x4c, 0x51, 0x63, 0x89, 0x6f, 0xa0, 0x19, 0x7d, 0x72, 0x49, 0x52, 0xe0,
-	0xb2, 0x36, 0xe6, 0xb2, 0x25, 0xc4, 0xf0, 0x5e, 0x53, 0x34, 0xb6, 0xc6, 0xeb, 0x28, 0x7d, 0x5f,
-	0xca, 0x90, 0x37, 0xc8, 0x99, 0xb2, 0x55, 0x49, 0xd5, 0x50, 0x3f, 0xb2, 0xd3, 0x1e, 0x20, 0xd4,
-	0xcc, 0xe0, 0xcf, 0x50, 0x4e, 0x1b, 0x3b, 0xe6, 0x4c, 0x33, 0xb0, 0xc1, 0xdf, 0x27, 0xdd, 0xc6,
-	0x5c, 0x1d, 0x76, 0x83, 0x43, 0xca, 0xbb, 0xbe, 0x0a, 0x0e, 0x6b, 0x85, 0x7e, 0x64, 0x0f, 0xaf,
-	0x41, 0x21, 0xca, 0xb1, 0x05, 0x26, 0x68, 0x36, 0x0e, 0xa4, 0x24, 0xe9, 0x72, 0xaa, 0x92, 0xa5,
-	0x89, 0x5a, 0xdc, 0x41, 0x85, 0xb1, 0x95, 0xf0, 0x02, 0x4a, 0xed, 0xf1, 0x43, 0x88, 0x68, 0x96,
-	0x6a, 0x11, 0x57, 0xd1, 0x4c, 0x8f, 0xb5, 0x43, 0x4e, 0xa6, 0x21, 0xca, 0x64, 0x52, 0xac, 0x1e,
-	0x78, 0x52, 0xd1, 0xd8, 0xec, 0xd6, 0xf4, 0x4d, 0x6b, 0xfd, 0x3e, 0xca, 0x0e, 0x70, 0x7c, 0x7b,
-	0x10, 0x6d, 0xeb, 0x35, 0xd1, 0x9e, 0xd7, 0x51, 0xd3, 0xc1, 0x31, 0x37, 0x30, 0xe3, 0xfa, 0xb7,
-	0x16, 0xca, 0x3f, 0x0e, 0xc4, 0xc1, 0xa1, 0xb9, 0xbb, 0xc4, 0x35, 0xb4, 0xc8, 0x7d, 0xe5, 0xa9,
-	0x43, 0x87, 0x29, 0x15, 0x78, 0xf5, 0x50, 0xf1, 0x78, 0xe9, 0x6c, 0x6d, 0xa5, 0x1f, 0xd9, 0x27,
-	0x27, 0xe9, 0x42, 0x0c, 0xdd, 0x19, 0x20, 0xd8, 0x46, 0x33, 0xb2, 0xdb, 0x66, 0x87, 0x70, 0xa9,
-	0x4c, 0x2d, 0xdb, 0x8f, 0xec, 0x18, 0xa0, 0xf1, 0x80, 0xff, 0x8b, 0xe6, 0x41, 0x70, 0x5c, 0xd1,
-	0xe3, 0x01, 0x6b, 0x72, 0x92, 0x2a, 0x5b, 0x95, 0x7c, 0x0d, 0xf7, 0x23, 0x7b, 0x6c, 0x86, 0xe6,
-	0x41, 0xdf, 0x34, 0xea, 0xfa, 0xcf, 0x08, 0xe5, 0x86, 0x72, 0x4f, 0xc7, 0xdf, 0x15, 0x9d, 0x0e,
-	0xf3, 0x1b, 0x26, 0xac, 0x89, 0x8a, 0x2b, 0x28, 0xd3, 0x62, 0x7e, 0xa3, 0xcd, 0x83, 0x38, 0xad,
-	0xb2, 0xb5, 0xb9, 0x7e, 0x64, 0x0f, 0x30, 0x3a, 0x90, 0xf0, 0x07, 0x68, 0xa9, 0xe5, 0x35, 0x5b,
-	0xce, 0x6e, 0x9b, 0x75, 0x1d, 0xd5, 0x0a, 0xb8, 0x6c, 0x89, 0x76, 0x9c, 0x53, 0xf9, 0xda, 0x6a,
-	0x3f, 0xb2, 0x27, 0x4d, 0xd3, 0x45, 0x0d, 0xde, 0x6b, 0xb3, 0xee, 0xd3, 0x04, 0xd2, 0x5b, 0x7a,
-	0xbe, 0xe2, 0x41, 0x8f, 0xb5, 0xc9, 0x0c, 0x78, 0xc3, 0x96, 0x09, 0x46, 0x07, 0x12, 0xfe, 0x3f,
-	0xc2, 0x6d, 0xb1, 0x3f, 0xbe, 0x63, 0x1a, 0x7c, 0xce, 0xf6, 0x23, 0x7b, 0xc2, 0x2c, 0x5d, 0x68,
-	0x8b, 0xfd, 0xd1, 0xfd, 0x2e, 0xa1, 0xd9, 0x6e, 0x58, 0x6f, 0x7b, 0xb2, 0x45, 0xb2, 0x10, 0xea,
-	0x5c, 0x3f, 0xb2, 0x13, 0x88, 0x26, 0x82, 0x0e, 0x77, 0x10, 0xfa, 0x40, 0x01, 0x26, 0x57, 0x10,
-	0xc4, 0x03, 0xc2, 0x3d, 0x3a, 0x43, 0xf3, 0x46, 0x37, 0xe9, 0xfd, 0x1f, 0x94, 0x97, 0x61, 0x5d,
-	0xba, 0x81, 0xd7, 0x55, 0x9e, 0xf0, 0x25, 0xc9, 0x81, 0xe7, 0x62, 0x3f, 0xb2, 0x47, 0x27, 0xe8,
-	0xa8, 0x8a, 0x6f, 0x20, 0x7c, 0xf7, 0x40, 0x71, 0xbf, 0xc1, 0x1b, 0xc7, 0x99, 0x41, 0xe6, 0xca,
-	0x56, 0x65, 0xae, 0x36, 0xd3, 0x8f, 0x6c, 0xeb, 0x9f, 0x74, 0x82, 0x01, 0x7e, 0x8a, 0x16, 0xbb,
-	0x3a, 0x1f, 0x1d, 0x93, 0x67, 0x3e, 0xeb, 0x70, 0x92, 0xd7, 0x0f, 0x5b, 0xab, 0x1c, 0x45, 0x76,
-	0x01, 0x92, 0xf5, 0x2e, 0xcc, 0x3d, 0x62, 0x1d, 0xae, 0x33, 0xf2, 0x84, 0x3d, 0x2d, 0x74, 0x47,
-	0xad, 0xf0, 0x43, 0xc3, 0xbb, 0x4e, 0x4c, 0x32, 0xf3, 0x50, 0x29, 0xab, 0x13, 0x48, 0x46, 0x97,
-	0x54, 0x6d, 0xc9, 0x14, 0xcb, 0xb0, 0x0f, 0x45, 0xa0, 0x6c, 0x01, 0xed, 0xe8, 0xfc, 0x56, 0x0d,
-	0xcf, 0x27, 0x85, 0xa1, 0xfc, 0xd6, 0x00, 0x8d, 0x07, 0x7c, 0x07, 0xa5, 0x65, 0x58, 0x6f, 0x84,
-	0x9c, 0x2c, 0x40, 0x59, 0x5f, 0x18, 0xdb, 0xea, 0xa9, 0xd7, 0xe1, 0x3b, 0x40, 0xc6, 0x3b, 0x2d,
-	0xee, 0xc7, 0xb4, 0x15, 0x3b, 0x50, 0x33, 0x62, 0x8c, 0xce, 0xb8, 0x81, 0xf0, 0xc9, 0x22, 0x24,
-	0x35, 0xc8, 0x78, 0x0d, 0xa5, 0x94, 0x6a, 0x13, 0x0c, 0x5c, 0x37, 0xdb, 0x8f, 0x6c, 0xad, 0x52,
-	0xfd, 0x47, 0x67, 0x82, 0x7e, 0x35, 0x11, 0x2a, 0xb2, 0x04, 0x49, 0x04, 0x99, 0x60, 0x20, 0x9a,
-	0x08, 0x78, 0x13, 0xcd, 0xc7, 0xe1, 0x0a, 0x4c, 0xbd, 0x93, 0x65, 0x38, 0xe0, 0xf9, 0xb1, 0x03,
-	0x8e, 0x70, 0x02, 0xcd, 0x77, 0x47, 0x28, 0xe2, 0x5f, 0x28, 0x17, 0x88, 0xd0, 0x6f, 0x38, 0x81,
-	0xa8, 0x7b, 0x3e, 0x59, 0x81, 0x20, 0x00, 0x49, 0x0e, 0xc1, 0x14, 0x81, 0x42, 0xb5, 0x8c, 0x3f,
-	0x44, 0xcb, 0x22, 0x54, 0xdd, 0x50, 0x39, 0x1d, 0xae, 0x02, 0xcf, 0x75, 0x76, 0x45, 0xd0, 0x61,
-	0x8a, 0x9c, 0x85, 0x87, 0x25, 0xfd, 0xc8, 0x9e, 0x38, 0x4f, 0x71, 0x8c, 0x3e, 0x04, 0xf0, 0x1e,
-	0x60, 0xf8, 0x31, 0x3a, 0x3b, 0x6a, 0x3b, 0x28, 0xf2, 0x55, 0x48, 0xcd, 0x62, 0x3f, 0xb2, 0x4f,
-	0xb1, 0xa0, 0xcb, 0xc3, 0xeb, 0x6d, 0x25, 0xe5, 0x7f, 0x05, 0x65, 0xb8, 0xdf, 0x73, 0x7a, 0x2c,
-	0x90, 0x84, 0x1c, 0x13, 0x45, 0x82, 0xd1, 0x59, 0xee, 0xf7, 0x3e, 0x61, 0x81, 0xc4, 0x1f, 0xa3,
-	0x8c, 0xee, 0xa9, 0x0d, 0xa6, 0x18, 0x29, 0x42, 0xdc, 0xc6, 0x1b, 0xd5, 0x76, 0xfd, 0x73, 0xee,
-	0xea, 0xf5, 0x59, 0xad, 0xa4, 0xb3, 0xe8, 0x55, 0x64, 0x5b, 0xba, 0x9a, 0x13, 0xb7, 0x7f, 0x88,
-	0x8e, 0xa7, 0x78, 0xa7, 0xab, 0x0e, 0xe9, 0x60, 0x29, 0x7c, 0x19, 0x15, 0x3a, 0xec, 0xc0, 0x31,
-	0x67, 0x96, 0xde, 0x33, 0x4e, 0xce, 0xe9, 0x27, 0xa6, 0xf9, 0x0e, 0x3b, 0xd8, 0x06, 0xf4, 0x89,
-	0xf7, 0x8c, 0xe3, 0x4b, 0x68, 0xbe, 0xe1, 0x49, 0x97, 0x05, 0x0d, 0x63, 0x4b, 0xce, 0xeb, 0xd0,
-	0xd3, 0xbc, 0x41, 0x63, 0x53, 0x7c, 0xfb, 0xb8, 0x23, 0x5d, 0x80, 0x44, 0x5f, 0x19, 0x3b, 0xe4,
-	0x13, 0x98, 0x8d, 0x33, 0xc4, 0x58, 0x0e, 0xba, 0xd6, 0xad, 0xcc, 0x57, 0xcf, 0xed, 0xa9, 0x17,
-	0xcf, 0x6d, 0x6b, 0xfd, 0xfb, 0x02, 0x9a, 0x01, 0xa6, 0x7d, 0xcf, 0xb1, 0x7f, 0x50, 0x8e, 0x7d,
-	0x4f, 0x96, 0x7f, 0x46, 0xb2, 0x2c, 0xa2, 0x4c, 0x23, 0x0c, 0x98, 0x7e, 0x62, 0x20, 0x48, 0x8b,
-	0x0e, 0x74, 0x9d, 0xfc, 0xfc, 0x80, 0xbb, 0xa1, 0xe2, 0x0d, 0xb2, 0x0a, 0x37, 0x8b, 0xa9, 0xca,
-	0x60, 0x74, 0x20, 0xe1, 0x7b, 0x68, 0xb6, 0xe5, 0x49, 0x25, 0x82, 0x43, 0xe0, 0xb4, 0xdc, 0xc6,
-	0xb9, 0x49, 0x9f, 0xbc, 0x5b, 0xb1, 0x49, 0xad, 0x60, 0x5e, 0x31, 0xf1, 0xa1, 0x89, 0xa0, 0x3f,
-	0xb1, 0xe3, 0x0f, 0x6a, 0xb2, 0x76, 0xf2, 0x13, 0x3b, 0x1e, 0xb5, 0x8d, 0x21, 0xa4, 0x22, 0x24,
-	0x1f, 0xd8, 0xc4, 0x08, 0x35, 0x23, 0x5e, 0xd6, 0x69, 0xc0, 0x54, 0x4c, 0x6d, 0x59, 0x1a, 0x2b,
-	0xda, 0x53, 0x0b, 0xa1, 0x04, 0x2a, 0xcb, 0x9b, 0xc7, 0x05, 0x84, 0x9a, 0x51, 0x97, 0xb1, 0x12,
-	0x8a, 0xb5, 0x1d, 0x70, 0x71, 0xdc, 0x16, 0xf3, 0x9b, 0x9c, 0x5c, 0x38, 0x2e, 0xe3, 0x93, 0xb3,
-	0x74, 0x01, 0xb0, 0x27, 0x1a, 0xda, 0x04, 0x04, 0x57, 0xd1, 0x6c, 0x9b, 0x49, 0xe5, 0x88, 0x3d,
-	0x52, 0x82, 0x8b, 0xac, 0x1c, 0x45, 0x76, 0xfa, 0x01, 0x93, 0x6a, 0xfb, 0x23, 0x7d, 0x71, 0x33,
-	0x49, 0xd3, 0x5a, 0xd8, 0xde, 0xc3, 0xd7, 0x50, 0x4e, 0xb8, 0x6e, 0x18, 0x04, 0xdc, 0x77, 0xb9,
-	0x24, 0x36, 0xf8, 0xc0, 0xbb, 0x0d, 0xc1, 0x74, 0x58, 0xc1, 0x8f, 0xd0, 0xca, 0x90, 0xea, 0xec,
-	0x33, 0xc5, 0x83, 0x0e, 0x0b, 0xf6, 0x48, 0x19, 0x9c, 0xd7, 0xfa, 0x91, 0x3d, 0xd9, 0x80, 0x2e,
-	0x0f, 0xc1, 0x3b, 0x09, 0x8a, 0xcb, 0x28, 0x23, 0xbd, 0xb6, 0x06, 0x1b, 0xe4, 0x22, 0x50, 0x42,
-	0xfc, 0x43, 0x6b, 0x80, 0xe2, 0xab, 0xc9, 0xcf, 0xa6, 0x75, 0x78, 0xe2, 0xa5, 0x09, 0x45, 0x6a,
-	0x7c, 0xcc, 0x0f, 0xa6, 0xd3, 0x1a, 0xf1, 0x5f, 0xde, 0x69, 0x23, 0xfe, 0xeb, 0x3b, 0x68, 0xc4,
-	0x97, 0xde, 0xb6, 0x11, 0x5f, 0xfe, 0x5d, 0x1b, 0xf1, 0x95, 0xb7, 0x6b, 0xc4, 0x95, 0x37, 0x34,
-	0xe2, 0xbf, 0xfd, 0xe6, 0x46, 0x7c, 0xca, 0x07, 0xb4, 0xfb, 0x86, 0x0f, 0xe8, 0xa1, 0xfe, 0xfd,
-	0xa5, 0xf9, 0x45, 0xbf, 0x75, 0x5c, 0xc9, 0xa6, 0xd6, 0xac, 0x53, 0x6b, 0x6d, 0x98, 0x5f, 0xa6,
-	0x5f, 0xcb, 0x2f, 0x17, 0x51, 0x46, 0xb7, 0xce, 0xae, 0xe7, 0x37, 0xe1, 0xc7, 0x5b, 0x26, 0x39,
-	0xd4, 0x00, 0xae, 0x95, 0x7f, 0xf9, 0xb1, 0x64, 0xbd, 0x38, 0x2a, 0x59, 0xdf, 0x1d, 0x95, 0xac,
-	0x97, 0x47, 0x25, 0xeb, 0xd5, 0x51, 0xc9, 0xfa, 0xe1, 0xa8, 0x64, 0x7d, 0xfd, 0x53, 0x69, 0xea,
-	0xd3, 0xe9, 0xde, 0x46, 0x3d, 0x0d, 0xff, 0x7c, 0xb8, 0xfe, 0x6b, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0x5d, 0xc1, 0x63, 0x16, 0x11, 0x00, 0x00,
+	// 1799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x8a, 0x16, 0x25, 0x0e, 0x45, 0x51, 0x1a, 0x49, 0xf6, 0x48, 0x91, 0xb9, 0x0c, 0x1d,
+	0x27, 0xfc, 0x7e, 0xe3, 0xd0, 0xb1, 0xd2, 0xa0, 0x69, 0x9a, 0x43, 0xbd, 0x8a, 0x5d, 0xa5, 0x8d,
+	0x7f, 0x60, 0xe4, 0xd6, 0x40, 0x81, 0x62, 0x31, 0x5c, 0x8e, 0xc8, 0xad, 0xc8, 0x1d, 0x76, 0x77,
+	0x96, 0x92, 0x7c, 0x69, 0x8f, 0x05, 0xfa, 0x0f, 0xf4, 0x54, 0xe4, 0x98, 0x5b, 0xaf, 0xbd, 0xf5,
+	0x9a, 0x63, 0xfe, 0x82, 0x45, 0xab, 0xde, 0xf6, 0x2f, 0xe8, 0xb1, 0x98, 0x37, 0xb3, 0xe4, 0x92,
+	0xa2, 0xa2, 0x14, 0x50, 0x80, 0xa2, 0xc8, 0x85, 0xfb, 0xde, 0xe7, 0xbd, 0xf9, 0xf5, 0xe6, 0xfd,
+	0x1a, 0xa2, 0xb2, 0xd7, 0xe3, 0xde, 0x71, 0x6b, 0x18, 0x0a, 0x29, 0x70, 0x25, 0xe2, 0x41, 0x14,
+	0xb7, 0x3c, 0x11, 0xf2, 0xd6, 0x68, 0x6f, 0xe7, 0x07, 0x5d, 0x5f, 0xf6, 0xe2, 0x76, 0xcb, 0x13,
+	0x83, 0x07, 0x5d, 0xd1, 0x15, 0x0f, 0x40, 0xab, 0x1d, 0x1f, 0xfd, 0x64, 0xf4, 0xb0, 0xf5, 0x41,
+	0xeb, 0x21, 0x80, 0x80, 0x01, 0xa5, 0x27, 0xd9, 0x29, 0xb3, 0x28, 0xe2, 0xd2, 0x30, 0xa8, 0x27,
+	0xc4, 0x71, 0x46, 0x0f, 0xb8, 0x64, 0x86, 0x5e, 0x97, 0xfe, 0x80, 0xbb, 0x27, 0x7e, 0xd0, 0x11,
+	0x27, 0x06, 0x5a, 0x89, 0xb8, 0x17, 0x66, 0x03, 0x1b, 0x7f, 0x2b, 0xa0, 0x95, 0x7d, 0xb5, 0x35,
+	0xca, 0x7f, 0x1b, 0xf3, 0x48, 0xe2, 0x8f, 0x50, 0xd1, 0x13, 0xc1, 0x91, 0xdf, 0x25, 0x56, 0xdd,
+	0x6a, 0x96, 0xf7, 0x76, 0x5a, 0x53, 0x9b, 0x6d, 0x81, 0xf2, 0x3e, 0x68, 0x38, 0x37, 0xbf, 0x4a,
+	0x6c, 0x8b, 0x1a, 0x7d, 0xbc, 0x87, 0x8a, 0xb0, 0xa5, 0x88, 0x2c, 0xd4, 0x0b, 0xcd, 0xf2, 0xde,
+	0xe6, 0xcc, 0xc8, 0x47, 0x4a, 0x08, 0x63, 0x6e, 0x50, 0xa3, 0x89, 0x3f, 0x44, 0x8b, 0x6a, 0xe7,
+	0x11, 0x29, 0xc0, 0x90, 0xed, 0x99, 0x21, 0x07, 0x42, 0xe4, 0xd7, 0xba, 0x41, 0xb5, 0x36, 0x6e,
+	0xa0, 0xe2, 0x67, 0x51, 0x14, 0xf3, 0x0e, 0xb9, 0x59, 0xb7, 0x9a, 0x05, 0x07, 0xa5, 0x89, 0x5d,
+	0xf4, 0x01, 0xa1, 0x46, 0x82, 0x7f, 0x8d, 0xca, 0x4a, 0xd9, 0x35, 0x7b, 0x5a, 0x84, 0x05, 0xde,
+	0x9d, 0x77, 0x1a, 0x73, 0x74, 0x58, 0x0d, 0x36, 0x19, 0x3d, 0x0e, 0x64, 0x78, 0xe6, 0x54, 0xd3,
+	0xc4, 0xce, 0xcf, 0x41, 0xc1, 0xca, 0x5a, 0x03, 0x13, 0xb4, 0xa4, 0x0d, 0x19, 0x91, 0x62, 0xbd,
+	0xd0, 0x2c, 0xd1, 0x8c, 0xc5, 0x9b, 0x68, 0x31, 0x1a, 0xf6, 0xd9, 0x19, 0x59, 0xaa, 0x5b, 0xcd,
+	0x0a, 0xd5, 0xcc, 0xce, 0x2b, 0x54, 0x9d, 0x99, 0x1f, 0xaf, 0xa1, 0xc2, 0x31, 0x3f, 0x03, 0x3b,
+	0x97, 0xa8, 0x22, 0x71, 0x0b, 0x2d, 0x8e, 0x58, 0x3f, 0xe6, 0x64, 0x01, 0x6c, 0x4f, 0xe6, 0x59,
+	0xf0, 0x73, 0x3f, 0x92, 0x54, 0xab, 0x7d, 0xbc, 0xf0, 0x91, 0xd5, 0xf8, 0x0c, 0x95, 0xc6, 0x38,
+	0xfe, 0x64, 0x7c, 0x07, 0xd6, 0x37, 0xdc, 0xc1, 0xaa, 0xb2, 0xa5, 0x32, 0x99, 0x39, 0x97, 0xf9,
+	0x36, 0xfe, 0x62, 0xa1, 0xca, 0x8b, 0x50, 0x9c, 0x9e, 0x19, 0x8b, 0x44, 0xd8, 0x41, 0xeb, 0x3c,
+	0x90, 0xbe, 0x3c, 0x73, 0x99, 0x94, 0xa1, 0xdf, 0x8e, 0x25, 0xd7, 0x53, 0x97, 0x9c, 0xad, 0x34,
+	0xb1, 0x2f, 0x0a, 0xe9, 0x9a, 0x86, 0x1e, 0x8d, 0x11, 0x6c, 0x67, 0xf6, 0x50, 0x87, 0x5a, 0x76,
+	0x4a, 0x69, 0x62, 0x6b, 0xc0, 0x98, 0x06, 0xff, 0x08, 0xad, 0x02, 0xe1, 0x7a, 0x62, 0xc4, 0x43,
+	0xd6, 0xe5, 0xa4, 0xa0, 0x2c, 0xe7, 0xe0, 0x34, 0xb1, 0x67, 0x24, 0xb4, 0x02, 0xfc, 0xbe, 0x61,
+	0x1b, 0x4f, 0x51, 0x05, 0xae, 0xd0, 0xe9, 0x33, 0xef, 0x58, 0xc4, 0x12, 0x63, 0x74, 0xd3, 0x0b,
+	0x45, 0x60, 0x8c, 0x0a, 0x34, 0x6e, 0xa2, 0xe5, 0x4e, 0x1c, 0x32, 0xe9, 0x8b, 0x00, 0xf6, 0x50,
+	0x71, 0x56, 0xd2, 0xc4, 0x1e, 0x63, 0x74, 0x4c, 0x35, 0x7e, 0x6f, 0x21, 0x04, 0xf3, 0xbd, 0x08,
+	0x45, 0x9b, 0xab, 0xc9, 0xe4, 0xd9, 0x90, 0x67, 0x93, 0x29, 0x1a, 0xdf, 0x42, 0x45, 0xc9, 0xc2,
+	0x2e, 0x97, 0x30, 0x55, 0x89, 0x1a, 0x0e, 0x3f, 0x41, 0x55, 0x7e, 0x3a, 0xe4, 0x9e, 0xe4, 0x1d,
+	0x37, 0x92, 0x4c, 0xc6, 0x91, 0x39, 0xc5, 0x9d, 0x34, 0xb1, 0xb7, 0x67, 0x44, 0xf7, 0xc5, 0xc0,
+	0x97, 0x7c, 0x30, 0x94, 0x67, 0x74, 0x35, 0x13, 0x1d, 0x82, 0xa4, 0xf1, 0xc7, 0x55, 0x54, 0xce,
+	0xc5, 0x98, 0xf2, 0x33, 0x4f, 0x0c, 0x06, 0x2c, 0xe8, 0x98, 0x6d, 0x64, 0xac, 0x3a, 0x56, 0x8f,
+	0x05, 0x9d, 0x3e, 0x0f, 0x75, 0xf8, 0x94, 0xf4, 0xb1, 0x32, 0x8c, 0x8e, 0x29, 0xfc, 0x53, 0xb4,
+	0xd1, 0xf3, 0xbb, 0x3d, 0xf7, 0xa8, 0xcf, 0x86, 0xae, 0xec, 0x85, 0x3c, 0xea, 0x89, 0xbe, 0x8e,
+	0x9d, 0x8a, 0x73, 0x3b, 0x4d, 0xec, 0x79, 0x62, 0xba, 0xae, 0xc0, 0x27, 0x7d, 0x36, 0x7c, 0x99,
+	0x41, 0x6a, 0x49, 0x3f, 0x90, 0x3c, 0x1c, 0xb1, 0x3e, 0x59, 0x9c, 0x58, 0x32, 0xc3, 0xe8, 0x98,
+	0xc2, 0x9f, 0x22, 0xdc, 0x17, 0x27, 0xb3, 0x2b, 0x16, 0x61, 0xcc, 0xad, 0x34, 0xb1, 0xe7, 0x48,
+	0xe9, 0x5a, 0x5f, 0x9c, 0x4c, 0xaf, 0x77, 0x0f, 0x2d, 0x0d, 0xe3, 0x76, 0xdf, 0x8f, 0x7a, 0xa4,
+	0x04, 0xce, 0x53, 0x4e, 0x13, 0x3b, 0x83, 0x68, 0x46, 0x28, 0x07, 0x0a, 0xe3, 0x00, 0x52, 0x9d,
+	0xf1, 0x7e, 0x04, 0xf6, 0x00, 0x07, 0x9a, 0x96, 0xd0, 0x8a, 0xe1, 0x4d, 0x18, 0xff, 0x10, 0x55,
+	0xa2, 0xb8, 0x1d, 0x79, 0xa1, 0x3f, 0x54, 0x1e, 0x10, 0x91, 0x32, 0x8c, 0x5c, 0x4f, 0x13, 0x7b,
+	0x5a, 0x40, 0xa7, 0x59, 0xfc, 0x21, 0xc2, 0x8f, 0x4f, 0x25, 0x0f, 0x3a, 0xbc, 0x33, 0xf1, 0x75,
+	0xb2, 0x52, 0xb7, 0x9a, 0x2b, 0xce, 0x62, 0x9a, 0xd8, 0xd6, 0x7b, 0x74, 0x8e, 0x02, 0x7e, 0x89,
+	0xd6, 0x87, 0x2a, 0xc2, 0x5c, 0x13, 0x39, 0x01, 0x1b, 0x70, 0x52, 0x51, 0x17, 0xeb, 0x34, 0xcf,
+	0x13, 0xbb, 0x0a, 0xe1, 0xf7, 0x18, 0x64, 0xcf, 0xd8, 0x80, 0xab, 0x18, 0xbb, 0xa0, 0x4f, 0xab,
+	0xc3, 0x69, 0x2d, 0xfc, 0xd4, 0xd4, 0x17, 0x57, 0x27, 0xd3, 0x55, 0x88, 0xfd, 0xdb, 0x73, 0x92,
+	0xa9, 0x4a, 0x12, 0xce, 0x86, 0x09, 0xff, 0xfc, 0x18, 0x8a, 0x80, 0x39, 0x80, 0xf4, 0xaa, 0x22,
+	0x56, 0x76, 0xfc, 0x80, 0x54, 0x73, 0x11, 0xab, 0x00, 0xaa, 0x3f, 0xf8, 0x11, 0x2a, 0x46, 0x71,
+	0xbb, 0x13, 0x73, 0xb2, 0x06, 0x89, 0xea, 0xce, 0xcc, 0x52, 0x2f, 0xfd, 0x01, 0x7f, 0x05, 0x45,
+	0xe7, 0x55, 0x8f, 0x07, 0x3a, 0x3d, 0xeb, 0x01, 0xd4, 0x7c, 0xc7, 0x81, 0xba, 0x9e, 0x0b, 0xd4,
+	0x6d, 0x54, 0x90, 0xb2, 0x4f, 0x30, 0xe4, 0xf4, 0xa5, 0x34, 0xb1, 0x15, 0x4b, 0xd5, 0x8f, 0xf2,
+	0x04, 0x75, 0x6b, 0x22, 0x96, 0x64, 0x03, 0x9c, 0x08, 0x3c, 0xc1, 0x40, 0x34, 0x23, 0xf0, 0x3e,
+	0x5a, 0xd5, 0xe6, 0x0a, 0x4d, 0x06, 0x23, 0x9b, 0xb0, 0xc1, 0xdd, 0x99, 0x0d, 0x4e, 0x65, 0x39,
+	0x5a, 0x19, 0x4e, 0x25, 0xbd, 0xf7, 0x51, 0x39, 0x14, 0x71, 0xd0, 0x71, 0x43, 0xd1, 0xf6, 0x03,
+	0xb2, 0x05, 0x46, 0x80, 0x62, 0x90, 0x83, 0x29, 0x02, 0x86, 0x2a, 0x1a, 0xff, 0x0c, 0x6d, 0x8a,
+	0x58, 0x0e, 0x63, 0xe9, 0x0e, 0xb8, 0x0c, 0x7d, 0xcf, 0x3d, 0x12, 0xe1, 0x80, 0x49, 0x72, 0x0b,
+	0x2e, 0x96, 0xa4, 0x89, 0x3d, 0x57, 0x4e, 0xb1, 0x46, 0x9f, 0x02, 0xf8, 0x04, 0x30, 0xfc, 0x02,
+	0xdd, 0x9a, 0xd6, 0x1d, 0x07, 0xf9, 0x6d, 0x70, 0xcd, 0x9d, 0x34, 0xb1, 0x2f, 0xd1, 0xa0, 0x9b,
+	0xf9, 0xf9, 0x0e, 0xb2, 0xf0, 0x7f, 0x07, 0x2d, 0xf3, 0x60, 0xe4, 0x8e, 0x58, 0x18, 0x11, 0x32,
+	0x49, 0x14, 0x19, 0x46, 0x97, 0x78, 0x30, 0xfa, 0x25, 0x0b, 0x23, 0xfc, 0x0b, 0xb4, 0xac, 0x7a,
+	0x87, 0x0e, 0x93, 0x8c, 0xec, 0x80, 0xdd, 0x66, 0x0b, 0xf2, 0xf3, 0xf6, 0x6f, 0xb8, 0xa7, 0xe6,
+	0x67, 0x4e, 0x4d, 0x79, 0xd1, 0xd7, 0x89, 0x6d, 0xa9, 0x68, 0xce, 0x86, 0xe5, 0x12, 0xdb, 0x78,
+	0x2a, 0xfc, 0x36, 0xaa, 0x0e, 0xd8, 0xa9, 0x6b, 0xf6, 0x1c, 0xf9, 0xaf, 0x39, 0x79, 0x43, 0x5d,
+	0x31, 0xad, 0x0c, 0xd8, 0xe9, 0x73, 0x40, 0x0f, 0xfd, 0xd7, 0x1c, 0xdf, 0x43, 0xab, 0x1d, 0x3f,
+	0xf2, 0x58, 0xd8, 0x31, 0xba, 0x64, 0x57, 0x99, 0x9e, 0x56, 0x0c, 0xaa, 0x55, 0xf1, 0x27, 0x93,
+	0xca, 0x7b, 0x07, 0x1c, 0x7d, 0x6b, 0x66, 0x93, 0x87, 0x20, 0xd5, 0x1e, 0x62, 0x34, 0x27, 0xd5,
+	0xf9, 0x2e, 0xaa, 0x28, 0x5f, 0x73, 0x95, 0xc7, 0xbc, 0x16, 0x01, 0x27, 0x35, 0x70, 0xc0, 0x15,
+	0x05, 0xbe, 0x34, 0x98, 0xf2, 0x00, 0xd6, 0xe5, 0x81, 0x74, 0x75, 0xe1, 0xb2, 0x27, 0x1e, 0x90,
+	0x83, 0x29, 0x02, 0xe6, 0x10, 0x6a, 0xd8, 0x33, 0x84, 0x59, 0x2c, 0x85, 0x1b, 0xf2, 0x48, 0xf4,
+	0x47, 0xdc, 0x65, 0x47, 0x92, 0x87, 0xa4, 0x0e, 0x9e, 0x5c, 0x4f, 0x13, 0x7b, 0xf7, 0xa2, 0x34,
+	0x67, 0xab, 0x35, 0x25, 0xa5, 0x5a, 0xf8, 0x48, 0xc9, 0xf0, 0x21, 0x2a, 0xb5, 0x4d, 0x4d, 0x8b,
+	0xc8, 0x9b, 0x70, 0xcc, 0xdd, 0x79, 0xbd, 0x4b, 0x56, 0xf8, 0x74, 0x1a, 0x1f, 0x0f, 0xc9, 0xcd,
+	0x3d, 0x99, 0x07, 0xef, 0xa1, 0x65, 0x7e, 0xca, 0xbd, 0x58, 0x8a, 0x90, 0x34, 0xc0, 0x35, 0x21,
+	0x15, 0x67, 0x58, 0xfe, 0xf2, 0x32, 0x0c, 0x7f, 0x8a, 0x16, 0x87, 0xaa, 0x18, 0x92, 0xbb, 0x73,
+	0x1d, 0x62, 0x52, 0x2d, 0x9d, 0x8d, 0x34, 0xb1, 0xab, 0xa0, 0x9b, 0x9b, 0x48, 0x0f, 0xc6, 0xf7,
+	0x51, 0x31, 0xe4, 0x1d, 0xe6, 0x49, 0xf2, 0x16, 0x38, 0xe0, 0x66, 0x9a, 0xd8, 0x6b, 0x1a, 0xc9,
+	0x29, 0x1b, 0x9d, 0x8f, 0x97, 0xff, 0xf0, 0x85, 0x7d, 0xe3, 0xcb, 0x2f, 0x6c, 0xab, 0xf1, 0xe7,
+	0x0d, 0xb4, 0x08, 0x4b, 0x7c, 0x5f, 0x07, 0xff, 0x4b, 0xeb, 0xe0, 0xf7, 0x05, 0xed, 0x7f, 0xb1,
+	0xa0, 0xed, 0xe4, 0x5a, 0x66, 0x55, 0xc4, 0xac, 0x49, 0x93, 0xac, 0x9c, 0x5f, 0x67, 0x07, 0xde,
+	0x21, 0xb7, 0xe1, 0x64, 0xba, 0x9c, 0x18, 0x8c, 0x8e, 0x29, 0xfc, 0x04, 0x2d, 0xf5, 0xfc, 0x48,
+	0x8a, 0xf0, 0x0c, 0xea, 0x4e, 0x79, 0xef, 0x8d, 0x79, 0xd9, 0xe3, 0x40, 0xab, 0x38, 0x55, 0x73,
+	0x8b, 0xd9, 0x18, 0x9a, 0x11, 0xea, 0xb9, 0xa7, 0x1f, 0x77, 0x64, 0xfb, 0xe2, 0x73, 0x4f, 0x7f,
+	0x95, 0x8e, 0x29, 0x1a, 0x3b, 0xe0, 0x7c, 0xa0, 0xa3, 0x11, 0x6a, 0xbe, 0xf0, 0x32, 0x93, 0x4c,
+	0xea, 0xf2, 0x53, 0xa2, 0x9a, 0x51, 0x23, 0x4d, 0xc3, 0xbe, 0x0b, 0x17, 0xa1, 0x2f, 0x17, 0x10,
+	0x6a, 0xbe, 0x2a, 0x8c, 0xa5, 0x90, 0xac, 0x0f, 0xfd, 0x3b, 0x77, 0xbd, 0x1e, 0x0b, 0xba, 0x9c,
+	0xdc, 0x99, 0x84, 0xf1, 0x45, 0x29, 0x5d, 0x03, 0x4c, 0xb5, 0xf5, 0x7c, 0x1f, 0x10, 0xdc, 0x42,
+	0x4b, 0x7d, 0x16, 0x49, 0x57, 0x1c, 0x43, 0xd5, 0x29, 0x38, 0x5b, 0xe7, 0x89, 0x5d, 0xfc, 0x9c,
+	0x45, 0xf2, 0xf9, 0xcf, 0xd5, 0xc1, 0x8d, 0x90, 0x16, 0x15, 0xf1, 0xfc, 0x18, 0x3f, 0x44, 0x65,
+	0xe1, 0x79, 0x71, 0x18, 0xf2, 0xc0, 0xe3, 0x11, 0x94, 0xa1, 0x82, 0xbe, 0xb7, 0x1c, 0x4c, 0xf3,
+	0x0c, 0x7e, 0x86, 0xb6, 0x72, 0xac, 0x7b, 0xc2, 0x24, 0x0f, 0x07, 0x2c, 0x3c, 0x36, 0xa5, 0x68,
+	0x3b, 0x4d, 0xec, 0xf9, 0x0a, 0x74, 0x33, 0x07, 0xbf, 0xca, 0x50, 0x5c, 0x47, 0xcb, 0x91, 0xdf,
+	0x57, 0x60, 0x07, 0xca, 0x50, 0xc9, 0x3c, 0xfa, 0xc7, 0x28, 0x7e, 0x90, 0x3d, 0xe1, 0x1b, 0x70,
+	0xc5, 0x1b, 0x73, 0x82, 0xd4, 0x8c, 0x31, 0x8f, 0xf7, 0xcb, 0x9a, 0xa5, 0xbb, 0xd7, 0xda, 0x2c,
+	0xbd, 0x75, 0x0d, 0xcd, 0xd2, 0xbd, 0x6f, 0xdb, 0x2c, 0xbd, 0xfd, 0x9d, 0x36, 0x4b, 0xef, 0x7c,
+	0xbb, 0x66, 0xa9, 0x79, 0x45, 0xb3, 0xf4, 0x7f, 0xd7, 0xd0, 0x2c, 0xfd, 0xff, 0xd5, 0xcd, 0xd2,
+	0xbb, 0x57, 0x37, 0x4b, 0x3f, 0x46, 0xe5, 0x01, 0x53, 0x25, 0x32, 0x60, 0x81, 0xc7, 0xc9, 0x7d,
+	0x30, 0x33, 0xb8, 0x66, 0x0e, 0xce, 0x59, 0x27, 0xaf, 0x7d, 0x49, 0xa7, 0xf5, 0xde, 0xf5, 0x74,
+	0x5a, 0xad, 0xef, 0xa0, 0xd3, 0x7a, 0xf0, 0x9f, 0x76, 0x5a, 0xef, 0x5f, 0x4f, 0xa7, 0xf5, 0xf0,
+	0xea, 0x4e, 0xeb, 0x92, 0x57, 0xac, 0x77, 0xc5, 0x2b, 0x36, 0xd7, 0xa0, 0xfd, 0xce, 0xfc, 0x7d,
+	0x78, 0x30, 0x49, 0xd5, 0x26, 0x99, 0x5a, 0x97, 0x26, 0xd3, 0x7c, 0x01, 0x59, 0xf8, 0xc6, 0x02,
+	0xf2, 0x26, 0x5a, 0x56, 0xbd, 0xd1, 0xd0, 0x0f, 0xba, 0xf0, 0x6f, 0xca, 0x72, 0xb6, 0xa9, 0x31,
+	0xec, 0xd4, 0xff, 0xf5, 0x8f, 0x9a, 0xf5, 0xe5, 0x79, 0xcd, 0xfa, 0xeb, 0x79, 0xcd, 0xfa, 0xea,
+	0xbc, 0x66, 0x7d, 0x7d, 0x5e, 0xb3, 0xfe, 0x7e, 0x5e, 0xb3, 0xfe, 0xf4, 0xcf, 0xda, 0x8d, 0x5f,
+	0x2d, 0x8c, 0xf6, 0xda, 0x45, 0xf8, 0xa7, 0xf3, 0x83, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x94,
+	0xff, 0x91, 0x67, 0x83, 0x15, 0x00, 0x00,
 }
 
 func (this *CheckRequest) Equal(that interface{}) bool {
@@ -717,6 +929,9 @@ func (this *CheckRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Splay != that1.Splay {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -792,6 +1007,69 @@ func (this *ProxyRequests) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CheckBlackout) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckBlackout)
+	if !ok {
+		that2, ok := that.(CheckBlackout)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cron != that1.Cron {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CheckProbe) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckProbe)
+	if !ok {
+		that2, ok := that.(CheckProbe)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if this.ExpectedStatus != that1.ExpectedStatus {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *CheckConfig) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -921,6 +1199,37 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.CronTimezone != that1.CronTimezone {
+		return false
+	}
+	if this.AgentSplay != that1.AgentSplay {
+		return false
+	}
+	if this.AutoResolveAfter != that1.AutoResolveAfter {
+		return false
+	}
+	if len(this.Blackouts) != len(that1.Blackouts) {
+		return false
+	}
+	for i := range this.Blackouts {
+		if !this.Blackouts[i].Equal(that1.Blackouts[i]) {
+			return false
+		}
+	}
+	if this.Executor != that1.Executor {
+		return false
+	}
+	if !this.Probe.Equal(that1.Probe) {
+		return false
+	}
+	if len(this.Redact) != len(that1.Redact) {
+		return false
+	}
+	for i := range this.Redact {
+		if this.Redact[i] != that1.Redact[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1106,6 +1415,45 @@ func (this *Check) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.CronTimezone != that1.CronTimezone {
+		return false
+	}
+	if this.AgentSplay != that1.AgentSplay {
+		return false
+	}
+	if len(this.Maintenance) != len(that1.Maintenance) {
+		return false
+	}
+	for i := range this.Maintenance {
+		if this.Maintenance[i] != that1.Maintenance[i] {
+			return false
+		}
+	}
+	if this.AutoResolveAfter != that1.AutoResolveAfter {
+		return false
+	}
+	if len(this.Blackouts) != len(that1.Blackouts) {
+		return false
+	}
+	for i := range this.Blackouts {
+		if !this.Blackouts[i].Equal(that1.Blackouts[i]) {
+			return false
+		}
+	}
+	if this.Executor != that1.Executor {
+		return false
+	}
+	if !this.Probe.Equal(that1.Probe) {
+		return false
+	}
+	if len(this.Redact) != len(that1.Redact) {
+		return false
+	}
+	for i := range this.Redact {
+		if this.Redact[i] != that1.Redact[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
 		return false
 	}
@@ -1175,6 +1523,13 @@ type CheckConfigFace interface {
 	GetMaxOutputSize() int64
 	GetDiscardOutput() bool
 	GetSecrets() []*Secret
+	GetCronTimezone() string
+	GetAgentSplay() bool
+	GetAutoResolveAfter() int64
+	GetBlackouts() []*CheckBlackout
+	GetExecutor() string
+	GetProbe() *CheckProbe
+	GetRedact() []string
 }
 
 func (this *CheckConfig) Proto() github_com_golang_protobuf_proto.Message {
@@ -1285,6 +1640,34 @@ func (this *CheckConfig) GetSecrets() []*Secret {
 	return this.Secrets
 }
 
+func (this *CheckConfig) GetCronTimezone() string {
+	return this.CronTimezone
+}
+
+func (this *CheckConfig) GetAgentSplay() bool {
+	return this.AgentSplay
+}
+
+func (this *CheckConfig) GetAutoResolveAfter() int64 {
+	return this.AutoResolveAfter
+}
+
+func (this *CheckConfig) GetBlackouts() []*CheckBlackout {
+	return this.Blackouts
+}
+
+func (this *CheckConfig) GetExecutor() string {
+	return this.Executor
+}
+
+func (this *CheckConfig) GetProbe() *CheckProbe {
+	return this.Probe
+}
+
+func (this *CheckConfig) GetRedact() []string {
+	return this.Redact
+}
+
 func NewCheckConfigFromFace(that CheckConfigFace) *CheckConfig {
 	this := &CheckConfig{}
 	this.Command = that.GetCommand()
@@ -1312,6 +1695,13 @@ func NewCheckConfigFromFace(that CheckConfigFace) *CheckConfig {
 	this.MaxOutputSize = that.GetMaxOutputSize()
 	this.DiscardOutput = that.GetDiscardOutput()
 	this.Secrets = that.GetSecrets()
+	this.CronTimezone = that.GetCronTimezone()
+	this.AgentSplay = that.GetAgentSplay()
+	this.AutoResolveAfter = that.GetAutoResolveAfter()
+	this.Blackouts = that.GetBlackouts()
+	this.Executor = that.GetExecutor()
+	this.Probe = that.GetProbe()
+	this.Redact = that.GetRedact()
 	return this
 }
 
@@ -1354,6 +1744,14 @@ type CheckFace interface {
 	GetMaxOutputSize() int64
 	GetDiscardOutput() bool
 	GetSecrets() []*Secret
+	GetCronTimezone() string
+	GetAgentSplay() bool
+	GetMaintenance() []string
+	GetAutoResolveAfter() int64
+	GetBlackouts() []*CheckBlackout
+	GetExecutor() string
+	GetProbe() *CheckProbe
+	GetRedact() []string
 	GetExtendedAttributes() []byte
 }
 
@@ -1513,6 +1911,38 @@ func (this *Check) GetSecrets() []*Secret {
 	return this.Secrets
 }
 
+func (this *Check) GetCronTimezone() string {
+	return this.CronTimezone
+}
+
+func (this *Check) GetAgentSplay() bool {
+	return this.AgentSplay
+}
+
+func (this *Check) GetMaintenance() []string {
+	return this.Maintenance
+}
+
+func (this *Check) GetAutoResolveAfter() int64 {
+	return this.AutoResolveAfter
+}
+
+func (this *Check) GetBlackouts() []*CheckBlackout {
+	return this.Blackouts
+}
+
+func (this *Check) GetExecutor() string {
+	return this.Executor
+}
+
+func (this *Check) GetProbe() *CheckProbe {
+	return this.Probe
+}
+
+func (this *Check) GetRedact() []string {
+	return this.Redact
+}
+
 func (this *Check) GetExtendedAttributes() []byte {
 	return this.ExtendedAttributes
 }
@@ -1556,6 +1986,14 @@ func NewCheckFromFace(that CheckFace) *Check {
 	this.MaxOutputSize = that.GetMaxOutputSize()
 	this.DiscardOutput = that.GetDiscardOutput()
 	this.Secrets = that.GetSecrets()
+	this.CronTimezone = that.GetCronTimezone()
+	this.AgentSplay = that.GetAgentSplay()
+	this.Maintenance = that.GetMaintenance()
+	this.AutoResolveAfter = that.GetAutoResolveAfter()
+	this.Blackouts = that.GetBlackouts()
+	this.Executor = that.GetExecutor()
+	this.Probe = that.GetProbe()
+	this.Redact = that.GetRedact()
 	this.ExtendedAttributes = that.GetExtendedAttributes()
 	return this
 }
@@ -1584,6 +2022,11 @@ func (m *CheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Splay != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.Splay))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Secrets) > 0 {
 		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Secrets[iNdEx])
@@ -1759,7 +2202,7 @@ func (m *ProxyRequests) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CheckConfig) Marshal() (dAtA []byte, err error) {
+func (m *CheckBlackout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1769,12 +2212,12 @@ func (m *CheckConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckBlackout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckBlackout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1783,36 +2226,199 @@ func (m *CheckConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Secrets) > 0 {
-		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCheck(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xea
-		}
-	}
-	if m.DiscardOutput {
-		i--
-		if m.DiscardOutput {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.Duration != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.Duration))
 		i--
-		dAtA[i] = 0xe0
+		dAtA[i] = 0x10
 	}
-	if m.MaxOutputSize != 0 {
-		i = encodeVarintCheck(dAtA, i, uint64(m.MaxOutputSize))
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpectedStatus != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.ExpectedStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Redact) > 0 {
+		for iNdEx := len(m.Redact) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Redact[iNdEx])
+			copy(dAtA[i:], m.Redact[iNdEx])
+			i = encodeVarintCheck(dAtA, i, uint64(len(m.Redact[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.Probe != nil {
+		{
+			size, err := m.Probe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheck(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Blackouts) > 0 {
+		for iNdEx := len(m.Blackouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blackouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCheck(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.AutoResolveAfter != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.AutoResolveAfter))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AgentSplay {
+		i--
+		if m.AgentSplay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.CronTimezone) > 0 {
+		i -= len(m.CronTimezone)
+		copy(dAtA[i:], m.CronTimezone)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.CronTimezone)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCheck(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.DiscardOutput {
+		i--
+		if m.DiscardOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxOutputSize != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.MaxOutputSize))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -2057,6 +2663,95 @@ func (m *Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if len(m.Redact) > 0 {
+		for iNdEx := len(m.Redact) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Redact[iNdEx])
+			copy(dAtA[i:], m.Redact[iNdEx])
+			i = encodeVarintCheck(dAtA, i, uint64(len(m.Redact[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Probe != nil {
+		{
+			size, err := m.Probe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheck(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.Blackouts) > 0 {
+		for iNdEx := len(m.Blackouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blackouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCheck(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.AutoResolveAfter != 0 {
+		i = encodeVarintCheck(dAtA, i, uint64(m.AutoResolveAfter))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.Maintenance) > 0 {
+		for iNdEx := len(m.Maintenance) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Maintenance[iNdEx])
+			copy(dAtA[i:], m.Maintenance[iNdEx])
+			i = encodeVarintCheck(dAtA, i, uint64(len(m.Maintenance[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.AgentSplay {
+		i--
+		if m.AgentSplay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.CronTimezone) > 0 {
+		i -= len(m.CronTimezone)
+		copy(dAtA[i:], m.CronTimezone)
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.CronTimezone)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
 	if len(m.Secrets) > 0 {
 		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2504,8 +3199,9 @@ func NewPopulatedCheckRequest(r randyCheck, easy bool) *CheckRequest {
 	for i := 0; i < v6; i++ {
 		this.Secrets[i] = string(randStringCheck(r))
 	}
+	this.Splay = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedCheck(r, 7)
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 8)
 	}
 	return this
 }
@@ -2541,6 +3237,27 @@ func NewPopulatedProxyRequests(r randyCheck, easy bool) *ProxyRequests {
 	return this
 }
 
+func NewPopulatedCheckBlackout(r randyCheck, easy bool) *CheckBlackout {
+	this := &CheckBlackout{}
+	this.Cron = string(randStringCheck(r))
+	this.Duration = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedCheckProbe(r randyCheck, easy bool) *CheckProbe {
+	this := &CheckProbe{}
+	this.Type = string(randStringCheck(r))
+	this.Target = string(randStringCheck(r))
+	this.ExpectedStatus = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 4)
+	}
+	return this
+}
+
 func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 	this := &CheckConfig{}
 	this.Command = string(randStringCheck(r))
@@ -2616,8 +3333,30 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 			this.Secrets[i] = NewPopulatedSecret(r, easy)
 		}
 	}
+	this.CronTimezone = string(randStringCheck(r))
+	this.AgentSplay = bool(bool(r.Intn(2) == 0))
+	this.AutoResolveAfter = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.AutoResolveAfter *= -1
+	}
+	if r.Intn(5) != 0 {
+		v20 := r.Intn(5)
+		this.Blackouts = make([]*CheckBlackout, v20)
+		for i := 0; i < v20; i++ {
+			this.Blackouts[i] = NewPopulatedCheckBlackout(r, easy)
+		}
+	}
+	this.Executor = string(randStringCheck(r))
+	if r.Intn(5) != 0 {
+		this.Probe = NewPopulatedCheckProbe(r, easy)
+	}
+	v21 := r.Intn(10)
+	this.Redact = make([]string, v21)
+	for i := 0; i < v21; i++ {
+		this.Redact[i] = string(randStringCheck(r))
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedCheck(r, 30)
+		this.XXX_unrecognized = randUnrecognizedCheck(r, 37)
 	}
 	return this
 }
@@ -2625,32 +3364,32 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	this := &Check{}
 	this.Command = string(randStringCheck(r))
-	v20 := r.Intn(10)
-	this.Handlers = make([]string, v20)
-	for i := 0; i < v20; i++ {
+	v22 := r.Intn(10)
+	this.Handlers = make([]string, v22)
+	for i := 0; i < v22; i++ {
 		this.Handlers[i] = string(randStringCheck(r))
 	}
 	this.HighFlapThreshold = uint32(r.Uint32())
 	this.Interval = uint32(r.Uint32())
 	this.LowFlapThreshold = uint32(r.Uint32())
 	this.Publish = bool(bool(r.Intn(2) == 0))
-	v21 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v21)
-	for i := 0; i < v21; i++ {
+	v23 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v23)
+	for i := 0; i < v23; i++ {
 		this.RuntimeAssets[i] = string(randStringCheck(r))
 	}
-	v22 := r.Intn(10)
-	this.Subscriptions = make([]string, v22)
-	for i := 0; i < v22; i++ {
+	v24 := r.Intn(10)
+	this.Subscriptions = make([]string, v24)
+	for i := 0; i < v24; i++ {
 		this.Subscriptions[i] = string(randStringCheck(r))
 	}
 	this.ProxyEntityName = string(randStringCheck(r))
 	if r.Intn(5) != 0 {
-		v23 := r.Intn(5)
-		this.CheckHooks = make([]HookList, v23)
-		for i := 0; i < v23; i++ {
-			v24 := NewPopulatedHookList(r, easy)
-			this.CheckHooks[i] = *v24
+		v25 := r.Intn(5)
+		this.CheckHooks = make([]HookList, v25)
+		for i := 0; i < v25; i++ {
+			v26 := NewPopulatedHookList(r, easy)
+			this.CheckHooks[i] = *v26
 		}
 	}
 	this.Stdin = bool(bool(r.Intn(2) == 0))
@@ -2676,11 +3415,11 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 		this.Executed *= -1
 	}
 	if r.Intn(5) != 0 {
-		v25 := r.Intn(5)
-		this.History = make([]CheckHistory, v25)
-		for i := 0; i < v25; i++ {
-			v26 := NewPopulatedCheckHistory(r, easy)
-			this.History[i] = *v26
+		v27 := r.Intn(5)
+		this.History = make([]CheckHistory, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedCheckHistory(r, easy)
+			this.History[i] = *v28
 		}
 	}
 	this.Issued = int64(r.Int63())
@@ -2703,46 +3442,73 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	if r.Intn(2) == 0 {
 		this.OccurrencesWatermark *= -1
 	}
-	v27 := r.Intn(10)
-	this.Silenced = make([]string, v27)
-	for i := 0; i < v27; i++ {
+	v29 := r.Intn(10)
+	this.Silenced = make([]string, v29)
+	for i := 0; i < v29; i++ {
 		this.Silenced[i] = string(randStringCheck(r))
 	}
 	if r.Intn(5) != 0 {
-		v28 := r.Intn(5)
-		this.Hooks = make([]*Hook, v28)
-		for i := 0; i < v28; i++ {
+		v30 := r.Intn(5)
+		this.Hooks = make([]*Hook, v30)
+		for i := 0; i < v30; i++ {
 			this.Hooks[i] = NewPopulatedHook(r, easy)
 		}
 	}
 	this.OutputMetricFormat = string(randStringCheck(r))
-	v29 := r.Intn(10)
-	this.OutputMetricHandlers = make([]string, v29)
-	for i := 0; i < v29; i++ {
+	v31 := r.Intn(10)
+	this.OutputMetricHandlers = make([]string, v31)
+	for i := 0; i < v31; i++ {
 		this.OutputMetricHandlers[i] = string(randStringCheck(r))
 	}
-	v30 := r.Intn(10)
-	this.EnvVars = make([]string, v30)
-	for i := 0; i < v30; i++ {
+	v32 := r.Intn(10)
+	this.EnvVars = make([]string, v32)
+	for i := 0; i < v32; i++ {
 		this.EnvVars[i] = string(randStringCheck(r))
 	}
-	v31 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v31
+	v33 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v33
 	this.MaxOutputSize = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.MaxOutputSize *= -1
 	}
 	this.DiscardOutput = bool(bool(r.Intn(2) == 0))
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(5)
-		this.Secrets = make([]*Secret, v32)
-		for i := 0; i < v32; i++ {
+		v34 := r.Intn(5)
+		this.Secrets = make([]*Secret, v34)
+		for i := 0; i < v34; i++ {
 			this.Secrets[i] = NewPopulatedSecret(r, easy)
 		}
 	}
-	v33 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v33)
-	for i := 0; i < v33; i++ {
+	this.CronTimezone = string(randStringCheck(r))
+	this.AgentSplay = bool(bool(r.Intn(2) == 0))
+	v35 := r.Intn(10)
+	this.Maintenance = make([]string, v35)
+	for i := 0; i < v35; i++ {
+		this.Maintenance[i] = string(randStringCheck(r))
+	}
+	this.AutoResolveAfter = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.AutoResolveAfter *= -1
+	}
+	if r.Intn(5) != 0 {
+		v36 := r.Intn(5)
+		this.Blackouts = make([]*CheckBlackout, v36)
+		for i := 0; i < v36; i++ {
+			this.Blackouts[i] = NewPopulatedCheckBlackout(r, easy)
+		}
+	}
+	this.Executor = string(randStringCheck(r))
+	if r.Intn(5) != 0 {
+		this.Probe = NewPopulatedCheckProbe(r, easy)
+	}
+	v37 := r.Intn(10)
+	this.Redact = make([]string, v37)
+	for i := 0; i < v37; i++ {
+		this.Redact[i] = string(randStringCheck(r))
+	}
+	v38 := r.Intn(100)
+	this.ExtendedAttributes = make([]byte, v38)
+	for i := 0; i < v38; i++ {
 		this.ExtendedAttributes[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2784,9 +3550,9 @@ func randUTF8RuneCheck(r randyCheck) rune {
 	return rune(ru + 61)
 }
 func randStringCheck(r randyCheck) string {
-	v34 := r.Intn(100)
-	tmps := make([]rune, v34)
-	for i := 0; i < v34; i++ {
+	v39 := r.Intn(100)
+	tmps := make([]rune, v39)
+	for i := 0; i < v39; i++ {
 		tmps[i] = randUTF8RuneCheck(r)
 	}
 	return string(tmps)
@@ -2808,11 +3574,11 @@ func randFieldCheck(dAtA []byte, r randyCheck, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
-		v35 := r.Int63()
+		v40 := r.Int63()
 		if r.Intn(2) == 0 {
-			v35 *= -1
+			v40 *= -1
 		}
-		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v35))
+		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v40))
 	case 1:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2881,6 +3647,9 @@ func (m *CheckRequest) Size() (n int) {
 			n += 1 + l + sovCheck(uint64(l))
 		}
 	}
+	if m.Splay != 0 {
+		n += 1 + sovCheck(uint64(m.Splay))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2929,20 +3698,62 @@ func (m *ProxyRequests) Size() (n int) {
 	return n
 }
 
-func (m *CheckConfig) Size() (n int) {
+func (m *CheckBlackout) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Command)
+	l = len(m.Cron)
 	if l > 0 {
 		n += 1 + l + sovCheck(uint64(l))
 	}
-	if len(m.Handlers) > 0 {
-		for _, s := range m.Handlers {
-			l = len(s)
-			n += 1 + l + sovCheck(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovCheck(uint64(m.Duration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if m.ExpectedStatus != 0 {
+		n += 1 + sovCheck(uint64(m.ExpectedStatus))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovCheck(uint64(l))
+	}
+	if len(m.Handlers) > 0 {
+		for _, s := range m.Handlers {
+			l = len(s)
+			n += 1 + l + sovCheck(uint64(l))
 		}
 	}
 	if m.HighFlapThreshold != 0 {
@@ -3037,6 +3848,36 @@ func (m *CheckConfig) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	l = len(m.CronTimezone)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.AgentSplay {
+		n += 3
+	}
+	if m.AutoResolveAfter != 0 {
+		n += 2 + sovCheck(uint64(m.AutoResolveAfter))
+	}
+	if len(m.Blackouts) > 0 {
+		for _, e := range m.Blackouts {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.Probe != nil {
+		l = m.Probe.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3197,6 +4038,42 @@ func (m *Check) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	l = len(m.CronTimezone)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.AgentSplay {
+		n += 3
+	}
+	if len(m.Maintenance) > 0 {
+		for _, s := range m.Maintenance {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.AutoResolveAfter != 0 {
+		n += 2 + sovCheck(uint64(m.AutoResolveAfter))
+	}
+	if len(m.Blackouts) > 0 {
+		for _, e := range m.Blackouts {
+			l = e.Size()
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.Probe != nil {
+		l = m.Probe.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
 	l = len(m.ExtendedAttributes)
 	if l > 0 {
 		n += 2 + l + sovCheck(uint64(l))
@@ -3547,6 +4424,25 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Secrets = append(m.Secrets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splay", wireType)
+			}
+			m.Splay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Splay |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -3785,6 +4681,248 @@ func (m *ProxyRequests) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CheckBlackout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckBlackout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckBlackout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedStatus", wireType)
+			}
+			m.ExpectedStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedStatus |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4449,11 +5587,202 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscardOutput", wireType)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscardOutput", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DiscardOutput = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronTimezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronTimezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentSplay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AgentSplay = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResolveAfter", wireType)
+			}
+			m.AutoResolveAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoResolveAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blackouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blackouts = append(m.Blackouts, &CheckBlackout{})
+			if err := m.Blackouts[len(m.Blackouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probe", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4463,17 +5792,33 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DiscardOutput = bool(v != 0)
-		case 29:
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Probe == nil {
+				m.Probe = &CheckProbe{}
+			}
+			if err := m.Probe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redact", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCheck
@@ -4483,25 +5828,23 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCheck
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCheck
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secrets = append(m.Secrets, &Secret{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5520,6 +6863,243 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronTimezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronTimezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentSplay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AgentSplay = bool(v != 0)
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintenance = append(m.Maintenance, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResolveAfter", wireType)
+			}
+			m.AutoResolveAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoResolveAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blackouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blackouts = append(m.Blackouts, &CheckBlackout{})
+			if err := m.Blackouts[len(m.Blackouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Probe == nil {
+				m.Probe = &CheckProbe{}
+			}
+			if err := m.Probe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
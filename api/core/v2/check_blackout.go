@@ -0,0 +1,55 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+)
+
+// Validate returns an error if the CheckBlackout does not pass validation
+// tests.
+func (b *CheckBlackout) Validate() error {
+	if _, err := cron.ParseStandard(b.Cron); err != nil {
+		return fmt.Errorf("check blackout cron string is invalid: %s", err)
+	}
+	if b.Duration == 0 {
+		return errors.New("check blackout duration must be greater than 0")
+	}
+	return nil
+}
+
+// IsActive returns true if the blackout period began less than its duration
+// before t.
+func (b *CheckBlackout) IsActive(t time.Time) bool {
+	schedule, err := cron.ParseStandard(b.Cron)
+	if err != nil {
+		return false
+	}
+	duration := time.Duration(b.Duration) * time.Second
+	return !schedule.Next(t.Add(-duration)).After(t)
+}
+
+// validateBlackouts returns an error if one of the blackouts is invalid.
+func validateBlackouts(blackouts []*CheckBlackout) error {
+	for _, blackout := range blackouts {
+		if blackout == nil {
+			return errors.New("check blackout must not be null")
+		}
+		if err := blackout.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inBlackout returns true if one of the blackouts is active at t.
+func inBlackout(blackouts []*CheckBlackout, t time.Time) bool {
+	for _, blackout := range blackouts {
+		if blackout != nil && blackout.IsActive(t) {
+			return true
+		}
+	}
+	return false
+}
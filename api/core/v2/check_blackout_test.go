@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBlackoutValidate(t *testing.T) {
+	b := &CheckBlackout{}
+
+	// Invalid cron
+	assert.Error(t, b.Validate())
+	b.Cron = "0 2 * * *"
+
+	// Invalid duration
+	assert.Error(t, b.Validate())
+	b.Duration = 3600
+
+	assert.NoError(t, b.Validate())
+}
+
+func TestCheckBlackoutIsActive(t *testing.T) {
+	b := &CheckBlackout{Cron: "CRON_TZ=UTC 0 2 * * *", Duration: 3600}
+
+	day := time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{name: "before", t: day.Add(2*time.Hour - time.Second), want: false},
+		{name: "beginning", t: day.Add(2 * time.Hour), want: true},
+		{name: "during", t: day.Add(150 * time.Minute), want: true},
+		{name: "end", t: day.Add(3 * time.Hour), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, b.IsActive(tt.t))
+		})
+	}
+}
+
+func TestCheckConfigIsBlackedOut(t *testing.T) {
+	c := FixtureCheckConfig("foo")
+	now := time.Now()
+	assert.False(t, c.IsBlackedOut(now))
+
+	c.Blackouts = []*CheckBlackout{{Cron: "* * * * *", Duration: 60}}
+	assert.NoError(t, c.Validate())
+	assert.True(t, c.IsBlackedOut(now))
+
+	c.Blackouts = append(c.Blackouts, &CheckBlackout{Cron: "* * * * *"})
+	assert.Error(t, c.Validate())
+}
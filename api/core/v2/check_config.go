@@ -98,6 +98,14 @@ func (c *CheckConfig) Validate() error {
 		}
 	}
 
+	if err := validateCronTimezone(c.Cron, c.CronTimezone); err != nil {
+		return err
+	}
+
+	if c.AgentSplay && c.Cron != "" {
+		return errors.New("agent splay can only be enabled with an interval")
+	}
+
 	if c.Interval == 0 && c.Cron == "" {
 		return errors.New("check interval must be greater than 0 or a valid cron schedule must be provided")
 	}
@@ -110,6 +118,14 @@ func (c *CheckConfig) Validate() error {
 		return errors.New("ttl must be greater than check interval")
 	}
 
+	if err := validateAutoResolveAfter(c.AutoResolveAfter, c.Interval); err != nil {
+		return err
+	}
+
+	if err := ValidateSecrets(c.Secrets); err != nil {
+		return err
+	}
+
 	for _, assetName := range c.RuntimeAssets {
 		if err := ValidateAssetName(assetName); err != nil {
 			return fmt.Errorf("asset's %s", err)
@@ -150,9 +166,23 @@ func (c *CheckConfig) Validate() error {
 		return err
 	}
 
+	if err := validateBlackouts(c.Blackouts); err != nil {
+		return err
+	}
+
+	if err := validateCheckExecutor(c.Executor, c.Probe, c.ProxyEntityName, c.ProxyRequests, c.RoundRobin); err != nil {
+		return err
+	}
+
 	return c.Subdue.Validate()
 }
 
+// CronSchedule returns the cron schedule of the check, qualified with its cron
+// timezone if any, as understood by cron.ParseStandard.
+func (c *CheckConfig) CronSchedule() string {
+	return cronSchedule(c.Cron, c.CronTimezone)
+}
+
 // IsSubdued returns true if the check is subdued at the current time.
 // It returns false otherwise.
 func (c *CheckConfig) IsSubdued() bool {
@@ -167,6 +197,18 @@ func (c *CheckConfig) IsSubdued() bool {
 	return subdued
 }
 
+// IsExecutedByBackend returns true if the check is probed by the backend
+// instead of being executed by the agents.
+func (c *CheckConfig) IsExecutedByBackend() bool {
+	return c.Executor == CheckExecutorBackend
+}
+
+// IsBlackedOut returns true if one of the blackout periods of the check is
+// active at t. It returns false otherwise.
+func (c *CheckConfig) IsBlackedOut(t time.Time) bool {
+	return inBlackout(c.Blackouts, t)
+}
+
 //
 // Sorting
 //
@@ -225,3 +267,44 @@ func CheckConfigFields(r Resource) map[string]string {
 		"check.subscriptions":  strings.Join(resource.Subscriptions, ","),
 	}
 }
+
+// cronSchedule qualifies the cron schedule with timezone, if any.
+func cronSchedule(schedule, timezone string) string {
+	if schedule == "" || timezone == "" {
+		return schedule
+	}
+	return "CRON_TZ=" + timezone + " " + schedule
+}
+
+// validateCronTimezone returns an error if timezone is not a valid cron
+// timezone for the cron schedule.
+func validateCronTimezone(schedule, timezone string) error {
+	if timezone == "" {
+		return nil
+	}
+	if schedule == "" {
+		return errors.New("cron timezone can only be specified with a cron schedule")
+	}
+	if strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=") {
+		return errors.New("must only specify either a cron timezone or a timezone in the cron schedule")
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("check cron timezone is invalid: %s", err)
+	}
+	return nil
+}
+
+// validateAutoResolveAfter returns an error if the auto resolve delay of a
+// check executed every interval seconds is invalid.
+func validateAutoResolveAfter(autoResolveAfter int64, interval uint32) error {
+	if autoResolveAfter < 0 {
+		return errors.New("auto resolve after must not be negative")
+	}
+	if autoResolveAfter > 0 && autoResolveAfter <= int64(interval) {
+		return errors.New("auto resolve after must be greater than check interval")
+	}
+	if autoResolveAfter > 0 && autoResolveAfter < 5 {
+		return errors.New("minimum auto resolve after is 5 seconds")
+	}
+	return nil
+}
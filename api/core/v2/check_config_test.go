@@ -98,6 +98,56 @@ func TestCheckConfigFlapThresholdValidation(t *testing.T) {
 	assert.Error(t, c.Validate())
 }
 
+func TestCheckConfigCronTimezone(t *testing.T) {
+	c := FixtureCheckConfig("foo")
+	c.Interval = 0
+	c.Cron = "0 9 * * *"
+	assert.Equal(t, "0 9 * * *", c.CronSchedule())
+
+	c.CronTimezone = "America/Vancouver"
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, "CRON_TZ=America/Vancouver 0 9 * * *", c.CronSchedule())
+
+	// unknown timezone is invalid
+	c.CronTimezone = "Mars/Olympus_Mons"
+	assert.Error(t, c.Validate())
+
+	// timezone in both the cron schedule and the cron timezone is invalid
+	c.Cron = "CRON_TZ=UTC 0 9 * * *"
+	c.CronTimezone = "America/Vancouver"
+	assert.Error(t, c.Validate())
+
+	// timezone without cron schedule is invalid
+	c.Cron = ""
+	c.Interval = 60
+	assert.Error(t, c.Validate())
+}
+
+func TestCheckConfigAgentSplay(t *testing.T) {
+	c := FixtureCheckConfig("foo")
+	c.AgentSplay = true
+	assert.NoError(t, c.Validate())
+
+	// agent splay of a cron check is invalid
+	c.Interval = 0
+	c.Cron = "* * * * *"
+	assert.Error(t, c.Validate())
+}
+
+func TestCheckConfigAutoResolveAfter(t *testing.T) {
+	c := FixtureCheckConfig("foo")
+	c.Interval = 60
+	c.AutoResolveAfter = 600
+	assert.NoError(t, c.Validate())
+
+	// auto resolve after must be greater than the interval
+	c.AutoResolveAfter = 60
+	assert.Error(t, c.Validate())
+
+	c.AutoResolveAfter = -1
+	assert.Error(t, c.Validate())
+}
+
 func TestSortCheckConfigsByName(t *testing.T) {
 	a := FixtureCheckConfig("Abernathy")
 	b := FixtureCheckConfig("Bernard")
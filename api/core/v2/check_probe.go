@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// CheckExecutorAgent is the executor of the checks executed by the agents
+	CheckExecutorAgent = "agent"
+
+	// CheckExecutorBackend is the executor of the checks probed by the
+	// backend
+	CheckExecutorBackend = "backend"
+
+	// ProbeTypeHTTP is the type of the probes requesting an HTTP URL
+	ProbeTypeHTTP = "http"
+
+	// ProbeTypeTCP is the type of the probes opening a TCP connection
+	ProbeTypeTCP = "tcp"
+
+	// ProbeTypeICMP is the type of the probes sending an ICMP echo request
+	ProbeTypeICMP = "icmp"
+)
+
+// Validate returns an error if the CheckProbe does not pass validation tests.
+func (p *CheckProbe) Validate() error {
+	switch p.Type {
+	case ProbeTypeHTTP, ProbeTypeTCP, ProbeTypeICMP:
+	default:
+		return fmt.Errorf("check probe type must be one of %q, %q or %q", ProbeTypeHTTP, ProbeTypeTCP, ProbeTypeICMP)
+	}
+	if p.Target == "" {
+		return errors.New("check probe target must be set")
+	}
+	if p.ExpectedStatus != 0 && p.Type != ProbeTypeHTTP {
+		return errors.New("check probe expected status can only be set for http probes")
+	}
+	return nil
+}
+
+// validateCheckExecutor returns an error if the executor or the probe of a
+// check is invalid. The backend only probes proxy entities, so a check it
+// executes must either be a proxy check or have a proxy entity.
+func validateCheckExecutor(executor string, probe *CheckProbe, proxyEntityName string, proxyRequests *ProxyRequests, roundRobin bool) error {
+	switch executor {
+	case "", CheckExecutorAgent:
+		if probe != nil {
+			return errors.New("check probe can only be set for checks executed by the backend")
+		}
+		return nil
+	case CheckExecutorBackend:
+	default:
+		return fmt.Errorf("check executor must be either %q or %q", CheckExecutorAgent, CheckExecutorBackend)
+	}
+
+	if probe == nil {
+		return errors.New("check probe must be set for checks executed by the backend")
+	}
+	if err := probe.Validate(); err != nil {
+		return err
+	}
+	if proxyEntityName == "" && proxyRequests == nil {
+		return errors.New("checks executed by the backend must have a proxy entity name or proxy requests")
+	}
+	if roundRobin {
+		return errors.New("checks executed by the backend can't be round robin")
+	}
+	return nil
+}
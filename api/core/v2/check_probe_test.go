@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckProbeValidate(t *testing.T) {
+	p := &CheckProbe{}
+
+	// Invalid type
+	assert.Error(t, p.Validate())
+	p.Type = ProbeTypeTCP
+
+	// Missing target
+	assert.Error(t, p.Validate())
+	p.Target = "db.example.com:5432"
+	assert.NoError(t, p.Validate())
+
+	// Expected status on a tcp probe
+	p.ExpectedStatus = 200
+	assert.Error(t, p.Validate())
+	p.Type = ProbeTypeHTTP
+	p.Target = "https://www.example.com/health"
+	assert.NoError(t, p.Validate())
+}
+
+func TestCheckConfigExecutor(t *testing.T) {
+	c := FixtureCheckConfig("foo")
+	assert.NoError(t, c.Validate())
+	assert.False(t, c.IsExecutedByBackend())
+
+	c.Executor = "nobody"
+	assert.Error(t, c.Validate())
+
+	// A probe is required
+	c.Executor = CheckExecutorBackend
+	assert.Error(t, c.Validate())
+	assert.True(t, c.IsExecutedByBackend())
+
+	// A proxy entity is required
+	c.Probe = &CheckProbe{Type: ProbeTypeICMP, Target: "{{ .labels.address }}"}
+	assert.Error(t, c.Validate())
+
+	c.ProxyRequests = FixtureProxyRequests(true)
+	assert.NoError(t, c.Validate())
+
+	c.RoundRobin = true
+	assert.Error(t, c.Validate())
+	c.RoundRobin = false
+
+	// The agents don't probe
+	c.Executor = CheckExecutorAgent
+	assert.Error(t, c.Validate())
+}
@@ -36,6 +36,10 @@ const (
 
 	// PageSizeKey contains the page size used in pagination
 	PageSizeKey
+
+	// DryRunKey indicates that the changes of a request must be validated but
+	// not persisted
+	DryRunKey
 )
 
 // ContextNamespace returns the namespace injected in the context
@@ -63,3 +67,12 @@ func PageContinueFromContext(ctx context.Context) string {
 	}
 	return ""
 }
+
+// DryRunFromContext returns whether the changes of the request of the given
+// context must only be validated, without being persisted.
+func DryRunFromContext(ctx context.Context) bool {
+	if value := ctx.Value(DryRunKey); value != nil {
+		return value.(bool)
+	}
+	return false
+}
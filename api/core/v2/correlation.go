@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/sensu/sensu-go/js"
+)
+
+const (
+	// CorrelationsResource is the name of this resource type
+	CorrelationsResource = "correlations"
+
+	// CorrelationGroupByEntity groups the events by entity
+	CorrelationGroupByEntity = "entity"
+
+	// CorrelationGroupByLabels groups the events by the values of labels
+	CorrelationGroupByLabels = "labels"
+
+	// CorrelationGroupByExpression groups the events by the result of a
+	// JavaScript expression
+	CorrelationGroupByExpression = "expression"
+)
+
+// StorePrefix returns the path prefix to this resource in the store
+func (c *Correlation) StorePrefix() string {
+	return CorrelationsResource
+}
+
+// URIPath returns the path component of a correlation URI.
+func (c *Correlation) URIPath() string {
+	if c.Namespace == "" {
+		return path.Join(URLPrefix, CorrelationsResource, url.PathEscape(c.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(c.Namespace), CorrelationsResource, url.PathEscape(c.Name))
+}
+
+// Validate returns an error if the correlation does not pass validation
+// tests.
+func (c *Correlation) Validate() error {
+	if err := ValidateName(c.Name); err != nil {
+		return errors.New("correlation name " + err.Error())
+	}
+
+	switch c.GroupBy {
+	case CorrelationGroupByEntity:
+	case CorrelationGroupByLabels:
+		if len(c.LabelKeys) == 0 {
+			return errors.New("correlation label keys must be set to group by labels")
+		}
+	case CorrelationGroupByExpression:
+		if c.Expression == "" {
+			return errors.New("correlation expression must be set to group by expression")
+		}
+		if err := js.ParseExpressions([]string{c.Expression}); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("correlation group by %q is not valid", c.GroupBy)
+	}
+
+	if c.Window == 0 {
+		return errors.New("correlation window must be greater than 0")
+	}
+
+	if c.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// NewCorrelation creates a new Correlation.
+func NewCorrelation(meta ObjectMeta) *Correlation {
+	return &Correlation{ObjectMeta: meta}
+}
+
+// FixtureCorrelation returns a Correlation fixture for testing.
+func FixtureCorrelation(name string) *Correlation {
+	return &Correlation{
+		ObjectMeta: NewObjectMeta(name, "default"),
+		GroupBy:    CorrelationGroupByEntity,
+		Window:     300,
+	}
+}
+
+// CorrelationFields returns a set of fields that represent that resource
+func CorrelationFields(r Resource) map[string]string {
+	resource := r.(*Correlation)
+	return map[string]string{
+		"correlation.name":      resource.ObjectMeta.Name,
+		"correlation.namespace": resource.ObjectMeta.Namespace,
+		"correlation.group_by":  resource.GroupBy,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (c *Correlation) SetNamespace(namespace string) {
+	c.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (c *Correlation) SetObjectMeta(meta ObjectMeta) {
+	c.ObjectMeta = meta
+}
+
+func (c *Correlation) RBACName() string {
+	return "correlations"
+}
@@ -0,0 +1,696 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: correlation.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A Correlation groups the related events handled by a handler, so that only
+// the first event of a group is handled until the group closes.
+type Correlation struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// correlation
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// GroupBy is how the events are grouped, either "entity", "labels" or
+	// "expression".
+	GroupBy string `protobuf:"bytes,2,opt,name=group_by,json=groupBy,proto3" json:"group_by"`
+	// LabelKeys are the keys of the entity and check labels whose values
+	// group the events, if GroupBy is "labels".
+	LabelKeys []string `protobuf:"bytes,3,rep,name=label_keys,json=labelKeys,proto3" json:"label_keys"`
+	// Expression is the JavaScript expression returning the group of the
+	// events, if GroupBy is "expression".
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Window is the number of seconds a group stays open after its last
+	// event.
+	Window               uint32   `protobuf:"varint,5,opt,name=window,proto3" json:"window"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Correlation) Reset()         { *m = Correlation{} }
+func (m *Correlation) String() string { return proto.CompactTextString(m) }
+func (*Correlation) ProtoMessage()    {}
+func (*Correlation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2117ccb6af34b354, []int{0}
+}
+func (m *Correlation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Correlation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Correlation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Correlation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Correlation.Merge(m, src)
+}
+func (m *Correlation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Correlation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Correlation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Correlation proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Correlation)(nil), "sensu.core.v2.Correlation")
+}
+
+func init() { proto.RegisterFile("correlation.proto", fileDescriptor_2117ccb6af34b354) }
+
+var fileDescriptor_2117ccb6af34b354 = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xeb, 0x30,
+	0x18, 0xc7, 0xeb, 0xf6, 0xbd, 0xbe, 0xd6, 0x7d, 0x45, 0xc2, 0x62, 0x08, 0x1d, 0xec, 0xa8, 0x0b,
+	0x19, 0xc0, 0x55, 0x5b, 0x06, 0xc4, 0x84, 0xc2, 0x88, 0x10, 0x52, 0x24, 0x16, 0x96, 0x2a, 0x49,
+	0x4d, 0x08, 0x34, 0x71, 0x94, 0x38, 0x2d, 0xb9, 0x01, 0x47, 0x60, 0xec, 0xc0, 0xd0, 0x23, 0x70,
+	0x84, 0x8e, 0x3d, 0x81, 0x05, 0x61, 0xcb, 0x09, 0x18, 0x11, 0x6e, 0x69, 0xcb, 0xe4, 0x9f, 0x7e,
+	0xfe, 0xfc, 0xff, 0x5b, 0x1f, 0xdc, 0x75, 0x79, 0x1c, 0xb3, 0x91, 0x2d, 0x7c, 0x1e, 0xd2, 0x28,
+	0xe6, 0x82, 0xa3, 0x66, 0xc2, 0xc2, 0x24, 0xa5, 0x2e, 0x8f, 0x19, 0x1d, 0xf7, 0x5a, 0xc7, 0x9e,
+	0x2f, 0xee, 0x52, 0x87, 0xba, 0x3c, 0xe8, 0x78, 0xdc, 0xe3, 0x1d, 0x35, 0xe5, 0xa4, 0xb7, 0x67,
+	0xe3, 0x2e, 0xed, 0xd3, 0xae, 0x92, 0xca, 0x29, 0x5a, 0x86, 0xb4, 0x60, 0xc0, 0x84, 0xbd, 0xe4,
+	0xf6, 0x4b, 0x19, 0x36, 0xce, 0x37, 0x35, 0xe8, 0x1a, 0xd6, 0xbe, 0x6f, 0x87, 0xb6, 0xb0, 0x35,
+	0xa0, 0x03, 0xa3, 0xd1, 0xdb, 0xa7, 0xbf, 0x3a, 0xe9, 0x95, 0x73, 0xcf, 0x5c, 0x71, 0xc9, 0x84,
+	0x6d, 0xe2, 0xb9, 0x24, 0xa5, 0x85, 0x24, 0xa0, 0x90, 0x04, 0xfd, 0x3c, 0x3b, 0xe4, 0x81, 0x2f,
+	0x58, 0x10, 0x89, 0xcc, 0x5a, 0x47, 0xa1, 0x03, 0x58, 0xf3, 0x62, 0x9e, 0x46, 0x03, 0x27, 0xd3,
+	0xca, 0x3a, 0x30, 0xea, 0xe6, 0xff, 0x42, 0x92, 0xb5, 0xb3, 0xfe, 0x29, 0x32, 0x33, 0x74, 0x04,
+	0xe1, 0xc8, 0x76, 0xd8, 0x68, 0xf0, 0xc0, 0xb2, 0x44, 0xab, 0xe8, 0x15, 0xa3, 0x6e, 0xee, 0x14,
+	0x92, 0x6c, 0x59, 0xab, 0xae, 0xf8, 0x82, 0x65, 0x09, 0x3a, 0x81, 0x90, 0x3d, 0x46, 0x31, 0x4b,
+	0x12, 0x9f, 0x87, 0xda, 0x1f, 0x95, 0xac, 0x15, 0x92, 0xec, 0x6d, 0xec, 0xd6, 0x7f, 0xb6, 0x66,
+	0x51, 0x1b, 0x56, 0x27, 0x7e, 0x38, 0xe4, 0x13, 0xed, 0xaf, 0x0e, 0x8c, 0xa6, 0x09, 0x0b, 0x49,
+	0x56, 0xc6, 0x5a, 0x9d, 0xa7, 0xb5, 0xa7, 0x29, 0x29, 0xcd, 0xa6, 0x04, 0x98, 0xfa, 0xe7, 0x3b,
+	0x06, 0xb3, 0x1c, 0x83, 0xd7, 0x1c, 0x83, 0x79, 0x8e, 0xc1, 0x22, 0xc7, 0xe0, 0x2d, 0xc7, 0xe0,
+	0xf9, 0x03, 0x97, 0x6e, 0xca, 0xe3, 0x9e, 0x53, 0x55, 0xfb, 0xec, 0x7f, 0x05, 0x00, 0x00, 0xff,
+	0xff, 0x39, 0xa1, 0x28, 0xd7, 0xb5, 0x01, 0x00, 0x00,
+}
+
+func (this *Correlation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Correlation)
+	if !ok {
+		that2, ok := that.(Correlation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.GroupBy != that1.GroupBy {
+		return false
+	}
+	if len(this.LabelKeys) != len(that1.LabelKeys) {
+		return false
+	}
+	for i := range this.LabelKeys {
+		if this.LabelKeys[i] != that1.LabelKeys[i] {
+			return false
+		}
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type CorrelationFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetGroupBy() string
+	GetLabelKeys() []string
+	GetExpression() string
+	GetWindow() uint32
+}
+
+func (this *Correlation) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *Correlation) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewCorrelationFromFace(this)
+}
+
+func (this *Correlation) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *Correlation) GetGroupBy() string {
+	return this.GroupBy
+}
+
+func (this *Correlation) GetLabelKeys() []string {
+	return this.LabelKeys
+}
+
+func (this *Correlation) GetExpression() string {
+	return this.Expression
+}
+
+func (this *Correlation) GetWindow() uint32 {
+	return this.Window
+}
+
+func NewCorrelationFromFace(that CorrelationFace) *Correlation {
+	this := &Correlation{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.GroupBy = that.GetGroupBy()
+	this.LabelKeys = that.GetLabelKeys()
+	this.Expression = that.GetExpression()
+	this.Window = that.GetWindow()
+	return this
+}
+
+func (m *Correlation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Correlation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Correlation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Window != 0 {
+		i = encodeVarintCorrelation(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Expression) > 0 {
+		i -= len(m.Expression)
+		copy(dAtA[i:], m.Expression)
+		i = encodeVarintCorrelation(dAtA, i, uint64(len(m.Expression)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LabelKeys) > 0 {
+		for iNdEx := len(m.LabelKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LabelKeys[iNdEx])
+			copy(dAtA[i:], m.LabelKeys[iNdEx])
+			i = encodeVarintCorrelation(dAtA, i, uint64(len(m.LabelKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintCorrelation(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCorrelation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCorrelation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCorrelation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedCorrelation(r randyCorrelation, easy bool) *Correlation {
+	this := &Correlation{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.GroupBy = string(randStringCorrelation(r))
+	v2 := r.Intn(10)
+	this.LabelKeys = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.LabelKeys[i] = string(randStringCorrelation(r))
+	}
+	this.Expression = string(randStringCorrelation(r))
+	this.Window = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedCorrelation(r, 6)
+	}
+	return this
+}
+
+type randyCorrelation interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneCorrelation(r randyCorrelation) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringCorrelation(r randyCorrelation) string {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
+		tmps[i] = randUTF8RuneCorrelation(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedCorrelation(r randyCorrelation, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldCorrelation(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldCorrelation(dAtA []byte, r randyCorrelation, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateCorrelation(dAtA, uint64(key))
+		v4 := r.Int63()
+		if r.Intn(2) == 0 {
+			v4 *= -1
+		}
+		dAtA = encodeVarintPopulateCorrelation(dAtA, uint64(v4))
+	case 1:
+		dAtA = encodeVarintPopulateCorrelation(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateCorrelation(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateCorrelation(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateCorrelation(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateCorrelation(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Correlation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovCorrelation(uint64(l))
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovCorrelation(uint64(l))
+	}
+	if len(m.LabelKeys) > 0 {
+		for _, s := range m.LabelKeys {
+			l = len(s)
+			n += 1 + l + sovCorrelation(uint64(l))
+		}
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovCorrelation(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovCorrelation(uint64(m.Window))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCorrelation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCorrelation(x uint64) (n int) {
+	return sovCorrelation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Correlation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCorrelation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Correlation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Correlation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelKeys = append(m.LabelKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCorrelation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCorrelation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCorrelation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCorrelation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCorrelation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCorrelation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCorrelation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCorrelation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCorrelation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCorrelation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCorrelation = fmt.Errorf("proto: unexpected end of group")
+)
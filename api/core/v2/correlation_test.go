@@ -0,0 +1,47 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureCorrelation(t *testing.T) {
+	fixture := FixtureCorrelation("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+}
+
+func TestCorrelationValidate(t *testing.T) {
+	var c Correlation
+
+	// Invalid name
+	assert.Error(t, c.Validate())
+	c.Name = "foo"
+
+	// Invalid group by
+	assert.Error(t, c.Validate())
+	c.GroupBy = CorrelationGroupByLabels
+
+	// Invalid label keys
+	assert.Error(t, c.Validate())
+	c.LabelKeys = []string{"region"}
+
+	// Invalid window
+	assert.Error(t, c.Validate())
+	c.Window = 300
+
+	// Invalid namespace
+	assert.Error(t, c.Validate())
+	c.Namespace = "default"
+
+	// Invalid expression
+	c.GroupBy = CorrelationGroupByExpression
+	assert.Error(t, c.Validate())
+	c.Expression = "event.check.name +"
+	assert.Error(t, c.Validate())
+	c.Expression = "event.entity.labels.region"
+
+	// Valid correlation
+	assert.NoError(t, c.Validate())
+}
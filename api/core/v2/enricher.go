@@ -0,0 +1,98 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// EnrichersResource is the name of this resource type
+	EnrichersResource = "enrichers"
+
+	// EnricherCacheKeyEntity caches the metadata of an enricher per entity
+	EnricherCacheKeyEntity = "entity"
+
+	// EnricherCacheKeyCheck caches the metadata of an enricher per entity and
+	// check
+	EnricherCacheKeyCheck = "check"
+)
+
+// StorePrefix returns the path prefix to this resource in the store
+func (e *Enricher) StorePrefix() string {
+	return EnrichersResource
+}
+
+// URIPath returns the path component of an enricher URI.
+func (e *Enricher) URIPath() string {
+	if e.Namespace == "" {
+		return path.Join(URLPrefix, EnrichersResource, url.PathEscape(e.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(e.Namespace), EnrichersResource, url.PathEscape(e.Name))
+}
+
+// Validate returns an error if the enricher does not pass validation tests.
+func (e *Enricher) Validate() error {
+	if err := ValidateName(e.Name); err != nil {
+		return errors.New("enricher name " + err.Error())
+	}
+
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return fmt.Errorf("enricher url is invalid: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return errors.New("enricher url must be an http or https url")
+	}
+
+	switch e.CacheKey {
+	case "", EnricherCacheKeyEntity, EnricherCacheKeyCheck:
+	default:
+		return fmt.Errorf("enricher cache key %q is not valid", e.CacheKey)
+	}
+
+	if e.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// NewEnricher creates a new Enricher.
+func NewEnricher(meta ObjectMeta) *Enricher {
+	return &Enricher{ObjectMeta: meta}
+}
+
+// FixtureEnricher returns an Enricher fixture for testing.
+func FixtureEnricher(name string) *Enricher {
+	return &Enricher{
+		ObjectMeta: NewObjectMeta(name, "default"),
+		URL:        "http://127.0.0.1:8080/enrich",
+		Timeout:    10,
+	}
+}
+
+// EnricherFields returns a set of fields that represent that resource
+func EnricherFields(r Resource) map[string]string {
+	resource := r.(*Enricher)
+	return map[string]string{
+		"enricher.name":      resource.ObjectMeta.Name,
+		"enricher.namespace": resource.ObjectMeta.Namespace,
+		"enricher.cache_key": resource.CacheKey,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (e *Enricher) SetNamespace(namespace string) {
+	e.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (e *Enricher) SetObjectMeta(meta ObjectMeta) {
+	e.ObjectMeta = meta
+}
+
+func (e *Enricher) RBACName() string {
+	return "enrichers"
+}
@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enricher.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// An Enricher is an HTTP endpoint providing metadata about the events, such as
+// the owner or the escalation policy of an entity. The metadata is added to
+// the annotations of the events before they are handled.
+type Enricher struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// enricher
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// URL is the URL of the HTTP endpoint, to which the events are posted as
+	// JSON. The endpoint responds with a JSON object of metadata.
+	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Headers are the HTTP headers sent to the endpoint.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Timeout is the request timeout in seconds.
+	Timeout uint32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout"`
+	// CacheTTL is the number of seconds the metadata returned by the endpoint
+	// is reused for the events with the same cache key. The metadata is not
+	// cached if zero.
+	CacheTTL uint32 `protobuf:"varint,5,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl"`
+	// CacheKey is the part of the events the metadata is cached for, either
+	// "entity" or "check". Defaults to "entity".
+	CacheKey string `protobuf:"bytes,6,opt,name=cache_key,json=cacheKey,proto3" json:"cache_key,omitempty"`
+	// AnnotationPrefix is prepended to the keys of the metadata added to the
+	// annotations of the events.
+	AnnotationPrefix     string   `protobuf:"bytes,7,opt,name=annotation_prefix,json=annotationPrefix,proto3" json:"annotation_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Enricher) Reset()         { *m = Enricher{} }
+func (m *Enricher) String() string { return proto.CompactTextString(m) }
+func (*Enricher) ProtoMessage()    {}
+func (*Enricher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02268fc19c086213, []int{0}
+}
+func (m *Enricher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Enricher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Enricher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Enricher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Enricher.Merge(m, src)
+}
+func (m *Enricher) XXX_Size() int {
+	return m.Size()
+}
+func (m *Enricher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Enricher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Enricher proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Enricher)(nil), "sensu.core.v2.Enricher")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.Enricher.HeadersEntry")
+}
+
+func init() { proto.RegisterFile("enricher.proto", fileDescriptor_02268fc19c086213) }
+
+var fileDescriptor_02268fc19c086213 = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0x64, 0x6d, 0xb3, 0x99, 0x58, 0xa9, 0xa3, 0xe0, 0x26, 0xc2, 0xce, 0x22, 0x0a, 0x39,
+	0xc8, 0x94, 0xa6, 0x45, 0x24, 0x07, 0x91, 0x48, 0x41, 0x30, 0xa2, 0x2c, 0xe9, 0xc5, 0x4b, 0x99,
+	0x6c, 0xbf, 0x26, 0xab, 0xd9, 0x9d, 0xb0, 0xf9, 0x36, 0x98, 0x37, 0xf0, 0x11, 0x3c, 0xf6, 0xd8,
+	0x47, 0xf0, 0x11, 0x7a, 0xec, 0x13, 0x0c, 0xba, 0xe2, 0x65, 0x9f, 0xc0, 0xa3, 0xec, 0xac, 0xdb,
+	0x44, 0x7b, 0xfb, 0xcd, 0xef, 0xcf, 0xf7, 0x67, 0x66, 0xe8, 0x1d, 0x88, 0x93, 0x30, 0x98, 0x42,
+	0x22, 0xe6, 0x89, 0x42, 0xc5, 0x76, 0x16, 0x10, 0x2f, 0x52, 0x11, 0xa8, 0x04, 0xc4, 0xb2, 0xd7,
+	0x39, 0x9c, 0x84, 0x38, 0x4d, 0xc7, 0x22, 0x50, 0xd1, 0xde, 0x44, 0x4d, 0xd4, 0x9e, 0x71, 0x8d,
+	0xd3, 0xb3, 0x97, 0xcb, 0x7d, 0x71, 0x20, 0xf6, 0x0d, 0x69, 0x38, 0x83, 0xca, 0x22, 0x1d, 0x1a,
+	0x01, 0xca, 0x12, 0x3f, 0xfa, 0x65, 0x51, 0xfb, 0xe8, 0x6f, 0x0f, 0x76, 0x4c, 0xed, 0x42, 0x3a,
+	0x95, 0x28, 0x1d, 0xe2, 0x91, 0x6e, 0xab, 0xd7, 0x16, 0xff, 0x34, 0x14, 0xef, 0xc6, 0x1f, 0x21,
+	0xc0, 0xb7, 0x80, 0x72, 0xe0, 0x5e, 0x6a, 0x5e, 0xbb, 0xd2, 0x9c, 0xe4, 0x9a, 0xb3, 0x2a, 0xf6,
+	0x54, 0x45, 0x21, 0x42, 0x34, 0xc7, 0x95, 0x7f, 0x5d, 0x8a, 0xb5, 0xa9, 0x95, 0x26, 0x33, 0xa7,
+	0xee, 0x91, 0x6e, 0x73, 0xd0, 0xc8, 0x34, 0xb7, 0x8e, 0xfd, 0xa1, 0x5f, 0x70, 0xec, 0x05, 0x6d,
+	0x4c, 0x41, 0x9e, 0x42, 0xb2, 0x70, 0x2c, 0xcf, 0xea, 0xb6, 0x7a, 0x8f, 0xff, 0x6b, 0x58, 0xcd,
+	0x26, 0x5e, 0x97, 0xb6, 0xa3, 0x18, 0x93, 0x95, 0x5f, 0x85, 0xd8, 0x13, 0xda, 0xc0, 0x30, 0x02,
+	0x95, 0xa2, 0x73, 0xcb, 0x23, 0xdd, 0x9d, 0x41, 0x2b, 0xd7, 0xbc, 0xa2, 0xfc, 0x0a, 0xb0, 0x67,
+	0xb4, 0x19, 0xc8, 0x60, 0x0a, 0x27, 0x88, 0x33, 0x67, 0xcb, 0x18, 0xdb, 0x99, 0xe6, 0xf6, 0xab,
+	0x82, 0x1c, 0x8d, 0x86, 0xb9, 0xe6, 0x6b, 0x83, 0x6f, 0x1b, 0x38, 0xc2, 0x19, 0x3b, 0xac, 0x72,
+	0x9f, 0x60, 0xe5, 0x6c, 0x9b, 0xf9, 0x1f, 0xe4, 0x9a, 0xdf, 0xbb, 0x26, 0x37, 0xf7, 0x35, 0xe4,
+	0x1b, 0x58, 0xb1, 0x21, 0xbd, 0x2b, 0xe3, 0x58, 0xa1, 0xc4, 0x50, 0xc5, 0x27, 0xf3, 0x04, 0xce,
+	0xc2, 0xcf, 0x4e, 0xc3, 0xa4, 0x79, 0xae, 0xf9, 0xc3, 0x1b, 0xe2, 0x46, 0x95, 0xdd, 0xb5, 0xf8,
+	0xde, 0x68, 0x9d, 0x3e, 0xbd, 0xbd, 0xb9, 0x3b, 0xdb, 0xa5, 0x56, 0x31, 0x4d, 0xf1, 0x3e, 0x4d,
+	0xbf, 0x80, 0xec, 0x3e, 0xdd, 0x5a, 0xca, 0x59, 0x0a, 0xe5, 0x0d, 0xfb, 0xe5, 0xa1, 0x5f, 0x7f,
+	0x4e, 0xfa, 0xf6, 0x97, 0x73, 0x5e, 0xbb, 0x38, 0xe7, 0x64, 0xe0, 0xfd, 0xfe, 0xe1, 0x92, 0x8b,
+	0xcc, 0x25, 0xdf, 0x32, 0x97, 0x5c, 0x66, 0x2e, 0xb9, 0xca, 0x5c, 0xf2, 0x3d, 0x73, 0xc9, 0xd7,
+	0x9f, 0x6e, 0xed, 0x43, 0x7d, 0xd9, 0x1b, 0x6f, 0x9b, 0x0f, 0x71, 0xf0, 0x27, 0x00, 0x00, 0xff,
+	0xff, 0xad, 0xe0, 0x21, 0x02, 0x73, 0x02, 0x00, 0x00,
+}
+
+func (this *Enricher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Enricher)
+	if !ok {
+		that2, ok := that.(Enricher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if this.CacheTTL != that1.CacheTTL {
+		return false
+	}
+	if this.CacheKey != that1.CacheKey {
+		return false
+	}
+	if this.AnnotationPrefix != that1.AnnotationPrefix {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type EnricherFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetURL() string
+	GetHeaders() map[string]string
+	GetTimeout() uint32
+	GetCacheTTL() uint32
+	GetCacheKey() string
+	GetAnnotationPrefix() string
+}
+
+func (this *Enricher) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *Enricher) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewEnricherFromFace(this)
+}
+
+func (this *Enricher) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *Enricher) GetURL() string {
+	return this.URL
+}
+
+func (this *Enricher) GetHeaders() map[string]string {
+	return this.Headers
+}
+
+func (this *Enricher) GetTimeout() uint32 {
+	return this.Timeout
+}
+
+func (this *Enricher) GetCacheTTL() uint32 {
+	return this.CacheTTL
+}
+
+func (this *Enricher) GetCacheKey() string {
+	return this.CacheKey
+}
+
+func (this *Enricher) GetAnnotationPrefix() string {
+	return this.AnnotationPrefix
+}
+
+func NewEnricherFromFace(that EnricherFace) *Enricher {
+	this := &Enricher{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.URL = that.GetURL()
+	this.Headers = that.GetHeaders()
+	this.Timeout = that.GetTimeout()
+	this.CacheTTL = that.GetCacheTTL()
+	this.CacheKey = that.GetCacheKey()
+	this.AnnotationPrefix = that.GetAnnotationPrefix()
+	return this
+}
+
+func (m *Enricher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Enricher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Enricher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AnnotationPrefix) > 0 {
+		i -= len(m.AnnotationPrefix)
+		copy(dAtA[i:], m.AnnotationPrefix)
+		i = encodeVarintEnricher(dAtA, i, uint64(len(m.AnnotationPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CacheKey) > 0 {
+		i -= len(m.CacheKey)
+		copy(dAtA[i:], m.CacheKey)
+		i = encodeVarintEnricher(dAtA, i, uint64(len(m.CacheKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CacheTTL != 0 {
+		i = encodeVarintEnricher(dAtA, i, uint64(m.CacheTTL))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintEnricher(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEnricher(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEnricher(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEnricher(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintEnricher(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnricher(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEnricher(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEnricher(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedEnricher(r randyEnricher, easy bool) *Enricher {
+	this := &Enricher{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.URL = string(randStringEnricher(r))
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.Headers[randStringEnricher(r)] = randStringEnricher(r)
+		}
+	}
+	this.Timeout = uint32(r.Uint32())
+	this.CacheTTL = uint32(r.Uint32())
+	this.CacheKey = string(randStringEnricher(r))
+	this.AnnotationPrefix = string(randStringEnricher(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedEnricher(r, 8)
+	}
+	return this
+}
+
+type randyEnricher interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneEnricher(r randyEnricher) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringEnricher(r randyEnricher) string {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
+		tmps[i] = randUTF8RuneEnricher(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedEnricher(r randyEnricher, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldEnricher(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldEnricher(dAtA []byte, r randyEnricher, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateEnricher(dAtA, uint64(key))
+		v4 := r.Int63()
+		if r.Intn(2) == 0 {
+			v4 *= -1
+		}
+		dAtA = encodeVarintPopulateEnricher(dAtA, uint64(v4))
+	case 1:
+		dAtA = encodeVarintPopulateEnricher(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateEnricher(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateEnricher(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateEnricher(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateEnricher(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Enricher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovEnricher(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovEnricher(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEnricher(uint64(len(k))) + 1 + len(v) + sovEnricher(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEnricher(uint64(mapEntrySize))
+		}
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovEnricher(uint64(m.Timeout))
+	}
+	if m.CacheTTL != 0 {
+		n += 1 + sovEnricher(uint64(m.CacheTTL))
+	}
+	l = len(m.CacheKey)
+	if l > 0 {
+		n += 1 + l + sovEnricher(uint64(l))
+	}
+	l = len(m.AnnotationPrefix)
+	if l > 0 {
+		n += 1 + l + sovEnricher(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEnricher(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEnricher(x uint64) (n int) {
+	return sovEnricher(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Enricher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnricher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Enricher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Enricher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEnricher
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnricher
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEnricher
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEnricher
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEnricher
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEnricher
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEnricher
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEnricher(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthEnricher
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheTTL", wireType)
+			}
+			m.CacheTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheTTL |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnricher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEnricher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEnricher(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEnricher
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEnricher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEnricher
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEnricher
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEnricher
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEnricher        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEnricher          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEnricher = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureEnricher(t *testing.T) {
+	fixture := FixtureEnricher("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+}
+
+func TestEnricherValidate(t *testing.T) {
+	var e Enricher
+
+	// Invalid name
+	assert.Error(t, e.Validate())
+	e.Name = "foo"
+
+	// Invalid url
+	assert.Error(t, e.Validate())
+	e.URL = "ftp://cmdb.example.com"
+	assert.Error(t, e.Validate())
+	e.URL = "https://cmdb.example.com/enrich"
+
+	// Invalid namespace
+	assert.Error(t, e.Validate())
+	e.Namespace = "default"
+
+	// Invalid cache key
+	e.CacheKey = "foo"
+	assert.Error(t, e.Validate())
+	e.CacheKey = EnricherCacheKeyCheck
+
+	// Valid enricher
+	assert.NoError(t, e.Validate())
+}
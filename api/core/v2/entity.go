@@ -64,6 +64,21 @@ func (e *Entity) Validate() error {
 	return nil
 }
 
+// AllHandlers returns the handler and the additional handlers of the
+// deregistration event, without duplicates.
+func (d *Deregistration) AllHandlers() []string {
+	handlers := []string{}
+	if d.Handler != "" {
+		handlers = append(handlers, d.Handler)
+	}
+	for _, handler := range d.Handlers {
+		if handler != "" && !utilstrings.InArray(handler, handlers) {
+			handlers = append(handlers, handler)
+		}
+	}
+	return handlers
+}
+
 // NewEntity creates a new Entity.
 func NewEntity(meta ObjectMeta) *Entity {
 	return &Entity{ObjectMeta: meta}
@@ -472,7 +472,13 @@ func (m *NetworkInterface) GetAddresses() []string {
 
 // Deregistration contains configuration for Sensu entity de-registration.
 type Deregistration struct {
-	Handler              string   `protobuf:"bytes,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	// Handler is the handler of the deregistration event.
+	Handler string `protobuf:"bytes,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	// Handlers are additional handlers of the deregistration event.
+	Handlers []string `protobuf:"bytes,2,rep,name=handlers,proto3" json:"handlers,omitempty"`
+	// Payload is a template, evaluated with the entity, used as the output of
+	// the deregistration event.
+	Payload              string   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -518,6 +524,20 @@ func (m *Deregistration) GetHandler() string {
 	return ""
 }
 
+func (m *Deregistration) GetHandlers() []string {
+	if m != nil {
+		return m.Handlers
+	}
+	return nil
+}
+
+func (m *Deregistration) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Entity)(nil), "sensu.core.v2.Entity")
 	proto.RegisterType((*System)(nil), "sensu.core.v2.System")
@@ -530,73 +550,76 @@ func init() {
 func init() { proto.RegisterFile("entity.proto", fileDescriptor_cf50d946d740d100) }
 
 var fileDescriptor_cf50d946d740d100 = []byte{
-	// 1047 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0xcb, 0x72, 0xe3, 0x44,
-	0x14, 0x8d, 0xfc, 0xf6, 0x75, 0xec, 0x24, 0x3d, 0x45, 0xd0, 0xa4, 0x18, 0xcb, 0x65, 0x8a, 0xc2,
-	0x33, 0x30, 0x0e, 0x93, 0x50, 0xc3, 0x63, 0x45, 0x14, 0x9e, 0x05, 0x61, 0x52, 0x1d, 0xc8, 0x82,
-	0x05, 0xaa, 0xb6, 0xd4, 0x71, 0x44, 0xac, 0x47, 0x75, 0xb7, 0x0c, 0xfe, 0x03, 0xb6, 0xec, 0x58,
-	0xce, 0x72, 0x3e, 0x81, 0x35, 0xab, 0x59, 0xce, 0x17, 0xa8, 0xc0, 0xec, 0xb4, 0x61, 0xcb, 0x92,
-	0xea, 0x56, 0x4b, 0xb6, 0x53, 0xd9, 0xd8, 0xf7, 0x9e, 0x73, 0x6e, 0x3f, 0x6e, 0x9f, 0x6e, 0xc1,
-	0x36, 0x0d, 0x85, 0x2f, 0x16, 0xe3, 0x98, 0x45, 0x22, 0x42, 0x5d, 0x4e, 0x43, 0x9e, 0x8c, 0xdd,
-	0x88, 0xd1, 0xf1, 0xfc, 0xe8, 0xe0, 0xfd, 0xa9, 0x2f, 0xae, 0x93, 0xc9, 0xd8, 0x8d, 0x82, 0xc3,
-	0x69, 0x34, 0x8d, 0x0e, 0x95, 0x6a, 0x92, 0x5c, 0x7d, 0x32, 0x7f, 0x32, 0x3e, 0x1e, 0x3f, 0x51,
-	0xa0, 0xc2, 0x54, 0x94, 0x0f, 0x72, 0x00, 0x01, 0x15, 0x24, 0x8f, 0x87, 0xbf, 0xd5, 0xa1, 0xf1,
-	0x99, 0x9a, 0x01, 0x1d, 0x17, 0x73, 0x39, 0xee, 0x8c, 0x70, 0x6e, 0x1a, 0x03, 0x63, 0xd4, 0xb6,
-	0x77, 0xb3, 0xd4, 0xda, 0xc0, 0x71, 0x27, 0xcf, 0x4e, 0x65, 0x82, 0x8e, 0xa1, 0xc1, 0x17, 0x5c,
-	0xd0, 0xc0, 0xac, 0x0e, 0x8c, 0x51, 0xe7, 0xe8, 0xb5, 0xf1, 0xc6, 0x0a, 0xc7, 0x17, 0x8a, 0xb4,
-	0x6b, 0x2f, 0x53, 0x6b, 0x0b, 0x6b, 0x29, 0xfa, 0x00, 0xba, 0x3c, 0x99, 0x70, 0x97, 0xf9, 0xb1,
-	0xf0, 0xa3, 0x90, 0x9b, 0xb5, 0x41, 0x75, 0xd4, 0xb6, 0xf7, 0xb2, 0xd4, 0xda, 0x24, 0xf0, 0x66,
-	0x8a, 0x1e, 0x41, 0x7b, 0x46, 0xb8, 0x70, 0x38, 0xa5, 0xa1, 0x59, 0x1f, 0x18, 0xa3, 0xaa, 0xdd,
-	0xcd, 0x52, 0x6b, 0x05, 0xe2, 0x96, 0x0c, 0x2f, 0x28, 0x0d, 0xd1, 0x18, 0xc0, 0xa3, 0x8c, 0x4e,
-	0x7d, 0x2e, 0x28, 0x33, 0x1b, 0x03, 0x63, 0xd4, 0xb2, 0x7b, 0x59, 0x6a, 0xad, 0xa1, 0x78, 0x2d,
-	0x46, 0x5f, 0x43, 0xaf, 0xc8, 0x18, 0x91, 0xd3, 0x99, 0x4d, 0xb5, 0xa3, 0x07, 0xb7, 0x76, 0xf4,
-	0xe9, 0x86, 0x48, 0xef, 0xec, 0x56, 0x29, 0x42, 0x50, 0x4b, 0x38, 0x65, 0x66, 0x47, 0xf6, 0x10,
-	0xab, 0x18, 0x3d, 0x85, 0x7b, 0xf4, 0x17, 0x41, 0x43, 0x8f, 0x7a, 0x0e, 0x11, 0x82, 0xf9, 0x93,
-	0x44, 0x50, 0x6e, 0x6e, 0x0f, 0x8c, 0xd1, 0xb6, 0x5d, 0xcf, 0x52, 0xcb, 0x78, 0x8c, 0x51, 0xa1,
-	0x38, 0x29, 0x05, 0x68, 0x1f, 0x1a, 0x8c, 0x7a, 0xc4, 0x15, 0x66, 0x57, 0xb6, 0x09, 0xeb, 0x0c,
-	0x7d, 0x0f, 0x2d, 0x79, 0x90, 0x1e, 0x11, 0xc4, 0xec, 0xa9, 0xa5, 0xde, 0xbf, 0xb5, 0xd4, 0x67,
-	0x93, 0x9f, 0xa8, 0x2b, 0xce, 0xa8, 0x20, 0x76, 0x5f, 0x2e, 0xf3, 0x55, 0x6a, 0x19, 0x59, 0x6a,
-	0xa1, 0xa2, 0xec, 0xdd, 0x28, 0xf0, 0x05, 0x0d, 0x62, 0xb1, 0xc0, 0xe5, 0x50, 0xe8, 0x0b, 0xb8,
-	0xa7, 0x46, 0x71, 0xc8, 0x94, 0x86, 0xc2, 0x99, 0x53, 0xc6, 0x65, 0x33, 0x76, 0x94, 0x1b, 0x5e,
-	0xcf, 0x52, 0xeb, 0x2e, 0x1a, 0xef, 0x29, 0xf0, 0x44, 0x62, 0x97, 0x39, 0x84, 0x1e, 0x03, 0xba,
-	0xa1, 0x34, 0x26, 0x33, 0x7f, 0x4e, 0x9d, 0x6b, 0x12, 0x7a, 0x33, 0xca, 0xb8, 0xb9, 0xab, 0xf6,
-	0xb0, 0x57, 0x32, 0x5f, 0x6a, 0xe2, 0xe3, 0xd6, 0xaf, 0xcf, 0xad, 0xad, 0x17, 0xcf, 0x2d, 0x63,
-	0xf8, 0x67, 0x0d, 0x1a, 0xb9, 0x6f, 0xd0, 0x01, 0xb4, 0xae, 0x23, 0x2e, 0x42, 0x12, 0xd0, 0xdc,
-	0x8f, 0xb8, 0xcc, 0xd1, 0x3e, 0x54, 0x22, 0x6e, 0x56, 0xd4, 0xba, 0x1a, 0xcb, 0xd4, 0xaa, 0x3c,
-	0xbb, 0xc0, 0x95, 0x88, 0xcb, 0x9a, 0x78, 0x46, 0xc4, 0x55, 0xc4, 0x72, 0x53, 0xb6, 0x71, 0x99,
-	0xa3, 0xb7, 0x61, 0xa7, 0x88, 0x9d, 0x2b, 0x12, 0xf8, 0xb3, 0x85, 0x59, 0x53, 0x92, 0x5e, 0x01,
-	0x7f, 0xae, 0x50, 0xf4, 0x10, 0x76, 0x4b, 0x61, 0xd1, 0x82, 0xba, 0x52, 0x96, 0x03, 0x14, 0xfb,
-	0x7c, 0x0a, 0xcd, 0x90, 0x8a, 0x9f, 0x23, 0x76, 0xa3, 0x5c, 0xd6, 0x39, 0xda, 0xbf, 0x75, 0x0c,
-	0xdf, 0xe6, 0xac, 0xb6, 0x4a, 0x21, 0x96, 0x1e, 0x21, 0xcc, 0xbd, 0x56, 0x36, 0x6b, 0x63, 0x15,
-	0xa3, 0x43, 0xe8, 0x90, 0xb5, 0x19, 0x5b, 0x03, 0x63, 0x54, 0xb7, 0x7b, 0xcb, 0xd4, 0x82, 0x13,
-	0x7c, 0xa6, 0x27, 0xc4, 0x40, 0x56, 0x93, 0x3f, 0x84, 0xd6, 0x37, 0xfe, 0xe4, 0xf4, 0xbb, 0x45,
-	0x4c, 0xcd, 0xb6, 0x6a, 0x45, 0x7e, 0x21, 0xfc, 0x89, 0xeb, 0x88, 0x45, 0x4c, 0x71, 0x49, 0x4b,
-	0xe9, 0xe5, 0x59, 0xde, 0x57, 0x13, 0x56, 0xd2, 0x79, 0xe0, 0xe4, 0xd7, 0x12, 0x97, 0x34, 0x7a,
-	0x13, 0x1a, 0x97, 0x67, 0x38, 0x9a, 0xd1, 0xdc, 0xc0, 0x76, 0x27, 0x4b, 0xad, 0xe6, 0x3c, 0x70,
-	0x58, 0x34, 0xa3, 0x58, 0x53, 0xe8, 0x43, 0xe8, 0x9e, 0xce, 0xa2, 0xc4, 0x3b, 0x67, 0xd1, 0xdc,
-	0xf7, 0x28, 0x53, 0x4e, 0x6e, 0xdb, 0x28, 0x4b, 0xad, 0x9e, 0x2b, 0x09, 0x27, 0xd6, 0x0c, 0xde,
-	0x14, 0xa2, 0x07, 0x00, 0x57, 0xb3, 0x88, 0x08, 0xb5, 0x42, 0xb3, 0xab, 0xf6, 0xdf, 0x56, 0x88,
-	0x5a, 0xe8, 0x29, 0xb4, 0xcf, 0x59, 0xe4, 0x52, 0xce, 0x29, 0x37, 0x7b, 0x83, 0xea, 0x1d, 0x2d,
-	0xd5, 0x7c, 0xbe, 0x83, 0xb8, 0x10, 0xe3, 0x55, 0xdd, 0xf0, 0xdf, 0x0a, 0x34, 0x75, 0x86, 0xde,
-	0x80, 0xda, 0xca, 0x41, 0x76, 0x2b, 0x4b, 0x2d, 0x95, 0x63, 0xf5, 0x8b, 0xee, 0x43, 0x35, 0xf6,
-	0x3d, 0x65, 0xa4, 0xba, 0xdd, 0xcc, 0x52, 0x4b, 0xa6, 0x58, 0xfe, 0xc8, 0xc2, 0x58, 0x72, 0x55,
-	0xc5, 0xa9, 0x42, 0x99, 0x63, 0xf5, 0x8b, 0x86, 0xd0, 0xe0, 0x82, 0x88, 0x84, 0xe7, 0x1e, 0xb2,
-	0x21, 0x4b, 0x2d, 0x8d, 0x60, 0xfd, 0x2f, 0x5f, 0xa1, 0x09, 0x71, 0x6f, 0xa6, 0x2c, 0x4a, 0x42,
-	0x4f, 0x39, 0x48, 0xbf, 0x42, 0x2b, 0x14, 0xaf, 0xc5, 0xe8, 0x2d, 0x68, 0xb2, 0x24, 0x0c, 0xfd,
-	0x70, 0xaa, 0x9f, 0x2c, 0xd5, 0x7a, 0x0d, 0xe1, 0x22, 0x90, 0x32, 0x97, 0x51, 0x22, 0xa8, 0xa7,
-	0xec, 0x53, 0xcd, 0x65, 0x1a, 0xc2, 0x45, 0x80, 0x3e, 0x82, 0x5e, 0x40, 0x83, 0x88, 0x2d, 0x9c,
-	0x98, 0x32, 0x97, 0x86, 0x42, 0x39, 0xaa, 0x92, 0x9f, 0xd1, 0x26, 0x83, 0xbb, 0x79, 0x7e, 0x9e,
-	0xa7, 0xe8, 0x3d, 0xe8, 0xb8, 0x71, 0x52, 0xd6, 0x49, 0x6f, 0x19, 0xf6, 0x4e, 0x96, 0x5a, 0xeb,
-	0x30, 0x06, 0x37, 0x4e, 0x74, 0xc5, 0xf0, 0x47, 0x68, 0x6a, 0xa7, 0xa3, 0x0b, 0x00, 0x3f, 0x14,
-	0x94, 0x5d, 0x11, 0x97, 0xca, 0x0f, 0x89, 0x3c, 0x42, 0xeb, 0xee, 0x5b, 0xf1, 0x55, 0xa1, 0xb3,
-	0x91, 0xbc, 0x1e, 0xb2, 0x35, 0xab, 0x52, 0xbc, 0x16, 0x0f, 0x43, 0xd8, 0xbd, 0x5d, 0x23, 0xef,
-	0xd0, 0xda, 0xdb, 0x50, 0x9e, 0x67, 0x40, 0x5c, 0xfd, 0x30, 0x34, 0x97, 0xa9, 0x55, 0x3d, 0x3b,
-	0x39, 0xc5, 0x12, 0x43, 0xef, 0x40, 0x9b, 0x78, 0x1e, 0xcb, 0x9d, 0x55, 0x55, 0x1f, 0x1d, 0xe5,
-	0xa0, 0x12, 0xc4, 0xab, 0x70, 0xf8, 0x08, 0x7a, 0x9b, 0x6f, 0x3d, 0x32, 0xa1, 0xa9, 0xdf, 0x31,
-	0x3d, 0x61, 0x91, 0xda, 0x83, 0xff, 0xfe, 0xee, 0x1b, 0x2f, 0x96, 0x7d, 0xe3, 0x8f, 0x65, 0xdf,
-	0x78, 0xb9, 0xec, 0x1b, 0xaf, 0x96, 0x7d, 0xe3, 0xaf, 0x65, 0xdf, 0xf8, 0xfd, 0x9f, 0xfe, 0xd6,
-	0x0f, 0x95, 0xf9, 0xd1, 0xa4, 0xa1, 0xbe, 0xb7, 0xc7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa8,
-	0xe2, 0x42, 0xaa, 0xd0, 0x07, 0x00, 0x00,
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x4d, 0x73, 0xe3, 0x44,
+	0x13, 0x8e, 0xfc, 0xed, 0x76, 0xec, 0x24, 0xb3, 0xb5, 0x79, 0xb5, 0xa9, 0x77, 0x2d, 0x97, 0x29,
+	0x0a, 0x2f, 0xb0, 0x0e, 0x9b, 0x50, 0xcb, 0xc7, 0x89, 0x28, 0x7c, 0x16, 0x84, 0x4d, 0x4d, 0x20,
+	0x07, 0x0e, 0xa8, 0xc6, 0xd2, 0xc4, 0x11, 0xb1, 0x3e, 0x6a, 0x66, 0x64, 0xf0, 0x3f, 0xe0, 0x46,
+	0x71, 0xe3, 0xb8, 0xc7, 0xfd, 0x09, 0x9c, 0x39, 0xed, 0x71, 0x7f, 0x81, 0x0a, 0xcc, 0x4d, 0x17,
+	0xae, 0x1c, 0xa9, 0x19, 0x8d, 0x64, 0x3b, 0x95, 0x8b, 0xdd, 0xfd, 0xf4, 0xd3, 0x33, 0xd3, 0x3d,
+	0xcf, 0xb4, 0x60, 0x9b, 0x86, 0xc2, 0x17, 0x8b, 0x71, 0xcc, 0x22, 0x11, 0xa1, 0x2e, 0xa7, 0x21,
+	0x4f, 0xc6, 0x6e, 0xc4, 0xe8, 0x78, 0x7e, 0x74, 0xf0, 0xee, 0xd4, 0x17, 0xd7, 0xc9, 0x64, 0xec,
+	0x46, 0xc1, 0xe1, 0x34, 0x9a, 0x46, 0x87, 0x8a, 0x35, 0x49, 0xae, 0x3e, 0x9a, 0x3f, 0x19, 0x1f,
+	0x8f, 0x9f, 0x28, 0x50, 0x61, 0xca, 0xca, 0x17, 0x39, 0x80, 0x80, 0x0a, 0x92, 0xdb, 0xc3, 0x5f,
+	0xeb, 0xd0, 0xf8, 0x44, 0xed, 0x80, 0x8e, 0x8b, 0xbd, 0x1c, 0x77, 0x46, 0x38, 0x37, 0x8d, 0x81,
+	0x31, 0x6a, 0xdb, 0xbb, 0x59, 0x6a, 0x6d, 0xe0, 0xb8, 0x93, 0x7b, 0xa7, 0xd2, 0x41, 0xc7, 0xd0,
+	0xe0, 0x0b, 0x2e, 0x68, 0x60, 0x56, 0x07, 0xc6, 0xa8, 0x73, 0x74, 0x7f, 0xbc, 0x71, 0xc2, 0xf1,
+	0x85, 0x0a, 0xda, 0xb5, 0x97, 0xa9, 0xb5, 0x85, 0x35, 0x15, 0xbd, 0x07, 0x5d, 0x9e, 0x4c, 0xb8,
+	0xcb, 0xfc, 0x58, 0xf8, 0x51, 0xc8, 0xcd, 0xda, 0xa0, 0x3a, 0x6a, 0xdb, 0x7b, 0x59, 0x6a, 0x6d,
+	0x06, 0xf0, 0xa6, 0x8b, 0xde, 0x84, 0xf6, 0x8c, 0x70, 0xe1, 0x70, 0x4a, 0x43, 0xb3, 0x3e, 0x30,
+	0x46, 0x55, 0xbb, 0x9b, 0xa5, 0xd6, 0x0a, 0xc4, 0x2d, 0x69, 0x5e, 0x50, 0x1a, 0xa2, 0x31, 0x80,
+	0x47, 0x19, 0x9d, 0xfa, 0x5c, 0x50, 0x66, 0x36, 0x06, 0xc6, 0xa8, 0x65, 0xf7, 0xb2, 0xd4, 0x5a,
+	0x43, 0xf1, 0x9a, 0x8d, 0xbe, 0x84, 0x5e, 0xe1, 0x31, 0x22, 0xb7, 0x33, 0x9b, 0xaa, 0xa2, 0x87,
+	0xb7, 0x2a, 0xfa, 0x78, 0x83, 0xa4, 0x2b, 0xbb, 0x95, 0x8a, 0x10, 0xd4, 0x12, 0x4e, 0x99, 0xd9,
+	0x91, 0x3d, 0xc4, 0xca, 0x46, 0x4f, 0xe1, 0x1e, 0xfd, 0x49, 0xd0, 0xd0, 0xa3, 0x9e, 0x43, 0x84,
+	0x60, 0xfe, 0x24, 0x11, 0x94, 0x9b, 0xdb, 0x03, 0x63, 0xb4, 0x6d, 0xd7, 0xb3, 0xd4, 0x32, 0x1e,
+	0x63, 0x54, 0x30, 0x4e, 0x4a, 0x02, 0xda, 0x87, 0x06, 0xa3, 0x1e, 0x71, 0x85, 0xd9, 0x95, 0x6d,
+	0xc2, 0xda, 0x43, 0xdf, 0x42, 0x4b, 0x5e, 0xa4, 0x47, 0x04, 0x31, 0x7b, 0xea, 0xa8, 0x0f, 0x6e,
+	0x1d, 0xf5, 0xd9, 0xe4, 0x07, 0xea, 0x8a, 0x33, 0x2a, 0x88, 0xdd, 0x97, 0xc7, 0x7c, 0x95, 0x5a,
+	0x46, 0x96, 0x5a, 0xa8, 0x48, 0x7b, 0x3b, 0x0a, 0x7c, 0x41, 0x83, 0x58, 0x2c, 0x70, 0xb9, 0x14,
+	0xfa, 0x0c, 0xee, 0xa9, 0x55, 0x1c, 0x32, 0xa5, 0xa1, 0x70, 0xe6, 0x94, 0x71, 0xd9, 0x8c, 0x1d,
+	0xa5, 0x86, 0xff, 0x65, 0xa9, 0x75, 0x57, 0x18, 0xef, 0x29, 0xf0, 0x44, 0x62, 0x97, 0x39, 0x84,
+	0x1e, 0x03, 0xba, 0xa1, 0x34, 0x26, 0x33, 0x7f, 0x4e, 0x9d, 0x6b, 0x12, 0x7a, 0x33, 0xca, 0xb8,
+	0xb9, 0xab, 0x6a, 0xd8, 0x2b, 0x23, 0x9f, 0xeb, 0xc0, 0x87, 0xad, 0x9f, 0x9f, 0x5b, 0x5b, 0x2f,
+	0x9e, 0x5b, 0xc6, 0xf0, 0x8f, 0x1a, 0x34, 0x72, 0xdd, 0xa0, 0x03, 0x68, 0x5d, 0x47, 0x5c, 0x84,
+	0x24, 0xa0, 0xb9, 0x1e, 0x71, 0xe9, 0xa3, 0x7d, 0xa8, 0x44, 0xdc, 0xac, 0xa8, 0x73, 0x35, 0x96,
+	0xa9, 0x55, 0x79, 0x76, 0x81, 0x2b, 0x11, 0x97, 0x39, 0xf1, 0x8c, 0x88, 0xab, 0x88, 0xe5, 0xa2,
+	0x6c, 0xe3, 0xd2, 0x47, 0x6f, 0xc0, 0x4e, 0x61, 0x3b, 0x57, 0x24, 0xf0, 0x67, 0x0b, 0xb3, 0xa6,
+	0x28, 0xbd, 0x02, 0xfe, 0x54, 0xa1, 0xe8, 0x11, 0xec, 0x96, 0xc4, 0xa2, 0x05, 0x75, 0xc5, 0x2c,
+	0x17, 0x28, 0xea, 0x7c, 0x0a, 0xcd, 0x90, 0x8a, 0x1f, 0x23, 0x76, 0xa3, 0x54, 0xd6, 0x39, 0xda,
+	0xbf, 0x75, 0x0d, 0x5f, 0xe7, 0x51, 0x2d, 0x95, 0x82, 0x2c, 0x35, 0x42, 0x98, 0x7b, 0xad, 0x64,
+	0xd6, 0xc6, 0xca, 0x46, 0x87, 0xd0, 0x21, 0x6b, 0x3b, 0xb6, 0x06, 0xc6, 0xa8, 0x6e, 0xf7, 0x96,
+	0xa9, 0x05, 0x27, 0xf8, 0x4c, 0x6f, 0x88, 0x81, 0xac, 0x36, 0x7f, 0x04, 0xad, 0xaf, 0xfc, 0xc9,
+	0xe9, 0x37, 0x8b, 0x98, 0x9a, 0x6d, 0xd5, 0x8a, 0xfc, 0x41, 0xf8, 0x13, 0xd7, 0x11, 0x8b, 0x98,
+	0xe2, 0x32, 0x2c, 0xa9, 0x97, 0x67, 0x79, 0x5f, 0x4d, 0x58, 0x51, 0xe7, 0x81, 0x93, 0x3f, 0x4b,
+	0x5c, 0x86, 0xd1, 0x6b, 0xd0, 0xb8, 0x3c, 0xc3, 0xd1, 0x8c, 0xe6, 0x02, 0xb6, 0x3b, 0x59, 0x6a,
+	0x35, 0xe7, 0x81, 0xc3, 0xa2, 0x19, 0xc5, 0x3a, 0x84, 0xde, 0x87, 0xee, 0xe9, 0x2c, 0x4a, 0xbc,
+	0x73, 0x16, 0xcd, 0x7d, 0x8f, 0x32, 0xa5, 0xe4, 0xb6, 0x8d, 0xb2, 0xd4, 0xea, 0xb9, 0x32, 0xe0,
+	0xc4, 0x3a, 0x82, 0x37, 0x89, 0xe8, 0x21, 0xc0, 0xd5, 0x2c, 0x22, 0x42, 0x9d, 0xd0, 0xec, 0xaa,
+	0xfa, 0xdb, 0x0a, 0x51, 0x07, 0x3d, 0x85, 0xf6, 0x39, 0x8b, 0x5c, 0xca, 0x39, 0xe5, 0x66, 0x6f,
+	0x50, 0xbd, 0xa3, 0xa5, 0x3a, 0x9e, 0x57, 0x10, 0x17, 0x64, 0xbc, 0xca, 0x1b, 0xfe, 0x53, 0x81,
+	0xa6, 0xf6, 0xd0, 0xff, 0xa1, 0xb6, 0x52, 0x90, 0xdd, 0xca, 0x52, 0x4b, 0xf9, 0x58, 0xfd, 0xa2,
+	0x07, 0x50, 0x8d, 0x7d, 0x4f, 0x09, 0xa9, 0x6e, 0x37, 0xb3, 0xd4, 0x92, 0x2e, 0x96, 0x3f, 0x32,
+	0x31, 0x96, 0xb1, 0xaa, 0x8a, 0xa9, 0x44, 0xe9, 0x63, 0xf5, 0x8b, 0x86, 0xd0, 0xe0, 0x82, 0x88,
+	0x84, 0xe7, 0x1a, 0xb2, 0x21, 0x4b, 0x2d, 0x8d, 0x60, 0xfd, 0x2f, 0xa7, 0xd0, 0x84, 0xb8, 0x37,
+	0x53, 0x16, 0x25, 0xa1, 0xa7, 0x14, 0xa4, 0xa7, 0xd0, 0x0a, 0xc5, 0x6b, 0x36, 0x7a, 0x1d, 0x9a,
+	0x2c, 0x09, 0x43, 0x3f, 0x9c, 0xea, 0x91, 0xa5, 0x5a, 0xaf, 0x21, 0x5c, 0x18, 0x92, 0xe6, 0x32,
+	0x4a, 0x04, 0xf5, 0x94, 0x7c, 0xaa, 0x39, 0x4d, 0x43, 0xb8, 0x30, 0xd0, 0x07, 0xd0, 0x0b, 0x68,
+	0x10, 0xb1, 0x85, 0x13, 0x53, 0xe6, 0xd2, 0x50, 0x28, 0x45, 0x55, 0xf2, 0x3b, 0xda, 0x8c, 0xe0,
+	0x6e, 0xee, 0x9f, 0xe7, 0x2e, 0x7a, 0x07, 0x3a, 0x6e, 0x9c, 0x94, 0x79, 0x52, 0x5b, 0x86, 0xbd,
+	0x93, 0xa5, 0xd6, 0x3a, 0x8c, 0xc1, 0x8d, 0x13, 0x9d, 0x31, 0xfc, 0x1e, 0x9a, 0x5a, 0xe9, 0xe8,
+	0x02, 0xc0, 0x0f, 0x05, 0x65, 0x57, 0xc4, 0xa5, 0xf2, 0x43, 0x22, 0xaf, 0xd0, 0xba, 0xfb, 0x55,
+	0x7c, 0x51, 0xf0, 0x6c, 0x24, 0x9f, 0x87, 0x6c, 0xcd, 0x2a, 0x15, 0xaf, 0xd9, 0xc3, 0x10, 0x76,
+	0x6f, 0xe7, 0xc8, 0x37, 0xb4, 0x36, 0x1b, 0xca, 0xfb, 0x0c, 0x88, 0xab, 0x07, 0x43, 0x73, 0x99,
+	0x5a, 0xd5, 0xb3, 0x93, 0x53, 0x2c, 0x31, 0xf4, 0x16, 0xb4, 0x89, 0xe7, 0xb1, 0x5c, 0x59, 0x55,
+	0xf5, 0xd1, 0x51, 0x0a, 0x2a, 0x41, 0xbc, 0x32, 0x87, 0xbf, 0x18, 0xd0, 0xdb, 0x1c, 0xf6, 0xc8,
+	0x84, 0xa6, 0x1e, 0x64, 0x7a, 0xc7, 0xc2, 0x45, 0x47, 0xd0, 0x2a, 0x47, 0x5c, 0x45, 0x2d, 0xbc,
+	0x2f, 0x27, 0x6d, 0x81, 0xad, 0x4f, 0xda, 0x02, 0x43, 0x87, 0xd0, 0x8c, 0xc9, 0x62, 0x16, 0x91,
+	0x5c, 0x60, 0x6d, 0xfb, 0x7e, 0x96, 0x5a, 0x7b, 0x1a, 0x5a, 0xcb, 0x28, 0x58, 0xf6, 0xe0, 0xdf,
+	0xbf, 0xfa, 0xc6, 0x8b, 0x65, 0xdf, 0xf8, 0x7d, 0xd9, 0x37, 0x5e, 0x2e, 0xfb, 0xc6, 0xab, 0x65,
+	0xdf, 0xf8, 0x73, 0xd9, 0x37, 0x7e, 0xfb, 0xbb, 0xbf, 0xf5, 0x5d, 0x65, 0x7e, 0x34, 0x69, 0xa8,
+	0xaf, 0xfa, 0xf1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xd0, 0xec, 0xbd, 0x36, 0x08, 0x00,
+	0x00,
 }
 
 func (this *Entity) Equal(that interface{}) bool {
@@ -888,6 +911,17 @@ func (this *Deregistration) Equal(that interface{}) bool {
 	if this.Handler != that1.Handler {
 		return false
 	}
+	if len(this.Handlers) != len(that1.Handlers) {
+		return false
+	}
+	for i := range this.Handlers {
+		if this.Handlers[i] != that1.Handlers[i] {
+			return false
+		}
+	}
+	if this.Payload != that1.Payload {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1448,6 +1482,22 @@ func (m *Deregistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Handlers) > 0 {
+		for iNdEx := len(m.Handlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Handlers[iNdEx])
+			copy(dAtA[i:], m.Handlers[iNdEx])
+			i = encodeVarintEntity(dAtA, i, uint64(len(m.Handlers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Handler) > 0 {
 		i -= len(m.Handler)
 		copy(dAtA[i:], m.Handler)
@@ -1609,8 +1659,14 @@ func NewPopulatedNetworkInterface(r randyEntity, easy bool) *NetworkInterface {
 func NewPopulatedDeregistration(r randyEntity, easy bool) *Deregistration {
 	this := &Deregistration{}
 	this.Handler = string(randStringEntity(r))
+	v13 := r.Intn(10)
+	this.Handlers = make([]string, v13)
+	for i := 0; i < v13; i++ {
+		this.Handlers[i] = string(randStringEntity(r))
+	}
+	this.Payload = string(randStringEntity(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedEntity(r, 2)
+		this.XXX_unrecognized = randUnrecognizedEntity(r, 4)
 	}
 	return this
 }
@@ -1634,9 +1690,9 @@ func randUTF8RuneEntity(r randyEntity) rune {
 	return rune(ru + 61)
 }
 func randStringEntity(r randyEntity) string {
-	v13 := r.Intn(100)
-	tmps := make([]rune, v13)
-	for i := 0; i < v13; i++ {
+	v14 := r.Intn(100)
+	tmps := make([]rune, v14)
+	for i := 0; i < v14; i++ {
 		tmps[i] = randUTF8RuneEntity(r)
 	}
 	return string(tmps)
@@ -1658,11 +1714,11 @@ func randFieldEntity(dAtA []byte, r randyEntity, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEntity(dAtA, uint64(key))
-		v14 := r.Int63()
+		v15 := r.Int63()
 		if r.Intn(2) == 0 {
-			v14 *= -1
+			v15 *= -1
 		}
-		dAtA = encodeVarintPopulateEntity(dAtA, uint64(v14))
+		dAtA = encodeVarintPopulateEntity(dAtA, uint64(v15))
 	case 1:
 		dAtA = encodeVarintPopulateEntity(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1907,6 +1963,16 @@ func (m *Deregistration) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntity(uint64(l))
 	}
+	if len(m.Handlers) > 0 {
+		for _, s := range m.Handlers {
+			l = len(s)
+			n += 1 + l + sovEntity(uint64(l))
+		}
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3365,6 +3431,70 @@ func (m *Deregistration) Unmarshal(dAtA []byte) error {
 			}
 			m.Handler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntity(dAtA[iNdEx:])
@@ -34,6 +34,15 @@ func TestFixtureEntityIsValid(t *testing.T) {
 	assert.NoError(t, e.Validate())
 }
 
+func TestDeregistrationAllHandlers(t *testing.T) {
+	d := Deregistration{}
+	assert.Equal(t, []string{}, d.AllHandlers())
+
+	d.Handler = "slack"
+	d.Handlers = []string{"cmdb", "slack"}
+	assert.Equal(t, []string{"slack", "cmdb"}, d.AllHandlers())
+}
+
 func TestEntityUnmarshal(t *testing.T) {
 	entity := Entity{}
 
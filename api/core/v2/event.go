@@ -122,6 +122,25 @@ func (e *Event) IsSilenced() bool {
 	return len(e.Check.Silenced) > 0
 }
 
+// IsInMaintenance determines if an event was received during a maintenance
+// window affecting it.
+func (e *Event) IsInMaintenance() bool {
+	if !e.HasCheck() {
+		return false
+	}
+
+	return len(e.Check.Maintenance) > 0
+}
+
+// IsFlapping determines if an event is flapping.
+func (e *Event) IsFlapping() bool {
+	if !e.HasCheck() {
+		return false
+	}
+
+	return e.Check.State == EventFlappingState
+}
+
 // IsFlappingStart determines if an event started flapping on this occurrence.
 func (e *Event) IsFlappingStart() bool {
 	if !e.HasCheck() {
@@ -158,6 +177,7 @@ func (e *Event) SynthesizeExtras() map[string]interface{} {
 		"is_incident":       e.IsIncident(),
 		"is_resolution":     e.IsResolution(),
 		"is_silenced":       e.IsSilenced(),
+		"is_flapping":       e.IsFlapping(),
 		"is_flapping_start": e.IsFlappingStart(),
 		"is_flapping_end":   e.IsFlappingEnd(),
 	}
@@ -38,7 +38,10 @@ type Event struct {
 	// Metadata contains name, namespace, labels and annotations
 	ObjectMeta `protobuf:"bytes,5,opt,name=metadata,proto3,embedded=metadata" json:"metadata"`
 	// ID is the unique identifier of the event.
-	ID                   []byte   `protobuf:"bytes,6,opt,name=ID,proto3" json:"id"`
+	ID []byte `protobuf:"bytes,6,opt,name=ID,proto3" json:"id"`
+	// TraceParent is the W3C trace context of the span which last handled the
+	// event, propagating its trace from the agent to the handlers.
+	TraceParent          string   `protobuf:"bytes,7,opt,name=trace_parent,json=traceParent,proto3" json:"trace_parent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -84,29 +87,32 @@ func init() {
 func init() { proto.RegisterFile("event.proto", fileDescriptor_2d17a9d3f0ddf27e) }
 
 var fileDescriptor_2d17a9d3f0ddf27e = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4f, 0xfa, 0x40,
-	0x18, 0xc6, 0x79, 0x0b, 0xf4, 0xcf, 0xff, 0x80, 0xe5, 0x82, 0xa4, 0x12, 0x73, 0xd7, 0x38, 0x31,
-	0x1d, 0x02, 0xc6, 0xc1, 0xc9, 0x54, 0x18, 0x88, 0x21, 0x26, 0x1d, 0xdd, 0xda, 0x72, 0x42, 0x35,
-	0xa5, 0x84, 0x1e, 0x4d, 0xfc, 0x06, 0x7e, 0x04, 0x47, 0x46, 0x76, 0x17, 0x3f, 0x02, 0x23, 0x9f,
-	0xa0, 0xd1, 0xba, 0xf1, 0x09, 0x1c, 0x0d, 0xd7, 0x13, 0x23, 0xdb, 0xfb, 0x3e, 0xcf, 0xf3, 0x7b,
-	0xf3, 0xdc, 0xa1, 0x32, 0x8f, 0xf9, 0x54, 0xb0, 0xd9, 0x3c, 0x14, 0x21, 0xae, 0x46, 0x7c, 0x1a,
-	0x2d, 0x98, 0x17, 0xce, 0x39, 0x8b, 0x3b, 0x8d, 0xf3, 0xb1, 0x2f, 0x26, 0x0b, 0x97, 0x79, 0x61,
-	0xd0, 0x1a, 0x87, 0xe3, 0xb0, 0x25, 0x53, 0xee, 0xe2, 0xfe, 0x2a, 0x6e, 0xb3, 0x2e, 0x6b, 0x4b,
-	0x51, 0x6a, 0x72, 0xca, 0x8e, 0x34, 0x2a, 0x7c, 0x2a, 0x7c, 0xf1, 0xa4, 0xb6, 0xb2, 0x37, 0xe1,
-	0xde, 0xa3, 0x5a, 0xaa, 0x01, 0x17, 0x73, 0xdf, 0x8b, 0xd4, 0x8a, 0x02, 0x2e, 0x9c, 0x6c, 0x3e,
-	0x7d, 0xd5, 0x50, 0xb1, 0xbf, 0xab, 0x82, 0x4f, 0xd0, 0x7f, 0xe1, 0x07, 0x3c, 0x12, 0x4e, 0x30,
-	0x33, 0xc0, 0x84, 0x66, 0xde, 0xfe, 0x15, 0x70, 0x17, 0xe9, 0xd9, 0x7d, 0x43, 0x33, 0xa1, 0x59,
-	0xee, 0x1c, 0xb1, 0x3f, 0x9d, 0x59, 0x5f, 0x9a, 0x56, 0x61, 0x9d, 0x50, 0xb0, 0x55, 0x14, 0x9f,
-	0xa1, 0xa2, 0xac, 0x61, 0xe4, 0x25, 0x53, 0x3b, 0x60, 0xae, 0x77, 0x9e, 0x42, 0xb2, 0x20, 0xbe,
-	0x40, 0xff, 0x54, 0x57, 0xa3, 0x20, 0x99, 0xfa, 0x01, 0x33, 0xcc, 0x5c, 0x45, 0xfd, 0x84, 0xf1,
-	0x0d, 0x2a, 0xed, 0x1e, 0x35, 0x72, 0x84, 0x63, 0x14, 0x25, 0x78, 0x7c, 0x00, 0xde, 0xba, 0x0f,
-	0xdc, 0x13, 0x43, 0x2e, 0x1c, 0xab, 0xb6, 0x4e, 0x68, 0x6e, 0x93, 0x50, 0xd8, 0x26, 0x74, 0x8f,
-	0xd9, 0xfb, 0x09, 0xd7, 0x91, 0x36, 0xe8, 0x19, 0xba, 0x09, 0xcd, 0x8a, 0xa5, 0x6f, 0x13, 0xaa,
-	0xf9, 0x23, 0x5b, 0x1b, 0xf4, 0x2e, 0x4b, 0xcf, 0x4b, 0x9a, 0x5b, 0x2d, 0x29, 0x58, 0xe6, 0xd7,
-	0x07, 0x81, 0x55, 0x4a, 0xe0, 0x2d, 0x25, 0xb0, 0x4e, 0x09, 0x6c, 0x52, 0x02, 0xef, 0x29, 0x81,
-	0x97, 0x4f, 0x92, 0xbb, 0xd3, 0xe2, 0x8e, 0xab, 0xcb, 0xef, 0xed, 0x7e, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0xf4, 0x22, 0xff, 0x4f, 0xe8, 0x01, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0xce, 0xd2, 0x40,
+	0x14, 0xc5, 0x99, 0x02, 0x05, 0xa6, 0xb0, 0x99, 0x20, 0xa9, 0xc4, 0x74, 0x1a, 0x57, 0x35, 0x31,
+	0x83, 0x80, 0x71, 0xe1, 0xca, 0x54, 0x58, 0x10, 0x25, 0x9a, 0xc6, 0x95, 0x1b, 0xd3, 0x96, 0x11,
+	0xaa, 0xe9, 0x9f, 0xb4, 0x97, 0x26, 0x2c, 0xdd, 0xf9, 0x08, 0x2e, 0x59, 0xf2, 0x08, 0x3e, 0x02,
+	0x4b, 0x9e, 0xa0, 0xd1, 0xba, 0xe3, 0x09, 0x5c, 0x9a, 0x4e, 0x2b, 0x7c, 0x1f, 0xbb, 0x7b, 0xcf,
+	0x39, 0xbf, 0x9b, 0x33, 0x19, 0xac, 0xf0, 0x94, 0x07, 0xc0, 0xa2, 0x38, 0x84, 0x90, 0xf4, 0x12,
+	0x1e, 0x24, 0x5b, 0xe6, 0x86, 0x31, 0x67, 0xe9, 0x64, 0xf8, 0x7c, 0xed, 0xc1, 0x66, 0xeb, 0x30,
+	0x37, 0xf4, 0x47, 0xeb, 0x70, 0x1d, 0x8e, 0x44, 0xca, 0xd9, 0x7e, 0x7e, 0x95, 0x8e, 0xd9, 0x94,
+	0x8d, 0x85, 0x28, 0x34, 0x31, 0x95, 0x47, 0x86, 0x5d, 0x1e, 0x80, 0x07, 0xbb, 0x6a, 0x53, 0xdc,
+	0x0d, 0x77, 0xbf, 0x56, 0x4b, 0xcf, 0xe7, 0x10, 0x7b, 0x6e, 0x52, 0xad, 0xd8, 0xe7, 0x60, 0x97,
+	0xf3, 0xe3, 0x6f, 0x75, 0xdc, 0x9c, 0x17, 0x55, 0xc8, 0x23, 0xdc, 0x01, 0xcf, 0xe7, 0x09, 0xd8,
+	0x7e, 0xa4, 0x22, 0x1d, 0x19, 0x75, 0xeb, 0x2a, 0x90, 0x29, 0x96, 0xcb, 0xfb, 0xaa, 0xa4, 0x23,
+	0x43, 0x99, 0x3c, 0x60, 0xf7, 0x3a, 0xb3, 0xb9, 0x30, 0xcd, 0xc6, 0x31, 0xa3, 0xc8, 0xaa, 0xa2,
+	0xe4, 0x19, 0x6e, 0x8a, 0x1a, 0x6a, 0x5d, 0x30, 0xfd, 0x1b, 0xe6, 0x75, 0xe1, 0x55, 0x48, 0x19,
+	0x24, 0x2f, 0x70, 0xab, 0xea, 0xaa, 0x36, 0x04, 0x33, 0xb8, 0x61, 0x96, 0xa5, 0x5b, 0x51, 0xff,
+	0xc3, 0xe4, 0x0d, 0x6e, 0x17, 0x8f, 0x5a, 0xd9, 0x60, 0xab, 0x4d, 0x01, 0x3e, 0xbc, 0x01, 0xdf,
+	0x39, 0x5f, 0xb8, 0x0b, 0x4b, 0x0e, 0xb6, 0xd9, 0x3f, 0x66, 0xb4, 0x76, 0xca, 0x28, 0x3a, 0x67,
+	0xf4, 0x82, 0x59, 0x97, 0x89, 0x0c, 0xb0, 0xb4, 0x98, 0xa9, 0xb2, 0x8e, 0x8c, 0xae, 0x29, 0x9f,
+	0x33, 0x2a, 0x79, 0x2b, 0x4b, 0x5a, 0xcc, 0xc8, 0x5b, 0xdc, 0x85, 0xd8, 0x76, 0xf9, 0xa7, 0xc8,
+	0x8e, 0x79, 0x00, 0x6a, 0x4b, 0x47, 0x46, 0xc7, 0x7c, 0x92, 0x67, 0x54, 0xf9, 0x50, 0xe8, 0xef,
+	0x85, 0x7c, 0xce, 0xe8, 0xe0, 0x6e, 0xec, 0x69, 0xe8, 0x7b, 0xc0, 0xfd, 0x08, 0x76, 0x96, 0x02,
+	0xd7, 0xd8, 0xcb, 0xf6, 0xf7, 0x3d, 0xad, 0x1d, 0xf6, 0x14, 0x99, 0xfa, 0xdf, 0xdf, 0x1a, 0x3a,
+	0xe4, 0x1a, 0xfa, 0x99, 0x6b, 0xe8, 0x98, 0x6b, 0xe8, 0x94, 0x6b, 0xe8, 0x57, 0xae, 0xa1, 0x1f,
+	0x7f, 0xb4, 0xda, 0x47, 0x29, 0x9d, 0x38, 0xb2, 0xf8, 0xac, 0xe9, 0xbf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x7d, 0xe2, 0x34, 0x79, 0x36, 0x02, 0x00, 0x00,
 }
 
 func (this *Event) Equal(that interface{}) bool {
@@ -146,6 +152,9 @@ func (this *Event) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ID, that1.ID) {
 		return false
 	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -160,6 +169,7 @@ type EventFace interface {
 	GetMetrics() *Metrics
 	GetObjectMeta() ObjectMeta
 	GetID() []byte
+	GetTraceParent() string
 }
 
 func (this *Event) Proto() github_com_golang_protobuf_proto.Message {
@@ -194,6 +204,10 @@ func (this *Event) GetID() []byte {
 	return this.ID
 }
 
+func (this *Event) GetTraceParent() string {
+	return this.TraceParent
+}
+
 func NewEventFromFace(that EventFace) *Event {
 	this := &Event{}
 	this.Timestamp = that.GetTimestamp()
@@ -202,6 +216,7 @@ func NewEventFromFace(that EventFace) *Event {
 	this.Metrics = that.GetMetrics()
 	this.ObjectMeta = that.GetObjectMeta()
 	this.ID = that.GetID()
+	this.TraceParent = that.GetTraceParent()
 	return this
 }
 
@@ -229,6 +244,13 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TraceParent) > 0 {
+		i -= len(m.TraceParent)
+		copy(dAtA[i:], m.TraceParent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraceParent)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ID) > 0 {
 		i -= len(m.ID)
 		copy(dAtA[i:], m.ID)
@@ -323,8 +345,9 @@ func NewPopulatedEvent(r randyEvent, easy bool) *Event {
 	for i := 0; i < v2; i++ {
 		this.ID[i] = byte(r.Intn(256))
 	}
+	this.TraceParent = string(randStringEvent(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedEvent(r, 7)
+		this.XXX_unrecognized = randUnrecognizedEvent(r, 8)
 	}
 	return this
 }
@@ -428,6 +451,10 @@ func (m *Event) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -663,6 +690,38 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				m.ID = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
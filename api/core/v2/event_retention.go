@@ -0,0 +1,123 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// EventRetentionPoliciesResource is the name of this resource type
+	EventRetentionPoliciesResource = "event-retention-policies"
+
+	// EventArchiveS3 is the provider of the Amazon S3 archives
+	EventArchiveS3 = "s3"
+
+	// EventArchiveGCS is the provider of the Google Cloud Storage archives
+	EventArchiveGCS = "gcs"
+)
+
+// StorePrefix returns the path prefix to this resource in the store
+func (p *EventRetentionPolicy) StorePrefix() string {
+	return EventRetentionPoliciesResource
+}
+
+// URIPath returns the path component of an event retention policy URI.
+func (p *EventRetentionPolicy) URIPath() string {
+	if p.Namespace == "" {
+		return path.Join(URLPrefix, EventRetentionPoliciesResource, url.PathEscape(p.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(p.Namespace), EventRetentionPoliciesResource, url.PathEscape(p.Name))
+}
+
+// Validate returns an error if the event retention policy does not pass
+// validation tests.
+func (p *EventRetentionPolicy) Validate() error {
+	if err := ValidateName(p.Name); err != nil {
+		return errors.New("event retention policy name " + err.Error())
+	}
+
+	if p.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	if p.MaxOccurrences == 0 && p.MaxAge == 0 {
+		return errors.New("max_occurrences or max_age must be set")
+	}
+
+	if p.Archive != nil {
+		return p.Archive.Validate()
+	}
+
+	return nil
+}
+
+// Validate returns an error if the event archive does not pass validation
+// tests.
+func (a *EventArchive) Validate() error {
+	switch a.Provider {
+	case EventArchiveS3:
+		if a.Region == "" && a.Endpoint == "" {
+			return errors.New("the region or the endpoint of an s3 archive must be set")
+		}
+	case EventArchiveGCS:
+	default:
+		return fmt.Errorf("archive provider %q is not supported", a.Provider)
+	}
+
+	if a.Bucket == "" {
+		return errors.New("the bucket of the archive must be set")
+	}
+
+	if a.Endpoint != "" {
+		if _, err := url.ParseRequestURI(a.Endpoint); err != nil {
+			return fmt.Errorf("invalid archive endpoint: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// NewEventRetentionPolicy creates a new EventRetentionPolicy.
+func NewEventRetentionPolicy(meta ObjectMeta) *EventRetentionPolicy {
+	return &EventRetentionPolicy{ObjectMeta: meta}
+}
+
+// FixtureEventRetentionPolicy returns an EventRetentionPolicy fixture for
+// testing.
+func FixtureEventRetentionPolicy(name string) *EventRetentionPolicy {
+	return &EventRetentionPolicy{
+		ObjectMeta:     NewObjectMeta(name, "default"),
+		MaxOccurrences: 100,
+		MaxAge:         86400,
+	}
+}
+
+// EventRetentionPolicyFields returns a set of fields that represent that
+// resource
+func EventRetentionPolicyFields(r Resource) map[string]string {
+	resource := r.(*EventRetentionPolicy)
+	fields := map[string]string{
+		"event_retention_policy.name":      resource.ObjectMeta.Name,
+		"event_retention_policy.namespace": resource.ObjectMeta.Namespace,
+	}
+	if resource.Archive != nil {
+		fields["event_retention_policy.archive.provider"] = resource.Archive.Provider
+	}
+	return fields
+}
+
+// SetNamespace sets the namespace of the resource.
+func (p *EventRetentionPolicy) SetNamespace(namespace string) {
+	p.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (p *EventRetentionPolicy) SetObjectMeta(meta ObjectMeta) {
+	p.ObjectMeta = meta
+}
+
+func (p *EventRetentionPolicy) RBACName() string {
+	return "event-retention-policies"
+}
@@ -0,0 +1,1079 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: event_retention.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// An EventRetentionPolicy keeps the history of the events of its namespace,
+// and limits how much of it is retained. The occurrences of an event beyond
+// the limits are expired, and archived before their deletion if the policy
+// has an archive. A limit of 0 means no limit.
+type EventRetentionPolicy struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// event retention policy
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// MaxOccurrences is the number of occurrences of each event retained in its
+	// history.
+	MaxOccurrences uint32 `protobuf:"varint,2,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	// MaxAge is the number of seconds the occurrences of the events are
+	// retained in their history.
+	MaxAge uint32 `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Archive is the bucket where the expired occurrences are archived before
+	// their deletion, if any.
+	Archive              *EventArchive `protobuf:"bytes,4,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EventRetentionPolicy) Reset()         { *m = EventRetentionPolicy{} }
+func (m *EventRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventRetentionPolicy) ProtoMessage()    {}
+func (*EventRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_971ca3da215691fa, []int{0}
+}
+func (m *EventRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRetentionPolicy.Merge(m, src)
+}
+func (m *EventRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRetentionPolicy proto.InternalMessageInfo
+
+// An EventArchive is an object storage bucket where the expired occurrences
+// of the events are archived, as newline-delimited JSON.
+type EventArchive struct {
+	// Provider is the object storage provider of the bucket, either s3 or gcs.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider"`
+	// Bucket is the name of the bucket.
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket"`
+	// Prefix is the prefix of the names of the archived objects.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Region is the region of an s3 bucket.
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// Endpoint is the URL of the object storage API, instead of the default
+	// endpoint of the provider, e.g. for S3-compatible storages.
+	Endpoint             string   `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventArchive) Reset()         { *m = EventArchive{} }
+func (m *EventArchive) String() string { return proto.CompactTextString(m) }
+func (*EventArchive) ProtoMessage()    {}
+func (*EventArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_971ca3da215691fa, []int{1}
+}
+func (m *EventArchive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventArchive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventArchive.Merge(m, src)
+}
+func (m *EventArchive) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventArchive proto.InternalMessageInfo
+
+func (m *EventArchive) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventArchive) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *EventArchive) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *EventArchive) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *EventArchive) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventRetentionPolicy)(nil), "sensu.core.v2.EventRetentionPolicy")
+	proto.RegisterType((*EventArchive)(nil), "sensu.core.v2.EventArchive")
+}
+
+func init() { proto.RegisterFile("event_retention.proto", fileDescriptor_971ca3da215691fa) }
+
+var fileDescriptor_971ca3da215691fa = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0xc6, 0x6f, 0x0f, 0xb8, 0xdc, 0x2d, 0x09, 0x7f, 0x56, 0x09, 0x72, 0x82, 0xf0, 0x9e, 0xae,
+	0xba, 0x22, 0xda, 0x28, 0x17, 0x2a, 0x2a, 0x62, 0x09, 0x2a, 0x50, 0xd0, 0x49, 0x34, 0x34, 0xd1,
+	0x7a, 0x6f, 0xe2, 0x2c, 0x60, 0xaf, 0xb5, 0xb7, 0xb6, 0x2e, 0x6f, 0xc0, 0x23, 0x50, 0xa6, 0xcc,
+	0x23, 0xd0, 0xd0, 0xa7, 0xcc, 0x13, 0x58, 0x60, 0x3a, 0x8b, 0x07, 0xa0, 0x44, 0xde, 0xb5, 0x0f,
+	0x93, 0xca, 0xa3, 0xdf, 0x7e, 0xf3, 0x8d, 0xbe, 0x19, 0xe3, 0x1d, 0xc8, 0x21, 0x31, 0xa7, 0x1a,
+	0x0c, 0x24, 0x46, 0xaa, 0x84, 0xa5, 0x5a, 0x19, 0x45, 0xb6, 0x96, 0x90, 0x2c, 0x33, 0x26, 0x94,
+	0x06, 0x96, 0xcf, 0xf6, 0x9e, 0x47, 0xd2, 0x9c, 0x67, 0x21, 0x13, 0x2a, 0x3e, 0x88, 0x54, 0xa4,
+	0x0e, 0xac, 0x2a, 0xcc, 0xce, 0x5e, 0xe6, 0x87, 0xec, 0x88, 0x1d, 0x5a, 0x68, 0x99, 0xad, 0x9c,
+	0xc9, 0x1e, 0x8e, 0xc1, 0x70, 0x57, 0x4f, 0xbe, 0xf7, 0xf1, 0xf6, 0xab, 0x7a, 0xd4, 0xbc, 0x9d,
+	0xf4, 0x4e, 0x7d, 0x96, 0xe2, 0x82, 0xbc, 0xc7, 0xc3, 0x5a, 0xb6, 0xe0, 0x86, 0x7b, 0x68, 0x8c,
+	0xa6, 0xf7, 0x67, 0xbb, 0xec, 0xbf, 0xe1, 0xec, 0x24, 0xfc, 0x08, 0xc2, 0xbc, 0x05, 0xc3, 0x03,
+	0xff, 0xba, 0xa0, 0xbd, 0x9b, 0x82, 0xa2, 0xaa, 0xa0, 0xa4, 0x6d, 0xdb, 0x57, 0xb1, 0x34, 0x10,
+	0xa7, 0xe6, 0x62, 0xbe, 0xb6, 0x22, 0xaf, 0xf1, 0xc3, 0x98, 0xaf, 0x4e, 0x95, 0x10, 0x99, 0xd6,
+	0x90, 0x08, 0x58, 0x7a, 0xfd, 0x31, 0x9a, 0x6e, 0x05, 0xcf, 0xaa, 0x82, 0xee, 0xde, 0x7a, 0xea,
+	0x38, 0x3c, 0x88, 0xf9, 0xea, 0xe4, 0xdf, 0x0b, 0x61, 0x78, 0xa3, 0x16, 0xf3, 0x08, 0xbc, 0x3b,
+	0xb6, 0x7f, 0xa7, 0x2a, 0xe8, 0xe3, 0x06, 0x75, 0xfa, 0x06, 0x31, 0x5f, 0x1d, 0x47, 0x40, 0xde,
+	0xe0, 0x0d, 0xae, 0xc5, 0xb9, 0xcc, 0xc1, 0xbb, 0x6b, 0xd3, 0x3c, 0xbd, 0x95, 0xc6, 0x2e, 0xe1,
+	0xd8, 0x49, 0x9c, 0x59, 0xa3, 0xef, 0x98, 0xb5, 0x16, 0x2f, 0x86, 0x5f, 0x2e, 0x69, 0xef, 0xea,
+	0x92, 0xa2, 0xc9, 0x6f, 0x84, 0x37, 0xbb, 0xad, 0x64, 0x8a, 0x87, 0xa9, 0x56, 0xb9, 0x5c, 0x80,
+	0xb6, 0x7b, 0x1b, 0x05, 0x9b, 0x55, 0x41, 0xd7, 0x6c, 0xbe, 0xae, 0xc8, 0x04, 0x0f, 0xc2, 0x4c,
+	0x7c, 0x02, 0x63, 0x37, 0x30, 0x0a, 0x70, 0x55, 0xd0, 0x86, 0xcc, 0x9b, 0x2f, 0xd9, 0xc7, 0x83,
+	0x54, 0xc3, 0x99, 0x5c, 0xd9, 0x94, 0xa3, 0x60, 0xbb, 0x2a, 0xe8, 0x23, 0x47, 0xba, 0x21, 0x1d,
+	0xa9, 0xd5, 0x1a, 0x22, 0xa9, 0x12, 0x9b, 0xb1, 0x51, 0x3b, 0xd2, 0x55, 0x3b, 0x42, 0x66, 0x78,
+	0x08, 0xc9, 0x22, 0x55, 0x32, 0x31, 0xde, 0x3d, 0xab, 0x7f, 0x52, 0x9f, 0xaf, 0x65, 0xdd, 0xf3,
+	0xb5, 0x2c, 0x18, 0xff, 0xf9, 0xe9, 0xa3, 0xab, 0xd2, 0x47, 0xdf, 0x4a, 0x1f, 0x5d, 0x97, 0x3e,
+	0xba, 0x29, 0x7d, 0xf4, 0xa3, 0xf4, 0xd1, 0xd7, 0x5f, 0x7e, 0xef, 0x43, 0x3f, 0x9f, 0x85, 0x03,
+	0xfb, 0x5f, 0x1d, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x95, 0x6e, 0x3b, 0xd9, 0xc1, 0x02, 0x00,
+	0x00,
+}
+
+func (this *EventRetentionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventRetentionPolicy)
+	if !ok {
+		that2, ok := that.(EventRetentionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.MaxOccurrences != that1.MaxOccurrences {
+		return false
+	}
+	if this.MaxAge != that1.MaxAge {
+		return false
+	}
+	if !this.Archive.Equal(that1.Archive) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *EventArchive) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventArchive)
+	if !ok {
+		that2, ok := that.(EventArchive)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Provider != that1.Provider {
+		return false
+	}
+	if this.Bucket != that1.Bucket {
+		return false
+	}
+	if this.Prefix != that1.Prefix {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Endpoint != that1.Endpoint {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type EventRetentionPolicyFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetMaxOccurrences() uint32
+	GetMaxAge() uint32
+	GetArchive() *EventArchive
+}
+
+func (this *EventRetentionPolicy) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *EventRetentionPolicy) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewEventRetentionPolicyFromFace(this)
+}
+
+func (this *EventRetentionPolicy) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *EventRetentionPolicy) GetMaxOccurrences() uint32 {
+	return this.MaxOccurrences
+}
+
+func (this *EventRetentionPolicy) GetMaxAge() uint32 {
+	return this.MaxAge
+}
+
+func (this *EventRetentionPolicy) GetArchive() *EventArchive {
+	return this.Archive
+}
+
+func NewEventRetentionPolicyFromFace(that EventRetentionPolicyFace) *EventRetentionPolicy {
+	this := &EventRetentionPolicy{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.MaxOccurrences = that.GetMaxOccurrences()
+	this.MaxAge = that.GetMaxAge()
+	this.Archive = that.GetArchive()
+	return this
+}
+
+func (m *EventRetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Archive != nil {
+		{
+			size, err := m.Archive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEventRetention(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintEventRetention(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxOccurrences != 0 {
+		i = encodeVarintEventRetention(dAtA, i, uint64(m.MaxOccurrences))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEventRetention(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventArchive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventArchive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventArchive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintEventRetention(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintEventRetention(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintEventRetention(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintEventRetention(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEventRetention(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventRetention(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventRetention(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedEventRetentionPolicy(r randyEventRetention, easy bool) *EventRetentionPolicy {
+	this := &EventRetentionPolicy{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.MaxOccurrences = uint32(r.Uint32())
+	this.MaxAge = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.Archive = NewPopulatedEventArchive(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedEventRetention(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedEventArchive(r randyEventRetention, easy bool) *EventArchive {
+	this := &EventArchive{}
+	this.Provider = string(randStringEventRetention(r))
+	this.Bucket = string(randStringEventRetention(r))
+	this.Prefix = string(randStringEventRetention(r))
+	this.Region = string(randStringEventRetention(r))
+	this.Endpoint = string(randStringEventRetention(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedEventRetention(r, 6)
+	}
+	return this
+}
+
+type randyEventRetention interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneEventRetention(r randyEventRetention) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringEventRetention(r randyEventRetention) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneEventRetention(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedEventRetention(r randyEventRetention, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldEventRetention(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldEventRetention(dAtA []byte, r randyEventRetention, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateEventRetention(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateEventRetention(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateEventRetention(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateEventRetention(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateEventRetention(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateEventRetention(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateEventRetention(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *EventRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovEventRetention(uint64(l))
+	if m.MaxOccurrences != 0 {
+		n += 1 + sovEventRetention(uint64(m.MaxOccurrences))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovEventRetention(uint64(m.MaxAge))
+	}
+	if m.Archive != nil {
+		l = m.Archive.Size()
+		n += 1 + l + sovEventRetention(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventArchive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEventRetention(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovEventRetention(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovEventRetention(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovEventRetention(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovEventRetention(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEventRetention(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventRetention(x uint64) (n int) {
+	return sovEventRetention(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventRetention
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOccurrences", wireType)
+			}
+			m.MaxOccurrences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOccurrences |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archive == nil {
+				m.Archive = &EventArchive{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventRetention(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventArchive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventRetention
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventArchive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventArchive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventRetention(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventRetention
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventRetention(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventRetention
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventRetention
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventRetention
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventRetention
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventRetention
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventRetention        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventRetention          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventRetention = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventRetentionPolicyValidate(t *testing.T) {
+	p := FixtureEventRetentionPolicy("foo")
+	assert.NoError(t, p.Validate())
+
+	p.Name = ""
+	assert.Error(t, p.Validate())
+
+	p = FixtureEventRetentionPolicy("foo")
+	p.Namespace = ""
+	assert.Error(t, p.Validate())
+
+	p = FixtureEventRetentionPolicy("foo")
+	p.MaxOccurrences = 0
+	assert.NoError(t, p.Validate())
+	p.MaxAge = 0
+	assert.Error(t, p.Validate())
+}
+
+func TestEventArchiveValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive EventArchive
+		wantErr bool
+	}{
+		{
+			name:    "s3 with a region",
+			archive: EventArchive{Provider: EventArchiveS3, Bucket: "events", Region: "us-east-1"},
+		},
+		{
+			name:    "s3 with an endpoint",
+			archive: EventArchive{Provider: EventArchiveS3, Bucket: "events", Endpoint: "https://minio.example.com"},
+		},
+		{
+			name:    "s3 without region",
+			archive: EventArchive{Provider: EventArchiveS3, Bucket: "events"},
+			wantErr: true,
+		},
+		{
+			name:    "gcs",
+			archive: EventArchive{Provider: EventArchiveGCS, Bucket: "events", Prefix: "sensu"},
+		},
+		{
+			name:    "missing bucket",
+			archive: EventArchive{Provider: EventArchiveGCS},
+			wantErr: true,
+		},
+		{
+			name:    "unknown provider",
+			archive: EventArchive{Provider: "ftp", Bucket: "events"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid endpoint",
+			archive: EventArchive{Provider: EventArchiveGCS, Bucket: "events", Endpoint: "storage"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := FixtureEventRetentionPolicy("foo")
+			p.Archive = &tt.archive
+			if err := p.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -202,6 +202,16 @@ func TestEventIsSilenced(t *testing.T) {
 	}
 }
 
+func TestEventIsFlapping(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	assert.False(t, event.IsFlapping())
+
+	event.Check.State = EventFlappingState
+	assert.True(t, event.IsFlapping())
+
+	assert.False(t, (&Event{}).IsFlapping())
+}
+
 func TestEventIsFlappingStart(t *testing.T) {
 	testCases := []struct {
 		name     string
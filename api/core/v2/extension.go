@@ -18,6 +18,9 @@ func (e *Extension) StorePrefix() string {
 
 // URIPath returns the path component of an extension URI.
 func (e *Extension) URIPath() string {
+	if e.Namespace == "" {
+		return path.Join(URLPrefix, ExtensionsResource, url.PathEscape(e.Name))
+	}
 	return path.Join(URLPrefix, "namespaces", url.PathEscape(e.Namespace), ExtensionsResource, url.PathEscape(e.Name))
 }
 
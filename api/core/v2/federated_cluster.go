@@ -0,0 +1,99 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// FederatedClustersResource is the name of this resource type
+	FederatedClustersResource = "federated-clusters"
+
+	// FederatedClusterLabel is the label of the events and entities listed by
+	// the federated API, whose value is the name of their cluster
+	FederatedClusterLabel = "sensu.io/cluster"
+
+	// FederatedClusterLocal is the value of the federated cluster label of the
+	// events and entities of the cluster itself
+	FederatedClusterLocal = "local"
+
+	// FederationUnavailableHeader is the header of the responses of the
+	// federated API listing the clusters that could not be reached
+	FederationUnavailableHeader = "Sensu-Federation-Unavailable"
+)
+
+// StorePrefix returns the path prefix to this resource in the store
+func (c *FederatedCluster) StorePrefix() string {
+	return FederatedClustersResource
+}
+
+// URIPath returns the path component of a federated cluster URI.
+func (c *FederatedCluster) URIPath() string {
+	return path.Join(URLPrefix, FederatedClustersResource, url.PathEscape(c.Name))
+}
+
+// Validate returns an error if the federated cluster does not pass
+// validation tests.
+func (c *FederatedCluster) Validate() error {
+	if err := ValidateName(c.Name); err != nil {
+		return errors.New("federated cluster name " + err.Error())
+	}
+	if c.Name == FederatedClusterLocal {
+		return fmt.Errorf("federated cluster name %q is reserved", FederatedClusterLocal)
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("federated cluster url is invalid: %s", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("federated cluster url must be an http or https url")
+	}
+
+	if c.APIKey == "" {
+		return errors.New("federated cluster api key must be set")
+	}
+
+	if c.Namespace != "" {
+		return errors.New("federated clusters are not namespaced")
+	}
+
+	return nil
+}
+
+// NewFederatedCluster creates a new FederatedCluster.
+func NewFederatedCluster(meta ObjectMeta) *FederatedCluster {
+	return &FederatedCluster{ObjectMeta: meta}
+}
+
+// FixtureFederatedCluster returns a FederatedCluster fixture for testing.
+func FixtureFederatedCluster(name string) *FederatedCluster {
+	return &FederatedCluster{
+		ObjectMeta: NewObjectMeta(name, ""),
+		URL:        "https://127.0.0.1:8080",
+		APIKey:     "83abef1e-e7d7-4beb-91fc-79ad90084d5b",
+	}
+}
+
+// FederatedClusterFields returns a set of fields that represent that resource
+func FederatedClusterFields(r Resource) map[string]string {
+	resource := r.(*FederatedCluster)
+	return map[string]string{
+		"federated_cluster.name": resource.ObjectMeta.Name,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (c *FederatedCluster) SetNamespace(namespace string) {
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (c *FederatedCluster) SetObjectMeta(meta ObjectMeta) {
+	c.ObjectMeta = meta
+}
+
+func (c *FederatedCluster) RBACName() string {
+	return FederatedClustersResource
+}
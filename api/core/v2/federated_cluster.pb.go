@@ -0,0 +1,693 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: federated_cluster.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A FederatedCluster is a remote cluster whose events and entities are listed
+// along with the events and entities of the cluster, by the federated API, so
+// that many clusters can be monitored from a single one.
+type FederatedCluster struct {
+	// Metadata contains the name, labels and annotations of the federated
+	// cluster. The name labels the events and entities of the cluster.
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// URL is the URL of the API of the remote cluster.
+	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// APIKey is the API key the events and entities of the remote cluster are
+	// listed with.
+	APIKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key"`
+	// CABundle is the PEM-encoded CA certificates used to verify the
+	// certificate of the remote cluster, instead of the system ones.
+	CABundle string `protobuf:"bytes,4,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`
+	// InsecureSkipTLSVerify skips the verification of the certificate of the
+	// remote cluster.
+	InsecureSkipTLSVerify bool     `protobuf:"varint,5,opt,name=insecure_skip_tls_verify,json=insecureSkipTlsVerify,proto3" json:"insecure_skip_tls_verify,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *FederatedCluster) Reset()         { *m = FederatedCluster{} }
+func (m *FederatedCluster) String() string { return proto.CompactTextString(m) }
+func (*FederatedCluster) ProtoMessage()    {}
+func (*FederatedCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf06d245f0cf27d, []int{0}
+}
+func (m *FederatedCluster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FederatedCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FederatedCluster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FederatedCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FederatedCluster.Merge(m, src)
+}
+func (m *FederatedCluster) XXX_Size() int {
+	return m.Size()
+}
+func (m *FederatedCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_FederatedCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FederatedCluster proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FederatedCluster)(nil), "sensu.core.v2.FederatedCluster")
+}
+
+func init() { proto.RegisterFile("federated_cluster.proto", fileDescriptor_daf06d245f0cf27d) }
+
+var fileDescriptor_daf06d245f0cf27d = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0x73, 0x09, 0xa4, 0xee, 0x21, 0x24, 0x64, 0x14, 0xe1, 0x76, 0xb8, 0xb3, 0xca, 0x92,
+	0x01, 0x5d, 0xd5, 0x94, 0x89, 0x85, 0xd6, 0x95, 0x90, 0xaa, 0x16, 0x81, 0x5c, 0xca, 0xc0, 0x62,
+	0x9d, 0xed, 0x2f, 0xe1, 0x88, 0x1d, 0x5b, 0xf6, 0xd9, 0xc2, 0x6f, 0xc0, 0x23, 0x30, 0x76, 0xec,
+	0x23, 0xf0, 0x08, 0x1d, 0xf3, 0x04, 0x27, 0x38, 0x36, 0x3f, 0x01, 0x62, 0x42, 0x39, 0x93, 0x28,
+	0x0c, 0x6c, 0x9f, 0xfe, 0xdf, 0xef, 0xf7, 0xfd, 0x4f, 0x3a, 0xfc, 0x64, 0x0a, 0x31, 0x14, 0x5c,
+	0x42, 0x1c, 0x44, 0x49, 0x55, 0x4a, 0x28, 0x58, 0x5e, 0x64, 0x32, 0xb3, 0x1f, 0x96, 0xb0, 0x28,
+	0x2b, 0x16, 0x65, 0x05, 0xb0, 0x7a, 0xb2, 0xff, 0x7c, 0x26, 0xe4, 0xc7, 0x2a, 0x64, 0x51, 0x96,
+	0x1e, 0xce, 0xb2, 0x59, 0x76, 0x68, 0xa8, 0xb0, 0x9a, 0x9e, 0xd4, 0x47, 0xec, 0x98, 0x1d, 0x99,
+	0xd0, 0x64, 0x66, 0xea, 0x8e, 0xec, 0xe3, 0x14, 0x24, 0xef, 0xe6, 0x83, 0xdf, 0x7d, 0xfc, 0xe8,
+	0xd5, 0xba, 0xec, 0xac, 0xeb, 0xb2, 0xaf, 0xb1, 0xb5, 0x42, 0x62, 0x2e, 0xb9, 0x83, 0x5c, 0x34,
+	0x7e, 0x30, 0xd9, 0x63, 0xff, 0x14, 0xb3, 0x37, 0xe1, 0x27, 0x88, 0xe4, 0x6b, 0x90, 0xdc, 0x23,
+	0x77, 0x8a, 0xf6, 0x96, 0x8a, 0xa2, 0x56, 0x51, 0x7b, 0xad, 0x3d, 0xcb, 0x52, 0x21, 0x21, 0xcd,
+	0x65, 0xe3, 0x6f, 0x4e, 0xd9, 0x7b, 0x78, 0x50, 0x15, 0x89, 0xd3, 0x77, 0xd1, 0x78, 0xd7, 0xdb,
+	0xd1, 0x8a, 0x0e, 0xae, 0xfd, 0x4b, 0x7f, 0x95, 0xd9, 0x0c, 0xef, 0xf0, 0x5c, 0x04, 0x73, 0x68,
+	0x9c, 0x81, 0x59, 0x8f, 0xb4, 0xa2, 0xc3, 0xd3, 0xb7, 0xe7, 0x17, 0xd0, 0xb4, 0x8a, 0xae, 0x97,
+	0xfe, 0x90, 0xe7, 0xe2, 0x02, 0x1a, 0xfb, 0x04, 0xef, 0x46, 0x3c, 0x08, 0xab, 0x45, 0x9c, 0x80,
+	0x73, 0xcf, 0x18, 0x4f, 0xb5, 0xa2, 0xd6, 0xd9, 0xa9, 0x67, 0xb2, 0x56, 0xd1, 0xc7, 0x1b, 0x60,
+	0xfb, 0x31, 0x11, 0xef, 0x00, 0xfb, 0x33, 0x76, 0xc4, 0xa2, 0x84, 0xa8, 0x2a, 0x20, 0x28, 0xe7,
+	0x22, 0x0f, 0x64, 0x52, 0x06, 0x35, 0x14, 0x62, 0xda, 0x38, 0xf7, 0x5d, 0x34, 0xb6, 0xbc, 0x97,
+	0x5a, 0xd1, 0xd1, 0xf9, 0x5f, 0xe6, 0x6a, 0x2e, 0xf2, 0x77, 0x97, 0x57, 0xef, 0x0d, 0xd0, 0x2a,
+	0x7a, 0xf0, 0x3f, 0x79, 0xab, 0x6c, 0x24, 0xb6, 0xe5, 0xa4, 0xec, 0xe4, 0x17, 0xd6, 0x97, 0x1b,
+	0xda, 0xbb, 0xbd, 0xa1, 0xc8, 0x73, 0x7f, 0xfd, 0x20, 0xe8, 0x56, 0x13, 0xf4, 0x4d, 0x13, 0x74,
+	0xa7, 0x09, 0x5a, 0x6a, 0x82, 0xbe, 0x6b, 0x82, 0xbe, 0xfe, 0x24, 0xbd, 0x0f, 0xfd, 0x7a, 0x12,
+	0x0e, 0xcd, 0x2f, 0x1d, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xec, 0xa3, 0x50, 0x10, 0x11, 0x02,
+	0x00, 0x00,
+}
+
+func (this *FederatedCluster) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FederatedCluster)
+	if !ok {
+		that2, ok := that.(FederatedCluster)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.APIKey != that1.APIKey {
+		return false
+	}
+	if this.CABundle != that1.CABundle {
+		return false
+	}
+	if this.InsecureSkipTLSVerify != that1.InsecureSkipTLSVerify {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type FederatedClusterFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetURL() string
+	GetAPIKey() string
+	GetCABundle() string
+	GetInsecureSkipTLSVerify() bool
+}
+
+func (this *FederatedCluster) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *FederatedCluster) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewFederatedClusterFromFace(this)
+}
+
+func (this *FederatedCluster) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *FederatedCluster) GetURL() string {
+	return this.URL
+}
+
+func (this *FederatedCluster) GetAPIKey() string {
+	return this.APIKey
+}
+
+func (this *FederatedCluster) GetCABundle() string {
+	return this.CABundle
+}
+
+func (this *FederatedCluster) GetInsecureSkipTLSVerify() bool {
+	return this.InsecureSkipTLSVerify
+}
+
+func NewFederatedClusterFromFace(that FederatedClusterFace) *FederatedCluster {
+	this := &FederatedCluster{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.URL = that.GetURL()
+	this.APIKey = that.GetAPIKey()
+	this.CABundle = that.GetCABundle()
+	this.InsecureSkipTLSVerify = that.GetInsecureSkipTLSVerify()
+	return this
+}
+
+func (m *FederatedCluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FederatedCluster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FederatedCluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InsecureSkipTLSVerify {
+		i--
+		if m.InsecureSkipTLSVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CABundle) > 0 {
+		i -= len(m.CABundle)
+		copy(dAtA[i:], m.CABundle)
+		i = encodeVarintFederatedCluster(dAtA, i, uint64(len(m.CABundle)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.APIKey) > 0 {
+		i -= len(m.APIKey)
+		copy(dAtA[i:], m.APIKey)
+		i = encodeVarintFederatedCluster(dAtA, i, uint64(len(m.APIKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintFederatedCluster(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFederatedCluster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFederatedCluster(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFederatedCluster(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedFederatedCluster(r randyFederatedCluster, easy bool) *FederatedCluster {
+	this := &FederatedCluster{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.URL = string(randStringFederatedCluster(r))
+	this.APIKey = string(randStringFederatedCluster(r))
+	this.CABundle = string(randStringFederatedCluster(r))
+	this.InsecureSkipTLSVerify = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedFederatedCluster(r, 6)
+	}
+	return this
+}
+
+type randyFederatedCluster interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneFederatedCluster(r randyFederatedCluster) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringFederatedCluster(r randyFederatedCluster) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneFederatedCluster(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedFederatedCluster(r randyFederatedCluster, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldFederatedCluster(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldFederatedCluster(dAtA []byte, r randyFederatedCluster, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateFederatedCluster(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateFederatedCluster(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateFederatedCluster(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateFederatedCluster(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateFederatedCluster(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateFederatedCluster(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateFederatedCluster(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *FederatedCluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovFederatedCluster(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovFederatedCluster(uint64(l))
+	}
+	l = len(m.APIKey)
+	if l > 0 {
+		n += 1 + l + sovFederatedCluster(uint64(l))
+	}
+	l = len(m.CABundle)
+	if l > 0 {
+		n += 1 + l + sovFederatedCluster(uint64(l))
+	}
+	if m.InsecureSkipTLSVerify {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovFederatedCluster(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFederatedCluster(x uint64) (n int) {
+	return sovFederatedCluster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FederatedCluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFederatedCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FederatedCluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FederatedCluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CABundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFederatedCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthFederatedCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFederatedCluster(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFederatedCluster
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFederatedCluster
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFederatedCluster
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFederatedCluster
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFederatedCluster
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFederatedCluster        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFederatedCluster          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFederatedCluster = fmt.Errorf("proto: unexpected end of group")
+)
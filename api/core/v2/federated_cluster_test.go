@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureFederatedCluster(t *testing.T) {
+	fixture := FixtureFederatedCluster("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+}
+
+func TestFederatedClusterValidate(t *testing.T) {
+	var c FederatedCluster
+
+	// Invalid name
+	assert.Error(t, c.Validate())
+	c.Name = FederatedClusterLocal
+	assert.Error(t, c.Validate())
+	c.Name = "eu-west"
+
+	// Invalid url
+	assert.Error(t, c.Validate())
+	c.URL = "ftp://sensu.example.com"
+	assert.Error(t, c.Validate())
+	c.URL = "https://sensu.example.com:8080"
+
+	// Missing api key
+	assert.Error(t, c.Validate())
+	c.APIKey = "key"
+
+	// Invalid namespace
+	c.Namespace = "default"
+	assert.Error(t, c.Validate())
+	c.Namespace = ""
+
+	// Valid federated cluster
+	assert.NoError(t, c.Validate())
+}
@@ -57,8 +57,14 @@ func (f *EventFilter) Validate() error {
 		return fmt.Errorf("action '%s' is not valid", f.Action)
 	}
 
-	if len(f.Expressions) == 0 {
-		return errors.New("filter must have one or more expressions")
+	if len(f.Expressions) == 0 && f.WASMModule == "" {
+		return errors.New("filter must have one or more expressions or a wasm module")
+	}
+
+	if f.WASMModule != "" {
+		if err := validateWASMModule(f.WASMModule); err != nil {
+			return err
+		}
 	}
 
 	if err := js.ParseExpressions(f.Expressions); err != nil {
@@ -85,6 +91,8 @@ func (f *EventFilter) Update(from *EventFilter, fields ...string) error {
 			f.Expressions = append(f.Expressions[0:0], from.Expressions...)
 		case "RuntimeAssets":
 			f.RuntimeAssets = append(f.RuntimeAssets[0:0], from.RuntimeAssets...)
+		case "WASMModule":
+			f.WASMModule = from.WASMModule
 		default:
 			return fmt.Errorf("unsupported field: %q", f)
 		}
@@ -38,7 +38,11 @@ type EventFilter struct {
 	When *TimeWindowWhen `protobuf:"bytes,6,opt,name=when,proto3" json:"when,omitempty"`
 	// Runtime assets are Sensu assets that contain javascript libraries. They
 	// are evaluated within the execution context.
-	RuntimeAssets        []string `protobuf:"bytes,8,rep,name=runtime_assets,json=runtimeAssets,proto3" json:"runtime_assets"`
+	RuntimeAssets []string `protobuf:"bytes,8,rep,name=runtime_assets,json=runtimeAssets,proto3" json:"runtime_assets"`
+	// WASMModule is the name of a WebAssembly module in the lib directory of
+	// the runtime assets. When set, the event matches the filter only if it
+	// also matches the module.
+	WASMModule           string   `protobuf:"bytes,9,opt,name=wasm_module,json=wasmModule,proto3" json:"wasm_module,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -84,29 +88,32 @@ func init() {
 func init() { proto.RegisterFile("filter.proto", fileDescriptor_1f5303cab7a20d6f) }
 
 var fileDescriptor_1f5303cab7a20d6f = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x86, 0x19, 0xb8, 0x21, 0x30, 0x5c, 0xee, 0xcd, 0x9d, 0xc5, 0x4d, 0x2f, 0xc9, 0x9d, 0x36,
-	0xae, 0x58, 0x98, 0x21, 0x05, 0x37, 0xba, 0x52, 0x12, 0xdd, 0x19, 0x93, 0x46, 0x43, 0xe2, 0xc6,
-	0xb4, 0xe5, 0x00, 0x63, 0xec, 0x0c, 0xe9, 0x4c, 0x8b, 0xbe, 0x81, 0x8f, 0xe0, 0x92, 0x9d, 0x3c,
-	0x82, 0x8f, 0xc0, 0x92, 0x27, 0x68, 0xb4, 0xee, 0x78, 0x02, 0x97, 0xc6, 0x01, 0x0d, 0xb2, 0x3b,
-	0xff, 0x77, 0xce, 0x9c, 0xf3, 0xcf, 0x8f, 0x7f, 0x0e, 0xf8, 0x8d, 0x86, 0x98, 0x8d, 0x63, 0xa9,
-	0x25, 0xa9, 0x2b, 0x10, 0x2a, 0x61, 0xa1, 0x8c, 0x81, 0xa5, 0xed, 0xc6, 0xde, 0x90, 0xeb, 0x51,
-	0x12, 0xb0, 0x50, 0x46, 0xad, 0xa1, 0x1c, 0xca, 0x96, 0x99, 0x0a, 0x92, 0xc1, 0x61, 0xea, 0xb2,
-	0x0e, 0x73, 0x0d, 0x34, 0xcc, 0x54, 0xab, 0x25, 0x8d, 0x3f, 0x9a, 0x47, 0x70, 0x35, 0xe1, 0xa2,
-	0x2f, 0x27, 0x6b, 0x84, 0x23, 0xd0, 0xfe, 0xaa, 0xde, 0x79, 0x2c, 0xe2, 0xda, 0x71, 0x0a, 0x42,
-	0x9f, 0x98, 0xcb, 0xe4, 0x02, 0x57, 0x3e, 0xba, 0x7d, 0x5f, 0xfb, 0x16, 0x72, 0x50, 0xb3, 0xd6,
-	0xfe, 0xc7, 0xbe, 0xd9, 0x60, 0x67, 0xc1, 0x35, 0x84, 0xfa, 0x14, 0xb4, 0xdf, 0xa5, 0xf3, 0xcc,
-	0x2e, 0x2c, 0x32, 0x1b, 0x2d, 0x33, 0x9b, 0x7c, 0x3e, 0xdb, 0x95, 0x11, 0xd7, 0x10, 0x8d, 0xf5,
-	0x9d, 0xf7, 0xb5, 0x8a, 0xfc, 0xc5, 0x65, 0x3f, 0xd4, 0x5c, 0x0a, 0xab, 0xe8, 0xa0, 0x66, 0xd5,
-	0x5b, 0x2b, 0xe2, 0xe2, 0x1a, 0xdc, 0x8e, 0x63, 0x50, 0x8a, 0x4b, 0xa1, 0xac, 0x92, 0x53, 0x6a,
-	0x56, 0xbb, 0xbf, 0x97, 0x99, 0xbd, 0x89, 0xbd, 0x4d, 0x41, 0x5c, 0xfc, 0x63, 0x32, 0x02, 0x61,
-	0x95, 0x8d, 0xbb, 0xff, 0x5b, 0xee, 0xce, 0x79, 0x04, 0x3d, 0xf3, 0xd9, 0xde, 0x08, 0x84, 0x67,
-	0x46, 0xc9, 0x3e, 0xfe, 0x15, 0x27, 0xc2, 0x04, 0xe1, 0x2b, 0x05, 0x5a, 0x59, 0x15, 0x73, 0x88,
-	0x2c, 0x33, 0x7b, 0xab, 0xe3, 0xd5, 0xd7, 0xfa, 0xc8, 0xc8, 0x83, 0xca, 0xfd, 0xd4, 0x2e, 0xcc,
-	0xa6, 0x36, 0xea, 0x3a, 0x6f, 0x2f, 0x14, 0xcd, 0x72, 0x8a, 0x9e, 0x72, 0x8a, 0xe6, 0x39, 0x45,
-	0x8b, 0x9c, 0xa2, 0xe7, 0x9c, 0xa2, 0x87, 0x57, 0x5a, 0xb8, 0x2c, 0xa6, 0xed, 0xa0, 0x6c, 0x22,
-	0xed, 0xbc, 0x07, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xa4, 0xe0, 0x84, 0xc6, 0x01, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x39, 0x14, 0x25, 0x6b, 0x0e, 0xc4, 0x4a, 0x20, 0x73, 0x12, 0xbb, 0x16, 0x0d,
+	0x29, 0xd0, 0x9e, 0x9c, 0xa3, 0x81, 0x8a, 0xb3, 0x04, 0xa2, 0x89, 0x90, 0x0c, 0x28, 0x12, 0xcd,
+	0xc9, 0x76, 0xe6, 0x92, 0x45, 0xb7, 0xbb, 0x91, 0x77, 0x6d, 0xc3, 0x1b, 0xf0, 0x08, 0x94, 0x57,
+	0xde, 0x23, 0xf0, 0x08, 0x57, 0xe6, 0x09, 0x2c, 0x30, 0x9d, 0x3b, 0x3a, 0x4a, 0x94, 0x4d, 0x40,
+	0xbe, 0x74, 0xf3, 0x7f, 0xf3, 0x7b, 0xe6, 0xf7, 0x2c, 0xbe, 0x7d, 0x2e, 0x2e, 0x2c, 0xe4, 0x7c,
+	0x95, 0x6b, 0xab, 0xc9, 0xa1, 0x01, 0x65, 0x0a, 0x9e, 0xe9, 0x1c, 0x78, 0x39, 0x39, 0x7a, 0xb6,
+	0x10, 0x76, 0x59, 0xa4, 0x3c, 0xd3, 0xf2, 0x78, 0xa1, 0x17, 0xfa, 0xd8, 0xb9, 0xd2, 0xe2, 0xfc,
+	0x65, 0x19, 0xf2, 0x13, 0x1e, 0x3a, 0xe8, 0x98, 0xab, 0xb6, 0x43, 0x8e, 0xee, 0x59, 0x21, 0xe1,
+	0xac, 0x12, 0x6a, 0xae, 0xab, 0x1d, 0xc2, 0x12, 0x6c, 0xb2, 0xad, 0x1f, 0xff, 0xee, 0x63, 0xef,
+	0x55, 0x09, 0xca, 0xbe, 0x76, 0x9b, 0xc9, 0x07, 0x3c, 0xdc, 0x74, 0xe7, 0x89, 0x4d, 0x7c, 0x14,
+	0xa0, 0xb1, 0x37, 0x79, 0xc8, 0x6f, 0xc4, 0xe0, 0x6f, 0xd3, 0x4f, 0x90, 0xd9, 0x29, 0xd8, 0x24,
+	0xa2, 0xd7, 0x35, 0xeb, 0xad, 0x6b, 0x86, 0xda, 0x9a, 0x91, 0x7f, 0x9f, 0x3d, 0xd5, 0x52, 0x58,
+	0x90, 0x2b, 0xfb, 0x25, 0xfe, 0x3f, 0x8a, 0x3c, 0xc0, 0x83, 0x24, 0xb3, 0x42, 0x2b, 0xbf, 0x1f,
+	0xa0, 0xf1, 0x28, 0xde, 0x29, 0x12, 0x62, 0x0f, 0x3e, 0xaf, 0x72, 0x30, 0x46, 0x68, 0x65, 0xfc,
+	0x83, 0xe0, 0x60, 0x3c, 0x8a, 0xee, 0xb6, 0x35, 0xeb, 0xe2, 0xb8, 0x2b, 0x48, 0x88, 0x6f, 0x55,
+	0x4b, 0x50, 0xfe, 0xc0, 0xa5, 0x7b, 0xb4, 0x97, 0xee, 0xbd, 0x90, 0x30, 0x73, 0x3f, 0x3b, 0x5b,
+	0x82, 0x8a, 0x9d, 0x95, 0x3c, 0xc7, 0x77, 0xf2, 0x42, 0xb9, 0x43, 0x24, 0xc6, 0x80, 0x35, 0xfe,
+	0xd0, 0x2d, 0x22, 0x6d, 0xcd, 0xf6, 0x3a, 0xf1, 0xe1, 0x4e, 0x9f, 0x3a, 0x49, 0xde, 0x60, 0xaf,
+	0x4a, 0x8c, 0x3c, 0x93, 0x7a, 0x5e, 0x5c, 0x80, 0x3f, 0xda, 0xa4, 0x8f, 0x9e, 0x34, 0x35, 0xc3,
+	0xb3, 0xd3, 0x77, 0xd3, 0xa9, 0xa3, 0x6d, 0xcd, 0xee, 0x77, 0x4c, 0x9d, 0x03, 0xe0, 0x0d, 0xde,
+	0x9a, 0x5e, 0x0c, 0xbf, 0x5e, 0xb2, 0xde, 0xd5, 0x25, 0x43, 0x51, 0xf0, 0xe7, 0x27, 0x45, 0x57,
+	0x0d, 0x45, 0xdf, 0x1b, 0x8a, 0xae, 0x1b, 0x8a, 0xd6, 0x0d, 0x45, 0x3f, 0x1a, 0x8a, 0xbe, 0xfd,
+	0xa2, 0xbd, 0x8f, 0xfd, 0x72, 0x92, 0x0e, 0xdc, 0xe3, 0x9c, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0xc4, 0x06, 0x04, 0x1b, 0x10, 0x02, 0x00, 0x00,
 }
 
 func (this *EventFilter) Equal(that interface{}) bool {
@@ -153,6 +160,9 @@ func (this *EventFilter) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.WASMModule != that1.WASMModule {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -166,6 +176,7 @@ type EventFilterFace interface {
 	GetExpressions() []string
 	GetWhen() *TimeWindowWhen
 	GetRuntimeAssets() []string
+	GetWASMModule() string
 }
 
 func (this *EventFilter) Proto() github_com_golang_protobuf_proto.Message {
@@ -196,6 +207,10 @@ func (this *EventFilter) GetRuntimeAssets() []string {
 	return this.RuntimeAssets
 }
 
+func (this *EventFilter) GetWASMModule() string {
+	return this.WASMModule
+}
+
 func NewEventFilterFromFace(that EventFilterFace) *EventFilter {
 	this := &EventFilter{}
 	this.ObjectMeta = that.GetObjectMeta()
@@ -203,6 +218,7 @@ func NewEventFilterFromFace(that EventFilterFace) *EventFilter {
 	this.Expressions = that.GetExpressions()
 	this.When = that.GetWhen()
 	this.RuntimeAssets = that.GetRuntimeAssets()
+	this.WASMModule = that.GetWASMModule()
 	return this
 }
 
@@ -230,6 +246,13 @@ func (m *EventFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.WASMModule) > 0 {
+		i -= len(m.WASMModule)
+		copy(dAtA[i:], m.WASMModule)
+		i = encodeVarintFilter(dAtA, i, uint64(len(m.WASMModule)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.RuntimeAssets) > 0 {
 		for iNdEx := len(m.RuntimeAssets) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.RuntimeAssets[iNdEx])
@@ -309,8 +332,9 @@ func NewPopulatedEventFilter(r randyFilter, easy bool) *EventFilter {
 	for i := 0; i < v3; i++ {
 		this.RuntimeAssets[i] = string(randStringFilter(r))
 	}
+	this.WASMModule = string(randStringFilter(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedFilter(r, 9)
+		this.XXX_unrecognized = randUnrecognizedFilter(r, 10)
 	}
 	return this
 }
@@ -415,6 +439,10 @@ func (m *EventFilter) Size() (n int) {
 			n += 1 + l + sovFilter(uint64(l))
 		}
 	}
+	l = len(m.WASMModule)
+	if l > 0 {
+		n += 1 + l + sovFilter(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -621,6 +649,38 @@ func (m *EventFilter) Unmarshal(dAtA []byte) error {
 			}
 			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFilter(dAtA[iNdEx:])
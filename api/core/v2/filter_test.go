@@ -43,4 +43,13 @@ func TestEventFilterValidate(t *testing.T) {
 
 	// Valid filter
 	assert.NoError(t, f.Validate())
+
+	// Valid wasm filter
+	f.Expressions = nil
+	f.WASMModule = "filter.wasm"
+	assert.NoError(t, f.Validate())
+
+	// Invalid wasm module
+	f.WASMModule = "../filter.wasm"
+	assert.Error(t, f.Validate())
 }
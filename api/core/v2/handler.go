@@ -7,6 +7,8 @@ import (
 	"path"
 	"sort"
 	"strings"
+
+	utilstrings "github.com/sensu/sensu-go/util/strings"
 )
 
 const (
@@ -33,6 +35,33 @@ const (
 	// HandlerGRPCType is a special kind of handler that represents an extension
 	HandlerGRPCType = "grpc"
 
+	// HandlerOTLPType represents handlers that export the metrics of events to
+	// an OpenTelemetry collector
+	HandlerOTLPType = "otlp"
+
+	// HandlerInfluxDBType represents handlers that write the metrics of events
+	// to InfluxDB
+	HandlerInfluxDBType = "influxdb"
+
+	// HandlerKafkaType represents handlers that publish events to a Kafka
+	// topic
+	HandlerKafkaType = "kafka"
+
+	// OTLPProtocolGRPC exports metrics to an OpenTelemetry collector over gRPC
+	OTLPProtocolGRPC = "grpc"
+
+	// OTLPProtocolHTTP exports metrics to an OpenTelemetry collector over HTTP
+	OTLPProtocolHTTP = "http"
+
+	// KafkaPartitionKeyEntity partitions the events by entity name
+	KafkaPartitionKeyEntity = "entity"
+
+	// KafkaPartitionKeyCheck partitions the events by entity and check names
+	KafkaPartitionKeyCheck = "check"
+
+	// KafkaPartitionKeyNone distributes the events randomly among partitions
+	KafkaPartitionKeyNone = "none"
+
 	// KeepaliveHandlerName is the name of the handler that is executed when
 	// a keepalive timeout occurs.
 	KeepaliveHandlerName = "keepalive"
@@ -69,6 +98,10 @@ func (h *Handler) Validate() error {
 		return errors.New("namespace must be set")
 	}
 
+	if err := ValidateSecrets(h.Secrets); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -87,6 +120,12 @@ func (h *Handler) validateType() error {
 		return nil
 	case "tcp", "udp":
 		return h.Socket.Validate()
+	case "otlp":
+		return h.OTLP.Validate()
+	case "influxdb":
+		return h.InfluxDB.Validate()
+	case "kafka":
+		return h.Kafka.Validate()
 	}
 
 	return fmt.Errorf("unknown handler type: %s", h.Type)
@@ -106,6 +145,83 @@ func (s *HandlerSocket) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the handler OTLP configuration does not pass
+// validation tests.
+func (o *HandlerOTLP) Validate() error {
+	if o == nil {
+		return errors.New("otlp handlers need a valid otlp configuration")
+	}
+	if len(o.Endpoint) == 0 {
+		return errors.New("otlp endpoint undefined")
+	}
+	switch o.Protocol {
+	case "", OTLPProtocolGRPC, OTLPProtocolHTTP:
+		return nil
+	}
+	return fmt.Errorf("invalid otlp protocol %q, expected %q or %q", o.Protocol, OTLPProtocolGRPC, OTLPProtocolHTTP)
+}
+
+// Validate returns an error if the handler InfluxDB configuration does not
+// pass validation tests.
+func (i *HandlerInfluxDB) Validate() error {
+	if i == nil {
+		return errors.New("influxdb handlers need a valid influxdb configuration")
+	}
+	if len(i.URL) == 0 {
+		return errors.New("influxdb url undefined")
+	}
+	if _, err := url.Parse(i.URL); err != nil {
+		return fmt.Errorf("invalid influxdb url: %s", err)
+	}
+	if len(i.Bucket) > 0 {
+		if len(i.Org) == 0 {
+			return errors.New("influxdb org undefined")
+		}
+		return nil
+	}
+	if len(i.Database) == 0 {
+		return errors.New("influxdb database or bucket undefined")
+	}
+	return nil
+}
+
+// KafkaCompressions are the valid compression codecs of a Kafka handler.
+var KafkaCompressions = []string{"none", "gzip", "snappy", "lz4", "zstd"}
+
+// KafkaSASLMechanisms are the valid SASL mechanisms of a Kafka handler.
+var KafkaSASLMechanisms = []string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"}
+
+// Validate returns an error if the handler Kafka configuration does not pass
+// validation tests.
+func (k *HandlerKafka) Validate() error {
+	if k == nil {
+		return errors.New("kafka handlers need a valid kafka configuration")
+	}
+	if len(k.Brokers) == 0 {
+		return errors.New("kafka brokers undefined")
+	}
+	if len(k.Topic) == 0 {
+		return errors.New("kafka topic undefined")
+	}
+	switch k.PartitionKey {
+	case "", KafkaPartitionKeyEntity, KafkaPartitionKeyCheck, KafkaPartitionKeyNone:
+	default:
+		return fmt.Errorf("invalid kafka partition key %q, expected %q, %q or %q", k.PartitionKey, KafkaPartitionKeyEntity, KafkaPartitionKeyCheck, KafkaPartitionKeyNone)
+	}
+	if k.Compression != "" && !utilstrings.InArray(k.Compression, KafkaCompressions) {
+		return fmt.Errorf("invalid kafka compression %q, expected one of %s", k.Compression, strings.Join(KafkaCompressions, ", "))
+	}
+	if k.SASLMechanism != "" {
+		if !utilstrings.InArray(k.SASLMechanism, KafkaSASLMechanisms) {
+			return fmt.Errorf("invalid kafka sasl mechanism %q, expected one of %s", k.SASLMechanism, strings.Join(KafkaSASLMechanisms, ", "))
+		}
+		if len(k.SASLUsername) == 0 {
+			return errors.New("kafka sasl username undefined")
+		}
+	}
+	return nil
+}
+
 // NewHandler creates a new Handler.
 func NewHandler(meta ObjectMeta) *Handler {
 	return &Handler{ObjectMeta: meta}
@@ -174,6 +290,39 @@ func FixtureSocketHandler(name string, proto string) *Handler {
 	return handler
 }
 
+// FixtureOTLPHandler returns a Handler fixture for testing.
+func FixtureOTLPHandler(name string, protocol string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerOTLPType
+	handler.OTLP = &HandlerOTLP{
+		Endpoint: "127.0.0.1:4317",
+		Protocol: protocol,
+	}
+	return handler
+}
+
+// FixtureInfluxDBHandler returns a Handler fixture for testing.
+func FixtureInfluxDBHandler(name string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerInfluxDBType
+	handler.InfluxDB = &HandlerInfluxDB{
+		URL:      "http://127.0.0.1:8086",
+		Database: "sensu",
+	}
+	return handler
+}
+
+// FixtureKafkaHandler returns a Handler fixture for testing.
+func FixtureKafkaHandler(name string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerKafkaType
+	handler.Kafka = &HandlerKafka{
+		Brokers: []string{"127.0.0.1:9092"},
+		Topic:   "sensu-events",
+	}
+	return handler
+}
+
 // FixtureSetHandler returns a Handler fixture for testing.
 func FixtureSetHandler(name string, handlers ...string) *Handler {
 	handler := FixtureHandler(name)
@@ -49,10 +49,22 @@ type Handler struct {
 	RuntimeAssets []string `protobuf:"bytes,13,rep,name=runtime_assets,json=runtimeAssets,proto3" json:"runtime_assets"`
 	// Secrets is the list of Sensu secrets to set for the handler's
 	// execution environment.
-	Secrets              []*Secret `protobuf:"bytes,14,rep,name=secrets,proto3" json:"secrets"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Secrets []*Secret `protobuf:"bytes,14,rep,name=secrets,proto3" json:"secrets"`
+	// OTLP contains configuration for an OTLP handler.
+	OTLP *HandlerOTLP `protobuf:"bytes,15,opt,name=otlp,proto3" json:"otlp,omitempty"`
+	// InfluxDB contains configuration for an InfluxDB handler.
+	InfluxDB *HandlerInfluxDB `protobuf:"bytes,16,opt,name=influxdb,proto3" json:"influxdb,omitempty"`
+	// Kafka contains configuration for a Kafka handler.
+	Kafka *HandlerKafka `protobuf:"bytes,17,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	// Enrichers is a list of enrichers name to call before mutating the event
+	// for this handler.
+	Enrichers []string `protobuf:"bytes,18,rep,name=enrichers,proto3" json:"enrichers,omitempty"`
+	// Correlation is the name of the correlation grouping the events handled
+	// by this handler.
+	Correlation          string   `protobuf:"bytes,19,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Handler) Reset()         { *m = Handler{} }
@@ -146,45 +158,437 @@ func (m *HandlerSocket) GetPort() uint32 {
 	return 0
 }
 
+// HandlerOTLP contains configuration for an OTLP handler, which exports the
+// metrics of events to an OpenTelemetry collector.
+type HandlerOTLP struct {
+	// Endpoint is the address of the collector, i.e. collector:4317 with the
+	// grpc protocol or http://collector:4318 with the http protocol.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Protocol is the OTLP transport, either grpc or http.
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Insecure disables TLS with the grpc protocol.
+	Insecure bool `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// Headers are added to every export request.
+	Headers              map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HandlerOTLP) Reset()         { *m = HandlerOTLP{} }
+func (m *HandlerOTLP) String() string { return proto.CompactTextString(m) }
+func (*HandlerOTLP) ProtoMessage()    {}
+func (*HandlerOTLP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515968b8e1a22554, []int{2}
+}
+func (m *HandlerOTLP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerOTLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerOTLP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerOTLP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerOTLP.Merge(m, src)
+}
+func (m *HandlerOTLP) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerOTLP) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerOTLP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerOTLP proto.InternalMessageInfo
+
+func (m *HandlerOTLP) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *HandlerOTLP) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *HandlerOTLP) GetInsecure() bool {
+	if m != nil {
+		return m.Insecure
+	}
+	return false
+}
+
+func (m *HandlerOTLP) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+// HandlerInfluxDB contains configuration for an InfluxDB handler, which writes
+// the metrics of events to InfluxDB using the line protocol. The InfluxDB 2.x
+// API is used when a bucket is configured, the InfluxDB 1.x API otherwise.
+type HandlerInfluxDB struct {
+	// URL is the address of the InfluxDB server, i.e. http://influxdb:8086.
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Database is the database written to with the InfluxDB 1.x API.
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// Username is used to authenticate with the InfluxDB 1.x API.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Password is used to authenticate with the InfluxDB 1.x API.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Org is the organization written to with the InfluxDB 2.x API.
+	Org string `protobuf:"bytes,5,opt,name=org,proto3" json:"org,omitempty"`
+	// Bucket is the bucket written to with the InfluxDB 2.x API.
+	Bucket string `protobuf:"bytes,6,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Token is the token used to authenticate with InfluxDB.
+	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	// BatchSize is the maximum number of points written per request.
+	BatchSize uint32 `protobuf:"varint,8,opt,name=batch_size,json=batchSize,proto3" json:"batch_size"`
+	// FlushInterval is the number of seconds during which metrics are buffered
+	// so they are written along with the metrics of other events. Metrics are
+	// written as soon as they are handled when set to 0.
+	FlushInterval uint32 `protobuf:"varint,9,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval"`
+	// MaxRetries is the number of times a failed write is retried.
+	MaxRetries           uint32   `protobuf:"varint,10,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandlerInfluxDB) Reset()         { *m = HandlerInfluxDB{} }
+func (m *HandlerInfluxDB) String() string { return proto.CompactTextString(m) }
+func (*HandlerInfluxDB) ProtoMessage()    {}
+func (*HandlerInfluxDB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515968b8e1a22554, []int{3}
+}
+func (m *HandlerInfluxDB) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerInfluxDB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerInfluxDB.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerInfluxDB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerInfluxDB.Merge(m, src)
+}
+func (m *HandlerInfluxDB) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerInfluxDB) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerInfluxDB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerInfluxDB proto.InternalMessageInfo
+
+func (m *HandlerInfluxDB) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetOrg() string {
+	if m != nil {
+		return m.Org
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *HandlerInfluxDB) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *HandlerInfluxDB) GetFlushInterval() uint32 {
+	if m != nil {
+		return m.FlushInterval
+	}
+	return 0
+}
+
+func (m *HandlerInfluxDB) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// HandlerKafka contains configuration for a Kafka handler, which publishes
+// events to a Kafka topic.
+type HandlerKafka struct {
+	// Brokers is the list of the Kafka brokers addresses, i.e. kafka:9092.
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers"`
+	// Topic is the topic the events are published to.
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// PartitionKey is the key used to partition the events, either entity,
+	// check or none. Events are partitioned by entity name by default.
+	PartitionKey string `protobuf:"bytes,3,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	// Compression is the compression codec of the messages, either none, gzip,
+	// snappy, lz4 or zstd.
+	Compression string `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`
+	// TLS enables TLS connections to the brokers.
+	TLS bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// TLSCAFile is the path of the PEM encoded CA bundle used to verify the
+	// certificates of the brokers.
+	TLSCAFile string `protobuf:"bytes,6,opt,name=tls_ca_file,json=tlsCaFile,proto3" json:"tls_ca_file,omitempty"`
+	// TLSInsecureSkipVerify disables the verification of the certificates of
+	// the brokers.
+	TLSInsecureSkipVerify bool `protobuf:"varint,7,opt,name=tls_insecure_skip_verify,json=tlsInsecureSkipVerify,proto3" json:"tls_insecure_skip_verify,omitempty"`
+	// SASLMechanism is the SASL mechanism used to authenticate with the
+	// brokers, either PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512. SASL is disabled
+	// when empty.
+	SASLMechanism string `protobuf:"bytes,8,opt,name=sasl_mechanism,json=saslMechanism,proto3" json:"sasl_mechanism,omitempty"`
+	// SASLUsername is the username used to authenticate with the brokers.
+	SASLUsername string `protobuf:"bytes,9,opt,name=sasl_username,json=saslUsername,proto3" json:"sasl_username,omitempty"`
+	// SASLPassword is the password used to authenticate with the brokers.
+	SASLPassword         string   `protobuf:"bytes,10,opt,name=sasl_password,json=saslPassword,proto3" json:"sasl_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandlerKafka) Reset()         { *m = HandlerKafka{} }
+func (m *HandlerKafka) String() string { return proto.CompactTextString(m) }
+func (*HandlerKafka) ProtoMessage()    {}
+func (*HandlerKafka) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515968b8e1a22554, []int{4}
+}
+func (m *HandlerKafka) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerKafka) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerKafka.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerKafka) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerKafka.Merge(m, src)
+}
+func (m *HandlerKafka) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerKafka) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerKafka.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerKafka proto.InternalMessageInfo
+
+func (m *HandlerKafka) GetBrokers() []string {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *HandlerKafka) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *HandlerKafka) GetPartitionKey() string {
+	if m != nil {
+		return m.PartitionKey
+	}
+	return ""
+}
+
+func (m *HandlerKafka) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *HandlerKafka) GetTLS() bool {
+	if m != nil {
+		return m.TLS
+	}
+	return false
+}
+
+func (m *HandlerKafka) GetTLSCAFile() string {
+	if m != nil {
+		return m.TLSCAFile
+	}
+	return ""
+}
+
+func (m *HandlerKafka) GetTLSInsecureSkipVerify() bool {
+	if m != nil {
+		return m.TLSInsecureSkipVerify
+	}
+	return false
+}
+
+func (m *HandlerKafka) GetSASLMechanism() string {
+	if m != nil {
+		return m.SASLMechanism
+	}
+	return ""
+}
+
+func (m *HandlerKafka) GetSASLUsername() string {
+	if m != nil {
+		return m.SASLUsername
+	}
+	return ""
+}
+
+func (m *HandlerKafka) GetSASLPassword() string {
+	if m != nil {
+		return m.SASLPassword
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Handler)(nil), "sensu.core.v2.Handler")
 	proto.RegisterType((*HandlerSocket)(nil), "sensu.core.v2.HandlerSocket")
+	proto.RegisterType((*HandlerOTLP)(nil), "sensu.core.v2.HandlerOTLP")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.HandlerOTLP.HeadersEntry")
+	proto.RegisterType((*HandlerInfluxDB)(nil), "sensu.core.v2.HandlerInfluxDB")
+	proto.RegisterType((*HandlerKafka)(nil), "sensu.core.v2.HandlerKafka")
 }
 
 func init() { proto.RegisterFile("handler.proto", fileDescriptor_515968b8e1a22554) }
 
 var fileDescriptor_515968b8e1a22554 = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0x31, 0x6e, 0xdb, 0x30,
-	0x14, 0x35, 0x63, 0xd5, 0x92, 0xe9, 0x28, 0x03, 0x81, 0x02, 0x6c, 0x10, 0x88, 0x42, 0x80, 0xa2,
-	0x1a, 0x0a, 0x05, 0x71, 0xba, 0x34, 0xe8, 0xd0, 0x68, 0xea, 0x52, 0x14, 0x60, 0xd0, 0x0e, 0x5d,
-	0x02, 0x5a, 0x66, 0x6c, 0xb7, 0x91, 0x68, 0x88, 0x94, 0x80, 0xdc, 0x20, 0x47, 0xe8, 0x98, 0x31,
-	0x47, 0xe8, 0x11, 0x3c, 0xe6, 0x04, 0x42, 0xab, 0x6e, 0x3a, 0x41, 0xc7, 0x42, 0x5f, 0x92, 0x8b,
-	0x78, 0x11, 0xde, 0x7b, 0xff, 0xe9, 0xf3, 0x3f, 0x7e, 0x62, 0x77, 0x29, 0xd2, 0xf9, 0x8d, 0xcc,
-	0xc2, 0x75, 0xa6, 0x8c, 0x22, 0xae, 0x96, 0xa9, 0xce, 0xc3, 0x58, 0x65, 0x32, 0x2c, 0xa6, 0x87,
-	0x6f, 0x16, 0x2b, 0xb3, 0xcc, 0x67, 0x61, 0xac, 0x92, 0x93, 0x85, 0x5a, 0xa8, 0x13, 0x70, 0xcd,
-	0xf2, 0xeb, 0xf7, 0xc5, 0x69, 0x78, 0x16, 0x9e, 0x82, 0x08, 0x1a, 0xa0, 0xb6, 0xc9, 0x21, 0x4e,
-	0xa4, 0x11, 0x1d, 0xde, 0xd7, 0x32, 0xce, 0xa4, 0x69, 0xd9, 0xf1, 0x9d, 0x85, 0xed, 0x0f, 0xed,
-	0x81, 0xe4, 0x33, 0x76, 0x1a, 0xdf, 0x5c, 0x18, 0x41, 0x91, 0x8f, 0x82, 0xc9, 0xf4, 0x45, 0xf8,
-	0xe4, 0xf4, 0xf0, 0xd3, 0xec, 0x9b, 0x8c, 0xcd, 0x47, 0x69, 0x44, 0xe4, 0x6d, 0x4a, 0x36, 0x78,
-	0x2c, 0x19, 0xaa, 0x4b, 0x46, 0xfa, 0xdf, 0x5e, 0xab, 0x64, 0x65, 0x64, 0xb2, 0x36, 0xb7, 0x7c,
-	0xdb, 0x8a, 0x10, 0x6c, 0x99, 0xdb, 0xb5, 0xa4, 0x7b, 0x3e, 0x0a, 0xc6, 0x1c, 0x30, 0xa1, 0xd8,
-	0x4e, 0x72, 0x23, 0x8c, 0xca, 0xe8, 0x10, 0xe4, 0x9e, 0x36, 0x95, 0x58, 0x25, 0x89, 0x48, 0xe7,
-	0xd4, 0x6a, 0x2b, 0x1d, 0x25, 0x2f, 0xb1, 0x6d, 0x56, 0x89, 0x54, 0xb9, 0xa1, 0xcf, 0x7c, 0x14,
-	0xb8, 0xd1, 0xa4, 0x2e, 0x59, 0x2f, 0xf1, 0x1e, 0x90, 0x73, 0x3c, 0xd2, 0x2a, 0xfe, 0x2e, 0x0d,
-	0x1d, 0x41, 0x86, 0xa3, 0x9d, 0x0c, 0x5d, 0xda, 0x4b, 0xf0, 0x44, 0xd6, 0xa6, 0x64, 0x88, 0x77,
-	0x7f, 0x90, 0x00, 0x3b, 0xdd, 0xed, 0x6b, 0x6a, 0xfb, 0xc3, 0x60, 0x1c, 0xed, 0xd7, 0x25, 0xdb,
-	0x6a, 0x7c, 0x8b, 0x9a, 0x61, 0xae, 0x57, 0x37, 0xa6, 0x31, 0x3a, 0x60, 0x84, 0x61, 0x3a, 0x89,
-	0xf7, 0x80, 0xbc, 0xc2, 0x8e, 0x4c, 0x8b, 0xab, 0x42, 0x64, 0x9a, 0x8e, 0xff, 0x37, 0xec, 0x35,
-	0x6e, 0xcb, 0xb4, 0xf8, 0x22, 0x32, 0x4d, 0xde, 0xe2, 0x83, 0x2c, 0x4f, 0x9b, 0x0c, 0x57, 0x42,
-	0x6b, 0x69, 0x34, 0x75, 0xc1, 0x4e, 0xea, 0x92, 0xed, 0x54, 0xb8, 0xdb, 0xf1, 0x0b, 0xa0, 0xe4,
-	0x1d, 0xb6, 0xdb, 0x95, 0x6a, 0x7a, 0xe0, 0x0f, 0x83, 0xc9, 0xf4, 0xf9, 0x4e, 0xe2, 0x4b, 0xa8,
-	0xb6, 0x13, 0x76, 0x4e, 0xde, 0x83, 0x73, 0xe7, 0xee, 0x9e, 0x0d, 0x1e, 0xee, 0x19, 0x3a, 0xbe,
-	0xc0, 0xee, 0x93, 0xbb, 0x69, 0x16, 0xb7, 0x54, 0xda, 0xc0, 0x5b, 0x18, 0x73, 0xc0, 0xe4, 0x08,
-	0x5b, 0x6b, 0x95, 0x19, 0x58, 0xa6, 0x1b, 0x39, 0x75, 0xc9, 0x80, 0x73, 0xf8, 0x46, 0xfe, 0xdf,
-	0xdf, 0x1e, 0x7a, 0xa8, 0x3c, 0xf4, 0xb3, 0xf2, 0xd0, 0xa6, 0xf2, 0xd0, 0x63, 0xe5, 0xa1, 0x5f,
-	0x95, 0x87, 0x7e, 0xfc, 0xf1, 0x06, 0x5f, 0xf7, 0x8a, 0xe9, 0x6c, 0x04, 0xcf, 0xee, 0xec, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x03, 0x99, 0xbf, 0xe6, 0x02, 0x00, 0x00,
+	// 1093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x0e, 0x2d, 0xc5, 0x22, 0x47, 0xa2, 0xed, 0x4c, 0x7e, 0xff, 0xa5, 0xdd, 0x40, 0x14, 0x5c,
+	0x14, 0xd1, 0xa2, 0x51, 0x1a, 0xa7, 0x41, 0x53, 0x37, 0x8b, 0x9a, 0x69, 0x8b, 0x18, 0x51, 0x90,
+	0x60, 0x64, 0x67, 0xd1, 0x0d, 0x31, 0xa2, 0x46, 0x16, 0x2b, 0x5e, 0x84, 0x99, 0xa1, 0x6a, 0xe5,
+	0x09, 0xfa, 0x08, 0x5d, 0x66, 0x99, 0x47, 0xe8, 0xb6, 0x3b, 0x2f, 0xb3, 0xec, 0xa2, 0x20, 0x52,
+	0x76, 0xa7, 0x27, 0xe8, 0xb2, 0x98, 0xc3, 0x8b, 0x65, 0xd7, 0xdd, 0x08, 0xe7, 0xfb, 0xce, 0x77,
+	0x8e, 0x78, 0x6e, 0x83, 0xcc, 0x09, 0x8d, 0x46, 0x01, 0xe3, 0xbd, 0x19, 0x8f, 0x65, 0x8c, 0x4d,
+	0xc1, 0x22, 0x91, 0xf4, 0xbc, 0x98, 0xb3, 0xde, 0x7c, 0x7f, 0xf7, 0x8b, 0x53, 0x5f, 0x4e, 0x92,
+	0x61, 0xcf, 0x8b, 0xc3, 0xfb, 0xa7, 0xf1, 0x69, 0x7c, 0x1f, 0x54, 0xc3, 0x64, 0xfc, 0xcd, 0xfc,
+	0x41, 0xef, 0x61, 0xef, 0x01, 0x90, 0xc0, 0x81, 0x95, 0x27, 0xd9, 0x45, 0x21, 0x93, 0xb4, 0xb0,
+	0x5b, 0x82, 0x79, 0x9c, 0xc9, 0x1c, 0xed, 0xfd, 0xb6, 0x8e, 0x1a, 0xcf, 0xf2, 0x3f, 0xc4, 0x27,
+	0x48, 0x57, 0xba, 0x11, 0x95, 0xd4, 0xd2, 0x3a, 0x5a, 0xb7, 0xb9, 0xbf, 0xd3, 0xbb, 0xf4, 0xef,
+	0xbd, 0x97, 0xc3, 0x1f, 0x99, 0x27, 0x5f, 0x30, 0x49, 0x9d, 0xf6, 0x79, 0x6a, 0xdf, 0x78, 0x9f,
+	0xda, 0xda, 0x32, 0xb5, 0x71, 0x19, 0xf6, 0x59, 0x1c, 0xfa, 0x92, 0x85, 0x33, 0xb9, 0x20, 0x55,
+	0x2a, 0x8c, 0x51, 0x5d, 0x2e, 0x66, 0xcc, 0x5a, 0xeb, 0x68, 0x5d, 0x83, 0x80, 0x8d, 0x2d, 0xd4,
+	0x08, 0x13, 0x49, 0x65, 0xcc, 0xad, 0x1a, 0xd0, 0x25, 0x54, 0x1e, 0x2f, 0x0e, 0x43, 0x1a, 0x8d,
+	0xac, 0x7a, 0xee, 0x29, 0x20, 0xfe, 0x14, 0x35, 0xa4, 0x1f, 0xb2, 0x38, 0x91, 0xd6, 0xcd, 0x8e,
+	0xd6, 0x35, 0x9d, 0xe6, 0x32, 0xb5, 0x4b, 0x8a, 0x94, 0x06, 0x3e, 0x40, 0xeb, 0x22, 0xf6, 0xa6,
+	0x4c, 0x5a, 0xeb, 0x50, 0xc3, 0x9d, 0x2b, 0x35, 0x14, 0xd5, 0x0e, 0x40, 0xe3, 0xd4, 0xcf, 0x53,
+	0x5b, 0x23, 0x45, 0x04, 0xee, 0x22, 0xbd, 0xe8, 0xbe, 0xb0, 0x1a, 0x9d, 0x5a, 0xd7, 0x70, 0x5a,
+	0xcb, 0xd4, 0xae, 0x38, 0x52, 0x59, 0xea, 0x63, 0xc6, 0x7e, 0x20, 0x95, 0x50, 0x07, 0x21, 0x7c,
+	0x4c, 0x41, 0x91, 0xd2, 0xc0, 0x77, 0x91, 0xce, 0xa2, 0xb9, 0x3b, 0xa7, 0x5c, 0x58, 0xc6, 0x45,
+	0xc2, 0x92, 0x23, 0x0d, 0x16, 0xcd, 0x5f, 0x53, 0x2e, 0xf0, 0x57, 0x68, 0x83, 0x27, 0x91, 0xaa,
+	0xc1, 0xa5, 0x42, 0x30, 0x29, 0x2c, 0x13, 0xe4, 0x78, 0x99, 0xda, 0x57, 0x3c, 0xc4, 0x2c, 0xf0,
+	0x21, 0x40, 0xfc, 0x04, 0x35, 0xf2, 0x91, 0x0a, 0x6b, 0xa3, 0x53, 0xeb, 0x36, 0xf7, 0xb7, 0xaf,
+	0x54, 0x3c, 0x00, 0x6f, 0xfe, 0x85, 0x85, 0x92, 0x94, 0x06, 0x7e, 0x82, 0xea, 0xb1, 0x0c, 0x66,
+	0xd6, 0x26, 0x34, 0x6b, 0xf7, 0xfa, 0x66, 0xbd, 0x3c, 0xee, 0xbf, 0x72, 0x5a, 0xaa, 0x55, 0x59,
+	0x6a, 0xd7, 0x15, 0x22, 0x10, 0x85, 0xfb, 0x48, 0xf7, 0xa3, 0x71, 0x90, 0x9c, 0x8d, 0x86, 0xd6,
+	0x16, 0x64, 0x68, 0x5f, 0x9f, 0xe1, 0x08, 0x54, 0xdf, 0x3a, 0xce, 0x56, 0x91, 0x45, 0x2f, 0x19,
+	0x52, 0x65, 0xc0, 0x5f, 0xa2, 0x9b, 0x53, 0x3a, 0x9e, 0x52, 0xeb, 0x16, 0xa4, 0xfa, 0xf8, 0xfa,
+	0x54, 0xcf, 0x95, 0xa4, 0x18, 0x5c, 0xae, 0xc7, 0x8f, 0x90, 0xc1, 0x22, 0xee, 0x7b, 0x13, 0x35,
+	0x0f, 0x0c, 0x8d, 0xfb, 0x68, 0x99, 0xda, 0xb7, 0x2b, 0x72, 0x65, 0x31, 0x2f, 0x94, 0xf8, 0x6b,
+	0xd4, 0xf4, 0x62, 0xce, 0x59, 0x40, 0xa5, 0x1f, 0x47, 0xd6, 0x6d, 0xb5, 0x6f, 0xce, 0xce, 0x32,
+	0xb5, 0xb7, 0x57, 0xe8, 0x95, 0xd0, 0x55, 0xf5, 0x81, 0xfe, 0xf3, 0x5b, 0xfb, 0xc6, 0xbb, 0xb7,
+	0xb6, 0xb6, 0x77, 0x88, 0xcc, 0x4b, 0x4b, 0xa5, 0x36, 0x7e, 0x12, 0x0b, 0x09, 0x47, 0x64, 0x10,
+	0xb0, 0xf1, 0x1d, 0x54, 0x9f, 0xc5, 0x5c, 0xc2, 0x15, 0x98, 0x8e, 0xbe, 0x4c, 0x6d, 0xc0, 0x04,
+	0x7e, 0xf7, 0x3e, 0x68, 0xa8, 0xb9, 0xd2, 0x6b, 0xbc, 0xab, 0xf6, 0x66, 0x34, 0x8b, 0xfd, 0xa8,
+	0xcc, 0x52, 0x61, 0xe5, 0x83, 0xdb, 0xf5, 0xe2, 0xa0, 0xb8, 0xa9, 0x0a, 0x2b, 0x9f, 0x1f, 0x09,
+	0xe6, 0x25, 0x9c, 0xc1, 0x61, 0xe9, 0xa4, 0xc2, 0xf8, 0x10, 0x35, 0x26, 0x8c, 0x8e, 0x54, 0x8b,
+	0xea, 0xb0, 0x27, 0x77, 0xff, 0x7b, 0xd8, 0xbd, 0x67, 0xb9, 0xf2, 0xbb, 0x48, 0xf2, 0x05, 0x29,
+	0xe3, 0x76, 0x0f, 0x50, 0x6b, 0xd5, 0x81, 0xb7, 0x50, 0x6d, 0xca, 0x16, 0xc5, 0x17, 0x2a, 0x13,
+	0xff, 0x0f, 0xdd, 0x9c, 0xd3, 0x20, 0x29, 0xaf, 0x3d, 0x07, 0x07, 0x6b, 0x8f, 0xb5, 0xbd, 0x3f,
+	0xd6, 0xd0, 0xe6, 0x95, 0x65, 0xc0, 0x3b, 0xa8, 0x96, 0xf0, 0x20, 0x8f, 0x77, 0x1a, 0x59, 0x6a,
+	0xd7, 0x4e, 0x48, 0x9f, 0x28, 0x4e, 0x55, 0xa2, 0x5e, 0x8f, 0x21, 0x15, 0x65, 0xae, 0x0a, 0x2b,
+	0x5f, 0x22, 0x18, 0x8f, 0x68, 0xc8, 0x8a, 0xe7, 0xa3, 0xc2, 0xd0, 0x1d, 0x2a, 0xc4, 0x4f, 0x31,
+	0x2f, 0x1f, 0x90, 0x0a, 0xab, 0xcf, 0x8d, 0xf9, 0x29, 0xbc, 0x1e, 0x06, 0x51, 0x26, 0xfe, 0x3f,
+	0x5a, 0x1f, 0x26, 0xd5, 0x63, 0x61, 0x90, 0x02, 0xa9, 0x32, 0x64, 0x3c, 0x65, 0x91, 0xd5, 0xc8,
+	0xcb, 0x00, 0x80, 0xef, 0x21, 0x34, 0xa4, 0xd2, 0x9b, 0xb8, 0xc2, 0x7f, 0xc3, 0x2c, 0x1d, 0x26,
+	0xb9, 0xb1, 0x4c, 0xed, 0x15, 0x96, 0x18, 0x60, 0x0f, 0xfc, 0x37, 0x4c, 0xdd, 0xf4, 0x38, 0x48,
+	0xc4, 0xc4, 0xf5, 0x23, 0xc9, 0xf8, 0x9c, 0x06, 0x96, 0x01, 0x21, 0x70, 0xd3, 0x97, 0x3d, 0xc4,
+	0x04, 0x7c, 0x54, 0x40, 0xfc, 0x39, 0x6a, 0x86, 0xf4, 0xcc, 0xe5, 0x4c, 0x72, 0x9f, 0x09, 0x0b,
+	0x41, 0xdc, 0xe6, 0x32, 0xb5, 0x57, 0x69, 0x82, 0x42, 0x7a, 0x46, 0x72, 0x7b, 0xef, 0xf7, 0x1a,
+	0x6a, 0xad, 0x1e, 0x88, 0x7a, 0xa1, 0x86, 0x3c, 0x9e, 0xaa, 0x71, 0x6b, 0x17, 0x2f, 0x54, 0x41,
+	0x91, 0xd2, 0xc8, 0x2b, 0x9d, 0xf9, 0x5e, 0x39, 0x30, 0x00, 0xf8, 0x13, 0x64, 0xce, 0x28, 0x97,
+	0xbe, 0xda, 0x74, 0x57, 0x8d, 0x38, 0x6f, 0x73, 0xab, 0x22, 0x9f, 0xb3, 0x05, 0xee, 0xa8, 0xf3,
+	0x09, 0x67, 0x9c, 0x09, 0xa1, 0xce, 0x27, 0xef, 0xf6, 0x2a, 0xa5, 0xe6, 0x2b, 0x03, 0x01, 0x0d,
+	0xd7, 0xf3, 0xf9, 0x1e, 0xf7, 0x07, 0x44, 0x71, 0xf8, 0x1e, 0x6a, 0xca, 0x40, 0xb8, 0x1e, 0x75,
+	0xc7, 0x7e, 0xc0, 0xf2, 0xf6, 0x3b, 0x66, 0x96, 0xda, 0xc6, 0x71, 0x7f, 0xf0, 0xf4, 0xf0, 0x7b,
+	0x3f, 0x60, 0xc4, 0x90, 0x81, 0x78, 0x4a, 0x95, 0x89, 0x09, 0xb2, 0x94, 0xbc, 0x5c, 0x66, 0x57,
+	0x4c, 0xfd, 0x99, 0x3b, 0x67, 0xdc, 0x1f, 0x2f, 0x60, 0x46, 0xba, 0xb3, 0x93, 0xa5, 0xf6, 0xf6,
+	0x71, 0x7f, 0x70, 0x54, 0x48, 0x06, 0x53, 0x7f, 0xf6, 0x1a, 0x04, 0x64, 0x5b, 0x06, 0xe2, 0xdf,
+	0x34, 0x7e, 0x8c, 0x36, 0x04, 0x15, 0x81, 0x1b, 0x32, 0x6f, 0x42, 0x23, 0x5f, 0x84, 0x30, 0x52,
+	0xc3, 0xb9, 0x95, 0xa5, 0xb6, 0x39, 0x38, 0x1c, 0xf4, 0x5f, 0x94, 0x0e, 0x62, 0x2a, 0x61, 0x05,
+	0xf1, 0x23, 0x04, 0x84, 0x5b, 0x6d, 0xa1, 0x01, 0x81, 0x5b, 0x59, 0x6a, 0xb7, 0x54, 0xe0, 0x49,
+	0xc1, 0x93, 0x96, 0x92, 0x95, 0xa8, 0x0a, 0xab, 0x16, 0x14, 0x5d, 0x0e, 0x7b, 0x55, 0xf0, 0x79,
+	0x58, 0x89, 0x9c, 0xce, 0xdf, 0x7f, 0xb6, 0xb5, 0x77, 0x59, 0x5b, 0xfb, 0x35, 0x6b, 0x6b, 0xe7,
+	0x59, 0x5b, 0x7b, 0x9f, 0xb5, 0xb5, 0x0f, 0x59, 0x5b, 0xfb, 0xe5, 0xaf, 0xf6, 0x8d, 0x1f, 0xd6,
+	0xe6, 0xfb, 0xc3, 0x75, 0x78, 0x00, 0x1e, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x07, 0xd6, 0x93,
+	0x5e, 0x3c, 0x08, 0x00, 0x00,
 }
 
 func (this *Handler) Equal(that interface{}) bool {
@@ -264,6 +668,26 @@ func (this *Handler) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.OTLP.Equal(that1.OTLP) {
+		return false
+	}
+	if !this.InfluxDB.Equal(that1.InfluxDB) {
+		return false
+	}
+	if !this.Kafka.Equal(that1.Kafka) {
+		return false
+	}
+	if len(this.Enrichers) != len(that1.Enrichers) {
+		return false
+	}
+	for i := range this.Enrichers {
+		if this.Enrichers[i] != that1.Enrichers[i] {
+			return false
+		}
+	}
+	if this.Correlation != that1.Correlation {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -299,28 +723,187 @@ func (this *HandlerSocket) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HandlerOTLP) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type HandlerFace interface {
-	Proto() github_com_golang_protobuf_proto.Message
-	GetObjectMeta() ObjectMeta
-	GetType() string
-	GetMutator() string
-	GetCommand() string
-	GetTimeout() uint32
-	GetSocket() *HandlerSocket
-	GetHandlers() []string
-	GetFilters() []string
-	GetEnvVars() []string
-	GetRuntimeAssets() []string
-	GetSecrets() []*Secret
-}
-
-func (this *Handler) Proto() github_com_golang_protobuf_proto.Message {
-	return this
-}
-
-func (this *Handler) TestProto() github_com_golang_protobuf_proto.Message {
-	return NewHandlerFromFace(this)
+	that1, ok := that.(*HandlerOTLP)
+	if !ok {
+		that2, ok := that.(HandlerOTLP)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Endpoint != that1.Endpoint {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	if this.Insecure != that1.Insecure {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HandlerInfluxDB) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandlerInfluxDB)
+	if !ok {
+		that2, ok := that.(HandlerInfluxDB)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.Database != that1.Database {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	if this.Org != that1.Org {
+		return false
+	}
+	if this.Bucket != that1.Bucket {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
+	if this.FlushInterval != that1.FlushInterval {
+		return false
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HandlerKafka) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandlerKafka)
+	if !ok {
+		that2, ok := that.(HandlerKafka)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Brokers) != len(that1.Brokers) {
+		return false
+	}
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
+		}
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	if this.PartitionKey != that1.PartitionKey {
+		return false
+	}
+	if this.Compression != that1.Compression {
+		return false
+	}
+	if this.TLS != that1.TLS {
+		return false
+	}
+	if this.TLSCAFile != that1.TLSCAFile {
+		return false
+	}
+	if this.TLSInsecureSkipVerify != that1.TLSInsecureSkipVerify {
+		return false
+	}
+	if this.SASLMechanism != that1.SASLMechanism {
+		return false
+	}
+	if this.SASLUsername != that1.SASLUsername {
+		return false
+	}
+	if this.SASLPassword != that1.SASLPassword {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type HandlerFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetType() string
+	GetMutator() string
+	GetCommand() string
+	GetTimeout() uint32
+	GetSocket() *HandlerSocket
+	GetHandlers() []string
+	GetFilters() []string
+	GetEnvVars() []string
+	GetRuntimeAssets() []string
+	GetSecrets() []*Secret
+	GetOTLP() *HandlerOTLP
+	GetInfluxDB() *HandlerInfluxDB
+	GetKafka() *HandlerKafka
+	GetEnrichers() []string
+	GetCorrelation() string
+}
+
+func (this *Handler) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *Handler) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewHandlerFromFace(this)
 }
 
 func (this *Handler) GetObjectMeta() ObjectMeta {
@@ -367,6 +950,26 @@ func (this *Handler) GetSecrets() []*Secret {
 	return this.Secrets
 }
 
+func (this *Handler) GetOTLP() *HandlerOTLP {
+	return this.OTLP
+}
+
+func (this *Handler) GetInfluxDB() *HandlerInfluxDB {
+	return this.InfluxDB
+}
+
+func (this *Handler) GetKafka() *HandlerKafka {
+	return this.Kafka
+}
+
+func (this *Handler) GetEnrichers() []string {
+	return this.Enrichers
+}
+
+func (this *Handler) GetCorrelation() string {
+	return this.Correlation
+}
+
 func NewHandlerFromFace(that HandlerFace) *Handler {
 	this := &Handler{}
 	this.ObjectMeta = that.GetObjectMeta()
@@ -380,6 +983,11 @@ func NewHandlerFromFace(that HandlerFace) *Handler {
 	this.EnvVars = that.GetEnvVars()
 	this.RuntimeAssets = that.GetRuntimeAssets()
 	this.Secrets = that.GetSecrets()
+	this.OTLP = that.GetOTLP()
+	this.InfluxDB = that.GetInfluxDB()
+	this.Kafka = that.GetKafka()
+	this.Enrichers = that.GetEnrichers()
+	this.Correlation = that.GetCorrelation()
 	return this
 }
 
@@ -407,6 +1015,66 @@ func (m *Handler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Correlation) > 0 {
+		i -= len(m.Correlation)
+		copy(dAtA[i:], m.Correlation)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Correlation)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Enrichers) > 0 {
+		for iNdEx := len(m.Enrichers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Enrichers[iNdEx])
+			copy(dAtA[i:], m.Enrichers[iNdEx])
+			i = encodeVarintHandler(dAtA, i, uint64(len(m.Enrichers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandler(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.InfluxDB != nil {
+		{
+			size, err := m.InfluxDB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandler(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OTLP != nil {
+		{
+			size, err := m.OTLP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandler(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Secrets) > 0 {
 		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -547,93 +1215,432 @@ func (m *HandlerSocket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHandler(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HandlerOTLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
-	this := &Handler{}
-	v1 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v1
-	this.Type = string(randStringHandler(r))
-	this.Mutator = string(randStringHandler(r))
-	this.Command = string(randStringHandler(r))
-	this.Timeout = uint32(r.Uint32())
-	if r.Intn(5) != 0 {
-		this.Socket = NewPopulatedHandlerSocket(r, easy)
-	}
-	v2 := r.Intn(10)
-	this.Handlers = make([]string, v2)
-	for i := 0; i < v2; i++ {
-		this.Handlers[i] = string(randStringHandler(r))
-	}
-	v3 := r.Intn(10)
-	this.Filters = make([]string, v3)
-	for i := 0; i < v3; i++ {
-		this.Filters[i] = string(randStringHandler(r))
-	}
-	v4 := r.Intn(10)
-	this.EnvVars = make([]string, v4)
-	for i := 0; i < v4; i++ {
-		this.EnvVars[i] = string(randStringHandler(r))
+
+func (m *HandlerOTLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerOTLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	v5 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v5)
-	for i := 0; i < v5; i++ {
-		this.RuntimeAssets[i] = string(randStringHandler(r))
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintHandler(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHandler(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHandler(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Secrets = make([]*Secret, v6)
-		for i := 0; i < v6; i++ {
-			this.Secrets[i] = NewPopulatedSecret(r, easy)
+	if m.Insecure {
+		i--
+		if m.Insecure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedHandler(r, 15)
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return this
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedHandlerSocket(r randyHandler, easy bool) *HandlerSocket {
-	this := &HandlerSocket{}
-	this.Host = string(randStringHandler(r))
-	this.Port = uint32(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedHandler(r, 3)
+func (m *HandlerInfluxDB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyHandler interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *HandlerInfluxDB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneHandler(r randyHandler) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
+func (m *HandlerInfluxDB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FlushInterval != 0 {
+		i = encodeVarintHandler(dAtA, i, uint64(m.FlushInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintHandler(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Org) > 0 {
+		i -= len(m.Org)
+		copy(dAtA[i:], m.Org)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Org)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandlerKafka) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerKafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerKafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SASLPassword) > 0 {
+		i -= len(m.SASLPassword)
+		copy(dAtA[i:], m.SASLPassword)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.SASLPassword)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SASLUsername) > 0 {
+		i -= len(m.SASLUsername)
+		copy(dAtA[i:], m.SASLUsername)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.SASLUsername)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SASLMechanism) > 0 {
+		i -= len(m.SASLMechanism)
+		copy(dAtA[i:], m.SASLMechanism)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.SASLMechanism)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TLSInsecureSkipVerify {
+		i--
+		if m.TLSInsecureSkipVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TLSCAFile) > 0 {
+		i -= len(m.TLSCAFile)
+		copy(dAtA[i:], m.TLSCAFile)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.TLSCAFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TLS {
+		i--
+		if m.TLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PartitionKey) > 0 {
+		i -= len(m.PartitionKey)
+		copy(dAtA[i:], m.PartitionKey)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PartitionKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintHandler(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHandler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
+	this := &Handler{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Type = string(randStringHandler(r))
+	this.Mutator = string(randStringHandler(r))
+	this.Command = string(randStringHandler(r))
+	this.Timeout = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.Socket = NewPopulatedHandlerSocket(r, easy)
+	}
+	v2 := r.Intn(10)
+	this.Handlers = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Handlers[i] = string(randStringHandler(r))
+	}
+	v3 := r.Intn(10)
+	this.Filters = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Filters[i] = string(randStringHandler(r))
+	}
+	v4 := r.Intn(10)
+	this.EnvVars = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.EnvVars[i] = string(randStringHandler(r))
+	}
+	v5 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v5)
+	for i := 0; i < v5; i++ {
+		this.RuntimeAssets[i] = string(randStringHandler(r))
+	}
+	if r.Intn(5) != 0 {
+		v6 := r.Intn(5)
+		this.Secrets = make([]*Secret, v6)
+		for i := 0; i < v6; i++ {
+			this.Secrets[i] = NewPopulatedSecret(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.OTLP = NewPopulatedHandlerOTLP(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.InfluxDB = NewPopulatedHandlerInfluxDB(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Kafka = NewPopulatedHandlerKafka(r, easy)
+	}
+	v7 := r.Intn(10)
+	this.Enrichers = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.Enrichers[i] = string(randStringHandler(r))
+	}
+	this.Correlation = string(randStringHandler(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandler(r, 20)
+	}
+	return this
+}
+
+func NewPopulatedHandlerSocket(r randyHandler, easy bool) *HandlerSocket {
+	this := &HandlerSocket{}
+	this.Host = string(randStringHandler(r))
+	this.Port = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandler(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedHandlerOTLP(r randyHandler, easy bool) *HandlerOTLP {
+	this := &HandlerOTLP{}
+	this.Endpoint = string(randStringHandler(r))
+	this.Protocol = string(randStringHandler(r))
+	this.Insecure = bool(bool(r.Intn(2) == 0))
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v8; i++ {
+			this.Headers[randStringHandler(r)] = randStringHandler(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandler(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedHandlerInfluxDB(r randyHandler, easy bool) *HandlerInfluxDB {
+	this := &HandlerInfluxDB{}
+	this.URL = string(randStringHandler(r))
+	this.Database = string(randStringHandler(r))
+	this.Username = string(randStringHandler(r))
+	this.Password = string(randStringHandler(r))
+	this.Org = string(randStringHandler(r))
+	this.Bucket = string(randStringHandler(r))
+	this.Token = string(randStringHandler(r))
+	this.BatchSize = uint32(r.Uint32())
+	this.FlushInterval = uint32(r.Uint32())
+	this.MaxRetries = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandler(r, 11)
+	}
+	return this
+}
+
+func NewPopulatedHandlerKafka(r randyHandler, easy bool) *HandlerKafka {
+	this := &HandlerKafka{}
+	v9 := r.Intn(10)
+	this.Brokers = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.Brokers[i] = string(randStringHandler(r))
+	}
+	this.Topic = string(randStringHandler(r))
+	this.PartitionKey = string(randStringHandler(r))
+	this.Compression = string(randStringHandler(r))
+	this.TLS = bool(bool(r.Intn(2) == 0))
+	this.TLSCAFile = string(randStringHandler(r))
+	this.TLSInsecureSkipVerify = bool(bool(r.Intn(2) == 0))
+	this.SASLMechanism = string(randStringHandler(r))
+	this.SASLUsername = string(randStringHandler(r))
+	this.SASLPassword = string(randStringHandler(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedHandler(r, 11)
+	}
+	return this
+}
+
+type randyHandler interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneHandler(r randyHandler) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
 	return rune(ru + 61)
 }
 func randStringHandler(r randyHandler) string {
-	v7 := r.Intn(100)
-	tmps := make([]rune, v7)
-	for i := 0; i < v7; i++ {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
 		tmps[i] = randUTF8RuneHandler(r)
 	}
 	return string(tmps)
@@ -655,11 +1662,11 @@ func randFieldHandler(dAtA []byte, r randyHandler, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateHandler(dAtA, uint64(key))
-		v8 := r.Int63()
+		v11 := r.Int63()
 		if r.Intn(2) == 0 {
-			v8 *= -1
+			v11 *= -1
 		}
-		dAtA = encodeVarintPopulateHandler(dAtA, uint64(v8))
+		dAtA = encodeVarintPopulateHandler(dAtA, uint64(v11))
 	case 1:
 		dAtA = encodeVarintPopulateHandler(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -741,6 +1748,28 @@ func (m *Handler) Size() (n int) {
 			n += 1 + l + sovHandler(uint64(l))
 		}
 	}
+	if m.OTLP != nil {
+		l = m.OTLP.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.InfluxDB != nil {
+		l = m.InfluxDB.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if len(m.Enrichers) > 0 {
+		for _, s := range m.Enrichers {
+			l = len(s)
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.Correlation)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -766,43 +1795,175 @@ func (m *HandlerSocket) Size() (n int) {
 	return n
 }
 
-func sovHandler(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHandler(x uint64) (n int) {
-	return sovHandler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Handler) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Handler: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Handler: illegal tag %d (wire type %d)", fieldNum, wire)
+func (m *HandlerOTLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Insecure {
+		n += 2
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHandler(uint64(mapEntrySize))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HandlerInfluxDB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Org)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovHandler(uint64(m.BatchSize))
+	}
+	if m.FlushInterval != 0 {
+		n += 1 + sovHandler(uint64(m.FlushInterval))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovHandler(uint64(m.MaxRetries))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HandlerKafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.PartitionKey)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.TLS {
+		n += 2
+	}
+	l = len(m.TLSCAFile)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.TLSInsecureSkipVerify {
+		n += 2
+	}
+	l = len(m.SASLMechanism)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.SASLUsername)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.SASLPassword)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHandler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHandler(x uint64) (n int) {
+	return sovHandler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Handler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Handler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Handler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
@@ -823,20 +1984,1057 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Socket == nil {
+				m.Socket = &HandlerSocket{}
+			}
+			if err := m.Socket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OTLP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OTLP == nil {
+				m.OTLP = &HandlerOTLP{}
+			}
+			if err := m.OTLP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfluxDB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfluxDB == nil {
+				m.InfluxDB = &HandlerInfluxDB{}
+			}
+			if err := m.InfluxDB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &HandlerKafka{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrichers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enrichers = append(m.Enrichers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Correlation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Correlation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerSocket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerSocket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerOTLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerOTLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerOTLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandler
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHandler
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHandler
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandler
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthHandler
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthHandler
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHandler(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthHandler
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerInfluxDB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerInfluxDB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerInfluxDB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHandler
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Org", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -864,11 +3062,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Org = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -896,11 +3094,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutator = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -928,13 +3126,13 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			m.Timeout = 0
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -944,16 +3142,16 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint32(b&0x7F) << shift
+				m.BatchSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushInterval", wireType)
 			}
-			var msglen int
+			m.FlushInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -963,31 +3161,87 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FlushInterval |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthHandler
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Socket == nil {
-				m.Socket = &HandlerSocket{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerKafka) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
 			}
-			if err := m.Socket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerKafka: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerKafka: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1015,11 +3269,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1047,11 +3301,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, string(dAtA[iNdEx:postIndex]))
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1079,11 +3333,11 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvVars = append(m.EnvVars, string(dAtA[iNdEx:postIndex]))
+			m.PartitionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1111,13 +3365,33 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			m.Compression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCAFile", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -1127,83 +3401,79 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHandler
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secrets = append(m.Secrets, &Secret{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TLSCAFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHandler(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSInsecureSkipVerify", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthHandler
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthHandler
+			m.TLSInsecureSkipVerify = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASLMechanism", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HandlerSocket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandlerSocket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SASLMechanism = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASLUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1231,13 +3501,13 @@ func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.SASLUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASLPassword", wireType)
 			}
-			m.Port = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -1247,11 +3517,24 @@ func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SASLPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
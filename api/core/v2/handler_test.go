@@ -30,6 +30,84 @@ func TestFixtureSocketHandler(t *testing.T) {
 	assert.NoError(t, handler.Validate())
 }
 
+func TestFixtureOTLPHandler(t *testing.T) {
+	handler := FixtureOTLPHandler("handler", OTLPProtocolGRPC)
+	assert.Equal(t, "handler", handler.Name)
+	assert.Equal(t, HandlerOTLPType, handler.Type)
+	assert.NoError(t, handler.Validate())
+}
+
+func TestFixtureInfluxDBHandler(t *testing.T) {
+	handler := FixtureInfluxDBHandler("handler")
+	assert.Equal(t, "handler", handler.Name)
+	assert.Equal(t, HandlerInfluxDBType, handler.Type)
+	assert.NoError(t, handler.Validate())
+}
+
+func TestFixtureKafkaHandler(t *testing.T) {
+	handler := FixtureKafkaHandler("handler")
+	assert.Equal(t, "handler", handler.Name)
+	assert.Equal(t, HandlerKafkaType, handler.Type)
+	assert.NoError(t, handler.Validate())
+}
+
+func TestHandlerKafkaValidate(t *testing.T) {
+	tests := []struct {
+		Kafka *HandlerKafka
+		Error string
+	}{
+		{
+			Error: "kafka handlers need a valid kafka configuration",
+		},
+		{
+			Kafka: &HandlerKafka{Topic: "events"},
+			Error: "kafka brokers undefined",
+		},
+		{
+			Kafka: &HandlerKafka{Brokers: []string{"kafka:9092"}},
+			Error: "kafka topic undefined",
+		},
+		{
+			Kafka: &HandlerKafka{Brokers: []string{"kafka:9092"}, Topic: "events", PartitionKey: "random"},
+			Error: `invalid kafka partition key "random", expected "entity", "check" or "none"`,
+		},
+		{
+			Kafka: &HandlerKafka{Brokers: []string{"kafka:9092"}, Topic: "events", Compression: "brotli"},
+			Error: `invalid kafka compression "brotli", expected one of none, gzip, snappy, lz4, zstd`,
+		},
+		{
+			Kafka: &HandlerKafka{Brokers: []string{"kafka:9092"}, Topic: "events", SASLMechanism: "GSSAPI"},
+			Error: `invalid kafka sasl mechanism "GSSAPI", expected one of PLAIN, SCRAM-SHA-256, SCRAM-SHA-512`,
+		},
+		{
+			Kafka: &HandlerKafka{Brokers: []string{"kafka:9092"}, Topic: "events", SASLMechanism: "PLAIN"},
+			Error: "kafka sasl username undefined",
+		},
+		{
+			Kafka: &HandlerKafka{
+				Brokers:       []string{"kafka:9092"},
+				Topic:         "events",
+				PartitionKey:  KafkaPartitionKeyCheck,
+				Compression:   "zstd",
+				TLS:           true,
+				SASLMechanism: "SCRAM-SHA-512",
+				SASLUsername:  "sensu",
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			err := test.Kafka.Validate()
+			if test.Error == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, test.Error)
+		})
+	}
+}
+
 func TestHandlerValidate(t *testing.T) {
 	tests := []struct {
 		Handler Handler
@@ -192,6 +270,108 @@ func TestHandlerValidate(t *testing.T) {
 			},
 			Error: "unknown handler type: magic",
 		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "otlp",
+			},
+			Error: "otlp handlers need a valid otlp configuration",
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "otlp",
+				OTLP: &HandlerOTLP{
+					Protocol: "grpc",
+				},
+			},
+			Error: "otlp endpoint undefined",
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "otlp",
+				OTLP: &HandlerOTLP{
+					Endpoint: "localhost:4317",
+					Protocol: "carrier-pigeon",
+				},
+			},
+			Error: `invalid otlp protocol "carrier-pigeon", expected "grpc" or "http"`,
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "otlp",
+				OTLP: &HandlerOTLP{
+					Endpoint: "http://localhost:4318",
+					Protocol: "http",
+				},
+			},
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "influxdb",
+			},
+			Error: "influxdb handlers need a valid influxdb configuration",
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "influxdb",
+				InfluxDB: &HandlerInfluxDB{
+					URL: "http://localhost:8086",
+				},
+			},
+			Error: "influxdb database or bucket undefined",
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "influxdb",
+				InfluxDB: &HandlerInfluxDB{
+					URL:    "http://localhost:8086",
+					Bucket: "sensu",
+				},
+			},
+			Error: "influxdb org undefined",
+		},
+		{
+			Handler: Handler{
+				ObjectMeta: ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Type: "influxdb",
+				InfluxDB: &HandlerInfluxDB{
+					URL:    "http://localhost:8086",
+					Org:    "acme",
+					Bucket: "sensu",
+					Token:  "secret",
+				},
+			},
+		},
 		{
 			Handler: Handler{
 				ObjectMeta: ObjectMeta{
@@ -0,0 +1,133 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+const (
+	// KeepalivePoliciesResource is the name of this resource type
+	KeepalivePoliciesResource = "keepalive-policies"
+)
+
+// StorePrefix returns the path prefix to this resource in the store
+func (k *KeepalivePolicy) StorePrefix() string {
+	return KeepalivePoliciesResource
+}
+
+// URIPath returns the path component of a keepalive policy URI.
+func (k *KeepalivePolicy) URIPath() string {
+	if k.Namespace == "" {
+		return path.Join(URLPrefix, KeepalivePoliciesResource, url.PathEscape(k.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(k.Namespace), KeepalivePoliciesResource, url.PathEscape(k.Name))
+}
+
+// Validate returns an error if the keepalive policy does not pass validation
+// tests.
+func (k *KeepalivePolicy) Validate() error {
+	if err := ValidateName(k.Name); err != nil {
+		return errors.New("keepalive policy name " + err.Error())
+	}
+
+	if len(k.Stages) == 0 {
+		return errors.New("keepalive policy must have at least one stage")
+	}
+
+	var timeout uint32
+	for i, stage := range k.Stages {
+		if stage.Timeout <= timeout {
+			return errors.New("keepalive policy stage timeouts must be greater than 0 and increasing")
+		}
+		timeout = stage.Timeout
+
+		if stage.Deregister {
+			if i != len(k.Stages)-1 {
+				return errors.New("keepalive policy deregistration stage must be the last one")
+			}
+			continue
+		}
+
+		if stage.Status == 0 {
+			return fmt.Errorf("keepalive policy stage %d status must be greater than 0", i)
+		}
+	}
+
+	if k.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// Matches returns true if the keepalive policy applies to entity.
+func (k *KeepalivePolicy) Matches(entity *Entity) bool {
+	if len(k.EntityClasses) > 0 && !utilstrings.InArray(entity.EntityClass, k.EntityClasses) {
+		return false
+	}
+	if len(k.Subscriptions) > 0 && len(utilstrings.Intersect(k.Subscriptions, entity.Subscriptions)) == 0 {
+		return false
+	}
+	return true
+}
+
+// Stage returns the last stage reached by an entity that did not send any
+// keepalive for the given number of seconds, or nil if none was reached.
+func (k *KeepalivePolicy) Stage(timeSinceLastSeen int64) *KeepaliveStage {
+	var reached *KeepaliveStage
+	for i := range k.Stages {
+		if int64(k.Stages[i].Timeout) > timeSinceLastSeen {
+			break
+		}
+		reached = &k.Stages[i]
+	}
+	return reached
+}
+
+// NewKeepalivePolicy creates a new KeepalivePolicy.
+func NewKeepalivePolicy(meta ObjectMeta) *KeepalivePolicy {
+	return &KeepalivePolicy{ObjectMeta: meta}
+}
+
+// FixtureKeepalivePolicy returns a KeepalivePolicy fixture for testing.
+func FixtureKeepalivePolicy(name string) *KeepalivePolicy {
+	return &KeepalivePolicy{
+		ObjectMeta:    NewObjectMeta(name, "default"),
+		EntityClasses: []string{EntityAgentClass},
+		Stages: []KeepaliveStage{
+			{Timeout: 120, Status: 1},
+			{Timeout: 300, Status: 2, Handlers: []string{"pagerduty"}},
+			{Timeout: 3600, Deregister: true},
+		},
+	}
+}
+
+// KeepalivePolicyFields returns a set of fields that represent that resource
+func KeepalivePolicyFields(r Resource) map[string]string {
+	resource := r.(*KeepalivePolicy)
+	return map[string]string{
+		"keepalive_policy.name":           resource.ObjectMeta.Name,
+		"keepalive_policy.namespace":      resource.ObjectMeta.Namespace,
+		"keepalive_policy.entity_classes": strings.Join(resource.EntityClasses, ","),
+		"keepalive_policy.subscriptions":  strings.Join(resource.Subscriptions, ","),
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (k *KeepalivePolicy) SetNamespace(namespace string) {
+	k.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (k *KeepalivePolicy) SetObjectMeta(meta ObjectMeta) {
+	k.ObjectMeta = meta
+}
+
+func (k *KeepalivePolicy) RBACName() string {
+	return "keepalive-policies"
+}
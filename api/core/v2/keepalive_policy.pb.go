@@ -0,0 +1,1062 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: keepalive_policy.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A KeepalivePolicy escalates the keepalive events of the matching entities
+// through several stages as they stay silent.
+type KeepalivePolicy struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// keepalive policy
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// EntityClasses are the entity classes the policy applies to, or all of
+	// them if empty.
+	EntityClasses []string `protobuf:"bytes,2,rep,name=entity_classes,json=entityClasses,proto3" json:"entity_classes,omitempty"`
+	// Subscriptions are the subscriptions the policy applies to, or all of them
+	// if empty. An entity must have one of them.
+	Subscriptions []string `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// Stages are the escalation stages of the policy, by increasing timeout.
+	Stages               []KeepaliveStage `protobuf:"bytes,4,rep,name=stages,proto3" json:"stages"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *KeepalivePolicy) Reset()         { *m = KeepalivePolicy{} }
+func (m *KeepalivePolicy) String() string { return proto.CompactTextString(m) }
+func (*KeepalivePolicy) ProtoMessage()    {}
+func (*KeepalivePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4b7e09fba5e1b7, []int{0}
+}
+func (m *KeepalivePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeepalivePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeepalivePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeepalivePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeepalivePolicy.Merge(m, src)
+}
+func (m *KeepalivePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeepalivePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeepalivePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeepalivePolicy proto.InternalMessageInfo
+
+// A KeepaliveStage is a stage of a keepalive policy, reached when an entity
+// did not send any keepalive for its timeout.
+type KeepaliveStage struct {
+	// Timeout is the number of seconds since the last keepalive of an entity
+	// after which the stage is reached.
+	Timeout uint32 `protobuf:"varint,1,opt,name=timeout,proto3" json:"timeout"`
+	// Status is the status of the keepalive events in this stage.
+	Status uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	// Handlers are the handlers of the keepalive events in this stage, instead
+	// of the keepalive handlers of the entity.
+	Handlers []string `protobuf:"bytes,3,rep,name=handlers,proto3" json:"handlers,omitempty"`
+	// Deregister deregisters the entity when the stage is reached. It must be
+	// the last stage.
+	Deregister           bool     `protobuf:"varint,4,opt,name=deregister,proto3" json:"deregister,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeepaliveStage) Reset()         { *m = KeepaliveStage{} }
+func (m *KeepaliveStage) String() string { return proto.CompactTextString(m) }
+func (*KeepaliveStage) ProtoMessage()    {}
+func (*KeepaliveStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4b7e09fba5e1b7, []int{1}
+}
+func (m *KeepaliveStage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeepaliveStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeepaliveStage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeepaliveStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeepaliveStage.Merge(m, src)
+}
+func (m *KeepaliveStage) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeepaliveStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeepaliveStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeepaliveStage proto.InternalMessageInfo
+
+func (m *KeepaliveStage) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *KeepaliveStage) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *KeepaliveStage) GetHandlers() []string {
+	if m != nil {
+		return m.Handlers
+	}
+	return nil
+}
+
+func (m *KeepaliveStage) GetDeregister() bool {
+	if m != nil {
+		return m.Deregister
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*KeepalivePolicy)(nil), "sensu.core.v2.KeepalivePolicy")
+	proto.RegisterType((*KeepaliveStage)(nil), "sensu.core.v2.KeepaliveStage")
+}
+
+func init() { proto.RegisterFile("keepalive_policy.proto", fileDescriptor_7d4b7e09fba5e1b7) }
+
+var fileDescriptor_7d4b7e09fba5e1b7 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0xcf, 0x25, 0x55, 0x09, 0x17, 0x25, 0x48, 0x27, 0x54, 0x4c, 0x01, 0x9f, 0x15, 0x09, 0x29,
+	0x03, 0x72, 0x55, 0x97, 0x01, 0x31, 0x81, 0x2b, 0x26, 0x84, 0x40, 0x41, 0x2c, 0x2c, 0x95, 0xed,
+	0x7c, 0xb8, 0x07, 0x71, 0xce, 0xf2, 0x7d, 0x8e, 0x94, 0x37, 0xe0, 0x11, 0x18, 0x3b, 0xf6, 0x11,
+	0x58, 0xd9, 0xca, 0xd6, 0x27, 0x38, 0x81, 0xd9, 0xfc, 0x04, 0x8c, 0xc8, 0xe7, 0x38, 0xd8, 0x9d,
+	0xfc, 0xe9, 0xf7, 0xe7, 0xfb, 0xf9, 0xfb, 0xe9, 0xe8, 0xc1, 0x17, 0x80, 0x34, 0x58, 0x8a, 0x35,
+	0x9c, 0xa5, 0x72, 0x29, 0xa2, 0x8d, 0x9b, 0x66, 0x12, 0x25, 0x1b, 0x2b, 0x58, 0xa9, 0xdc, 0x8d,
+	0x64, 0x06, 0xee, 0xda, 0x3b, 0x7c, 0x1a, 0x0b, 0x3c, 0xcf, 0x43, 0x37, 0x92, 0xc9, 0x51, 0x2c,
+	0x63, 0x79, 0x64, 0x54, 0x61, 0xfe, 0xe9, 0xc5, 0xfa, 0xd8, 0x3d, 0x71, 0x8f, 0x0d, 0x68, 0x30,
+	0x33, 0xd5, 0x4b, 0x0e, 0x69, 0x02, 0x18, 0xd4, 0xf3, 0xf4, 0x47, 0x9f, 0xde, 0x79, 0xdd, 0x64,
+	0xbd, 0x33, 0x51, 0xec, 0x03, 0x1d, 0x56, 0x8a, 0x45, 0x80, 0x81, 0x45, 0x1c, 0x32, 0x1b, 0x79,
+	0xf7, 0xdd, 0x4e, 0xae, 0xfb, 0x36, 0xfc, 0x0c, 0x11, 0xbe, 0x01, 0x0c, 0x7c, 0xfb, 0x4a, 0xf3,
+	0xde, 0xb5, 0xe6, 0xa4, 0xd4, 0x9c, 0x35, 0xb6, 0x27, 0x32, 0x11, 0x08, 0x49, 0x8a, 0x9b, 0xf9,
+	0x6e, 0x15, 0x3b, 0xa5, 0x13, 0x58, 0xa1, 0xc0, 0xcd, 0x59, 0xb4, 0x0c, 0x94, 0x02, 0x65, 0xf5,
+	0x9d, 0xc1, 0xec, 0xb6, 0xff, 0xb0, 0xd4, 0xdc, 0xea, 0x32, 0x2d, 0xff, 0xb8, 0x66, 0x4e, 0x6b,
+	0x82, 0xbd, 0xa4, 0x63, 0x95, 0x87, 0x2a, 0xca, 0x44, 0x8a, 0x42, 0xae, 0x94, 0x35, 0x30, 0x3b,
+	0x1e, 0x94, 0x9a, 0xdf, 0xeb, 0x10, 0xed, 0x15, 0x1d, 0x82, 0xbd, 0xa2, 0xfb, 0x0a, 0x83, 0x18,
+	0x94, 0xb5, 0xe7, 0x0c, 0x66, 0x23, 0xef, 0xd1, 0x8d, 0xe3, 0x76, 0x75, 0xbc, 0xaf, 0x54, 0xfe,
+	0xa4, 0x3a, 0xb0, 0xd4, 0x7c, 0x6b, 0x9a, 0x6f, 0xbf, 0xcf, 0x87, 0x5f, 0x2f, 0x78, 0xef, 0xf2,
+	0x82, 0x93, 0xe9, 0x4f, 0x42, 0x27, 0x5d, 0x13, 0x7b, 0x4c, 0x6f, 0xa1, 0x48, 0x40, 0xe6, 0x68,
+	0x1a, 0x1c, 0xfb, 0xa3, 0x52, 0xf3, 0x06, 0x9a, 0x37, 0x03, 0x9b, 0x9a, 0x5f, 0xc1, 0xbc, 0xaa,
+	0xa2, 0x52, 0xd1, 0x6d, 0x0e, 0xe6, 0x75, 0x0e, 0xe6, 0x8a, 0x79, 0x74, 0x78, 0x1e, 0xac, 0x16,
+	0x4b, 0xc8, 0x9a, 0x63, 0x0f, 0xaa, 0xaa, 0x1b, 0xac, 0x5d, 0x75, 0x83, 0xb1, 0x67, 0x94, 0x2e,
+	0x20, 0x83, 0x58, 0x28, 0x84, 0xcc, 0xda, 0x73, 0xc8, 0x6c, 0xe8, 0x5b, 0xa5, 0xe6, 0x77, 0xff,
+	0xa3, 0x2d, 0x5f, 0x4b, 0xeb, 0x3b, 0x7f, 0x7f, 0xdb, 0xe4, 0xb2, 0xb0, 0xc9, 0xf7, 0xc2, 0x26,
+	0x57, 0x85, 0x4d, 0xae, 0x0b, 0x9b, 0xfc, 0x2a, 0x6c, 0xf2, 0xed, 0x8f, 0xdd, 0xfb, 0xd8, 0x5f,
+	0x7b, 0xe1, 0xbe, 0x79, 0x38, 0x27, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x0e, 0xac, 0x3f,
+	0xa3, 0x02, 0x00, 0x00,
+}
+
+func (this *KeepalivePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeepalivePolicy)
+	if !ok {
+		that2, ok := that.(KeepalivePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if len(this.EntityClasses) != len(that1.EntityClasses) {
+		return false
+	}
+	for i := range this.EntityClasses {
+		if this.EntityClasses[i] != that1.EntityClasses[i] {
+			return false
+		}
+	}
+	if len(this.Subscriptions) != len(that1.Subscriptions) {
+		return false
+	}
+	for i := range this.Subscriptions {
+		if this.Subscriptions[i] != that1.Subscriptions[i] {
+			return false
+		}
+	}
+	if len(this.Stages) != len(that1.Stages) {
+		return false
+	}
+	for i := range this.Stages {
+		if !this.Stages[i].Equal(&that1.Stages[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *KeepaliveStage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeepaliveStage)
+	if !ok {
+		that2, ok := that.(KeepaliveStage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if len(this.Handlers) != len(that1.Handlers) {
+		return false
+	}
+	for i := range this.Handlers {
+		if this.Handlers[i] != that1.Handlers[i] {
+			return false
+		}
+	}
+	if this.Deregister != that1.Deregister {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type KeepalivePolicyFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetEntityClasses() []string
+	GetSubscriptions() []string
+	GetStages() []KeepaliveStage
+}
+
+func (this *KeepalivePolicy) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *KeepalivePolicy) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewKeepalivePolicyFromFace(this)
+}
+
+func (this *KeepalivePolicy) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *KeepalivePolicy) GetEntityClasses() []string {
+	return this.EntityClasses
+}
+
+func (this *KeepalivePolicy) GetSubscriptions() []string {
+	return this.Subscriptions
+}
+
+func (this *KeepalivePolicy) GetStages() []KeepaliveStage {
+	return this.Stages
+}
+
+func NewKeepalivePolicyFromFace(that KeepalivePolicyFace) *KeepalivePolicy {
+	this := &KeepalivePolicy{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.EntityClasses = that.GetEntityClasses()
+	this.Subscriptions = that.GetSubscriptions()
+	this.Stages = that.GetStages()
+	return this
+}
+
+func (m *KeepalivePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeepalivePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeepalivePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeepalivePolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Subscriptions[iNdEx])
+			copy(dAtA[i:], m.Subscriptions[iNdEx])
+			i = encodeVarintKeepalivePolicy(dAtA, i, uint64(len(m.Subscriptions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EntityClasses) > 0 {
+		for iNdEx := len(m.EntityClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EntityClasses[iNdEx])
+			copy(dAtA[i:], m.EntityClasses[iNdEx])
+			i = encodeVarintKeepalivePolicy(dAtA, i, uint64(len(m.EntityClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeepalivePolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KeepaliveStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeepaliveStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeepaliveStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Deregister {
+		i--
+		if m.Deregister {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Handlers) > 0 {
+		for iNdEx := len(m.Handlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Handlers[iNdEx])
+			copy(dAtA[i:], m.Handlers[iNdEx])
+			i = encodeVarintKeepalivePolicy(dAtA, i, uint64(len(m.Handlers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintKeepalivePolicy(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintKeepalivePolicy(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeepalivePolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeepalivePolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedKeepalivePolicy(r randyKeepalivePolicy, easy bool) *KeepalivePolicy {
+	this := &KeepalivePolicy{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	v2 := r.Intn(10)
+	this.EntityClasses = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.EntityClasses[i] = string(randStringKeepalivePolicy(r))
+	}
+	v3 := r.Intn(10)
+	this.Subscriptions = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Subscriptions[i] = string(randStringKeepalivePolicy(r))
+	}
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.Stages = make([]KeepaliveStage, v4)
+		for i := 0; i < v4; i++ {
+			v5 := NewPopulatedKeepaliveStage(r, easy)
+			this.Stages[i] = *v5
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedKeepalivePolicy(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedKeepaliveStage(r randyKeepalivePolicy, easy bool) *KeepaliveStage {
+	this := &KeepaliveStage{}
+	this.Timeout = uint32(r.Uint32())
+	this.Status = uint32(r.Uint32())
+	v6 := r.Intn(10)
+	this.Handlers = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.Handlers[i] = string(randStringKeepalivePolicy(r))
+	}
+	this.Deregister = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedKeepalivePolicy(r, 5)
+	}
+	return this
+}
+
+type randyKeepalivePolicy interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneKeepalivePolicy(r randyKeepalivePolicy) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringKeepalivePolicy(r randyKeepalivePolicy) string {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
+		tmps[i] = randUTF8RuneKeepalivePolicy(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedKeepalivePolicy(r randyKeepalivePolicy, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldKeepalivePolicy(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldKeepalivePolicy(dAtA []byte, r randyKeepalivePolicy, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateKeepalivePolicy(dAtA, uint64(key))
+		v8 := r.Int63()
+		if r.Intn(2) == 0 {
+			v8 *= -1
+		}
+		dAtA = encodeVarintPopulateKeepalivePolicy(dAtA, uint64(v8))
+	case 1:
+		dAtA = encodeVarintPopulateKeepalivePolicy(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateKeepalivePolicy(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateKeepalivePolicy(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateKeepalivePolicy(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateKeepalivePolicy(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *KeepalivePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovKeepalivePolicy(uint64(l))
+	if len(m.EntityClasses) > 0 {
+		for _, s := range m.EntityClasses {
+			l = len(s)
+			n += 1 + l + sovKeepalivePolicy(uint64(l))
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, s := range m.Subscriptions {
+			l = len(s)
+			n += 1 + l + sovKeepalivePolicy(uint64(l))
+		}
+	}
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovKeepalivePolicy(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeepaliveStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timeout != 0 {
+		n += 1 + sovKeepalivePolicy(uint64(m.Timeout))
+	}
+	if m.Status != 0 {
+		n += 1 + sovKeepalivePolicy(uint64(m.Status))
+	}
+	if len(m.Handlers) > 0 {
+		for _, s := range m.Handlers {
+			l = len(s)
+			n += 1 + l + sovKeepalivePolicy(uint64(l))
+		}
+	}
+	if m.Deregister {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovKeepalivePolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeepalivePolicy(x uint64) (n int) {
+	return sovKeepalivePolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeepalivePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeepalivePolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeepalivePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeepalivePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityClasses = append(m.EntityClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, KeepaliveStage{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeepalivePolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeepaliveStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeepalivePolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeepaliveStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeepaliveStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handlers = append(m.Handlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deregister", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deregister = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeepalivePolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeepalivePolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeepalivePolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeepalivePolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeepalivePolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeepalivePolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeepalivePolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeepalivePolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeepalivePolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeepalivePolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeepalivePolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeepalivePolicyValidate(t *testing.T) {
+	k := FixtureKeepalivePolicy("foo")
+	assert.NoError(t, k.Validate())
+
+	k.Name = ""
+	assert.Error(t, k.Validate())
+
+	k = FixtureKeepalivePolicy("foo")
+	k.Stages = nil
+	assert.Error(t, k.Validate())
+
+	// stage timeouts must be increasing
+	k = FixtureKeepalivePolicy("foo")
+	k.Stages[1].Timeout = 120
+	assert.Error(t, k.Validate())
+
+	// the deregistration stage must be the last one
+	k = FixtureKeepalivePolicy("foo")
+	k.Stages = append(k.Stages, KeepaliveStage{Timeout: 7200, Status: 2})
+	assert.Error(t, k.Validate())
+
+	k = FixtureKeepalivePolicy("foo")
+	k.Stages[0].Status = 0
+	assert.Error(t, k.Validate())
+}
+
+func TestKeepalivePolicyMatches(t *testing.T) {
+	k := FixtureKeepalivePolicy("foo")
+	entity := FixtureEntity("bar")
+	entity.EntityClass = EntityAgentClass
+	assert.True(t, k.Matches(entity))
+
+	entity.EntityClass = EntityProxyClass
+	assert.False(t, k.Matches(entity))
+
+	k.EntityClasses = nil
+	k.Subscriptions = []string{"database"}
+	assert.False(t, k.Matches(entity))
+
+	entity.Subscriptions = append(entity.Subscriptions, "database")
+	assert.True(t, k.Matches(entity))
+}
+
+func TestKeepalivePolicyStage(t *testing.T) {
+	k := FixtureKeepalivePolicy("foo")
+	assert.Nil(t, k.Stage(60))
+	assert.Equal(t, uint32(1), k.Stage(120).Status)
+	assert.Equal(t, uint32(2), k.Stage(600).Status)
+	assert.True(t, k.Stage(3600).Deregister)
+}
@@ -0,0 +1,227 @@
+package v2
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+const (
+	// MaintenanceWindowsResource is the name of this resource type
+	MaintenanceWindowsResource = "maintenance-windows"
+)
+
+// MaintenanceWindowOccurrence is a period during which a maintenance window
+// was active.
+type MaintenanceWindowOccurrence struct {
+	// Begin is the beginning of the occurrence, in seconds since the Epoch.
+	Begin int64 `json:"begin"`
+
+	// End is the end of the occurrence, in seconds since the Epoch.
+	End int64 `json:"end"`
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (m *MaintenanceWindow) StorePrefix() string {
+	return MaintenanceWindowsResource
+}
+
+// URIPath returns the path component of a maintenance window URI.
+func (m *MaintenanceWindow) URIPath() string {
+	if m.Namespace == "" {
+		return path.Join(URLPrefix, MaintenanceWindowsResource, url.PathEscape(m.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(m.Namespace), MaintenanceWindowsResource, url.PathEscape(m.Name))
+}
+
+// Validate returns an error if the maintenance window does not pass
+// validation tests.
+func (m *MaintenanceWindow) Validate() error {
+	if err := ValidateName(m.Name); err != nil {
+		return errors.New("maintenance window name " + err.Error())
+	}
+
+	if m.Schedule == nil && m.End == 0 {
+		return errors.New("maintenance window must have a schedule or an end")
+	}
+
+	if m.End > 0 && m.End <= m.Begin {
+		return errors.New("maintenance window end must be greater than its beginning")
+	}
+
+	if err := m.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	if m.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// IsActive returns true if the maintenance window is active at the given
+// time.
+func (m *MaintenanceWindow) IsActive(t time.Time) bool {
+	now := t.Unix()
+	if now < m.Begin || (m.End > 0 && now >= m.End) {
+		return false
+	}
+	if m.Schedule == nil {
+		return true
+	}
+	active, err := m.Schedule.InWindows(t.UTC())
+	if err != nil {
+		return false
+	}
+	return active
+}
+
+// Affects returns true if the maintenance window affects the entity and check
+// of event. Every non-empty list of subscriptions, entities and checks of the
+// maintenance window must match the event.
+func (m *MaintenanceWindow) Affects(event *Event) bool {
+	if !event.HasCheck() || event.Entity == nil {
+		return false
+	}
+	if len(m.Entities) > 0 && !utilstrings.InArray(event.Entity.Name, m.Entities) {
+		return false
+	}
+	if len(m.Checks) > 0 && !utilstrings.InArray(event.Check.Name, m.Checks) {
+		return false
+	}
+	if len(m.Subscriptions) > 0 {
+		if len(utilstrings.Intersect(m.Subscriptions, event.Entity.Subscriptions)) == 0 &&
+			len(utilstrings.Intersect(m.Subscriptions, event.Check.Subscriptions)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// History returns the occurrences of the maintenance window between from and
+// to, from the oldest to the most recent. The occurrences are clipped to the
+// given period.
+func (m *MaintenanceWindow) History(from, to time.Time) []MaintenanceWindowOccurrence {
+	begin, end := from.Unix(), to.Unix()
+	if m.Begin > begin {
+		begin = m.Begin
+	}
+	if m.End > 0 && m.End < end {
+		end = m.End
+	}
+	if begin >= end {
+		return []MaintenanceWindowOccurrence{}
+	}
+
+	if m.Schedule == nil {
+		return []MaintenanceWindowOccurrence{{Begin: begin, End: end}}
+	}
+
+	ranges := [][2]int64{}
+	windowsByDay := m.Schedule.MapTimeWindows()
+	day := time.Unix(begin, 0).UTC().Truncate(24 * time.Hour)
+	for ; day.Unix() < end; day = day.AddDate(0, 0, 1) {
+		var windows []*TimeWindowTimeRange
+		windows = append(windows, windowsByDay["All"]...)
+		windows = append(windows, windowsByDay[day.Weekday().String()]...)
+		for _, window := range windows {
+			for _, r := range window.ranges(day) {
+				if r[0] < begin {
+					r[0] = begin
+				}
+				if r[1] > end {
+					r[1] = end
+				}
+				if r[0] < r[1] {
+					ranges = append(ranges, r)
+				}
+			}
+		}
+	}
+
+	// Merge the overlapping ranges, such as a window ending at midnight and
+	// a window beginning at midnight
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	history := []MaintenanceWindowOccurrence{}
+	for _, r := range ranges {
+		if n := len(history); n > 0 && r[0] <= history[n-1].End {
+			if r[1] > history[n-1].End {
+				history[n-1].End = r[1]
+			}
+			continue
+		}
+		history = append(history, MaintenanceWindowOccurrence{Begin: r[0], End: r[1]})
+	}
+	return history
+}
+
+// ranges returns the periods of day, in seconds since the Epoch, covered by
+// the time window, like InWindow. A time window ending before its beginning
+// covers the beginning and the end of the day.
+func (t *TimeWindowTimeRange) ranges(day time.Time) [][2]int64 {
+	begin, err := time.Parse(time.Kitchen, strings.Replace(t.Begin, " ", "", -1))
+	if err != nil {
+		return nil
+	}
+	end, err := time.Parse(time.Kitchen, strings.Replace(t.End, " ", "", -1))
+	if err != nil {
+		return nil
+	}
+	midnight := day.Unix()
+	b := midnight + int64(begin.Hour()*3600+begin.Minute()*60)
+	e := midnight + int64(end.Hour()*3600+end.Minute()*60)
+	if e < b {
+		return [][2]int64{{midnight, e}, {b, midnight + 24*3600}}
+	}
+	return [][2]int64{{b, e}}
+}
+
+// NewMaintenanceWindow creates a new MaintenanceWindow.
+func NewMaintenanceWindow(meta ObjectMeta) *MaintenanceWindow {
+	return &MaintenanceWindow{ObjectMeta: meta}
+}
+
+// FixtureMaintenanceWindow returns a MaintenanceWindow fixture for testing.
+func FixtureMaintenanceWindow(name string) *MaintenanceWindow {
+	return &MaintenanceWindow{
+		ObjectMeta: NewObjectMeta(name, "default"),
+		Schedule: &TimeWindowWhen{
+			Days: TimeWindowDays{
+				Sunday: []*TimeWindowTimeRange{{Begin: "1:00 AM", End: "5:00 AM"}},
+			},
+		},
+		Subscriptions: []string{"linux"},
+	}
+}
+
+// MaintenanceWindowFields returns a set of fields that represent that resource
+func MaintenanceWindowFields(r Resource) map[string]string {
+	resource := r.(*MaintenanceWindow)
+	return map[string]string{
+		"maintenance_window.name":          resource.ObjectMeta.Name,
+		"maintenance_window.namespace":     resource.ObjectMeta.Namespace,
+		"maintenance_window.subscriptions": strings.Join(resource.Subscriptions, ","),
+		"maintenance_window.entities":      strings.Join(resource.Entities, ","),
+		"maintenance_window.checks":        strings.Join(resource.Checks, ","),
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (m *MaintenanceWindow) SetNamespace(namespace string) {
+	m.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (m *MaintenanceWindow) SetObjectMeta(meta ObjectMeta) {
+	m.ObjectMeta = meta
+}
+
+func (m *MaintenanceWindow) RBACName() string {
+	return "maintenance-windows"
+}
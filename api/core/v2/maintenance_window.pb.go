@@ -0,0 +1,894 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance_window.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A MaintenanceWindow is a planned period, possibly recurring, during which
+// the events of the affected entities and checks are not handled.
+type MaintenanceWindow struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// maintenance window
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Schedule is the recurring schedule of the maintenance window, in UTC. The
+	// maintenance window is active from Begin to End if not set.
+	Schedule *TimeWindowWhen `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Begin is the time, in seconds since the Epoch, before which the
+	// maintenance window is never active.
+	Begin int64 `protobuf:"varint,3,opt,name=begin,proto3" json:"begin"`
+	// End is the time, in seconds since the Epoch, after which the maintenance
+	// window is never active.
+	End int64 `protobuf:"varint,4,opt,name=end,proto3" json:"end"`
+	// Subscriptions are the subscriptions affected by the maintenance window,
+	// matched against the subscriptions of the entities and checks.
+	Subscriptions []string `protobuf:"bytes,5,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// Entities are the names of the entities affected by the maintenance
+	// window.
+	Entities []string `protobuf:"bytes,6,rep,name=entities,proto3" json:"entities"`
+	// Checks are the names of the checks affected by the maintenance window.
+	Checks []string `protobuf:"bytes,7,rep,name=checks,proto3" json:"checks"`
+	// Reason is the reason of the maintenance.
+	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05b72d3170c07d23, []int{0}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MaintenanceWindow)(nil), "sensu.core.v2.MaintenanceWindow")
+}
+
+func init() { proto.RegisterFile("maintenance_window.proto", fileDescriptor_05b72d3170c07d23) }
+
+var fileDescriptor_05b72d3170c07d23 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xe3, 0x2e, 0x4d, 0x37, 0x86, 0x4a, 0xc4, 0x42, 0xc8, 0xad, 0x84, 0xbd, 0xea, 0x69,
+	0x0f, 0x95, 0xab, 0xa6, 0x48, 0x48, 0x9c, 0xd0, 0xde, 0x2b, 0xc4, 0x0a, 0x54, 0x89, 0x0b, 0xda,
+	0x75, 0x86, 0xc4, 0xc0, 0xda, 0x51, 0xec, 0x4d, 0xc5, 0x1b, 0xf0, 0x08, 0x1c, 0x7b, 0xec, 0x23,
+	0xf4, 0x11, 0x7a, 0xec, 0x13, 0x58, 0xb0, 0xdc, 0xf6, 0x09, 0x38, 0xa2, 0x38, 0x9b, 0xd0, 0xf4,
+	0xe2, 0x99, 0xf9, 0x66, 0xfe, 0xdf, 0x63, 0x19, 0xd3, 0xaa, 0x50, 0xda, 0x81, 0x2e, 0xb4, 0x84,
+	0x4f, 0x97, 0x4a, 0x8f, 0xcd, 0xa5, 0x98, 0xcd, 0x8d, 0x33, 0x64, 0xdf, 0x82, 0xb6, 0xb5, 0x90,
+	0x66, 0x0e, 0x62, 0x31, 0x3a, 0x7c, 0x39, 0x51, 0x6e, 0x5a, 0x97, 0x42, 0x9a, 0xea, 0x64, 0x62,
+	0x26, 0xe6, 0x24, 0x4c, 0x95, 0xf5, 0xe7, 0x37, 0x8b, 0x53, 0x71, 0x26, 0x4e, 0x03, 0x0c, 0x2c,
+	0x64, 0x2b, 0x93, 0x43, 0x5c, 0x81, 0x2b, 0xba, 0x7c, 0xe8, 0x54, 0xb5, 0x7d, 0xc7, 0xd1, 0x4d,
+	0x84, 0x87, 0xe7, 0xff, 0x17, 0xb8, 0x08, 0x3d, 0xf2, 0x01, 0xc7, 0x4b, 0xd9, 0xb8, 0x70, 0x05,
+	0x45, 0x09, 0x4a, 0x1f, 0x8f, 0x0e, 0xc4, 0xd6, 0x32, 0xe2, 0x6d, 0xf9, 0x05, 0xa4, 0x3b, 0x07,
+	0x57, 0x64, 0xec, 0xd6, 0xf3, 0xde, 0x9d, 0xe7, 0xa8, 0xf5, 0x9c, 0xac, 0x65, 0xc7, 0xa6, 0x52,
+	0x0e, 0xaa, 0x99, 0xfb, 0x9e, 0x6f, 0xac, 0xc8, 0x3b, 0x1c, 0x5b, 0x39, 0x85, 0x71, 0xfd, 0x0d,
+	0xe8, 0x4e, 0xb0, 0x7d, 0xf1, 0xc0, 0xf6, 0xbd, 0xaa, 0xba, 0x1d, 0x2e, 0xa6, 0xa0, 0xb3, 0xe7,
+	0x4b, 0xcb, 0xb5, 0xe4, 0xbe, 0xe5, 0x9a, 0x11, 0x8e, 0x77, 0x4b, 0x98, 0x28, 0x4d, 0xa3, 0x04,
+	0xa5, 0x51, 0x36, 0x68, 0x3d, 0x5f, 0x81, 0x7c, 0x15, 0xc8, 0x01, 0x8e, 0x40, 0x8f, 0xe9, 0xa3,
+	0xd0, 0xde, 0x6b, 0x3d, 0x5f, 0x96, 0xf9, 0xf2, 0x20, 0xaf, 0xf0, 0xbe, 0xad, 0x4b, 0x2b, 0xe7,
+	0x6a, 0xe6, 0x94, 0xd1, 0x96, 0xee, 0x26, 0x51, 0x3a, 0xc8, 0x86, 0xad, 0xe7, 0xdb, 0x8d, 0x7c,
+	0xbb, 0x24, 0x29, 0x8e, 0x41, 0x3b, 0xe5, 0x14, 0x58, 0xda, 0x0f, 0x9a, 0x27, 0xad, 0xe7, 0x1b,
+	0x96, 0x6f, 0x32, 0x72, 0x84, 0xfb, 0x72, 0x0a, 0xf2, 0xab, 0xa5, 0x7b, 0x61, 0x0e, 0xb7, 0x9e,
+	0x77, 0x24, 0xef, 0x22, 0x39, 0xc6, 0xfd, 0x39, 0x14, 0xd6, 0x68, 0x1a, 0x27, 0x28, 0x1d, 0x64,
+	0xcf, 0x5a, 0xcf, 0x9f, 0xae, 0xc8, 0xbd, 0x27, 0x77, 0x33, 0xaf, 0xe3, 0x1f, 0x57, 0xbc, 0x77,
+	0x7d, 0xc5, 0x51, 0x96, 0xfc, 0xfd, 0xcd, 0xd0, 0x75, 0xc3, 0xd0, 0x4d, 0xc3, 0xd0, 0x6d, 0xc3,
+	0xd0, 0x5d, 0xc3, 0xd0, 0xaf, 0x86, 0xa1, 0x9f, 0x7f, 0x58, 0xef, 0xe3, 0xce, 0x62, 0x54, 0xf6,
+	0xc3, 0x1f, 0x9f, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x83, 0x62, 0xa5, 0x63, 0x02, 0x00,
+	0x00,
+}
+
+func (this *MaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if !this.Schedule.Equal(that1.Schedule) {
+		return false
+	}
+	if this.Begin != that1.Begin {
+		return false
+	}
+	if this.End != that1.End {
+		return false
+	}
+	if len(this.Subscriptions) != len(that1.Subscriptions) {
+		return false
+	}
+	for i := range this.Subscriptions {
+		if this.Subscriptions[i] != that1.Subscriptions[i] {
+			return false
+		}
+	}
+	if len(this.Entities) != len(that1.Entities) {
+		return false
+	}
+	for i := range this.Entities {
+		if this.Entities[i] != that1.Entities[i] {
+			return false
+		}
+	}
+	if len(this.Checks) != len(that1.Checks) {
+		return false
+	}
+	for i := range this.Checks {
+		if this.Checks[i] != that1.Checks[i] {
+			return false
+		}
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type MaintenanceWindowFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetSchedule() *TimeWindowWhen
+	GetBegin() int64
+	GetEnd() int64
+	GetSubscriptions() []string
+	GetEntities() []string
+	GetChecks() []string
+	GetReason() string
+}
+
+func (this *MaintenanceWindow) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *MaintenanceWindow) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewMaintenanceWindowFromFace(this)
+}
+
+func (this *MaintenanceWindow) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *MaintenanceWindow) GetSchedule() *TimeWindowWhen {
+	return this.Schedule
+}
+
+func (this *MaintenanceWindow) GetBegin() int64 {
+	return this.Begin
+}
+
+func (this *MaintenanceWindow) GetEnd() int64 {
+	return this.End
+}
+
+func (this *MaintenanceWindow) GetSubscriptions() []string {
+	return this.Subscriptions
+}
+
+func (this *MaintenanceWindow) GetEntities() []string {
+	return this.Entities
+}
+
+func (this *MaintenanceWindow) GetChecks() []string {
+	return this.Checks
+}
+
+func (this *MaintenanceWindow) GetReason() string {
+	return this.Reason
+}
+
+func NewMaintenanceWindowFromFace(that MaintenanceWindowFace) *MaintenanceWindow {
+	this := &MaintenanceWindow{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Schedule = that.GetSchedule()
+	this.Begin = that.GetBegin()
+	this.End = that.GetEnd()
+	this.Subscriptions = that.GetSubscriptions()
+	this.Entities = that.GetEntities()
+	this.Checks = that.GetChecks()
+	this.Reason = that.GetReason()
+	return this
+}
+
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMaintenanceWindow(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checks[iNdEx])
+			copy(dAtA[i:], m.Checks[iNdEx])
+			i = encodeVarintMaintenanceWindow(dAtA, i, uint64(len(m.Checks[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Entities) > 0 {
+		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Entities[iNdEx])
+			copy(dAtA[i:], m.Entities[iNdEx])
+			i = encodeVarintMaintenanceWindow(dAtA, i, uint64(len(m.Entities[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Subscriptions[iNdEx])
+			copy(dAtA[i:], m.Subscriptions[iNdEx])
+			i = encodeVarintMaintenanceWindow(dAtA, i, uint64(len(m.Subscriptions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.End != 0 {
+		i = encodeVarintMaintenanceWindow(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Begin != 0 {
+		i = encodeVarintMaintenanceWindow(dAtA, i, uint64(m.Begin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaintenanceWindow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaintenanceWindow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaintenanceWindow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaintenanceWindow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedMaintenanceWindow(r randyMaintenanceWindow, easy bool) *MaintenanceWindow {
+	this := &MaintenanceWindow{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	if r.Intn(5) != 0 {
+		this.Schedule = NewPopulatedTimeWindowWhen(r, easy)
+	}
+	this.Begin = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Begin *= -1
+	}
+	this.End = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.End *= -1
+	}
+	v2 := r.Intn(10)
+	this.Subscriptions = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Subscriptions[i] = string(randStringMaintenanceWindow(r))
+	}
+	v3 := r.Intn(10)
+	this.Entities = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Entities[i] = string(randStringMaintenanceWindow(r))
+	}
+	v4 := r.Intn(10)
+	this.Checks = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.Checks[i] = string(randStringMaintenanceWindow(r))
+	}
+	this.Reason = string(randStringMaintenanceWindow(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMaintenanceWindow(r, 9)
+	}
+	return this
+}
+
+type randyMaintenanceWindow interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneMaintenanceWindow(r randyMaintenanceWindow) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringMaintenanceWindow(r randyMaintenanceWindow) string {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
+		tmps[i] = randUTF8RuneMaintenanceWindow(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedMaintenanceWindow(r randyMaintenanceWindow, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldMaintenanceWindow(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldMaintenanceWindow(dAtA []byte, r randyMaintenanceWindow, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateMaintenanceWindow(dAtA, uint64(key))
+		v6 := r.Int63()
+		if r.Intn(2) == 0 {
+			v6 *= -1
+		}
+		dAtA = encodeVarintPopulateMaintenanceWindow(dAtA, uint64(v6))
+	case 1:
+		dAtA = encodeVarintPopulateMaintenanceWindow(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateMaintenanceWindow(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateMaintenanceWindow(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateMaintenanceWindow(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateMaintenanceWindow(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovMaintenanceWindow(uint64(l))
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovMaintenanceWindow(uint64(l))
+	}
+	if m.Begin != 0 {
+		n += 1 + sovMaintenanceWindow(uint64(m.Begin))
+	}
+	if m.End != 0 {
+		n += 1 + sovMaintenanceWindow(uint64(m.End))
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, s := range m.Subscriptions {
+			l = len(s)
+			n += 1 + l + sovMaintenanceWindow(uint64(l))
+		}
+	}
+	if len(m.Entities) > 0 {
+		for _, s := range m.Entities {
+			l = len(s)
+			n += 1 + l + sovMaintenanceWindow(uint64(l))
+		}
+	}
+	if len(m.Checks) > 0 {
+		for _, s := range m.Checks {
+			l = len(s)
+			n += 1 + l + sovMaintenanceWindow(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMaintenanceWindow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMaintenanceWindow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMaintenanceWindow(x uint64) (n int) {
+	return sovMaintenanceWindow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaintenanceWindow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &TimeWindowWhen{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			m.Begin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Begin |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entities = append(m.Entities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaintenanceWindow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMaintenanceWindow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMaintenanceWindow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMaintenanceWindow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaintenanceWindow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMaintenanceWindow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMaintenanceWindow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMaintenanceWindow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMaintenanceWindow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMaintenanceWindow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMaintenanceWindow = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	m := FixtureMaintenanceWindow("foo")
+	assert.NoError(t, m.Validate())
+
+	m.Name = ""
+	assert.Error(t, m.Validate())
+
+	// a maintenance window without a schedule must end
+	m = FixtureMaintenanceWindow("foo")
+	m.Schedule = nil
+	assert.Error(t, m.Validate())
+	m.Begin, m.End = 2000, 1000
+	assert.Error(t, m.Validate())
+	m.Begin, m.End = 1000, 2000
+	assert.NoError(t, m.Validate())
+
+	m = FixtureMaintenanceWindow("foo")
+	m.Schedule.Days.Sunday[0].Begin = "not a time"
+	assert.Error(t, m.Validate())
+}
+
+func TestMaintenanceWindowIsActive(t *testing.T) {
+	m := FixtureMaintenanceWindow("foo")
+
+	// Sunday 2:00 AM UTC
+	sunday := time.Date(2020, 3, 1, 2, 0, 0, 0, time.UTC)
+	assert.True(t, m.IsActive(sunday))
+	assert.False(t, m.IsActive(sunday.Add(4*time.Hour)))
+	assert.False(t, m.IsActive(sunday.AddDate(0, 0, 1)))
+
+	m.End = sunday.Unix()
+	assert.False(t, m.IsActive(sunday))
+
+	m = FixtureMaintenanceWindow("foo")
+	m.Schedule = nil
+	m.Begin, m.End = sunday.Unix(), sunday.Add(time.Hour).Unix()
+	assert.True(t, m.IsActive(sunday))
+	assert.False(t, m.IsActive(sunday.Add(-time.Second)))
+	assert.False(t, m.IsActive(sunday.Add(time.Hour)))
+}
+
+func TestMaintenanceWindowAffects(t *testing.T) {
+	event := FixtureEvent("entity1", "check1")
+	event.Entity.Subscriptions = []string{"linux"}
+	event.Check.Subscriptions = []string{"web"}
+
+	m := FixtureMaintenanceWindow("foo")
+	assert.True(t, m.Affects(event))
+
+	m.Subscriptions = []string{"web"}
+	assert.True(t, m.Affects(event))
+
+	m.Subscriptions = []string{"windows"}
+	assert.False(t, m.Affects(event))
+
+	m.Subscriptions = nil
+	m.Entities = []string{"entity1"}
+	m.Checks = []string{"check2"}
+	assert.False(t, m.Affects(event))
+
+	m.Checks = []string{"check1", "check2"}
+	assert.True(t, m.Affects(event))
+
+	assert.False(t, m.Affects(&Event{Entity: FixtureEntity("entity1")}))
+}
+
+func TestMaintenanceWindowHistory(t *testing.T) {
+	m := FixtureMaintenanceWindow("foo")
+	m.Schedule.Days.Saturday = []*TimeWindowTimeRange{{Begin: "10:00 PM", End: "12:00 AM"}}
+	m.Schedule.Days.Sunday = []*TimeWindowTimeRange{{Begin: "11:00 PM", End: "1:00 AM"}}
+
+	// Saturday 12:00 PM to the next Saturday 12:00 PM
+	from := time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	history := m.History(from, to)
+
+	saturday := time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)
+	sunday := saturday.AddDate(0, 0, 1)
+	assert.Equal(t, []MaintenanceWindowOccurrence{
+		// Saturday 10:00 PM to Sunday 1:00 AM
+		{Begin: saturday.Add(22 * time.Hour).Unix(), End: sunday.Add(time.Hour).Unix()},
+		// Sunday 11:00 PM to midnight
+		{Begin: sunday.Add(23 * time.Hour).Unix(), End: sunday.Add(24 * time.Hour).Unix()},
+	}, history)
+
+	// The history is clipped to the bounds of the maintenance window
+	m.End = sunday.Add(30 * time.Minute).Unix()
+	history = m.History(from, to)
+	assert.Equal(t, []MaintenanceWindowOccurrence{
+		{Begin: saturday.Add(22 * time.Hour).Unix(), End: m.End},
+	}, history)
+
+	m.Schedule = nil
+	m.Begin = saturday.Unix()
+	history = m.History(from, to)
+	assert.Equal(t, []MaintenanceWindowOccurrence{{Begin: from.Unix(), End: m.End}}, history)
+}
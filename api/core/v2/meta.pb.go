@@ -45,7 +45,12 @@ type ObjectMeta struct {
 	// More info: http://kubernetes.io/docs/user-guide/annotations
 	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" yaml: "annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// CreatedBy indicates which user created the resource.
-	CreatedBy            string   `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty" yaml: "created_by,omitempty"`
+	CreatedBy string `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty" yaml: "created_by,omitempty"`
+	// ResourceVersion identifies the version of the resource in the store. It
+	// changes every time the resource is modified, and is set by the store when
+	// the resource is read. It can be given in the If-Match header of a request
+	// to only modify the resource if it was not modified since it was read.
+	ResourceVersion      string   `protobuf:"bytes,6,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty" yaml:"resource_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -119,6 +124,13 @@ func (m *ObjectMeta) GetCreatedBy() string {
 	return ""
 }
 
+func (m *ObjectMeta) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
 // TypeMeta is information that can be used to resolve a data type
 type TypeMeta struct {
 	// Type is the type name of the data type
@@ -187,37 +199,39 @@ func init() {
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x8a, 0xd4, 0x40,
-	0x10, 0xb5, 0x67, 0xb2, 0x8b, 0x53, 0x83, 0x32, 0xb4, 0xab, 0xc4, 0x51, 0x93, 0xa1, 0x41, 0x58,
-	0x64, 0xe8, 0x75, 0x66, 0x65, 0x59, 0xe7, 0x20, 0xbb, 0x03, 0x1e, 0x04, 0xc5, 0x25, 0x2c, 0x0a,
-	0x5e, 0x96, 0x4e, 0x6c, 0xc7, 0xe8, 0x24, 0x1d, 0x92, 0x4e, 0x20, 0x7f, 0xe0, 0x07, 0x78, 0xf0,
-	0x13, 0xfc, 0x04, 0x3f, 0xc1, 0xa3, 0x5f, 0x10, 0x34, 0xde, 0x72, 0x14, 0x04, 0x8f, 0x92, 0x4e,
-	0x30, 0xc9, 0xb0, 0x1e, 0xf6, 0x94, 0xae, 0x57, 0x55, 0xef, 0x75, 0x55, 0xbf, 0x00, 0x78, 0x5c,
-	0x32, 0x1a, 0x84, 0x42, 0x0a, 0x7c, 0x25, 0xe2, 0x7e, 0x14, 0x53, 0x47, 0x84, 0x9c, 0x26, 0xf3,
-	0xf1, 0x83, 0x95, 0x2b, 0xdf, 0xc6, 0x36, 0x75, 0x84, 0xb7, 0xb7, 0x12, 0x2b, 0xb1, 0xa7, 0xaa,
-	0xec, 0xf8, 0xcd, 0x51, 0x32, 0xa3, 0xfb, 0x74, 0xa6, 0x40, 0x85, 0xa9, 0x53, 0x45, 0x42, 0x7e,
-	0x6b, 0x00, 0xcf, 0xed, 0x77, 0xdc, 0x91, 0xcf, 0xb8, 0x64, 0xf8, 0x08, 0x34, 0x9f, 0x79, 0x5c,
-	0x47, 0x13, 0xb4, 0x3b, 0x58, 0x4e, 0x8b, 0xcc, 0xbc, 0x5a, 0xc6, 0x53, 0xe1, 0xb9, 0x92, 0x7b,
-	0x81, 0x4c, 0x7f, 0x65, 0xe6, 0x8d, 0x94, 0x79, 0xeb, 0xc5, 0x84, 0x74, 0x13, 0xc4, 0x52, 0x9d,
-	0xf8, 0x14, 0x06, 0xe5, 0x37, 0x0a, 0x98, 0xc3, 0xf5, 0x9e, 0xa2, 0x39, 0x28, 0x32, 0xf3, 0xda,
-	0x3f, 0xb0, 0xc3, 0x75, 0xab, 0xc5, 0xb5, 0x91, 0x25, 0x56, 0x43, 0x84, 0x03, 0xd8, 0x5e, 0x33,
-	0x9b, 0xaf, 0x23, 0xbd, 0x3f, 0xe9, 0xef, 0x0e, 0xe7, 0x77, 0x69, 0x67, 0x78, 0xda, 0x8c, 0x40,
-	0x9f, 0xaa, 0xba, 0xc7, 0xbe, 0x0c, 0xd3, 0xe5, 0xac, 0xc8, 0xcc, 0x51, 0xd5, 0xd8, 0x91, 0xbd,
-	0x59, 0xcb, 0x4e, 0x37, 0x73, 0xc4, 0xaa, 0x75, 0xf0, 0x07, 0x04, 0x43, 0xe6, 0xfb, 0x42, 0x32,
-	0xe9, 0x0a, 0x3f, 0xd2, 0x35, 0xa5, 0x7b, 0xef, 0xff, 0xba, 0xc7, 0x4d, 0x71, 0x25, 0xbe, 0x28,
-	0x32, 0xf3, 0x7a, 0x8b, 0xa2, 0x73, 0x83, 0x3b, 0xf5, 0x0d, 0xce, 0xcd, 0x13, 0xab, 0x2d, 0x8d,
-	0x5f, 0x02, 0x38, 0x21, 0x67, 0x92, 0xbf, 0x3e, 0xb3, 0x53, 0x7d, 0x4b, 0xed, 0xf4, 0xb0, 0xc8,
-	0xcc, 0x9d, 0x06, 0xed, 0x70, 0xdf, 0xae, 0xb9, 0xcf, 0x4b, 0x13, 0x6b, 0x50, 0xc3, 0xcb, 0x74,
-	0xfc, 0x10, 0x86, 0xad, 0x6d, 0xe1, 0x11, 0xf4, 0xdf, 0xf3, 0xb4, 0x7a, 0x7b, 0xab, 0x3c, 0xe2,
-	0x1d, 0xd8, 0x4a, 0xd8, 0x3a, 0xae, 0x1f, 0xd2, 0xaa, 0x82, 0x45, 0xef, 0x10, 0x8d, 0x1f, 0xc1,
-	0x68, 0x73, 0xe0, 0x8b, 0xf4, 0x93, 0x8f, 0x08, 0x2e, 0x9f, 0xa6, 0x01, 0x57, 0xae, 0x3b, 0x00,
-	0xad, 0x3c, 0xd7, 0xae, 0x23, 0x45, 0x66, 0x6a, 0x32, 0x0d, 0x78, 0xcb, 0x6b, 0x65, 0xd8, 0xf1,
-	0x5a, 0x59, 0x8f, 0x4f, 0x00, 0x8e, 0x4f, 0x9e, 0xbc, 0xe0, 0x61, 0xe4, 0x0a, 0xbf, 0x36, 0xdb,
-	0xfd, 0x22, 0x33, 0x87, 0x2c, 0x70, 0xcf, 0x92, 0x0a, 0x6e, 0xef, 0xba, 0x41, 0xdb, 0x5c, 0x2d,
-	0x8e, 0xe5, 0xe4, 0xcf, 0x0f, 0x03, 0x7d, 0xce, 0x0d, 0xf4, 0x25, 0x37, 0xd0, 0xd7, 0xdc, 0x40,
-	0xdf, 0x72, 0x03, 0x7d, 0xcf, 0x0d, 0xf4, 0xe9, 0xa7, 0x71, 0xe9, 0x55, 0x2f, 0x99, 0xdb, 0xdb,
-	0xea, 0xbf, 0xd9, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x17, 0xf8, 0x46, 0xdc, 0x8a, 0x03, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x8a, 0xd4, 0x40,
+	0x18, 0xb5, 0xfa, 0x0f, 0xfb, 0x6b, 0xd4, 0xa6, 0x1c, 0x25, 0xb6, 0x9a, 0xb4, 0x05, 0xc2, 0x20,
+	0x4d, 0xc6, 0xee, 0x91, 0x61, 0xec, 0x85, 0xcc, 0xb4, 0xb8, 0x10, 0x14, 0x87, 0x30, 0x28, 0xb8,
+	0x19, 0x2a, 0xb1, 0x6c, 0xa3, 0x9d, 0x54, 0x48, 0x2a, 0x81, 0xdc, 0xc0, 0x03, 0xb8, 0xf0, 0x08,
+	0x1e, 0xc1, 0x23, 0xb8, 0xf4, 0x04, 0x41, 0x23, 0x6e, 0xb2, 0x74, 0xe5, 0x52, 0x52, 0xc9, 0x98,
+	0xa4, 0x99, 0x5e, 0xcc, 0x2a, 0x55, 0xef, 0x7d, 0xf5, 0x5e, 0x7d, 0x5f, 0xbd, 0x00, 0x38, 0x4c,
+	0x50, 0xdd, 0xf3, 0xb9, 0xe0, 0xf8, 0x52, 0xc0, 0xdc, 0x20, 0xd4, 0x2d, 0xee, 0x33, 0x3d, 0x9a,
+	0x8d, 0x1e, 0x2c, 0x6d, 0xf1, 0x2e, 0x34, 0x75, 0x8b, 0x3b, 0x3b, 0x4b, 0xbe, 0xe4, 0x3b, 0xb2,
+	0xca, 0x0c, 0xdf, 0x1e, 0x44, 0x53, 0x7d, 0x57, 0x9f, 0x4a, 0x50, 0x62, 0x72, 0x55, 0x88, 0x90,
+	0xdf, 0x5d, 0x80, 0x17, 0xe6, 0x7b, 0x66, 0x89, 0xe7, 0x4c, 0x50, 0x7c, 0x00, 0x1d, 0x97, 0x3a,
+	0x4c, 0x41, 0x63, 0xb4, 0xdd, 0x5f, 0x4c, 0xb2, 0x44, 0xbb, 0x9c, 0xef, 0x27, 0xdc, 0xb1, 0x05,
+	0x73, 0x3c, 0x11, 0xff, 0x49, 0xb4, 0xeb, 0x31, 0x75, 0x56, 0xf3, 0x31, 0x69, 0x12, 0xc4, 0x90,
+	0x27, 0xf1, 0x31, 0xf4, 0xf3, 0x6f, 0xe0, 0x51, 0x8b, 0x29, 0x2d, 0x29, 0xb3, 0x97, 0x25, 0xda,
+	0xd5, 0xff, 0x60, 0x43, 0xeb, 0x66, 0x4d, 0x6b, 0x8d, 0x25, 0x46, 0x25, 0x84, 0x3d, 0xe8, 0xad,
+	0xa8, 0xc9, 0x56, 0x81, 0xd2, 0x1e, 0xb7, 0xb7, 0x07, 0xb3, 0xbb, 0x7a, 0xa3, 0x79, 0xbd, 0x6a,
+	0x41, 0x7f, 0x26, 0xeb, 0x9e, 0xb8, 0xc2, 0x8f, 0x17, 0xd3, 0x2c, 0xd1, 0x86, 0xc5, 0xc1, 0x86,
+	0xed, 0x8d, 0xd2, 0x76, 0xb2, 0xce, 0x11, 0xa3, 0xf4, 0xc1, 0x1f, 0x11, 0x0c, 0xa8, 0xeb, 0x72,
+	0x41, 0x85, 0xcd, 0xdd, 0x40, 0xe9, 0x48, 0xdf, 0x7b, 0x9b, 0x7d, 0x0f, 0xab, 0xe2, 0xc2, 0x7c,
+	0x9e, 0x25, 0xda, 0xb5, 0x9a, 0x44, 0xe3, 0x06, 0xb7, 0xcb, 0x1b, 0x9c, 0xc9, 0x13, 0xa3, 0x6e,
+	0x8d, 0x5f, 0x01, 0x58, 0x3e, 0xa3, 0x82, 0xbd, 0x39, 0x31, 0x63, 0xa5, 0x2b, 0x67, 0xba, 0x9f,
+	0x25, 0xda, 0x56, 0x85, 0x36, 0xb4, 0x6f, 0x95, 0xda, 0x67, 0xd1, 0xc4, 0xe8, 0x97, 0xf0, 0x22,
+	0xc6, 0x2e, 0x0c, 0x7d, 0x16, 0xf0, 0xd0, 0xb7, 0xd8, 0x49, 0xc4, 0xfc, 0xc0, 0xe6, 0xae, 0xd2,
+	0x93, 0xf2, 0x8f, 0xb3, 0x44, 0x1b, 0xad, 0x73, 0x0d, 0x93, 0x3b, 0xd2, 0x84, 0x6c, 0xae, 0x21,
+	0xc6, 0x95, 0x53, 0xf2, 0x65, 0xc1, 0x8d, 0x1e, 0xc2, 0xa0, 0xf6, 0x3a, 0x78, 0x08, 0xed, 0x0f,
+	0x2c, 0x2e, 0xb2, 0x66, 0xe4, 0x4b, 0xbc, 0x05, 0xdd, 0x88, 0xae, 0xc2, 0x32, 0x38, 0x46, 0xb1,
+	0x99, 0xb7, 0xf6, 0xd1, 0xe8, 0x11, 0x0c, 0xd7, 0x07, 0x7c, 0x9e, 0xf3, 0xe4, 0x13, 0x82, 0x8b,
+	0xc7, 0xb1, 0xc7, 0x64, 0xca, 0xf7, 0xa0, 0x93, 0xaf, 0xcb, 0x94, 0x93, 0x2c, 0xd1, 0x3a, 0x22,
+	0xf6, 0x58, 0x2d, 0xdb, 0xf9, 0xb6, 0x91, 0xed, 0xbc, 0x1e, 0x1f, 0x01, 0x1c, 0x1e, 0x3d, 0x2d,
+	0xbb, 0x29, 0xc3, 0x7d, 0x3f, 0x4b, 0xb4, 0x01, 0xf5, 0xec, 0xd3, 0x01, 0xd4, 0xdf, 0xb6, 0x42,
+	0xeb, 0x5a, 0x35, 0x8d, 0xc5, 0xf8, 0xef, 0x4f, 0x15, 0x7d, 0x49, 0x55, 0xf4, 0x35, 0x55, 0xd1,
+	0xb7, 0x54, 0x45, 0xdf, 0x53, 0x15, 0xfd, 0x48, 0x55, 0xf4, 0xf9, 0x97, 0x7a, 0xe1, 0x75, 0x2b,
+	0x9a, 0x99, 0x3d, 0xf9, 0x9f, 0xee, 0xfe, 0x1b, 0x00, 0x29, 0xae, 0x2b, 0x2a, 0xfa, 0x03, 0x00,
 	0x00,
 }
 
@@ -265,6 +279,9 @@ func (this *ObjectMeta) Equal(that interface{}) bool {
 	if this.CreatedBy != that1.CreatedBy {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -324,6 +341,13 @@ func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ResourceVersion) > 0 {
+		i -= len(m.ResourceVersion)
+		copy(dAtA[i:], m.ResourceVersion)
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.CreatedBy) > 0 {
 		i -= len(m.CreatedBy)
 		copy(dAtA[i:], m.CreatedBy)
@@ -457,8 +481,9 @@ func NewPopulatedObjectMeta(r randyMeta, easy bool) *ObjectMeta {
 		}
 	}
 	this.CreatedBy = string(randStringMeta(r))
+	this.ResourceVersion = string(randStringMeta(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMeta(r, 6)
+		this.XXX_unrecognized = randUnrecognizedMeta(r, 7)
 	}
 	return this
 }
@@ -579,6 +604,10 @@ func (m *ObjectMeta) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	l = len(m.ResourceVersion)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -990,6 +1019,38 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			}
 			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
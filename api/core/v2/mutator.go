@@ -7,11 +7,21 @@ import (
 	"path"
 	"sort"
 	"strings"
+
+	"github.com/itchyny/gojq"
 )
 
 const (
 	// MutatorsResource is the name of this resource type
 	MutatorsResource = "mutators"
+
+	// MutatorTypePipe is the type of the mutators executing a command or a
+	// WebAssembly module
+	MutatorTypePipe = "pipe"
+
+	// MutatorTypeJQ is the type of the mutators applying a jq expression to
+	// the events
+	MutatorTypeJQ = "jq"
 )
 
 // StorePrefix returns the path prefix to this resource in the store
@@ -32,14 +42,66 @@ func (m *Mutator) Validate() error {
 	if err := ValidateName(m.Name); err != nil {
 		return errors.New("mutator name " + err.Error())
 	}
-	if m.Command == "" {
-		return errors.New("mutator command must be set")
+
+	switch m.Type {
+	case "", MutatorTypePipe:
+		if err := m.validatePipe(); err != nil {
+			return err
+		}
+	case MutatorTypeJQ:
+		if err := m.validateJQ(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("mutator type %q is not valid", m.Type)
 	}
 
 	if m.Namespace == "" {
 		return errors.New("namespace must be set")
 	}
 
+	if err := ValidateSecrets(m.Secrets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mutator) validatePipe() error {
+	if m.Eval != "" {
+		return errors.New("mutator eval can only be set for jq mutators")
+	}
+	if m.WASMModule != "" {
+		if m.Command != "" {
+			return errors.New("mutator command and wasm module cannot both be set")
+		}
+		return validateWASMModule(m.WASMModule)
+	}
+	if m.Command == "" {
+		return errors.New("mutator command must be set")
+	}
+	return nil
+}
+
+func (m *Mutator) validateJQ() error {
+	if m.Command != "" || m.WASMModule != "" {
+		return errors.New("jq mutators cannot have a command or a wasm module")
+	}
+	if m.Eval == "" {
+		return errors.New("mutator eval must be set for jq mutators")
+	}
+	if _, err := gojq.Parse(m.Eval); err != nil {
+		return fmt.Errorf("mutator eval is not a valid jq expression: %s", err)
+	}
+	return nil
+}
+
+// validateWASMModule returns an error if module is not the name of a file in
+// the lib directory of an asset.
+func validateWASMModule(module string) error {
+	if path.IsAbs(module) || path.Clean(module) != module || strings.HasPrefix(module, "..") {
+		return fmt.Errorf("wasm module %q must be a path relative to the lib directory of an asset", module)
+	}
 	return nil
 }
 
@@ -56,6 +118,12 @@ func (m *Mutator) Update(from *Mutator, fields ...string) error {
 			m.EnvVars = append(m.EnvVars[0:0], from.EnvVars...)
 		case "RuntimeAssets":
 			m.RuntimeAssets = append(m.RuntimeAssets[0:0], from.RuntimeAssets...)
+		case "WASMModule":
+			m.WASMModule = from.WASMModule
+		case "Type":
+			m.Type = from.Type
+		case "Eval":
+			m.Eval = from.Eval
 		default:
 			return fmt.Errorf("unsupported field: %q", f)
 		}
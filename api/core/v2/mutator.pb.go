@@ -39,10 +39,19 @@ type Mutator struct {
 	RuntimeAssets []string `protobuf:"bytes,8,rep,name=runtime_assets,json=runtimeAssets,proto3" json:"runtime_assets"`
 	// Secrets is the list of Sensu secrets to set for the mutators's
 	// execution environment.
-	Secrets              []*Secret `protobuf:"bytes,9,rep,name=secrets,proto3" json:"secrets"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Secrets []*Secret `protobuf:"bytes,9,rep,name=secrets,proto3" json:"secrets"`
+	// WASMModule is the name of a WebAssembly module in the lib directory of
+	// the runtime assets. When set, the module mutates the events instead of
+	// the command.
+	WASMModule string `protobuf:"bytes,10,opt,name=wasm_module,json=wasmModule,proto3" json:"wasm_module,omitempty"`
+	// Type is the type of the mutator, either "pipe" or "jq". Pipe mutators
+	// execute a command or a WebAssembly module, and are the default.
+	Type string `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty"`
+	// Eval is the jq expression applied to the events by jq mutators.
+	Eval                 string   `protobuf:"bytes,12,opt,name=eval,proto3" json:"eval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Mutator) Reset()         { *m = Mutator{} }
@@ -85,30 +94,35 @@ func init() {
 func init() { proto.RegisterFile("mutator.proto", fileDescriptor_a2bb83fa74d938fa) }
 
 var fileDescriptor_a2bb83fa74d938fa = []byte{
-	// 367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x8a, 0xdb, 0x30,
-	0x14, 0x86, 0xa3, 0xa4, 0xd4, 0x8e, 0x12, 0x77, 0x21, 0x28, 0xb8, 0x59, 0x48, 0xa6, 0x50, 0xea,
-	0x45, 0x51, 0x88, 0xd3, 0x4d, 0x4b, 0x17, 0xad, 0xf7, 0xa1, 0xe0, 0xd2, 0x2e, 0xba, 0x09, 0xb2,
-	0xa3, 0xc9, 0x64, 0x40, 0x56, 0xb0, 0x64, 0xc3, 0xdc, 0x60, 0x8e, 0x30, 0xcb, 0x2c, 0x73, 0x84,
-	0x39, 0x42, 0x36, 0x03, 0x39, 0x81, 0x99, 0xf1, 0xec, 0x7c, 0x82, 0x59, 0x0e, 0x91, 0xe3, 0xc0,
-	0x64, 0xf7, 0xff, 0xff, 0xfb, 0xde, 0x7b, 0xd2, 0x83, 0x8e, 0xc8, 0x35, 0xd3, 0x32, 0xa3, 0xeb,
-	0x4c, 0x6a, 0x89, 0x1c, 0xc5, 0x53, 0x95, 0xd3, 0x44, 0x66, 0x9c, 0x16, 0xc1, 0xe8, 0xeb, 0x72,
-	0xa5, 0x2f, 0xf3, 0x98, 0x26, 0x52, 0x8c, 0x97, 0x72, 0x29, 0xc7, 0x86, 0x8a, 0xf3, 0x8b, 0x9f,
-	0xc5, 0x84, 0x4e, 0xe9, 0xc4, 0x84, 0x26, 0x33, 0xaa, 0x19, 0x32, 0x82, 0x82, 0x6b, 0x76, 0xd4,
-	0x43, 0xc5, 0x93, 0x8c, 0xeb, 0xc6, 0x7d, 0xbc, 0xef, 0x42, 0x6b, 0xd6, 0x2c, 0x44, 0x7f, 0xa1,
-	0x7d, 0xe0, 0x16, 0x4c, 0x33, 0x17, 0x78, 0xc0, 0x1f, 0x04, 0x1f, 0xe8, 0xab, 0xed, 0xf4, 0x77,
-	0x7c, 0xc5, 0x13, 0x3d, 0xe3, 0x9a, 0x85, 0x78, 0x57, 0x92, 0xce, 0xbe, 0x24, 0xa0, 0x2e, 0x09,
-	0x6a, 0xdb, 0xbe, 0x48, 0xb1, 0xd2, 0x5c, 0xac, 0xf5, 0x75, 0x74, 0x1a, 0x85, 0x5c, 0x68, 0x25,
-	0x52, 0x08, 0x96, 0x2e, 0xdc, 0xae, 0x07, 0xfc, 0x7e, 0xd4, 0x5a, 0xf4, 0x09, 0x5a, 0x7a, 0x25,
-	0xb8, 0xcc, 0xb5, 0xdb, 0xf3, 0x80, 0xef, 0x84, 0x83, 0xba, 0x24, 0x6d, 0x14, 0xb5, 0x02, 0x7d,
-	0x86, 0x36, 0x4f, 0x8b, 0x79, 0xc1, 0x32, 0xe5, 0xbe, 0xf1, 0x7a, 0x7e, 0x3f, 0x1c, 0xd6, 0x25,
-	0x39, 0x65, 0x91, 0xc5, 0xd3, 0xe2, 0x1f, 0xcb, 0x14, 0xfa, 0x06, 0xdf, 0x65, 0x79, 0x7a, 0x68,
-	0x9b, 0x33, 0xa5, 0xb8, 0x56, 0xae, 0x6d, 0x70, 0x54, 0x97, 0xe4, 0xac, 0x12, 0x39, 0x47, 0xff,
-	0xcb, 0x58, 0xf4, 0x03, 0x5a, 0xcd, 0x5d, 0x94, 0xdb, 0xf7, 0x7a, 0xfe, 0x20, 0x78, 0x7f, 0xf6,
-	0xf5, 0x3f, 0xa6, 0xda, 0xbc, 0xf0, 0x48, 0x46, 0xad, 0xf8, 0x6e, 0xdf, 0x6c, 0x48, 0x67, 0xbb,
-	0x21, 0x20, 0xf4, 0x9e, 0x1f, 0x31, 0xd8, 0x56, 0x18, 0xdc, 0x55, 0x18, 0xec, 0x2a, 0x0c, 0xf6,
-	0x15, 0x06, 0x0f, 0x15, 0x06, 0xb7, 0x4f, 0xb8, 0xf3, 0xbf, 0x5b, 0x04, 0xf1, 0x5b, 0x73, 0xf8,
-	0xe9, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x08, 0x64, 0x78, 0xe8, 0x01, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x75, 0x22, 0xad, 0xd3, 0x72, 0xb0, 0x34, 0xc9, 0xec, 0x60, 0x47, 0x48, 0xb0,
+	0x1c, 0x90, 0xa7, 0x75, 0x5c, 0x40, 0x1c, 0x58, 0x4e, 0x5c, 0x2a, 0xa4, 0x4c, 0x80, 0xc4, 0xa5,
+	0x72, 0x53, 0x53, 0x8a, 0xe6, 0xb8, 0x8a, 0x9d, 0xa0, 0xbd, 0x01, 0x8f, 0xc0, 0x71, 0xc7, 0x3d,
+	0x02, 0x8f, 0xb0, 0xe3, 0x9e, 0xc0, 0x82, 0x70, 0x40, 0xca, 0x13, 0x70, 0x44, 0x76, 0x9a, 0xd1,
+	0xf5, 0xf6, 0xff, 0xff, 0xbf, 0x9f, 0x3f, 0xfb, 0xfb, 0x64, 0x38, 0x96, 0xa5, 0xe1, 0x46, 0x15,
+	0x6c, 0x5d, 0x28, 0xa3, 0xd0, 0x58, 0x8b, 0x5c, 0x97, 0x2c, 0x53, 0x85, 0x60, 0xd5, 0xe4, 0xf0,
+	0xf9, 0x72, 0x65, 0x3e, 0x97, 0x73, 0x96, 0x29, 0x79, 0xbc, 0x54, 0x4b, 0x75, 0xec, 0xa9, 0x79,
+	0xf9, 0xe9, 0x75, 0x75, 0xc2, 0x4e, 0xd9, 0x89, 0x0f, 0x7d, 0xe6, 0x55, 0xdb, 0xe4, 0x10, 0x4a,
+	0x61, 0xf8, 0x46, 0x8f, 0xb4, 0xc8, 0x0a, 0x61, 0x5a, 0xf7, 0xf8, 0x4f, 0x1f, 0x06, 0xd3, 0xf6,
+	0x42, 0xf4, 0x0e, 0x0e, 0x1c, 0xb7, 0xe0, 0x86, 0x63, 0x10, 0x81, 0x38, 0x9c, 0x3c, 0x62, 0xf7,
+	0x6e, 0x67, 0x6f, 0xe7, 0x5f, 0x44, 0x66, 0xa6, 0xc2, 0xf0, 0x84, 0xdc, 0x58, 0xda, 0xbb, 0xb5,
+	0x14, 0x34, 0x96, 0xa2, 0xee, 0xd8, 0x33, 0x25, 0x57, 0x46, 0xc8, 0xb5, 0xb9, 0x4c, 0xef, 0x5a,
+	0x21, 0x0c, 0x83, 0x4c, 0x49, 0xc9, 0xf3, 0x05, 0xde, 0x8b, 0x40, 0x3c, 0x4c, 0x3b, 0x8b, 0x9e,
+	0xc0, 0xc0, 0xac, 0xa4, 0x50, 0xa5, 0xc1, 0xfd, 0x08, 0xc4, 0xe3, 0x24, 0x6c, 0x2c, 0xed, 0xa2,
+	0xb4, 0x13, 0xe8, 0x08, 0x0e, 0x44, 0x5e, 0xcd, 0x2a, 0x5e, 0x68, 0xbc, 0x1f, 0xf5, 0xe3, 0x61,
+	0x32, 0x6a, 0x2c, 0xbd, 0xcb, 0xd2, 0x40, 0xe4, 0xd5, 0x7b, 0x5e, 0x68, 0xf4, 0x02, 0x3e, 0x2c,
+	0xca, 0xdc, 0x1d, 0x9b, 0x71, 0xad, 0x85, 0xd1, 0x78, 0xe0, 0x71, 0xd4, 0x58, 0xba, 0x53, 0x49,
+	0xc7, 0x1b, 0x7f, 0xe6, 0x2d, 0x7a, 0x05, 0x83, 0x76, 0x2f, 0x1a, 0x0f, 0xa3, 0x7e, 0x1c, 0x4e,
+	0x0e, 0x76, 0x46, 0x3f, 0xf7, 0xd5, 0xf6, 0x85, 0x1b, 0x32, 0xed, 0x04, 0x7a, 0x03, 0xc3, 0xaf,
+	0x5c, 0xcb, 0x99, 0x54, 0x8b, 0xf2, 0x42, 0x60, 0xe8, 0xc6, 0x4c, 0x8e, 0x6a, 0x4b, 0xe1, 0x87,
+	0xb3, 0xf3, 0xe9, 0xd4, 0xa7, 0x8d, 0xa5, 0x07, 0x5b, 0xd0, 0xd6, 0xaa, 0xa0, 0x8b, 0x5b, 0x08,
+	0x3d, 0x85, 0xfb, 0xe6, 0x72, 0x2d, 0x70, 0xe8, 0x5b, 0xf8, 0x87, 0x3b, 0xbf, 0x45, 0xfb, 0xba,
+	0xe3, 0x44, 0xc5, 0x2f, 0xf0, 0xe8, 0x3f, 0xe7, 0xfc, 0x36, 0xe7, 0xfc, 0xcb, 0xc1, 0xb7, 0x2b,
+	0xda, 0xbb, 0xbe, 0xa2, 0x20, 0x89, 0xfe, 0xfe, 0x22, 0xe0, 0xba, 0x26, 0xe0, 0x47, 0x4d, 0xc0,
+	0x4d, 0x4d, 0xc0, 0x6d, 0x4d, 0xc0, 0xcf, 0x9a, 0x80, 0xef, 0xbf, 0x49, 0xef, 0xe3, 0x5e, 0x35,
+	0x99, 0x3f, 0xf0, 0x5f, 0xe2, 0xf4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x1d, 0xcd, 0x5d,
+	0x82, 0x02, 0x00, 0x00,
 }
 
 func (this *Mutator) Equal(that interface{}) bool {
@@ -163,6 +177,15 @@ func (this *Mutator) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.WASMModule != that1.WASMModule {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Eval != that1.Eval {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -177,6 +200,9 @@ type MutatorFace interface {
 	GetEnvVars() []string
 	GetRuntimeAssets() []string
 	GetSecrets() []*Secret
+	GetWASMModule() string
+	GetType() string
+	GetEval() string
 }
 
 func (this *Mutator) Proto() github_com_golang_protobuf_proto.Message {
@@ -211,6 +237,18 @@ func (this *Mutator) GetSecrets() []*Secret {
 	return this.Secrets
 }
 
+func (this *Mutator) GetWASMModule() string {
+	return this.WASMModule
+}
+
+func (this *Mutator) GetType() string {
+	return this.Type
+}
+
+func (this *Mutator) GetEval() string {
+	return this.Eval
+}
+
 func NewMutatorFromFace(that MutatorFace) *Mutator {
 	this := &Mutator{}
 	this.ObjectMeta = that.GetObjectMeta()
@@ -219,6 +257,9 @@ func NewMutatorFromFace(that MutatorFace) *Mutator {
 	this.EnvVars = that.GetEnvVars()
 	this.RuntimeAssets = that.GetRuntimeAssets()
 	this.Secrets = that.GetSecrets()
+	this.WASMModule = that.GetWASMModule()
+	this.Type = that.GetType()
+	this.Eval = that.GetEval()
 	return this
 }
 
@@ -246,6 +287,27 @@ func (m *Mutator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Eval) > 0 {
+		i -= len(m.Eval)
+		copy(dAtA[i:], m.Eval)
+		i = encodeVarintMutator(dAtA, i, uint64(len(m.Eval)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMutator(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.WASMModule) > 0 {
+		i -= len(m.WASMModule)
+		copy(dAtA[i:], m.WASMModule)
+		i = encodeVarintMutator(dAtA, i, uint64(len(m.WASMModule)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Secrets) > 0 {
 		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -337,8 +399,11 @@ func NewPopulatedMutator(r randyMutator, easy bool) *Mutator {
 			this.Secrets[i] = NewPopulatedSecret(r, easy)
 		}
 	}
+	this.WASMModule = string(randStringMutator(r))
+	this.Type = string(randStringMutator(r))
+	this.Eval = string(randStringMutator(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMutator(r, 10)
+		this.XXX_unrecognized = randUnrecognizedMutator(r, 13)
 	}
 	return this
 }
@@ -448,6 +513,18 @@ func (m *Mutator) Size() (n int) {
 			n += 1 + l + sovMutator(uint64(l))
 		}
 	}
+	l = len(m.WASMModule)
+	if l > 0 {
+		n += 1 + l + sovMutator(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMutator(uint64(l))
+	}
+	l = len(m.Eval)
+	if l > 0 {
+		n += 1 + l + sovMutator(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -671,6 +748,102 @@ func (m *Mutator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMutator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMutator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMutator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMutator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMutator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMutator(dAtA[iNdEx:])
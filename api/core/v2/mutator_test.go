@@ -30,6 +30,57 @@ func TestMutatorValidate(t *testing.T) {
 
 	// Valid mutator
 	assert.NoError(t, m.Validate())
+
+	// Command and wasm module are exclusive
+	m.WASMModule = "mutator.wasm"
+	assert.Error(t, m.Validate())
+	m.Command = ""
+
+	// Valid wasm mutator
+	assert.NoError(t, m.Validate())
+}
+
+func TestMutatorValidateWASMModule(t *testing.T) {
+	m := FixtureMutator("foo")
+	m.Command = ""
+	for _, module := range []string{"/lib/mutator.wasm", "../mutator.wasm", "mutators/../mutator.wasm"} {
+		m.WASMModule = module
+		assert.Error(t, m.Validate(), module)
+	}
+	m.WASMModule = "mutators/mutator.wasm"
+	assert.NoError(t, m.Validate())
+}
+
+func TestMutatorValidateJQ(t *testing.T) {
+	m := FixtureMutator("foo")
+	m.Type = MutatorTypeJQ
+
+	// Command cannot be set
+	m.Eval = ".entity"
+	assert.Error(t, m.Validate())
+	m.Command = ""
+
+	// Valid jq mutator
+	assert.NoError(t, m.Validate())
+
+	// Invalid eval
+	m.Eval = ".entity |"
+	assert.Error(t, m.Validate())
+
+	// Missing eval
+	m.Eval = ""
+	assert.Error(t, m.Validate())
+
+	// Eval requires the jq type
+	m.Type = MutatorTypePipe
+	m.Command = "cat"
+	m.Eval = ".entity"
+	assert.Error(t, m.Validate())
+
+	// Invalid type
+	m.Type = "foo"
+	m.Eval = ""
+	assert.Error(t, m.Validate())
 }
 
 func TestSortMutatorsByName(t *testing.T) {
@@ -0,0 +1,137 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+const (
+	// NamespaceTemplatesResource is the name of this resource type
+	NamespaceTemplatesResource = "namespace-templates"
+)
+
+// NamespaceTemplateResources are the types of the resources a namespace
+// template can copy, as named in the RBAC rules.
+var NamespaceTemplateResources = []string{
+	"assets",
+	"checks",
+	"filters",
+	"handlers",
+	"hooks",
+	"mutators",
+	"rolebindings",
+	"roles",
+}
+
+// defaultNamespaceTemplateResources are the types of the resources copied by
+// the namespace templates without resources.
+var defaultNamespaceTemplateResources = []string{
+	"roles",
+	"rolebindings",
+	"handlers",
+	"filters",
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (t *NamespaceTemplate) StorePrefix() string {
+	return NamespaceTemplatesResource
+}
+
+// URIPath returns the path component of a namespace template URI.
+func (t *NamespaceTemplate) URIPath() string {
+	return path.Join(URLPrefix, NamespaceTemplatesResource, url.PathEscape(t.Name))
+}
+
+// Validate returns an error if the namespace template does not pass
+// validation tests.
+func (t *NamespaceTemplate) Validate() error {
+	if err := ValidateName(t.Name); err != nil {
+		return errors.New("namespace template name " + err.Error())
+	}
+
+	if t.Source == "" {
+		return errors.New("namespace template source must be set")
+	}
+
+	for _, resource := range t.Resources {
+		if !utilstrings.InArray(resource, NamespaceTemplateResources) {
+			return fmt.Errorf("namespace templates can't copy the %q resources", resource)
+		}
+	}
+
+	for _, pattern := range t.Namespaces {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("namespace template pattern %q is not valid: %s", pattern, err)
+		}
+	}
+
+	if t.Namespace != "" {
+		return errors.New("namespace templates are not namespaced")
+	}
+
+	return nil
+}
+
+// Matches returns whether the template provisions the namespace of the given
+// name. The template never provisions its source namespace.
+func (t *NamespaceTemplate) Matches(namespace string) bool {
+	if namespace == t.Source {
+		return false
+	}
+	if len(t.Namespaces) == 0 {
+		return true
+	}
+	for _, pattern := range t.Namespaces {
+		if ok, _ := path.Match(pattern, namespace); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceTypes returns the types of the resources copied by the template.
+func (t *NamespaceTemplate) ResourceTypes() []string {
+	if len(t.Resources) == 0 {
+		return defaultNamespaceTemplateResources
+	}
+	return t.Resources
+}
+
+// NewNamespaceTemplate creates a new NamespaceTemplate.
+func NewNamespaceTemplate(meta ObjectMeta) *NamespaceTemplate {
+	return &NamespaceTemplate{ObjectMeta: meta}
+}
+
+// FixtureNamespaceTemplate returns a NamespaceTemplate fixture for testing.
+func FixtureNamespaceTemplate(name string) *NamespaceTemplate {
+	return &NamespaceTemplate{
+		ObjectMeta: NewObjectMeta(name, ""),
+		Source:     "template",
+	}
+}
+
+// NamespaceTemplateFields returns a set of fields that represent that resource
+func NamespaceTemplateFields(r Resource) map[string]string {
+	resource := r.(*NamespaceTemplate)
+	return map[string]string{
+		"namespace_template.name":   resource.ObjectMeta.Name,
+		"namespace_template.source": resource.Source,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (t *NamespaceTemplate) SetNamespace(namespace string) {
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (t *NamespaceTemplate) SetObjectMeta(meta ObjectMeta) {
+	t.ObjectMeta = meta
+}
+
+func (t *NamespaceTemplate) RBACName() string {
+	return NamespaceTemplatesResource
+}
@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: namespace_template.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A NamespaceTemplate provisions the new namespaces with copies of the
+// resources of a template namespace, maintained by the platform admins, e.g.
+// to give a standard set of role bindings, handlers and filters to the
+// namespace of each team.
+type NamespaceTemplate struct {
+	// Metadata contains the name, labels and annotations of the namespace
+	// template
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Source is the namespace holding the resources copied to the new
+	// namespaces.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	// Resources are the types of the resources copied, as named in the RBAC
+	// rules (e.g. "handlers"). Defaults to the roles, role bindings, handlers
+	// and filters.
+	Resources []string `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources"`
+	// Namespaces are the shell patterns of the names of the namespaces
+	// provisioned by the template (e.g. "team-*"), or all of the new namespaces
+	// if empty.
+	Namespaces           []string `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceTemplate) Reset()         { *m = NamespaceTemplate{} }
+func (m *NamespaceTemplate) String() string { return proto.CompactTextString(m) }
+func (*NamespaceTemplate) ProtoMessage()    {}
+func (*NamespaceTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_971aed49c73ac300, []int{0}
+}
+func (m *NamespaceTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplate.Merge(m, src)
+}
+func (m *NamespaceTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceTemplate proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*NamespaceTemplate)(nil), "sensu.core.v2.NamespaceTemplate")
+}
+
+func init() { proto.RegisterFile("namespace_template.proto", fileDescriptor_971aed49c73ac300) }
+
+var fileDescriptor_971aed49c73ac300 = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0x4b, 0xcc, 0x4d,
+	0x2d, 0x2e, 0x48, 0x4c, 0x4e, 0x8d, 0x2f, 0x49, 0xcd, 0x2d, 0xc8, 0x49, 0x2c, 0x49, 0xd5, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2d, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x4b, 0xce, 0x2f, 0x4a,
+	0xd5, 0x2b, 0x33, 0x92, 0x32, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
+	0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x4a, 0x2a, 0x4d, 0x73, 0x28, 0x33, 0xd4, 0x33, 0xd6,
+	0x33, 0x04, 0x0b, 0x82, 0xc5, 0xc0, 0x2c, 0x88, 0x21, 0x52, 0x5c, 0xb9, 0xa9, 0x25, 0x89, 0x10,
+	0xb6, 0xd2, 0x57, 0x46, 0x2e, 0x41, 0x3f, 0x98, 0x6d, 0x21, 0x50, 0xcb, 0x84, 0x42, 0xb9, 0x38,
+	0x40, 0x6a, 0x52, 0x12, 0x4b, 0x12, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x50,
+	0x6c, 0xd6, 0xf3, 0x4f, 0xca, 0x4a, 0x4d, 0x2e, 0xf1, 0x4d, 0x2d, 0x49, 0x74, 0x92, 0x3b, 0x71,
+	0x4f, 0x9e, 0xe1, 0xc2, 0x3d, 0x79, 0xc6, 0x57, 0xf7, 0xe4, 0x85, 0x60, 0xda, 0x74, 0xf2, 0x73,
+	0x33, 0x41, 0x6e, 0x2f, 0xa9, 0x0c, 0x82, 0x1b, 0x25, 0xa4, 0xc4, 0xc5, 0x56, 0x9c, 0x5f, 0x5a,
+	0x94, 0x9c, 0x2a, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0xe9, 0xc4, 0xf5, 0xea, 0x9e, 0x3c, 0x54, 0x24,
+	0x08, 0x4a, 0x0b, 0x69, 0x73, 0x71, 0x16, 0xa5, 0x42, 0xd8, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x1a,
+	0x9c, 0x4e, 0xbc, 0xaf, 0xee, 0xc9, 0x23, 0x04, 0x83, 0x10, 0x4c, 0x21, 0x3d, 0x2e, 0x2e, 0x78,
+	0x50, 0x15, 0x4b, 0xb0, 0x80, 0x55, 0xf3, 0xbd, 0xba, 0x27, 0x8f, 0x24, 0x1a, 0x84, 0xc4, 0xb6,
+	0xe2, 0xe8, 0x58, 0x20, 0xcf, 0xb0, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xc2, 0x8f, 0x87, 0x72, 0x8c,
+	0x2b, 0x1e, 0xc9, 0x31, 0xee, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
+	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0xce, 0x78, 0x2c, 0xc7, 0x10, 0xc5, 0x54, 0x66, 0x94, 0xc4, 0x06,
+	0x0e, 0x20, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0xc6, 0x4e, 0xf2, 0x8d, 0x01, 0x00,
+	0x00,
+}
+
+func (this *NamespaceTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamespaceTemplate)
+	if !ok {
+		that2, ok := that.(NamespaceTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if len(this.Resources) != len(that1.Resources) {
+		return false
+	}
+	for i := range this.Resources {
+		if this.Resources[i] != that1.Resources[i] {
+			return false
+		}
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type NamespaceTemplateFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetSource() string
+	GetResources() []string
+	GetNamespaces() []string
+}
+
+func (this *NamespaceTemplate) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *NamespaceTemplate) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewNamespaceTemplateFromFace(this)
+}
+
+func (this *NamespaceTemplate) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *NamespaceTemplate) GetSource() string {
+	return this.Source
+}
+
+func (this *NamespaceTemplate) GetResources() []string {
+	return this.Resources
+}
+
+func (this *NamespaceTemplate) GetNamespaces() []string {
+	return this.Namespaces
+}
+
+func NewNamespaceTemplateFromFace(that NamespaceTemplateFace) *NamespaceTemplate {
+	this := &NamespaceTemplate{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Source = that.GetSource()
+	this.Resources = that.GetResources()
+	this.Namespaces = that.GetNamespaces()
+	return this
+}
+
+func (m *NamespaceTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintNamespaceTemplate(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintNamespaceTemplate(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintNamespaceTemplate(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNamespaceTemplate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNamespaceTemplate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNamespaceTemplate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedNamespaceTemplate(r randyNamespaceTemplate, easy bool) *NamespaceTemplate {
+	this := &NamespaceTemplate{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Source = string(randStringNamespaceTemplate(r))
+	v2 := r.Intn(10)
+	this.Resources = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Resources[i] = string(randStringNamespaceTemplate(r))
+	}
+	v3 := r.Intn(10)
+	this.Namespaces = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Namespaces[i] = string(randStringNamespaceTemplate(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedNamespaceTemplate(r, 5)
+	}
+	return this
+}
+
+type randyNamespaceTemplate interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneNamespaceTemplate(r randyNamespaceTemplate) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringNamespaceTemplate(r randyNamespaceTemplate) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RuneNamespaceTemplate(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedNamespaceTemplate(r randyNamespaceTemplate, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldNamespaceTemplate(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldNamespaceTemplate(dAtA []byte, r randyNamespaceTemplate, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateNamespaceTemplate(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulateNamespaceTemplate(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulateNamespaceTemplate(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateNamespaceTemplate(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateNamespaceTemplate(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateNamespaceTemplate(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateNamespaceTemplate(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *NamespaceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovNamespaceTemplate(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovNamespaceTemplate(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, s := range m.Resources {
+			l = len(s)
+			n += 1 + l + sovNamespaceTemplate(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovNamespaceTemplate(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovNamespaceTemplate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNamespaceTemplate(x uint64) (n int) {
+	return sovNamespaceTemplate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NamespaceTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespaceTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaceTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaceTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaceTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaceTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespaceTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNamespaceTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNamespaceTemplate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNamespaceTemplate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespaceTemplate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespaceTemplate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNamespaceTemplate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNamespaceTemplate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNamespaceTemplate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNamespaceTemplate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNamespaceTemplate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNamespaceTemplate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceTemplateValidate(t *testing.T) {
+	template := FixtureNamespaceTemplate("team")
+	assert.NoError(t, template.Validate())
+
+	template.Resources = []string{"handlers", "events"}
+	assert.Error(t, template.Validate())
+
+	template = FixtureNamespaceTemplate("team")
+	template.Namespaces = []string{"team-["}
+	assert.Error(t, template.Validate())
+
+	template = FixtureNamespaceTemplate("team")
+	template.Source = ""
+	assert.Error(t, template.Validate())
+
+	template = FixtureNamespaceTemplate("team")
+	template.Namespace = "default"
+	assert.Error(t, template.Validate())
+}
+
+func TestNamespaceTemplateMatches(t *testing.T) {
+	template := FixtureNamespaceTemplate("team")
+	assert.True(t, template.Matches("default"))
+	assert.False(t, template.Matches("template"))
+
+	template.Namespaces = []string{"team-*", "ops"}
+	assert.True(t, template.Matches("team-a"))
+	assert.True(t, template.Matches("ops"))
+	assert.False(t, template.Matches("default"))
+}
+
+func TestNamespaceTemplateResourceTypes(t *testing.T) {
+	template := FixtureNamespaceTemplate("team")
+	assert.Equal(t, []string{"roles", "rolebindings", "handlers", "filters"}, template.ResourceTypes())
+
+	template.Resources = []string{"checks"}
+	assert.Equal(t, []string{"checks"}, template.ResourceTypes())
+}
@@ -36,6 +36,14 @@ const (
 	// LocalSelfUserResource represents a local user trying to view itself
 	// or change its password
 	LocalSelfUserResource = "localselfuser"
+
+	// RuleEffectAllow is the effect of the rules granting the access to
+	// resources
+	RuleEffectAllow = "allow"
+
+	// RuleEffectDeny is the effect of the rules refusing the access to
+	// resources, even if other rules grant it
+	RuleEffectDeny = "deny"
 )
 
 // CommonCoreResources represents the common "core" resources found in a
@@ -43,12 +51,16 @@ const (
 var CommonCoreResources = []string{
 	"assets",
 	"checks",
+	"correlations",
+	"enrichers",
 	"entities",
 	"extensions",
 	"events",
 	"filters",
 	"handlers",
 	"hooks",
+	"keepalive-policies",
+	"maintenance-windows",
 	"mutators",
 	"silenced",
 }
@@ -140,8 +152,19 @@ func (r *ClusterRole) Validate() error {
 		return errors.New("the ClusterRole name " + err.Error())
 	}
 
-	if len(r.Rules) == 0 {
-		return errors.New("a ClusterRole must have at least one rule")
+	if len(r.Rules) == 0 && r.AggregationRule == nil {
+		return errors.New("a ClusterRole must have at least one rule or an aggregation rule")
+	}
+
+	if r.AggregationRule != nil {
+		if len(r.AggregationRule.ClusterRoleSelectors) == 0 {
+			return errors.New("an aggregation rule must have at least one selector")
+		}
+		for _, selector := range r.AggregationRule.ClusterRoleSelectors {
+			if len(selector.MatchLabels) == 0 {
+				return errors.New("a ClusterRole selector must match at least one label")
+			}
+		}
 	}
 
 	if r.Namespace != "" {
@@ -157,6 +180,10 @@ func (r *ClusterRole) Validate() error {
 		if err := validateVerbs(r.Rules[i].Verbs); err != nil {
 			return err
 		}
+
+		if err := validateEffect(r.Rules[i].Effect); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -230,6 +257,10 @@ func (r *Role) Validate() error {
 		if err := validateVerbs(r.Rules[i].Verbs); err != nil {
 			return err
 		}
+
+		if err := validateEffect(r.Rules[i].Effect); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -269,6 +300,40 @@ func (b *RoleBinding) Validate() error {
 	return nil
 }
 
+// Denies returns whether the rule refuses the access to the resources it
+// matches, instead of granting it
+func (r Rule) Denies() bool {
+	return r.Effect == RuleEffectDeny
+}
+
+// Matches returns whether the given labels include all of the labels of the
+// selector
+func (s ClusterRoleSelector) Matches(labels map[string]string) bool {
+	if len(s.MatchLabels) == 0 {
+		return false
+	}
+	for key, value := range s.MatchLabels {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Aggregates returns whether the rules of the given ClusterRole are
+// aggregated in the ClusterRole. A ClusterRole never aggregates itself.
+func (r *ClusterRole) Aggregates(role *ClusterRole) bool {
+	if r.AggregationRule == nil || role.Name == r.Name {
+		return false
+	}
+	for _, selector := range r.AggregationRule.ClusterRoleSelectors {
+		if selector.Matches(role.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
 // ResourceMatches returns whether the specified requestedResource matches any
 // of the rule resources
 func (r Rule) ResourceMatches(requestedResource string) bool {
@@ -432,6 +497,14 @@ func split(list []string) []string {
 }
 
 // validateVerbs ensures the provided verbs are valid
+func validateEffect(effect string) error {
+	switch effect {
+	case "", RuleEffectAllow, RuleEffectDeny:
+		return nil
+	}
+	return fmt.Errorf("the effect %q is not valid", effect)
+}
+
 func validateVerbs(verbs []string) error {
 	for _, verb := range verbs {
 		if !stringsutil.InArray(verb, allowedVerbs) {
@@ -37,7 +37,10 @@ type Rule struct {
 	Resources []string `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources"`
 	// ResourceNames is an optional list of resource names that the rule applies
 	// to.
-	ResourceNames        []string `protobuf:"bytes,3,rep,name=resource_names,json=resourceNames,proto3" json:"resource_names"`
+	ResourceNames []string `protobuf:"bytes,3,rep,name=resource_names,json=resourceNames,proto3" json:"resource_names"`
+	// Effect is either "allow" to grant the access to the resources, or "deny"
+	// to refuse it even if other rules grant it. Defaults to "allow".
+	Effect               string   `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -97,21 +100,131 @@ func (m *Rule) GetResourceNames() []string {
 	return nil
 }
 
+func (m *Rule) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+// AggregationRule composes a ClusterRole from the rules of other ClusterRoles.
+type AggregationRule struct {
+	// ClusterRoleSelectors select the ClusterRoles whose rules are aggregated
+	// by their labels. A ClusterRole is selected if its labels include all of
+	// the labels of one of the selectors.
+	ClusterRoleSelectors []ClusterRoleSelector `protobuf:"bytes,1,rep,name=cluster_role_selectors,json=clusterRoleSelectors,proto3" json:"cluster_role_selectors"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AggregationRule) Reset()         { *m = AggregationRule{} }
+func (m *AggregationRule) String() string { return proto.CompactTextString(m) }
+func (*AggregationRule) ProtoMessage()    {}
+func (*AggregationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f88ffdd966c9c7ed, []int{1}
+}
+func (m *AggregationRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregationRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationRule.Merge(m, src)
+}
+func (m *AggregationRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregationRule proto.InternalMessageInfo
+
+func (m *AggregationRule) GetClusterRoleSelectors() []ClusterRoleSelector {
+	if m != nil {
+		return m.ClusterRoleSelectors
+	}
+	return nil
+}
+
+// ClusterRoleSelector selects ClusterRoles by their labels.
+type ClusterRoleSelector struct {
+	// MatchLabels are the labels the ClusterRoles must have.
+	MatchLabels          map[string]string `protobuf:"bytes,1,rep,name=match_labels,json=matchLabels,proto3" json:"match_labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ClusterRoleSelector) Reset()         { *m = ClusterRoleSelector{} }
+func (m *ClusterRoleSelector) String() string { return proto.CompactTextString(m) }
+func (*ClusterRoleSelector) ProtoMessage()    {}
+func (*ClusterRoleSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f88ffdd966c9c7ed, []int{2}
+}
+func (m *ClusterRoleSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRoleSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterRoleSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterRoleSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRoleSelector.Merge(m, src)
+}
+func (m *ClusterRoleSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRoleSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRoleSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRoleSelector proto.InternalMessageInfo
+
+func (m *ClusterRoleSelector) GetMatchLabels() map[string]string {
+	if m != nil {
+		return m.MatchLabels
+	}
+	return nil
+}
+
 // ClusterRole applies to all namespaces within a cluster.
 type ClusterRole struct {
 	Rules []Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
 	// Metadata contains name, namespace, labels and annotations
-	ObjectMeta           `protobuf:"bytes,3,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ObjectMeta `protobuf:"bytes,3,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// AggregationRule adds the rules of the selected ClusterRoles to the rules
+	// of the ClusterRole.
+	AggregationRule      *AggregationRule `protobuf:"bytes,4,opt,name=aggregation_rule,json=aggregationRule,proto3" json:"aggregation_rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ClusterRole) Reset()         { *m = ClusterRole{} }
 func (m *ClusterRole) String() string { return proto.CompactTextString(m) }
 func (*ClusterRole) ProtoMessage()    {}
 func (*ClusterRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f88ffdd966c9c7ed, []int{1}
+	return fileDescriptor_f88ffdd966c9c7ed, []int{3}
 }
 func (m *ClusterRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +267,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f88ffdd966c9c7ed, []int{2}
+	return fileDescriptor_f88ffdd966c9c7ed, []int{4}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,7 +311,7 @@ func (m *RoleRef) Reset()         { *m = RoleRef{} }
 func (m *RoleRef) String() string { return proto.CompactTextString(m) }
 func (*RoleRef) ProtoMessage()    {}
 func (*RoleRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f88ffdd966c9c7ed, []int{3}
+	return fileDescriptor_f88ffdd966c9c7ed, []int{5}
 }
 func (m *RoleRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,7 +368,7 @@ func (m *Subject) Reset()         { *m = Subject{} }
 func (m *Subject) String() string { return proto.CompactTextString(m) }
 func (*Subject) ProtoMessage()    {}
 func (*Subject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f88ffdd966c9c7ed, []int{4}
+	return fileDescriptor_f88ffdd966c9c7ed, []int{6}
 }
 func (m *Subject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +429,7 @@ func (m *ClusterRoleBinding) Reset()         { *m = ClusterRoleBinding{} }
 func (m *ClusterRoleBinding) String() string { return proto.CompactTextString(m) }
 func (*ClusterRoleBinding) ProtoMessage()    {}
 func (*ClusterRoleBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f88ffdd966c9c7ed, []int{5}
+	return fileDescriptor_f88ffdd966c9c7ed, []int{7}
 }
 func (m *ClusterRoleBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +476,7 @@ func (m *RoleBinding) Reset()         { *m = RoleBinding{} }
 func (m *RoleBinding) String() string { return proto.CompactTextString(m) }
 func (*RoleBinding) ProtoMessage()    {}
 func (*RoleBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f88ffdd966c9c7ed, []int{6}
+	return fileDescriptor_f88ffdd966c9c7ed, []int{8}
 }
 func (m *RoleBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,6 +507,9 @@ var xxx_messageInfo_RoleBinding proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Rule)(nil), "sensu.core.v2.Rule")
+	proto.RegisterType((*AggregationRule)(nil), "sensu.core.v2.AggregationRule")
+	proto.RegisterType((*ClusterRoleSelector)(nil), "sensu.core.v2.ClusterRoleSelector")
+	proto.RegisterMapType((map[string]string)(nil), "sensu.core.v2.ClusterRoleSelector.MatchLabelsEntry")
 	proto.RegisterType((*ClusterRole)(nil), "sensu.core.v2.ClusterRole")
 	proto.RegisterType((*Role)(nil), "sensu.core.v2.Role")
 	proto.RegisterType((*RoleRef)(nil), "sensu.core.v2.RoleRef")
@@ -405,37 +521,49 @@ func init() {
 func init() { proto.RegisterFile("rbac.proto", fileDescriptor_f88ffdd966c9c7ed) }
 
 var fileDescriptor_f88ffdd966c9c7ed = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x8e, 0xd3, 0x40,
-	0x10, 0xce, 0xe6, 0x87, 0x73, 0x26, 0x0a, 0x42, 0x8b, 0x84, 0xcc, 0x09, 0x79, 0xa3, 0x54, 0x91,
-	0x40, 0x3e, 0x5d, 0x8e, 0x02, 0xa8, 0x90, 0x0f, 0x4a, 0x40, 0x5a, 0x44, 0x43, 0x73, 0xb2, 0x7d,
-	0x93, 0x10, 0x64, 0x67, 0xa3, 0xf5, 0x3a, 0xd2, 0x75, 0x94, 0x74, 0xb4, 0x94, 0x47, 0x97, 0x47,
-	0xe0, 0x11, 0xae, 0xcc, 0x13, 0x58, 0x60, 0x3a, 0x3f, 0x01, 0x74, 0x68, 0xd7, 0x71, 0x0c, 0xa1,
-	0x84, 0x14, 0x34, 0xbb, 0xf3, 0xfb, 0xed, 0x37, 0xdf, 0x48, 0x0b, 0x20, 0x03, 0x3f, 0x74, 0x17,
-	0x52, 0x28, 0x41, 0xfb, 0x09, 0xce, 0x93, 0xd4, 0x0d, 0x85, 0x44, 0x77, 0x39, 0x3e, 0xbc, 0x3f,
-	0x9d, 0xa9, 0x37, 0x69, 0xe0, 0x86, 0x22, 0x3e, 0x9a, 0x8a, 0xa9, 0x38, 0x32, 0x55, 0x41, 0x3a,
-	0x79, 0xbc, 0x3c, 0x76, 0x4f, 0xdc, 0x63, 0x13, 0x34, 0x31, 0x63, 0x95, 0x20, 0x87, 0x10, 0xa3,
-	0xf2, 0x4b, 0x7b, 0xf8, 0x81, 0x40, 0x9b, 0xa7, 0x11, 0x52, 0x06, 0x9d, 0x25, 0xca, 0x20, 0xb1,
-	0xc9, 0xa0, 0x35, 0xea, 0x7a, 0xdd, 0x22, 0x63, 0x65, 0x80, 0x97, 0x17, 0xbd, 0x0b, 0x5d, 0x89,
-	0x89, 0x48, 0x65, 0x88, 0x89, 0xdd, 0x34, 0x45, 0xfd, 0x22, 0x63, 0x75, 0x90, 0xd7, 0x26, 0x7d,
-	0x08, 0xd7, 0x2b, 0xe7, 0x6c, 0xee, 0xc7, 0x98, 0xd8, 0x2d, 0xd3, 0x41, 0x8b, 0x8c, 0xed, 0x64,
-	0x78, 0xbf, 0xf2, 0x9f, 0x6b, 0x77, 0xb8, 0x22, 0xd0, 0x3b, 0x8d, 0xd2, 0x44, 0xa1, 0xe4, 0x22,
-	0x42, 0xfa, 0x00, 0x3a, 0x32, 0x8d, 0xb0, 0x24, 0xd6, 0x1b, 0xdf, 0x74, 0x7f, 0x93, 0xc0, 0xd5,
-	0xe4, 0xbd, 0xfe, 0x55, 0xc6, 0x1a, 0x9a, 0xb1, 0xa9, 0xe4, 0xe5, 0x45, 0x5f, 0x81, 0xa5, 0x27,
-	0x3d, 0xf7, 0x95, 0x6f, 0xb7, 0x06, 0x64, 0xd4, 0x1b, 0xdf, 0xde, 0x69, 0x7e, 0x11, 0xbc, 0xc5,
-	0x50, 0x3d, 0x43, 0xe5, 0x7b, 0x8e, 0x86, 0x58, 0x67, 0x8c, 0x14, 0x19, 0xa3, 0x55, 0xdb, 0x3d,
-	0x11, 0xcf, 0x14, 0xc6, 0x0b, 0x75, 0xc1, 0xb7, 0x50, 0x8f, 0xac, 0xf7, 0x97, 0xac, 0xb1, 0xba,
-	0x64, 0x64, 0xf8, 0x49, 0x8b, 0xf7, 0xef, 0x38, 0xb6, 0xf7, 0xc1, 0xf1, 0x29, 0x1c, 0x68, 0x8a,
-	0x1c, 0x27, 0xf4, 0x0e, 0xb4, 0xd5, 0xc5, 0x02, 0x6d, 0x32, 0x20, 0xa3, 0xae, 0x67, 0x15, 0x19,
-	0x33, 0x3e, 0x37, 0xa7, 0xce, 0xea, 0x7d, 0xd8, 0xcd, 0x3a, 0xab, 0x7d, 0x6e, 0x4e, 0x0d, 0xf3,
-	0x32, 0x35, 0x4c, 0xfe, 0x0a, 0xe6, 0x5d, 0x13, 0xe8, 0x2f, 0xcb, 0xf5, 0x66, 0xf3, 0xf3, 0xd9,
-	0x7c, 0x4a, 0x9f, 0x80, 0x95, 0x94, 0xe8, 0x95, 0x84, 0xb7, 0x76, 0x54, 0xd8, 0x3c, 0xee, 0xdd,
-	0xd8, 0xa8, 0xb8, 0xad, 0xe7, 0x5b, 0x8b, 0x9e, 0x82, 0x25, 0x45, 0x84, 0x67, 0x12, 0x27, 0xe6,
-	0xf9, 0x3f, 0x51, 0x36, 0x4a, 0xd4, 0x28, 0x55, 0x3d, 0x3f, 0x90, 0x1b, 0x91, 0xf6, 0xbe, 0x90,
-	0x1f, 0x04, 0x7a, 0xff, 0xc1, 0xec, 0x9d, 0x3d, 0xcc, 0xee, 0x0d, 0xbe, 0x7f, 0x75, 0xc8, 0x2a,
-	0x77, 0xc8, 0xe7, 0xdc, 0x21, 0x57, 0xb9, 0x43, 0xd6, 0xb9, 0x43, 0xbe, 0xe4, 0x0e, 0xf9, 0xf8,
-	0xcd, 0x69, 0xbc, 0x6e, 0x2e, 0xc7, 0xc1, 0x35, 0xf3, 0x2d, 0x9d, 0xfc, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0xad, 0xa2, 0xc1, 0x87, 0xf5, 0x04, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x3d, 0x6f, 0xdb, 0x3a,
+	0x14, 0x0d, 0xfd, 0x91, 0xd8, 0xf4, 0xf3, 0x8b, 0xc1, 0x04, 0x81, 0x9e, 0xf1, 0x20, 0x1a, 0x9e,
+	0x02, 0xbc, 0x40, 0x41, 0x9c, 0x37, 0xa4, 0x19, 0x8a, 0x56, 0x69, 0xb6, 0xa6, 0x05, 0x18, 0x74,
+	0xe9, 0x62, 0x48, 0xca, 0xb5, 0xe2, 0x56, 0xb2, 0x02, 0x8a, 0x32, 0xea, 0xad, 0x63, 0x81, 0x8e,
+	0x5d, 0x3a, 0xa6, 0x5b, 0x7e, 0x42, 0x97, 0xa2, 0x6b, 0xc6, 0xfc, 0x02, 0xa1, 0x75, 0x37, 0xfd,
+	0x82, 0x76, 0x2b, 0x44, 0x4a, 0xb1, 0xa3, 0xb4, 0xe8, 0xd0, 0x64, 0xe8, 0x62, 0xdd, 0x7b, 0x79,
+	0xce, 0xe1, 0xe5, 0xb9, 0x24, 0x8c, 0x31, 0xb7, 0x2d, 0xc7, 0x38, 0xe1, 0x81, 0x08, 0x48, 0x33,
+	0x84, 0x51, 0x18, 0x19, 0x4e, 0xc0, 0xc1, 0x18, 0xf7, 0xda, 0xff, 0xbb, 0x43, 0x71, 0x1c, 0xd9,
+	0x86, 0x13, 0xf8, 0x9b, 0x6e, 0xe0, 0x06, 0x9b, 0x12, 0x65, 0x47, 0x83, 0x7b, 0xe3, 0x2d, 0x63,
+	0xdb, 0xd8, 0x92, 0x45, 0x59, 0x93, 0x91, 0x12, 0x69, 0x63, 0x1f, 0x84, 0xa5, 0xe2, 0xee, 0x07,
+	0x84, 0x2b, 0x2c, 0xf2, 0x80, 0x50, 0x5c, 0x1d, 0x03, 0xb7, 0x43, 0x0d, 0x75, 0xca, 0xeb, 0x75,
+	0xb3, 0x9e, 0xc4, 0x54, 0x15, 0x98, 0xfa, 0x90, 0xff, 0x70, 0x9d, 0x43, 0x18, 0x44, 0xdc, 0x81,
+	0x50, 0x2b, 0x49, 0x50, 0x33, 0x89, 0xe9, 0xac, 0xc8, 0x66, 0x21, 0xb9, 0x83, 0xff, 0xce, 0x93,
+	0xfe, 0xc8, 0xf2, 0x21, 0xd4, 0xca, 0x92, 0x41, 0x92, 0x98, 0x16, 0x56, 0x58, 0x33, 0xcf, 0x1f,
+	0xa5, 0x29, 0xd9, 0xc0, 0x8b, 0x30, 0x18, 0x80, 0x23, 0xb4, 0x4a, 0x07, 0xad, 0xd7, 0xcd, 0xd5,
+	0x24, 0xa6, 0x2d, 0x55, 0xd9, 0x08, 0xfc, 0xa1, 0x00, 0xff, 0x44, 0x4c, 0x58, 0x86, 0xe9, 0xbe,
+	0x46, 0x78, 0xf9, 0xbe, 0xeb, 0x72, 0x70, 0x2d, 0x31, 0x0c, 0x46, 0xf2, 0x28, 0x2f, 0xf0, 0x9a,
+	0xe3, 0x45, 0xa1, 0x00, 0xde, 0xe7, 0x81, 0x07, 0xfd, 0x10, 0x3c, 0x70, 0x44, 0xc0, 0xd5, 0xd9,
+	0x1a, 0xbd, 0xae, 0x71, 0xc5, 0x45, 0x63, 0x4f, 0x81, 0x59, 0xe0, 0xc1, 0x61, 0x06, 0x35, 0xf5,
+	0xf3, 0x98, 0x2e, 0x24, 0x31, 0xfd, 0x89, 0x12, 0x5b, 0x75, 0xae, 0x93, 0xc2, 0xee, 0x47, 0x84,
+	0x57, 0x7e, 0xa0, 0x46, 0x8e, 0xf1, 0x5f, 0xbe, 0x25, 0x9c, 0xe3, 0xbe, 0x67, 0xd9, 0xe0, 0xe5,
+	0x7d, 0x6c, 0xff, 0xba, 0x0f, 0xe3, 0x20, 0xa5, 0x3d, 0x94, 0xac, 0xfd, 0x91, 0xe0, 0x13, 0xb3,
+	0x95, 0xc4, 0xf4, 0x8a, 0x18, 0x6b, 0xf8, 0x33, 0x4c, 0xfb, 0x2e, 0x6e, 0x15, 0x29, 0xa4, 0x85,
+	0xcb, 0xcf, 0x61, 0xa2, 0xa1, 0xd4, 0x4e, 0x96, 0x86, 0x64, 0x15, 0x57, 0xc7, 0x96, 0x17, 0x81,
+	0x56, 0x92, 0x35, 0x95, 0xec, 0x96, 0x76, 0x50, 0xf7, 0x4d, 0x09, 0x37, 0xe6, 0xfa, 0x20, 0x3b,
+	0xb8, 0xca, 0x23, 0x0f, 0xf2, 0x96, 0x57, 0x0a, 0x2d, 0xa7, 0x7e, 0x9b, 0xcd, 0xcc, 0x2b, 0x85,
+	0x64, 0xea, 0x43, 0x9e, 0xe0, 0x5a, 0x7a, 0xcf, 0x8e, 0x2c, 0x61, 0x69, 0xe5, 0x0e, 0x5a, 0x6f,
+	0xf4, 0xfe, 0x29, 0x90, 0x1f, 0xdb, 0xcf, 0xc0, 0x11, 0x07, 0x20, 0x2c, 0x65, 0xf7, 0x45, 0x4c,
+	0x51, 0x12, 0x53, 0x92, 0xd3, 0xe6, 0xc6, 0x7d, 0x29, 0x45, 0x86, 0xb8, 0x65, 0xcd, 0xe6, 0xdd,
+	0x4f, 0xf7, 0x92, 0x17, 0xa5, 0xd1, 0xd3, 0x0b, 0xf2, 0x85, 0x6b, 0x61, 0xea, 0x49, 0x4c, 0xdb,
+	0x45, 0xee, 0xdc, 0x1e, 0xcb, 0xd6, 0x55, 0xc2, 0x6e, 0xed, 0xd5, 0x29, 0x5d, 0x38, 0x3b, 0xa5,
+	0xa8, 0xfb, 0x2e, 0x7d, 0x25, 0x37, 0x67, 0x47, 0xe5, 0xc6, 0xec, 0x98, 0xeb, 0x71, 0x1f, 0x2f,
+	0xa5, 0x2d, 0x32, 0x18, 0x90, 0x7f, 0x71, 0x45, 0x4c, 0x4e, 0x40, 0x4d, 0xdc, 0xac, 0x25, 0x31,
+	0x95, 0x39, 0x93, 0xbf, 0xe9, 0x6a, 0xfa, 0xf0, 0xd4, 0xec, 0xd5, 0x6a, 0x9a, 0x33, 0xf9, 0x9b,
+	0xca, 0x1c, 0x46, 0xb2, 0x93, 0xdf, 0x92, 0x79, 0x59, 0xc2, 0x64, 0xee, 0x1e, 0x99, 0xc3, 0xd1,
+	0xd1, 0x70, 0xe4, 0x92, 0x07, 0xb8, 0x16, 0x2a, 0xf5, 0xdc, 0xc2, 0xb5, 0x82, 0x0b, 0xd9, 0xe6,
+	0x66, 0x2b, 0x73, 0xf1, 0x12, 0xcf, 0x2e, 0x23, 0xb2, 0x87, 0x6b, 0xf2, 0x39, 0x72, 0x18, 0xc8,
+	0xed, 0xaf, 0xab, 0x64, 0x4e, 0xcc, 0x54, 0x72, 0x3c, 0x5b, 0xe2, 0x99, 0x49, 0xb7, 0x3e, 0x90,
+	0x6f, 0x08, 0x37, 0xfe, 0x80, 0xb3, 0x57, 0x6f, 0xe1, 0xec, 0x66, 0xe7, 0xeb, 0x67, 0x1d, 0x9d,
+	0x4d, 0x75, 0xf4, 0x7e, 0xaa, 0xa3, 0xf3, 0xa9, 0x8e, 0x2e, 0xa6, 0x3a, 0xfa, 0x34, 0xd5, 0xd1,
+	0xdb, 0x2f, 0xfa, 0xc2, 0xd3, 0xd2, 0xb8, 0x67, 0x2f, 0xca, 0xff, 0x9f, 0xed, 0xef, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0xfa, 0x15, 0xc2, 0x66, 0xde, 0x06, 0x00, 0x00,
 }
 
 func (this *Rule) Equal(that interface{}) bool {
@@ -481,6 +609,73 @@ func (this *Rule) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Effect != that1.Effect {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AggregationRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregationRule)
+	if !ok {
+		that2, ok := that.(AggregationRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ClusterRoleSelectors) != len(that1.ClusterRoleSelectors) {
+		return false
+	}
+	for i := range this.ClusterRoleSelectors {
+		if !this.ClusterRoleSelectors[i].Equal(&that1.ClusterRoleSelectors[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ClusterRoleSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterRoleSelector)
+	if !ok {
+		that2, ok := that.(ClusterRoleSelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MatchLabels) != len(that1.MatchLabels) {
+		return false
+	}
+	for i := range this.MatchLabels {
+		if this.MatchLabels[i] != that1.MatchLabels[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -516,6 +711,9 @@ func (this *ClusterRole) Equal(that interface{}) bool {
 	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
 		return false
 	}
+	if !this.AggregationRule.Equal(that1.AggregationRule) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -697,6 +895,7 @@ type ClusterRoleFace interface {
 	Proto() github_com_golang_protobuf_proto.Message
 	GetRules() []Rule
 	GetObjectMeta() ObjectMeta
+	GetAggregationRule() *AggregationRule
 }
 
 func (this *ClusterRole) Proto() github_com_golang_protobuf_proto.Message {
@@ -715,10 +914,15 @@ func (this *ClusterRole) GetObjectMeta() ObjectMeta {
 	return this.ObjectMeta
 }
 
+func (this *ClusterRole) GetAggregationRule() *AggregationRule {
+	return this.AggregationRule
+}
+
 func NewClusterRoleFromFace(that ClusterRoleFace) *ClusterRole {
 	this := &ClusterRole{}
 	this.Rules = that.GetRules()
 	this.ObjectMeta = that.GetObjectMeta()
+	this.AggregationRule = that.GetAggregationRule()
 	return this
 }
 
@@ -845,6 +1049,13 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Effect) > 0 {
+		i -= len(m.Effect)
+		copy(dAtA[i:], m.Effect)
+		i = encodeVarintRbac(dAtA, i, uint64(len(m.Effect)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ResourceNames) > 0 {
 		for iNdEx := len(m.ResourceNames) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ResourceNames[iNdEx])
@@ -875,6 +1086,93 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClusterRoleSelectors) > 0 {
+		for iNdEx := len(m.ClusterRoleSelectors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClusterRoleSelectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRbac(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterRoleSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterRoleSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterRoleSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MatchLabels) > 0 {
+		for k := range m.MatchLabels {
+			v := m.MatchLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRbac(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRbac(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRbac(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -899,6 +1197,18 @@ func (m *ClusterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AggregationRule != nil {
+		{
+			size, err := m.AggregationRule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRbac(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1209,32 +1519,46 @@ func NewPopulatedRule(r randyRbac, easy bool) *Rule {
 	for i := 0; i < v3; i++ {
 		this.ResourceNames[i] = string(randStringRbac(r))
 	}
+	this.Effect = string(randStringRbac(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRbac(r, 4)
+		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
 	}
 	return this
 }
 
-func NewPopulatedClusterRole(r randyRbac, easy bool) *ClusterRole {
-	this := &ClusterRole{}
+func NewPopulatedAggregationRule(r randyRbac, easy bool) *AggregationRule {
+	this := &AggregationRule{}
 	if r.Intn(5) != 0 {
 		v4 := r.Intn(5)
-		this.Rules = make([]Rule, v4)
+		this.ClusterRoleSelectors = make([]ClusterRoleSelector, v4)
 		for i := 0; i < v4; i++ {
-			v5 := NewPopulatedRule(r, easy)
-			this.Rules[i] = *v5
+			v5 := NewPopulatedClusterRoleSelector(r, easy)
+			this.ClusterRoleSelectors[i] = *v5
 		}
 	}
-	v6 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v6
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRbac(r, 4)
+		this.XXX_unrecognized = randUnrecognizedRbac(r, 2)
 	}
 	return this
 }
 
-func NewPopulatedRole(r randyRbac, easy bool) *Role {
-	this := &Role{}
+func NewPopulatedClusterRoleSelector(r randyRbac, easy bool) *ClusterRoleSelector {
+	this := &ClusterRoleSelector{}
+	if r.Intn(5) != 0 {
+		v6 := r.Intn(10)
+		this.MatchLabels = make(map[string]string)
+		for i := 0; i < v6; i++ {
+			this.MatchLabels[randStringRbac(r)] = randStringRbac(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRbac(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedClusterRole(r randyRbac, easy bool) *ClusterRole {
+	this := &ClusterRole{}
 	if r.Intn(5) != 0 {
 		v7 := r.Intn(5)
 		this.Rules = make([]Rule, v7)
@@ -1245,6 +1569,27 @@ func NewPopulatedRole(r randyRbac, easy bool) *Role {
 	}
 	v9 := NewPopulatedObjectMeta(r, easy)
 	this.ObjectMeta = *v9
+	if r.Intn(5) != 0 {
+		this.AggregationRule = NewPopulatedAggregationRule(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedRole(r randyRbac, easy bool) *Role {
+	this := &Role{}
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(5)
+		this.Rules = make([]Rule, v10)
+		for i := 0; i < v10; i++ {
+			v11 := NewPopulatedRule(r, easy)
+			this.Rules[i] = *v11
+		}
+	}
+	v12 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v12
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
 	}
@@ -1274,17 +1619,17 @@ func NewPopulatedSubject(r randyRbac, easy bool) *Subject {
 func NewPopulatedClusterRoleBinding(r randyRbac, easy bool) *ClusterRoleBinding {
 	this := &ClusterRoleBinding{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.Subjects = make([]Subject, v10)
-		for i := 0; i < v10; i++ {
-			v11 := NewPopulatedSubject(r, easy)
-			this.Subjects[i] = *v11
+		v13 := r.Intn(5)
+		this.Subjects = make([]Subject, v13)
+		for i := 0; i < v13; i++ {
+			v14 := NewPopulatedSubject(r, easy)
+			this.Subjects[i] = *v14
 		}
 	}
-	v12 := NewPopulatedRoleRef(r, easy)
-	this.RoleRef = *v12
-	v13 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v13
+	v15 := NewPopulatedRoleRef(r, easy)
+	this.RoleRef = *v15
+	v16 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v16
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 5)
 	}
@@ -1294,17 +1639,17 @@ func NewPopulatedClusterRoleBinding(r randyRbac, easy bool) *ClusterRoleBinding
 func NewPopulatedRoleBinding(r randyRbac, easy bool) *RoleBinding {
 	this := &RoleBinding{}
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.Subjects = make([]Subject, v14)
-		for i := 0; i < v14; i++ {
-			v15 := NewPopulatedSubject(r, easy)
-			this.Subjects[i] = *v15
+		v17 := r.Intn(5)
+		this.Subjects = make([]Subject, v17)
+		for i := 0; i < v17; i++ {
+			v18 := NewPopulatedSubject(r, easy)
+			this.Subjects[i] = *v18
 		}
 	}
-	v16 := NewPopulatedRoleRef(r, easy)
-	this.RoleRef = *v16
-	v17 := NewPopulatedObjectMeta(r, easy)
-	this.ObjectMeta = *v17
+	v19 := NewPopulatedRoleRef(r, easy)
+	this.RoleRef = *v19
+	v20 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v20
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedRbac(r, 6)
 	}
@@ -1330,9 +1675,9 @@ func randUTF8RuneRbac(r randyRbac) rune {
 	return rune(ru + 61)
 }
 func randStringRbac(r randyRbac) string {
-	v18 := r.Intn(100)
-	tmps := make([]rune, v18)
-	for i := 0; i < v18; i++ {
+	v21 := r.Intn(100)
+	tmps := make([]rune, v21)
+	for i := 0; i < v21; i++ {
 		tmps[i] = randUTF8RuneRbac(r)
 	}
 	return string(tmps)
@@ -1354,11 +1699,11 @@ func randFieldRbac(dAtA []byte, r randyRbac, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRbac(dAtA, uint64(key))
-		v19 := r.Int63()
+		v22 := r.Int63()
 		if r.Intn(2) == 0 {
-			v19 *= -1
+			v22 *= -1
 		}
-		dAtA = encodeVarintPopulateRbac(dAtA, uint64(v19))
+		dAtA = encodeVarintPopulateRbac(dAtA, uint64(v22))
 	case 1:
 		dAtA = encodeVarintPopulateRbac(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1407,6 +1752,48 @@ func (m *Rule) Size() (n int) {
 			n += 1 + l + sovRbac(uint64(l))
 		}
 	}
+	l = len(m.Effect)
+	if l > 0 {
+		n += 1 + l + sovRbac(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClusterRoleSelectors) > 0 {
+		for _, e := range m.ClusterRoleSelectors {
+			l = e.Size()
+			n += 1 + l + sovRbac(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterRoleSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MatchLabels) > 0 {
+		for k, v := range m.MatchLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRbac(uint64(len(k))) + 1 + len(v) + sovRbac(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRbac(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1427,6 +1814,10 @@ func (m *ClusterRole) Size() (n int) {
 	}
 	l = m.ObjectMeta.Size()
 	n += 1 + l + sovRbac(uint64(l))
+	if m.AggregationRule != nil {
+		l = m.AggregationRule.Size()
+		n += 1 + l + sovRbac(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1668,6 +2059,307 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			}
 			m.ResourceNames = append(m.ResourceNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Effect = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRbac(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRbac
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterRoleSelectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterRoleSelectors = append(m.ClusterRoleSelectors, ClusterRoleSelector{})
+			if err := m.ClusterRoleSelectors[len(m.ClusterRoleSelectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRbac(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterRoleSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRbac
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterRoleSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterRoleSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatchLabels == nil {
+				m.MatchLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRbac
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRbac
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRbac
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRbac
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRbac
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRbac
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRbac
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRbac(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRbac
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MatchLabels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRbac(dAtA[iNdEx:])
@@ -1789,6 +2481,42 @@ func (m *ClusterRole) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRbac
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRbac
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRbac
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregationRule == nil {
+				m.AggregationRule = &AggregationRule{}
+			}
+			if err := m.AggregationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRbac(dAtA[iNdEx:])
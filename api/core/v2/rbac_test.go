@@ -194,3 +194,83 @@ func Test_split(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterRoleValidateAggregation(t *testing.T) {
+	role := &ClusterRole{ObjectMeta: NewObjectMeta("aggregated", "")}
+	if err := role.Validate(); err == nil {
+		t.Error("expected an error for a ClusterRole without rules")
+	}
+
+	role.AggregationRule = &AggregationRule{}
+	if err := role.Validate(); err == nil {
+		t.Error("expected an error for an aggregation rule without selectors")
+	}
+
+	role.AggregationRule.ClusterRoleSelectors = []ClusterRoleSelector{{}}
+	if err := role.Validate(); err == nil {
+		t.Error("expected an error for a selector without labels")
+	}
+
+	role.AggregationRule.ClusterRoleSelectors[0].MatchLabels = map[string]string{"aggregate-to": "ops"}
+	if err := role.Validate(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	role.Rules = []Rule{{Verbs: []string{"get"}, Resources: []string{"checks"}, Effect: "ignore"}}
+	if err := role.Validate(); err == nil {
+		t.Error("expected an error for an invalid effect")
+	}
+}
+
+func TestClusterRoleAggregates(t *testing.T) {
+	role := &ClusterRole{
+		ObjectMeta: NewObjectMeta("aggregated", ""),
+		AggregationRule: &AggregationRule{
+			ClusterRoleSelectors: []ClusterRoleSelector{
+				{MatchLabels: map[string]string{"aggregate-to": "ops", "tier": "1"}},
+				{MatchLabels: map[string]string{"aggregate-to": "all"}},
+			},
+		},
+	}
+	role.Labels = map[string]string{"aggregate-to": "all"}
+
+	tests := []struct {
+		name   string
+		role   *ClusterRole
+		labels map[string]string
+		want   bool
+	}{
+		{
+			name:   "all the labels of a selector",
+			role:   FixtureClusterRole("checks"),
+			labels: map[string]string{"aggregate-to": "ops", "tier": "1", "team": "a"},
+			want:   true,
+		},
+		{
+			name:   "some of the labels of a selector",
+			role:   FixtureClusterRole("checks"),
+			labels: map[string]string{"aggregate-to": "ops"},
+			want:   false,
+		},
+		{
+			name:   "another selector",
+			role:   FixtureClusterRole("checks"),
+			labels: map[string]string{"aggregate-to": "all"},
+			want:   true,
+		},
+		{
+			name:   "itself",
+			role:   role,
+			labels: map[string]string{"aggregate-to": "all"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.role.Labels = tt.labels
+			if got := role.Aggregates(tt.role); got != tt.want {
+				t.Errorf("ClusterRole.Aggregates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
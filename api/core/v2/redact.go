@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"sort"
+	"strings"
+
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+// RedactFields returns the keys whose values are masked in the output of the
+// check executed for the given entity: the redact fields of the check along
+// with the ones of the entity, which default to DefaultRedactFields.
+func (c *Check) RedactFields(entity *Entity) []string {
+	fields := DefaultRedactFields
+	if entity != nil && len(entity.Redact) > 0 {
+		fields = entity.Redact
+	}
+	if len(c.Redact) == 0 {
+		return fields
+	}
+	return append(append([]string{}, fields...), c.Redact...)
+}
+
+// SensitiveValues returns the values of the environment variables, formatted
+// as KEY=VALUE, and of the labels and annotations of the entity, whose keys
+// match the given redact fields.
+func SensitiveValues(fields []string, env []string, entity *Entity) []string {
+	var values []string
+	for _, kv := range env {
+		i := strings.Index(kv, "=")
+		if i < 0 {
+			continue
+		}
+		if redacted(kv[:i], fields) {
+			values = append(values, kv[i+1:])
+		}
+	}
+	if entity != nil {
+		for _, m := range []map[string]string{entity.Labels, entity.Annotations} {
+			for k, v := range m {
+				if redacted(k, fields) {
+					values = append(values, v)
+				}
+			}
+		}
+	}
+	return values
+}
+
+// EnvValues returns the values of the given environment variables, formatted
+// as KEY=VALUE, e.g. to mask the values of the secrets.
+func EnvValues(env []string) []string {
+	values := make([]string, 0, len(env))
+	for _, kv := range env {
+		if i := strings.Index(kv, "="); i >= 0 {
+			values = append(values, kv[i+1:])
+		}
+	}
+	return values
+}
+
+// redacted returns whether the key matches any of the redact fields, ignoring
+// the case.
+func redacted(key string, fields []string) bool {
+	return utilstrings.FoundInArray(strings.ToLower(key), fields)
+}
+
+// RedactString replaces the occurrences of the given values in s with
+// Redacted. The longest values are replaced first, so that a value containing
+// another one is entirely masked.
+func RedactString(s string, values []string) string {
+	if s == "" || len(values) == 0 {
+		return s
+	}
+	sorted := make([]string, 0, len(values))
+	for _, value := range values {
+		// Masking the empty or trivial values would garble the output
+		if len(value) > 1 && value != Redacted {
+			sorted = append(sorted, value)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+	for _, value := range sorted {
+		s = strings.Replace(s, value, Redacted, -1)
+	}
+	return s
+}
+
+// RedactOutput masks the values of the environment variables of the check
+// and of the labels and annotations of the entity matching the redact fields
+// in the output of the check and of its hooks, along with the given values,
+// e.g. the values of the secrets of the check.
+func (e *Event) RedactOutput(values ...string) {
+	if !e.HasCheck() {
+		return
+	}
+	values = append(values, SensitiveValues(e.Check.RedactFields(e.Entity), e.Check.EnvVars, e.Entity)...)
+	e.Check.Output = RedactString(e.Check.Output, values)
+	for _, hook := range e.Check.Hooks {
+		if hook != nil {
+			hook.Output = RedactString(hook.Output, values)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRedactFields(t *testing.T) {
+	check := FixtureCheck("check")
+	assert.Equal(t, DefaultRedactFields, check.RedactFields(nil))
+
+	entity := FixtureEntity("entity")
+	entity.Redact = []string{"token"}
+	assert.Equal(t, []string{"token"}, check.RedactFields(entity))
+
+	check.Redact = []string{"region"}
+	assert.Equal(t, []string{"token", "region"}, check.RedactFields(entity))
+	assert.Equal(t, []string{"token"}, entity.Redact)
+}
+
+func TestSensitiveValues(t *testing.T) {
+	entity := FixtureEntity("entity")
+	entity.Labels = map[string]string{"region": "eu-west-1"}
+	entity.Annotations = map[string]string{"password": "hunter2"}
+	env := []string{"PASSWORD=p4ss", "API_KEY=k3y", "HOME=/root", "INVALID"}
+
+	values := SensitiveValues(DefaultRedactFields, env, entity)
+	assert.ElementsMatch(t, []string{"p4ss", "k3y", "hunter2"}, values)
+}
+
+func TestRedactString(t *testing.T) {
+	assert.Equal(t, "token REDACTED, REDACTED", RedactString("token abc123, abc", []string{"abc", "abc123"}))
+	assert.Equal(t, "a b c", RedactString("a b c", []string{"", "a", Redacted}))
+	assert.Equal(t, "", RedactString("", []string{"abc"}))
+}
+
+func TestEventRedactOutput(t *testing.T) {
+	event := FixtureEvent("entity", "check")
+	event.Check.EnvVars = []string{"API_TOKEN=s3cr3t-token", "REGION=eu-west-1"}
+	event.Check.Redact = []string{"region"}
+	event.Check.Output = "posted to https://hooks.slack.com/T0 with s3cr3t-token in eu-west-1"
+	event.Check.Hooks = []*Hook{{Output: "s3cr3t-token"}}
+
+	event.RedactOutput(EnvValues([]string{"SLACK_WEBHOOK=https://hooks.slack.com/T0"})...)
+	assert.Equal(t, "posted to REDACTED with REDACTED in REDACTED", event.Check.Output)
+	assert.Equal(t, "REDACTED", event.Check.Hooks[0].Output)
+}
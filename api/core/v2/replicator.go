@@ -0,0 +1,189 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// ReplicatorsResource is the name of this resource type
+	ReplicatorsResource = "replicators"
+
+	// ReplicatorAnnotation is the annotation of the replicated resources,
+	// whose value is the name of their replicator
+	ReplicatorAnnotation = "sensu.io/replicator"
+
+	// ReplicatorConflictPolicySkip keeps the resources of the remote cluster
+	// which were not replicated
+	ReplicatorConflictPolicySkip = "skip"
+
+	// ReplicatorConflictPolicyOverwrite replaces the resources of the remote
+	// cluster which were not replicated
+	ReplicatorConflictPolicyOverwrite = "overwrite"
+)
+
+// replicableResources are the resource types which can be replicated, in the
+// order in which they are replicated, so that the resources are replicated
+// after the resources they depend on.
+var replicableResources = []struct {
+	name string
+	new  func() Resource
+}{
+	{NamespacesResource, func() Resource { return &Namespace{} }},
+	{ClusterRolesResource, func() Resource { return &ClusterRole{} }},
+	{ClusterRoleBindingsResource, func() Resource { return &ClusterRoleBinding{} }},
+	{RolesResource, func() Resource { return &Role{} }},
+	{RoleBindingsResource, func() Resource { return &RoleBinding{} }},
+	{NamespaceTemplatesResource, func() Resource { return &NamespaceTemplate{} }},
+	{SecretsProvidersResource, func() Resource { return &SecretsProvider{} }},
+	{SecretsResource, func() Resource { return &SecretConfig{} }},
+	{AssetsResource, func() Resource { return &Asset{} }},
+	{HooksResource, func() Resource { return &HookConfig{} }},
+	{MutatorsResource, func() Resource { return &Mutator{} }},
+	{EventFiltersResource, func() Resource { return &EventFilter{} }},
+	{EnrichersResource, func() Resource { return &Enricher{} }},
+	{CorrelationsResource, func() Resource { return &Correlation{} }},
+	{HandlersResource, func() Resource { return &Handler{} }},
+	{ChecksResource, func() Resource { return &CheckConfig{} }},
+	{SilencedResource, func() Resource { return &Silenced{} }},
+	{KeepalivePoliciesResource, func() Resource { return &KeepalivePolicy{} }},
+	{MaintenanceWindowsResource, func() Resource { return &MaintenanceWindow{} }},
+	{ResourceQuotasResource, func() Resource { return &ResourceQuota{} }},
+	{EventRetentionPoliciesResource, func() Resource { return &EventRetentionPolicy{} }},
+}
+
+// ReplicableResources returns the RBAC names of the resource types which can
+// be replicated, in the order in which they are replicated.
+func ReplicableResources() []string {
+	names := make([]string, len(replicableResources))
+	for i, r := range replicableResources {
+		names[i] = r.name
+	}
+	return names
+}
+
+// NewReplicableResource returns a new resource of the replicable type of the
+// given RBAC name, or nil if the resources of the type can't be replicated.
+func NewReplicableResource(name string) Resource {
+	for _, r := range replicableResources {
+		if r.name == name {
+			return r.new()
+		}
+	}
+	return nil
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (r *Replicator) StorePrefix() string {
+	return ReplicatorsResource
+}
+
+// URIPath returns the path component of a replicator URI.
+func (r *Replicator) URIPath() string {
+	return path.Join(URLPrefix, ReplicatorsResource, url.PathEscape(r.Name))
+}
+
+// Validate returns an error if the replicator does not pass validation tests.
+func (r *Replicator) Validate() error {
+	if err := ValidateName(r.Name); err != nil {
+		return errors.New("replicator name " + err.Error())
+	}
+
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return fmt.Errorf("replicator url is invalid: %s", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("replicator url must be an http or https url")
+	}
+
+	if r.APIKey == "" {
+		return errors.New("replicator api key must be set")
+	}
+
+	if len(r.Resources) == 0 {
+		return errors.New("replicator resources must be set")
+	}
+	for _, resource := range r.Resources {
+		if NewReplicableResource(resource) == nil {
+			return fmt.Errorf("replicator resource %q can't be replicated", resource)
+		}
+	}
+
+	switch r.ConflictPolicy {
+	case "", ReplicatorConflictPolicySkip, ReplicatorConflictPolicyOverwrite:
+	default:
+		return fmt.Errorf("replicator conflict policy %q is not valid", r.ConflictPolicy)
+	}
+
+	if r.Namespace != "" {
+		return errors.New("replicators are not namespaced")
+	}
+
+	return nil
+}
+
+// Replicates returns whether the resources of the given RBAC name are
+// replicated.
+func (r *Replicator) Replicates(resource string) bool {
+	for _, name := range r.Resources {
+		if name == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplicatesNamespace returns whether the resources of the given namespace
+// are replicated. The resources which are not namespaced are always
+// replicated.
+func (r *Replicator) ReplicatesNamespace(namespace string) bool {
+	if namespace == "" || len(r.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range r.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// NewReplicator creates a new Replicator.
+func NewReplicator(meta ObjectMeta) *Replicator {
+	return &Replicator{ObjectMeta: meta}
+}
+
+// FixtureReplicator returns a Replicator fixture for testing.
+func FixtureReplicator(name string) *Replicator {
+	return &Replicator{
+		ObjectMeta: NewObjectMeta(name, ""),
+		URL:        "https://127.0.0.1:8080",
+		APIKey:     "83abef1e-e7d7-4beb-91fc-79ad90084d5b",
+		Resources:  []string{ChecksResource, HandlersResource},
+	}
+}
+
+// ReplicatorFields returns a set of fields that represent that resource
+func ReplicatorFields(r Resource) map[string]string {
+	resource := r.(*Replicator)
+	return map[string]string{
+		"replicator.name":            resource.ObjectMeta.Name,
+		"replicator.conflict_policy": resource.ConflictPolicy,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (r *Replicator) SetNamespace(namespace string) {
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (r *Replicator) SetObjectMeta(meta ObjectMeta) {
+	r.ObjectMeta = meta
+}
+
+func (r *Replicator) RBACName() string {
+	return ReplicatorsResource
+}
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: replicator.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A Replicator replicates resources of the cluster to a remote cluster, over
+// the API of the remote cluster, so that many clusters can be managed from a
+// single one. The replicated resources are annotated with the name of the
+// replicator, and are updated or deleted on the remote cluster along with the
+// resources of the cluster.
+type Replicator struct {
+	// Metadata contains the name, labels and annotations of the replicator
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// URL is the URL of the API of the remote cluster.
+	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// APIKey is the API key the replicator authenticates with to the remote
+	// cluster. It is never returned by the API.
+	APIKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key"`
+	// CABundle is the PEM-encoded CA certificates used to verify the
+	// certificate of the remote cluster, instead of the system ones.
+	CABundle string `protobuf:"bytes,4,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`
+	// InsecureSkipTLSVerify skips the verification of the certificate of the
+	// remote cluster.
+	InsecureSkipTLSVerify bool `protobuf:"varint,5,opt,name=insecure_skip_tls_verify,json=insecureSkipTlsVerify,proto3" json:"insecure_skip_tls_verify,omitempty"`
+	// Resources are the resource types replicated, as named in the RBAC rules
+	// (e.g. "checks").
+	Resources []string `protobuf:"bytes,6,rep,name=resources,proto3" json:"resources"`
+	// Namespaces restricts the replicated resources to these namespaces. The
+	// resources of every namespace are replicated if empty.
+	Namespaces []string `protobuf:"bytes,7,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// ConflictPolicy is either "skip" to keep the resources of the remote
+	// cluster which were not replicated, or "overwrite" to replace them.
+	// Defaults to "skip".
+	ConflictPolicy       string   `protobuf:"bytes,8,opt,name=conflict_policy,json=conflictPolicy,proto3" json:"conflict_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Replicator) Reset()         { *m = Replicator{} }
+func (m *Replicator) String() string { return proto.CompactTextString(m) }
+func (*Replicator) ProtoMessage()    {}
+func (*Replicator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e741e2c5586b74b6, []int{0}
+}
+func (m *Replicator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replicator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replicator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Replicator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replicator.Merge(m, src)
+}
+func (m *Replicator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replicator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replicator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replicator proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Replicator)(nil), "sensu.core.v2.Replicator")
+}
+
+func init() { proto.RegisterFile("replicator.proto", fileDescriptor_e741e2c5586b74b6) }
+
+var fileDescriptor_e741e2c5586b74b6 = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0x75, 0xb4, 0xa9, 0xd1, 0x00, 0x19, 0x2a, 0xa5, 0x93, 0x88, 0xa3, 0x71, 0xa9,
+	0x04, 0xca, 0xb4, 0x8e, 0x03, 0xe2, 0xc2, 0x16, 0x24, 0xa4, 0x69, 0x43, 0x4c, 0x19, 0xe3, 0xc0,
+	0x25, 0x72, 0x3c, 0xb7, 0x98, 0x26, 0x71, 0x94, 0x38, 0x15, 0x79, 0x03, 0x1e, 0x81, 0xe3, 0x24,
+	0x2e, 0x7b, 0x04, 0x1e, 0x61, 0xc7, 0x3d, 0x81, 0x05, 0xe6, 0x96, 0x27, 0xe0, 0x88, 0xea, 0xd2,
+	0x36, 0x20, 0x71, 0xfb, 0xeb, 0xfb, 0x7e, 0xdf, 0xf7, 0x45, 0x91, 0xe1, 0xbd, 0x9c, 0x65, 0x31,
+	0xa7, 0x44, 0x8a, 0xdc, 0xcb, 0x72, 0x21, 0x05, 0xda, 0x2a, 0x58, 0x5a, 0x94, 0x1e, 0x15, 0x39,
+	0xf3, 0x66, 0xa3, 0xed, 0xa7, 0x13, 0x2e, 0x3f, 0x94, 0x91, 0x47, 0x45, 0xb2, 0x3b, 0x11, 0x13,
+	0xb1, 0x6b, 0xa8, 0xa8, 0x1c, 0x1f, 0xcc, 0xf6, 0xbc, 0x7d, 0x6f, 0xcf, 0x88, 0x46, 0x33, 0xd7,
+	0xa2, 0x64, 0x1b, 0x26, 0x4c, 0x92, 0xc5, 0xbd, 0xf3, 0x75, 0x13, 0xc2, 0x60, 0xb5, 0x82, 0xce,
+	0xa1, 0x35, 0x37, 0x2f, 0x88, 0x24, 0x36, 0x70, 0xc1, 0xf0, 0xf6, 0x68, 0xe0, 0xfd, 0x35, 0xe9,
+	0xbd, 0x89, 0x3e, 0x32, 0x2a, 0x5f, 0x33, 0x49, 0x7c, 0xe7, 0x5a, 0xe1, 0xd6, 0x8d, 0xc2, 0xa0,
+	0x56, 0x18, 0x2d, 0x63, 0x4f, 0x44, 0xc2, 0x25, 0x4b, 0x32, 0x59, 0x05, 0xab, 0x2a, 0x34, 0x80,
+	0xed, 0x32, 0x8f, 0xed, 0x0d, 0x17, 0x0c, 0x7b, 0x7e, 0x57, 0x2b, 0xdc, 0x3e, 0x0f, 0x4e, 0x82,
+	0xb9, 0x86, 0x3c, 0xd8, 0x25, 0x19, 0x0f, 0xa7, 0xac, 0xb2, 0xdb, 0xc6, 0xee, 0x6b, 0x85, 0x3b,
+	0x87, 0xa7, 0x47, 0xc7, 0xac, 0xaa, 0x15, 0x5e, 0x9a, 0x41, 0x87, 0x64, 0xfc, 0x98, 0x55, 0xe8,
+	0x00, 0xf6, 0x28, 0x09, 0xa3, 0x32, 0xbd, 0x88, 0x99, 0xbd, 0x69, 0x12, 0x8f, 0xb4, 0xc2, 0xd6,
+	0xcb, 0x43, 0xdf, 0x68, 0xb5, 0xc2, 0xf7, 0x57, 0x40, 0xf3, 0x63, 0x28, 0x59, 0x00, 0xe8, 0x13,
+	0xb4, 0x79, 0x5a, 0x30, 0x5a, 0xe6, 0x2c, 0x2c, 0xa6, 0x3c, 0x0b, 0x65, 0x5c, 0x84, 0x33, 0x96,
+	0xf3, 0x71, 0x65, 0xdf, 0x72, 0xc1, 0xd0, 0xf2, 0x5f, 0x68, 0x85, 0xfb, 0x47, 0x7f, 0x98, 0xb3,
+	0x29, 0xcf, 0xde, 0x9e, 0x9c, 0xbd, 0x33, 0x40, 0xad, 0xf0, 0xce, 0xff, 0xc2, 0x8d, 0xb1, 0x3e,
+	0x6f, 0x86, 0xe3, 0x62, 0x11, 0x46, 0x8f, 0x61, 0x2f, 0x67, 0x85, 0x28, 0x73, 0xca, 0x0a, 0xbb,
+	0xe3, 0xb6, 0x87, 0x3d, 0x7f, 0xab, 0x56, 0x78, 0x2d, 0x06, 0xeb, 0x13, 0x3d, 0x83, 0x30, 0x25,
+	0x09, 0x2b, 0x32, 0x32, 0xa7, 0xbb, 0x86, 0xb6, 0x6b, 0x85, 0x1f, 0xac, 0xd5, 0xc6, 0x62, 0x83,
+	0x45, 0xaf, 0xe0, 0x5d, 0x2a, 0xd2, 0x71, 0xcc, 0xa9, 0x0c, 0x33, 0x11, 0x73, 0x5a, 0xd9, 0x96,
+	0xf9, 0x51, 0x0f, 0x6b, 0x85, 0x07, 0xff, 0x58, 0x8d, 0x8e, 0x3b, 0x4b, 0xeb, 0xd4, 0x38, 0xcf,
+	0xad, 0xcf, 0x97, 0xb8, 0x75, 0x75, 0x89, 0x81, 0xef, 0xfe, 0xfa, 0xe1, 0x80, 0x2b, 0xed, 0x80,
+	0x6f, 0xda, 0x01, 0xd7, 0xda, 0x01, 0x37, 0xda, 0x01, 0xdf, 0xb5, 0x03, 0xbe, 0xfc, 0x74, 0x5a,
+	0xef, 0x37, 0x66, 0xa3, 0xa8, 0x63, 0x9e, 0xd3, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed,
+	0x99, 0xaa, 0x16, 0xb3, 0x02, 0x00, 0x00,
+}
+
+func (this *Replicator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Replicator)
+	if !ok {
+		that2, ok := that.(Replicator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.APIKey != that1.APIKey {
+		return false
+	}
+	if this.CABundle != that1.CABundle {
+		return false
+	}
+	if this.InsecureSkipTLSVerify != that1.InsecureSkipTLSVerify {
+		return false
+	}
+	if len(this.Resources) != len(that1.Resources) {
+		return false
+	}
+	for i := range this.Resources {
+		if this.Resources[i] != that1.Resources[i] {
+			return false
+		}
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if this.ConflictPolicy != that1.ConflictPolicy {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type ReplicatorFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetURL() string
+	GetAPIKey() string
+	GetCABundle() string
+	GetInsecureSkipTLSVerify() bool
+	GetResources() []string
+	GetNamespaces() []string
+	GetConflictPolicy() string
+}
+
+func (this *Replicator) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *Replicator) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewReplicatorFromFace(this)
+}
+
+func (this *Replicator) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *Replicator) GetURL() string {
+	return this.URL
+}
+
+func (this *Replicator) GetAPIKey() string {
+	return this.APIKey
+}
+
+func (this *Replicator) GetCABundle() string {
+	return this.CABundle
+}
+
+func (this *Replicator) GetInsecureSkipTLSVerify() bool {
+	return this.InsecureSkipTLSVerify
+}
+
+func (this *Replicator) GetResources() []string {
+	return this.Resources
+}
+
+func (this *Replicator) GetNamespaces() []string {
+	return this.Namespaces
+}
+
+func (this *Replicator) GetConflictPolicy() string {
+	return this.ConflictPolicy
+}
+
+func NewReplicatorFromFace(that ReplicatorFace) *Replicator {
+	this := &Replicator{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.URL = that.GetURL()
+	this.APIKey = that.GetAPIKey()
+	this.CABundle = that.GetCABundle()
+	this.InsecureSkipTLSVerify = that.GetInsecureSkipTLSVerify()
+	this.Resources = that.GetResources()
+	this.Namespaces = that.GetNamespaces()
+	this.ConflictPolicy = that.GetConflictPolicy()
+	return this
+}
+
+func (m *Replicator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replicator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replicator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConflictPolicy) > 0 {
+		i -= len(m.ConflictPolicy)
+		copy(dAtA[i:], m.ConflictPolicy)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.ConflictPolicy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintReplicator(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintReplicator(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.InsecureSkipTLSVerify {
+		i--
+		if m.InsecureSkipTLSVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CABundle) > 0 {
+		i -= len(m.CABundle)
+		copy(dAtA[i:], m.CABundle)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.CABundle)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.APIKey) > 0 {
+		i -= len(m.APIKey)
+		copy(dAtA[i:], m.APIKey)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.APIKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReplicator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReplicator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReplicator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedReplicator(r randyReplicator, easy bool) *Replicator {
+	this := &Replicator{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.URL = string(randStringReplicator(r))
+	this.APIKey = string(randStringReplicator(r))
+	this.CABundle = string(randStringReplicator(r))
+	this.InsecureSkipTLSVerify = bool(bool(r.Intn(2) == 0))
+	v2 := r.Intn(10)
+	this.Resources = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Resources[i] = string(randStringReplicator(r))
+	}
+	v3 := r.Intn(10)
+	this.Namespaces = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Namespaces[i] = string(randStringReplicator(r))
+	}
+	this.ConflictPolicy = string(randStringReplicator(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedReplicator(r, 9)
+	}
+	return this
+}
+
+type randyReplicator interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneReplicator(r randyReplicator) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringReplicator(r randyReplicator) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RuneReplicator(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedReplicator(r randyReplicator, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldReplicator(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldReplicator(dAtA []byte, r randyReplicator, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateReplicator(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulateReplicator(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulateReplicator(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateReplicator(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateReplicator(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateReplicator(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateReplicator(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Replicator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovReplicator(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	l = len(m.APIKey)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	l = len(m.CABundle)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	if m.InsecureSkipTLSVerify {
+		n += 2
+	}
+	if len(m.Resources) > 0 {
+		for _, s := range m.Resources {
+			l = len(s)
+			n += 1 + l + sovReplicator(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovReplicator(uint64(l))
+		}
+	}
+	l = len(m.ConflictPolicy)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovReplicator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReplicator(x uint64) (n int) {
+	return sovReplicator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Replicator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplicator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Replicator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Replicator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CABundle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplicator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReplicator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReplicator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReplicator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReplicator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReplicator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReplicator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReplicator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReplicator = fmt.Errorf("proto: unexpected end of group")
+)
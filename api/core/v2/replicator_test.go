@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureReplicator(t *testing.T) {
+	fixture := FixtureReplicator("fixture")
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.NoError(t, fixture.Validate())
+}
+
+func TestReplicatorValidate(t *testing.T) {
+	var r Replicator
+
+	// Invalid name
+	assert.Error(t, r.Validate())
+	r.Name = "foo"
+
+	// Invalid url
+	assert.Error(t, r.Validate())
+	r.URL = "ftp://sensu.example.com"
+	assert.Error(t, r.Validate())
+	r.URL = "https://sensu.example.com:8080"
+
+	// Missing api key
+	assert.Error(t, r.Validate())
+	r.APIKey = "key"
+
+	// Invalid resources
+	assert.Error(t, r.Validate())
+	r.Resources = []string{ChecksResource, UsersResource}
+	assert.Error(t, r.Validate())
+	r.Resources = []string{ChecksResource, RolesResource}
+
+	// Invalid conflict policy
+	r.ConflictPolicy = "foo"
+	assert.Error(t, r.Validate())
+	r.ConflictPolicy = ReplicatorConflictPolicyOverwrite
+
+	// Invalid namespace
+	r.Namespace = "default"
+	assert.Error(t, r.Validate())
+	r.Namespace = ""
+
+	// Valid replicator
+	assert.NoError(t, r.Validate())
+}
+
+func TestReplicatorReplicates(t *testing.T) {
+	r := FixtureReplicator("foo")
+	assert.True(t, r.Replicates(ChecksResource))
+	assert.False(t, r.Replicates(AssetsResource))
+
+	assert.True(t, r.ReplicatesNamespace("default"))
+	r.Namespaces = []string{"acme"}
+	assert.False(t, r.ReplicatesNamespace("default"))
+	assert.True(t, r.ReplicatesNamespace("acme"))
+	assert.True(t, r.ReplicatesNamespace(""))
+}
+
+func TestReplicableResources(t *testing.T) {
+	for _, name := range ReplicableResources() {
+		resource := NewReplicableResource(name)
+		if assert.NotNil(t, resource, name) {
+			assert.Equal(t, name, resource.RBACName())
+		}
+	}
+	assert.Nil(t, NewReplicableResource(EventsResource))
+}
@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"errors"
+	"net/url"
+	"path"
+)
+
+const (
+	// ResourceQuotasResource is the name of this resource type
+	ResourceQuotasResource = "resource-quotas"
+)
+
+// ResourceUsage is the number of resources of a type in a namespace, along
+// with the limit of a resource quota, 0 meaning no limit.
+type ResourceUsage struct {
+	Used  int64  `json:"used"`
+	Limit uint32 `json:"limit"`
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (r *ResourceQuota) StorePrefix() string {
+	return ResourceQuotasResource
+}
+
+// URIPath returns the path component of a resource quota URI.
+func (r *ResourceQuota) URIPath() string {
+	if r.Namespace == "" {
+		return path.Join(URLPrefix, ResourceQuotasResource, url.PathEscape(r.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(r.Namespace), ResourceQuotasResource, url.PathEscape(r.Name))
+}
+
+// Validate returns an error if the resource quota does not pass validation
+// tests.
+func (r *ResourceQuota) Validate() error {
+	if err := ValidateName(r.Name); err != nil {
+		return errors.New("resource quota name " + err.Error())
+	}
+
+	if r.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+
+	return nil
+}
+
+// Limits returns the limits of the quota by resource type, for the resource
+// types it limits.
+func (r *ResourceQuota) Limits() map[string]uint32 {
+	limits := map[string]uint32{}
+	for resource, limit := range map[string]uint32{
+		ChecksResource:   r.Checks,
+		EntitiesResource: r.Entities,
+		HandlersResource: r.Handlers,
+		EventsResource:   r.Events,
+	} {
+		if limit > 0 {
+			limits[resource] = limit
+		}
+	}
+	return limits
+}
+
+// NewResourceQuota creates a new ResourceQuota.
+func NewResourceQuota(meta ObjectMeta) *ResourceQuota {
+	return &ResourceQuota{ObjectMeta: meta}
+}
+
+// FixtureResourceQuota returns a ResourceQuota fixture for testing.
+func FixtureResourceQuota(name string) *ResourceQuota {
+	return &ResourceQuota{
+		ObjectMeta: NewObjectMeta(name, "default"),
+		Checks:     100,
+		Entities:   1000,
+		Handlers:   10,
+	}
+}
+
+// ResourceQuotaFields returns a set of fields that represent that resource
+func ResourceQuotaFields(r Resource) map[string]string {
+	resource := r.(*ResourceQuota)
+	return map[string]string{
+		"resource_quota.name":      resource.ObjectMeta.Name,
+		"resource_quota.namespace": resource.ObjectMeta.Namespace,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (r *ResourceQuota) SetNamespace(namespace string) {
+	r.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (r *ResourceQuota) SetObjectMeta(meta ObjectMeta) {
+	r.ObjectMeta = meta
+}
+
+func (r *ResourceQuota) RBACName() string {
+	return "resource-quotas"
+}
@@ -0,0 +1,630 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: resource_quota.proto
+
+package v2
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A ResourceQuota limits the number of resources of its namespace created
+// through the API. The resources of a namespace must satisfy all of its
+// quotas. A limit of 0 means no limit.
+type ResourceQuota struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// resource quota
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Checks is the maximum number of checks of the namespace.
+	Checks uint32 `protobuf:"varint,2,opt,name=checks,proto3" json:"checks,omitempty"`
+	// Entities is the maximum number of entities of the namespace.
+	Entities uint32 `protobuf:"varint,3,opt,name=entities,proto3" json:"entities,omitempty"`
+	// Handlers is the maximum number of handlers of the namespace.
+	Handlers uint32 `protobuf:"varint,4,opt,name=handlers,proto3" json:"handlers,omitempty"`
+	// Events is the maximum number of events of the namespace.
+	Events               uint32   `protobuf:"varint,5,opt,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceQuota) Reset()         { *m = ResourceQuota{} }
+func (m *ResourceQuota) String() string { return proto.CompactTextString(m) }
+func (*ResourceQuota) ProtoMessage()    {}
+func (*ResourceQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f89d404064b740ce, []int{0}
+}
+func (m *ResourceQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceQuota.Merge(m, src)
+}
+func (m *ResourceQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceQuota proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ResourceQuota)(nil), "sensu.core.v2.ResourceQuota")
+}
+
+func init() { proto.RegisterFile("resource_quota.proto", fileDescriptor_f89d404064b740ce) }
+
+var fileDescriptor_f89d404064b740ce = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbf, 0x4e, 0xc2, 0x40,
+	0x1c, 0xc7, 0x39, 0x54, 0x42, 0xce, 0x90, 0x98, 0x86, 0x98, 0xca, 0x70, 0x47, 0x9c, 0x18, 0xc8,
+	0x11, 0x8a, 0x93, 0x93, 0x61, 0x37, 0x46, 0x12, 0x17, 0x17, 0xd3, 0x1e, 0x3f, 0x69, 0xd5, 0xf6,
+	0xb0, 0x77, 0x6d, 0xe2, 0x1b, 0xf8, 0x08, 0x8e, 0x8c, 0x3c, 0x80, 0x83, 0x8f, 0xc0, 0xc8, 0x13,
+	0x34, 0x5a, 0x37, 0x9e, 0xc0, 0xd1, 0xf4, 0xca, 0x49, 0xdd, 0x2e, 0x9f, 0xfb, 0xfe, 0xcb, 0x0f,
+	0xb7, 0x63, 0x90, 0x22, 0x89, 0x39, 0xdc, 0x3d, 0x27, 0x42, 0xb9, 0x6c, 0x1e, 0x0b, 0x25, 0xac,
+	0x96, 0x84, 0x48, 0x26, 0x8c, 0x8b, 0x18, 0x58, 0xea, 0x74, 0xce, 0x66, 0x81, 0xf2, 0x13, 0x8f,
+	0x71, 0x11, 0x0e, 0x66, 0x62, 0x26, 0x06, 0x5a, 0xe5, 0x25, 0xf7, 0x17, 0xe9, 0x90, 0x8d, 0xd8,
+	0x50, 0x43, 0xcd, 0xf4, 0xab, 0x0c, 0xe9, 0xe0, 0x10, 0x4c, 0xe0, 0xe9, 0x7b, 0x1d, 0xb7, 0x26,
+	0xdb, 0xa6, 0xeb, 0xa2, 0xc8, 0xba, 0xc1, 0xcd, 0xe2, 0x7f, 0xea, 0x2a, 0xd7, 0x46, 0x5d, 0xd4,
+	0x3b, 0x74, 0x4e, 0xd8, 0xbf, 0x56, 0x76, 0xe5, 0x3d, 0x00, 0x57, 0x97, 0xa0, 0xdc, 0x31, 0x59,
+	0x65, 0xb4, 0xb6, 0xce, 0x28, 0xda, 0x64, 0xd4, 0x32, 0xb6, 0xbe, 0x08, 0x03, 0x05, 0xe1, 0x5c,
+	0xbd, 0x4c, 0xfe, 0xa2, 0xac, 0x3e, 0x6e, 0x70, 0x1f, 0xf8, 0xa3, 0xb4, 0xeb, 0x5d, 0xd4, 0x6b,
+	0x8d, 0xdb, 0x9b, 0x8c, 0x1e, 0x95, 0xa4, 0xa2, 0xdf, 0x6a, 0x2c, 0x07, 0x37, 0x21, 0x52, 0x81,
+	0x0a, 0x40, 0xda, 0x7b, 0x5a, 0x7f, 0x5c, 0x34, 0x18, 0x56, 0x6d, 0x30, 0xac, 0xf0, 0xf8, 0x6e,
+	0x34, 0x7d, 0x82, 0x58, 0xda, 0xfb, 0x3b, 0x8f, 0x61, 0x55, 0x8f, 0x61, 0xc5, 0x2a, 0x48, 0x21,
+	0x52, 0xd2, 0x3e, 0xd8, 0xad, 0x2a, 0x49, 0x75, 0x55, 0x49, 0xce, 0x9b, 0xaf, 0x0b, 0x5a, 0x5b,
+	0x2e, 0x28, 0x1a, 0x77, 0x7f, 0xbe, 0x08, 0x5a, 0xe6, 0x04, 0x7d, 0xe4, 0x04, 0xad, 0x72, 0x82,
+	0xd6, 0x39, 0x41, 0x9f, 0x39, 0x41, 0x6f, 0xdf, 0xa4, 0x76, 0x5b, 0x4f, 0x1d, 0xaf, 0xa1, 0xef,
+	0x3b, 0xfa, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x50, 0xa6, 0x48, 0xc8, 0x01, 0x00, 0x00,
+}
+
+func (this *ResourceQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResourceQuota)
+	if !ok {
+		that2, ok := that.(ResourceQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Checks != that1.Checks {
+		return false
+	}
+	if this.Entities != that1.Entities {
+		return false
+	}
+	if this.Handlers != that1.Handlers {
+		return false
+	}
+	if this.Events != that1.Events {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+type ResourceQuotaFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetChecks() uint32
+	GetEntities() uint32
+	GetHandlers() uint32
+	GetEvents() uint32
+}
+
+func (this *ResourceQuota) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *ResourceQuota) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewResourceQuotaFromFace(this)
+}
+
+func (this *ResourceQuota) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *ResourceQuota) GetChecks() uint32 {
+	return this.Checks
+}
+
+func (this *ResourceQuota) GetEntities() uint32 {
+	return this.Entities
+}
+
+func (this *ResourceQuota) GetHandlers() uint32 {
+	return this.Handlers
+}
+
+func (this *ResourceQuota) GetEvents() uint32 {
+	return this.Events
+}
+
+func NewResourceQuotaFromFace(that ResourceQuotaFace) *ResourceQuota {
+	this := &ResourceQuota{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Checks = that.GetChecks()
+	this.Entities = that.GetEntities()
+	this.Handlers = that.GetHandlers()
+	this.Events = that.GetEvents()
+	return this
+}
+
+func (m *ResourceQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Events != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.Events))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Handlers != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.Handlers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Entities != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.Entities))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Checks != 0 {
+		i = encodeVarintResourceQuota(dAtA, i, uint64(m.Checks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintResourceQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResourceQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResourceQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedResourceQuota(r randyResourceQuota, easy bool) *ResourceQuota {
+	this := &ResourceQuota{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Checks = uint32(r.Uint32())
+	this.Entities = uint32(r.Uint32())
+	this.Handlers = uint32(r.Uint32())
+	this.Events = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedResourceQuota(r, 6)
+	}
+	return this
+}
+
+type randyResourceQuota interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneResourceQuota(r randyResourceQuota) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringResourceQuota(r randyResourceQuota) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneResourceQuota(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedResourceQuota(r randyResourceQuota, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldResourceQuota(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldResourceQuota(dAtA []byte, r randyResourceQuota, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateResourceQuota(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateResourceQuota(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ResourceQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovResourceQuota(uint64(l))
+	if m.Checks != 0 {
+		n += 1 + sovResourceQuota(uint64(m.Checks))
+	}
+	if m.Entities != 0 {
+		n += 1 + sovResourceQuota(uint64(m.Entities))
+	}
+	if m.Handlers != 0 {
+		n += 1 + sovResourceQuota(uint64(m.Handlers))
+	}
+	if m.Events != 0 {
+		n += 1 + sovResourceQuota(uint64(m.Events))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovResourceQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozResourceQuota(x uint64) (n int) {
+	return sovResourceQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ResourceQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResourceQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			m.Checks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			m.Entities = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entities |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handlers", wireType)
+			}
+			m.Handlers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Handlers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			m.Events = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Events |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResourceQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthResourceQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipResourceQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowResourceQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowResourceQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthResourceQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupResourceQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthResourceQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthResourceQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowResourceQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupResourceQuota = fmt.Errorf("proto: unexpected end of group")
+)
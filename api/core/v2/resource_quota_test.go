@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceQuotaValidate(t *testing.T) {
+	r := FixtureResourceQuota("foo")
+	assert.NoError(t, r.Validate())
+
+	r.Name = ""
+	assert.Error(t, r.Validate())
+
+	r = FixtureResourceQuota("foo")
+	r.Namespace = ""
+	assert.Error(t, r.Validate())
+}
+
+func TestResourceQuotaLimits(t *testing.T) {
+	r := FixtureResourceQuota("foo")
+	assert.Equal(t, map[string]uint32{
+		ChecksResource:   100,
+		EntitiesResource: 1000,
+		HandlersResource: 10,
+	}, r.Limits())
+}
@@ -0,0 +1,217 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+const (
+	// SecretsResource is the name of the secret resource type
+	SecretsResource = "secrets"
+
+	// SecretsProvidersResource is the name of the secrets provider resource
+	// type
+	SecretsProvidersResource = "secrets-providers"
+
+	// SecretsProviderTypeEnv is the type of the providers resolving the
+	// secrets from the environment variables of the backend.
+	SecretsProviderTypeEnv = "env"
+
+	// SecretsProviderTypeVault is the type of the providers resolving the
+	// secrets from HashiCorp Vault.
+	SecretsProviderTypeVault = "vault"
+)
+
+// Validate returns an error if the secret reference does not pass validation
+// tests.
+func (s *Secret) Validate() error {
+	if s.Name == "" {
+		return errors.New("secret name must be set")
+	}
+	if s.Secret == "" {
+		return fmt.Errorf("secret %q must reference a secret", s.Name)
+	}
+	return nil
+}
+
+// ValidateSecrets returns an error if any of the given secret references does
+// not pass validation tests, or if two of them have the same name.
+func ValidateSecrets(secrets []*Secret) error {
+	names := map[string]bool{}
+	for _, secret := range secrets {
+		if secret == nil {
+			return errors.New("secret must not be null")
+		}
+		if err := secret.Validate(); err != nil {
+			return err
+		}
+		if names[secret.Name] {
+			return fmt.Errorf("secret %q is referenced more than once", secret.Name)
+		}
+		names[secret.Name] = true
+	}
+	return nil
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (s *SecretConfig) StorePrefix() string {
+	return SecretsResource
+}
+
+// URIPath returns the path component of a secret URI.
+func (s *SecretConfig) URIPath() string {
+	if s.Namespace == "" {
+		return path.Join(URLPrefix, SecretsResource, url.PathEscape(s.Name))
+	}
+	return path.Join(URLPrefix, "namespaces", url.PathEscape(s.Namespace), SecretsResource, url.PathEscape(s.Name))
+}
+
+// Validate returns an error if the secret does not pass validation tests.
+func (s *SecretConfig) Validate() error {
+	if err := ValidateName(s.Name); err != nil {
+		return errors.New("secret name " + err.Error())
+	}
+	if s.Namespace == "" {
+		return errors.New("namespace must be set")
+	}
+	if s.Provider == "" {
+		return errors.New("secret provider must be set")
+	}
+	if s.ID == "" {
+		return errors.New("secret id must be set")
+	}
+	return nil
+}
+
+// NewSecretConfig creates a new SecretConfig.
+func NewSecretConfig(meta ObjectMeta) *SecretConfig {
+	return &SecretConfig{ObjectMeta: meta}
+}
+
+// FixtureSecretConfig returns a SecretConfig fixture for testing.
+func FixtureSecretConfig(name string) *SecretConfig {
+	return &SecretConfig{
+		ObjectMeta: NewObjectMeta(name, "default"),
+		Provider:   SecretsProviderTypeEnv,
+		ID:         "SENSU_SECRET",
+	}
+}
+
+// SecretConfigFields returns a set of fields that represent that resource
+func SecretConfigFields(r Resource) map[string]string {
+	resource := r.(*SecretConfig)
+	return map[string]string{
+		"secret.name":      resource.ObjectMeta.Name,
+		"secret.namespace": resource.ObjectMeta.Namespace,
+		"secret.provider":  resource.Provider,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (s *SecretConfig) SetNamespace(namespace string) {
+	s.Namespace = namespace
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (s *SecretConfig) SetObjectMeta(meta ObjectMeta) {
+	s.ObjectMeta = meta
+}
+
+func (s *SecretConfig) RBACName() string {
+	return SecretsResource
+}
+
+// StorePrefix returns the path prefix to this resource in the store
+func (p *SecretsProvider) StorePrefix() string {
+	return SecretsProvidersResource
+}
+
+// URIPath returns the path component of a secrets provider URI.
+func (p *SecretsProvider) URIPath() string {
+	return path.Join(URLPrefix, SecretsProvidersResource, url.PathEscape(p.Name))
+}
+
+// Validate returns an error if the secrets provider does not pass validation
+// tests.
+func (p *SecretsProvider) Validate() error {
+	if err := ValidateName(p.Name); err != nil {
+		return errors.New("secrets provider name " + err.Error())
+	}
+
+	switch p.Type {
+	case SecretsProviderTypeEnv:
+		if p.Vault != nil {
+			return errors.New("env secrets providers can't have a vault configuration")
+		}
+	case SecretsProviderTypeVault:
+		if p.Vault == nil {
+			return errors.New("vault secrets providers must have a vault configuration")
+		}
+		if err := p.Vault.Validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("secrets provider type %q is not supported", p.Type)
+	}
+
+	if p.Namespace != "" {
+		return errors.New("secrets providers are not namespaced")
+	}
+
+	return nil
+}
+
+// NewSecretsProvider creates a new SecretsProvider.
+func NewSecretsProvider(meta ObjectMeta) *SecretsProvider {
+	return &SecretsProvider{ObjectMeta: meta}
+}
+
+// FixtureSecretsProvider returns a SecretsProvider fixture for testing.
+func FixtureSecretsProvider(name string) *SecretsProvider {
+	return &SecretsProvider{
+		ObjectMeta: NewObjectMeta(name, ""),
+		Type:       SecretsProviderTypeVault,
+		Vault: &VaultSecretsProviderConfig{
+			Address: "https://vault.example.com:8200",
+		},
+	}
+}
+
+// SecretsProviderFields returns a set of fields that represent that resource
+func SecretsProviderFields(r Resource) map[string]string {
+	resource := r.(*SecretsProvider)
+	return map[string]string{
+		"secrets_provider.name": resource.ObjectMeta.Name,
+		"secrets_provider.type": resource.Type,
+	}
+}
+
+// SetNamespace sets the namespace of the resource.
+func (p *SecretsProvider) SetNamespace(namespace string) {
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (p *SecretsProvider) SetObjectMeta(meta ObjectMeta) {
+	p.ObjectMeta = meta
+}
+
+func (p *SecretsProvider) RBACName() string {
+	return SecretsProvidersResource
+}
+
+// Validate returns an error if the vault configuration does not pass
+// validation tests.
+func (c *VaultSecretsProviderConfig) Validate() error {
+	u, err := url.Parse(c.Address)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("vault address %q must be an absolute URL", c.Address)
+	}
+	switch c.Version {
+	case "", "v1", "v2":
+	default:
+		return fmt.Errorf("vault key/value secrets engine version %q is not supported", c.Version)
+	}
+	return nil
+}
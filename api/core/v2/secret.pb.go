@@ -69,26 +69,203 @@ func (m *Secret) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Secret proto.InternalMessageInfo
 
+// A SecretConfig defines a Sensu secret, which the checks, handlers and
+// mutators reference by name, as an identifier in a secrets provider. The
+// value of the secret is resolved at execution time and never stored.
+type SecretConfig struct {
+	// Metadata contains the name, namespace, labels and annotations of the
+	// secret
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Provider is the name of the secrets provider holding the secret.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	// ID identifies the secret in its provider, e.g. the name of an environment
+	// variable of the backend, or "path#key" for Vault.
+	ID                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecretConfig) Reset()         { *m = SecretConfig{} }
+func (m *SecretConfig) String() string { return proto.CompactTextString(m) }
+func (*SecretConfig) ProtoMessage()    {}
+func (*SecretConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6acf428160d7a216, []int{1}
+}
+func (m *SecretConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretConfig.Merge(m, src)
+}
+func (m *SecretConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretConfig proto.InternalMessageInfo
+
+// A SecretsProvider configures a provider resolving the values of the
+// secrets.
+type SecretsProvider struct {
+	// Metadata contains the name, labels and annotations of the secrets
+	// provider
+	ObjectMeta `protobuf:"bytes,1,opt,name=metadata,proto3,embedded=metadata" json:"metadata,omitempty"`
+	// Type is either "env", resolving the secrets from the environment
+	// variables of the backend, or "vault", resolving them from HashiCorp Vault.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	// Vault configures the "vault" providers.
+	Vault                *VaultSecretsProviderConfig `protobuf:"bytes,3,opt,name=vault,proto3" json:"vault,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *SecretsProvider) Reset()         { *m = SecretsProvider{} }
+func (m *SecretsProvider) String() string { return proto.CompactTextString(m) }
+func (*SecretsProvider) ProtoMessage()    {}
+func (*SecretsProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6acf428160d7a216, []int{2}
+}
+func (m *SecretsProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretsProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretsProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretsProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretsProvider.Merge(m, src)
+}
+func (m *SecretsProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretsProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretsProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretsProvider proto.InternalMessageInfo
+
+// VaultSecretsProviderConfig configures a secrets provider backed by a
+// key/value secrets engine of HashiCorp Vault.
+type VaultSecretsProviderConfig struct {
+	// Address is the URL of the Vault server, e.g. "https://vault:8200".
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	// Token is the Vault token of the backend. The VAULT_TOKEN environment
+	// variable of the backend is used if it is empty.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Version is the version of the key/value secrets engine, "v1" or "v2".
+	// Defaults to "v2".
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Timeout is the timeout of the requests to Vault, in seconds. Defaults to
+	// 10.
+	Timeout uint32 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// TLS configures the client certificate and the CA of the connections to
+	// Vault.
+	TLS                  *TLSOptions `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *VaultSecretsProviderConfig) Reset()         { *m = VaultSecretsProviderConfig{} }
+func (m *VaultSecretsProviderConfig) String() string { return proto.CompactTextString(m) }
+func (*VaultSecretsProviderConfig) ProtoMessage()    {}
+func (*VaultSecretsProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6acf428160d7a216, []int{3}
+}
+func (m *VaultSecretsProviderConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSecretsProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultSecretsProviderConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultSecretsProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSecretsProviderConfig.Merge(m, src)
+}
+func (m *VaultSecretsProviderConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSecretsProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSecretsProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSecretsProviderConfig proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Secret)(nil), "sensu.core.v2.Secret")
+	proto.RegisterType((*SecretConfig)(nil), "sensu.core.v2.SecretConfig")
+	proto.RegisterType((*SecretsProvider)(nil), "sensu.core.v2.SecretsProvider")
+	proto.RegisterType((*VaultSecretsProviderConfig)(nil), "sensu.core.v2.VaultSecretsProviderConfig")
 }
 
 func init() { proto.RegisterFile("secret.proto", fileDescriptor_6acf428160d7a216) }
 
 var fileDescriptor_6acf428160d7a216 = []byte{
-	// 185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x4e, 0x4d, 0x2e,
-	0x4a, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2d, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5,
-	0x4b, 0xce, 0x2f, 0x4a, 0xd5, 0x2b, 0x33, 0x92, 0x32, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x4a, 0x2a, 0x4d, 0x73, 0x28,
-	0x33, 0xd4, 0x33, 0xd6, 0x33, 0x04, 0x0b, 0x82, 0xc5, 0xc0, 0x2c, 0x88, 0x21, 0x52, 0x5c, 0xb9,
-	0xa9, 0x25, 0x89, 0x10, 0xb6, 0x92, 0x1d, 0x17, 0x5b, 0x30, 0xd8, 0x02, 0x21, 0x21, 0x2e, 0x96,
-	0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x48, 0x8c, 0x8b,
-	0x0d, 0x62, 0xbd, 0x04, 0x13, 0x58, 0x14, 0xca, 0xb3, 0xe2, 0xe8, 0x58, 0x20, 0xcf, 0xb0, 0x62,
-	0x81, 0x3c, 0xa3, 0x93, 0xc2, 0x8f, 0x87, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0xee, 0x78, 0x24,
-	0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0xce, 0x78,
-	0x2c, 0xc7, 0x10, 0xc5, 0x54, 0x66, 0x94, 0xc4, 0x06, 0xb6, 0xc8, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x00, 0x42, 0xf3, 0x43, 0xc9, 0x00, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xae, 0xd3, 0x3f, 0xeb, 0xdc, 0x56, 0x13, 0x46, 0xa0, 0x50, 0x4d, 0x71, 0x35, 0x09, 0xa9,
+	0x93, 0x50, 0xaa, 0x75, 0x9c, 0x38, 0x20, 0x14, 0xe0, 0x80, 0x34, 0x34, 0x94, 0x16, 0x0e, 0xdc,
+	0xd2, 0xc6, 0x2b, 0x86, 0x26, 0x8e, 0x62, 0x27, 0xd2, 0xde, 0x80, 0x47, 0xe0, 0x38, 0x6e, 0x7b,
+	0x03, 0x78, 0x84, 0x1d, 0xf7, 0x04, 0x16, 0x04, 0x89, 0x43, 0x9e, 0x80, 0x23, 0x8a, 0x9d, 0x94,
+	0x50, 0xc1, 0x71, 0x97, 0xea, 0xf3, 0xd7, 0xdf, 0xf7, 0xc7, 0xfe, 0x29, 0xb0, 0xcf, 0xc9, 0x32,
+	0x26, 0xc2, 0x8e, 0x62, 0x26, 0x18, 0x1a, 0x70, 0x12, 0xf2, 0xc4, 0x5e, 0xb2, 0x98, 0xd8, 0xe9,
+	0x74, 0xf8, 0x70, 0x45, 0xc5, 0xbb, 0x64, 0x61, 0x2f, 0x59, 0x30, 0x59, 0xb1, 0x15, 0x9b, 0xa8,
+	0xa9, 0x45, 0x72, 0xf6, 0x24, 0x3d, 0xb2, 0x8f, 0xed, 0x23, 0x45, 0x2a, 0x4e, 0x21, 0x6d, 0x32,
+	0x84, 0x01, 0x11, 0x5e, 0x89, 0x77, 0xc5, 0x9a, 0x6b, 0x78, 0xf0, 0x18, 0x76, 0x66, 0x2a, 0x0b,
+	0x21, 0xd8, 0x0a, 0xbd, 0x80, 0x98, 0x60, 0x04, 0xc6, 0xbb, 0xae, 0xc2, 0xe8, 0x2e, 0xec, 0xe8,
+	0x26, 0xa6, 0xa1, 0xd8, 0xf2, 0xf4, 0xa8, 0xfb, 0xf1, 0x02, 0x37, 0x2e, 0x2f, 0x30, 0x38, 0xf8,
+	0x02, 0x60, 0x5f, 0x1b, 0x3c, 0x65, 0xe1, 0x19, 0x5d, 0xa1, 0xd7, 0xb0, 0x5b, 0x24, 0xf9, 0x9e,
+	0xf0, 0x94, 0x55, 0x6f, 0x7a, 0xcf, 0xfe, 0xab, 0xbf, 0x7d, 0xba, 0x78, 0x4f, 0x96, 0xe2, 0x25,
+	0x11, 0x9e, 0x63, 0x5d, 0x49, 0xdc, 0xb8, 0x96, 0x18, 0xe4, 0x12, 0xa3, 0x4a, 0xf6, 0x80, 0x05,
+	0x54, 0x90, 0x20, 0x12, 0xe7, 0xee, 0xc6, 0x0a, 0x8d, 0x61, 0x37, 0x8a, 0x59, 0x4a, 0x7d, 0x12,
+	0xeb, 0x2e, 0x4e, 0x3f, 0x97, 0x78, 0xc3, 0xb9, 0x1b, 0x84, 0xf6, 0xa1, 0x41, 0x7d, 0xb3, 0xa9,
+	0x67, 0x32, 0x89, 0x8d, 0x17, 0xcf, 0x72, 0x89, 0x0d, 0xea, 0xbb, 0x06, 0xf5, 0x6b, 0xcd, 0x7f,
+	0x02, 0xb8, 0xa7, 0x9b, 0xf3, 0x57, 0x95, 0xf6, 0x86, 0xca, 0xef, 0xc3, 0x96, 0x38, 0x8f, 0x48,
+	0x59, 0xbc, 0x9b, 0x4b, 0xac, 0xce, 0xae, 0xfa, 0x45, 0x73, 0xd8, 0x4e, 0xbd, 0x64, 0x2d, 0x54,
+	0xe7, 0xde, 0xf4, 0x70, 0x2b, 0xf1, 0x4d, 0xf1, 0xdf, 0x56, 0x51, 0xfd, 0xd6, 0xce, 0xed, 0x5c,
+	0xe2, 0x3d, 0xa5, 0xad, 0xc5, 0x6a, 0xb3, 0xda, 0x45, 0x3f, 0x1b, 0x70, 0xf8, 0x7f, 0x13, 0x74,
+	0x1f, 0xee, 0x78, 0xbe, 0x1f, 0x13, 0xce, 0xf5, 0xea, 0x9d, 0x5e, 0x2e, 0x71, 0x45, 0xb9, 0x15,
+	0x40, 0x87, 0xb0, 0x2d, 0xd8, 0x07, 0x12, 0x96, 0x97, 0x50, 0xd1, 0x8a, 0xa8, 0x47, 0x2b, 0x02,
+	0x4d, 0xe0, 0x4e, 0x4a, 0x62, 0x4e, 0x59, 0x58, 0xae, 0xe1, 0x4e, 0x2e, 0xf1, 0xad, 0x92, 0xaa,
+	0x8d, 0x57, 0x53, 0x85, 0x40, 0xd0, 0x80, 0xb0, 0x44, 0x98, 0xad, 0x11, 0x18, 0x0f, 0xb4, 0xa0,
+	0xa4, 0xea, 0x82, 0x92, 0x42, 0xcf, 0x61, 0x53, 0xac, 0xb9, 0xd9, 0xfe, 0xe7, 0x8a, 0xe6, 0x27,
+	0xb3, 0xd3, 0x48, 0x50, 0x16, 0x72, 0xc7, 0xcc, 0x24, 0x6e, 0xce, 0x4f, 0x66, 0xb9, 0xc4, 0x03,
+	0xb1, 0xe6, 0x35, 0xab, 0x42, 0xff, 0xe7, 0x8d, 0x9c, 0xd1, 0xaf, 0xef, 0x16, 0xb8, 0xcc, 0x2c,
+	0xf0, 0x35, 0xb3, 0xc0, 0x55, 0x66, 0x81, 0xeb, 0xcc, 0x02, 0xdf, 0x32, 0x0b, 0x7c, 0xfa, 0x61,
+	0x35, 0xde, 0x1a, 0xe9, 0x74, 0xd1, 0x51, 0xdf, 0xcb, 0xf1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x15, 0xd8, 0xbc, 0x69, 0x9b, 0x03, 0x00, 0x00,
 }
 
 func (this *Secret) Equal(that interface{}) bool {
@@ -121,6 +298,111 @@ func (this *Secret) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SecretConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SecretConfig)
+	if !ok {
+		that2, ok := that.(SecretConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Provider != that1.Provider {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SecretsProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SecretsProvider)
+	if !ok {
+		that2, ok := that.(SecretsProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ObjectMeta.Equal(&that1.ObjectMeta) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.Vault.Equal(that1.Vault) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VaultSecretsProviderConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VaultSecretsProviderConfig)
+	if !ok {
+		that2, ok := that.(VaultSecretsProviderConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if !this.TLS.Equal(that1.TLS) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 
 type SecretFace interface {
 	Proto() github_com_golang_protobuf_proto.Message
@@ -151,6 +433,123 @@ func NewSecretFromFace(that SecretFace) *Secret {
 	return this
 }
 
+type SecretConfigFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetProvider() string
+	GetID() string
+}
+
+func (this *SecretConfig) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *SecretConfig) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewSecretConfigFromFace(this)
+}
+
+func (this *SecretConfig) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *SecretConfig) GetProvider() string {
+	return this.Provider
+}
+
+func (this *SecretConfig) GetID() string {
+	return this.ID
+}
+
+func NewSecretConfigFromFace(that SecretConfigFace) *SecretConfig {
+	this := &SecretConfig{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Provider = that.GetProvider()
+	this.ID = that.GetID()
+	return this
+}
+
+type SecretsProviderFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetObjectMeta() ObjectMeta
+	GetType() string
+	GetVault() *VaultSecretsProviderConfig
+}
+
+func (this *SecretsProvider) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *SecretsProvider) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewSecretsProviderFromFace(this)
+}
+
+func (this *SecretsProvider) GetObjectMeta() ObjectMeta {
+	return this.ObjectMeta
+}
+
+func (this *SecretsProvider) GetType() string {
+	return this.Type
+}
+
+func (this *SecretsProvider) GetVault() *VaultSecretsProviderConfig {
+	return this.Vault
+}
+
+func NewSecretsProviderFromFace(that SecretsProviderFace) *SecretsProvider {
+	this := &SecretsProvider{}
+	this.ObjectMeta = that.GetObjectMeta()
+	this.Type = that.GetType()
+	this.Vault = that.GetVault()
+	return this
+}
+
+type VaultSecretsProviderConfigFace interface {
+	Proto() github_com_golang_protobuf_proto.Message
+	GetAddress() string
+	GetToken() string
+	GetVersion() string
+	GetTimeout() uint32
+	GetTLS() *TLSOptions
+}
+
+func (this *VaultSecretsProviderConfig) Proto() github_com_golang_protobuf_proto.Message {
+	return this
+}
+
+func (this *VaultSecretsProviderConfig) TestProto() github_com_golang_protobuf_proto.Message {
+	return NewVaultSecretsProviderConfigFromFace(this)
+}
+
+func (this *VaultSecretsProviderConfig) GetAddress() string {
+	return this.Address
+}
+
+func (this *VaultSecretsProviderConfig) GetToken() string {
+	return this.Token
+}
+
+func (this *VaultSecretsProviderConfig) GetVersion() string {
+	return this.Version
+}
+
+func (this *VaultSecretsProviderConfig) GetTimeout() uint32 {
+	return this.Timeout
+}
+
+func (this *VaultSecretsProviderConfig) GetTLS() *TLSOptions {
+	return this.TLS
+}
+
+func NewVaultSecretsProviderConfigFromFace(that VaultSecretsProviderConfigFace) *VaultSecretsProviderConfig {
+	this := &VaultSecretsProviderConfig{}
+	this.Address = that.GetAddress()
+	this.Token = that.GetToken()
+	this.Version = that.GetVersion()
+	this.Timeout = that.GetTimeout()
+	this.TLS = that.GetTLS()
+	return this
+}
+
 func (m *Secret) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -192,50 +591,263 @@ func (m *Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSecret(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSecret(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedSecret(r randySecret, easy bool) *Secret {
-	this := &Secret{}
-	this.Name = string(randStringSecret(r))
-	this.Secret = string(randStringSecret(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedSecret(r, 3)
+func (m *SecretConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randySecret interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *SecretConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneSecret(r randySecret) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func (m *SecretConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return rune(ru + 61)
-}
-func randStringSecret(r randySecret) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
-		tmps[i] = randUTF8RuneSecret(r)
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintSecret(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintSecret(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSecret(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretsProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretsProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretsProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Vault != nil {
+		{
+			size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecret(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSecret(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSecret(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultSecretsProviderConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSecretsProviderConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSecretsProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSecret(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintSecret(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintSecret(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintSecret(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSecret(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSecret(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSecret(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedSecret(r randySecret, easy bool) *Secret {
+	this := &Secret{}
+	this.Name = string(randStringSecret(r))
+	this.Secret = string(randStringSecret(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSecret(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedSecretConfig(r randySecret, easy bool) *SecretConfig {
+	this := &SecretConfig{}
+	v1 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v1
+	this.Provider = string(randStringSecret(r))
+	this.ID = string(randStringSecret(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSecret(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSecretsProvider(r randySecret, easy bool) *SecretsProvider {
+	this := &SecretsProvider{}
+	v2 := NewPopulatedObjectMeta(r, easy)
+	this.ObjectMeta = *v2
+	this.Type = string(randStringSecret(r))
+	if r.Intn(5) != 0 {
+		this.Vault = NewPopulatedVaultSecretsProviderConfig(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSecret(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedVaultSecretsProviderConfig(r randySecret, easy bool) *VaultSecretsProviderConfig {
+	this := &VaultSecretsProviderConfig{}
+	this.Address = string(randStringSecret(r))
+	this.Token = string(randStringSecret(r))
+	this.Version = string(randStringSecret(r))
+	this.Timeout = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.TLS = NewPopulatedTLSOptions(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedSecret(r, 6)
+	}
+	return this
+}
+
+type randySecret interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneSecret(r randySecret) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringSecret(r randySecret) string {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
+		tmps[i] = randUTF8RuneSecret(r)
 	}
 	return string(tmps)
 }
@@ -256,11 +868,11 @@ func randFieldSecret(dAtA []byte, r randySecret, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateSecret(dAtA, uint64(key))
-		v2 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateSecret(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateSecret(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateSecret(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -305,6 +917,81 @@ func (m *Secret) Size() (n int) {
 	return n
 }
 
+func (m *SecretConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovSecret(uint64(l))
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretsProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovSecret(uint64(l))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	if m.Vault != nil {
+		l = m.Vault.Size()
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VaultSecretsProviderConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovSecret(uint64(m.Timeout))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovSecret(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSecret(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -429,6 +1116,517 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SecretConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecret
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecret(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretsProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecret
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretsProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretsProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vault == nil {
+				m.Vault = &VaultSecretsProviderConfig{}
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecret(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultSecretsProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecret
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultSecretsProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultSecretsProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecret
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecret
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSOptions{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecret(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSecret
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSecret(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
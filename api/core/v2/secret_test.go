@@ -0,0 +1,68 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSecrets(t *testing.T) {
+	assert.NoError(t, ValidateSecrets(nil))
+	assert.NoError(t, ValidateSecrets([]*Secret{
+		{Name: "GITHUB_TOKEN", Secret: "github"},
+		{Name: "SLACK_WEBHOOK", Secret: "slack"},
+	}))
+	assert.Error(t, ValidateSecrets([]*Secret{{Name: "GITHUB_TOKEN"}}))
+	assert.Error(t, ValidateSecrets([]*Secret{{Secret: "github"}}))
+	assert.Error(t, ValidateSecrets([]*Secret{
+		{Name: "GITHUB_TOKEN", Secret: "github"},
+		{Name: "GITHUB_TOKEN", Secret: "github-ops"},
+	}))
+
+	handler := FixtureHandler("slack")
+	handler.Secrets = []*Secret{{Name: "SLACK_WEBHOOK"}}
+	assert.Error(t, handler.Validate())
+}
+
+func TestSecretConfigValidate(t *testing.T) {
+	secret := FixtureSecretConfig("github")
+	assert.NoError(t, secret.Validate())
+	assert.Equal(t, "/api/core/v2/namespaces/default/secrets/github", secret.URIPath())
+
+	secret.ID = ""
+	assert.Error(t, secret.Validate())
+
+	secret = FixtureSecretConfig("github")
+	secret.Provider = ""
+	assert.Error(t, secret.Validate())
+
+	secret = FixtureSecretConfig("github")
+	secret.Namespace = ""
+	assert.Error(t, secret.Validate())
+}
+
+func TestSecretsProviderValidate(t *testing.T) {
+	provider := FixtureSecretsProvider("vault")
+	assert.NoError(t, provider.Validate())
+	assert.Equal(t, "/api/core/v2/secrets-providers/vault", provider.URIPath())
+
+	provider.Vault.Version = "v3"
+	assert.Error(t, provider.Validate())
+
+	provider = FixtureSecretsProvider("vault")
+	provider.Vault.Address = "vault:8200"
+	assert.Error(t, provider.Validate())
+
+	provider = FixtureSecretsProvider("vault")
+	provider.Vault = nil
+	assert.Error(t, provider.Validate())
+
+	provider = FixtureSecretsProvider("env")
+	provider.Type = SecretsProviderTypeEnv
+	assert.Error(t, provider.Validate())
+	provider.Vault = nil
+	assert.NoError(t, provider.Validate())
+
+	provider.Type = "aws"
+	assert.Error(t, provider.Validate())
+}
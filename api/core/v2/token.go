@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	jwt "github.com/dgrijalva/jwt-go"
+	stringsutil "github.com/sensu/sensu-go/util/strings"
 )
 
 var (
@@ -19,6 +20,28 @@ type Claims struct {
 	Groups   []string           `json:"groups"`
 	Provider AuthProviderClaims `json:"provider"`
 	APIKey   bool               `json:"api_key"`
+
+	// Namespaces and Verbs restrict the requests of an API key, if not empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	Verbs      []string `json:"verbs,omitempty"`
+
+	// ProviderRefreshToken is the refresh token issued by the authentication
+	// provider, carried by the Sensu refresh tokens only.
+	ProviderRefreshToken string `json:"provider_refresh_token,omitempty"`
+}
+
+// Allows returns whether the claims allow the requests of the given verb in
+// the given namespace, regardless of the permissions of their subject. The
+// requests outside of namespaces are not allowed if the claims are restricted
+// to namespaces.
+func (c *Claims) Allows(namespace, verb string) bool {
+	if len(c.Namespaces) > 0 && !stringsutil.InArray(namespace, c.Namespaces) {
+		return false
+	}
+	if len(c.Verbs) > 0 && !stringsutil.InArray(verb, c.Verbs) && !stringsutil.InArray(VerbAll, c.Verbs) {
+		return false
+	}
+	return true
 }
 
 // AuthProviderClaims contains information from the authentication provider
@@ -9,116 +9,176 @@ import (
 
 // typeMap is used to dynamically look up data types from strings.
 var typeMap = map[string]interface{}{
-	"APIKey":                 &APIKey{},
-	"api_key":                &APIKey{},
-	"AdhocRequest":           &AdhocRequest{},
-	"adhoc_request":          &AdhocRequest{},
-	"Any":                    &Any{},
-	"any":                    &Any{},
-	"Asset":                  &Asset{},
-	"asset":                  &Asset{},
-	"AssetBuild":             &AssetBuild{},
-	"asset_build":            &AssetBuild{},
-	"AssetList":              &AssetList{},
-	"asset_list":             &AssetList{},
-	"AuthProviderClaims":     &AuthProviderClaims{},
-	"auth_provider_claims":   &AuthProviderClaims{},
-	"Check":                  &Check{},
-	"check":                  &Check{},
-	"CheckConfig":            &CheckConfig{},
-	"check_config":           &CheckConfig{},
-	"CheckHistory":           &CheckHistory{},
-	"check_history":          &CheckHistory{},
-	"CheckRequest":           &CheckRequest{},
-	"check_request":          &CheckRequest{},
-	"Claims":                 &Claims{},
-	"claims":                 &Claims{},
-	"ClusterHealth":          &ClusterHealth{},
-	"cluster_health":         &ClusterHealth{},
-	"ClusterRole":            &ClusterRole{},
-	"cluster_role":           &ClusterRole{},
-	"ClusterRoleBinding":     &ClusterRoleBinding{},
-	"cluster_role_binding":   &ClusterRoleBinding{},
-	"Deregistration":         &Deregistration{},
-	"deregistration":         &Deregistration{},
-	"Entity":                 &Entity{},
-	"entity":                 &Entity{},
-	"Event":                  &Event{},
-	"event":                  &Event{},
-	"EventFilter":            &EventFilter{},
-	"event_filter":           &EventFilter{},
-	"Extension":              &Extension{},
-	"extension":              &Extension{},
-	"Handler":                &Handler{},
-	"handler":                &Handler{},
-	"HandlerSocket":          &HandlerSocket{},
-	"handler_socket":         &HandlerSocket{},
-	"HealthResponse":         &HealthResponse{},
-	"health_response":        &HealthResponse{},
-	"Hook":                   &Hook{},
-	"hook":                   &Hook{},
-	"HookConfig":             &HookConfig{},
-	"hook_config":            &HookConfig{},
-	"HookList":               &HookList{},
-	"hook_list":              &HookList{},
-	"KeepaliveRecord":        &KeepaliveRecord{},
-	"keepalive_record":       &KeepaliveRecord{},
-	"MetricPoint":            &MetricPoint{},
-	"metric_point":           &MetricPoint{},
-	"MetricTag":              &MetricTag{},
-	"metric_tag":             &MetricTag{},
-	"Metrics":                &Metrics{},
-	"metrics":                &Metrics{},
-	"Mutator":                &Mutator{},
-	"mutator":                &Mutator{},
-	"Namespace":              &Namespace{},
-	"namespace":              &Namespace{},
-	"Network":                &Network{},
-	"network":                &Network{},
-	"NetworkInterface":       &NetworkInterface{},
-	"network_interface":      &NetworkInterface{},
-	"ObjectMeta":             &ObjectMeta{},
-	"object_meta":            &ObjectMeta{},
-	"PostgresHealth":         &PostgresHealth{},
-	"postgres_health":        &PostgresHealth{},
-	"Process":                &Process{},
-	"process":                &Process{},
-	"ProxyRequests":          &ProxyRequests{},
-	"proxy_requests":         &ProxyRequests{},
-	"Role":                   &Role{},
-	"role":                   &Role{},
-	"RoleBinding":            &RoleBinding{},
-	"role_binding":           &RoleBinding{},
-	"RoleRef":                &RoleRef{},
-	"role_ref":               &RoleRef{},
-	"Rule":                   &Rule{},
-	"rule":                   &Rule{},
-	"Secret":                 &Secret{},
-	"secret":                 &Secret{},
-	"Silenced":               &Silenced{},
-	"silenced":               &Silenced{},
-	"Subject":                &Subject{},
-	"subject":                &Subject{},
-	"System":                 &System{},
-	"system":                 &System{},
-	"TLSOptions":             &TLSOptions{},
-	"tls_options":            &TLSOptions{},
-	"TessenConfig":           &TessenConfig{},
-	"tessen_config":          &TessenConfig{},
-	"TimeWindowDays":         &TimeWindowDays{},
-	"time_window_days":       &TimeWindowDays{},
-	"TimeWindowTimeRange":    &TimeWindowTimeRange{},
-	"time_window_time_range": &TimeWindowTimeRange{},
-	"TimeWindowWhen":         &TimeWindowWhen{},
-	"time_window_when":       &TimeWindowWhen{},
-	"Tokens":                 &Tokens{},
-	"tokens":                 &Tokens{},
-	"TypeMeta":               &TypeMeta{},
-	"type_meta":              &TypeMeta{},
-	"User":                   &User{},
-	"user":                   &User{},
-	"Version":                &Version{},
-	"version":                &Version{},
+	"APIKey":                        &APIKey{},
+	"api_key":                       &APIKey{},
+	"AccessReview":                  &AccessReview{},
+	"access_review":                 &AccessReview{},
+	"AccessReviewBinding":           &AccessReviewBinding{},
+	"access_review_binding":         &AccessReviewBinding{},
+	"AdhocRequest":                  &AdhocRequest{},
+	"adhoc_request":                 &AdhocRequest{},
+	"AdmissionResponse":             &AdmissionResponse{},
+	"admission_response":            &AdmissionResponse{},
+	"AdmissionReview":               &AdmissionReview{},
+	"admission_review":              &AdmissionReview{},
+	"AdmissionWebhook":              &AdmissionWebhook{},
+	"admission_webhook":             &AdmissionWebhook{},
+	"AggregationRule":               &AggregationRule{},
+	"aggregation_rule":              &AggregationRule{},
+	"Any":                           &Any{},
+	"any":                           &Any{},
+	"Asset":                         &Asset{},
+	"asset":                         &Asset{},
+	"AssetBuild":                    &AssetBuild{},
+	"asset_build":                   &AssetBuild{},
+	"AssetList":                     &AssetList{},
+	"asset_list":                    &AssetList{},
+	"AssetSignature":                &AssetSignature{},
+	"asset_signature":               &AssetSignature{},
+	"AuthProviderClaims":            &AuthProviderClaims{},
+	"auth_provider_claims":          &AuthProviderClaims{},
+	"BulkResult":                    &BulkResult{},
+	"bulk_result":                   &BulkResult{},
+	"Check":                         &Check{},
+	"check":                         &Check{},
+	"CheckBlackout":                 &CheckBlackout{},
+	"check_blackout":                &CheckBlackout{},
+	"CheckConfig":                   &CheckConfig{},
+	"check_config":                  &CheckConfig{},
+	"CheckHistory":                  &CheckHistory{},
+	"check_history":                 &CheckHistory{},
+	"CheckProbe":                    &CheckProbe{},
+	"check_probe":                   &CheckProbe{},
+	"CheckRequest":                  &CheckRequest{},
+	"check_request":                 &CheckRequest{},
+	"Claims":                        &Claims{},
+	"claims":                        &Claims{},
+	"ClusterHealth":                 &ClusterHealth{},
+	"cluster_health":                &ClusterHealth{},
+	"ClusterRole":                   &ClusterRole{},
+	"cluster_role":                  &ClusterRole{},
+	"ClusterRoleBinding":            &ClusterRoleBinding{},
+	"cluster_role_binding":          &ClusterRoleBinding{},
+	"ClusterRoleSelector":           &ClusterRoleSelector{},
+	"cluster_role_selector":         &ClusterRoleSelector{},
+	"Correlation":                   &Correlation{},
+	"correlation":                   &Correlation{},
+	"Deregistration":                &Deregistration{},
+	"deregistration":                &Deregistration{},
+	"Enricher":                      &Enricher{},
+	"enricher":                      &Enricher{},
+	"Entity":                        &Entity{},
+	"entity":                        &Entity{},
+	"Event":                         &Event{},
+	"event":                         &Event{},
+	"EventArchive":                  &EventArchive{},
+	"event_archive":                 &EventArchive{},
+	"EventFilter":                   &EventFilter{},
+	"event_filter":                  &EventFilter{},
+	"EventRetentionPolicy":          &EventRetentionPolicy{},
+	"event_retention_policy":        &EventRetentionPolicy{},
+	"Extension":                     &Extension{},
+	"extension":                     &Extension{},
+	"FederatedCluster":              &FederatedCluster{},
+	"federated_cluster":             &FederatedCluster{},
+	"Handler":                       &Handler{},
+	"handler":                       &Handler{},
+	"HandlerInfluxDB":               &HandlerInfluxDB{},
+	"handler_influx_db":             &HandlerInfluxDB{},
+	"HandlerKafka":                  &HandlerKafka{},
+	"handler_kafka":                 &HandlerKafka{},
+	"HandlerOTLP":                   &HandlerOTLP{},
+	"handler_otlp":                  &HandlerOTLP{},
+	"HandlerSocket":                 &HandlerSocket{},
+	"handler_socket":                &HandlerSocket{},
+	"HealthResponse":                &HealthResponse{},
+	"health_response":               &HealthResponse{},
+	"Hook":                          &Hook{},
+	"hook":                          &Hook{},
+	"HookConfig":                    &HookConfig{},
+	"hook_config":                   &HookConfig{},
+	"HookList":                      &HookList{},
+	"hook_list":                     &HookList{},
+	"KeepalivePolicy":               &KeepalivePolicy{},
+	"keepalive_policy":              &KeepalivePolicy{},
+	"KeepaliveRecord":               &KeepaliveRecord{},
+	"keepalive_record":              &KeepaliveRecord{},
+	"KeepaliveStage":                &KeepaliveStage{},
+	"keepalive_stage":               &KeepaliveStage{},
+	"MaintenanceWindow":             &MaintenanceWindow{},
+	"maintenance_window":            &MaintenanceWindow{},
+	"MaintenanceWindowOccurrence":   &MaintenanceWindowOccurrence{},
+	"maintenance_window_occurrence": &MaintenanceWindowOccurrence{},
+	"MetricPoint":                   &MetricPoint{},
+	"metric_point":                  &MetricPoint{},
+	"MetricTag":                     &MetricTag{},
+	"metric_tag":                    &MetricTag{},
+	"Metrics":                       &Metrics{},
+	"metrics":                       &Metrics{},
+	"Mutator":                       &Mutator{},
+	"mutator":                       &Mutator{},
+	"Namespace":                     &Namespace{},
+	"namespace":                     &Namespace{},
+	"NamespaceTemplate":             &NamespaceTemplate{},
+	"namespace_template":            &NamespaceTemplate{},
+	"Network":                       &Network{},
+	"network":                       &Network{},
+	"NetworkInterface":              &NetworkInterface{},
+	"network_interface":             &NetworkInterface{},
+	"ObjectMeta":                    &ObjectMeta{},
+	"object_meta":                   &ObjectMeta{},
+	"PostgresHealth":                &PostgresHealth{},
+	"postgres_health":               &PostgresHealth{},
+	"Process":                       &Process{},
+	"process":                       &Process{},
+	"ProxyRequests":                 &ProxyRequests{},
+	"proxy_requests":                &ProxyRequests{},
+	"Replicator":                    &Replicator{},
+	"replicator":                    &Replicator{},
+	"ResourceQuota":                 &ResourceQuota{},
+	"resource_quota":                &ResourceQuota{},
+	"ResourceUsage":                 &ResourceUsage{},
+	"resource_usage":                &ResourceUsage{},
+	"Role":                          &Role{},
+	"role":                          &Role{},
+	"RoleBinding":                   &RoleBinding{},
+	"role_binding":                  &RoleBinding{},
+	"RoleRef":                       &RoleRef{},
+	"role_ref":                      &RoleRef{},
+	"Rule":                          &Rule{},
+	"rule":                          &Rule{},
+	"Secret":                        &Secret{},
+	"secret":                        &Secret{},
+	"SecretConfig":                  &SecretConfig{},
+	"secret_config":                 &SecretConfig{},
+	"SecretsProvider":               &SecretsProvider{},
+	"secrets_provider":              &SecretsProvider{},
+	"Silenced":                      &Silenced{},
+	"silenced":                      &Silenced{},
+	"Subject":                       &Subject{},
+	"subject":                       &Subject{},
+	"System":                        &System{},
+	"system":                        &System{},
+	"TLSOptions":                    &TLSOptions{},
+	"tls_options":                   &TLSOptions{},
+	"TessenConfig":                  &TessenConfig{},
+	"tessen_config":                 &TessenConfig{},
+	"TimeWindowDays":                &TimeWindowDays{},
+	"time_window_days":              &TimeWindowDays{},
+	"TimeWindowTimeRange":           &TimeWindowTimeRange{},
+	"time_window_time_range":        &TimeWindowTimeRange{},
+	"TimeWindowWhen":                &TimeWindowWhen{},
+	"time_window_when":              &TimeWindowWhen{},
+	"Tokens":                        &Tokens{},
+	"tokens":                        &Tokens{},
+	"TypeMeta":                      &TypeMeta{},
+	"type_meta":                     &TypeMeta{},
+	"User":                          &User{},
+	"user":                          &User{},
+	"VaultSecretsProviderConfig":    &VaultSecretsProviderConfig{},
+	"vault_secrets_provider_config": &VaultSecretsProviderConfig{},
+	"Version":                       &Version{},
+	"version":                       &Version{},
 }
 
 // ResolveResource returns a zero-valued resource, given a name.
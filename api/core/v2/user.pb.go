@@ -26,10 +26,14 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // User describes an authenticated user
 type User struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Groups               []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
-	Disabled             bool     `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled"`
+	Username string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Groups   []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Disabled bool     `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled"`
+	// PasswordHash is the bcrypt hash of the password of the user. It is
+	// returned by the API in place of the password, and can be given instead of
+	// the password to create a user with the same credentials.
+	PasswordHash         string   `protobuf:"bytes,5,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -96,6 +100,13 @@ func (m *User) GetDisabled() bool {
 	return false
 }
 
+func (m *User) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "sensu.core.v2.User")
 }
@@ -103,21 +114,24 @@ func init() {
 func init() { proto.RegisterFile("user.proto", fileDescriptor_116e343673f7ffaf) }
 
 var fileDescriptor_116e343673f7ffaf = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
+	// 261 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2a, 0x2d, 0x4e, 0x2d,
 	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2d, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x4b, 0xce,
 	0x2f, 0x4a, 0xd5, 0x2b, 0x33, 0x92, 0x32, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
 	0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x4a, 0x2a, 0x4d, 0x73, 0x28, 0x33, 0xd4,
-	0x33, 0xd6, 0x33, 0x04, 0x0b, 0x82, 0xc5, 0xc0, 0x2c, 0x88, 0x21, 0x4a, 0x0d, 0x8c, 0x5c, 0x2c,
-	0xa1, 0xc5, 0xa9, 0x45, 0x42, 0x52, 0x5c, 0x1c, 0x20, 0xb3, 0xf3, 0x12, 0x73, 0x53, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0x38, 0x83, 0xe0, 0x7c, 0x90, 0x5c, 0x41, 0x62, 0x71, 0x71, 0x79, 0x7e, 0x51,
-	0x8a, 0x04, 0x13, 0x44, 0x0e, 0xc6, 0x17, 0x12, 0xe3, 0x62, 0x4b, 0x2f, 0xca, 0x2f, 0x2d, 0x28,
-	0x96, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x0c, 0x82, 0xf2, 0x84, 0x34, 0xb8, 0x38, 0x52, 0x32, 0x8b,
-	0x13, 0x93, 0x72, 0x52, 0x53, 0x24, 0x58, 0x14, 0x18, 0x35, 0x38, 0x9c, 0x78, 0x5e, 0xdd, 0x93,
-	0x87, 0x8b, 0x05, 0xc1, 0x59, 0x4e, 0x0a, 0x3f, 0x1e, 0xca, 0x31, 0xae, 0x78, 0x24, 0xc7, 0xb8,
-	0xe3, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
-	0x38, 0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x53, 0x99, 0x51, 0x12, 0x1b, 0xd8, 0xad, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x46, 0x74, 0x35, 0xed, 0xfe, 0x00, 0x00, 0x00,
+	0x33, 0xd6, 0x33, 0x04, 0x0b, 0x82, 0xc5, 0xc0, 0x2c, 0x88, 0x21, 0x4a, 0x87, 0x18, 0xb9, 0x58,
+	0x42, 0x8b, 0x53, 0x8b, 0x84, 0xa4, 0xb8, 0x38, 0x40, 0x66, 0xe7, 0x25, 0xe6, 0xa6, 0x4a, 0x30,
+	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xf9, 0x20, 0xb9, 0x82, 0xc4, 0xe2, 0xe2, 0xf2, 0xfc, 0xa2,
+	0x14, 0x09, 0x26, 0x88, 0x1c, 0x8c, 0x2f, 0x24, 0xc6, 0xc5, 0x96, 0x5e, 0x94, 0x5f, 0x5a, 0x50,
+	0x2c, 0xc1, 0xac, 0xc0, 0xac, 0xc1, 0x19, 0x04, 0xe5, 0x09, 0x69, 0x70, 0x71, 0xa4, 0x64, 0x16,
+	0x27, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0xb0, 0x28, 0x30, 0x6a, 0x70, 0x38, 0xf1, 0xbc, 0xba, 0x27,
+	0x0f, 0x17, 0x0b, 0x82, 0xb3, 0x84, 0x1c, 0xb8, 0x78, 0x61, 0xa6, 0xc5, 0x67, 0x24, 0x16, 0x67,
+	0x48, 0xb0, 0x82, 0xac, 0x70, 0x92, 0x7e, 0x75, 0x4f, 0x5e, 0x1c, 0x45, 0x42, 0x27, 0x3f, 0x37,
+	0xb3, 0x24, 0x35, 0xb7, 0xa0, 0xa4, 0x32, 0x88, 0x07, 0x26, 0xe1, 0x91, 0x58, 0x9c, 0xe1, 0xa4,
+	0xf0, 0xe3, 0xa1, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x3b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24,
+	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x44, 0x31,
+	0x95, 0x19, 0x25, 0xb1, 0x81, 0x7d, 0x6b, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x95, 0x65,
+	0xb3, 0x40, 0x01, 0x00, 0x00,
 }
 
 func (this *User) Equal(that interface{}) bool {
@@ -156,6 +170,9 @@ func (this *User) Equal(that interface{}) bool {
 	if this.Disabled != that1.Disabled {
 		return false
 	}
+	if this.PasswordHash != that1.PasswordHash {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -185,6 +202,13 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PasswordHash) > 0 {
+		i -= len(m.PasswordHash)
+		copy(dAtA[i:], m.PasswordHash)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PasswordHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Disabled {
 		i--
 		if m.Disabled {
@@ -242,8 +266,9 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 		this.Groups[i] = string(randStringUser(r))
 	}
 	this.Disabled = bool(bool(r.Intn(2) == 0))
+	this.PasswordHash = string(randStringUser(r))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedUser(r, 5)
+		this.XXX_unrecognized = randUnrecognizedUser(r, 6)
 	}
 	return this
 }
@@ -343,6 +368,10 @@ func (m *User) Size() (n int) {
 	if m.Disabled {
 		n += 2
 	}
+	l = len(m.PasswordHash)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -500,6 +529,38 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Disabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
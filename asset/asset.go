@@ -1,10 +1,11 @@
 // Package asset provides a mechanism for installing, managing, and utilizing
 // Sensu Assets.
 //
-// Access to assets are serialized. When an asset is first encountered,
+// Access to each asset is serialized. When an asset is first encountered,
 // getting the asset from the manager blocks until the asset has been
 // fetched, verified, and expanded on the host filesystem (or deemed
-// unnecessary due to asset filters).
+// unnecessary due to asset filters). Different assets are installed
+// concurrently.
 //
 // The first goroutine to get an asset will cause the installation, and
 // subsequent calling goroutines will simply block while installation
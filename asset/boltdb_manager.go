@@ -3,10 +3,15 @@ package asset
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/util/retry"
 	bolt "go.etcd.io/bbolt"
 	"golang.org/x/time/rate"
 )
@@ -15,6 +20,13 @@ var (
 	assetBucketName = []byte("assets")
 )
 
+const (
+	// fetchRetryDelay is the initial delay before the installation of an
+	// asset is retried, doubled on each retry up to maxFetchRetryDelay.
+	fetchRetryDelay    = time.Second
+	maxFetchRetryDelay = 30 * time.Second
+)
+
 // NewBoltDBGetter returns a new default asset Getter. If fetcher, verifier, or
 // expander are nil, the getter will use the built-in components.
 func NewBoltDBGetter(db *bolt.DB,
@@ -23,6 +35,15 @@ func NewBoltDBGetter(db *bolt.DB,
 	verifier Verifier,
 	expander Expander,
 	limiter *rate.Limiter) Getter {
+	return newBoltDBAssetManager(db, localStorage, fetcher, verifier, expander, limiter)
+}
+
+func newBoltDBAssetManager(db *bolt.DB,
+	localStorage string,
+	fetcher Fetcher,
+	verifier Verifier,
+	expander Expander,
+	limiter *rate.Limiter) *boltDBAssetManager {
 
 	if fetcher == nil {
 		fetcher = &httpFetcher{
@@ -58,104 +79,249 @@ type boltDBAssetManager struct {
 	fetcher      Fetcher
 	expander     Expander
 	verifier     Verifier
+
+	// trustedKeys verify the signatures of the assets, which are not verified
+	// if it is nil.
+	trustedKeys       *TrustedKeys
+	requireSignatures bool
+
+	// installing holds a channel per asset being installed, closed once the
+	// installation is done.
+	installing sync.Map
+
+	// slots limits the number of assets installed concurrently, unless nil.
+	slots chan struct{}
+
+	// fetchRetries is the number of times the installation of an asset is
+	// retried.
+	fetchRetries int
+
+	// cache tracks the installed assets to evict and collect them, unless
+	// nil.
+	cache *assetCache
+}
+
+// assetRecord is the record of an installed asset in BoltDB.
+type assetRecord struct {
+	Path     string
+	Size     int64 `json:",omitempty"`
+	LastUsed int64 `json:",omitempty"`
 }
 
-// Get opens a transaction to BoltDB, causing subsequent calls to
-// Get to block. During this transaction, we attempt to determine if the asset
-// is installed by querying BoltDB for the asset's SHA (which we use as an ID).
+// Get queries BoltDB to determine if the asset is installed, using the
+// asset's SHA as an ID.
 //
 // If a value is returned, we return the deserialized asset stored in BoltDB.
 // If deserialization fails, we assume there is some level of corruption and
 // attempt to re-install the asset.
 //
 // If a value is not returned, the asset is not installed or not installed
-// correctly. We then proceed to attempt asset installation.
+// correctly. We then proceed to attempt asset installation. Only one call
+// installs a given asset, the concurrent calls getting it block until it is
+// installed, and retry its installation if it failed. Different assets are
+// installed concurrently, up to the parallelism of the manager.
 func (b *boltDBAssetManager) Get(ctx context.Context, asset *corev2.Asset) (*RuntimeAsset, error) {
-	key := []byte(asset.GetSha512())
-	var localAsset *RuntimeAsset
+	key := asset.GetSha512()
+	var done chan struct{}
+	defer func() {
+		if done != nil {
+			b.installing.Delete(key)
+			close(done)
+		}
+	}()
+	for {
+		localAsset, err := b.lookup(key)
+		if err != nil {
+			return nil, err
+		}
+		if localAsset != nil {
+			b.cache.touch(key)
+			localAsset.SHA512 = asset.Sha512
+			return localAsset, nil
+		}
+		if done != nil {
+			break
+		}
 
-	// Concurrent calls to View are allowed, but a concurrent call that has
-	// has proceeded to Update below will block here.
-	if err := b.db.View(func(tx *bolt.Tx) error {
-		// If the key exists, the bucket should already exist.
-		bucket := tx.Bucket(assetBucketName)
-		if bucket == nil {
-			return nil
+		done = make(chan struct{})
+		installing, loaded := b.installing.LoadOrStore(key, done)
+		if !loaded {
+			// Check the asset again, in case its installation completed
+			// right before
+			continue
+		}
+		done = nil
+		select {
+		case <-installing.(chan struct{}):
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
+	}
 
-		value := bucket.Get(key)
-		if value != nil {
-			// deserialize asset
-			if err := json.Unmarshal(value, &localAsset); err == nil {
-				return nil
-			}
+	if b.slots != nil {
+		select {
+		case b.slots <- struct{}{}:
+			defer func() { <-b.slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
+	}
 
-		return nil
-	}); err != nil {
+	assetPath, err := b.install(ctx, asset)
+	if err != nil {
 		return nil, err
 	}
-
-	// Check to see if the view was successful.
-	if localAsset != nil {
-		localAsset.SHA512 = asset.Sha512
-		return localAsset, nil
+	record := assetRecord{
+		Path:     assetPath,
+		Size:     dirSize(assetPath),
+		LastUsed: time.Now().Unix(),
 	}
-
 	if err := b.db.Update(func(tx *bolt.Tx) error {
 		bucket, err := tx.CreateBucketIfNotExists(assetBucketName)
 		if err != nil {
 			return err
 		}
 
-		// Though we've already attempted to do this, it's possible that a previous
-		// call completed installation of the asset while this transaction
-		// was blocked on serialization. Re-attempt to get the key in case that is
-		// what happened.
-		value := bucket.Get(key)
+		assetJSON, err := json.Marshal(record)
+		if err != nil {
+			panic(err)
+		}
+
+		return bucket.Put([]byte(key), assetJSON)
+	}); err != nil {
+		return nil, err
+	}
+	b.cache.add(key, record)
+	b.cache.evict()
+
+	return &RuntimeAsset{
+		Path:   assetPath,
+		SHA512: asset.Sha512,
+	}, nil
+}
+
+// lookup returns the installed asset of the given key, or nil if the asset is
+// not installed.
+func (b *boltDBAssetManager) lookup(key string) (*RuntimeAsset, error) {
+	var localAsset *RuntimeAsset
+	err := b.db.View(func(tx *bolt.Tx) error {
+		// If the key exists, the bucket should already exist.
+		bucket := tx.Bucket(assetBucketName)
+		if bucket == nil {
+			return nil
+		}
+
+		value := bucket.Get([]byte(key))
 		if value != nil {
 			// deserialize asset
-			if err := json.Unmarshal(value, &localAsset); err == nil {
-				return nil
+			if err := json.Unmarshal(value, &localAsset); err != nil {
+				localAsset = nil
 			}
 		}
 
-		// install the asset
-		tmpFile, err := b.fetcher.Fetch(ctx, asset.URL, asset.Headers)
-		if err != nil {
-			return err
-		}
-		defer tmpFile.Close()
-		defer os.Remove(tmpFile.Name())
+		return nil
+	})
+	return localAsset, err
+}
 
-		// verify
-		if err := b.verifier.Verify(tmpFile, asset.Sha512); err != nil {
-			return err
-		}
+// install fetches, verifies and expands an asset, retrying with backoff, and
+// returns the path it was expanded to.
+func (b *boltDBAssetManager) install(ctx context.Context, asset *corev2.Asset) (string, error) {
+	if err := b.signatureRequirement(asset); err != nil {
+		return "", err
+	}
 
-		// expand
-		assetPath := filepath.Join(b.localStorage, asset.Sha512)
-		if err := b.expander.Expand(tmpFile, assetPath); err != nil {
-			return err
+	var assetPath string
+	var lastErr error
+	backoff := retry.ExponentialBackoff{
+		Ctx:                  ctx,
+		InitialDelayInterval: fetchRetryDelay,
+		MaxDelayInterval:     maxFetchRetryDelay,
+		MaxRetryAttempts:     b.fetchRetries + 1,
+	}
+	err := backoff.Retry(func(n int) (bool, error) {
+		if n > 0 {
+			logger.WithError(lastErr).WithField("asset", asset.Name).Warningf("retrying asset installation (%d/%d)", n, b.fetchRetries)
 		}
+		assetPath, lastErr = b.installOnce(ctx, asset)
+		return lastErr == nil, nil
+	})
+	if err == retry.ErrMaxRetryAttempts {
+		return "", lastErr
+	}
+	return assetPath, err
+}
 
-		localAsset = &RuntimeAsset{
-			Path: assetPath,
-		}
+func (b *boltDBAssetManager) installOnce(ctx context.Context, asset *corev2.Asset) (string, error) {
+	tmpFile, err := b.fetcher.Fetch(ctx, asset.URL, asset.Headers)
+	if err != nil {
+		return "", err
+	}
+	defer tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
 
-		assetJSON, err := json.Marshal(localAsset)
-		if err != nil {
-			panic(err)
-		}
+	// verify
+	if err := b.verifier.Verify(tmpFile, asset.Sha512); err != nil {
+		return "", err
+	}
+	if err := b.verifySignature(ctx, asset, tmpFile); err != nil {
+		return "", err
+	}
 
-		return bucket.Put(key, assetJSON)
-	}); err != nil {
-		return nil, err
+	// expand
+	assetPath := filepath.Join(b.localStorage, asset.Sha512)
+	if err := b.expander.Expand(tmpFile, assetPath); err != nil {
+		// Clean up the partial expansion, so that it can be retried
+		_ = os.RemoveAll(assetPath)
+		return "", err
+	}
+
+	return assetPath, nil
+}
+
+// signatureRequirement returns an error if the signature of an asset can't
+// be verified while signatures are required.
+func (b *boltDBAssetManager) signatureRequirement(asset *corev2.Asset) error {
+	if !b.requireSignatures {
+		return nil
 	}
+	if asset.Signature == nil {
+		return fmt.Errorf("asset %s is not signed but signatures are required", asset.Name)
+	}
+	if b.trustedKeys == nil {
+		return fmt.Errorf("can't verify the signature of asset %s: no trusted keys", asset.Name)
+	}
+	return nil
+}
 
-	if localAsset != nil {
-		localAsset.SHA512 = asset.Sha512
+// verifySignature verifies the signature of the archive of an asset against
+// the trusted keys, if any, and rejects the unsigned assets if signatures are
+// required.
+func (b *boltDBAssetManager) verifySignature(ctx context.Context, asset *corev2.Asset, archive *os.File) error {
+	if err := b.signatureRequirement(asset); err != nil {
+		return err
+	}
+	if asset.Signature == nil {
+		return nil
+	}
+	if b.trustedKeys == nil {
+		logger.WithField("asset", asset.Name).Debug("no trusted keys, not verifying asset signature")
+		return nil
 	}
 
-	return localAsset, nil
+	sigFile, err := b.fetcher.Fetch(ctx, asset.Signature.URL, asset.Headers)
+	if err != nil {
+		return fmt.Errorf("can't fetch the signature of asset %s: %s", asset.Name, err)
+	}
+	defer os.Remove(sigFile.Name())
+	signature, err := ioutil.ReadAll(sigFile)
+	sigFile.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := b.trustedKeys.Verify(archive, asset.Signature.Type, signature); err != nil {
+		return fmt.Errorf("invalid signature of asset %s: %s", asset.Name, err)
+	}
+	return nil
 }
@@ -7,11 +7,15 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	bolt "go.etcd.io/bbolt"
 
 	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
 )
 
 type mockFetcher struct {
@@ -288,3 +292,120 @@ func TestSuccessfulGetAsset(t *testing.T) {
 		t.Fail()
 	}
 }
+
+// countingFetcher fails the first failures fetches, and counts the fetches.
+type countingFetcher struct {
+	mu       sync.Mutex
+	fetches  int
+	failures int
+	delay    time.Duration
+	running  int
+	maxRun   int
+}
+
+func (c *countingFetcher) Fetch(context.Context, string, map[string]string) (*os.File, error) {
+	c.mu.Lock()
+	c.fetches++
+	fail := c.fetches <= c.failures
+	c.running++
+	if c.running > c.maxRun {
+		c.maxRun = c.running
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.delay)
+
+	c.mu.Lock()
+	c.running--
+	c.mu.Unlock()
+	if fail {
+		return nil, errors.New("fetch failed")
+	}
+	return ioutil.TempFile(os.TempDir(), "boltdb_manager_test_fetcher")
+}
+
+func newTestBoltDB(t *testing.T) (*bolt.DB, func()) {
+	dir, err := ioutil.TempDir("", "boltdb_manager_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := bolt.Open(filepath.Join(dir, dbName), 0600, &bolt.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetInstallsAssetOnce(t *testing.T) {
+	db, cleanup := newTestBoltDB(t)
+	defer cleanup()
+
+	fetcher := &countingFetcher{delay: 50 * time.Millisecond}
+	manager := &boltDBAssetManager{
+		db:       db,
+		fetcher:  fetcher,
+		verifier: &mockVerifier{true},
+		expander: &mockExpander{true},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runtimeAsset, err := manager.Get(context.Background(), &types.Asset{Sha512: "sha"})
+			assert.NoError(t, err)
+			assert.NotNil(t, runtimeAsset)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, fetcher.fetches)
+}
+
+func TestGetInstallsAssetsConcurrently(t *testing.T) {
+	db, cleanup := newTestBoltDB(t)
+	defer cleanup()
+
+	fetcher := &countingFetcher{delay: 50 * time.Millisecond}
+	manager := &boltDBAssetManager{
+		db:       db,
+		fetcher:  fetcher,
+		verifier: &mockVerifier{true},
+		expander: &mockExpander{true},
+		slots:    make(chan struct{}, 2),
+	}
+
+	assets := []types.Asset{{Sha512: "a"}, {Sha512: "b"}, {Sha512: "c"}, {Sha512: "d"}}
+	runtimeAssets, err := GetAll(context.Background(), manager, assets)
+	assert.NoError(t, err)
+	assert.Len(t, runtimeAssets, 4)
+	assert.Equal(t, 4, fetcher.fetches)
+	assert.Equal(t, 2, fetcher.maxRun)
+}
+
+func TestGetRetriesAssetInstallation(t *testing.T) {
+	db, cleanup := newTestBoltDB(t)
+	defer cleanup()
+
+	fetcher := &countingFetcher{failures: 1}
+	manager := &boltDBAssetManager{
+		db:           db,
+		fetcher:      fetcher,
+		verifier:     &mockVerifier{true},
+		expander:     &mockExpander{true},
+		fetchRetries: 1,
+	}
+
+	runtimeAsset, err := manager.Get(context.Background(), &types.Asset{Sha512: "a"})
+	assert.NoError(t, err)
+	assert.NotNil(t, runtimeAsset)
+	assert.Equal(t, 2, fetcher.fetches)
+
+	fetcher.failures = 4
+	_, err = manager.Get(context.Background(), &types.Asset{Sha512: "b"})
+	assert.EqualError(t, err, "fetch failed")
+	assert.Equal(t, 4, fetcher.fetches)
+}
@@ -0,0 +1,186 @@
+package asset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// BundleManifestFile is the name of the manifest of an asset bundle, listing
+// the asset builds whose archives are in the bundle directory.
+const BundleManifestFile = "bundle.json"
+
+// A BundleManifest lists the asset builds of an asset bundle, a directory of
+// asset archives pre-packaged, e.g. by sensuctl asset bundle, for agents that
+// can't fetch them.
+type BundleManifest struct {
+	Builds []BundleBuild `json:"builds"`
+}
+
+// A BundleBuild is an asset build of a bundle.
+type BundleBuild struct {
+	// Asset is the name of the asset of the build.
+	Asset string `json:"asset"`
+
+	// URL is the URL of the build, which its archive is served for.
+	URL string `json:"url"`
+
+	// Sha512 is the checksum of the archive of the build.
+	Sha512 string `json:"sha512"`
+
+	// File is the name of the archive of the build in the bundle directory.
+	File string `json:"file"`
+
+	// SignatureURL is the URL of the signature of the build, if signed.
+	SignatureURL string `json:"signature_url,omitempty"`
+
+	// SignatureFile is the name of the signature of the build in the bundle
+	// directory, if signed.
+	SignatureFile string `json:"signature_file,omitempty"`
+}
+
+// BundleFile returns the name of the archive of an asset build in a bundle.
+func BundleFile(sha512 string) string {
+	return sha512 + ".archive"
+}
+
+// BundleSignatureFile returns the name of the signature of an asset build in
+// a bundle.
+func BundleSignatureFile(sha512 string) string {
+	return sha512 + ".sig"
+}
+
+// ReadBundleManifest reads the manifest of the bundle of the given directory.
+func ReadBundleManifest(dir string) (*BundleManifest, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, BundleManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	var manifest BundleManifest
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid asset bundle manifest: %s", err)
+	}
+	return &manifest, nil
+}
+
+// WriteBundleManifest writes the manifest of the bundle of the given
+// directory.
+func WriteBundleManifest(dir string, manifest *BundleManifest) error {
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, BundleManifestFile), b, 0644)
+}
+
+// AddToBundle fetches the archive of an asset build, and its signature if
+// signed, into the bundle of the given directory, verifying the archive, and
+// returns the entry of the build in the manifest of the bundle. The archives
+// already in the bundle are not fetched again.
+func AddToBundle(ctx context.Context, dir, assetName string, build *corev2.AssetBuild) (BundleBuild, error) {
+	entry := BundleBuild{
+		Asset:  assetName,
+		URL:    build.URL,
+		Sha512: build.Sha512,
+		File:   BundleFile(build.Sha512),
+	}
+	fetcher := &httpFetcher{}
+
+	if build.Signature != nil {
+		entry.SignatureURL = build.Signature.URL
+		entry.SignatureFile = BundleSignatureFile(build.Sha512)
+		sigFile, err := fetcher.Fetch(ctx, build.Signature.URL, build.Headers)
+		if err != nil {
+			return entry, err
+		}
+		defer sigFile.Close()
+		defer os.Remove(sigFile.Name())
+		if err := writeBundleFile(filepath.Join(dir, entry.SignatureFile), sigFile); err != nil {
+			return entry, err
+		}
+	}
+
+	path := filepath.Join(dir, entry.File)
+	if f, err := os.Open(path); err == nil {
+		err = defaultVerifier.Verify(f, build.Sha512)
+		f.Close()
+		if err == nil {
+			return entry, nil
+		}
+	}
+
+	tmpFile, err := fetcher.Fetch(ctx, build.URL, build.Headers)
+	if err != nil {
+		return entry, err
+	}
+	defer tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
+	if err := defaultVerifier.Verify(tmpFile, build.Sha512); err != nil {
+		return entry, err
+	}
+	return entry, writeBundleFile(path, tmpFile)
+}
+
+// writeBundleFile writes the contents of r next to path, and renames it in
+// place.
+func writeBundleFile(path string, r io.Reader) error {
+	partial, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(partial.Name())
+	if _, err := io.Copy(partial, r); err != nil {
+		partial.Close()
+		return err
+	}
+	if err := partial.Close(); err != nil {
+		return err
+	}
+	return os.Rename(partial.Name(), path)
+}
+
+// A bundleFetcher fetches the assets from the bundle of a directory, and falls
+// back to the next fetcher for the assets missing from the bundle. The
+// manifest is read on each fetch, so that the bundle can be updated without
+// restarting. The archives are verified like the fetched ones.
+type bundleFetcher struct {
+	dir  string
+	next Fetcher
+}
+
+// Fetch the file found at the specified url in the bundle, or with the next
+// fetcher if it isn't in the bundle.
+func (b *bundleFetcher) Fetch(ctx context.Context, url string, headers map[string]string) (*os.File, error) {
+	manifest, err := ReadBundleManifest(b.dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.WithError(err).WithField("bundle", b.dir).Warn("couldn't read asset bundle")
+		}
+		return b.next.Fetch(ctx, url, headers)
+	}
+	for _, build := range manifest.Builds {
+		file := build.File
+		if build.URL != url {
+			if build.SignatureURL == "" || build.SignatureURL != url {
+				continue
+			}
+			file = build.SignatureFile
+		}
+		f, err := os.Open(filepath.Join(b.dir, filepath.Base(file)))
+		if err != nil {
+			logger.WithError(err).WithField("url", url).Warn("couldn't open asset from bundle")
+			break
+		}
+		defer f.Close()
+		logger.WithField("url", url).WithField("bundle", b.dir).Debug("fetching asset from bundle")
+		// The archive is copied, as the fetched files are removed once expanded
+		return writeTempFile(f)
+	}
+	return b.next.Fetch(ctx, url, headers)
+}
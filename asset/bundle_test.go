@@ -0,0 +1,131 @@
+package asset
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingFetcher struct {
+	fetched []string
+}
+
+func (m *recordingFetcher) Fetch(ctx context.Context, url string, headers map[string]string) (*os.File, error) {
+	m.fetched = append(m.fetched, url)
+	return ioutil.TempFile("", "sensu-asset")
+}
+
+func fixtureBuild(t *testing.T, url string) *corev2.AssetBuild {
+	sha, err := ioutil.ReadFile(getFixturePath("rubby-on-rails.tar.sha512"))
+	require.NoError(t, err)
+	return &corev2.AssetBuild{URL: url, Sha512: string(sha)}
+}
+
+func TestAddToBundle(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.ServeFile(w, r, getFixturePath("rubby-on-rails.tar"))
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "asset-bundle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	build := fixtureBuild(t, ts.URL)
+	entry, err := AddToBundle(context.Background(), dir, "rubby", build)
+	require.NoError(t, err)
+	assert.Equal(t, BundleBuild{Asset: "rubby", URL: ts.URL, Sha512: build.Sha512, File: BundleFile(build.Sha512)}, entry)
+	f, err := os.Open(filepath.Join(dir, entry.File))
+	require.NoError(t, err)
+	defer f.Close()
+	assert.NoError(t, defaultVerifier.Verify(f, build.Sha512))
+
+	// The archive already in the bundle is not fetched again
+	_, err = AddToBundle(context.Background(), dir, "rubby", build)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// The signature is bundled with the archive
+	build.Signature = &corev2.AssetSignature{Type: corev2.AssetSignatureGPG, URL: ts.URL + "/rubby.tar.sig"}
+	entry, err = AddToBundle(context.Background(), dir, "rubby", build)
+	require.NoError(t, err)
+	assert.Equal(t, build.Signature.URL, entry.SignatureURL)
+	_, err = os.Stat(filepath.Join(dir, BundleSignatureFile(build.Sha512)))
+	assert.NoError(t, err)
+
+	build.Sha512 = "invalid"
+	_, err = AddToBundle(context.Background(), dir, "rubby", build)
+	assert.Error(t, err)
+}
+
+func TestBundleFetcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "asset-bundle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	next := &recordingFetcher{}
+	fetcher := &bundleFetcher{dir: dir, next: next}
+
+	// Without manifest, the assets are fetched by the next fetcher
+	f, err := fetcher.Fetch(context.Background(), "https://example.com/rubby.tar", nil)
+	require.NoError(t, err)
+	f.Close()
+	os.Remove(f.Name())
+	assert.Equal(t, []string{"https://example.com/rubby.tar"}, next.fetched)
+
+	build := fixtureBuild(t, "https://example.com/rubby.tar")
+	archive, err := ioutil.ReadFile(getFixturePath("rubby-on-rails.tar"))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, BundleFile(build.Sha512)), archive, 0644))
+	require.NoError(t, WriteBundleManifest(dir, &BundleManifest{
+		Builds: []BundleBuild{{Asset: "rubby", URL: build.URL, Sha512: build.Sha512, File: BundleFile(build.Sha512)}},
+	}))
+
+	f, err = fetcher.Fetch(context.Background(), build.URL, nil)
+	require.NoError(t, err)
+	defer f.Close()
+	defer os.Remove(f.Name())
+	assert.NoError(t, defaultVerifier.Verify(f, build.Sha512))
+	assert.Len(t, next.fetched, 1)
+
+	// The bundled archive is copied, not removed with the fetched file
+	_, err = os.Stat(filepath.Join(dir, BundleFile(build.Sha512)))
+	assert.NoError(t, err)
+
+	f2, err := fetcher.Fetch(context.Background(), "https://example.com/other.tar", nil)
+	require.NoError(t, err)
+	f2.Close()
+	os.Remove(f2.Name())
+	assert.Len(t, next.fetched, 2)
+
+	// The signatures are fetched from the bundle too
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, BundleSignatureFile(build.Sha512)), []byte("signature"), 0644))
+	require.NoError(t, WriteBundleManifest(dir, &BundleManifest{
+		Builds: []BundleBuild{{
+			Asset:         "rubby",
+			URL:           build.URL,
+			Sha512:        build.Sha512,
+			File:          BundleFile(build.Sha512),
+			SignatureURL:  build.URL + ".sig",
+			SignatureFile: BundleSignatureFile(build.Sha512),
+		}},
+	}))
+	sig, err := fetcher.Fetch(context.Background(), build.URL+".sig", nil)
+	require.NoError(t, err)
+	defer sig.Close()
+	defer os.Remove(sig.Name())
+	b, err := ioutil.ReadAll(sig)
+	require.NoError(t, err)
+	assert.Equal(t, "signature", string(b))
+	assert.Len(t, next.fetched, 2)
+}
@@ -0,0 +1,268 @@
+package asset
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// minEvictionAge is the time during which the assets used recently are
+	// not evicted, even if the cache exceeds its quota, so that the assets of
+	// the checks being executed are not removed.
+	minEvictionAge = 10 * time.Minute
+
+	// lastUsedResolution is the resolution of the times the assets were last
+	// used recorded in BoltDB, so that using an asset doesn't write it each
+	// time.
+	lastUsedResolution = time.Hour
+)
+
+// A GarbageAsset is an installed asset removed, or to be removed, by the
+// garbage collection of the unused assets.
+type GarbageAsset struct {
+	// SHA512 is the checksum of the asset.
+	SHA512 string `json:"sha512"`
+
+	// Path is the path of the expanded asset.
+	Path string `json:"path"`
+
+	// Size is the size in bytes of the expanded asset.
+	Size int64 `json:"size"`
+
+	// LastUsed is the time the asset was last used, in seconds since the
+	// Unix epoch.
+	LastUsed int64 `json:"last_used"`
+}
+
+// assetCache tracks the size of the installed assets and the time they were
+// last used, to evict the least recently used ones when they exceed a quota,
+// and to collect the ones unused for a grace period. The quota is soft, it's
+// exceeded while all the assets were used recently.
+type assetCache struct {
+	db    *bolt.DB
+	quota int64
+
+	mu     sync.Mutex
+	assets map[string]*cachedAsset
+}
+
+type cachedAsset struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+
+	// recorded is the time the asset was last used recorded in BoltDB.
+	recorded time.Time
+}
+
+// newAssetCache creates a new cache of the assets installed in db, with the
+// given quota in bytes. The cache is not limited if quota is 0. The assets
+// recorded without the time they were last used are considered used on load.
+func newAssetCache(db *bolt.DB, quota int64) (*assetCache, error) {
+	c := &assetCache{
+		db:     db,
+		quota:  quota,
+		assets: make(map[string]*cachedAsset),
+	}
+	now := time.Now()
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(assetBucketName)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(key, value []byte) error {
+			var record assetRecord
+			if err := json.Unmarshal(value, &record); err != nil {
+				return nil
+			}
+			if record.Size == 0 {
+				record.Size = dirSize(record.Path)
+			}
+			lastUsed := now
+			if record.LastUsed > 0 {
+				lastUsed = time.Unix(record.LastUsed, 0)
+			}
+			c.assets[string(key)] = &cachedAsset{
+				path:     record.Path,
+				size:     record.Size,
+				lastUsed: lastUsed,
+				recorded: lastUsed,
+			}
+			return nil
+		})
+	})
+	return c, err
+}
+
+// touch marks an asset as used, and records it in BoltDB if it wasn't for
+// lastUsedResolution.
+func (c *assetCache) touch(key string) {
+	if c == nil {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	asset, ok := c.assets[key]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	asset.lastUsed = now
+	if now.Sub(asset.recorded) < lastUsedResolution {
+		c.mu.Unlock()
+		return
+	}
+	asset.recorded = now
+	record := assetRecord{
+		Path:     asset.path,
+		Size:     asset.size,
+		LastUsed: now.Unix(),
+	}
+	c.mu.Unlock()
+
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(assetBucketName)
+		if bucket == nil || bucket.Get([]byte(key)) == nil {
+			// The asset was removed in the meantime
+			return nil
+		}
+		assetJSON, err := json.Marshal(record)
+		if err != nil {
+			panic(err)
+		}
+		return bucket.Put([]byte(key), assetJSON)
+	})
+	if err != nil {
+		logger.WithError(err).WithField("path", record.Path).Warn("couldn't record the last use of asset")
+	}
+}
+
+// add adds an installed asset, marked as used.
+func (c *assetCache) add(key string, record assetRecord) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	c.assets[key] = &cachedAsset{
+		path:     record.Path,
+		size:     record.Size,
+		lastUsed: now,
+		recorded: now,
+	}
+}
+
+// evict removes the least recently used assets, until the installed assets
+// fit in the quota.
+func (c *assetCache) evict() {
+	if c == nil || c.quota <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var total int64
+	keys := make([]string, 0, len(c.assets))
+	for key, asset := range c.assets {
+		total += asset.size
+		keys = append(keys, key)
+	}
+	if total <= c.quota {
+		return
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return c.assets[keys[i]].lastUsed.Before(c.assets[keys[j]].lastUsed)
+	})
+
+	for _, key := range keys {
+		if total <= c.quota {
+			return
+		}
+		asset := c.assets[key]
+		if time.Since(asset.lastUsed) < minEvictionAge {
+			break
+		}
+		if err := c.remove(key); err != nil {
+			logger.WithError(err).WithField("path", asset.path).Error("couldn't evict asset")
+			return
+		}
+		logger.WithField("path", asset.path).WithField("size", asset.size).Info("evicted asset exceeding the cache quota")
+		total -= asset.size
+	}
+	if total <= c.quota {
+		return
+	}
+	logger.WithField("size", total).WithField("quota", c.quota).Warn("the assets used recently exceed the cache quota")
+}
+
+// collect removes the assets unused for the grace period, or only lists them
+// if dryRun is true, and returns them.
+func (c *assetCache) collect(gracePeriod time.Duration, dryRun bool) ([]GarbageAsset, error) {
+	garbage := []GarbageAsset{}
+	if c == nil {
+		return garbage, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, asset := range c.assets {
+		if time.Since(asset.lastUsed) < gracePeriod {
+			continue
+		}
+		if !dryRun {
+			if err := c.remove(key); err != nil {
+				return garbage, err
+			}
+			logger.WithField("path", asset.path).WithField("size", asset.size).Info("removed unused asset")
+		}
+		garbage = append(garbage, GarbageAsset{
+			SHA512:   key,
+			Path:     asset.path,
+			Size:     asset.size,
+			LastUsed: asset.lastUsed.Unix(),
+		})
+	}
+	sort.Slice(garbage, func(i, j int) bool {
+		return garbage[i].LastUsed < garbage[j].LastUsed
+	})
+	return garbage, nil
+}
+
+// remove removes an asset from BoltDB and from the disk. It must be called
+// with c.mu locked.
+func (c *assetCache) remove(key string) error {
+	asset := c.assets[key]
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		if bucket := tx.Bucket(assetBucketName); bucket != nil {
+			return bucket.Delete([]byte(key))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	delete(c.assets, key)
+	if err := os.RemoveAll(asset.path); err != nil {
+		logger.WithError(err).WithField("path", asset.path).Error("couldn't remove asset")
+	}
+	return nil
+}
+
+// dirSize returns the total size of the files of a directory.
+func dirSize(path string) int64 {
+	var size int64
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
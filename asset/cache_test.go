@@ -0,0 +1,147 @@
+package asset
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// newTestAssetCache installs assets of 100 bytes in a new BoltDB, recorded
+// without size and last used time like before they were recorded, and
+// returns the cache of the assets.
+func newTestAssetCache(t *testing.T, dir string, quota int64, keys ...string) *assetCache {
+	db, err := bolt.Open(filepath.Join(dir, dbName), 0600, &bolt.Options{})
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		path := filepath.Join(dir, key)
+		require.NoError(t, os.MkdirAll(filepath.Join(path, "bin"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(path, "bin", "x"), make([]byte, 100), 0755))
+		record, err := json.Marshal(RuntimeAsset{Path: path})
+		require.NoError(t, err)
+		require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(assetBucketName)
+			if err != nil {
+				return err
+			}
+			return bucket.Put([]byte(key), record)
+		}))
+	}
+
+	cache, err := newAssetCache(db, quota)
+	require.NoError(t, err)
+	return cache
+}
+
+func assertRemoved(t *testing.T, cache *assetCache, dir, key string) {
+	t.Helper()
+	assert.NotContains(t, cache.assets, key)
+	_, err := os.Stat(filepath.Join(dir, key))
+	assert.True(t, os.IsNotExist(err))
+	require.NoError(t, cache.db.View(func(tx *bolt.Tx) error {
+		assert.Nil(t, tx.Bucket(assetBucketName).Get([]byte(key)))
+		return nil
+	}))
+}
+
+func TestAssetCacheEvict(t *testing.T) {
+	dir, err := ioutil.TempDir("", "asset-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := newTestAssetCache(t, dir, 200, "a", "b", "c")
+	defer cache.db.Close()
+	require.Len(t, cache.assets, 3)
+	assert.Equal(t, int64(100), cache.assets["a"].size)
+
+	// a was used long ago, b less long ago, c recently
+	cache.assets["a"].lastUsed = time.Now().Add(-2 * time.Hour)
+	cache.assets["b"].lastUsed = time.Now().Add(-time.Hour)
+
+	// Only a is evicted, as the assets fit in the quota then
+	cache.evict()
+	assertRemoved(t, cache, dir, "a")
+	assert.Contains(t, cache.assets, "b")
+
+	// The assets used recently are not evicted, even over the quota
+	cache.add("d", assetRecord{Path: filepath.Join(dir, "d"), Size: 100})
+	cache.evict()
+	assertRemoved(t, cache, dir, "b")
+	assert.Contains(t, cache.assets, "c")
+	assert.Contains(t, cache.assets, "d")
+
+	cache.add("e", assetRecord{Path: filepath.Join(dir, "e"), Size: 100})
+	cache.evict()
+	assert.Len(t, cache.assets, 3)
+}
+
+func TestAssetCacheCollect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "asset-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := newTestAssetCache(t, dir, 0, "a", "b", "c")
+	defer cache.db.Close()
+
+	// The quota is disabled
+	cache.evict()
+	assert.Len(t, cache.assets, 3)
+
+	cache.assets["a"].lastUsed = time.Now().Add(-48 * time.Hour)
+	cache.assets["b"].lastUsed = time.Now().Add(-25 * time.Hour)
+
+	garbage, err := cache.collect(24*time.Hour, true)
+	require.NoError(t, err)
+	require.Len(t, garbage, 2)
+	assert.Equal(t, "a", garbage[0].SHA512)
+	assert.Equal(t, filepath.Join(dir, "a"), garbage[0].Path)
+	assert.Equal(t, int64(100), garbage[0].Size)
+	assert.Equal(t, "b", garbage[1].SHA512)
+	assert.Len(t, cache.assets, 3)
+
+	garbage, err = cache.collect(24*time.Hour, false)
+	require.NoError(t, err)
+	assert.Len(t, garbage, 2)
+	assertRemoved(t, cache, dir, "a")
+	assertRemoved(t, cache, dir, "b")
+	assert.Contains(t, cache.assets, "c")
+}
+
+func TestAssetCacheTouch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "asset-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := newTestAssetCache(t, dir, 0, "a")
+	recorded := time.Now().Add(-2 * lastUsedResolution)
+	cache.assets["a"].recorded = recorded
+	cache.touch("a")
+	cache.touch("unknown")
+	require.NoError(t, cache.db.Close())
+
+	// The last used time is recorded, and loaded with the cache
+	db, err := bolt.Open(filepath.Join(dir, dbName), 0600, &bolt.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+	reloaded, err := newAssetCache(db, 0)
+	require.NoError(t, err)
+	assert.Equal(t, cache.assets["a"].lastUsed.Unix(), reloaded.assets["a"].lastUsed.Unix())
+	assert.Equal(t, int64(100), reloaded.assets["a"].size)
+}
+
+func TestNilAssetCache(t *testing.T) {
+	var cache *assetCache
+	cache.touch("a")
+	cache.add("a", assetRecord{})
+	cache.evict()
+	garbage, err := cache.collect(time.Hour, false)
+	assert.NoError(t, err)
+	assert.Empty(t, garbage)
+}
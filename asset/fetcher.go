@@ -26,6 +26,22 @@ const (
 	// DefaultAssetsBurstLimit defines the burst ceiling for a rate limited asset fetch.
 	// If 0, then the setting has no effect.
 	DefaultAssetsBurstLimit int = 100
+
+	// DefaultAssetsParallelism is the default maximum number of assets
+	// installed concurrently.
+	DefaultAssetsParallelism = 4
+
+	// DefaultAssetsFetchRetries is the default number of times the
+	// installation of an asset is retried.
+	DefaultAssetsFetchRetries = 3
+
+	// DefaultAssetsGCInterval is the default interval at which the unused
+	// assets are removed.
+	DefaultAssetsGCInterval = time.Hour
+
+	// DefaultAssetsGCGracePeriod is the default time after which the unused
+	// assets are removed.
+	DefaultAssetsGCGracePeriod = 7 * 24 * time.Hour
 )
 
 // A Fetcher fetches a file from the specified source and returns an *os.File
@@ -68,6 +84,11 @@ func httpGet(ctx context.Context, path string, headers map[string]string) (io.Re
 type httpFetcher struct {
 	URLGetter urlGetter
 	Limiter   *rate.Limiter
+	Mirrors   []Mirror
+
+	// Bandwidth limits the bytes per second downloaded by all the fetches
+	// sharing it, unless nil.
+	Bandwidth *rate.Limiter
 }
 
 // Fetch the file found at the specified url, and return the file or an
@@ -83,22 +104,72 @@ func (h *httpFetcher) Fetch(ctx context.Context, url string, headers map[string]
 		}
 	}
 
+	if mirrored := RewriteURL(url, h.Mirrors); mirrored != url {
+		logger.WithField("url", url).WithField("mirror", mirrored).Debug("fetching asset from mirror")
+		url = mirrored
+	}
+
 	resp, err := h.URLGetter(ctx, url, headers)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Close()
+
+	if h.Bandwidth != nil {
+		return writeTempFile(&limitedReader{ctx: ctx, r: resp, limiter: h.Bandwidth})
+	}
+	return writeTempFile(resp)
+}
 
-	// Write response to tmp
+// NewBandwidthLimiter returns a limiter of the given bytes per second, shared
+// by the fetches of an asset manager.
+func NewBandwidthLimiter(bytesPerSecond int) *rate.Limiter {
+	burst := bytesPerSecond
+	if burst < minBandwidthBurst {
+		burst = minBandwidthBurst
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), burst)
+}
+
+// minBandwidthBurst is the minimum number of bytes read at once by the
+// bandwidth limited fetches.
+const minBandwidthBurst = 32 * 1024
+
+// limitedReader reads from r at the rate of limiter.
+type limitedReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *rate.Limiter
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if burst := l.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	n, err := l.r.Read(p)
+	if n > 0 {
+		if werr := l.limiter.WaitN(l.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// writeTempFile writes the contents of r to a temporary file, and returns the
+// file open at its beginning.
+func writeTempFile(r io.Reader) (*os.File, error) {
 	tmpFile, err := ioutil.TempFile(os.TempDir(), "sensu-asset")
 	if err != nil {
 		return nil, fmt.Errorf("can't open tmp file for asset: %s", err)
 	}
 
 	buffered := bufio.NewWriter(tmpFile)
-	if _, err = io.Copy(buffered, resp); err != nil {
+	if _, err = io.Copy(buffered, r); err != nil {
+		tmpFile.Close()
 		return nil, fmt.Errorf("error downloading asset: %s", err)
 	}
 	if err := buffered.Flush(); err != nil {
+		tmpFile.Close()
 		return nil, fmt.Errorf("error downloading asset: %s", err)
 	}
 
@@ -1,6 +1,7 @@
 package asset
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -10,8 +11,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -85,3 +89,23 @@ func TestHTTPGetNon200(t *testing.T) {
 	assert.Nil(t, closer)
 	assert.EqualError(t, err, "error fetching asset: Response Code 404")
 }
+
+func TestFetchBandwidthLimit(t *testing.T) {
+	fetcher := &httpFetcher{
+		URLGetter: func(ctx context.Context, path string, header map[string]string) (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(make([]byte, 3*minBandwidthBurst))), nil
+		},
+		// The first burst is read at once, the next 2 in 2 * 100ms
+		Bandwidth: rate.NewLimiter(rate.Limit(10*minBandwidthBurst), minBandwidthBurst),
+	}
+	start := time.Now()
+	f, err := fetcher.Fetch(context.Background(), "asset.tar", nil)
+	require.NoError(t, err)
+	defer f.Close()
+	defer os.Remove(f.Name())
+	assert.True(t, time.Since(start) >= 150*time.Millisecond, "fetch took %s", time.Since(start))
+
+	info, err := f.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3*minBandwidthBurst), info.Size())
+}
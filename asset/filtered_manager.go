@@ -50,6 +50,7 @@ func (f *filteredManager) Get(ctx context.Context, asset *corev2.Asset) (*Runtim
 				Sha512:     build.Sha512,
 				Filters:    build.Filters,
 				Headers:    build.Headers,
+				Signature:  build.Signature,
 				ObjectMeta: asset.ObjectMeta,
 			}
 
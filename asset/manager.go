@@ -2,6 +2,7 @@ package asset
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"sync"
@@ -18,6 +19,42 @@ const (
 
 // Manager ...
 type Manager struct {
+	// Mirrors rewrite the URLs of the assets fetched.
+	Mirrors []Mirror
+
+	// BundleDir is the directory of an asset bundle the assets are fetched
+	// from before their URLs, if set.
+	BundleDir string
+
+	// TrustedKeys verify the signatures of the assets. The signatures are not
+	// verified if it is nil.
+	TrustedKeys *TrustedKeys
+
+	// RequireSignatures rejects the assets which are not signed.
+	RequireSignatures bool
+
+	// Parallelism is the maximum number of assets installed concurrently,
+	// DefaultAssetsParallelism if 0.
+	Parallelism int
+
+	// BandwidthLimit is the maximum number of bytes per second downloaded by
+	// all the asset fetches. The bandwidth is not limited if 0.
+	BandwidthLimit int
+
+	// FetchRetries is the number of times the installation of an asset is
+	// retried, with exponential backoff.
+	FetchRetries int
+
+	// CacheQuota is the size in bytes of the installed assets over which the
+	// least recently used ones are evicted. The cache is not limited if 0.
+	CacheQuota int64
+
+	// GCInterval is the interval at which the assets unused for
+	// GCGracePeriod are removed. They are not removed periodically if 0.
+	GCInterval    time.Duration
+	GCGracePeriod time.Duration
+
+	cache    *assetCache
 	cacheDir string
 	entity   *types.Entity
 	stopping chan struct{}
@@ -55,8 +92,73 @@ func (m *Manager) StartAssetManager(ctx context.Context, limiter *rate.Limiter)
 			logger.Debug(err)
 		}
 	}()
-	boltDBGetter := NewBoltDBGetter(
-		db, m.cacheDir, nil, nil, nil, limiter)
+	httpFetcher := &httpFetcher{
+		Limiter: limiter,
+		Mirrors: m.Mirrors,
+	}
+	if m.BandwidthLimit > 0 {
+		httpFetcher.Bandwidth = NewBandwidthLimiter(m.BandwidthLimit)
+	}
+	var fetcher Fetcher = httpFetcher
+	if m.BundleDir != "" {
+		fetcher = &bundleFetcher{dir: m.BundleDir, next: fetcher}
+	}
+	boltDBGetter := newBoltDBAssetManager(
+		db, m.cacheDir, fetcher, nil, nil, limiter)
+	boltDBGetter.trustedKeys = m.TrustedKeys
+	boltDBGetter.requireSignatures = m.RequireSignatures
+	parallelism := m.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultAssetsParallelism
+	}
+	boltDBGetter.slots = make(chan struct{}, parallelism)
+	boltDBGetter.fetchRetries = m.FetchRetries
+	if boltDBGetter.cache, err = newAssetCache(db, m.CacheQuota); err != nil {
+		return nil, err
+	}
+	boltDBGetter.cache.evict()
+	m.cache = boltDBGetter.cache
+
+	if m.GCInterval > 0 {
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			m.runGarbageCollection(ctx)
+		}()
+	}
 
 	return NewFilteredManager(boltDBGetter, m.entity), nil
 }
+
+// CollectGarbage removes the installed assets unused for the grace period, or
+// only lists them if dryRun is true, and returns them. The assets are used
+// when the checks, hooks or handlers referencing them are executed, so the
+// grace period must exceed the intervals of their executions.
+func (m *Manager) CollectGarbage(gracePeriod time.Duration, dryRun bool) ([]GarbageAsset, error) {
+	if m.cache == nil {
+		return nil, errors.New("the asset manager is not started")
+	}
+	return m.cache.collect(gracePeriod, dryRun)
+}
+
+// runGarbageCollection removes the assets unused for the grace period at each
+// interval, until ctx is done.
+func (m *Manager) runGarbageCollection(ctx context.Context) {
+	ticker := time.NewTicker(m.GCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		garbage, err := m.CollectGarbage(m.GCGracePeriod, false)
+		if err != nil {
+			logger.WithError(err).Error("error removing unused assets")
+			continue
+		}
+		if len(garbage) > 0 {
+			logger.WithField("assets", len(garbage)).Info("removed unused assets")
+		}
+	}
+}
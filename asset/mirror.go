@@ -0,0 +1,46 @@
+package asset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Mirror rewrites the URLs of the assets starting with Source to start with
+// Target instead, so that assets published on e.g. Bonsai or GitHub can be
+// fetched from an internal artifact server.
+type Mirror struct {
+	Source string
+	Target string
+}
+
+// ParseMirrors parses mirrors specified as SOURCE=TARGET URL prefixes, e.g.
+// https://github.com/=https://artifacts.example.com/github/.
+func ParseMirrors(specs []string) ([]Mirror, error) {
+	mirrors := make([]Mirror, 0, len(specs))
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid asset mirror %q: expected SOURCE=TARGET", spec)
+		}
+		mirrors = append(mirrors, Mirror{Source: parts[0], Target: parts[1]})
+	}
+	return mirrors, nil
+}
+
+// RewriteURL rewrites url with the mirror of the longest source it starts
+// with, and returns it unchanged if no mirror applies.
+func RewriteURL(url string, mirrors []Mirror) string {
+	var match *Mirror
+	for i, mirror := range mirrors {
+		if !strings.HasPrefix(url, mirror.Source) {
+			continue
+		}
+		if match == nil || len(mirror.Source) > len(match.Source) {
+			match = &mirrors[i]
+		}
+	}
+	if match == nil {
+		return url
+	}
+	return match.Target + strings.TrimPrefix(url, match.Source)
+}
@@ -0,0 +1,60 @@
+package asset
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMirrors(t *testing.T) {
+	mirrors, err := ParseMirrors([]string{"https://github.com/=https://artifacts.example.com/github/"})
+	require.NoError(t, err)
+	assert.Equal(t, []Mirror{{Source: "https://github.com/", Target: "https://artifacts.example.com/github/"}}, mirrors)
+
+	for _, spec := range []string{"https://github.com/", "=https://artifacts.example.com/", "https://github.com/="} {
+		_, err := ParseMirrors([]string{spec})
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestRewriteURL(t *testing.T) {
+	mirrors := []Mirror{
+		{Source: "https://github.com/", Target: "https://artifacts.example.com/github/"},
+		{Source: "https://github.com/sensu/", Target: "https://artifacts.example.com/sensu/"},
+		{Source: "https://assets.bonsai.sensu.io/", Target: "https://artifacts.example.com/bonsai/"},
+	}
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/foo/bar.tar.gz", "https://artifacts.example.com/github/foo/bar.tar.gz"},
+		{"https://github.com/sensu/bar.tar.gz", "https://artifacts.example.com/sensu/bar.tar.gz"},
+		{"https://assets.bonsai.sensu.io/123/bar.tar.gz", "https://artifacts.example.com/bonsai/123/bar.tar.gz"},
+		{"https://example.com/bar.tar.gz", "https://example.com/bar.tar.gz"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, RewriteURL(tt.url, mirrors))
+	}
+}
+
+func TestFetchMirroredAsset(t *testing.T) {
+	var fetched string
+	fetcher := &httpFetcher{
+		URLGetter: func(ctx context.Context, url string, headers map[string]string) (io.ReadCloser, error) {
+			fetched = url
+			return ioutil.NopCloser(strings.NewReader("asset")), nil
+		},
+		Mirrors: []Mirror{{Source: "https://github.com/", Target: "https://artifacts.example.com/"}},
+	}
+	f, err := fetcher.Fetch(context.Background(), "https://github.com/foo.tar", nil)
+	require.NoError(t, err)
+	defer f.Close()
+	defer os.Remove(f.Name())
+	assert.Equal(t, "https://artifacts.example.com/foo.tar", fetched)
+}
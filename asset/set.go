@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/sensu/sensu-go/types"
 )
@@ -46,13 +47,43 @@ func (r RuntimeAssetSet) Scripts() (map[string]io.ReadCloser, error) {
 	return scripts, nil
 }
 
-// GetAll gets a list of assets with the provided getter.
+// WASMModule returns the path of the WebAssembly module named name, looking
+// for it in the lib directory of the assets. Modules outside of the lib
+// directories are not found.
+func (r RuntimeAssetSet) WASMModule(name string) (string, error) {
+	for _, asset := range r {
+		libDir := asset.LibDir()
+		path := filepath.Join(libDir, filepath.FromSlash(name))
+		rel, err := filepath.Rel(libDir, path)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("wasm module %q not found in the runtime assets", name)
+}
+
+// GetAll gets a list of assets with the provided getter, concurrently. The
+// runtime assets are returned in the order of the assets.
 func GetAll(ctx context.Context, getter Getter, assets []types.Asset) (RuntimeAssetSet, error) {
+	results := make([]*RuntimeAsset, len(assets))
+	errs := make([]error, len(assets))
+	var wg sync.WaitGroup
+	for i := range assets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = getter.Get(ctx, &assets[i])
+		}(i)
+	}
+	wg.Wait()
+
 	runtimeAssets := make([]*RuntimeAsset, 0, len(assets))
-	for _, asset := range assets {
-		runtimeAsset, err := getter.Get(ctx, &asset)
-		if err != nil {
-			return nil, err
+	for i, runtimeAsset := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
 		}
 		if runtimeAsset != nil {
 			runtimeAssets = append(runtimeAssets, runtimeAsset)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -115,3 +116,41 @@ func TestEnvContainsParentEnv(t *testing.T) {
 	assert.True(t, keyFound)
 	os.Setenv(envKey, oldEnv)
 }
+
+func TestWASMModule(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "sensu-asset-wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	runtimeAsset := &RuntimeAsset{Path: tmpDir}
+	modulePath := filepath.Join(runtimeAsset.LibDir(), "filters", "module.wasm")
+	if err := os.MkdirAll(filepath.Dir(modulePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(modulePath, []byte{0x00, 0x61, 0x73, 0x6d}, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	runtimeAssetSet := append(fixtureRuntimeAssets(), runtimeAsset)
+	path, err := runtimeAssetSet.WASMModule("filters/module.wasm")
+	assert.NoError(t, err)
+	assert.Equal(t, modulePath, path)
+
+	_, err = runtimeAssetSet.WASMModule("filters")
+	assert.Error(t, err)
+
+	_, err = runtimeAssetSet.WASMModule("missing.wasm")
+	assert.Error(t, err)
+
+	// The modules outside of the lib directory are not found
+	outsidePath := filepath.Join(tmpDir, "outside.wasm")
+	if err := ioutil.WriteFile(outsidePath, []byte{0x00, 0x61, 0x73, 0x6d}, 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = runtimeAssetSet.WASMModule("../outside.wasm")
+	assert.Error(t, err)
+	_, err = runtimeAssetSet.WASMModule("filters/../../outside.wasm")
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package asset
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"golang.org/x/crypto/openpgp"
+)
+
+const armorPrefix = "-----BEGIN "
+
+// TrustedKeys are the public keys the signatures of the assets are verified
+// against: GPG keys for the gpg signatures, and ECDSA or RSA keys for the
+// cosign signatures.
+type TrustedKeys struct {
+	gpg    openpgp.EntityList
+	cosign []crypto.PublicKey
+}
+
+// LoadTrustedKeys loads the trusted keys of the given files, each holding
+// either GPG public keys, armored or binary, or PEM encoded public keys.
+func LoadTrustedKeys(paths []string) (*TrustedKeys, error) {
+	keys := &TrustedKeys{}
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("can't read trusted key: %s", err)
+		}
+		if err := keys.add(b); err != nil {
+			return nil, fmt.Errorf("invalid trusted key %s: %s", path, err)
+		}
+	}
+	return keys, nil
+}
+
+func (k *TrustedKeys) add(b []byte) error {
+	if bytes.Contains(b, []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----")) {
+		entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		k.gpg = append(k.gpg, entities...)
+		return nil
+	}
+	if !bytes.Contains(b, []byte(armorPrefix)) {
+		entities, err := openpgp.ReadKeyRing(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		k.gpg = append(k.gpg, entities...)
+		return nil
+	}
+	found := false
+	for block, rest := pem.Decode(b); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+		switch key.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey:
+		default:
+			return fmt.Errorf("unsupported public key type %T", key)
+		}
+		k.cosign = append(k.cosign, key)
+		found = true
+	}
+	if !found {
+		return errors.New("no public key found")
+	}
+	return nil
+}
+
+// Verify verifies the signature of an asset archive, of the given type,
+// against the trusted keys. The archive is rewound once verified.
+func (k *TrustedKeys) Verify(archive io.ReadSeeker, signatureType string, signature []byte) error {
+	var err error
+	switch signatureType {
+	case corev2.AssetSignatureGPG:
+		err = k.verifyGPG(archive, signature)
+	case corev2.AssetSignatureCosign:
+		err = k.verifyCosign(archive, signature)
+	default:
+		err = fmt.Errorf("unsupported signature type %q", signatureType)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = archive.Seek(0, 0)
+	return err
+}
+
+func (k *TrustedKeys) verifyGPG(archive io.Reader, signature []byte) error {
+	if len(k.gpg) == 0 {
+		return errors.New("no trusted gpg key")
+	}
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte(armorPrefix)) {
+		_, err = openpgp.CheckArmoredDetachedSignature(k.gpg, archive, bytes.NewReader(signature))
+	} else {
+		_, err = openpgp.CheckDetachedSignature(k.gpg, archive, bytes.NewReader(signature))
+	}
+	if err != nil {
+		return fmt.Errorf("gpg signature verification failed: %s", err)
+	}
+	return nil
+}
+
+func (k *TrustedKeys) verifyCosign(archive io.Reader, signature []byte) error {
+	if len(k.cosign) == 0 {
+		return errors.New("no trusted cosign key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil {
+		return fmt.Errorf("invalid cosign signature: %s", err)
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, archive); err != nil {
+		return err
+	}
+	digest := h.Sum(nil)
+	for _, key := range k.cosign {
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			var rs struct{ R, S *big.Int }
+			if _, err := asn1.Unmarshal(sig, &rs); err == nil && ecdsa.Verify(key, digest, rs.R, rs.S) {
+				return nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, sig) == nil {
+				return nil
+			}
+		}
+	}
+	return errors.New("cosign signature verification failed: no trusted key matches the signature")
+}
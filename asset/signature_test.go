@@ -0,0 +1,172 @@
+package asset
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+const archive = "asset archive"
+
+func writeKey(t *testing.T, dir, name string, key []byte) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, key, 0644))
+	return path
+}
+
+func pemPublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestVerifyGPGSignature(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trusted-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	entity, err := openpgp.NewEntity("sensu", "", "sensu@example.com", nil)
+	require.NoError(t, err)
+	var armored bytes.Buffer
+	w, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	keys, err := LoadTrustedKeys([]string{writeKey(t, dir, "key.asc", armored.Bytes())})
+	require.NoError(t, err)
+
+	var signature bytes.Buffer
+	require.NoError(t, openpgp.ArmoredDetachSign(&signature, entity, strings.NewReader(archive), nil))
+	assert.NoError(t, keys.Verify(strings.NewReader(archive), corev2.AssetSignatureGPG, signature.Bytes()))
+
+	signature.Reset()
+	require.NoError(t, openpgp.DetachSign(&signature, entity, strings.NewReader(archive), nil))
+	assert.NoError(t, keys.Verify(strings.NewReader(archive), corev2.AssetSignatureGPG, signature.Bytes()))
+
+	assert.Error(t, keys.Verify(strings.NewReader("tampered"), corev2.AssetSignatureGPG, signature.Bytes()))
+	assert.Error(t, keys.Verify(strings.NewReader(archive), corev2.AssetSignatureCosign, signature.Bytes()))
+}
+
+func TestVerifyCosignSignature(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trusted-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keys, err := LoadTrustedKeys([]string{
+		writeKey(t, dir, "ec.pub", pemPublicKey(t, ecKey.Public())),
+		writeKey(t, dir, "rsa.pub", pemPublicKey(t, rsaKey.Public())),
+	})
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte(archive))
+	for _, signer := range []crypto.Signer{ecKey, rsaKey} {
+		sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		signature := []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+		assert.NoError(t, keys.Verify(strings.NewReader(archive), corev2.AssetSignatureCosign, signature))
+		assert.Error(t, keys.Verify(strings.NewReader("tampered"), corev2.AssetSignatureCosign, signature))
+	}
+
+	untrusted, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	sig, err := untrusted.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	assert.Error(t, keys.Verify(strings.NewReader(archive), corev2.AssetSignatureCosign, []byte(base64.StdEncoding.EncodeToString(sig))))
+	assert.Error(t, keys.Verify(strings.NewReader(archive), corev2.AssetSignatureGPG, []byte(base64.StdEncoding.EncodeToString(sig))))
+}
+
+func TestLoadInvalidTrustedKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trusted-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = LoadTrustedKeys([]string{filepath.Join(dir, "missing")})
+	assert.Error(t, err)
+	_, err = LoadTrustedKeys([]string{writeKey(t, dir, "cert.pem", []byte("-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"))})
+	assert.Error(t, err)
+	_, err = LoadTrustedKeys([]string{writeKey(t, dir, "garbage", []byte("garbage"))})
+	assert.Error(t, err)
+}
+
+func TestVerifyAssetSignature(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trusted-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keys, err := LoadTrustedKeys([]string{writeKey(t, dir, "ec.pub", pemPublicKey(t, key.Public()))})
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(archive))
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(sig)
+
+	archiveFile, err := writeTempFile(strings.NewReader(archive))
+	require.NoError(t, err)
+	defer archiveFile.Close()
+	defer os.Remove(archiveFile.Name())
+
+	fetcher := &httpFetcher{
+		URLGetter: func(ctx context.Context, url string, headers map[string]string) (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader(signature)), nil
+		},
+	}
+	signed := &corev2.Asset{
+		ObjectMeta: corev2.ObjectMeta{Name: "signed"},
+		Signature:  &corev2.AssetSignature{Type: corev2.AssetSignatureCosign, URL: "https://localhost/asset.sig"},
+	}
+	unsigned := &corev2.Asset{ObjectMeta: corev2.ObjectMeta{Name: "unsigned"}}
+
+	tests := []struct {
+		name    string
+		manager *boltDBAssetManager
+		asset   *corev2.Asset
+		wantErr bool
+	}{
+		{"signed", &boltDBAssetManager{fetcher: fetcher, trustedKeys: keys}, signed, false},
+		{"unsigned", &boltDBAssetManager{fetcher: fetcher, trustedKeys: keys}, unsigned, false},
+		{"unsigned but required", &boltDBAssetManager{fetcher: fetcher, trustedKeys: keys, requireSignatures: true}, unsigned, true},
+		{"no trusted keys", &boltDBAssetManager{fetcher: fetcher}, signed, false},
+		{"no trusted keys but required", &boltDBAssetManager{fetcher: fetcher, requireSignatures: true}, signed, true},
+		{"tampered", &boltDBAssetManager{fetcher: fetcher, trustedKeys: keys}, &corev2.Asset{
+			ObjectMeta: signed.ObjectMeta,
+			Signature:  &corev2.AssetSignature{Type: corev2.AssetSignatureGPG, URL: signed.Signature.URL},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.manager.verifySignature(context.Background(), tt.asset, archiveFile)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+// Package acmed obtains and renews the TLS certificate of the API and the
+// dashboard from an ACME certificate authority, such as Let's Encrypt.
+package acmed
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// ChallengeHTTP01 proves the control of the domains by serving a token
+	// over HTTP on port 80.
+	ChallengeHTTP01 = "http-01"
+
+	// ChallengeDNS01 proves the control of the domains by publishing a TXT
+	// record with the DNS hook.
+	ChallengeDNS01 = "dns-01"
+
+	// DefaultDirectoryURL is the directory of the Let's Encrypt production
+	// certificate authority.
+	DefaultDirectoryURL = acme.LetsEncryptURL
+
+	// DefaultHTTPAddress is the address of the HTTP-01 challenge listener.
+	DefaultHTTPAddress = ":80"
+
+	// DefaultRenewBefore is how long before its expiration the certificate
+	// is renewed.
+	DefaultRenewBefore = 30 * 24 * time.Hour
+
+	// checkInterval is the interval at which the certificate is checked for
+	// renewal.
+	checkInterval = 12 * time.Hour
+
+	// retryDelay is the delay before retrying to obtain the certificate,
+	// long enough to stay within the rate limits of Let's Encrypt.
+	retryDelay = 10 * time.Minute
+
+	// challengePathPrefix is the path of the HTTP-01 challenge tokens.
+	challengePathPrefix = "/.well-known/acme-challenge/"
+
+	certKey         = "cert"
+	accountKey      = "account.key"
+	httpTokenPrefix = "http-01/"
+)
+
+// locker serializes the orders of the backends of the cluster.
+type locker interface {
+	Lock(ctx context.Context) error
+	Unlock(ctx context.Context) error
+}
+
+// Config configures Acmed.
+type Config struct {
+	// Domains are the DNS names of the certificate. The first one is its
+	// common name.
+	Domains []string
+
+	// Email is the contact of the ACME account, notified of the problems
+	// with the certificate.
+	Email string
+
+	// DirectoryURL is the ACME directory of the certificate authority,
+	// DefaultDirectoryURL if empty.
+	DirectoryURL string
+
+	// Challenge is either ChallengeHTTP01, the default, or ChallengeDNS01.
+	Challenge string
+
+	// HTTPAddress is the address of the HTTP-01 challenge listener,
+	// DefaultHTTPAddress if empty.
+	HTTPAddress string
+
+	// DNSHook is the command publishing the DNS-01 challenge records,
+	// executed as "<hook> present|cleanup <fqdn> <value>". It must only
+	// return once the record is published.
+	DNSHook string
+
+	// RenewBefore is how long before its expiration the certificate is
+	// renewed, DefaultRenewBefore if zero.
+	RenewBefore time.Duration
+
+	// Client is the etcd client storing the account and the certificate.
+	Client *clientv3.Client
+}
+
+// Acmed is the ACME client daemon.
+type Acmed struct {
+	domains      []string
+	email        string
+	directoryURL string
+	challenge    string
+	dnsHook      string
+	renewBefore  time.Duration
+	client       *clientv3.Client
+	cache        Cache
+	locker       locker
+	cert         atomic.Value
+	httpServer   *http.Server
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	errChan      chan error
+	now          func() time.Time
+}
+
+// New creates a new Acmed.
+func New(c Config) (*Acmed, error) {
+	if len(c.Domains) == 0 {
+		return nil, errors.New("at least one ACME domain must be set")
+	}
+	a := &Acmed{
+		domains:      c.Domains,
+		email:        c.Email,
+		directoryURL: c.DirectoryURL,
+		challenge:    c.Challenge,
+		dnsHook:      c.DNSHook,
+		renewBefore:  c.RenewBefore,
+		client:       c.Client,
+		cache:        &EtcdCache{Client: c.Client},
+		errChan:      make(chan error, 1),
+		now:          time.Now,
+	}
+	a.ctx, a.cancel = context.WithCancel(context.Background())
+	if a.directoryURL == "" {
+		a.directoryURL = DefaultDirectoryURL
+	}
+	if a.renewBefore == 0 {
+		a.renewBefore = DefaultRenewBefore
+	}
+	switch a.challenge {
+	case "", ChallengeHTTP01:
+		a.challenge = ChallengeHTTP01
+		address := c.HTTPAddress
+		if address == "" {
+			address = DefaultHTTPAddress
+		}
+		a.httpServer = &http.Server{
+			Addr:         address,
+			Handler:      a.HTTPHandler(),
+			ReadTimeout:  15 * time.Second,
+			WriteTimeout: 15 * time.Second,
+		}
+	case ChallengeDNS01:
+		if a.dnsHook == "" {
+			return nil, errors.New("the ACME dns-01 challenge requires a DNS hook")
+		}
+	default:
+		return nil, fmt.Errorf("ACME challenge %q is not supported", a.challenge)
+	}
+	return a, nil
+}
+
+// Start obtains the certificate, if it's not already stored, in the
+// background and renews it before its expiration.
+func (a *Acmed) Start() error {
+	session, err := concurrency.NewSession(a.client)
+	if err != nil {
+		return fmt.Errorf("failed to start acmed: %s", err)
+	}
+	a.locker = concurrency.NewMutex(session, acmePath(".lock"))
+
+	if a.httpServer != nil {
+		logger.Info("starting the ACME http-01 challenge listener on address: ", a.httpServer.Addr)
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			if err := a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				a.errChan <- fmt.Errorf("acmed failed while serving: %s", err)
+			}
+		}()
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer session.Close()
+		for {
+			delay := a.renew(a.ctx)
+			select {
+			case <-a.ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops Acmed.
+func (a *Acmed) Stop() error {
+	a.cancel()
+	if a.httpServer != nil {
+		if err := a.httpServer.Shutdown(context.TODO()); err != nil {
+			_ = a.httpServer.Close()
+		}
+	}
+	a.wg.Wait()
+	close(a.errChan)
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (a *Acmed) Err() <-chan error {
+	return a.errChan
+}
+
+// Name returns the daemon name
+func (a *Acmed) Name() string {
+	return "acmed"
+}
+
+// GetCertificate returns the certificate obtained from the certificate
+// authority. It is meant to be used as tls.Config.GetCertificate.
+func (a *Acmed) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, _ := a.cert.Load().(*tls.Certificate)
+	if cert == nil {
+		return nil, errors.New("the ACME certificate has not been obtained yet")
+	}
+	return cert, nil
+}
+
+// HTTPHandler serves the tokens of the HTTP-01 challenges.
+func (a *Acmed) HTTPHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, challengePathPrefix) {
+			http.NotFound(w, r)
+			return
+		}
+		token := strings.TrimPrefix(r.URL.Path, challengePathPrefix)
+		data, err := a.cache.Get(r.Context(), httpTokenPrefix+token)
+		if err == errCacheMiss {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			logger.WithError(err).Error("could not get the ACME http-01 challenge token")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write(data)
+	})
+}
+
+// renew obtains the certificate if it's missing or expiring, and returns the
+// delay before the next check.
+func (a *Acmed) renew(ctx context.Context) time.Duration {
+	if err := a.ensure(ctx); err != nil {
+		if ctx.Err() == nil {
+			logger.WithError(err).Error("could not obtain the ACME certificate, retrying in ", retryDelay)
+		}
+		return retryDelay
+	}
+	return checkInterval
+}
+
+// ensure loads the certificate stored in etcd, and obtains a new one if it's
+// missing or expiring.
+func (a *Acmed) ensure(ctx context.Context) error {
+	if err := a.load(ctx); err != nil {
+		return err
+	}
+	if !a.expiring() {
+		return nil
+	}
+
+	if a.locker != nil {
+		if err := a.locker.Lock(ctx); err != nil {
+			return err
+		}
+		defer func() {
+			_ = a.locker.Unlock(context.Background())
+		}()
+		// Another backend may have renewed the certificate in the meantime
+		if err := a.load(ctx); err != nil {
+			return err
+		}
+		if !a.expiring() {
+			return nil
+		}
+	}
+
+	logger.WithField("domains", a.domains).Info("obtaining a new ACME certificate")
+	data, err := a.obtain(ctx)
+	if err != nil {
+		return err
+	}
+	if err := a.cache.Put(ctx, certKey, data); err != nil {
+		return err
+	}
+	return a.load(ctx)
+}
+
+// load loads the certificate stored in etcd, if any.
+func (a *Acmed) load(ctx context.Context) error {
+	data, err := a.cache.Get(ctx, certKey)
+	if err == errCacheMiss {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	cert, err := parseCert(data)
+	if err != nil {
+		return err
+	}
+	a.cert.Store(cert)
+	return nil
+}
+
+// expiring returns whether the certificate is missing, expires within
+// renewBefore or does not cover the configured domains.
+func (a *Acmed) expiring() bool {
+	cert, _ := a.cert.Load().(*tls.Certificate)
+	if cert == nil || cert.Leaf == nil {
+		return true
+	}
+	if a.now().Add(a.renewBefore).After(cert.Leaf.NotAfter) {
+		return true
+	}
+	for _, domain := range a.domains {
+		if err := cert.Leaf.VerifyHostname(domain); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCert parses a private key and its certificate chain in PEM format.
+func parseCert(data []byte) (*tls.Certificate, error) {
+	var certPEM, keyPEM []byte
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			certPEM = append(certPEM, pem.EncodeToMemory(block)...)
+		} else {
+			keyPEM = pem.EncodeToMemory(block)
+		}
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ACME certificate: %s", err)
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid ACME certificate: %s", err)
+	}
+	return &cert, nil
+}
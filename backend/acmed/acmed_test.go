@@ -0,0 +1,197 @@
+package acmed
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryCache struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{data: map[string][]byte{}}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.data[key]
+	if !ok {
+		return nil, errCacheMiss
+	}
+	return data, nil
+}
+
+func (c *memoryCache) Put(ctx context.Context, key string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[key] = data
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.data, key)
+	return nil
+}
+
+// certPEM returns a self-signed certificate for the domains and its key, in
+// the format stored in the cache.
+func certPEM(t *testing.T, notAfter time.Time, domains ...string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domains[0]},
+		DNSNames:     domains,
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, pem.Encode(&buf, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	require.NoError(t, pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	return buf.Bytes()
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{})
+	assert.Error(t, err)
+
+	a, err := New(Config{Domains: []string{"sensu.example.com"}})
+	require.NoError(t, err)
+	assert.Equal(t, ChallengeHTTP01, a.challenge)
+	assert.Equal(t, DefaultDirectoryURL, a.directoryURL)
+	assert.Equal(t, DefaultHTTPAddress, a.httpServer.Addr)
+
+	_, err = New(Config{Domains: []string{"sensu.example.com"}, Challenge: ChallengeDNS01})
+	assert.Error(t, err)
+
+	a, err = New(Config{Domains: []string{"sensu.example.com"}, Challenge: ChallengeDNS01, DNSHook: "/bin/true"})
+	require.NoError(t, err)
+	assert.Nil(t, a.httpServer)
+
+	_, err = New(Config{Domains: []string{"sensu.example.com"}, Challenge: "tls-alpn-01"})
+	assert.Error(t, err)
+}
+
+func TestLoadAndExpiring(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		description string
+		cert        []byte
+		expiring    bool
+	}{
+		{description: "missing certificate", expiring: true},
+		{description: "valid certificate", cert: certPEM(t, now.Add(60*24*time.Hour), "sensu.example.com")},
+		{description: "expiring certificate", cert: certPEM(t, now.Add(10*24*time.Hour), "sensu.example.com"), expiring: true},
+		{description: "other domain", cert: certPEM(t, now.Add(60*24*time.Hour), "other.example.com"), expiring: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			cache := newMemoryCache()
+			if tc.cert != nil {
+				require.NoError(t, cache.Put(context.Background(), certKey, tc.cert))
+			}
+			a := &Acmed{
+				domains:     []string{"sensu.example.com"},
+				renewBefore: DefaultRenewBefore,
+				cache:       cache,
+				now:         func() time.Time { return now },
+			}
+			require.NoError(t, a.load(context.Background()))
+			assert.Equal(t, tc.expiring, a.expiring())
+
+			cert, err := a.GetCertificate(nil)
+			if tc.cert == nil {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, cert.Leaf)
+			}
+		})
+	}
+}
+
+func TestEnsureKeepsValidCertificate(t *testing.T) {
+	cache := newMemoryCache()
+	require.NoError(t, cache.Put(context.Background(), certKey, certPEM(t, time.Now().Add(60*24*time.Hour), "sensu.example.com")))
+	a := &Acmed{
+		domains:     []string{"sensu.example.com"},
+		renewBefore: DefaultRenewBefore,
+		cache:       cache,
+		now:         time.Now,
+	}
+	assert.NoError(t, a.ensure(context.Background()))
+	assert.Equal(t, checkInterval, a.renew(context.Background()))
+}
+
+func TestHTTPHandler(t *testing.T) {
+	cache := newMemoryCache()
+	require.NoError(t, cache.Put(context.Background(), httpTokenPrefix+"token", []byte("token.thumbprint")))
+	a := &Acmed{cache: cache}
+
+	tests := []struct {
+		path string
+		code int
+		body string
+	}{
+		{path: challengePathPrefix + "token", code: http.StatusOK, body: "token.thumbprint"},
+		{path: challengePathPrefix + "other", code: http.StatusNotFound},
+		{path: "/", code: http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		w := httptest.NewRecorder()
+		a.HTTPHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+		assert.Equal(t, tc.code, w.Code, tc.path)
+		if tc.body != "" {
+			assert.Equal(t, tc.body, w.Body.String())
+		}
+	}
+}
+
+func TestRunDNSHook(t *testing.T) {
+	dir, err := ioutil.TempDir("", "acmed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	out := filepath.Join(dir, "out")
+	hook := filepath.Join(dir, "hook.sh")
+	script := "#!/bin/sh\necho \"$@\" >> " + out + "\n[ \"$1\" != fail ]\n"
+	require.NoError(t, ioutil.WriteFile(hook, []byte(script), 0700))
+
+	a := &Acmed{dnsHook: hook}
+	require.NoError(t, a.runDNSHook(context.Background(), "present", "_acme-challenge.sensu.example.com", "value"))
+	assert.Error(t, a.runDNSHook(context.Background(), "fail", "_acme-challenge.sensu.example.com", "value"))
+
+	data, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Equal(t, "present _acme-challenge.sensu.example.com value", lines[0])
+}
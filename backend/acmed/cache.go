@@ -0,0 +1,65 @@
+package acmed
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store/etcd"
+)
+
+// acmePathPrefix is the etcd path prefix of the ACME account, certificate and
+// HTTP-01 challenge tokens, shared by the backends of the cluster.
+const acmePathPrefix = "acme"
+
+// errCacheMiss is returned by a Cache when the key does not exist.
+var errCacheMiss = errors.New("acme cache miss")
+
+// Cache stores the data of the ACME client.
+type Cache interface {
+	// Get returns the data of the key, or errCacheMiss.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores the data of the key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete deletes the key, if it exists.
+	Delete(ctx context.Context, key string) error
+}
+
+// EtcdCache is a Cache storing the data in etcd, so that the certificates are
+// only obtained once for the cluster and that any backend can answer the
+// HTTP-01 challenges.
+type EtcdCache struct {
+	Client *clientv3.Client
+}
+
+// acmePath returns the etcd path of the given key.
+func acmePath(key string) string {
+	return path.Join(etcd.EtcdRoot, acmePathPrefix, key)
+}
+
+// Get returns the data of the key, or errCacheMiss.
+func (c *EtcdCache) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := c.Client.Get(ctx, acmePath(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, errCacheMiss
+	}
+	return resp.Kvs[0].Value, nil
+}
+
+// Put stores the data of the key.
+func (c *EtcdCache) Put(ctx context.Context, key string, data []byte) error {
+	_, err := c.Client.Put(ctx, acmePath(key), string(data))
+	return err
+}
+
+// Delete deletes the key, if it exists.
+func (c *EtcdCache) Delete(ctx context.Context, key string) error {
+	_, err := c.Client.Delete(ctx, acmePath(key))
+	return err
+}
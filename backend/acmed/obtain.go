@@ -0,0 +1,197 @@
+package acmed
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"os/exec"
+
+	"golang.org/x/crypto/acme"
+)
+
+// obtain orders a certificate for the domains, and returns its private key
+// and certificate chain in PEM format.
+func (a *Acmed) obtain(ctx context.Context) ([]byte, error) {
+	client, err := a.acmeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(a.domains...))
+	if err != nil {
+		return nil, fmt.Errorf("could not order the certificate: %s", err)
+	}
+	for _, url := range order.AuthzURLs {
+		if err := a.authorize(ctx, client, url); err != nil {
+			return nil, err
+		}
+	}
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("the certificate order failed: %s", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: a.domains[0]},
+		DNSNames: a.domains,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not issue the certificate: %s", err)
+	}
+
+	var buf bytes.Buffer
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	_ = pem.Encode(&buf, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	for _, der := range chain {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+	return buf.Bytes(), nil
+}
+
+// acmeClient returns a client of the certificate authority, registering the
+// account on first use.
+func (a *Acmed) acmeClient(ctx context.Context) (*acme.Client, error) {
+	key, err := a.accountKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{Key: key, DirectoryURL: a.directoryURL}
+	account := &acme.Account{}
+	if a.email != "" {
+		account.Contact = []string{"mailto:" + a.email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return nil, fmt.Errorf("could not register the ACME account: %s", err)
+	}
+	return client, nil
+}
+
+// accountKey returns the private key of the ACME account, generating it on
+// first use.
+func (a *Acmed) accountKey(ctx context.Context) (crypto.Signer, error) {
+	data, err := a.cache.Get(ctx, accountKey)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("invalid ACME account key")
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	} else if err != errCacheMiss {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	data = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	if err := a.cache.Put(ctx, accountKey, data); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// authorize proves the control of the domain of the authorization with the
+// configured challenge.
+func (a *Acmed) authorize(ctx context.Context, client *acme.Client, url string) error {
+	authz, err := client.GetAuthorization(ctx, url)
+	if err != nil {
+		return err
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+	domain := authz.Identifier.Value
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == a.challenge {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("the certificate authority does not offer the %s challenge for %s", a.challenge, domain)
+	}
+
+	cleanup, err := a.fulfill(ctx, client, domain, challenge)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("could not accept the %s challenge for %s: %s", a.challenge, domain, err)
+	}
+	if _, err := client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("the %s challenge for %s failed: %s", a.challenge, domain, err)
+	}
+	return nil
+}
+
+// fulfill prepares the response to the challenge, and returns the function
+// cleaning it up.
+func (a *Acmed) fulfill(ctx context.Context, client *acme.Client, domain string, challenge *acme.Challenge) (func(), error) {
+	switch a.challenge {
+	case ChallengeHTTP01:
+		response, err := client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		key := httpTokenPrefix + challenge.Token
+		if err := a.cache.Put(ctx, key, []byte(response)); err != nil {
+			return nil, err
+		}
+		return func() {
+			_ = a.cache.Delete(context.Background(), key)
+		}, nil
+
+	case ChallengeDNS01:
+		record, err := client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		fqdn := "_acme-challenge." + domain
+		if err := a.runDNSHook(ctx, "present", fqdn, record); err != nil {
+			return nil, err
+		}
+		return func() {
+			if err := a.runDNSHook(context.Background(), "cleanup", fqdn, record); err != nil {
+				logger.WithError(err).Warn("could not clean up the ACME dns-01 challenge record")
+			}
+		}, nil
+	}
+	return nil, fmt.Errorf("ACME challenge %q is not supported", a.challenge)
+}
+
+// runDNSHook executes the DNS hook to present or clean up the TXT record of
+// a DNS-01 challenge.
+func (a *Acmed) runDNSHook(ctx context.Context, action, fqdn, value string) error {
+	output, err := exec.CommandContext(ctx, a.dnsHook, action, fqdn, value).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("the DNS hook failed to %s the record %s: %s: %s", action, fqdn, err, bytes.TrimSpace(output))
+	}
+	return nil
+}
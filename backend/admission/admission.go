@@ -0,0 +1,222 @@
+// Package admission calls the admission webhooks configured in the store
+// before the resources are created or updated through the API.
+package admission
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultTimeout is the timeout of the admission webhooks without a
+	// timeout.
+	DefaultTimeout = 10 * time.Second
+
+	// maxResponseSize is the maximum size of the responses of the admission
+	// webhooks.
+	maxResponseSize = 1 << 20
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "admission",
+})
+
+// Store is a store that calls the admission webhooks before the resources are
+// created or updated with its CreateResource and CreateOrUpdateResource
+// methods. The webhooks can reject the resources, or modify them before they
+// are stored.
+type Store struct {
+	store.Store
+}
+
+// NewStore returns a store calling the admission webhooks before writing the
+// resources to the given store.
+func NewStore(s store.Store) store.Store {
+	admission := &Store{Store: s}
+	return store.WrapWatcher(admission, s)
+}
+
+// CreateResource admits the given resource and creates it.
+func (s *Store) CreateResource(ctx context.Context, resource corev2.Resource) error {
+	admitted, err := s.Admit(ctx, resource, corev2.AdmissionOperationCreate)
+	if err != nil {
+		return err
+	}
+	return s.Store.CreateResource(ctx, admitted)
+}
+
+// CreateOrUpdateResource admits the given resource and creates or updates it.
+func (s *Store) CreateOrUpdateResource(ctx context.Context, resource corev2.Resource) error {
+	admitted, err := s.Admit(ctx, resource, corev2.AdmissionOperationUpdate)
+	if err != nil {
+		return err
+	}
+	return s.Store.CreateOrUpdateResource(ctx, admitted)
+}
+
+// Admit calls the admission webhooks of the given resource in the order of
+// their names, and returns the resource as modified by the webhooks. The
+// resources rejected by a webhook are returned as *store.ErrNotValid. The
+// admission webhooks themselves are always admitted, so that a faulty webhook
+// can be fixed.
+func (s *Store) Admit(ctx context.Context, resource corev2.Resource, operation string) (corev2.Resource, error) {
+	if _, ok := resource.(*corev2.AdmissionWebhook); ok {
+		return resource, nil
+	}
+
+	var webhooks []*corev2.AdmissionWebhook
+	err := s.Store.ListResources(store.NamespaceContext(ctx, ""), corev2.AdmissionWebhooksResource, &webhooks, &store.SelectionPredicate{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Handles(resource.RBACName()) {
+			continue
+		}
+		fields := logrus.Fields{
+			"admission_webhook": webhook.Name,
+			"resource":          resource.RBACName(),
+			"name":              resource.GetObjectMeta().Name,
+			"namespace":         resource.GetObjectMeta().Namespace,
+		}
+
+		response, err := review(ctx, webhook, resource, operation)
+		if err != nil {
+			if webhook.FailurePolicy == corev2.AdmissionFailurePolicyIgnore {
+				logger.WithFields(fields).WithError(err).Warn("admission webhook failed, ignoring")
+				continue
+			}
+			logger.WithFields(fields).WithError(err).Error("admission webhook failed")
+			return nil, fmt.Errorf("admission webhook %q failed: %s", webhook.Name, err)
+		}
+
+		if !response.Allowed {
+			reason := response.Reason
+			if reason == "" {
+				reason = "no reason given"
+			}
+			return nil, &store.ErrNotValid{Err: fmt.Errorf("denied by admission webhook %q: %s", webhook.Name, reason)}
+		}
+
+		if len(response.Resource) > 0 {
+			resource, err = modified(resource, response.Resource)
+			if err != nil {
+				return nil, &store.ErrNotValid{Err: fmt.Errorf("admission webhook %q: %s", webhook.Name, err)}
+			}
+		}
+	}
+
+	return resource, nil
+}
+
+// review posts an admission review of the given resource to the webhook, and
+// decodes its response.
+func review(ctx context.Context, webhook *corev2.AdmissionWebhook, resource corev2.Resource, operation string) (*corev2.AdmissionResponse, error) {
+	wrapper := types.WrapResource(resource)
+	review := corev2.AdmissionReview{
+		Operation:  operation,
+		Type:       wrapper.Type,
+		APIVersion: wrapper.APIVersion,
+		Resource:   resource,
+	}
+	if claims := jwt.GetClaimsFromContext(ctx); claims != nil {
+		review.User = claims.StandardClaims.Subject
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := httpClient(webhook)
+	if err != nil {
+		return nil, err
+	}
+	timeout := DefaultTimeout
+	if webhook.Timeout > 0 {
+		timeout = time.Duration(webhook.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for key, value := range webhook.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("admission webhook responded with status %d", resp.StatusCode)
+	}
+
+	var response corev2.AdmissionResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("admission webhook response is invalid: %s", err)
+	}
+	return &response, nil
+}
+
+// httpClient returns the HTTP client of the webhook, which trusts the CA
+// certificates of its CA bundle, if any.
+func httpClient(webhook *corev2.AdmissionWebhook) (*http.Client, error) {
+	if webhook.CABundle == "" {
+		return http.DefaultClient, nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(webhook.CABundle)) {
+		return nil, errors.New("no certificate found in the CA bundle")
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: pool},
+			DisableKeepAlives: true,
+		},
+	}, nil
+}
+
+// modified decodes the resource returned by an admission webhook, which must
+// be the same resource as the one it was given.
+func modified(resource corev2.Resource, data json.RawMessage) (corev2.Resource, error) {
+	result := reflect.New(reflect.TypeOf(resource).Elem()).Interface().(corev2.Resource)
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("modified resource is invalid: %s", err)
+	}
+
+	meta, resultMeta := resource.GetObjectMeta(), result.GetObjectMeta()
+	if resultMeta.Name != meta.Name || resultMeta.Namespace != meta.Namespace {
+		return nil, errors.New("the name and namespace of the resource can't be modified")
+	}
+	if err := result.Validate(); err != nil {
+		return nil, fmt.Errorf("modified resource is invalid: %s", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,129 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/fixture"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// admissionServer returns a TLS server admitting the resources with a "team"
+// label, to which it adds a "reviewed" label, and the webhook calling it.
+func admissionServer(t *testing.T, name string) (*httptest.Server, *corev2.AdmissionWebhook) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var review struct {
+			Operation string
+			Resource  fixture.Resource
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&review))
+		assert.Equal(t, corev2.AdmissionOperationCreate, review.Operation)
+
+		resource := review.Resource
+		if resource.Labels["team"] == "" {
+			_ = json.NewEncoder(w).Encode(corev2.AdmissionResponse{Reason: "the team label is mandatory"})
+			return
+		}
+		resource.Labels["reviewed"] = "true"
+		data, _ := json.Marshal(resource)
+		_ = json.NewEncoder(w).Encode(corev2.AdmissionResponse{Allowed: true, Resource: data})
+	}))
+
+	webhook := corev2.FixtureAdmissionWebhook(name)
+	webhook.URL = server.URL
+	webhook.CABundle = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	return server, webhook
+}
+
+func mockWebhooks(s *mockstore.MockStore, webhooks ...*corev2.AdmissionWebhook) {
+	s.On("ListResources", mock.Anything, corev2.AdmissionWebhooksResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.AdmissionWebhook)
+			*list = webhooks
+		}).Return(nil)
+}
+
+func fixtureResource(labels map[string]string) *fixture.Resource {
+	return &fixture.Resource{ObjectMeta: corev2.ObjectMeta{Name: "foo", Namespace: "default", Labels: labels}}
+}
+
+func TestStoreAdmitted(t *testing.T) {
+	server, webhook := admissionServer(t, "labels")
+	defer server.Close()
+
+	s := &mockstore.MockStore{}
+	mockWebhooks(s, webhook)
+	var created *fixture.Resource
+	s.On("CreateResource", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*fixture.Resource)
+	}).Return(nil)
+
+	err := NewStore(s).CreateResource(context.Background(), fixtureResource(map[string]string{"team": "ops"}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops", "reviewed": "true"}, created.Labels)
+}
+
+func TestStoreDenied(t *testing.T) {
+	server, webhook := admissionServer(t, "labels")
+	defer server.Close()
+
+	s := &mockstore.MockStore{}
+	mockWebhooks(s, webhook)
+
+	err := NewStore(s).CreateResource(context.Background(), fixtureResource(nil))
+	assert.EqualError(t, err, `resource is invalid: denied by admission webhook "labels": the team label is mandatory`)
+	assert.IsType(t, &store.ErrNotValid{}, err)
+	s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+}
+
+func TestStoreUnhandledResource(t *testing.T) {
+	webhook := corev2.FixtureAdmissionWebhook("checks")
+	webhook.Resources = []string{corev2.ChecksResource}
+
+	s := &mockstore.MockStore{}
+	mockWebhooks(s, webhook)
+	s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+
+	assert.NoError(t, NewStore(s).CreateOrUpdateResource(context.Background(), fixtureResource(nil)))
+}
+
+func TestStoreFailurePolicy(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	webhook := corev2.FixtureAdmissionWebhook("failing")
+	webhook.URL = server.URL
+
+	s := &mockstore.MockStore{}
+	mockWebhooks(s, webhook)
+	s.On("CreateResource", mock.Anything, mock.Anything).Return(nil)
+
+	// The certificate of the server is not trusted
+	err := NewStore(s).CreateResource(context.Background(), fixtureResource(nil))
+	assert.Error(t, err)
+	_, denied := err.(*store.ErrNotValid)
+	assert.False(t, denied)
+
+	webhook.FailurePolicy = corev2.AdmissionFailurePolicyIgnore
+	assert.NoError(t, NewStore(s).CreateResource(context.Background(), fixtureResource(nil)))
+}
+
+func TestStoreAdmissionWebhook(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+
+	// The admission webhooks are admitted without calling the webhooks
+	assert.NoError(t, NewStore(s).CreateOrUpdateResource(context.Background(), corev2.FixtureAdmissionWebhook("foo")))
+	s.AssertNotCalled(t, "ListResources", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -2,8 +2,11 @@ package agentd
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand"
 	"net"
 	"net/http"
 	"strings"
@@ -11,6 +14,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -23,18 +27,26 @@ import (
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/tracing"
 	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/util/tlsreload"
+	"github.com/sirupsen/logrus"
 )
 
 var (
 	// upgrader is safe for concurrent use, and we don't need any particularly
-	// specialized configurations for different uses.
-	upgrader = &websocket.Upgrader{}
+	// specialized configurations for different uses. The agents requesting the
+	// permessage-deflate compression are accepted.
+	upgrader = &websocket.Upgrader{EnableCompression: true}
 
 	// used for registering prometheus session counter
 	sessionCounterOnce sync.Once
 )
 
+// drainTimeout is how long agentd waits, when shutting down, for the agents
+// asked to reconnect to close their sessions.
+const drainTimeout = 5 * time.Second
+
 // Agentd is the backend HTTP API.
 type Agentd struct {
 	// Host is the hostname Agentd is running on.
@@ -55,6 +67,24 @@ type Agentd struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
 	writeTimeout int
+	reviewer     TokenReviewer
+	certAuth     bool
+	drainJitter  time.Duration
+	sessions     map[*Session]struct{}
+	sessionsMu   sync.Mutex
+	draining     bool
+	client       *clientv3.Client
+	backendName  string
+	rebalance    bool
+	handingOff   int32
+	tracer       *tracing.Tracer
+	events       *eventQueue
+}
+
+// TokenReviewer authenticates the bearer tokens of the agents.
+type TokenReviewer interface {
+	// Review returns the user authenticated by token
+	Review(ctx context.Context, token string) (*corev2.User, error)
 }
 
 // Config configures an Agentd.
@@ -66,6 +96,42 @@ type Config struct {
 	TLS          *corev2.TLSOptions
 	RingPool     *ringv2.Pool
 	WriteTimeout int
+
+	// TokenReviewer authenticates the agents using bearer tokens, such as
+	// Kubernetes service account tokens. Bearer tokens are rejected if nil.
+	TokenReviewer TokenReviewer
+
+	// CertAuth authenticates the agents presenting a client certificate
+	// signed by the trusted CA of TLS, whose common name and organizational
+	// unit establish their entity name and namespace.
+	CertAuth bool
+
+	// TLSReload is the interval at which the TLS certificate and key files
+	// are checked, and reloaded if renewed, or zero to never reload them.
+	TLSReload time.Duration
+
+	// DrainJitter is the maximum delay the agents wait before reconnecting
+	// when agentd is drained or shuts down, so they don't all reconnect to
+	// the other backends at once.
+	DrainJitter time.Duration
+
+	// Client publishes the number of agent sessions of the backend to etcd,
+	// under BackendName, to balance the agent sessions across the backends
+	// of the cluster. The agent sessions are not balanced if nil.
+	Client      *clientv3.Client
+	BackendName string
+
+	// AutoRebalance hands off the agent sessions exceeding the fair share of
+	// the backend periodically, rather than only when requested.
+	AutoRebalance bool
+
+	// Tracer traces the reception of the events of the agents, if set.
+	Tracer *tracing.Tracer
+
+	// EventQueueSize is the number of events received from the agents which
+	// can wait to be processed by eventd, above which the agents are held.
+	// It defaults to DefaultEventQueueSize.
+	EventQueueSize int
 }
 
 // Option is a functional option.
@@ -88,6 +154,15 @@ func New(c Config, opts ...Option) (*Agentd, error) {
 		ctx:          ctx,
 		cancel:       cancel,
 		writeTimeout: c.WriteTimeout,
+		reviewer:     c.TokenReviewer,
+		certAuth:     c.CertAuth,
+		drainJitter:  c.DrainJitter,
+		sessions:     make(map[*Session]struct{}),
+		client:       c.Client,
+		backendName:  c.BackendName,
+		rebalance:    c.AutoRebalance,
+		tracer:       c.Tracer,
+		events:       newEventQueue(c.Bus, c.EventQueueSize),
 	}
 
 	// prepare server TLS config
@@ -95,6 +170,19 @@ func New(c Config, opts ...Option) (*Agentd, error) {
 	if err != nil {
 		return nil, err
 	}
+	if c.CertAuth {
+		if c.TLS == nil || c.TLS.TrustedCAFile == "" {
+			return nil, errors.New("agent certificate authentication requires a TLS trusted CA file")
+		}
+		// The agents without a client certificate can still use the other
+		// authentication methods, unless client certificates are required
+		if tlsServerConfig.ClientAuth < tls.VerifyClientCertIfGiven {
+			tlsServerConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	if err := tlsreload.Configure(tlsServerConfig, c.TLS.GetCertFile(), c.TLS.GetKeyFile(), c.TLSReload); err != nil {
+		return nil, err
+	}
 
 	// Configure the middlewares used by agentd's HTTP server by assigning them to
 	// public variables so they can be overriden from the enterprise codebase
@@ -152,18 +240,43 @@ func (a *Agentd) Start() error {
 		}
 	}()
 
+	if a.client != nil {
+		a.wg.Add(1)
+		go a.balanceLoop()
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.events.run(a.ctx)
+	}()
+
 	sessionCounterOnce.Do(func() {
-		if err := prometheus.Register(sessionCounter); err != nil {
-			logger.WithError(err).Error("error registering session counter")
-			a.errChan <- err
+		collectors := []prometheus.Collector{
+			sessionCounter,
+			sessionFormatCounter,
+			sessionMessagesDropped,
+			sessionMessageDuration,
+			EventQueueDepth,
+			BackpressureSignals,
+		}
+		for _, collector := range collectors {
+			if err := prometheus.Register(collector); err != nil {
+				logger.WithError(err).Error("error registering session metrics")
+				a.errChan <- err
+				return
+			}
 		}
 	})
 
 	return nil
 }
 
-// Stop Agentd.
+// Stop Agentd. The connected agents are asked to reconnect to other backends
+// first.
 func (a *Agentd) Stop() error {
+	a.Drain(a.drainJitter)
+	a.waitSessions(drainTimeout)
 	a.cancel()
 	if err := a.httpServer.Shutdown(context.TODO()); err != nil {
 		// failure/timeout shutting down the server gracefully
@@ -190,7 +303,87 @@ func (a *Agentd) Name() string {
 	return "agentd"
 }
 
+// Drain asks the agents connected to agentd to reconnect, e.g. to other
+// backends, each after a random delay up to jitter, and refuses any new agent
+// connection until agentd is restarted. It returns the number of agent
+// sessions drained.
+func (a *Agentd) Drain(jitter time.Duration) int {
+	a.sessionsMu.Lock()
+	a.draining = true
+	sessions := make([]*Session, 0, len(a.sessions))
+	for session := range a.sessions {
+		sessions = append(sessions, session)
+	}
+	a.sessionsMu.Unlock()
+
+	logger.WithFields(logrus.Fields{
+		"sessions": len(sessions),
+		"jitter":   jitter,
+	}).Warn("draining agent sessions")
+	for _, session := range sessions {
+		var delay time.Duration
+		if jitter > 0 {
+			delay = time.Duration(rand.Int63n(int64(jitter)))
+		}
+		session.Reconnect(delay)
+	}
+	return len(sessions)
+}
+
+// Draining returns true if agentd was drained.
+func (a *Agentd) Draining() bool {
+	a.sessionsMu.Lock()
+	defer a.sessionsMu.Unlock()
+	return a.draining
+}
+
+// waitSessions waits for the agent sessions to stop, at most for timeout.
+func (a *Agentd) waitSessions(timeout time.Duration) {
+	a.sessionsMu.Lock()
+	sessions := make([]*Session, 0, len(a.sessions))
+	for session := range a.sessions {
+		sessions = append(sessions, session)
+	}
+	a.sessionsMu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for _, session := range sessions {
+		select {
+		case <-session.Done():
+		case <-timer.C:
+			logger.Warn("timed out waiting for the agents to reconnect to other backends")
+			return
+		}
+	}
+}
+
+// trackSession keeps track of session until it stops, so it can be drained.
+func (a *Agentd) trackSession(session *Session) {
+	a.sessionsMu.Lock()
+	a.sessions[session] = struct{}{}
+	a.sessionsMu.Unlock()
+
+	go func() {
+		<-session.Done()
+		a.sessionsMu.Lock()
+		delete(a.sessions, session)
+		a.sessionsMu.Unlock()
+	}()
+}
+
 func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
+	// The agents connect to the other backends while agentd is drained, or
+	// exceeds its fair share of the agent sessions
+	if a.Draining() {
+		http.Error(w, "backend is draining", http.StatusServiceUnavailable)
+		return
+	}
+	if a.isHandingOff() {
+		http.Error(w, "backend exceeds its share of the agent sessions", http.StatusServiceUnavailable)
+		return
+	}
+
 	var marshal MarshalFunc
 	var unmarshal UnmarshalFunc
 	var contentType string
@@ -199,6 +392,8 @@ func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
 	logger.WithField("header", fmt.Sprintf("Accept: %s", ProtobufSerializationHeader)).Debug("setting header")
 	responseHeader.Add("Accept", JSONSerializationHeader)
 	logger.WithField("header", fmt.Sprintf("Accept: %s", JSONSerializationHeader)).Debug("setting header")
+	// The transport decodes the batches of messages of the agents
+	responseHeader.Set(transport.HeaderKeyBatching, "true")
 	if r.Header.Get("Accept") == ProtobufSerializationHeader {
 		marshal = proto.Marshal
 		unmarshal = proto.Unmarshal
@@ -222,6 +417,9 @@ func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
 		RingPool:      a.ringPool,
 		ContentType:   contentType,
 		WriteTimeout:  a.writeTimeout,
+		Tracer:        a.tracer,
+		Backpressure:  r.Header.Get(transport.HeaderKeyBackpressure) != "",
+		events:        a.events,
 	}
 
 	// Validate the agent namespace
@@ -269,12 +467,26 @@ func (a *Agentd) webSocketHandler(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	a.trackSession(session)
 }
 
 // AuthenticationMiddleware represents the core authentication middleware for
-// agentd, which consists of basic authentication.
+// agentd, which consists of basic authentication, of bearer token
+// authentication when a token reviewer is configured, or of client
+// certificate authentication when enabled.
 func (a *Agentd) AuthenticationMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.certAuth {
+			if cert := verifiedCert(r); cert != nil {
+				a.authenticateCert(next, w, r, cert)
+				return
+			}
+		}
+		if a.reviewer != nil && strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			a.authenticateToken(next, w, r)
+			return
+		}
+
 		username, password, ok := r.BasicAuth()
 		if !ok {
 			http.Error(w, "missing credentials", http.StatusUnauthorized)
@@ -320,6 +532,30 @@ func (a *Agentd) AuthenticationMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// authenticateToken authenticates the bearer token of the request with the
+// token reviewer.
+func (a *Agentd) authenticateToken(next http.Handler, w http.ResponseWriter, r *http.Request) {
+	user, err := a.reviewer.Review(r.Context(), jwt.ExtractBearerToken(r))
+	if err != nil {
+		if r.Context().Err() != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		logger.
+			WithField("agent", r.Header.Get(transport.HeaderKeyAgentName)).
+			WithError(err).
+			Error("invalid bearer token")
+		http.Error(w, "bad credentials", http.StatusUnauthorized)
+		return
+	}
+	// The agent user is the one of the token, e.g. its service account
+	r.Header.Set(transport.HeaderKeyUser, user.Username)
+
+	claims, _ := jwt.NewClaims(user)
+	ctx := jwt.SetClaimsIntoContext(r, claims)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AuthorizationMiddleware represents the core authorization middleware for
 // agentd, which consists of making sure the agent's entity is authorized to
 // create events in the given namespace
@@ -2,17 +2,23 @@ package agentd
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAgentdMiddlewares(t *testing.T) {
@@ -102,3 +108,81 @@ func TestAgentdMiddlewares(t *testing.T) {
 		assert.Equal(tc.expectedCode, res.StatusCode, tc.description)
 	}
 }
+
+type tokenReviewer map[string]*corev2.User
+
+func (r tokenReviewer) Review(ctx context.Context, token string) (*corev2.User, error) {
+	user, ok := r[token]
+	if !ok {
+		return nil, errors.New("token not authenticated")
+	}
+	return user, nil
+}
+
+func TestAgentdTokenAuthentication(t *testing.T) {
+	var username string
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username = r.Header.Get(transport.HeaderKeyUser)
+	})
+
+	reviewer := tokenReviewer{
+		"valid": &corev2.User{
+			Username: "system:serviceaccount:monitoring:sensu-agent",
+			Groups:   []string{"system:serviceaccounts:monitoring"},
+		},
+	}
+	agentd := &Agentd{reviewer: reviewer}
+	server := httptest.NewServer(agentd.AuthenticationMiddleware(testHandler))
+	defer server.Close()
+
+	tests := []struct {
+		description  string
+		token        string
+		expectedCode int
+	}{
+		{description: "valid token", token: "valid", expectedCode: http.StatusOK},
+		{description: "invalid token", token: "invalid", expectedCode: http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+		res, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expectedCode, res.StatusCode, tc.description)
+	}
+	assert.Equal(t, "system:serviceaccount:monitoring:sensu-agent", username)
+}
+
+func TestAgentdDrain(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	st := &mockstore.MockStore{}
+	cfg := SessionConfig{
+		AgentName:     "testing",
+		Namespace:     "acme",
+		Subscriptions: []string{"testing"},
+	}
+	conn := &testTransport{sendCh: make(chan *transport.Message, 10)}
+	session, err := NewSession(context.Background(), cfg, conn, bus, st, UnmarshalJSON, MarshalJSON)
+	require.NoError(t, err)
+	defer session.cancel()
+
+	agentd := &Agentd{sessions: make(map[*Session]struct{})}
+	agentd.trackSession(session)
+
+	assert.Equal(t, 1, agentd.Drain(time.Second))
+	assert.True(t, agentd.Draining())
+
+	msg := <-session.sendq
+	assert.Equal(t, transport.MessageTypeReconnect, msg.Type)
+	delay, err := strconv.Atoi(string(msg.Payload))
+	require.NoError(t, err)
+	assert.True(t, delay >= 0 && delay < 1000, "bad delay: %d", delay)
+
+	// New agent connections are refused while drained
+	w := httptest.NewRecorder()
+	agentd.webSocketHandler(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
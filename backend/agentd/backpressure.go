@@ -0,0 +1,151 @@
+package agentd
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultEventQueueSize is the default number of events received from
+	// the agents which wait to be processed by eventd.
+	DefaultEventQueueSize = 1000
+
+	// EventQueueDepthGauge is the name of the prometheus gauge used to report
+	// the number of events received from the agents waiting for eventd.
+	EventQueueDepthGauge = "sensu_go_agentd_event_queue_depth"
+
+	// BackpressureSignalsCounter is the name of the prometheus counter used
+	// to count the agents asked to slow down.
+	BackpressureSignalsCounter = "sensu_go_agentd_backpressure_signals"
+
+	// backpressureThreshold is the fraction of the capacity of the event
+	// queue above which the agents are asked to slow down.
+	backpressureThreshold = 0.75
+
+	// minBackpressureDelay and maxBackpressureDelay bound the delay the
+	// agents are asked to hold their events for, which grows with the
+	// number of events queued above the threshold.
+	minBackpressureDelay = 250 * time.Millisecond
+	maxBackpressureDelay = 5 * time.Second
+)
+
+var (
+	// EventQueueDepth is the number of events received from the agents
+	// waiting to be processed by eventd.
+	EventQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: EventQueueDepthGauge,
+			Help: "The number of events received from the agents waiting to be processed by eventd",
+		},
+	)
+
+	// BackpressureSignals counts the backpressure messages sent to the
+	// agents.
+	BackpressureSignals = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: BackpressureSignalsCounter,
+			Help: "The total number of times the agents were asked to slow down",
+		},
+	)
+)
+
+// eventQueue is the bounded queue of the events received from the agents,
+// published to eventd in order. The sessions wait for room in the queue
+// before receiving more messages, and ask their agents to slow down when the
+// queue fills up, so that the events don't pile up in the backend while
+// eventd, or etcd, is slower than the agents.
+type eventQueue struct {
+	events chan *corev2.Event
+	bus    messaging.MessageBus
+}
+
+func newEventQueue(bus messaging.MessageBus, size int) *eventQueue {
+	if size < 1 {
+		size = DefaultEventQueueSize
+	}
+	return &eventQueue{
+		events: make(chan *corev2.Event, size),
+		bus:    bus,
+	}
+}
+
+// push queues the event, waiting for room in the queue until ctx is done. It
+// returns the delay the agent should hold its events for, which is zero
+// unless the queue is above the backpressure threshold.
+func (q *eventQueue) push(ctx context.Context, event *corev2.Event) (time.Duration, error) {
+	select {
+	case q.events <- event:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	depth := len(q.events)
+	EventQueueDepth.Set(float64(depth))
+	return backpressureDelay(depth, cap(q.events)), nil
+}
+
+// run publishes the queued events to eventd until ctx is done, then publishes
+// the events left in the queue.
+func (q *eventQueue) run(ctx context.Context) {
+	for {
+		select {
+		case event := <-q.events:
+			q.publish(event)
+		case <-ctx.Done():
+			for {
+				select {
+				case event := <-q.events:
+					q.publish(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (q *eventQueue) publish(event *corev2.Event) {
+	EventQueueDepth.Set(float64(len(q.events)))
+	if err := q.bus.Publish(messaging.TopicEventRaw, event); err != nil {
+		sessionMessagesDropped.WithLabelValues(messageReceived).Inc()
+		logger.WithError(err).Error("error publishing event")
+	}
+}
+
+// backpressureDelay returns the delay the agents are asked to hold their
+// events for, given the depth and the capacity of the event queue.
+func backpressureDelay(depth, capacity int) time.Duration {
+	fill := float64(depth) / float64(capacity)
+	if fill < backpressureThreshold {
+		return 0
+	}
+	ratio := (fill - backpressureThreshold) / (1 - backpressureThreshold)
+	return minBackpressureDelay + time.Duration(ratio*float64(maxBackpressureDelay-minBackpressureDelay))
+}
+
+// signalBackpressure asks the agent to hold its events for delay, unless it
+// was already asked to within the previous delay, or doesn't handle the
+// backpressure messages. The message is dropped if the send queue is full.
+func (s *Session) signalBackpressure(delay time.Duration) {
+	now := time.Now()
+	if delay == 0 || !s.cfg.Backpressure || now.Before(s.backpressureUntil) {
+		return
+	}
+	payload := []byte(strconv.FormatInt(int64(delay/time.Millisecond), 10))
+	select {
+	case s.sendq <- transport.NewMessage(transport.MessageTypeBackpressure, payload):
+		s.backpressureUntil = now.Add(delay)
+		BackpressureSignals.Inc()
+		logger.WithFields(logrus.Fields{
+			"agent": s.cfg.AgentName,
+			"delay": delay,
+		}).Debug("asking agent to slow down")
+	default:
+	}
+}
@@ -0,0 +1,111 @@
+package agentd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventSubscriber chan interface{}
+
+func (s eventSubscriber) Receiver() chan<- interface{} {
+	return s
+}
+
+func TestBackpressureDelay(t *testing.T) {
+	tests := []struct {
+		name  string
+		depth int
+		want  time.Duration
+	}{
+		{name: "empty queue", depth: 0, want: 0},
+		{name: "below the threshold", depth: 74, want: 0},
+		{name: "at the threshold", depth: 75, want: minBackpressureDelay},
+		{name: "full queue", depth: 100, want: maxBackpressureDelay},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, backpressureDelay(tt.depth, 100))
+		})
+	}
+}
+
+func TestEventQueue(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	sub := make(eventSubscriber, 10)
+	_, err = bus.Subscribe(messaging.TopicEventRaw, "testing", sub)
+	require.NoError(t, err)
+
+	queue := newEventQueue(bus, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The queue asks to slow down once filled above the threshold
+	var delay time.Duration
+	for i := 0; i < 4; i++ {
+		delay, err = queue.push(ctx, corev2.FixtureEvent("entity", "check"))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, maxBackpressureDelay, delay)
+
+	// A full queue holds the events until ctx is done
+	pushCtx, pushCancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer pushCancel()
+	_, err = queue.push(pushCtx, corev2.FixtureEvent("entity", "check"))
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	done := make(chan struct{})
+	go func() {
+		queue.run(ctx)
+		close(done)
+	}()
+	for i := 0; i < 4; i++ {
+		select {
+		case msg := <-sub:
+			assert.IsType(t, &corev2.Event{}, msg)
+		case <-time.After(time.Second):
+			t.Fatal("event not published")
+		}
+	}
+
+	cancel()
+	<-done
+}
+
+func TestSignalBackpressure(t *testing.T) {
+	session := &Session{
+		cfg:   SessionConfig{AgentName: "testing", Backpressure: true},
+		sendq: make(chan *transport.Message, 10),
+	}
+
+	// Not congested
+	session.signalBackpressure(0)
+	assert.Len(t, session.sendq, 0)
+
+	session.signalBackpressure(time.Second)
+	require.Len(t, session.sendq, 1)
+	msg := <-session.sendq
+	assert.Equal(t, transport.MessageTypeBackpressure, msg.Type)
+	assert.Equal(t, "1000", string(msg.Payload))
+
+	// Already asked to slow down
+	session.signalBackpressure(time.Second)
+	assert.Len(t, session.sendq, 0)
+
+	// The agent doesn't handle the backpressure messages
+	session = &Session{
+		cfg:   SessionConfig{AgentName: "testing"},
+		sendq: make(chan *transport.Message, 10),
+	}
+	session.signalBackpressure(time.Second)
+	assert.Len(t, session.sendq, 0)
+}
@@ -0,0 +1,272 @@
+package agentd
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"path"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// balanceInterval is the interval at which the backends publish their
+	// number of agent sessions, and balance them if enabled.
+	balanceInterval = 10 * time.Second
+
+	// balanceTolerance is the fraction of its fair share of the agent
+	// sessions a backend can exceed before handing off sessions.
+	balanceTolerance = 0.1
+)
+
+var (
+	// sessionCountsPath is the etcd key prefix of the number of agent
+	// sessions of every backend.
+	sessionCountsPath = store.NewKeyBuilder("agentsessions").Build()
+
+	// rebalanceKey is the etcd key written to ask every backend to balance
+	// its agent sessions.
+	rebalanceKey = store.NewKeyBuilder("agentrebalance").Build()
+)
+
+// SessionCounts returns the number of agent sessions of every backend of the
+// cluster, keyed by backend name.
+func (a *Agentd) SessionCounts(ctx context.Context) (map[string]int, error) {
+	if a.client == nil {
+		return map[string]int{a.backendName: a.sessionCount()}, nil
+	}
+	resp, err := a.client.Get(ctx, sessionCountsPath+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		count, err := strconv.Atoi(string(kv.Value))
+		if err != nil {
+			logger.WithError(err).WithField("key", string(kv.Key)).Error("invalid agent session count")
+			continue
+		}
+		counts[path.Base(string(kv.Key))] = count
+	}
+	// The count of this backend is always up to date
+	counts[a.backendName] = a.sessionCount()
+	return counts, nil
+}
+
+// Rebalance asks every backend of the cluster to hand off its agent sessions
+// exceeding its fair share to the other backends.
+func (a *Agentd) Rebalance(ctx context.Context) error {
+	if a.client == nil {
+		return nil
+	}
+	_, err := a.client.Put(ctx, rebalanceKey, time.Now().UTC().Format(time.RFC3339Nano))
+	return err
+}
+
+// sessionCount returns the number of agent sessions of agentd.
+func (a *Agentd) sessionCount() int {
+	a.sessionsMu.Lock()
+	defer a.sessionsMu.Unlock()
+	return len(a.sessions)
+}
+
+// balanceLoop publishes the number of agent sessions of agentd, and balances
+// them on every tick if enabled, or when a rebalance is requested.
+func (a *Agentd) balanceLoop() {
+	defer a.wg.Done()
+	key := path.Join(sessionCountsPath, a.backendName)
+	var lease clientv3.LeaseID
+	defer func() {
+		if lease == clientv3.NoLease {
+			return
+		}
+		// The count of a stopped backend is removed right away
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if _, err := a.client.Revoke(ctx, lease); err != nil {
+			logger.WithError(err).Error("error removing the agent session count")
+		}
+	}()
+
+	ticker := time.NewTicker(balanceInterval)
+	defer ticker.Stop()
+	rebalance := a.client.Watch(a.ctx, rebalanceKey)
+	for {
+		var err error
+		if lease, err = a.publishSessionCount(key, lease); err != nil {
+			logger.WithError(err).Error("error publishing the agent session count")
+		}
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			if a.rebalance {
+				a.balance()
+			} else {
+				// A requested rebalance only lasts until the next tick
+				atomic.StoreInt32(&a.handingOff, 0)
+			}
+		case resp, ok := <-rebalance:
+			if !ok {
+				return
+			}
+			if err := resp.Err(); err != nil {
+				logger.WithError(err).Error("error watching the rebalance requests")
+				continue
+			}
+			logger.Info("agent sessions rebalance requested")
+			a.balance()
+		}
+	}
+}
+
+// publishSessionCount writes the number of agent sessions of agentd at key,
+// attached to lease so it expires with the backend, and returns the lease.
+func (a *Agentd) publishSessionCount(key string, lease clientv3.LeaseID) (clientv3.LeaseID, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, balanceInterval)
+	defer cancel()
+	if lease != clientv3.NoLease {
+		if _, err := a.client.KeepAliveOnce(ctx, lease); err != nil {
+			lease = clientv3.NoLease
+		}
+	}
+	if lease == clientv3.NoLease {
+		resp, err := a.client.Grant(ctx, int64(3*balanceInterval/time.Second))
+		if err != nil {
+			return lease, fmt.Errorf("error granting lease: %s", err)
+		}
+		lease = resp.ID
+	}
+	_, err := a.client.Put(ctx, key, strconv.Itoa(a.sessionCount()), clientv3.WithLease(lease))
+	return lease, err
+}
+
+// balance hands off the agent sessions exceeding the fair share of agentd,
+// given the number of agent sessions of every backend, to the other backends.
+// The new agent connections are refused until the next balancing if agentd
+// exceeds its fair share.
+func (a *Agentd) balance() {
+	ctx, cancel := context.WithTimeout(a.ctx, balanceInterval)
+	defer cancel()
+	counts, err := a.SessionCounts(ctx)
+	if err != nil {
+		logger.WithError(err).Error("error getting the agent session counts")
+		return
+	}
+
+	a.sessionsMu.Lock()
+	sessions := make([]*Session, 0, len(a.sessions))
+	for session := range a.sessions {
+		sessions = append(sessions, session)
+	}
+	a.sessionsMu.Unlock()
+	counts[a.backendName] = len(sessions)
+
+	excess := excessSessions(counts, a.backendName)
+	if excess == 0 {
+		atomic.StoreInt32(&a.handingOff, 0)
+		return
+	}
+	atomic.StoreInt32(&a.handingOff, 1)
+	backends := make([]string, 0, len(counts))
+	for backend := range counts {
+		backends = append(backends, backend)
+	}
+	sortHandoff(sessions, backends, a.backendName)
+
+	logger.WithField("sessions", excess).Warn("handing off agent sessions to the other backends")
+	for _, session := range sessions[:excess] {
+		var delay time.Duration
+		if a.drainJitter > 0 {
+			delay = time.Duration(rand.Int63n(int64(a.drainJitter)))
+		}
+		session.Reconnect(delay)
+	}
+}
+
+// isHandingOff returns true if agentd exceeded its fair share of the agent
+// sessions of the cluster when last balanced.
+func (a *Agentd) isHandingOff() bool {
+	return atomic.LoadInt32(&a.handingOff) == 1
+}
+
+// fairShare returns the number of agent sessions of every backend if they
+// were evenly distributed.
+func fairShare(counts map[string]int) int {
+	if len(counts) == 0 {
+		return 0
+	}
+	var total int
+	for _, count := range counts {
+		total += count
+	}
+	return (total + len(counts) - 1) / len(counts)
+}
+
+// sessionLimit returns the number of agent sessions above which a backend
+// exceeds its fair share, or zero if there is a single backend.
+func sessionLimit(counts map[string]int) int {
+	if len(counts) < 2 {
+		return 0
+	}
+	share := fairShare(counts)
+	tolerance := int(float64(share) * balanceTolerance)
+	if tolerance < 1 {
+		tolerance = 1
+	}
+	return share + tolerance
+}
+
+// excessSessions returns the number of agent sessions backend hands off to
+// get back to its fair share.
+func excessSessions(counts map[string]int, backend string) int {
+	limit := sessionLimit(counts)
+	if limit == 0 || counts[backend] <= limit {
+		return 0
+	}
+	return counts[backend] - fairShare(counts)
+}
+
+// sessionScore returns the rendezvous hashing score of the agent of session
+// for backend. Each agent is assigned to the backend of highest score.
+func sessionScore(session *Session, backend string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(backend))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(session.cfg.Namespace))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(session.cfg.AgentName))
+	return h.Sum64()
+}
+
+// sortHandoff sorts sessions in the order they are handed off by backend,
+// using rendezvous hashing over backends: the sessions of the agents assigned
+// to another backend come first, the ones of lowest score for backend first.
+// The handed off agents are consistent, so balancing the sessions again
+// mostly moves the same agents.
+func sortHandoff(sessions []*Session, backends []string, backend string) {
+	assigned := make(map[*Session]bool, len(sessions))
+	scores := make(map[*Session]uint64, len(sessions))
+	for _, session := range sessions {
+		scores[session] = sessionScore(session, backend)
+		assigned[session] = true
+		for _, other := range backends {
+			if other != backend && sessionScore(session, other) > scores[session] {
+				assigned[session] = false
+				break
+			}
+		}
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		if assigned[sessions[i]] != assigned[sessions[j]] {
+			return !assigned[sessions[i]]
+		}
+		return scores[sessions[i]] < scores[sessions[j]]
+	})
+}
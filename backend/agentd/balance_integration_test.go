@@ -0,0 +1,64 @@
+// +build integration,!race
+
+package agentd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionCountsIntegration(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client := e.NewEmbeddedClient()
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	newAgentd := func(name string, sessions int) *Agentd {
+		a := &Agentd{
+			ctx:         ctx,
+			client:      client,
+			backendName: name,
+			sessions:    make(map[*Session]struct{}),
+		}
+		for i := 0; i < sessions; i++ {
+			session := &Session{ctx: ctx, sendq: make(chan *transport.Message, 1)}
+			a.sessions[session] = struct{}{}
+		}
+		return a
+	}
+	a := newAgentd("a", 4)
+	b := newAgentd("b", 0)
+
+	for _, agentd := range []*Agentd{a, b} {
+		_, err := agentd.publishSessionCount(sessionCountsPath+"/"+agentd.backendName, clientv3.NoLease)
+		require.NoError(t, err)
+	}
+	counts, err := b.SessionCounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 4, "b": 0}, counts)
+
+	// Only the backend exceeding its fair share hands off sessions
+	a.balance()
+	assert.True(t, a.isHandingOff())
+	var handedOff int
+	for session := range a.sessions {
+		handedOff += len(session.sendq)
+	}
+	assert.Equal(t, 2, handedOff)
+	b.balance()
+	assert.False(t, b.isHandingOff())
+
+	watch := client.Watch(ctx, rebalanceKey)
+	require.NoError(t, a.Rebalance(ctx))
+	resp := <-watch
+	require.NoError(t, resp.Err())
+	assert.Len(t, resp.Events, 1)
+}
@@ -0,0 +1,90 @@
+package agentd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcessSessions(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts map[string]int
+		want   int
+	}{
+		{
+			name:   "single backend",
+			counts: map[string]int{"a": 100},
+			want:   0,
+		},
+		{
+			name:   "balanced",
+			counts: map[string]int{"a": 50, "b": 50},
+			want:   0,
+		},
+		{
+			name:   "within tolerance",
+			counts: map[string]int{"a": 54, "b": 46},
+			want:   0,
+		},
+		{
+			name:   "new backend",
+			counts: map[string]int{"a": 100, "b": 100, "c": 0},
+			want:   33,
+		},
+		{
+			name:   "few sessions",
+			counts: map[string]int{"a": 4, "b": 0},
+			want:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, excessSessions(tt.counts, "a"))
+		})
+	}
+}
+
+func TestSortHandoff(t *testing.T) {
+	newSessions := func() []*Session {
+		var sessions []*Session
+		for i := 0; i < 100; i++ {
+			sessions = append(sessions, &Session{cfg: SessionConfig{
+				Namespace: "default",
+				AgentName: fmt.Sprintf("agent%d", i),
+			}})
+		}
+		return sessions
+	}
+	backends := []string{"a", "b", "c"}
+
+	sessions := newSessions()
+	sortHandoff(sessions, backends, "a")
+
+	// The agents assigned to the other backends are handed off first
+	var handedOff bool
+	for _, session := range sessions {
+		assigned := true
+		for _, backend := range backends[1:] {
+			if sessionScore(session, backend) > sessionScore(session, "a") {
+				assigned = false
+			}
+		}
+		if assigned {
+			handedOff = true
+		} else if handedOff {
+			t.Fatalf("agent %s assigned to another backend handed off late", session.cfg.AgentName)
+		}
+	}
+
+	// The same agents are handed off, whatever the order of the sessions
+	other := newSessions()
+	for i, j := 0, len(other)-1; i < j; i, j = i+1, j-1 {
+		other[i], other[j] = other[j], other[i]
+	}
+	sortHandoff(other, backends, "a")
+	for i := range sessions {
+		assert.Equal(t, sessions[i].cfg.AgentName, other[i].cfg.AgentName)
+	}
+}
@@ -0,0 +1,94 @@
+package agentd
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/transport"
+)
+
+const (
+	// CertUserPrefix prefixes the name of the users authenticated with a
+	// client certificate, so they can't be mistaken for the users of the store.
+	CertUserPrefix = "agent:"
+
+	// CertGroup is the group of the agents authenticated with a client
+	// certificate, bound to the system:agent cluster role by the seeds.
+	CertGroup = "system:agents"
+)
+
+// CertIdentity returns the entity name and namespace established by the
+// client certificate of an agent. The entity name is the common name of the
+// certificate, or its first DNS name, and the namespace is its first
+// organizational unit, or the default namespace. Both must be valid names.
+func CertIdentity(cert *x509.Certificate) (name, namespace string, err error) {
+	name = cert.Subject.CommonName
+	if name == "" && len(cert.DNSNames) > 0 {
+		name = cert.DNSNames[0]
+	}
+	if name == "" {
+		return "", "", errors.New("the certificate has neither a common name nor a DNS name")
+	}
+	if err := corev2.ValidateName(name); err != nil {
+		return "", "", fmt.Errorf("the certificate entity name %s", err)
+	}
+
+	namespace = "default"
+	if len(cert.Subject.OrganizationalUnit) > 0 {
+		namespace = cert.Subject.OrganizationalUnit[0]
+	}
+	if err := corev2.ValidateName(namespace); err != nil {
+		return "", "", fmt.Errorf("the certificate namespace %s", err)
+	}
+	return name, namespace, nil
+}
+
+// verifiedCert returns the verified client certificate of the request, if
+// any.
+func verifiedCert(r *http.Request) *x509.Certificate {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return r.TLS.VerifiedChains[0][0]
+}
+
+// authenticateCert authenticates the agent with its verified client
+// certificate, which establishes its entity name and namespace. The agent can
+// omit them, but is rejected if it claims another identity.
+func (a *Agentd) authenticateCert(next http.Handler, w http.ResponseWriter, r *http.Request, cert *x509.Certificate) {
+	name, namespace, err := CertIdentity(cert)
+	if err != nil {
+		logger.WithError(err).Error("invalid client certificate")
+		http.Error(w, "bad credentials", http.StatusUnauthorized)
+		return
+	}
+	claims := map[string]string{
+		transport.HeaderKeyAgentName: name,
+		transport.HeaderKeyNamespace: namespace,
+	}
+	for key, value := range claims {
+		if header := r.Header.Get(key); header != "" && header != value {
+			logger.
+				WithField("agent", name).
+				WithField("namespace", namespace).
+				Errorf("the %s header %q does not match the client certificate", key, header)
+			http.Error(w, "the agent identity does not match its certificate", http.StatusUnauthorized)
+			return
+		}
+		r.Header.Set(key, value)
+	}
+
+	user := &corev2.User{
+		Username: CertUserPrefix + name,
+		Groups:   []string{CertGroup},
+	}
+	r.Header.Set(transport.HeaderKeyUser, user.Username)
+
+	jwtClaims, _ := jwt.NewClaims(user)
+	ctx := jwt.SetClaimsIntoContext(r, jwtClaims)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
@@ -0,0 +1,111 @@
+package agentd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertIdentity(t *testing.T) {
+	tests := []struct {
+		description string
+		cert        *x509.Certificate
+		name        string
+		namespace   string
+		wantErr     bool
+	}{
+		{
+			description: "common name and organizational unit",
+			cert:        &x509.Certificate{Subject: pkix.Name{CommonName: "web-01", OrganizationalUnit: []string{"ops"}}},
+			name:        "web-01",
+			namespace:   "ops",
+		},
+		{
+			description: "dns name and default namespace",
+			cert:        &x509.Certificate{DNSNames: []string{"web-01.example.com"}},
+			name:        "web-01.example.com",
+			namespace:   "default",
+		},
+		{
+			description: "no name",
+			cert:        &x509.Certificate{},
+			wantErr:     true,
+		},
+		{
+			description: "invalid name",
+			cert:        &x509.Certificate{Subject: pkix.Name{CommonName: "web/01"}},
+			wantErr:     true,
+		},
+		{
+			description: "invalid namespace",
+			cert:        &x509.Certificate{Subject: pkix.Name{CommonName: "web-01", OrganizationalUnit: []string{"ops/../dev"}}},
+			wantErr:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			name, namespace, err := CertIdentity(tc.cert)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.name, name)
+			assert.Equal(t, tc.namespace, namespace)
+		})
+	}
+}
+
+func TestAgentdCertAuthentication(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "web-01", OrganizationalUnit: []string{"ops"}}}
+
+	tests := []struct {
+		description  string
+		certAuth     bool
+		agentName    string
+		namespace    string
+		expectedCode int
+	}{
+		{description: "identity from the certificate", certAuth: true, expectedCode: http.StatusOK},
+		{description: "matching identity", certAuth: true, agentName: "web-01", namespace: "ops", expectedCode: http.StatusOK},
+		{description: "other agent name", certAuth: true, agentName: "db-01", expectedCode: http.StatusUnauthorized},
+		{description: "other namespace", certAuth: true, namespace: "default", expectedCode: http.StatusUnauthorized},
+		{description: "certificate authentication disabled", expectedCode: http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var header http.Header
+			var claims *corev2.Claims
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header
+				claims, _ = r.Context().Value(corev2.ClaimsKey).(*corev2.Claims)
+			})
+			agentd := &Agentd{certAuth: tc.certAuth}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+			req.Header.Set(transport.HeaderKeyAgentName, tc.agentName)
+			req.Header.Set(transport.HeaderKeyNamespace, tc.namespace)
+			w := httptest.NewRecorder()
+			agentd.AuthenticationMiddleware(testHandler).ServeHTTP(w, req)
+			require.Equal(t, tc.expectedCode, w.Code, w.Body.String())
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "web-01", header.Get(transport.HeaderKeyAgentName))
+			assert.Equal(t, "ops", header.Get(transport.HeaderKeyNamespace))
+			assert.Equal(t, "agent:web-01", header.Get(transport.HeaderKeyUser))
+			require.NotNil(t, claims)
+			assert.Equal(t, []string{CertGroup}, claims.Groups)
+		})
+	}
+}
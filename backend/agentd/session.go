@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -16,12 +17,17 @@ import (
 	"github.com/sensu/sensu-go/backend/ringv2"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/handler"
+	"github.com/sensu/sensu-go/tracing"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sirupsen/logrus"
 )
 
 const deletedEventSentinel = -1
 
+// deregisteredEventSentinel is the keepalive timestamp asking keepalived to
+// deregister an entity.
+const deregisteredEventSentinel = -2
+
 var (
 	sessionCounter = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -30,8 +36,55 @@ var (
 		},
 		[]string{"namespace"},
 	)
+
+	sessionFormatCounter = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sensu_go_agent_sessions_by_format",
+			Help: "Number of active agent sessions on this backend, by negotiated serialization format",
+		},
+		[]string{"format"},
+	)
+
+	sessionMessagesDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_go_agent_session_messages_dropped",
+			Help: "The total number of messages of the agent sessions that could not be handled or sent, by direction",
+		},
+		[]string{"direction"},
+	)
+
+	sessionMessageDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sensu_go_agent_session_message_duration_seconds",
+			Help:    "The duration of the handling of the messages received from the agents, in seconds",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		},
+		[]string{"type"},
+	)
 )
 
+// The directions of the dropped session messages.
+const (
+	messageReceived = "received"
+	messageSent     = "sent"
+)
+
+// ProtobufSerializationFormat and JSONSerializationFormat are the names of
+// the serialization formats reported by the metrics.
+const (
+	ProtobufSerializationFormat = "protobuf"
+	JSONSerializationFormat     = "json"
+)
+
+// SerializationFormat returns the name of the serialization format of the
+// given content type.
+func SerializationFormat(contentType string) string {
+	if contentType == ProtobufSerializationHeader {
+		return ProtobufSerializationFormat
+	}
+	return JSONSerializationFormat
+}
+
 // ProtobufSerializationHeader is the Content-Type header which indicates protobuf serialization.
 const ProtobufSerializationHeader = "application/octet-stream"
 
@@ -72,12 +125,17 @@ type Session struct {
 	unmarshal    UnmarshalFunc
 
 	subscriptions chan messaging.Subscription
+
+	// backpressureUntil is the time until which the agent was asked to hold
+	// its events, only accessed by the receiver.
+	backpressureUntil time.Time
 }
 
 func newSessionHandler(s *Session) *handler.MessageHandler {
 	handler := handler.NewMessageHandler()
 	handler.AddHandler(transport.MessageTypeKeepalive, s.handleKeepalive)
 	handler.AddHandler(transport.MessageTypeEvent, s.handleEvent)
+	handler.AddHandler(transport.MessageTypeDeregistration, s.handleDeregistration)
 
 	return handler
 }
@@ -93,6 +151,16 @@ type SessionConfig struct {
 	Subscriptions []string
 	RingPool      *ringv2.Pool
 	WriteTimeout  int
+
+	// Tracer traces the reception of the events, if set.
+	Tracer *tracing.Tracer
+
+	// Backpressure is true if the agent handles the backpressure messages.
+	Backpressure bool
+
+	// events queues the events received for eventd, which are published
+	// directly if nil.
+	events *eventQueue
 }
 
 // NewSession creates a new Session object given the triple of a transport
@@ -160,6 +228,14 @@ func makeEntitySwitchBurialEvent(cfg SessionConfig) *corev2.Event {
 	}
 }
 
+// makeEntityDeregistrationEvent returns the event sent to keepalived when the
+// agent deregisters its entity.
+func makeEntityDeregistrationEvent(cfg SessionConfig) *corev2.Event {
+	event := makeEntitySwitchBurialEvent(cfg)
+	event.Timestamp = deregisteredEventSentinel
+	return event
+}
+
 // Receiver returns the check channel for the session.
 func (s *Session) Receiver() chan<- interface{} {
 	return s.checkChannel
@@ -190,7 +266,11 @@ func (s *Session) receiver() {
 			return
 		}
 		ctx, cancel := context.WithTimeout(s.ctx, time.Duration(s.cfg.WriteTimeout)*time.Second)
-		if err := s.handler.Handle(ctx, msg.Type, msg.Payload); err != nil {
+		start := time.Now()
+		err = s.handler.Handle(ctx, msg.Type, msg.Payload)
+		sessionMessageDuration.WithLabelValues(msg.Type).Observe(time.Since(start).Seconds())
+		if err != nil {
+			sessionMessagesDropped.WithLabelValues(messageReceived).Inc()
 			logger.WithError(err).WithFields(logrus.Fields{
 				"type":    msg.Type,
 				"payload": string(msg.Payload)}).Error("error handling message")
@@ -218,12 +298,14 @@ func (s *Session) sender() {
 		case c := <-s.checkChannel:
 			request, ok := c.(*corev2.CheckRequest)
 			if !ok {
+				sessionMessagesDropped.WithLabelValues(messageSent).Inc()
 				logger.Error("session received non-config over check channel")
 				continue
 			}
 
 			configBytes, err := s.marshal(request)
 			if err != nil {
+				sessionMessagesDropped.WithLabelValues(messageSent).Inc()
 				logger.WithError(err).Error("session failed to serialize check request")
 				continue
 			}
@@ -234,6 +316,7 @@ func (s *Session) sender() {
 		}
 		logger.WithField("payload_size", len(msg.Payload)).Debug("session - sending message")
 		if err := s.conn.Send(msg); err != nil {
+			sessionMessagesDropped.WithLabelValues(messageSent).Inc()
 			switch err := err.(type) {
 			case transport.ConnectionError, transport.ClosedError:
 			default:
@@ -250,6 +333,7 @@ func (s *Session) sender() {
 // 3. Start goroutine that waits for context cancellation, and shuts down service.
 func (s *Session) Start() (err error) {
 	sessionCounter.WithLabelValues(s.cfg.Namespace).Inc()
+	sessionFormatCounter.WithLabelValues(SerializationFormat(s.cfg.ContentType)).Inc()
 	s.wg = &sync.WaitGroup{}
 	s.wg.Add(2)
 	s.stopWG.Add(1)
@@ -289,6 +373,21 @@ func (s *Session) Start() (err error) {
 	return nil
 }
 
+// Reconnect asks the agent to reconnect, e.g. to another backend, after the
+// given delay.
+func (s *Session) Reconnect(delay time.Duration) {
+	payload := []byte(strconv.FormatInt(int64(delay/time.Millisecond), 10))
+	select {
+	case s.sendq <- transport.NewMessage(transport.MessageTypeReconnect, payload):
+	case <-s.ctx.Done():
+	}
+}
+
+// Done returns a channel closed when the session stops.
+func (s *Session) Done() <-chan struct{} {
+	return s.ctx.Done()
+}
+
 // Stop a running session. This will cause the send and receive loops to
 // shutdown. Blocks until the session has shutdown.
 func (s *Session) Stop() {
@@ -306,6 +405,7 @@ func (s *Session) stop() {
 	}()
 
 	sessionCounter.WithLabelValues(s.cfg.Namespace).Dec()
+	sessionFormatCounter.WithLabelValues(SerializationFormat(s.cfg.ContentType)).Dec()
 	s.wg.Wait()
 
 	for sub := range s.subscriptions {
@@ -361,14 +461,37 @@ func (s *Session) handleKeepalive(ctx context.Context, payload []byte) error {
 	return s.bus.Publish(messaging.TopicKeepalive, keepalive)
 }
 
+// handleDeregistration is the deregistration message handler. Only the entity
+// of the agent can be deregistered, whatever the payload.
+func (s *Session) handleDeregistration(ctx context.Context, payload []byte) error {
+	logger.WithFields(logrus.Fields{
+		"agent":     s.cfg.AgentName,
+		"namespace": s.cfg.Namespace,
+	}).Info("agent deregistering its entity")
+
+	return s.bus.Publish(messaging.TopicKeepalive, makeEntityDeregistrationEvent(s.cfg))
+}
+
 // handleEvent is the event message handler.
-func (s *Session) handleEvent(ctx context.Context, payload []byte) error {
+func (s *Session) handleEvent(ctx context.Context, payload []byte) (err error) {
+	start := time.Now()
+
 	// Decode the payload to an event
 	event := &corev2.Event{}
 	if err := s.unmarshal(payload, event); err != nil {
 		return err
 	}
 
+	// Trace the reception of the event, and propagate the trace to eventd
+	parent, _ := tracing.ParseTraceParent(event.TraceParent)
+	span := s.cfg.Tracer.StartAt(parent, "agentd.receive", start)
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+	tracing.SetEventAttributes(span, event)
+	event.TraceParent = span.Context().TraceParent()
+
 	// Validate the received event
 	if err := event.Validate(); err != nil {
 		return err
@@ -377,5 +500,16 @@ func (s *Session) handleEvent(ctx context.Context, payload []byte) error {
 	// Add the entity subscription to the subscriptions of this entity
 	event.Entity.Subscriptions = addEntitySubscription(event.Entity.Name, event.Entity.Subscriptions)
 
-	return s.bus.Publish(messaging.TopicEventRaw, event)
+	if s.cfg.events == nil {
+		return s.bus.Publish(messaging.TopicEventRaw, event)
+	}
+
+	// Wait for room in the event queue rather than for the handler timeout,
+	// so that the agent is held instead of its event being dropped
+	delay, err := s.cfg.events.push(s.ctx, event)
+	if err != nil {
+		return err
+	}
+	s.signalBackpressure(delay)
+	return nil
 }
@@ -175,3 +175,24 @@ func TestMakeEntitySwitchBurialEvent(t *testing.T) {
 		t.Errorf("bad timestamp: got %d, want %d", got, want)
 	}
 }
+
+func TestMakeEntityDeregistrationEvent(t *testing.T) {
+	cfg := SessionConfig{
+		Namespace:     "default",
+		AgentName:     "entity",
+		Subscriptions: []string{"default"},
+	}
+	event := makeEntityDeregistrationEvent(cfg)
+	if err := event.Entity.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := event.Timestamp, int64(deregisteredEventSentinel); got != want {
+		t.Errorf("bad timestamp: got %d, want %d", got, want)
+	}
+}
+
+func TestSerializationFormat(t *testing.T) {
+	assert.Equal(t, ProtobufSerializationFormat, SerializationFormat(ProtobufSerializationHeader))
+	assert.Equal(t, JSONSerializationFormat, SerializationFormat(JSONSerializationHeader))
+	assert.Equal(t, JSONSerializationFormat, SerializationFormat(""))
+}
@@ -9,8 +9,13 @@ import (
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/backend/authentication/providers/basic"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
 )
 
+// ErrOIDCDisabled is the error returned when the OIDC provider is not
+// configured.
+var ErrOIDCDisabled = errors.New("the oidc provider is not configured")
+
 // AuthenticationClient is an API client for authentication.
 type AuthenticationClient struct {
 	auth *authentication.Authenticator
@@ -32,6 +37,44 @@ func (a *AuthenticationClient) CreateAccessToken(ctx context.Context, username,
 		return nil, corev2.ErrUnauthorized
 	}
 
+	return issueTokens(ctx, claims)
+}
+
+// CreateAccessTokenFromIDToken creates a new access token, given an ID token
+// issued by the OIDC provider to the user, and the nonce of its request if
+// any. The refresh token issued by the provider along with the ID token, if
+// any, is used to refresh the access token.
+func (a *AuthenticationClient) CreateAccessTokenFromIDToken(ctx context.Context, idToken, nonce, refreshToken string) (*corev2.Tokens, error) {
+	provider := a.OIDCProvider()
+	if provider == nil {
+		return nil, ErrOIDCDisabled
+	}
+
+	claims, err := provider.AuthenticateIDToken(ctx, idToken, nonce)
+	if err != nil {
+		logger.WithError(err).Info("could not authenticate the id token")
+		return nil, corev2.ErrUnauthorized
+	}
+	claims.ProviderRefreshToken = refreshToken
+
+	return issueTokens(ctx, claims)
+}
+
+// OIDCProvider returns the OIDC provider, or nil if it is not configured.
+func (a *AuthenticationClient) OIDCProvider() *oidc.Provider {
+	if a.auth == nil {
+		return nil
+	}
+	for _, provider := range a.auth.Providers() {
+		if provider, ok := provider.(*oidc.Provider); ok {
+			return provider
+		}
+	}
+	return nil
+}
+
+// issueTokens issues an access token and a refresh token for the given claims.
+func issueTokens(ctx context.Context, claims *corev2.Claims) (*corev2.Tokens, error) {
 	// Add the 'system:users' group to this user
 	claims.Groups = append(claims.Groups, "system:users")
 
@@ -40,6 +83,13 @@ func (a *AuthenticationClient) CreateAccessToken(ctx context.Context, username,
 		claims.Issuer = issuer.(string)
 	}
 
+	// Only the refresh token carries the refresh token of the provider
+	refreshClaims := &corev2.Claims{
+		StandardClaims:       corev2.StandardClaims(claims.Subject),
+		ProviderRefreshToken: claims.ProviderRefreshToken,
+	}
+	claims.ProviderRefreshToken = ""
+
 	// Create an access token and its signed version
 	_, tokenString, err := jwt.AccessToken(claims)
 	if err != nil {
@@ -47,7 +97,6 @@ func (a *AuthenticationClient) CreateAccessToken(ctx context.Context, username,
 	}
 
 	// Create a refresh token and its signed version
-	refreshClaims := &corev2.Claims{StandardClaims: corev2.StandardClaims(claims.Subject)}
 	_, refreshTokenString, err := jwt.RefreshToken(refreshClaims)
 	if err != nil {
 		return nil, fmt.Errorf("error creating access token: %s", err)
@@ -139,6 +188,18 @@ func (a *AuthenticationClient) RefreshAccessToken(ctx context.Context) (*corev2.
 	// Ensure the 'system:users' group is present
 	claims.Groups = append(claims.Groups, "system:users")
 
+	// Issue a new refresh token if the provider renewed its own
+	if claims.ProviderRefreshToken != "" {
+		refreshClaims := &corev2.Claims{
+			StandardClaims:       corev2.StandardClaims(claims.Subject),
+			ProviderRefreshToken: claims.ProviderRefreshToken,
+		}
+		claims.ProviderRefreshToken = ""
+		if _, refreshTokenString, err = jwt.RefreshToken(refreshClaims); err != nil {
+			return nil, err
+		}
+	}
+
 	// Add the issuer URL
 	if issuer := ctx.Value(jwt.IssuerURLKey); issuer != nil {
 		claims.Issuer = issuer.(string)
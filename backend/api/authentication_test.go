@@ -218,3 +218,61 @@ func TestRefreshAccessToken(t *testing.T) {
 		})
 	}
 }
+
+// renewingProvider renews the refresh token of the provider on every refresh.
+type renewingProvider struct {
+	basic.Provider
+}
+
+func (p *renewingProvider) Refresh(ctx context.Context, claims *corev2.Claims) (*corev2.Claims, error) {
+	refreshClaims := ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims)
+	return &corev2.Claims{
+		StandardClaims:       corev2.StandardClaims(claims.Subject),
+		Provider:             claims.Provider,
+		ProviderRefreshToken: refreshClaims.ProviderRefreshToken + "+",
+	}, nil
+}
+
+func TestRefreshAccessTokenRenewsProviderRefreshToken(t *testing.T) {
+	authenticator := &authentication.Authenticator{}
+	authenticator.AddProvider(&renewingProvider{basic.Provider{ObjectMeta: corev2.ObjectMeta{Name: basic.Type}}})
+	auth := NewAuthenticationClient(authenticator)
+
+	claims := corev2.FixtureClaims("foo", nil)
+	ctx := contextWithClaims(claims)
+	refreshClaims := ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims)
+	refreshClaims.ProviderRefreshToken = "refresh"
+	_, refreshTokenString, _ := jwt.RefreshToken(refreshClaims)
+	ctx = context.WithValue(ctx, corev2.RefreshTokenString, refreshTokenString)
+
+	tokens, err := auth.RefreshAccessToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens.Refresh == refreshTokenString {
+		t.Fatal("the refresh token was not renewed")
+	}
+
+	// Only the refresh token carries the refresh token of the provider
+	token, err := jwt.ValidateToken(tokens.Refresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := token.Claims.(*corev2.Claims).ProviderRefreshToken, "refresh+"; got != want {
+		t.Fatalf("bad provider refresh token: got %q, want %q", got, want)
+	}
+	token, err = jwt.ValidateToken(tokens.Access)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := token.Claims.(*corev2.Claims).ProviderRefreshToken; got != "" {
+		t.Fatalf("the access token carries the provider refresh token %q", got)
+	}
+}
+
+func TestCreateAccessTokenFromIDTokenDisabled(t *testing.T) {
+	auth := NewAuthenticationClient(defaultAuth(defaultStore()))
+	if _, err := auth.CreateAccessTokenFromIDToken(context.Background(), "token", "", ""); err != ErrOIDCDisabled {
+		t.Fatalf("got error %v, want %v", err, ErrOIDCDisabled)
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -80,6 +81,37 @@ func (e *EventClient) ListEvents(ctx context.Context, pred *store.SelectionPredi
 	return events, nil
 }
 
+// CountEvents returns the number of events in a namespace, if authorized.
+func (e *EventClient) CountEvents(ctx context.Context, pred *store.SelectionPredicate) (int64, error) {
+	attrs := eventListAttributes(ctx)
+	if err := authorize(ctx, e.auth, attrs); err != nil {
+		return 0, err
+	}
+	count, err := e.store.CountEvents(ctx, pred)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't count events: %s", err)
+	}
+	return count, nil
+}
+
+// WatchEvents returns a channel that emits the events created, updated and
+// deleted in the namespace of ctx, if authorized. The channel is closed once
+// ctx is done.
+func (e *EventClient) WatchEvents(ctx context.Context) (<-chan store.WatchEventEvent, error) {
+	attrs := eventListAttributes(ctx)
+	if err := authorize(ctx, e.auth, attrs); err != nil {
+		return nil, err
+	}
+	var events <-chan store.WatchEventEvent
+	if watcher, ok := e.store.(store.EventWatcher); ok {
+		events = watcher.GetEventWatcher(ctx, 0)
+	}
+	if events == nil {
+		return nil, errors.New("couldn't watch events: not supported by the event store")
+	}
+	return events, nil
+}
+
 func eventUpdateAttributes(ctx context.Context) *authorization.Attributes {
 	return &authorization.Attributes{
 		APIGroup:   "core",
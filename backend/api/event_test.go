@@ -558,3 +558,75 @@ func TestDeleteEvent(t *testing.T) {
 		})
 	}
 }
+
+type watchingEventStore struct {
+	store.EventStore
+	events chan store.WatchEventEvent
+}
+
+func (s watchingEventStore) GetEventWatcher(ctx context.Context, revision int64) <-chan store.WatchEventEvent {
+	return s.events
+}
+
+func TestWatchEvents(t *testing.T) {
+	auth := &mockAuth{
+		attrs: map[authorization.AttributesKey]bool{
+			authorization.AttributesKey{
+				APIGroup:   "core",
+				APIVersion: "v2",
+				Namespace:  "default",
+				Resource:   "events",
+				UserName:   "legit",
+				Verb:       "list",
+			}: true,
+		},
+	}
+	events := make(chan store.WatchEventEvent)
+	client := NewEventClient(watchingEventStore{events: events}, auth, nil)
+
+	if _, err := client.WatchEvents(contextWithUser(defaultContext(), "haxor", nil)); err == nil {
+		t.Error("expected an error for an unauthorized user")
+	}
+	ch, err := client.WatchEvents(contextWithUser(defaultContext(), "legit", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ch != (<-chan store.WatchEventEvent)(events) {
+		t.Error("expected the channel of the event store")
+	}
+
+	// The event store cannot watch the events
+	client = NewEventClient(new(mockstore.MockStore), auth, nil)
+	if _, err := client.WatchEvents(contextWithUser(defaultContext(), "legit", nil)); err == nil {
+		t.Error("expected an error when the event store cannot watch the events")
+	}
+}
+
+func TestCountEvents(t *testing.T) {
+	auth := &mockAuth{
+		attrs: map[authorization.AttributesKey]bool{
+			authorization.AttributesKey{
+				APIGroup:   "core",
+				APIVersion: "v2",
+				Namespace:  "default",
+				Resource:   "events",
+				UserName:   "legit",
+				Verb:       "list",
+			}: true,
+		},
+	}
+	es := new(mockstore.MockStore)
+	es.On("CountEvents", mock.Anything, mock.Anything).Return(int64(42), nil)
+	client := NewEventClient(es, auth, nil)
+
+	if _, err := client.CountEvents(contextWithUser(defaultContext(), "haxor", nil), &store.SelectionPredicate{}); err == nil {
+		t.Error("expected an error for an unauthorized user")
+	}
+	count, err := client.CountEvents(contextWithUser(defaultContext(), "legit", nil), &store.SelectionPredicate{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := count, int64(42); got != want {
+		t.Errorf("bad count: got %d, want %d", got, want)
+	}
+}
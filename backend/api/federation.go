@@ -0,0 +1,325 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sync"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/federation"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ErrInvalidContinueToken is returned when the continue token of a federated
+// list was not returned by a previous federated list.
+var ErrInvalidContinueToken = errors.New("invalid continue token")
+
+// FederationStore is the store of the federated clusters and of the local
+// entities.
+type FederationStore interface {
+	store.ResourceStore
+	store.EntityStore
+}
+
+// FederationClient is an API client listing the events and entities of the
+// cluster along with the events and entities of its federated clusters. Every
+// event and entity is labeled with the name of its cluster, which is "local"
+// for the cluster itself.
+//
+// Listing the events or entities of the federated clusters requires the
+// permission to list them in the cluster; they are listed with the API keys
+// of the federated clusters.
+type FederationClient struct {
+	store      FederationStore
+	eventStore store.EventStore
+	auth       authorization.Authorizer
+	newRemote  func(*corev2.FederatedCluster) (remoteLister, error)
+}
+
+// remoteLister lists the resources of a federated cluster.
+type remoteLister interface {
+	Page(ctx context.Context, uri string, limit int64, continueToken string, v interface{}) (string, error)
+}
+
+// federatedContinue is the continue token of the federated lists, which
+// identifies the cluster of the next page and the continue token of the
+// cluster.
+type federatedContinue struct {
+	Cluster  string `json:"cluster"`
+	Continue string `json:"continue,omitempty"`
+}
+
+// NewFederationClient creates a new FederationClient, given a store, an event
+// store and an authorizer.
+func NewFederationClient(store FederationStore, eventStore store.EventStore, auth authorization.Authorizer) *FederationClient {
+	return &FederationClient{
+		store:      store,
+		eventStore: eventStore,
+		auth:       auth,
+		newRemote: func(c *corev2.FederatedCluster) (remoteLister, error) {
+			return federation.NewRemote(c.URL, c.APIKey, c.CABundle, c.InsecureSkipTLSVerify)
+		},
+	}
+}
+
+// ListFederatedEvents lists the events of the namespace of ctx, or of every
+// namespace if empty, of the cluster and of its federated clusters, according
+// to the selection predicate, if authorized. It also returns the names of the
+// federated clusters that could not be reached.
+func (f *FederationClient) ListFederatedEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, []string, error) {
+	if err := authorize(ctx, f.auth, eventListAttributes(ctx)); err != nil {
+		return nil, nil, err
+	}
+	local := func(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+		events, err := f.eventStore.GetEvents(ctx, pred)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't list events: %s", err)
+		}
+		resources := make([]corev2.Resource, len(events))
+		for i := range events {
+			resources[i] = events[i]
+		}
+		return resources, nil
+	}
+	remote := func(ctx context.Context, r remoteLister, limit int64, continueToken string) ([]corev2.Resource, string, error) {
+		var events []*corev2.Event
+		next, err := r.Page(ctx, federatedPath(ctx, corev2.EventsResource), limit, continueToken, &events)
+		resources := make([]corev2.Resource, len(events))
+		for i := range events {
+			resources[i] = events[i]
+		}
+		return resources, next, err
+	}
+	resources, unavailable, err := f.list(ctx, pred, local, remote)
+	if err != nil {
+		return nil, nil, err
+	}
+	events := make([]*corev2.Event, len(resources))
+	for i := range resources {
+		events[i] = resources[i].(*corev2.Event)
+	}
+	return events, unavailable, nil
+}
+
+// ListFederatedEntities lists the entities of the namespace of ctx, or of
+// every namespace if empty, of the cluster and of its federated clusters,
+// according to the selection predicate, if authorized. It also returns the
+// names of the federated clusters that could not be reached.
+func (f *FederationClient) ListFederatedEntities(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Entity, []string, error) {
+	attrs := &authorization.Attributes{
+		APIGroup:   "core",
+		APIVersion: "v2",
+		Namespace:  corev2.ContextNamespace(ctx),
+		Resource:   corev2.EntitiesResource,
+		Verb:       "list",
+	}
+	if err := authorize(ctx, f.auth, attrs); err != nil {
+		return nil, nil, err
+	}
+	local := func(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+		entities, err := f.store.GetEntities(ctx, pred)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't list entities: %s", err)
+		}
+		resources := make([]corev2.Resource, len(entities))
+		for i := range entities {
+			resources[i] = entities[i]
+		}
+		return resources, nil
+	}
+	remote := func(ctx context.Context, r remoteLister, limit int64, continueToken string) ([]corev2.Resource, string, error) {
+		var entities []*corev2.Entity
+		next, err := r.Page(ctx, federatedPath(ctx, corev2.EntitiesResource), limit, continueToken, &entities)
+		resources := make([]corev2.Resource, len(entities))
+		for i := range entities {
+			resources[i] = entities[i]
+		}
+		return resources, next, err
+	}
+	resources, unavailable, err := f.list(ctx, pred, local, remote)
+	if err != nil {
+		return nil, nil, err
+	}
+	entities := make([]*corev2.Entity, len(resources))
+	for i := range resources {
+		entities[i] = resources[i].(*corev2.Entity)
+	}
+	return entities, unavailable, nil
+}
+
+// localListFunc lists a page of the resources of the cluster.
+type localListFunc func(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
+
+// remoteListFunc lists a page of the resources of a federated cluster, and
+// returns the continue token of the next page.
+type remoteListFunc func(ctx context.Context, r remoteLister, limit int64, continueToken string) ([]corev2.Resource, string, error)
+
+// cluster is the cluster itself or a federated cluster, from which resources
+// are listed.
+type cluster struct {
+	name   string
+	remote remoteLister
+}
+
+// list lists the resources of the cluster and of its federated clusters, in
+// this order, and labels them with the name of their cluster. Without limit,
+// the clusters are listed concurrently. Otherwise, the page is filled with the
+// resources of the cluster of the continue token of pred, then with the
+// resources of the following clusters, and the continue token of pred is set
+// to where the next page starts, so a page may be empty when the previous page
+// was filled by the last resources of a cluster. The federated clusters that
+// can't be reached are skipped, and returned.
+func (f *FederationClient) list(ctx context.Context, pred *store.SelectionPredicate, local localListFunc, remote remoteListFunc) ([]corev2.Resource, []string, error) {
+	clusters, err := f.clusters(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fetch := func(c cluster, limit int64, continueToken string) ([]corev2.Resource, string, error) {
+		var resources []corev2.Resource
+		var next string
+		var err error
+		if c.remote == nil {
+			p := &store.SelectionPredicate{Limit: limit, Continue: continueToken}
+			resources, err = local(ctx, p)
+			next = p.Continue
+		} else {
+			resources, next, err = remote(ctx, c.remote, limit, continueToken)
+		}
+		for _, resource := range resources {
+			labelCluster(resource, c.name)
+		}
+		return resources, next, err
+	}
+
+	if pred.Limit == 0 {
+		return f.listAll(clusters, fetch)
+	}
+
+	var token federatedContinue
+	if pred.Continue != "" {
+		if err := json.Unmarshal([]byte(pred.Continue), &token); err != nil {
+			return nil, nil, ErrInvalidContinueToken
+		}
+	}
+	start := len(clusters)
+	for i, c := range clusters {
+		if c.name == token.Cluster || token.Cluster == "" {
+			start = i
+			break
+		}
+	}
+
+	var resources []corev2.Resource
+	var unavailable []string
+	pred.Continue = ""
+	continueToken := token.Continue
+	for i := start; i < len(clusters); i++ {
+		c := clusters[i]
+		for {
+			page, next, err := fetch(c, pred.Limit-int64(len(resources)), continueToken)
+			continueToken = ""
+			if err != nil {
+				if c.remote == nil {
+					return nil, nil, err
+				}
+				logger.WithError(err).WithField("cluster", c.name).Warn("could not list the resources of a federated cluster")
+				unavailable = append(unavailable, c.name)
+				break
+			}
+			resources = append(resources, page...)
+			if next == "" {
+				break
+			}
+			if int64(len(resources)) >= pred.Limit {
+				b, _ := json.Marshal(federatedContinue{Cluster: c.name, Continue: next})
+				pred.Continue = string(b)
+				return resources, unavailable, nil
+			}
+			continueToken = next
+		}
+		if int64(len(resources)) >= pred.Limit && i+1 < len(clusters) {
+			b, _ := json.Marshal(federatedContinue{Cluster: clusters[i+1].name})
+			pred.Continue = string(b)
+			return resources, unavailable, nil
+		}
+	}
+	return resources, unavailable, nil
+}
+
+// listAll lists every resource of the clusters concurrently.
+func (f *FederationClient) listAll(clusters []cluster, fetch func(cluster, int64, string) ([]corev2.Resource, string, error)) ([]corev2.Resource, []string, error) {
+	results := make([][]corev2.Resource, len(clusters))
+	errs := make([]error, len(clusters))
+	var wg sync.WaitGroup
+	for i := range clusters {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _, errs[i] = fetch(clusters[i], 0, "")
+		}(i)
+	}
+	wg.Wait()
+
+	var resources []corev2.Resource
+	var unavailable []string
+	for i, c := range clusters {
+		if errs[i] != nil {
+			if c.remote == nil {
+				return nil, nil, errs[i]
+			}
+			logger.WithError(errs[i]).WithField("cluster", c.name).Warn("could not list the resources of a federated cluster")
+			unavailable = append(unavailable, c.name)
+			continue
+		}
+		resources = append(resources, results[i]...)
+	}
+	return resources, unavailable, nil
+}
+
+// clusters returns the cluster itself followed by its federated clusters, by
+// name.
+func (f *FederationClient) clusters(ctx context.Context) ([]cluster, error) {
+	var federated []*corev2.FederatedCluster
+	// The federated clusters are not namespaced
+	if err := f.store.ListResources(store.NamespaceContext(ctx, ""), corev2.FederatedClustersResource, &federated, &store.SelectionPredicate{}); err != nil {
+		return nil, fmt.Errorf("couldn't list the federated clusters: %s", err)
+	}
+	clusters := []cluster{{name: corev2.FederatedClusterLocal}}
+	for _, c := range federated {
+		remote, err := f.newRemote(c)
+		if err != nil {
+			logger.WithError(err).WithField("cluster", c.Name).Error("invalid federated cluster")
+			continue
+		}
+		clusters = append(clusters, cluster{name: c.Name, remote: remote})
+	}
+	return clusters, nil
+}
+
+// federatedPath returns the API path of the resources of the given type of
+// the namespace of ctx, or of every namespace if empty.
+func federatedPath(ctx context.Context, resource string) string {
+	namespace := corev2.ContextNamespace(ctx)
+	if namespace == "" {
+		return path.Join(corev2.URLPrefix, resource)
+	}
+	return path.Join(corev2.URLPrefix, "namespaces", url.PathEscape(namespace), resource)
+}
+
+// labelCluster labels the resource with the name of its cluster.
+func labelCluster(resource corev2.Resource, name string) {
+	meta := resource.GetObjectMeta()
+	labels := make(map[string]string, len(meta.Labels)+1)
+	for k, v := range meta.Labels {
+		labels[k] = v
+	}
+	labels[corev2.FederatedClusterLabel] = name
+	meta.Labels = labels
+	resource.SetObjectMeta(meta)
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRemote is a federated cluster, whose events are paged by index.
+type fakeRemote struct {
+	events []*corev2.Event
+	err    error
+}
+
+func (r *fakeRemote) Page(ctx context.Context, uri string, limit int64, continueToken string, v interface{}) (string, error) {
+	if r.err != nil {
+		return "", r.err
+	}
+	start, _ := strconv.Atoi(continueToken)
+	end := len(r.events)
+	if limit > 0 && start+int(limit) < end {
+		end = start + int(limit)
+	}
+	events := v.(*[]*corev2.Event)
+	for _, event := range r.events[start:end] {
+		copied := *event
+		*events = append(*events, &copied)
+	}
+	if end == len(r.events) {
+		return "", nil
+	}
+	return strconv.Itoa(end), nil
+}
+
+func federationFixture(remotes map[string]*fakeRemote) (*FederationClient, *mockstore.MockStore) {
+	s := &mockstore.MockStore{}
+	s.On("ListResources", mock.Anything, corev2.FederatedClustersResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.FederatedCluster)
+			*list = []*corev2.FederatedCluster{
+				corev2.FixtureFederatedCluster("eu"),
+				corev2.FixtureFederatedCluster("us"),
+			}
+		}).Return(nil)
+	auth := &mockAuth{
+		attrs: map[authorization.AttributesKey]bool{
+			authorization.AttributesKey{
+				APIGroup:   "core",
+				APIVersion: "v2",
+				Namespace:  "default",
+				Resource:   "events",
+				UserName:   "legit",
+				Verb:       "list",
+			}: true,
+		},
+	}
+	client := NewFederationClient(s, s, auth)
+	client.newRemote = func(c *corev2.FederatedCluster) (remoteLister, error) {
+		return remotes[c.Name], nil
+	}
+	return client, s
+}
+
+func clusterNames(events []*corev2.Event) []string {
+	names := []string{}
+	for _, event := range events {
+		names = append(names, event.Labels[corev2.FederatedClusterLabel]+"/"+event.Check.Name)
+	}
+	return names
+}
+
+func TestListFederatedEvents(t *testing.T) {
+	remotes := map[string]*fakeRemote{
+		"eu": {events: []*corev2.Event{
+			corev2.FixtureEvent("entity", "a"),
+			corev2.FixtureEvent("entity", "b"),
+			corev2.FixtureEvent("entity", "c"),
+		}},
+		"us": {err: errors.New("unreachable")},
+	}
+	client, s := federationFixture(remotes)
+	s.On("GetEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{corev2.FixtureEvent("entity", "local")}, nil)
+	ctx := contextWithUser(defaultContext(), "legit", nil)
+
+	// Every event is listed without limit
+	events, unavailable, err := client.ListFederatedEvents(ctx, &store.SelectionPredicate{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"local/local", "eu/a", "eu/b", "eu/c"}, clusterNames(events))
+	assert.Equal(t, []string{"us"}, unavailable)
+
+	// The events are paged across the clusters
+	pred := &store.SelectionPredicate{Limit: 2}
+	events, _, err = client.ListFederatedEvents(ctx, pred)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"local/local", "eu/a"}, clusterNames(events))
+	require.NotEmpty(t, pred.Continue)
+
+	events, _, err = client.ListFederatedEvents(ctx, pred)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu/b", "eu/c"}, clusterNames(events))
+	require.NotEmpty(t, pred.Continue)
+
+	// The page of the clusters following a full page may be empty
+	events, unavailable, err = client.ListFederatedEvents(ctx, pred)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Equal(t, []string{"us"}, unavailable)
+	assert.Empty(t, pred.Continue)
+
+	_, _, err = client.ListFederatedEvents(ctx, &store.SelectionPredicate{Limit: 2, Continue: "invalid"})
+	assert.Error(t, err)
+}
+
+func TestListFederatedEventsUnauthorized(t *testing.T) {
+	client, _ := federationFixture(nil)
+	ctx := contextWithUser(defaultContext(), "haxor", nil)
+	_, _, err := client.ListFederatedEvents(ctx, &store.SelectionPredicate{})
+	assert.Error(t, err)
+}
@@ -154,3 +154,27 @@ func (g *GenericClient) List(ctx context.Context, resources interface{}, pred *s
 	}
 	return g.Store.ListResources(ctx, g.Kind.StorePrefix(), resources, pred)
 }
+
+// Watch returns a channel that emits the resources created, updated and
+// deleted within a namespace from the given store revision, if authorized.
+// The channel is closed once ctx is done.
+func (g *GenericClient) Watch(ctx context.Context, revision int64) (<-chan store.WatchEventResource, error) {
+	if err := g.validateConfig(); err != nil {
+		return nil, err
+	}
+	attrs := &authorization.Attributes{
+		APIGroup:   g.APIGroup,
+		APIVersion: g.APIVersion,
+		Resource:   g.Kind.RBACName(),
+		Namespace:  corev2.ContextNamespace(ctx),
+		Verb:       "list",
+	}
+	if err := authorize(ctx, g.Auth, attrs); err != nil {
+		return nil, err
+	}
+	watcher, ok := g.Store.(store.ResourceWatcher)
+	if !ok {
+		return nil, errors.New("couldn't watch resources: not supported by the store")
+	}
+	return watcher.WatchResources(ctx, g.Kind.StorePrefix(), g.Kind, revision), nil
+}
@@ -92,7 +92,7 @@ func (a *NamespaceClient) ListNamespaces(ctx context.Context) ([]*corev2.Namespa
 		if len(namespaceMap) == 0 {
 			return false
 		}
-		if !rule.VerbMatches("get") {
+		if !rule.VerbMatches("get") || rule.Denies() {
 			return true
 		}
 
@@ -204,7 +204,7 @@ func (a *NamespaceClient) FetchNamespace(ctx context.Context, name string) (*cor
 		}
 
 		// If the rule verb doesn't match "get", ignore this rule and continue
-		if !rule.VerbMatches("get") {
+		if !rule.VerbMatches("get") || rule.Denies() {
 			return true
 		}
 
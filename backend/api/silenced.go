@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -106,6 +107,22 @@ func (s *SilencedClient) GetSilencedBySubscription(ctx context.Context, subs ...
 	return silenceds, nil
 }
 
+// WatchSilenced returns a channel that emits the silenced entries created,
+// updated and deleted in the namespace of ctx from now on, if authorized. The
+// channel is closed once ctx is done.
+func (s *SilencedClient) WatchSilenced(ctx context.Context) (<-chan store.WatchEventResource, error) {
+	attrs := silencedListAttrs(ctx)
+	if err := authorize(ctx, s.auth, attrs); err != nil {
+		return nil, err
+	}
+	watcher, ok := s.store.(store.ResourceWatcher)
+	if !ok {
+		return nil, errors.New("couldn't watch silenced entries: not supported by the store")
+	}
+	var elem corev2.Silenced
+	return watcher.WatchResources(ctx, elem.StorePrefix(), &elem, -1), nil
+}
+
 func silencedUpdateAttrs(ctx context.Context, name string) *authorization.Attributes {
 	return &authorization.Attributes{
 		APIGroup:     "core",
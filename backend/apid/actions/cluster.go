@@ -2,6 +2,9 @@ package actions
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/store"
@@ -10,15 +13,61 @@ import (
 // ClusterController is a thin wrapper around clientv3.Cluster. It exists
 // only for the purposes of access control.
 type ClusterController struct {
-	cluster clientv3.Cluster
-	store   store.ClusterIDStore
+	cluster  clientv3.Cluster
+	store    store.ClusterIDStore
+	balancer AgentBalancer
 }
 
-// NewClusterController provides a new controller for the etcd cluster.
-func NewClusterController(cluster clientv3.Cluster, store store.ClusterIDStore) ClusterController {
+// AgentDrainer asks the agents connected to the backend to reconnect to other
+// backends.
+type AgentDrainer interface {
+	// Drain asks the agents to reconnect after a random delay up to jitter,
+	// and returns their number.
+	Drain(jitter time.Duration) int
+}
+
+// AgentBalancer balances the agent sessions across the backends of the
+// cluster.
+type AgentBalancer interface {
+	AgentDrainer
+
+	// SessionCounts returns the number of agent sessions of every backend,
+	// keyed by backend name.
+	SessionCounts(ctx context.Context) (map[string]int, error)
+
+	// Rebalance asks every backend to hand off its agent sessions exceeding
+	// its fair share to the other backends.
+	Rebalance(ctx context.Context) error
+}
+
+// BackendSessions is the representation of the number of agent sessions of a
+// backend in the API.
+type BackendSessions struct {
+	// Backend is the name of the backend.
+	Backend string `json:"backend"`
+
+	// Sessions is the number of agent sessions of the backend.
+	Sessions int `json:"sessions"`
+}
+
+// AgentDrain is the representation of the result of draining a backend in the
+// API.
+type AgentDrain struct {
+	// Sessions is the number of agent sessions drained.
+	Sessions int `json:"sessions"`
+}
+
+// errNoAgentd is returned when the agent sessions of a backend which doesn't
+// accept agent connections are managed.
+var errNoAgentd = errors.New("the backend does not accept agent connections")
+
+// NewClusterController provides a new controller for the etcd cluster. The
+// agent sessions of the backends are drained and balanced with balancer.
+func NewClusterController(cluster clientv3.Cluster, store store.ClusterIDStore, balancer AgentBalancer) ClusterController {
 	return ClusterController{
-		cluster: cluster,
-		store:   store,
+		cluster:  cluster,
+		store:    store,
+		balancer: balancer,
 	}
 }
 
@@ -56,3 +105,42 @@ func (c ClusterController) ClusterID(ctx context.Context) (string, error) {
 
 	return id, nil
 }
+
+// Drain asks the agents connected to the backend serving the request to
+// reconnect to other backends, each after a random delay up to jitter. Only
+// the backend serving the request is affected.
+func (c ClusterController) Drain(ctx context.Context, jitter time.Duration) (*AgentDrain, error) {
+	if c.balancer == nil {
+		return nil, errNoAgentd
+	}
+	return &AgentDrain{Sessions: c.balancer.Drain(jitter)}, nil
+}
+
+// SessionCounts returns the number of agent sessions of every backend of the
+// cluster, sorted by backend name.
+func (c ClusterController) SessionCounts(ctx context.Context) ([]BackendSessions, error) {
+	if c.balancer == nil {
+		return nil, errNoAgentd
+	}
+	counts, err := c.balancer.SessionCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]BackendSessions, 0, len(counts))
+	for backend, count := range counts {
+		sessions = append(sessions, BackendSessions{Backend: backend, Sessions: count})
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Backend < sessions[j].Backend
+	})
+	return sessions, nil
+}
+
+// Rebalance asks every backend of the cluster to hand off its agent sessions
+// exceeding its fair share to the other backends.
+func (c ClusterController) Rebalance(ctx context.Context) error {
+	if c.balancer == nil {
+		return errNoAgentd
+	}
+	return c.balancer.Rebalance(ctx)
+}
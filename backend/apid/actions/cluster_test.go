@@ -3,6 +3,7 @@ package actions
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/google/uuid"
@@ -34,8 +35,27 @@ func (mockCluster) MemberUpdate(context.Context, uint64, []string) (*clientv3.Me
 
 var _ clientv3.Cluster = mockCluster{}
 
+type mockBalancer struct {
+	jitter     time.Duration
+	rebalanced bool
+}
+
+func (m *mockBalancer) Drain(jitter time.Duration) int {
+	m.jitter = jitter
+	return 42
+}
+
+func (m *mockBalancer) SessionCounts(ctx context.Context) (map[string]int, error) {
+	return map[string]int{"b": 2, "a": 1}, nil
+}
+
+func (m *mockBalancer) Rebalance(ctx context.Context) error {
+	m.rebalanced = true
+	return nil
+}
+
 func TestMemberList(t *testing.T) {
-	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{})
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, nil)
 
 	_, err := ctrl.MemberList(context.Background())
 	if err != nil {
@@ -44,7 +64,7 @@ func TestMemberList(t *testing.T) {
 }
 
 func TestMemberAdd(t *testing.T) {
-	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{})
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, nil)
 
 	_, err := ctrl.MemberAdd(context.Background(), []string{"foo"})
 	if err != nil {
@@ -53,7 +73,7 @@ func TestMemberAdd(t *testing.T) {
 }
 
 func TestMemberUpdate(t *testing.T) {
-	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{})
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, nil)
 
 	_, err := ctrl.MemberUpdate(context.Background(), 1234, []string{"foo"})
 	if err != nil {
@@ -62,7 +82,7 @@ func TestMemberUpdate(t *testing.T) {
 }
 
 func TestMemberRemove(t *testing.T) {
-	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{})
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, nil)
 
 	_, err := ctrl.MemberRemove(context.Background(), 1234)
 	if err != nil {
@@ -74,7 +94,7 @@ func TestNewClusterIDController(t *testing.T) {
 	assert := assert.New(t)
 
 	store := &mockstore.MockStore{}
-	actions := NewClusterController(mockCluster{}, store)
+	actions := NewClusterController(mockCluster{}, store, nil)
 
 	assert.NotNil(actions)
 	assert.Equal(store, actions.store)
@@ -112,7 +132,7 @@ func TestGetClusterID(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewClusterController(mockCluster{}, store)
+		actions := NewClusterController(mockCluster{}, store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -138,3 +158,39 @@ func TestGetClusterID(t *testing.T) {
 		})
 	}
 }
+
+func TestDrain(t *testing.T) {
+	balancer := &mockBalancer{}
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, balancer)
+
+	result, err := ctrl.Drain(context.Background(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 42, result.Sessions)
+	assert.Equal(t, time.Minute, balancer.jitter)
+
+	ctrl = NewClusterController(mockCluster{}, &mockstore.MockStore{}, nil)
+	_, err = ctrl.Drain(context.Background(), time.Minute)
+	assert.Error(t, err)
+}
+
+func TestSessionCounts(t *testing.T) {
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, &mockBalancer{})
+
+	sessions, err := ctrl.SessionCounts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []BackendSessions{{Backend: "a", Sessions: 1}, {Backend: "b", Sessions: 2}}, sessions)
+}
+
+func TestRebalance(t *testing.T) {
+	balancer := &mockBalancer{}
+	ctrl := NewClusterController(mockCluster{}, &mockstore.MockStore{}, balancer)
+
+	if err := ctrl.Rebalance(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, balancer.rebalanced)
+}
@@ -42,17 +42,33 @@ const (
 	// PaymentRequired is used when the user tries to use a feature that's gated
 	// behind a license.
 	PaymentRequired
+
+	// PreconditionFailed means that a resource was not modified because its
+	// version is not the one given by the viewer, i.e. it was modified since
+	// the viewer read it.
+	PreconditionFailed
+
+	// TooManyRequests means that the viewer exceeded its rate of requests and
+	// must wait before trying again.
+	TooManyRequests
+
+	// QuotaExceeded means that a resource was not created because a resource
+	// quota of its namespace does not allow another resource of its type.
+	QuotaExceeded
 )
 
 // Default error messages if not message is provided.
 var standardErrorMessages = map[ErrCode]string{
-	InternalErr:      "internal error occurred",
-	InvalidArgument:  "invalid argument(s) received",
-	NotFound:         "not found",
-	AlreadyExistsErr: "resource already exists",
-	PermissionDenied: "unauthorized to perform action",
-	Unauthenticated:  "unauthenticated",
-	PaymentRequired:  "license required",
+	InternalErr:        "internal error occurred",
+	InvalidArgument:    "invalid argument(s) received",
+	NotFound:           "not found",
+	AlreadyExistsErr:   "resource already exists",
+	PermissionDenied:   "unauthorized to perform action",
+	Unauthenticated:    "unauthenticated",
+	PaymentRequired:    "license required",
+	PreconditionFailed: "resource was modified",
+	TooManyRequests:    "too many requests",
+	QuotaExceeded:      "resource quota exceeded",
 }
 
 // Error describes an issue that ocurred while performing the action.
@@ -0,0 +1,38 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogLevel is the representation of the backend log level in the API.
+type LogLevel struct {
+	Level string `json:"level"`
+}
+
+// LogLevelController exposes actions to read and change the log level of the
+// backend at runtime.
+type LogLevelController struct{}
+
+// NewLogLevelController returns a new LogLevelController
+func NewLogLevelController() LogLevelController {
+	return LogLevelController{}
+}
+
+// Get returns the current log level of the backend.
+func (LogLevelController) Get(ctx context.Context) *LogLevel {
+	return &LogLevel{Level: logrus.GetLevel().String()}
+}
+
+// Set changes the log level of the backend. Only the backend serving the
+// request is affected.
+func (LogLevelController) Set(ctx context.Context, level *LogLevel) error {
+	newLevel, err := logrus.ParseLevel(level.Level)
+	if err != nil {
+		return NewError(InvalidArgument, err)
+	}
+	logrus.SetLevel(newLevel)
+	logger.Warnf("set log level to %s", newLevel)
+	return nil
+}
@@ -0,0 +1,26 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLevelController(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+
+	ctrl := NewLogLevelController()
+
+	assert.NoError(t, ctrl.Set(context.Background(), &LogLevel{Level: "debug"}))
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+	assert.Equal(t, &LogLevel{Level: "debug"}, ctrl.Get(context.Background()))
+
+	err := ctrl.Set(context.Background(), &LogLevel{Level: "chatty"})
+	assert.Error(t, err)
+	code, ok := StatusFromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, InvalidArgument, code)
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+}
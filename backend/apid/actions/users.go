@@ -2,6 +2,7 @@ package actions
 
 import (
 	"context"
+	"errors"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/bcrypt"
@@ -10,7 +11,7 @@ import (
 
 // UserController exposes actions in which a viewer can perform.
 type UserController struct {
-	store store.UserStore
+	store store.Store
 }
 
 // NewUserController returns new UserController
@@ -28,7 +29,10 @@ func (a UserController) List(ctx context.Context, pred *store.SelectionPredicate
 		return nil, NewError(InternalErr, err)
 	}
 
+	// Replace the password hashes of the users, which are stored as their
+	// password, with the password_hash field, so they can be exported
 	for i := range results {
+		results[i].PasswordHash = results[i].Password
 		results[i].Password = ""
 	}
 
@@ -74,17 +78,27 @@ func (a UserController) CreateOrReplace(ctx context.Context, user *corev2.User)
 		return NewError(InvalidArgument, err)
 	}
 
-	// Validate password
-	if err := user.ValidatePassword(); err != nil {
-		return NewError(InvalidArgument, err)
-	}
+	// A user can be given the password hash of an exported user instead of a
+	// password, to keep its credentials
+	if user.Password == "" && user.PasswordHash != "" {
+		if !bcrypt.IsHash(user.PasswordHash) {
+			return NewError(InvalidArgument, errors.New("password_hash is not a bcrypt hash"))
+		}
+		user.Password = user.PasswordHash
+	} else {
+		// Validate password
+		if err := user.ValidatePassword(); err != nil {
+			return NewError(InvalidArgument, err)
+		}
 
-	// Create password digest
-	hash, err := bcrypt.HashPassword(user.Password)
-	if err != nil {
-		return NewError(InternalErr, err)
+		// Create password digest
+		hash, err := bcrypt.HashPassword(user.Password)
+		if err != nil {
+			return NewError(InternalErr, err)
+		}
+		user.Password = hash
 	}
-	user.Password = hash
+	user.PasswordHash = ""
 
 	// Persist
 	if err := a.store.UpdateUser(user); err != nil {
@@ -94,7 +108,9 @@ func (a UserController) CreateOrReplace(ctx context.Context, user *corev2.User)
 	return nil
 }
 
-// Disable disables user identified by given name if viewer has access.
+// Disable disables user identified by given name if viewer has access. The
+// API keys of the user are revoked, and its access tokens are rejected from
+// then on.
 func (a UserController) Disable(ctx context.Context, name string) error {
 	// Fetch from store
 	result, serr := a.findUser(ctx, name)
@@ -109,7 +125,7 @@ func (a UserController) Disable(ctx context.Context, name string) error {
 		}
 	}
 
-	return nil
+	return a.revokeAPIKeys(ctx, result.Username)
 }
 
 // Enable disables user identified by given name if viewer has access.
@@ -172,6 +188,28 @@ func (a UserController) RemoveAllGroups(ctx context.Context, username string) er
 	})
 }
 
+// revokeAPIKeys deletes the API keys of a user, so that they are not valid
+// anymore if the user is reinstated.
+func (a UserController) revokeAPIKeys(ctx context.Context, username string) error {
+	// The API keys are not namespaced
+	ctx = store.NamespaceContext(ctx, "")
+
+	keys := []*corev2.APIKey{}
+	if err := a.store.ListResources(ctx, corev2.APIKeysResource, &keys, &store.SelectionPredicate{}); err != nil {
+		return NewError(InternalErr, err)
+	}
+	for _, key := range keys {
+		if key.Username != username {
+			continue
+		}
+		if err := a.store.DeleteResource(ctx, corev2.APIKeysResource, key.Name); err != nil {
+			return NewError(InternalErr, err)
+		}
+	}
+
+	return nil
+}
+
 func (a UserController) findUser(ctx context.Context, name string) (*corev2.User, error) {
 	result, serr := a.store.GetUser(ctx, name)
 	if serr != nil {
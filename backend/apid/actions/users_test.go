@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
@@ -80,6 +81,11 @@ func TestUserList(t *testing.T) {
 			// Assert
 			assert.EqualValues(tc.expectedErr, err)
 			assert.Len(results, tc.expectedLen)
+			for _, result := range results {
+				user := result.(*types.User)
+				assert.Empty(user.Password)
+				assert.NotEmpty(user.PasswordHash)
+			}
 		})
 	}
 }
@@ -163,6 +169,14 @@ func TestUserCreateOrReplace(t *testing.T) {
 	badUser := types.FixtureUser("user1")
 	badUser.Username = "!@#!#$@#^$%&$%&$&$%&%^*%&(%@###"
 
+	hashedUser := types.FixtureUser("user1")
+	hashedUser.Password = ""
+	hashedUser.PasswordHash = "$2a$10$iyYyGmveS9dcYp5DHMbOm.LShX806vB0ClzoPyt1TIgkZ9KQ62cOO"
+
+	badHashUser := types.FixtureUser("user1")
+	badHashUser.Password = ""
+	badHashUser.PasswordHash = "P@ssw0rd!"
+
 	testCases := []struct {
 		name            string
 		ctx             context.Context
@@ -179,6 +193,19 @@ func TestUserCreateOrReplace(t *testing.T) {
 			argument:    types.FixtureUser("user1"),
 			expectedErr: false,
 		},
+		{
+			name:        "Created with password hash",
+			ctx:         defaultCtx,
+			argument:    hashedUser,
+			expectedErr: false,
+		},
+		{
+			name:            "Invalid password hash",
+			ctx:             defaultCtx,
+			argument:        badHashUser,
+			expectedErr:     true,
+			expectedErrCode: InvalidArgument,
+		},
 		{
 			name:        "Already Exists",
 			ctx:         defaultCtx,
@@ -367,6 +394,9 @@ func TestUserDisable(t *testing.T) {
 			store.
 				On("GetUser", mock.Anything, mock.Anything).
 				Return(tc.fetchResult, tc.fetchErr)
+			store.
+				On("ListResources", mock.Anything, corev2.APIKeysResource, mock.Anything, mock.Anything).
+				Return(nil)
 
 			// Exec Query
 			err := actions.Disable(tc.ctx, tc.argument)
@@ -386,6 +416,27 @@ func TestUserDisable(t *testing.T) {
 	}
 }
 
+func TestUserDisableRevokesAPIKeys(t *testing.T) {
+	store := &mockstore.MockStore{}
+	actions := NewUserController(store)
+
+	store.On("GetUser", mock.Anything, "user1").Return(types.FixtureUser("user1"), nil)
+	store.On("DeleteUser", mock.Anything, mock.Anything).Return(nil)
+	store.On("ListResources", mock.Anything, corev2.APIKeysResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			keys := args.Get(2).(*[]*corev2.APIKey)
+			*keys = []*corev2.APIKey{
+				corev2.FixtureAPIKey("key1", "user1"),
+				corev2.FixtureAPIKey("key2", "user2"),
+			}
+		}).Return(nil)
+	store.On("DeleteResource", mock.Anything, corev2.APIKeysResource, "key1").Return(nil)
+
+	assert.NoError(t, actions.Disable(context.Background(), "user1"))
+	store.AssertCalled(t, "DeleteResource", mock.Anything, corev2.APIKeysResource, "key1")
+	store.AssertNotCalled(t, "DeleteResource", mock.Anything, corev2.APIKeysResource, "key2")
+}
+
 func TestUserEnable(t *testing.T) {
 	correctPermsCtx := testutil.NewContext(
 		testutil.ContextWithNamespace("default"),
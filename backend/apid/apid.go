@@ -13,16 +13,27 @@ import (
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sensu/sensu-go/backend/admission"
+	"github.com/sensu/sensu-go/backend/api"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/graphql"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
 	"github.com/sensu/sensu-go/backend/apid/routers"
+	"github.com/sensu/sensu-go/backend/audit"
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authorization/rbac"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/metricsbuffer"
+	"github.com/sensu/sensu-go/backend/nstemplate"
+	"github.com/sensu/sensu-go/backend/pipeline"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/schedulerd"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/tlsreload"
 )
 
 // APId is the backend HTTP API.
@@ -52,19 +63,57 @@ type Option func(*APId) error
 
 // Config configures APId.
 type Config struct {
-	ListenAddress       string
-	URL                 string
-	Bus                 messaging.MessageBus
-	Store               store.Store
-	EventStore          store.EventStore
-	QueueGetter         types.QueueGetter
-	TLS                 *types.TLSOptions
+	ListenAddress string
+	URL           string
+	Bus           messaging.MessageBus
+	Store         store.Store
+	EventStore    store.EventStore
+	QueueGetter   types.QueueGetter
+	TLS           *types.TLSOptions
+	TLSReload     time.Duration
+
+	// GetCertificate, if set, provides the TLS certificate of the API
+	// instead of the files of TLS, e.g. to serve an ACME certificate.
+	GetCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+
 	Cluster             clientv3.Cluster
 	EtcdClientTLSConfig *tls.Config
 	Authenticator       *authentication.Authenticator
 	ClusterVersion      string
 	GraphQLService      *graphql.Service
 	HealthRouter        *routers.HealthRouter
+	AuditLogger         audit.Logger
+	FilterTracer        *pipeline.FilterTracer
+	RoundRobinTracker   *schedulerd.RoundRobinTracker
+
+	// AgentBalancer drains and balances the agent sessions of the backend,
+	// or is nil if the backend doesn't accept agent connections.
+	AgentBalancer actions.AgentBalancer
+
+	// GraphQLPersistedQueries and GraphQLCacheTTL configure the persisted
+	// queries and the result cache of the GraphQL service.
+	GraphQLPersistedQueries *routers.GraphQLPersistedQueries
+	GraphQLCacheTTL         time.Duration
+
+	// OpenAPI is the OpenAPI document of the API, generated from the routes
+	// of its router if nil.
+	OpenAPI *openapi.Spec
+
+	// RateLimiter limits the rate of the requests of every user and API key,
+	// or is nil for no limits.
+	RateLimiter *middlewares.RateLimiter
+
+	// EventHistoryStore is the store of the occurrences of the events kept
+	// by the event retention policies.
+	EventHistoryStore store.EventHistoryStore
+
+	// MetricsBuffer keeps the downsampled metrics of the events, or is nil
+	// if the buffer is disabled.
+	MetricsBuffer *metricsbuffer.Buffer
+
+	// Diagnostics collects the diagnostics bundles of the backend, or is nil
+	// if they are not served.
+	Diagnostics routers.DiagnosticsCollector
 }
 
 // New creates a new APId.
@@ -88,19 +137,34 @@ func New(c Config, opts ...Option) (*APId, error) {
 	// prepare TLS config
 	var tlsServerConfig *tls.Config
 	var err error
-	if c.TLS != nil {
+	if c.TLS != nil || c.GetCertificate != nil {
 		tlsServerConfig, err = c.TLS.ToServerTLSConfig()
 		if err != nil {
 			return nil, err
 		}
+		if c.GetCertificate != nil {
+			tlsServerConfig.Certificates = nil
+			tlsServerConfig.NameToCertificate = nil
+			tlsServerConfig.GetCertificate = c.GetCertificate
+		} else if err := tlsreload.Configure(tlsServerConfig, c.TLS.GetCertFile(), c.TLS.GetKeyFile(), c.TLSReload); err != nil {
+			return nil, err
+		}
 	}
 
+	_ = prometheus.Register(middlewares.ThrottledRequests)
+
 	router := NewRouter()
+	if c.OpenAPI == nil {
+		c.OpenAPI = openapi.NewSpec(router)
+	}
 	_ = PublicSubrouter(router, c)
 	a.GraphQLSubrouter = GraphQLSubrouter(router, c)
 	_ = AuthenticationSubrouter(router, c)
 	a.CoreSubrouter = CoreSubrouter(router, c)
 	a.EntityLimitedCoreSubrouter = EntityLimitedCoreSubrouter(router, c)
+	_ = BulkSubrouter(router, c)
+	_ = SCIMSubrouter(router, c)
+	_ = AccessReviewSubrouter(router, c)
 
 	a.HTTPServer = &http.Server{
 		Addr:         c.ListenAddress,
@@ -150,36 +214,67 @@ func AuthenticationSubrouter(router *mux.Router, cfg Config) *mux.Router {
 // CoreSubrouter initializes a subrouter that handles all requests coming to
 // /api/core/v2
 func CoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
+	// The requests are authenticated with the store itself, so that recording
+	// the use of the API keys does not call the admission webhooks
+	authentication := middlewares.Authentication{Store: cfg.Store}
+
+	// Enforce the resource quotas and call the admission webhooks before the
+	// resources are written, and provision the new namespaces
+	quotas := &quota.Enforcer{Store: cfg.Store, EventStore: cfg.EventStore}
+	cfg.Store = admission.NewStore(quota.NewStore(nstemplate.NewStore(cfg.Store)))
+
 	subrouter := NewSubrouter(
 		router.PathPrefix("/api/{group:core}/{version:v2}/"),
 		middlewares.SimpleLogger{},
 		middlewares.Namespace{},
-		middlewares.Authentication{Store: cfg.Store},
+		middlewares.Audit{Logger: cfg.AuditLogger},
+		authentication,
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
 		middlewares.AuthorizationAttributes{},
 		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}},
 		middlewares.LimitRequest{},
+		middlewares.ValidateRequest{Spec: cfg.OpenAPI},
 		middlewares.Pagination{},
+		middlewares.ResourceVersion{},
+		middlewares.DryRun{},
 	)
 	mountRouters(
 		subrouter,
+		routers.NewAdmissionWebhooksRouter(cfg.Store),
 		routers.NewAssetRouter(cfg.Store),
 		routers.NewAPIKeysRouter(cfg.Store),
 		routers.NewChecksRouter(cfg.Store, cfg.QueueGetter),
 		routers.NewClusterRolesRouter(cfg.Store),
 		routers.NewClusterRoleBindingsRouter(cfg.Store),
-		routers.NewClusterRouter(actions.NewClusterController(cfg.Cluster, cfg.Store)),
+		routers.NewClusterRouter(actions.NewClusterController(cfg.Cluster, cfg.Store, cfg.AgentBalancer)),
+		routers.NewCorrelationsRouter(cfg.Store),
+		routers.NewEnrichersRouter(cfg.Store),
+		routers.NewKeepalivePoliciesRouter(cfg.Store),
+		routers.NewMaintenanceWindowsRouter(cfg.Store),
 		routers.NewEventFiltersRouter(cfg.Store),
+		routers.NewEventRetentionPoliciesRouter(cfg.Store),
 		routers.NewExtensionsRouter(cfg.Store),
 		routers.NewHandlersRouter(cfg.Store),
 		routers.NewHooksRouter(cfg.Store),
+		routers.NewLogLevelRouter(actions.NewLogLevelController()),
 		routers.NewMutatorsRouter(cfg.Store),
 		routers.NewNamespacesRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}),
+		routers.NewNamespaceTemplatesRouter(cfg.Store),
+		routers.NewReplicatorsRouter(cfg.Store),
+		routers.NewFederatedClustersRouter(cfg.Store),
+		routers.NewResourceQuotasRouter(cfg.Store, quotas),
 		routers.NewRolesRouter(cfg.Store),
 		routers.NewRoleBindingsRouter(cfg.Store),
+		routers.NewRoundRobinRouter(cfg.Store, cfg.RoundRobinTracker),
+		routers.NewSecretsRouter(cfg.Store),
+		routers.NewSecretsProvidersRouter(cfg.Store),
 		routers.NewSilencedRouter(cfg.Store),
 		routers.NewTessenRouter(actions.NewTessenController(cfg.Store, cfg.Bus)),
 		routers.NewUsersRouter(cfg.Store),
 	)
+	if cfg.Diagnostics != nil {
+		mountRouters(subrouter, routers.NewDiagnosticsRouter(cfg.Diagnostics))
+	}
 
 	return subrouter
 }
@@ -187,20 +282,96 @@ func CoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
 // EntityLimitedCoreSubrouter initializes a subrouter that handles all requests
 // coming to /api/core/v2 that must be gated by entity limits.
 func EntityLimitedCoreSubrouter(router *mux.Router, cfg Config) *mux.Router {
+	authentication := middlewares.Authentication{Store: cfg.Store}
+	quotas := &quota.Enforcer{Store: cfg.Store, EventStore: cfg.EventStore}
+	cfg.Store = admission.NewStore(quota.NewStore(cfg.Store))
+
 	subrouter := NewSubrouter(
 		router.PathPrefix("/api/{group:core}/{version:v2}/"),
 		middlewares.SimpleLogger{},
 		middlewares.Namespace{},
-		middlewares.Authentication{Store: cfg.Store},
+		middlewares.Audit{Logger: cfg.AuditLogger},
+		authentication,
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
 		middlewares.AuthorizationAttributes{},
 		middlewares.Authorization{Authorizer: &rbac.Authorizer{Store: cfg.Store}},
 		middlewares.LimitRequest{},
+		middlewares.ValidateRequest{Spec: cfg.OpenAPI},
 		middlewares.Pagination{},
+		middlewares.ResourceVersion{},
+		middlewares.DryRun{},
 	)
 	mountRouters(
 		subrouter,
 		routers.NewEntitiesRouter(cfg.Store, cfg.EventStore),
-		routers.NewEventsRouter(cfg.EventStore, cfg.Bus),
+		routers.NewEventsRouter(cfg.EventStore, cfg.Bus, quotas),
+		routers.NewFederationRouter(api.NewFederationClient(cfg.Store, cfg.EventStore, &rbac.Authorizer{Store: cfg.Store})),
+		routers.NewEventHistoryRouter(cfg.EventHistoryStore),
+		routers.NewFilterTracesRouter(cfg.FilterTracer),
+		routers.NewEventMetricsRouter(cfg.MetricsBuffer),
+	)
+
+	return subrouter
+}
+
+// BulkSubrouter initializes a subrouter that handles the bulk operations of
+// /api/core/v2/bulk. The bulk router authorizes each resource of the requests
+// itself, so the requests are not authorized as a whole.
+func BulkSubrouter(router *mux.Router, cfg Config) *mux.Router {
+	authentication := middlewares.Authentication{Store: cfg.Store}
+	cfg.Store = admission.NewStore(quota.NewStore(nstemplate.NewStore(cfg.Store)))
+
+	subrouter := NewSubrouter(
+		router.PathPrefix("/api/{group:core}/{version:v2}/"),
+		middlewares.SimpleLogger{},
+		authentication,
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
+		middlewares.LimitRequest{Limit: middlewares.MaxBulkBytesLimit},
+		middlewares.DryRun{},
+	)
+	mountRouters(
+		subrouter,
+		routers.NewBulkRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}, cfg.AuditLogger),
+	)
+
+	return subrouter
+}
+
+// SCIMSubrouter initializes a subrouter that handles the requests of the
+// identity management systems provisioning the users with the SCIM protocol,
+// coming to /scim/v2. The SCIM router authorizes the requests itself, since
+// its paths don't follow the conventions of the API.
+func SCIMSubrouter(router *mux.Router, cfg Config) *mux.Router {
+	subrouter := NewSubrouter(
+		router.PathPrefix("/scim/v2"),
+		middlewares.SimpleLogger{},
+		middlewares.Authentication{Store: cfg.Store},
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
+		middlewares.LimitRequest{},
+	)
+	mountRouters(
+		subrouter,
+		routers.NewSCIMRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}),
+	)
+
+	return subrouter
+}
+
+// AccessReviewSubrouter initializes a subrouter that handles the requests of
+// /api/core/v2/can-i, which evaluate the permissions of the users. The access
+// review router authorizes the requests itself, since any user can evaluate
+// its own permissions.
+func AccessReviewSubrouter(router *mux.Router, cfg Config) *mux.Router {
+	subrouter := NewSubrouter(
+		router.PathPrefix("/api/{group:core}/{version:v2}/"),
+		middlewares.SimpleLogger{},
+		middlewares.Authentication{Store: cfg.Store},
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
+		middlewares.LimitRequest{},
+	)
+	mountRouters(
+		subrouter,
+		routers.NewAccessReviewRouter(cfg.Store, &rbac.Authorizer{Store: cfg.Store}),
 	)
 
 	return subrouter
@@ -221,11 +392,16 @@ func GraphQLSubrouter(router *mux.Router, cfg Config) *mux.Router {
 		// https://github.com/graphql/graphiql
 		// https://graphql.org/learn/introspection/
 		middlewares.Authentication{IgnoreUnauthorized: true, Store: cfg.Store},
+		middlewares.RateLimit{Limiter: cfg.RateLimiter},
 	)
 
 	mountRouters(
 		subrouter,
-		&routers.GraphQLRouter{Service: cfg.GraphQLService},
+		&routers.GraphQLRouter{
+			Service:          cfg.GraphQLService,
+			PersistedQueries: cfg.GraphQLPersistedQueries,
+			CacheTTL:         cfg.GraphQLCacheTTL,
+		},
 	)
 
 	return subrouter
@@ -243,6 +419,8 @@ func PublicSubrouter(router *mux.Router, cfg Config) *mux.Router {
 	mountRouters(subrouter,
 		cfg.HealthRouter,
 		routers.NewVersionRouter(actions.NewVersionController(cfg.ClusterVersion)),
+		routers.NewOpenAPIRouter(cfg.OpenAPI),
+		routers.NewOIDCRouter(cfg.Authenticator),
 		routers.NewTessenMetricRouter(actions.NewTessenMetricController(cfg.Bus)),
 	)
 
@@ -272,7 +450,7 @@ func (a *APId) Start() error {
 	go func() {
 		defer a.wg.Done()
 		var err error
-		if a.tls != nil {
+		if a.HTTPServer.TLSConfig != nil {
 			// TLS configuration comes from ToServerTLSConfig
 			err = a.HTTPServer.ServeTLS(ln, "", "")
 		} else {
@@ -37,6 +37,13 @@ type EventClient interface {
 	FetchEvent(ctx context.Context, entity, check string) (*corev2.Event, error)
 	DeleteEvent(ctx context.Context, entity, check string) error
 	ListEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, error)
+	CountEvents(ctx context.Context, pred *store.SelectionPredicate) (int64, error)
+	WatchEvents(ctx context.Context) (<-chan store.WatchEventEvent, error)
+}
+
+type FederationClient interface {
+	ListFederatedEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, []string, error)
+	ListFederatedEntities(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Entity, []string, error)
 }
 
 type EventFilterClient interface {
@@ -70,6 +77,7 @@ type SilencedClient interface {
 	ListSilenced(ctx context.Context) ([]*corev2.Silenced, error)
 	GetSilencedByCheckName(ctx context.Context, check string) ([]*corev2.Silenced, error)
 	GetSilencedBySubscription(ctx context.Context, subs ...string) ([]*corev2.Silenced, error)
+	WatchSilenced(ctx context.Context) (<-chan store.WatchEventResource, error)
 }
 
 type NamespaceClient interface {
@@ -87,6 +87,10 @@ func (c *MockSilencedClient) GetSilencedBySubscription(ctx context.Context, subs
 	args := c.Called(ctx, subs)
 	return args.Get(0).([]*corev2.Silenced), args.Error(1)
 }
+func (c *MockSilencedClient) WatchSilenced(ctx context.Context) (<-chan store.WatchEventResource, error) {
+	args := c.Called(ctx)
+	return args.Get(0).(<-chan store.WatchEventResource), args.Error(1)
+}
 
 type MockHandlerClient struct {
 	mock.Mock
@@ -162,6 +166,30 @@ func (c *MockEventClient) ListEvents(ctx context.Context, pred *store.SelectionP
 	return args.Get(0).([]*corev2.Event), args.Error(1)
 }
 
+func (c *MockEventClient) CountEvents(ctx context.Context, pred *store.SelectionPredicate) (int64, error) {
+	args := c.Called(ctx, pred)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (c *MockEventClient) WatchEvents(ctx context.Context) (<-chan store.WatchEventEvent, error) {
+	args := c.Called(ctx)
+	return args.Get(0).(<-chan store.WatchEventEvent), args.Error(1)
+}
+
+type MockFederationClient struct {
+	mock.Mock
+}
+
+func (c *MockFederationClient) ListFederatedEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, []string, error) {
+	args := c.Called(ctx, pred)
+	return args.Get(0).([]*corev2.Event), args.Get(1).([]string), args.Error(2)
+}
+
+func (c *MockFederationClient) ListFederatedEntities(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Entity, []string, error) {
+	args := c.Called(ctx, pred)
+	return args.Get(0).([]*corev2.Entity), args.Get(1).([]string), args.Error(2)
+}
+
 type MockMutatorClient struct {
 	mock.Mock
 }
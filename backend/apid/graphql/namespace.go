@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"errors"
+	"math"
 	"sort"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/sensu/sensu-go/backend/apid/graphql/filter"
 	"github.com/sensu/sensu-go/backend/apid/graphql/globalid"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/graphql"
 	string_utils "github.com/sensu/sensu-go/util/strings"
 )
@@ -20,7 +22,8 @@ var _ schema.NamespaceFieldResolvers = (*namespaceImpl)(nil)
 //
 
 type namespaceImpl struct {
-	client NamespaceClient
+	client      NamespaceClient
+	eventClient EventClient
 }
 
 // ID implements response to request for 'id' field.
@@ -288,34 +291,69 @@ func (r *namespaceImpl) Entities(p schema.NamespaceEntitiesFieldResolverParams)
 
 // Events implements response to request for 'events' field.
 func (r *namespaceImpl) Events(p schema.NamespaceEventsFieldResolverParams) (interface{}, error) {
-	res := newOffsetContainer(p.Args.Offset, p.Args.Limit)
+	res := newEventConnection(p.Args.Offset, p.Args.Limit)
 	nsp := p.Source.(*corev2.Namespace)
-
-	// fetch
-	results, err := loadEvents(p.Context, nsp.Name)
-	if err != nil {
-		return res, err
+	if p.Args.Limit <= 0 {
+		return res, nil
 	}
 
-	// filter
-	matches, err := filter.Compile(p.Args.Filters, EventFilters(), corev2.EventFields)
-	if err != nil {
-		return res, err
+	// the events of the page are selected from offset after the cursor
+	offset := clampInt(p.Args.Offset, 0, math.MaxInt32)
+	ordering, descending := eventsListOrdering(p.Args.OrderBy)
+	pred := &store.SelectionPredicate{
+		Continue:   p.Args.After,
+		Limit:      int64(offset + p.Args.Limit),
+		Ordering:   ordering,
+		Descending: descending,
 	}
-	filteredResults := make([]*corev2.Event, 0, len(results))
-	for i := range results {
-		if matches(results[i]) {
-			filteredResults = append(filteredResults, results[i])
+
+	var records []*corev2.Event
+	if len(p.Args.Filters) == 0 {
+		// sort and paginate in the store
+		ctx := store.NamespaceContext(p.Context, nsp.Name)
+		results, err := r.eventClient.ListEvents(ctx, pred)
+		if err != nil {
+			return res, handleListErr(err)
+		}
+		count, err := r.eventClient.CountEvents(ctx, &store.SelectionPredicate{})
+		if err != nil {
+			return res, handleListErr(err)
+		}
+		records = results
+		res.PageInfo.totalCount = int(count)
+	} else {
+		// fetch
+		results, err := loadEvents(p.Context, nsp.Name)
+		if err != nil {
+			return res, err
 		}
-	}
 
-	// sort records
-	sortEvents(filteredResults, p.Args.OrderBy)
+		// filter
+		matches, err := filter.Compile(p.Args.Filters, EventFilters(), corev2.EventFields)
+		if err != nil {
+			return res, err
+		}
+		filteredResults := make([]*corev2.Event, 0, len(results))
+		for i := range results {
+			if matches(results[i]) {
+				filteredResults = append(filteredResults, results[i])
+			}
+		}
 
-	// pagination
-	l, h := clampSlice(p.Args.Offset, p.Args.Offset+p.Args.Limit, len(filteredResults))
-	res.Nodes = filteredResults[l:h]
-	res.PageInfo.totalCount = len(filteredResults)
+		// sort and paginate records
+		page, err := store.NewEventPage(pred)
+		if err != nil {
+			return res, err
+		}
+		page.Add(filteredResults...)
+		records = page.Events(pred)
+		res.PageInfo.totalCount = len(filteredResults)
+	}
+
+	res.Nodes = records[clampInt(offset, 0, len(records)):]
+	if pred.Continue != "" {
+		res.NextCursor = &pred.Continue
+	}
 	return res, nil
 }
 
@@ -7,6 +7,7 @@ import (
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -83,26 +84,75 @@ func TestNamespaceTypeEventsField(t *testing.T) {
 		corev2.FixtureEvent("b", "c"),
 		corev2.FixtureEvent("c", "d"),
 	}, nil).Once()
+	client.On("CountEvents", mock.Anything, mock.Anything).Return(int64(3), nil)
 
-	impl := &namespaceImpl{}
+	impl := &namespaceImpl{eventClient: client}
 	params := schema.NamespaceEventsFieldResolverParams{}
 	cfg := ServiceConfig{EventClient: client}
 	params.Context = contextWithLoadersNoCache(context.Background(), cfg)
 	params.Source = corev2.FixtureNamespace("default")
+	params.Args.Offset = 1
 	params.Args.Limit = 20
+	params.Args.After = "cursor"
+	params.Args.OrderBy = schema.EventsListOrders.NEWEST
 
 	// Success
 	res, err := impl.Events(params)
 	assert.NoError(t, err)
-	assert.NotEmpty(t, res.(offsetContainer).Nodes)
+	assert.Len(t, res.(eventConnection).Nodes, 2)
+	assert.Equal(t, 3, res.(eventConnection).PageInfo.totalCount)
+
+	// The events are sorted and paginated by the store
+	pred := client.Calls[0].Arguments.Get(1).(*store.SelectionPredicate)
+	assert.Equal(t, &store.SelectionPredicate{
+		Continue:   "cursor",
+		Limit:      21,
+		Ordering:   store.EventSortTimestamp,
+		Descending: true,
+	}, pred)
 
 	// Store err
 	client.On("ListEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{}, errors.New("abc")).Once()
 	res, err = impl.Events(params)
-	assert.Empty(t, res.(offsetContainer).Nodes)
+	assert.Empty(t, res.(eventConnection).Nodes)
 	assert.Error(t, err)
 }
 
+func TestNamespaceTypeEventsFieldFiltered(t *testing.T) {
+	var events []*corev2.Event
+	for _, entity := range []string{"c", "a", "b", "d"} {
+		events = append(events, corev2.FixtureEvent(entity, "check"))
+	}
+	events[3].Check.Status = 2
+	client := new(MockEventClient)
+	client.On("ListEvents", mock.Anything, mock.Anything).Return(events, nil)
+
+	impl := &namespaceImpl{eventClient: client}
+	params := schema.NamespaceEventsFieldResolverParams{}
+	cfg := ServiceConfig{EventClient: client}
+	params.Context = contextWithLoadersNoCache(context.Background(), cfg)
+	params.Source = corev2.FixtureNamespace("default")
+	params.Args.Limit = 2
+	params.Args.OrderBy = schema.EventsListOrders.ENTITY
+	params.Args.Filters = []string{"status:passing"}
+
+	// First page
+	res, err := impl.Events(params)
+	require.NoError(t, err)
+	page := res.(eventConnection)
+	assert.Equal(t, []*corev2.Event{events[1], events[2]}, page.Nodes)
+	assert.Equal(t, 3, page.PageInfo.totalCount)
+	require.NotNil(t, page.NextCursor)
+
+	// Next page
+	params.Args.After = *page.NextCursor
+	res, err = impl.Events(params)
+	require.NoError(t, err)
+	page = res.(eventConnection)
+	assert.Equal(t, []*corev2.Event{events[0]}, page.Nodes)
+	assert.Nil(t, page.NextCursor)
+}
+
 func TestNamespaceTypeEventFiltersField(t *testing.T) {
 	client := new(MockEventFilterClient)
 	client.On("ListEventFilters", mock.Anything).Return([]*corev2.EventFilter{
@@ -28,6 +28,22 @@ func newOffsetContainer(offset, limit int) offsetContainer {
 	return container
 }
 
+// eventConnection is an offset container that can also be paginated with
+// the cursor of its next page.
+type eventConnection struct {
+	Nodes      interface{}
+	PageInfo   offsetPageInfo
+	NextCursor *string
+}
+
+func newEventConnection(offset, limit int) eventConnection {
+	container := eventConnection{}
+	container.Nodes = make([]interface{}, 0)
+	container.PageInfo.offset = offset
+	container.PageInfo.limit = limit
+	return container
+}
+
 //
 // Implement OffsetPageInfoFieldResolvers
 //
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strings"
@@ -152,6 +153,51 @@ func (r *queryImpl) Suggest(p schema.QuerySuggestFieldResolverParams) (interface
 	return results, nil
 }
 
+// federatedConnection is a page of the resources of the cluster and of its
+// federated clusters.
+type federatedConnection struct {
+	Nodes               interface{}
+	NextCursor          string
+	UnavailableClusters []string
+}
+
+func newFederatedConnection(nodes interface{}, pred *store.SelectionPredicate, unavailable []string) federatedConnection {
+	res := federatedConnection{Nodes: nodes, NextCursor: pred.Continue, UnavailableClusters: []string{}}
+	if unavailable != nil {
+		res.UnavailableClusters = unavailable
+	}
+	return res
+}
+
+// FederatedEvents implements response to request for 'federatedEvents' field.
+func (r *queryImpl) FederatedEvents(p schema.QueryFederatedEventsFieldResolverParams) (interface{}, error) {
+	ctx := store.NamespaceContext(p.Context, p.Args.Namespace)
+	pred := &store.SelectionPredicate{
+		Continue: p.Args.After,
+		Limit:    int64(clampInt(p.Args.Limit, 0, math.MaxInt32)),
+	}
+	events, unavailable, err := r.svc.FederationClient.ListFederatedEvents(ctx, pred)
+	if err != nil {
+		return newFederatedConnection([]*corev2.Event{}, &store.SelectionPredicate{}, nil), handleListErr(err)
+	}
+	return newFederatedConnection(events, pred, unavailable), nil
+}
+
+// FederatedEntities implements response to request for 'federatedEntities'
+// field.
+func (r *queryImpl) FederatedEntities(p schema.QueryFederatedEntitiesFieldResolverParams) (interface{}, error) {
+	ctx := store.NamespaceContext(p.Context, p.Args.Namespace)
+	pred := &store.SelectionPredicate{
+		Continue: p.Args.After,
+		Limit:    int64(clampInt(p.Args.Limit, 0, math.MaxInt32)),
+	}
+	entities, unavailable, err := r.svc.FederationClient.ListFederatedEntities(ctx, pred)
+	if err != nil {
+		return newFederatedConnection([]*corev2.Entity{}, &store.SelectionPredicate{}, nil), handleListErr(err)
+	}
+	return newFederatedConnection(entities, pred, unavailable), nil
+}
+
 // Versions implements response to request for 'versions' field.
 func (r *queryImpl) Versions(p graphql.ResolveParams) (interface{}, error) {
 	resp := r.svc.VersionController.GetVersion(p.Context)
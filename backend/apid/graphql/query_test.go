@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -8,6 +9,7 @@ import (
 	dto "github.com/prometheus/client_model/go"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -45,6 +47,51 @@ func TestQueryTypeEventFilterField(t *testing.T) {
 	assert.NotEmpty(t, res)
 }
 
+func TestQueryTypeFederatedEventsField(t *testing.T) {
+	client := new(MockFederationClient)
+	cfg := ServiceConfig{FederationClient: client}
+	impl := queryImpl{svc: cfg}
+
+	event := corev2.FixtureEvent("a", "b")
+	params := schema.QueryFederatedEventsFieldResolverParams{}
+	params.Context = context.Background()
+	params.Args.Limit = 10
+
+	// Success
+	client.On("ListFederatedEvents", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*store.SelectionPredicate).Continue = "next"
+	}).Return([]*corev2.Event{event}, []string{"eu"}, nil).Once()
+	res, err := impl.FederatedEvents(params)
+	require.NoError(t, err)
+	conn := res.(federatedConnection)
+	assert.Equal(t, []*corev2.Event{event}, conn.Nodes)
+	assert.Equal(t, "next", conn.NextCursor)
+	assert.Equal(t, []string{"eu"}, conn.UnavailableClusters)
+
+	// Failure
+	client.On("ListFederatedEvents", mock.Anything, mock.Anything).Return([]*corev2.Event(nil), []string(nil), errors.New("error")).Once()
+	_, err = impl.FederatedEvents(params)
+	assert.Error(t, err)
+}
+
+func TestQueryTypeFederatedEntitiesField(t *testing.T) {
+	client := new(MockFederationClient)
+	cfg := ServiceConfig{FederationClient: client}
+	impl := queryImpl{svc: cfg}
+
+	entity := corev2.FixtureEntity("a")
+	params := schema.QueryFederatedEntitiesFieldResolverParams{}
+	params.Context = context.Background()
+
+	client.On("ListFederatedEntities", mock.Anything, mock.Anything).Return([]*corev2.Entity{entity}, []string(nil), nil).Once()
+	res, err := impl.FederatedEntities(params)
+	require.NoError(t, err)
+	conn := res.(federatedConnection)
+	assert.Equal(t, []*corev2.Entity{entity}, conn.Nodes)
+	assert.Empty(t, conn.NextCursor)
+	assert.Empty(t, conn.UnavailableClusters)
+}
+
 func TestQueryTypeNamespaceField(t *testing.T) {
 	client := new(MockNamespaceClient)
 	cfg := ServiceConfig{NamespaceClient: client}
@@ -18,6 +18,15 @@ type ruleImpl struct {
 	schema.RuleAliases
 }
 
+// Effect implements response to request for 'effect' field.
+func (*ruleImpl) Effect(p graphql.ResolveParams) (string, error) {
+	rule := p.Source.(corev2.Rule)
+	if rule.Denies() {
+		return corev2.RuleEffectDeny, nil
+	}
+	return corev2.RuleEffectAllow, nil
+}
+
 // IsTypeOf is used to determine if a given value is associated with the type
 func (*ruleImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
 	_, ok := s.(corev2.Rule)
@@ -0,0 +1,24 @@
+package graphql
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleTypeEffectField(t *testing.T) {
+	imp := &ruleImpl{}
+
+	res, err := imp.Effect(graphql.ResolveParams{Source: corev2.FixtureRule()})
+	require.NoError(t, err)
+	assert.Equal(t, "allow", res)
+
+	rule := corev2.FixtureRule()
+	rule.Effect = corev2.RuleEffectDeny
+	res, err = imp.Effect(graphql.ResolveParams{Source: rule})
+	require.NoError(t, err)
+	assert.Equal(t, "deny", res)
+}
@@ -651,6 +651,12 @@ type EventConnectionPageInfoFieldResolver interface {
 	PageInfo(p graphql.ResolveParams) (interface{}, error)
 }
 
+// EventConnectionNextCursorFieldResolver implement to resolve requests for the EventConnection's nextCursor field.
+type EventConnectionNextCursorFieldResolver interface {
+	// NextCursor implements response to request for nextCursor field.
+	NextCursor(p graphql.ResolveParams) (string, error)
+}
+
 //
 // EventConnectionFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'EventConnection' type.
@@ -715,6 +721,7 @@ type EventConnectionPageInfoFieldResolver interface {
 type EventConnectionFieldResolvers interface {
 	EventConnectionNodesFieldResolver
 	EventConnectionPageInfoFieldResolver
+	EventConnectionNextCursorFieldResolver
 }
 
 // EventConnectionAliases implements all methods on EventConnectionFieldResolvers interface by using reflection to
@@ -776,6 +783,19 @@ func (_ EventConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{},
 	return val, err
 }
 
+// NextCursor implements response to request for 'nextCursor' field.
+func (_ EventConnectionAliases) NextCursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'nextCursor'")
+	}
+	return ret, err
+}
+
 // EventConnectionType A connection to a sequence of records.
 var EventConnectionType = graphql.NewType("EventConnection", graphql.ObjectKind)
 
@@ -797,10 +817,24 @@ func _ObjTypeEventConnectionPageInfoHandler(impl interface{}) graphql1.FieldReso
 	}
 }
 
+func _ObjTypeEventConnectionNextCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventConnectionNextCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.NextCursor(frp)
+	}
+}
+
 func _ObjectTypeEventConnectionConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "A connection to a sequence of records.",
 		Fields: graphql1.Fields{
+			"nextCursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Cursor to use as the after argument to fetch the next page; null if there\nare no more items.",
+				Name:              "nextCursor",
+				Type:              graphql1.String,
+			},
 			"nodes": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -833,8 +867,9 @@ func _ObjectTypeEventConnectionConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeEventConnectionDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeEventConnectionConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"nodes":    _ObjTypeEventConnectionNodesHandler,
-		"pageInfo": _ObjTypeEventConnectionPageInfoHandler,
+		"nextCursor": _ObjTypeEventConnectionNextCursorHandler,
+		"nodes":      _ObjTypeEventConnectionNodesHandler,
+		"pageInfo":   _ObjTypeEventConnectionPageInfoHandler,
 	},
 }
 
@@ -843,6 +878,7 @@ type EventsListOrder string
 
 // EventsListOrders holds enum values
 var EventsListOrders = _EnumTypeEventsListOrderValues{
+	ENTITY:   "ENTITY",
 	LASTOK:   "LASTOK",
 	NEWEST:   "NEWEST",
 	OLDEST:   "OLDEST",
@@ -861,6 +897,11 @@ func _EnumTypeEventsListOrderConfigFn() graphql1.EnumConfig {
 		Description: "Describes ways in which a list of events can be ordered.",
 		Name:        "EventsListOrder",
 		Values: graphql1.EnumValueConfigMap{
+			"ENTITY": &graphql1.EnumValueConfig{
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Value:             "ENTITY",
+			},
 			"LASTOK": &graphql1.EnumValueConfig{
 				DeprecationReason: "",
 				Description:       "self descriptive",
@@ -889,6 +930,8 @@ func _EnumTypeEventsListOrderConfigFn() graphql1.EnumConfig {
 var _EnumTypeEventsListOrderDesc = graphql.EnumDesc{Config: _EnumTypeEventsListOrderConfigFn}
 
 type _EnumTypeEventsListOrderValues struct {
+	// ENTITY - self descriptive
+	ENTITY EventsListOrder
 	// LASTOK - self descriptive
 	LASTOK EventsListOrder
 	// NEWEST - self descriptive
@@ -0,0 +1,491 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// FederatedEventConnectionNodesFieldResolver implement to resolve requests for the FederatedEventConnection's nodes field.
+type FederatedEventConnectionNodesFieldResolver interface {
+	// Nodes implements response to request for nodes field.
+	Nodes(p graphql.ResolveParams) (interface{}, error)
+}
+
+// FederatedEventConnectionNextCursorFieldResolver implement to resolve requests for the FederatedEventConnection's nextCursor field.
+type FederatedEventConnectionNextCursorFieldResolver interface {
+	// NextCursor implements response to request for nextCursor field.
+	NextCursor(p graphql.ResolveParams) (string, error)
+}
+
+// FederatedEventConnectionUnavailableClustersFieldResolver implement to resolve requests for the FederatedEventConnection's unavailableClusters field.
+type FederatedEventConnectionUnavailableClustersFieldResolver interface {
+	// UnavailableClusters implements response to request for unavailableClusters field.
+	UnavailableClusters(p graphql.ResolveParams) ([]string, error)
+}
+
+//
+// FederatedEventConnectionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'FederatedEventConnection' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type FederatedEventConnectionFieldResolvers interface {
+	FederatedEventConnectionNodesFieldResolver
+	FederatedEventConnectionNextCursorFieldResolver
+	FederatedEventConnectionUnavailableClustersFieldResolver
+}
+
+// FederatedEventConnectionAliases implements all methods on FederatedEventConnectionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type FederatedEventConnectionAliases struct{}
+
+// Nodes implements response to request for 'nodes' field.
+func (_ FederatedEventConnectionAliases) Nodes(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// NextCursor implements response to request for 'nextCursor' field.
+func (_ FederatedEventConnectionAliases) NextCursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'nextCursor'")
+	}
+	return ret, err
+}
+
+// UnavailableClusters implements response to request for 'unavailableClusters' field.
+func (_ FederatedEventConnectionAliases) UnavailableClusters(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'unavailableClusters'")
+	}
+	return ret, err
+}
+
+// FederatedEventConnectionType A page of the events of the cluster and of its federated clusters.
+var FederatedEventConnectionType = graphql.NewType("FederatedEventConnection", graphql.ObjectKind)
+
+// RegisterFederatedEventConnection registers FederatedEventConnection object type with given service.
+func RegisterFederatedEventConnection(svc *graphql.Service, impl FederatedEventConnectionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeFederatedEventConnectionDesc, impl)
+}
+func _ObjTypeFederatedEventConnectionNodesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(FederatedEventConnectionNodesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Nodes(frp)
+	}
+}
+
+func _ObjTypeFederatedEventConnectionNextCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(FederatedEventConnectionNextCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.NextCursor(frp)
+	}
+}
+
+func _ObjTypeFederatedEventConnectionUnavailableClustersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(FederatedEventConnectionUnavailableClustersFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.UnavailableClusters(frp)
+	}
+}
+
+func _ObjectTypeFederatedEventConnectionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A page of the events of the cluster and of its federated clusters.",
+		Fields: graphql1.Fields{
+			"nextCursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Cursor to use as the after argument to fetch the next page; empty if there\nare no more items.",
+				Name:              "nextCursor",
+				Type:              graphql1.String,
+			},
+			"nodes": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The events of the page, labeled with the name of their cluster\n(sensu.io/cluster), which is \"local\" for the cluster itself.",
+				Name:              "nodes",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Event")))),
+			},
+			"unavailableClusters": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The names of the federated clusters that could not be reached.",
+				Name:              "unavailableClusters",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see FederatedEventConnectionFieldResolvers.")
+		},
+		Name: "FederatedEventConnection",
+	}
+}
+
+// describe FederatedEventConnection's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeFederatedEventConnectionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeFederatedEventConnectionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"nextCursor":          _ObjTypeFederatedEventConnectionNextCursorHandler,
+		"nodes":               _ObjTypeFederatedEventConnectionNodesHandler,
+		"unavailableClusters": _ObjTypeFederatedEventConnectionUnavailableClustersHandler,
+	},
+}
+
+// FederatedEntityConnectionNodesFieldResolver implement to resolve requests for the FederatedEntityConnection's nodes field.
+type FederatedEntityConnectionNodesFieldResolver interface {
+	// Nodes implements response to request for nodes field.
+	Nodes(p graphql.ResolveParams) (interface{}, error)
+}
+
+// FederatedEntityConnectionNextCursorFieldResolver implement to resolve requests for the FederatedEntityConnection's nextCursor field.
+type FederatedEntityConnectionNextCursorFieldResolver interface {
+	// NextCursor implements response to request for nextCursor field.
+	NextCursor(p graphql.ResolveParams) (string, error)
+}
+
+// FederatedEntityConnectionUnavailableClustersFieldResolver implement to resolve requests for the FederatedEntityConnection's unavailableClusters field.
+type FederatedEntityConnectionUnavailableClustersFieldResolver interface {
+	// UnavailableClusters implements response to request for unavailableClusters field.
+	UnavailableClusters(p graphql.ResolveParams) ([]string, error)
+}
+
+//
+// FederatedEntityConnectionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'FederatedEntityConnection' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type FederatedEntityConnectionFieldResolvers interface {
+	FederatedEntityConnectionNodesFieldResolver
+	FederatedEntityConnectionNextCursorFieldResolver
+	FederatedEntityConnectionUnavailableClustersFieldResolver
+}
+
+// FederatedEntityConnectionAliases implements all methods on FederatedEntityConnectionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type FederatedEntityConnectionAliases struct{}
+
+// Nodes implements response to request for 'nodes' field.
+func (_ FederatedEntityConnectionAliases) Nodes(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// NextCursor implements response to request for 'nextCursor' field.
+func (_ FederatedEntityConnectionAliases) NextCursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'nextCursor'")
+	}
+	return ret, err
+}
+
+// UnavailableClusters implements response to request for 'unavailableClusters' field.
+func (_ FederatedEntityConnectionAliases) UnavailableClusters(p graphql.ResolveParams) ([]string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.([]string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'unavailableClusters'")
+	}
+	return ret, err
+}
+
+// FederatedEntityConnectionType A page of the entities of the cluster and of its federated clusters.
+var FederatedEntityConnectionType = graphql.NewType("FederatedEntityConnection", graphql.ObjectKind)
+
+// RegisterFederatedEntityConnection registers FederatedEntityConnection object type with given service.
+func RegisterFederatedEntityConnection(svc *graphql.Service, impl FederatedEntityConnectionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeFederatedEntityConnectionDesc, impl)
+}
+func _ObjTypeFederatedEntityConnectionNodesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(FederatedEntityConnectionNodesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Nodes(frp)
+	}
+}
+
+func _ObjTypeFederatedEntityConnectionNextCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(FederatedEntityConnectionNextCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.NextCursor(frp)
+	}
+}
+
+func _ObjTypeFederatedEntityConnectionUnavailableClustersHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(FederatedEntityConnectionUnavailableClustersFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.UnavailableClusters(frp)
+	}
+}
+
+func _ObjectTypeFederatedEntityConnectionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A page of the entities of the cluster and of its federated clusters.",
+		Fields: graphql1.Fields{
+			"nextCursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Cursor to use as the after argument to fetch the next page; empty if there\nare no more items.",
+				Name:              "nextCursor",
+				Type:              graphql1.String,
+			},
+			"nodes": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The entities of the page, labeled with the name of their cluster\n(sensu.io/cluster), which is \"local\" for the cluster itself.",
+				Name:              "nodes",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql.OutputType("Entity")))),
+			},
+			"unavailableClusters": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The names of the federated clusters that could not be reached.",
+				Name:              "unavailableClusters",
+				Type:              graphql1.NewNonNull(graphql1.NewList(graphql1.NewNonNull(graphql1.String))),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see FederatedEntityConnectionFieldResolvers.")
+		},
+		Name: "FederatedEntityConnection",
+	}
+}
+
+// describe FederatedEntityConnection's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeFederatedEntityConnectionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeFederatedEntityConnectionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"nextCursor":          _ObjTypeFederatedEntityConnectionNextCursorHandler,
+		"nodes":               _ObjTypeFederatedEntityConnectionNodesHandler,
+		"unavailableClusters": _ObjTypeFederatedEntityConnectionUnavailableClustersHandler,
+	},
+}
@@ -115,8 +115,12 @@ type NamespaceEntitiesFieldResolver interface {
 
 // NamespaceEventsFieldResolverArgs contains arguments provided to events when selected
 type NamespaceEventsFieldResolverArgs struct {
-	Offset  int             // Offset - self descriptive
-	Limit   int             // Limit adds optional limit to the number of entries returned.
+	Offset int    // Offset - self descriptive
+	Limit  int    // Limit adds optional limit to the number of entries returned.
+	After  string /*
+	After is the cursor of the page to fetch, given by the nextCursor of the
+	previous page; the offset is relative to the cursor.
+	*/
 	OrderBy EventsListOrder // OrderBy adds optional order to the records retrieved.
 	Filter  string          // Filter - DEPRECATED: Please use the filters argument instead.
 	Filters []string        /*
@@ -761,6 +765,11 @@ func _ObjectTypeNamespaceConfigFn() graphql1.ObjectConfig {
 			},
 			"events": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						DefaultValue: "",
+						Description:  "After is the cursor of the page to fetch, given by the nextCursor of the\nprevious page; the offset is relative to the cursor.",
+						Type:         graphql1.String,
+					},
 					"filter": &graphql1.ArgumentConfig{
 						DefaultValue: "",
 						Description:  "DEPRECATED: Please use the filters argument instead.",
@@ -788,7 +797,7 @@ func _ObjectTypeNamespaceConfigFn() graphql1.ObjectConfig {
 					},
 				},
 				DeprecationReason: "",
-				Description:       "All events associated with the namespace.",
+				Description:       "All events associated with the namespace. Unless filtered, the events are\nsorted and paginated by the store.",
 				Name:              "events",
 				Type:              graphql1.NewNonNull(graphql.OutputType("EventConnection")),
 			},
@@ -186,6 +186,50 @@ type QuerySuggestFieldResolver interface {
 	Suggest(p QuerySuggestFieldResolverParams) (interface{}, error)
 }
 
+// QueryFederatedEventsFieldResolverArgs contains arguments provided to federatedEvents when selected
+type QueryFederatedEventsFieldResolverArgs struct {
+	Namespace string // Namespace of the events; the events of every namespace are listed if empty.
+	Limit     int    // Limit adds optional limit to the number of entries returned.
+	After     string /*
+	After is the cursor of the page to fetch, given by the nextCursor of the
+	previous page.
+	*/
+}
+
+// QueryFederatedEventsFieldResolverParams contains contextual info to resolve federatedEvents field
+type QueryFederatedEventsFieldResolverParams struct {
+	graphql.ResolveParams
+	Args QueryFederatedEventsFieldResolverArgs
+}
+
+// QueryFederatedEventsFieldResolver implement to resolve requests for the Query's federatedEvents field.
+type QueryFederatedEventsFieldResolver interface {
+	// FederatedEvents implements response to request for federatedEvents field.
+	FederatedEvents(p QueryFederatedEventsFieldResolverParams) (interface{}, error)
+}
+
+// QueryFederatedEntitiesFieldResolverArgs contains arguments provided to federatedEntities when selected
+type QueryFederatedEntitiesFieldResolverArgs struct {
+	Namespace string // Namespace of the entities; the entities of every namespace are listed if empty.
+	Limit     int    // Limit adds optional limit to the number of entries returned.
+	After     string /*
+	After is the cursor of the page to fetch, given by the nextCursor of the
+	previous page.
+	*/
+}
+
+// QueryFederatedEntitiesFieldResolverParams contains contextual info to resolve federatedEntities field
+type QueryFederatedEntitiesFieldResolverParams struct {
+	graphql.ResolveParams
+	Args QueryFederatedEntitiesFieldResolverArgs
+}
+
+// QueryFederatedEntitiesFieldResolver implement to resolve requests for the Query's federatedEntities field.
+type QueryFederatedEntitiesFieldResolver interface {
+	// FederatedEntities implements response to request for federatedEntities field.
+	FederatedEntities(p QueryFederatedEntitiesFieldResolverParams) (interface{}, error)
+}
+
 // QueryHealthFieldResolver implement to resolve requests for the Query's health field.
 type QueryHealthFieldResolver interface {
 	// Health implements response to request for health field.
@@ -320,6 +364,8 @@ type QueryFieldResolvers interface {
 	QueryEventFilterFieldResolver
 	QueryHandlerFieldResolver
 	QuerySuggestFieldResolver
+	QueryFederatedEventsFieldResolver
+	QueryFederatedEntitiesFieldResolver
 	QueryHealthFieldResolver
 	QueryVersionsFieldResolver
 	QueryMetricsFieldResolver
@@ -428,6 +474,18 @@ func (_ QueryAliases) Suggest(p QuerySuggestFieldResolverParams) (interface{}, e
 	return val, err
 }
 
+// FederatedEvents implements response to request for 'federatedEvents' field.
+func (_ QueryAliases) FederatedEvents(p QueryFederatedEventsFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// FederatedEntities implements response to request for 'federatedEntities' field.
+func (_ QueryAliases) FederatedEntities(p QueryFederatedEntitiesFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // Health implements response to request for 'health' field.
 func (_ QueryAliases) Health(p graphql.ResolveParams) (interface{}, error) {
 	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
@@ -576,6 +634,32 @@ func _ObjTypeQuerySuggestHandler(impl interface{}) graphql1.FieldResolveFn {
 	}
 }
 
+func _ObjTypeQueryFederatedEventsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(QueryFederatedEventsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := QueryFederatedEventsFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.FederatedEvents(frp)
+	}
+}
+
+func _ObjTypeQueryFederatedEntitiesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(QueryFederatedEntitiesFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := QueryFederatedEntitiesFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.FederatedEntities(frp)
+	}
+}
+
 func _ObjTypeQueryHealthHandler(impl interface{}) graphql1.FieldResolveFn {
 	resolver := impl.(QueryHealthFieldResolver)
 	return func(frp graphql1.ResolveParams) (interface{}, error) {
@@ -701,6 +785,52 @@ func _ObjectTypeQueryConfigFn() graphql1.ObjectConfig {
 				Name:              "eventFilter",
 				Type:              graphql.OutputType("EventFilter"),
 			},
+			"federatedEntities": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						DefaultValue: "",
+						Description:  "After is the cursor of the page to fetch, given by the nextCursor of the\nprevious page.",
+						Type:         graphql1.String,
+					},
+					"limit": &graphql1.ArgumentConfig{
+						DefaultValue: 100,
+						Description:  "Limit adds optional limit to the number of entries returned.",
+						Type:         graphql1.Int,
+					},
+					"namespace": &graphql1.ArgumentConfig{
+						DefaultValue: "",
+						Description:  "Namespace of the entities; the entities of every namespace are listed if empty.",
+						Type:         graphql1.String,
+					},
+				},
+				DeprecationReason: "",
+				Description:       "FederatedEntities lists the entities of the cluster followed by the entities\nof its federated clusters. The federated clusters that can't be reached are\nskipped.",
+				Name:              "federatedEntities",
+				Type:              graphql1.NewNonNull(graphql.OutputType("FederatedEntityConnection")),
+			},
+			"federatedEvents": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						DefaultValue: "",
+						Description:  "After is the cursor of the page to fetch, given by the nextCursor of the\nprevious page.",
+						Type:         graphql1.String,
+					},
+					"limit": &graphql1.ArgumentConfig{
+						DefaultValue: 100,
+						Description:  "Limit adds optional limit to the number of entries returned.",
+						Type:         graphql1.Int,
+					},
+					"namespace": &graphql1.ArgumentConfig{
+						DefaultValue: "",
+						Description:  "Namespace of the events; the events of every namespace are listed if empty.",
+						Type:         graphql1.String,
+					},
+				},
+				DeprecationReason: "",
+				Description:       "FederatedEvents lists the events of the cluster followed by the events of its\nfederated clusters. The federated clusters that can't be reached are skipped.",
+				Name:              "federatedEvents",
+				Type:              graphql1.NewNonNull(graphql.OutputType("FederatedEventConnection")),
+			},
 			"handler": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
 					"name": &graphql1.ArgumentConfig{
@@ -844,19 +974,21 @@ func _ObjectTypeQueryConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeQueryDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeQueryConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
-		"check":       _ObjTypeQueryCheckHandler,
-		"entity":      _ObjTypeQueryEntityHandler,
-		"event":       _ObjTypeQueryEventHandler,
-		"eventFilter": _ObjTypeQueryEventFilterHandler,
-		"handler":     _ObjTypeQueryHandlerHandler,
-		"health":      _ObjTypeQueryHealthHandler,
-		"metrics":     _ObjTypeQueryMetricsHandler,
-		"mutator":     _ObjTypeQueryMutatorHandler,
-		"namespace":   _ObjTypeQueryNamespaceHandler,
-		"node":        _ObjTypeQueryNodeHandler,
-		"suggest":     _ObjTypeQuerySuggestHandler,
-		"versions":    _ObjTypeQueryVersionsHandler,
-		"viewer":      _ObjTypeQueryViewerHandler,
-		"wrappedNode": _ObjTypeQueryWrappedNodeHandler,
+		"check":             _ObjTypeQueryCheckHandler,
+		"entity":            _ObjTypeQueryEntityHandler,
+		"event":             _ObjTypeQueryEventHandler,
+		"eventFilter":       _ObjTypeQueryEventFilterHandler,
+		"federatedEntities": _ObjTypeQueryFederatedEntitiesHandler,
+		"federatedEvents":   _ObjTypeQueryFederatedEventsHandler,
+		"handler":           _ObjTypeQueryHandlerHandler,
+		"health":            _ObjTypeQueryHealthHandler,
+		"metrics":           _ObjTypeQueryMetricsHandler,
+		"mutator":           _ObjTypeQueryMutatorHandler,
+		"namespace":         _ObjTypeQueryNamespaceHandler,
+		"node":              _ObjTypeQueryNodeHandler,
+		"suggest":           _ObjTypeQuerySuggestHandler,
+		"versions":          _ObjTypeQueryVersionsHandler,
+		"viewer":            _ObjTypeQueryViewerHandler,
+		"wrappedNode":       _ObjTypeQueryWrappedNodeHandler,
 	},
 }
@@ -26,6 +26,12 @@ type RuleResourceNamesFieldResolver interface {
 	ResourceNames(p graphql.ResolveParams) ([]string, error)
 }
 
+// RuleEffectFieldResolver implement to resolve requests for the Rule's effect field.
+type RuleEffectFieldResolver interface {
+	// Effect implements response to request for effect field.
+	Effect(p graphql.ResolveParams) (string, error)
+}
+
 //
 // RuleFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Rule' type.
@@ -91,6 +97,7 @@ type RuleFieldResolvers interface {
 	RuleVerbsFieldResolver
 	RuleResourcesFieldResolver
 	RuleResourceNamesFieldResolver
+	RuleEffectFieldResolver
 }
 
 // RuleAliases implements all methods on RuleFieldResolvers interface by using reflection to
@@ -179,6 +186,19 @@ func (_ RuleAliases) ResourceNames(p graphql.ResolveParams) ([]string, error) {
 	return ret, err
 }
 
+// Effect implements response to request for 'effect' field.
+func (_ RuleAliases) Effect(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := val.(string)
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'effect'")
+	}
+	return ret, err
+}
+
 // RuleType Rule holds information that describes an action that can be taken
 var RuleType = graphql.NewType("Rule", graphql.ObjectKind)
 
@@ -207,10 +227,24 @@ func _ObjTypeRuleResourceNamesHandler(impl interface{}) graphql1.FieldResolveFn
 	}
 }
 
+func _ObjTypeRuleEffectHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(RuleEffectFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Effect(frp)
+	}
+}
+
 func _ObjectTypeRuleConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Rule holds information that describes an action that can be taken",
 		Fields: graphql1.Fields{
+			"effect": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Effect is either \"allow\" to grant the access to the resources, or \"deny\" to\nrefuse it even if other rules grant it.",
+				Name:              "effect",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
 			"resourceNames": &graphql1.Field{
 				Args:              graphql1.FieldConfigArgument{},
 				DeprecationReason: "",
@@ -250,6 +284,7 @@ func _ObjectTypeRuleConfigFn() graphql1.ObjectConfig {
 var _ObjectTypeRuleDesc = graphql.ObjectDesc{
 	Config: _ObjectTypeRuleConfigFn,
 	FieldHandlers: map[string]graphql.FieldHandler{
+		"effect":        _ObjTypeRuleEffectHandler,
 		"resourceNames": _ObjTypeRuleResourceNamesHandler,
 		"resources":     _ObjTypeRuleResourcesHandler,
 		"verbs":         _ObjTypeRuleVerbsHandler,
@@ -0,0 +1,788 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	errors "errors"
+	graphql1 "github.com/graphql-go/graphql"
+	mapstructure "github.com/mitchellh/mapstructure"
+	graphql "github.com/sensu/sensu-go/graphql"
+)
+
+// SubscriptionEventChangedFieldResolverArgs contains arguments provided to eventChanged when selected
+type SubscriptionEventChangedFieldResolverArgs struct {
+	Namespace string // Namespace - self descriptive
+	Entity    string // Entity - If given, only the changes to the events of this entity are notified.
+	Check     string // Check - If given, only the changes to the events of this check are notified.
+}
+
+// SubscriptionEventChangedFieldResolverParams contains contextual info to resolve eventChanged field
+type SubscriptionEventChangedFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionEventChangedFieldResolverArgs
+}
+
+// SubscriptionEventChangedFieldResolver implement to resolve requests for the Subscription's eventChanged field.
+type SubscriptionEventChangedFieldResolver interface {
+	// EventChanged implements response to request for eventChanged field.
+	EventChanged(p SubscriptionEventChangedFieldResolverParams) (interface{}, error)
+}
+
+// SubscriptionEntityStatusChangedFieldResolverArgs contains arguments provided to entityStatusChanged when selected
+type SubscriptionEntityStatusChangedFieldResolverArgs struct {
+	Namespace string // Namespace - self descriptive
+	Entity    string // Entity - If given, only the changes to the status of this entity are notified.
+}
+
+// SubscriptionEntityStatusChangedFieldResolverParams contains contextual info to resolve entityStatusChanged field
+type SubscriptionEntityStatusChangedFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionEntityStatusChangedFieldResolverArgs
+}
+
+// SubscriptionEntityStatusChangedFieldResolver implement to resolve requests for the Subscription's entityStatusChanged field.
+type SubscriptionEntityStatusChangedFieldResolver interface {
+	// EntityStatusChanged implements response to request for entityStatusChanged field.
+	EntityStatusChanged(p SubscriptionEntityStatusChangedFieldResolverParams) (interface{}, error)
+}
+
+// SubscriptionSilenceChangedFieldResolverArgs contains arguments provided to silenceChanged when selected
+type SubscriptionSilenceChangedFieldResolverArgs struct {
+	Namespace string // Namespace - self descriptive
+}
+
+// SubscriptionSilenceChangedFieldResolverParams contains contextual info to resolve silenceChanged field
+type SubscriptionSilenceChangedFieldResolverParams struct {
+	graphql.ResolveParams
+	Args SubscriptionSilenceChangedFieldResolverArgs
+}
+
+// SubscriptionSilenceChangedFieldResolver implement to resolve requests for the Subscription's silenceChanged field.
+type SubscriptionSilenceChangedFieldResolver interface {
+	// SilenceChanged implements response to request for silenceChanged field.
+	SilenceChanged(p SubscriptionSilenceChangedFieldResolverParams) (interface{}, error)
+}
+
+//
+// SubscriptionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'Subscription' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type SubscriptionFieldResolvers interface {
+	SubscriptionEventChangedFieldResolver
+	SubscriptionEntityStatusChangedFieldResolver
+	SubscriptionSilenceChangedFieldResolver
+}
+
+// SubscriptionAliases implements all methods on SubscriptionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type SubscriptionAliases struct{}
+
+// EventChanged implements response to request for 'eventChanged' field.
+func (_ SubscriptionAliases) EventChanged(p SubscriptionEventChangedFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// EntityStatusChanged implements response to request for 'entityStatusChanged' field.
+func (_ SubscriptionAliases) EntityStatusChanged(p SubscriptionEntityStatusChangedFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// SilenceChanged implements response to request for 'silenceChanged' field.
+func (_ SubscriptionAliases) SilenceChanged(p SubscriptionSilenceChangedFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+/*
+SubscriptionType The root query for implementing GraphQL subscriptions, notifying the clients of
+the changes made to the resources as they happen.
+*/
+var SubscriptionType = graphql.NewType("Subscription", graphql.ObjectKind)
+
+// RegisterSubscription registers Subscription object type with given service.
+func RegisterSubscription(svc *graphql.Service, impl SubscriptionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSubscriptionDesc, impl)
+}
+func _ObjTypeSubscriptionEventChangedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SubscriptionEventChangedFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionEventChangedFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.EventChanged(frp)
+	}
+}
+
+func _ObjTypeSubscriptionEntityStatusChangedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SubscriptionEntityStatusChangedFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionEntityStatusChangedFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.EntityStatusChanged(frp)
+	}
+}
+
+func _ObjTypeSubscriptionSilenceChangedHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SubscriptionSilenceChangedFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := SubscriptionSilenceChangedFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.SilenceChanged(frp)
+	}
+}
+
+func _ObjectTypeSubscriptionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "The root query for implementing GraphQL subscriptions, notifying the clients of\nthe changes made to the resources as they happen.",
+		Fields: graphql1.Fields{
+			"entityStatusChanged": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"entity": &graphql1.ArgumentConfig{
+						Description: "If given, only the changes to the status of this entity are notified.",
+						Type:        graphql1.String,
+					},
+					"namespace": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.NewNonNull(graphql1.String),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "Notifies of the changes to the status of the entities in the namespace, when\nthe status of one of their events changes or one of their events is created\nor deleted.",
+				Name:              "entityStatusChanged",
+				Type:              graphql.OutputType("Entity"),
+			},
+			"eventChanged": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"check": &graphql1.ArgumentConfig{
+						Description: "If given, only the changes to the events of this check are notified.",
+						Type:        graphql1.String,
+					},
+					"entity": &graphql1.ArgumentConfig{
+						Description: "If given, only the changes to the events of this entity are notified.",
+						Type:        graphql1.String,
+					},
+					"namespace": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.NewNonNull(graphql1.String),
+					},
+				},
+				DeprecationReason: "",
+				Description:       "Notifies of the events created, updated and deleted in the namespace.",
+				Name:              "eventChanged",
+				Type:              graphql.OutputType("EventChange"),
+			},
+			"silenceChanged": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{"namespace": &graphql1.ArgumentConfig{
+					Description: "self descriptive",
+					Type:        graphql1.NewNonNull(graphql1.String),
+				}},
+				DeprecationReason: "",
+				Description:       "Notifies of the silences created, updated and deleted in the namespace.",
+				Name:              "silenceChanged",
+				Type:              graphql.OutputType("SilenceChange"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SubscriptionFieldResolvers.")
+		},
+		Name: "Subscription",
+	}
+}
+
+// describe Subscription's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSubscriptionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSubscriptionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"entityStatusChanged": _ObjTypeSubscriptionEntityStatusChangedHandler,
+		"eventChanged":        _ObjTypeSubscriptionEventChangedHandler,
+		"silenceChanged":      _ObjTypeSubscriptionSilenceChangedHandler,
+	},
+}
+
+// ChangeAction Describes the type of change made to a resource.
+type ChangeAction string
+
+// ChangeActions holds enum values
+var ChangeActions = _EnumTypeChangeActionValues{
+	CREATED: "CREATED",
+	DELETED: "DELETED",
+	UPDATED: "UPDATED",
+}
+
+// ChangeActionType Describes the type of change made to a resource.
+var ChangeActionType = graphql.NewType("ChangeAction", graphql.EnumKind)
+
+// RegisterChangeAction registers ChangeAction object type with given service.
+func RegisterChangeAction(svc *graphql.Service) {
+	svc.RegisterEnum(_EnumTypeChangeActionDesc)
+}
+func _EnumTypeChangeActionConfigFn() graphql1.EnumConfig {
+	return graphql1.EnumConfig{
+		Description: "Describes the type of change made to a resource.",
+		Name:        "ChangeAction",
+		Values: graphql1.EnumValueConfigMap{
+			"CREATED": &graphql1.EnumValueConfig{
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Value:             "CREATED",
+			},
+			"DELETED": &graphql1.EnumValueConfig{
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Value:             "DELETED",
+			},
+			"UPDATED": &graphql1.EnumValueConfig{
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Value:             "UPDATED",
+			},
+		},
+	}
+}
+
+// describe ChangeAction's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _EnumTypeChangeActionDesc = graphql.EnumDesc{Config: _EnumTypeChangeActionConfigFn}
+
+type _EnumTypeChangeActionValues struct {
+	// CREATED - self descriptive
+	CREATED ChangeAction
+	// UPDATED - self descriptive
+	UPDATED ChangeAction
+	// DELETED - self descriptive
+	DELETED ChangeAction
+}
+
+// EventChangeActionFieldResolver implement to resolve requests for the EventChange's action field.
+type EventChangeActionFieldResolver interface {
+	// Action implements response to request for action field.
+	Action(p graphql.ResolveParams) (ChangeAction, error)
+}
+
+// EventChangeEventFieldResolver implement to resolve requests for the EventChange's event field.
+type EventChangeEventFieldResolver interface {
+	// Event implements response to request for event field.
+	Event(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// EventChangeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'EventChange' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type EventChangeFieldResolvers interface {
+	EventChangeActionFieldResolver
+	EventChangeEventFieldResolver
+}
+
+// EventChangeAliases implements all methods on EventChangeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type EventChangeAliases struct{}
+
+// Action implements response to request for 'action' field.
+func (_ EventChangeAliases) Action(p graphql.ResolveParams) (ChangeAction, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := ChangeAction(val.(string)), true
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'action'")
+	}
+	return ret, err
+}
+
+// Event implements response to request for 'event' field.
+func (_ EventChangeAliases) Event(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// EventChangeType A change made to an event.
+var EventChangeType = graphql.NewType("EventChange", graphql.ObjectKind)
+
+// RegisterEventChange registers EventChange object type with given service.
+func RegisterEventChange(svc *graphql.Service, impl EventChangeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeEventChangeDesc, impl)
+}
+func _ObjTypeEventChangeActionHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventChangeActionFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+
+		val, err := resolver.Action(frp)
+		return string(val), err
+	}
+}
+
+func _ObjTypeEventChangeEventHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EventChangeEventFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Event(frp)
+	}
+}
+
+func _ObjectTypeEventChangeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A change made to an event.",
+		Fields: graphql1.Fields{
+			"action": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The type of change made to the event.",
+				Name:              "action",
+				Type:              graphql1.NewNonNull(graphql.OutputType("ChangeAction")),
+			},
+			"event": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The event, as it was before its deletion if deleted.",
+				Name:              "event",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Event")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see EventChangeFieldResolvers.")
+		},
+		Name: "EventChange",
+	}
+}
+
+// describe EventChange's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeEventChangeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeEventChangeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"action": _ObjTypeEventChangeActionHandler,
+		"event":  _ObjTypeEventChangeEventHandler,
+	},
+}
+
+// SilenceChangeActionFieldResolver implement to resolve requests for the SilenceChange's action field.
+type SilenceChangeActionFieldResolver interface {
+	// Action implements response to request for action field.
+	Action(p graphql.ResolveParams) (ChangeAction, error)
+}
+
+// SilenceChangeSilenceFieldResolver implement to resolve requests for the SilenceChange's silence field.
+type SilenceChangeSilenceFieldResolver interface {
+	// Silence implements response to request for silence field.
+	Silence(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// SilenceChangeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'SilenceChange' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type SilenceChangeFieldResolvers interface {
+	SilenceChangeActionFieldResolver
+	SilenceChangeSilenceFieldResolver
+}
+
+// SilenceChangeAliases implements all methods on SilenceChangeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type SilenceChangeAliases struct{}
+
+// Action implements response to request for 'action' field.
+func (_ SilenceChangeAliases) Action(p graphql.ResolveParams) (ChangeAction, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret, ok := ChangeAction(val.(string)), true
+	if err != nil {
+		return ret, err
+	}
+	if !ok {
+		return ret, errors.New("unable to coerce value for field 'action'")
+	}
+	return ret, err
+}
+
+// Silence implements response to request for 'silence' field.
+func (_ SilenceChangeAliases) Silence(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// SilenceChangeType A change made to a silence.
+var SilenceChangeType = graphql.NewType("SilenceChange", graphql.ObjectKind)
+
+// RegisterSilenceChange registers SilenceChange object type with given service.
+func RegisterSilenceChange(svc *graphql.Service, impl SilenceChangeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeSilenceChangeDesc, impl)
+}
+func _ObjTypeSilenceChangeActionHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SilenceChangeActionFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+
+		val, err := resolver.Action(frp)
+		return string(val), err
+	}
+}
+
+func _ObjTypeSilenceChangeSilenceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(SilenceChangeSilenceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Silence(frp)
+	}
+}
+
+func _ObjectTypeSilenceChangeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A change made to a silence.",
+		Fields: graphql1.Fields{
+			"action": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The type of change made to the silence.",
+				Name:              "action",
+				Type:              graphql1.NewNonNull(graphql.OutputType("ChangeAction")),
+			},
+			"silence": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "The silence, as it was before its deletion if deleted.",
+				Name:              "silence",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Silenced")),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see SilenceChangeFieldResolvers.")
+		},
+		Name: "SilenceChange",
+	}
+}
+
+// describe SilenceChange's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeSilenceChangeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeSilenceChangeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"action":  _ObjTypeSilenceChangeActionHandler,
+		"silence": _ObjTypeSilenceChangeSilenceHandler,
+	},
+}
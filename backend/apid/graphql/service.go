@@ -21,6 +21,14 @@ type ClientFactory interface {
 	NewWithContext(ctx context.Context) client.APIClient
 }
 
+const (
+	// DefaultMaxDepth is the default maximum depth of the queries.
+	DefaultMaxDepth = 15
+
+	// DefaultMaxCost is the default maximum cost of the queries.
+	DefaultMaxCost = 25000
+)
+
 // ServiceConfig describes values required to instantiate service.
 type ServiceConfig struct {
 	AssetClient       AssetClient
@@ -28,6 +36,7 @@ type ServiceConfig struct {
 	EntityClient      EntityClient
 	EventClient       EventClient
 	EventFilterClient EventFilterClient
+	FederationClient  FederationClient
 	HandlerClient     HandlerClient
 	HealthController  EtcdHealthController
 	MutatorClient     MutatorClient
@@ -39,6 +48,11 @@ type ServiceConfig struct {
 	VersionController VersionController
 	GenericClient     GenericClient
 	MetricGatherer    MetricGatherer
+
+	// MaxDepth and MaxCost limit the depth and the cost of the queries. A
+	// value of zero disables the limit.
+	MaxDepth int
+	MaxCost  int
 }
 
 // Service describes the Sensu GraphQL service capable of handling queries.
@@ -51,6 +65,8 @@ type Service struct {
 // NewService instantiates new GraphQL service
 func NewService(cfg ServiceConfig) (*Service, error) {
 	svc := graphql.NewService()
+	svc.MaxDepth = cfg.MaxDepth
+	svc.MaxCost = cfg.MaxCost
 	nodeResolver := newNodeResolver(cfg)
 	wrapper := Service{
 		Target:       svc,
@@ -60,7 +76,7 @@ func NewService(cfg ServiceConfig) (*Service, error) {
 
 	// Register types
 	schema.RegisterAsset(svc, &assetImpl{})
-	schema.RegisterNamespace(svc, &namespaceImpl{client: cfg.NamespaceClient})
+	schema.RegisterNamespace(svc, &namespaceImpl{client: cfg.NamespaceClient, eventClient: cfg.EventClient})
 	schema.RegisterErrCode(svc)
 	schema.RegisterEvent(svc, &eventImpl{})
 	schema.RegisterEventsListOrder(svc)
@@ -114,6 +130,10 @@ func NewService(cfg ServiceConfig) (*Service, error) {
 	schema.RegisterEvent(svc, &eventImpl{})
 	schema.RegisterEventConnection(svc, &schema.EventConnectionAliases{})
 
+	// Register federation types
+	schema.RegisterFederatedEventConnection(svc, &schema.FederatedEventConnectionAliases{})
+	schema.RegisterFederatedEntityConnection(svc, &schema.FederatedEntityConnectionAliases{})
+
 	// Register event filter types
 	schema.RegisterEventFilter(svc, &eventFilterImpl{})
 	schema.RegisterEventFilterConnection(svc, &schema.EventFilterConnectionAliases{})
@@ -161,7 +181,7 @@ func NewService(cfg ServiceConfig) (*Service, error) {
 	schema.RegisterRole(svc, &schema.RoleAliases{})
 	schema.RegisterRoleBinding(svc, &schema.RoleBindingAliases{})
 	schema.RegisterRoleRef(svc, &schema.RoleRefAliases{})
-	schema.RegisterRule(svc, &schema.RuleAliases{})
+	schema.RegisterRule(svc, &ruleImpl{})
 	schema.RegisterSubject(svc, &schema.SubjectAliases{})
 
 	// Register user types
@@ -189,6 +209,12 @@ func NewService(cfg ServiceConfig) (*Service, error) {
 	schema.RegisterUpdateCheckPayload(svc, &checkMutationPayload{})
 	schema.RegisterPutWrappedPayload(svc, &schema.PutWrappedPayloadAliases{})
 
+	// Register subscriptions
+	schema.RegisterSubscription(svc, &subscriptionImpl{svc: cfg})
+	schema.RegisterChangeAction(svc)
+	schema.RegisterEventChange(svc, &schema.EventChangeAliases{})
+	schema.RegisterSilenceChange(svc, &schema.SilenceChangeAliases{})
+
 	// Errors
 	schema.RegisterStandardError(svc, stdErrImpl{})
 	schema.RegisterError(svc, &errImpl{})
@@ -214,3 +240,12 @@ func (svc *Service) Do(ctx context.Context, p graphql.QueryParams) *graphql.Resu
 	// Execute query inside context
 	return svc.Target.Do(qryCtx, p)
 }
+
+// Subscribe executes given subscription and returns the channel of its
+// results, closed once ctx is done.
+func (svc *Service) Subscribe(ctx context.Context, p graphql.QueryParams) <-chan *graphql.Result {
+	return svc.Target.Subscribe(ctx, p, func(ctx context.Context) context.Context {
+		// Each change is resolved with fresh loaders
+		return contextWithLoaders(ctx, svc.Config)
+	})
+}
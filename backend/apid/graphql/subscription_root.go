@@ -0,0 +1,146 @@
+package graphql
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/graphql"
+)
+
+var _ schema.SubscriptionFieldResolvers = (*subscriptionImpl)(nil)
+
+//
+// Implement SubscriptionFieldResolvers
+//
+
+type subscriptionImpl struct {
+	svc ServiceConfig
+}
+
+// EventChanged implements response to request for 'eventChanged' field.
+func (r *subscriptionImpl) EventChanged(p schema.SubscriptionEventChangedFieldResolverParams) (interface{}, error) {
+	return graphql.ResolveSubscription(p.ResolveParams, func(ctx context.Context) (<-chan interface{}, error) {
+		ctx = contextWithNamespace(ctx, p.Args.Namespace)
+		events, err := r.svc.EventClient.WatchEvents(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		changes := make(chan interface{})
+		go func() {
+			defer close(changes)
+			for watchEvent := range events {
+				if !matchesEventArgs(watchEvent.Event, p.Args.Entity, p.Args.Check) {
+					continue
+				}
+				change := map[string]interface{}{
+					"action": changeAction(watchEvent.Action),
+					"event":  watchEvent.Event,
+				}
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return changes, nil
+	})
+}
+
+// EntityStatusChanged implements response to request for 'entityStatusChanged' field.
+func (r *subscriptionImpl) EntityStatusChanged(p schema.SubscriptionEntityStatusChangedFieldResolverParams) (interface{}, error) {
+	return graphql.ResolveSubscription(p.ResolveParams, func(ctx context.Context) (<-chan interface{}, error) {
+		ctx = contextWithNamespace(ctx, p.Args.Namespace)
+		events, err := r.svc.EventClient.WatchEvents(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		changes := make(chan interface{})
+		go func() {
+			defer close(changes)
+			for watchEvent := range events {
+				if !matchesEventArgs(watchEvent.Event, p.Args.Entity, "") || !statusChanged(watchEvent) {
+					continue
+				}
+				// The status of the entity is resolved from all its events
+				entity, err := r.svc.EntityClient.FetchEntity(ctx, watchEvent.Event.Entity.Name)
+				if err != nil || entity == nil {
+					continue
+				}
+				select {
+				case changes <- entity:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return changes, nil
+	})
+}
+
+// SilenceChanged implements response to request for 'silenceChanged' field.
+func (r *subscriptionImpl) SilenceChanged(p schema.SubscriptionSilenceChangedFieldResolverParams) (interface{}, error) {
+	return graphql.ResolveSubscription(p.ResolveParams, func(ctx context.Context) (<-chan interface{}, error) {
+		ctx = contextWithNamespace(ctx, p.Args.Namespace)
+		silences, err := r.svc.SilencedClient.WatchSilenced(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		changes := make(chan interface{})
+		go func() {
+			defer close(changes)
+			for watchEvent := range silences {
+				if watchEvent.Resource == nil {
+					continue
+				}
+				change := map[string]interface{}{
+					"action":  changeAction(watchEvent.Action),
+					"silence": watchEvent.Resource,
+				}
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return changes, nil
+	})
+}
+
+// matchesEventArgs returns true if the event belongs to the given entity and
+// check, when not empty.
+func matchesEventArgs(event *corev2.Event, entity, check string) bool {
+	if event == nil || event.Entity == nil || !event.HasCheck() {
+		return false
+	}
+	if entity != "" && event.Entity.Name != entity {
+		return false
+	}
+	return check == "" || event.Check.Name == check
+}
+
+// statusChanged returns true if the event was created or deleted, or if the
+// status of its check changed.
+func statusChanged(watchEvent store.WatchEventEvent) bool {
+	if watchEvent.Action != store.WatchUpdate {
+		return true
+	}
+	history := watchEvent.Event.Check.History
+	return len(history) < 2 || history[len(history)-2].Status != watchEvent.Event.Check.Status
+}
+
+func changeAction(action store.WatchActionType) string {
+	switch action {
+	case store.WatchCreate:
+		return string(schema.ChangeActions.CREATED)
+	case store.WatchDelete:
+		return string(schema.ChangeActions.DELETED)
+	}
+	return string(schema.ChangeActions.UPDATED)
+}
@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionEventChanged(t *testing.T) {
+	web := corev2.FixtureEvent("web", "check-cpu")
+	db := corev2.FixtureEvent("db", "check-cpu")
+	events := make(chan store.WatchEventEvent, 3)
+	events <- store.WatchEventEvent{Action: store.WatchCreate, Event: web}
+	events <- store.WatchEventEvent{Action: store.WatchCreate, Event: db}
+	events <- store.WatchEventEvent{Action: store.WatchDelete, Event: web}
+	close(events)
+
+	client := new(MockEventClient)
+	client.On("WatchEvents", mock.Anything).Return((<-chan store.WatchEventEvent)(events), nil)
+	svc, err := NewService(ServiceConfig{EventClient: client})
+	require.NoError(t, err)
+
+	query := `subscription { eventChanged(namespace: "default", entity: "web") { action event { check { name } } } }`
+	var actions []interface{}
+	for result := range svc.Subscribe(context.Background(), graphql.QueryParams{Query: query}) {
+		require.Empty(t, result.Errors)
+		change := result.Data.(map[string]interface{})["eventChanged"].(map[string]interface{})
+		actions = append(actions, change["action"])
+	}
+	assert.Equal(t, []interface{}{"CREATED", "DELETED"}, actions)
+
+	// The namespace of the subscription is watched
+	ctx := client.Calls[0].Arguments.Get(0).(context.Context)
+	assert.Equal(t, "default", corev2.ContextNamespace(ctx))
+}
+
+func TestSubscriptionEntityStatusChanged(t *testing.T) {
+	failing := corev2.FixtureEvent("web", "check-cpu")
+	failing.Check.Status = 2
+	failing.Check.History = []corev2.CheckHistory{{Status: 0}, {Status: 2}}
+	stillFailing := corev2.FixtureEvent("web", "check-cpu")
+	stillFailing.Check.Status = 2
+	stillFailing.Check.History = []corev2.CheckHistory{{Status: 2}, {Status: 2}}
+	events := make(chan store.WatchEventEvent, 2)
+	events <- store.WatchEventEvent{Action: store.WatchUpdate, Event: failing}
+	events <- store.WatchEventEvent{Action: store.WatchUpdate, Event: stillFailing}
+	close(events)
+
+	eventClient := new(MockEventClient)
+	eventClient.On("WatchEvents", mock.Anything).Return((<-chan store.WatchEventEvent)(events), nil)
+	eventClient.On("ListEvents", mock.Anything, mock.Anything).Return([]*corev2.Event{failing}, nil)
+	entityClient := new(MockEntityClient)
+	entityClient.On("FetchEntity", mock.Anything, "web").Return(corev2.FixtureEntity("web"), nil)
+	svc, err := NewService(ServiceConfig{EventClient: eventClient, EntityClient: entityClient})
+	require.NoError(t, err)
+
+	query := `subscription { entityStatusChanged(namespace: "default") { name status } }`
+	var results []*graphql.Result
+	for result := range svc.Subscribe(context.Background(), graphql.QueryParams{Query: query}) {
+		results = append(results, result)
+	}
+	require.Len(t, results, 1)
+	require.Empty(t, results[0].Errors)
+	entity := results[0].Data.(map[string]interface{})["entityStatusChanged"].(map[string]interface{})
+	assert.Equal(t, "web", entity["name"])
+	assert.EqualValues(t, 2, entity["status"])
+}
+
+func TestSubscriptionSilenceChangedUnauthorized(t *testing.T) {
+	client := new(MockSilencedClient)
+	client.On("WatchSilenced", mock.Anything).Return((<-chan store.WatchEventResource)(nil), errors.New("unauthorized"))
+	svc, err := NewService(ServiceConfig{SilencedClient: client})
+	require.NoError(t, err)
+
+	query := `subscription { silenceChanged(namespace: "default") { action } }`
+	var results []*graphql.Result
+	for result := range svc.Subscribe(context.Background(), graphql.QueryParams{Query: query}) {
+		results = append(results, result)
+	}
+	require.Len(t, results, 1)
+	assert.NotEmpty(t, results[0].Errors)
+}
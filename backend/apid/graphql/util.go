@@ -6,6 +6,7 @@ import (
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/backend/store"
 )
 
 // clampInt returns int within given range.
@@ -44,7 +45,11 @@ func convertTs(ts int64) *time.Time {
 
 // sortEvents by given enum value
 func sortEvents(evs []*corev2.Event, order schema.EventsListOrder) {
-	if order == schema.EventsListOrders.SEVERITY {
+	if order == schema.EventsListOrders.ENTITY {
+		sort.Slice(evs, func(i, j int) bool {
+			return evs[i].Entity.Name+"/"+evs[i].Check.Name < evs[j].Entity.Name+"/"+evs[j].Check.Name
+		})
+	} else if order == schema.EventsListOrders.SEVERITY {
 		sort.Sort(corev2.EventsBySeverity(evs))
 	} else if order == schema.EventsListOrders.LASTOK {
 		sort.Sort(corev2.EventsByLastOk(evs))
@@ -55,3 +60,18 @@ func sortEvents(evs []*corev2.Event, order schema.EventsListOrder) {
 		))
 	}
 }
+
+// eventsListOrdering returns the store ordering of given enum value
+func eventsListOrdering(order schema.EventsListOrder) (ordering string, descending bool) {
+	switch order {
+	case schema.EventsListOrders.ENTITY:
+		return store.EventSortEntity, false
+	case schema.EventsListOrders.LASTOK:
+		return store.EventSortLastOk, false
+	case schema.EventsListOrders.NEWEST:
+		return store.EventSortTimestamp, true
+	case schema.EventsListOrders.OLDEST:
+		return store.EventSortTimestamp, false
+	}
+	return store.EventSortSeverity, false
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// applyAttempts is the number of times the changes of an apply are merged
+// into the resource when it is modified concurrently.
+const applyAttempts = 3
+
+// ApplyResource merges the changes of the JSON merge patch (RFC 7386) given in
+// the request body into the resource identified in the request path. Since the
+// changes are merged field-wise, the concurrent changes made to the other
+// fields of the resource are preserved. If the request has an If-Match header,
+// the resource is only modified if it was not modified since the client read
+// it.
+func (h Handlers) ApplyResource(r *http.Request) (interface{}, error) {
+	params := mux.Vars(r)
+	name, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	var patch map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	ctx := r.Context()
+	attempts := applyAttempts
+	if store.ResourceVersionFromContext(ctx) != "" {
+		// The client expects a version, so the changes can't be merged again
+		attempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		resource := reflect.New(reflect.TypeOf(h.Resource).Elem()).Interface().(corev2.Resource)
+		if err := h.Store.GetResource(ctx, name, resource); err != nil {
+			switch err := err.(type) {
+			case *store.ErrNotFound:
+				return nil, actions.NewErrorf(actions.NotFound)
+			default:
+				return nil, actions.NewError(actions.InternalErr, err)
+			}
+		}
+
+		applied, err := h.mergePatch(resource, patch)
+		if err != nil {
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		}
+		if err := CheckMeta(applied, params, "id"); err != nil {
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		}
+		if corev2.DryRunFromContext(ctx) {
+			return dryRunResponse(DryRun(ctx, h.Store, applied, false))
+		}
+
+		// Only write the resource if it was not modified since it was read
+		writeCtx := ctx
+		if store.ResourceVersionFromContext(ctx) == "" {
+			writeCtx = store.ResourceVersionContext(ctx, resource.GetObjectMeta().ResourceVersion)
+		}
+
+		err = h.Store.CreateOrUpdateResource(writeCtx, applied)
+		switch err := err.(type) {
+		case nil:
+			return nil, nil
+		case *store.ErrNotValid:
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		case *store.ErrPreconditionFailed:
+			if attempt < attempts {
+				continue
+			}
+			return nil, actions.NewErrorf(actions.PreconditionFailed)
+		default:
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+	}
+}
+
+// mergePatch returns a new resource made of the given resource with the
+// changes of the given JSON merge patch.
+func (h Handlers) mergePatch(resource corev2.Resource, patch map[string]interface{}) (corev2.Resource, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if b, err = json.Marshal(mergePatch(doc, patch)); err != nil {
+		return nil, err
+	}
+
+	applied := reflect.New(reflect.TypeOf(h.Resource).Elem()).Interface().(corev2.Resource)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(applied); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+// mergePatch applies a JSON merge patch to a JSON document, as described by
+// RFC 7386: the members of the patch objects replace the members of the
+// document objects recursively, and the null members remove them.
+func mergePatch(doc, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	docObject, ok := doc.(map[string]interface{})
+	if !ok {
+		docObject = map[string]interface{}{}
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(docObject, name)
+		} else {
+			docObject[name] = mergePatch(docObject[name], value)
+		}
+	}
+	return docObject
+}
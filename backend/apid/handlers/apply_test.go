@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/fixture"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockGetFixtureResource(s *mockstore.MockStore, version string) {
+	s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*fixture.Resource")).
+		Run(func(args mock.Arguments) {
+			resource := args.Get(2).(*fixture.Resource)
+			*resource = fixture.Resource{
+				ObjectMeta: corev2.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					Labels:          map[string]string{"a": "1", "b": "2"},
+					ResourceVersion: version,
+				},
+				Foo: "bar",
+			}
+		}).Return(nil).Once()
+}
+
+func resourceVersionIs(version string) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return store.ResourceVersionFromContext(ctx) == version
+	})
+}
+
+func applyRequest(ctx context.Context, body string) *http.Request {
+	r, _ := http.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+	r = r.WithContext(ctx)
+	return mux.SetURLVars(r, map[string]string{"id": "foo", "namespace": "default"})
+}
+
+func TestHandlers_ApplyResource(t *testing.T) {
+	s := &mockstore.MockStore{}
+	h := Handlers{Resource: &fixture.Resource{}, Store: s}
+
+	// The resource is modified between the read and the write of the first
+	// attempt, so the changes are merged again
+	mockGetFixtureResource(s, "1")
+	mockGetFixtureResource(s, "2")
+	s.On("CreateOrUpdateResource", resourceVersionIs("1"), mock.Anything).
+		Return(&store.ErrPreconditionFailed{}).Once()
+	var applied *fixture.Resource
+	s.On("CreateOrUpdateResource", resourceVersionIs("2"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			applied = args.Get(1).(*fixture.Resource)
+		}).Return(nil).Once()
+
+	_, err := h.ApplyResource(applyRequest(context.Background(), `{"metadata": {"labels": {"b": null, "c": "3"}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "c": "3"}, applied.Labels)
+	assert.Equal(t, "bar", applied.Foo)
+	s.AssertExpectations(t)
+}
+
+func TestHandlers_ApplyResourceIfMatch(t *testing.T) {
+	s := &mockstore.MockStore{}
+	h := Handlers{Resource: &fixture.Resource{}, Store: s}
+
+	// The changes are not merged again if the client gave the version it read
+	mockGetFixtureResource(s, "2")
+	s.On("CreateOrUpdateResource", resourceVersionIs("1"), mock.Anything).
+		Return(&store.ErrPreconditionFailed{}).Once()
+
+	ctx := store.ResourceVersionContext(context.Background(), "1")
+	_, err := h.ApplyResource(applyRequest(ctx, `{"foo": "baz"}`))
+	code, _ := actions.StatusFromError(err)
+	assert.Equal(t, actions.PreconditionFailed, code)
+	s.AssertExpectations(t)
+}
+
+func TestHandlers_ApplyResourceErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		code actions.ErrCode
+	}{
+		{
+			name: "invalid patch",
+			body: `["foo"]`,
+			code: actions.InvalidArgument,
+		},
+		{
+			name: "unknown field",
+			body: `{"bar": "baz"}`,
+			code: actions.InvalidArgument,
+		},
+		{
+			name: "renamed resource",
+			body: `{"metadata": {"name": "bar"}}`,
+			code: actions.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			mockGetFixtureResource(s, "1")
+			h := Handlers{Resource: &fixture.Resource{}, Store: s}
+
+			_, err := h.ApplyResource(applyRequest(context.Background(), tt.body))
+			code, _ := actions.StatusFromError(err)
+			assert.Equal(t, tt.code, code)
+		})
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	doc := map[string]interface{}{
+		"a": "b",
+		"c": map[string]interface{}{"d": "e", "f": "g"},
+	}
+	patch := map[string]interface{}{
+		"a": "z",
+		"c": map[string]interface{}{"f": nil},
+		"h": []interface{}{"i"},
+	}
+	expected := map[string]interface{}{
+		"a": "z",
+		"c": map[string]interface{}{"d": "e"},
+		"h": []interface{}{"i"},
+	}
+	assert.Equal(t, expected, mergePatch(doc, patch))
+}
@@ -35,12 +35,18 @@ func (h Handlers) CreateResource(r *http.Request) (interface{}, error) {
 		resource.SetObjectMeta(meta)
 	}
 
+	if corev2.DryRunFromContext(r.Context()) {
+		return dryRunResponse(DryRun(r.Context(), h.Store, resource, true))
+	}
+
 	if err := h.Store.CreateResource(r.Context(), resource); err != nil {
 		switch err := err.(type) {
 		case *store.ErrAlreadyExists:
 			return nil, actions.NewErrorf(actions.AlreadyExistsErr)
 		case *store.ErrNotValid:
 			return nil, actions.NewError(actions.InvalidArgument, err)
+		case *store.ErrQuotaExceeded:
+			return nil, actions.NewError(actions.QuotaExceeded, err)
 		default:
 			return nil, actions.NewError(actions.InternalErr, err)
 		}
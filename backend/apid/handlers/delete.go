@@ -21,6 +21,10 @@ func (h Handlers) DeleteResource(r *http.Request) (interface{}, error) {
 		switch err := err.(type) {
 		case *store.ErrNotFound:
 			return nil, actions.NewErrorf(actions.NotFound)
+		case *store.ErrPreconditionFailed:
+			return nil, actions.NewErrorf(actions.PreconditionFailed)
+		case *store.ErrNotValid:
+			return nil, actions.NewError(actions.InvalidArgument, err)
 		default:
 			return nil, actions.NewError(actions.InternalErr, err)
 		}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+)
+
+// builtinMutators and builtinFilters are implemented by the pipeline, and
+// therefore don't need to exist in the store.
+var (
+	builtinMutators = []string{"json", "only_check_output"}
+	builtinFilters  = []string{"is_incident", "has_metrics", "not_silenced", "not_flapping"}
+)
+
+// reference is a resource referred to by name in a field of another resource.
+type reference struct {
+	field string
+	name  string
+
+	// kinds are the types of the resources the name can refer to
+	kinds []corev2.Resource
+
+	// clusterWide indicates that the referred resource is not namespaced
+	clusterWide bool
+}
+
+// admitter is implemented by the resource stores that admit the resources
+// before writing them, e.g. with admission webhooks.
+type admitter interface {
+	Admit(ctx context.Context, resource corev2.Resource, operation string) (corev2.Resource, error)
+}
+
+// DryRun validates the given resource as if it was about to be created or
+// updated, without persisting it. Unlike the stores, it also ensures that the
+// resources it refers to exist, so that a manifest can be validated before it
+// is deployed. If create is true, the resource must not already exist. The
+// resource is also admitted by the store, if it admits the resources, and
+// returned as it would be persisted, so that clients can preview the changes.
+func DryRun(ctx context.Context, s store.ResourceStore, resource corev2.Resource, create bool) (corev2.Resource, error) {
+	if err := resource.Validate(); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	if a, ok := s.(admitter); ok {
+		operation := corev2.AdmissionOperationUpdate
+		if create {
+			operation = corev2.AdmissionOperationCreate
+		}
+		admitted, err := a.Admit(ctx, resource, operation)
+		switch err := err.(type) {
+		case nil:
+			resource = admitted
+		case *store.ErrNotValid:
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		default:
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+	}
+
+	meta := resource.GetObjectMeta()
+	ctx = store.NamespaceContext(ctx, meta.Namespace)
+
+	if create {
+		existing := newResource(resource)
+		switch err := s.GetResource(ctx, meta.Name, existing); err.(type) {
+		case nil:
+			return nil, actions.NewErrorf(actions.AlreadyExistsErr)
+		case *store.ErrNotFound:
+		default:
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+	}
+
+	var missing []string
+	for _, ref := range references(resource) {
+		found, err := referenceExists(ctx, s, ref)
+		if err != nil {
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+		if !found {
+			missing = append(missing, fmt.Sprintf("%s %q", ref.field, ref.name))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, actions.NewErrorf(actions.InvalidArgument,
+			fmt.Sprintf("referenced resources not found: %s", strings.Join(missing, ", ")))
+	}
+
+	return resource, nil
+}
+
+// dryRunResponse returns the response of a dry run, the resource as it would
+// be persisted.
+func dryRunResponse(resource corev2.Resource, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// references returns the resources referred to by the given resource.
+func references(resource corev2.Resource) []reference {
+	var refs []reference
+	add := func(field string, names []string, builtins []string, kinds ...corev2.Resource) {
+		for _, name := range names {
+			if name == "" || utilstrings.InArray(name, builtins) {
+				continue
+			}
+			refs = append(refs, reference{field: field, name: name, kinds: kinds})
+		}
+	}
+
+	switch r := resource.(type) {
+	case *corev2.CheckConfig:
+		add("runtime_assets", r.RuntimeAssets, nil, &corev2.Asset{})
+		add("handlers", r.Handlers, nil, &corev2.Handler{})
+		add("output_metric_handlers", r.OutputMetricHandlers, nil, &corev2.Handler{})
+		for _, hooks := range r.CheckHooks {
+			add("check_hooks", hooks.Hooks, nil, &corev2.HookConfig{})
+		}
+	case *corev2.Handler:
+		add("runtime_assets", r.RuntimeAssets, nil, &corev2.Asset{})
+		add("handlers", r.Handlers, nil, &corev2.Handler{})
+		add("mutator", []string{r.Mutator}, builtinMutators, &corev2.Mutator{}, &corev2.Extension{})
+		add("filters", r.Filters, builtinFilters, &corev2.EventFilter{}, &corev2.Extension{})
+	case *corev2.Mutator:
+		add("runtime_assets", r.RuntimeAssets, nil, &corev2.Asset{})
+	case *corev2.EventFilter:
+		add("runtime_assets", r.RuntimeAssets, nil, &corev2.Asset{})
+	case *corev2.HookConfig:
+		add("runtime_assets", r.RuntimeAssets, nil, &corev2.Asset{})
+	case *corev2.KeepalivePolicy:
+		for _, stage := range r.Stages {
+			add("stages.handlers", stage.Handlers, nil, &corev2.Handler{})
+		}
+	case *corev2.RoleBinding:
+		if r.RoleRef.Type == "ClusterRole" {
+			refs = append(refs, reference{
+				field: "role_ref", name: r.RoleRef.Name, kinds: []corev2.Resource{&corev2.ClusterRole{}}, clusterWide: true,
+			})
+		} else {
+			add("role_ref", []string{r.RoleRef.Name}, nil, &corev2.Role{})
+		}
+	case *corev2.ClusterRoleBinding:
+		refs = append(refs, reference{
+			field: "role_ref", name: r.RoleRef.Name, kinds: []corev2.Resource{&corev2.ClusterRole{}}, clusterWide: true,
+		})
+	}
+
+	return refs
+}
+
+// referenceExists returns whether a resource of one of the kinds of the given
+// reference exists.
+func referenceExists(ctx context.Context, s store.ResourceStore, ref reference) (bool, error) {
+	if ref.clusterWide {
+		ctx = store.NamespaceContext(ctx, "")
+	}
+	for _, kind := range ref.kinds {
+		switch err := s.GetResource(ctx, ref.name, newResource(kind)); err.(type) {
+		case nil:
+			return true, nil
+		case *store.ErrNotFound:
+		default:
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// newResource returns a new resource of the same type as the given resource.
+func newResource(resource corev2.Resource) corev2.Resource {
+	return reflect.New(reflect.TypeOf(resource).Elem()).Interface().(corev2.Resource)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/fixture"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDryRun(t *testing.T) {
+	handler := corev2.FixtureHandler("slack")
+	handler.Mutator = "only_check_output"
+	handler.Filters = []string{"is_incident", "state-change", "ext"}
+	handler.RuntimeAssets = []string{"sensu-slack-handler"}
+
+	tests := []struct {
+		name      string
+		resource  corev2.Resource
+		create    bool
+		storeFunc func(*mockstore.MockStore)
+		wantCode  actions.ErrCode
+		wantErr   bool
+	}{
+		{
+			name:     "invalid resource",
+			resource: &corev2.CheckConfig{},
+			wantCode: actions.InvalidArgument,
+			wantErr:  true,
+		},
+		{
+			name:     "existing resource",
+			resource: &fixture.Resource{ObjectMeta: corev2.ObjectMeta{Name: "foo"}},
+			create:   true,
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.Anything).Return(nil)
+			},
+			wantCode: actions.AlreadyExistsErr,
+			wantErr:  true,
+		},
+		{
+			name:     "new resource",
+			resource: &fixture.Resource{ObjectMeta: corev2.ObjectMeta{Name: "foo"}},
+			create:   true,
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.Anything).Return(&store.ErrNotFound{})
+			},
+		},
+		{
+			name:     "existing references",
+			resource: handler,
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "sensu-slack-handler", mock.AnythingOfType("*v2.Asset")).Return(nil)
+				s.On("GetResource", mock.Anything, "state-change", mock.AnythingOfType("*v2.EventFilter")).Return(nil)
+				s.On("GetResource", mock.Anything, "ext", mock.AnythingOfType("*v2.EventFilter")).Return(&store.ErrNotFound{})
+				s.On("GetResource", mock.Anything, "ext", mock.AnythingOfType("*v2.Extension")).Return(nil)
+			},
+		},
+		{
+			name:     "missing references",
+			resource: handler,
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(&store.ErrNotFound{})
+			},
+			wantCode: actions.InvalidArgument,
+			wantErr:  true,
+		},
+		{
+			name: "cluster role reference",
+			resource: &corev2.RoleBinding{
+				ObjectMeta: corev2.ObjectMeta{Name: "foo", Namespace: "default"},
+				Subjects:   []corev2.Subject{corev2.FixtureSubject(corev2.UserType, "bar")},
+				RoleRef:    corev2.FixtureRoleRef("ClusterRole", "admin"),
+			},
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.MatchedBy(func(ctx context.Context) bool {
+					return corev2.ContextNamespace(ctx) == ""
+				}), "admin", mock.AnythingOfType("*v2.ClusterRole")).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			if tt.storeFunc != nil {
+				tt.storeFunc(s)
+			}
+
+			resource, err := DryRun(context.Background(), s, tt.resource, tt.create)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DryRun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				code, _ := actions.StatusFromError(err)
+				assert.Equal(t, tt.wantCode, code)
+			} else {
+				assert.Equal(t, tt.resource, resource)
+			}
+			s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+			s.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandlers_CreateResourceDryRun(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "foo", mock.Anything).Return(&store.ErrNotFound{})
+	h := Handlers{Resource: &fixture.Resource{}, Store: s}
+
+	ctx := context.WithValue(context.Background(), corev2.DryRunKey, true)
+	req := applyRequest(ctx, `{"metadata": {"name": "foo", "namespace": "default"}}`)
+	resource, err := h.CreateResource(req)
+	assert.NoError(t, err)
+	if assert.IsType(t, &fixture.Resource{}, resource) {
+		assert.Equal(t, "foo", resource.(*fixture.Resource).Name)
+	}
+	s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+}
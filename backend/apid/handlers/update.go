@@ -35,10 +35,18 @@ func (h Handlers) CreateOrUpdateResource(r *http.Request) (interface{}, error) {
 		resource.SetObjectMeta(meta)
 	}
 
+	if corev2.DryRunFromContext(r.Context()) {
+		return dryRunResponse(DryRun(r.Context(), h.Store, resource, false))
+	}
+
 	if err := h.Store.CreateOrUpdateResource(r.Context(), resource); err != nil {
 		switch err := err.(type) {
 		case *store.ErrNotValid:
 			return nil, actions.NewError(actions.InvalidArgument, err)
+		case *store.ErrQuotaExceeded:
+			return nil, actions.NewError(actions.QuotaExceeded, err)
+		case *store.ErrPreconditionFailed:
+			return nil, actions.NewErrorf(actions.PreconditionFailed)
 		default:
 			return nil, actions.NewError(actions.InternalErr, err)
 		}
@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/sensu/sensu-go/backend/audit"
+	"github.com/sensu/sensu-go/backend/authorization"
+)
+
+// Audit is an HTTP middleware that records every create, update and delete
+// request, along with its outcome, in the audit log. It must be executed
+// before the Authentication middleware, so the requests failing
+// authentication are recorded too. The user and the resource of the request
+// are filled in by the AuthorizationAttributes middleware, in the attributes
+// the Audit middleware adds to the context.
+type Audit struct {
+	Logger audit.Logger
+}
+
+// Then middleware
+func (a Audit) Then(next http.Handler) http.Handler {
+	if a.Logger == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attrs := &authorization.Attributes{}
+		setRequestAttributes(r, attrs)
+		if !isMutation(attrs.Verb) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := authorization.SetAttributes(r.Context(), attrs)
+		writerWithCapture := makeResponseWriterWithCapture(w)
+		next.ServeHTTP(writerWithCapture, r.WithContext(ctx))
+
+		entry := audit.Entry{
+			User:         attrs.User.Username,
+			Namespace:    attrs.Namespace,
+			APIGroup:     attrs.APIGroup,
+			APIVersion:   attrs.APIVersion,
+			Resource:     attrs.Resource,
+			ResourceName: attrs.ResourceName,
+			Verb:         attrs.Verb,
+			Outcome:      audit.OutcomeFromStatus(writerWithCapture.Status()),
+			Status:       writerWithCapture.Status(),
+		}
+		if err := a.Logger.Log(entry); err != nil {
+			logger.WithError(err).Error("could not record request in the audit log")
+		}
+	})
+}
+
+func isMutation(verb string) bool {
+	switch verb {
+	case "create", "update", "delete":
+		return true
+	}
+	return false
+}
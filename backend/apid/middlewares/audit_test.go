@@ -0,0 +1,140 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingAuditLogger struct {
+	entries []audit.Entry
+}
+
+func (r *recordingAuditLogger) Log(entry audit.Entry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+	cases := []struct {
+		description   string
+		method        string
+		authenticated bool
+		status        int
+		expected      []audit.Entry
+	}{
+		{
+			description:   "reads are not recorded",
+			method:        http.MethodGet,
+			authenticated: true,
+			status:        http.StatusOK,
+		},
+		{
+			description:   "successful create",
+			method:        http.MethodPost,
+			authenticated: true,
+			status:        http.StatusCreated,
+			expected: []audit.Entry{
+				{
+					User:         "alice",
+					Namespace:    "default",
+					Resource:     "checks",
+					ResourceName: "check-cpu",
+					Verb:         "create",
+					Outcome:      audit.OutcomeSuccess,
+					Status:       http.StatusCreated,
+				},
+			},
+		},
+		{
+			description:   "denied delete",
+			method:        http.MethodDelete,
+			authenticated: true,
+			status:        http.StatusForbidden,
+			expected: []audit.Entry{
+				{
+					User:         "alice",
+					Namespace:    "default",
+					Resource:     "checks",
+					ResourceName: "check-cpu",
+					Verb:         "delete",
+					Outcome:      audit.OutcomeDenied,
+					Status:       http.StatusForbidden,
+				},
+			},
+		},
+		{
+			description:   "failed update",
+			method:        http.MethodPut,
+			authenticated: true,
+			status:        http.StatusInternalServerError,
+			expected: []audit.Entry{
+				{
+					User:         "alice",
+					Namespace:    "default",
+					Resource:     "checks",
+					ResourceName: "check-cpu",
+					Verb:         "update",
+					Outcome:      audit.OutcomeFailure,
+					Status:       http.StatusInternalServerError,
+				},
+			},
+		},
+		{
+			description: "unauthenticated delete",
+			method:      http.MethodDelete,
+			status:      http.StatusUnauthorized,
+			expected: []audit.Entry{
+				{
+					Namespace:    "default",
+					Resource:     "checks",
+					ResourceName: "check-cpu",
+					Verb:         "delete",
+					Outcome:      audit.OutcomeDenied,
+					Status:       http.StatusUnauthorized,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			logger := &recordingAuditLogger{}
+			final := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			})
+			// The authenticated requests carry the claims of alice, the other
+			// ones are rejected by the Authentication middleware
+			authenticated := AuthorizationAttributes{}.Then(final)
+			if tc.authenticated {
+				next := authenticated
+				authenticated = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					claims := &corev2.Claims{StandardClaims: corev2.StandardClaims("alice")}
+					next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), corev2.ClaimsKey, claims)))
+				})
+			} else {
+				authenticated = Authentication{}.Then(authenticated)
+			}
+			handler := Audit{Logger: logger}.Then(authenticated)
+
+			req, _ := http.NewRequest(tc.method, "/", nil)
+			req = mux.SetURLVars(req, map[string]string{
+				"namespace": "default",
+				"resource":  "checks",
+				"id":        "check-cpu",
+			})
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Equal(t, tc.expected, logger.entries)
+		})
+	}
+}
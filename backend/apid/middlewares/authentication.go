@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
@@ -31,14 +32,14 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 			// if the auth header contains Bearer, continue with token auth
 			if strings.HasPrefix(headerString, "Bearer ") {
 				headerString = strings.TrimPrefix(headerString, "Bearer ")
-				token, err := jwt.ValidateToken(headerString)
+				claims, err := TokenClaims(ctx, headerString, a.Store)
 				if err != nil {
 					logger.WithError(err).Warn("invalid token")
 					writeErr(w, actions.NewErrorf(actions.Unauthenticated, "invalid credentials"))
 					return
 				}
 				// Set the claims into the request context
-				ctx = jwt.SetClaimsIntoContext(r, token.Claims.(*corev2.Claims))
+				ctx = jwt.SetClaimsIntoContext(r, claims)
 				next.ServeHTTP(w, r.WithContext(ctx))
 				return
 			}
@@ -46,7 +47,7 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 			// if the auth header contains Key, continue with api key auth
 			if strings.HasPrefix(headerString, "Key ") {
 				headerString = strings.TrimPrefix(headerString, "Key ")
-				claims, err := extractAPIKeyClaims(ctx, headerString, a.Store)
+				claims, err := APIKeyClaims(ctx, headerString, a.Store)
 				if err != nil {
 					logger.WithError(err).Warn("invalid api key")
 					writeErr(w, actions.NewErrorf(actions.Unauthenticated, "invalid credentials"))
@@ -71,7 +72,39 @@ func (a Authentication) Then(next http.Handler) http.Handler {
 	})
 }
 
-func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*corev2.Claims, error) {
+// TokenClaims validates the given access token and returns its claims. The
+// tokens of the users disabled since they were issued are rejected, if a store
+// is given. The users of other providers than the basic one may not be in the
+// store, in which case their tokens are accepted.
+func TokenClaims(ctx context.Context, tokenString string, store store.Store) (*corev2.Claims, error) {
+	token, err := jwt.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	claims := token.Claims.(*corev2.Claims)
+	if store == nil {
+		return claims, nil
+	}
+
+	user, err := store.GetUser(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil && user.Disabled {
+		return nil, fmt.Errorf("user %s is disabled", user.Username)
+	}
+
+	return claims, nil
+}
+
+// apiKeyLastUsedPrecision is the precision in seconds of the last use of the
+// API keys, which are not written to the store more often.
+const apiKeyLastUsedPrecision = 60
+
+// APIKeyClaims returns the claims of the user of the given API key, restricted
+// to the scope of the key, and records the use of the key. The use is recorded
+// with s, which must not call the admission webhooks.
+func APIKeyClaims(ctx context.Context, key string, s store.Store) (*corev2.Claims, error) {
 	var claims *corev2.Claims
 	// retrieve the APIKey based on the key provided
 	apiKey := &corev2.APIKey{
@@ -79,12 +112,17 @@ func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*c
 			Name: key,
 		},
 	}
-	if err := store.GetResource(context.Background(), apiKey.Name, apiKey); err != nil {
+	if err := s.GetResource(context.Background(), apiKey.Name, apiKey); err != nil {
 		return claims, err
 	}
 
+	now := time.Now()
+	if apiKey.Expired(now) {
+		return claims, fmt.Errorf("api key of user %s expired", apiKey.Username)
+	}
+
 	// retrieve the sensu user associated with the key provided
-	user, err := store.GetUser(ctx, apiKey.Username)
+	user, err := s.GetUser(ctx, apiKey.Username)
 	if err != nil {
 		return claims, err
 	}
@@ -94,13 +132,39 @@ func extractAPIKeyClaims(ctx context.Context, key string, store store.Store) (*c
 	if user == nil {
 		return claims, fmt.Errorf("user %s not found", apiKey.Username)
 	}
+	if user.Disabled {
+		return claims, fmt.Errorf("user %s is disabled", apiKey.Username)
+	}
+
+	if now.Unix()-apiKey.LastUsedAt >= apiKeyLastUsedPrecision {
+		recordAPIKeyUse(s, apiKey, now)
+	}
 
 	// inject the username and groups into standard jwt claims
 	claims = &corev2.Claims{
 		StandardClaims: corev2.StandardClaims(user.Username),
 		Groups:         user.Groups,
 		APIKey:         true,
+		Namespaces:     apiKey.Namespaces,
+		Verbs:          apiKey.Verbs,
 	}
 
 	return claims, nil
 }
+
+// recordAPIKeyUse records the last use of apiKey, unless it was modified or
+// revoked since it was read, so that a revoked key is not written again.
+func recordAPIKeyUse(s store.Store, apiKey *corev2.APIKey, now time.Time) {
+	version := apiKey.ResourceVersion
+	if version == "" {
+		return
+	}
+	apiKey.LastUsedAt = now.Unix()
+	ctx := store.ResourceVersionContext(context.Background(), version)
+	if err := s.CreateOrUpdateResource(ctx, apiKey); err != nil {
+		if _, ok := err.(*store.ErrPreconditionFailed); ok {
+			return
+		}
+		logger.WithError(err).Warn("could not record the use of an api key")
+	}
+}
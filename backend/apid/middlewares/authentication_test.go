@@ -1,14 +1,17 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	realStore "github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -84,8 +87,16 @@ func TestMiddlewareValidAPIKey(t *testing.T) {
 	defer server.Close()
 
 	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
-	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(*corev2.APIKey).ResourceVersion = "42"
+	}).Return(nil)
 	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{}, nil)
+	// The use is only recorded if the key was not modified or revoked
+	store.On("CreateOrUpdateResource", mock.MatchedBy(func(ctx context.Context) bool {
+		return realStore.ResourceVersionFromContext(ctx) == "42"
+	}), mock.MatchedBy(func(key *corev2.APIKey) bool {
+		return key.LastUsedAt > 0
+	})).Return(nil)
 
 	client := &http.Client{}
 	req, _ := http.NewRequest("GET", server.URL, nil)
@@ -93,6 +104,90 @@ func TestMiddlewareValidAPIKey(t *testing.T) {
 	res, err := client.Do(req)
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, res.StatusCode)
+	store.AssertCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+}
+
+func TestMiddlewareRevokedAPIKeyUse(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(*corev2.APIKey).ResourceVersion = "42"
+	}).Return(nil)
+	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{}, nil)
+	// The key was revoked while the request was authenticated
+	store.On("CreateOrUpdateResource", mock.Anything, mock.Anything).
+		Return(&realStore.ErrPreconditionFailed{Key: key.Name})
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	store.AssertNumberOfCalls(t, "CreateOrUpdateResource", 1)
+}
+
+func TestMiddlewareScopedAPIKey(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	var claims *corev2.Claims
+	server := httptest.NewServer(mware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims = jwt.GetClaimsFromContext(r.Context())
+	})))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		apiKey := args.Get(2).(*corev2.APIKey)
+		apiKey.Username = "admin"
+		apiKey.Namespaces = []string{"dev"}
+		apiKey.Verbs = []string{"get", "list"}
+		// The last use was recorded recently
+		apiKey.LastUsedAt = time.Now().Unix()
+	}).Return(nil)
+	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{Username: "admin"}, nil)
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	if assert.NotNil(t, claims) {
+		assert.True(t, claims.APIKey)
+		assert.Equal(t, []string{"dev"}, claims.Namespaces)
+		assert.Equal(t, []string{"get", "list"}, claims.Verbs)
+	}
+	store.AssertNotCalled(t, "CreateOrUpdateResource", mock.Anything, mock.Anything)
+}
+
+func TestMiddlewareExpiredAPIKey(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(*corev2.APIKey).ExpiresAt = time.Now().Add(-time.Minute).Unix()
+	}).Return(nil)
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
 }
 
 func TestMiddlewareInvalidAPIKey(t *testing.T) {
@@ -154,3 +249,49 @@ func TestMiddlewareNoUserAPIKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
 }
+
+func TestMiddlewareDisabledUserJWT(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	user := corev2.FixtureUser("foo")
+	store.On("GetUser", mock.Anything, "foo").Return(user, nil)
+
+	_, tokenString, _ := jwt.AccessToken(corev2.FixtureClaims("foo", nil))
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tokenString))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	// The token is rejected once the user is disabled
+	user.Disabled = true
+	res, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestMiddlewareDisabledUserAPIKey(t *testing.T) {
+	store := &mockstore.MockStore{}
+	mware := Authentication{
+		Store: store,
+	}
+	server := httptest.NewServer(mware.Then(testHandler()))
+	defer server.Close()
+
+	key := corev2.FixtureAPIKey("174373d0-4aff-41d8-aa5f-084dfcad7dc7", "admin")
+	store.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	store.On("GetUser", mock.Anything, mock.Anything).Return(&corev2.User{Username: "admin", Disabled: true}, nil)
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Add("Authorization", fmt.Sprintf("Key %s", key.Name))
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
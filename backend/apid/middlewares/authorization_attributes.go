@@ -32,54 +32,18 @@ type AuthorizationAttributes struct{}
 func (a AuthorizationAttributes) Then(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		attrs := &authorization.Attributes{}
 
-		ctx = authorization.SetAttributes(ctx, attrs)
-		defer next.ServeHTTP(w, r.WithContext(ctx))
-
-		switch r.Method {
-		case "POST":
-			attrs.Verb = "create"
-		case "GET", "HEAD":
-			attrs.Verb = "get"
-		case "PUT":
-			attrs.Verb = "update"
-		case "DELETE":
-			attrs.Verb = "delete"
-		default:
-			attrs.Verb = ""
+		// Fill in the attributes already set by the Audit middleware, if any,
+		// so it can record them once the request is handled
+		attrs := authorization.GetAttributes(ctx)
+		if attrs == nil {
+			attrs = &authorization.Attributes{}
+			ctx = authorization.SetAttributes(ctx, attrs)
 		}
+		defer next.ServeHTTP(w, r.WithContext(ctx))
 
 		vars := mux.Vars(r)
-		attrs.APIGroup = vars["group"]
-		attrs.APIVersion = vars["version"]
-		attrs.Namespace = vars["namespace"]
-		attrs.Resource = vars["resource"]
-		attrs.ResourceName = vars["id"]
-
-		// TODO: we can probably get rid of this special case by reworking the
-		// cluster router.
-		if attrs.Resource == "cluster" {
-			attrs.Resource = "cluster-members"
-		}
-
-		// Most resource names are identified by a route variable named "id".
-		// Other resources have snowflake paths; see their corresponding router
-		// and the expected paths above.
-		switch attrs.Resource {
-		case "events":
-			attrs.ResourceName = path.Join(vars["entity"], vars["check"])
-		case "silenced":
-			if strings.Contains(r.URL.Path, "/silenced/checks") {
-				attrs.ResourceName = path.Join("checks", vars["check"])
-			} else if strings.Contains(r.URL.Path, "/silenced/subscriptions") {
-				attrs.ResourceName = path.Join("subscriptions", vars["subscription"])
-			}
-		}
-
-		if attrs.Verb == "get" && (attrs.ResourceName == "" || isListable(attrs.Resource, attrs.ResourceName)) {
-			attrs.Verb = "list"
-		}
+		setRequestAttributes(r, attrs)
 
 		// Add the user to the attributes
 		if err := GetUser(ctx, attrs); err != nil {
@@ -103,6 +67,54 @@ func (a AuthorizationAttributes) Then(next http.Handler) http.Handler {
 	})
 }
 
+// setRequestAttributes fills in the verb and the resource of attrs from the
+// method and the route variables of r.
+func setRequestAttributes(r *http.Request, attrs *authorization.Attributes) {
+	switch r.Method {
+	case "POST":
+		attrs.Verb = "create"
+	case "GET", "HEAD":
+		attrs.Verb = "get"
+	case "PUT", "PATCH":
+		attrs.Verb = "update"
+	case "DELETE":
+		attrs.Verb = "delete"
+	default:
+		attrs.Verb = ""
+	}
+
+	vars := mux.Vars(r)
+	attrs.APIGroup = vars["group"]
+	attrs.APIVersion = vars["version"]
+	attrs.Namespace = vars["namespace"]
+	attrs.Resource = vars["resource"]
+	attrs.ResourceName = vars["id"]
+
+	// TODO: we can probably get rid of this special case by reworking the
+	// cluster router.
+	if attrs.Resource == "cluster" {
+		attrs.Resource = "cluster-members"
+	}
+
+	// Most resource names are identified by a route variable named "id".
+	// Other resources have snowflake paths; see their corresponding router
+	// and the expected paths above.
+	switch attrs.Resource {
+	case "events":
+		attrs.ResourceName = path.Join(vars["entity"], vars["check"])
+	case "silenced":
+		if strings.Contains(r.URL.Path, "/silenced/checks") {
+			attrs.ResourceName = path.Join("checks", vars["check"])
+		} else if strings.Contains(r.URL.Path, "/silenced/subscriptions") {
+			attrs.ResourceName = path.Join("subscriptions", vars["subscription"])
+		}
+	}
+
+	if attrs.Verb == "get" && (attrs.ResourceName == "" || isListable(attrs.Resource, attrs.ResourceName)) {
+		attrs.Verb = "list"
+	}
+}
+
 func isListable(resourceType, name string) bool {
 	// For /events, if the resource name doesn't contain a '/', we're listing
 	// /events/{entity} as opposed to getting /events/{entity}/{check}
@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+)
+
+// DryRun retrieves the "dryRun" query parameter and adds it to the request's
+// context, so that the changes of the request are validated but not persisted.
+type DryRun struct{}
+
+// Then middleware
+func (d DryRun) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := r.URL.Query().Get("dryRun")
+		if value == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			writeErr(w, actions.NewErrorf(actions.InvalidArgument, "invalid dryRun parameter %q", value))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), corev2.DryRunKey, dryRun)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunMiddleware(t *testing.T) {
+	cases := []struct {
+		description  string
+		query        string
+		expected     bool
+		expectedCode int
+	}{
+		{
+			description:  "No dryRun parameter",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Dry run",
+			query:        "?dryRun=true",
+			expected:     true,
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "No dry run",
+			query:        "?dryRun=false",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Invalid dryRun parameter",
+			query:        "?dryRun=foo",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			var dryRun bool
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				dryRun = corev2.DryRunFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/"+tt.query, nil)
+			w := httptest.NewRecorder()
+			DryRun{}.Then(testHandler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expected, dryRun)
+		})
+	}
+}
@@ -8,15 +8,26 @@ import (
 // MaxBytesLimit is the max http request size, in bytes (see https://github.com/sensu/sensu-alpha-documentation/blob/master/97-FAQ.md)
 const (
 	MaxBytesLimit = 512000
+
+	// MaxBulkBytesLimit is the max size of the bulk requests, in bytes, which
+	// hold thousands of resources.
+	MaxBulkBytesLimit = 20 * MaxBytesLimit
 )
 
 // LimitRequest is an HTTP middleware that enforces request limits
-type LimitRequest struct{}
+type LimitRequest struct {
+	// Limit is the max http request size, in bytes, or MaxBytesLimit if zero.
+	Limit int64
+}
 
 // Then middleware
 func (l LimitRequest) Then(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		r.Body = http.MaxBytesReader(w, r.Body, MaxBytesLimit)
+		limit := l.Limit
+		if limit == 0 {
+			limit = MaxBytesLimit
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
 		r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 		err := r.ParseForm()
 		if err != nil && err != io.EOF {
@@ -0,0 +1,130 @@
+package middlewares
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// ThrottledRequestsCounterVec is the name of the prometheus counter vec
+	// used to count the requests rejected by the rate limits.
+	ThrottledRequestsCounterVec = "sensu_go_api_throttled_requests"
+
+	// rateLimiterIdleTimeout is the duration after which the limiter of a
+	// client that made no request is forgotten.
+	rateLimiterIdleTimeout = 10 * time.Minute
+)
+
+// ThrottledRequests counts the requests rejected by the rate limits, by user.
+var ThrottledRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: ThrottledRequestsCounterVec,
+		Help: "The total number of API requests rejected by the rate limits",
+	},
+	[]string{"user"},
+)
+
+// RateLimiter limits the rate of the requests of every user and every API
+// key independently, so that a single client can't starve the others.
+type RateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	limiters  map[string]*clientLimiter
+	lastPurge time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing limit requests per second to
+// each client, with bursts of up to burst requests.
+func NewRateLimiter(limit rate.Limit, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		limit:     limit,
+		burst:     burst,
+		limiters:  make(map[string]*clientLimiter),
+		lastPurge: time.Now(),
+	}
+}
+
+// Reserve takes a request from the allowance of the given client. It returns
+// zero if the request is allowed, or how long the client must wait before
+// making it otherwise.
+func (l *RateLimiter) Reserve(client string) time.Duration {
+	now := time.Now()
+
+	l.mu.Lock()
+	if now.Sub(l.lastPurge) > rateLimiterIdleTimeout {
+		for key, cl := range l.limiters {
+			if now.Sub(cl.lastSeen) > rateLimiterIdleTimeout {
+				delete(l.limiters, key)
+			}
+		}
+		l.lastPurge = now
+	}
+	cl, ok := l.limiters[client]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[client] = cl
+	}
+	cl.lastSeen = now
+	l.mu.Unlock()
+
+	reservation := cl.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// The request is rejected, so it must not count
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// RateLimit is an HTTP middleware that rejects the requests of the clients
+// exceeding their rate, with the 429 status and a Retry-After header. The
+// clients are the users authenticated with access tokens, and every API key.
+// It must follow the Authentication middleware.
+type RateLimit struct {
+	// Limiter is the rate limiter of the clients, or nil for no limits.
+	Limiter *RateLimiter
+}
+
+// Then middleware
+func (m RateLimit) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, _ := r.Context().Value(corev2.ClaimsKey).(*corev2.Claims)
+		if m.Limiter == nil || claims == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		client := "user:" + claims.Subject
+		if claims.APIKey {
+			client = "key:" + strings.TrimPrefix(r.Header.Get("Authorization"), "Key ")
+		}
+
+		if delay := m.Limiter.Reserve(client); delay > 0 {
+			ThrottledRequests.WithLabelValues(claims.Subject).Inc()
+			retryAfter := int(math.Ceil(delay.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			writeErr(w, actions.NewErrorf(actions.TooManyRequests, "rate limit exceeded, retry in %ds", retryAfter))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
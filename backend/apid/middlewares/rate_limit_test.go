@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	limiter := NewRateLimiter(rate.Limit(0.01), 2)
+	handler := RateLimit{Limiter: limiter}.Then(testHandler())
+
+	serve := func(claims *corev2.Claims, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if claims != nil {
+			req = req.WithContext(context.WithValue(req.Context(), corev2.ClaimsKey, claims))
+		}
+		req.Header.Set("Authorization", authorization)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	foo := corev2.FixtureClaims("foo", nil)
+	fooKey := &corev2.Claims{StandardClaims: corev2.StandardClaims("foo"), APIKey: true}
+
+	// The burst is allowed
+	assert.Equal(t, http.StatusOK, serve(foo, "Bearer token").Code)
+	assert.Equal(t, http.StatusOK, serve(foo, "Bearer token").Code)
+
+	w := serve(foo, "Bearer token")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "100", w.Header().Get("Retry-After"))
+
+	// The other users and the API keys have their own limits
+	assert.Equal(t, http.StatusOK, serve(corev2.FixtureClaims("bar", nil), "Bearer token").Code)
+	assert.Equal(t, http.StatusOK, serve(fooKey, "Key 1").Code)
+	assert.Equal(t, http.StatusOK, serve(fooKey, "Key 1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(fooKey, "Key 1").Code)
+	assert.Equal(t, http.StatusOK, serve(fooKey, "Key 2").Code)
+
+	// The unauthenticated requests are not limited
+	assert.Equal(t, http.StatusOK, serve(nil, "").Code)
+
+	// No limits without limiter
+	handler = RateLimit{}.Then(testHandler())
+	assert.Equal(t, http.StatusOK, serve(foo, "Bearer token").Code)
+}
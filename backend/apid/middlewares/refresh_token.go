@@ -52,11 +52,6 @@ func (m RefreshToken) Then(next http.Handler) http.Handler {
 			return
 		}
 
-		if accessClaims.Provider.ProviderType == "oidc" {
-			http.Redirect(w, r, "/api/enterprise/authentication/v2/oidc/token", http.StatusTemporaryRedirect)
-			return
-		}
-
 		decoder := json.NewDecoder(r.Body)
 		payload := &types.Tokens{}
 		err = decoder.Decode(payload)
@@ -0,0 +1,36 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ResourceVersion is an HTTP middleware that passes the resource version of
+// the If-Match header of the requests to the stores, so that a resource is
+// only modified or deleted if it was not modified since the client read it.
+type ResourceVersion struct{}
+
+// Then middleware
+func (v ResourceVersion) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+		if ifMatch == "" || ifMatch == "*" || r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The resource versions are given as entity tags, e.g. "42"
+		version := strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+		if revision, err := strconv.ParseInt(version, 10, 64); err != nil || revision <= 0 {
+			writeErr(w, actions.NewErrorf(actions.InvalidArgument, "invalid If-Match header %q", ifMatch))
+			return
+		}
+
+		ctx := store.ResourceVersionContext(r.Context(), version)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
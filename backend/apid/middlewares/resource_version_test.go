@@ -0,0 +1,77 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceVersionMiddleware(t *testing.T) {
+	cases := []struct {
+		description  string
+		method       string
+		ifMatch      string
+		expected     string
+		expectedCode int
+	}{
+		{
+			description:  "No If-Match header",
+			method:       http.MethodPut,
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Entity tag",
+			method:       http.MethodPut,
+			ifMatch:      `"42"`,
+			expected:     "42",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Weak entity tag",
+			method:       http.MethodDelete,
+			ifMatch:      `W/"42"`,
+			expected:     "42",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Any version",
+			method:       http.MethodPut,
+			ifMatch:      "*",
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Read request",
+			method:       http.MethodGet,
+			ifMatch:      `"42"`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Invalid version",
+			method:       http.MethodPatch,
+			ifMatch:      `"foo"`,
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			var version string
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				version = store.ResourceVersionFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+			ResourceVersion{}.Then(testHandler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+)
+
+// ValidateRequest validates the bodies of the POST and PUT requests against
+// the schemas of their operations in the OpenAPI document of the API.
+type ValidateRequest struct {
+	Spec *openapi.Spec
+}
+
+// Then middleware
+func (v ValidateRequest) Then(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v.Spec == nil || (r.Method != http.MethodPost && r.Method != http.MethodPut) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		route := mux.CurrentRoute(r)
+		if route == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeErr(w, actions.NewErrorf(actions.InvalidArgument, "could not read the request body: %s", err))
+			return
+		}
+		_ = r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		// The bodies that can't be decoded are rejected by the handlers
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := v.Spec.ValidateRequestBody(route, r.Method, value); err != nil {
+			writeErr(w, actions.NewErrorf(actions.InvalidArgument, "invalid request body: %s", err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRequest(t *testing.T) {
+	router := mux.NewRouter()
+	middleware := ValidateRequest{Spec: openapi.NewSpec(router)}
+	handler := middleware.Then(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body is restored for the handler
+		body, _ := ioutil.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	router.Handle("/api/{group:core}/{version:v2}/namespaces/{namespace}/{resource:checks}", handler).
+		Methods(http.MethodPost, http.MethodGet)
+
+	cases := []struct {
+		description  string
+		method       string
+		body         string
+		expectedCode int
+	}{
+		{
+			description:  "Valid body",
+			method:       http.MethodPost,
+			body:         `{"command": "true", "interval": 60}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Invalid body",
+			method:       http.MethodPost,
+			body:         `{"command": "true", "interval": "60"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "Undecodable body",
+			method:       http.MethodPost,
+			body:         `{`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			description:  "Not validated method",
+			method:       http.MethodGet,
+			body:         `[]`,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/api/core/v2/namespaces/default/checks", strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			if tc.expectedCode == http.StatusOK {
+				assert.Equal(t, tc.body, w.Body.String())
+			} else {
+				assert.Contains(t, w.Body.String(), "invalid request body: interval: must be an integer")
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+// Package openapi generates the OpenAPI 3 document of the API from its route
+// definitions and the Go types of its resources, and validates the request
+// bodies against it.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/version"
+)
+
+// Version is the version of the OpenAPI specification of the documents.
+const Version = "3.0.3"
+
+// Document is an OpenAPI document.
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+	requests   map[string]*Operation `json:"-"`
+}
+
+// Info describes the API of a document.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem holds the operations of a path, by lowercase HTTP method.
+type PathItem map[string]*Operation
+
+// Operation is an operation on a path.
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a parameter of an operation.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is the request body of an operation.
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the content of a request or response body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas referred to by the operations.
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// resourceTypes are the types of the resources of the {resource} variables of
+// the route paths, by RBAC name. The operations on the other routes are
+// documented without their request and response bodies.
+var resourceTypes = map[string]corev2.Resource{}
+
+func init() {
+	for _, resource := range []corev2.Resource{
+		&corev2.AdmissionWebhook{},
+		&corev2.APIKey{},
+		&corev2.Asset{},
+		&corev2.CheckConfig{},
+		&corev2.ClusterRole{},
+		&corev2.ClusterRoleBinding{},
+		&corev2.Correlation{},
+		&corev2.Enricher{},
+		&corev2.Entity{},
+		&corev2.Event{},
+		&corev2.EventFilter{},
+		&corev2.Extension{},
+		&corev2.Handler{},
+		&corev2.HookConfig{},
+		&corev2.KeepalivePolicy{},
+		&corev2.MaintenanceWindow{},
+		&corev2.Mutator{},
+		&corev2.Namespace{},
+		&corev2.Replicator{},
+		&corev2.FederatedCluster{},
+		&corev2.Role{},
+		&corev2.RoleBinding{},
+		&corev2.Silenced{},
+		&corev2.User{},
+	} {
+		resourceTypes[resource.RBACName()] = resource
+	}
+}
+
+// literalPattern matches the patterns of the path variables that only match
+// a literal, e.g. {resource:checks}.
+var literalPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// Spec is the OpenAPI document of a router, generated once all its routes are
+// registered, i.e. on first use.
+type Spec struct {
+	router *mux.Router
+	once   sync.Once
+	doc    *Document
+	err    error
+}
+
+// NewSpec returns the OpenAPI document of the given router.
+func NewSpec(router *mux.Router) *Spec {
+	return &Spec{router: router}
+}
+
+// Document returns the OpenAPI document.
+func (s *Spec) Document() (*Document, error) {
+	s.once.Do(func() {
+		s.doc, s.err = Generate(s.router)
+	})
+	return s.doc, s.err
+}
+
+// ValidateRequestBody validates the request body of the operation of the
+// given route and method, decoded with encoding/json, against its schema, if
+// the operation has one.
+func (s *Spec) ValidateRequestBody(route *mux.Route, method string, body interface{}) error {
+	doc, err := s.Document()
+	if err != nil {
+		return nil
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return nil
+	}
+	op, ok := doc.requests[requestKey(method, parsePath(template).path)]
+	if !ok || op.RequestBody == nil {
+		return nil
+	}
+	return doc.Validate(op.RequestBody.Content["application/json"].Schema, body)
+}
+
+func requestKey(method, path string) string {
+	return method + " " + path
+}
+
+// endpoint is a method on a route path of a router.
+type endpoint struct {
+	method string
+	parsedPath
+}
+
+// parsedPath is a route path template, e.g.
+// /api/{group:core}/{version:v2}/namespaces/{namespace}/{resource:checks}.
+type parsedPath struct {
+	// path is the path with its literal variables replaced with their values
+	// and the others without their pattern, e.g.
+	// /api/core/v2/namespaces/{namespace}/checks.
+	path string
+
+	// segments are the segments of path, and literals whether each segment is
+	// part of the name of its operations.
+	segments []string
+	literals []bool
+
+	// params are the names of the path parameters.
+	params []string
+
+	// resource is the name of the resource of the path, if any, and rest are
+	// the segments that follow it.
+	resource string
+	rest     []string
+}
+
+func parsePath(template string) parsedPath {
+	var p parsedPath
+	for _, segment := range strings.Split(template, "/") {
+		if segment == "" {
+			continue
+		}
+		literal := true
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			parts := strings.SplitN(segment[1:len(segment)-1], ":", 2)
+			name := parts[0]
+			switch {
+			case len(parts) == 2 && literalPattern.MatchString(parts[1]):
+				segment = parts[1]
+				// The API group and version are not part of the names
+				literal = name != "group" && name != "version"
+				if name == "resource" {
+					p.resource = segment
+					p.rest = []string{}
+				}
+			default:
+				segment = "{" + name + "}"
+				literal = false
+				p.params = append(p.params, name)
+			}
+		} else if len(p.segments) == 0 && segment == "api" {
+			literal = false
+		}
+		if p.rest != nil && p.resource != segment {
+			p.rest = append(p.rest, segment)
+		}
+		p.segments = append(p.segments, segment)
+		p.literals = append(p.literals, literal)
+	}
+	p.path = "/" + strings.Join(p.segments, "/")
+	return p
+}
+
+// Generate returns the OpenAPI document of the routes of the given router.
+func Generate(router *mux.Router) (*Document, error) {
+	var endpoints []endpoint
+	watchable := map[string]bool{}
+	seen := map[string]bool{}
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			// The route has no path, e.g. a subrouter matching any path
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		p := parsePath(template)
+		if queries, _ := route.GetQueriesTemplates(); len(queries) > 0 {
+			// The watches are lists with the watch query parameter
+			watchable[p.path] = true
+			return nil
+		}
+		for _, method := range methods {
+			if key := requestKey(method, p.path); !seen[key] {
+				seen[key] = true
+				endpoints = append(endpoints, endpoint{method: method, parsedPath: p})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The resources with namespaced routes are listed across all namespaces
+	// by the routes without a namespace
+	namespaced := map[string]bool{}
+	for _, e := range endpoints {
+		for _, param := range e.params {
+			if param == "namespace" && e.resource != "" {
+				namespaced[e.resource] = true
+			}
+		}
+	}
+
+	generator := &schemaGenerator{schemas: map[string]*Schema{
+		"Error": {Type: "object", Properties: map[string]*Schema{
+			"message": {Type: "string"},
+			"code":    {Type: "integer", Format: "int64"},
+		}},
+	}}
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       Info{Title: "Sensu API", Version: version.Semver()},
+		Paths:      map[string]PathItem{},
+		Components: Components{Schemas: generator.schemas},
+		requests:   map[string]*Operation{},
+	}
+	operationIDs := map[string]bool{}
+	for _, e := range endpoints {
+		op := operation(generator, e, namespaced[e.resource], watchable[e.path])
+		for i := 2; operationIDs[op.OperationID]; i++ {
+			op.OperationID = strings.TrimRight(op.OperationID, "0123456789") + fmt.Sprint(i)
+		}
+		operationIDs[op.OperationID] = true
+
+		if doc.Paths[e.path] == nil {
+			doc.Paths[e.path] = PathItem{}
+		}
+		doc.Paths[e.path][strings.ToLower(e.method)] = op
+		doc.requests[requestKey(e.method, e.path)] = op
+	}
+
+	return doc, nil
+}
+
+// operation returns the operation of the given endpoint. The operations of
+// the CRUD routes of the resources are documented with their bodies.
+func operation(g *schemaGenerator, e endpoint, namespaced, watchable bool) *Operation {
+	op := &Operation{Responses: map[string]*Response{
+		"default": jsonResponse("Error", &Schema{Ref: schemaRefPrefix + "Error"}),
+	}}
+	for _, param := range e.params {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name: param, In: "path", Required: true, Schema: &Schema{Type: "string"},
+		})
+	}
+
+	resource, ok := resourceTypes[e.resource]
+	collection := len(e.rest) == 0
+	item := len(e.rest) == 1 && e.rest[0] == "{id}"
+	if !ok || !(collection || item) {
+		op.OperationID = strings.ToLower(e.method) + operationName(e.parsedPath)
+		if e.resource != "" {
+			op.Tags = []string{e.resource}
+		} else {
+			op.Tags = []string{firstLiteral(e.parsedPath)}
+		}
+		op.Responses["2XX"] = &Response{Description: "Success"}
+		return op
+	}
+
+	op.Tags = []string{e.resource}
+	name := reflectType(resource).Name()
+	schema := g.schema(reflectType(resource))
+	switch {
+	case collection && e.method == http.MethodGet:
+		op.OperationID = "list" + name
+		if namespaced && !hasParam(e.params, "namespace") {
+			op.OperationID += "AllNamespaces"
+		}
+		op.Parameters = append(op.Parameters, queryParams("limit", "continue", "labelSelector", "fieldSelector", "fields")...)
+		if watchable {
+			op.Parameters = append(op.Parameters, Parameter{Name: "watch", In: "query", Schema: &Schema{Type: "boolean"}})
+		}
+		op.Responses["200"] = jsonResponse("The resources", &Schema{Type: "array", Items: schema})
+	case collection && e.method == http.MethodPost:
+		op.OperationID = "create" + name
+		op.Parameters = append(op.Parameters, dryRunParam())
+		op.RequestBody = jsonRequestBody("application/json", schema)
+		op.Responses["201"] = &Response{Description: "Created"}
+	case item && e.method == http.MethodGet:
+		op.OperationID = "get" + name
+		op.Parameters = append(op.Parameters, queryParams("fields")...)
+		op.Responses["200"] = jsonResponse("The resource", schema)
+	case item && e.method == http.MethodPut:
+		op.OperationID = "createOrReplace" + name
+		op.Parameters = append(op.Parameters, dryRunParam(), ifMatchParam())
+		op.RequestBody = jsonRequestBody("application/json", schema)
+		op.Responses["201"] = &Response{Description: "Created or replaced"}
+	case item && e.method == http.MethodPatch:
+		op.OperationID = "apply" + name
+		op.Parameters = append(op.Parameters, dryRunParam(), ifMatchParam())
+		op.RequestBody = jsonRequestBody("application/merge-patch+json", &Schema{Type: "object"})
+		op.Responses["204"] = &Response{Description: "Applied"}
+	case item && e.method == http.MethodDelete:
+		op.OperationID = "delete" + name
+		op.Parameters = append(op.Parameters, ifMatchParam())
+		op.Responses["204"] = &Response{Description: "Deleted"}
+	default:
+		op.OperationID = strings.ToLower(e.method) + name
+		op.Responses["2XX"] = &Response{Description: "Success"}
+	}
+	return op
+}
+
+// operationName returns the name of the operations on a path, made of its
+// literal segments, e.g. NamespacesChecksExecute.
+func operationName(p parsedPath) string {
+	var name strings.Builder
+	for i, segment := range p.segments {
+		if !p.literals[i] {
+			continue
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			name.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return name.String()
+}
+
+func firstLiteral(p parsedPath) string {
+	for i, segment := range p.segments {
+		if p.literals[i] {
+			return segment
+		}
+	}
+	return ""
+}
+
+func reflectType(resource corev2.Resource) reflect.Type {
+	return reflect.TypeOf(resource).Elem()
+}
+
+func hasParam(params []string, name string) bool {
+	for _, param := range params {
+		if param == name {
+			return true
+		}
+	}
+	return false
+}
+
+func queryParams(names ...string) []Parameter {
+	params := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		schema := &Schema{Type: "string"}
+		if name == "limit" {
+			schema = &Schema{Type: "integer", Format: "int64"}
+		}
+		params = append(params, Parameter{Name: name, In: "query", Schema: schema})
+	}
+	return params
+}
+
+func dryRunParam() Parameter {
+	return Parameter{Name: "dryRun", In: "query", Schema: &Schema{Type: "boolean"}}
+}
+
+func ifMatchParam() Parameter {
+	return Parameter{Name: "If-Match", In: "header", Schema: &Schema{Type: "string"}}
+}
+
+func jsonRequestBody(contentType string, schema *Schema) *RequestBody {
+	return &RequestBody{
+		Required: true,
+		Content:  map[string]MediaType{contentType: {Schema: schema}},
+	}
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content:     map[string]MediaType{"application/json": {Schema: schema}},
+	}
+}
@@ -0,0 +1,123 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRouter returns a router with the routes of the namespaced checks and of
+// an action on them.
+func testRouter() *mux.Router {
+	handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	router := mux.NewRouter()
+	api := router.PathPrefix("/api/{group:core}/{version:v2}/").Subrouter()
+	for _, prefix := range []string{"/namespaces/{namespace}/{resource:checks}", "/{resource:checks}"} {
+		api.Handle(prefix, handler).Methods(http.MethodGet).Queries("watch", "true")
+		api.Handle(prefix, handler).Methods(http.MethodGet)
+	}
+	checks := "/namespaces/{namespace}/{resource:checks}"
+	api.Handle(checks, handler).Methods(http.MethodPost)
+	api.Handle(checks+"/{id}", handler).Methods(http.MethodGet)
+	api.Handle(checks+"/{id}", handler).Methods(http.MethodPut)
+	api.Handle(checks+"/{id}", handler).Methods(http.MethodPatch)
+	api.Handle(checks+"/{id}", handler).Methods(http.MethodDelete)
+	api.Handle(checks+"/{id}/execute", handler).Methods(http.MethodPost)
+	router.Handle("/version", handler).Methods(http.MethodGet)
+	return router
+}
+
+func TestGenerate(t *testing.T) {
+	doc, err := Generate(testRouter())
+	require.NoError(t, err)
+
+	operationIDs := map[string]string{}
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			operationIDs[method+" "+path] = op.OperationID
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"get /api/core/v2/namespaces/{namespace}/checks":               "listCheckConfig",
+		"get /api/core/v2/checks":                                      "listCheckConfigAllNamespaces",
+		"post /api/core/v2/namespaces/{namespace}/checks":              "createCheckConfig",
+		"get /api/core/v2/namespaces/{namespace}/checks/{id}":          "getCheckConfig",
+		"put /api/core/v2/namespaces/{namespace}/checks/{id}":          "createOrReplaceCheckConfig",
+		"patch /api/core/v2/namespaces/{namespace}/checks/{id}":        "applyCheckConfig",
+		"delete /api/core/v2/namespaces/{namespace}/checks/{id}":       "deleteCheckConfig",
+		"post /api/core/v2/namespaces/{namespace}/checks/{id}/execute": "postNamespacesChecksExecute",
+		"get /version": "getVersion",
+	}, operationIDs)
+
+	list := doc.Paths["/api/core/v2/namespaces/{namespace}/checks"]["get"]
+	var params []string
+	for _, param := range list.Parameters {
+		params = append(params, param.In+":"+param.Name)
+	}
+	assert.Equal(t, []string{"path:namespace", "query:limit", "query:continue", "query:labelSelector", "query:fieldSelector", "query:fields", "query:watch"}, params)
+	assert.Equal(t, schemaRefPrefix+"CheckConfig", list.Responses["200"].Content["application/json"].Schema.Items.Ref)
+
+	check := doc.Components.Schemas["CheckConfig"]
+	require.NotNil(t, check)
+	assert.Equal(t, schemaRefPrefix+"ObjectMeta", check.Properties["metadata"].Ref)
+	assert.Equal(t, "string", check.Properties["command"].Type)
+	assert.Equal(t, "integer", check.Properties["interval"].Type)
+	assert.Equal(t, "array", check.Properties["check_hooks"].Type)
+
+	// The document can be encoded
+	_, err = json.Marshal(doc)
+	assert.NoError(t, err)
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	router := testRouter()
+	spec := NewSpec(router)
+
+	var match mux.RouteMatch
+	req, _ := http.NewRequest(http.MethodPost, "/api/core/v2/namespaces/default/checks", nil)
+	require.True(t, router.Match(req, &match))
+
+	decode := func(body string) interface{} {
+		var value interface{}
+		decoder := json.NewDecoder(strings.NewReader(body))
+		decoder.UseNumber()
+		require.NoError(t, decoder.Decode(&value))
+		return value
+	}
+
+	tests := []struct {
+		body    string
+		wantErr string
+	}{
+		{body: `{"command": "true", "interval": 10, "metadata": {"name": "check"}, "unknown": 1}`},
+		{body: `{"command": null, "check_hooks": [{"critical": ["hook"]}]}`},
+		{body: `[]`, wantErr: "must be an object, not an array"},
+		{body: `{"command": 1}`, wantErr: "command: must be a string, not a number"},
+		{body: `{"interval": "10"}`, wantErr: "interval: must be an integer, not a string"},
+		{body: `{"interval": 1.5}`, wantErr: "interval: must be an integer, not a number"},
+		{body: `{"interval": -1}`, wantErr: "interval: must be greater than or equal to 0"},
+		{body: `{"metadata": {"labels": {"foo": true}}}`, wantErr: "metadata.labels.foo: must be a string, not a boolean"},
+		{body: `{"check_hooks": [{"critical": [1]}]}`, wantErr: "check_hooks[0].critical[0]: must be a string, not a number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.body, func(t *testing.T) {
+			err := spec.ValidateRequestBody(match.Route, http.MethodPost, decode(tt.body))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+
+	// The operations without a schema are not validated
+	req, _ = http.NewRequest(http.MethodPost, "/api/core/v2/namespaces/default/checks/check/execute", nil)
+	match = mux.RouteMatch{}
+	require.True(t, router.Match(req, &match))
+	assert.NoError(t, spec.ValidateRequestBody(match.Route, http.MethodPost, decode(`[]`)))
+}
@@ -0,0 +1,156 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// Schema is an OpenAPI schema object, restricted to what the Go types of the
+// API need.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+}
+
+// schemaRefPrefix is the prefix of the references to the schemas of the
+// components of a document.
+const schemaRefPrefix = "#/components/schemas/"
+
+var (
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	bytesType      = reflect.TypeOf([]byte{})
+)
+
+// typeSchemas are the schemas of the types whose JSON encoding differs from
+// their Go type.
+var typeSchemas = map[reflect.Type]*Schema{
+	// A hook list is encoded as {"<type>": ["<hook>", ...]}
+	reflect.TypeOf(corev2.HookList{}): {
+		Type:                 "object",
+		AdditionalProperties: &Schema{Type: "array", Items: &Schema{Type: "string"}},
+	},
+}
+
+// fieldSchemas are the schemas of the struct fields whose JSON encoding
+// differs from their Go type, by type and field name.
+var fieldSchemas = map[string]*Schema{
+	"Event.ID": {Type: "string", Format: "uuid"},
+}
+
+// schemaGenerator generates the schemas of Go types from their JSON encoding.
+// The named struct types are added to schemas, and referred to by name.
+type schemaGenerator struct {
+	schemas map[string]*Schema
+}
+
+func (g *schemaGenerator) schema(t reflect.Type) *Schema {
+	if schema, ok := typeSchemas[t]; ok {
+		return schema
+	}
+	if t == rawMessageType {
+		return &Schema{}
+	}
+	if t == bytesType {
+		return &Schema{Type: "string", Format: "byte", Nullable: true}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int64", Minimum: float(0), Maximum: float(math.MaxUint32)}
+	case reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: float(0)}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schema(t.Elem()), Nullable: t.Kind() == reflect.Slice}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		if _, ok := g.schemas[t.Name()]; !ok {
+			// Register the name first, since the type can be recursive
+			g.schemas[t.Name()] = &Schema{}
+			*g.schemas[t.Name()] = *g.structSchema(t)
+		}
+		return &Schema{Ref: schemaRefPrefix + t.Name()}
+	}
+
+	// Channels and functions can't be encoded
+	return &Schema{}
+}
+
+// structSchema returns the schema of a struct type, with the properties of
+// its fields as encoded by encoding/json.
+func (g *schemaGenerator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		// The fields of the embedded structs without a name are inlined
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			embedded := g.structSchema(fieldType)
+			for property, propertySchema := range embedded.Properties {
+				if _, ok := schema.Properties[property]; !ok {
+					schema.Properties[property] = propertySchema
+				}
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		if fieldSchema, ok := fieldSchemas[t.Name()+"."+field.Name]; ok {
+			schema.Properties[name] = fieldSchema
+		} else {
+			schema.Properties[name] = g.schema(field.Type)
+		}
+	}
+	return schema
+}
+
+func float(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,145 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ValidationError is the error of a value not matching its schema.
+type ValidationError struct {
+	// Path is the path of the invalid value, e.g. metadata.name, or empty if
+	// the invalid value is the validated value itself.
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// Validate validates a value decoded by encoding/json, with its numbers
+// decoded as json.Number, against the given schema of the document. Like the
+// decoding of the resources, it accepts null values and unknown properties.
+func (d *Document) Validate(schema *Schema, value interface{}) error {
+	return d.validate(schema, value, "")
+}
+
+func (d *Document) validate(schema *Schema, value interface{}, path string) error {
+	if schema == nil || value == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		resolved, ok := d.Components.Schemas[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+		if !ok {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("unknown schema %s", schema.Ref)}
+		}
+		return d.validate(resolved, value, path)
+	}
+
+	switch schema.Type {
+	case "":
+		return nil
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return typeError(schema, value, path)
+		}
+		for name, property := range object {
+			propertySchema, ok := schema.Properties[name]
+			if !ok {
+				propertySchema = schema.AdditionalProperties
+			}
+			if err := d.validate(propertySchema, property, join(path, name)); err != nil {
+				return err
+			}
+		}
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return typeError(schema, value, path)
+		}
+		for i, item := range array {
+			if err := d.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return typeError(schema, value, path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return typeError(schema, value, path)
+		}
+	case "number":
+		if _, ok := number(value); !ok {
+			return typeError(schema, value, path)
+		}
+	case "integer":
+		n, ok := number(value)
+		if !ok {
+			return typeError(schema, value, path)
+		}
+		f, err := n.Float64()
+		if err != nil || f != math.Trunc(f) {
+			return typeError(schema, value, path)
+		}
+		if schema.Minimum != nil && f < *schema.Minimum {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("must be greater than or equal to %v", *schema.Minimum)}
+		}
+		if schema.Maximum != nil && f > *schema.Maximum {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("must be less than or equal to %v", *schema.Maximum)}
+		}
+	}
+	return nil
+}
+
+func number(value interface{}) (json.Number, bool) {
+	switch n := value.(type) {
+	case json.Number:
+		return n, true
+	case float64:
+		return json.Number(strconv.FormatFloat(n, 'g', -1, 64)), true
+	}
+	return "", false
+}
+
+func typeError(schema *Schema, value interface{}, path string) error {
+	return &ValidationError{Path: path, Message: fmt.Sprintf("must be %s %s, not %s", article(schema.Type), schema.Type, jsonType(value))}
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case json.Number, float64:
+		return "a number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func article(word string) string {
+	if strings.ContainsAny(word[:1], "aeiou") {
+		return "an"
+	}
+	return "a"
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
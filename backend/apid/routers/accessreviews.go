@@ -0,0 +1,121 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// AccessReviewer evaluates the permissions of the users and explains its
+// decisions.
+type AccessReviewer interface {
+	Review(ctx context.Context, attrs *authorization.Attributes) (*corev2.AccessReview, error)
+}
+
+// accessReviewStore is the store of the users whose permissions are
+// evaluated.
+type accessReviewStore interface {
+	GetUser(ctx context.Context, username string) (*corev2.User, error)
+}
+
+// AccessReviewRouter handles the requests for /can-i, which evaluate whether
+// the caller, or another user, is allowed to make a request. Any user can
+// evaluate its own permissions, while evaluating the permissions of another
+// user requires to be allowed to get it.
+type AccessReviewRouter struct {
+	store    accessReviewStore
+	reviewer AccessReviewer
+}
+
+// NewAccessReviewRouter instantiates a new router for the can-i API.
+func NewAccessReviewRouter(store accessReviewStore, reviewer AccessReviewer) *AccessReviewRouter {
+	return &AccessReviewRouter{store: store, reviewer: reviewer}
+}
+
+// Mount the AccessReviewRouter to a parent Router
+func (r *AccessReviewRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/"+corev2.AccessReviewPath, actionHandler(r.review)).Methods(http.MethodGet)
+}
+
+func (r *AccessReviewRouter) review(req *http.Request) (interface{}, error) {
+	query := req.URL.Query()
+	review := &corev2.AccessReview{
+		User:         query.Get("user"),
+		Verb:         query.Get("verb"),
+		Resource:     query.Get("resource"),
+		ResourceName: query.Get("resource_name"),
+		Namespace:    query.Get("namespace"),
+	}
+	if err := review.Validate(); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	caller := &authorization.Attributes{}
+	if err := middlewares.GetUser(req.Context(), caller); err != nil {
+		return nil, actions.NewError(actions.Unauthenticated, err)
+	}
+
+	attrs := &authorization.Attributes{
+		APIGroup:     "core",
+		APIVersion:   "v2",
+		Namespace:    review.Namespace,
+		Resource:     review.Resource,
+		ResourceName: review.ResourceName,
+		Verb:         review.Verb,
+		User:         caller.User,
+	}
+
+	if review.User != "" && review.User != caller.User.Username {
+		user, err := r.user(req.Context(), caller, review.User)
+		if err != nil {
+			return nil, err
+		}
+		if user.Disabled {
+			review.Reason = "the user is disabled"
+			return review, nil
+		}
+		attrs.User = corev2.User{Username: user.Username, Groups: user.Groups}
+	}
+
+	ctx := store.NamespaceContext(req.Context(), review.Namespace)
+	result, err := r.reviewer.Review(ctx, attrs)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return result, nil
+}
+
+// user returns the user of the given name, if the caller is allowed to get
+// it.
+func (r *AccessReviewRouter) user(ctx context.Context, caller *authorization.Attributes, name string) (*corev2.User, error) {
+	attrs := &authorization.Attributes{
+		APIGroup:     "core",
+		APIVersion:   "v2",
+		Resource:     corev2.UsersResource,
+		ResourceName: name,
+		Verb:         "get",
+		User:         caller.User,
+	}
+	review, err := r.reviewer.Review(ctx, attrs)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	if !review.Allowed {
+		return nil, actions.NewErrorf(actions.PermissionDenied)
+	}
+
+	user, err := r.store.GetUser(ctx, name)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	if user == nil {
+		return nil, actions.NewErrorf(actions.NotFound)
+	}
+	return user, nil
+}
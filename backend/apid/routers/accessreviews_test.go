@@ -0,0 +1,112 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// groupReviewer allows the requests of the members of the "admins" group.
+type groupReviewer struct{}
+
+func (groupReviewer) Review(ctx context.Context, attrs *authorization.Attributes) (*corev2.AccessReview, error) {
+	review := &corev2.AccessReview{User: attrs.User.Username, Verb: attrs.Verb, Resource: attrs.Resource}
+	for _, group := range attrs.User.Groups {
+		if group == "admins" {
+			review.Allowed = true
+		}
+	}
+	return review, nil
+}
+
+func TestAccessReviewRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetUser", mock.Anything, "bob").Return(&corev2.User{Username: "bob"}, nil)
+	s.On("GetUser", mock.Anything, "eve").Return(&corev2.User{Username: "eve", Groups: []string{"admins"}, Disabled: true}, nil)
+	s.On("GetUser", mock.Anything, "ghost").Return((*corev2.User)(nil), nil)
+	router := mux.NewRouter()
+	NewAccessReviewRouter(s, groupReviewer{}).Mount(router.PathPrefix(corev2.URLPrefix).Subrouter())
+
+	tests := []struct {
+		name    string
+		caller  []string
+		query   string
+		code    int
+		user    string
+		allowed bool
+	}{
+		{
+			name:    "own permissions",
+			caller:  []string{"admins"},
+			query:   "verb=create&resource=checks&namespace=ops",
+			code:    http.StatusOK,
+			user:    "alice",
+			allowed: true,
+		},
+		{
+			name:    "own permissions without access",
+			query:   "verb=create&resource=checks&namespace=ops",
+			code:    http.StatusOK,
+			user:    "alice",
+			allowed: false,
+		},
+		{
+			name:   "other user permissions",
+			caller: []string{"admins"},
+			query:  "verb=create&resource=checks&user=bob",
+			code:   http.StatusOK,
+			user:   "bob",
+		},
+		{
+			name:   "disabled user permissions",
+			caller: []string{"admins"},
+			query:  "verb=create&resource=checks&user=eve",
+			code:   http.StatusOK,
+			user:   "eve",
+		},
+		{
+			name:  "other user permissions without access",
+			query: "verb=create&resource=checks&user=bob",
+			code:  http.StatusNotFound,
+		},
+		{
+			name:   "missing user",
+			caller: []string{"admins"},
+			query:  "verb=create&resource=checks&user=ghost",
+			code:   http.StatusNotFound,
+		},
+		{
+			name:  "missing verb",
+			query: "resource=checks",
+			code:  http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+"/can-i?"+tt.query, nil)
+			claims := corev2.FixtureClaims("alice", tt.caller)
+			req = req.WithContext(context.WithValue(req.Context(), corev2.ClaimsKey, claims))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			require.Equal(t, tt.code, w.Code, w.Body.String())
+			if tt.code != http.StatusOK {
+				return
+			}
+
+			var review corev2.AccessReview
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&review))
+			assert.Equal(t, tt.user, review.User)
+			assert.Equal(t, tt.allowed, review.Allowed)
+		})
+	}
+}
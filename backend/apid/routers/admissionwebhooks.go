@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// AdmissionWebhooksRouter handles requests for admission webhooks.
+type AdmissionWebhooksRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewAdmissionWebhooksRouter instantiates a new router for admission webhooks.
+func NewAdmissionWebhooksRouter(store store.ResourceStore) *AdmissionWebhooksRouter {
+	return &AdmissionWebhooksRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.AdmissionWebhook{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the AdmissionWebhooksRouter on the given parent Router
+func (r *AdmissionWebhooksRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:admission-webhooks}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.AdmissionWebhookFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
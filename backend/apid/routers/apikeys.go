@@ -70,6 +70,7 @@ func (r *APIKeysRouter) create(w http.ResponseWriter, req *http.Request) {
 	}
 	apikey.Name = key.String()
 	apikey.CreatedAt = time.Now().Unix()
+	apikey.LastUsedAt = 0
 	newBytes, err := json.Marshal(apikey)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -30,9 +30,12 @@ func (r *AssetsRouter) Mount(parent *mux.Router) {
 	}
 
 	routes.Get(r.handlers.GetResource)
+	routes.Watch(r.handlers, corev2.AssetFields)
+	routes.WatchAllNamespaces(r.handlers, "/{resource:assets}", corev2.AssetFields)
 	routes.List(r.handlers.ListResources, corev2.AssetFields)
 	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:assets}", corev2.AssetFields)
 	routes.Post(r.handlers.CreateResource)
 	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
 	routes.Del(r.handlers.DeleteResource)
 }
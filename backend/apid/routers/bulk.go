@@ -0,0 +1,206 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/audit"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// BulkRouter handles requests for /bulk, which create, replace or delete
+// lists of wrapped resources of any type in a single request. Each resource
+// is authorized and processed on its own, and the response holds the result
+// of each resource, so that a failure doesn't abort the rest of the request.
+type BulkRouter struct {
+	store       store.ResourceStore
+	auth        authorization.Authorizer
+	auditLogger audit.Logger
+}
+
+// NewBulkRouter instantiates a new router for bulk operations. The operations
+// on each resource are recorded in the given audit log, if not nil.
+func NewBulkRouter(store store.ResourceStore, auth authorization.Authorizer, auditLogger audit.Logger) *BulkRouter {
+	return &BulkRouter{
+		store:       store,
+		auth:        auth,
+		auditLogger: auditLogger,
+	}
+}
+
+// Mount the BulkRouter to a parent Router
+func (r *BulkRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/bulk", r.handle("create")).Methods(http.MethodPost)
+	parent.HandleFunc("/bulk", r.handle("update")).Methods(http.MethodPut)
+	parent.HandleFunc("/bulk", r.handle("delete")).Methods(http.MethodDelete)
+}
+
+func (r *BulkRouter) handle(verb string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var items []json.RawMessage
+		if err := json.NewDecoder(req.Body).Decode(&items); err != nil {
+			WriteError(w, actions.NewError(actions.InvalidArgument, err))
+			return
+		}
+
+		results := make([]corev2.BulkResult, len(items))
+		for i, item := range items {
+			results[i] = r.process(req, verb, item)
+		}
+		RespondWith(w, req, results)
+	}
+}
+
+// process performs the operation of the given verb on a wrapped resource.
+func (r *BulkRouter) process(req *http.Request, verb string, item json.RawMessage) corev2.BulkResult {
+	var wrapper types.Wrapper
+	if err := json.Unmarshal(item, &wrapper); err != nil {
+		return bulkResult(corev2.BulkResult{}, verb, actions.NewError(actions.InvalidArgument, err))
+	}
+	meta := wrapper.Value.GetObjectMeta()
+	result := corev2.BulkResult{
+		Type:       wrapper.Type,
+		APIVersion: wrapper.APIVersion,
+		Namespace:  meta.Namespace,
+		Name:       meta.Name,
+	}
+
+	if err := bulkSupported(wrapper.Value, verb); err != nil {
+		return bulkResult(result, verb, err)
+	}
+
+	var resourceStore store.ResourceStore = r.store
+	if corev2.DryRunFromContext(req.Context()) {
+		resourceStore = dryRunStore{ResourceStore: r.store}
+	}
+	client := &api.GenericClient{Store: resourceStore, Auth: r.auth}
+	if err := client.SetTypeMeta(wrapper.TypeMeta); err != nil {
+		return bulkResult(result, verb, actions.NewError(actions.InvalidArgument, err))
+	}
+	ctx := store.NamespaceContext(req.Context(), meta.Namespace)
+
+	var err error
+	switch verb {
+	case "delete":
+		err = client.Delete(ctx, meta.Name)
+	default:
+		if err := wrapper.Value.Validate(); err != nil {
+			return bulkResult(result, verb, actions.NewError(actions.InvalidArgument, err))
+		}
+		if claims := jwt.GetClaimsFromContext(ctx); claims != nil {
+			meta.CreatedBy = claims.StandardClaims.Subject
+			wrapper.Value.SetObjectMeta(meta)
+		}
+		if verb == "create" {
+			err = client.Create(ctx, wrapper.Value)
+		} else {
+			err = client.Update(ctx, wrapper.Value)
+		}
+	}
+	result = bulkResult(result, verb, err)
+
+	if r.auditLogger != nil {
+		entry := audit.Entry{
+			Namespace:    meta.Namespace,
+			APIGroup:     client.APIGroup,
+			APIVersion:   client.APIVersion,
+			Resource:     client.Kind.RBACName(),
+			ResourceName: meta.Name,
+			Verb:         verb,
+			Outcome:      audit.OutcomeFromStatus(result.Status),
+			Status:       result.Status,
+		}
+		if claims := jwt.GetClaimsFromContext(ctx); claims != nil {
+			entry.User = claims.StandardClaims.Subject
+		}
+		if err := r.auditLogger.Log(entry); err != nil {
+			logger.WithError(err).Error("could not record request in the audit log")
+		}
+	}
+
+	return result
+}
+
+// dryRunStore is a resource store that validates the resources written to it
+// without persisting them, once the client has authorized the operations.
+type dryRunStore struct {
+	store.ResourceStore
+}
+
+func (s dryRunStore) CreateResource(ctx context.Context, resource corev2.Resource) error {
+	_, err := handlers.DryRun(ctx, s.ResourceStore, resource, true)
+	return err
+}
+
+func (s dryRunStore) CreateOrUpdateResource(ctx context.Context, resource corev2.Resource) error {
+	_, err := handlers.DryRun(ctx, s.ResourceStore, resource, false)
+	return err
+}
+
+func (s dryRunStore) DeleteResource(ctx context.Context, prefix, name string) error {
+	return actions.NewErrorf(actions.InvalidArgument, "dry run is not supported for deletes")
+}
+
+// bulkSupported returns an error if the operation of the given verb on the
+// resource needs more than the resource store, and must therefore be made
+// through its own endpoint.
+func bulkSupported(resource corev2.Resource, verb string) error {
+	var unsupported bool
+	switch resource.(type) {
+	case *corev2.Event, *corev2.User:
+		unsupported = true
+	case *corev2.Entity:
+		// The events of the entity must be deleted along with it
+		unsupported = verb == "delete"
+	}
+	if unsupported {
+		return actions.NewError(actions.InvalidArgument,
+			fmt.Errorf("bulk %s is not supported for %s", verb, resource.RBACName()))
+	}
+	return nil
+}
+
+// bulkResult sets the status of the given result from the error of its
+// operation, as the single requests would.
+func bulkResult(result corev2.BulkResult, verb string, err error) corev2.BulkResult {
+	if err == nil {
+		result.Status = http.StatusCreated
+		if verb == "delete" {
+			result.Status = http.StatusNoContent
+		}
+		return result
+	}
+
+	var actionErr actions.Error
+	switch err := err.(type) {
+	case actions.Error:
+		actionErr = err
+	case *store.ErrAlreadyExists:
+		actionErr = actions.NewErrorf(actions.AlreadyExistsErr)
+	case *store.ErrNotFound:
+		actionErr = actions.NewErrorf(actions.NotFound)
+	case *store.ErrNotValid:
+		actionErr = actions.NewError(actions.InvalidArgument, err)
+	case *store.ErrQuotaExceeded:
+		actionErr = actions.NewError(actions.QuotaExceeded, err)
+	default:
+		if err == authorization.ErrUnauthorized {
+			actionErr = actions.NewErrorf(actions.PermissionDenied)
+		} else {
+			actionErr = actions.NewError(actions.InternalErr, err)
+		}
+	}
+	result.Status = HTTPStatusFromCode(actionErr.Code)
+	result.Error = actionErr.Message
+	return result
+}
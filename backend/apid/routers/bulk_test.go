@@ -0,0 +1,125 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBulkAuthorizer denies the operations on the resources of a given name.
+type mockBulkAuthorizer struct {
+	denied string
+}
+
+func (a mockBulkAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	return attrs.ResourceName != a.denied, nil
+}
+
+func wrappedCheck(name string) string {
+	return `{"type": "CheckConfig", "api_version": "core/v2", "spec": {
+		"metadata": {"name": "` + name + `", "namespace": "default"},
+		"command": "true", "interval": 60, "subscriptions": ["linux"]
+	}}`
+}
+
+func doBulkRequest(t *testing.T, router *mux.Router, method string, items ...string) []corev2.BulkResult {
+	t.Helper()
+	body := "[" + strings.Join(items, ",") + "]"
+	req := httptest.NewRequest(method, "/bulk", strings.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), corev2.ClaimsKey, corev2.FixtureClaims("admin", nil)))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var results []corev2.BulkResult
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &results))
+	return results
+}
+
+func resultStatuses(results []corev2.BulkResult) []int {
+	statuses := make([]int, len(results))
+	for i, result := range results {
+		statuses[i] = result.Status
+	}
+	return statuses
+}
+
+func TestBulkRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+	s.On("CreateResource", mock.Anything, mock.MatchedBy(func(r corev2.Resource) bool {
+		return r.GetObjectMeta().Name == "check-cpu"
+	})).Return(nil)
+	s.On("CreateResource", mock.Anything, mock.Anything).Return(&store.ErrAlreadyExists{})
+	s.On("DeleteResource", mock.Anything, "checks", "check-cpu").Return(nil)
+	s.On("DeleteResource", mock.Anything, "checks", mock.Anything).Return(&store.ErrNotFound{})
+
+	router := mux.NewRouter()
+	NewBulkRouter(s, mockBulkAuthorizer{denied: "check-denied"}, nil).Mount(router)
+
+	results := doBulkRequest(t, router, http.MethodPut,
+		wrappedCheck("check-cpu"),
+		wrappedCheck("check-denied"),
+		wrappedCheck(""),
+		`{"type": "Event", "spec": {"metadata": {"namespace": "default"}}}`,
+		`{"type": "Foo", "spec": {}}`,
+	)
+	assert.Equal(t, []int{201, 404, 400, 400, 400}, resultStatuses(results))
+	assert.Equal(t, "CheckConfig", results[0].Type)
+	assert.Equal(t, "default", results[0].Namespace)
+	assert.Equal(t, "check-cpu", results[0].Name)
+	assert.Empty(t, results[0].Error)
+	assert.NotEmpty(t, results[3].Error)
+
+	results = doBulkRequest(t, router, http.MethodPost, wrappedCheck("check-cpu"), wrappedCheck("check-mem"))
+	assert.Equal(t, []int{201, 409}, resultStatuses(results))
+
+	results = doBulkRequest(t, router, http.MethodDelete, wrappedCheck("check-cpu"), wrappedCheck("check-mem"))
+	assert.Equal(t, []int{204, 404}, resultStatuses(results))
+
+	s.AssertNumberOfCalls(t, "CreateOrUpdateResource", 1)
+}
+
+func TestBulkRouterInvalidBody(t *testing.T) {
+	router := mux.NewRouter()
+	NewBulkRouter(&mockstore.MockStore{}, mockBulkAuthorizer{}, nil).Mount(router)
+
+	req := httptest.NewRequest(http.MethodPut, "/bulk", strings.NewReader(wrappedCheck("check-cpu")))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestBulkRouterDryRun(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "check-cpu", mock.Anything).Return(&store.ErrNotFound{})
+	s.On("GetResource", mock.Anything, "check-mem", mock.Anything).Return(nil)
+
+	router := mux.NewRouter()
+	NewBulkRouter(s, mockBulkAuthorizer{denied: "check-denied"}, nil).Mount(router)
+
+	body := "[" + strings.Join([]string{wrappedCheck("check-cpu"), wrappedCheck("check-mem"), wrappedCheck("check-denied")}, ",") + "]"
+	req := httptest.NewRequest(http.MethodPost, "/bulk", strings.NewReader(body))
+	ctx := context.WithValue(req.Context(), corev2.ClaimsKey, corev2.FixtureClaims("admin", nil))
+	req = req.WithContext(context.WithValue(ctx, corev2.DryRunKey, true))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var results []corev2.BulkResult
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &results))
+	assert.Equal(t, []int{201, 409, 404}, resultStatuses(results))
+	s.AssertNotCalled(t, "CreateResource", mock.Anything, mock.Anything)
+}
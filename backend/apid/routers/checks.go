@@ -49,10 +49,13 @@ func (r *ChecksRouter) Mount(parent *mux.Router) {
 
 	routes.Del(r.handlers.DeleteResource)
 	routes.Get(r.handlers.GetResource)
+	routes.Watch(r.handlers, corev2.CheckConfigFields)
+	routes.WatchAllNamespaces(r.handlers, "/{resource:checks}", corev2.CheckConfigFields)
 	routes.List(r.handlers.ListResources, corev2.CheckConfigFields)
 	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:checks}", corev2.CheckConfigFields)
 	routes.Post(r.handlers.CreateResource)
 	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
 
 	// Custom
 	routes.Path("{id}/hooks/{type}", r.addCheckHook).Methods(http.MethodPut)
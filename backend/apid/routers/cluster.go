@@ -12,10 +12,15 @@ import (
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 )
 
 const defaultTimeout = 3
 
+// defaultDrainJitter is the default maximum delay, in seconds, the agents
+// of a drained backend wait before reconnecting.
+const defaultDrainJitter = 30
+
 // ClusterController represents the controller needs of the ClusterRouter.
 type ClusterController interface {
 	// MemberList lists the current cluster membership.
@@ -32,6 +37,17 @@ type ClusterController interface {
 
 	// ClusterID gets the sensu cluster id.
 	ClusterID(ctx context.Context) (string, error)
+
+	// Drain asks the agents connected to the backend to reconnect to other
+	// backends.
+	Drain(ctx context.Context, jitter time.Duration) (*actions.AgentDrain, error)
+
+	// SessionCounts lists the number of agent sessions of every backend.
+	SessionCounts(ctx context.Context) ([]actions.BackendSessions, error)
+
+	// Rebalance asks every backend to hand off its agent sessions exceeding
+	// its fair share to the other backends.
+	Rebalance(ctx context.Context) error
 }
 
 // ClusterRouter handles requests for /cluster
@@ -53,6 +69,9 @@ func (r *ClusterRouter) Mount(parent *mux.Router) {
 	parent.HandleFunc("/cluster/members/{id}", r.memberRemove).Methods(http.MethodDelete)
 	parent.HandleFunc("/cluster/members/{id}", r.memberUpdate).Methods(http.MethodPut)
 	parent.HandleFunc("/cluster/id", r.clusterID).Methods(http.MethodGet)
+	parent.HandleFunc("/cluster/drain", r.drain).Methods(http.MethodPost)
+	parent.HandleFunc("/cluster/sessions", r.sessionCounts).Methods(http.MethodGet)
+	parent.HandleFunc("/cluster/rebalance", r.rebalance).Methods(http.MethodPost)
 }
 
 func parseID(req *http.Request) (uint64, error) {
@@ -80,6 +99,21 @@ func parseTimeout(req *http.Request) (int, error) {
 	return strconv.Atoi(val)
 }
 
+func parseJitter(req *http.Request) (time.Duration, error) {
+	val := req.FormValue("jitter")
+	if len(val) == 0 {
+		return defaultDrainJitter * time.Second, nil
+	}
+	jitter, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, err
+	}
+	if jitter < 0 {
+		return 0, fmt.Errorf("bad jitter (%d): must not be negative", jitter)
+	}
+	return time.Duration(jitter) * time.Second, nil
+}
+
 func (r *ClusterRouter) list(w http.ResponseWriter, req *http.Request) {
 	timeout, err := parseTimeout(req)
 	if err != nil {
@@ -199,3 +233,56 @@ func (r *ClusterRouter) clusterID(w http.ResponseWriter, req *http.Request) {
 	}
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+func (r *ClusterRouter) drain(w http.ResponseWriter, req *http.Request) {
+	jitter, err := parseJitter(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp, err := r.controller.Drain(req.Context(), jitter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (r *ClusterRouter) sessionCounts(w http.ResponseWriter, req *http.Request) {
+	timeout, err := parseTimeout(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := req.Context()
+	if timeout > 0 {
+		tctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+		ctx = tctx
+	}
+	resp, err := r.controller.SessionCounts(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (r *ClusterRouter) rebalance(w http.ResponseWriter, req *http.Request) {
+	timeout, err := parseTimeout(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := req.Context()
+	if timeout > 0 {
+		tctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+		ctx = tctx
+	}
+	if err := r.controller.Rebalance(ctx); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -43,6 +45,21 @@ func (m *mockClusterController) ClusterID(ctx context.Context) (string, error) {
 	return args.Get(0).(string), args.Error(1)
 }
 
+func (m *mockClusterController) Drain(ctx context.Context, jitter time.Duration) (*actions.AgentDrain, error) {
+	args := m.Called(ctx, jitter)
+	return args.Get(0).(*actions.AgentDrain), args.Error(1)
+}
+
+func (m *mockClusterController) SessionCounts(ctx context.Context) ([]actions.BackendSessions, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]actions.BackendSessions), args.Error(1)
+}
+
+func (m *mockClusterController) Rebalance(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 func newClusterTest(t *testing.T) (*mockClusterController, *httptest.Server) {
 	controller := &mockClusterController{}
 	clusterRouter := NewClusterRouter(controller)
@@ -267,3 +284,98 @@ func TestGetClusterID(t *testing.T) {
 
 	controller.AssertCalled(t, "ClusterID", mock.Anything)
 }
+
+func TestClusterRouterDrain(t *testing.T) {
+	controller, server := newClusterTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	controller.On("Drain", mock.Anything, time.Minute).Return(&actions.AgentDrain{Sessions: 42}, nil)
+	endpoint := "/cluster/drain?jitter=60"
+	req := newRequest(t, http.MethodPost, server.URL+endpoint, nil)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode >= 400 {
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+	if got, want := string(body), "{\"sessions\":42}\n"; got != want {
+		t.Fatalf("bad body: got %q, want %q", got, want)
+	}
+
+	controller.AssertCalled(t, "Drain", mock.Anything, time.Minute)
+}
+
+func TestClusterRouterDrainBadRequest(t *testing.T) {
+	_, server := newClusterTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	endpoint := "/cluster/drain?jitter=-1"
+	req := newRequest(t, http.MethodPost, server.URL+endpoint, nil)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != 400 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status (want 400): %d (%q)", resp.StatusCode, string(body))
+	}
+}
+
+func TestClusterRouterSessionCounts(t *testing.T) {
+	controller, server := newClusterTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	counts := []actions.BackendSessions{{Backend: "a", Sessions: 1}, {Backend: "b", Sessions: 2}}
+	controller.On("SessionCounts", mock.Anything).Return(counts, nil)
+	endpoint := "/cluster/sessions"
+	req := newRequest(t, http.MethodGet, server.URL+endpoint, nil)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode >= 400 {
+		t.Fatalf("bad status: %d (%q)", resp.StatusCode, string(body))
+	}
+	want := "[{\"backend\":\"a\",\"sessions\":1},{\"backend\":\"b\",\"sessions\":2}]\n"
+	if got := string(body); got != want {
+		t.Fatalf("bad body: got %q, want %q", got, want)
+	}
+}
+
+func TestClusterRouterRebalance(t *testing.T) {
+	controller, server := newClusterTest(t)
+	defer server.Close()
+
+	client := new(http.Client)
+
+	controller.On("Rebalance", mock.Anything).Return(nil)
+	endpoint := "/cluster/rebalance"
+	req := newRequest(t, http.MethodPost, server.URL+endpoint, nil)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := ioutil.ReadAll(resp.Body)
+		t.Fatalf("bad status (want 204): %d (%q)", resp.StatusCode, string(body))
+	}
+
+	controller.AssertCalled(t, "Rebalance", mock.Anything)
+}
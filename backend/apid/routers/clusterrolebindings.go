@@ -34,4 +34,5 @@ func (r *ClusterRoleBindingsRouter) Mount(parent *mux.Router) {
 	routes.List(r.handlers.ListResources, corev2.ClusterRoleBindingFields)
 	routes.Post(r.handlers.CreateResource)
 	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
 }
@@ -34,4 +34,5 @@ func (r *ClusterRolesRouter) Mount(parent *mux.Router) {
 	routes.List(r.handlers.ListResources, corev2.ClusterRoleFields)
 	routes.Post(r.handlers.CreateResource)
 	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
 }
@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// CorrelationsRouter handles requests for /correlations
+type CorrelationsRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewCorrelationsRouter instantiates new router for controlling correlation resources
+func NewCorrelationsRouter(store store.ResourceStore) *CorrelationsRouter {
+	return &CorrelationsRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.Correlation{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the CorrelationsRouter to a parent Router
+func (r *CorrelationsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:correlations}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.CorrelationFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:correlations}", corev2.CorrelationFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
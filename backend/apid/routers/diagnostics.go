@@ -0,0 +1,68 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/diagnostics"
+)
+
+// defaultCPUProfileSeconds is the default duration of the CPU profile of the
+// diagnostics bundles.
+const defaultCPUProfileSeconds = 5
+
+// DiagnosticsCollector collects the diagnostics bundles of the backend.
+type DiagnosticsCollector interface {
+	WriteBundle(ctx context.Context, w io.Writer, cpuProfileDuration time.Duration) error
+}
+
+// DiagnosticsRouter handles requests for /diagnostics.
+type DiagnosticsRouter struct {
+	collector DiagnosticsCollector
+}
+
+// NewDiagnosticsRouter instantiates a new router serving the diagnostics
+// bundles of the backend.
+func NewDiagnosticsRouter(collector DiagnosticsCollector) *DiagnosticsRouter {
+	return &DiagnosticsRouter{collector: collector}
+}
+
+// Mount the DiagnosticsRouter on the given parent Router
+func (r *DiagnosticsRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/{resource:diagnostics}", r.bundle).Methods(http.MethodGet)
+}
+
+// bundle responds with a gzipped tarball of the diagnostics of the backend
+// serving the request, with a CPU profile of the number of seconds of the
+// cpuProfileSeconds query parameter.
+func (r *DiagnosticsRouter) bundle(w http.ResponseWriter, req *http.Request) {
+	seconds := defaultCPUProfileSeconds
+	if value := req.URL.Query().Get("cpuProfileSeconds"); value != "" {
+		var err error
+		seconds, err = strconv.Atoi(value)
+		max := int(diagnostics.MaxCPUProfileDuration / time.Second)
+		if err != nil || seconds < 0 || seconds > max {
+			WriteError(w, actions.NewError(actions.InvalidArgument, fmt.Errorf("cpuProfileSeconds must be between 0 and %d", max)))
+			return
+		}
+	}
+
+	// The bundle is buffered so that the errors can still be reported
+	var buf bytes.Buffer
+	if err := r.collector.WriteBundle(req.Context(), &buf, time.Duration(seconds)*time.Second); err != nil {
+		WriteError(w, actions.NewError(actions.InternalErr, err))
+		return
+	}
+	filename := fmt.Sprintf("sensu-backend-diagnostics-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	_, _ = buf.WriteTo(w)
+}
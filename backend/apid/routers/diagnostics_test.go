@@ -0,0 +1,103 @@
+package routers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockDiagnosticsCollector struct {
+	mock.Mock
+}
+
+func (m *mockDiagnosticsCollector) WriteBundle(ctx context.Context, w io.Writer, d time.Duration) error {
+	args := m.Called(ctx, w, d)
+	_, _ = w.Write([]byte(args.String(0)))
+	return args.Error(1)
+}
+
+func newDiagnosticsTest(t *testing.T) (*mockDiagnosticsCollector, *httptest.Server) {
+	collector := &mockDiagnosticsCollector{}
+	router := mux.NewRouter()
+	NewDiagnosticsRouter(collector).Mount(router)
+	return collector, httptest.NewServer(router)
+}
+
+func TestGetDiagnostics(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		duration   time.Duration
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "default cpu profile",
+			duration:   defaultCPUProfileSeconds * time.Second,
+			wantStatus: http.StatusOK,
+			wantBody:   "bundle",
+		},
+		{
+			name:       "no cpu profile",
+			query:      "?cpuProfileSeconds=0",
+			wantStatus: http.StatusOK,
+			wantBody:   "bundle",
+		},
+		{
+			name:       "cpu profile too long",
+			query:      "?cpuProfileSeconds=11",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid cpu profile",
+			query:      "?cpuProfileSeconds=a",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "collector error",
+			duration:   defaultCPUProfileSeconds * time.Second,
+			err:        errors.New("error"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector, server := newDiagnosticsTest(t)
+			defer server.Close()
+			collector.On("WriteBundle", mock.Anything, mock.Anything, tt.duration).Return("bundle", tt.err)
+
+			req := newRequest(t, http.MethodGet, server.URL+"/diagnostics"+tt.query, nil)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("bad status: got %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got, want := resp.Header.Get("Content-Type"), "application/gzip"; got != want {
+				t.Errorf("bad content type: got %q, want %q", got, want)
+			}
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(body); got != tt.wantBody {
+				t.Errorf("bad body: got %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
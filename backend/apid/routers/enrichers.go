@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// EnrichersRouter handles requests for /enrichers
+type EnrichersRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewEnrichersRouter instantiates new router for controlling enricher resources
+func NewEnrichersRouter(store store.ResourceStore) *EnrichersRouter {
+	return &EnrichersRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.Enricher{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the EnrichersRouter to a parent Router
+func (r *EnrichersRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:enrichers}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.EnricherFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:enrichers}", corev2.EnricherFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
@@ -41,8 +41,11 @@ func (r *EntitiesRouter) Mount(parent *mux.Router) {
 
 	routes.Del(deleter.Delete)
 	routes.Get(r.handlers.GetResource)
+	routes.Watch(r.handlers, corev2.EntityFields)
+	routes.WatchAllNamespaces(r.handlers, "/{resource:entities}", corev2.EntityFields)
 	routes.List(r.handlers.ListResources, corev2.EntityFields)
 	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:entities}", corev2.EntityFields)
 	routes.Post(r.handlers.CreateResource)
 	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
 }
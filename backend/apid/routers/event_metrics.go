@@ -0,0 +1,54 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/metricsbuffer"
+)
+
+// MetricsSeriesLister represents the metrics series needs of the
+// EventMetricsRouter.
+type MetricsSeriesLister interface {
+	Series(namespace, entity, check string) []*metricsbuffer.Series
+}
+
+// EventMetricsRouter handles requests for the downsampled metrics of events.
+type EventMetricsRouter struct {
+	series MetricsSeriesLister
+}
+
+// NewEventMetricsRouter instantiates a new router for the downsampled metrics
+// of events.
+func NewEventMetricsRouter(series MetricsSeriesLister) *EventMetricsRouter {
+	return &EventMetricsRouter{
+		series: series,
+	}
+}
+
+// Mount the EventMetricsRouter to a parent Router
+func (r *EventMetricsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:events}",
+	}
+
+	routes.Path("{entity}/{check}/metrics", r.list).Methods(http.MethodGet)
+}
+
+func (r *EventMetricsRouter) list(req *http.Request) (interface{}, error) {
+	vars := mux.Vars(req)
+	entity, err := url.PathUnescape(vars["entity"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	check, err := url.PathUnescape(vars["check"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	namespace := corev2.ContextNamespace(req.Context())
+	return r.series.Series(namespace, entity, check), nil
+}
@@ -0,0 +1,43 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/metricsbuffer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetricsSeriesLister struct {
+	entity, check string
+}
+
+func (m *mockMetricsSeriesLister) Series(namespace, entity, check string) []*metricsbuffer.Series {
+	m.entity, m.check = entity, check
+	return []*metricsbuffer.Series{{Entity: entity, Check: check, Name: "cpu"}}
+}
+
+func TestEventMetricsRouter(t *testing.T) {
+	lister := &mockMetricsSeriesLister{}
+	router := mux.NewRouter().UseEncodedPath()
+	NewEventMetricsRouter(lister).Mount(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	req := newRequest(t, http.MethodGet, server.URL+"/namespaces/default/events/entity%2F1/check1/metrics", nil)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var series []metricsbuffer.Series
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&series))
+	require.Len(t, series, 1)
+	assert.Equal(t, "cpu", series[0].Name)
+	assert.Equal(t, "entity/1", lister.entity)
+	assert.Equal(t, "check1", lister.check)
+}
@@ -0,0 +1,55 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// EventHistoryRouter handles requests for /events/{entity}/{check}/history
+type EventHistoryRouter struct {
+	store store.EventHistoryStore
+}
+
+// NewEventHistoryRouter instantiates new router for reading the history of
+// the events.
+func NewEventHistoryRouter(store store.EventHistoryStore) *EventHistoryRouter {
+	return &EventHistoryRouter{store: store}
+}
+
+// Mount the EventHistoryRouter to a parent Router
+func (r *EventHistoryRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:events}",
+	}
+
+	routes.Path("{entity}/{check}/{subresource:history}", r.list).Methods(http.MethodGet)
+}
+
+// list returns the occurrences of an event retained in its history, from the
+// oldest to the most recent.
+func (r *EventHistoryRouter) list(req *http.Request) (interface{}, error) {
+	vars := mux.Vars(req)
+	entity, err := url.PathUnescape(vars["entity"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	check, err := url.PathUnescape(vars["check"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	history, err := r.store.GetEventHistory(req.Context(), entity, check)
+	if err != nil {
+		switch err := err.(type) {
+		case *store.ErrNotValid:
+			return nil, actions.NewError(actions.InvalidArgument, err)
+		default:
+			return nil, actions.NewError(actions.InternalErr, err)
+		}
+	}
+	return history, nil
+}
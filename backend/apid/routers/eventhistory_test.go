@@ -0,0 +1,42 @@
+package routers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHistoryRouter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewEventHistoryRouter(s)
+	parentRouter := mux.NewRouter().UseEncodedPath()
+	router.Mount(parentRouter)
+
+	occurrence := &corev2.Event{Timestamp: 42}
+	s.On("GetEventHistory", mock.Anything, "foo/1", "check_cpu").Return([]*corev2.Event{occurrence}, nil)
+	s.On("GetEventHistory", mock.Anything, "bar", "check_cpu").Return([]*corev2.Event(nil), errors.New("error"))
+
+	req := httptest.NewRequest(http.MethodGet, "/namespaces/default/events/foo%2F1/check_cpu/history", nil)
+	w := httptest.NewRecorder()
+	parentRouter.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var history []*corev2.Event
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&history))
+	require.Len(t, history, 1)
+	assert.Equal(t, int64(42), history[0].Timestamp)
+
+	req = httptest.NewRequest(http.MethodGet, "/namespaces/default/events/bar/check_cpu/history", nil)
+	w = httptest.NewRecorder()
+	parentRouter.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,40 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// EventRetentionPoliciesRouter handles requests for /event-retention-policies
+type EventRetentionPoliciesRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewEventRetentionPoliciesRouter instantiates new router for controlling
+// event retention policy resources.
+func NewEventRetentionPoliciesRouter(store store.ResourceStore) *EventRetentionPoliciesRouter {
+	return &EventRetentionPoliciesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.EventRetentionPolicy{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the EventRetentionPoliciesRouter to a parent Router
+func (r *EventRetentionPoliciesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:event-retention-policies}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.EventRetentionPolicyFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:event-retention-policies}", corev2.EventRetentionPolicyFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
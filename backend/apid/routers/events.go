@@ -17,6 +17,8 @@ import (
 // EventsRouter handles requests for /events
 type EventsRouter struct {
 	controller eventController
+	store      store.EventStore
+	quota      eventQuota
 }
 
 // eventController represents the controller needs of the EventsRouter.
@@ -27,10 +29,20 @@ type eventController interface {
 	List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
 }
 
-// NewEventsRouter instantiates new events controller
-func NewEventsRouter(store store.EventStore, bus messaging.MessageBus) *EventsRouter {
+// eventQuota represents the resource quotas enforcement needs of the
+// EventsRouter.
+type eventQuota interface {
+	EnforceEvent(ctx context.Context, event *corev2.Event) error
+}
+
+// NewEventsRouter instantiates new events controller. The new events are
+// rejected if they exceed a resource quota of their namespace, unless quota
+// is nil.
+func NewEventsRouter(store store.EventStore, bus messaging.MessageBus, quota eventQuota) *EventsRouter {
 	return &EventsRouter{
 		controller: actions.NewEventController(store, bus),
+		store:      store,
+		quota:      quota,
 	}
 }
 
@@ -41,6 +53,10 @@ func (r *EventsRouter) Mount(parent *mux.Router) {
 		PathPrefix: "/namespaces/{namespace}/{resource:events}",
 	}
 
+	// Stream the events to the clients accepting server-sent events
+	parent.HandleFunc(routes.PathPrefix, r.stream).Methods(http.MethodGet).MatcherFunc(acceptsEventStream)
+	parent.HandleFunc("/{resource:events}", r.stream).Methods(http.MethodGet).MatcherFunc(acceptsEventStream)
+
 	routes.Post(r.create)
 	routes.List(r.controller.List, corev2.EventFields)
 	routes.ListAllNamespaces(r.controller.List, "/{resource:events}", corev2.EventFields)
@@ -70,6 +86,10 @@ func (r *EventsRouter) delete(req *http.Request) (interface{}, error) {
 }
 
 func (r *EventsRouter) create(req *http.Request) (interface{}, error) {
+	if err := unsupportedDryRun(req); err != nil {
+		return nil, err
+	}
+
 	event := &corev2.Event{}
 	if err := UnmarshalBody(req, event); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -79,12 +99,19 @@ func (r *EventsRouter) create(req *http.Request) (interface{}, error) {
 	if err := validateEventPayload(event, vars); err != nil {
 		return nil, err
 	}
+	if err := r.enforceQuota(req.Context(), event); err != nil {
+		return nil, err
+	}
 
 	err := r.controller.CreateOrReplace(req.Context(), event)
 	return nil, err
 }
 
 func (r *EventsRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	if err := unsupportedDryRun(req); err != nil {
+		return nil, err
+	}
+
 	event := &corev2.Event{}
 	if err := UnmarshalBody(req, event); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -94,11 +121,30 @@ func (r *EventsRouter) createOrReplace(req *http.Request) (interface{}, error) {
 	if err := validateEventPayload(event, vars); err != nil {
 		return nil, err
 	}
+	if err := r.enforceQuota(req.Context(), event); err != nil {
+		return nil, err
+	}
 
 	err := r.controller.CreateOrReplace(req.Context(), event)
 	return nil, err
 }
 
+// enforceQuota returns an error if the given event exceeds a resource quota of
+// its namespace.
+func (r *EventsRouter) enforceQuota(ctx context.Context, event *corev2.Event) error {
+	if r.quota == nil {
+		return nil
+	}
+	switch err := r.quota.EnforceEvent(ctx, event).(type) {
+	case nil:
+		return nil
+	case *store.ErrQuotaExceeded:
+		return actions.NewError(actions.QuotaExceeded, err)
+	default:
+		return actions.NewError(actions.InternalErr, err)
+	}
+}
+
 // validateEventPayload validates the event payload against the URL path values
 func validateEventPayload(event *corev2.Event, vars map[string]string) error {
 	if event.Entity != nil {
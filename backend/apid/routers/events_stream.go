@@ -0,0 +1,160 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// eventStreamContentType is the content type of server-sent events
+	eventStreamContentType = "text/event-stream"
+
+	// eventStreamDuration is the duration of an event stream. It ends before
+	// the write timeout of apid, and the clients reconnect with the ID of the
+	// last event they received to resume it without missing any event.
+	eventStreamDuration = 12 * time.Second
+
+	// eventStreamRetry is the time the clients wait before reconnecting
+	eventStreamRetry = 500 * time.Millisecond
+
+	// eventStreamMissed is the type of the server-sent event notifying the
+	// client that some events were missed, for example if the revision it
+	// resumes from was compacted.
+	eventStreamMissed = "missed"
+)
+
+// acceptsEventStream matches the requests accepting server-sent events.
+func acceptsEventStream(r *http.Request, m *mux.RouteMatch) bool {
+	return strings.Contains(r.Header.Get("Accept"), eventStreamContentType)
+}
+
+// stream streams the events created, updated and deleted as server-sent
+// events, filtered by the labelSelector and fieldSelector query parameters.
+// The labels of an event are the ones of its entity, its check, and its own.
+// The ID of each server-sent event is the store revision of the change, so
+// the clients can resume a stream with the Last-Event-ID header.
+func (r *EventsRouter) stream(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	labelSelector, err := selector.Parse(query.Get("labelSelector"))
+	if err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+	fieldSelector, err := selector.Parse(query.Get("fieldSelector"))
+	if err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+
+	var revision int64
+	if id := req.Header.Get("Last-Event-ID"); id != "" {
+		revision, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			WriteError(w, actions.NewError(actions.InvalidArgument, errors.New("invalid Last-Event-ID")))
+			return
+		}
+		// Resume after the last event received
+		revision++
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), eventStreamDuration)
+	defer cancel()
+
+	flusher, ok := w.(http.Flusher)
+	watcher, canWatch := r.store.(store.EventWatcher)
+	if !ok || !canWatch {
+		http.Error(w, "event streaming is not supported", http.StatusNotImplemented)
+		return
+	}
+	events := watcher.GetEventWatcher(ctx, revision)
+	if events == nil {
+		http.Error(w, "event streaming is not supported", http.StatusNotImplemented)
+		return
+	}
+
+	w.Header().Set("Content-Type", eventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", eventStreamRetry/time.Millisecond)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case watchEvent, ok := <-events:
+			if !ok {
+				return
+			}
+			if !matchesEventSelectors(watchEvent, labelSelector, fieldSelector) {
+				continue
+			}
+			if err := writeStreamEvent(w, watchEvent); err != nil {
+				logger.WithError(err).Error("failed to write event stream")
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func matchesEventSelectors(watchEvent store.WatchEventEvent, labelSelector, fieldSelector *selector.Selector) bool {
+	event := watchEvent.Event
+	if event == nil {
+		// Always notify the clients of missed events
+		return true
+	}
+	if !labelSelector.Empty() && !labelSelector.Matches(eventLabels(event)) {
+		return false
+	}
+	if !fieldSelector.Empty() && (!event.HasCheck() || !fieldSelector.Matches(corev2.EventFields(event))) {
+		return false
+	}
+	return true
+}
+
+// eventLabels returns the labels of the entity, the check and the event, which
+// take precedence in that order.
+func eventLabels(event *corev2.Event) map[string]string {
+	labels := make(map[string]string)
+	if event.Entity != nil {
+		for k, v := range event.Entity.Labels {
+			labels[k] = v
+		}
+	}
+	if event.Check != nil {
+		for k, v := range event.Check.Labels {
+			labels[k] = v
+		}
+	}
+	for k, v := range event.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+func writeStreamEvent(w http.ResponseWriter, watchEvent store.WatchEventEvent) error {
+	if watchEvent.Action == store.WatchError {
+		_, err := fmt.Fprintf(w, "event: %s\ndata: {}\n\n", eventStreamMissed)
+		return err
+	}
+	data, err := json.Marshal(watchEvent.Event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n",
+		watchEvent.Revision, strings.ToLower(watchEvent.Action.String()), data)
+	return err
+}
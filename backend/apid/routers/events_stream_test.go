@@ -0,0 +1,97 @@
+package routers
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEventWatcher struct {
+	store.EventStore
+	events   []store.WatchEventEvent
+	revision int64
+}
+
+func (m *mockEventWatcher) GetEventWatcher(ctx context.Context, revision int64) <-chan store.WatchEventEvent {
+	m.revision = revision
+	ch := make(chan store.WatchEventEvent, len(m.events))
+	for _, event := range m.events {
+		ch <- event
+	}
+	close(ch)
+	return ch
+}
+
+func TestEventsRouterStream(t *testing.T) {
+	web := corev2.FixtureEvent("web", "check-cpu")
+	web.Entity.Labels = map[string]string{"app": "web"}
+	db := corev2.FixtureEvent("db", "check-cpu")
+	db.Entity.Labels = map[string]string{"app": "db"}
+
+	watcher := &mockEventWatcher{events: []store.WatchEventEvent{
+		{Action: store.WatchCreate, Event: web, Revision: 5},
+		{Action: store.WatchCreate, Event: db, Revision: 6},
+		{Action: store.WatchError},
+		{Action: store.WatchDelete, Event: web, Revision: 8},
+	}}
+	router := &EventsRouter{controller: &mockEventController{}, store: watcher}
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+corev2.URLPrefix+"/namespaces/default/events?labelSelector=app%20%3D%3D%20web", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Last-Event-ID", "4")
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), watcher.revision)
+
+	messages := strings.Split(strings.TrimSpace(string(body)), "\n\n")
+	require.Len(t, messages, 4)
+	assert.Equal(t, "retry: 500", messages[0])
+	assert.True(t, strings.HasPrefix(messages[1], "id: 5\nevent: create\ndata: {"), messages[1])
+	assert.Contains(t, messages[1], `"name":"web"`)
+	assert.Equal(t, "event: missed\ndata: {}", messages[2])
+	assert.True(t, strings.HasPrefix(messages[3], "id: 8\nevent: delete\ndata: {"), messages[3])
+}
+
+func TestEventsRouterStreamErrors(t *testing.T) {
+	router := &EventsRouter{controller: &mockEventController{}, store: &mockEventWatcher{}}
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	for _, url := range []string{"/events?labelSelector=app", "/events?fieldSelector=a%20in%20b"} {
+		req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+url, nil)
+		req.Header.Set("Accept", "text/event-stream")
+		w := httptest.NewRecorder()
+		parentRouter.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, url)
+	}
+
+	// The event store cannot watch the events
+	router = &EventsRouter{controller: &mockEventController{}, store: struct{ store.EventStore }{}}
+	parentRouter = mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+	req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+"/events", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	w := httptest.NewRecorder()
+	parentRouter.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+}
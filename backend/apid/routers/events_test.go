@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -412,3 +413,35 @@ func TestEventsRouter(t *testing.T) {
 		})
 	}
 }
+
+// eventQuotaFunc enforces the resource quotas with a function.
+type eventQuotaFunc func(*corev2.Event) error
+
+func (f eventQuotaFunc) EnforceEvent(ctx context.Context, event *corev2.Event) error {
+	return f(event)
+}
+
+func TestEventsRouterQuota(t *testing.T) {
+	controller := &mockEventController{}
+	controller.On("CreateOrReplace", mock.Anything, mock.Anything).Return(nil)
+	quota := eventQuotaFunc(func(event *corev2.Event) error {
+		if event.Check.Name == "new" {
+			return &store.ErrQuotaExceeded{Quota: "quota", Namespace: "default", Resource: "events", Limit: 1}
+		}
+		return nil
+	})
+	router := EventsRouter{controller: controller, quota: quota}
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	for check, wantStatusCode := range map[string]int{"existing": http.StatusCreated, "new": http.StatusForbidden} {
+		body := `{"entity":{"metadata":{"name":"foo","namespace":"default"}},"check":{"metadata":{"name":"` + check + `"},"interval":60}}`
+		req := httptest.NewRequest(http.MethodPut, "/api/core/v2/namespaces/default/events/foo/"+check, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		parentRouter.ServeHTTP(w, req)
+		if w.Code != wantStatusCode {
+			t.Errorf("%s: StatusCode = %v, wantStatusCode %v", check, w.Code, wantStatusCode)
+		}
+	}
+	controller.AssertNumberOfCalls(t, "CreateOrReplace", 1)
+}
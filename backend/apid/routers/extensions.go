@@ -35,4 +35,5 @@ func (r *ExtensionsRouter) Mount(parent *mux.Router) {
 	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:extensions}", corev2.ExtensionFields)
 	routes.Post(r.handlers.CreateResource)
 	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
 }
@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// FederatedClustersRouter handles requests for federated clusters.
+type FederatedClustersRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewFederatedClustersRouter instantiates a new router for federated clusters.
+func NewFederatedClustersRouter(store store.ResourceStore) *FederatedClustersRouter {
+	return &FederatedClustersRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.FederatedCluster{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the FederatedClustersRouter on the given parent Router
+func (r *FederatedClustersRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:federated-clusters}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.FederatedClusterFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
@@ -0,0 +1,96 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// FederationRouter handles requests for /federated, which list the events and
+// entities of the cluster along with the events and entities of its federated
+// clusters, labeled with the name of their cluster. The names of the federated
+// clusters that could not be reached are listed in the
+// Sensu-Federation-Unavailable header.
+type FederationRouter struct {
+	client federationClient
+}
+
+// federationClient represents the client needs of the FederationRouter.
+type federationClient interface {
+	ListFederatedEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, []string, error)
+	ListFederatedEntities(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Entity, []string, error)
+}
+
+// NewFederationRouter instantiates a new router for the federated events and
+// entities.
+func NewFederationRouter(client federationClient) *FederationRouter {
+	return &FederationRouter{
+		client: client,
+	}
+}
+
+// Mount the FederationRouter to a parent Router
+func (r *FederationRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/federated/namespaces/{namespace}/{resource:events}", r.listEvents).Methods(http.MethodGet)
+	parent.HandleFunc("/federated/{resource:events}", r.listEvents).Methods(http.MethodGet)
+	parent.HandleFunc("/federated/namespaces/{namespace}/{resource:entities}", r.listEntities).Methods(http.MethodGet)
+	parent.HandleFunc("/federated/{resource:entities}", r.listEntities).Methods(http.MethodGet)
+}
+
+func (r *FederationRouter) listEvents(w http.ResponseWriter, req *http.Request) {
+	list := func(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+		events, unavailable, err := r.client.ListFederatedEvents(ctx, pred)
+		if err != nil {
+			return nil, federationError(err)
+		}
+		setUnavailable(w, unavailable)
+		resources := make([]corev2.Resource, len(events))
+		for i := range events {
+			resources[i] = events[i]
+		}
+		return resources, nil
+	}
+	List(list, corev2.EventFields).ServeHTTP(w, req)
+}
+
+func (r *FederationRouter) listEntities(w http.ResponseWriter, req *http.Request) {
+	list := func(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+		entities, unavailable, err := r.client.ListFederatedEntities(ctx, pred)
+		if err != nil {
+			return nil, federationError(err)
+		}
+		setUnavailable(w, unavailable)
+		resources := make([]corev2.Resource, len(entities))
+		for i := range entities {
+			resources[i] = entities[i]
+		}
+		return resources, nil
+	}
+	List(list, corev2.EntityFields).ServeHTTP(w, req)
+}
+
+// setUnavailable lists the federated clusters that could not be reached in
+// the response headers.
+func setUnavailable(w http.ResponseWriter, unavailable []string) {
+	if len(unavailable) > 0 {
+		w.Header().Set(corev2.FederationUnavailableHeader, strings.Join(unavailable, ","))
+	}
+}
+
+func federationError(err error) error {
+	switch err {
+	case authorization.ErrUnauthorized:
+		return actions.NewErrorf(actions.PermissionDenied)
+	case api.ErrInvalidContinueToken:
+		return actions.NewError(actions.InvalidArgument, err)
+	default:
+		return actions.NewError(actions.InternalErr, err)
+	}
+}
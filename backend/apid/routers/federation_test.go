@@ -0,0 +1,62 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFederationClient struct {
+	err error
+}
+
+func (m *mockFederationClient) ListFederatedEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, []string, error) {
+	local := corev2.FixtureEvent("entity", "check")
+	local.Labels = map[string]string{corev2.FederatedClusterLabel: corev2.FederatedClusterLocal}
+	remote := corev2.FixtureEvent("entity", "check")
+	remote.Labels = map[string]string{corev2.FederatedClusterLabel: "eu"}
+	return []*corev2.Event{local, remote}, []string{"us"}, m.err
+}
+
+func (m *mockFederationClient) ListFederatedEntities(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Entity, []string, error) {
+	return []*corev2.Entity{corev2.FixtureEntity("entity")}, nil, m.err
+}
+
+func TestFederationRouter(t *testing.T) {
+	client := &mockFederationClient{}
+	router := mux.NewRouter()
+	NewFederationRouter(client).Mount(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/federated/namespaces/default/events?labelSelector=sensu.io/cluster%20%3D%3D%20eu")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "us", resp.Header.Get(corev2.FederationUnavailableHeader))
+	var events []*corev2.Event
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&events))
+	require.Len(t, events, 1)
+	assert.Equal(t, "eu", events[0].Labels[corev2.FederatedClusterLabel])
+
+	resp, err = http.Get(server.URL + "/federated/entities")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get(corev2.FederationUnavailableHeader))
+
+	client.err = api.ErrInvalidContinueToken
+	resp, err = http.Get(server.URL + "/federated/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,91 @@
+package routers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// fieldsQueryParam is the query parameter with which clients request only
+// some fields of the resources, as a comma-separated list of dot-separated
+// paths of JSON attributes, e.g. ?fields=metadata.name,check.status
+const fieldsQueryParam = "fields"
+
+// fieldSelection is a tree of the JSON attributes selected. The nested
+// attributes of an attribute are all selected if its selection is empty.
+type fieldSelection map[string]fieldSelection
+
+// parseFieldSelection returns the selection of the fields query parameter of
+// the request, or nil if the request does not select fields.
+func parseFieldSelection(r *http.Request) (fieldSelection, error) {
+	if r.Method != http.MethodGet {
+		return nil, nil
+	}
+	values, ok := r.URL.Query()[fieldsQueryParam]
+	if !ok {
+		return nil, nil
+	}
+
+	selection := fieldSelection{}
+	for _, value := range values {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			node := selection
+			for _, name := range strings.Split(field, ".") {
+				if name == "" {
+					return nil, fmt.Errorf("invalid field %q", field)
+				}
+				child, ok := node[name]
+				if !ok {
+					child = fieldSelection{}
+					node[name] = child
+				}
+				node = child
+			}
+		}
+	}
+	if len(selection) == 0 {
+		return nil, fmt.Errorf("invalid %s query parameter: no field selected", fieldsQueryParam)
+	}
+	return selection, nil
+}
+
+// selectFields returns the JSON document of the given resources with only the
+// selected fields. The selection applies to each element of a list.
+func selectFields(resources interface{}, selection fieldSelection) ([]byte, error) {
+	b, err := json.Marshal(resources)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(selection.apply(doc))
+}
+
+func (s fieldSelection) apply(doc interface{}) interface{} {
+	if len(s) == 0 {
+		return doc
+	}
+	switch doc := doc.(type) {
+	case []interface{}:
+		for i := range doc {
+			doc[i] = s.apply(doc[i])
+		}
+		return doc
+	case map[string]interface{}:
+		selected := make(map[string]interface{}, len(s))
+		for name, value := range doc {
+			if child, ok := s[name]; ok {
+				selected[name] = child.apply(value)
+			}
+		}
+		return selected
+	}
+	return doc
+}
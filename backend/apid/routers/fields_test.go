@@ -0,0 +1,76 @@
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespondWithFields(t *testing.T) {
+	event := map[string]interface{}{
+		"timestamp": 42,
+		"entity": map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "web", "namespace": "default"},
+		},
+		"check": map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "check-cpu", "namespace": "default"},
+			"status":   2,
+			"output":   "a very long output",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		url      string
+		body     interface{}
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "single resource",
+			url:      "/events/web/check-cpu?fields=timestamp,check.status,entity.metadata.name",
+			body:     event,
+			wantCode: http.StatusOK,
+			wantBody: `{"check":{"status":2},"entity":{"metadata":{"name":"web"}},"timestamp":42}`,
+		},
+		{
+			name:     "list of resources",
+			url:      "/events?fields=check.metadata.name&fields=check.status",
+			body:     []interface{}{event, event},
+			wantCode: http.StatusOK,
+			wantBody: `[{"check":{"metadata":{"name":"check-cpu"},"status":2}},{"check":{"metadata":{"name":"check-cpu"},"status":2}}]`,
+		},
+		{
+			name:     "unknown field",
+			url:      "/events/web/check-cpu?fields=foo",
+			body:     event,
+			wantCode: http.StatusOK,
+			wantBody: `{}`,
+		},
+		{
+			name:     "invalid field",
+			url:      "/events/web/check-cpu?fields=check..status",
+			body:     event,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "no field",
+			url:      "/events/web/check-cpu?fields=",
+			body:     event,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+			RespondWith(w, req, tt.body)
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
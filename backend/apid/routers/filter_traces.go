@@ -0,0 +1,54 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/pipeline"
+)
+
+// FilterTraceLister represents the filter traces needs of the
+// FilterTracesRouter.
+type FilterTraceLister interface {
+	Traces(namespace, entity, check string) []*pipeline.FilterTrace
+}
+
+// FilterTracesRouter handles requests for the filter traces of events.
+type FilterTracesRouter struct {
+	traces FilterTraceLister
+}
+
+// NewFilterTracesRouter instantiates a new router for the filter traces of
+// events.
+func NewFilterTracesRouter(traces FilterTraceLister) *FilterTracesRouter {
+	return &FilterTracesRouter{
+		traces: traces,
+	}
+}
+
+// Mount the FilterTracesRouter to a parent Router
+func (r *FilterTracesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:events}",
+	}
+
+	routes.Path("{entity}/{check}/filter-traces", r.list).Methods(http.MethodGet)
+}
+
+func (r *FilterTracesRouter) list(req *http.Request) (interface{}, error) {
+	vars := mux.Vars(req)
+	entity, err := url.PathUnescape(vars["entity"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	check, err := url.PathUnescape(vars["check"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	namespace := corev2.ContextNamespace(req.Context())
+	return r.traces.Traces(namespace, entity, check), nil
+}
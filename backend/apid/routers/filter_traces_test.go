@@ -0,0 +1,43 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFilterTraceLister struct {
+	entity, check string
+}
+
+func (m *mockFilterTraceLister) Traces(namespace, entity, check string) []*pipeline.FilterTrace {
+	m.entity, m.check = entity, check
+	return []*pipeline.FilterTrace{{Entity: entity, Check: check, FilteredBy: "is_incident"}}
+}
+
+func TestFilterTracesRouter(t *testing.T) {
+	lister := &mockFilterTraceLister{}
+	router := mux.NewRouter().UseEncodedPath()
+	NewFilterTracesRouter(lister).Mount(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	req := newRequest(t, http.MethodGet, server.URL+"/namespaces/default/events/entity%2F1/check1/filter-traces", nil)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var traces []pipeline.FilterTrace
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&traces))
+	require.Len(t, traces, 1)
+	assert.Equal(t, "is_incident", traces[0].FilteredBy)
+	assert.Equal(t, "entity/1", lister.entity)
+	assert.Equal(t, "check1", lister.check)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql/gqlerrors"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
 	"github.com/sensu/sensu-go/graphql"
@@ -19,16 +20,29 @@ var (
 
 type GraphQLService interface {
 	Do(context.Context, graphql.QueryParams) *graphql.Result
+	Subscribe(context.Context, graphql.QueryParams) <-chan *graphql.Result
 }
 
 // GraphQLRouter handles requests for /events
 type GraphQLRouter struct {
 	Service GraphQLService
+
+	// PersistedQueries holds the queries the clients can execute by hash. The
+	// persisted queries are not supported if nil.
+	PersistedQueries *GraphQLPersistedQueries
+
+	// CacheTTL is the time during which the result of a query is reused for
+	// the identical queries of the same user. The results are not cached if
+	// it is 0.
+	CacheTTL time.Duration
+
+	cache graphQLResultCache
 }
 
 // Mount the GraphQLRouter to a parent Router
 func (r *GraphQLRouter) Mount(parent *mux.Router) {
 	parent.HandleFunc("/graphql", actionHandler(r.query)).Methods(http.MethodPost)
+	parent.HandleFunc("/graphql", r.subscribe).Methods(http.MethodGet)
 }
 
 func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
@@ -68,8 +82,19 @@ func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
 		queryVars, _ := op["variables"].(map[string]interface{})
 		skipValidate, _ := op["skip_validation"].(bool)
 
+		// Resolve the persisted query
+		query, err := r.PersistedQueries.resolve(query, persistedQueryHash(op))
+		if err != nil {
+			results = append(results, map[string]interface{}{
+				"data":   nil,
+				"errors": []gqlerrors.FormattedError{*err},
+				"auth":   claims != nil,
+			})
+			continue
+		}
+
 		// Execute given query
-		result := r.Service.Do(ctx, graphql.QueryParams{
+		result := r.do(ctx, claims, query, graphql.QueryParams{
 			Query:          query,
 			Variables:      queryVars,
 			SkipValidation: skipValidate,
@@ -91,3 +116,30 @@ func (r *GraphQLRouter) query(req *http.Request) (interface{}, error) {
 	}
 	return results[0], nil
 }
+
+// do executes the given query, or returns the cached result of an identical
+// query of the user.
+func (r *GraphQLRouter) do(ctx context.Context, claims *corev2.Claims, query string, p graphql.QueryParams) *graphql.Result {
+	if r.CacheTTL <= 0 {
+		return r.Service.Do(ctx, p)
+	}
+	if !isQueryOperation(query, p.OperationName) {
+		// The results cached before a mutation could be outdated
+		r.cache.flush()
+		return r.Service.Do(ctx, p)
+	}
+
+	key, err := graphQLCacheKey(claims, query, p)
+	if err != nil {
+		return r.Service.Do(ctx, p)
+	}
+	now := time.Now()
+	if result, ok := r.cache.get(key, now); ok {
+		return result
+	}
+	result := r.Service.Do(ctx, p)
+	if len(result.Errors) == 0 {
+		r.cache.set(key, result, now.Add(r.CacheTTL))
+	}
+	return result
+}
@@ -0,0 +1,249 @@
+package routers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/graphql"
+)
+
+const (
+	// graphQLPersistedQueriesMaxSize is the maximum number of queries the
+	// clients can persist.
+	graphQLPersistedQueriesMaxSize = 1000
+
+	// graphQLCacheSweepSize is the number of cached results above which the
+	// expired results are removed from the cache.
+	graphQLCacheSweepSize = 1000
+)
+
+// The error codes of the automatic persisted queries protocol of the Apollo
+// clients, from which they recover by sending the full query.
+const (
+	errCodePersistedQueryNotFound     = "PERSISTED_QUERY_NOT_FOUND"
+	errCodePersistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+	errCodePersistedQueryNotAllowed   = "PERSISTED_QUERY_NOT_ALLOWED"
+	errCodePersistedQueryInvalidHash  = "PERSISTED_QUERY_INVALID_HASH"
+)
+
+// GraphQLPersistedQueries holds GraphQL queries keyed by their SHA-256 hash,
+// so that the clients can send the hash of a query instead of the query
+// itself, with the automatic persisted queries protocol of the Apollo clients.
+// https://github.com/apollographql/apollo-link-persisted-queries
+//
+// The zero value is ready to use and lets the clients persist their queries.
+type GraphQLPersistedQueries struct {
+	// Allowlist restricts the queries executed to the persisted ones. The
+	// clients cannot persist new queries.
+	Allowlist bool
+
+	mu      sync.RWMutex
+	queries map[string]string
+}
+
+// LoadGraphQLPersistedQueries returns the allowlist of the queries of the
+// given JSON file, an object of queries keyed by their SHA-256 hash.
+func LoadGraphQLPersistedQueries(path string) (*GraphQLPersistedQueries, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var queries map[string]string
+	if err := json.Unmarshal(b, &queries); err != nil {
+		return nil, fmt.Errorf("invalid persisted queries file %q: %s", path, err)
+	}
+	for hash, query := range queries {
+		if queryHash(query) != strings.ToLower(hash) {
+			return nil, fmt.Errorf("invalid persisted queries file %q: hash %s does not match its query", path, hash)
+		}
+	}
+
+	p := &GraphQLPersistedQueries{Allowlist: true, queries: make(map[string]string, len(queries))}
+	for hash, query := range queries {
+		p.queries[strings.ToLower(hash)] = query
+	}
+	return p, nil
+}
+
+// resolve returns the query to execute given the query and the hash sent by
+// the client, and persists the query if needed.
+func (p *GraphQLPersistedQueries) resolve(query, hash string) (string, *gqlerrors.FormattedError) {
+	if p == nil {
+		if query == "" {
+			return "", persistedQueryError("PersistedQueryNotSupported", errCodePersistedQueryNotSupported)
+		}
+		return query, nil
+	}
+	if hash == "" {
+		if p.Allowlist && !p.has(queryHash(query)) {
+			return "", persistedQueryError("query is not allowed", errCodePersistedQueryNotAllowed)
+		}
+		return query, nil
+	}
+
+	hash = strings.ToLower(hash)
+	if query == "" {
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+		query, ok := p.queries[hash]
+		if !ok {
+			return "", persistedQueryError("PersistedQueryNotFound", errCodePersistedQueryNotFound)
+		}
+		return query, nil
+	}
+
+	if queryHash(query) != hash {
+		return "", persistedQueryError("provided sha does not match query", errCodePersistedQueryInvalidHash)
+	}
+	if p.Allowlist {
+		if !p.has(hash) {
+			return "", persistedQueryError("query is not allowed", errCodePersistedQueryNotAllowed)
+		}
+		return query, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.queries == nil {
+		p.queries = make(map[string]string)
+	}
+	if _, ok := p.queries[hash]; !ok && len(p.queries) >= graphQLPersistedQueriesMaxSize {
+		// Make room for the new query, the clients send the full query again
+		// if they need the evicted one.
+		for k := range p.queries {
+			delete(p.queries, k)
+			break
+		}
+	}
+	p.queries[hash] = query
+	return query, nil
+}
+
+func (p *GraphQLPersistedQueries) has(hash string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.queries[hash]
+	return ok
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func persistedQueryError(message, code string) *gqlerrors.FormattedError {
+	return &gqlerrors.FormattedError{
+		Message:    message,
+		Extensions: map[string]interface{}{"code": code},
+	}
+}
+
+// persistedQueryHash returns the hash of the persisted query of the extensions
+// of a GraphQL operation, if any.
+func persistedQueryHash(op map[string]interface{}) string {
+	extensions, _ := op["extensions"].(map[string]interface{})
+	persistedQuery, _ := extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := persistedQuery["sha256Hash"].(string)
+	return hash
+}
+
+// graphQLResultCache holds the results of the GraphQL queries until they
+// expire, so that identical queries of a user are executed once. The zero
+// value is ready to use.
+type graphQLResultCache struct {
+	mu      sync.Mutex
+	entries map[string]graphQLResultCacheEntry
+}
+
+type graphQLResultCacheEntry struct {
+	result  *graphql.Result
+	expires time.Time
+}
+
+// get returns the result cached for key, if it did not expire.
+func (c *graphQLResultCache) get(key string, now time.Time) (*graphql.Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expires) {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// set caches the result of the query identified by key.
+func (c *graphQLResultCache) set(key string, result *graphql.Result, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]graphQLResultCacheEntry)
+	}
+	if len(c.entries) >= graphQLCacheSweepSize {
+		now := time.Now()
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = graphQLResultCacheEntry{result: result, expires: expires}
+}
+
+// flush removes all the cached results.
+func (c *graphQLResultCache) flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+}
+
+// graphQLCacheKey returns the key identifying the result of the given
+// operation for the given user, as the result depends on its permissions.
+func graphQLCacheKey(claims *corev2.Claims, query string, p graphql.QueryParams) (string, error) {
+	var subject string
+	var groups []string
+	if claims != nil {
+		subject = claims.Subject
+		groups = append(groups, claims.Groups...)
+		sort.Strings(groups)
+	}
+	key, err := json.Marshal([]interface{}{
+		subject, groups, queryHash(query), p.OperationName, p.Variables, p.SkipValidation,
+	})
+	return string(key), err
+}
+
+// isQueryOperation returns true if the operation of the given query to execute
+// is a query, whose result can be cached, rather than a mutation.
+func isQueryOperation(query, operationName string) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return false
+	}
+	var found bool
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName != "" && (op.Name == nil || op.Name.Value != operationName) {
+			continue
+		}
+		if op.Operation != ast.OperationTypeQuery || found {
+			// Ambiguous operations are left to the service to reject
+			return false
+		}
+		found = true
+	}
+	return found
+}
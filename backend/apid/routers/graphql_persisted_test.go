@@ -0,0 +1,142 @@
+package routers
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGraphQLCounter struct {
+	mockGraphQLSubscriber
+	queries []string
+}
+
+func (m *mockGraphQLCounter) Do(ctx context.Context, p graphql.QueryParams) *graphql.Result {
+	m.queries = append(m.queries, p.Query)
+	return &graphql.Result{Data: map[string]interface{}{"count": len(m.queries)}}
+}
+
+func persistedQueryOp(query, hash string) map[string]interface{} {
+	op := map[string]interface{}{
+		"extensions": map[string]interface{}{
+			"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": hash},
+		},
+	}
+	if query != "" {
+		op["query"] = query
+	}
+	return op
+}
+
+func doGraphQLQuery(t *testing.T, router *GraphQLRouter, body interface{}) map[string]interface{} {
+	t.Helper()
+	req, err := setupRequest("POST", "/graphql", body)
+	require.NoError(t, err)
+	result, err := router.query(req)
+	require.NoError(t, err)
+	return result.(map[string]interface{})
+}
+
+func resultErrorCode(result map[string]interface{}) interface{} {
+	errs, _ := result["errors"].([]gqlerrors.FormattedError)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs[0].Extensions["code"]
+}
+
+func TestGraphQLPersistedQueries(t *testing.T) {
+	service := &mockGraphQLCounter{}
+	router := &GraphQLRouter{Service: service, PersistedQueries: &GraphQLPersistedQueries{}}
+	query := "{ version }"
+	hash := queryHash(query)
+
+	// The query is unknown
+	result := doGraphQLQuery(t, router, persistedQueryOp("", hash))
+	assert.Equal(t, errCodePersistedQueryNotFound, resultErrorCode(result))
+
+	// The hash must match the query
+	result = doGraphQLQuery(t, router, persistedQueryOp(query, queryHash("{ other }")))
+	assert.Equal(t, errCodePersistedQueryInvalidHash, resultErrorCode(result))
+
+	// The query is persisted
+	result = doGraphQLQuery(t, router, persistedQueryOp(query, hash))
+	assert.Nil(t, resultErrorCode(result))
+	result = doGraphQLQuery(t, router, persistedQueryOp("", hash))
+	assert.Nil(t, resultErrorCode(result))
+	assert.Equal(t, []string{query, query}, service.queries)
+
+	// The persisted queries are not supported
+	router.PersistedQueries = nil
+	result = doGraphQLQuery(t, router, persistedQueryOp("", hash))
+	assert.Equal(t, errCodePersistedQueryNotSupported, resultErrorCode(result))
+}
+
+func TestGraphQLPersistedQueriesAllowlist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-graphql")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	allowed := "{ version }"
+	path := filepath.Join(dir, "queries.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"`+queryHash(allowed)+`": "{ version }"}`), 0644))
+	persistedQueries, err := LoadGraphQLPersistedQueries(path)
+	require.NoError(t, err)
+
+	service := &mockGraphQLCounter{}
+	router := &GraphQLRouter{Service: service, PersistedQueries: persistedQueries}
+	result := doGraphQLQuery(t, router, persistedQueryOp("", queryHash(allowed)))
+	assert.Nil(t, resultErrorCode(result))
+	result = doGraphQLQuery(t, router, map[string]interface{}{"query": allowed})
+	assert.Nil(t, resultErrorCode(result))
+
+	// The clients cannot persist new queries
+	other := "{ other }"
+	result = doGraphQLQuery(t, router, persistedQueryOp(other, queryHash(other)))
+	assert.Equal(t, errCodePersistedQueryNotAllowed, resultErrorCode(result))
+	result = doGraphQLQuery(t, router, map[string]interface{}{"query": other})
+	assert.Equal(t, errCodePersistedQueryNotAllowed, resultErrorCode(result))
+	assert.Equal(t, []string{allowed, allowed}, service.queries)
+
+	// The hashes of the file must match their queries
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"abc": "{ version }"}`), 0644))
+	_, err = LoadGraphQLPersistedQueries(path)
+	assert.Error(t, err)
+}
+
+func TestGraphQLResultCache(t *testing.T) {
+	service := &mockGraphQLCounter{}
+	router := &GraphQLRouter{Service: service, CacheTTL: time.Minute}
+	query := map[string]interface{}{"query": "{ version }"}
+
+	first := doGraphQLQuery(t, router, query)
+	second := doGraphQLQuery(t, router, query)
+	assert.Equal(t, first["data"], second["data"])
+	assert.Len(t, service.queries, 1)
+
+	// The results are cached per user
+	claims := &corev2.Claims{Groups: []string{"cluster-admins"}}
+	claims.Subject = "admin"
+	req, err := setupRequest("POST", "/graphql", query)
+	require.NoError(t, err)
+	req = req.WithContext(context.WithValue(req.Context(), corev2.ClaimsKey, claims))
+	_, err = router.query(req)
+	require.NoError(t, err)
+	assert.Len(t, service.queries, 2)
+
+	// The mutations are not cached and flush the cache
+	mutation := map[string]interface{}{"query": "mutation { putCheck { id } }"}
+	doGraphQLQuery(t, router, mutation)
+	doGraphQLQuery(t, router, mutation)
+	doGraphQLQuery(t, router, query)
+	assert.Len(t, service.queries, 5)
+}
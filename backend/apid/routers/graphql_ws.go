@@ -0,0 +1,280 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/graphql"
+)
+
+// The GraphQL subscriptions are served over websockets with the graphql-ws
+// protocol of subscriptions-transport-ws, supported by the Apollo clients.
+// https://github.com/apollographql/subscriptions-transport-ws/blob/master/PROTOCOL.md
+const (
+	graphQLWSProtocol = "graphql-ws"
+
+	gqlConnectionInit      = "connection_init"
+	gqlConnectionAck       = "connection_ack"
+	gqlConnectionError     = "connection_error"
+	gqlConnectionKeepAlive = "ka"
+	gqlConnectionTerminate = "connection_terminate"
+	gqlStart               = "start"
+	gqlStop                = "stop"
+	gqlData                = "data"
+	gqlError               = "error"
+	gqlComplete            = "complete"
+
+	// graphQLWSInitTimeout is the time given to the clients to initialize the
+	// connection once upgraded
+	graphQLWSInitTimeout = 10 * time.Second
+
+	// graphQLWSKeepAlive is the interval at which the keep alive messages are
+	// sent to the clients
+	graphQLWSKeepAlive = 15 * time.Second
+
+	// graphQLWSReadTimeout is the time after which a connection is closed if
+	// the client did not answer the pings
+	graphQLWSReadTimeout = 2 * graphQLWSKeepAlive
+
+	// graphQLWSWriteTimeout is the time given to each message to be written
+	graphQLWSWriteTimeout = 10 * time.Second
+)
+
+var graphQLUpgrader = websocket.Upgrader{
+	HandshakeTimeout: graphQLWSInitTimeout,
+	Subprotocols:     []string{graphQLWSProtocol},
+}
+
+// graphQLWSMessage is a message of the graphql-ws protocol
+type graphQLWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// graphQLWSOperation is the payload of a start message
+type graphQLWSOperation struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// graphQLWSConn is a websocket connection serving GraphQL subscriptions
+type graphQLWSConn struct {
+	conn    *websocket.Conn
+	service GraphQLService
+	ctx     context.Context
+
+	// writeMu serializes the writes to the connection
+	writeMu sync.Mutex
+
+	// mu protects the cancel functions of the operations in progress
+	mu         sync.Mutex
+	operations map[string]context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// subscribe upgrades the connection to a websocket serving the GraphQL
+// subscriptions. The clients can authenticate with the Authorization header of
+// the request, or with an Authorization field in the payload of the
+// connection_init message, as browsers cannot set headers on websockets.
+func (r *GraphQLRouter) subscribe(w http.ResponseWriter, req *http.Request) {
+	conn, err := graphQLUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		// The upgrader already replied to the client
+		logger.WithError(err).Warn("unable to upgrade the GraphQL connection")
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(middlewares.MaxBytesLimit)
+
+	ctx, cancel := context.WithCancel(context.WithValue(req.Context(), corev2.NamespaceKey, ""))
+	defer cancel()
+	c := &graphQLWSConn{
+		conn:       conn,
+		service:    r.Service,
+		ctx:        ctx,
+		operations: map[string]context.CancelFunc{},
+	}
+	if err := c.init(); err != nil {
+		logger.WithError(err).Debug("unable to initialize the GraphQL connection")
+		return
+	}
+	go c.keepAlive()
+	c.serve()
+
+	// Stop the operations in progress before closing the connection
+	cancel()
+	c.wg.Wait()
+}
+
+// init waits for the connection_init message and authenticates the client.
+func (c *graphQLWSConn) init() error {
+	_ = c.conn.SetReadDeadline(time.Now().Add(graphQLWSInitTimeout))
+	var msg graphQLWSMessage
+	if err := c.conn.ReadJSON(&msg); err != nil {
+		return err
+	}
+	if msg.Type != gqlConnectionInit {
+		return c.initError("expected " + gqlConnectionInit)
+	}
+
+	var params struct {
+		Authorization string `json:"Authorization"`
+	}
+	if len(msg.Payload) > 0 {
+		_ = json.Unmarshal(msg.Payload, &params)
+	}
+	if params.Authorization != "" {
+		token, err := jwt.ValidateToken(strings.TrimPrefix(params.Authorization, "Bearer "))
+		if err != nil {
+			return c.initError("invalid credentials")
+		}
+		c.ctx = context.WithValue(c.ctx, corev2.ClaimsKey, token.Claims.(*corev2.Claims))
+	}
+
+	if err := c.write(graphQLWSMessage{Type: gqlConnectionAck}); err != nil {
+		return err
+	}
+	return c.write(graphQLWSMessage{Type: gqlConnectionKeepAlive})
+}
+
+// initError notifies the client that the connection cannot be initialized.
+func (c *graphQLWSConn) initError(message string) error {
+	_ = c.write(graphQLWSMessage{Type: gqlConnectionError, Payload: errorPayload(message)})
+	return errors.New(message)
+}
+
+// serve reads the messages of the client until the connection is terminated.
+func (c *graphQLWSConn) serve() {
+	_ = c.conn.SetReadDeadline(time.Now().Add(graphQLWSReadTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(graphQLWSReadTimeout))
+	})
+
+	for {
+		var msg graphQLWSMessage
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.WithError(err).Debug("GraphQL connection closed")
+			}
+			return
+		}
+		switch msg.Type {
+		case gqlStart:
+			c.start(msg)
+		case gqlStop:
+			c.stop(msg.ID)
+		case gqlConnectionTerminate:
+			return
+		default:
+			_ = c.write(graphQLWSMessage{ID: msg.ID, Type: gqlError, Payload: errorPayload("unknown message type " + msg.Type)})
+		}
+	}
+}
+
+// start executes the subscription of given start message.
+func (c *graphQLWSConn) start(msg graphQLWSMessage) {
+	var op graphQLWSOperation
+	if err := json.Unmarshal(msg.Payload, &op); err != nil {
+		_ = c.write(graphQLWSMessage{ID: msg.ID, Type: gqlError, Payload: errorPayload("invalid payload")})
+		return
+	}
+
+	c.mu.Lock()
+	if _, ok := c.operations[msg.ID]; ok || msg.ID == "" {
+		c.mu.Unlock()
+		_ = c.write(graphQLWSMessage{ID: msg.ID, Type: gqlError, Payload: errorPayload("invalid operation id")})
+		return
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.operations[msg.ID] = cancel
+	c.mu.Unlock()
+
+	results := c.service.Subscribe(ctx, graphql.QueryParams{
+		Query:         op.Query,
+		Variables:     op.Variables,
+		OperationName: op.OperationName,
+	})
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.stop(msg.ID)
+		for result := range results {
+			if len(result.Errors) > 0 {
+				logger.
+					WithField("errors", result.Errors).
+					Error("error(s) occurred while executing GraphQL subscription")
+			}
+			payload, err := json.Marshal(map[string]interface{}{
+				"data":   result.Data,
+				"errors": result.Errors,
+			})
+			if err != nil {
+				logger.WithError(err).Error("unable to marshal the GraphQL subscription result")
+				continue
+			}
+			if err := c.write(graphQLWSMessage{ID: msg.ID, Type: gqlData, Payload: payload}); err != nil {
+				return
+			}
+		}
+		if c.ctx.Err() == nil {
+			_ = c.write(graphQLWSMessage{ID: msg.ID, Type: gqlComplete})
+		}
+	}()
+}
+
+// stop stops the operation with given id, if in progress.
+func (c *graphQLWSConn) stop(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cancel, ok := c.operations[id]; ok {
+		cancel()
+		delete(c.operations, id)
+	}
+}
+
+// keepAlive sends keep alive messages and pings until the connection is
+// closed.
+func (c *graphQLWSConn) keepAlive() {
+	ticker := time.NewTicker(graphQLWSKeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.write(graphQLWSMessage{Type: gqlConnectionKeepAlive}); err != nil {
+				return
+			}
+			c.writeMu.Lock()
+			err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(graphQLWSWriteTimeout))
+			c.writeMu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *graphQLWSConn) write(msg graphQLWSMessage) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(graphQLWSWriteTimeout))
+	return c.conn.WriteJSON(msg)
+}
+
+func errorPayload(message string) json.RawMessage {
+	payload, _ := json.Marshal(map[string]string{"message": message})
+	return payload
+}
@@ -0,0 +1,103 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGraphQLSubscriber struct {
+	results []*graphql.Result
+	params  graphql.QueryParams
+}
+
+func (m *mockGraphQLSubscriber) Do(context.Context, graphql.QueryParams) *graphql.Result {
+	return &graphql.Result{}
+}
+
+func (m *mockGraphQLSubscriber) Subscribe(ctx context.Context, p graphql.QueryParams) <-chan *graphql.Result {
+	m.params = p
+	ch := make(chan *graphql.Result, len(m.results))
+	for _, result := range m.results {
+		ch <- result
+	}
+	close(ch)
+	return ch
+}
+
+func dialGraphQL(t *testing.T, service GraphQLService) (*websocket.Conn, func()) {
+	t.Helper()
+	router := mux.NewRouter()
+	(&GraphQLRouter{Service: service}).Mount(router)
+	server := httptest.NewServer(router)
+
+	dialer := websocket.Dialer{Subprotocols: []string{graphQLWSProtocol}}
+	conn, res, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/graphql", nil)
+	require.NoError(t, err)
+	assert.Equal(t, graphQLWSProtocol, res.Header.Get("Sec-Websocket-Protocol"))
+	return conn, func() {
+		conn.Close()
+		server.Close()
+	}
+}
+
+func readGraphQLMessage(t *testing.T, conn *websocket.Conn) graphQLWSMessage {
+	var msg graphQLWSMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func TestGraphQLSubscription(t *testing.T) {
+	service := &mockGraphQLSubscriber{results: []*graphql.Result{
+		{Data: map[string]interface{}{"eventChanged": map[string]interface{}{"action": "CREATED"}}},
+		{Data: map[string]interface{}{"eventChanged": map[string]interface{}{"action": "DELETED"}}},
+	}}
+	conn, cleanup := dialGraphQL(t, service)
+	defer cleanup()
+
+	require.NoError(t, conn.WriteJSON(graphQLWSMessage{Type: gqlConnectionInit}))
+	assert.Equal(t, gqlConnectionAck, readGraphQLMessage(t, conn).Type)
+	assert.Equal(t, gqlConnectionKeepAlive, readGraphQLMessage(t, conn).Type)
+
+	query := `subscription { eventChanged(namespace: "default") { action } }`
+	payload, _ := json.Marshal(graphQLWSOperation{Query: query, Variables: map[string]interface{}{"a": "b"}})
+	require.NoError(t, conn.WriteJSON(graphQLWSMessage{ID: "1", Type: gqlStart, Payload: payload}))
+
+	for _, action := range []string{"CREATED", "DELETED"} {
+		msg := readGraphQLMessage(t, conn)
+		assert.Equal(t, gqlData, msg.Type)
+		assert.Equal(t, "1", msg.ID)
+		assert.Contains(t, string(msg.Payload), action)
+	}
+	complete := readGraphQLMessage(t, conn)
+	assert.Equal(t, gqlComplete, complete.Type)
+	assert.Equal(t, "1", complete.ID)
+	assert.Equal(t, query, service.params.Query)
+	assert.Equal(t, map[string]interface{}{"a": "b"}, service.params.Variables)
+
+	require.NoError(t, conn.WriteJSON(graphQLWSMessage{ID: "2", Type: "unknown"}))
+	assert.Equal(t, gqlError, readGraphQLMessage(t, conn).Type)
+	require.NoError(t, conn.WriteJSON(graphQLWSMessage{Type: gqlConnectionTerminate}))
+}
+
+func TestGraphQLSubscriptionInvalidCredentials(t *testing.T) {
+	conn, cleanup := dialGraphQL(t, &mockGraphQLSubscriber{})
+	defer cleanup()
+
+	payload := json.RawMessage(`{"Authorization": "Bearer invalid"}`)
+	require.NoError(t, conn.WriteJSON(graphQLWSMessage{Type: gqlConnectionInit, Payload: payload}))
+	msg := readGraphQLMessage(t, conn)
+	assert.Equal(t, gqlConnectionError, msg.Type)
+
+	// The connection is closed
+	var next graphQLWSMessage
+	assert.Error(t, conn.ReadJSON(&next))
+}
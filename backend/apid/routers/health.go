@@ -9,6 +9,7 @@ import (
 
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/health"
 )
 
 // HealthController represents the controller needs of the HealthRouter
@@ -16,22 +17,61 @@ type HealthController interface {
 	GetClusterHealth(ctx context.Context) *corev2.HealthResponse
 }
 
-// HealthRouter handles requests for /health
+// HealthChecker represents the checker needs of the HealthRouter
+type HealthChecker interface {
+	Liveness(ctx context.Context) *health.Report
+	Readiness(ctx context.Context) *health.Report
+}
+
+// HealthRouter handles requests for /health, and for /health/live and
+// /health/ready, which respond with a 503 status when the backend is not
+// alive or not ready, for load balancers.
 type HealthRouter struct {
 	controller HealthController
+	checker    HealthChecker
 	mu         sync.Mutex
 }
 
-// NewHealthRouter instantiates new router for controlling health info
-func NewHealthRouter(ctrl HealthController) *HealthRouter {
+// NewHealthRouter instantiates new router for controlling health info. The
+// liveness and readiness endpoints are not mounted if checker is nil.
+func NewHealthRouter(ctrl HealthController, checker HealthChecker) *HealthRouter {
 	return &HealthRouter{
 		controller: ctrl,
+		checker:    checker,
 	}
 }
 
 // Mount the HealthRouter to a parent Router
 func (r *HealthRouter) Mount(parent *mux.Router) {
 	parent.HandleFunc("/health", r.health).Methods(http.MethodGet)
+	if r.checker != nil {
+		parent.HandleFunc("/health/live", r.report(r.checker.Liveness)).Methods(http.MethodGet)
+		parent.HandleFunc("/health/ready", r.report(r.checker.Readiness)).Methods(http.MethodGet)
+	}
+}
+
+// report responds with the report of the given check, within the timeout of
+// the request.
+func (r *HealthRouter) report(check func(context.Context) *health.Report) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		timeout, err := parseTimeout(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ctx := req.Context()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+			defer cancel()
+		}
+		report := check(ctx)
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	}
 }
 
 func (r *HealthRouter) health(w http.ResponseWriter, req *http.Request) {
@@ -2,12 +2,14 @@ package routers
 
 import (
 	"context"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/health"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/mock"
 )
@@ -23,7 +25,7 @@ func (m *mockHealthController) GetClusterHealth(ctx context.Context) *types.Heal
 
 func newHealthTest(t *testing.T) (*mockHealthController, *httptest.Server) {
 	controller := &mockHealthController{}
-	healthRouter := NewHealthRouter(controller)
+	healthRouter := NewHealthRouter(controller, nil)
 	router := mux.NewRouter()
 	healthRouter.Mount(router)
 	return controller, httptest.NewServer(router)
@@ -88,3 +90,51 @@ func TestUnHealthyClusterStatus(t *testing.T) {
 	}
 
 }
+
+type mockHealthChecker struct {
+	healthy bool
+}
+
+func (m *mockHealthChecker) Liveness(ctx context.Context) *health.Report {
+	return &health.Report{Healthy: true}
+}
+
+func (m *mockHealthChecker) Readiness(ctx context.Context) *health.Report {
+	return &health.Report{Healthy: m.healthy, Subsystems: []health.Status{{Name: health.EtcdQuorum, Healthy: m.healthy}}}
+}
+
+func TestHealthLivenessReadiness(t *testing.T) {
+	checker := &mockHealthChecker{healthy: true}
+	router := mux.NewRouter()
+	NewHealthRouter(&mockHealthController{}, checker).Mount(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	for path, status := range map[string]int{"/health/live": http.StatusOK, "/health/ready": http.StatusOK} {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("%s: bad status: %d", path, resp.StatusCode)
+		}
+	}
+
+	checker.healthy = false
+	resp, err := http.Get(server.URL + "/health/ready")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("bad status: %d", resp.StatusCode)
+	}
+	var report health.Report
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Healthy || len(report.Subsystems) != 1 {
+		t.Errorf("bad report: %v", report)
+	}
+}
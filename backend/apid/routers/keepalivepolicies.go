@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// KeepalivePoliciesRouter handles requests for /keepalive-policies
+type KeepalivePoliciesRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewKeepalivePoliciesRouter instantiates new router for controlling keepalive policy resources
+func NewKeepalivePoliciesRouter(store store.ResourceStore) *KeepalivePoliciesRouter {
+	return &KeepalivePoliciesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.KeepalivePolicy{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the KeepalivePoliciesRouter to a parent Router
+func (r *KeepalivePoliciesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:keepalive-policies}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.KeepalivePolicyFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:keepalive-policies}", corev2.KeepalivePolicyFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gorilla/mux"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/selector"
 	"github.com/sensu/sensu-go/backend/store"
 )
 
@@ -29,9 +30,23 @@ func init() {
 	Lister = List
 }
 
-// List handles resources listing with pagination support
+// List handles resources listing with pagination support. The resources are
+// filtered by the labelSelector and fieldSelector query parameters; since the
+// selectors apply to each page, a page may hold fewer resources than the limit.
 func List(list ListControllerFunc, fields FieldsFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		labelSelector, err := selector.Parse(query.Get("labelSelector"))
+		if err != nil {
+			WriteError(w, actions.NewError(actions.InvalidArgument, err))
+			return
+		}
+		fieldSelector, err := selector.Parse(query.Get("fieldSelector"))
+		if err != nil {
+			WriteError(w, actions.NewError(actions.InvalidArgument, err))
+			return
+		}
+
 		pred := &store.SelectionPredicate{
 			Continue: corev2.PageContinueFromContext(r.Context()),
 			Limit:    int64(corev2.PageSizeFromContext(r.Context())),
@@ -48,6 +63,16 @@ func List(list ListControllerFunc, fields FieldsFunc) http.HandlerFunc {
 			return
 		}
 
+		if !labelSelector.Empty() || !fieldSelector.Empty() {
+			selected := make([]corev2.Resource, 0, len(results))
+			for _, resource := range results {
+				if matchesResourceSelectors(resource, fields, labelSelector, fieldSelector) {
+					selected = append(selected, resource)
+				}
+			}
+			results = selected
+		}
+
 		if pred.Continue != "" {
 			encodedContinue := base64.RawURLEncoding.EncodeToString([]byte(pred.Continue))
 			w.Header().Set(corev2.PaginationContinueHeader, encodedContinue)
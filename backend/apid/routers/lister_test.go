@@ -12,6 +12,7 @@ import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/fixture"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -114,3 +115,77 @@ func TestList(t *testing.T) {
 		})
 	}
 }
+
+func TestListSelectors(t *testing.T) {
+	bar := &fixture.Resource{Foo: "bar"}
+	bar.Name = "bar"
+	bar.Labels = map[string]string{"team": "ops"}
+	baz := &fixture.Resource{Foo: "baz"}
+	baz.Name = "baz"
+	baz.Labels = map[string]string{"team": "dev"}
+	fields := func(r corev2.Resource) map[string]string {
+		return map[string]string{"resource.foo": r.(*fixture.Resource).Foo}
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedFoos   []string
+		expectedStatus int
+	}{
+		{
+			name:           "no selector",
+			query:          "",
+			expectedFoos:   []string{"bar", "baz"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "label selector",
+			query:          "?labelSelector=team%20%3D%3D%20ops",
+			expectedFoos:   []string{"bar"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "field selector",
+			query:          "?fieldSelector=resource.foo%20in%20%5Bbaz%5D",
+			expectedFoos:   []string{"baz"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "no match",
+			query:          "?labelSelector=team%20%3D%3D%20ops&fieldSelector=resource.foo%20%3D%3D%20baz",
+			expectedFoos:   []string{},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid selector",
+			query:          "?labelSelector=team%20%3D%3D",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &mockGenericController{}
+			controller.On("List", mock.Anything, mock.AnythingOfType("*store.SelectionPredicate")).
+				Return([]corev2.Resource{bar, baz}, nil)
+
+			r := httptest.NewRequest(http.MethodGet, "/resources"+tt.query, nil)
+			w := httptest.NewRecorder()
+			List(controller.List, fields).ServeHTTP(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if w.Code >= 400 {
+				return
+			}
+			payload := []fixture.Resource{}
+			if err := json.Unmarshal(w.Body.Bytes(), &payload); err != nil {
+				t.Fatal(err)
+			}
+			foos := []string{}
+			for _, resource := range payload {
+				foos = append(foos, resource.Foo)
+			}
+			assert.Equal(t, tt.expectedFoos, foos)
+		})
+	}
+}
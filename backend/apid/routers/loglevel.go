@@ -0,0 +1,54 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+)
+
+// LogLevelController represents the controller needs of the LogLevelRouter.
+type LogLevelController interface {
+	Get(context.Context) *actions.LogLevel
+	Set(context.Context, *actions.LogLevel) error
+}
+
+// LogLevelRouter handles requests for /loglevel.
+type LogLevelRouter struct {
+	controller LogLevelController
+}
+
+// NewLogLevelRouter instantiates a new router for the backend log level.
+func NewLogLevelRouter(ctrl LogLevelController) *LogLevelRouter {
+	return &LogLevelRouter{
+		controller: ctrl,
+	}
+}
+
+// Mount the LogLevelRouter on the given parent Router
+func (r *LogLevelRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:loglevel}",
+	}
+
+	routes.Path("", r.get).Methods(http.MethodGet)
+	routes.Path("", r.set).Methods(http.MethodPut)
+}
+
+func (r *LogLevelRouter) get(req *http.Request) (interface{}, error) {
+	return r.controller.Get(req.Context()), nil
+}
+
+func (r *LogLevelRouter) set(req *http.Request) (interface{}, error) {
+	level := &actions.LogLevel{}
+	if err := UnmarshalBody(req, level); err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	if err := r.controller.Set(req.Context(), level); err != nil {
+		return nil, err
+	}
+	return r.controller.Get(req.Context()), nil
+}
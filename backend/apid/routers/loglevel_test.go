@@ -0,0 +1,106 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockLogLevelController struct {
+	mock.Mock
+}
+
+func (m *mockLogLevelController) Get(ctx context.Context) *actions.LogLevel {
+	return m.Called(ctx).Get(0).(*actions.LogLevel)
+}
+
+func (m *mockLogLevelController) Set(ctx context.Context, level *actions.LogLevel) error {
+	return m.Called(ctx, level).Error(0)
+}
+
+func newLogLevelTest(t *testing.T) (*mockLogLevelController, *httptest.Server) {
+	controller := &mockLogLevelController{}
+	logLevelRouter := NewLogLevelRouter(controller)
+	router := mux.NewRouter()
+	logLevelRouter.Mount(router)
+
+	return controller, httptest.NewServer(router)
+}
+
+func TestGetLogLevel(t *testing.T) {
+	controller, server := newLogLevelTest(t)
+	defer server.Close()
+
+	controller.On("Get", mock.Anything).Return(&actions.LogLevel{Level: "warning"})
+
+	req := newRequest(t, http.MethodGet, server.URL+"/loglevel", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("bad status: %d", resp.StatusCode)
+	}
+	var level actions.LogLevel
+	if err := json.NewDecoder(resp.Body).Decode(&level); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := level.Level, "warning"; got != want {
+		t.Fatalf("bad level: got %q, want %q", got, want)
+	}
+}
+
+func TestPutLogLevel(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setErr     error
+		wantStatus int
+	}{
+		{
+			name:       "valid level",
+			body:       `{"level": "debug"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid level",
+			body:       `{"level": "chatty"}`,
+			setErr:     actions.NewErrorf(actions.InvalidArgument),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid body",
+			body:       `{"level":`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller, server := newLogLevelTest(t)
+			defer server.Close()
+
+			controller.On("Set", mock.Anything, mock.Anything).Return(test.setErr)
+			controller.On("Get", mock.Anything).Return(&actions.LogLevel{Level: "debug"})
+
+			req := newRequest(t, http.MethodPut, server.URL+"/loglevel", bytes.NewBufferString(test.body))
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if got, want := resp.StatusCode, test.wantStatus; got != want {
+				t.Fatalf("bad status: got %d, want %d", got, want)
+			}
+		})
+	}
+}
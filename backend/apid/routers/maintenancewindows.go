@@ -0,0 +1,79 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// defaultMaintenanceWindowHistoryDays is the number of days of history
+	// returned if not specified.
+	defaultMaintenanceWindowHistoryDays = 7
+
+	// maxMaintenanceWindowHistoryDays is the maximum number of days of
+	// history that can be requested.
+	maxMaintenanceWindowHistoryDays = 366
+)
+
+// MaintenanceWindowsRouter handles requests for /maintenance-windows
+type MaintenanceWindowsRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewMaintenanceWindowsRouter instantiates new router for controlling
+// maintenance window resources
+func NewMaintenanceWindowsRouter(store store.ResourceStore) *MaintenanceWindowsRouter {
+	return &MaintenanceWindowsRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.MaintenanceWindow{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the MaintenanceWindowsRouter to a parent Router
+func (r *MaintenanceWindowsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:maintenance-windows}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.MaintenanceWindowFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:maintenance-windows}", corev2.MaintenanceWindowFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+	routes.Path("{id}/history", r.history).Methods(http.MethodGet)
+}
+
+// history returns the occurrences of a maintenance window over the last days,
+// given by the days query parameter.
+func (r *MaintenanceWindowsRouter) history(req *http.Request) (interface{}, error) {
+	days := defaultMaintenanceWindowHistoryDays
+	if value := req.URL.Query().Get("days"); value != "" {
+		var err error
+		days, err = strconv.Atoi(value)
+		if err != nil || days < 1 || days > maxMaintenanceWindowHistoryDays {
+			return nil, actions.NewError(actions.InvalidArgument, errors.New("days must be between 1 and 366"))
+		}
+	}
+
+	resource, err := r.handlers.GetResource(req)
+	if err != nil {
+		return nil, err
+	}
+	window := resource.(*corev2.MaintenanceWindow)
+
+	now := time.Now()
+	return window.History(now.AddDate(0, 0, -days), now), nil
+}
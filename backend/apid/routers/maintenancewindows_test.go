@@ -0,0 +1,63 @@
+package routers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMaintenanceWindowsRouter(t *testing.T) {
+	// Setup the router
+	s := &mockstore.MockStore{}
+	router := NewMaintenanceWindowsRouter(s)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.MaintenanceWindow{}
+	fixture := corev2.FixtureMaintenanceWindow("foo")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	tests = append(tests, []routerTestCase{
+		{
+			name:   "it returns the history of a maintenance window",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/history?days=30",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.MaintenanceWindow")).
+					Return(nil).
+					Once()
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "it returns 404 if the maintenance window is not found",
+			method: http.MethodGet,
+			path:   fixture.URIPath() + "/history",
+			storeFunc: func(s *mockstore.MockStore) {
+				s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.MaintenanceWindow")).
+					Return(&store.ErrNotFound{}).
+					Once()
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "it returns 400 if the number of days is invalid",
+			method:         http.MethodGet,
+			path:           fixture.URIPath() + "/history?days=0",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// NamespaceTemplatesRouter handles requests for namespace templates.
+type NamespaceTemplatesRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewNamespaceTemplatesRouter instantiates a new router for namespace templates.
+func NewNamespaceTemplatesRouter(store store.ResourceStore) *NamespaceTemplatesRouter {
+	return &NamespaceTemplatesRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.NamespaceTemplate{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the NamespaceTemplatesRouter on the given parent Router
+func (r *NamespaceTemplatesRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:namespace-templates}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.NamespaceTemplateFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
@@ -0,0 +1,87 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/authentication"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+)
+
+// OIDCRouter handles the logins with the OIDC provider. Its routes are public,
+// since the clients are not authenticated yet.
+type OIDCRouter struct {
+	authenticator *authentication.Authenticator
+}
+
+// NewOIDCRouter instantiates new router.
+func NewOIDCRouter(authenticator *authentication.Authenticator) *OIDCRouter {
+	return &OIDCRouter{authenticator: authenticator}
+}
+
+// Mount the OIDC routes on given mux.Router.
+func (o *OIDCRouter) Mount(r *mux.Router) {
+	r.HandleFunc("/auth/oidc", o.config).Methods(http.MethodGet)
+	r.HandleFunc("/auth/oidc/token", o.token).Methods(http.MethodPost)
+}
+
+// config returns the configuration of the OIDC provider needed by the
+// clients to log in with it
+func (o *OIDCRouter) config(w http.ResponseWriter, r *http.Request) {
+	client := api.NewAuthenticationClient(o.authenticator)
+	provider := client.OIDCProvider()
+	if provider == nil {
+		http.Error(w, api.ErrOIDCDisabled.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(provider.Config()); err != nil {
+		logger.WithError(err).Error("couldn't write response body")
+	}
+}
+
+// oidcTokenRequest is the body of the requests exchanging the tokens issued by
+// the OIDC provider for Sensu tokens
+type oidcTokenRequest struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	Nonce        string `json:"nonce"`
+}
+
+// token issues access tokens to the users logged in with the OIDC provider
+func (o *OIDCRouter) token(w http.ResponseWriter, r *http.Request) {
+	var body oidcTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.IDToken == "" {
+		http.Error(w, "the request must have an id_token", http.StatusBadRequest)
+		return
+	}
+
+	// Determine the URL that serves this request so it can be later used as the
+	// issuer URL
+	ctx := context.WithValue(r.Context(), jwt.IssuerURLKey, issuerURL(r))
+
+	client := api.NewAuthenticationClient(o.authenticator)
+	tokens, err := client.CreateAccessTokenFromIDToken(ctx, body.IDToken, body.Nonce, body.RefreshToken)
+	if err != nil {
+		switch err {
+		case api.ErrOIDCDisabled:
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case corev2.ErrUnauthorized:
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		default:
+			logger.WithError(err).Error("could not issue an access token")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(tokens); err != nil {
+		logger.WithError(err).Error("couldn't write response body")
+	}
+}
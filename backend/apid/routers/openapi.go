@@ -0,0 +1,36 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+)
+
+// OpenAPIRouter handles requests for /api/openapi.json
+type OpenAPIRouter struct {
+	spec *openapi.Spec
+}
+
+// NewOpenAPIRouter instantiates a new router serving the OpenAPI document of
+// the API
+func NewOpenAPIRouter(spec *openapi.Spec) *OpenAPIRouter {
+	return &OpenAPIRouter{spec: spec}
+}
+
+// Mount the OpenAPIRouter to a parent Router
+func (r *OpenAPIRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/api/openapi.json", r.document).Methods(http.MethodGet)
+}
+
+func (r *OpenAPIRouter) document(w http.ResponseWriter, _ *http.Request) {
+	doc, err := r.spec.Document()
+	if err != nil {
+		WriteError(w, actions.NewError(actions.InternalErr, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(doc)
+}
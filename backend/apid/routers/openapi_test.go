@@ -0,0 +1,39 @@
+package routers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/openapi"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIRouter(t *testing.T) {
+	router := mux.NewRouter()
+	NewOpenAPIRouter(openapi.NewSpec(router)).Mount(router)
+	NewClusterRolesRouter(&mockstore.MockStore{}).Mount(router.PathPrefix("/api/{group:core}/{version:v2}").Subrouter())
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var doc struct {
+		OpenAPI string
+		Paths   map[string]map[string]struct {
+			OperationID string
+		}
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Equal(t, "listClusterRole", doc.Paths["/api/core/v2/clusterroles"]["get"].OperationID)
+	assert.Equal(t, "getOpenapiJson", doc.Paths["/api/openapi.json"]["get"].OperationID)
+}
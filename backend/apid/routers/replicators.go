@@ -0,0 +1,78 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ReplicatorsRouter handles requests for replicators.
+type ReplicatorsRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewReplicatorsRouter instantiates a new router for replicators.
+func NewReplicatorsRouter(store store.ResourceStore) *ReplicatorsRouter {
+	return &ReplicatorsRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.Replicator{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the ReplicatorsRouter on the given parent Router
+func (r *ReplicatorsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:replicators}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.get)
+	routes.List(r.list, corev2.ReplicatorFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.apply)
+}
+
+func (r *ReplicatorsRouter) get(req *http.Request) (interface{}, error) {
+	resource, err := r.handlers.GetResource(req)
+	if err != nil {
+		return nil, err
+	}
+	return redactReplicator(resource), nil
+}
+
+func (r *ReplicatorsRouter) list(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+	resources, err := r.handlers.ListResources(ctx, pred)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources {
+		redactReplicator(resource)
+	}
+	return resources, nil
+}
+
+// apply applies the patch, and redacts the replicator returned by a dry run.
+func (r *ReplicatorsRouter) apply(req *http.Request) (interface{}, error) {
+	resource, err := r.handlers.ApplyResource(req)
+	if err != nil {
+		return nil, err
+	}
+	return redactReplicator(resource), nil
+}
+
+// redactReplicator removes the API key of the remote cluster from the
+// replicator, like the passwords of the users, so that it is never returned.
+func redactReplicator(resource interface{}) interface{} {
+	if replicator, ok := resource.(*corev2.Replicator); ok {
+		replicator.APIKey = ""
+	}
+	return resource
+}
@@ -0,0 +1,70 @@
+package routers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicatorsRouter(t *testing.T) {
+	// Setup the router
+	s := &mockstore.MockStore{}
+	router := NewReplicatorsRouter(s)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.Replicator{}
+	fixture := corev2.FixtureReplicator("foo")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
+
+func TestReplicatorsRouterRedactsAPIKey(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewReplicatorsRouter(s)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	fixture := corev2.FixtureReplicator("foo")
+	s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.Replicator")).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*corev2.Replicator) = *corev2.FixtureReplicator("foo")
+		})
+	s.On("ListResources", mock.Anything, fixture.StorePrefix(), mock.AnythingOfType("*[]*v2.Replicator"), mock.AnythingOfType("*store.SelectionPredicate")).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			replicators := args.Get(2).(*[]*corev2.Replicator)
+			*replicators = append(*replicators, corev2.FixtureReplicator("foo"))
+		})
+
+	for _, p := range []string{fixture.URIPath(), path.Dir(fixture.URIPath())} {
+		resp, err := http.Get(server.URL + p)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, string(body), fixture.URL)
+		assert.NotContains(t, string(body), fixture.APIKey)
+	}
+}
@@ -0,0 +1,70 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// quotaUsage represents the usage reporting needs of the
+// ResourceQuotasRouter.
+type quotaUsage interface {
+	Usage(ctx context.Context, quota *corev2.ResourceQuota) (map[string]corev2.ResourceUsage, error)
+}
+
+// ResourceQuotasRouter handles requests for /resource-quotas
+type ResourceQuotasRouter struct {
+	handlers handlers.Handlers
+	usage    quotaUsage
+}
+
+// NewResourceQuotasRouter instantiates new router for controlling resource
+// quota resources, reporting the usage of the quotas with the given usage.
+func NewResourceQuotasRouter(store store.ResourceStore, usage quotaUsage) *ResourceQuotasRouter {
+	return &ResourceQuotasRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.ResourceQuota{},
+			Store:    store,
+		},
+		usage: usage,
+	}
+}
+
+// Mount the ResourceQuotasRouter to a parent Router
+func (r *ResourceQuotasRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:resource-quotas}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.ResourceQuotaFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:resource-quotas}", corev2.ResourceQuotaFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+
+	// Custom
+	routes.Path("{id}/{subresource:usage}", r.getUsage).Methods(http.MethodGet)
+}
+
+// getUsage returns the number of resources of each type limited by the
+// resource quotas in the namespace, along with the limits of the quota.
+func (r *ResourceQuotasRouter) getUsage(req *http.Request) (interface{}, error) {
+	resource, err := r.handlers.GetResource(req)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := r.usage.Usage(req.Context(), resource.(*corev2.ResourceQuota))
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return usage, nil
+}
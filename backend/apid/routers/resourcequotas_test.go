@@ -0,0 +1,75 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockQuotaUsage reports the number of checks of the namespace.
+type mockQuotaUsage int64
+
+func (u mockQuotaUsage) Usage(ctx context.Context, quota *corev2.ResourceQuota) (map[string]corev2.ResourceUsage, error) {
+	return map[string]corev2.ResourceUsage{
+		corev2.ChecksResource: {Used: int64(u), Limit: quota.Checks},
+	}, nil
+}
+
+func TestResourceQuotasRouter(t *testing.T) {
+	// Setup the router
+	s := &mockstore.MockStore{}
+	router := NewResourceQuotasRouter(s, mockQuotaUsage(0))
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	empty := &corev2.ResourceQuota{}
+	fixture := corev2.FixtureResourceQuota("foo")
+
+	tests := []routerTestCase{}
+	tests = append(tests, getTestCases(fixture)...)
+	tests = append(tests, listTestCases(empty)...)
+	tests = append(tests, createTestCases(empty)...)
+	tests = append(tests, updateTestCases(fixture)...)
+	tests = append(tests, deleteTestCases(fixture)...)
+	for _, tt := range tests {
+		run(t, tt, parentRouter, s)
+	}
+}
+
+func TestResourceQuotasRouterUsage(t *testing.T) {
+	s := &mockstore.MockStore{}
+	router := NewResourceQuotasRouter(s, mockQuotaUsage(42))
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	fixture := corev2.FixtureResourceQuota("foo")
+	s.On("GetResource", mock.Anything, "foo", mock.AnythingOfType("*v2.ResourceQuota")).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*corev2.ResourceQuota) = *fixture
+		}).Return(nil)
+	s.On("GetResource", mock.Anything, "bar", mock.Anything).Return(&store.ErrNotFound{})
+
+	req := httptest.NewRequest(http.MethodGet, fixture.URIPath()+"/usage", nil)
+	w := httptest.NewRecorder()
+	parentRouter.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var usage map[string]corev2.ResourceUsage
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&usage))
+	assert.Equal(t, corev2.ResourceUsage{Used: 42, Limit: 100}, usage[corev2.ChecksResource])
+
+	req = httptest.NewRequest(http.MethodGet, corev2.FixtureResourceQuota("bar").URIPath()+"/usage", nil)
+	w = httptest.NewRecorder()
+	parentRouter.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
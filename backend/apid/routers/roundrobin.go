@@ -0,0 +1,68 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/schedulerd"
+)
+
+// RoundRobinStatusGetter represents the round robin scheduling needs of the
+// RoundRobinRouter.
+type RoundRobinStatusGetter interface {
+	Status(ctx context.Context, check *corev2.CheckConfig) (*schedulerd.RoundRobinStatus, error)
+}
+
+// checkConfigGetter represents the store needs of the RoundRobinRouter.
+type checkConfigGetter interface {
+	GetCheckConfigByName(ctx context.Context, name string) (*corev2.CheckConfig, error)
+}
+
+// RoundRobinRouter handles requests for the round robin scheduling status of
+// checks.
+type RoundRobinRouter struct {
+	store  checkConfigGetter
+	status RoundRobinStatusGetter
+}
+
+// NewRoundRobinRouter instantiates a new router for the round robin
+// scheduling status of checks.
+func NewRoundRobinRouter(store checkConfigGetter, status RoundRobinStatusGetter) *RoundRobinRouter {
+	return &RoundRobinRouter{
+		store:  store,
+		status: status,
+	}
+}
+
+// Mount the RoundRobinRouter to a parent Router
+func (r *RoundRobinRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:checks}",
+	}
+
+	routes.Path("{id}/round-robin", r.get).Methods(http.MethodGet)
+}
+
+func (r *RoundRobinRouter) get(req *http.Request) (interface{}, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+	check, err := r.store.GetCheckConfigByName(req.Context(), id)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	if check == nil {
+		return nil, actions.NewErrorf(actions.NotFound)
+	}
+	status, err := r.status.Status(req.Context(), check)
+	if err != nil {
+		return nil, actions.NewError(actions.InternalErr, err)
+	}
+	return status, nil
+}
@@ -0,0 +1,60 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/schedulerd"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRoundRobinStatusGetter struct{}
+
+func (m mockRoundRobinStatusGetter) Status(ctx context.Context, check *corev2.CheckConfig) (*schedulerd.RoundRobinStatus, error) {
+	return &schedulerd.RoundRobinStatus{
+		Check:      check.Name,
+		Namespace:  check.Namespace,
+		RoundRobin: check.RoundRobin,
+		Skipped:    1,
+	}, nil
+}
+
+func TestRoundRobinRouter(t *testing.T) {
+	check := corev2.FixtureCheckConfig("check1")
+	check.RoundRobin = true
+
+	s := &mockstore.MockStore{}
+	s.On("GetCheckConfigByName", mock.Anything, "check1").Return(check, nil)
+	s.On("GetCheckConfigByName", mock.Anything, "missing").Return((*corev2.CheckConfig)(nil), nil)
+
+	router := mux.NewRouter().UseEncodedPath()
+	NewRoundRobinRouter(s, mockRoundRobinStatusGetter{}).Mount(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	req := newRequest(t, http.MethodGet, server.URL+"/namespaces/default/checks/check1/round-robin", nil)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var status schedulerd.RoundRobinStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	assert.Equal(t, "check1", status.Check)
+	assert.True(t, status.RoundRobin)
+	assert.Equal(t, 1, status.Skipped)
+
+	req = newRequest(t, http.MethodGet, server.URL+"/namespaces/default/checks/missing/round-robin", nil)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -5,8 +5,10 @@ import (
 	"io"
 	"net/http"
 	"path"
+	"strconv"
 
 	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 )
 
@@ -30,8 +32,26 @@ func RespondWith(w http.ResponseWriter, r *http.Request, resources interface{})
 		return
 	}
 
-	// Marshal
-	bytes, err := json.Marshal(resources)
+	// The version of a resource is its entity tag, so that clients can give
+	// it in the If-Match header of their requests
+	if resource, ok := resources.(corev2.Resource); ok {
+		if version := resource.GetObjectMeta().ResourceVersion; version != "" {
+			w.Header().Set("ETag", strconv.Quote(version))
+		}
+	}
+
+	// Marshal, with only the fields selected by the client if any
+	selection, err := parseFieldSelection(r)
+	if err != nil {
+		WriteError(w, actions.NewError(actions.InvalidArgument, err))
+		return
+	}
+	var bytes []byte
+	if selection != nil {
+		bytes, err = selectFields(resources, selection)
+	} else {
+		bytes, err = json.Marshal(resources)
+	}
 	if err != nil {
 		WriteError(w, err)
 		return
@@ -95,6 +115,12 @@ func HTTPStatusFromCode(code actions.ErrCode) int {
 		return http.StatusConflict
 	case actions.PaymentRequired:
 		return http.StatusPaymentRequired
+	case actions.PreconditionFailed:
+		return http.StatusPreconditionFailed
+	case actions.TooManyRequests:
+		return http.StatusTooManyRequests
+	case actions.QuotaExceeded:
+		return http.StatusForbidden
 	case actions.PermissionDenied:
 		return http.StatusNotFound
 	case actions.Unauthenticated:
@@ -161,7 +187,7 @@ type listHandlerFunc func(w http.ResponseWriter, req *http.Request) (interface{}
 //   routes.Get(myShowAction)     // given action is mounted at GET /checks/:id
 //   routes.List(myIndexAction)   // given action is mounted at GET /checks
 //   routes.Put(myCreateAction)   // given action is mounted at PUT /checks/:id
-//   routes.Patch(myUpdateAction) // given action is mounted at PATCH /checks/:id
+//   routes.Patch(myApplyAction)  // given action is mounted at PATCH /checks/:id
 //   routes.Post(myCreateAction)  // given action is mounted at POST /checks
 //   routes.Del(myCreateAction)   // given action is mounted at DELETE /checks/:id
 //   routes.Path("{id}/publish", publishAction).Methods(http.MethodDelete) // when you need something customer
@@ -191,12 +217,10 @@ func (r *ResourceRoute) Post(fn actionHandlerFunc) *mux.Route {
 	return r.Path("", fn).Methods(http.MethodPost)
 }
 
-// TODO: uncomment this and use it once controller update fits
-// http patch semantics.
-// Patch updates/modifies
-// func (r *ResourceRoute) Patch(fn actionHandlerFunc) *mux.Route {
-// 	return r.Path("{id}", fn).Methods(http.MethodPatch)
-// }
+// Patch applies changes
+func (r *ResourceRoute) Patch(fn actionHandlerFunc) *mux.Route {
+	return r.Path("{id}", fn).Methods(http.MethodPatch)
+}
 
 // Put updates/replaces
 func (r *ResourceRoute) Put(fn actionHandlerFunc) *mux.Route {
@@ -218,6 +242,15 @@ func handleAction(router *mux.Router, path string, fn actionHandlerFunc) *mux.Ro
 	return router.HandleFunc(path, actionHandler(fn))
 }
 
+// unsupportedDryRun returns an error if the given request is a dry run, for
+// the actions that can't validate their changes without persisting them.
+func unsupportedDryRun(req *http.Request) error {
+	if corev2.DryRunFromContext(req.Context()) {
+		return actions.NewErrorf(actions.InvalidArgument, "dry run is not supported for this request")
+	}
+	return nil
+}
+
 // UnmarshalBody decodes the request body
 func UnmarshalBody(req *http.Request, record interface{}) error {
 	err := json.NewDecoder(req.Body).Decode(&record)
@@ -0,0 +1,894 @@
+package routers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// The SCIM schemas, see RFC 7643 and RFC 7644.
+const (
+	scimUserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	scimContentType = "application/scim+json"
+)
+
+// scimFilterRegexp matches the only filters supported, the equality of an
+// attribute, which are the ones used by the identity management systems to
+// look up the users and groups before provisioning them.
+var scimFilterRegexp = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// SCIMUserController represents the controller needs of the SCIMRouter.
+type SCIMUserController interface {
+	List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error)
+	Get(ctx context.Context, name string) (*corev2.User, error)
+	Create(ctx context.Context, user *corev2.User) error
+	CreateOrReplace(ctx context.Context, user *corev2.User) error
+	Disable(ctx context.Context, name string) error
+	Enable(ctx context.Context, name string) error
+	AddGroup(ctx context.Context, name string, group string) error
+	RemoveGroup(ctx context.Context, name string, group string) error
+}
+
+// SCIMRouter handles the requests of the identity management systems for
+// /scim/v2, which provision the users and their group memberships with the
+// SCIM protocol. The users are identified by their username and the groups
+// by their name. Since Sensu groups only exist through their members, a
+// group without members is not found.
+//
+// The users are never deleted: deprovisioning a user disables it, which
+// revokes its API keys and rejects its access tokens.
+type SCIMRouter struct {
+	controller SCIMUserController
+	auth       authorization.Authorizer
+}
+
+// NewSCIMRouter instantiates a new router for the SCIM provisioning API.
+func NewSCIMRouter(store store.Store, auth authorization.Authorizer) *SCIMRouter {
+	return &SCIMRouter{
+		controller: actions.NewUserController(store),
+		auth:       auth,
+	}
+}
+
+// Mount the SCIMRouter to a parent Router
+func (r *SCIMRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/Users", r.handle(r.listUsers)).Methods(http.MethodGet)
+	parent.HandleFunc("/Users", r.handle(r.createUser)).Methods(http.MethodPost)
+	parent.HandleFunc("/Users/{id}", r.handle(r.getUser)).Methods(http.MethodGet)
+	parent.HandleFunc("/Users/{id}", r.handle(r.replaceUser)).Methods(http.MethodPut)
+	parent.HandleFunc("/Users/{id}", r.handle(r.patchUser)).Methods(http.MethodPatch)
+	parent.HandleFunc("/Users/{id}", r.handle(r.deleteUser)).Methods(http.MethodDelete)
+
+	parent.HandleFunc("/Groups", r.handle(r.listGroups)).Methods(http.MethodGet)
+	parent.HandleFunc("/Groups", r.handle(r.createGroup)).Methods(http.MethodPost)
+	parent.HandleFunc("/Groups/{id}", r.handle(r.getGroup)).Methods(http.MethodGet)
+	parent.HandleFunc("/Groups/{id}", r.handle(r.replaceGroup)).Methods(http.MethodPut)
+	parent.HandleFunc("/Groups/{id}", r.handle(r.patchGroup)).Methods(http.MethodPatch)
+	parent.HandleFunc("/Groups/{id}", r.handle(r.deleteGroup)).Methods(http.MethodDelete)
+}
+
+// scimUser is the SCIM representation of a user.
+type scimUser struct {
+	Schemas  []string      `json:"schemas"`
+	ID       string        `json:"id,omitempty"`
+	UserName string        `json:"userName"`
+	Active   *bool         `json:"active,omitempty"`
+	Password string        `json:"password,omitempty"`
+	Groups   []scimMember  `json:"groups,omitempty"`
+	Meta     *scimMetadata `json:"meta,omitempty"`
+}
+
+// scimGroup is the SCIM representation of a group.
+type scimGroup struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []scimMember  `json:"members"`
+	Meta        *scimMetadata `json:"meta,omitempty"`
+}
+
+// scimMember is a member of a group, or a group of a user.
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimMetadata struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+type scimListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// scimError is an error of the SCIM protocol, with its SCIM type.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e scimError) Error() string {
+	return e.detail
+}
+
+func scimBadRequest(scimType, format string, args ...interface{}) error {
+	return scimError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+type scimResponse struct {
+	status int
+	body   interface{}
+}
+
+// handle writes the response or the error of a SCIM handler in the SCIM
+// format.
+func (r *SCIMRouter) handle(fn func(*http.Request) (scimResponse, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		res, err := fn(req)
+		if err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", scimContentType)
+		if res.body == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(res.status)
+		if err := json.NewEncoder(w).Encode(res.body); err != nil {
+			logger.WithError(err).Error("failed to write response")
+		}
+	}
+}
+
+func writeSCIMError(w http.ResponseWriter, err error) {
+	body := scimErrorResponse{
+		Schemas: []string{scimErrorSchema},
+		Detail:  err.Error(),
+	}
+	st := http.StatusInternalServerError
+	switch err := err.(type) {
+	case scimError:
+		st = err.status
+		body.ScimType = err.scimType
+	case actions.Error:
+		st = HTTPStatusFromCode(err.Code)
+		switch err.Code {
+		case actions.PermissionDenied:
+			st = http.StatusForbidden
+		case actions.AlreadyExistsErr:
+			body.ScimType = "uniqueness"
+		}
+	}
+	body.Status = strconv.Itoa(st)
+
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(st)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.WithError(err).Error("failed to write response")
+	}
+}
+
+// authorize verifies that the user of the request may perform the operation
+// of the given verb on the users.
+func (r *SCIMRouter) authorize(req *http.Request, verb, name string) error {
+	attrs := &authorization.Attributes{
+		APIGroup:     "core",
+		APIVersion:   "v2",
+		Resource:     corev2.UsersResource,
+		ResourceName: name,
+		Verb:         verb,
+	}
+	if err := middlewares.GetUser(req.Context(), attrs); err != nil {
+		return actions.NewError(actions.Unauthenticated, err)
+	}
+	authorized, err := r.auth.Authorize(req.Context(), attrs)
+	if err != nil {
+		return actions.NewError(actions.InternalErr, err)
+	}
+	if !authorized {
+		return actions.NewErrorf(actions.PermissionDenied)
+	}
+	return nil
+}
+
+func (r *SCIMRouter) listUsers(req *http.Request) (scimResponse, error) {
+	if err := r.authorize(req, "list", ""); err != nil {
+		return scimResponse{}, err
+	}
+	users, err := r.users(req.Context())
+	if err != nil {
+		return scimResponse{}, err
+	}
+
+	if filter := req.URL.Query().Get("filter"); filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return scimResponse{}, err
+		}
+		if !strings.EqualFold(attr, "userName") {
+			return scimResponse{}, scimBadRequest("invalidFilter", "unsupported filter attribute %q", attr)
+		}
+		var filtered []*corev2.User
+		for _, user := range users {
+			if user.Username == value {
+				filtered = append(filtered, user)
+			}
+		}
+		users = filtered
+	}
+
+	resources := make([]interface{}, len(users))
+	for i, user := range users {
+		resources[i] = newSCIMUser(req, user)
+	}
+	return listResponse(req, resources)
+}
+
+func (r *SCIMRouter) getUser(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	if err := r.authorize(req, "get", id); err != nil {
+		return scimResponse{}, err
+	}
+	user, err := r.controller.Get(req.Context(), id)
+	if err != nil {
+		return scimResponse{}, err
+	}
+	return scimResponse{status: http.StatusOK, body: newSCIMUser(req, user)}, nil
+}
+
+func (r *SCIMRouter) createUser(req *http.Request) (scimResponse, error) {
+	var body scimUser
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+	}
+	if err := r.authorize(req, "create", body.UserName); err != nil {
+		return scimResponse{}, err
+	}
+
+	user := &corev2.User{
+		Username: body.UserName,
+		Password: body.Password,
+		Disabled: body.Active != nil && !*body.Active,
+	}
+	for _, group := range body.Groups {
+		user.Groups = append(user.Groups, group.Value)
+	}
+	// The users provisioned without a password log in with another provider,
+	// so they are given a password nobody knows
+	if user.Password == "" {
+		password, err := randomPassword()
+		if err != nil {
+			return scimResponse{}, actions.NewError(actions.InternalErr, err)
+		}
+		user.Password = password
+	}
+	if err := r.controller.Create(req.Context(), user); err != nil {
+		return scimResponse{}, err
+	}
+
+	return scimResponse{status: http.StatusCreated, body: newSCIMUser(req, user)}, nil
+}
+
+func (r *SCIMRouter) replaceUser(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	var body scimUser
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+	}
+	if body.UserName != "" && body.UserName != id {
+		return scimResponse{}, scimBadRequest("mutability", "the username of a user can't be changed")
+	}
+	if err := r.authorize(req, "update", id); err != nil {
+		return scimResponse{}, err
+	}
+
+	user, err := r.controller.Get(req.Context(), id)
+	if err != nil {
+		return scimResponse{}, err
+	}
+	if body.Password != "" {
+		user.Password = body.Password
+		if err := r.controller.CreateOrReplace(req.Context(), user); err != nil {
+			return scimResponse{}, err
+		}
+	}
+	if body.Groups != nil {
+		groups := make([]string, len(body.Groups))
+		for i, group := range body.Groups {
+			groups[i] = group.Value
+		}
+		if err := r.setGroups(req.Context(), user, groups); err != nil {
+			return scimResponse{}, err
+		}
+	}
+	if body.Active != nil {
+		if err := r.setActive(req.Context(), user, *body.Active); err != nil {
+			return scimResponse{}, err
+		}
+	}
+
+	return r.getUser(req)
+}
+
+func (r *SCIMRouter) patchUser(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	var body scimPatchRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+	}
+	if err := r.authorize(req, "update", id); err != nil {
+		return scimResponse{}, err
+	}
+
+	user, err := r.controller.Get(req.Context(), id)
+	if err != nil {
+		return scimResponse{}, err
+	}
+	for _, op := range body.Operations {
+		if !strings.EqualFold(op.Op, "replace") && !strings.EqualFold(op.Op, "add") {
+			return scimResponse{}, scimBadRequest("invalidValue", "unsupported operation %q on a user", op.Op)
+		}
+
+		// The attributes are either given by the path, or by the keys of the
+		// value if there is no path
+		attrs := map[string]json.RawMessage{}
+		if op.Path != "" {
+			attrs[op.Path] = op.Value
+		} else if err := json.Unmarshal(op.Value, &attrs); err != nil {
+			return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+		}
+
+		for attr, value := range attrs {
+			switch {
+			case strings.EqualFold(attr, "active"):
+				active, err := parseSCIMBool(value)
+				if err != nil {
+					return scimResponse{}, err
+				}
+				if err := r.setActive(req.Context(), user, active); err != nil {
+					return scimResponse{}, err
+				}
+			case strings.EqualFold(attr, "password"):
+				var password string
+				if err := json.Unmarshal(value, &password); err != nil {
+					return scimResponse{}, scimBadRequest("invalidValue", "%s", err)
+				}
+				user.Password = password
+				if err := r.controller.CreateOrReplace(req.Context(), user); err != nil {
+					return scimResponse{}, err
+				}
+			default:
+				// The other attributes of the identity management systems,
+				// e.g. the names and emails, are not stored by Sensu
+			}
+		}
+	}
+
+	return r.getUser(req)
+}
+
+func (r *SCIMRouter) deleteUser(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	if err := r.authorize(req, "delete", id); err != nil {
+		return scimResponse{}, err
+	}
+	if err := r.controller.Disable(req.Context(), id); err != nil {
+		return scimResponse{}, err
+	}
+	return scimResponse{}, nil
+}
+
+func (r *SCIMRouter) listGroups(req *http.Request) (scimResponse, error) {
+	if err := r.authorize(req, "list", ""); err != nil {
+		return scimResponse{}, err
+	}
+	users, err := r.users(req.Context())
+	if err != nil {
+		return scimResponse{}, err
+	}
+	groups := groupMembers(users)
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	if filter := req.URL.Query().Get("filter"); filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return scimResponse{}, err
+		}
+		if !strings.EqualFold(attr, "displayName") {
+			return scimResponse{}, scimBadRequest("invalidFilter", "unsupported filter attribute %q", attr)
+		}
+		names = names[:0]
+		if _, ok := groups[value]; ok {
+			names = append(names, value)
+		}
+	}
+	sort.Strings(names)
+
+	resources := make([]interface{}, len(names))
+	for i, name := range names {
+		resources[i] = newSCIMGroup(req, name, groups[name])
+	}
+	return listResponse(req, resources)
+}
+
+func (r *SCIMRouter) getGroup(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	if err := r.authorize(req, "list", ""); err != nil {
+		return scimResponse{}, err
+	}
+	users, err := r.users(req.Context())
+	if err != nil {
+		return scimResponse{}, err
+	}
+	members, ok := groupMembers(users)[id]
+	if !ok {
+		return scimResponse{}, actions.NewErrorf(actions.NotFound)
+	}
+	return scimResponse{status: http.StatusOK, body: newSCIMGroup(req, id, members)}, nil
+}
+
+func (r *SCIMRouter) createGroup(req *http.Request) (scimResponse, error) {
+	var body scimGroup
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+	}
+	if body.DisplayName == "" {
+		return scimResponse{}, scimBadRequest("invalidValue", "the group must have a display name")
+	}
+	if err := r.authorize(req, "update", ""); err != nil {
+		return scimResponse{}, err
+	}
+	users, err := r.users(req.Context())
+	if err != nil {
+		return scimResponse{}, err
+	}
+	if _, ok := groupMembers(users)[body.DisplayName]; ok {
+		return scimResponse{}, actions.NewErrorf(actions.AlreadyExistsErr)
+	}
+
+	var members []string
+	for _, member := range body.Members {
+		if err := r.controller.AddGroup(req.Context(), member.Value, body.DisplayName); err != nil {
+			return scimResponse{}, err
+		}
+		members = append(members, member.Value)
+	}
+	return scimResponse{status: http.StatusCreated, body: newSCIMGroup(req, body.DisplayName, members)}, nil
+}
+
+func (r *SCIMRouter) replaceGroup(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	var body scimGroup
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+	}
+	if err := r.authorize(req, "update", ""); err != nil {
+		return scimResponse{}, err
+	}
+
+	members := make([]string, len(body.Members))
+	for i, member := range body.Members {
+		members[i] = member.Value
+	}
+	name := id
+	if body.DisplayName != "" {
+		name = body.DisplayName
+	}
+	if err := r.replaceMembers(req.Context(), id, name, members); err != nil {
+		return scimResponse{}, err
+	}
+	return scimResponse{status: http.StatusOK, body: newSCIMGroup(req, name, members)}, nil
+}
+
+func (r *SCIMRouter) patchGroup(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	var body scimPatchRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+	}
+	if err := r.authorize(req, "update", ""); err != nil {
+		return scimResponse{}, err
+	}
+
+	ctx := req.Context()
+	name := id
+	for _, op := range body.Operations {
+		path := strings.TrimSpace(op.Path)
+		switch {
+		case strings.EqualFold(op.Op, "add") && strings.EqualFold(path, "members"):
+			members, err := parseSCIMMembers(op.Value)
+			if err != nil {
+				return scimResponse{}, err
+			}
+			for _, member := range members {
+				if err := r.controller.AddGroup(ctx, member, name); err != nil {
+					return scimResponse{}, err
+				}
+			}
+		case strings.EqualFold(op.Op, "remove") && strings.HasPrefix(strings.ToLower(path), "members"):
+			var members []string
+			if filter := strings.TrimPrefix(path[len("members"):], "["); filter != "" {
+				// e.g. members[value eq "jane"]
+				attr, value, err := parseSCIMFilter(strings.TrimSuffix(filter, "]"))
+				if err != nil {
+					return scimResponse{}, err
+				}
+				if !strings.EqualFold(attr, "value") {
+					return scimResponse{}, scimBadRequest("invalidFilter", "unsupported filter attribute %q", attr)
+				}
+				members = []string{value}
+			} else if len(op.Value) > 0 {
+				if members, err = parseSCIMMembers(op.Value); err != nil {
+					return scimResponse{}, err
+				}
+			} else {
+				// All the members are removed
+				if err := r.replaceMembers(ctx, name, name, nil); err != nil {
+					return scimResponse{}, err
+				}
+			}
+			for _, member := range members {
+				if err := r.controller.RemoveGroup(ctx, member, name); err != nil {
+					return scimResponse{}, err
+				}
+			}
+		case strings.EqualFold(op.Op, "replace") && strings.EqualFold(path, "members"):
+			members, err := parseSCIMMembers(op.Value)
+			if err != nil {
+				return scimResponse{}, err
+			}
+			if err := r.replaceMembers(ctx, name, name, members); err != nil {
+				return scimResponse{}, err
+			}
+		case strings.EqualFold(op.Op, "replace") && (path == "" || strings.EqualFold(path, "displayName")):
+			var newName string
+			if path == "" {
+				var attrs struct {
+					DisplayName string `json:"displayName"`
+				}
+				if err := json.Unmarshal(op.Value, &attrs); err != nil {
+					return scimResponse{}, scimBadRequest("invalidSyntax", "%s", err)
+				}
+				newName = attrs.DisplayName
+			} else if err := json.Unmarshal(op.Value, &newName); err != nil {
+				return scimResponse{}, scimBadRequest("invalidValue", "%s", err)
+			}
+			if newName == "" || newName == name {
+				continue
+			}
+			if err := r.renameGroup(ctx, name, newName); err != nil {
+				return scimResponse{}, err
+			}
+			name = newName
+		default:
+			return scimResponse{}, scimBadRequest("invalidPath", "unsupported operation %q on %q", op.Op, op.Path)
+		}
+	}
+
+	users, err := r.users(ctx)
+	if err != nil {
+		return scimResponse{}, err
+	}
+	return scimResponse{status: http.StatusOK, body: newSCIMGroup(req, name, groupMembers(users)[name])}, nil
+}
+
+func (r *SCIMRouter) deleteGroup(req *http.Request) (scimResponse, error) {
+	id, err := url.PathUnescape(mux.Vars(req)["id"])
+	if err != nil {
+		return scimResponse{}, err
+	}
+	if err := r.authorize(req, "update", ""); err != nil {
+		return scimResponse{}, err
+	}
+	users, err := r.users(req.Context())
+	if err != nil {
+		return scimResponse{}, err
+	}
+	members, ok := groupMembers(users)[id]
+	if !ok {
+		return scimResponse{}, actions.NewErrorf(actions.NotFound)
+	}
+	for _, member := range members {
+		if err := r.controller.RemoveGroup(req.Context(), member, id); err != nil {
+			return scimResponse{}, err
+		}
+	}
+	return scimResponse{}, nil
+}
+
+// users returns all the users, sorted by username.
+func (r *SCIMRouter) users(ctx context.Context) ([]*corev2.User, error) {
+	resources, err := r.controller.List(ctx, &store.SelectionPredicate{})
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*corev2.User, 0, len(resources))
+	for _, resource := range resources {
+		if user, ok := resource.(*corev2.User); ok {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+	return users, nil
+}
+
+// replaceMembers replaces the members of the group named from by the given
+// members, under the name to.
+func (r *SCIMRouter) replaceMembers(ctx context.Context, from, to string, members []string) error {
+	users, err := r.users(ctx)
+	if err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, member := range members {
+		keep[member] = true
+	}
+	for _, member := range groupMembers(users)[from] {
+		if from == to && keep[member] {
+			continue
+		}
+		if err := r.controller.RemoveGroup(ctx, member, from); err != nil {
+			return err
+		}
+	}
+	for _, member := range members {
+		if err := r.controller.AddGroup(ctx, member, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *SCIMRouter) renameGroup(ctx context.Context, from, to string) error {
+	users, err := r.users(ctx)
+	if err != nil {
+		return err
+	}
+	return r.replaceMembers(ctx, from, to, groupMembers(users)[from])
+}
+
+// setGroups replaces the groups of a user.
+func (r *SCIMRouter) setGroups(ctx context.Context, user *corev2.User, groups []string) error {
+	keep := map[string]bool{}
+	for _, group := range groups {
+		keep[group] = true
+	}
+	for _, group := range user.Groups {
+		if !keep[group] {
+			if err := r.controller.RemoveGroup(ctx, user.Username, group); err != nil {
+				return err
+			}
+		}
+	}
+	for _, group := range groups {
+		if err := r.controller.AddGroup(ctx, user.Username, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setActive disables or reinstates a user.
+func (r *SCIMRouter) setActive(ctx context.Context, user *corev2.User, active bool) error {
+	if active {
+		return r.controller.Enable(ctx, user.Username)
+	}
+	return r.controller.Disable(ctx, user.Username)
+}
+
+// groupMembers returns the usernames of the members of each group.
+func groupMembers(users []*corev2.User) map[string][]string {
+	groups := map[string][]string{}
+	for _, user := range users {
+		for _, group := range user.Groups {
+			groups[group] = append(groups[group], user.Username)
+		}
+	}
+	return groups
+}
+
+func newSCIMUser(req *http.Request, user *corev2.User) scimUser {
+	active := !user.Disabled
+	result := scimUser{
+		Schemas:  []string{scimUserSchema},
+		ID:       user.Username,
+		UserName: user.Username,
+		Active:   &active,
+		Meta: &scimMetadata{
+			ResourceType: "User",
+			Location:     scimLocation(req, "Users", user.Username),
+		},
+	}
+	for _, group := range user.Groups {
+		result.Groups = append(result.Groups, scimMember{Value: group, Display: group})
+	}
+	return result
+}
+
+func newSCIMGroup(req *http.Request, name string, members []string) scimGroup {
+	result := scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          name,
+		DisplayName: name,
+		Members:     []scimMember{},
+		Meta: &scimMetadata{
+			ResourceType: "Group",
+			Location:     scimLocation(req, "Groups", name),
+		},
+	}
+	for _, member := range members {
+		result.Members = append(result.Members, scimMember{Value: member, Display: member})
+	}
+	return result
+}
+
+// scimLocation returns the URL of a SCIM resource, relative to the SCIM
+// endpoint of the request.
+func scimLocation(req *http.Request, resource, id string) string {
+	prefix := req.URL.Path[:strings.Index(req.URL.Path, "/"+resource)]
+	return prefix + "/" + resource + "/" + url.PathEscape(id)
+}
+
+// listResponse returns the page of the resources requested with the
+// startIndex and count parameters.
+func listResponse(req *http.Request, resources []interface{}) (scimResponse, error) {
+	query := req.URL.Query()
+	start, count := 1, len(resources)
+	if value := query.Get("startIndex"); value != "" {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return scimResponse{}, scimBadRequest("invalidValue", "invalid startIndex %q", value)
+		}
+		if i > 1 {
+			start = i
+		}
+	}
+	if value := query.Get("count"); value != "" {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return scimResponse{}, scimBadRequest("invalidValue", "invalid count %q", value)
+		}
+		if i >= 0 && i < count {
+			count = i
+		}
+	}
+
+	page := []interface{}{}
+	if start <= len(resources) {
+		page = resources[start-1:]
+		if count < len(page) {
+			page = page[:count]
+		}
+	}
+	return scimResponse{status: http.StatusOK, body: scimListResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}}, nil
+}
+
+// parseSCIMFilter returns the attribute and the value of an equality filter.
+func parseSCIMFilter(filter string) (string, string, error) {
+	matches := scimFilterRegexp.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", "", scimBadRequest("invalidFilter", "unsupported filter %q", filter)
+	}
+	var value string
+	if err := json.Unmarshal([]byte(`"`+matches[2]+`"`), &value); err != nil {
+		return "", "", scimBadRequest("invalidFilter", "invalid filter value %q", matches[2])
+	}
+	return matches[1], value, nil
+}
+
+// parseSCIMBool parses a boolean, which some identity management systems send
+// as a string.
+func parseSCIMBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(strings.ToLower(s)); err == nil {
+			return b, nil
+		}
+	}
+	return false, scimBadRequest("invalidValue", "invalid boolean %s", value)
+}
+
+// parseSCIMMembers returns the usernames of a list of members.
+func parseSCIMMembers(value json.RawMessage) ([]string, error) {
+	var members []scimMember
+	if err := json.Unmarshal(value, &members); err != nil {
+		return nil, scimBadRequest("invalidValue", "%s", err)
+	}
+	usernames := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.Value == "" {
+			return nil, scimBadRequest("invalidValue", "a member has no value")
+		}
+		usernames = append(usernames, member.Value)
+	}
+	return usernames, nil
+}
+
+func randomPassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.New("could not generate a password")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,278 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryUserController keeps the users in memory.
+type memoryUserController struct {
+	users map[string]*corev2.User
+}
+
+func (c *memoryUserController) List(ctx context.Context, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+	var resources []corev2.Resource
+	for _, user := range c.users {
+		copy := *user
+		resources = append(resources, &copy)
+	}
+	return resources, nil
+}
+
+func (c *memoryUserController) Get(ctx context.Context, name string) (*corev2.User, error) {
+	user, ok := c.users[name]
+	if !ok {
+		return nil, actions.NewErrorf(actions.NotFound)
+	}
+	copy := *user
+	return &copy, nil
+}
+
+func (c *memoryUserController) Create(ctx context.Context, user *corev2.User) error {
+	if _, ok := c.users[user.Username]; ok {
+		return actions.NewErrorf(actions.AlreadyExistsErr)
+	}
+	return c.CreateOrReplace(ctx, user)
+}
+
+func (c *memoryUserController) CreateOrReplace(ctx context.Context, user *corev2.User) error {
+	copy := *user
+	c.users[user.Username] = &copy
+	return nil
+}
+
+func (c *memoryUserController) Disable(ctx context.Context, name string) error {
+	if _, ok := c.users[name]; !ok {
+		return actions.NewErrorf(actions.NotFound)
+	}
+	c.users[name].Disabled = true
+	return nil
+}
+
+func (c *memoryUserController) Enable(ctx context.Context, name string) error {
+	if _, ok := c.users[name]; !ok {
+		return actions.NewErrorf(actions.NotFound)
+	}
+	c.users[name].Disabled = false
+	return nil
+}
+
+func (c *memoryUserController) AddGroup(ctx context.Context, name string, group string) error {
+	user, ok := c.users[name]
+	if !ok {
+		return actions.NewErrorf(actions.NotFound)
+	}
+	for _, g := range user.Groups {
+		if g == group {
+			return nil
+		}
+	}
+	user.Groups = append(user.Groups, group)
+	return nil
+}
+
+func (c *memoryUserController) RemoveGroup(ctx context.Context, name string, group string) error {
+	user, ok := c.users[name]
+	if !ok {
+		return actions.NewErrorf(actions.NotFound)
+	}
+	groups := []string{}
+	for _, g := range user.Groups {
+		if g != group {
+			groups = append(groups, g)
+		}
+	}
+	user.Groups = groups
+	return nil
+}
+
+// verbAuthorizer authorizes the requests of the given verbs.
+type verbAuthorizer map[string]bool
+
+func (a verbAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	return a[attrs.Verb] && attrs.Resource == corev2.UsersResource, nil
+}
+
+func newSCIMTestRouter(auth authorization.Authorizer, users ...*corev2.User) (*mux.Router, *memoryUserController) {
+	controller := &memoryUserController{users: map[string]*corev2.User{}}
+	for _, user := range users {
+		controller.users[user.Username] = user
+	}
+	router := mux.NewRouter()
+	scim := &SCIMRouter{controller: controller, auth: auth}
+	scim.Mount(router.PathPrefix("/scim/v2").Subrouter())
+	return router, controller
+}
+
+func scimRequest(t *testing.T, router *mux.Router, method, path, body string, result interface{}) int {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req = req.WithContext(context.WithValue(req.Context(), corev2.ClaimsKey, corev2.FixtureClaims("okta", nil)))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if result != nil {
+		require.NoError(t, json.NewDecoder(w.Body).Decode(result), w.Body.String())
+	}
+	return w.Code
+}
+
+func TestSCIMRouterUsers(t *testing.T) {
+	all := verbAuthorizer{"get": true, "list": true, "create": true, "update": true, "delete": true}
+	router, controller := newSCIMTestRouter(all)
+
+	var user scimUser
+	status := scimRequest(t, router, http.MethodPost, "/scim/v2/Users",
+		`{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"jane","active":true,"groups":[{"value":"ops"}],"name":{"givenName":"Jane"}}`,
+		&user)
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "jane", user.ID)
+	assert.Equal(t, "/scim/v2/Users/jane", user.Meta.Location)
+	assert.Empty(t, user.Password)
+	// A password nobody knows is set
+	assert.True(t, len(controller.users["jane"].Password) >= 8)
+	assert.Equal(t, []string{"ops"}, controller.users["jane"].Groups)
+
+	status = scimRequest(t, router, http.MethodPost, "/scim/v2/Users", `{"userName":"jane"}`, nil)
+	assert.Equal(t, http.StatusConflict, status)
+
+	var list scimListResponse
+	status = scimRequest(t, router, http.MethodGet, `/scim/v2/Users?filter=userName+eq+"jane"`, "", &list)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 1, list.TotalResults)
+	status = scimRequest(t, router, http.MethodGet, `/scim/v2/Users?filter=userName+eq+"john"`, "", &list)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 0, list.TotalResults)
+	status = scimRequest(t, router, http.MethodGet, `/scim/v2/Users?filter=emails+co+"example"`, "", nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// Deactivation, as sent by Okta
+	status = scimRequest(t, router, http.MethodPatch, "/scim/v2/Users/jane",
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"active":false}}]}`,
+		&user)
+	require.Equal(t, http.StatusOK, status)
+	assert.False(t, *user.Active)
+	assert.True(t, controller.users["jane"].Disabled)
+
+	// Reactivation, as sent by Azure AD
+	status = scimRequest(t, router, http.MethodPatch, "/scim/v2/Users/jane",
+		`{"Operations":[{"op":"Replace","path":"active","value":"True"}]}`,
+		&user)
+	require.Equal(t, http.StatusOK, status)
+	assert.True(t, *user.Active)
+
+	status = scimRequest(t, router, http.MethodPut, "/scim/v2/Users/jane",
+		`{"userName":"jane","active":true,"groups":[{"value":"dev"}]}`, &user)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []string{"dev"}, controller.users["jane"].Groups)
+
+	status = scimRequest(t, router, http.MethodPut, "/scim/v2/Users/jane", `{"userName":"john"}`, nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status = scimRequest(t, router, http.MethodDelete, "/scim/v2/Users/jane", "", nil)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.True(t, controller.users["jane"].Disabled)
+
+	var scimErr scimErrorResponse
+	status = scimRequest(t, router, http.MethodGet, "/scim/v2/Users/john", "", &scimErr)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, []string{scimErrorSchema}, scimErr.Schemas)
+	assert.Equal(t, "404", scimErr.Status)
+}
+
+func TestSCIMRouterGroups(t *testing.T) {
+	all := verbAuthorizer{"get": true, "list": true, "update": true}
+	router, controller := newSCIMTestRouter(all,
+		corev2.FixtureUser("jane"),
+		corev2.FixtureUser("john"),
+	)
+	controller.users["jane"].Groups = []string{}
+	controller.users["john"].Groups = []string{}
+
+	var group scimGroup
+	status := scimRequest(t, router, http.MethodPost, "/scim/v2/Groups",
+		`{"displayName":"ops","members":[{"value":"jane"},{"value":"john"}]}`, &group)
+	require.Equal(t, http.StatusCreated, status)
+	assert.Len(t, group.Members, 2)
+
+	status = scimRequest(t, router, http.MethodPost, "/scim/v2/Groups", `{"displayName":"ops"}`, nil)
+	assert.Equal(t, http.StatusConflict, status)
+
+	status = scimRequest(t, router, http.MethodPatch, "/scim/v2/Groups/ops",
+		`{"Operations":[{"op":"remove","path":"members[value eq \"jane\"]"}]}`, &group)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []scimMember{{Value: "john", Display: "john"}}, group.Members)
+	assert.Empty(t, controller.users["jane"].Groups)
+
+	status = scimRequest(t, router, http.MethodPatch, "/scim/v2/Groups/ops",
+		`{"Operations":[{"op":"add","path":"members","value":[{"value":"jane"}]},{"op":"replace","path":"displayName","value":"sre"}]}`, &group)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "sre", group.ID)
+	assert.Len(t, group.Members, 2)
+	assert.Equal(t, []string{"sre"}, controller.users["jane"].Groups)
+	assert.Equal(t, []string{"sre"}, controller.users["john"].Groups)
+
+	status = scimRequest(t, router, http.MethodPut, "/scim/v2/Groups/sre",
+		`{"displayName":"sre","members":[{"value":"john"}]}`, &group)
+	require.Equal(t, http.StatusOK, status)
+	assert.Empty(t, controller.users["jane"].Groups)
+
+	var list scimListResponse
+	status = scimRequest(t, router, http.MethodGet, `/scim/v2/Groups?filter=displayName+eq+"sre"`, "", &list)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 1, list.TotalResults)
+
+	status = scimRequest(t, router, http.MethodDelete, "/scim/v2/Groups/sre", "", nil)
+	assert.Equal(t, http.StatusNoContent, status)
+	status = scimRequest(t, router, http.MethodGet, "/scim/v2/Groups/sre", "", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestSCIMRouterPagination(t *testing.T) {
+	router, _ := newSCIMTestRouter(verbAuthorizer{"list": true},
+		corev2.FixtureUser("a"),
+		corev2.FixtureUser("b"),
+		corev2.FixtureUser("c"),
+	)
+
+	var list struct {
+		TotalResults int        `json:"totalResults"`
+		StartIndex   int        `json:"startIndex"`
+		Resources    []scimUser `json:"Resources"`
+	}
+	status := scimRequest(t, router, http.MethodGet, "/scim/v2/Users?startIndex=2&count=1", "", &list)
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 3, list.TotalResults)
+	assert.Equal(t, 2, list.StartIndex)
+	require.Len(t, list.Resources, 1)
+	assert.Equal(t, "b", list.Resources[0].UserName)
+
+	status = scimRequest(t, router, http.MethodGet, "/scim/v2/Users?startIndex=5", "", &list)
+	require.Equal(t, http.StatusOK, status)
+	assert.Empty(t, list.Resources)
+}
+
+func TestSCIMRouterUnauthorized(t *testing.T) {
+	router, controller := newSCIMTestRouter(verbAuthorizer{"get": true, "list": true}, corev2.FixtureUser("jane"))
+
+	var scimErr scimErrorResponse
+	status := scimRequest(t, router, http.MethodDelete, "/scim/v2/Users/jane", "", &scimErr)
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.Equal(t, "403", scimErr.Status)
+	assert.False(t, controller.users["jane"].Disabled)
+
+	status = scimRequest(t, router, http.MethodPost, "/scim/v2/Groups", `{"displayName":"ops","members":[{"value":"jane"}]}`, nil)
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.NotContains(t, controller.users["jane"].Groups, "ops")
+}
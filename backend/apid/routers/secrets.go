@@ -0,0 +1,70 @@
+package routers
+
+import (
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// SecretsRouter handles requests for /secrets
+type SecretsRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewSecretsRouter instantiates new router for controlling secret resources
+func NewSecretsRouter(store store.ResourceStore) *SecretsRouter {
+	return &SecretsRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.SecretConfig{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the SecretsRouter to a parent Router
+func (r *SecretsRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/namespaces/{namespace}/{resource:secrets}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.SecretConfigFields)
+	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:secrets}", corev2.SecretConfigFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
+
+// SecretsProvidersRouter handles requests for /secrets-providers
+type SecretsProvidersRouter struct {
+	handlers handlers.Handlers
+}
+
+// NewSecretsProvidersRouter instantiates new router for controlling secrets
+// provider resources
+func NewSecretsProvidersRouter(store store.ResourceStore) *SecretsProvidersRouter {
+	return &SecretsProvidersRouter{
+		handlers: handlers.Handlers{
+			Resource: &corev2.SecretsProvider{},
+			Store:    store,
+		},
+	}
+}
+
+// Mount the SecretsProvidersRouter to a parent Router
+func (r *SecretsProvidersRouter) Mount(parent *mux.Router) {
+	routes := ResourceRoute{
+		Router:     parent,
+		PathPrefix: "/{resource:secrets-providers}",
+	}
+
+	routes.Del(r.handlers.DeleteResource)
+	routes.Get(r.handlers.GetResource)
+	routes.List(r.handlers.ListResources, corev2.SecretsProviderFields)
+	routes.Post(r.handlers.CreateResource)
+	routes.Put(r.handlers.CreateOrUpdateResource)
+	routes.Patch(r.handlers.ApplyResource)
+}
@@ -46,6 +46,8 @@ func (r *SilencedRouter) Mount(parent *mux.Router) {
 	routes.Get(r.handlers.GetResource)
 	routes.Post(r.create)
 	routes.Put(r.createOrReplace)
+	routes.Watch(r.handlers, corev2.SilencedFields)
+	routes.WatchAllNamespaces(r.handlers, "/{resource:silenced}", corev2.SilencedFields)
 	routes.List(r.handlers.ListResources, corev2.SilencedFields)
 	routes.ListAllNamespaces(r.handlers.ListResources, "/{resource:silenced}", corev2.SilencedFields)
 
@@ -57,6 +59,10 @@ func (r *SilencedRouter) Mount(parent *mux.Router) {
 }
 
 func (r *SilencedRouter) create(req *http.Request) (interface{}, error) {
+	if err := unsupportedDryRun(req); err != nil {
+		return nil, err
+	}
+
 	entry := &corev2.Silenced{}
 	if err := UnmarshalBody(req, entry); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -71,6 +77,10 @@ func (r *SilencedRouter) create(req *http.Request) (interface{}, error) {
 }
 
 func (r *SilencedRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	if err := unsupportedDryRun(req); err != nil {
+		return nil, err
+	}
+
 	entry := &corev2.Silenced{}
 	if err := UnmarshalBody(req, entry); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -69,12 +69,17 @@ func (r *UsersRouter) get(req *http.Request) (interface{}, error) {
 
 	// Obfuscate users password
 	if user != nil {
+		user.PasswordHash = user.Password
 		user.Password = ""
 	}
 	return user, err
 }
 
 func (r *UsersRouter) create(req *http.Request) (interface{}, error) {
+	if err := unsupportedDryRun(req); err != nil {
+		return nil, err
+	}
+
 	user := &corev2.User{}
 	if err := UnmarshalBody(req, user); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
@@ -85,6 +90,10 @@ func (r *UsersRouter) create(req *http.Request) (interface{}, error) {
 }
 
 func (r *UsersRouter) createOrReplace(req *http.Request) (interface{}, error) {
+	if err := unsupportedDryRun(req); err != nil {
+		return nil, err
+	}
+
 	user := &corev2.User{}
 	if err := UnmarshalBody(req, user); err != nil {
 		return nil, actions.NewError(actions.InvalidArgument, err)
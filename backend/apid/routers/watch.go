@@ -0,0 +1,140 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/apid/handlers"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// watchEventError is the type of the watch event notifying the client that
+// some changes were missed, for example if the resource version it resumes
+// from was compacted. The client must list the resources again.
+const watchEventError = "error"
+
+// watchEvent is a change to a watched resource, written as a line of JSON.
+type watchEvent struct {
+	Type            string          `json:"type"`
+	Object          corev2.Resource `json:"object,omitempty"`
+	ResourceVersion int64           `json:"resource_version,omitempty"`
+}
+
+// Watch streams the changes to the resources of the route, when the watch
+// query parameter is true.
+func (r *ResourceRoute) Watch(h handlers.Handlers, fields FieldsFunc) *mux.Route {
+	return r.Router.HandleFunc(r.PathPrefix, watchHandler(h, fields)).
+		Methods(http.MethodGet).Queries("watch", "true")
+}
+
+// WatchAllNamespaces streams the changes to the resources of the route across
+// all namespaces, when the watch query parameter is true.
+func (r *ResourceRoute) WatchAllNamespaces(h handlers.Handlers, path string, fields FieldsFunc) *mux.Route {
+	return r.Router.HandleFunc(path, watchHandler(h, fields)).
+		Methods(http.MethodGet).Queries("watch", "true")
+}
+
+// watchHandler streams the resources created, updated and deleted as lines of
+// JSON, filtered by the labelSelector and fieldSelector query parameters. The
+// existing resources are first sent as created, unless the resourceVersion
+// query parameter is set, in which case the changes made after this version
+// are sent. Each change carries its resource version, so the clients can
+// resume a watch after the stream ends.
+func watchHandler(h handlers.Handlers, fields FieldsFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		labelSelector, err := selector.Parse(query.Get("labelSelector"))
+		if err != nil {
+			WriteError(w, actions.NewError(actions.InvalidArgument, err))
+			return
+		}
+		fieldSelector, err := selector.Parse(query.Get("fieldSelector"))
+		if err != nil {
+			WriteError(w, actions.NewError(actions.InvalidArgument, err))
+			return
+		}
+
+		var revision int64
+		if version := query.Get("resourceVersion"); version != "" {
+			revision, err = strconv.ParseInt(version, 10, 64)
+			if err != nil || revision < 0 {
+				WriteError(w, actions.NewError(actions.InvalidArgument, errors.New("invalid resourceVersion")))
+				return
+			}
+			// Resume after the last change received
+			revision++
+		}
+
+		// The stream ends before the write timeout of apid, like event streams
+		ctx, cancel := context.WithTimeout(req.Context(), eventStreamDuration)
+		defer cancel()
+
+		flusher, ok := w.(http.Flusher)
+		watcher, canWatch := h.Store.(store.ResourceWatcher)
+		if !ok || !canWatch {
+			http.Error(w, "watching resources is not supported", http.StatusNotImplemented)
+			return
+		}
+		changes := watcher.WatchResources(ctx, h.Resource.StorePrefix(), h.Resource, revision)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		encoder := json.NewEncoder(w)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case change, ok := <-changes:
+				if !ok {
+					return
+				}
+				event := watchEvent{Type: watchEventError}
+				if change.Action != store.WatchError {
+					if !matchesResourceSelectors(change.Resource, fields, labelSelector, fieldSelector) {
+						continue
+					}
+					event = watchEvent{
+						Type:            strings.ToLower(change.Action.String()),
+						Object:          change.Resource,
+						ResourceVersion: change.Revision,
+					}
+				}
+				if err := encoder.Encode(event); err != nil {
+					logger.WithError(err).Error("failed to write watch stream")
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+func matchesResourceSelectors(resource corev2.Resource, fields FieldsFunc, labelSelector, fieldSelector *selector.Selector) bool {
+	if !labelSelector.Empty() && !labelSelector.Matches(resourceLabels(resource)) {
+		return false
+	}
+	if !fieldSelector.Empty() && (fields == nil || !fieldSelector.Matches(fields(resource))) {
+		return false
+	}
+	return true
+}
+
+// resourceLabels returns the labels matched by the label selectors. The labels
+// of an event include the labels of its entity and check.
+func resourceLabels(resource corev2.Resource) map[string]string {
+	if event, ok := resource.(*corev2.Event); ok {
+		return eventLabels(event)
+	}
+	return resource.GetObjectMeta().Labels
+}
@@ -0,0 +1,104 @@
+package routers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockResourceWatcher struct {
+	store.Store
+	changes  []store.WatchEventResource
+	prefix   string
+	revision int64
+}
+
+func (m *mockResourceWatcher) WatchResources(ctx context.Context, prefix string, elem corev2.Resource, revision int64) <-chan store.WatchEventResource {
+	m.prefix = prefix
+	m.revision = revision
+	ch := make(chan store.WatchEventResource, len(m.changes))
+	for _, change := range m.changes {
+		ch <- change
+	}
+	close(ch)
+	return ch
+}
+
+func TestWatchEntities(t *testing.T) {
+	web := corev2.FixtureEntity("web")
+	web.Labels = map[string]string{"app": "web"}
+	db := corev2.FixtureEntity("db")
+	db.Labels = map[string]string{"app": "db"}
+
+	watcher := &mockResourceWatcher{changes: []store.WatchEventResource{
+		{Action: store.WatchCreate, Resource: web, Revision: 5},
+		{Action: store.WatchUpdate, Resource: db, Revision: 6},
+		{Action: store.WatchError},
+		{Action: store.WatchDelete, Resource: web, Revision: 8},
+	}}
+	router := NewEntitiesRouter(watcher, nil)
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+	server := httptest.NewServer(parentRouter)
+	defer server.Close()
+
+	res, err := http.Get(server.URL + corev2.URLPrefix + "/namespaces/default/entities?watch=true&resourceVersion=4&labelSelector=app%20%3D%3D%20web")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "entities", watcher.prefix)
+	assert.Equal(t, int64(5), watcher.revision)
+
+	var events []watchEvent
+	decoder := json.NewDecoder(res.Body)
+	for decoder.More() {
+		var event struct {
+			Type            string        `json:"type"`
+			Object          corev2.Entity `json:"object"`
+			ResourceVersion int64         `json:"resource_version"`
+		}
+		require.NoError(t, decoder.Decode(&event))
+		events = append(events, watchEvent{Type: event.Type, Object: &event.Object, ResourceVersion: event.ResourceVersion})
+	}
+	require.Len(t, events, 3)
+	assert.Equal(t, "create", events[0].Type)
+	assert.Equal(t, "web", events[0].Object.GetObjectMeta().Name)
+	assert.Equal(t, int64(5), events[0].ResourceVersion)
+	assert.Equal(t, "error", events[1].Type)
+	assert.Equal(t, "delete", events[2].Type)
+	assert.Equal(t, int64(8), events[2].ResourceVersion)
+}
+
+func TestWatchErrors(t *testing.T) {
+	router := NewAssetRouter(&mockResourceWatcher{})
+	parentRouter := mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+
+	for _, url := range []string{
+		"/assets?watch=true&labelSelector=app",
+		"/assets?watch=true&fieldSelector=a%20in%20b",
+		"/namespaces/default/assets?watch=true&resourceVersion=abc",
+	} {
+		req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+url, nil)
+		w := httptest.NewRecorder()
+		parentRouter.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, url)
+	}
+
+	// The store cannot watch the resources
+	router = NewAssetRouter(struct{ store.Store }{})
+	parentRouter = mux.NewRouter().PathPrefix(corev2.URLPrefix).Subrouter()
+	router.Mount(parentRouter)
+	req := httptest.NewRequest(http.MethodGet, corev2.URLPrefix+"/assets?watch=true", nil)
+	w := httptest.NewRecorder()
+	parentRouter.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+}
@@ -0,0 +1,275 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/util/logging"
+)
+
+const (
+	// OutcomeSuccess is recorded when the request was carried out.
+	OutcomeSuccess = "success"
+
+	// OutcomeDenied is recorded when the request was rejected during
+	// authentication or authorization.
+	OutcomeDenied = "denied"
+
+	// OutcomeFailure is recorded when the request was authorized but could not
+	// be carried out.
+	OutcomeFailure = "failure"
+
+	// maxEntrySize is the largest audit entry, in bytes, that will be read back
+	// from an existing audit file.
+	maxEntrySize = 1024 * 1024
+)
+
+// Entry is a single record of the audit log.
+type Entry struct {
+	// Sequence is a monotonically increasing number, starting at 1, that
+	// identifies the entry within the audit log.
+	Sequence uint64 `json:"sequence"`
+
+	// Timestamp is the time of the request, in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// User is the name of the user that performed the request.
+	User string `json:"user"`
+
+	// Namespace is the namespace of the affected resource, if any.
+	Namespace string `json:"namespace,omitempty"`
+
+	// APIGroup and APIVersion identify the API the request was made against.
+	APIGroup   string `json:"api_group,omitempty"`
+	APIVersion string `json:"api_version,omitempty"`
+
+	// Resource is the type of the affected resource (e.g. checks).
+	Resource string `json:"resource"`
+
+	// ResourceName is the name of the affected resource, if any.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// Verb is the operation that was requested (create, update or delete).
+	Verb string `json:"verb"`
+
+	// Outcome is one of OutcomeSuccess, OutcomeDenied or OutcomeFailure.
+	Outcome string `json:"outcome"`
+
+	// Status is the HTTP status code returned to the client.
+	Status int `json:"status"`
+
+	// PreviousHash is the hash of the preceding entry, or an empty string for
+	// the first entry of the log.
+	PreviousHash string `json:"previous_hash"`
+
+	// Hash is the hex-encoded SHA-256 sum of the entry, computed with the Hash
+	// field left empty. Since every hash covers the hash of the preceding
+	// entry, altering, removing or reordering entries breaks the chain.
+	Hash string `json:"hash"`
+}
+
+// OutcomeFromStatus returns the outcome that corresponds to an HTTP status
+// code.
+func OutcomeFromStatus(status int) string {
+	switch {
+	case status < 400:
+		return OutcomeSuccess
+	case status == 401 || status == 403:
+		return OutcomeDenied
+	default:
+		return OutcomeFailure
+	}
+}
+
+func (e Entry) sum() (string, error) {
+	e.Hash = ""
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Logger records audit entries.
+type Logger interface {
+	// Log assigns a sequence number and a hash to the entry, and persists it.
+	Log(Entry) error
+}
+
+// FileLogger is a Logger that appends entries to a file, one JSON document
+// per line. The file is rotated once it grows larger than its maximum size,
+// and the hash chain continues across the rotations, so that the archives,
+// from the oldest, followed by the file verify as a single log.
+type FileLogger struct {
+	mu       sync.Mutex
+	writer   *logging.RotateFileWriter
+	sequence uint64
+	lastHash string
+}
+
+// NewFileLogger opens, or creates, the audit file of the given configuration.
+// The sequence and hash chain are resumed from the last entry of the file, or
+// of its newest archive if the file is empty.
+func NewFileLogger(config logging.RotateFileWriterConfig) (*FileLogger, error) {
+	last, err := lastLoggedEntry(config.Path)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := logging.NewRotateFileWriter(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log: %s", err)
+	}
+	l := &FileLogger{writer: writer}
+	if last != nil {
+		l.sequence = last.Sequence
+		l.lastHash = last.Hash
+	}
+
+	return l, nil
+}
+
+// lastLoggedEntry returns the last entry of the audit file at path, or of the
+// newest archive of the file that has entries, or nil if there are none.
+func lastLoggedEntry(path string) (*Entry, error) {
+	archives, err := logging.RotatedFiles(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the audit log archives: %s", err)
+	}
+	paths := append(archives, path)
+	for i := len(paths) - 1; i >= 0; i-- {
+		last, err := lastFileEntry(paths[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not read audit log %q: %s", paths[i], err)
+		}
+		if last != nil {
+			return last, nil
+		}
+	}
+	return nil, nil
+}
+
+// lastFileEntry returns the last entry of the file at path, or nil if it is
+// empty or does not exist.
+func lastFileEntry(path string) (*Entry, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return lastEntry(file)
+}
+
+// Log assigns the next sequence number and hash to the entry, and appends it
+// to the audit file.
+func (l *FileLogger) Log(entry Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if entry.Timestamp == 0 {
+		entry.Timestamp = time.Now().Unix()
+	}
+	entry.Sequence = l.sequence + 1
+	entry.PreviousHash = l.lastHash
+
+	hash, err := entry.sum()
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := l.writer.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("could not write audit entry: %s", err)
+	}
+
+	l.sequence = entry.Sequence
+	l.lastHash = entry.Hash
+
+	return nil
+}
+
+// Close closes the underlying audit file.
+func (l *FileLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.writer.Close()
+}
+
+// lastEntry returns the last entry found in r, or nil if r is empty.
+func lastEntry(r io.Reader) (*Entry, error) {
+	var last *Entry
+	scanner := newScanner(r)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, err
+		}
+		last = &entry
+	}
+	return last, scanner.Err()
+}
+
+// Verify reads an audit log from r and checks that the sequence numbers are
+// contiguous and that every entry hash is valid and chained to the preceding
+// entry. It returns the number of entries verified, along with an error
+// describing the first inconsistency found, if any.
+func Verify(r io.Reader) (uint64, error) {
+	var (
+		count    uint64
+		sequence uint64
+		lastHash string
+	)
+	scanner := newScanner(r)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return count, fmt.Errorf("entry following sequence %d is invalid: %s", sequence, err)
+		}
+		if count > 0 && entry.Sequence != sequence+1 {
+			return count, fmt.Errorf("sequence %d follows sequence %d", entry.Sequence, sequence)
+		}
+		if count > 0 && entry.PreviousHash != lastHash {
+			return count, fmt.Errorf("sequence %d is not chained to sequence %d", entry.Sequence, sequence)
+		}
+		hash, err := entry.sum()
+		if err != nil {
+			return count, err
+		}
+		if hash != entry.Hash {
+			return count, fmt.Errorf("sequence %d has an invalid hash", entry.Sequence)
+		}
+		count++
+		sequence = entry.Sequence
+		lastHash = entry.Hash
+	}
+	return count, scanner.Err()
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxEntrySize)
+	return scanner
+}
@@ -0,0 +1,188 @@
+package audit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/util/logging"
+)
+
+func tempAuditFile(t *testing.T) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "audit.log"), func() { _ = os.RemoveAll(dir) }
+}
+
+func TestFileLoggerResumesSequence(t *testing.T) {
+	path, cleanup := tempAuditFile(t)
+	defer cleanup()
+
+	logger, err := NewFileLogger(logging.RotateFileWriterConfig{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, verb := range []string{"create", "update"} {
+		if err := logger.Log(Entry{User: "admin", Resource: "checks", Verb: verb}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	logger, err = NewFileLogger(logging.RotateFileWriterConfig{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := logger.Log(Entry{User: "admin", Resource: "checks", Verb: "delete"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	count, err := Verify(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := count, uint64(3); got != want {
+		t.Fatalf("bad entry count: got %d, want %d", got, want)
+	}
+}
+
+func TestFileLoggerRotation(t *testing.T) {
+	path, cleanup := tempAuditFile(t)
+	defer cleanup()
+
+	// Every entry is larger than the maximum size, so each one is written to
+	// its own file
+	config := logging.RotateFileWriterConfig{Path: path, MaxSizeBytes: 1}
+	logger, err := NewFileLogger(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, verb := range []string{"create", "update", "delete"} {
+		if err := logger.Log(Entry{User: "admin", Resource: "checks", Verb: verb}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The chain is resumed from the newest archive if the file is empty, as
+	// after it was truncated
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	logger, err = NewFileLogger(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := logger.Log(Entry{User: "admin", Resource: "checks", Verb: "create"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archives, err := logging.RotatedFiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(archives), 2; got != want {
+		t.Fatalf("bad archive count: got %d, want %d", got, want)
+	}
+	var log bytes.Buffer
+	for _, p := range append(archives, path) {
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		log.Write(b)
+	}
+	count, err := Verify(&log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := count, uint64(3); got != want {
+		t.Fatalf("bad entry count: got %d, want %d", got, want)
+	}
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	path, cleanup := tempAuditFile(t)
+	defer cleanup()
+
+	logger, err := NewFileLogger(logging.RotateFileWriterConfig{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range []string{"alice", "bob", "carol"} {
+		if err := logger.Log(Entry{User: user, Resource: "checks", Verb: "create"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = logger.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitAfter(strings.TrimSpace(string(b)), "\n")
+
+	tests := []struct {
+		name string
+		log  string
+	}{
+		{
+			name: "altered entry",
+			log:  strings.Replace(string(b), `"user":"bob"`, `"user":"mallory"`, 1),
+		},
+		{
+			name: "removed entry",
+			log:  lines[0] + lines[2],
+		},
+		{
+			name: "reordered entries",
+			log:  lines[1] + lines[0],
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Verify(bytes.NewBufferString(test.log)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestOutcomeFromStatus(t *testing.T) {
+	tests := map[int]string{
+		200: OutcomeSuccess,
+		201: OutcomeSuccess,
+		204: OutcomeSuccess,
+		400: OutcomeFailure,
+		401: OutcomeDenied,
+		403: OutcomeDenied,
+		404: OutcomeFailure,
+		500: OutcomeFailure,
+	}
+	for status, want := range tests {
+		if got := OutcomeFromStatus(status); got != want {
+			t.Errorf("bad outcome for %d: got %s, want %s", status, got, want)
+		}
+	}
+}
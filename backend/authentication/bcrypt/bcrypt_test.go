@@ -21,3 +21,9 @@ func TestHashPassword(t *testing.T) {
 	assert.NotEqual(t, password, hash)
 	assert.NoError(t, err)
 }
+
+func TestIsHash(t *testing.T) {
+	assert.True(t, IsHash("$2a$10$iyYyGmveS9dcYp5DHMbOm.LShX806vB0ClzoPyt1TIgkZ9KQ62cOO"))
+	assert.False(t, IsHash("P@ssw0rd!"))
+	assert.False(t, IsHash(""))
+}
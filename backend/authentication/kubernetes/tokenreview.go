@@ -0,0 +1,153 @@
+// Package kubernetes authenticates Kubernetes service account tokens with the
+// TokenReview API of the cluster the backend runs in.
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// DefaultAudience is the default audience of the projected service account
+	// tokens used by the agents
+	DefaultAudience = "sensu"
+
+	serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	reviewPath        = "/apis/authentication.k8s.io/v1/tokenreviews"
+	reviewTimeout     = 10 * time.Second
+)
+
+// TokenReviewer authenticates service account tokens with the TokenReview API.
+type TokenReviewer struct {
+	client   *http.Client
+	endpoint string
+	audience string
+
+	// tokenPath is the path of the service account token of the backend,
+	// which is read on every review since it is rotated by the kubelet
+	tokenPath string
+}
+
+// NewInClusterTokenReviewer returns a TokenReviewer which reviews the tokens
+// with the API server of the cluster the backend runs in, using the service
+// account of the backend pod. Only the tokens issued for audience are
+// authenticated.
+func NewInClusterTokenReviewer(audience string) (*TokenReviewer, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("the backend is not running in a kubernetes cluster")
+	}
+
+	ca, err := ioutil.ReadFile(serviceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, fmt.Errorf("could not read the kubernetes CA: %s", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("invalid kubernetes CA")
+	}
+
+	return &TokenReviewer{
+		client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			},
+			Timeout: reviewTimeout,
+		},
+		endpoint:  "https://" + net.JoinHostPort(host, port),
+		audience:  audience,
+		tokenPath: serviceAccountDir + "/token",
+	}, nil
+}
+
+type tokenReview struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Spec       tokenReviewSpec   `json:"spec"`
+	Status     tokenReviewStatus `json:"status,omitempty"`
+}
+
+type tokenReviewSpec struct {
+	Token     string   `json:"token"`
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+type tokenReviewStatus struct {
+	Authenticated bool     `json:"authenticated"`
+	Audiences     []string `json:"audiences,omitempty"`
+	Error         string   `json:"error,omitempty"`
+	User          struct {
+		Username string   `json:"username"`
+		Groups   []string `json:"groups"`
+	} `json:"user"`
+}
+
+// Review authenticates token and returns the user of its service account. The
+// username is system:serviceaccount:<namespace>:<name>, and the groups include
+// system:serviceaccounts:<namespace>, so the agents can be authorized with
+// role bindings on these subjects.
+func (r *TokenReviewer) Review(ctx context.Context, token string) (*corev2.User, error) {
+	review := tokenReview{
+		APIVersion: "authentication.k8s.io/v1",
+		Kind:       "TokenReview",
+		Spec:       tokenReviewSpec{Token: token},
+	}
+	if r.audience != "" {
+		review.Spec.Audiences = []string{r.audience}
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.endpoint+reviewPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.tokenPath != "" {
+		credentials, err := ioutil.ReadFile(r.tokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the backend service account token: %s", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(credentials)))
+	}
+
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token review failed: %s", resp.Status)
+	}
+
+	var result tokenReview
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid token review: %s", err)
+	}
+	if !result.Status.Authenticated {
+		if result.Status.Error != "" {
+			return nil, fmt.Errorf("token not authenticated: %s", result.Status.Error)
+		}
+		return nil, errors.New("token not authenticated")
+	}
+
+	return &corev2.User{
+		Username: result.Status.User.Username,
+		Groups:   result.Status.User.Groups,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReview(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, reviewPath, r.URL.Path)
+		assert.Equal(t, "Bearer backend-token", r.Header.Get("Authorization"))
+
+		var review tokenReview
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&review))
+		assert.Equal(t, []string{"sensu"}, review.Spec.Audiences)
+
+		w.WriteHeader(http.StatusCreated)
+		if review.Spec.Token != "agent-token" {
+			fmt.Fprint(w, `{"status": {"authenticated": false, "error": "invalid bearer token"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"status": {"authenticated": true, "user": {
+			"username": "system:serviceaccount:monitoring:sensu-agent",
+			"groups": ["system:serviceaccounts", "system:serviceaccounts:monitoring"]}}}`)
+	}))
+	defer ts.Close()
+
+	f, err := ioutil.TempFile("", "token")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("backend-token\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reviewer := &TokenReviewer{
+		client:    ts.Client(),
+		endpoint:  ts.URL,
+		audience:  DefaultAudience,
+		tokenPath: f.Name(),
+	}
+
+	user, err := reviewer.Review(context.Background(), "agent-token")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:monitoring:sensu-agent", user.Username)
+	assert.Equal(t, []string{"system:serviceaccounts", "system:serviceaccounts:monitoring"}, user.Groups)
+
+	_, err = reviewer.Review(context.Background(), "other-token")
+	assert.EqualError(t, err, "token not authenticated: invalid bearer token")
+}
+
+func TestNewInClusterTokenReviewer(t *testing.T) {
+	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		t.Skip("running in a kubernetes cluster")
+	}
+	_, err := NewInClusterTokenReviewer(DefaultAudience)
+	assert.Error(t, err)
+}
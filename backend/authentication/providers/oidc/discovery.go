@@ -0,0 +1,213 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// discoveryPath is the path of the discovery document, relative to the issuer.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// Discovery is the subset of the metadata of an OpenID provider, published in
+// its discovery document, that is used by Sensu.
+type Discovery struct {
+	Issuer                        string   `json:"issuer"`
+	AuthorizationEndpoint         string   `json:"authorization_endpoint"`
+	TokenEndpoint                 string   `json:"token_endpoint"`
+	UserinfoEndpoint              string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI                       string   `json:"jwks_uri"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported,omitempty"`
+}
+
+// Discover retrieves the discovery document of the given issuer.
+func Discover(ctx context.Context, client *http.Client, issuer string) (*Discovery, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+	discovery := &Discovery{}
+	if err := getJSON(ctx, client, issuer+discoveryPath, "", discovery); err != nil {
+		return nil, fmt.Errorf("could not retrieve the discovery document of %s: %s", issuer, err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("the discovery document of %s is for the issuer %s", issuer, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("the discovery document of %s is incomplete", issuer)
+	}
+	return discovery, nil
+}
+
+// TokenResponse is the response of the token endpoint of a provider.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+}
+
+// Exchange requests tokens from the token endpoint with the given grant, e.g.
+// an authorization code or a refresh token.
+func (d *Discovery) Exchange(ctx context.Context, client *http.Client, grant url.Values) (*TokenResponse, error) {
+	req, err := http.NewRequest(http.MethodPost, d.TokenEndpoint, strings.NewReader(grant.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var tokenErr struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		if err := json.Unmarshal(body, &tokenErr); err == nil && tokenErr.Error != "" {
+			if tokenErr.Description != "" {
+				return nil, fmt.Errorf("%s: %s", tokenErr.Error, tokenErr.Description)
+			}
+			return nil, errors.New(tokenErr.Error)
+		}
+		return nil, fmt.Errorf("the token endpoint returned the status %s", resp.Status)
+	}
+
+	tokens := &TokenResponse{}
+	if err := json.Unmarshal(body, tokens); err != nil {
+		return nil, fmt.Errorf("invalid response of the token endpoint: %s", err)
+	}
+	return tokens, nil
+}
+
+// Userinfo returns the claims of the user of the given access token.
+func (d *Discovery) Userinfo(ctx context.Context, client *http.Client, accessToken string) (map[string]interface{}, error) {
+	if d.UserinfoEndpoint == "" {
+		return nil, errors.New("the provider has no userinfo endpoint")
+	}
+	claims := map[string]interface{}{}
+	if err := getJSON(ctx, client, d.UserinfoEndpoint, accessToken, &claims); err != nil {
+		return nil, fmt.Errorf("could not retrieve the userinfo: %s", err)
+	}
+	return claims, nil
+}
+
+// jsonWebKey is a public key of a JSON Web Key Set.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keys retrieves the signing keys of the provider, by key ID. The keys of
+// unsupported types are ignored.
+func (d *Discovery) keys(ctx context.Context, client *http.Client) (map[string]interface{}, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(ctx, client, d.JWKSURI, "", &set); err != nil {
+		return nil, fmt.Errorf("could not retrieve the signing keys: %s", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			logger.WithError(err).WithField("kid", jwk.Kid).Debug("ignoring signing key")
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid key parameter: %s", err)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, u, bearer string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned the status %s", u, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
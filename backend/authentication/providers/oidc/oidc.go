@@ -0,0 +1,398 @@
+// Package oidc authenticates the users with an OpenID Connect provider, e.g.
+// Okta or Azure AD. The clients, sensuctl and the web UI, log in with the
+// provider themselves, using the authorization code flow with PKCE, and
+// exchange the ID token issued by the provider for Sensu tokens.
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Type represents the type of the OIDC authentication provider
+	Type = "oidc"
+
+	// DefaultUsernameClaim is the default claim of the ID tokens used as the
+	// username.
+	DefaultUsernameClaim = "email"
+
+	// DefaultGroupsClaim is the default claim of the ID tokens listing the
+	// groups of the user.
+	DefaultGroupsClaim = "groups"
+
+	// keysRefreshInterval is the minimum interval between two retrievals of
+	// the signing keys of the provider, which are retrieved again when an ID
+	// token is signed by an unknown key.
+	keysRefreshInterval = time.Minute
+
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	logger = logrus.WithFields(logrus.Fields{
+		"component": "authentication",
+	})
+
+	// ErrPasswordNotSupported is the error returned by the provider when one
+	// tries to authenticate with a username and a password.
+	ErrPasswordNotSupported = errors.New("the oidc provider does not authenticate passwords")
+
+	// signingMethods are the algorithms accepted for the ID tokens.
+	signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+)
+
+// Provider represents the OpenID Connect authentication provider
+type Provider struct {
+	// Issuer is the URL of the OpenID provider.
+	Issuer string
+
+	// ClientID is the ID of the client registered with the provider for
+	// Sensu, and ClientSecret its secret, which is optional for public
+	// clients.
+	ClientID     string
+	ClientSecret string
+
+	// Scopes are the scopes requested by the clients, in addition to openid.
+	Scopes []string
+
+	// UsernameClaim and GroupsClaim are the claims of the ID tokens holding
+	// the username and the groups of the user, which are prefixed with
+	// UsernamePrefix and GroupsPrefix to build the Sensu username and groups.
+	UsernameClaim  string
+	UsernamePrefix string
+	GroupsClaim    string
+	GroupsPrefix   string
+
+	// Client is the HTTP client used to reach the provider.
+	Client *http.Client
+
+	// ObjectMeta contains the name, namespace, labels and annotations
+	corev2.ObjectMeta `json:"metadata"`
+
+	mu            sync.Mutex
+	discovery     *Discovery
+	keys          map[string]interface{}
+	keysFetchedAt time.Time
+}
+
+// Config is the configuration of the provider needed by the clients to log in.
+type Config struct {
+	Issuer   string   `json:"issuer"`
+	ClientID string   `json:"client_id"`
+	Scopes   []string `json:"scopes"`
+}
+
+// Config returns the configuration of the provider needed by the clients.
+func (p *Provider) Config() Config {
+	scopes := []string{"openid"}
+	for _, scope := range p.Scopes {
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return Config{
+		Issuer:   p.Issuer,
+		ClientID: p.ClientID,
+		Scopes:   scopes,
+	}
+}
+
+// Authenticate is not supported, since the users log in with the provider
+// itself.
+func (p *Provider) Authenticate(ctx context.Context, username, password string) (*corev2.Claims, error) {
+	return nil, ErrPasswordNotSupported
+}
+
+// AuthenticateIDToken returns the claims of the user of an ID token issued by
+// the provider. The nonce of the token is verified if not empty.
+func (p *Provider) AuthenticateIDToken(ctx context.Context, idToken, nonce string) (*corev2.Claims, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	idClaims, err := p.verify(ctx, idToken)
+	if err != nil {
+		return nil, err
+	}
+	if nonce != "" {
+		if value, _ := idClaims["nonce"].(string); value != nonce {
+			return nil, errors.New("the nonce of the id token does not match")
+		}
+	}
+	return p.newClaims(idClaims)
+}
+
+// Refresh the claims of a user with the refresh token of the provider, carried
+// by the claims of the Sensu refresh token found in the context. The claims
+// returned carry the new refresh token of the provider, if it issued one.
+func (p *Provider) Refresh(ctx context.Context, claims *corev2.Claims) (*corev2.Claims, error) {
+	refreshClaims, _ := ctx.Value(corev2.RefreshTokenClaims).(*corev2.Claims)
+	if refreshClaims == nil || refreshClaims.ProviderRefreshToken == "" {
+		return nil, errors.New("no refresh token of the provider, the user must log in again")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grant := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshClaims.ProviderRefreshToken},
+	}
+	tokens, err := discovery.Exchange(ctx, p.client(), p.authenticateClient(grant))
+	if err != nil {
+		return nil, fmt.Errorf("could not refresh the tokens of the provider: %s", err)
+	}
+
+	// The providers don't always issue new ID tokens when refreshing, in
+	// which case the claims are retrieved from the userinfo endpoint
+	var idClaims map[string]interface{}
+	if tokens.IDToken != "" {
+		idClaims, err = p.verify(ctx, tokens.IDToken)
+	} else {
+		idClaims, err = discovery.Userinfo(ctx, p.client(), tokens.AccessToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	newClaims, err := p.newClaims(idClaims)
+	if err != nil {
+		return nil, err
+	}
+	if newClaims.Provider.UserID != claims.Provider.UserID {
+		return nil, fmt.Errorf("the provider refreshed the tokens of %q instead of %q", newClaims.Provider.UserID, claims.Provider.UserID)
+	}
+	newClaims.ProviderRefreshToken = tokens.RefreshToken
+
+	return newClaims, nil
+}
+
+// authenticateClient adds the credentials of the client to a grant.
+func (p *Provider) authenticateClient(grant url.Values) url.Values {
+	grant.Set("client_id", p.ClientID)
+	if p.ClientSecret != "" {
+		grant.Set("client_secret", p.ClientSecret)
+	}
+	return grant
+}
+
+// verify verifies the signature, the issuer, the audience and the expiration
+// of an ID token, and returns its claims.
+func (p *Provider) verify(ctx context.Context, idToken string) (jwtgo.MapClaims, error) {
+	claims := jwtgo.MapClaims{}
+	parser := &jwtgo.Parser{ValidMethods: signingMethods}
+	_, err := parser.ParseWithClaims(idToken, claims, func(token *jwtgo.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %s", err)
+	}
+
+	if iss, _ := claims["iss"].(string); strings.TrimSuffix(iss, "/") != strings.TrimSuffix(p.Issuer, "/") {
+		return nil, fmt.Errorf("invalid id token: issued by %q", iss)
+	}
+	if !hasAudience(claims["aud"], p.ClientID) {
+		return nil, errors.New("invalid id token: not issued for sensu")
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("invalid id token: no expiration")
+	}
+	return claims, nil
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, value := range aud {
+			if value == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// key returns the signing key of the provider with the given ID. The ID can be
+// empty if the provider has a single key.
+func (p *Provider) key(ctx context.Context, kid string) (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key, ok := p.lookupKey(kid)
+	if ok || time.Since(p.keysFetchedAt) < keysRefreshInterval {
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
+	}
+
+	// The keys are rotated by the providers
+	discovery, err := p.discoverLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := discovery.keys(ctx, p.client())
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (p *Provider) lookupKey(kid string) (interface{}, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) discover(ctx context.Context) (*Discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.discoverLocked(ctx)
+}
+
+func (p *Provider) discoverLocked(ctx context.Context) (*Discovery, error) {
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	discovery, err := Discover(ctx, p.client(), p.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	p.discovery = discovery
+	return discovery, nil
+}
+
+func (p *Provider) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return http.DefaultClient
+}
+
+// newClaims returns the Sensu claims of the claims of an ID token.
+func (p *Provider) newClaims(idClaims map[string]interface{}) (*corev2.Claims, error) {
+	subject, _ := idClaims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("the id token has no subject")
+	}
+
+	usernameClaim := p.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = DefaultUsernameClaim
+	}
+	username, _ := idClaims[usernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("the id token has no %q claim", usernameClaim)
+	}
+
+	groupsClaim := p.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = DefaultGroupsClaim
+	}
+	var groups []string
+	switch value := idClaims[groupsClaim].(type) {
+	case string:
+		groups = append(groups, p.GroupsPrefix+value)
+	case []interface{}:
+		for _, group := range value {
+			if group, ok := group.(string); ok {
+				groups = append(groups, p.GroupsPrefix+group)
+			}
+		}
+	}
+
+	claims, err := jwt.NewClaims(&corev2.User{
+		Username: p.UsernamePrefix + username,
+		Groups:   groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+	claims.Provider = corev2.AuthProviderClaims{
+		ProviderID:   p.Name(),
+		ProviderType: Type,
+		UserID:       subject,
+	}
+	return claims, nil
+}
+
+// GetObjectMeta returns the provider metadata
+func (p *Provider) GetObjectMeta() corev2.ObjectMeta {
+	return p.ObjectMeta
+}
+
+// Name returns the provider name
+func (p *Provider) Name() string {
+	return p.ObjectMeta.Name
+}
+
+// Type returns the provider type
+func (p *Provider) Type() string {
+	return Type
+}
+
+// StorePrefix returns the path prefix to the provider in the store. Not
+// implemented
+func (p *Provider) StorePrefix() string {
+	return ""
+}
+
+// URIPath returns the path component of the OIDC provider. Not implemented
+func (p *Provider) URIPath() string {
+	return ""
+}
+
+// Validate validates the OIDC provider configuration
+func (p *Provider) Validate() error {
+	p.ObjectMeta.Name = Type
+	if p.Issuer == "" {
+		return errors.New("the oidc provider must have an issuer")
+	}
+	if p.ClientID == "" {
+		return errors.New("the oidc provider must have a client id")
+	}
+	return nil
+}
+
+// SetNamespace sets the namespace of the resource.
+func (p *Provider) SetNamespace(namespace string) {
+	p.Namespace = namespace
+}
+
+// RBACName is not implemented
+func (p *Provider) RBACName() string {
+	return ""
+}
+
+// SetObjectMeta sets the meta of the resource.
+func (p *Provider) SetObjectMeta(meta corev2.ObjectMeta) {
+	p.ObjectMeta = meta
+}
@@ -0,0 +1,221 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testIssuer is an OpenID provider signing its ID tokens with a RSA key.
+type testIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	kid string
+
+	// refreshed is the refresh token received by the token endpoint
+	refreshed string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := &testIssuer{key: key, kid: "1"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Discovery{
+			Issuer:                issuer.URL,
+			AuthorizationEndpoint: issuer.URL + "/authorize",
+			TokenEndpoint:         issuer.URL + "/token",
+			JWKSURI:               issuer.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kty: "RSA",
+				Kid: issuer.kid,
+				Use: "sig",
+				N:   encode(issuer.key.N.Bytes()),
+				E:   encode(big.NewInt(int64(issuer.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("client_id") != "sensu" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		issuer.refreshed = r.Form.Get("refresh_token")
+		_ = json.NewEncoder(w).Encode(TokenResponse{
+			AccessToken:  "access",
+			RefreshToken: "new-refresh",
+			IDToken:      issuer.idToken(t, issuer.claims()),
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	return issuer
+}
+
+func (i *testIssuer) claims() jwtgo.MapClaims {
+	return jwtgo.MapClaims{
+		"iss":    i.URL,
+		"aud":    []string{"sensu", "other"},
+		"sub":    "00u1",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"email":  "jane@example.com",
+		"groups": []string{"ops", "dev"},
+		"nonce":  "nonce",
+	}
+}
+
+func (i *testIssuer) idToken(t *testing.T, claims jwtgo.MapClaims) string {
+	token := jwtgo.NewWithClaims(jwtgo.SigningMethodRS256, claims)
+	token.Header["kid"] = i.kid
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func newTestProvider(issuer *testIssuer) *Provider {
+	return &Provider{
+		ObjectMeta:   corev2.ObjectMeta{Name: Type},
+		Issuer:       issuer.URL,
+		ClientID:     "sensu",
+		GroupsPrefix: "oidc:",
+	}
+}
+
+func TestAuthenticateIDToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	defer issuer.Close()
+	provider := newTestProvider(issuer)
+
+	claims, err := provider.AuthenticateIDToken(context.Background(), issuer.idToken(t, issuer.claims()), "nonce")
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", claims.Subject)
+	assert.Equal(t, []string{"oidc:ops", "oidc:dev"}, claims.Groups)
+	assert.Equal(t, corev2.AuthProviderClaims{ProviderID: Type, ProviderType: Type, UserID: "00u1"}, claims.Provider)
+	assert.NotEmpty(t, claims.Id)
+
+	tests := []struct {
+		name   string
+		modify func(jwtgo.MapClaims)
+		nonce  string
+	}{
+		{
+			name:   "other audience",
+			modify: func(c jwtgo.MapClaims) { c["aud"] = "other" },
+		},
+		{
+			name:   "other issuer",
+			modify: func(c jwtgo.MapClaims) { c["iss"] = "https://example.com" },
+		},
+		{
+			name:   "expired",
+			modify: func(c jwtgo.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		},
+		{
+			name:   "no expiration",
+			modify: func(c jwtgo.MapClaims) { delete(c, "exp") },
+		},
+		{
+			name:   "no username",
+			modify: func(c jwtgo.MapClaims) { delete(c, "email") },
+		},
+		{
+			name:   "other nonce",
+			modify: func(c jwtgo.MapClaims) {},
+			nonce:  "replayed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idClaims := issuer.claims()
+			tt.modify(idClaims)
+			_, err := provider.AuthenticateIDToken(context.Background(), issuer.idToken(t, idClaims), tt.nonce)
+			assert.Error(t, err)
+		})
+	}
+
+	// Signed by another key
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	token := jwtgo.NewWithClaims(jwtgo.SigningMethodRS256, issuer.claims())
+	token.Header["kid"] = issuer.kid
+	forged, err := token.SignedString(other)
+	require.NoError(t, err)
+	_, err = provider.AuthenticateIDToken(context.Background(), forged, "")
+	assert.Error(t, err)
+}
+
+func TestAuthenticateIDTokenKeyRotation(t *testing.T) {
+	issuer := newTestIssuer(t)
+	defer issuer.Close()
+	provider := newTestProvider(issuer)
+
+	_, err := provider.AuthenticateIDToken(context.Background(), issuer.idToken(t, issuer.claims()), "")
+	require.NoError(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer.key, issuer.kid = key, "2"
+
+	// The keys were just retrieved
+	_, err = provider.AuthenticateIDToken(context.Background(), issuer.idToken(t, issuer.claims()), "")
+	assert.Error(t, err)
+
+	provider.keysFetchedAt = time.Now().Add(-keysRefreshInterval)
+	_, err = provider.AuthenticateIDToken(context.Background(), issuer.idToken(t, issuer.claims()), "")
+	assert.NoError(t, err)
+}
+
+func TestRefresh(t *testing.T) {
+	issuer := newTestIssuer(t)
+	defer issuer.Close()
+	provider := newTestProvider(issuer)
+
+	claims, err := provider.AuthenticateIDToken(context.Background(), issuer.idToken(t, issuer.claims()), "")
+	require.NoError(t, err)
+
+	// No refresh token
+	_, err = provider.Refresh(context.Background(), claims)
+	assert.Error(t, err)
+
+	refreshClaims := &corev2.Claims{ProviderRefreshToken: "refresh"}
+	ctx := context.WithValue(context.Background(), corev2.RefreshTokenClaims, refreshClaims)
+	newClaims, err := provider.Refresh(ctx, claims)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", issuer.refreshed)
+	assert.Equal(t, "jane@example.com", newClaims.Subject)
+	assert.Equal(t, "new-refresh", newClaims.ProviderRefreshToken)
+
+	// Another user
+	claims.Provider.UserID = "00u2"
+	_, err = provider.Refresh(ctx, claims)
+	assert.Error(t, err)
+}
+
+func TestConfig(t *testing.T) {
+	provider := &Provider{Issuer: "https://example.com", ClientID: "sensu", Scopes: []string{"email", "openid"}}
+	assert.Equal(t, Config{
+		Issuer:   "https://example.com",
+		ClientID: "sensu",
+		Scopes:   []string{"openid", "email"},
+	}, provider.Config())
+}
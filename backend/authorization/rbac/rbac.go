@@ -20,6 +20,7 @@ type Store interface {
 	ListRoleBindings(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.RoleBinding, error)
 	GetRole(ctx context.Context, name string) (*corev2.Role, error)
 	GetClusterRole(ctx context.Context, name string) (*corev2.ClusterRole, error)
+	ListClusterRoles(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.ClusterRole, error)
 }
 
 // Authorizer implements an authorizer interface using Role-Based Acccess
@@ -108,8 +109,17 @@ func (a *Authorizer) VisitRulesFor(ctx context.Context, attrs *authorization.Att
 	}
 }
 
-// Authorize determines if a request is authorized based on its attributes
+// Authorize determines if a request is authorized based on its attributes. A
+// request is authorized if a rule allows it and no rule denies it.
 func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	review, err := a.Review(ctx, attrs)
+	return review.Allowed, err
+}
+
+// Review evaluates a request based on its attributes like Authorize does, and
+// explains the decision with the binding that allowed or denied the request.
+func (a *Authorizer) Review(ctx context.Context, attrs *authorization.Attributes) (*corev2.AccessReview, error) {
+	review := &corev2.AccessReview{}
 	if attrs != nil {
 		logger = logger.WithFields(logrus.Fields{
 			"zz_request": map[string]string{
@@ -122,12 +132,23 @@ func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attribu
 				"verb":         attrs.Verb,
 			},
 		})
+		review.User = attrs.User.Username
+		review.Verb = attrs.Verb
+		review.Resource = attrs.Resource
+		review.ResourceName = attrs.ResourceName
+		review.Namespace = attrs.Namespace
 	}
 
-	var (
-		authorized bool
-		visitErr   error
-	)
+	// The requests of the API keys are restricted by their scope
+	if claims, ok := ctx.Value(corev2.ClaimsKey).(*corev2.Claims); ok && attrs != nil {
+		if claims.Subject == attrs.User.Username && !claims.Allows(attrs.Namespace, attrs.Verb) {
+			logger.Debug("request outside of the scope of the api key")
+			review.Reason = "the request is outside of the scope of the API key"
+			return review, nil
+		}
+	}
+
+	var visitErr error
 
 	a.VisitRulesFor(ctx, attrs, func(binding RoleBinding, rule corev2.Rule, err error) bool {
 		if err != nil {
@@ -144,31 +165,68 @@ func (a *Authorizer) Authorize(ctx context.Context, attrs *authorization.Attribu
 			}
 		}
 
-		allowed, reason := ruleAllows(attrs, rule)
-		if allowed {
-			roleRef := binding.GetRoleRef()
-			name := roleRef.GetName()
-			logger.Debugf("request authorized by the binding %s", name)
-			authorized = true
+		matches, reason := ruleAllows(attrs, rule)
+		if !matches {
+			logger.Tracef("%s by rule %+v", reason, rule)
+			return true
+		}
+
+		roleRef := binding.GetRoleRef()
+		name := roleRef.GetName()
+		if rule.Denies() {
+			// The deny rules take precedence over the allow rules
+			logger.Debugf("request denied by the binding %s", name)
+			review.Allowed = false
+			review.Binding = reviewBinding(binding, rule)
+			review.Reason = fmt.Sprintf("denied by a rule of the %s %s bound by the %s", roleRef.Type, name, review.Binding)
 			return false
 		}
-		logger.Tracef("%s by rule %+v", reason, rule)
+		if !review.Allowed {
+			logger.Debugf("request authorized by the binding %s", name)
+			review.Allowed = true
+			review.Binding = reviewBinding(binding, rule)
+			review.Reason = fmt.Sprintf("allowed by a rule of the %s %s bound by the %s", roleRef.Type, name, review.Binding)
+		}
 
+		// Keep visiting the rules, since a deny rule could refuse the request
 		return true
 	})
 
-	if !authorized {
+	if !review.Allowed {
 		logger.Debug("unauthorized request")
+		if review.Binding == nil {
+			review.Reason = "no rule allows the request"
+		}
 	}
 
-	return authorized, visitErr
+	return review, visitErr
+}
+
+// reviewBinding describes the given binding and its matching rule.
+func reviewBinding(binding RoleBinding, rule corev2.Rule) *corev2.AccessReviewBinding {
+	meta := binding.GetObjectMeta()
+	result := &corev2.AccessReviewBinding{
+		Type:      "RoleBinding",
+		Name:      meta.Name,
+		Namespace: meta.Namespace,
+		RoleRef:   binding.GetRoleRef(),
+		Rule:      rule,
+	}
+	if _, ok := binding.(*corev2.ClusterRoleBinding); ok {
+		result.Type = "ClusterRoleBinding"
+	}
+	return result
 }
 
 func (a *Authorizer) getRoleReferencerules(ctx context.Context, roleRef types.RoleRef) ([]types.Rule, error) {
 	switch roleRef.Type {
 	case "Role":
 		role, err := a.Store.GetRole(ctx, roleRef.Name)
-		if err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			// The bindings of the missing roles grant nothing, and must not
+			// fail the requests authorized by the other bindings
+			return nil, nil
+		} else if err != nil {
 			return nil, fmt.Errorf("could not retrieve the Role %s: %s", roleRef.Name, err.Error())
 		} else if role == nil {
 			return nil, fmt.Errorf("the Role %s is invalid", roleRef.Name)
@@ -177,18 +235,40 @@ func (a *Authorizer) getRoleReferencerules(ctx context.Context, roleRef types.Ro
 
 	case "ClusterRole":
 		clusterRole, err := a.Store.GetClusterRole(ctx, roleRef.Name)
-		if err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return nil, nil
+		} else if err != nil {
 			return nil, fmt.Errorf("could not retrieve the ClusterRole %s: %s", roleRef.Name, err.Error())
 		} else if clusterRole == nil {
 			return nil, fmt.Errorf("the ClusterRole %s is invalid", roleRef.Name)
 		}
-		return clusterRole.Rules, nil
+		if clusterRole.AggregationRule == nil {
+			return clusterRole.Rules, nil
+		}
+		return a.aggregateRules(ctx, clusterRole)
 
 	default:
 		return nil, fmt.Errorf("unsupported role reference type: %s", roleRef.Type)
 	}
 }
 
+// aggregateRules returns the rules of the given ClusterRole along with the
+// rules of the ClusterRoles it aggregates.
+func (a *Authorizer) aggregateRules(ctx context.Context, clusterRole *corev2.ClusterRole) ([]types.Rule, error) {
+	clusterRoles, err := a.Store.ListClusterRoles(ctx, &store.SelectionPredicate{})
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate the ClusterRole %s: %s", clusterRole.Name, err.Error())
+	}
+
+	rules := append([]types.Rule{}, clusterRole.Rules...)
+	for _, role := range clusterRoles {
+		if clusterRole.Aggregates(role) {
+			rules = append(rules, role.Rules...)
+		}
+	}
+	return rules, nil
+}
+
 // matchesUser returns whether any of the subjects matches the specified user
 func matchesUser(user types.User, subjects []types.Subject) bool {
 	for _, subject := range subjects {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/authorization"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -271,6 +272,155 @@ func TestAuthorize(t *testing.T) {
 	}
 }
 
+func TestAuthorizeAPIKeyScope(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("ListClusterRoleBindings", mock.Anything, mock.Anything).
+		Return([]*types.ClusterRoleBinding{{
+			RoleRef:  types.RoleRef{Type: "ClusterRole", Name: "admin"},
+			Subjects: []types.Subject{{Type: types.UserType, Name: "foo"}},
+		}}, nil)
+	store.On("GetClusterRole", mock.Anything, "admin").
+		Return(&types.ClusterRole{Rules: []types.Rule{{
+			Verbs:     []string{types.VerbAll},
+			Resources: []string{types.ResourceAll},
+		}}}, nil)
+	store.On("ListRoleBindings", mock.Anything, mock.Anything).
+		Return([]*types.RoleBinding{}, nil)
+	a := &Authorizer{Store: store}
+
+	claims := corev2.FixtureClaims("foo", nil)
+	claims.APIKey = true
+	claims.Namespaces = []string{"acme"}
+	claims.Verbs = []string{"get"}
+	ctx := context.WithValue(context.Background(), corev2.ClaimsKey, claims)
+
+	tests := []struct {
+		attrs *authorization.Attributes
+		want  bool
+	}{
+		{
+			attrs: &authorization.Attributes{Namespace: "acme", Resource: "checks", Verb: "get", User: types.User{Username: "foo"}},
+			want:  true,
+		},
+		{
+			attrs: &authorization.Attributes{Namespace: "acme", Resource: "checks", Verb: "delete", User: types.User{Username: "foo"}},
+			want:  false,
+		},
+		{
+			attrs: &authorization.Attributes{Namespace: "dev", Resource: "checks", Verb: "get", User: types.User{Username: "foo"}},
+			want:  false,
+		},
+		{
+			attrs: &authorization.Attributes{Resource: "users", Verb: "get", User: types.User{Username: "foo"}},
+			want:  false,
+		},
+	}
+	for _, tc := range tests {
+		got, err := a.Authorize(ctx, tc.attrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("Authorizer.Authorize(%+v) = %v, want %v", tc.attrs, got, tc.want)
+		}
+	}
+}
+
+func TestAuthorizeDenyRules(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, mock.Anything).
+		Return([]*types.ClusterRoleBinding{
+			{
+				RoleRef:  types.RoleRef{Type: "ClusterRole", Name: "operator"},
+				Subjects: []types.Subject{{Type: types.UserType, Name: "foo"}},
+			},
+			{
+				RoleRef:  types.RoleRef{Type: "ClusterRole", Name: "deleted"},
+				Subjects: []types.Subject{{Type: types.UserType, Name: "foo"}},
+			},
+			{
+				RoleRef:  types.RoleRef{Type: "ClusterRole", Name: "secrets-reader"},
+				Subjects: []types.Subject{{Type: types.UserType, Name: "foo"}},
+			},
+		}, nil)
+	s.On("GetClusterRole", mock.Anything, "operator").
+		Return(&types.ClusterRole{Rules: []types.Rule{
+			{Verbs: []string{types.VerbAll}, Resources: []string{types.ResourceAll}},
+			{Verbs: []string{types.VerbAll}, Resources: []string{"secrets", "users"}, Effect: corev2.RuleEffectDeny},
+		}}, nil)
+	s.On("GetClusterRole", mock.Anything, "deleted").
+		Return((*types.ClusterRole)(nil), &store.ErrNotFound{})
+	s.On("GetClusterRole", mock.Anything, "secrets-reader").
+		Return(&types.ClusterRole{Rules: []types.Rule{
+			{Verbs: []string{"get"}, Resources: []string{"secrets"}},
+		}}, nil)
+	a := &Authorizer{Store: s}
+
+	tests := []struct {
+		resource string
+		want     bool
+	}{
+		{resource: "checks", want: true},
+		{resource: "secrets", want: false},
+		{resource: "users", want: false},
+	}
+	for _, tc := range tests {
+		attrs := &authorization.Attributes{Resource: tc.resource, Verb: "get", User: types.User{Username: "foo"}}
+		got, err := a.Authorize(context.Background(), attrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("Authorizer.Authorize(%s) = %v, want %v", tc.resource, got, tc.want)
+		}
+	}
+}
+
+func TestAuthorizeAggregatedClusterRole(t *testing.T) {
+	checks := types.FixtureClusterRole("checks")
+	checks.Labels = map[string]string{"aggregate-to-ops": "true"}
+	checks.Rules = []types.Rule{{Verbs: []string{"get"}, Resources: []string{"checks"}}}
+	handlers := types.FixtureClusterRole("handlers")
+	handlers.Rules = []types.Rule{{Verbs: []string{"get"}, Resources: []string{"handlers"}}}
+	ops := &types.ClusterRole{
+		ObjectMeta: corev2.NewObjectMeta("ops", ""),
+		AggregationRule: &corev2.AggregationRule{
+			ClusterRoleSelectors: []corev2.ClusterRoleSelector{
+				{MatchLabels: map[string]string{"aggregate-to-ops": "true"}},
+			},
+		},
+	}
+
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, mock.Anything).
+		Return([]*types.ClusterRoleBinding{{
+			RoleRef:  types.RoleRef{Type: "ClusterRole", Name: "ops"},
+			Subjects: []types.Subject{{Type: types.UserType, Name: "foo"}},
+		}}, nil)
+	s.On("GetClusterRole", mock.Anything, "ops").Return(ops, nil)
+	s.On("ListClusterRoles", mock.Anything, mock.Anything).
+		Return([]*types.ClusterRole{checks, handlers, ops}, nil)
+	a := &Authorizer{Store: s}
+
+	tests := []struct {
+		resource string
+		want     bool
+	}{
+		{resource: "checks", want: true},
+		{resource: "handlers", want: false},
+	}
+	for _, tc := range tests {
+		attrs := &authorization.Attributes{Resource: tc.resource, Verb: "get", User: types.User{Username: "foo"}}
+		got, err := a.Authorize(context.Background(), attrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("Authorizer.Authorize(%s) = %v, want %v", tc.resource, got, tc.want)
+		}
+	}
+}
+
 func TestMatchesUser(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -448,3 +598,84 @@ func TestVisitRulesFor(t *testing.T) {
 		t.Fatalf("wrong number of rules: got %d, want %d", got, want)
 	}
 }
+
+func TestReview(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("ListClusterRoleBindings", mock.Anything, mock.Anything).
+		Return([]*types.ClusterRoleBinding{
+			{
+				ObjectMeta: corev2.NewObjectMeta("operators", ""),
+				RoleRef:    types.RoleRef{Type: "ClusterRole", Name: "operator"},
+				Subjects:   []types.Subject{{Type: types.GroupType, Name: "ops"}},
+			},
+		}, nil)
+	s.On("ListRoleBindings", mock.Anything, mock.Anything).
+		Return([]*types.RoleBinding{
+			{
+				ObjectMeta: corev2.NewObjectMeta("check-editors", "dev"),
+				RoleRef:    types.RoleRef{Type: "Role", Name: "check-editor"},
+				Subjects:   []types.Subject{{Type: types.UserType, Name: "foo"}},
+			},
+		}, nil)
+	s.On("GetClusterRole", mock.Anything, "operator").
+		Return(&types.ClusterRole{Rules: []types.Rule{
+			{Verbs: []string{"get"}, Resources: []string{types.ResourceAll}},
+			{Verbs: []string{types.VerbAll}, Resources: []string{"secrets"}, Effect: corev2.RuleEffectDeny},
+		}}, nil)
+	s.On("GetRole", mock.Anything, "check-editor").
+		Return(&types.Role{Rules: []types.Rule{
+			{Verbs: []string{"create"}, Resources: []string{"checks"}},
+		}}, nil)
+	a := &Authorizer{Store: s}
+	user := types.User{Username: "foo", Groups: []string{"ops"}}
+
+	tests := []struct {
+		verb     string
+		resource string
+		allowed  bool
+		binding  string
+		reason   string
+	}{
+		{
+			verb:     "create",
+			resource: "checks",
+			allowed:  true,
+			binding:  "RoleBinding dev/check-editors",
+			reason:   "allowed by a rule of the Role check-editor bound by the RoleBinding dev/check-editors",
+		},
+		{
+			verb:     "get",
+			resource: "secrets",
+			allowed:  false,
+			binding:  "ClusterRoleBinding operators",
+			reason:   "denied by a rule of the ClusterRole operator bound by the ClusterRoleBinding operators",
+		},
+		{
+			verb:     "delete",
+			resource: "checks",
+			allowed:  false,
+			reason:   "no rule allows the request",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.verb+" "+tc.resource, func(t *testing.T) {
+			attrs := &authorization.Attributes{Namespace: "dev", Resource: tc.resource, Verb: tc.verb, User: user}
+			review, err := a.Review(context.Background(), attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if review.Allowed != tc.allowed {
+				t.Errorf("Allowed = %v, want %v", review.Allowed, tc.allowed)
+			}
+			if review.Reason != tc.reason {
+				t.Errorf("Reason = %q, want %q", review.Reason, tc.reason)
+			}
+			if tc.binding == "" && review.Binding != nil {
+				t.Errorf("Binding = %s, want none", review.Binding)
+			}
+			if tc.binding != "" && (review.Binding == nil || review.Binding.String() != tc.binding) {
+				t.Errorf("Binding = %v, want %s", review.Binding, tc.binding)
+			}
+		})
+	}
+}
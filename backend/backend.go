@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime/debug"
 	"sync"
 	"syscall"
@@ -16,33 +17,51 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
+	"github.com/sensu/sensu-go/backend/acmed"
 	"github.com/sensu/sensu-go/backend/agentd"
 	"github.com/sensu/sensu-go/backend/api"
 	"github.com/sensu/sensu-go/backend/apid"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/graphql"
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
 	"github.com/sensu/sensu-go/backend/apid/routers"
+	"github.com/sensu/sensu-go/backend/audit"
 	"github.com/sensu/sensu-go/backend/authentication"
 	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authentication/kubernetes"
 	"github.com/sensu/sensu-go/backend/authentication/providers/basic"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
 	"github.com/sensu/sensu-go/backend/authorization/rbac"
 	"github.com/sensu/sensu-go/backend/daemon"
 	"github.com/sensu/sensu-go/backend/dashboardd"
+	"github.com/sensu/sensu-go/backend/diagnostics"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/backend/eventd"
+	"github.com/sensu/sensu-go/backend/federation"
+	"github.com/sensu/sensu-go/backend/health"
 	"github.com/sensu/sensu-go/backend/keepalived"
 	"github.com/sensu/sensu-go/backend/liveness"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/metricsbuffer"
+	"github.com/sensu/sensu-go/backend/nstemplate"
+	"github.com/sensu/sensu-go/backend/pipeline"
 	"github.com/sensu/sensu-go/backend/pipelined"
 	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/backend/retentiond"
 	"github.com/sensu/sensu-go/backend/ringv2"
+	"github.com/sensu/sensu-go/backend/rpcd"
 	"github.com/sensu/sensu-go/backend/schedulerd"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/bolt"
+	"github.com/sensu/sensu-go/backend/store/cache"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
 	"github.com/sensu/sensu-go/backend/tessend"
+	"github.com/sensu/sensu-go/js"
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/system"
+	"github.com/sensu/sensu-go/tracing"
+	"github.com/sensu/sensu-go/util/logging"
 	"github.com/sensu/sensu-go/util/retry"
 	"github.com/spf13/viper"
 	"golang.org/x/time/rate"
@@ -64,6 +83,7 @@ type Backend struct {
 	Client                 *clientv3.Client
 	Daemons                []daemon.Daemon
 	Etcd                   *etcd.Etcd
+	Bolt                   *bolt.Store
 	Store                  store.Store
 	EventStore             EventStoreUpdater
 	GraphQLService         *graphql.Service
@@ -75,6 +95,8 @@ type Backend struct {
 	runCtx    context.Context
 	runCancel context.CancelFunc
 	cfg       *Config
+	auditLog  *audit.FileLogger
+	tracer    *tracing.Tracer
 }
 
 // EventStoreUpdater offers a way to update an event store to a different
@@ -84,6 +106,27 @@ type EventStoreUpdater interface {
 }
 
 func newClient(ctx context.Context, config *Config, backend *Backend) (*clientv3.Client, error) {
+	switch config.StoreDriver {
+	case "", StoreDriverEtcd:
+	case StoreDriverBolt:
+		if config.NoEmbedEtcd {
+			return nil, fmt.Errorf("the %s store driver can't be used with an external etcd", StoreDriverBolt)
+		}
+		// Don't start up etcd, the bolt store provides the etcd API in-process
+		logger.Info("opening bolt store")
+		if err := os.MkdirAll(config.StateDir, 0700); err != nil {
+			return nil, err
+		}
+		s, err := bolt.Open(filepath.Join(config.StateDir, BoltFileName), config.EtcdName)
+		if err != nil {
+			return nil, fmt.Errorf("error opening bolt store: %s", err)
+		}
+		backend.Bolt = s
+		return s.NewClient(), nil
+	default:
+		return nil, fmt.Errorf("unknown store driver %q", config.StoreDriver)
+	}
+
 	if config.NoEmbedEtcd {
 		logger.Info("dialing etcd server")
 		tlsInfo := (transport.TLSInfo)(config.EtcdClientTLSInfo)
@@ -105,6 +148,7 @@ func newClient(ctx context.Context, config *Config, backend *Backend) (*clientv3
 			TLS:         tlsConfig,
 			DialOptions: []grpc.DialOption{
 				grpc.WithBlock(),
+				grpc.WithChainUnaryInterceptor(etcd.UnaryClientInterceptor),
 			},
 		})
 		if err != nil {
@@ -181,11 +225,17 @@ func newClient(ctx context.Context, config *Config, backend *Backend) (*clientv3
 // configuring etcd and establishing a list of daemons, which constitute our
 // backend. The daemons will later be started according to their position in the
 // b.Daemons list, and stopped in reverse order
-func Initialize(ctx context.Context, config *Config) (*Backend, error) {
-	var err error
+func Initialize(ctx context.Context, config *Config) (_ *Backend, err error) {
 	// Initialize a Backend struct
 	b := &Backend{cfg: config}
 
+	defer func() {
+		// Release the bolt store file, so the backend can be initialized again
+		if err != nil && b.Bolt != nil {
+			_ = b.Bolt.Close()
+		}
+	}()
+
 	b.ctx = ctx
 	b.runCtx, b.runCancel = context.WithCancel(b.ctx)
 
@@ -220,6 +270,16 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	// Initialize an etcd getter
 	queueGetter := queue.EtcdGetter{Client: b.Client, BackendIDGetter: backendID}
 
+	// Limit the resources of the JavaScript evaluations
+	js.SetLimits(js.Limits{
+		Timeout:   time.Duration(config.JSEvaluationTimeout) * time.Millisecond,
+		MaxMemory: config.JSEvaluationMaxMemory,
+	})
+	_ = prometheus.Register(js.EvaluationsInterrupted)
+
+	// Observe the latency of the requests to etcd
+	_ = prometheus.Register(etcd.RequestDuration)
+
 	// Initialize the bus
 	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
 	if err != nil {
@@ -231,6 +291,19 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	backendEntity := b.getBackendEntity(config)
 	logger.WithField("entity", backendEntity).Info("backend entity information")
 	assetManager := asset.NewManager(config.CacheDir, backendEntity, &sync.WaitGroup{})
+	assetManager.Mirrors, err = asset.ParseMirrors(config.AssetsMirrors)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing asset manager: %s", err)
+	}
+	assetManager.BundleDir = config.AssetsBundleDir
+	if len(config.AssetsTrustedKeys) > 0 {
+		if assetManager.TrustedKeys, err = asset.LoadTrustedKeys(config.AssetsTrustedKeys); err != nil {
+			return nil, fmt.Errorf("error initializing asset manager: %s", err)
+		}
+	}
+	assetManager.RequireSignatures = config.AssetsRequireSignatures
+	assetManager.GCInterval = time.Duration(config.AssetsGCInterval) * time.Second
+	assetManager.GCGracePeriod = time.Duration(config.AssetsGCGracePeriod) * time.Second
 	limit := b.cfg.AssetsRateLimit
 	if limit == 0 {
 		limit = rate.Limit(asset.DefaultAssetsRateLimit)
@@ -240,17 +313,48 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		return nil, fmt.Errorf("error initializing asset manager: %s", err)
 	}
 
-	// Initialize the secrets provider manager
+	// Initialize the secrets provider manager, with the built-in env provider
+	// and the providers of the store. The secrets are only sent to the agents
+	// over TLS.
 	b.SecretsProviderManager = secrets.NewProviderManager()
+	b.SecretsProviderManager.TLSenabled = config.TLS != nil || config.AgentTLSOptions != nil
+	b.SecretsProviderManager.Getter = &secrets.StoreGetter{Store: stor}
+	b.SecretsProviderManager.AddProvider(secrets.NewEnvProvider())
+	go b.SecretsProviderManager.Watch(b.RunContext(), stor)
+
+	// Initialize the tracer of the events, shared by agentd, eventd and
+	// pipelined
+	b.tracer, err = tracing.New(tracing.Config{
+		OTLPEndpoint: config.Tracing.OTLPEndpoint,
+		ServiceName:  "sensu-backend",
+		SampleRate:   config.Tracing.SampleRate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing the tracer: %s", err)
+	}
+
+	// Initialize the filter tracer, shared by pipelined and apid
+	filterTracer := pipeline.NewFilterTracer(pipeline.DefaultFilterTraceSize)
+
+	// Initialize the caching store, serving the entities, handlers, filters
+	// and mutators read for each event by eventd and pipelined
+	cachedStore, err := cache.NewStore(b.RunContext(), b.Client, stor)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing the caching store: %s", err)
+	}
 
 	// Initialize pipelined
 	pipeline, err := pipelined.New(pipelined.Config{
-		Store:                   stor,
+		Store:                   cachedStore,
 		Bus:                     bus,
 		ExtensionExecutorGetter: rpc.NewGRPCExtensionExecutor,
 		AssetGetter:             assetGetter,
 		BufferSize:              viper.GetInt(FlagPipelinedBufferSize),
 		WorkerCount:             viper.GetInt(FlagPipelinedWorkers),
+		HandlerConcurrency:      viper.GetInt(FlagPipelinedHandlerConcurrency),
+		BackpressurePolicy:      viper.GetString(FlagPipelinedBackpressurePolicy),
+		FilterTracer:            filterTracer,
+		Tracer:                  b.tracer,
 		StoreTimeout:            2 * time.Minute,
 		SecretsProviderManager:  b.SecretsProviderManager,
 		BackendEntity:           backendEntity,
@@ -260,18 +364,34 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	}
 	b.Daemons = append(b.Daemons, pipeline)
 
+	// Initialize the metrics buffer, if enabled, shared by eventd and apid
+	var metricsBuffer *metricsbuffer.Buffer
+	if config.MetricsBuffer {
+		bufferConfig := metricsbuffer.Config{
+			Resolution: time.Duration(config.MetricsBufferResolution) * time.Second,
+		}
+		if config.MetricsBufferFlushURL != "" {
+			bufferConfig.Flusher = metricsbuffer.NewHTTPFlusher(config.MetricsBufferFlushURL)
+		}
+		metricsBuffer = metricsbuffer.New(bufferConfig)
+		b.Daemons = append(b.Daemons, metricsBuffer)
+	}
+
 	// Initialize eventd
 	event, err := eventd.New(
 		b.RunContext(),
 		eventd.Config{
-			Store:           stor,
+			Store:           cachedStore,
 			EventStore:      eventStoreProxy,
+			HistoryStore:    stor,
 			Bus:             bus,
 			LivenessFactory: liveness.EtcdFactory(b.RunContext(), b.Client),
 			Client:          b.Client,
 			BufferSize:      viper.GetInt(FlagEventdBufferSize),
 			WorkerCount:     viper.GetInt(FlagEventdWorkers),
 			StoreTimeout:    2 * time.Minute,
+			MetricsBuffer:   metricsBuffer,
+			Tracer:          b.tracer,
 		},
 	)
 	if err != nil {
@@ -279,7 +399,29 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	}
 	b.Daemons = append(b.Daemons, event)
 
+	// Initialize retentiond
+	retention, err := retentiond.New(retentiond.Config{
+		Store:        stor,
+		HistoryStore: stor,
+		Client:       b.Client,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing %s: %s", retention.Name(), err)
+	}
+	b.Daemons = append(b.Daemons, retention)
+
+	// Initialize federation
+	replication, err := federation.New(federation.Config{
+		Store:  stor,
+		Client: b.Client,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing %s: %s", replication.Name(), err)
+	}
+	b.Daemons = append(b.Daemons, replication)
+
 	ringPool := ringv2.NewPool(b.Client)
+	roundRobinTracker := schedulerd.NewRoundRobinTracker(ringPool, schedulerd.DefaultRoundRobinHistorySize)
 
 	// Initialize schedulerd
 	scheduler, err := schedulerd.New(
@@ -291,6 +433,8 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 			RingPool:               ringPool,
 			Client:                 b.Client,
 			SecretsProviderManager: b.SecretsProviderManager,
+			RoundRobinTracker:      roundRobinTracker,
+			AgentSplay:             config.AgentSplay,
 		})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", scheduler.Name(), err)
@@ -304,14 +448,30 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	}
 
 	// Initialize agentd
+	var reviewer agentd.TokenReviewer
+	if config.AgentKubernetesAuth {
+		reviewer, err = kubernetes.NewInClusterTokenReviewer(config.AgentKubernetesAudience)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing the kubernetes agent authentication: %s", err)
+		}
+	}
 	agent, err := agentd.New(agentd.Config{
-		Host:         config.AgentHost,
-		Port:         config.AgentPort,
-		Bus:          bus,
-		Store:        stor,
-		TLS:          config.AgentTLSOptions,
-		RingPool:     ringPool,
-		WriteTimeout: config.AgentWriteTimeout,
+		Host:           config.AgentHost,
+		Port:           config.AgentPort,
+		Bus:            bus,
+		Store:          stor,
+		TLS:            config.AgentTLSOptions,
+		RingPool:       ringPool,
+		WriteTimeout:   config.AgentWriteTimeout,
+		TokenReviewer:  reviewer,
+		CertAuth:       config.AgentCertAuth,
+		TLSReload:      time.Duration(config.TLSReloadInterval) * time.Second,
+		DrainJitter:    time.Duration(config.AgentDrainJitter) * time.Second,
+		Client:         b.Client,
+		BackendName:    backendEntity.Name,
+		AutoRebalance:  config.AgentAutoRebalance,
+		Tracer:         b.tracer,
+		EventQueueSize: viper.GetInt(FlagAgentdEventQueueSize),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", agent.Name(), err)
@@ -329,6 +489,7 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		BufferSize:            viper.GetInt(FlagKeepalivedBufferSize),
 		WorkerCount:           viper.GetInt(FlagKeepalivedWorkers),
 		StoreTimeout:          2 * time.Minute,
+		BatchInterval:         time.Duration(viper.GetInt(FlagKeepalivedBatchInterval)) * time.Millisecond,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", keepalive.Name(), err)
@@ -350,10 +511,27 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		Store:      stor,
 	}
 	authenticator.AddProvider(basic)
+	if config.OIDC.Issuer != "" {
+		provider := &oidc.Provider{
+			ObjectMeta:     corev2.ObjectMeta{Name: oidc.Type},
+			Issuer:         config.OIDC.Issuer,
+			ClientID:       config.OIDC.ClientID,
+			ClientSecret:   config.OIDC.ClientSecret,
+			Scopes:         config.OIDC.Scopes,
+			UsernameClaim:  config.OIDC.UsernameClaim,
+			UsernamePrefix: config.OIDC.UsernamePrefix,
+			GroupsClaim:    config.OIDC.GroupsClaim,
+			GroupsPrefix:   config.OIDC.GroupsPrefix,
+		}
+		if err := provider.Validate(); err != nil {
+			return nil, err
+		}
+		authenticator.AddProvider(provider)
+	}
 
 	var clusterVersion string
 	// only retrieve the cluster version if etcd is embedded
-	if !config.NoEmbedEtcd {
+	if b.Etcd != nil {
 		clusterVersion = b.Etcd.GetClusterVersion()
 	}
 
@@ -363,7 +541,20 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	}
 
 	// Initialize the health router
-	b.HealthRouter = routers.NewHealthRouter(actions.NewHealthController(stor, b.Client.Cluster, b.EtcdClientTLSConfig))
+	healthController := actions.NewHealthController(stor, b.Client.Cluster, b.EtcdClientTLSConfig)
+	healthChecker := health.New(health.Config{
+		ClusterHealth: healthController,
+		KV:            b.Client,
+		Eventd:        event,
+		Pipelined:     pipeline,
+		AgentdAddress: fmt.Sprintf("%s:%d", agent.Host, agent.Port),
+		Thresholds: health.Thresholds{
+			EtcdLatency:      time.Duration(config.Health.EtcdLatencyThreshold) * time.Millisecond,
+			EventdQueue:      config.Health.EventdQueueThreshold,
+			PipelinedBacklog: config.Health.PipelinedBacklogThreshold,
+		},
+	})
+	b.HealthRouter = routers.NewHealthRouter(healthController, healthChecker)
 
 	// Initialize GraphQL service
 	auth := &rbac.Authorizer{Store: stor}
@@ -372,23 +563,68 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		CheckClient:       api.NewCheckClient(stor, actions.NewCheckController(stor, queueGetter), auth),
 		EntityClient:      api.NewEntityClient(stor, eventStoreProxy, auth),
 		EventClient:       api.NewEventClient(eventStoreProxy, auth, bus),
+		FederationClient:  api.NewFederationClient(stor, eventStoreProxy, auth),
 		EventFilterClient: api.NewEventFilterClient(stor, auth),
 		HandlerClient:     api.NewHandlerClient(stor, auth),
 		HealthController:  actions.NewHealthController(stor, b.Client.Cluster, etcdClientTLSConfig),
 		MutatorClient:     api.NewMutatorClient(stor, auth),
 		SilencedClient:    api.NewSilencedClient(stor, auth),
-		NamespaceClient:   api.NewNamespaceClient(stor, auth),
+		NamespaceClient:   api.NewNamespaceClient(nstemplate.NewStore(stor), auth),
 		HookClient:        api.NewHookConfigClient(stor, auth),
 		UserClient:        api.NewUserClient(stor, auth),
 		RBACClient:        api.NewRBACClient(stor, auth),
 		VersionController: actions.NewVersionController(clusterVersion),
 		MetricGatherer:    prometheus.DefaultGatherer,
 		GenericClient:     &api.GenericClient{Store: stor, Auth: auth},
+		MaxDepth:          config.GraphQLMaxDepth,
+		MaxCost:           config.GraphQLMaxCost,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing graphql.Service: %s", err)
 	}
 
+	// Initialize the audit log, if enabled
+	var auditLogger audit.Logger
+	if config.AuditLogFile != "" {
+		b.auditLog, err = audit.NewFileLogger(logging.RotateFileWriterConfig{
+			Path:           config.AuditLogFile,
+			MaxSizeBytes:   config.AuditLogMaxSize,
+			RetentionFiles: config.AuditLogRetention,
+		})
+		if err != nil {
+			return nil, err
+		}
+		auditLogger = b.auditLog
+	}
+
+	// Load the allowlist of the GraphQL queries, if any
+	persistedQueries := &routers.GraphQLPersistedQueries{}
+	if config.GraphQLPersistedQueries != "" {
+		persistedQueries, err = routers.LoadGraphQLPersistedQueries(config.GraphQLPersistedQueries)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the persisted GraphQL queries: %s", err)
+		}
+	}
+
+	// Initialize acmed, if enabled
+	var getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+	if len(config.ACMEDomains) > 0 {
+		acme, err := acmed.New(acmed.Config{
+			Domains:      config.ACMEDomains,
+			Email:        config.ACMEEmail,
+			DirectoryURL: config.ACMEDirectoryURL,
+			Challenge:    config.ACMEChallenge,
+			HTTPAddress:  config.ACMEHTTPAddress,
+			DNSHook:      config.ACMEDNSHook,
+			Client:       b.Client,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error initializing acmed: %s", err)
+		}
+		b.Daemons = append(b.Daemons, acme)
+		getCertificate = acme.GetCertificate
+	}
+
 	// Initialize apid
 	apidConfig := apid.Config{
 		ListenAddress:       config.APIListenAddress,
@@ -398,12 +634,33 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		EventStore:          eventStoreProxy,
 		QueueGetter:         queueGetter,
 		TLS:                 config.TLS,
+		TLSReload:           time.Duration(config.TLSReloadInterval) * time.Second,
+		GetCertificate:      getCertificate,
 		Cluster:             b.Client.Cluster,
 		EtcdClientTLSConfig: etcdClientTLSConfig,
 		Authenticator:       authenticator,
 		ClusterVersion:      clusterVersion,
 		GraphQLService:      b.GraphQLService,
 		HealthRouter:        b.HealthRouter,
+		AuditLogger:         auditLogger,
+		FilterTracer:        filterTracer,
+		RoundRobinTracker:   roundRobinTracker,
+		AgentBalancer:       agent,
+
+		GraphQLPersistedQueries: persistedQueries,
+		GraphQLCacheTTL:         time.Duration(config.GraphQLCacheTTL) * time.Second,
+		EventHistoryStore:       stor,
+		MetricsBuffer:           metricsBuffer,
+		Diagnostics: &diagnostics.Collector{
+			Config:         config,
+			ClusterVersion: clusterVersion,
+			Logs:           diagnostics.RecentLogs,
+			Gatherer:       prometheus.DefaultGatherer,
+			Started:        time.Now(),
+		},
+	}
+	if config.APIRateLimit > 0 {
+		apidConfig.RateLimiter = middlewares.NewRateLimiter(config.APIRateLimit, config.APIBurstLimit)
 	}
 	api, err := apid.New(apidConfig)
 	if err != nil {
@@ -411,6 +668,21 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 	}
 	b.Daemons = append(b.Daemons, api)
 
+	// Initialize rpcd, if enabled
+	if config.GRPCListenAddress != "" {
+		rpc, err := rpcd.New(rpcd.Config{
+			ListenAddress: config.GRPCListenAddress,
+			Store:         stor,
+			EventStore:    eventStoreProxy,
+			Bus:           bus,
+			TLS:           config.TLS,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error initializing %s: %s", rpc.Name(), err)
+		}
+		b.Daemons = append(b.Daemons, rpc)
+	}
+
 	// Initialize tessend
 	tessen, err := tessend.New(
 		b.RunContext(),
@@ -443,10 +715,12 @@ func Initialize(ctx context.Context, config *Config) (*Backend, error) {
 		}
 	}
 	dashboard, err := dashboardd.New(dashboardd.Config{
-		APIDConfig: apidConfig,
-		Host:       config.DashboardHost,
-		Port:       config.DashboardPort,
-		TLS:        dashboardTLSConfig,
+		APIDConfig:     apidConfig,
+		Host:           config.DashboardHost,
+		Port:           config.DashboardPort,
+		TLS:            dashboardTLSConfig,
+		TLSReload:      time.Duration(config.TLSReloadInterval) * time.Second,
+		GetCertificate: getCertificate,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error initializing %s: %s", dashboard.Name(), err)
@@ -460,6 +734,13 @@ func (b *Backend) runOnce(sighup <-chan os.Signal) error {
 	eCloser := b.EventStore.(closer)
 	defer eCloser.Close()
 
+	if b.auditLog != nil {
+		defer b.auditLog.Close()
+	}
+
+	// Export the last spans once the daemons are stopped
+	defer b.tracer.Stop()
+
 	var derr error
 
 	eg := errGroup{
@@ -483,6 +764,16 @@ func (b *Backend) runOnce(sighup <-chan os.Signal) error {
 		}()
 	}
 
+	if b.Bolt != nil {
+		defer func() {
+			logger.Info("closing bolt store")
+			err := b.Bolt.Close()
+			if derr == nil {
+				derr = err
+			}
+		}()
+	}
+
 	sg := stopGroup{}
 
 	// Loop across the daemons in order to start them, then add them to our groups
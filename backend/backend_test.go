@@ -135,7 +135,7 @@ func TestBackendHTTPListener(t *testing.T) {
 				transport.HeaderKeyAgentName:     {"agent"},
 				transport.HeaderKeySubscriptions: {},
 			}
-			client, _, err := transport.Connect(fmt.Sprintf("%s://127.0.0.1:%d/", tc.wsScheme, agentPort), tc.tls, hdr, 5)
+			client, _, err := transport.Connect(fmt.Sprintf("%s://127.0.0.1:%d/", tc.wsScheme, agentPort), tc.tls, hdr, 5, nil, false)
 			require.NoError(t, err)
 			require.NotNil(t, client)
 
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagAPIKey            = "api-key"
+	flagUsername          = "username"
+	flagPassword          = "password"
+	flagCPUProfileSeconds = "cpu-profile-seconds"
+
+	// diagnosticsPath is the path of the diagnostics bundles in the API.
+	diagnosticsPath = "/api/core/v2/diagnostics"
+)
+
+// DiagnosticsCommand is the 'sensu-backend diagnostics' subcommand.
+func DiagnosticsCommand() *cobra.Command {
+	var setupErr error
+	cmd := &cobra.Command{
+		Use:   "diagnostics [FILE]",
+		Short: "save a diagnostics bundle of a running backend to FILE, to attach to support tickets",
+		Long: `Save a diagnostics bundle of a running backend to FILE, a gzipped tarball of
+its CPU and memory profiles, goroutine dump, redacted configuration, recent
+logs, metrics, including those of etcd, and version information. The bundle is
+requested from the API of the backend, as a cluster admin, and is saved to
+sensu-backend-diagnostics-TIMESTAMP.tar.gz if FILE is not given.`,
+		Args:          cobra.MaximumNArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			if setupErr != nil {
+				return setupErr
+			}
+
+			file := fmt.Sprintf("sensu-backend-diagnostics-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+			if len(args) > 0 {
+				file = args[0]
+			}
+
+			client, err := newDiagnosticsClient()
+			if err != nil {
+				return err
+			}
+			authorization, err := diagnosticsAuthorization(client)
+			if err != nil {
+				return err
+			}
+
+			query := url.Values{"cpuProfileSeconds": {strconv.Itoa(viper.GetInt(flagCPUProfileSeconds))}}
+			req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(viper.GetString(flagAPIURL), "/")+diagnosticsPath+"?"+query.Encode(), nil)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Authorization", authorization)
+			resp, err := client.Do(req)
+			if err != nil {
+				return fmt.Errorf("error requesting the diagnostics bundle: %s", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+				return fmt.Errorf("error requesting the diagnostics bundle: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+			}
+
+			// The bundle is only moved to FILE once complete
+			tmpFile, err := ioutil.TempFile(filepath.Dir(file), ".sensu-diagnostics")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmpFile.Name())
+			size, err := io.Copy(tmpFile, resp.Body)
+			if err != nil {
+				_ = tmpFile.Close()
+				return fmt.Errorf("error reading the diagnostics bundle: %s", err)
+			}
+			if err := tmpFile.Close(); err != nil {
+				return err
+			}
+			if err := os.Rename(tmpFile.Name(), file); err != nil {
+				return err
+			}
+
+			fmt.Printf("Diagnostics bundle saved to %s (%d bytes)\n", file, size)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAPIURL, "http://localhost:8080", "url of the api of the backend")
+	cmd.Flags().String(flagAPIKey, "", "API key of a cluster admin")
+	cmd.Flags().String(flagUsername, "", "username of a cluster admin, if no API key is given")
+	cmd.Flags().String(flagPassword, "", "password of the user")
+	cmd.Flags().Int(flagCPUProfileSeconds, 5, "duration, in seconds, of the CPU profile of the bundle (0 disables the CPU profile)")
+	cmd.Flags().String(flagTrustedCAFile, "", "TLS CA certificate bundle in PEM format, to verify the certificate of the api")
+	cmd.Flags().Bool(flagInsecureSkipTLSVerify, false, "skip the verification of the certificate of the api")
+
+	setupErr = handleConfig(cmd, false)
+
+	return cmd
+}
+
+func newDiagnosticsClient() (*http.Client, error) {
+	tlsOptions := corev2.TLSOptions{
+		TrustedCAFile:      viper.GetString(flagTrustedCAFile),
+		InsecureSkipVerify: viper.GetBool(flagInsecureSkipTLSVerify),
+	}
+	tlsConfig, err := tlsOptions.ToClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: time.Minute}, nil
+}
+
+// diagnosticsAuthorization returns the authorization header of the requests
+// to the API, from the API key, or from an access token issued for the
+// username and the password.
+func diagnosticsAuthorization(client *http.Client) (string, error) {
+	if key := viper.GetString(flagAPIKey); key != "" {
+		return "Key " + key, nil
+	}
+	username := viper.GetString(flagUsername)
+	if username == "" {
+		return "", errors.New("an API key or a username is required")
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(viper.GetString(flagAPIURL), "/")+"/auth", nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(username, viper.GetString(flagPassword))
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error authenticating: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error authenticating: %s", resp.Status)
+	}
+	var tokens corev2.Tokens
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return "", fmt.Errorf("error authenticating: %s", err)
+	}
+	return "Bearer " + tokens.Access, nil
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/AlecAivazis/survey"
@@ -12,7 +14,9 @@ import (
 	"github.com/sensu/sensu-go/backend"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/sensu/sensu-go/backend/store/bolt"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sensu/sensu-go/util/path"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -113,23 +117,8 @@ func InitCommand() *cobra.Command {
 				return err
 			}
 
-			clientURLs := viper.GetStringSlice(flagEtcdClientURLs)
-			if len(clientURLs) == 0 {
-				clientURLs = viper.GetStringSlice(flagEtcdAdvertiseClientURLs)
-			}
-
 			timeout := viper.GetDuration(flagTimeout)
 
-			client, err := clientv3.New(clientv3.Config{
-				Endpoints:   clientURLs,
-				DialTimeout: timeout * time.Second,
-				TLS:         tlsConfig,
-			})
-
-			if err != nil {
-				return fmt.Errorf("error connecting to cluster: %s", err)
-			}
-
 			uname := viper.GetString(flagInitAdminUsername)
 			pword := viper.GetString(flagInitAdminPassword)
 
@@ -155,6 +144,26 @@ func InitCommand() *cobra.Command {
 				Timeout: timeout,
 			}
 
+			// The bolt store is seeded in place, while the backend is stopped
+			if viper.GetString(backend.FlagStoreDriver) == backend.StoreDriverBolt {
+				return seedBoltStore(viper.GetString(flagStateDir), seedConfig)
+			}
+
+			clientURLs := viper.GetStringSlice(flagEtcdClientURLs)
+			if len(clientURLs) == 0 {
+				clientURLs = viper.GetStringSlice(flagEtcdAdvertiseClientURLs)
+			}
+
+			client, err := clientv3.New(clientv3.Config{
+				Endpoints:   clientURLs,
+				DialTimeout: timeout * time.Second,
+				TLS:         tlsConfig,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error connecting to cluster: %s", err)
+			}
+
 			// Make sure at least one of the provided endpoints is reachable. This is
 			// required to debug TLS errors because the seeding below will not print
 			// the latest connection error (see
@@ -183,12 +192,29 @@ func InitCommand() *cobra.Command {
 	cmd.Flags().String(flagInitAdminPassword, "", "cluster admin password")
 	cmd.Flags().Bool(flagInteractive, false, "interactive mode")
 	cmd.Flags().String(flagTimeout, defaultTimeout, "timeout, in seconds, for failing to establish a connection to etcd")
+	cmd.Flags().StringP(flagStateDir, "d", path.SystemDataDir("sensu-backend"), "path to sensu state storage, where the bolt store is seeded")
 
 	setupErr = handleConfig(cmd, false)
 
 	return cmd
 }
 
+// seedBoltStore seeds the bolt store of the state directory stateDir. The
+// store can't be opened while the backend is running.
+func seedBoltStore(stateDir string, config seedConfig) error {
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return err
+	}
+	s, err := bolt.Open(filepath.Join(stateDir, backend.BoltFileName), backend.DefaultEtcdName)
+	if err != nil {
+		return fmt.Errorf("error opening bolt store, make sure the backend is stopped: %s", err)
+	}
+	defer s.Close()
+	client := s.NewClient()
+	defer client.Close()
+	return seedCluster(client, config)
+}
+
 func seedCluster(client *clientv3.Client, config seedConfig) error {
 	store := etcdstore.NewStore(client, "")
 	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout*time.Second)
@@ -0,0 +1,121 @@
+// +build windows
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	runtimedebug "runtime/debug"
+
+	"github.com/sensu/sensu-go/backend"
+	"github.com/sensu/sensu-go/util/service"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+)
+
+var _ svc.Handler = &Service{}
+
+// NewService returns the sensu-backend service. args holds the path to the
+// config file and the path to the log file.
+func NewService(args []string) *Service {
+	return &Service{args: args}
+}
+
+// Service runs sensu-backend under the control of the service control
+// manager.
+type Service struct {
+	args []string
+}
+
+// run starts the backend and blocks until it stops.
+func (s *Service) run() (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = errors.New(string(runtimedebug.Stack()))
+		}
+	}()
+
+	binPath, err := service.ExePath()
+	if err != nil {
+		return err
+	}
+	configFile := s.args[0]
+	logPath := s.args[1]
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("service quit: can't open log file: %s", err)
+	}
+	defer logFile.Close()
+
+	logrus.SetFormatter(&logrus.JSONFormatter{})
+	logrus.SetOutput(logFile)
+
+	// The config file is looked up in the command line arguments when the
+	// start command is created
+	os.Args = []string{binPath, "start", "--" + flagConfigFile, configFile}
+	command := StartCommand(backend.Initialize)
+	command.SetArgs(os.Args[2:])
+
+	if err := command.Execute(); err != nil && err != context.Canceled {
+		logger.WithError(err).Error("sensu-backend exited with error")
+		return err
+	}
+	return nil
+}
+
+// Execute runs the backend until the service is stopped. If the backend stops
+// on its own, the service stops with a non-zero exit code so the recovery
+// actions of the service apply.
+func (s *Service) Execute(_ []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	elog, _ := eventlog.Open(serviceName)
+	defer elog.Close()
+
+	changes <- svc.Status{State: svc.StartPending}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.run()
+	}()
+	changes <- svc.Status{State: svc.Running, Accepts: svc.AcceptShutdown | svc.AcceptStop}
+
+	for {
+		select {
+		case req := <-r:
+			switch req.Cmd {
+			case svc.Interrogate:
+				changes <- req.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				elog.Info(1, "service shutting down")
+				changes <- svc.Status{State: svc.StopPending}
+				close(stopRequests)
+				if err := <-errs; err != nil {
+					elog.Error(1, fmt.Sprintf("error while shutting down: %s", err))
+				}
+				changes <- svc.Status{State: svc.Stopped}
+				return false, 0
+			}
+		case err := <-errs:
+			if err == nil {
+				err = errors.New("sensu-backend exited unexpectedly")
+			}
+			elog.Error(1, fmt.Sprintf("service quit (%v): %s", s.args, err))
+			return false, 1
+		}
+	}
+}
+
+func runService(args []string) error {
+	elog, err := eventlog.Open(serviceName)
+	if err != nil {
+		return err
+	}
+	defer elog.Close()
+	elog.Info(1, fmt.Sprintf("starting %s service (%v)", serviceName, args))
+	if err := svc.Run(serviceName, NewService(args)); err != nil {
+		return err
+	}
+	elog.Info(1, fmt.Sprintf("%s service terminated", serviceName))
+	return nil
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/transport"
+	"github.com/sensu/sensu-go/backend"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/util/path"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagEncryptionKeyFile = "encryption-key-file"
+)
+
+// SnapshotCommand is the 'sensu-backend snapshot' subcommand.
+func SnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "save and restore snapshots of the etcd store",
+	}
+	cmd.AddCommand(snapshotSaveCommand())
+	cmd.AddCommand(snapshotRestoreCommand())
+	return cmd
+}
+
+func snapshotSaveCommand() *cobra.Command {
+	var setupErr error
+	cmd := &cobra.Command{
+		Use:           "save FILE",
+		Short:         "save a consistent snapshot of the etcd store, embedded or not, to FILE",
+		Args:          cobra.ExactArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			if setupErr != nil {
+				return setupErr
+			}
+			if viper.GetString(backend.FlagStoreDriver) == backend.StoreDriverBolt {
+				return errors.New("snapshots require the etcd store driver, back up the bolt store of the state directory while the backend is stopped instead")
+			}
+
+			passphrase, err := readEncryptionKey()
+			if err != nil {
+				return err
+			}
+
+			tlsInfo := transport.TLSInfo{
+				CertFile:       viper.GetString(flagEtcdCertFile),
+				KeyFile:        viper.GetString(flagEtcdKeyFile),
+				TrustedCAFile:  viper.GetString(flagEtcdTrustedCAFile),
+				ClientCertAuth: viper.GetBool(flagEtcdClientCertAuth),
+			}
+			tlsConfig, err := tlsInfo.ClientConfig()
+			if err != nil {
+				return err
+			}
+
+			// The snapshot is only moved to FILE once verified, and encrypted
+			file := args[0]
+			tmpDir, err := ioutil.TempDir(filepath.Dir(file), ".sensu-snapshot")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+			tmpFile := filepath.Join(tmpDir, "snapshot.db")
+
+			status, err := etcd.SaveSnapshot(context.Background(), clientv3.Config{
+				Endpoints:   fallbackStringSlice(flagEtcdClientURLs, flagEtcdAdvertiseClientURLs),
+				DialTimeout: viper.GetDuration(flagTimeout) * time.Second,
+				TLS:         tlsConfig,
+			}, tmpFile)
+			if err != nil {
+				return fmt.Errorf("error saving snapshot: %s", err)
+			}
+
+			if passphrase != nil {
+				encrypted := filepath.Join(tmpDir, "snapshot.db.enc")
+				if err := encryptSnapshotFile(encrypted, tmpFile, passphrase); err != nil {
+					return fmt.Errorf("error encrypting snapshot: %s", err)
+				}
+				tmpFile = encrypted
+			}
+			if err := os.Rename(tmpFile, file); err != nil {
+				return err
+			}
+
+			fmt.Printf("Snapshot saved to %s (revision %d, %d keys, %d bytes)\n", file, status.Revision, status.TotalKey, status.TotalSize)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagEncryptionKeyFile, "", "path to a file containing the passphrase to encrypt the snapshot with (not encrypted if empty)")
+	cmd.Flags().String(flagTimeout, defaultTimeout, "timeout, in seconds, for failing to establish a connection to etcd")
+
+	setupErr = handleConfig(cmd, false)
+
+	return cmd
+}
+
+func snapshotRestoreCommand() *cobra.Command {
+	var setupErr error
+	cmd := &cobra.Command{
+		Use:   "restore FILE",
+		Short: "restore the snapshot FILE into the state directory of a new embedded etcd member",
+		Long: `Restore the snapshot FILE into the state directory of a new embedded etcd
+member, which must not hold any etcd data. When restoring a cluster, restore
+the same snapshot on every member, with its own --etcd-name and
+--etcd-initial-advertise-peer-urls and the same --etcd-initial-cluster and
+--etcd-initial-cluster-token, then start the backends.`,
+		Args:          cobra.ExactArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			if setupErr != nil {
+				return setupErr
+			}
+
+			passphrase, err := readEncryptionKey()
+			if err != nil {
+				return err
+			}
+
+			file := args[0]
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			encrypted, err := etcd.IsEncryptedSnapshot(f)
+			_ = f.Close()
+			if err != nil {
+				return err
+			}
+			if encrypted {
+				if passphrase == nil {
+					return fmt.Errorf("the snapshot is encrypted, --%s is required", flagEncryptionKeyFile)
+				}
+				tmpDir, err := ioutil.TempDir("", "sensu-snapshot")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmpDir)
+				decrypted := filepath.Join(tmpDir, "snapshot.db")
+				if err := decryptSnapshotFile(decrypted, file, passphrase); err != nil {
+					return fmt.Errorf("error decrypting snapshot: %s", err)
+				}
+				file = decrypted
+			}
+
+			cfg := etcd.NewConfig()
+			cfg.DataDir = viper.GetString(flagStateDir)
+			cfg.Name = viper.GetString(flagEtcdNodeName)
+			cfg.InitialAdvertisePeerURLs = viper.GetStringSlice(flagEtcdInitialAdvertisePeerURLs)
+			cfg.InitialCluster = viper.GetString(flagEtcdInitialCluster)
+			cfg.InitialClusterToken = viper.GetString(flagEtcdInitialClusterToken)
+			if cfg.InitialCluster == "" && len(cfg.InitialAdvertisePeerURLs) > 0 {
+				cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, cfg.InitialAdvertisePeerURLs[0])
+			}
+
+			if err := etcd.RestoreSnapshot(file, cfg); err != nil {
+				return fmt.Errorf("error restoring snapshot: %s", err)
+			}
+
+			fmt.Printf("Snapshot restored to %s\n", cfg.DataDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagEncryptionKeyFile, "", "path to a file containing the passphrase the snapshot is encrypted with")
+	cmd.Flags().StringP(flagStateDir, "d", path.SystemDataDir("sensu-backend"), "path to sensu state storage, where the snapshot is restored")
+	cmd.Flags().String(flagEtcdNodeName, defaultEtcdName, "name of the restored etcd member")
+	cmd.Flags().StringSlice(flagEtcdInitialAdvertisePeerURLs, []string{defaultEtcdPeerURL}, "list of the peer URLs of the restored member")
+	cmd.Flags().String(flagEtcdInitialCluster, "", "initial cluster configuration of the restored cluster (the restored member only if empty)")
+	cmd.Flags().String(flagEtcdInitialClusterToken, "", "initial cluster token of the restored cluster")
+
+	setupErr = handleConfig(cmd, false)
+
+	return cmd
+}
+
+// readEncryptionKey returns the passphrase of the encryption key file, or nil
+// if there is none.
+func readEncryptionKey() ([]byte, error) {
+	keyFile := viper.GetString(flagEncryptionKeyFile)
+	if keyFile == "" {
+		return nil, nil
+	}
+	b, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	passphrase := bytes.TrimRight(b, "\r\n")
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("the encryption key file %s is empty", keyFile)
+	}
+	return passphrase, nil
+}
+
+func encryptSnapshotFile(dst, src string, passphrase []byte) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if err := etcd.EncryptSnapshot(out, in, passphrase); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func decryptSnapshotFile(dst, src string, passphrase []byte) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if err := etcd.DecryptSnapshot(out, in, passphrase); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -15,7 +15,20 @@ import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/backend"
+	"github.com/sensu/sensu-go/backend/acmed"
+	"github.com/sensu/sensu-go/backend/agentd"
+	"github.com/sensu/sensu-go/backend/apid/graphql"
+	"github.com/sensu/sensu-go/backend/authentication/kubernetes"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
+	"github.com/sensu/sensu-go/backend/diagnostics"
 	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/health"
+	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/metricsbuffer"
+	"github.com/sensu/sensu-go/backend/pipelined"
+	"github.com/sensu/sensu-go/js"
+	"github.com/sensu/sensu-go/tracing"
+	"github.com/sensu/sensu-go/util/logging"
 	"github.com/sensu/sensu-go/util/path"
 	stringsutil "github.com/sensu/sensu-go/util/strings"
 	"github.com/sirupsen/logrus"
@@ -28,6 +41,9 @@ import (
 var (
 	annotations map[string]string
 	labels      map[string]string
+
+	// stopRequests is closed to stop the running backend
+	stopRequests = make(chan struct{})
 )
 
 const (
@@ -39,6 +55,15 @@ const (
 	flagAPIURL                = "api-url"
 	flagAssetsRateLimit       = "assets-rate-limit"
 	flagAssetsBurstLimit      = "assets-burst-limit"
+	flagAssetsMirror          = "assets-mirror"
+	flagAssetsBundleDir       = "assets-bundle-dir"
+	flagAssetsTrustedKeys     = "assets-trusted-keys"
+	flagAssetsRequireSigs     = "assets-require-signatures"
+	flagAssetsGCInterval      = "assets-gc-interval"
+	flagAssetsGCGracePeriod   = "assets-gc-grace-period"
+	flagAuditLogFile          = "audit-log-file"
+	flagAuditLogMaxSize       = "audit-log-max-size"
+	flagAuditLogRetention     = "audit-log-retention"
 	flagDashboardHost         = "dashboard-host"
 	flagDashboardPort         = "dashboard-port"
 	flagDashboardCertFile     = "dashboard-cert-file"
@@ -52,6 +77,12 @@ const (
 	flagInsecureSkipTLSVerify = "insecure-skip-tls-verify"
 	flagDebug                 = "debug"
 	flagLogLevel              = "log-level"
+	flagLogOutput             = "log-output"
+	flagSyslogNetwork         = "syslog-network"
+	flagSyslogAddress         = "syslog-address"
+	flagSyslogFacility        = "syslog-facility"
+	flagSyslogTag             = "syslog-tag"
+	flagSyslogCAFile          = "syslog-ca-file"
 	flagLabels                = "labels"
 	flagAnnotations           = "annotations"
 
@@ -162,6 +193,23 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 			}
 			logrus.SetLevel(level)
 
+			// Keep the recent logs for the diagnostics bundles
+			logrus.AddHook(diagnostics.RecentLogs)
+
+			logOutput, err := logging.ConfigureOutput(viper.GetString(flagLogOutput), logging.SyslogConfig{
+				Network:  viper.GetString(flagSyslogNetwork),
+				Address:  viper.GetString(flagSyslogAddress),
+				Facility: viper.GetString(flagSyslogFacility),
+				Tag:      viper.GetString(flagSyslogTag),
+				CAFile:   viper.GetString(flagSyslogCAFile),
+			})
+			if err != nil {
+				return err
+			}
+			if logOutput != nil {
+				defer logOutput.Close()
+			}
+
 			// If no clustering options are provided, default to a static
 			// cluster 'defaultEtcdName=defaultEtcdPeerURL'.
 			initialCluster := viper.GetString(flagEtcdInitialCluster)
@@ -173,20 +221,55 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 			}
 
 			cfg := &backend.Config{
-				AgentHost:             viper.GetString(flagAgentHost),
-				AgentPort:             viper.GetInt(flagAgentPort),
-				AgentWriteTimeout:     viper.GetInt(backend.FlagAgentWriteTimeout),
-				APIListenAddress:      viper.GetString(flagAPIListenAddress),
-				APIURL:                viper.GetString(flagAPIURL),
-				AssetsRateLimit:       rate.Limit(viper.GetFloat64(flagAssetsRateLimit)),
-				AssetsBurstLimit:      viper.GetInt(flagAssetsBurstLimit),
-				DashboardHost:         viper.GetString(flagDashboardHost),
-				DashboardPort:         viper.GetInt(flagDashboardPort),
-				DashboardTLSCertFile:  viper.GetString(flagDashboardCertFile),
-				DashboardTLSKeyFile:   viper.GetString(flagDashboardKeyFile),
-				DeregistrationHandler: viper.GetString(flagDeregistrationHandler),
-				CacheDir:              viper.GetString(flagCacheDir),
-				StateDir:              viper.GetString(flagStateDir),
+				AgentHost:               viper.GetString(flagAgentHost),
+				AgentPort:               viper.GetInt(flagAgentPort),
+				AgentWriteTimeout:       viper.GetInt(backend.FlagAgentWriteTimeout),
+				AgentKubernetesAuth:     viper.GetBool(backend.FlagAgentKubernetesAuth),
+				AgentKubernetesAudience: viper.GetString(backend.FlagAgentKubernetesAudience),
+				AgentCertAuth:           viper.GetBool(backend.FlagAgentCertAuth),
+				AgentDrainJitter:        viper.GetInt(backend.FlagAgentDrainJitter),
+				AgentAutoRebalance:      viper.GetBool(backend.FlagAgentAutoRebalance),
+				TLSReloadInterval:       viper.GetInt(backend.FlagTLSReloadInterval),
+				ACMEDomains:             viper.GetStringSlice(backend.FlagACMEDomains),
+				ACMEEmail:               viper.GetString(backend.FlagACMEEmail),
+				ACMEDirectoryURL:        viper.GetString(backend.FlagACMEDirectoryURL),
+				ACMEChallenge:           viper.GetString(backend.FlagACMEChallenge),
+				ACMEHTTPAddress:         viper.GetString(backend.FlagACMEHTTPAddress),
+				ACMEDNSHook:             viper.GetString(backend.FlagACMEDNSHook),
+				APIListenAddress:        viper.GetString(flagAPIListenAddress),
+				APIURL:                  viper.GetString(flagAPIURL),
+				AssetsRateLimit:         rate.Limit(viper.GetFloat64(flagAssetsRateLimit)),
+				AssetsBurstLimit:        viper.GetInt(flagAssetsBurstLimit),
+				AssetsMirrors:           viper.GetStringSlice(flagAssetsMirror),
+				AssetsBundleDir:         viper.GetString(flagAssetsBundleDir),
+				AssetsTrustedKeys:       viper.GetStringSlice(flagAssetsTrustedKeys),
+				AssetsRequireSignatures: viper.GetBool(flagAssetsRequireSigs),
+				AssetsGCInterval:        viper.GetInt(flagAssetsGCInterval),
+				AssetsGCGracePeriod:     viper.GetInt(flagAssetsGCGracePeriod),
+				JSEvaluationTimeout:     viper.GetUint(backend.FlagJSEvaluationTimeout),
+				JSEvaluationMaxMemory:   viper.GetUint64(backend.FlagJSEvaluationMaxMemory),
+				AgentSplay:              viper.GetBool(backend.FlagAgentSplay),
+				GraphQLMaxDepth:         viper.GetInt(backend.FlagGraphQLMaxDepth),
+				GraphQLMaxCost:          viper.GetInt(backend.FlagGraphQLMaxCost),
+				GraphQLPersistedQueries: viper.GetString(backend.FlagGraphQLPersistedQueries),
+				GraphQLCacheTTL:         viper.GetInt(backend.FlagGraphQLCacheTTL),
+				GRPCListenAddress:       viper.GetString(backend.FlagGRPCListenAddress),
+				APIRateLimit:            rate.Limit(viper.GetFloat64(backend.FlagAPIRateLimit)),
+				APIBurstLimit:           viper.GetInt(backend.FlagAPIBurstLimit),
+				MetricsBuffer:           viper.GetBool(backend.FlagMetricsBuffer),
+				MetricsBufferResolution: viper.GetInt(backend.FlagMetricsBufferResolution),
+				MetricsBufferFlushURL:   viper.GetString(backend.FlagMetricsBufferFlushURL),
+				AuditLogFile:            viper.GetString(flagAuditLogFile),
+				AuditLogMaxSize:         viper.GetInt64(flagAuditLogMaxSize),
+				AuditLogRetention:       viper.GetInt(flagAuditLogRetention),
+				DashboardHost:           viper.GetString(flagDashboardHost),
+				DashboardPort:           viper.GetInt(flagDashboardPort),
+				DashboardTLSCertFile:    viper.GetString(flagDashboardCertFile),
+				DashboardTLSKeyFile:     viper.GetString(flagDashboardKeyFile),
+				DeregistrationHandler:   viper.GetString(flagDeregistrationHandler),
+				CacheDir:                viper.GetString(flagCacheDir),
+				StateDir:                viper.GetString(flagStateDir),
+				StoreDriver:             viper.GetString(backend.FlagStoreDriver),
 
 				EtcdAdvertiseClientURLs:      viper.GetStringSlice(flagEtcdAdvertiseClientURLs),
 				EtcdListenClientURLs:         viper.GetStringSlice(flagEtcdListenClientURLs),
@@ -207,6 +290,28 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 				NoEmbedEtcd:                  viper.GetBool(flagNoEmbedEtcd),
 				Labels:                       viper.GetStringMapString(flagLabels),
 				Annotations:                  viper.GetStringMapString(flagAnnotations),
+
+				OIDC: backend.OIDCConfig{
+					Issuer:         viper.GetString(backend.FlagOIDCIssuer),
+					ClientID:       viper.GetString(backend.FlagOIDCClientID),
+					ClientSecret:   viper.GetString(backend.FlagOIDCClientSecret),
+					Scopes:         viper.GetStringSlice(backend.FlagOIDCScopes),
+					UsernameClaim:  viper.GetString(backend.FlagOIDCUsernameClaim),
+					UsernamePrefix: viper.GetString(backend.FlagOIDCUsernamePrefix),
+					GroupsClaim:    viper.GetString(backend.FlagOIDCGroupsClaim),
+					GroupsPrefix:   viper.GetString(backend.FlagOIDCGroupsPrefix),
+				},
+
+				Health: backend.HealthConfig{
+					EtcdLatencyThreshold:      viper.GetInt(backend.FlagHealthEtcdLatencyThreshold),
+					EventdQueueThreshold:      viper.GetInt(backend.FlagHealthEventdQueueThreshold),
+					PipelinedBacklogThreshold: viper.GetInt(backend.FlagHealthPipelinedBacklogThreshold),
+				},
+
+				Tracing: backend.TracingConfig{
+					OTLPEndpoint: viper.GetString(backend.FlagTracingOTLPEndpoint),
+					SampleRate:   viper.GetFloat64(backend.FlagTracingSampleRate),
+				},
 			}
 
 			if flag := cmd.Flags().Lookup(flagLabels); flag != nil && flag.Changed {
@@ -262,8 +367,12 @@ func StartCommand(initialize InitializeFunc) *cobra.Command {
 
 			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 			go func() {
-				sig := <-sigs
-				logger.Warn("signal received: ", sig)
+				select {
+				case sig := <-sigs:
+					logger.Warn("signal received: ", sig)
+				case <-stopRequests:
+					logger.Warn("stop requested")
+				}
 				cancel()
 			}()
 
@@ -311,6 +420,13 @@ func handleConfig(cmd *cobra.Command, server bool) error {
 		viper.SetDefault(flagAPIURL, "http://localhost:8080")
 		viper.SetDefault(flagAssetsRateLimit, asset.DefaultAssetsRateLimit)
 		viper.SetDefault(flagAssetsBurstLimit, asset.DefaultAssetsBurstLimit)
+		viper.SetDefault(flagAssetsBundleDir, "")
+		viper.SetDefault(flagAssetsRequireSigs, false)
+		viper.SetDefault(flagAssetsGCInterval, int(asset.DefaultAssetsGCInterval.Seconds()))
+		viper.SetDefault(flagAssetsGCGracePeriod, int(asset.DefaultAssetsGCGracePeriod.Seconds()))
+		viper.SetDefault(flagAuditLogFile, "")
+		viper.SetDefault(flagAuditLogMaxSize, 128*1024*1024)
+		viper.SetDefault(flagAuditLogRetention, 10)
 		viper.SetDefault(flagDashboardHost, "[::]")
 		viper.SetDefault(flagDashboardPort, 3000)
 		viper.SetDefault(flagDashboardCertFile, "")
@@ -323,15 +439,60 @@ func handleConfig(cmd *cobra.Command, server bool) error {
 		viper.SetDefault(flagTrustedCAFile, "")
 		viper.SetDefault(flagInsecureSkipTLSVerify, false)
 		viper.SetDefault(flagLogLevel, "warn")
+		viper.SetDefault(flagLogOutput, logging.OutputStderr)
+		viper.SetDefault(flagSyslogFacility, logging.DefaultSyslogFacility)
+		viper.SetDefault(flagSyslogTag, "sensu-backend")
 		viper.SetDefault(backend.FlagEventdWorkers, 100)
 		viper.SetDefault(backend.FlagEventdBufferSize, 100)
 		viper.SetDefault(backend.FlagKeepalivedWorkers, 100)
 		viper.SetDefault(backend.FlagKeepalivedBufferSize, 100)
+		viper.SetDefault(backend.FlagKeepalivedBatchInterval, int(keepalived.DefaultBatchInterval.Milliseconds()))
 		viper.SetDefault(backend.FlagPipelinedWorkers, 100)
 		viper.SetDefault(backend.FlagPipelinedBufferSize, 100)
+		viper.SetDefault(backend.FlagPipelinedHandlerConcurrency, 0)
+		viper.SetDefault(backend.FlagPipelinedBackpressurePolicy, pipelined.BackpressureBlock)
+		viper.SetDefault(backend.FlagAgentdEventQueueSize, agentd.DefaultEventQueueSize)
 		viper.SetDefault(backend.FlagAgentWriteTimeout, 15)
+		viper.SetDefault(backend.FlagAgentKubernetesAuth, false)
+		viper.SetDefault(backend.FlagAgentKubernetesAudience, kubernetes.DefaultAudience)
+		viper.SetDefault(backend.FlagAgentCertAuth, false)
+		viper.SetDefault(backend.FlagAgentDrainJitter, 30)
+		viper.SetDefault(backend.FlagAgentAutoRebalance, true)
+		viper.SetDefault(backend.FlagTLSReloadInterval, 60)
+		viper.SetDefault(backend.FlagACMEDirectoryURL, acmed.DefaultDirectoryURL)
+		viper.SetDefault(backend.FlagACMEChallenge, acmed.ChallengeHTTP01)
+		viper.SetDefault(backend.FlagACMEHTTPAddress, acmed.DefaultHTTPAddress)
+		viper.SetDefault(backend.FlagJSEvaluationTimeout, uint(js.DefaultTimeout.Milliseconds()))
+		viper.SetDefault(backend.FlagJSEvaluationMaxMemory, 0)
+		viper.SetDefault(backend.FlagAgentSplay, false)
+		viper.SetDefault(backend.FlagGraphQLMaxDepth, graphql.DefaultMaxDepth)
+		viper.SetDefault(backend.FlagGraphQLMaxCost, graphql.DefaultMaxCost)
+		viper.SetDefault(backend.FlagGraphQLPersistedQueries, "")
+		viper.SetDefault(backend.FlagGraphQLCacheTTL, 2)
+		viper.SetDefault(backend.FlagGRPCListenAddress, "")
+		viper.SetDefault(backend.FlagAPIRateLimit, 0)
+		viper.SetDefault(backend.FlagAPIBurstLimit, 100)
+		viper.SetDefault(backend.FlagMetricsBuffer, false)
+		viper.SetDefault(backend.FlagMetricsBufferResolution, int(metricsbuffer.DefaultResolution.Seconds()))
+		viper.SetDefault(backend.FlagMetricsBufferFlushURL, "")
+		viper.SetDefault(backend.FlagHealthEtcdLatencyThreshold, int(health.DefaultEtcdLatencyThreshold.Milliseconds()))
+		viper.SetDefault(backend.FlagHealthEventdQueueThreshold, health.DefaultQueueThreshold)
+		viper.SetDefault(backend.FlagHealthPipelinedBacklogThreshold, health.DefaultQueueThreshold)
+		viper.SetDefault(backend.FlagTracingOTLPEndpoint, "")
+		viper.SetDefault(backend.FlagTracingSampleRate, tracing.DefaultSampleRate)
+		viper.SetDefault(backend.FlagOIDCIssuer, "")
+		viper.SetDefault(backend.FlagOIDCClientID, "")
+		viper.SetDefault(backend.FlagOIDCClientSecret, "")
+		viper.SetDefault(backend.FlagOIDCScopes, []string{"email", "profile", "offline_access"})
+		viper.SetDefault(backend.FlagOIDCUsernameClaim, oidc.DefaultUsernameClaim)
+		viper.SetDefault(backend.FlagOIDCUsernamePrefix, "")
+		viper.SetDefault(backend.FlagOIDCGroupsClaim, oidc.DefaultGroupsClaim)
+		viper.SetDefault(backend.FlagOIDCGroupsPrefix, "")
 	}
 
+	// Store defaults
+	viper.SetDefault(backend.FlagStoreDriver, backend.StoreDriverEtcd)
+
 	// Etcd defaults
 	viper.SetDefault(flagEtcdAdvertiseClientURLs, defaultEtcdAdvertiseClientURL)
 	viper.SetDefault(flagEtcdListenClientURLs, defaultEtcdClientURL)
@@ -363,6 +524,15 @@ func handleConfig(cmd *cobra.Command, server bool) error {
 		cmd.Flags().String(flagAPIURL, viper.GetString(flagAPIURL), "url of the api to connect to")
 		cmd.Flags().Float64(flagAssetsRateLimit, viper.GetFloat64(flagAssetsRateLimit), "maximum number of assets fetched per second")
 		cmd.Flags().Int(flagAssetsBurstLimit, viper.GetInt(flagAssetsBurstLimit), "asset fetch burst limit")
+		cmd.Flags().StringSlice(flagAssetsMirror, viper.GetStringSlice(flagAssetsMirror), "mirror of the asset URLs, as SOURCE=TARGET URL prefixes. This flag can also be invoked multiple times")
+		cmd.Flags().String(flagAssetsBundleDir, viper.GetString(flagAssetsBundleDir), "directory of an asset bundle created by sensuctl asset bundle, which assets are fetched from before their URLs")
+		cmd.Flags().StringSlice(flagAssetsTrustedKeys, viper.GetStringSlice(flagAssetsTrustedKeys), "files of the GPG or PEM public keys the asset signatures are verified against. This flag can also be invoked multiple times")
+		cmd.Flags().Bool(flagAssetsRequireSigs, viper.GetBool(flagAssetsRequireSigs), "reject the assets which are not signed")
+		cmd.Flags().Int(flagAssetsGCInterval, viper.GetInt(flagAssetsGCInterval), "interval in seconds at which the unused assets are removed from the cache (0 to disable)")
+		cmd.Flags().Int(flagAssetsGCGracePeriod, viper.GetInt(flagAssetsGCGracePeriod), "time in seconds after which an unused asset is removed from the cache")
+		cmd.Flags().String(flagAuditLogFile, viper.GetString(flagAuditLogFile), "path to the audit log file recording API create, update and delete requests (disabled if empty)")
+		cmd.Flags().Int64(flagAuditLogMaxSize, viper.GetInt64(flagAuditLogMaxSize), "size in bytes the audit log file can reach before it is rotated (0 to never rotate it)")
+		cmd.Flags().Int(flagAuditLogRetention, viper.GetInt(flagAuditLogRetention), "number of rotated audit log files kept (0 to keep them all)")
 		cmd.Flags().String(flagDashboardHost, viper.GetString(flagDashboardHost), "dashboard listener host")
 		cmd.Flags().Int(flagDashboardPort, viper.GetInt(flagDashboardPort), "dashboard listener port")
 		cmd.Flags().String(flagDashboardCertFile, viper.GetString(flagDashboardCertFile), "dashboard TLS certificate in PEM format")
@@ -373,16 +543,64 @@ func handleConfig(cmd *cobra.Command, server bool) error {
 		cmd.Flags().String(flagCertFile, viper.GetString(flagCertFile), "TLS certificate in PEM format")
 		cmd.Flags().String(flagKeyFile, viper.GetString(flagKeyFile), "TLS certificate key in PEM format")
 		cmd.Flags().String(flagTrustedCAFile, viper.GetString(flagTrustedCAFile), "TLS CA certificate bundle in PEM format")
+		cmd.Flags().Int(backend.FlagTLSReloadInterval, viper.GetInt(backend.FlagTLSReloadInterval), "interval in seconds at which the TLS certificate and key files are checked, and reloaded if renewed (0 disables the reloading)")
+		cmd.Flags().StringSlice(backend.FlagACMEDomains, viper.GetStringSlice(backend.FlagACMEDomains), "domains of the TLS certificate of the api and the dashboard, obtained and renewed with ACME (e.g. from Let's Encrypt) instead of the certificate files")
+		cmd.Flags().String(backend.FlagACMEEmail, viper.GetString(backend.FlagACMEEmail), "contact email of the ACME account")
+		cmd.Flags().String(backend.FlagACMEDirectoryURL, viper.GetString(backend.FlagACMEDirectoryURL), "ACME directory URL of the certificate authority")
+		cmd.Flags().String(backend.FlagACMEChallenge, viper.GetString(backend.FlagACMEChallenge), "ACME challenge proving the control of the domains [http-01, dns-01]")
+		cmd.Flags().String(backend.FlagACMEHTTPAddress, viper.GetString(backend.FlagACMEHTTPAddress), "address of the ACME http-01 challenge listener, which must be reachable on port 80 of the domains")
+		cmd.Flags().String(backend.FlagACMEDNSHook, viper.GetString(backend.FlagACMEDNSHook), "command publishing the ACME dns-01 challenge records, executed as '<hook> present|cleanup <fqdn> <value>'")
 		cmd.Flags().Bool(flagInsecureSkipTLSVerify, viper.GetBool(flagInsecureSkipTLSVerify), "skip TLS verification (not recommended!)")
 		cmd.Flags().Bool(flagDebug, false, "enable debugging and profiling features")
 		cmd.Flags().String(flagLogLevel, viper.GetString(flagLogLevel), "logging level [panic, fatal, error, warn, info, debug]")
+		cmd.Flags().String(flagLogOutput, viper.GetString(flagLogOutput), "logging output [stderr, syslog, journald]")
+		cmd.Flags().String(flagSyslogNetwork, viper.GetString(flagSyslogNetwork), "network used to reach the syslog server [udp, tcp, tls, unix] (defaults to the local syslog daemon)")
+		cmd.Flags().String(flagSyslogAddress, viper.GetString(flagSyslogAddress), "address of the syslog server, required if --syslog-network is set")
+		cmd.Flags().String(flagSyslogFacility, viper.GetString(flagSyslogFacility), "syslog facility")
+		cmd.Flags().String(flagSyslogTag, viper.GetString(flagSyslogTag), "syslog tag (APP-NAME), also used as the journald SYSLOG_IDENTIFIER")
+		cmd.Flags().String(flagSyslogCAFile, viper.GetString(flagSyslogCAFile), "path to the certificate authority used to verify the syslog server when --syslog-network is tls (defaults to the system roots)")
 		cmd.Flags().Int(backend.FlagEventdWorkers, viper.GetInt(backend.FlagEventdWorkers), "number of workers spawned for processing incoming events")
 		cmd.Flags().Int(backend.FlagEventdBufferSize, viper.GetInt(backend.FlagEventdBufferSize), "number of incoming events that can be buffered")
 		cmd.Flags().Int(backend.FlagKeepalivedWorkers, viper.GetInt(backend.FlagKeepalivedWorkers), "number of workers spawned for processing incoming keepalives")
 		cmd.Flags().Int(backend.FlagKeepalivedBufferSize, viper.GetInt(backend.FlagKeepalivedBufferSize), "number of incoming keepalives that can be buffered")
+		cmd.Flags().Int(backend.FlagKeepalivedBatchInterval, viper.GetInt(backend.FlagKeepalivedBatchInterval), "interval in milliseconds over which the last seen timestamp updates of the entities are batched into fewer store writes (0 disables the batching)")
 		cmd.Flags().Int(backend.FlagPipelinedWorkers, viper.GetInt(backend.FlagPipelinedWorkers), "number of workers spawned for handling events through the event pipeline")
 		cmd.Flags().Int(backend.FlagPipelinedBufferSize, viper.GetInt(backend.FlagPipelinedBufferSize), "number of events to handle that can be buffered")
+		cmd.Flags().Int(backend.FlagPipelinedHandlerConcurrency, viper.GetInt(backend.FlagPipelinedHandlerConcurrency), "maximum number of concurrent executions of every handler (0 for no limit)")
+		cmd.Flags().String(backend.FlagPipelinedBackpressurePolicy, viper.GetString(backend.FlagPipelinedBackpressurePolicy), "what to do with events received while the pipelined buffer is full [block, shed]")
+		cmd.Flags().Int(backend.FlagAgentdEventQueueSize, viper.GetInt(backend.FlagAgentdEventQueueSize), "number of events received from the agents that can wait for eventd, the agents are asked to slow down as it fills up")
 		cmd.Flags().Int(backend.FlagAgentWriteTimeout, viper.GetInt(backend.FlagAgentWriteTimeout), "timeout in seconds for agent writes")
+		cmd.Flags().Bool(backend.FlagAgentKubernetesAuth, viper.GetBool(backend.FlagAgentKubernetesAuth), "authenticate the agents with kubernetes service account tokens")
+		cmd.Flags().String(backend.FlagAgentKubernetesAudience, viper.GetString(backend.FlagAgentKubernetesAudience), "audience of the kubernetes service account tokens of the agents")
+		cmd.Flags().Bool(backend.FlagAgentCertAuth, viper.GetBool(backend.FlagAgentCertAuth), "authenticate the agents with TLS client certificates signed by the trusted CA, whose common name and organizational unit establish their entity name and namespace")
+		cmd.Flags().Int(backend.FlagAgentDrainJitter, viper.GetInt(backend.FlagAgentDrainJitter), "maximum delay in seconds the agents wait before reconnecting to other backends when the backend shuts down")
+		cmd.Flags().Bool(backend.FlagAgentAutoRebalance, viper.GetBool(backend.FlagAgentAutoRebalance), "hand off the agent sessions exceeding the fair share of the backend to the other backends periodically")
+		cmd.Flags().Uint(backend.FlagJSEvaluationTimeout, viper.GetUint(backend.FlagJSEvaluationTimeout), "time in ms after which JavaScript filter evaluations are interrupted (0 for no limit)")
+		cmd.Flags().Uint64(backend.FlagJSEvaluationMaxMemory, viper.GetUint64(backend.FlagJSEvaluationMaxMemory), "heap growth in bytes after which JavaScript filter evaluations are interrupted (0 for no limit)")
+		cmd.Flags().Bool(backend.FlagAgentSplay, viper.GetBool(backend.FlagAgentSplay), "spread the executions of all the interval checks across the agents")
+		cmd.Flags().Int(backend.FlagGraphQLMaxDepth, viper.GetInt(backend.FlagGraphQLMaxDepth), "maximum depth of the GraphQL queries (0 for no limit)")
+		cmd.Flags().Int(backend.FlagGraphQLMaxCost, viper.GetInt(backend.FlagGraphQLMaxCost), "maximum cost of the GraphQL queries, counting the fields multiplied by the number of records requested (0 for no limit)")
+		cmd.Flags().String(backend.FlagGraphQLPersistedQueries, viper.GetString(backend.FlagGraphQLPersistedQueries), "path to a JSON file of the only GraphQL queries allowed, keyed by their SHA-256 hash")
+		cmd.Flags().Int(backend.FlagGraphQLCacheTTL, viper.GetInt(backend.FlagGraphQLCacheTTL), "time in seconds during which the results of GraphQL queries are reused for identical queries (0 to disable)")
+		cmd.Flags().String(backend.FlagGRPCListenAddress, viper.GetString(backend.FlagGRPCListenAddress), "address to listen on for grpc api traffic (disabled if empty)")
+		cmd.Flags().Float64(backend.FlagAPIRateLimit, viper.GetFloat64(backend.FlagAPIRateLimit), "maximum number of api requests per second of every user and api key (0 for no limit)")
+		cmd.Flags().Int(backend.FlagAPIBurstLimit, viper.GetInt(backend.FlagAPIBurstLimit), "maximum number of api requests of a user or api key in a burst")
+		cmd.Flags().Bool(backend.FlagMetricsBuffer, viper.GetBool(backend.FlagMetricsBuffer), "keep the metrics of the events downsampled in memory for the last 24 hours")
+		cmd.Flags().Int(backend.FlagMetricsBufferResolution, viper.GetInt(backend.FlagMetricsBufferResolution), "interval in seconds of the points downsampled by the metrics buffer")
+		cmd.Flags().String(backend.FlagMetricsBufferFlushURL, viper.GetString(backend.FlagMetricsBufferFlushURL), "URL to post the downsampled metrics to, at the end of every interval (disabled if empty)")
+		cmd.Flags().Int(backend.FlagHealthEtcdLatencyThreshold, viper.GetInt(backend.FlagHealthEtcdLatencyThreshold), "latency in ms of etcd above which /health/ready reports the backend as not ready")
+		cmd.Flags().Int(backend.FlagHealthEventdQueueThreshold, viper.GetInt(backend.FlagHealthEventdQueueThreshold), "percentage of the capacity of the eventd queue above which /health/ready reports the backend as not ready")
+		cmd.Flags().Int(backend.FlagHealthPipelinedBacklogThreshold, viper.GetInt(backend.FlagHealthPipelinedBacklogThreshold), "percentage of the capacity of the pipelined backlog above which /health/ready reports the backend as not ready")
+		cmd.Flags().String(backend.FlagTracingOTLPEndpoint, viper.GetString(backend.FlagTracingOTLPEndpoint), "URL of the OTLP/HTTP endpoint the traces of the events are exported to, tracing is disabled if empty")
+		cmd.Flags().Float64(backend.FlagTracingSampleRate, viper.GetFloat64(backend.FlagTracingSampleRate), "rate, from 0 to 1, of the traces started by the backend for the events without trace which are sampled")
+		cmd.Flags().String(backend.FlagOIDCIssuer, viper.GetString(backend.FlagOIDCIssuer), "URL of the OpenID Connect provider (OIDC authentication disabled if empty)")
+		cmd.Flags().String(backend.FlagOIDCClientID, viper.GetString(backend.FlagOIDCClientID), "ID of the client registered with the OIDC provider")
+		cmd.Flags().String(backend.FlagOIDCClientSecret, viper.GetString(backend.FlagOIDCClientSecret), "secret of the client registered with the OIDC provider, if confidential")
+		cmd.Flags().StringSlice(backend.FlagOIDCScopes, viper.GetStringSlice(backend.FlagOIDCScopes), "scopes requested from the OIDC provider, in addition to openid")
+		cmd.Flags().String(backend.FlagOIDCUsernameClaim, viper.GetString(backend.FlagOIDCUsernameClaim), "claim of the OIDC ID tokens used as the username")
+		cmd.Flags().String(backend.FlagOIDCUsernamePrefix, viper.GetString(backend.FlagOIDCUsernamePrefix), "prefix of the usernames of the OIDC users")
+		cmd.Flags().String(backend.FlagOIDCGroupsClaim, viper.GetString(backend.FlagOIDCGroupsClaim), "claim of the OIDC ID tokens listing the groups of the user")
+		cmd.Flags().String(backend.FlagOIDCGroupsPrefix, viper.GetString(backend.FlagOIDCGroupsPrefix), "prefix of the groups of the OIDC users")
 		cmd.Flags().String(backend.FlagJWTPrivateKeyFile, viper.GetString(backend.FlagJWTPrivateKeyFile), "path to the PEM-encoded private key to use to sign JWTs")
 		cmd.Flags().String(backend.FlagJWTPublicKeyFile, viper.GetString(backend.FlagJWTPublicKeyFile), "path to the PEM-encoded public key to use to verify JWT signatures")
 		cmd.Flags().StringToStringVar(&labels, flagLabels, nil, "entity labels map")
@@ -427,6 +645,10 @@ func handleConfig(cmd *cobra.Command, server bool) error {
 		_ = cmd.Flags().SetAnnotation(flagEtcdNodeName, "categories", []string{"store"})
 	}
 
+	// Store flags
+	cmd.Flags().String(backend.FlagStoreDriver, viper.GetString(backend.FlagStoreDriver), fmt.Sprintf("driver of the backend store, %q or %q (embedded single-node store, in the state directory)", backend.StoreDriverEtcd, backend.StoreDriverBolt))
+	_ = cmd.Flags().SetAnnotation(backend.FlagStoreDriver, "categories", []string{"store"})
+
 	// Etcd client/server flags
 	cmd.Flags().StringSlice(flagEtcdCipherSuites, nil, "list of ciphers to use for etcd TLS configuration")
 	_ = cmd.Flags().SetAnnotation(flagEtcdCipherSuites, "categories", []string{"store"})
@@ -0,0 +1,126 @@
+// +build windows
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/sensu/sensu-go/util/path"
+	"github.com/sensu/sensu-go/util/service"
+	"github.com/spf13/cobra"
+)
+
+const (
+	serviceName        = "SensuBackend"
+	serviceDisplayName = "Sensu Backend"
+	serviceDescription = "The monitoring backend for sensu-go (https://sensu.io)"
+
+	flagLogPath = "log-file"
+)
+
+// NewWindowsServiceCommand creates a cobra command that offers subcommands
+// for installing, uninstalling and running sensu-backend as a windows service.
+func NewWindowsServiceCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "service",
+		Short: "operate sensu-backend as a windows service",
+	}
+
+	command.AddCommand(NewWindowsInstallServiceCommand())
+	command.AddCommand(NewWindowsUninstallServiceCommand())
+	command.AddCommand(NewWindowsRunServiceCommand())
+
+	return command
+}
+
+// NewWindowsInstallServiceCommand creates a cobra command that installs a
+// sensu-backend service in Windows.
+func NewWindowsInstallServiceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "install",
+		Short:         "install the sensu-backend service",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configFile := cmd.Flag(flagConfigFile).Value.String()
+			p, err := filepath.Abs(configFile)
+			if err != nil {
+				return fmt.Errorf("error reading config file: %s", err)
+			}
+			fi, err := os.Stat(p)
+			if err != nil {
+				return fmt.Errorf("error reading config file: %s", err)
+			}
+			if !fi.Mode().IsRegular() {
+				return errors.New("error reading config file: not a regular file")
+			}
+
+			logFile := cmd.Flag(flagLogPath).Value.String()
+			lp, err := filepath.Abs(logFile)
+			if err != nil {
+				return fmt.Errorf("error reading log file: %s", err)
+			}
+			f, err := os.OpenFile(lp, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+			if err != nil {
+				return fmt.Errorf("error reading log file: %s", err)
+			}
+			_ = f.Close()
+			lfi, err := os.Stat(lp)
+			if err != nil {
+				return fmt.Errorf("error reading log file: %s", err)
+			}
+			if !lfi.Mode().IsRegular() {
+				return errors.New("error reading log file: not a regular file")
+			}
+
+			opts, err := service.InstallOptions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return service.Install(serviceName, serviceDisplayName, serviceDescription, opts, "service", "run", p, lp)
+		},
+	}
+
+	defaultConfigPath := filepath.Join(path.SystemConfigDir(), "backend.yml")
+	defaultLogPath := filepath.Join(path.SystemLogDir(), "sensu-backend.log")
+
+	cmd.Flags().StringP(flagConfigFile, "c", defaultConfigPath, "path to sensu-backend config file")
+	cmd.Flags().StringP(flagLogPath, "", defaultLogPath, "path to the sensu-backend log file")
+	service.AddInstallFlags(cmd.Flags())
+
+	return cmd
+}
+
+// NewWindowsUninstallServiceCommand creates a cobra command that uninstalls a
+// sensu-backend service in Windows.
+func NewWindowsUninstallServiceCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:           "uninstall",
+		Short:         "uninstall the sensu-backend service",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return service.Remove(serviceName)
+		},
+	}
+}
+
+// NewWindowsRunServiceCommand creates a cobra command that runs the
+// sensu-backend service. It is meant to be invoked by the service control
+// manager.
+func NewWindowsRunServiceCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:           "run",
+		Short:         "run the sensu-backend service (blocking)",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runService(args)
+		},
+	}
+}
@@ -16,6 +16,20 @@ const (
 	// DefaultEtcdPeerURL is the default URL to listen for Etcd peers (single-node cluster only)
 	DefaultEtcdPeerURL = "http://127.0.0.1:2380"
 
+	// StoreDriverEtcd stores the data of the backend in etcd, embedded or
+	// external.
+	StoreDriverEtcd = "etcd"
+
+	// StoreDriverBolt stores the data of the backend in an embedded bbolt
+	// database file, in the state directory, for single-node deployments.
+	StoreDriverBolt = "bolt"
+
+	// BoltFileName is the name of the database file of the bolt store driver.
+	BoltFileName = "sensu.db"
+
+	// FlagStoreDriver defines the driver of the backend store
+	FlagStoreDriver = "store-driver"
+
 	// FlagEventdWorkers defines the number of workers for eventd
 	FlagEventdWorkers = "eventd-workers"
 	// FlagEventdBufferSize defines the buffer size for eventd
@@ -24,15 +38,174 @@ const (
 	FlagKeepalivedWorkers = "keepalived-workers"
 	// FlagKeepalivedBufferSize defines buffer size for keepalived
 	FlagKeepalivedBufferSize = "keepalived-buffer-size"
+	// FlagKeepalivedBatchInterval defines the interval in milliseconds over
+	// which keepalived batches the last seen timestamp updates of the entities
+	FlagKeepalivedBatchInterval = "keepalived-batch-interval"
 	// FlagPipelinedWorkers defines the number of workers for pipelined
 	FlagPipelinedWorkers = "pipelined-workers"
 	// FlagPipelinedBufferSize defines the buffer size for pipelined
 	FlagPipelinedBufferSize = "pipelined-buffer-size"
+	// FlagPipelinedHandlerConcurrency defines the maximum number of concurrent
+	// executions of every handler
+	FlagPipelinedHandlerConcurrency = "pipelined-handler-concurrency"
+	// FlagPipelinedBackpressurePolicy defines what pipelined does with the
+	// events it cannot buffer
+	FlagPipelinedBackpressurePolicy = "pipelined-backpressure-policy"
+	// FlagAgentdEventQueueSize defines the number of events received from the
+	// agents which can wait for eventd, above which the agents are held
+	FlagAgentdEventQueueSize = "agentd-event-queue-size"
 
 	// FlagAgentWriteTimeout specifies the time in seconds to wait before
 	// giving up on a write to an agent and disposing of the connection.
 	FlagAgentWriteTimeout = "agent-write-timeout"
 
+	// FlagAgentKubernetesAuth enables the authentication of the agents with
+	// Kubernetes service account tokens.
+	FlagAgentKubernetesAuth = "agent-kubernetes-auth"
+
+	// FlagAgentKubernetesAudience specifies the audience of the service
+	// account tokens of the agents.
+	FlagAgentKubernetesAudience = "agent-kubernetes-audience"
+
+	// FlagAgentCertAuth enables the authentication of the agents with their
+	// TLS client certificates.
+	FlagAgentCertAuth = "agent-cert-auth"
+
+	// FlagAgentDrainJitter specifies the maximum delay in seconds the agents
+	// wait before reconnecting to other backends when the backend shuts down.
+	FlagAgentDrainJitter = "agent-drain-jitter"
+
+	// FlagAgentAutoRebalance enables the periodic balancing of the agent
+	// sessions across the backends.
+	FlagAgentAutoRebalance = "agent-auto-rebalance"
+
+	// FlagTLSReloadInterval specifies the interval in seconds at which the
+	// TLS certificate and key files of the servers are checked for renewals.
+	FlagTLSReloadInterval = "tls-reload-interval"
+
+	// FlagACMEDomains enables the provisioning of the TLS certificate of the
+	// API and the dashboard for the given domains with ACME.
+	FlagACMEDomains = "acme-domains"
+
+	// FlagACMEEmail specifies the contact of the ACME account.
+	FlagACMEEmail = "acme-email"
+
+	// FlagACMEDirectoryURL specifies the ACME directory of the certificate
+	// authority.
+	FlagACMEDirectoryURL = "acme-directory-url"
+
+	// FlagACMEChallenge specifies the ACME challenge, http-01 or dns-01.
+	FlagACMEChallenge = "acme-challenge"
+
+	// FlagACMEHTTPAddress specifies the address of the ACME http-01
+	// challenge listener.
+	FlagACMEHTTPAddress = "acme-http-address"
+
+	// FlagACMEDNSHook specifies the command publishing the ACME dns-01
+	// challenge records.
+	FlagACMEDNSHook = "acme-dns-hook"
+
+	// FlagJSEvaluationTimeout specifies the time in milliseconds after which
+	// JavaScript evaluations are interrupted.
+	FlagJSEvaluationTimeout = "js-evaluation-timeout"
+
+	// FlagJSEvaluationMaxMemory specifies the heap growth in bytes after which
+	// JavaScript evaluations are interrupted.
+	FlagJSEvaluationMaxMemory = "js-evaluation-max-memory"
+
+	// FlagAgentSplay spreads the executions of all the interval checks
+	// across the agents.
+	FlagAgentSplay = "agent-splay"
+
+	// FlagGraphQLMaxDepth specifies the maximum depth of the GraphQL queries.
+	FlagGraphQLMaxDepth = "graphql-max-depth"
+
+	// FlagGraphQLMaxCost specifies the maximum cost of the GraphQL queries.
+	FlagGraphQLMaxCost = "graphql-max-cost"
+
+	// FlagGraphQLPersistedQueries specifies the path to a JSON file of the
+	// only GraphQL queries allowed, keyed by their SHA-256 hash.
+	FlagGraphQLPersistedQueries = "graphql-persisted-queries"
+
+	// FlagGraphQLCacheTTL specifies the time in seconds during which the
+	// result of a GraphQL query is reused for identical queries.
+	FlagGraphQLCacheTTL = "graphql-cache-ttl"
+
+	// FlagGRPCListenAddress specifies the address of the gRPC API, which is
+	// disabled if empty.
+	FlagGRPCListenAddress = "grpc-listen-address"
+
+	// FlagAPIRateLimit specifies the maximum number of API requests per
+	// second of every user and API key. The rate is not limited if 0.
+	FlagAPIRateLimit = "api-rate-limit"
+
+	// FlagAPIBurstLimit specifies the maximum number of API requests of a
+	// user or API key in a burst.
+	FlagAPIBurstLimit = "api-burst-limit"
+
+	// FlagMetricsBuffer enables the in-memory buffer of the downsampled
+	// metrics of the events.
+	FlagMetricsBuffer = "metrics-buffer"
+
+	// FlagMetricsBufferResolution specifies the interval in seconds of the
+	// points downsampled by the metrics buffer.
+	FlagMetricsBufferResolution = "metrics-buffer-resolution"
+
+	// FlagMetricsBufferFlushURL specifies the URL to which the downsampled
+	// metrics are posted, not forwarded if empty.
+	FlagMetricsBufferFlushURL = "metrics-buffer-flush-url"
+
+	// FlagHealthEtcdLatencyThreshold specifies the latency in milliseconds
+	// of etcd above which the backend is not ready.
+	FlagHealthEtcdLatencyThreshold = "health-etcd-latency-threshold"
+
+	// FlagHealthEventdQueueThreshold specifies the percentage of the capacity
+	// of the eventd queue above which the backend is not ready.
+	FlagHealthEventdQueueThreshold = "health-eventd-queue-threshold"
+
+	// FlagHealthPipelinedBacklogThreshold specifies the percentage of the
+	// capacity of the pipelined backlog above which the backend is not ready.
+	FlagHealthPipelinedBacklogThreshold = "health-pipelined-backlog-threshold"
+
+	// FlagTracingOTLPEndpoint specifies the URL of the OTLP/HTTP endpoint the
+	// traces of the events are exported to. Tracing is disabled if empty.
+	FlagTracingOTLPEndpoint = "tracing-otlp-endpoint"
+
+	// FlagTracingSampleRate specifies the rate of the traces started by the
+	// backend, for the events without trace, which are sampled.
+	FlagTracingSampleRate = "tracing-sample-rate"
+
+	// FlagOIDCIssuer specifies the URL of the OpenID Connect provider. The
+	// OIDC authentication is disabled if empty.
+	FlagOIDCIssuer = "oidc-issuer"
+
+	// FlagOIDCClientID specifies the ID of the client registered with the
+	// OIDC provider.
+	FlagOIDCClientID = "oidc-client-id"
+
+	// FlagOIDCClientSecret specifies the secret of the client registered with
+	// the OIDC provider, if it is a confidential client.
+	FlagOIDCClientSecret = "oidc-client-secret"
+
+	// FlagOIDCScopes specifies the scopes requested from the OIDC provider.
+	FlagOIDCScopes = "oidc-scopes"
+
+	// FlagOIDCUsernameClaim specifies the claim of the ID tokens used as the
+	// username.
+	FlagOIDCUsernameClaim = "oidc-username-claim"
+
+	// FlagOIDCUsernamePrefix specifies the prefix of the usernames of the
+	// users authenticated with the OIDC provider.
+	FlagOIDCUsernamePrefix = "oidc-username-prefix"
+
+	// FlagOIDCGroupsClaim specifies the claim of the ID tokens listing the
+	// groups of the user.
+	FlagOIDCGroupsClaim = "oidc-groups-claim"
+
+	// FlagOIDCGroupsPrefix specifies the prefix of the groups of the users
+	// authenticated with the OIDC provider.
+	FlagOIDCGroupsPrefix = "oidc-groups-prefix"
+
 	// FlagJWTPrivateKeyFile defines the path to the private key file for JWT
 	// signatures
 	FlagJWTPrivateKeyFile = "jwt-private-key-file"
@@ -53,9 +226,71 @@ type Config struct {
 	AgentTLSOptions   *corev2.TLSOptions
 	AgentWriteTimeout int
 
+	// AgentKubernetesAuth enables the authentication of the agents with the
+	// Kubernetes service account tokens issued for AgentKubernetesAudience.
+	AgentKubernetesAuth     bool
+	AgentKubernetesAudience string
+
+	// AgentCertAuth enables the authentication of the agents with TLS client
+	// certificates, which establish their entity name and namespace.
+	AgentCertAuth bool
+
+	// AgentDrainJitter is the maximum delay in seconds the agents wait,
+	// randomly, before reconnecting to other backends when the backend shuts
+	// down, so they don't all reconnect at once.
+	AgentDrainJitter int
+
+	// AgentAutoRebalance hands off the agent sessions of the backend
+	// exceeding its fair share to the other backends periodically, e.g. when
+	// a backend joins the cluster, rather than only when requested.
+	AgentAutoRebalance bool
+
+	// TLSReloadInterval is the interval in seconds at which the TLS
+	// certificate and key files of agentd, apid and the dashboard are
+	// checked, and reloaded if renewed. Zero disables the reloading.
+	TLSReloadInterval int
+
+	// ACMEDomains enables the provisioning of the TLS certificate of the API
+	// and the dashboard from an ACME certificate authority, configured by the
+	// other ACME options, instead of the TLS certificate files.
+	ACMEDomains      []string
+	ACMEEmail        string
+	ACMEDirectoryURL string
+	ACMEChallenge    string
+	ACMEHTTPAddress  string
+	ACMEDNSHook      string
+
 	// Apid Configuration
 	APIListenAddress string
 	APIURL           string
+	AuditLogFile     string
+
+	// AuditLogMaxSize is the size in bytes the audit log file can reach before
+	// it is rotated, and AuditLogRetention the number of rotated files kept.
+	// The file is never rotated if AuditLogMaxSize is 0, and all the rotated
+	// files are kept if AuditLogRetention is 0.
+	AuditLogMaxSize   int64
+	AuditLogRetention int
+
+	// APIRateLimit is the maximum number of API requests per second of every
+	// user and API key, with bursts of up to APIBurstLimit requests. The rate
+	// is not limited if 0.
+	APIRateLimit  rate.Limit
+	APIBurstLimit int
+
+	// GRPCListenAddress is the address of the gRPC API, disabled if empty.
+	GRPCListenAddress string
+
+	// OIDC configures the OpenID Connect authentication provider, which is
+	// disabled if OIDC.Issuer is empty.
+	OIDC OIDCConfig
+
+	// Health configures the thresholds of the readiness of the backend.
+	Health HealthConfig
+
+	// Tracing configures the tracing of the events, which is disabled if
+	// Tracing.OTLPEndpoint is empty.
+	Tracing TracingConfig
 
 	// AssetsRateLimit is the maximum number of assets per second that will be fetched.
 	AssetsRateLimit rate.Limit
@@ -63,6 +298,62 @@ type Config struct {
 	// AssetsBurstLimit is the maximum amount of burst allowed in a rate interval.
 	AssetsBurstLimit int
 
+	// AssetsMirrors are the mirrors of the asset URLs, as SOURCE=TARGET URL
+	// prefixes.
+	AssetsMirrors []string
+
+	// AssetsBundleDir is the directory of an asset bundle, which the assets are
+	// fetched from before their URLs.
+	AssetsBundleDir string
+
+	// AssetsTrustedKeys are the files of the public keys which the signatures
+	// of the assets are verified against. The signatures are not verified if
+	// it is empty.
+	AssetsTrustedKeys []string
+
+	// AssetsRequireSignatures rejects the assets which are not signed.
+	AssetsRequireSignatures bool
+
+	// AssetsGCInterval is the interval in seconds at which the assets unused
+	// for AssetsGCGracePeriod are removed. The assets are not collected if 0.
+	AssetsGCInterval int
+
+	// AssetsGCGracePeriod is the time in seconds after which an unused asset
+	// is removed by the garbage collection.
+	AssetsGCGracePeriod int
+
+	// JSEvaluationTimeout is the time in milliseconds after which JavaScript
+	// evaluations are interrupted. Evaluations are not interrupted if it is 0.
+	JSEvaluationTimeout uint
+
+	// JSEvaluationMaxMemory is the heap growth in bytes after which JavaScript
+	// evaluations are interrupted. Evaluations are not interrupted if it is 0.
+	JSEvaluationMaxMemory uint64
+
+	// AgentSplay spreads the executions of all the interval checks across the
+	// agents, whether agent splay is enabled on the checks or not.
+	AgentSplay bool
+
+	// GraphQLMaxDepth and GraphQLMaxCost limit the depth and the cost of the
+	// GraphQL queries. The limits are disabled if 0.
+	GraphQLMaxDepth int
+	GraphQLMaxCost  int
+
+	// GraphQLPersistedQueries is the path to the allowlist of the GraphQL
+	// queries. All the queries are allowed if empty.
+	GraphQLPersistedQueries string
+
+	// GraphQLCacheTTL is the time in seconds during which the results of the
+	// GraphQL queries are cached. The results are not cached if it is 0.
+	GraphQLCacheTTL int
+
+	// MetricsBuffer enables the buffer of the metrics of the events,
+	// downsampled over intervals of MetricsBufferResolution seconds and
+	// posted to MetricsBufferFlushURL, if set.
+	MetricsBuffer           bool
+	MetricsBufferResolution int
+	MetricsBufferFlushURL   string
+
 	// Dashboardd Configuration
 	DashboardHost        string
 	DashboardPort        int
@@ -78,6 +369,10 @@ type Config struct {
 	// Annotations are key-value pairs that users can provide to backend entities
 	Annotations map[string]string
 
+	// StoreDriver is the driver of the backend store, StoreDriverEtcd if
+	// empty.
+	StoreDriver string
+
 	// Etcd configuration
 	EtcdAdvertiseClientURLs      []string
 	EtcdInitialAdvertisePeerURLs []string
@@ -104,3 +399,33 @@ type Config struct {
 
 	TLS *corev2.TLSOptions
 }
+
+// HealthConfig is the configuration of the readiness checks of the backend.
+// The backend is not ready when the latency of etcd, in milliseconds, or the
+// usage of the eventd queue or of the pipelined backlog, in percentage of
+// their capacity, exceeds its threshold.
+type HealthConfig struct {
+	EtcdLatencyThreshold      int
+	EventdQueueThreshold      int
+	PipelinedBacklogThreshold int
+}
+
+// TracingConfig is the configuration of the tracing of the events by agentd,
+// eventd and pipelined, whose spans are exported to an OTLP/HTTP endpoint.
+type TracingConfig struct {
+	OTLPEndpoint string
+	SampleRate   float64
+}
+
+// OIDCConfig is the configuration of the OpenID Connect authentication
+// provider.
+type OIDCConfig struct {
+	Issuer         string
+	ClientID       string
+	ClientSecret   string
+	Scopes         []string
+	UsernameClaim  string
+	UsernamePrefix string
+	GroupsClaim    string
+	GroupsPrefix   string
+}
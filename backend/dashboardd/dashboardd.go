@@ -3,6 +3,7 @@ package dashboardd
 import (
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -19,6 +20,7 @@ import (
 	"github.com/sensu/sensu-go/backend/dashboardd/asset"
 	"github.com/sensu/sensu-go/dashboard"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/tlsreload"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,6 +30,14 @@ type Config struct {
 	Port int
 	TLS  *types.TLSOptions
 
+	// TLSReload is the interval at which the TLS certificate and key files
+	// are checked, and reloaded if renewed, or zero to never reload them.
+	TLSReload time.Duration
+
+	// GetCertificate, if set, provides the TLS certificate of the dashboard
+	// instead of the files of TLS, e.g. to serve an ACME certificate.
+	GetCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+
 	APIDConfig apid.Config
 }
 
@@ -74,6 +84,13 @@ func New(cfg Config, opts ...Option) (*Dashboardd, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cfg.GetCertificate != nil {
+		tlsServerConfig.Certificates = nil
+		tlsServerConfig.NameToCertificate = nil
+		tlsServerConfig.GetCertificate = cfg.GetCertificate
+	} else if err := tlsreload.Configure(tlsServerConfig, cfg.TLS.GetCertFile(), cfg.TLS.GetKeyFile(), cfg.TLSReload); err != nil {
+		return nil, err
+	}
 
 	handler, err := httpRouter(cfg.APIDConfig, d)
 	if err != nil {
@@ -109,7 +126,7 @@ func (d *Dashboardd) Start() error {
 		defer d.wg.Done()
 		var err error
 		TLS := d.Config.TLS
-		if TLS != nil {
+		if TLS != nil || d.Config.GetCertificate != nil {
 			// TLS configuration comes from ToServerTLSConfig
 			err = d.httpServer.ServeTLS(ln, "", "")
 		} else {
@@ -171,6 +188,8 @@ func httpRouter(c apid.Config, d *Dashboardd) (*mux.Router, error) {
 	// Proxy endpoints
 	r.PathPrefix("/auth").Handler(router)
 	r.PathPrefix("/api").Handler(router)
+	// The websockets of the GraphQL subscriptions cannot be compressed
+	r.PathPrefix("/graphql").HeadersRegexp("Upgrade", "(?i)^websocket$").Handler(router)
 	r.PathPrefix("/graphql").Handler(gziphandler(router))
 
 	// Expose Asset Info
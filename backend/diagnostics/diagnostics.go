@@ -0,0 +1,235 @@
+// Package diagnostics gathers the state of a backend into a bundle, to attach
+// to the support tickets: its profiles, goroutine dump, redacted
+// configuration, recent logs, metrics, including those of etcd, and version.
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sensu/sensu-go/version"
+)
+
+const (
+	// MaxCPUProfileDuration is the maximum duration of the CPU profile of a
+	// bundle, which must be written within the write timeout of the API.
+	MaxCPUProfileDuration = 10 * time.Second
+
+	// bundleDir is the directory of the files of a bundle.
+	bundleDir = "sensu-backend-diagnostics"
+)
+
+// profiles are the runtime profiles of a bundle, besides the CPU profile.
+var profiles = []string{"allocs", "block", "goroutine", "heap", "mutex", "threadcreate"}
+
+// Collector collects the diagnostics bundles of a backend.
+type Collector struct {
+	// Config is the configuration of the backend, which is redacted in the
+	// bundles.
+	Config interface{}
+
+	// ClusterVersion is the version of the etcd cluster of the backend.
+	ClusterVersion string
+
+	// Logs keeps the recent logs of the backend, or is nil if not kept.
+	Logs *LogBuffer
+
+	// Gatherer gathers the metrics of the backend, and of etcd.
+	Gatherer prometheus.Gatherer
+
+	// Started is the time the backend started.
+	Started time.Time
+}
+
+// VersionInfo is the version information of a bundle.
+type VersionInfo struct {
+	Version        string    `json:"version"`
+	Edition        string    `json:"edition"`
+	BuildSHA       string    `json:"build_sha,omitempty"`
+	BuildDate      string    `json:"build_date,omitempty"`
+	GoVersion      string    `json:"go_version"`
+	OS             string    `json:"os"`
+	Arch           string    `json:"arch"`
+	Hostname       string    `json:"hostname"`
+	ClusterVersion string    `json:"etcd_cluster_version,omitempty"`
+	Started        time.Time `json:"started"`
+	Collected      time.Time `json:"collected"`
+	NumCPU         int       `json:"num_cpu"`
+	NumGoroutine   int       `json:"num_goroutine"`
+}
+
+// WriteBundle writes a gzipped tarball of the diagnostics of the backend to
+// w, with a CPU profile of cpuProfileDuration, if not zero. The bundle holds:
+//
+//	version.json          the version information
+//	config.json           the redacted configuration
+//	logs.json             the recent logs, as lines of JSON
+//	metrics.txt           the metrics, in the Prometheus text format
+//	goroutines.txt        the stack traces of all the goroutines
+//	profiles/NAME.pb.gz   the runtime profiles, for go tool pprof
+//	errors.txt            the files which could not be collected, if any
+func (c *Collector) WriteBundle(ctx context.Context, w io.Writer, cpuProfileDuration time.Duration) error {
+	if cpuProfileDuration > MaxCPUProfileDuration {
+		return fmt.Errorf("the cpu profile duration must not exceed %s", MaxCPUProfileDuration)
+	}
+	now := time.Now()
+	gz := gzip.NewWriter(w)
+	b := &bundle{tw: tar.NewWriter(gz), modTime: now}
+
+	// The CPU profile is collected first, so that it doesn't profile the
+	// collection of the other files
+	if cpuProfileDuration > 0 {
+		var buf bytes.Buffer
+		if err := cpuProfile(ctx, &buf, cpuProfileDuration); err != nil {
+			b.writeError("profiles/cpu.pb.gz", err)
+		} else if err := b.writeFile("profiles/cpu.pb.gz", buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	hostname, _ := os.Hostname()
+	info := VersionInfo{
+		Version:        version.Semver(),
+		Edition:        version.Edition,
+		BuildSHA:       version.BuildSHA,
+		BuildDate:      version.BuildDate,
+		GoVersion:      version.GoVersion,
+		OS:             runtime.GOOS,
+		Arch:           runtime.GOARCH,
+		Hostname:       hostname,
+		ClusterVersion: c.ClusterVersion,
+		Started:        c.Started,
+		Collected:      now,
+		NumCPU:         runtime.NumCPU(),
+		NumGoroutine:   runtime.NumGoroutine(),
+	}
+	if err := b.writeJSON("version.json", info); err != nil {
+		return err
+	}
+
+	if err := b.writeJSON("config.json", Redact(c.Config)); err != nil {
+		return err
+	}
+
+	if c.Logs != nil {
+		if err := b.writeFile("logs.json", c.Logs.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if c.Gatherer != nil {
+		var buf bytes.Buffer
+		if err := writeMetrics(&buf, c.Gatherer); err != nil {
+			b.writeError("metrics.txt", err)
+		} else if err := b.writeFile("metrics.txt", buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 2); err != nil {
+		b.writeError("goroutines.txt", err)
+	} else if err := b.writeFile("goroutines.txt", buf.Bytes()); err != nil {
+		return err
+	}
+
+	for _, name := range profiles {
+		buf.Reset()
+		file := fmt.Sprintf("profiles/%s.pb.gz", name)
+		if err := pprof.Lookup(name).WriteTo(&buf, 0); err != nil {
+			b.writeError(file, err)
+		} else if err := b.writeFile(file, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if len(b.errors) > 0 {
+		if err := b.writeFile("errors.txt", b.errors); err != nil {
+			return err
+		}
+	}
+
+	if err := b.tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// cpuProfile writes a CPU profile of duration d to w. It fails if a CPU
+// profile is already running, e.g. from the pprof endpoints.
+func cpuProfile(ctx context.Context, w io.Writer, d time.Duration) error {
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	defer pprof.StopCPUProfile()
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+	return nil
+}
+
+// writeMetrics writes the metrics of the gatherer in the Prometheus text
+// format.
+func writeMetrics(w io.Writer, gatherer prometheus.Gatherer) error {
+	families, err := gatherer.Gather()
+	if err != nil && len(families) == 0 {
+		return err
+	}
+	for _, family := range families {
+		if _, err := expfmt.MetricFamilyToText(w, family); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bundle writes the files of a bundle to a tarball. The files which could not
+// be collected are listed with their error in errors.txt.
+type bundle struct {
+	tw      *tar.Writer
+	modTime time.Time
+	errors  []byte
+}
+
+func (b *bundle) writeFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    bundleDir + "/" + name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: b.modTime,
+	}
+	if err := b.tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := b.tw.Write(data)
+	return err
+}
+
+func (b *bundle) writeJSON(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		b.writeError(name, err)
+		return nil
+	}
+	return b.writeFile(name, data)
+}
+
+func (b *bundle) writeError(name string, err error) {
+	logger.WithError(err).WithField("file", name).Warn("could not collect diagnostics file")
+	b.errors = append(b.errors, fmt.Sprintf("%s: %s\n", name, err)...)
+}
@@ -0,0 +1,9 @@
+package diagnostics
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "diagnostics",
+})
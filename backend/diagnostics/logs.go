@@ -0,0 +1,77 @@
+package diagnostics
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultLogBufferSize is the default number of recent log entries kept.
+const DefaultLogBufferSize = 1000
+
+// RecentLogs keeps the recent logs of the backend, once added as a hook of
+// the logger.
+var RecentLogs = NewLogBuffer(DefaultLogBufferSize)
+
+// LogBuffer is a logrus hook keeping the most recent log entries, formatted
+// as JSON, in a ring buffer.
+type LogBuffer struct {
+	formatter logrus.Formatter
+
+	mu      sync.Mutex
+	entries [][]byte
+	next    int
+	full    bool
+}
+
+// NewLogBuffer returns a new LogBuffer keeping the size most recent entries.
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{
+		formatter: &logrus.JSONFormatter{},
+		entries:   make([][]byte, size),
+	}
+}
+
+// Levels returns the levels of the entries kept, which are all the levels
+// enabled in the logger.
+func (b *LogBuffer) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire keeps the entry.
+func (b *LogBuffer) Fire(entry *logrus.Entry) error {
+	line, err := b.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	// The formatter may reuse the buffer of the entry
+	line = append([]byte(nil), line...)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) == 0 {
+		return nil
+	}
+	b.entries[b.next] = line
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+	return nil
+}
+
+// Bytes returns the entries kept, from the oldest to the most recent, as
+// lines of JSON.
+func (b *LogBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var result []byte
+	if b.full {
+		for _, line := range b.entries[b.next:] {
+			result = append(result, line...)
+		}
+	}
+	for _, line := range b.entries[:b.next] {
+		result = append(result, line...)
+	}
+	return result
+}
@@ -0,0 +1,30 @@
+package diagnostics
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBuffer(t *testing.T) {
+	logs := NewLogBuffer(3)
+	log := logrus.New()
+	log.AddHook(logs)
+	log.SetOutput(ioutil.Discard)
+
+	assert.Empty(t, logs.Bytes())
+
+	for _, msg := range []string{"a", "b", "c", "d", "e"} {
+		log.Warn(msg)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(logs.Bytes())), "\n")
+	if assert.Len(t, lines, 3) {
+		assert.Contains(t, lines[0], `"msg":"c"`)
+		assert.Contains(t, lines[1], `"msg":"d"`)
+		assert.Contains(t, lines[2], `"msg":"e"`)
+	}
+}
@@ -0,0 +1,139 @@
+package diagnostics
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// sensitiveFields are the fragments of the names of the fields whose values
+// are redacted, compared without case and underscores.
+var sensitiveFields = sensitiveFieldFragments(append(corev2.DefaultRedactFields, "token"))
+
+func sensitiveFieldFragments(fields []string) []string {
+	result := make([]string, len(fields))
+	for i, field := range fields {
+		result[i] = normalizeField(field)
+	}
+	return result
+}
+
+func normalizeField(field string) string {
+	return strings.ToLower(strings.Replace(field, "_", "", -1))
+}
+
+// Redact returns a representation of v, made of maps, slices and values, in
+// which the values of the fields whose name contains a sensitive word, such as
+// ClientSecret or password, and the passwords of the URLs are redacted. The
+// functions and channels of v are left out.
+func Redact(v interface{}) interface{} {
+	result, _ := redactValue(reflect.ValueOf(v))
+	return result
+}
+
+// redactValue returns the redacted representation of v, and false if v is
+// left out.
+func redactValue(v reflect.Value) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Invalid, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil, false
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, true
+		}
+		return redactValue(v.Elem())
+	case reflect.Struct:
+		result := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := field.Name
+			if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+			if value, ok := redactField(name, v.Field(i)); ok {
+				result[name] = value
+			}
+		}
+		return result, true
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		result := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			if value, ok := redactField(key, iter.Value()); ok {
+				result[key] = value
+			}
+		}
+		return result, true
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil, false
+		}
+		result := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if value, ok := redactValue(v.Index(i)); ok {
+				result = append(result, value)
+			}
+		}
+		return result, true
+	case reflect.String:
+		return redactURL(v.String()), true
+	case reflect.Float32, reflect.Float64:
+		// JSON has no infinite numbers, such as unlimited rates
+		if f := v.Float(); math.IsInf(f, 0) || math.IsNaN(f) {
+			return fmt.Sprint(f), true
+		}
+	}
+	return v.Interface(), true
+}
+
+// redactField returns the redacted representation of the value of the field
+// name.
+func redactField(name string, v reflect.Value) (interface{}, bool) {
+	if isSensitive(name) {
+		if v.IsZero() {
+			return "", true
+		}
+		return corev2.Redacted, true
+	}
+	return redactValue(v)
+}
+
+func isSensitive(key string) bool {
+	key = normalizeField(key)
+	for _, field := range sensitiveFields {
+		if strings.Contains(key, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL redacts the password of s, if it is a URL with a password.
+func redactURL(s string) string {
+	if !strings.Contains(s, "@") {
+		return s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); !ok {
+		return s
+	}
+	u.User = url.UserPassword(u.User.Username(), corev2.Redacted)
+	return u.String()
+}
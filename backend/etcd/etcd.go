@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/coreos/etcd/clientv3"
@@ -180,8 +179,8 @@ func NewEtcd(config *Config) (*Etcd, error) {
 	cfg := embed.NewConfig()
 	cfg.Name = config.Name
 
-	cfg.Dir = filepath.Join(config.DataDir, "etcd", "data")
-	cfg.WalDir = filepath.Join(config.DataDir, "etcd", "wal")
+	cfg.Dir = dataDir(config)
+	cfg.WalDir = walDir(config)
 	if err := ensureDir(cfg.Dir); err != nil {
 		return nil, err
 	}
@@ -273,6 +272,7 @@ func (e *Etcd) NewClientContext(ctx context.Context) (*clientv3.Client, error) {
 		TLS:         tlsConfig,
 		DialOptions: []grpc.DialOption{
 			grpc.WithBlock(),
+			grpc.WithChainUnaryInterceptor(UnaryClientInterceptor),
 		},
 		Context: ctx,
 	})
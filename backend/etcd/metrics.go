@@ -0,0 +1,37 @@
+package etcd
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RequestDurationHistogramVec is the name of the prometheus histogram vec
+	// used to observe the latency of the requests of the backend to etcd.
+	RequestDurationHistogramVec = "sensu_go_etcd_request_duration_seconds"
+)
+
+// RequestDuration observes the latency of the unary requests of the backend
+// to etcd, such as range, put, txn and lease requests, by gRPC method and
+// status code. Every attempt of a retried request is observed.
+var RequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    RequestDurationHistogramVec,
+		Help:    "The latency of the requests to etcd, in seconds",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	},
+	[]string{"method", "code"},
+)
+
+// UnaryClientInterceptor is a gRPC client interceptor observing the latency
+// of the requests to etcd in RequestDuration.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	RequestDuration.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return err
+}
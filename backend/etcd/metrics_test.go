@@ -0,0 +1,29 @@
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	method := "/etcdserverpb.KV/Range"
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+
+	err := UnaryClientInterceptor(context.Background(), method, nil, nil, nil, invoker)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	var metric dto.Metric
+	histogram := RequestDuration.WithLabelValues(method, codes.Unavailable.String())
+	require.NoError(t, histogram.(prometheus.Histogram).Write(&metric))
+	assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+}
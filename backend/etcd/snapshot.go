@@ -0,0 +1,131 @@
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/snapshot"
+	"go.uber.org/zap"
+)
+
+// SnapshotStatus describes a snapshot file.
+type SnapshotStatus = snapshot.Status
+
+// newSnapshotManager returns a snapshot manager which doesn't log, the
+// callers report the progress themselves.
+func newSnapshotManager() snapshot.Manager {
+	return snapshot.NewV3(zap.NewNop())
+}
+
+// SaveSnapshot saves a consistent snapshot of the etcd cluster to the file
+// path, and verifies its integrity. The snapshot is requested to the first of
+// the endpoints of cfg that answers.
+func SaveSnapshot(ctx context.Context, cfg clientv3.Config, path string) (SnapshotStatus, error) {
+	endpoint, err := snapshotEndpoint(ctx, cfg)
+	if err != nil {
+		return SnapshotStatus{}, err
+	}
+	cfg.Endpoints = []string{endpoint}
+	if err := newSnapshotManager().Save(ctx, cfg, path); err != nil {
+		return SnapshotStatus{}, err
+	}
+	return VerifySnapshot(path)
+}
+
+// snapshotEndpoint returns the first of the endpoints of cfg that answers
+// within its dial timeout.
+func snapshotEndpoint(ctx context.Context, cfg clientv3.Config) (string, error) {
+	if len(cfg.Endpoints) == 0 {
+		return "", errors.New("no etcd endpoint to request the snapshot to")
+	}
+	timeout := cfg.DialTimeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	client, err := clientv3.New(cfg)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+	for _, endpoint := range cfg.Endpoints {
+		statusCtx, cancel := context.WithTimeout(ctx, timeout)
+		_, err = client.Status(statusCtx, endpoint)
+		cancel()
+		if err == nil {
+			return endpoint, nil
+		}
+		logger.WithError(err).WithField("endpoint", endpoint).Warn("could not reach the etcd endpoint")
+	}
+	return "", err
+}
+
+// VerifySnapshot verifies the integrity of the snapshot file path, against
+// the SHA-256 hash appended by etcd, and returns its status.
+func VerifySnapshot(path string) (SnapshotStatus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return SnapshotStatus{}, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return SnapshotStatus{}, err
+	}
+	// The database pages are 512-byte aligned, the hash follows them
+	size := info.Size()
+	if size%512 != sha256.Size {
+		return SnapshotStatus{}, fmt.Errorf("%s is not an etcd snapshot or is truncated", path)
+	}
+	h := sha256.New()
+	if _, err := io.CopyN(h, f, size-sha256.Size); err != nil {
+		return SnapshotStatus{}, err
+	}
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(f, sum); err != nil {
+		return SnapshotStatus{}, err
+	}
+	if !bytes.Equal(h.Sum(nil), sum) {
+		return SnapshotStatus{}, fmt.Errorf("%s is corrupted, its hash doesn't match", path)
+	}
+
+	return newSnapshotManager().Status(path)
+}
+
+// RestoreSnapshot restores the snapshot file path into the data directory of
+// the embedded etcd configured by config, as the member config.Name of a new
+// cluster of the members of config.InitialCluster. Every member of the
+// cluster is restored from the same snapshot. The data directory must not
+// exist.
+func RestoreSnapshot(path string, config *Config) error {
+	if _, err := VerifySnapshot(path); err != nil {
+		return err
+	}
+	return newSnapshotManager().Restore(snapshot.RestoreConfig{
+		SnapshotPath:        path,
+		Name:                config.Name,
+		OutputDataDir:       dataDir(config),
+		OutputWALDir:        walDir(config),
+		PeerURLs:            config.InitialAdvertisePeerURLs,
+		InitialCluster:      config.InitialCluster,
+		InitialClusterToken: config.InitialClusterToken,
+	})
+}
+
+// dataDir returns the data directory of the embedded etcd.
+func dataDir(config *Config) string {
+	return filepath.Join(config.DataDir, "etcd", "data")
+}
+
+// walDir returns the write-ahead log directory of the embedded etcd.
+func walDir(config *Config) string {
+	return filepath.Join(config.DataDir, "etcd", "wal")
+}
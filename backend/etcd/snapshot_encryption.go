@@ -0,0 +1,167 @@
+package etcd
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// The encrypted snapshots start with snapshotMagic, the salt of the key
+// derived from the passphrase and the nonce of their first chunk. The
+// snapshot follows, in chunks of up to snapshotChunkSize bytes sealed with
+// AES-256-GCM, each prefixed with its sealed size. The last chunk is
+// authenticated as such, so that truncated snapshots are detected.
+const (
+	snapshotMagic     = "sensu-encrypted-snapshot-v1\n"
+	snapshotSaltSize  = 16
+	snapshotChunkSize = 64 * 1024
+)
+
+var errSnapshotTampered = errors.New("the snapshot is corrupted or the passphrase is wrong")
+
+// snapshotAEAD returns the AEAD of the key derived from passphrase and salt.
+func snapshotAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce of the chunk i.
+func chunkNonce(nonce []byte, i uint64) []byte {
+	n := make([]byte, len(nonce))
+	copy(n, nonce)
+	counter := binary.BigEndian.Uint64(n[len(n)-8:]) ^ i
+	binary.BigEndian.PutUint64(n[len(n)-8:], counter)
+	return n
+}
+
+// chunkData returns the additional data of a chunk, which flags the last one.
+func chunkData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// EncryptSnapshot writes the snapshot read from src to dst, encrypted with a
+// key derived from passphrase.
+func EncryptSnapshot(dst io.Writer, src io.Reader, passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return errors.New("the encryption passphrase is empty")
+	}
+	salt := make([]byte, snapshotSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	aead, err := snapshotAEAD(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(dst)
+	_, _ = w.WriteString(snapshotMagic)
+	_, _ = w.Write(salt)
+	_, _ = w.Write(nonce)
+
+	// Read ahead one chunk, to know which one is the last
+	r := bufio.NewReaderSize(src, snapshotChunkSize)
+	chunk := make([]byte, snapshotChunkSize)
+	sealed := make([]byte, 0, snapshotChunkSize+aead.Overhead())
+	size := make([]byte, 4)
+	for i := uint64(0); ; i++ {
+		n, err := io.ReadFull(r, chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		last := err != nil
+		if !last {
+			_, err := r.Peek(1)
+			last = err == io.EOF
+		}
+		sealed = aead.Seal(sealed[:0], chunkNonce(nonce, i), chunk[:n], chunkData(last))
+		binary.BigEndian.PutUint32(size, uint32(len(sealed)))
+		_, _ = w.Write(size)
+		if _, err := w.Write(sealed); err != nil {
+			return err
+		}
+		if last {
+			return w.Flush()
+		}
+	}
+}
+
+// DecryptSnapshot writes the snapshot encrypted with a key derived from
+// passphrase, read from src, to dst.
+func DecryptSnapshot(dst io.Writer, src io.Reader, passphrase []byte) error {
+	r := bufio.NewReader(src)
+	header := make([]byte, len(snapshotMagic)+snapshotSaltSize)
+	if _, err := io.ReadFull(r, header); err != nil || !bytes.HasPrefix(header, []byte(snapshotMagic)) {
+		return errors.New("the snapshot is not encrypted")
+	}
+	aead, err := snapshotAEAD(passphrase, header[len(snapshotMagic):])
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(r, nonce); err != nil {
+		return errSnapshotTampered
+	}
+
+	sealed := make([]byte, snapshotChunkSize+aead.Overhead())
+	chunk := make([]byte, 0, snapshotChunkSize)
+	size := make([]byte, 4)
+	for i := uint64(0); ; i++ {
+		if _, err := io.ReadFull(r, size); err != nil {
+			return errSnapshotTampered
+		}
+		n := binary.BigEndian.Uint32(size)
+		if int(n) > len(sealed) {
+			return errSnapshotTampered
+		}
+		if _, err := io.ReadFull(r, sealed[:n]); err != nil {
+			return errSnapshotTampered
+		}
+		_, err := r.Peek(1)
+		last := err == io.EOF
+		chunk, err = aead.Open(chunk[:0], chunkNonce(nonce, i), sealed[:n], chunkData(last))
+		if err != nil {
+			return errSnapshotTampered
+		}
+		if _, err := dst.Write(chunk); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// IsEncryptedSnapshot returns true if the snapshot read from r is encrypted.
+func IsEncryptedSnapshot(r io.Reader) (bool, error) {
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not read the snapshot: %s", err)
+	}
+	return string(magic) == snapshotMagic, nil
+}
@@ -0,0 +1,49 @@
+package etcd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotEncryption(t *testing.T) {
+	for _, size := range []int{0, 1, snapshotChunkSize, 3*snapshotChunkSize + 7} {
+		snapshot := make([]byte, size)
+		_, err := rand.Read(snapshot)
+		require.NoError(t, err)
+
+		var encrypted bytes.Buffer
+		require.NoError(t, EncryptSnapshot(&encrypted, bytes.NewReader(snapshot), []byte("passphrase")))
+		isEncrypted, err := IsEncryptedSnapshot(bytes.NewReader(encrypted.Bytes()))
+		require.NoError(t, err)
+		assert.True(t, isEncrypted)
+
+		var decrypted bytes.Buffer
+		require.NoError(t, DecryptSnapshot(&decrypted, bytes.NewReader(encrypted.Bytes()), []byte("passphrase")))
+		assert.True(t, bytes.Equal(snapshot, decrypted.Bytes()), "size %d", size)
+
+		// Truncated snapshots are detected
+		truncated := encrypted.Bytes()[:encrypted.Len()-1]
+		assert.Error(t, DecryptSnapshot(&bytes.Buffer{}, bytes.NewReader(truncated), []byte("passphrase")))
+	}
+}
+
+func TestSnapshotEncryptionErrors(t *testing.T) {
+	var encrypted bytes.Buffer
+	require.NoError(t, EncryptSnapshot(&encrypted, bytes.NewReader([]byte("snapshot")), []byte("passphrase")))
+	assert.Error(t, DecryptSnapshot(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), []byte("wrong")))
+
+	// Appended data is detected
+	appended := append(encrypted.Bytes(), 0)
+	assert.Error(t, DecryptSnapshot(&bytes.Buffer{}, bytes.NewReader(appended), []byte("passphrase")))
+
+	assert.Error(t, DecryptSnapshot(&bytes.Buffer{}, bytes.NewReader([]byte("snapshot")), []byte("passphrase")))
+	assert.Error(t, EncryptSnapshot(&bytes.Buffer{}, bytes.NewReader([]byte("snapshot")), nil))
+
+	isEncrypted, err := IsEncryptedSnapshot(bytes.NewReader([]byte("snapshot")))
+	require.NoError(t, err)
+	assert.False(t, isEncrypted)
+}
@@ -0,0 +1,74 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotSaveRestore(t *testing.T) {
+	e, cleanup := NewTestEtcd(t)
+	defer cleanup()
+
+	client := e.NewEmbeddedClient()
+	_, err := client.Put(context.Background(), "key", "value")
+	require.NoError(t, err)
+
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+	path := filepath.Join(tmpDir, "snapshot.db")
+
+	cfg := clientv3.Config{
+		Endpoints:   append([]string{"http://127.0.0.1:1"}, e.GetClientURLs()...),
+		DialTimeout: time.Second,
+	}
+	status, err := SaveSnapshot(context.Background(), cfg, path)
+	require.NoError(t, err)
+	assert.NotZero(t, status.TotalKey)
+
+	// Corrupted snapshots are not restored
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	b[len(b)/2]++
+	corrupted := filepath.Join(tmpDir, "corrupted.db")
+	require.NoError(t, ioutil.WriteFile(corrupted, b, 0600))
+	_, err = VerifySnapshot(corrupted)
+	assert.Error(t, err)
+
+	peerURL := "http://127.0.0.1:0"
+	config := NewConfig()
+	config.DataDir = filepath.Join(tmpDir, "state")
+	config.Name = "default"
+	config.InitialCluster = "default=" + peerURL
+	config.InitialAdvertisePeerURLs = []string{peerURL}
+	require.Error(t, RestoreSnapshot(corrupted, config))
+	_, err = os.Stat(config.DataDir)
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, RestoreSnapshot(path, config))
+
+	// The restored member serves the data of the snapshot
+	config.ListenClientURLs = []string{"http://127.0.0.1:0"}
+	config.AdvertiseClientURLs = config.ListenClientURLs
+	config.ListenPeerURLs = []string{peerURL}
+	config.InitialClusterState = ClusterStateNew
+	restored, err := NewEtcd(config)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restored.Shutdown())
+	}()
+	resp, err := restored.NewEmbeddedClient().Get(context.Background(), "key")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "value", string(resp.Kvs[0].Value))
+}
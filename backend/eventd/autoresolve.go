@@ -0,0 +1,151 @@
+package eventd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/liveness"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sirupsen/logrus"
+)
+
+// autoResolveSwitchSet is the name of the switch set tracking the failing
+// events to resolve automatically.
+const autoResolveSwitchSet = "eventd-autoresolve"
+
+// updateAutoResolve tracks the failing event of a check with auto resolve
+// enabled, so that it gets resolved if no new check result is received in
+// time, and forgets the events that were resolved or no longer need to be.
+func (e *Eventd) updateAutoResolve(ctx context.Context, event, prevEvent *corev2.Event) error {
+	if event.Check.Name == corev2.KeepaliveCheckName {
+		return nil
+	}
+
+	switches := e.livenessFactory(autoResolveSwitchSet, e.autoResolve, e.autoResolveReset, logger)
+	switchKey := eventKey(event)
+
+	if event.Check.AutoResolveAfter > 0 && event.Check.Status != 0 {
+		// Reset the switch
+		return switches.Alive(ctx, switchKey, event.Check.AutoResolveAfter)
+	}
+
+	if prevEvent != nil && prevEvent.HasCheck() && prevEvent.Check.AutoResolveAfter > 0 && prevEvent.Check.Status != 0 {
+		// The event was resolved or its auto resolve disabled, there is no
+		// longer a need to track it
+		logger.Debug("check auto resolve disabled")
+		if err := switches.Bury(ctx, switchKey); err != nil {
+			// It's better to publish the event even if this fails, so
+			// don't return the error here.
+			logger.WithError(err).Error("error burying switch")
+		}
+	}
+
+	return nil
+}
+
+func (e *Eventd) autoResolveReset(key string, prev liveness.State, leader bool) (bury bool) {
+	logger.WithFields(logrus.Fields{
+		"status":          liveness.Alive.String(),
+		"previous_status": prev.String(),
+		"key":             key,
+	}).Debug("check auto resolve reset")
+
+	return false
+}
+
+func (e *Eventd) autoResolve(key string, prev liveness.State, leader bool) (bury bool) {
+	if e.ctx.Err() != nil {
+		return false
+	}
+	lager := logger.WithFields(logrus.Fields{
+		"status":          liveness.Dead.String(),
+		"previous_status": prev.String()})
+
+	namespace, check, entity, err := parseKey(key)
+	if err != nil {
+		lager.Error(err)
+		return false
+	}
+
+	lager = lager.WithFields(logrus.Fields{
+		"check":     check,
+		"entity":    entity,
+		"namespace": namespace})
+
+	// Round robin events do not belong to a single entity, and are never
+	// resolved automatically
+	if entity == "" {
+		return true
+	}
+
+	ctx := store.NamespaceContext(context.Background(), namespace)
+	ctx, cancel := context.WithTimeout(ctx, e.storeTimeout)
+	defer cancel()
+
+	event, err := e.eventStore.GetEventByEntityCheck(ctx, entity, check)
+	if err != nil {
+		lager.WithError(err).Error("check auto resolve: error retrieving event")
+		if _, ok := err.(*store.ErrInternal); ok {
+			// Fatal error
+			select {
+			case e.errChan <- err:
+			case <-e.ctx.Done():
+			}
+		}
+		return false
+	}
+
+	if event == nil || event.Check.Status == 0 || event.Check.AutoResolveAfter == 0 {
+		// The event was deleted or resolved in the meantime
+		return true
+	}
+
+	if !leader {
+		return false
+	}
+
+	lager.Info("check auto resolve expired, resolving event")
+	if err := e.handleAutoResolve(ctx, event); err != nil {
+		lager.WithError(err).Error("can't resolve event automatically")
+		return false
+	}
+
+	return true
+}
+
+// handleAutoResolve creates a resolved check event from the failing event and
+// publishes it to TopicEvent.
+func (e *Eventd) handleAutoResolve(ctx context.Context, event *corev2.Event) error {
+	resolvedEvent := createResolvedCheckEvent(event, time.Now())
+	updatedEvent, _, err := e.eventStore.UpdateEvent(ctx, resolvedEvent)
+	if err != nil {
+		if _, ok := err.(*store.ErrInternal); ok {
+			// Fatal error
+			select {
+			case e.errChan <- err:
+			case <-e.ctx.Done():
+			}
+		}
+		return err
+	}
+
+	return e.bus.Publish(messaging.TopicEvent, updatedEvent)
+}
+
+// createResolvedCheckEvent returns a copy of the failing event, resolved at
+// now. Its check history is merged by the store when the event is updated.
+func createResolvedCheckEvent(event *corev2.Event, now time.Time) *corev2.Event {
+	check := corev2.NewCheck(corev2.NewCheckConfigFromFace(event.Check))
+	check.Output = fmt.Sprintf("No check result received for %d seconds, event automatically resolved", now.Unix()-event.Check.Executed)
+	check.Status = 0
+	check.Executed = now.Unix()
+
+	resolved := *event
+	resolved.Timestamp = now.Unix()
+	resolved.Check = check
+
+	return &resolved
+}
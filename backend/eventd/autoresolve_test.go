@@ -0,0 +1,168 @@
+package eventd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/liveness"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateAutoResolve(t *testing.T) {
+	failing := corev2.FixtureEvent("entity", "check")
+	failing.Check.Status = 2
+	failing.Check.AutoResolveAfter = 600
+	passing := corev2.FixtureEvent("entity", "check")
+	passing.Check.AutoResolveAfter = 600
+	keepalive := corev2.FixtureEvent("entity", corev2.KeepaliveCheckName)
+	keepalive.Check.Status = 1
+	keepalive.Check.AutoResolveAfter = 600
+
+	tests := []struct {
+		name         string
+		event        *corev2.Event
+		prevEvent    *corev2.Event
+		switchesFunc func(*mockSwitchSet)
+		wantErr      bool
+	}{
+		{
+			name:  "a passing event is not tracked",
+			event: passing,
+		},
+		{
+			name:  "a keepalive is not tracked",
+			event: keepalive,
+		},
+		{
+			name:  "a failing event should update its switchset with alive",
+			event: failing,
+			switchesFunc: func(s *mockSwitchSet) {
+				s.On("Alive", mock.Anything, "default/check/entity", int64(600)).Return(nil)
+			},
+		},
+		{
+			name:  "switchset alive err should be returned",
+			event: failing,
+			switchesFunc: func(s *mockSwitchSet) {
+				s.On("Alive", mock.Anything, "default/check/entity", int64(600)).Return(errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "a resolved event should bury its switchset",
+			event:     passing,
+			prevEvent: failing,
+			switchesFunc: func(s *mockSwitchSet) {
+				s.On("Bury", mock.Anything, "default/check/entity").Return(nil)
+			},
+		},
+		{
+			name:      "an error while burying the switchset should not be returned",
+			event:     passing,
+			prevEvent: failing,
+			switchesFunc: func(s *mockSwitchSet) {
+				s.On("Bury", mock.Anything, "default/check/entity").Return(errors.New("err"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			switches := &mockSwitchSet{}
+			if tt.switchesFunc != nil {
+				tt.switchesFunc(switches)
+			}
+			e := &Eventd{livenessFactory: newFakeFactory(switches)}
+			err := e.updateAutoResolve(context.Background(), tt.event, tt.prevEvent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Eventd.updateAutoResolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			switches.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAutoResolveBuryConditions(t *testing.T) {
+	failing := corev2.FixtureEvent("bar", "foo")
+	failing.Check.Status = 1
+	failing.Check.AutoResolveAfter = 600
+
+	tests := []struct {
+		name  string
+		key   string
+		store func(*mockstore.MockStore)
+		bury  bool
+	}{
+		{
+			name: "bury switch on round robin",
+			key:  "default/foo",
+			bury: true,
+		},
+		{
+			name: "bury switch on nil event",
+			key:  "default/foo/bar",
+			store: func(store *mockstore.MockStore) {
+				store.On("GetEventByEntityCheck", mock.Anything, "bar", "foo").Return((*corev2.Event)(nil), nil)
+			},
+			bury: true,
+		},
+		{
+			name: "bury switch on passing event",
+			key:  "default/foo/bar",
+			store: func(store *mockstore.MockStore) {
+				store.On("GetEventByEntityCheck", mock.Anything, "bar", "foo").Return(corev2.FixtureEvent("bar", "foo"), nil)
+			},
+			bury: true,
+		},
+		{
+			name: "do not bury switch on event lookup error",
+			key:  "default/foo/bar",
+			store: func(store *mockstore.MockStore) {
+				store.On("GetEventByEntityCheck", mock.Anything, "bar", "foo").Return((*corev2.Event)(nil), errors.New("!"))
+			},
+			bury: false,
+		},
+		{
+			name: "do not bury switch otherwise",
+			key:  "default/foo/bar",
+			store: func(store *mockstore.MockStore) {
+				store.On("GetEventByEntityCheck", mock.Anything, "bar", "foo").Return(failing, nil)
+			},
+			bury: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := new(mockstore.MockStore)
+			if test.store != nil {
+				test.store(store)
+			}
+			eventd := &Eventd{store: store, eventStore: store, ctx: context.Background()}
+			if got, want := eventd.autoResolve(test.key, liveness.Alive, false), test.bury; got != want {
+				t.Fatalf("bad bury result: got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCreateResolvedCheckEvent(t *testing.T) {
+	now := time.Unix(2000, 0)
+	event := corev2.FixtureEvent("entity", "check")
+	event.Check.Status = 2
+	event.Check.Executed = 1400
+	event.Check.AutoResolveAfter = 600
+
+	resolved := createResolvedCheckEvent(event, now)
+	assert.Equal(t, uint32(0), resolved.Check.Status)
+	assert.Equal(t, int64(2000), resolved.Check.Executed)
+	assert.Equal(t, int64(2000), resolved.Timestamp)
+	assert.Equal(t, int64(600), resolved.Check.AutoResolveAfter)
+	assert.Equal(t, "No check result received for 600 seconds, event automatically resolved", resolved.Check.Output)
+
+	// The original event is left untouched
+	assert.Equal(t, uint32(2), event.Check.Status)
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/sensu/sensu-go/backend/keepalived"
 	"github.com/sensu/sensu-go/backend/liveness"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/metricsbuffer"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/sensu/sensu-go/tracing"
 	"github.com/sirupsen/logrus"
 )
 
@@ -34,6 +36,18 @@ const (
 	// EventsProcessedLabelSuccess is the name of the label used to count events processed successfully.
 	EventsProcessedLabelSuccess = "success"
 
+	// EventsProcessedLabelError is the name of the label used to count events
+	// that could not be processed, and were dropped.
+	EventsProcessedLabelError = "error"
+
+	// EventQueueDepthGauge is the name of the prometheus gauge used to report
+	// the number of events waiting to be processed.
+	EventQueueDepthGauge = "sensu_go_eventd_queue_depth"
+
+	// EventDurationHistogram is the name of the prometheus histogram used to
+	// observe the duration of the processing of events.
+	EventDurationHistogram = "sensu_go_eventd_event_duration_seconds"
+
 	// defaultStoreTimeout is the store timeout used if the backend did not configure one
 	defaultStoreTimeout = time.Minute
 )
@@ -51,28 +65,53 @@ var (
 		},
 		[]string{EventsProcessedLabelName},
 	)
+
+	// EventQueueDepth reports the number of events waiting to be processed,
+	// as of the last event taken from the queue.
+	EventQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: EventQueueDepthGauge,
+			Help: "The number of events waiting to be processed by eventd",
+		},
+	)
+
+	// EventDuration observes the duration of the processing of events, by
+	// status.
+	EventDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    EventDurationHistogram,
+			Help:    "The duration of the processing of events by eventd, in seconds",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		},
+		[]string{EventsProcessedLabelName},
+	)
 )
 
 const deletedEventSentinel = -1
 
 // Eventd handles incoming sensu events and stores them in etcd.
 type Eventd struct {
-	ctx             context.Context
-	cancel          context.CancelFunc
-	store           store.Store
-	eventStore      store.EventStore
-	bus             messaging.MessageBus
-	workerCount     int
-	livenessFactory liveness.Factory
-	eventChan       chan interface{}
-	subscription    messaging.Subscription
-	errChan         chan error
-	mu              *sync.Mutex
-	shutdownChan    chan struct{}
-	wg              *sync.WaitGroup
-	Logger          Logger
-	silencedCache   *cache.Resource
-	storeTimeout    time.Duration
+	ctx              context.Context
+	cancel           context.CancelFunc
+	store            store.Store
+	eventStore       store.EventStore
+	bus              messaging.MessageBus
+	workerCount      int
+	livenessFactory  liveness.Factory
+	eventChan        chan interface{}
+	subscription     messaging.Subscription
+	errChan          chan error
+	mu               *sync.Mutex
+	shutdownChan     chan struct{}
+	wg               *sync.WaitGroup
+	Logger           Logger
+	silencedCache    silencedCache
+	maintenanceCache *cache.Resource
+	retentionCache   *cache.Resource
+	historyStore     store.EventHistoryStore
+	metricsBuffer    *metricsbuffer.Buffer
+	storeTimeout     time.Duration
+	tracer           *tracing.Tracer
 }
 
 // Option is a functional option.
@@ -82,12 +121,19 @@ type Option func(*Eventd) error
 type Config struct {
 	Store           store.Store
 	EventStore      store.EventStore
+	HistoryStore    store.EventHistoryStore
 	Bus             messaging.MessageBus
 	LivenessFactory liveness.Factory
 	Client          *clientv3.Client
 	BufferSize      int
 	WorkerCount     int
 	StoreTimeout    time.Duration
+
+	// MetricsBuffer keeps the downsampled metrics of the events, if set.
+	MetricsBuffer *metricsbuffer.Buffer
+
+	// Tracer traces the processing of the events, if set.
+	Tracer *tracing.Tracer
 }
 
 // New creates a new Eventd.
@@ -117,15 +163,32 @@ func New(ctx context.Context, c Config, opts ...Option) (*Eventd, error) {
 		wg:              &sync.WaitGroup{},
 		mu:              &sync.Mutex{},
 		Logger:          &RawLogger{},
+		historyStore:    c.HistoryStore,
+		metricsBuffer:   c.MetricsBuffer,
 		storeTimeout:    c.StoreTimeout,
+		tracer:          c.Tracer,
 	}
 
 	e.ctx, e.cancel = context.WithCancel(ctx)
-	cache, err := cache.New(e.ctx, c.Client, &corev2.Silenced{}, false)
+	maintenanceCache, err := cache.New(e.ctx, c.Client, &corev2.MaintenanceWindow{}, false)
 	if err != nil {
 		return nil, err
 	}
-	e.silencedCache = cache
+	e.maintenanceCache = maintenanceCache
+
+	retentionCache, err := cache.New(e.ctx, c.Client, &corev2.EventRetentionPolicy{}, false)
+	if err != nil {
+		return nil, err
+	}
+	e.retentionCache = retentionCache
+
+	// The silenced entries are watched, so that the events are silenced as
+	// soon as the entries are created
+	silenced, err := cache.NewWatched(e.ctx, c.Client, &corev2.Silenced{})
+	if err != nil {
+		return nil, err
+	}
+	e.silencedCache = silenced
 
 	for _, o := range opts {
 		if err := o(e); err != nil {
@@ -135,7 +198,10 @@ func New(ctx context.Context, c Config, opts ...Option) (*Eventd, error) {
 
 	// Initialize the most likely labels
 	EventsProcessed.WithLabelValues(EventsProcessedLabelSuccess)
+	EventsProcessed.WithLabelValues(EventsProcessedLabelError)
 	_ = prometheus.Register(EventsProcessed)
+	_ = prometheus.Register(EventQueueDepth)
+	_ = prometheus.Register(EventDuration)
 
 	return e, nil
 }
@@ -168,9 +234,7 @@ func (e *Eventd) startHandlers() {
 				case <-e.shutdownChan:
 					// drain the event channel.
 					for msg := range e.eventChan {
-						if err := e.handleMessage(msg); err != nil {
-							logger.WithError(err).Error("eventd - error handling event")
-						}
+						e.processMessage(msg)
 					}
 					return
 
@@ -190,9 +254,7 @@ func (e *Eventd) startHandlers() {
 						return
 					}
 
-					if err := e.handleMessage(msg); err != nil {
-						logger.WithError(err).Error("eventd - error handling event")
-					}
+					e.processMessage(msg)
 				}
 			}
 		}()
@@ -224,12 +286,36 @@ func logEvent(e *corev2.Event) {
 	logger.WithFields(fields).Info("eventd received event")
 }
 
-func (e *Eventd) handleMessage(msg interface{}) error {
+// processMessage handles a message taken from the event queue, and records
+// the depth of the queue and the outcome of the processing.
+func (e *Eventd) processMessage(msg interface{}) {
+	EventQueueDepth.Set(float64(len(e.eventChan)))
+	start := time.Now()
+	status := EventsProcessedLabelSuccess
+	if err := e.handleMessage(msg); err != nil {
+		logger.WithError(err).Error("eventd - error handling event")
+		status = EventsProcessedLabelError
+	}
+	EventsProcessed.WithLabelValues(status).Inc()
+	EventDuration.WithLabelValues(status).Observe(time.Since(start).Seconds())
+}
+
+func (e *Eventd) handleMessage(msg interface{}) (err error) {
 	event, ok := msg.(*corev2.Event)
 	if !ok {
 		return errors.New("received non-Event on event channel")
 	}
 
+	// Trace the processing of the event, and propagate the trace to
+	// pipelined
+	span := e.tracer.StartFromTraceParent(event.TraceParent, "eventd.process")
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+	tracing.SetEventAttributes(span, event)
+	event.TraceParent = span.Context().TraceParent()
+
 	logEvent(event)
 
 	// Validate the received event
@@ -237,6 +323,8 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 		return err
 	}
 
+	e.metricsBuffer.Add(event)
+
 	// If the event does not contain a check (rather, it contains metrics)
 	// publish the event without writing to the store
 	if !event.HasCheck() {
@@ -252,9 +340,16 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 		return err
 	}
 
+	// Mask the sensitive values in the output of the events which were not
+	// redacted by their agent, e.g. the events posted to the API
+	event.RedactOutput()
+
 	// Add any silenced subscriptions to the event
 	getSilenced(ctx, event, e.silencedCache)
 
+	// Add any active maintenance windows to the event
+	getMaintenance(event, e.maintenanceCache)
+
 	// Merge the new event with the stored event if a match is found
 	event, prevEvent, err := e.eventStore.UpdateEvent(ctx, event)
 	if err != nil {
@@ -263,9 +358,16 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 
 	e.Logger.Println(event)
 
+	// Keep the history of the event if its namespace retains it
+	addHistory(ctx, event, e.retentionCache, e.historyStore)
+
 	switches := e.livenessFactory("eventd", e.dead, e.alive, logger)
 	switchKey := eventKey(event)
 
+	if err := e.updateAutoResolve(context.TODO(), event, prevEvent); err != nil {
+		return err
+	}
+
 	if event.Check.Name == corev2.KeepaliveCheckName {
 		goto NOTTL
 	}
@@ -288,8 +390,6 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 
 NOTTL:
 
-	EventsProcessed.WithLabelValues(EventsProcessedLabelSuccess).Inc()
-
 	return e.bus.Publish(messaging.TopicEvent, event)
 }
 
@@ -502,3 +602,9 @@ func (e *Eventd) Err() <-chan error {
 func (e *Eventd) Name() string {
 	return "eventd"
 }
+
+// QueueDepth returns the number of events waiting to be processed, and the
+// capacity of the queue.
+func (e *Eventd) QueueDepth() (int, int) {
+	return len(e.eventChan), cap(e.eventChan)
+}
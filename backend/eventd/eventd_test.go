@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/liveness"
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -389,3 +390,15 @@ func TestBuryConditions(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessMessageMetrics(t *testing.T) {
+	e := &Eventd{eventChan: make(chan interface{}, 2)}
+	e.eventChan <- corev2.FixtureEvent("entity", "check")
+	errors := testutil.ToFloat64(EventsProcessed.WithLabelValues(EventsProcessedLabelError))
+
+	// A message which is not an event is dropped
+	e.processMessage("not an event")
+
+	assert.Equal(t, errors+1, testutil.ToFloat64(EventsProcessed.WithLabelValues(EventsProcessedLabelError)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(EventQueueDepth))
+}
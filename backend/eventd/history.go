@@ -0,0 +1,26 @@
+package eventd
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/cache"
+)
+
+// addHistory adds the event to its history if an event retention policy of
+// its namespace retains the history of the events. The event is handled
+// anyway if it can't be added to its history.
+func addHistory(ctx context.Context, event *corev2.Event, cache *cache.Resource, history store.EventHistoryStore) {
+	if cache == nil || history == nil {
+		return
+	}
+
+	if len(cache.Get(event.Entity.Namespace)) == 0 {
+		return
+	}
+
+	if err := history.AddEventHistory(ctx, event); err != nil {
+		logger.WithError(err).Error("error adding the event to its history")
+	}
+}
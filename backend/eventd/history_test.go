@@ -0,0 +1,29 @@
+package eventd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddHistory(t *testing.T) {
+	policy := corev2.FixtureEventRetentionPolicy("policy")
+	policy.Namespace = "acme"
+	c := cache.NewFromResources([]corev2.Resource{policy}, false)
+
+	// The events of the namespaces without retention policy have no history
+	s := &mockstore.MockStore{}
+	addHistory(context.Background(), corev2.FixtureEvent("foo", "check_cpu"), c, s)
+	s.AssertNotCalled(t, "AddEventHistory", mock.Anything, mock.Anything)
+
+	event := corev2.FixtureEvent("foo", "check_cpu")
+	event.Entity.Namespace = "acme"
+	s.On("AddEventHistory", mock.Anything, event).Return(errors.New("error")).Once()
+	addHistory(context.Background(), event, c, s)
+	s.AssertExpectations(t)
+}
@@ -0,0 +1,25 @@
+package eventd
+
+import (
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store/cache"
+)
+
+// getMaintenance adds to the event the names of the maintenance windows that
+// are active and affect it, so that it is not handled.
+func getMaintenance(event *corev2.Event, cache *cache.Resource) {
+	if !event.HasCheck() || cache == nil {
+		return
+	}
+
+	event.Check.Maintenance = nil
+	now := time.Now()
+	for _, resource := range cache.Get(event.Check.Namespace) {
+		window := resource.Resource.(*corev2.MaintenanceWindow)
+		if window.IsActive(now) && window.Affects(event) {
+			event.Check.Maintenance = append(event.Check.Maintenance, window.Name)
+		}
+	}
+}
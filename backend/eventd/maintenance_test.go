@@ -0,0 +1,41 @@
+package eventd
+
+import (
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMaintenance(t *testing.T) {
+	now := time.Now()
+
+	active := corev2.FixtureMaintenanceWindow("active")
+	active.Schedule = nil
+	active.Begin, active.End = now.Add(-time.Hour).Unix(), now.Add(time.Hour).Unix()
+	active.Subscriptions = nil
+	active.Checks = []string{"check_cpu"}
+
+	ended := corev2.FixtureMaintenanceWindow("ended")
+	ended.Schedule = nil
+	ended.Begin, ended.End = now.Add(-2*time.Hour).Unix(), now.Add(-time.Hour).Unix()
+	ended.Subscriptions = nil
+
+	other := corev2.FixtureMaintenanceWindow("other")
+	other.Schedule = nil
+	other.Begin, other.End = active.Begin, active.End
+	other.Subscriptions = nil
+	other.Entities = []string{"bar"}
+
+	c := cache.NewFromResources([]corev2.Resource{active, ended, other}, false)
+
+	event := corev2.FixtureEvent("foo", "check_cpu")
+	getMaintenance(event, c)
+	assert.Equal(t, []string{"active"}, event.Check.Maintenance)
+
+	event = corev2.FixtureEvent("foo", "check_mem")
+	getMaintenance(event, c)
+	assert.Empty(t, event.Check.Maintenance)
+}
@@ -8,6 +8,11 @@ import (
 	stringsutil "github.com/sensu/sensu-go/util/strings"
 )
 
+// silencedCache is a cache of the silenced entries, by namespace.
+type silencedCache interface {
+	Get(namespace string) []cache.Value
+}
+
 // addToSilencedBy takes a silenced entry ID and adds it to a silence of IDs if
 // it's not already present in order to avoid duplicated elements
 func addToSilencedBy(id string, ids []string) []string {
@@ -20,7 +25,7 @@ func addToSilencedBy(id string, ids []string) []string {
 // getSilenced retrieves all silenced entries for a given event, using the
 // entity subscription, the check subscription and the check name while
 // supporting wildcard silenced entries (e.g. subscription:*)
-func getSilenced(ctx context.Context, event *corev2.Event, cache *cache.Resource) {
+func getSilenced(ctx context.Context, event *corev2.Event, cache silencedCache) {
 	if !event.HasCheck() {
 		return
 	}
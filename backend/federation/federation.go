@@ -0,0 +1,308 @@
+// Package federation replicates the resources of the cluster to the remote
+// clusters of its replicators, over their API.
+package federation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultInterval is the interval at which the resources are replicated.
+	DefaultInterval = time.Minute
+
+	// lockKey is the key of the lock serializing the replications of the
+	// backends of the cluster.
+	lockKey = "/sensu.io/federation/.lock"
+)
+
+// locker serializes the replications of the backends of the cluster.
+type locker interface {
+	Lock(ctx context.Context) error
+	Unlock(ctx context.Context) error
+}
+
+// Config configures Federation.
+type Config struct {
+	// Store is the store of the replicators and of the replicated resources.
+	Store store.ResourceStore
+
+	// Client is the etcd client of the lock serializing the replications, if
+	// any.
+	Client *clientv3.Client
+
+	// Interval is the interval at which the resources are replicated,
+	// DefaultInterval if zero.
+	Interval time.Duration
+}
+
+// Federation is the resource replication daemon. At every interval, it
+// makes the resources of the remote cluster of every replicator match the
+// resources of the cluster: the missing resources are created, the modified
+// resources are replaced and the deleted resources are deleted. Only the
+// resources it replicated are replaced or deleted, unless the conflict policy
+// of the replicator is "overwrite".
+type Federation struct {
+	store     store.ResourceStore
+	client    *clientv3.Client
+	interval  time.Duration
+	locker    locker
+	newRemote func(*corev2.Replicator) (*Remote, error)
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	errChan   chan error
+}
+
+// New creates a new Federation.
+func New(c Config) (*Federation, error) {
+	f := &Federation{
+		store:     c.Store,
+		client:    c.Client,
+		interval:  c.Interval,
+		newRemote: newRemote,
+		errChan:   make(chan error, 1),
+	}
+	f.ctx, f.cancel = context.WithCancel(context.Background())
+	if f.interval == 0 {
+		f.interval = DefaultInterval
+	}
+	return f, nil
+}
+
+// Start replicates the resources periodically, in the background.
+func (f *Federation) Start() error {
+	var session *concurrency.Session
+	if f.client != nil {
+		var err error
+		session, err = concurrency.NewSession(f.client)
+		if err != nil {
+			return fmt.Errorf("failed to start federation: %s", err)
+		}
+		f.locker = concurrency.NewMutex(session, lockKey)
+	}
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		if session != nil {
+			defer session.Close()
+		}
+		ticker := time.NewTicker(f.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-f.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := f.replicateAll(f.ctx); err != nil && f.ctx.Err() == nil {
+				logger.WithError(err).Error("could not replicate the resources")
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops Federation.
+func (f *Federation) Stop() error {
+	f.cancel()
+	f.wg.Wait()
+	close(f.errChan)
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (f *Federation) Err() <-chan error {
+	return f.errChan
+}
+
+// Name returns the daemon name
+func (f *Federation) Name() string {
+	return "federation"
+}
+
+// replicateAll replicates the resources of every replicator.
+func (f *Federation) replicateAll(ctx context.Context) error {
+	if f.locker != nil {
+		if err := f.locker.Lock(ctx); err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.locker.Unlock(context.Background())
+		}()
+	}
+
+	var replicators []*corev2.Replicator
+	if err := f.store.ListResources(ctx, corev2.ReplicatorsResource, &replicators, &store.SelectionPredicate{}); err != nil {
+		return err
+	}
+
+	for _, replicator := range replicators {
+		if err := f.replicate(ctx, replicator); err != nil {
+			logger.WithError(err).WithField("replicator", replicator.Name).Error("could not replicate the resources")
+		}
+	}
+	return nil
+}
+
+// replicate replicates the resources of the replicator to its remote
+// cluster. The resources are created or replaced in the order of
+// corev2.ReplicableResources, and deleted in the reverse order, so that the
+// resources they depend on exist.
+func (f *Federation) replicate(ctx context.Context, replicator *corev2.Replicator) error {
+	remote, err := f.newRemote(replicator)
+	if err != nil {
+		return err
+	}
+
+	var failures []string
+	var deletes []corev2.Resource
+	for _, name := range corev2.ReplicableResources() {
+		if !replicator.Replicates(name) {
+			continue
+		}
+		kind := corev2.NewReplicableResource(name)
+
+		list := reflect.New(reflect.SliceOf(reflect.TypeOf(kind)))
+		if err := f.store.ListResources(ctx, kind.StorePrefix(), list.Interface(), &store.SelectionPredicate{}); err != nil {
+			return err
+		}
+		list = list.Elem()
+		local := make([]corev2.Resource, list.Len())
+		for i := range local {
+			local[i] = list.Index(i).Interface().(corev2.Resource)
+		}
+
+		remoteResources, err := remote.list(ctx, kind)
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+
+		puts, dels := diff(replicator, local, remoteResources)
+		if err := remote.bulk(ctx, http.MethodPut, puts); err != nil {
+			failures = append(failures, err.Error())
+		}
+		deletes = append(dels, deletes...)
+	}
+	if err := remote.bulk(ctx, http.MethodDelete, deletes); err != nil {
+		failures = append(failures, err.Error())
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d replication failures: %s", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// diff returns the resources of the remote cluster to create or replace,
+// and to delete, so that they match the local resources.
+func diff(replicator *corev2.Replicator, local, remote []corev2.Resource) (puts, deletes []corev2.Resource) {
+	remoteByKey := make(map[string]corev2.Resource, len(remote))
+	for _, resource := range remote {
+		remoteByKey[key(resource)] = resource
+	}
+
+	matched := make(map[string]bool, len(local))
+	for _, resource := range local {
+		if !replicator.ReplicatesNamespace(resource.GetObjectMeta().Namespace) {
+			continue
+		}
+		k := key(resource)
+		matched[k] = true
+		replica := newReplica(replicator, resource)
+		existing, ok := remoteByKey[k]
+		switch {
+		case !ok:
+			puts = append(puts, replica)
+		case !annotatable(resource):
+			// The resources without metadata are identified by their name
+		case !replicated(replicator, existing) && replicator.ConflictPolicy != corev2.ReplicatorConflictPolicyOverwrite:
+			logger.WithFields(logrus.Fields{
+				"replicator": replicator.Name,
+				"resource":   resource.RBACName(),
+				"name":       resource.GetObjectMeta().Name,
+				"namespace":  resource.GetObjectMeta().Namespace,
+			}).Warn("skipping the replication of a resource which exists in the remote cluster")
+		case !equal(replica, existing):
+			puts = append(puts, replica)
+		}
+	}
+
+	for _, resource := range remote {
+		if matched[key(resource)] || !replicated(replicator, resource) {
+			continue
+		}
+		if replicator.ReplicatesNamespace(resource.GetObjectMeta().Namespace) {
+			deletes = append(deletes, resource)
+		}
+	}
+	return puts, deletes
+}
+
+// key identifies a resource among the resources of its type.
+func key(resource corev2.Resource) string {
+	meta := resource.GetObjectMeta()
+	return meta.Namespace + "/" + meta.Name
+}
+
+// annotatable returns whether the resource has metadata, which can hold the
+// replicator annotation. Namespaces only have a name.
+func annotatable(resource corev2.Resource) bool {
+	_, ok := resource.(*corev2.Namespace)
+	return !ok
+}
+
+// replicated returns whether the remote resource was replicated by the
+// replicator.
+func replicated(replicator *corev2.Replicator, resource corev2.Resource) bool {
+	return resource.GetObjectMeta().Annotations[corev2.ReplicatorAnnotation] == replicator.Name
+}
+
+// newReplica returns the resource as replicated to the remote cluster, with
+// the replicator annotation.
+func newReplica(replicator *corev2.Replicator, resource corev2.Resource) corev2.Resource {
+	meta := resource.GetObjectMeta()
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	annotations[corev2.ReplicatorAnnotation] = replicator.Name
+	meta.Annotations = annotations
+	meta.ResourceVersion = ""
+	meta.CreatedBy = ""
+	resource.SetObjectMeta(meta)
+	return resource
+}
+
+// equal returns whether the replica and the remote resource are the same,
+// regardless of their resource version and creator.
+func equal(replica, remote corev2.Resource) bool {
+	meta := remote.GetObjectMeta()
+	meta.ResourceVersion = ""
+	meta.CreatedBy = ""
+	remote.SetObjectMeta(meta)
+	a, err := json.Marshal(replica)
+	if err != nil {
+		return false
+	}
+	b, err := json.Marshal(remote)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(a, b)
+}
@@ -0,0 +1,149 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func replica(check *corev2.CheckConfig, replicator string) *corev2.CheckConfig {
+	check.Annotations = map[string]string{corev2.ReplicatorAnnotation: replicator}
+	return check
+}
+
+func names(resources []corev2.Resource) []string {
+	result := []string{}
+	for _, resource := range resources {
+		result = append(result, resource.GetObjectMeta().Name)
+	}
+	return result
+}
+
+func TestDiff(t *testing.T) {
+	modified := replica(corev2.FixtureCheckConfig("modified"), "foo")
+	modified.Command = "old"
+
+	local := []corev2.Resource{
+		corev2.FixtureCheckConfig("missing"),
+		corev2.FixtureCheckConfig("unchanged"),
+		corev2.FixtureCheckConfig("modified"),
+		corev2.FixtureCheckConfig("conflict"),
+	}
+	remote := []corev2.Resource{
+		replica(corev2.FixtureCheckConfig("unchanged"), "foo"),
+		modified,
+		corev2.FixtureCheckConfig("conflict"),
+		replica(corev2.FixtureCheckConfig("deleted"), "foo"),
+		replica(corev2.FixtureCheckConfig("other"), "bar"),
+		corev2.FixtureCheckConfig("remote"),
+	}
+	remote[0].(*corev2.CheckConfig).ResourceVersion = "42"
+
+	replicator := corev2.FixtureReplicator("foo")
+	puts, deletes := diff(replicator, local, remote)
+	assert.Equal(t, []string{"missing", "modified"}, names(puts))
+	assert.Equal(t, []string{"deleted"}, names(deletes))
+	assert.Equal(t, "foo", puts[0].GetObjectMeta().Annotations[corev2.ReplicatorAnnotation])
+
+	replicator.ConflictPolicy = corev2.ReplicatorConflictPolicyOverwrite
+	puts, _ = diff(replicator, local, remote)
+	assert.Equal(t, []string{"missing", "modified", "conflict"}, names(puts))
+
+	// Only the resources of the namespaces of the replicator are replicated
+	replicator.Namespaces = []string{"acme"}
+	puts, deletes = diff(replicator, local, remote)
+	assert.Empty(t, puts)
+	assert.Empty(t, deletes)
+}
+
+func TestDiffNamespaces(t *testing.T) {
+	local := []corev2.Resource{corev2.FixtureNamespace("default"), corev2.FixtureNamespace("acme")}
+	remote := []corev2.Resource{corev2.FixtureNamespace("default"), corev2.FixtureNamespace("remote")}
+
+	puts, deletes := diff(corev2.FixtureReplicator("foo"), local, remote)
+	assert.Equal(t, []string{"acme"}, names(puts))
+	assert.Empty(t, deletes)
+}
+
+// fakeRemote is the API of a remote cluster, which lists the checks given to
+// it and records the bulk requests.
+type fakeRemote struct {
+	mu       sync.Mutex
+	checks   []*corev2.CheckConfig
+	requests map[string][]types.Wrapper
+}
+
+func (r *fakeRemote) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.Header.Get("Authorization") != "Key "+corev2.FixtureReplicator("foo").APIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch req.URL.Path {
+	case "/api/core/v2/checks":
+		_ = json.NewEncoder(w).Encode(r.checks)
+	case "/api/core/v2/bulk":
+		var wrappers []types.Wrapper
+		if err := json.NewDecoder(req.Body).Decode(&wrappers); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.requests[req.Method] = append(r.requests[req.Method], wrappers...)
+		_ = json.NewEncoder(w).Encode(make([]corev2.BulkResult, len(wrappers)))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestReplicate(t *testing.T) {
+	remote := &fakeRemote{
+		checks: []*corev2.CheckConfig{
+			replica(corev2.FixtureCheckConfig("deleted"), "foo"),
+		},
+		requests: make(map[string][]types.Wrapper),
+	}
+	server := httptest.NewServer(remote)
+	defer server.Close()
+
+	replicator := corev2.FixtureReplicator("foo")
+	replicator.URL = server.URL
+	replicator.Resources = []string{corev2.ChecksResource}
+
+	s := &mockstore.MockStore{}
+	s.On("ListResources", mock.Anything, corev2.ReplicatorsResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.Replicator)
+			*list = []*corev2.Replicator{replicator}
+		}).Return(nil)
+	s.On("ListResources", mock.Anything, corev2.ChecksResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.CheckConfig)
+			*list = []*corev2.CheckConfig{corev2.FixtureCheckConfig("created")}
+		}).Return(nil)
+
+	f, err := New(Config{Store: s})
+	require.NoError(t, err)
+	require.NoError(t, f.replicateAll(context.Background()))
+
+	require.Len(t, remote.requests[http.MethodPut], 1)
+	put := remote.requests[http.MethodPut][0].Value
+	assert.Equal(t, "created", put.GetObjectMeta().Name)
+	assert.Equal(t, "foo", put.GetObjectMeta().Annotations[corev2.ReplicatorAnnotation])
+	require.Len(t, remote.requests[http.MethodDelete], 1)
+	assert.Equal(t, "deleted", remote.requests[http.MethodDelete][0].Value.GetObjectMeta().Name)
+
+	// The remote errors are reported
+	replicator.APIKey = "wrong"
+	assert.Error(t, f.replicate(context.Background(), replicator))
+}
@@ -0,0 +1,9 @@
+package federation
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "federation",
+})
@@ -0,0 +1,181 @@
+package federation
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// remoteTimeout is the timeout of the requests to the remote clusters.
+	remoteTimeout = 30 * time.Second
+
+	// remotePageSize is the number of resources listed per request.
+	remotePageSize = 500
+
+	// remoteBatchSize is the number of resources sent per bulk request.
+	remoteBatchSize = 100
+)
+
+// Remote is a client of the API of a remote cluster.
+type Remote struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+// NewRemote returns a client of the API of the remote cluster at the given
+// URL, authenticated with the given API key. The certificate of the cluster is
+// verified with the PEM-encoded CA certificates of caBundle, or the system
+// ones if empty, unless insecureSkipTLSVerify is true.
+func NewRemote(url, apiKey, caBundle string, insecureSkipTLSVerify bool) (*Remote, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecureSkipTLSVerify,
+	}
+	if caBundle != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, errors.New("no valid certificate in the ca bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return &Remote{
+		url:    strings.TrimSuffix(url, "/"),
+		apiKey: apiKey,
+		client: &http.Client{
+			Timeout:   remoteTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// newRemote returns a client of the API of the remote cluster of the given
+// replicator.
+func newRemote(replicator *corev2.Replicator) (*Remote, error) {
+	return NewRemote(replicator.URL, replicator.APIKey, replicator.CABundle, replicator.InsecureSkipTLSVerify)
+}
+
+// do sends a request to the remote cluster, and decodes the JSON response
+// body into v, if not nil. It returns the response headers.
+func (r *Remote) do(ctx context.Context, method, uri string, body interface{}, v interface{}) (http.Header, error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, r.url+uri, reader)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Key "+r.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s %s: %s: %s", method, uri, resp.Status, strings.TrimSpace(string(b)))
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return nil, fmt.Errorf("%s %s: %s", method, uri, err)
+		}
+	}
+	return resp.Header, nil
+}
+
+// Page lists, into v, a page of at most limit resources of the given API
+// path, starting at the given continue token. It returns the continue token of
+// the next page, which is empty after the last page. A limit of zero lists
+// every resource.
+func (r *Remote) Page(ctx context.Context, uri string, limit int64, continueToken string, v interface{}) (string, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.FormatInt(limit, 10))
+	}
+	if continueToken != "" {
+		query.Set("continue", continueToken)
+	}
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+	header, err := r.do(ctx, http.MethodGet, uri, nil, v)
+	if err != nil {
+		return "", err
+	}
+	return header.Get(corev2.PaginationContinueHeader), nil
+}
+
+// list returns the resources of every namespace of the remote cluster of
+// the type of the given resource.
+func (r *Remote) list(ctx context.Context, kind corev2.Resource) ([]corev2.Resource, error) {
+	var resources []corev2.Resource
+	var continueToken string
+	for {
+		page := reflect.New(reflect.SliceOf(reflect.TypeOf(kind)))
+		next, err := r.Page(ctx, kind.URIPath(), remotePageSize, continueToken, page.Interface())
+		if err != nil {
+			return nil, err
+		}
+		page = page.Elem()
+		for i := 0; i < page.Len(); i++ {
+			resources = append(resources, page.Index(i).Interface().(corev2.Resource))
+		}
+		continueToken = next
+		if continueToken == "" {
+			return resources, nil
+		}
+	}
+}
+
+// bulk creates or replaces (PUT), or deletes (DELETE) the given resources of
+// the remote cluster. The error lists the resources that failed, if any.
+func (r *Remote) bulk(ctx context.Context, method string, resources []corev2.Resource) error {
+	var failures []string
+	for start := 0; start < len(resources); start += remoteBatchSize {
+		end := start + remoteBatchSize
+		if end > len(resources) {
+			end = len(resources)
+		}
+		wrappers := make([]types.Wrapper, 0, end-start)
+		for _, resource := range resources[start:end] {
+			wrappers = append(wrappers, types.WrapResource(resource))
+		}
+		var results []corev2.BulkResult
+		if _, err := r.do(ctx, method, path.Join(corev2.URLPrefix, "bulk"), wrappers, &results); err != nil {
+			return err
+		}
+		for _, result := range results {
+			if result.Error != "" {
+				failures = append(failures, fmt.Sprintf("%s %q of namespace %q: %s", result.Type, result.Name, result.Namespace, result.Error))
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, ", "))
+	}
+	return nil
+}
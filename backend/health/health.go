@@ -0,0 +1,257 @@
+// Package health reports the liveness and the readiness of the backend, from
+// the status of its subsystems, for the load balancers and the orchestrators
+// in front of the backends.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// DefaultEtcdLatencyThreshold is the default latency of etcd above which
+	// the backend is not ready.
+	DefaultEtcdLatencyThreshold = time.Second
+
+	// DefaultQueueThreshold is the default percentage of the capacity of the
+	// eventd queue and of the pipelined backlog above which the backend is
+	// not ready.
+	DefaultQueueThreshold = 90
+
+	// latencyKey is the key read to measure the latency of etcd.
+	latencyKey = "/sensu.io/health"
+)
+
+// Subsystem names
+const (
+	EtcdQuorum       = "etcd_quorum"
+	EtcdLatency      = "etcd_latency"
+	EventdQueue      = "eventd_queue"
+	PipelinedBacklog = "pipelined_backlog"
+	AgentdListener   = "agentd_listener"
+)
+
+// Status is the status of a subsystem of the backend.
+type Status struct {
+	// Name is the name of the subsystem.
+	Name string `json:"name"`
+
+	// Healthy is false if the subsystem failed its check.
+	Healthy bool `json:"healthy"`
+
+	// Message describes why the subsystem is not healthy.
+	Message string `json:"message,omitempty"`
+
+	// Details holds the measures the subsystem was checked against.
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// Report is the status of the subsystems of the backend.
+type Report struct {
+	// Healthy is false if any subsystem is not healthy.
+	Healthy bool `json:"healthy"`
+
+	// Subsystems are the statuses of the subsystems checked.
+	Subsystems []Status `json:"subsystems"`
+}
+
+// ClusterHealthGetter returns the health of the members of the etcd cluster.
+type ClusterHealthGetter interface {
+	GetClusterHealth(ctx context.Context) *corev2.HealthResponse
+}
+
+// Queue is a queue of work of a daemon, such as the events of eventd.
+type Queue interface {
+	// QueueDepth returns the number of items in the queue, and its capacity.
+	QueueDepth() (depth, capacity int)
+}
+
+// Thresholds are the values above which the subsystems are not ready.
+type Thresholds struct {
+	// EtcdLatency is the latency of etcd above which it is not ready,
+	// DefaultEtcdLatencyThreshold if zero.
+	EtcdLatency time.Duration
+
+	// EventdQueue and PipelinedBacklog are the percentages of the capacity
+	// of the eventd queue and of the pipelined backlog above which they are
+	// not ready, DefaultQueueThreshold if zero.
+	EventdQueue      int
+	PipelinedBacklog int
+}
+
+// Config configures a Checker. The subsystems that are not set are not
+// checked.
+type Config struct {
+	// ClusterHealth gives the health of the etcd cluster members, from which
+	// the quorum is checked.
+	ClusterHealth ClusterHealthGetter
+
+	// KV is the etcd client whose latency is checked.
+	KV clientv3.KV
+
+	// Eventd and Pipelined are the queues of eventd and pipelined.
+	Eventd    Queue
+	Pipelined Queue
+
+	// AgentdAddress is the address agentd listens on.
+	AgentdAddress string
+
+	Thresholds Thresholds
+}
+
+// Checker checks the subsystems of the backend.
+type Checker struct {
+	config Config
+}
+
+// New returns a new Checker.
+func New(c Config) *Checker {
+	if c.Thresholds.EtcdLatency == 0 {
+		c.Thresholds.EtcdLatency = DefaultEtcdLatencyThreshold
+	}
+	if c.Thresholds.EventdQueue == 0 {
+		c.Thresholds.EventdQueue = DefaultQueueThreshold
+	}
+	if c.Thresholds.PipelinedBacklog == 0 {
+		c.Thresholds.PipelinedBacklog = DefaultQueueThreshold
+	}
+	return &Checker{config: c}
+}
+
+// Liveness reports whether the backend is alive, or should be restarted. Only
+// the subsystems of the backend itself are checked, so that the backends are
+// not restarted when one of their dependencies, such as etcd, fails.
+func (c *Checker) Liveness(ctx context.Context) *Report {
+	report := &Report{Healthy: true, Subsystems: []Status{}}
+	if c.config.AgentdAddress != "" {
+		report.add(c.checkAgentd(ctx))
+	}
+	return report
+}
+
+// Readiness reports whether the backend is ready to serve requests: etcd has
+// a quorum and a latency below its threshold, the eventd queue and pipelined
+// backlog are below their thresholds, and agentd accepts connections.
+func (c *Checker) Readiness(ctx context.Context) *Report {
+	report := &Report{Healthy: true, Subsystems: []Status{}}
+	if c.config.ClusterHealth != nil {
+		report.add(c.checkQuorum(ctx))
+	}
+	if c.config.KV != nil {
+		report.add(c.checkLatency(ctx))
+	}
+	if c.config.Eventd != nil {
+		report.add(checkQueue(EventdQueue, c.config.Eventd, c.config.Thresholds.EventdQueue))
+	}
+	if c.config.Pipelined != nil {
+		report.add(checkQueue(PipelinedBacklog, c.config.Pipelined, c.config.Thresholds.PipelinedBacklog))
+	}
+	if c.config.AgentdAddress != "" {
+		report.add(c.checkAgentd(ctx))
+	}
+	if !report.Healthy {
+		logger.WithField("report", report).Warn("the backend is not ready")
+	}
+	return report
+}
+
+func (r *Report) add(status Status) {
+	r.Subsystems = append(r.Subsystems, status)
+	r.Healthy = r.Healthy && status.Healthy
+}
+
+// checkQuorum checks that a majority of the etcd cluster members are healthy.
+func (c *Checker) checkQuorum(ctx context.Context) Status {
+	status := Status{Name: EtcdQuorum}
+	response := c.config.ClusterHealth.GetClusterHealth(ctx)
+	members := len(response.ClusterHealth)
+	healthy := 0
+	for _, member := range response.ClusterHealth {
+		if member.Healthy {
+			healthy++
+		}
+	}
+	status.Details = map[string]interface{}{
+		"members":         members,
+		"healthy_members": healthy,
+	}
+	status.Healthy = members > 0 && healthy > members/2
+	if !status.Healthy {
+		status.Message = fmt.Sprintf("%d of %d etcd members are healthy", healthy, members)
+	}
+	return status
+}
+
+// checkLatency checks the latency of a linearizable read of etcd.
+func (c *Checker) checkLatency(ctx context.Context) Status {
+	status := Status{Name: EtcdLatency}
+	start := time.Now()
+	_, err := c.config.KV.Get(ctx, latencyKey, clientv3.WithCountOnly())
+	latency := time.Since(start)
+	status.Details = map[string]interface{}{
+		"latency_ms":   latency.Milliseconds(),
+		"threshold_ms": c.config.Thresholds.EtcdLatency.Milliseconds(),
+	}
+	switch {
+	case err != nil:
+		status.Message = fmt.Sprintf("could not read from etcd: %s", err)
+	case latency > c.config.Thresholds.EtcdLatency:
+		status.Message = fmt.Sprintf("etcd latency of %s exceeds %s", latency, c.config.Thresholds.EtcdLatency)
+	default:
+		status.Healthy = true
+	}
+	return status
+}
+
+// checkQueue checks that the queue is filled below the threshold percentage
+// of its capacity.
+func checkQueue(name string, queue Queue, threshold int) Status {
+	status := Status{Name: name}
+	depth, capacity := queue.QueueDepth()
+	status.Details = map[string]interface{}{
+		"depth":             depth,
+		"capacity":          capacity,
+		"threshold_percent": threshold,
+	}
+	status.Healthy = capacity == 0 || depth*100 <= capacity*threshold
+	if !status.Healthy {
+		status.Message = fmt.Sprintf("%d of %d items queued exceeds %d%%", depth, capacity, threshold)
+	}
+	return status
+}
+
+// checkAgentd checks that agentd accepts connections.
+func (c *Checker) checkAgentd(ctx context.Context) Status {
+	status := Status{Name: AgentdListener}
+	address := dialAddress(c.config.AgentdAddress)
+	status.Details = map[string]interface{}{
+		"address": address,
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		status.Message = fmt.Sprintf("agentd does not accept connections: %s", err)
+		return status
+	}
+	_ = conn.Close()
+	status.Healthy = true
+	return status
+}
+
+// dialAddress returns the address to dial to reach a listener on the given
+// address, which is the loopback address for the listeners on every address.
+func dialAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
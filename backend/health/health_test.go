@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClusterHealth []bool
+
+func (f fakeClusterHealth) GetClusterHealth(ctx context.Context) *corev2.HealthResponse {
+	response := &corev2.HealthResponse{}
+	for _, healthy := range f {
+		response.ClusterHealth = append(response.ClusterHealth, &corev2.ClusterHealth{Healthy: healthy})
+	}
+	return response
+}
+
+type fakeKV struct {
+	clientv3.KV
+	delay time.Duration
+	err   error
+}
+
+func (f *fakeKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	time.Sleep(f.delay)
+	return &clientv3.GetResponse{}, f.err
+}
+
+type fakeQueue struct {
+	depth, capacity int
+}
+
+func (f fakeQueue) QueueDepth() (int, int) {
+	return f.depth, f.capacity
+}
+
+func statuses(report *Report) map[string]bool {
+	result := make(map[string]bool)
+	for _, status := range report.Subsystems {
+		result[status.Name] = status.Healthy
+	}
+	return result
+}
+
+func TestReadiness(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	kv := &fakeKV{}
+	config := Config{
+		ClusterHealth: fakeClusterHealth{true, true, false},
+		KV:            kv,
+		Eventd:        fakeQueue{depth: 90, capacity: 100},
+		Pipelined:     fakeQueue{depth: 0, capacity: 100},
+		AgentdAddress: ln.Addr().String(),
+		Thresholds: Thresholds{
+			EtcdLatency: 50 * time.Millisecond,
+		},
+	}
+	report := New(config).Readiness(context.Background())
+	assert.True(t, report.Healthy)
+	assert.Equal(t, map[string]bool{
+		EtcdQuorum:       true,
+		EtcdLatency:      true,
+		EventdQueue:      true,
+		PipelinedBacklog: true,
+		AgentdListener:   true,
+	}, statuses(report))
+
+	config.ClusterHealth = fakeClusterHealth{true, false, false}
+	config.Eventd = fakeQueue{depth: 91, capacity: 100}
+	config.Thresholds.PipelinedBacklog = 50
+	config.Pipelined = fakeQueue{depth: 51, capacity: 100}
+	kv.delay = 100 * time.Millisecond
+	report = New(config).Readiness(context.Background())
+	assert.False(t, report.Healthy)
+	assert.Equal(t, map[string]bool{
+		EtcdQuorum:       false,
+		EtcdLatency:      false,
+		EventdQueue:      false,
+		PipelinedBacklog: false,
+		AgentdListener:   true,
+	}, statuses(report))
+
+	kv.delay = 0
+	kv.err = errors.New("unavailable")
+	report = New(Config{KV: kv}).Readiness(context.Background())
+	assert.False(t, report.Healthy)
+	assert.Contains(t, report.Subsystems[0].Message, "unavailable")
+}
+
+func TestLiveness(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := ln.Addr().String()
+
+	// The dependencies of the backend are not checked
+	checker := New(Config{
+		ClusterHealth: fakeClusterHealth{false},
+		AgentdAddress: address,
+	})
+	assert.True(t, checker.Liveness(context.Background()).Healthy)
+
+	ln.Close()
+	assert.False(t, checker.Liveness(context.Background()).Healthy)
+}
+
+func TestDialAddress(t *testing.T) {
+	assert.Equal(t, "localhost:8081", dialAddress("[::]:8081"))
+	assert.Equal(t, "localhost:8081", dialAddress("0.0.0.0:8081"))
+	assert.Equal(t, "localhost:8081", dialAddress(":8081"))
+	assert.Equal(t, "10.0.0.1:8081", dialAddress("10.0.0.1:8081"))
+}
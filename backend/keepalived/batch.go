@@ -0,0 +1,136 @@
+package keepalived
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// DefaultBatchInterval is the default interval over which the keepalives
+	// that only refresh the last seen timestamp of their entity are batched.
+	DefaultBatchInterval = time.Second
+
+	// KeepalivesBatchedCounter is the name of the prometheus counter used to
+	// count the keepalives whose store updates were batched.
+	KeepalivesBatchedCounter = "sensu_go_keepalived_keepalives_batched"
+
+	// BatchWritesCounterVec is the name of the prometheus counter vec used to
+	// count the batches of keepalives written to the store, by status.
+	BatchWritesCounterVec = "sensu_go_keepalived_batch_writes"
+)
+
+var (
+	// KeepalivesBatched counts the keepalives whose store updates were
+	// batched instead of written immediately.
+	KeepalivesBatched = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: KeepalivesBatchedCounter,
+			Help: "The total number of keepalives whose store updates were batched by keepalived",
+		},
+	)
+
+	// BatchWrites counts the batches of keepalives written to the store, by
+	// status, either success or failure.
+	BatchWrites = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: BatchWritesCounterVec,
+			Help: "The total number of batches of keepalives written to the store by keepalived",
+		},
+		[]string{"status"},
+	)
+)
+
+// keepaliveBatch coalesces the store updates of the keepalives that only
+// refresh the last seen timestamp of their entity, and writes them at each
+// interval. Only the last keepalive of each entity is written.
+type keepaliveBatch struct {
+	store        store.KeepaliveStore
+	interval     time.Duration
+	storeTimeout time.Duration
+
+	mu       sync.Mutex
+	entities map[string]*corev2.Entity
+}
+
+func newKeepaliveBatch(store store.KeepaliveStore, interval, storeTimeout time.Duration) *keepaliveBatch {
+	return &keepaliveBatch{
+		store:        store,
+		interval:     interval,
+		storeTimeout: storeTimeout,
+		entities:     make(map[string]*corev2.Entity),
+	}
+}
+
+// add queues the update of the entity, replacing its queued update, if any.
+func (b *keepaliveBatch) add(entity *corev2.Entity) {
+	// The entity is shared with the keepalive event
+	e := *entity
+	b.mu.Lock()
+	b.entities[path.Join(entity.Namespace, entity.Name)] = &e
+	b.mu.Unlock()
+	KeepalivesBatched.Inc()
+}
+
+// remove drops the queued update of the entity, which is being written
+// immediately.
+func (b *keepaliveBatch) remove(entity *corev2.Entity) {
+	b.mu.Lock()
+	delete(b.entities, path.Join(entity.Namespace, entity.Name))
+	b.mu.Unlock()
+}
+
+// run writes the queued updates at each interval until ctx is done, then
+// writes the updates left.
+func (b *keepaliveBatch) run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.flush(ctx)
+		case <-ctx.Done():
+			b.flush(context.Background())
+			return
+		}
+	}
+}
+
+func (b *keepaliveBatch) flush(ctx context.Context) {
+	b.mu.Lock()
+	if len(b.entities) == 0 {
+		b.mu.Unlock()
+		return
+	}
+	entities := make([]*corev2.Entity, 0, len(b.entities))
+	for _, entity := range b.entities {
+		entities = append(entities, entity)
+	}
+	b.entities = make(map[string]*corev2.Entity, len(entities))
+	b.mu.Unlock()
+
+	tctx, cancel := context.WithTimeout(ctx, b.storeTimeout)
+	defer cancel()
+	if err := b.store.UpdateKeepalives(tctx, entities); err != nil {
+		// The next keepalives of the entities will update them
+		logger.WithError(err).WithField("entities", len(entities)).Error("error updating batched keepalives")
+		BatchWrites.WithLabelValues("failure").Inc()
+		return
+	}
+	BatchWrites.WithLabelValues("success").Inc()
+}
+
+// onlyLastSeenChanged returns whether the entity of a keepalive only differs
+// from the stored entity by its last seen timestamp. The resource version set
+// by the store is ignored.
+func onlyLastSeenChanged(stored, entity *corev2.Entity) bool {
+	e := *stored
+	e.LastSeen = entity.LastSeen
+	e.ResourceVersion = entity.ResourceVersion
+	return e.Equal(entity)
+}
@@ -0,0 +1,83 @@
+package keepalived
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnlyLastSeenChanged(t *testing.T) {
+	stored := corev2.FixtureEntity("entity")
+	stored.LastSeen = 1
+	stored.ResourceVersion = "42"
+
+	entity := corev2.FixtureEntity("entity")
+	entity.LastSeen = 2
+	assert.True(t, onlyLastSeenChanged(stored, entity))
+
+	entity.Subscriptions = append(entity.Subscriptions, "windows")
+	assert.False(t, onlyLastSeenChanged(stored, entity))
+}
+
+func TestKeepaliveBatch(t *testing.T) {
+	st := &mockstore.MockStore{}
+	batch := newKeepaliveBatch(st, time.Second, time.Second)
+
+	// Nothing to write
+	batch.flush(context.Background())
+
+	entity1 := corev2.FixtureEntity("entity1")
+	entity1.LastSeen = 1
+	batch.add(entity1)
+	entity1.LastSeen = 2
+	batch.add(entity1)
+	entity2 := corev2.FixtureEntity("entity2")
+	batch.add(entity2)
+	entity3 := corev2.FixtureEntity("entity3")
+	batch.add(entity3)
+	batch.remove(entity3)
+
+	st.On("UpdateKeepalives", mock.Anything, mock.MatchedBy(func(entities []*corev2.Entity) bool {
+		lastSeen := map[string]int64{}
+		for _, entity := range entities {
+			lastSeen[entity.Name] = entity.LastSeen
+		}
+		return assert.Equal(t, map[string]int64{"entity1": 2, "entity2": 0}, lastSeen)
+	})).Return(nil).Once()
+	batch.flush(context.Background())
+	st.AssertExpectations(t)
+
+	// The batch is empty once written
+	batch.flush(context.Background())
+	st.AssertNumberOfCalls(t, "UpdateKeepalives", 1)
+}
+
+func TestHandleUpdateBatch(t *testing.T) {
+	test := newKeepalivedTest(t)
+	defer test.Dispose(t)
+	k := test.Keepalived
+	k.batch = newKeepaliveBatch(test.Store, time.Second, time.Second)
+
+	stored := corev2.FixtureEntity("entity")
+	event := corev2.FixtureEvent("entity", "keepalive")
+	event.Timestamp = 42
+
+	// The keepalive only refreshes the last seen timestamp of the entity
+	require.NoError(t, k.handleUpdate(event, stored))
+	test.Store.AssertNotCalled(t, "UpdateEntity", mock.Anything, mock.Anything)
+	assert.Len(t, k.batch.entities, 1)
+
+	// The entity changed
+	event.Entity.Subscriptions = []string{"windows"}
+	test.Store.On("DeleteFailingKeepalive", mock.Anything, event.Entity).Return(nil)
+	test.Store.On("UpdateEntity", mock.Anything, event.Entity).Return(nil)
+	require.NoError(t, k.handleUpdate(event, stored))
+	test.Store.AssertCalled(t, "UpdateEntity", mock.Anything, event.Entity)
+	assert.Len(t, k.batch.entities, 0)
+}
@@ -2,13 +2,16 @@ package keepalived
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/token"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/types/dynamic"
 )
 
 // A Deregisterer provides a mechanism for deregistering entities and
@@ -65,14 +68,15 @@ func (d *Deregistration) Deregister(entity *types.Entity) error {
 		}
 	}
 
-	if entity.Deregistration.Handler != "" {
+	if handlers := entity.Deregistration.AllHandlers(); len(handlers) > 0 {
 		deregistrationCheck := &types.Check{
 			ObjectMeta:    corev2.NewObjectMeta("deregistration", entity.Namespace),
 			Interval:      1,
 			Subscriptions: []string{""},
 			Command:       "",
-			Handlers:      []string{entity.Deregistration.Handler},
+			Handlers:      handlers,
 			Status:        1,
+			Output:        deregistrationPayload(entity),
 		}
 
 		deregistrationEvent := &types.Event{
@@ -86,3 +90,21 @@ func (d *Deregistration) Deregister(entity *types.Entity) error {
 	logger.WithField("entity", entity.GetName()).Info("entity deregistered")
 	return nil
 }
+
+// deregistrationPayload returns the deregistration payload of the entity,
+// evaluated as a template with the entity.
+func deregistrationPayload(entity *types.Entity) string {
+	if entity.Deregistration.Payload == "" {
+		return ""
+	}
+	payload, err := token.Substitution(dynamic.Synthesize(entity), entity.Deregistration.Payload)
+	if err != nil {
+		logger.WithField("entity", entity.GetName()).WithError(err).Error("unable to substitute tokens in deregistration payload")
+		return entity.Deregistration.Payload
+	}
+	var output string
+	if err := json.Unmarshal(payload, &output); err != nil {
+		return entity.Deregistration.Payload
+	}
+	return output
+}
@@ -69,3 +69,39 @@ func TestDeregistrationHandler(t *testing.T) {
 
 	assert.NoError(adapter.Deregister(entity))
 }
+
+func TestDeregistrationHandlersPayload(t *testing.T) {
+	assert := assert.New(t)
+
+	mockStore := &mockstore.MockStore{}
+	mockBus := &mockbus.MockBus{}
+
+	adapter := &Deregistration{
+		EventStore:  mockStore,
+		EntityStore: mockStore,
+		MessageBus:  mockBus,
+	}
+
+	entity := types.FixtureEntity("entity")
+	entity.Deregister = true
+	entity.Labels = map[string]string{"asset_id": "42"}
+	entity.Deregistration = types.Deregistration{
+		Handler:  "deregistration",
+		Handlers: []string{"cmdb"},
+		Payload:  `{"name": "{{ .name }}", "asset_id": "{{ .labels.asset_id }}"}`,
+	}
+
+	mockStore.On("GetEventsByEntity", mock.Anything, entity.Name, &store.SelectionPredicate{}).Return([]*types.Event{}, nil)
+	mockStore.On("DeleteEntity", mock.Anything, entity).Return(nil)
+
+	var published *types.Event
+	mockBus.On("Publish", messaging.TopicEvent, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		published = args[1].(*types.Event)
+	})
+
+	assert.NoError(adapter.Deregister(entity))
+	if assert.NotNil(published) {
+		assert.Equal([]string{"deregistration", "cmdb"}, published.Check.Handlers)
+		assert.Equal(`{"name": "entity", "asset_id": "42"}`, published.Check.Output)
+	}
+}
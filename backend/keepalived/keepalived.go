@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/agent"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/liveness"
@@ -42,6 +44,54 @@ const (
 
 const deletedEventSentinel = -1
 
+// deregisteredEventSentinel is the keepalive timestamp sent by agentd when an
+// agent deregisters its entity.
+const deregisteredEventSentinel = -2
+
+const (
+	// QueueDepthGauge is the name of the prometheus gauge used to report the
+	// number of keepalives waiting to be processed.
+	QueueDepthGauge = "sensu_go_keepalived_queue_depth"
+
+	// KeepaliveDurationHistogram is the name of the prometheus histogram used
+	// to observe the duration of the processing of keepalives.
+	KeepaliveDurationHistogram = "sensu_go_keepalived_keepalive_duration_seconds"
+
+	// KeepalivesDroppedCounter is the name of the prometheus counter used to
+	// count the keepalives that could not be processed.
+	KeepalivesDroppedCounter = "sensu_go_keepalived_keepalives_dropped"
+)
+
+var (
+	// QueueDepth reports the number of keepalives waiting to be processed, as
+	// of the last keepalive taken from the queue.
+	QueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: QueueDepthGauge,
+			Help: "The number of keepalives waiting to be processed by keepalived",
+		},
+	)
+
+	// KeepaliveDuration observes the duration of the processing of
+	// keepalives.
+	KeepaliveDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    KeepaliveDurationHistogram,
+			Help:    "The duration of the processing of keepalives by keepalived, in seconds",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		},
+	)
+
+	// KeepalivesDropped counts the keepalives that were invalid, or whose
+	// switch could not be reset.
+	KeepalivesDropped = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: KeepalivesDroppedCounter,
+			Help: "The total number of keepalives dropped by keepalived",
+		},
+	)
+)
+
 // Keepalived is responsible for monitoring keepalive events and recording
 // keepalives for entities.
 type Keepalived struct {
@@ -60,6 +110,7 @@ type Keepalived struct {
 	ctx                   context.Context
 	cancel                context.CancelFunc
 	storeTimeout          time.Duration
+	batch                 *keepaliveBatch
 }
 
 // Option is a functional option.
@@ -76,6 +127,11 @@ type Config struct {
 	BufferSize            int
 	WorkerCount           int
 	StoreTimeout          time.Duration
+
+	// BatchInterval is the interval over which the keepalives that only
+	// refresh the last seen timestamp of their entity are batched, or zero
+	// to update the store at each keepalive.
+	BatchInterval time.Duration
 }
 
 // New creates a new Keepalived.
@@ -110,11 +166,21 @@ func New(c Config, opts ...Option) (*Keepalived, error) {
 		cancel:                cancel,
 		storeTimeout:          c.StoreTimeout,
 	}
+	if c.BatchInterval > 0 {
+		k.batch = newKeepaliveBatch(c.Store, c.BatchInterval, c.StoreTimeout)
+	}
 	for _, o := range opts {
 		if err := o(k); err != nil {
 			return nil, err
 		}
 	}
+
+	_ = prometheus.Register(QueueDepth)
+	_ = prometheus.Register(KeepaliveDuration)
+	_ = prometheus.Register(KeepalivesDropped)
+	_ = prometheus.Register(KeepalivesBatched)
+	_ = prometheus.Register(BatchWrites)
+
 	return k, nil
 }
 
@@ -224,6 +290,14 @@ func (k *Keepalived) startWorkers() {
 	for i := 0; i < k.workerCount; i++ {
 		go k.processKeepalives(k.ctx)
 	}
+
+	if k.batch != nil {
+		k.wg.Add(1)
+		go func() {
+			defer k.wg.Done()
+			k.batch.run(k.ctx)
+		}()
+	}
 }
 
 func (k *Keepalived) processKeepalives(ctx context.Context) {
@@ -239,93 +313,108 @@ func (k *Keepalived) processKeepalives(ctx context.Context) {
 			if !ok {
 				return
 			}
-			event, ok := msg.(*corev2.Event)
-			if !ok {
-				logger.Error("keepalived received non-Event on keepalive channel")
-				continue
+			QueueDepth.Set(float64(len(k.keepaliveChan)))
+			start := time.Now()
+			err := k.handleKeepalive(ctx, switches, msg)
+			KeepaliveDuration.Observe(time.Since(start).Seconds())
+			if err != nil {
+				// Fatal error
+				select {
+				case k.errChan <- err:
+				case <-ctx.Done():
+				}
+				return
 			}
+		}
+	}
+}
 
-			entity := event.Entity
-			if entity == nil {
-				logger.Error("keepalive channel received keepalive with nil event")
-				continue
-			}
+// handleKeepalive handles a keepalive message. Only fatal errors are
+// returned; the keepalives that can't be handled are logged and dropped.
+func (k *Keepalived) handleKeepalive(ctx context.Context, switches liveness.Interface, msg interface{}) error {
+	event, ok := msg.(*corev2.Event)
+	if !ok {
+		logger.Error("keepalived received non-Event on keepalive channel")
+		KeepalivesDropped.Inc()
+		return nil
+	}
 
-			if err := entity.Validate(); err != nil {
-				logger.WithError(err).Error("invalid keepalive event")
-				continue
-			}
+	entity := event.Entity
+	if entity == nil {
+		logger.Error("keepalive channel received keepalive with nil event")
+		KeepalivesDropped.Inc()
+		return nil
+	}
 
-			if event.Timestamp == deletedEventSentinel {
-				// The keepalive event was deleted, so we should bury its associated switch
-				id := path.Join(entity.Namespace, entity.Name)
-				tctx, cancel := context.WithTimeout(ctx, k.storeTimeout)
-				err := switches.Bury(tctx, id)
-				cancel()
-				if err != nil {
-					if _, ok := err.(*store.ErrInternal); ok {
-						// Fatal error
-						select {
-						case k.errChan <- err:
-						case <-ctx.Done():
-						}
-						return
-					}
-					logger.WithError(err).Error("error deleting keepalive")
-				}
-				continue
-			}
+	if err := entity.Validate(); err != nil {
+		logger.WithError(err).Error("invalid keepalive event")
+		KeepalivesDropped.Inc()
+		return nil
+	}
 
-			if err := k.handleEntityRegistration(entity); err != nil {
-				logger.WithError(err).Error("error handling entity registration")
-				if _, ok := err.(*store.ErrInternal); ok {
-					// Fatal error
-					select {
-					case k.errChan <- err:
-					case <-ctx.Done():
-					}
-					return
-				}
+	if event.Timestamp == deletedEventSentinel {
+		// The keepalive event was deleted, so we should bury its associated switch
+		id := path.Join(entity.Namespace, entity.Name)
+		tctx, cancel := context.WithTimeout(ctx, k.storeTimeout)
+		err := switches.Bury(tctx, id)
+		cancel()
+		if err != nil {
+			if _, ok := err.(*store.ErrInternal); ok {
+				return err
 			}
+			logger.WithError(err).Error("error deleting keepalive")
+		}
+		return nil
+	}
 
-			// Retrieve the keepalive timeout or use a default value in case an older
-			// agent version was used, since entity.KeepaliveTimeout no longer exist
-			ttl := int64(corev2.DefaultKeepaliveTimeout)
-			if event.Check != nil {
-				ttl = int64(event.Check.Timeout)
+	if event.Timestamp == deregisteredEventSentinel {
+		// The agent deregistered its entity, so we should bury its
+		// associated switch and deregister the entity
+		if err := k.handleEntityDeregistration(ctx, switches, entity); err != nil {
+			logger.WithError(err).Error("error deregistering entity")
+			if _, ok := err.(*store.ErrInternal); ok {
+				return err
 			}
+		}
+		return nil
+	}
 
-			key := path.Join(entity.Namespace, entity.Name)
+	storedEntity, err := k.handleEntityRegistration(entity)
+	if err != nil {
+		logger.WithError(err).Error("error handling entity registration")
+		if _, ok := err.(*store.ErrInternal); ok {
+			return err
+		}
+	}
 
-			tctx, cancel := context.WithTimeout(ctx, k.storeTimeout)
-			err := switches.Alive(tctx, key, ttl)
-			cancel()
-			if err != nil {
-				logger.WithError(err).Errorf("error on switch %q", key)
-				if _, ok := err.(*store.ErrInternal); ok {
-					// Fatal error
-					select {
-					case k.errChan <- err:
-					case <-ctx.Done():
-					}
-					return
-				}
-				continue
-			}
+	// Retrieve the keepalive timeout or use a default value in case an older
+	// agent version was used, since entity.KeepaliveTimeout no longer exist
+	ttl := int64(corev2.DefaultKeepaliveTimeout)
+	if event.Check != nil {
+		ttl = int64(event.Check.Timeout)
+	}
 
-			if err := k.handleUpdate(event); err != nil {
-				logger.WithError(err).Error("error updating event")
-				if _, ok := err.(*store.ErrInternal); ok {
-					// Fatal error
-					select {
-					case k.errChan <- err:
-					case <-ctx.Done():
-					}
-					return
-				}
-			}
+	key := path.Join(entity.Namespace, entity.Name)
+
+	tctx, cancel := context.WithTimeout(ctx, k.storeTimeout)
+	err = switches.Alive(tctx, key, ttl)
+	cancel()
+	if err != nil {
+		logger.WithError(err).Errorf("error on switch %q", key)
+		if _, ok := err.(*store.ErrInternal); ok {
+			return err
+		}
+		KeepalivesDropped.Inc()
+		return nil
+	}
+
+	if err := k.handleUpdate(event, storedEntity); err != nil {
+		logger.WithError(err).Error("error updating event")
+		if _, ok := err.(*store.ErrInternal); ok {
+			return err
 		}
 	}
+	return nil
 }
 
 // HandleError logs an error
@@ -333,9 +422,12 @@ func (k *Keepalived) HandleError(err error) {
 	logger.WithError(err).Error(err)
 }
 
-func (k *Keepalived) handleEntityRegistration(entity *corev2.Entity) error {
+// handleEntityRegistration publishes a registration event for the agent
+// entity if it isn't stored yet. It returns the stored entity, or nil if the
+// entity isn't stored or isn't an agent entity.
+func (k *Keepalived) handleEntityRegistration(entity *corev2.Entity) (*corev2.Entity, error) {
 	if entity.EntityClass != corev2.EntityAgentClass {
-		return nil
+		return nil, nil
 	}
 
 	ctx := corev2.SetContextFromResource(k.ctx, entity)
@@ -345,7 +437,7 @@ func (k *Keepalived) handleEntityRegistration(entity *corev2.Entity) error {
 
 	if err != nil {
 		// Warning: do not wrap this error
-		return err
+		return nil, err
 	}
 
 	if fetchedEntity == nil {
@@ -353,7 +445,37 @@ func (k *Keepalived) handleEntityRegistration(entity *corev2.Entity) error {
 		err = k.bus.Publish(messaging.TopicEvent, event)
 	}
 
-	return err
+	return fetchedEntity, err
+}
+
+// handleEntityDeregistration buries the keepalive switch of the entity sent by
+// an agent shutting down, and deregisters the stored entity.
+func (k *Keepalived) handleEntityDeregistration(ctx context.Context, switches liveness.Interface, entity *corev2.Entity) error {
+	id := path.Join(entity.Namespace, entity.Name)
+	tctx, cancel := context.WithTimeout(ctx, k.storeTimeout)
+	err := switches.Bury(tctx, id)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ctx = corev2.SetContextFromResource(ctx, entity)
+	tctx, cancel = context.WithTimeout(ctx, k.storeTimeout)
+	defer cancel()
+	storedEntity, err := k.store.GetEntityByName(tctx, entity.Name)
+	if err != nil {
+		// Warning: do not wrap this error
+		return err
+	}
+	if storedEntity == nil {
+		return nil
+	}
+
+	k.deregister(storedEntity, logger.WithFields(logrus.Fields{
+		"entity":    entity.Name,
+		"namespace": entity.Namespace,
+	}))
+	return nil
 }
 
 func createKeepaliveEvent(rawEvent *corev2.Event) *corev2.Event {
@@ -488,16 +610,7 @@ func (k *Keepalived) dead(key string, prev liveness.State, leader bool) bool {
 	}
 
 	if entity.Deregister {
-		deregisterer := &Deregistration{
-			EntityStore:  k.store,
-			EventStore:   k.eventStore,
-			MessageBus:   k.bus,
-			StoreTimeout: k.storeTimeout,
-		}
-		if err := deregisterer.Deregister(entity); err != nil {
-			lager.WithError(err).Error("error deregistering entity")
-		}
-		lager.Debug("deregistering entity")
+		k.deregister(entity, lager)
 		return true
 	}
 
@@ -512,21 +625,45 @@ func (k *Keepalived) dead(key string, prev liveness.State, leader bool) bool {
 		return true
 	}
 
+	policy, err := k.getKeepalivePolicy(ctx, entity)
+	if err != nil {
+		// Fall back to the keepalive timeouts of the entity
+		lager.WithError(err).Error("error while reading keepalive policies")
+	}
+
 	// this is a real keepalive event, emit it.
 	event := createKeepaliveEvent(currentEvent)
 	timeSinceLastSeen := time.Now().Unix() - entity.LastSeen
-	warningTimeout := int64(event.Check.Timeout)
-	criticalTimeout := event.Check.Ttl
 	var timeout int64
-	if warningTimeout != 0 && timeSinceLastSeen >= warningTimeout {
-		// warning keepalive
-		timeout = warningTimeout
-		event.Check.Status = 1
-	}
-	if criticalTimeout != 0 && timeSinceLastSeen >= criticalTimeout {
-		// critical keepalive
-		timeout = criticalTimeout
-		event.Check.Status = 2
+	if policy != nil {
+		lager = lager.WithField("keepalive_policy", policy.Name)
+		stage := policy.Stage(timeSinceLastSeen)
+		if stage == nil {
+			lager.Debug("first keepalive policy stage not reached yet")
+			return false
+		}
+		if stage.Deregister {
+			k.deregister(entity, lager)
+			return true
+		}
+		timeout = int64(stage.Timeout)
+		event.Check.Status = stage.Status
+		if len(stage.Handlers) > 0 {
+			event.Check.Handlers = stage.Handlers
+		}
+	} else {
+		warningTimeout := int64(event.Check.Timeout)
+		criticalTimeout := event.Check.Ttl
+		if warningTimeout != 0 && timeSinceLastSeen >= warningTimeout {
+			// warning keepalive
+			timeout = warningTimeout
+			event.Check.Status = 1
+		}
+		if criticalTimeout != 0 && timeSinceLastSeen >= criticalTimeout {
+			// critical keepalive
+			timeout = criticalTimeout
+			event.Check.Status = 2
+		}
 	}
 	event.Check.Output = fmt.Sprintf("No keepalive sent from %s for %v seconds (>= %v)", entity.Name, timeSinceLastSeen, timeout)
 
@@ -557,6 +694,38 @@ func (k *Keepalived) dead(key string, prev liveness.State, leader bool) bool {
 	return false
 }
 
+// deregister deregisters the entity after its keepalive timed out.
+func (k *Keepalived) deregister(entity *corev2.Entity, lager *logrus.Entry) {
+	deregisterer := &Deregistration{
+		EntityStore:  k.store,
+		EventStore:   k.eventStore,
+		MessageBus:   k.bus,
+		StoreTimeout: k.storeTimeout,
+	}
+	if err := deregisterer.Deregister(entity); err != nil {
+		lager.WithError(err).Error("error deregistering entity")
+	}
+	lager.Debug("deregistering entity")
+}
+
+// getKeepalivePolicy returns the first keepalive policy of the namespace of
+// the entity, by name, that applies to it, or nil if there is none.
+func (k *Keepalived) getKeepalivePolicy(ctx context.Context, entity *corev2.Entity) (*corev2.KeepalivePolicy, error) {
+	policies := []*corev2.KeepalivePolicy{}
+	tctx, cancel := context.WithTimeout(ctx, k.storeTimeout)
+	defer cancel()
+	if err := k.store.ListResources(tctx, corev2.KeepalivePoliciesResource, &policies, &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+	for _, policy := range policies {
+		if policy.Matches(entity) {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
 func parseKey(key string) (namespace, name string, err error) {
 	parts := strings.Split(key, "/")
 	if len(parts) != 2 {
@@ -566,22 +735,30 @@ func parseKey(key string) (namespace, name string, err error) {
 }
 
 // handleUpdate sets the entity's last seen time and publishes an OK check event
-// to the message bus.
-func (k *Keepalived) handleUpdate(e *corev2.Event) error {
+// to the message bus. The store update is batched if the keepalive only
+// refreshes the last seen time of the stored entity, and written immediately
+// otherwise, e.g. when the entity registers or changes.
+func (k *Keepalived) handleUpdate(e *corev2.Event, storedEntity *corev2.Entity) error {
 	entity := e.Entity
-
-	ctx := corev2.SetContextFromResource(context.Background(), entity)
-	if err := k.store.DeleteFailingKeepalive(ctx, e.Entity); err != nil {
-		// Warning: do not wrap this error
-		return err
-	}
-
 	entity.LastSeen = e.Timestamp
 
-	if err := k.store.UpdateEntity(ctx, entity); err != nil {
-		logger.WithError(err).Error("error updating entity in store")
-		// Warning: do not wrap this error
-		return err
+	ctx := corev2.SetContextFromResource(context.Background(), entity)
+	if k.batch != nil && storedEntity != nil && onlyLastSeenChanged(storedEntity, entity) {
+		k.batch.add(entity)
+	} else {
+		if k.batch != nil {
+			// The queued update is outdated
+			k.batch.remove(entity)
+		}
+		if err := k.store.DeleteFailingKeepalive(ctx, e.Entity); err != nil {
+			// Warning: do not wrap this error
+			return err
+		}
+		if err := k.store.UpdateEntity(ctx, entity); err != nil {
+			logger.WithError(err).Error("error updating entity in store")
+			// Warning: do not wrap this error
+			return err
+		}
 	}
 	event := createKeepaliveEvent(e)
 	event.Check.Status = 0
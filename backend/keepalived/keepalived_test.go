@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sensu/sensu-go/backend/liveness"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -261,7 +262,7 @@ func TestProcessRegistration(t *testing.T) {
 			require.NoError(t, err)
 
 			store.On("GetEntityByName", mock.Anything, "agent1").Return(tc.storeEntity, nil)
-			err = keepalived.handleEntityRegistration(tc.entity)
+			_, err = keepalived.handleEntityRegistration(tc.entity)
 			require.NoError(t, err)
 
 			assert.Equal(t, tc.expectedLen, len(tsub.ch))
@@ -368,3 +369,118 @@ func TestDeadCallbackNoEvent(t *testing.T) {
 		t.Fatalf("got bury: %v, want bury: %v", got, want)
 	}
 }
+
+func TestDeadCallbackKeepalivePolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		lastSeen     int64
+		wantStatus   uint32
+		wantHandlers []string
+		wantEvent    bool
+	}{
+		{
+			name:      "first stage not reached",
+			lastSeen:  60,
+			wantEvent: false,
+		},
+		{
+			name:         "first stage",
+			lastSeen:     200,
+			wantStatus:   1,
+			wantHandlers: []string{corev2.KeepaliveHandlerName},
+			wantEvent:    true,
+		},
+		{
+			name:         "second stage",
+			lastSeen:     600,
+			wantStatus:   2,
+			wantHandlers: []string{"pagerduty"},
+			wantEvent:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			messageBus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+			require.NoError(t, err)
+			require.NoError(t, messageBus.Start())
+			tsub := testSubscriber{
+				ch: make(chan interface{}, 1),
+			}
+			_, err = messageBus.Subscribe(messaging.TopicEventRaw, "testSubscriber", tsub)
+			require.NoError(t, err)
+
+			store := &mockstore.MockStore{}
+			keepalived, err := New(Config{
+				Store:           store,
+				EventStore:      store,
+				Bus:             messageBus,
+				LivenessFactory: fakeFactory,
+				WorkerCount:     1,
+				BufferSize:      1,
+				StoreTimeout:    time.Minute,
+			})
+			require.NoError(t, err)
+
+			entity := corev2.FixtureEntity("foo")
+			entity.EntityClass = corev2.EntityProxyClass
+			entity.LastSeen = time.Now().Unix() - tc.lastSeen
+			policy := corev2.FixtureKeepalivePolicy("policy")
+			policy.EntityClasses = nil
+
+			store.On("GetEntityByName", mock.Anything, "foo").Return(entity, nil)
+			store.On("GetEventByEntityCheck", mock.Anything, "foo", "keepalive").Return(corev2.FixtureEvent("foo", "keepalive"), nil)
+			store.On("ListResources", mock.Anything, corev2.KeepalivePoliciesResource, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(2).(*[]*corev2.KeepalivePolicy) = []*corev2.KeepalivePolicy{policy}
+				}).Return(nil)
+			store.On("UpdateFailingKeepalive", mock.Anything, entity, mock.AnythingOfType("int64")).Return(nil)
+
+			assert.False(t, keepalived.dead("default/foo", liveness.Alive, true))
+			if !tc.wantEvent {
+				assert.Equal(t, 0, len(tsub.ch))
+				return
+			}
+			require.Equal(t, 1, len(tsub.ch))
+			event := (<-tsub.ch).(*corev2.Event)
+			assert.Equal(t, tc.wantStatus, event.Check.Status)
+			assert.Equal(t, tc.wantHandlers, event.Check.Handlers)
+		})
+	}
+}
+
+func TestHandleEntityDeregistration(t *testing.T) {
+	test := newKeepalivedTest(t)
+	defer test.Dispose(t)
+
+	tsub := testSubscriber{
+		ch: make(chan interface{}, 1),
+	}
+	_, err := test.MessageBus.Subscribe(messaging.TopicEvent, "testSubscriber", tsub)
+	require.NoError(t, err)
+
+	entity := corev2.FixtureEntity("entity")
+	entity.Deregistration = corev2.Deregistration{Handler: "cmdb"}
+
+	test.Store.On("GetEntityByName", mock.Anything, "entity").Return(entity, nil)
+	test.Store.On("DeleteEntity", mock.Anything, entity).Return(nil)
+	test.Store.On("GetEventsByEntity", mock.Anything, "entity", mock.Anything).Return([]*corev2.Event{}, nil)
+
+	// The entity sent by agentd only holds the name and namespace
+	sent := &corev2.Entity{ObjectMeta: corev2.NewObjectMeta("entity", "default")}
+	require.NoError(t, test.Keepalived.handleEntityDeregistration(context.Background(), fakeLivenessInterface{}, sent))
+
+	test.Store.AssertCalled(t, "DeleteEntity", mock.Anything, entity)
+	require.Equal(t, 1, len(tsub.ch))
+	event := (<-tsub.ch).(*corev2.Event)
+	assert.Equal(t, []string{"cmdb"}, event.Check.Handlers)
+}
+
+func TestHandleKeepaliveDropped(t *testing.T) {
+	k := &Keepalived{}
+	dropped := testutil.ToFloat64(KeepalivesDropped)
+
+	assert.NoError(t, k.handleKeepalive(context.Background(), nil, "not an event"))
+	assert.NoError(t, k.handleKeepalive(context.Background(), nil, &corev2.Event{}))
+
+	assert.Equal(t, dropped+2, testutil.ToFloat64(KeepalivesDropped))
+}
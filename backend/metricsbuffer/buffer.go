@@ -0,0 +1,398 @@
+// Package metricsbuffer keeps the recent metrics of the events processed by
+// a backend in memory, downsampled to a fixed resolution, so that the series
+// of an event can be drawn without an external time series database.
+package metricsbuffer
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// DefaultResolution is the default interval of the downsampled points.
+	DefaultResolution = 5 * time.Minute
+
+	// DefaultRetention is the default duration during which the downsampled
+	// points are kept.
+	DefaultRetention = 24 * time.Hour
+
+	// DefaultMaxSeries is the default maximum number of series kept by a
+	// Buffer.
+	DefaultMaxSeries = 10000
+)
+
+// Config configures a Buffer.
+type Config struct {
+	// Resolution is the interval of the downsampled points,
+	// DefaultResolution if zero.
+	Resolution time.Duration
+
+	// Retention is the duration during which the downsampled points are
+	// kept, DefaultRetention if zero.
+	Retention time.Duration
+
+	// MaxSeries is the maximum number of series kept, DefaultMaxSeries if
+	// zero. The points of new series are dropped once it is reached.
+	MaxSeries int
+
+	// Flusher forwards the downsampled points of the completed intervals,
+	// if set.
+	Flusher Flusher
+}
+
+// Point is a metric point downsampled over an interval.
+type Point struct {
+	// Timestamp is the start of the interval, in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// Count is the number of points of the interval.
+	Count int64 `json:"count"`
+
+	// Min, Max and Mean are the minimum, maximum and mean values of the
+	// points of the interval.
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+}
+
+// Series is a downsampled series of metric points of an event.
+type Series struct {
+	// Namespace is the namespace of the event.
+	Namespace string `json:"namespace"`
+
+	// Entity is the name of the entity of the event.
+	Entity string `json:"entity"`
+
+	// Check is the name of the check of the event, if it has one.
+	Check string `json:"check,omitempty"`
+
+	// Name is the name of the metric points.
+	Name string `json:"name"`
+
+	// Tags are the tags of the metric points.
+	Tags []*corev2.MetricTag `json:"tags,omitempty"`
+
+	// Points are the downsampled points, oldest first.
+	Points []Point `json:"points"`
+}
+
+// bucket aggregates the points of an interval.
+type bucket struct {
+	start int64
+	count int64
+	sum   float64
+	min   float64
+	max   float64
+}
+
+// series is a ring of the buckets of a series.
+type series struct {
+	meta    Series
+	buckets []bucket
+
+	// last is the start of the most recent bucket, and flushed the start of
+	// the most recent bucket flushed.
+	last    int64
+	flushed int64
+}
+
+// add adds the value of a point to the bucket of its interval, unless the
+// interval was already overwritten by a more recent one.
+func (s *series) add(timestamp int64, value float64, resolution int64) {
+	start := timestamp - timestamp%resolution
+	b := &s.buckets[(start/resolution)%int64(len(s.buckets))]
+	if b.start > start {
+		return
+	}
+	if b.start < start {
+		*b = bucket{start: start, min: value, max: value}
+	}
+	b.count++
+	b.sum += value
+	b.min = math.Min(b.min, value)
+	b.max = math.Max(b.max, value)
+	if start > s.last {
+		s.last = start
+	}
+}
+
+// points returns the downsampled points of the intervals starting in
+// [from, to), oldest first.
+func (s *series) points(from, to int64) []Point {
+	points := []Point{}
+	for _, b := range s.buckets {
+		if b.count == 0 || b.start < from || b.start >= to {
+			continue
+		}
+		points = append(points, Point{
+			Timestamp: b.start,
+			Count:     b.count,
+			Min:       b.min,
+			Max:       b.max,
+			Mean:      b.sum / float64(b.count),
+		})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Timestamp < points[j].Timestamp
+	})
+	return points
+}
+
+// Buffer keeps the metric points of the events downsampled over the
+// intervals of its resolution, during its retention. It only holds the
+// metrics of the events processed by its backend.
+type Buffer struct {
+	resolution int64
+	retention  int64
+	maxSeries  int
+	flusher    Flusher
+
+	mu     sync.Mutex
+	events map[string]map[string]*series
+	count  int
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	errChan chan error
+	now     func() time.Time
+}
+
+// New creates a new Buffer.
+func New(c Config) *Buffer {
+	if c.Resolution < time.Second {
+		c.Resolution = DefaultResolution
+	}
+	if c.Retention == 0 {
+		c.Retention = DefaultRetention
+	}
+	if c.MaxSeries <= 0 {
+		c.MaxSeries = DefaultMaxSeries
+	}
+	b := &Buffer{
+		resolution: int64(c.Resolution / time.Second),
+		retention:  int64(c.Retention / time.Second),
+		maxSeries:  c.MaxSeries,
+		flusher:    c.Flusher,
+		events:     make(map[string]map[string]*series),
+		errChan:    make(chan error, 1),
+		now:        time.Now,
+	}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+	return b
+}
+
+// eventKey returns the key of the series of an event.
+func eventKey(namespace, entity, check string) string {
+	return strings.Join([]string{namespace, entity, check}, "\x00")
+}
+
+// tagsKey returns the key of the tags of a series, independent of their
+// order.
+func tagsKey(tags []*corev2.MetricTag) string {
+	pairs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag != nil {
+			pairs = append(pairs, tag.Name+"="+tag.Value)
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\x00")
+}
+
+// Add adds the metric points of an event. The points older than the
+// retention, in the future or without a finite value are ignored. Adding to
+// a nil Buffer does nothing.
+func (b *Buffer) Add(event *corev2.Event) {
+	if b == nil || !event.HasMetrics() || event.Entity == nil {
+		return
+	}
+	now := b.now()
+	oldest := now.Unix() - b.retention
+	check := ""
+	if event.HasCheck() {
+		check = event.Check.Name
+	}
+	key := eventKey(event.Entity.Namespace, event.Entity.Name, check)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	dropped := 0
+	for _, point := range event.Metrics.Points {
+		if point == nil || math.IsNaN(point.Value) || math.IsInf(point.Value, 0) {
+			continue
+		}
+		timestamp := pointTime(point.Timestamp, now)
+		if timestamp < oldest || timestamp > now.Unix() {
+			continue
+		}
+		name := point.Name + "\x00" + tagsKey(point.Tags)
+		s, ok := b.events[key][name]
+		if !ok {
+			if b.count >= b.maxSeries {
+				dropped++
+				continue
+			}
+			s = &series{
+				meta: Series{
+					Namespace: event.Entity.Namespace,
+					Entity:    event.Entity.Name,
+					Check:     check,
+					Name:      point.Name,
+					Tags:      point.Tags,
+				},
+				buckets: make([]bucket, b.retention/b.resolution+1),
+			}
+			if b.events[key] == nil {
+				b.events[key] = make(map[string]*series)
+			}
+			b.events[key][name] = s
+			b.count++
+		}
+		s.add(timestamp, point.Value, b.resolution)
+	}
+	if dropped > 0 {
+		logger.WithField("entity", event.Entity.Name).Warnf("metrics buffer full, dropped %d metric points", dropped)
+	}
+}
+
+// Series returns the series of the metrics of the events of a check and an
+// entity over the retention, ordered by name and tags. The series of the
+// events without check are returned when check is empty. A nil Buffer has no
+// series.
+func (b *Buffer) Series(namespace, entity, check string) []*Series {
+	result := []*Series{}
+	if b == nil {
+		return result
+	}
+	oldest := b.now().Unix() - b.retention
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	event := b.events[eventKey(namespace, entity, check)]
+	keys := make([]string, 0, len(event))
+	for key := range event {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := event[key]
+		points := s.points(oldest, math.MaxInt64)
+		if len(points) == 0 {
+			continue
+		}
+		series := s.meta
+		series.Points = points
+		result = append(result, &series)
+	}
+	return result
+}
+
+// flush forwards the points of the intervals completed since the previous
+// flush to the flusher, and forgets the series without points over the
+// retention. The points added to an interval after its flush are kept, but
+// not forwarded.
+func (b *Buffer) flush(ctx context.Context) {
+	now := b.now().Unix()
+	current := now - now%b.resolution
+	oldest := now - b.retention
+
+	var flushed []*Series
+	b.mu.Lock()
+	for key, event := range b.events {
+		for name, s := range event {
+			if s.last < oldest {
+				delete(event, name)
+				b.count--
+				continue
+			}
+			if b.flusher == nil {
+				continue
+			}
+			from := s.flushed + 1
+			if from < oldest {
+				from = oldest
+			}
+			points := s.points(from, current)
+			if len(points) == 0 {
+				continue
+			}
+			s.flushed = points[len(points)-1].Timestamp
+			series := s.meta
+			series.Points = points
+			flushed = append(flushed, &series)
+		}
+		if len(event) == 0 {
+			delete(b.events, key)
+		}
+	}
+	b.mu.Unlock()
+
+	if len(flushed) == 0 {
+		return
+	}
+	if err := b.flusher.Flush(ctx, flushed); err != nil {
+		logger.WithError(err).Error("could not flush the metrics buffer")
+	}
+}
+
+// Start flushes the buffer at the end of every interval, in the background.
+func (b *Buffer) Start() error {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(time.Duration(b.resolution) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.ctx.Done():
+				return
+			case <-ticker.C:
+				b.flush(b.ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the Buffer.
+func (b *Buffer) Stop() error {
+	b.cancel()
+	b.wg.Wait()
+	close(b.errChan)
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (b *Buffer) Err() <-chan error {
+	return b.errChan
+}
+
+// Name returns the daemon name
+func (b *Buffer) Name() string {
+	return "metricsbuffer"
+}
+
+// pointTime returns the time in seconds since the epoch of a metric point
+// timestamp, which the agents express in seconds, milliseconds, microseconds
+// or nanoseconds. Points without timestamp are of the current time.
+func pointTime(timestamp int64, now time.Time) int64 {
+	switch {
+	case timestamp <= 0:
+		return now.Unix()
+	case timestamp < 1e12:
+		return timestamp
+	case timestamp < 1e15:
+		return timestamp / 1e3
+	case timestamp < 1e18:
+		return timestamp / 1e6
+	default:
+		return timestamp / 1e9
+	}
+}
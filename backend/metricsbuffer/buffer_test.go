@@ -0,0 +1,115 @@
+package metricsbuffer
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFlusher struct {
+	series []*Series
+}
+
+func (f *fakeFlusher) Flush(ctx context.Context, series []*Series) error {
+	f.series = append(f.series, series...)
+	return nil
+}
+
+func metricsEvent(entity, check string, points ...*corev2.MetricPoint) *corev2.Event {
+	event := &corev2.Event{
+		Entity:  &corev2.Entity{ObjectMeta: corev2.NewObjectMeta(entity, "default")},
+		Metrics: &corev2.Metrics{Points: points},
+	}
+	if check != "" {
+		event.Check = &corev2.Check{ObjectMeta: corev2.NewObjectMeta(check, "default")}
+	}
+	return event
+}
+
+func point(name string, value float64, timestamp int64, tags ...*corev2.MetricTag) *corev2.MetricPoint {
+	return &corev2.MetricPoint{Name: name, Value: value, Timestamp: timestamp, Tags: tags}
+}
+
+// base is a time in seconds since the epoch, aligned on the minute.
+const base = 1599900000
+
+func newTestBuffer(c Config, now *time.Time) *Buffer {
+	b := New(c)
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestBufferSeries(t *testing.T) {
+	now := time.Unix(base+100000, 0)
+	b := newTestBuffer(Config{Resolution: time.Minute, Retention: time.Hour}, &now)
+
+	b.Add(metricsEvent("foo", "check_cpu",
+		point("cpu", 1, base+99960),
+		point("cpu", 3, (base+99990)*1000), // milliseconds
+		point("cpu", 5, base+99900),
+		point("cpu", 7, base+99950, &corev2.MetricTag{Name: "core", Value: "1"}),
+		point("cpu", math.NaN(), base+99960),
+		point("cpu", 9, base+90000),  // older than the retention
+		point("cpu", 9, base+100100), // in the future
+	))
+	b.Add(metricsEvent("foo", "", point("mem", 1, base+99960)))
+
+	series := b.Series("default", "foo", "check_cpu")
+	require.Len(t, series, 2)
+	assert.Equal(t, "cpu", series[0].Name)
+	assert.Empty(t, series[0].Tags)
+	assert.Equal(t, []Point{
+		{Timestamp: base + 99900, Count: 1, Min: 5, Max: 5, Mean: 5},
+		{Timestamp: base + 99960, Count: 2, Min: 1, Max: 3, Mean: 2},
+	}, series[0].Points)
+	assert.Equal(t, "core", series[1].Tags[0].Name)
+
+	series = b.Series("default", "foo", "")
+	require.Len(t, series, 1)
+	assert.Equal(t, "mem", series[0].Name)
+
+	assert.Empty(t, b.Series("default", "bar", "check_cpu"))
+	var nilBuffer *Buffer
+	nilBuffer.Add(metricsEvent("foo", "check_cpu", point("cpu", 1, 0)))
+	assert.Empty(t, nilBuffer.Series("default", "foo", "check_cpu"))
+}
+
+func TestBufferMaxSeries(t *testing.T) {
+	now := time.Unix(base+100000, 0)
+	b := newTestBuffer(Config{MaxSeries: 1}, &now)
+	b.Add(metricsEvent("foo", "check_cpu", point("cpu", 1, 0), point("load", 1, 0)))
+	b.Add(metricsEvent("bar", "check_cpu", point("cpu", 1, 0)))
+
+	assert.Len(t, b.Series("default", "foo", "check_cpu"), 1)
+	assert.Empty(t, b.Series("default", "bar", "check_cpu"))
+}
+
+func TestBufferFlush(t *testing.T) {
+	now := time.Unix(base+100000, 0)
+	flusher := &fakeFlusher{}
+	b := newTestBuffer(Config{Resolution: time.Minute, Retention: time.Hour, Flusher: flusher}, &now)
+
+	b.Add(metricsEvent("foo", "check_cpu", point("cpu", 1, base+99900), point("cpu", 2, base+99990)))
+	b.flush(context.Background())
+
+	// The current interval is not flushed until completed
+	require.Len(t, flusher.series, 1)
+	assert.Equal(t, []Point{{Timestamp: base + 99900, Count: 1, Min: 1, Max: 1, Mean: 1}}, flusher.series[0].Points)
+
+	now = now.Add(time.Minute)
+	b.flush(context.Background())
+	require.Len(t, flusher.series, 2)
+	assert.Equal(t, []Point{{Timestamp: base + 99960, Count: 1, Min: 2, Max: 2, Mean: 2}}, flusher.series[1].Points)
+
+	// The series without points over the retention are forgotten
+	now = now.Add(2 * time.Hour)
+	b.flush(context.Background())
+	assert.Len(t, flusher.series, 2)
+	assert.Empty(t, b.events)
+	assert.Equal(t, 0, b.count)
+}
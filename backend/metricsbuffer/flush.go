@@ -0,0 +1,58 @@
+package metricsbuffer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// flushTimeout is the timeout of the requests of the HTTPFlusher.
+const flushTimeout = 30 * time.Second
+
+// Flusher forwards the downsampled points of the completed intervals to an
+// external storage. The points are forwarded at most once.
+type Flusher interface {
+	Flush(ctx context.Context, series []*Series) error
+}
+
+// HTTPFlusher posts the downsampled series as a JSON array to a URL.
+type HTTPFlusher struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPFlusher creates a new HTTPFlusher posting to url.
+func NewHTTPFlusher(url string) *HTTPFlusher {
+	return &HTTPFlusher{
+		url:    url,
+		client: &http.Client{Timeout: flushTimeout},
+	}
+}
+
+// Flush posts the series.
+func (f *HTTPFlusher) Flush(ctx context.Context, series []*Series) error {
+	body, err := json.Marshal(series)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := f.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s returned %s", req.URL.Host, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package metricsbuffer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPFlusher(t *testing.T) {
+	var received []*Series
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	series := []*Series{{Namespace: "default", Entity: "foo", Name: "cpu", Points: []Point{{Timestamp: 60, Count: 1}}}}
+	require.NoError(t, NewHTTPFlusher(server.URL).Flush(context.Background(), series))
+	assert.Equal(t, series, received)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	assert.Error(t, NewHTTPFlusher(server.URL).Flush(context.Background(), series))
+}
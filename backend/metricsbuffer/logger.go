@@ -0,0 +1,9 @@
+package metricsbuffer
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "metricsbuffer",
+})
@@ -0,0 +1,97 @@
+// Package nstemplate provisions the namespaces created through the API with
+// the resources of the namespace templates.
+package nstemplate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sirupsen/logrus"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "nstemplate",
+})
+
+// kinds are the resource types the namespace templates can copy, by RBAC
+// name.
+var kinds = map[string]corev2.Resource{
+	"assets":       &corev2.Asset{},
+	"checks":       &corev2.CheckConfig{},
+	"filters":      &corev2.EventFilter{},
+	"handlers":     &corev2.Handler{},
+	"hooks":        &corev2.HookConfig{},
+	"mutators":     &corev2.Mutator{},
+	"rolebindings": &corev2.RoleBinding{},
+	"roles":        &corev2.Role{},
+}
+
+// Provisioner copies the resources of the namespace templates to the new
+// namespaces.
+type Provisioner struct {
+	Store store.Store
+}
+
+// Provision copies the resources of the templates matching the given
+// namespace, in the order of the names of the templates, to the namespace.
+// The resources already in the namespace, e.g. copied by a previous template,
+// are left as they are.
+func (p *Provisioner) Provision(ctx context.Context, namespace string) error {
+	var templates []*corev2.NamespaceTemplate
+	err := p.Store.ListResources(store.NamespaceContext(ctx, ""), corev2.NamespaceTemplatesResource, &templates, &store.SelectionPredicate{})
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		if !template.Matches(namespace) {
+			continue
+		}
+		if err := p.apply(ctx, template, namespace); err != nil {
+			return fmt.Errorf("namespace template %q: %s", template.Name, err)
+		}
+	}
+	return nil
+}
+
+// apply copies the resources of the given template to the namespace.
+func (p *Provisioner) apply(ctx context.Context, template *corev2.NamespaceTemplate, namespace string) error {
+	sourceCtx := store.NamespaceContext(ctx, template.Source)
+	targetCtx := store.NamespaceContext(ctx, namespace)
+
+	for _, kind := range template.ResourceTypes() {
+		resource, ok := kinds[kind]
+		if !ok {
+			continue
+		}
+		list := reflect.New(reflect.SliceOf(reflect.TypeOf(resource)))
+		if err := p.Store.ListResources(sourceCtx, resource.StorePrefix(), list.Interface(), &store.SelectionPredicate{}); err != nil {
+			return err
+		}
+
+		for i := 0; i < list.Elem().Len(); i++ {
+			copy := list.Elem().Index(i).Interface().(corev2.Resource)
+			meta := copy.GetObjectMeta()
+			meta.Namespace = namespace
+			meta.ResourceVersion = ""
+			copy.SetObjectMeta(meta)
+
+			switch err := p.Store.CreateResource(targetCtx, copy); err.(type) {
+			case nil:
+				logger.WithFields(logrus.Fields{
+					"namespace_template": template.Name,
+					"namespace":          namespace,
+					"resource":           kind,
+					"name":               meta.Name,
+				}).Info("provisioned resource")
+			case *store.ErrAlreadyExists:
+			default:
+				return fmt.Errorf("could not copy %s %q: %s", kind, meta.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package nstemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTemplates mocks a "team" template copying the handlers and role
+// bindings of the "template" namespace to the "team-*" namespaces.
+func mockTemplates(s *mockstore.MockStore) {
+	template := corev2.FixtureNamespaceTemplate("team")
+	template.Resources = []string{"handlers", "rolebindings"}
+	template.Namespaces = []string{"team-*"}
+
+	s.On("ListResources", mock.Anything, corev2.NamespaceTemplatesResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]*corev2.NamespaceTemplate) = []*corev2.NamespaceTemplate{template}
+		}).Return(nil)
+	s.On("ListResources", mock.Anything, "handlers", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			if store.NewNamespaceFromContext(args.Get(0).(context.Context)) != "template" {
+				return
+			}
+			handler := corev2.FixtureHandler("slack")
+			handler.Namespace = "template"
+			handler.ResourceVersion = "42"
+			*args.Get(2).(*[]*corev2.Handler) = []*corev2.Handler{handler}
+		}).Return(nil)
+	s.On("ListResources", mock.Anything, "rbac/rolebindings", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			binding := corev2.FixtureRoleBinding("team-admin", "template")
+			*args.Get(2).(*[]*corev2.RoleBinding) = []*corev2.RoleBinding{binding}
+		}).Return(nil)
+}
+
+func TestProvision(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockTemplates(s)
+	var created []corev2.Resource
+	s.On("CreateResource", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			created = append(created, args.Get(1).(corev2.Resource))
+		}).Return(nil)
+
+	provisioner := &Provisioner{Store: s}
+	require.NoError(t, provisioner.Provision(context.Background(), "team-a"))
+	require.Len(t, created, 2)
+	handler := created[0].(*corev2.Handler)
+	assert.Equal(t, "slack", handler.Name)
+	assert.Equal(t, "team-a", handler.Namespace)
+	assert.Zero(t, handler.ResourceVersion)
+	assert.Equal(t, "team-a", created[1].GetObjectMeta().Namespace)
+
+	// The other namespaces are not provisioned
+	created = nil
+	require.NoError(t, provisioner.Provision(context.Background(), "ops"))
+	assert.Empty(t, created)
+}
+
+func TestProvisionExisting(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockTemplates(s)
+	s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.Handler")).Return(&store.ErrAlreadyExists{})
+	s.On("CreateResource", mock.Anything, mock.AnythingOfType("*v2.RoleBinding")).Return(errors.New("etcd is down"))
+
+	provisioner := &Provisioner{Store: s}
+	err := provisioner.Provision(context.Background(), "team-a")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `rolebindings "team-admin"`)
+}
+
+func TestStore(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockTemplates(s)
+	s.On("GetResource", mock.Anything, "team-a", mock.Anything).Return(&store.ErrNotFound{})
+	s.On("GetResource", mock.Anything, "team-b", mock.Anything).Return(nil)
+	s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+	s.On("CreateResource", mock.Anything, mock.Anything).Return(nil)
+
+	nstemplate := NewStore(s)
+	require.NoError(t, nstemplate.CreateOrUpdateResource(context.Background(), corev2.FixtureNamespace("team-a")))
+	s.AssertNumberOfCalls(t, "CreateResource", 2)
+
+	// The existing namespaces are not provisioned again
+	require.NoError(t, nstemplate.CreateOrUpdateResource(context.Background(), corev2.FixtureNamespace("team-b")))
+	s.AssertNumberOfCalls(t, "CreateResource", 2)
+
+	require.NoError(t, nstemplate.CreateResource(context.Background(), corev2.FixtureNamespace("team-c")))
+	s.AssertNumberOfCalls(t, "CreateResource", 5)
+}
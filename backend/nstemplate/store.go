@@ -0,0 +1,71 @@
+package nstemplate
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// Store is a store that provisions the namespaces created with its
+// CreateResource and CreateOrUpdateResource methods with the resources of the
+// namespace templates.
+type Store struct {
+	store.Store
+	provisioner *Provisioner
+}
+
+// NewStore returns a store provisioning the namespaces it creates in the
+// given store.
+func NewStore(s store.Store) store.Store {
+	nstemplate := &Store{Store: s, provisioner: &Provisioner{Store: s}}
+	return store.WrapWatcher(nstemplate, s)
+}
+
+// CreateResource creates the given resource, and provisions it if it is a
+// namespace.
+func (s *Store) CreateResource(ctx context.Context, resource corev2.Resource) error {
+	if err := s.Store.CreateResource(ctx, resource); err != nil {
+		return err
+	}
+	if namespace, ok := resource.(*corev2.Namespace); ok {
+		return s.provision(ctx, namespace)
+	}
+	return nil
+}
+
+// CreateOrUpdateResource creates or updates the given resource, and
+// provisions it if it is a namespace that did not exist.
+func (s *Store) CreateOrUpdateResource(ctx context.Context, resource corev2.Resource) error {
+	namespace, ok := resource.(*corev2.Namespace)
+	if !ok {
+		return s.Store.CreateOrUpdateResource(ctx, resource)
+	}
+
+	var created bool
+	switch err := s.Store.GetResource(ctx, namespace.Name, &corev2.Namespace{}); err.(type) {
+	case nil:
+	case *store.ErrNotFound:
+		created = true
+	default:
+		return err
+	}
+
+	if err := s.Store.CreateOrUpdateResource(ctx, resource); err != nil {
+		return err
+	}
+	if created {
+		return s.provision(ctx, namespace)
+	}
+	return nil
+}
+
+// provision provisions the given namespace, which was just created. The
+// namespace is kept if it could not be provisioned.
+func (s *Store) provision(ctx context.Context, namespace *corev2.Namespace) error {
+	if err := s.provisioner.Provision(ctx, namespace.Name); err != nil {
+		logger.WithError(err).WithField("namespace", namespace.Name).Error("could not provision namespace")
+		return &store.ErrInternal{Message: "namespace created but not provisioned: " + err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package pipeline
+
+import (
+	"context"
+	"path"
+	"sync"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// HandlerLimiter limits the number of concurrent executions of every
+// handler. It is shared by the pipelines of a backend, so that a slow handler
+// can only use some of their workers.
+type HandlerLimiter struct {
+	limit int
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+// NewHandlerLimiter creates a HandlerLimiter allowing up to limit concurrent
+// executions of every handler. The executions are not limited if limit is
+// zero or negative.
+func NewHandlerLimiter(limit int) *HandlerLimiter {
+	return &HandlerLimiter{
+		limit: limit,
+		slots: make(map[string]chan struct{}),
+	}
+}
+
+// acquire waits until handler can be executed, or ctx is done. The returned
+// function must be called once the execution is over.
+func (l *HandlerLimiter) acquire(ctx context.Context, handler *corev2.Handler) (func(), error) {
+	if l == nil || l.limit <= 0 {
+		return func() {}, nil
+	}
+	key := path.Join(handler.Namespace, handler.Name)
+
+	l.mu.Lock()
+	slots, ok := l.slots[key]
+	if !ok {
+		slots = make(chan struct{}, l.limit)
+		l.slots[key] = slots
+	}
+	l.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,43 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerLimiter(t *testing.T) {
+	limiter := NewHandlerLimiter(1)
+	handler1 := corev2.FixtureHandler("handler1")
+	handler2 := corev2.FixtureHandler("handler2")
+
+	release, err := limiter.acquire(context.Background(), handler1)
+	require.NoError(t, err)
+
+	// Other handlers are not limited by the executions of handler1
+	release2, err := limiter.acquire(context.Background(), handler2)
+	require.NoError(t, err)
+	release2()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = limiter.acquire(ctx, handler1)
+	assert.Equal(t, context.Canceled, err)
+
+	release()
+	release, err = limiter.acquire(context.Background(), handler1)
+	require.NoError(t, err)
+	release()
+}
+
+func TestHandlerLimiterUnlimited(t *testing.T) {
+	for _, limiter := range []*HandlerLimiter{nil, NewHandlerLimiter(0)} {
+		for i := 0; i < 3; i++ {
+			_, err := limiter.acquire(context.Background(), corev2.FixtureHandler("handler1"))
+			require.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/js"
+	"github.com/sensu/sensu-go/types/dynamic"
+	utillogging "github.com/sensu/sensu-go/util/logging"
+)
+
+const (
+	// CorrelationAnnotation is the annotation of the events handled as the
+	// first event of a group, set to the name of the correlation.
+	CorrelationAnnotation = "sensu.io/correlation"
+
+	// CorrelationGroupAnnotation is the annotation of the events handled as
+	// the first event of a group, set to the group of the event.
+	CorrelationGroupAnnotation = "sensu.io/correlation-group"
+
+	// correlationGroupsSweepSize is the number of groups above which the
+	// closed groups are removed.
+	correlationGroupsSweepSize = 10000
+)
+
+// correlateEvent groups event with the related events handled by handler,
+// according to the correlation of handler. It returns true if the event
+// belongs to an open group, in which case it must not be handled. Otherwise it
+// returns a copy of event annotated with its correlation and group, which
+// opens a new group. Resolutions are never grouped.
+func (p *Pipeline) correlateEvent(ctx context.Context, handler *corev2.Handler, event *corev2.Event) (*corev2.Event, bool, error) {
+	if handler.Correlation == "" || !event.HasCheck() || event.IsResolution() {
+		return event, false, nil
+	}
+
+	// Prepare log entry
+	fields := utillogging.EventFields(event, false)
+	fields["handler"] = handler.Name
+	fields["correlation"] = handler.Correlation
+
+	correlation := &corev2.Correlation{}
+	tctx, cancel := context.WithTimeout(ctx, p.storeTimeout)
+	err := p.store.GetResource(tctx, handler.Correlation, correlation)
+	cancel()
+	if err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			logger.WithFields(fields).Warn("correlation not found, skipping")
+			return event, false, nil
+		}
+		// Warning: do not wrap this error
+		logger.WithFields(fields).WithError(err).Error("failed to retrieve correlation")
+		return nil, false, err
+	}
+
+	group, err := correlationGroup(correlation, event)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to group event")
+		return event, false, nil
+	}
+	if group == "" {
+		return event, false, nil
+	}
+	fields["group"] = group
+
+	key := path.Join(handler.Namespace, handler.Name, correlation.Name, group)
+	window := time.Duration(correlation.Window) * time.Second
+	if p.correlationGroups.add(key, time.Now(), window) {
+		logger.WithFields(fields).Info("event correlated with an open group")
+		return nil, true, nil
+	}
+
+	annotations := make(map[string]string, len(event.Annotations)+2)
+	for k, v := range event.Annotations {
+		annotations[k] = v
+	}
+	annotations[CorrelationAnnotation] = correlation.Name
+	annotations[CorrelationGroupAnnotation] = group
+
+	correlated := *event
+	correlated.Annotations = annotations
+	return &correlated, false, nil
+}
+
+// correlationGroup returns the group of event according to correlation, or an
+// empty string if the event does not belong to any group.
+func correlationGroup(correlation *corev2.Correlation, event *corev2.Event) (string, error) {
+	switch correlation.GroupBy {
+	case corev2.CorrelationGroupByEntity:
+		return event.Entity.Name, nil
+	case corev2.CorrelationGroupByLabels:
+		values := make([]string, 0, len(correlation.LabelKeys))
+		for _, key := range correlation.LabelKeys {
+			value, ok := event.Check.Labels[key]
+			if !ok {
+				value, ok = event.Entity.Labels[key]
+			}
+			if !ok {
+				return "", nil
+			}
+			values = append(values, fmt.Sprintf("%s=%s", key, value))
+		}
+		sort.Strings(values)
+		return strings.Join(values, ","), nil
+	case corev2.CorrelationGroupByExpression:
+		parameters := map[string]interface{}{"event": dynamic.Synthesize(event)}
+		return js.EvaluateString(correlation.Expression, parameters, nil)
+	default:
+		return "", errors.New("invalid correlation group by")
+	}
+}
+
+// CorrelationGroups holds the groups of events opened by correlations. It is
+// shared by the pipelines of a backend, so that every group is opened once.
+type CorrelationGroups struct {
+	mu     sync.Mutex
+	closes map[string]time.Time
+}
+
+// NewCorrelationGroups creates an empty CorrelationGroups.
+func NewCorrelationGroups() *CorrelationGroups {
+	return &CorrelationGroups{closes: make(map[string]time.Time)}
+}
+
+// add adds an event received at now to the group key. It returns true if the
+// group was already open, otherwise it opens the group for window.
+func (g *CorrelationGroups) add(key string, now time.Time, window time.Duration) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if closes, ok := g.closes[key]; ok && now.Before(closes) {
+		return true
+	}
+	if len(g.closes) >= correlationGroupsSweepSize {
+		for k, closes := range g.closes {
+			if !now.Before(closes) {
+				delete(g.closes, k)
+			}
+		}
+	}
+	g.closes[key] = now.Add(window)
+	return false
+}
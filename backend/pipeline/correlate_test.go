@@ -0,0 +1,185 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockCorrelation(s *mockstore.MockStore, correlation *corev2.Correlation) {
+	s.On("GetResource", mock.Anything, correlation.Name, mock.AnythingOfType("*v2.Correlation")).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*corev2.Correlation) = *correlation
+		}).Return(nil)
+}
+
+func TestCorrelateEvent(t *testing.T) {
+	correlation := corev2.FixtureCorrelation("by-entity")
+
+	s := &mockstore.MockStore{}
+	mockCorrelation(s, correlation)
+
+	p := New(Config{Store: s})
+	handler := corev2.FixtureHandler("handler1")
+	handler.Correlation = "by-entity"
+
+	event := corev2.FixtureEvent("foo", "check1")
+	event.Annotations = map[string]string{"foo": "bar"}
+
+	// The first event opens a group
+	correlated, suppressed, err := p.correlateEvent(context.Background(), handler, event)
+	require.NoError(t, err)
+	assert.False(t, suppressed)
+	assert.Equal(t, map[string]string{
+		"foo":                      "bar",
+		CorrelationAnnotation:      "by-entity",
+		CorrelationGroupAnnotation: "foo",
+	}, correlated.Annotations)
+
+	// The original event is left untouched
+	assert.Equal(t, map[string]string{"foo": "bar"}, event.Annotations)
+
+	// The events of the open group are suppressed
+	event2 := corev2.FixtureEvent("foo", "check2")
+	_, suppressed, err = p.correlateEvent(context.Background(), handler, event2)
+	require.NoError(t, err)
+	assert.True(t, suppressed)
+
+	// The events of other groups are not
+	event3 := corev2.FixtureEvent("bar", "check1")
+	_, suppressed, err = p.correlateEvent(context.Background(), handler, event3)
+	require.NoError(t, err)
+	assert.False(t, suppressed)
+
+	// Neither are the resolutions
+	event4 := corev2.FixtureEvent("foo", "check1")
+	event4.Check.History = []corev2.CheckHistory{{Status: 2}, {Status: 0}}
+	correlated, suppressed, err = p.correlateEvent(context.Background(), handler, event4)
+	require.NoError(t, err)
+	assert.False(t, suppressed)
+	assert.Equal(t, event4, correlated)
+}
+
+func TestCorrelateEventSharedGroups(t *testing.T) {
+	correlation := corev2.FixtureCorrelation("by-entity")
+
+	s := &mockstore.MockStore{}
+	mockCorrelation(s, correlation)
+
+	groups := NewCorrelationGroups()
+	p1 := New(Config{Store: s, CorrelationGroups: groups})
+	p2 := New(Config{Store: s, CorrelationGroups: groups})
+	handler := corev2.FixtureHandler("handler1")
+	handler.Correlation = "by-entity"
+
+	_, suppressed, err := p1.correlateEvent(context.Background(), handler, corev2.FixtureEvent("foo", "check1"))
+	require.NoError(t, err)
+	assert.False(t, suppressed)
+
+	// The group opened by a pipeline is open for the others
+	_, suppressed, err = p2.correlateEvent(context.Background(), handler, corev2.FixtureEvent("foo", "check2"))
+	require.NoError(t, err)
+	assert.True(t, suppressed)
+}
+
+func TestCorrelateEventErrors(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "missing", mock.Anything).Return(&store.ErrNotFound{Key: "missing"})
+	s.On("GetResource", mock.Anything, "broken", mock.Anything).Return(&store.ErrInternal{Message: "boom"})
+
+	p := New(Config{Store: s})
+	handler := corev2.FixtureHandler("handler1")
+	event := corev2.FixtureEvent("foo", "check1")
+
+	// A missing correlation does not prevent handling the event
+	handler.Correlation = "missing"
+	correlated, suppressed, err := p.correlateEvent(context.Background(), handler, event)
+	require.NoError(t, err)
+	assert.False(t, suppressed)
+	assert.Equal(t, event, correlated)
+
+	handler.Correlation = "broken"
+	_, _, err = p.correlateEvent(context.Background(), handler, event)
+	_, ok := err.(*store.ErrInternal)
+	assert.True(t, ok)
+}
+
+func TestCorrelationGroup(t *testing.T) {
+	event := corev2.FixtureEvent("foo", "check1")
+	event.Entity.Labels = map[string]string{"region": "us-west", "team": "ops"}
+	event.Check.Labels = map[string]string{"team": "dev"}
+
+	tests := []struct {
+		name        string
+		correlation *corev2.Correlation
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "entity",
+			correlation: &corev2.Correlation{GroupBy: corev2.CorrelationGroupByEntity},
+			want:        "foo",
+		},
+		{
+			name: "labels",
+			correlation: &corev2.Correlation{
+				GroupBy:   corev2.CorrelationGroupByLabels,
+				LabelKeys: []string{"team", "region"},
+			},
+			want: "region=us-west,team=dev",
+		},
+		{
+			name: "missing label",
+			correlation: &corev2.Correlation{
+				GroupBy:   corev2.CorrelationGroupByLabels,
+				LabelKeys: []string{"datacenter"},
+			},
+			want: "",
+		},
+		{
+			name: "expression",
+			correlation: &corev2.Correlation{
+				GroupBy:    corev2.CorrelationGroupByExpression,
+				Expression: "event.check.name + '/' + event.entity.labels.region",
+			},
+			want: "check1/us-west",
+		},
+		{
+			name: "invalid group by",
+			correlation: &corev2.Correlation{
+				GroupBy: "check",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := correlationGroup(tt.correlation, event)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("correlationGroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCorrelationGroupsAdd(t *testing.T) {
+	groups := NewCorrelationGroups()
+	now := time.Unix(1000, 0)
+	window := time.Minute
+
+	assert.False(t, groups.add("a", now, window))
+	assert.True(t, groups.add("a", now.Add(30*time.Second), window))
+
+	// The window starts with the first event of the group
+	assert.False(t, groups.add("a", now.Add(80*time.Second), window))
+	assert.True(t, groups.add("a", now.Add(130*time.Second), window))
+	assert.False(t, groups.add("b", now, window))
+}
@@ -0,0 +1,229 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	utillogging "github.com/sensu/sensu-go/util/logging"
+)
+
+const (
+	// DefaultEnricherTimeout is the timeout of the enrichers without a
+	// timeout.
+	DefaultEnricherTimeout = 10 * time.Second
+
+	// maxEnrichmentSize is the maximum size of the responses of enrichers.
+	maxEnrichmentSize = 1 << 20
+
+	// enrichmentCacheSize is the maximum number of cached metadata.
+	enrichmentCacheSize = 10000
+)
+
+// enrichEvent calls the enrichers of handler, and returns a copy of event
+// with the metadata they returned added to its annotations. Enrichers that
+// fail are logged and skipped, so that the event is still handled.
+func (p *Pipeline) enrichEvent(ctx context.Context, handler *corev2.Handler, event *corev2.Event) (*corev2.Event, error) {
+	if len(handler.Enrichers) == 0 {
+		return event, nil
+	}
+
+	// Prepare log entry
+	fields := utillogging.EventFields(event, false)
+	fields["handler"] = handler.Name
+
+	annotations := make(map[string]string, len(event.Annotations))
+	for key, value := range event.Annotations {
+		annotations[key] = value
+	}
+
+	for _, name := range handler.Enrichers {
+		fields["enricher"] = name
+
+		enricher := &corev2.Enricher{}
+		tctx, cancel := context.WithTimeout(ctx, p.storeTimeout)
+		err := p.store.GetResource(tctx, name, enricher)
+		cancel()
+		if err != nil {
+			if _, ok := err.(*store.ErrNotFound); ok {
+				logger.WithFields(fields).Warn("enricher not found, skipping")
+				continue
+			}
+			// Warning: do not wrap this error
+			logger.WithFields(fields).WithError(err).Error("failed to retrieve enricher")
+			return nil, err
+		}
+
+		metadata, err := p.enrichment(enricher, event)
+		if err != nil {
+			logger.WithFields(fields).WithError(err).Error("failed to enrich event")
+			continue
+		}
+		for key, value := range metadata {
+			annotations[enricher.AnnotationPrefix+key] = value
+		}
+	}
+
+	enriched := *event
+	enriched.Annotations = annotations
+	return &enriched, nil
+}
+
+// enrichment returns the metadata of event provided by enricher, from the
+// enrichment cache if possible.
+func (p *Pipeline) enrichment(enricher *corev2.Enricher, event *corev2.Event) (map[string]string, error) {
+	key := path.Join(enricher.Namespace, enricher.Name, event.Entity.Name)
+	if enricher.CacheKey == corev2.EnricherCacheKeyCheck && event.HasCheck() {
+		key = path.Join(key, event.Check.Name)
+	}
+
+	now := time.Now()
+	if enricher.CacheTTL > 0 {
+		if metadata, ok := p.enrichmentCache.get(key, enricher.URL, now); ok {
+			return metadata, nil
+		}
+	}
+
+	metadata, err := requestEnrichment(enricher, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if enricher.CacheTTL > 0 {
+		expires := now.Add(time.Duration(enricher.CacheTTL) * time.Second)
+		p.enrichmentCache.set(key, enricher.URL, metadata, expires)
+	}
+	return metadata, nil
+}
+
+// requestEnrichment posts event to the endpoint of enricher, and decodes the
+// metadata it responded with. Metadata values that are not strings are JSON
+// encoded.
+func requestEnrichment(enricher *corev2.Enricher, event *corev2.Event) (map[string]string, error) {
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := DefaultEnricherTimeout
+	if enricher.Timeout > 0 {
+		timeout = time.Duration(enricher.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, enricher.URL, bytes.NewReader(eventData))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for key, value := range enricher.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxEnrichmentSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("enricher returned %s", resp.Status)
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(body, &values); err != nil {
+		return nil, fmt.Errorf("enricher response is not a JSON object: %s", err)
+	}
+	metadata := make(map[string]string, len(values))
+	for key, value := range values {
+		if s, ok := value.(string); ok {
+			metadata[key] = s
+			continue
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		metadata[key] = string(b)
+	}
+	return metadata, nil
+}
+
+// EnrichmentCache holds the metadata returned by enrichers until they expire.
+// It is shared by the pipelines of a backend. When the cache is full, the
+// expired metadata are removed, then the metadata expiring first.
+type EnrichmentCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]enrichmentCacheEntry
+}
+
+type enrichmentCacheEntry struct {
+	url      string
+	metadata map[string]string
+	expires  time.Time
+}
+
+// NewEnrichmentCache creates an empty EnrichmentCache.
+func NewEnrichmentCache() *EnrichmentCache {
+	return &EnrichmentCache{
+		size:    enrichmentCacheSize,
+		entries: make(map[string]enrichmentCacheEntry),
+	}
+}
+
+// get returns the metadata cached for key, if they were returned by the
+// endpoint url and did not expire.
+func (c *EnrichmentCache) get(key, url string, now time.Time) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || entry.url != url || !now.Before(entry.expires) {
+		return nil, false
+	}
+	return entry.metadata, true
+}
+
+// set caches the metadata returned by the endpoint url for key.
+func (c *EnrichmentCache) set(key, url string, metadata map[string]string, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		c.evict(time.Now())
+	}
+	c.entries[key] = enrichmentCacheEntry{url: url, metadata: metadata, expires: expires}
+}
+
+// evict removes the expired metadata, or the metadata expiring first if none
+// expired. c.mu must be held.
+func (c *EnrichmentCache) evict(now time.Time) {
+	var first string
+	var firstExpires time.Time
+	for k, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, k)
+			continue
+		}
+		if first == "" || entry.expires.Before(firstExpires) {
+			first, firstExpires = k, entry.expires
+		}
+	}
+	if len(c.entries) >= c.size {
+		delete(c.entries, first)
+	}
+}
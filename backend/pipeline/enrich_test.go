@@ -0,0 +1,146 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockEnricher(s *mockstore.MockStore, enricher *corev2.Enricher) {
+	s.On("GetResource", mock.Anything, enricher.Name, mock.AnythingOfType("*v2.Enricher")).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*corev2.Enricher) = *enricher
+		}).Return(nil)
+}
+
+func TestEnrichEvent(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		var event corev2.Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"owner":      event.Entity.Name + "-team",
+			"escalation": map[string]int{"level": 2},
+		})
+	}))
+	defer server.Close()
+
+	enricher := corev2.FixtureEnricher("cmdb")
+	enricher.URL = server.URL
+	enricher.Headers = map[string]string{"X-Api-Key": "secret"}
+	enricher.AnnotationPrefix = "cmdb/"
+	enricher.CacheTTL = 60
+
+	s := &mockstore.MockStore{}
+	mockEnricher(s, enricher)
+	s.On("GetResource", mock.Anything, "missing", mock.Anything).Return(&store.ErrNotFound{Key: "missing"})
+
+	p := New(Config{Store: s})
+	handler := corev2.FixtureHandler("handler1")
+	handler.Enrichers = []string{"missing", "cmdb"}
+
+	event := &corev2.Event{Entity: corev2.FixtureEntity("foo")}
+	event.Annotations = map[string]string{"foo": "bar"}
+
+	enriched, err := p.enrichEvent(context.Background(), handler, event)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"foo":             "bar",
+		"cmdb/owner":      "foo-team",
+		"cmdb/escalation": `{"level":2}`,
+	}, enriched.Annotations)
+
+	// The original event is left untouched
+	assert.Equal(t, map[string]string{"foo": "bar"}, event.Annotations)
+
+	// The metadata is cached
+	_, err = p.enrichEvent(context.Background(), handler, event)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestEnrichEventError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	enricher := corev2.FixtureEnricher("cmdb")
+	enricher.URL = server.URL
+
+	s := &mockstore.MockStore{}
+	mockEnricher(s, enricher)
+
+	p := New(Config{Store: s})
+	handler := corev2.FixtureHandler("handler1")
+	handler.Enrichers = []string{"cmdb"}
+
+	event := &corev2.Event{Entity: corev2.FixtureEntity("foo")}
+	enriched, err := p.enrichEvent(context.Background(), handler, event)
+	require.NoError(t, err)
+	assert.Empty(t, enriched.Annotations)
+
+	// Internal store errors are returned
+	s = &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "cmdb", mock.Anything).Return(&store.ErrInternal{Message: "boom"})
+	p = New(Config{Store: s})
+	_, err = p.enrichEvent(context.Background(), handler, event)
+	assert.Error(t, err)
+}
+
+func TestEnrichmentCache(t *testing.T) {
+	c := NewEnrichmentCache()
+	now := time.Now()
+	metadata := map[string]string{"owner": "ops"}
+	c.set("default/cmdb/foo", "http://cmdb", metadata, now.Add(time.Minute))
+
+	got, ok := c.get("default/cmdb/foo", "http://cmdb", now)
+	assert.True(t, ok)
+	assert.Equal(t, metadata, got)
+
+	// The endpoint of the enricher changed
+	_, ok = c.get("default/cmdb/foo", "http://cmdb2", now)
+	assert.False(t, ok)
+
+	// The metadata expired
+	_, ok = c.get("default/cmdb/foo", "http://cmdb", now.Add(time.Minute))
+	assert.False(t, ok)
+}
+
+func TestEnrichmentCacheSize(t *testing.T) {
+	c := NewEnrichmentCache()
+	c.size = 2
+	now := time.Now()
+	metadata := map[string]string{"owner": "ops"}
+	c.set("a", "http://cmdb", metadata, now.Add(time.Hour))
+	c.set("b", "http://cmdb", metadata, now.Add(time.Minute))
+
+	// The metadata expiring first are evicted
+	c.set("c", "http://cmdb", metadata, now.Add(time.Hour))
+	assert.Len(t, c.entries, 2)
+	_, ok := c.get("b", "http://cmdb", now)
+	assert.False(t, ok)
+
+	// The expired metadata are evicted
+	c.set("a", "http://cmdb", metadata, now.Add(-time.Minute))
+	c.set("d", "http://cmdb", metadata, now.Add(time.Hour))
+	assert.Len(t, c.entries, 2)
+	_, ok = c.get("c", "http://cmdb", now)
+	assert.True(t, ok)
+	_, ok = c.get("d", "http://cmdb", now)
+	assert.True(t, ok)
+}
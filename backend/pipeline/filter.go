@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -11,8 +12,13 @@ import (
 	utillogging "github.com/sensu/sensu-go/util/logging"
 )
 
-// Returns true if the event should be filtered/denied.
-func evaluateEventFilter(event *corev2.Event, filter *corev2.EventFilter, assets asset.RuntimeAssetSet) bool {
+// maintenanceFilterName is the name reported as the filter of the events
+// denied because of maintenance windows.
+const maintenanceFilterName = "maintenance"
+
+// Returns true if the event should be filtered/denied. The outcome of the
+// evaluation is recorded in evaluation if it is not nil.
+func evaluateEventFilter(event *corev2.Event, filter *corev2.EventFilter, assets asset.RuntimeAssetSet, evaluation *FilterEvaluation) bool {
 	// Redact the entity to avoid leaking sensitive information
 	event.Entity = event.Entity.GetRedactedEntity()
 
@@ -25,16 +31,19 @@ func evaluateEventFilter(event *corev2.Event, filter *corev2.EventFilter, assets
 		if err != nil {
 			logger.WithFields(fields).WithError(err).
 				Error("denying event - unable to determine if time is in specified window")
+			evaluation.conclude(false, "unable to determine if time is in specified window: "+err.Error())
 			return false
 		}
 
 		if filter.Action == corev2.EventFilterActionAllow && !inWindows {
 			logger.WithFields(fields).Debug("denying event outside of filtering window")
+			evaluation.conclude(true, "event outside of filtering window")
 			return true
 		}
 
 		if filter.Action == corev2.EventFilterActionDeny && !inWindows {
 			logger.WithFields(fields).Debug("allowing event outside of filtering window")
+			evaluation.conclude(false, "event outside of filtering window")
 			return false
 		}
 	}
@@ -42,17 +51,39 @@ func evaluateEventFilter(event *corev2.Event, filter *corev2.EventFilter, assets
 	synth := dynamic.Synthesize(event)
 
 	for _, expression := range filter.Expressions {
-		match := evaluateJSFilter(synth, expression, assets)
+		match, err := evaluateJSFilter(synth, expression, assets)
+		evaluation.expression(expression, match, err)
 
 		// Allow - One of the expressions did not match, filter the event
 		if filter.Action == corev2.EventFilterActionAllow && !match {
 			logger.WithFields(fields).Debug("denying event that does not match filter")
+			evaluation.conclude(true, "event does not match expression "+expression)
 			return true
 		}
 
 		// Deny - One of the expressions did not match, do not filter the event
 		if filter.Action == corev2.EventFilterActionDeny && !match {
 			logger.WithFields(fields).Debug("allowing event that does not match filter")
+			evaluation.conclude(false, "event does not match expression "+expression)
+			return false
+		}
+	}
+
+	if filter.WASMModule != "" {
+		match, err := evaluateWASMFilter(event, filter.WASMModule, assets)
+		evaluation.expression("wasm_module: "+filter.WASMModule, match, err)
+
+		// Allow - The module did not match, filter the event
+		if filter.Action == corev2.EventFilterActionAllow && !match {
+			logger.WithFields(fields).Debug("denying event that does not match filter wasm module")
+			evaluation.conclude(true, "event does not match wasm module "+filter.WASMModule)
+			return true
+		}
+
+		// Deny - The module did not match, do not filter the event
+		if filter.Action == corev2.EventFilterActionDeny && !match {
+			logger.WithFields(fields).Debug("allowing event that does not match filter wasm module")
+			evaluation.conclude(false, "event does not match wasm module "+filter.WASMModule)
 			return false
 		}
 	}
@@ -60,18 +91,21 @@ func evaluateEventFilter(event *corev2.Event, filter *corev2.EventFilter, assets
 	// Allow - All of the expressions matched, do not filter the event
 	if filter.Action == corev2.EventFilterActionAllow {
 		logger.WithFields(fields).Debug("allowing event that matches filter")
+		evaluation.conclude(false, "event matches all expressions")
 		return false
 	}
 
 	// Deny - All of the expressions matched, filter the event
 	if filter.Action == corev2.EventFilterActionDeny {
 		logger.WithFields(fields).Debug("denying event that matches filter")
+		evaluation.conclude(true, "event matches all expressions")
 		return true
 	}
 
 	// Something weird happened, let's not filter the event and log a warning message
 	logger.WithFields(fields).
 		Warn("not filtering event due to unhandled case")
+	evaluation.conclude(false, "unhandled filter action")
 
 	return false
 }
@@ -79,11 +113,32 @@ func evaluateEventFilter(event *corev2.Event, filter *corev2.EventFilter, assets
 // filterEvent filters a Sensu event, determining if it will continue through
 // the Sensu pipeline. Returns the filter's name if the event was filtered and
 // any error encountered
-func (p *Pipeline) filterEvent(handler *corev2.Handler, event *corev2.Event) (string, error) {
+func (p *Pipeline) filterEvent(handler *corev2.Handler, event *corev2.Event) (filtered string, err error) {
 	// Prepare the logging
 	fields := utillogging.EventFields(event, false)
 	fields["handler"] = handler.Name
 
+	// Record the traced filter evaluations
+	var trace *FilterTrace
+	if p.filterTracer != nil {
+		trace = newFilterTrace(handler, event)
+		defer func() {
+			if err == nil {
+				trace.FilteredBy = filtered
+				p.filterTracer.add(trace)
+			}
+		}()
+	}
+
+	// Deny an event received during a maintenance window, unless it has
+	// metrics so that they are still handled
+	if event.IsInMaintenance() && !event.HasMetrics() {
+		evaluation := trace.evaluate(maintenanceFilterName, nil)
+		logger.WithFields(fields).Debug("denying event received during a maintenance window")
+		evaluation.conclude(true, "event is in maintenance: "+strings.Join(event.Check.Maintenance, ", "))
+		return maintenanceFilterName, nil
+	}
+
 	// Iterate through all event filters, the event is filtered if
 	// a filter returns true.
 	for _, filterName := range handler.Filters {
@@ -92,22 +147,40 @@ func (p *Pipeline) filterEvent(handler *corev2.Handler, event *corev2.Event) (st
 		switch filterName {
 		case "is_incident":
 			// Deny an event if it is neither an incident nor resolution.
+			evaluation := trace.evaluate(filterName, nil)
 			if !event.IsIncident() && !event.IsResolution() {
 				logger.WithFields(fields).Debug("denying event that is not an incident/resolution")
+				evaluation.conclude(true, "event is not an incident or a resolution")
 				return filterName, nil
 			}
+			evaluation.conclude(false, "event is an incident or a resolution")
 		case "has_metrics":
 			// Deny an event if it does not have metrics
+			evaluation := trace.evaluate(filterName, nil)
 			if !event.HasMetrics() {
 				logger.WithFields(fields).Debug("denying event without metrics")
+				evaluation.conclude(true, "event has no metrics")
 				return filterName, nil
 			}
+			evaluation.conclude(false, "event has metrics")
 		case "not_silenced":
 			// Deny event that is silenced.
+			evaluation := trace.evaluate(filterName, nil)
 			if event.IsSilenced() {
 				logger.WithFields(fields).Debug("denying event that is silenced")
+				evaluation.conclude(true, "event is silenced")
+				return filterName, nil
+			}
+			evaluation.conclude(false, "event is not silenced")
+		case "not_flapping":
+			// Deny event that is flapping.
+			evaluation := trace.evaluate(filterName, nil)
+			if event.IsFlapping() {
+				logger.WithFields(fields).Debug("denying event that is flapping")
+				evaluation.conclude(true, "event is flapping")
 				return filterName, nil
 			}
+			evaluation.conclude(false, "event is not flapping")
 		default:
 			// Retrieve the filter from the store with its name
 			ctx := corev2.SetContextFromResource(context.Background(), event.Entity)
@@ -134,7 +207,7 @@ func (p *Pipeline) filterEvent(handler *corev2.Handler, event *corev2.Event) (st
 						return "", err
 					}
 				}
-				filtered := evaluateEventFilter(event, filter, assets)
+				filtered := evaluateEventFilter(event, filter, assets, trace.evaluate(filterName, filter))
 				if filtered {
 					logger.WithFields(fields).Debug("denying event with custom filter")
 					return filterName, nil
@@ -165,16 +238,20 @@ func (p *Pipeline) filterEvent(handler *corev2.Handler, event *corev2.Event) (st
 					logger.WithError(err).Debug("error closing grpc client")
 				}
 			}()
+			evaluation := trace.evaluate(filterName, nil)
 			filtered, err := executor.FilterEvent(event)
 			if err != nil {
 				logger.WithFields(fields).WithError(err).
 					Error("could not execute filter")
+				evaluation.conclude(false, "could not execute filter extension: "+err.Error())
 				continue
 			}
 			if filtered {
 				logger.WithFields(fields).Debug("denying event with custom filter extension")
+				evaluation.conclude(true, "event denied by filter extension")
 				return filterName, nil
 			}
+			evaluation.conclude(false, "event allowed by filter extension")
 		}
 	}
 
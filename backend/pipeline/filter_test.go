@@ -64,6 +64,8 @@ func TestPipelineFilter(t *testing.T) {
 		history        []types.CheckHistory
 		metrics        *types.Metrics
 		silenced       []string
+		maintenance    []string
+		state          string
 		filters        []string
 		expectedFilter string
 	}{
@@ -179,6 +181,35 @@ func TestPipelineFilter(t *testing.T) {
 			filters:        []string{"is_incident"},
 			expectedFilter: "",
 		},
+		{
+			name:           "Flapping",
+			status:         1,
+			state:          types.EventFlappingState,
+			filters:        []string{"is_incident", "not_flapping"},
+			expectedFilter: "not_flapping",
+		},
+		{
+			name:           "Not Flapping",
+			status:         1,
+			state:          types.EventFailingState,
+			filters:        []string{"is_incident", "not_flapping"},
+			expectedFilter: "",
+		},
+		{
+			name:           "In Maintenance Without Metrics",
+			status:         1,
+			maintenance:    []string{"upgrade"},
+			filters:        []string{"is_incident"},
+			expectedFilter: "maintenance",
+		},
+		{
+			name:           "In Maintenance With Metrics",
+			status:         1,
+			metrics:        &types.Metrics{},
+			maintenance:    []string{"upgrade"},
+			filters:        []string{"has_metrics"},
+			expectedFilter: "",
+		},
 		{
 			name:           "Extension filter",
 			filters:        []string{"extension_filter"},
@@ -196,10 +227,12 @@ func TestPipelineFilter(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			event := &types.Event{
 				Check: &types.Check{
-					Status:   tc.status,
-					History:  tc.history,
-					Output:   "foo",
-					Silenced: tc.silenced,
+					Status:      tc.status,
+					History:     tc.history,
+					Output:      "foo",
+					Silenced:    tc.silenced,
+					Maintenance: tc.maintenance,
+					State:       tc.state,
 				},
 				Entity: &types.Entity{
 					ObjectMeta: types.ObjectMeta{
@@ -13,18 +13,28 @@ import (
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/rpc"
+	"github.com/sensu/sensu-go/tracing"
 	"github.com/sensu/sensu-go/util/environment"
 	utillogging "github.com/sensu/sensu-go/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
+// The attributes of the spans of the handlers.
+const (
+	AttributeHandler       = "sensu.handler"
+	AttributeHandlerType   = "sensu.handler.type"
+	AttributeHandlerStatus = "sensu.handler.status"
+	AttributeFilteredBy    = "sensu.filtered_by"
+	AttributeSuppressed    = "sensu.suppressed"
+)
+
 type handlerExtensionUnion struct {
 	*corev2.Extension
 	*corev2.Handler
 }
 
 // HandleEvent takes a Sensu event through a Sensu pipeline, filters
-// -> mutator -> handler. An event may have one or more handlers. Most
+// -> enrichers -> mutator -> handler. An event may have one or more handlers. Most
 // errors are only logged and used for flow control, they will not
 // interupt event handling.
 func (p *Pipeline) HandleEvent(ctx context.Context, event *corev2.Event) error {
@@ -58,59 +68,141 @@ func (p *Pipeline) HandleEvent(ctx context.Context, event *corev2.Event) error {
 	}
 
 	for _, u := range handlers {
-		handler := u.Handler
-		fields["handler"] = handler.Name
+		if err := p.handleEventWith(ctx, u, event, fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleEventWith takes the event through the filters, the mutator and the
+// handler of u, in a span of the trace of the event. Only the fatal errors
+// are returned.
+func (p *Pipeline) handleEventWith(ctx context.Context, u handlerExtensionUnion, event *corev2.Event, fields logrus.Fields) error {
+	handler := u.Handler
+	fields["handler"] = handler.Name
+
+	ctx, span := tracing.StartSpanFromContext(ctx, "pipeline.handler")
+	defer span.End()
+	span.SetAttribute(AttributeHandler, handler.Name)
+	span.SetAttribute(AttributeHandlerType, handler.Type)
+
+	filter, err := p.filterEvent(handler, event)
+	if err != nil {
+		if _, ok := err.(*store.ErrInternal); ok {
+			// Fatal error
+			return err
+		}
+		logger.WithError(err).Warn("error filtering event")
+	}
+	if filter != "" {
+		logger.WithFields(fields).Infof("event filtered by filter %q", filter)
+		span.SetAttribute(AttributeFilteredBy, filter)
+		return nil
+	}
+
+	correlated, suppressed, err := p.correlateEvent(ctx, handler, event)
+	if err != nil {
+		if _, ok := err.(*store.ErrInternal); ok {
+			// Fatal error
+			return err
+		}
+		logger.WithError(err).Warn("error correlating event")
+		correlated = event
+	}
+	if suppressed {
+		span.SetAttribute(AttributeSuppressed, true)
+		return nil
+	}
+
+	enriched, err := p.enrichEvent(ctx, handler, correlated)
+	if err != nil {
+		if _, ok := err.(*store.ErrInternal); ok {
+			// Fatal error
+			return err
+		}
+		logger.WithError(err).Warn("error enriching event")
+		enriched = correlated
+	}
+
+	eventData, err := p.mutateEvent(handler, enriched)
+	if err != nil {
+		logger.WithError(err).Warn("error mutating event")
+		span.SetError(err)
+		if _, ok := err.(*store.ErrInternal); ok {
+			// Fatal error
+			return err
+		}
+		return nil
+	}
 
-		filter, err := p.filterEvent(handler, event)
+	logger.WithFields(fields).Info("sending event to handler")
+
+	release, err := p.handlerLimiter.acquire(ctx, handler)
+	if err != nil {
+		return err
+	}
+	err = p.executeHandler(ctx, u, enriched, eventData, fields)
+	release()
+	return err
+}
+
+// executeHandler executes handler with the mutated eventData. Handler errors
+// are only logged, unless they are fatal.
+func (p *Pipeline) executeHandler(ctx context.Context, u handlerExtensionUnion, event *corev2.Event, eventData []byte, fields logrus.Fields) error {
+	handler := u.Handler
+	_, span := tracing.StartSpanFromContext(ctx, "pipeline.handler.execute")
+	defer span.End()
+	start := time.Now()
+	switch handler.Type {
+	case "pipe":
+		result, err := p.pipeHandler(handler, event, eventData)
+		observeHandler(span, handler, pipeHandlerStatus(result, err), start)
 		if err != nil {
+			logger.WithFields(fields).Error(err)
 			if _, ok := err.(*store.ErrInternal); ok {
-				// Fatal error
 				return err
 			}
-			logger.WithError(err).Warn("error filtering event")
-		}
-		if filter != "" {
-			logger.WithFields(fields).Infof("event filtered by filter %q", filter)
-			continue
 		}
-
-		eventData, err := p.mutateEvent(handler, event)
+	case "tcp", "udp":
+		_, err := p.socketHandler(handler, event, eventData)
+		observeHandler(span, handler, handlerStatus(err), start)
 		if err != nil {
-			logger.WithError(err).Warn("error mutating event")
+			logger.WithFields(fields).Error(err)
 			if _, ok := err.(*store.ErrInternal); ok {
-				// Fatal error
 				return err
 			}
-			continue
 		}
-
-		logger.WithFields(fields).Info("sending event to handler")
-
-		switch handler.Type {
-		case "pipe":
-			if _, err := p.pipeHandler(handler, event, eventData); err != nil {
-				logger.WithFields(fields).Error(err)
-				if _, ok := err.(*store.ErrInternal); ok {
-					return err
-				}
-			}
-		case "tcp", "udp":
-			if _, err := p.socketHandler(handler, event, eventData); err != nil {
-				logger.WithFields(fields).Error(err)
-				if _, ok := err.(*store.ErrInternal); ok {
-					return err
-				}
-			}
-		case "grpc":
-			if _, err := p.grpcHandler(u.Extension, event, eventData); err != nil {
-				logger.WithFields(fields).Error(err)
-				if _, ok := err.(*store.ErrInternal); ok {
-					return err
-				}
+	case "grpc":
+		_, err := p.grpcHandler(u.Extension, event, eventData)
+		observeHandler(span, handler, handlerStatus(err), start)
+		if err != nil {
+			logger.WithFields(fields).Error(err)
+			if _, ok := err.(*store.ErrInternal); ok {
+				return err
 			}
-		default:
-			return errors.New("unknown handler type")
 		}
+	case "otlp":
+		err := p.otlpHandler(handler, event)
+		observeHandler(span, handler, handlerStatus(err), start)
+		if err != nil {
+			logger.WithFields(fields).Error(err)
+		}
+	case "influxdb":
+		err := p.influxDBHandler(handler, event)
+		observeHandler(span, handler, handlerStatus(err), start)
+		if err != nil {
+			logger.WithFields(fields).Error(err)
+		}
+	case "kafka":
+		err := p.kafkaHandler(handler, event, eventData)
+		observeHandler(span, handler, handlerStatus(err), start)
+		if err != nil {
+			logger.WithFields(fields).Error(err)
+		}
+	default:
+		return errors.New("unknown handler type")
 	}
 
 	return nil
@@ -263,7 +355,7 @@ func (p *Pipeline) pipeHandler(handler *corev2.Handler, event *corev2.Event, eve
 		logger.WithFields(fields).WithError(err).Error("failed to execute event pipe handler")
 	} else {
 		fields["status"] = result.Status
-		fields["output"] = result.Output
+		fields["output"] = redactOutput(result.Output, handlerExec.Env, secrets)
 		logger.WithFields(fields).Info("event pipe handler executed")
 	}
 
@@ -0,0 +1,112 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/command"
+	"github.com/sensu/sensu-go/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// HandlerExecutionsCounterVec is the name of the prometheus counter vec
+	// used to count handler executions.
+	HandlerExecutionsCounterVec = "sensu_go_handler_executions"
+
+	// HandlerDurationHistogramVec is the name of the prometheus histogram vec
+	// used to observe the duration of handler executions.
+	HandlerDurationHistogramVec = "sensu_go_handler_duration_seconds"
+
+	// HandlerStatusLabelName is the name of the label which stores the
+	// outcome of handler executions.
+	HandlerStatusLabelName = "status"
+
+	// HandlerStatusSuccess is the status of successful handler executions.
+	HandlerStatusSuccess = "success"
+
+	// HandlerStatusFailure is the status of failed handler executions.
+	HandlerStatusFailure = "failure"
+
+	// HandlerStatusTimeout is the status of handler executions that timed out.
+	HandlerStatusTimeout = "timeout"
+)
+
+var (
+	// HandlerExecutions counts the handler executions, by handler and status.
+	HandlerExecutions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: HandlerExecutionsCounterVec,
+			Help: "The total number of handler executions",
+		},
+		[]string{"namespace", "handler", "type", HandlerStatusLabelName},
+	)
+
+	// HandlerDuration observes the duration of handler executions, by
+	// handler.
+	HandlerDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    HandlerDurationHistogramVec,
+			Help:    "The duration of handler executions, in seconds",
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		},
+		[]string{"namespace", "handler", "type"},
+	)
+)
+
+// observeHandler records the execution of handler, which started at start
+// and ended with status, and its status on the span of the execution.
+func observeHandler(span *tracing.Span, handler *corev2.Handler, status string, start time.Time) {
+	HandlerExecutions.WithLabelValues(handler.Namespace, handler.Name, handler.Type, status).Inc()
+	HandlerDuration.WithLabelValues(handler.Namespace, handler.Name, handler.Type).Observe(time.Since(start).Seconds())
+	span.SetAttribute(AttributeHandlerStatus, status)
+	if status != HandlerStatusSuccess {
+		span.SetError(fmt.Errorf("handler execution %s", status))
+	}
+}
+
+// handlerStatus returns the status of a handler execution that ended with
+// err.
+func handlerStatus(err error) string {
+	switch {
+	case err == nil:
+		return HandlerStatusSuccess
+	case isTimeout(err):
+		return HandlerStatusTimeout
+	default:
+		return HandlerStatusFailure
+	}
+}
+
+// pipeHandlerStatus returns the status of a pipe handler execution. The
+// execution fails if the command exits with a non-zero status.
+func pipeHandlerStatus(result *command.ExecutionResponse, err error) string {
+	switch {
+	case err != nil:
+		return handlerStatus(err)
+	case result.Status == command.TimeoutExitStatus && result.Output == command.TimeoutOutput:
+		return HandlerStatusTimeout
+	case result.Status != 0:
+		return HandlerStatusFailure
+	default:
+		return HandlerStatusSuccess
+	}
+}
+
+// isTimeout returns whether err is caused by a timeout.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return status.Code(err) == codes.DeadlineExceeded
+}
@@ -0,0 +1,60 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ net.Error = timeoutError{}
+
+func TestHandlerStatus(t *testing.T) {
+	assert.Equal(t, HandlerStatusSuccess, handlerStatus(nil))
+	assert.Equal(t, HandlerStatusFailure, handlerStatus(errors.New("error")))
+	assert.Equal(t, HandlerStatusTimeout, handlerStatus(context.DeadlineExceeded))
+	assert.Equal(t, HandlerStatusTimeout, handlerStatus(&net.OpError{Op: "dial", Err: timeoutError{}}))
+	assert.Equal(t, HandlerStatusTimeout, handlerStatus(status.Error(codes.DeadlineExceeded, "deadline exceeded")))
+}
+
+func TestPipeHandlerStatus(t *testing.T) {
+	assert.Equal(t, HandlerStatusSuccess, pipeHandlerStatus(&command.ExecutionResponse{}, nil))
+	assert.Equal(t, HandlerStatusFailure, pipeHandlerStatus(&command.ExecutionResponse{Status: 1}, nil))
+	assert.Equal(t, HandlerStatusFailure, pipeHandlerStatus(nil, errors.New("error")))
+	assert.Equal(t, HandlerStatusTimeout, pipeHandlerStatus(&command.ExecutionResponse{
+		Status: command.TimeoutExitStatus,
+		Output: command.TimeoutOutput,
+	}, nil))
+}
+
+func TestObserveHandler(t *testing.T) {
+	handler := corev2.FixtureHandler("observed")
+	counter := HandlerExecutions.WithLabelValues(handler.Namespace, handler.Name, handler.Type, HandlerStatusFailure)
+	before := testutil.ToFloat64(counter)
+
+	observeHandler(nil, handler, HandlerStatusFailure, time.Now())
+
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+
+	var metric dto.Metric
+	histogram := HandlerDuration.WithLabelValues(handler.Namespace, handler.Name, handler.Type)
+	require.NoError(t, histogram.(prometheus.Histogram).Write(&metric))
+	assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+}
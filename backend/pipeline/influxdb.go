@@ -0,0 +1,319 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/util/retry"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultInfluxDBBatchSize is the default maximum number of points written
+	// per request by InfluxDB handlers.
+	DefaultInfluxDBBatchSize = 5000
+
+	// influxDBEntityTag is the tag holding the entity name of the points.
+	influxDBEntityTag = "sensu_entity_name"
+)
+
+// influxDBRetryDelay is the delay before the first retry of a failed write.
+var influxDBRetryDelay = time.Second
+
+var (
+	influxDBMeasurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	influxDBTagEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+)
+
+// influxDBHandler writes the metrics of an event to InfluxDB. Every metric
+// point is written as a measurement named after the point, with a single
+// value field, tagged with the point tags and the entity name.
+func (p *Pipeline) influxDBHandler(handler *corev2.Handler, event *corev2.Event) error {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"namespace":  handler.Namespace,
+		"handler":    handler.Name,
+		"event_uuid": event.GetUUID().String(),
+		"entity":     event.Entity.Name,
+	}
+
+	if event.HasCheck() {
+		fields["check"] = event.Check.Name
+	}
+
+	if !event.HasMetrics() || len(event.Metrics.Points) == 0 {
+		logger.WithFields(fields).Debug("event has no metrics, skipping influxdb handler")
+		return nil
+	}
+
+	lines := make([][]byte, 0, len(event.Metrics.Points))
+	for _, point := range event.Metrics.Points {
+		lines = append(lines, influxDBLine(point, event.Entity.Name))
+	}
+
+	if err := p.influxDBWriters.get(handler).write(lines); err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to write metrics to influxdb")
+		return err
+	}
+
+	fields["metrics"] = len(lines)
+	logger.WithFields(fields).Info("event influxdb handler executed")
+	return nil
+}
+
+// InfluxDBWriters holds the writers of the InfluxDB handlers. It is shared by
+// the pipelines of a backend, so that the metrics of every handler are
+// batched together, and must be flushed once they are stopped.
+type InfluxDBWriters struct {
+	mu      sync.Mutex
+	writers map[string]*influxDBWriter
+}
+
+// NewInfluxDBWriters creates an empty InfluxDBWriters.
+func NewInfluxDBWriters() *InfluxDBWriters {
+	return &InfluxDBWriters{writers: make(map[string]*influxDBWriter)}
+}
+
+// get returns the writer of handler, which buffers the metrics written
+// between flushes. A new writer is created when the configuration of the
+// handler changes, and the metrics buffered by the previous one are flushed.
+func (w *InfluxDBWriters) get(handler *corev2.Handler) *influxDBWriter {
+	key := path.Join(handler.Namespace, handler.Name)
+	timeout := handlerTimeout(handler)
+
+	w.mu.Lock()
+	previous, ok := w.writers[key]
+	if ok && previous.config.Equal(handler.InfluxDB) && previous.timeout == timeout {
+		w.mu.Unlock()
+		return previous
+	}
+	writer := &influxDBWriter{
+		config:  *handler.InfluxDB,
+		timeout: timeout,
+	}
+	w.writers[key] = writer
+	w.mu.Unlock()
+
+	if ok {
+		previous.flushPending()
+	}
+	return writer
+}
+
+// Flush writes the metrics buffered by the writers.
+func (w *InfluxDBWriters) Flush() {
+	w.mu.Lock()
+	writers := make([]*influxDBWriter, 0, len(w.writers))
+	for _, writer := range w.writers {
+		writers = append(writers, writer)
+	}
+	w.mu.Unlock()
+	for _, writer := range writers {
+		writer.flushPending()
+	}
+}
+
+// handlerTimeout returns the timeout of a handler, or the default socket
+// timeout if the handler has no timeout.
+func handlerTimeout(handler *corev2.Handler) time.Duration {
+	timeout := handler.Timeout
+	if timeout == 0 {
+		timeout = DefaultSocketTimeout
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// influxDBWriter writes line protocol points to InfluxDB in batches.
+type influxDBWriter struct {
+	config  corev2.HandlerInfluxDB
+	timeout time.Duration
+
+	mu    sync.Mutex
+	lines [][]byte
+	timer *time.Timer
+}
+
+// write writes lines to InfluxDB, or buffers them until the next flush if the
+// writer has a flush interval and the batch is not full yet.
+func (w *influxDBWriter) write(lines [][]byte) error {
+	w.mu.Lock()
+	w.lines = append(w.lines, lines...)
+	if w.config.FlushInterval == 0 || len(w.lines) >= w.batchSize() {
+		pending := w.take()
+		w.mu.Unlock()
+		return w.flush(pending)
+	}
+	if w.timer == nil {
+		w.timer = time.AfterFunc(time.Duration(w.config.FlushInterval)*time.Second, w.flushPending)
+	}
+	w.mu.Unlock()
+	return nil
+}
+
+// take returns the buffered lines and stops the flush timer. w.mu must be
+// held.
+func (w *influxDBWriter) take() [][]byte {
+	lines := w.lines
+	w.lines = nil
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	return lines
+}
+
+// flushPending writes the buffered lines, once the flush interval elapsed or
+// when the writer is no longer used.
+func (w *influxDBWriter) flushPending() {
+	w.mu.Lock()
+	pending := w.take()
+	w.mu.Unlock()
+	if err := w.flush(pending); err != nil {
+		logger.WithError(err).Error("failed to write buffered metrics to influxdb")
+	}
+}
+
+func (w *influxDBWriter) batchSize() int {
+	if w.config.BatchSize == 0 {
+		return DefaultInfluxDBBatchSize
+	}
+	return int(w.config.BatchSize)
+}
+
+// flush writes lines in batches, retrying the batches that failed.
+func (w *influxDBWriter) flush(lines [][]byte) error {
+	size := w.batchSize()
+	for len(lines) > 0 {
+		n := size
+		if n > len(lines) {
+			n = len(lines)
+		}
+		body := bytes.Join(lines[:n], []byte("\n"))
+		lines = lines[n:]
+
+		var lastErr error
+		backoff := retry.ExponentialBackoff{
+			InitialDelayInterval: influxDBRetryDelay,
+			MaxRetryAttempts:     int(w.config.MaxRetries) + 1,
+		}
+		err := backoff.Retry(func(attempt int) (bool, error) {
+			retryable, err := w.post(body)
+			if err == nil {
+				return true, nil
+			}
+			if !retryable {
+				return true, err
+			}
+			lastErr = err
+			logger.WithError(err).WithField("attempt", attempt).Warn("failed to write metrics to influxdb")
+			return false, nil
+		})
+		if err == retry.ErrMaxRetryAttempts {
+			err = lastErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// post sends a write request to InfluxDB. It returns whether the request can
+// be retried if it fails.
+func (w *influxDBWriter) post(body []byte) (bool, error) {
+	req, err := w.newRequest(body)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := fmt.Errorf("influxdb returned %s: %s", resp.Status, bytes.TrimSpace(message))
+		retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retryable, err
+	}
+	return false, nil
+}
+
+// newRequest creates a write request for the InfluxDB 2.x API if the writer
+// has a bucket, or for the InfluxDB 1.x API otherwise.
+func (w *influxDBWriter) newRequest(body []byte) (*http.Request, error) {
+	endpoint, err := url.Parse(w.config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid influxdb url: %s", err)
+	}
+	query := url.Values{}
+	query.Set("precision", "ns")
+	if w.config.Bucket != "" {
+		endpoint.Path = path.Join(endpoint.Path, "/api/v2/write")
+		query.Set("org", w.config.Org)
+		query.Set("bucket", w.config.Bucket)
+	} else {
+		endpoint.Path = path.Join(endpoint.Path, "/write")
+		query.Set("db", w.config.Database)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if w.config.Token != "" {
+		req.Header.Set("Authorization", "Token "+w.config.Token)
+	} else if w.config.Username != "" {
+		req.SetBasicAuth(w.config.Username, w.config.Password)
+	}
+	return req, nil
+}
+
+// influxDBLine encodes a metric point in the InfluxDB line protocol. Tags are
+// sorted by key, as recommended by InfluxDB, and tags without value are
+// omitted since they are not valid.
+func influxDBLine(point *corev2.MetricPoint, entityName string) []byte {
+	tags := map[string]string{influxDBEntityTag: entityName}
+	for _, tag := range point.Tags {
+		tags[tag.Name] = tag.Value
+	}
+	keys := make([]string, 0, len(tags))
+	for key, value := range tags {
+		if key != "" && value != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var line bytes.Buffer
+	line.WriteString(influxDBMeasurementEscaper.Replace(point.Name))
+	for _, key := range keys {
+		line.WriteByte(',')
+		line.WriteString(influxDBTagEscaper.Replace(key))
+		line.WriteByte('=')
+		line.WriteString(influxDBTagEscaper.Replace(tags[key]))
+	}
+	line.WriteString(" value=")
+	line.WriteString(strconv.FormatFloat(point.Value, 'f', -1, 64))
+	line.WriteByte(' ')
+	line.WriteString(strconv.FormatUint(metricPointNanos(point.Timestamp), 10))
+	return line.Bytes()
+}
@@ -0,0 +1,203 @@
+package pipeline
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type influxDBWrite struct {
+	path  string
+	query string
+	auth  string
+	body  string
+}
+
+func newInfluxDBServer(statuses ...int) (*httptest.Server, func() []influxDBWrite) {
+	var mu sync.Mutex
+	var writes []influxDBWrite
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		writes = append(writes, influxDBWrite{
+			path:  r.URL.Path,
+			query: r.URL.RawQuery,
+			auth:  r.Header.Get("Authorization"),
+			body:  string(body),
+		})
+		if len(statuses) >= len(writes) {
+			w.WriteHeader(statuses[len(writes)-1])
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return server, func() []influxDBWrite {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]influxDBWrite(nil), writes...)
+	}
+}
+
+func influxDBFixtureEvent(points int) *corev2.Event {
+	event := corev2.FixtureEvent("entity1", "check1")
+	event.Metrics = &corev2.Metrics{}
+	for i := 0; i < points; i++ {
+		event.Metrics.Points = append(event.Metrics.Points, &corev2.MetricPoint{
+			Name:      "cpu.idle",
+			Value:     float64(i),
+			Timestamp: 1257894000,
+		})
+	}
+	return event
+}
+
+func TestInfluxDBLine(t *testing.T) {
+	point := &corev2.MetricPoint{
+		Name:      "disk usage",
+		Value:     42.5,
+		Timestamp: 1257894000,
+		Tags: []*corev2.MetricTag{
+			{Name: "path", Value: "/var/lib,data"},
+			{Name: "empty", Value: ""},
+		},
+	}
+	want := `disk\ usage,path=/var/lib\,data,sensu_entity_name=entity1 value=42.5 1257894000000000000`
+	assert.Equal(t, want, string(influxDBLine(point, "entity1")))
+}
+
+func TestInfluxDBHandlerV1(t *testing.T) {
+	server, writes := newInfluxDBServer()
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB.URL = server.URL
+	handler.InfluxDB.Username = "sensu"
+	handler.InfluxDB.Password = "secret"
+
+	p := New(Config{})
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(2)))
+
+	got := writes()
+	require.Len(t, got, 1)
+	assert.Equal(t, "/write", got[0].path)
+	assert.Equal(t, "db=sensu&precision=ns", got[0].query)
+	assert.True(t, strings.HasPrefix(got[0].auth, "Basic "))
+	assert.Equal(t, 2, len(strings.Split(got[0].body, "\n")))
+}
+
+func TestInfluxDBHandlerV2Batches(t *testing.T) {
+	server, writes := newInfluxDBServer()
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB = &corev2.HandlerInfluxDB{
+		URL:       server.URL,
+		Org:       "acme",
+		Bucket:    "sensu",
+		Token:     "secret",
+		BatchSize: 2,
+	}
+
+	p := New(Config{})
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(3)))
+
+	got := writes()
+	require.Len(t, got, 2)
+	assert.Equal(t, "/api/v2/write", got[0].path)
+	assert.Equal(t, "bucket=sensu&org=acme&precision=ns", got[0].query)
+	assert.Equal(t, "Token secret", got[0].auth)
+	assert.Equal(t, 2, len(strings.Split(got[0].body, "\n")))
+	assert.Equal(t, 1, len(strings.Split(got[1].body, "\n")))
+}
+
+func TestInfluxDBHandlerFlushInterval(t *testing.T) {
+	server, writes := newInfluxDBServer()
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB.URL = server.URL
+	handler.InfluxDB.FlushInterval = 1
+
+	p := New(Config{})
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(1)))
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(1)))
+	assert.Empty(t, writes())
+
+	assert.Eventually(t, func() bool { return len(writes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, len(strings.Split(writes()[0].body, "\n")))
+}
+
+func TestInfluxDBHandlerRetry(t *testing.T) {
+	defer func(delay time.Duration) { influxDBRetryDelay = delay }(influxDBRetryDelay)
+	influxDBRetryDelay = time.Millisecond
+
+	server, writes := newInfluxDBServer(http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB.URL = server.URL
+	handler.InfluxDB.MaxRetries = 2
+
+	p := New(Config{})
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(1)))
+	assert.Len(t, writes(), 3)
+}
+
+func TestInfluxDBHandlerNoRetryOnClientError(t *testing.T) {
+	server, writes := newInfluxDBServer(http.StatusBadRequest)
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB.URL = server.URL
+	handler.InfluxDB.MaxRetries = 2
+
+	p := New(Config{})
+	assert.Error(t, p.influxDBHandler(handler, influxDBFixtureEvent(1)))
+	assert.Len(t, writes(), 1)
+}
+
+func TestInfluxDBWritersFlush(t *testing.T) {
+	server, writes := newInfluxDBServer()
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB.URL = server.URL
+	handler.InfluxDB.FlushInterval = 60
+
+	writers := NewInfluxDBWriters()
+	p := New(Config{InfluxDBWriters: writers})
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(2)))
+	assert.Empty(t, writes())
+
+	writers.Flush()
+	got := writes()
+	require.Len(t, got, 1)
+	assert.Equal(t, 2, len(strings.Split(got[0].body, "\n")))
+}
+
+func TestInfluxDBWritersFlushReplaced(t *testing.T) {
+	server, writes := newInfluxDBServer()
+	defer server.Close()
+
+	handler := corev2.FixtureInfluxDBHandler("handler1")
+	handler.InfluxDB.URL = server.URL
+	handler.InfluxDB.FlushInterval = 60
+
+	p := New(Config{})
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(1)))
+	assert.Empty(t, writes())
+
+	// The metrics buffered with the previous configuration are written
+	handler.InfluxDB.BatchSize = 10
+	require.NoError(t, p.influxDBHandler(handler, influxDBFixtureEvent(1)))
+	assert.Len(t, writes(), 1)
+}
@@ -0,0 +1,88 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/itchyny/gojq"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultJQTimeout is the timeout of the jq mutators without a timeout.
+const DefaultJQTimeout = time.Second
+
+// jqMutator applies the jq expression of a Sensu mutator to the JSON encoding
+// of the Sensu event, in process. The values produced by the expression are
+// JSON encoded, one per line, to be used as the mutated event data.
+func (p *Pipeline) jqMutator(mutator *corev2.Mutator, event *corev2.Event) ([]byte, error) {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"namespace": mutator.Namespace,
+		"mutator":   mutator.Name,
+	}
+
+	timeout := DefaultJQTimeout
+	if mutator.Timeout > 0 {
+		timeout = time.Duration(mutator.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	eventData, err := evaluateJQ(ctx, mutator.Eval, event)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to evaluate jq mutator")
+		return nil, err
+	}
+	return eventData, nil
+}
+
+// evaluateJQ evaluates expression with the event as input.
+func evaluateJQ(ctx context.Context, expression string, event *corev2.Event) ([]byte, error) {
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq expression: %s", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq expression: %s", err)
+	}
+
+	// gojq operates on the values produced by encoding/json
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	var input interface{}
+	if err := json.Unmarshal(eventData, &input); err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	iter := code.RunWithContext(ctx, input)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := value.(error); ok {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, errors.New("jq expression evaluation timed out")
+			}
+			return nil, err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if output.Len() > 0 {
+			output.WriteByte('\n')
+		}
+		output.Write(data)
+	}
+	return output.Bytes(), nil
+}
@@ -0,0 +1,271 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/xdg/scram"
+)
+
+// kafkaClientID identifies the backend to the Kafka brokers.
+const kafkaClientID = "sensu-backend"
+
+// errKafkaProducerClosed is returned when a message is sent with a producer
+// closed because the configuration of its handler changed.
+var errKafkaProducerClosed = errors.New("kafka producer closed")
+
+// newKafkaProducer creates the producers of Kafka handlers.
+var newKafkaProducer = sarama.NewSyncProducer
+
+// kafkaHandler publishes the mutated event data to the Kafka topic of the
+// handler. The message key is derived from the event according to the
+// partition key of the handler.
+func (p *Pipeline) kafkaHandler(handler *corev2.Handler, event *corev2.Event, eventData []byte) error {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"namespace":  handler.Namespace,
+		"handler":    handler.Name,
+		"topic":      handler.Kafka.Topic,
+		"event_uuid": event.GetUUID().String(),
+		"entity":     event.Entity.Name,
+	}
+
+	if event.HasCheck() {
+		fields["check"] = event.Check.Name
+	}
+
+	message := &sarama.ProducerMessage{
+		Topic: handler.Kafka.Topic,
+		Value: sarama.ByteEncoder(eventData),
+	}
+	if key := kafkaPartitionKey(handler.Kafka.PartitionKey, event); key != "" {
+		message.Key = sarama.StringEncoder(key)
+	}
+
+	logger.WithFields(fields).Debug("sending event to kafka handler")
+
+	var (
+		partition int32
+		offset    int64
+		err       error
+	)
+	// The producer may be replaced by another handler while it is in use, in
+	// which case the message is sent again with the new producer
+	for i := 0; i < 2; i++ {
+		var producer *kafkaProducer
+		producer, err = p.kafkaProducers.get(handler)
+		if err != nil {
+			break
+		}
+		partition, offset, err = producer.send(message)
+		if err != errKafkaProducerClosed {
+			break
+		}
+	}
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to publish event to kafka")
+		return err
+	}
+
+	fields["partition"] = partition
+	fields["offset"] = offset
+	logger.WithFields(fields).Info("event kafka handler executed")
+	return nil
+}
+
+// kafkaPartitionKey returns the message key of event.
+func kafkaPartitionKey(partitionKey string, event *corev2.Event) string {
+	switch partitionKey {
+	case corev2.KafkaPartitionKeyNone:
+		return ""
+	case corev2.KafkaPartitionKeyCheck:
+		if event.HasCheck() {
+			return path.Join(event.Entity.Name, event.Check.Name)
+		}
+	}
+	return event.Entity.Name
+}
+
+// kafkaProducer is a producer shared by the events of a handler.
+type kafkaProducer struct {
+	config  corev2.HandlerKafka
+	timeout time.Duration
+
+	mu       sync.RWMutex
+	producer sarama.SyncProducer
+	closed   bool
+}
+
+// send publishes a message and returns its partition and offset.
+func (k *kafkaProducer) send(message *sarama.ProducerMessage) (int32, int64, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.closed {
+		return 0, 0, errKafkaProducerClosed
+	}
+	return k.producer.SendMessage(message)
+}
+
+// close closes the producer once the messages being sent are published.
+func (k *kafkaProducer) close() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.closed = true
+	if err := k.producer.Close(); err != nil {
+		logger.WithError(err).Error("error closing kafka producer")
+	}
+}
+
+// KafkaProducers holds the producers of the Kafka handlers. It is shared by
+// the pipelines of a backend, so that every handler has a single producer, and
+// must be closed once they are stopped.
+type KafkaProducers struct {
+	mu        sync.Mutex
+	producers map[string]*kafkaProducer
+}
+
+// NewKafkaProducers creates an empty KafkaProducers.
+func NewKafkaProducers() *KafkaProducers {
+	return &KafkaProducers{producers: make(map[string]*kafkaProducer)}
+}
+
+// get returns the producer of handler, which is created on first use. The
+// producer is replaced when the configuration of the handler changes.
+func (k *KafkaProducers) get(handler *corev2.Handler) (*kafkaProducer, error) {
+	key := path.Join(handler.Namespace, handler.Name)
+	timeout := handlerTimeout(handler)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	producer, ok := k.producers[key]
+	if ok && producer.config.Equal(handler.Kafka) && producer.timeout == timeout {
+		return producer, nil
+	}
+	if ok {
+		delete(k.producers, key)
+		go producer.close()
+	}
+
+	config, err := kafkaConfig(handler.Kafka, timeout)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newKafkaProducer(handler.Kafka.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating kafka producer: %s", err)
+	}
+	producer = &kafkaProducer{
+		config:   *handler.Kafka,
+		timeout:  timeout,
+		producer: client,
+	}
+	k.producers[key] = producer
+	return producer, nil
+}
+
+// Close closes the producers once the messages being sent are published.
+func (k *KafkaProducers) Close() {
+	k.mu.Lock()
+	producers := k.producers
+	k.producers = make(map[string]*kafkaProducer)
+	k.mu.Unlock()
+	for _, producer := range producers {
+		producer.close()
+	}
+}
+
+// kafkaConfig returns the producer configuration of a Kafka handler.
+func kafkaConfig(k *corev2.HandlerKafka, timeout time.Duration) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	config.ClientID = kafkaClientID
+	config.Net.DialTimeout = timeout
+	config.Net.ReadTimeout = timeout
+	config.Net.WriteTimeout = timeout
+	config.Producer.Timeout = timeout
+	config.Producer.Return.Successes = true
+
+	if k.PartitionKey == corev2.KafkaPartitionKeyNone {
+		config.Producer.Partitioner = sarama.NewRandomPartitioner
+	}
+
+	switch k.Compression {
+	case "gzip":
+		config.Producer.Compression = sarama.CompressionGZIP
+	case "snappy":
+		config.Producer.Compression = sarama.CompressionSnappy
+	case "lz4":
+		config.Producer.Compression = sarama.CompressionLZ4
+	case "zstd":
+		// zstd requires the produce request v7
+		config.Version = sarama.V2_1_0_0
+		config.Producer.Compression = sarama.CompressionZSTD
+	}
+
+	if k.TLS {
+		tlsConfig := &tls.Config{InsecureSkipVerify: k.TLSInsecureSkipVerify}
+		if k.TLSCAFile != "" {
+			pool, err := corev2.LoadCACerts(k.TLSCAFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = pool
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+
+	if k.SASLMechanism != "" {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Mechanism = sarama.SASLMechanism(k.SASLMechanism)
+		config.Net.SASL.User = k.SASLUsername
+		config.Net.SASL.Password = k.SASLPassword
+		switch config.Net.SASL.Mechanism {
+		case sarama.SASLTypeSCRAMSHA256:
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &kafkaSCRAMClient{hash: sha256.New}
+			}
+		case sarama.SASLTypeSCRAMSHA512:
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &kafkaSCRAMClient{hash: sha512.New}
+			}
+		}
+	}
+
+	return config, config.Validate()
+}
+
+// kafkaSCRAMClient implements the SCRAM authentication of the Kafka
+// producers.
+type kafkaSCRAMClient struct {
+	hash         scram.HashGeneratorFcn
+	conversation *scram.ClientConversation
+}
+
+// Begin prepares the client for the SCRAM exchange.
+func (c *kafkaSCRAMClient) Begin(userName, password, authzID string) error {
+	client, err := c.hash.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conversation = client.NewConversation()
+	return nil
+}
+
+// Step takes a string provided from a server and returns a response.
+func (c *kafkaSCRAMClient) Step(challenge string) (string, error) {
+	return c.conversation.Step(challenge)
+}
+
+// Done returns true if the SCRAM exchange is complete.
+func (c *kafkaSCRAMClient) Done() bool {
+	return c.conversation.Done()
+}
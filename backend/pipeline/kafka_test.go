@@ -0,0 +1,128 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKafkaProducer replaces the producers of the Kafka handlers until the
+// returned function is called.
+func mockKafkaProducer(t *testing.T) (*mocks.SyncProducer, func()) {
+	producer := mocks.NewSyncProducer(t, nil)
+	newKafkaProducer = func([]string, *sarama.Config) (sarama.SyncProducer, error) {
+		return producer, nil
+	}
+	return producer, func() {
+		newKafkaProducer = sarama.NewSyncProducer
+	}
+}
+
+func TestKafkaPartitionKey(t *testing.T) {
+	event := corev2.FixtureEvent("entity1", "check1")
+	assert.Equal(t, "entity1", kafkaPartitionKey("", event))
+	assert.Equal(t, "entity1", kafkaPartitionKey(corev2.KafkaPartitionKeyEntity, event))
+	assert.Equal(t, "entity1/check1", kafkaPartitionKey(corev2.KafkaPartitionKeyCheck, event))
+	assert.Equal(t, "", kafkaPartitionKey(corev2.KafkaPartitionKeyNone, event))
+}
+
+func TestKafkaHandler(t *testing.T) {
+	producer, restore := mockKafkaProducer(t)
+	defer restore()
+	producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(value []byte) error {
+		assert.Equal(t, []byte(`{"event":"data"}`), value)
+		return nil
+	})
+
+	p := New(Config{})
+	handler := corev2.FixtureKafkaHandler("handler1")
+	event := corev2.FixtureEvent("entity1", "check1")
+	require.NoError(t, p.kafkaHandler(handler, event, []byte(`{"event":"data"}`)))
+	require.NoError(t, producer.Close())
+}
+
+func TestKafkaHandlerError(t *testing.T) {
+	producer, restore := mockKafkaProducer(t)
+	defer restore()
+	producer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+
+	p := New(Config{})
+	handler := corev2.FixtureKafkaHandler("handler1")
+	event := corev2.FixtureEvent("entity1", "check1")
+	assert.Error(t, p.kafkaHandler(handler, event, nil))
+}
+
+func TestKafkaProducerReplaced(t *testing.T) {
+	_, restore := mockKafkaProducer(t)
+	defer restore()
+
+	producers := NewKafkaProducers()
+	handler := corev2.FixtureKafkaHandler("handler1")
+	first, err := producers.get(handler)
+	require.NoError(t, err)
+	same, err := producers.get(handler)
+	require.NoError(t, err)
+	assert.True(t, first == same)
+
+	handler.Kafka.Topic = "other"
+	second, err := producers.get(handler)
+	require.NoError(t, err)
+	assert.False(t, first == second)
+}
+
+func TestKafkaProducersClose(t *testing.T) {
+	_, restore := mockKafkaProducer(t)
+	defer restore()
+
+	producers := NewKafkaProducers()
+	handler := corev2.FixtureKafkaHandler("handler1")
+	producer, err := producers.get(handler)
+	require.NoError(t, err)
+
+	producers.Close()
+	_, _, err = producer.send(&sarama.ProducerMessage{Topic: handler.Kafka.Topic})
+	assert.Equal(t, errKafkaProducerClosed, err)
+}
+
+func TestKafkaConfig(t *testing.T) {
+	handler := corev2.FixtureKafkaHandler("handler1")
+	handler.Kafka.Compression = "zstd"
+	handler.Kafka.TLS = true
+	handler.Kafka.SASLMechanism = sarama.SASLTypeSCRAMSHA512
+	handler.Kafka.SASLUsername = "user"
+	handler.Kafka.SASLPassword = "password"
+
+	config, err := kafkaConfig(handler.Kafka, handlerTimeout(handler))
+	require.NoError(t, err)
+	assert.Equal(t, sarama.CompressionZSTD, config.Producer.Compression)
+	assert.Equal(t, sarama.V2_1_0_0, config.Version)
+	assert.True(t, config.Net.TLS.Enable)
+	assert.True(t, config.Net.SASL.Enable)
+	assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc)
+
+	handler.Kafka.TLSCAFile = "/nonexistent/ca.pem"
+	_, err = kafkaConfig(handler.Kafka, handlerTimeout(handler))
+	assert.Error(t, err)
+}
+
+func TestKafkaSCRAMClient(t *testing.T) {
+	config, err := kafkaConfig(&corev2.HandlerKafka{
+		Brokers:       []string{"127.0.0.1:9092"},
+		Topic:         "sensu-events",
+		SASLMechanism: sarama.SASLTypeSCRAMSHA256,
+		SASLUsername:  "user",
+		SASLPassword:  "password",
+	}, handlerTimeout(&corev2.Handler{}))
+	require.NoError(t, err)
+
+	client := config.Net.SASL.SCRAMClientGeneratorFunc()
+	require.NoError(t, client.Begin("user", "password", ""))
+	first, err := client.Step("")
+	require.NoError(t, err)
+	assert.Contains(t, first, "n=user")
+	assert.False(t, client.Done())
+}
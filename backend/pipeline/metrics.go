@@ -0,0 +1,22 @@
+package pipeline
+
+import "time"
+
+// metricPointNanos converts a metric point timestamp to nanoseconds. Metric
+// point timestamps are expressed in seconds, milliseconds, microseconds or
+// nanoseconds depending on the output metric format of the check. The current
+// time is used for metric points without timestamp.
+func metricPointNanos(timestamp int64) uint64 {
+	switch {
+	case timestamp <= 0:
+		return uint64(time.Now().UnixNano())
+	case timestamp < 1e12:
+		return uint64(timestamp) * uint64(time.Second)
+	case timestamp < 1e15:
+		return uint64(timestamp) * uint64(time.Millisecond)
+	case timestamp < 1e18:
+		return uint64(timestamp) * uint64(time.Microsecond)
+	default:
+		return uint64(timestamp)
+	}
+}
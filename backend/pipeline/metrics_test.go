@@ -0,0 +1,16 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricPointNanos(t *testing.T) {
+	want := uint64(1257894000 * time.Second)
+	assert.Equal(t, want, metricPointNanos(1257894000))
+	assert.Equal(t, want, metricPointNanos(1257894000000))
+	assert.Equal(t, want, metricPointNanos(1257894000000000))
+	assert.Equal(t, want, metricPointNanos(1257894000000000000))
+}
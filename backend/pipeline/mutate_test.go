@@ -1,13 +1,16 @@
 package pipeline
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/secrets"
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -131,3 +134,59 @@ func TestPipelinePipeMutator(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, output)
 }
+
+func TestPipelineJQMutator(t *testing.T) {
+	p := New(Config{})
+
+	mutator := types.FixtureMutator("jq")
+	mutator.Command = ""
+	mutator.Type = corev2.MutatorTypeJQ
+	mutator.Eval = `{entity: .entity.metadata.name, class: .entity.entity_class}`
+
+	event := &corev2.Event{Entity: corev2.FixtureEntity("foo")}
+
+	output, err := p.jqMutator(mutator, event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"entity": "foo", "class": "host"}`, string(output))
+
+	// Every value produced is written on its own line
+	mutator.Eval = `.entity.metadata.name, .entity.entity_class`
+	output, err = p.jqMutator(mutator, event)
+	require.NoError(t, err)
+	assert.Equal(t, "\"foo\"\n\"host\"", string(output))
+
+	mutator.Eval = `error("redacted")`
+	_, err = p.jqMutator(mutator, event)
+	assert.Error(t, err)
+}
+
+func TestPipelineJQMutate(t *testing.T) {
+	mutator := types.FixtureMutator("jq")
+	mutator.Command = ""
+	mutator.Type = corev2.MutatorTypeJQ
+	mutator.Eval = `del(.entity.system)`
+
+	store := &mockstore.MockStore{}
+	store.On("GetMutatorByName", mock.Anything, "jq").Return(mutator, nil)
+
+	p := New(Config{Store: store})
+
+	handler := types.FakeHandlerCommand("cat")
+	handler.Mutator = "jq"
+
+	event := &corev2.Event{Entity: corev2.FixtureEntity("foo")}
+	event.Entity.System.Hostname = "secret"
+
+	eventData, err := p.mutateEvent(handler, event)
+	require.NoError(t, err)
+	assert.NotContains(t, string(eventData), "secret")
+	assert.Contains(t, string(eventData), `"name":"foo"`)
+}
+
+func TestEvaluateJQTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := evaluateJQ(ctx, `def f: f; f`, &corev2.Event{})
+	assert.EqualError(t, err, "jq expression evaluation timed out")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
@@ -12,6 +13,7 @@ import (
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/util/environment"
 	utillogging "github.com/sensu/sensu-go/util/logging"
+	"github.com/sensu/sensu-go/wasm"
 	"github.com/sirupsen/logrus"
 )
 
@@ -80,7 +82,15 @@ func (p *Pipeline) mutateEvent(handler *corev2.Handler, event *corev2.Event) ([]
 		return eventData, nil
 	}
 
-	eventData, err := p.pipeMutator(mutator, event)
+	var eventData []byte
+	switch {
+	case mutator.Type == corev2.MutatorTypeJQ:
+		eventData, err = p.jqMutator(mutator, event)
+	case mutator.WASMModule != "":
+		eventData, err = p.wasmMutator(mutator, event)
+	default:
+		eventData, err = p.pipeMutator(mutator, event)
+	}
 
 	if err != nil {
 		logger.WithFields(fields).WithError(err).Error("failed to mutate the event")
@@ -114,6 +124,48 @@ func (p *Pipeline) onlyCheckOutputMutator(event *corev2.Event) []byte {
 	return nil
 }
 
+// wasmMutator calls the mutate function of the WebAssembly module of a Sensu
+// mutator, provided by one of the mutator assets, with the JSON encoding of
+// the Sensu event. The data returned by the module is used as the mutated
+// event data.
+func (p *Pipeline) wasmMutator(mutator *corev2.Mutator, event *corev2.Event) ([]byte, error) {
+	ctx := corev2.SetContextFromResource(context.Background(), mutator)
+	// Prepare log entry
+	fields := logrus.Fields{
+		"namespace":   mutator.Namespace,
+		"mutator":     mutator.Name,
+		"wasm_module": mutator.WASMModule,
+		"assets":      mutator.RuntimeAssets,
+	}
+
+	logger.WithFields(fields).Debug("fetching assets for mutator")
+	matchedAssets := asset.GetAssets(ctx, p.store, mutator.RuntimeAssets)
+	assets, err := asset.GetAll(ctx, p.assetGetter, matchedAssets)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to retrieve assets for mutator")
+		return nil, err
+	}
+
+	path, err := assets.WASMModule(mutator.WASMModule)
+	if err != nil {
+		return nil, err
+	}
+
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := wasm.DefaultTimeout
+	if mutator.Timeout > 0 {
+		timeout = time.Duration(mutator.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return wasm.Mutate(ctx, path, eventData)
+}
+
 // pipeMutator fork/executes a child process for a Sensu mutator
 // command, writes the JSON encoding of the Sensu event to it via
 // STDIN, and captures the command output (STDOUT/ERR) to be used as
@@ -165,7 +217,7 @@ func (p *Pipeline) pipeMutator(mutator *corev2.Mutator, event *corev2.Event) ([]
 	result, err := p.executor.Execute(context.Background(), mutatorExec)
 
 	fields["status"] = result.Status
-	fields["output"] = result.Output
+	fields["output"] = redactOutput(result.Output, mutatorExec.Env, secrets)
 	if err != nil {
 		logger.WithFields(fields).WithError(err).Error("failed to execute event pipe mutator")
 		return nil, err
@@ -178,3 +230,11 @@ func (p *Pipeline) pipeMutator(mutator *corev2.Mutator, event *corev2.Event) ([]
 
 	return []byte(result.Output), nil
 }
+
+// redactOutput masks the secrets, and the values of the environment variables
+// matching the default redact fields, in the output of a pipe handler or
+// mutator before it is logged.
+func redactOutput(output string, env []string, secrets []string) string {
+	values := corev2.SensitiveValues(corev2.DefaultRedactFields, env, nil)
+	return corev2.RedactString(output, append(values, corev2.EnvValues(secrets)...))
+}
@@ -0,0 +1,267 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// otlpExportMethod is the gRPC method of the OTLP metrics service.
+	otlpExportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+
+	// otlpMetricsPath is the path of the OTLP/HTTP metrics endpoint, used when
+	// the endpoint of the handler has no path.
+	otlpMetricsPath = "/v1/metrics"
+
+	// otlpScopeName is the name of the instrumentation scope of the exported
+	// metrics.
+	otlpScopeName = "sensu-go"
+)
+
+// otlpHandler exports the metrics of an event to an OpenTelemetry collector,
+// using the gRPC or HTTP transport of the OpenTelemetry protocol. The
+// resource of the metrics is derived from the entity of the event.
+func (p *Pipeline) otlpHandler(handler *corev2.Handler, event *corev2.Event) error {
+	// Prepare log entry
+	fields := logrus.Fields{
+		"namespace":  handler.Namespace,
+		"handler":    handler.Name,
+		"event_uuid": event.GetUUID().String(),
+		"entity":     event.Entity.Name,
+	}
+
+	if event.HasCheck() {
+		fields["check"] = event.Check.Name
+	}
+
+	if !event.HasMetrics() || len(event.Metrics.Points) == 0 {
+		logger.WithFields(fields).Debug("event has no metrics, skipping otlp handler")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout(handler))
+	defer cancel()
+
+	request := encodeOTLPMetrics(event)
+
+	var err error
+	switch handler.OTLP.Protocol {
+	case corev2.OTLPProtocolHTTP:
+		err = exportOTLPHTTP(ctx, handler.OTLP, request)
+	default:
+		err = exportOTLPGRPC(ctx, handler.OTLP, request)
+	}
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("failed to export metrics to otlp endpoint")
+		return err
+	}
+
+	fields["metrics"] = len(event.Metrics.Points)
+	logger.WithFields(fields).Info("event otlp handler executed")
+	return nil
+}
+
+// exportOTLPHTTP sends an export request to an OTLP/HTTP endpoint.
+func exportOTLPHTTP(ctx context.Context, config *corev2.HandlerOTLP, request []byte) error {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid otlp endpoint: %s", err)
+	}
+	if endpoint.Path == "" || endpoint.Path == "/" {
+		endpoint.Path = otlpMetricsPath
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint.String(), bytes.NewReader(request))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	for key, value := range config.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("otlp endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// exportOTLPGRPC sends an export request to an OTLP/gRPC endpoint.
+func exportOTLPGRPC(ctx context.Context, config *corev2.HandlerOTLP, request []byte) error {
+	opts := []grpc.DialOption{grpc.WithBlock()}
+	if config.Insecure {
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})))
+	}
+
+	conn, err := grpc.DialContext(ctx, config.Endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.WithError(err).Debug("error closing otlp grpc client conn")
+		}
+	}()
+
+	for key, value := range config.Headers {
+		ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+	}
+
+	req := otlpMessage(request)
+	var resp otlpMessage
+	return conn.Invoke(ctx, otlpExportMethod, &req, &resp, grpc.ForceCodec(otlpCodec{}))
+}
+
+// otlpMessage is an encoded OTLP protobuf message.
+type otlpMessage []byte
+
+// otlpCodec is a gRPC codec for messages that are already encoded.
+type otlpCodec struct{}
+
+// Marshal returns the encoded message.
+func (otlpCodec) Marshal(v interface{}) ([]byte, error) {
+	message, ok := v.(*otlpMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected otlp message type %T", v)
+	}
+	return *message, nil
+}
+
+// Unmarshal stores the encoded message.
+func (otlpCodec) Unmarshal(data []byte, v interface{}) error {
+	message, ok := v.(*otlpMessage)
+	if !ok {
+		return fmt.Errorf("unexpected otlp message type %T", v)
+	}
+	*message = append((*message)[:0], data...)
+	return nil
+}
+
+// Name returns the name of the codec, which is used in the content type of
+// the requests.
+func (otlpCodec) Name() string {
+	return "proto"
+}
+
+// encodeOTLPMetrics encodes the metric points of event as an OTLP
+// ExportMetricsServiceRequest. Every metric point is exported as a gauge with
+// a single data point, which attributes are the tags of the metric point.
+func encodeOTLPMetrics(event *corev2.Event) []byte {
+	var metrics []byte
+	for _, point := range event.Metrics.Points {
+		var attributes []byte
+		for _, tag := range point.Tags {
+			attributes = appendProtoBytes(attributes, 7, encodeOTLPAttribute(tag.Name, tag.Value))
+		}
+
+		var dataPoint []byte
+		dataPoint = appendProtoFixed64(dataPoint, 3, metricPointNanos(point.Timestamp))
+		dataPoint = appendProtoFixed64(dataPoint, 4, math.Float64bits(point.Value))
+		dataPoint = append(dataPoint, attributes...)
+
+		var metric []byte
+		metric = appendProtoBytes(metric, 1, []byte(point.Name))
+		metric = appendProtoBytes(metric, 5, appendProtoBytes(nil, 1, dataPoint))
+		metrics = appendProtoBytes(metrics, 2, metric)
+	}
+
+	scope := appendProtoBytes(nil, 1, []byte(otlpScopeName))
+	scopeMetrics := append(appendProtoBytes(nil, 1, scope), metrics...)
+
+	var resource []byte
+	for _, attribute := range otlpResourceAttributes(event.Entity) {
+		resource = appendProtoBytes(resource, 1, encodeOTLPAttribute(attribute[0], attribute[1]))
+	}
+
+	var resourceMetrics []byte
+	resourceMetrics = appendProtoBytes(resourceMetrics, 1, resource)
+	resourceMetrics = appendProtoBytes(resourceMetrics, 2, scopeMetrics)
+
+	return appendProtoBytes(nil, 1, resourceMetrics)
+}
+
+// otlpResourceAttributes returns the resource attributes of the metrics of an
+// entity, as key value pairs. The labels of the entity are added as is,
+// unless they conflict with one of the attributes derived from the entity.
+func otlpResourceAttributes(entity *corev2.Entity) [][2]string {
+	if entity == nil {
+		return nil
+	}
+	attributes := [][2]string{
+		{"sensu.namespace", entity.Namespace},
+		{"sensu.entity.name", entity.Name},
+		{"sensu.entity.class", entity.EntityClass},
+	}
+	if entity.System.Hostname != "" {
+		attributes = append(attributes, [2]string{"host.name", entity.System.Hostname})
+	}
+	if entity.System.Arch != "" {
+		attributes = append(attributes, [2]string{"host.arch", entity.System.Arch})
+	}
+	if entity.System.OS != "" {
+		attributes = append(attributes, [2]string{"os.type", entity.System.OS})
+	}
+
+	reserved := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		reserved[attribute[0]] = true
+	}
+	keys := make([]string, 0, len(entity.Labels))
+	for key := range entity.Labels {
+		if !reserved[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attributes = append(attributes, [2]string{key, entity.Labels[key]})
+	}
+	return attributes
+}
+
+// encodeOTLPAttribute encodes a KeyValue with a string value.
+func encodeOTLPAttribute(key, value string) []byte {
+	attribute := appendProtoBytes(nil, 1, []byte(key))
+	return appendProtoBytes(attribute, 2, appendProtoBytes(nil, 1, []byte(value)))
+}
+
+// appendProtoBytes appends a length-delimited protobuf field to b.
+func appendProtoBytes(b []byte, field int, value []byte) []byte {
+	b = append(b, proto.EncodeVarint(uint64(field)<<3|proto.WireBytes)...)
+	b = append(b, proto.EncodeVarint(uint64(len(value)))...)
+	return append(b, value...)
+}
+
+// appendProtoFixed64 appends a 64-bit protobuf field to b.
+func appendProtoFixed64(b []byte, field int, value uint64) []byte {
+	b = append(b, proto.EncodeVarint(uint64(field)<<3|proto.WireFixed64)...)
+	for i := uint(0); i < 8; i++ {
+		b = append(b, byte(value>>(8*i)))
+	}
+	return b
+}
@@ -0,0 +1,141 @@
+package pipeline
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// otlpServerCodec is the legacy codec interface required by the gRPC server.
+type otlpServerCodec struct {
+	otlpCodec
+}
+
+func (otlpServerCodec) String() string {
+	return "proto"
+}
+
+func otlpFixtureEvent() *corev2.Event {
+	event := corev2.FixtureEvent("entity1", "check1")
+	event.Entity.System.Hostname = "host1"
+	event.Entity.Labels = map[string]string{"region": "us-west-2"}
+	event.Metrics = &corev2.Metrics{
+		Points: []*corev2.MetricPoint{
+			{
+				Name:      "cpu.idle",
+				Value:     42.5,
+				Timestamp: 1257894000,
+				Tags:      []*corev2.MetricTag{{Name: "cpu", Value: "0"}},
+			},
+		},
+	}
+	return event
+}
+
+func TestEncodeOTLPAttribute(t *testing.T) {
+	want := []byte{
+		0x0a, 0x01, 'k', // key
+		0x12, 0x03, 0x0a, 0x01, 'v', // value.string_value
+	}
+	assert.Equal(t, want, encodeOTLPAttribute("k", "v"))
+}
+
+func TestEncodeOTLPMetrics(t *testing.T) {
+	request := encodeOTLPMetrics(otlpFixtureEvent())
+	for _, s := range []string{"cpu.idle", "sensu.entity.name", "entity1", "host.name", "host1", "region", "us-west-2", otlpScopeName} {
+		assert.True(t, bytes.Contains(request, []byte(s)), "missing %q", s)
+	}
+}
+
+func TestOTLPHandlerHTTP(t *testing.T) {
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, otlpMetricsPath, r.URL.Path)
+		header = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	handler := corev2.FixtureOTLPHandler("handler1", corev2.OTLPProtocolHTTP)
+	handler.OTLP.Endpoint = server.URL
+	handler.OTLP.Headers = map[string]string{"Authorization": "Bearer secret"}
+
+	p := &Pipeline{}
+	event := otlpFixtureEvent()
+	require.NoError(t, p.otlpHandler(handler, event))
+
+	assert.Equal(t, "application/x-protobuf", header.Get("Content-Type"))
+	assert.Equal(t, "Bearer secret", header.Get("Authorization"))
+	assert.Equal(t, encodeOTLPMetrics(event), body)
+}
+
+func TestOTLPHandlerHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	handler := corev2.FixtureOTLPHandler("handler1", corev2.OTLPProtocolHTTP)
+	handler.OTLP.Endpoint = server.URL
+
+	p := &Pipeline{}
+	assert.Error(t, p.otlpHandler(handler, otlpFixtureEvent()))
+}
+
+func TestOTLPHandlerGRPC(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	type export struct {
+		method string
+		body   otlpMessage
+		md     metadata.MD
+	}
+	exports := make(chan export, 1)
+	server := grpc.NewServer(
+		grpc.CustomCodec(otlpServerCodec{}),
+		grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+			method, _ := grpc.MethodFromServerStream(stream)
+			md, _ := metadata.FromIncomingContext(stream.Context())
+			var body otlpMessage
+			if err := stream.RecvMsg(&body); err != nil {
+				return err
+			}
+			exports <- export{method: method, body: body, md: md}
+			return stream.SendMsg(&otlpMessage{})
+		}),
+	)
+	go func() { _ = server.Serve(ln) }()
+	defer server.Stop()
+
+	handler := corev2.FixtureOTLPHandler("handler1", corev2.OTLPProtocolGRPC)
+	handler.OTLP.Endpoint = ln.Addr().String()
+	handler.OTLP.Insecure = true
+	handler.OTLP.Headers = map[string]string{"x-api-key": "secret"}
+	handler.Timeout = 5
+
+	p := &Pipeline{}
+	event := otlpFixtureEvent()
+	require.NoError(t, p.otlpHandler(handler, event))
+
+	got := <-exports
+	assert.Equal(t, otlpExportMethod, got.method)
+	assert.Equal(t, otlpMessage(encodeOTLPMetrics(event)), got.body)
+	assert.Equal(t, []string{"secret"}, got.md.Get("x-api-key"))
+}
+
+func TestOTLPHandlerNoMetrics(t *testing.T) {
+	handler := corev2.FixtureOTLPHandler("handler1", corev2.OTLPProtocolGRPC)
+	p := &Pipeline{}
+	assert.NoError(t, p.otlpHandler(handler, corev2.FixtureEvent("entity1", "check1")))
+}
@@ -1,6 +1,8 @@
 package pipeline
 
 import (
+	"context"
+	"encoding/json"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -11,6 +13,7 @@ import (
 	"github.com/sensu/sensu-go/js"
 	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/wasm"
 )
 
 // Pipeline takes events as inputs, and treats them in various ways according
@@ -23,6 +26,12 @@ type Pipeline struct {
 	executor               command.Executor
 	storeTimeout           time.Duration
 	secretsProviderManager *secrets.ProviderManager
+	handlerLimiter         *HandlerLimiter
+	filterTracer           *FilterTracer
+	influxDBWriters        *InfluxDBWriters
+	kafkaProducers         *KafkaProducers
+	enrichmentCache        *EnrichmentCache
+	correlationGroups      *CorrelationGroups
 }
 
 // Config holds the configuration for a Pipeline.
@@ -33,6 +42,30 @@ type Config struct {
 	ExtensionExecutorGetter ExtensionExecutorGetterFunc
 	StoreTimeout            time.Duration
 	SecretsProviderManager  *secrets.ProviderManager
+
+	// HandlerLimiter limits the concurrent executions of handlers. The
+	// executions are not limited if it is nil.
+	HandlerLimiter *HandlerLimiter
+
+	// FilterTracer records the traced filter evaluations. The evaluations
+	// are not traced if it is nil.
+	FilterTracer *FilterTracer
+
+	// InfluxDBWriters holds the writers of the InfluxDB handlers. The
+	// pipeline has its own writers if it is nil.
+	InfluxDBWriters *InfluxDBWriters
+
+	// KafkaProducers holds the producers of the Kafka handlers. The
+	// pipeline has its own producers if it is nil.
+	KafkaProducers *KafkaProducers
+
+	// EnrichmentCache holds the metadata returned by enrichers. The
+	// pipeline has its own cache if it is nil.
+	EnrichmentCache *EnrichmentCache
+
+	// CorrelationGroups holds the groups of events opened by
+	// correlations. The pipeline has its own groups if it is nil.
+	CorrelationGroups *CorrelationGroups
 }
 
 // Option is a functional option used to configure Pipelines.
@@ -48,6 +81,24 @@ func New(c Config, options ...Option) *Pipeline {
 		executor:               command.NewExecutor(),
 		storeTimeout:           c.StoreTimeout,
 		secretsProviderManager: c.SecretsProviderManager,
+		handlerLimiter:         c.HandlerLimiter,
+		filterTracer:           c.FilterTracer,
+		influxDBWriters:        c.InfluxDBWriters,
+		kafkaProducers:         c.KafkaProducers,
+		enrichmentCache:        c.EnrichmentCache,
+		correlationGroups:      c.CorrelationGroups,
+	}
+	if pipeline.influxDBWriters == nil {
+		pipeline.influxDBWriters = NewInfluxDBWriters()
+	}
+	if pipeline.kafkaProducers == nil {
+		pipeline.kafkaProducers = NewKafkaProducers()
+	}
+	if pipeline.enrichmentCache == nil {
+		pipeline.enrichmentCache = NewEnrichmentCache()
+	}
+	if pipeline.correlationGroups == nil {
+		pipeline.correlationGroups = NewCorrelationGroups()
 	}
 	for _, o := range options {
 		o(pipeline)
@@ -65,11 +116,34 @@ const (
 // pipelines from gRPC.
 type ExtensionExecutorGetterFunc func(*types.Extension) (rpc.ExtensionExecutor, error)
 
-func evaluateJSFilter(event interface{}, expr string, assets asset.RuntimeAssetSet) bool {
+// evaluateWASMFilter calls the filter function of the WebAssembly module
+// named module, provided by one of the filter assets, with the JSON encoding
+// of event.
+func evaluateWASMFilter(event *corev2.Event, module string, assets asset.RuntimeAssetSet) (bool, error) {
+	result, err := executeWASMFilter(event, module, assets)
+	if err != nil {
+		logger.WithError(err).Error("error executing wasm filter")
+	}
+	return result, err
+}
+
+func executeWASMFilter(event *corev2.Event, module string, assets asset.RuntimeAssetSet) (bool, error) {
+	path, err := assets.WASMModule(module)
+	if err != nil {
+		return false, err
+	}
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		return false, err
+	}
+	return wasm.Filter(context.Background(), path, eventData)
+}
+
+func evaluateJSFilter(event interface{}, expr string, assets asset.RuntimeAssetSet) (bool, error) {
 	parameters := map[string]interface{}{"event": event}
 	result, err := js.Evaluate(expr, parameters, assets)
 	if err != nil {
 		logger.WithError(err).Error("error executing JS")
 	}
-	return result
+	return result, err
 }
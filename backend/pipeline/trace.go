@@ -0,0 +1,193 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// FilterTraceAnnotation enables the tracing of filter evaluations when it
+	// is set to "true". Set on the check or the entity of an event, it traces
+	// every filter evaluated for the event. Set on a filter, it traces the
+	// evaluations of that filter.
+	FilterTraceAnnotation = "sensu.io/filter-trace"
+
+	// DefaultFilterTraceSize is the default number of filter traces kept by a
+	// FilterTracer.
+	DefaultFilterTraceSize = 1000
+)
+
+// FilterTrace records the evaluation of the filters of a handler for an
+// event.
+type FilterTrace struct {
+	// Timestamp is the time of the evaluation, in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// Namespace is the namespace of the event.
+	Namespace string `json:"namespace"`
+
+	// Entity is the name of the entity of the event.
+	Entity string `json:"entity"`
+
+	// Check is the name of the check of the event, if it has one.
+	Check string `json:"check,omitempty"`
+
+	// EventID is the UUID of the event.
+	EventID string `json:"event_id"`
+
+	// Handler is the name of the handler whose filters were evaluated.
+	Handler string `json:"handler"`
+
+	// FilteredBy is the name of the filter that denied the event, if any.
+	FilteredBy string `json:"filtered_by,omitempty"`
+
+	// Filters are the traced filter evaluations, in evaluation order.
+	Filters []*FilterEvaluation `json:"filters"`
+
+	traceAll bool
+}
+
+// FilterEvaluation records the evaluation of a filter.
+type FilterEvaluation struct {
+	// Filter is the name of the filter.
+	Filter string `json:"filter"`
+
+	// Action is the action of the filter, allow or deny.
+	Action string `json:"action,omitempty"`
+
+	// Denied is true if the filter denied the event.
+	Denied bool `json:"denied"`
+
+	// Reason explains the outcome of the evaluation.
+	Reason string `json:"reason"`
+
+	// Expressions are the results of the expressions evaluated.
+	Expressions []ExpressionEvaluation `json:"expressions,omitempty"`
+}
+
+// ExpressionEvaluation records the result of a filter expression.
+type ExpressionEvaluation struct {
+	// Expression is the filter expression.
+	Expression string `json:"expression"`
+
+	// Result is the result of the expression.
+	Result bool `json:"result"`
+
+	// Error is the error raised by the expression, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// newFilterTrace returns the trace of the filters of handler for event.
+func newFilterTrace(handler *corev2.Handler, event *corev2.Event) *FilterTrace {
+	trace := &FilterTrace{
+		Timestamp: time.Now().Unix(),
+		Namespace: event.Entity.Namespace,
+		Entity:    event.Entity.Name,
+		EventID:   event.GetUUID().String(),
+		Handler:   handler.Name,
+		traceAll:  filterTraceEnabled(event.Entity.Annotations),
+	}
+	if event.HasCheck() {
+		trace.Check = event.Check.Name
+		trace.traceAll = trace.traceAll || filterTraceEnabled(event.Check.Annotations)
+	}
+	return trace
+}
+
+// filterTraceEnabled returns true if annotations enable filter tracing.
+func filterTraceEnabled(annotations map[string]string) bool {
+	return annotations[FilterTraceAnnotation] == "true"
+}
+
+// evaluate returns the evaluation of the filter named name, or nil if the
+// filter is not traced.
+func (t *FilterTrace) evaluate(name string, filter *corev2.EventFilter) *FilterEvaluation {
+	if t == nil {
+		return nil
+	}
+	if !t.traceAll && (filter == nil || !filterTraceEnabled(filter.Annotations)) {
+		return nil
+	}
+	evaluation := &FilterEvaluation{Filter: name}
+	if filter != nil {
+		evaluation.Action = filter.Action
+	}
+	t.Filters = append(t.Filters, evaluation)
+	return evaluation
+}
+
+// conclude records the outcome of the evaluation.
+func (e *FilterEvaluation) conclude(denied bool, reason string) {
+	if e == nil {
+		return
+	}
+	e.Denied = denied
+	e.Reason = reason
+}
+
+// expression records the result of a filter expression.
+func (e *FilterEvaluation) expression(expression string, result bool, err error) {
+	if e == nil {
+		return
+	}
+	evaluation := ExpressionEvaluation{Expression: expression, Result: result}
+	if err != nil {
+		evaluation.Error = err.Error()
+	}
+	e.Expressions = append(e.Expressions, evaluation)
+}
+
+// FilterTracer keeps the most recent filter traces of a backend.
+type FilterTracer struct {
+	mu     sync.Mutex
+	traces []*FilterTrace
+	next   int
+	full   bool
+}
+
+// NewFilterTracer creates a FilterTracer keeping up to size traces.
+func NewFilterTracer(size int) *FilterTracer {
+	if size <= 0 {
+		size = DefaultFilterTraceSize
+	}
+	return &FilterTracer{traces: make([]*FilterTrace, size)}
+}
+
+// add records trace, unless it has no filter evaluation.
+func (t *FilterTracer) add(trace *FilterTrace) {
+	if t == nil || trace == nil || len(trace.Filters) == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.traces[t.next] = trace
+	t.next = (t.next + 1) % len(t.traces)
+	if t.next == 0 {
+		t.full = true
+	}
+}
+
+// Traces returns the traces of the events of a check and an entity, newest
+// first. The traces of events without check are returned when check is
+// empty. A nil FilterTracer has no traces.
+func (t *FilterTracer) Traces(namespace, entity, check string) []*FilterTrace {
+	traces := []*FilterTrace{}
+	if t == nil {
+		return traces
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	count := t.next
+	if t.full {
+		count = len(t.traces)
+	}
+	for i := 1; i <= count; i++ {
+		trace := t.traces[(t.next-i+len(t.traces))%len(t.traces)]
+		if trace.Namespace == namespace && trace.Entity == entity && trace.Check == check {
+			traces = append(traces, trace)
+		}
+	}
+	return traces
+}
@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterTracer(t *testing.T) {
+	tracer := NewFilterTracer(2)
+	for _, check := range []string{"check1", "check2", "check1", "check1"} {
+		tracer.add(&FilterTrace{
+			Namespace: "default",
+			Entity:    "entity1",
+			Check:     check,
+			Handler:   check,
+			Filters:   []*FilterEvaluation{{Filter: "is_incident"}},
+		})
+	}
+
+	// Only the two most recent traces are kept
+	traces := tracer.Traces("default", "entity1", "check1")
+	assert.Len(t, traces, 2)
+	assert.Empty(t, tracer.Traces("default", "entity1", "check2"))
+
+	// Traces without evaluation are not kept
+	tracer.add(&FilterTrace{Namespace: "default", Entity: "entity1", Check: "check3"})
+	assert.Empty(t, tracer.Traces("default", "entity1", "check3"))
+
+	var nilTracer *FilterTracer
+	assert.Empty(t, nilTracer.Traces("default", "entity1", "check1"))
+}
+
+func TestPipelineFilterTrace(t *testing.T) {
+	store := &mockstore.MockStore{}
+	tracer := NewFilterTracer(10)
+	p := &Pipeline{store: store, filterTracer: tracer}
+
+	tracedFilter := corev2.FixtureEventFilter("traced")
+	tracedFilter.Action = corev2.EventFilterActionAllow
+	tracedFilter.Expressions = []string{"event.check.status == 0", "event.check.status == 1"}
+	tracedFilter.Annotations = map[string]string{FilterTraceAnnotation: "true"}
+	store.On("GetEventFilterByName", mock.Anything, "traced").Return(tracedFilter, nil)
+
+	handler := corev2.FixtureHandler("handler1")
+	handler.Filters = []string{"is_incident", "traced"}
+
+	// Only the evaluations of the traced filter are recorded
+	event := corev2.FixtureEvent("entity1", "check1")
+	event.Check.Status = 1
+	filter, err := p.filterEvent(handler, event)
+	require.NoError(t, err)
+	assert.Equal(t, "traced", filter)
+
+	traces := tracer.Traces("default", "entity1", "check1")
+	require.Len(t, traces, 1)
+	assert.Equal(t, "traced", traces[0].FilteredBy)
+	assert.Equal(t, "handler1", traces[0].Handler)
+	require.Len(t, traces[0].Filters, 1)
+	evaluation := traces[0].Filters[0]
+	assert.Equal(t, "traced", evaluation.Filter)
+	assert.Equal(t, corev2.EventFilterActionAllow, evaluation.Action)
+	assert.True(t, evaluation.Denied)
+	assert.Equal(t, []ExpressionEvaluation{{Expression: "event.check.status == 0", Result: false}}, evaluation.Expressions)
+
+	// Every filter is traced if the check enables filter tracing
+	event = corev2.FixtureEvent("entity1", "check2")
+	event.Check.Annotations = map[string]string{FilterTraceAnnotation: "true"}
+	filter, err = p.filterEvent(handler, event)
+	require.NoError(t, err)
+	assert.Equal(t, "is_incident", filter)
+
+	traces = tracer.Traces("default", "entity1", "check2")
+	require.Len(t, traces, 1)
+	require.Len(t, traces[0].Filters, 1)
+	assert.Equal(t, "is_incident", traces[0].Filters[0].Filter)
+	assert.Equal(t, "event is not an incident or a resolution", traces[0].Filters[0].Reason)
+}
@@ -3,10 +3,12 @@ package pipelined
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/asset"
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -15,9 +17,62 @@ import (
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/rpc"
+	"github.com/sensu/sensu-go/tracing"
 )
 
-var defaultStoreTimeout = time.Minute
+const (
+	// BackpressureBlock is the backpressure policy blocking the event bus
+	// until the pipelines can handle new events.
+	BackpressureBlock = "block"
+
+	// BackpressureShed is the backpressure policy dropping the events that
+	// cannot be buffered until the pipelines can handle them.
+	BackpressureShed = "shed"
+
+	// EventsShedCounter is the name of the prometheus counter used to count
+	// the events dropped by pipelined.
+	EventsShedCounter = "sensu_go_pipelined_events_shed"
+
+	// BacklogGauge is the name of the prometheus gauge used to report the
+	// number of events waiting to be handled by pipelined.
+	BacklogGauge = "sensu_go_pipelined_backlog"
+
+	// EventDurationHistogram is the name of the prometheus histogram used to
+	// observe the duration of the handling of events by the pipelines.
+	EventDurationHistogram = "sensu_go_pipelined_event_duration_seconds"
+)
+
+var (
+	defaultStoreTimeout = time.Minute
+
+	// EventsShed counts the events dropped by pipelined because its buffer
+	// was full.
+	EventsShed = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: EventsShedCounter,
+			Help: "The total number of events dropped by pipelined because its buffer was full",
+		},
+	)
+
+	// Backlog reports the number of events waiting to be handled, as of the
+	// last event taken from the backlog.
+	Backlog = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: BacklogGauge,
+			Help: "The number of events waiting to be handled by pipelined",
+		},
+	)
+
+	// EventDuration observes the duration of the handling of events by the
+	// pipelines, filters, mutators and handlers included.
+	EventDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    EventDurationHistogram,
+			Help:    "The duration of the handling of events by pipelined, in seconds",
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		},
+	)
+)
 
 // ExtensionExecutorGetterFunc gets an ExtensionExecutor. Used to decouple
 // Pipelined from gRPC.
@@ -34,6 +89,15 @@ type Pipelined struct {
 	wg                     *sync.WaitGroup
 	errChan                chan error
 	eventChan              chan interface{}
+	receiver               chan interface{}
+	backpressure           string
+	handlerLimiter         *pipeline.HandlerLimiter
+	filterTracer           *pipeline.FilterTracer
+	influxDBWriters        *pipeline.InfluxDBWriters
+	kafkaProducers         *pipeline.KafkaProducers
+	enrichmentCache        *pipeline.EnrichmentCache
+	correlationGroups      *pipeline.CorrelationGroups
+	tracer                 *tracing.Tracer
 	subscription           messaging.Subscription
 	store                  store.Store
 	bus                    messaging.MessageBus
@@ -56,6 +120,21 @@ type Config struct {
 	StoreTimeout            time.Duration
 	SecretsProviderManager  *secrets.ProviderManager
 	BackendEntity           *corev2.Entity
+
+	// HandlerConcurrency is the maximum number of concurrent executions of
+	// every handler. The executions are not limited if it is zero.
+	HandlerConcurrency int
+
+	// BackpressurePolicy determines what happens to the events received
+	// while the buffer is full, either BackpressureBlock (the default) or
+	// BackpressureShed.
+	BackpressurePolicy string
+
+	// FilterTracer records the traced filter evaluations.
+	FilterTracer *pipeline.FilterTracer
+
+	// Tracer traces the handling of the events, if set.
+	Tracer *tracing.Tracer
 }
 
 // Option is a functional option used to configure Pipelined.
@@ -75,6 +154,13 @@ func New(c Config, options ...Option) (*Pipelined, error) {
 		logger.Warn("StoreTimeout not configured")
 		c.StoreTimeout = defaultStoreTimeout
 	}
+	switch c.BackpressurePolicy {
+	case "":
+		c.BackpressurePolicy = BackpressureBlock
+	case BackpressureBlock, BackpressureShed:
+	default:
+		return nil, fmt.Errorf("invalid backpressure policy %q, must be one of %q or %q", c.BackpressurePolicy, BackpressureBlock, BackpressureShed)
+	}
 
 	p := &Pipelined{
 		store:                  c.Store,
@@ -91,18 +177,37 @@ func New(c Config, options ...Option) (*Pipelined, error) {
 		storeTimeout:           c.StoreTimeout,
 		secretsProviderManager: c.SecretsProviderManager,
 		backendEntity:          c.BackendEntity,
+		backpressure:           c.BackpressurePolicy,
+		handlerLimiter:         pipeline.NewHandlerLimiter(c.HandlerConcurrency),
+		filterTracer:           c.FilterTracer,
+		influxDBWriters:        pipeline.NewInfluxDBWriters(),
+		kafkaProducers:         pipeline.NewKafkaProducers(),
+		enrichmentCache:        pipeline.NewEnrichmentCache(),
+		correlationGroups:      pipeline.NewCorrelationGroups(),
+		tracer:                 c.Tracer,
+	}
+	p.receiver = p.eventChan
+	if p.backpressure == BackpressureShed {
+		p.receiver = make(chan interface{})
 	}
 	for _, o := range options {
 		if err := o(p); err != nil {
 			return nil, err
 		}
 	}
+
+	_ = prometheus.Register(pipeline.HandlerExecutions)
+	_ = prometheus.Register(pipeline.HandlerDuration)
+	_ = prometheus.Register(EventsShed)
+	_ = prometheus.Register(Backlog)
+	_ = prometheus.Register(EventDuration)
+
 	return p, nil
 }
 
 // Receiver returns the event channel for pipelined.
 func (p *Pipelined) Receiver() chan<- interface{} {
-	return p.eventChan
+	return p.receiver
 }
 
 // Start pipelined, subscribing to the "event" message bus topic to
@@ -116,17 +221,45 @@ func (p *Pipelined) Start() error {
 
 	p.createPipelines(p.workerCount, p.eventChan)
 
+	if p.backpressure == BackpressureShed {
+		p.wg.Add(1)
+		go p.shed()
+	}
+
 	return nil
 }
 
+// shed buffers the events received, dropping them if the buffer is full.
+func (p *Pipelined) shed() {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.stopping:
+			return
+		case msg := <-p.receiver:
+			select {
+			case p.eventChan <- msg:
+			default:
+				EventsShed.Inc()
+				logger.Warn("pipelined buffer is full, dropping event")
+			}
+		}
+	}
+}
+
 // Stop pipelined.
 func (p *Pipelined) Stop() error {
 	p.running.Store(false)
 	close(p.stopping)
 	p.wg.Wait()
+	p.influxDBWriters.Flush()
+	p.kafkaProducers.Close()
 	close(p.errChan)
 	err := p.subscription.Cancel()
 	close(p.eventChan)
+	if p.receiver != p.eventChan {
+		close(p.receiver)
+	}
 
 	return err
 }
@@ -141,6 +274,12 @@ func (p *Pipelined) Name() string {
 	return "pipelined"
 }
 
+// QueueDepth returns the number of events waiting to be handled, and the
+// capacity of the backlog.
+func (p *Pipelined) QueueDepth() (int, int) {
+	return len(p.eventChan), cap(p.eventChan)
+}
+
 // createPipelines creates several goroutines, responsible for pulling
 // Sensu events from a channel (bound to message bus "event" topic)
 // and for handling them.
@@ -153,6 +292,12 @@ func (p *Pipelined) createPipelines(count int, channel chan interface{}) {
 			StoreTimeout:            p.storeTimeout,
 			SecretsProviderManager:  p.secretsProviderManager,
 			BackendEntity:           p.backendEntity,
+			HandlerLimiter:          p.handlerLimiter,
+			FilterTracer:            p.filterTracer,
+			InfluxDBWriters:         p.influxDBWriters,
+			KafkaProducers:          p.kafkaProducers,
+			EnrichmentCache:         p.enrichmentCache,
+			CorrelationGroups:       p.correlationGroups,
 		})
 		p.wg.Add(1)
 		go func() {
@@ -162,14 +307,21 @@ func (p *Pipelined) createPipelines(count int, channel chan interface{}) {
 				case <-p.stopping:
 					return
 				case msg := <-channel:
+					Backlog.Set(float64(len(channel)))
 					event, ok := msg.(*corev2.Event)
 					if !ok {
 						continue
 					}
 
-					ctx, cancel := context.WithCancel(context.Background())
+					start := time.Now()
+					span := p.tracer.StartFromTraceParent(event.TraceParent, "pipelined.handle")
+					tracing.SetEventAttributes(span, event)
+					ctx, cancel := context.WithCancel(tracing.ContextWithSpan(context.Background(), span))
 					err := pipeline.HandleEvent(ctx, event)
 					cancel()
+					span.SetError(err)
+					span.End()
+					EventDuration.Observe(time.Since(start).Seconds())
 					if err != nil {
 						if _, ok := err.(*store.ErrInternal); ok {
 							select {
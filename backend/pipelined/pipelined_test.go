@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
@@ -42,3 +43,27 @@ func TestPipelined(t *testing.T) {
 
 	assert.NoError(t, p.Stop())
 }
+
+func TestNewInvalidBackpressurePolicy(t *testing.T) {
+	_, err := New(Config{BackpressurePolicy: "drop"})
+	assert.Error(t, err)
+}
+
+func TestPipelinedShed(t *testing.T) {
+	p, err := New(Config{BufferSize: 1, BackpressurePolicy: BackpressureShed})
+	require.NoError(t, err)
+
+	before := testutil.ToFloat64(EventsShed)
+	p.wg.Add(1)
+	go p.shed()
+
+	// No pipeline consumes the buffer, so only the first event is buffered
+	for i := 0; i < 3; i++ {
+		p.Receiver() <- types.FixtureEvent("entity1", "check1")
+	}
+	close(p.stopping)
+	p.wg.Wait()
+
+	assert.Equal(t, 1, len(p.eventChan))
+	assert.Equal(t, before+2, testutil.ToFloat64(EventsShed))
+}
@@ -0,0 +1,172 @@
+// Package prober performs the HTTP, TCP and ICMP probes of the checks
+// executed by the backend against proxy entities.
+package prober
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+)
+
+const (
+	// DefaultTimeout is the timeout of the probes of the checks without one
+	DefaultTimeout = 10 * time.Second
+
+	// protocolICMP is the IANA number of the ICMP protocol
+	protocolICMP = 1
+)
+
+// Result is the result of a probe
+type Result struct {
+	// Status is 0 if the probe succeeded, 2 otherwise
+	Status uint32
+
+	// Output describes the result of the probe
+	Output string
+
+	// Duration is the time the probe took
+	Duration time.Duration
+}
+
+// Probe performs probe, and gives up once ctx is done.
+func Probe(ctx context.Context, probe *corev2.CheckProbe) Result {
+	start := time.Now()
+
+	var output string
+	var err error
+	switch probe.Type {
+	case corev2.ProbeTypeHTTP:
+		output, err = probeHTTP(ctx, probe.Target, probe.ExpectedStatus)
+	case corev2.ProbeTypeTCP:
+		output, err = probeTCP(ctx, probe.Target)
+	case corev2.ProbeTypeICMP:
+		output, err = probeICMP(ctx, probe.Target)
+	default:
+		err = fmt.Errorf("unknown probe type %q", probe.Type)
+	}
+
+	result := Result{Duration: time.Since(start)}
+	if err != nil {
+		result.Status = 2
+		result.Output = fmt.Sprintf("%s CRITICAL: %s", probeName(probe.Type), err)
+		return result
+	}
+	result.Output = fmt.Sprintf("%s OK: %s in %s", probeName(probe.Type), output, result.Duration.Round(time.Millisecond))
+	return result
+}
+
+func probeName(probeType string) string {
+	switch probeType {
+	case corev2.ProbeTypeHTTP:
+		return "HTTP"
+	case corev2.ProbeTypeTCP:
+		return "TCP"
+	case corev2.ProbeTypeICMP:
+		return "ICMP"
+	}
+	return "PROBE"
+}
+
+// probeHTTP requests url and checks the status of the response.
+func probeHTTP(ctx context.Context, url string, expectedStatus uint32) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+
+	if expectedStatus != 0 && uint32(resp.StatusCode) != expectedStatus {
+		return "", fmt.Errorf("%s returned %s, expected %d", url, resp.Status, expectedStatus)
+	}
+	if expectedStatus == 0 && resp.StatusCode >= 400 {
+		return "", fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return fmt.Sprintf("%s returned %s", url, resp.Status), nil
+}
+
+// probeTCP opens a TCP connection to address.
+func probeTCP(ctx context.Context, address string) (string, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+	_ = conn.Close()
+	return fmt.Sprintf("connected to %s", address), nil
+}
+
+// probeICMP sends an ICMP echo request to the IPv4 address of host, and waits
+// for the echo reply. It uses an unprivileged datagram socket when the system
+// allows it, and a raw socket otherwise.
+func probeICMP(ctx context.Context, host string) (string, error) {
+	ipAddr, err := net.ResolveIPAddr("ip4", host)
+	if err != nil {
+		return "", err
+	}
+
+	var dst net.Addr = &net.UDPAddr{IP: ipAddr.IP}
+	conn, err := icmp.ListenPacket("udp4", "0.0.0.0")
+	if err != nil {
+		dst = ipAddr
+		if conn, err = icmp.ListenPacket("ip4:icmp", "0.0.0.0"); err != nil {
+			return "", err
+		}
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return "", err
+	}
+
+	seq := int(time.Now().UnixNano() & 0xffff)
+	msg := icmp.Message{
+		Type: ipv4.ICMPTypeEcho,
+		Body: &icmp.Echo{
+			ID:   os.Getpid() & 0xffff,
+			Seq:  seq,
+			Data: []byte("sensu"),
+		},
+	}
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return "", err
+	}
+	if _, err := conn.WriteTo(b, dst); err != nil {
+		return "", err
+	}
+
+	reply := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFrom(reply)
+		if err != nil {
+			return "", err
+		}
+		rm, err := icmp.ParseMessage(protocolICMP, reply[:n])
+		if err != nil {
+			continue
+		}
+		if rm.Type != ipv4.ICMPTypeEchoReply {
+			continue
+		}
+		echo, ok := rm.Body.(*icmp.Echo)
+		if !ok || echo.Seq != seq {
+			continue
+		}
+		return fmt.Sprintf("echo reply received from %s", ipAddr), nil
+	}
+}
@@ -0,0 +1,77 @@
+package prober
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeHTTP(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name       string
+		probe      *corev2.CheckProbe
+		wantStatus uint32
+	}{
+		{
+			name:       "ok",
+			probe:      &corev2.CheckProbe{Type: corev2.ProbeTypeHTTP, Target: ts.URL},
+			wantStatus: 0,
+		},
+		{
+			name:       "error status",
+			probe:      &corev2.CheckProbe{Type: corev2.ProbeTypeHTTP, Target: ts.URL + "/missing"},
+			wantStatus: 2,
+		},
+		{
+			name:       "expected status",
+			probe:      &corev2.CheckProbe{Type: corev2.ProbeTypeHTTP, Target: ts.URL + "/missing", ExpectedStatus: 404},
+			wantStatus: 0,
+		},
+		{
+			name:       "unexpected status",
+			probe:      &corev2.CheckProbe{Type: corev2.ProbeTypeHTTP, Target: ts.URL, ExpectedStatus: 204},
+			wantStatus: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Probe(context.Background(), tt.probe)
+			assert.Equal(t, tt.wantStatus, result.Status, result.Output)
+		})
+	}
+}
+
+func TestProbeTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := ln.Addr().String()
+
+	result := Probe(context.Background(), &corev2.CheckProbe{Type: corev2.ProbeTypeTCP, Target: address})
+	assert.Equal(t, uint32(0), result.Status, result.Output)
+	assert.True(t, strings.HasPrefix(result.Output, "TCP OK: connected to "+address))
+
+	_ = ln.Close()
+	result = Probe(context.Background(), &corev2.CheckProbe{Type: corev2.ProbeTypeTCP, Target: address})
+	assert.Equal(t, uint32(2), result.Status)
+	assert.True(t, strings.HasPrefix(result.Output, "TCP CRITICAL: "))
+}
+
+func TestProbeUnknownType(t *testing.T) {
+	result := Probe(context.Background(), &corev2.CheckProbe{Type: "smtp", Target: "localhost"})
+	assert.Equal(t, uint32(2), result.Status)
+}
@@ -0,0 +1,163 @@
+// Package quota enforces the resource quotas of the namespaces when the
+// resources are created through the API.
+package quota
+
+import (
+	"context"
+	"reflect"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// kinds are the resource types limited by the resource quotas, except the
+// events, which are not stored as resources.
+var kinds = map[string]corev2.Resource{
+	corev2.ChecksResource:   &corev2.CheckConfig{},
+	corev2.EntitiesResource: &corev2.Entity{},
+	corev2.HandlersResource: &corev2.Handler{},
+}
+
+// Enforcer rejects the new resources that would exceed a resource quota of
+// their namespace. The resources are counted before they are written, so
+// concurrent requests can exceed a quota by a few resources.
+type Enforcer struct {
+	Store      store.Store
+	EventStore store.EventStore
+}
+
+// Enforce returns a *store.ErrQuotaExceeded if the given resource does not
+// exist yet and a resource quota of its namespace does not allow another
+// resource of its type.
+func (e *Enforcer) Enforce(ctx context.Context, resource corev2.Resource) error {
+	kind := resource.RBACName()
+	if _, ok := kinds[kind]; !ok {
+		return nil
+	}
+	meta := resource.GetObjectMeta()
+	ctx = store.NamespaceContext(ctx, meta.Namespace)
+
+	quotas, err := e.quotas(ctx, kind)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+
+	existing := reflect.New(reflect.TypeOf(resource).Elem()).Interface().(corev2.Resource)
+	switch err := e.Store.GetResource(ctx, meta.Name, existing); err.(type) {
+	case nil:
+		return nil
+	case *store.ErrNotFound:
+	default:
+		return err
+	}
+
+	count, err := e.count(ctx, kind)
+	if err != nil {
+		return err
+	}
+	return exceeded(quotas, kind, count)
+}
+
+// EnforceEvent returns a *store.ErrQuotaExceeded if the given event does not
+// exist yet and a resource quota of its namespace does not allow another
+// event.
+func (e *Enforcer) EnforceEvent(ctx context.Context, event *corev2.Event) error {
+	if !event.HasCheck() || event.Entity == nil {
+		return nil
+	}
+	ctx = store.NamespaceContext(ctx, event.Entity.Namespace)
+
+	quotas, err := e.quotas(ctx, corev2.EventsResource)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+
+	existing, err := e.EventStore.GetEventByEntityCheck(ctx, event.Entity.Name, event.Check.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	count, err := e.count(ctx, corev2.EventsResource)
+	if err != nil {
+		return err
+	}
+	return exceeded(quotas, corev2.EventsResource, count)
+}
+
+// Usage returns the number of resources of each type limited by the resource
+// quotas in the namespace of the given quota, along with the limits of the
+// quota.
+func (e *Enforcer) Usage(ctx context.Context, quota *corev2.ResourceQuota) (map[string]corev2.ResourceUsage, error) {
+	ctx = store.NamespaceContext(ctx, quota.Namespace)
+	limits := quota.Limits()
+
+	usage := map[string]corev2.ResourceUsage{}
+	for _, kind := range []string{corev2.ChecksResource, corev2.EntitiesResource, corev2.HandlersResource, corev2.EventsResource} {
+		if kind == corev2.EventsResource && e.EventStore == nil {
+			continue
+		}
+		count, err := e.count(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+		usage[kind] = corev2.ResourceUsage{Used: count, Limit: limits[kind]}
+	}
+	return usage, nil
+}
+
+// quotas returns the resource quotas of the namespace of ctx limiting the
+// given resource type.
+func (e *Enforcer) quotas(ctx context.Context, kind string) ([]*corev2.ResourceQuota, error) {
+	var quotas []*corev2.ResourceQuota
+	if err := e.Store.ListResources(ctx, corev2.ResourceQuotasResource, &quotas, &store.SelectionPredicate{}); err != nil {
+		return nil, err
+	}
+
+	limiting := quotas[:0]
+	for _, quota := range quotas {
+		if _, ok := quota.Limits()[kind]; ok {
+			limiting = append(limiting, quota)
+		}
+	}
+	return limiting, nil
+}
+
+// count returns the number of resources of the given type in the namespace of
+// ctx.
+func (e *Enforcer) count(ctx context.Context, kind string) (int64, error) {
+	if kind == corev2.EventsResource {
+		return e.EventStore.CountEvents(ctx, &store.SelectionPredicate{})
+	}
+
+	resource := kinds[kind]
+	if counter, ok := e.Store.(store.ResourceCounter); ok {
+		return counter.CountResources(ctx, resource.StorePrefix())
+	}
+
+	list := reflect.New(reflect.SliceOf(reflect.TypeOf(resource)))
+	if err := e.Store.ListResources(ctx, resource.StorePrefix(), list.Interface(), &store.SelectionPredicate{}); err != nil {
+		return 0, err
+	}
+	return int64(list.Elem().Len()), nil
+}
+
+// exceeded returns a *store.ErrQuotaExceeded if one of the given quotas does
+// not allow another resource of the given type, given the number of existing
+// resources.
+func exceeded(quotas []*corev2.ResourceQuota, kind string, count int64) error {
+	for _, quota := range quotas {
+		limit := quota.Limits()[kind]
+		if count >= int64(limit) {
+			return &store.ErrQuotaExceeded{
+				Quota:     quota.Name,
+				Namespace: quota.Namespace,
+				Resource:  kind,
+				Limit:     limit,
+			}
+		}
+	}
+	return nil
+}
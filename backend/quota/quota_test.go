@@ -0,0 +1,155 @@
+package quota
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockQuotas(s *mockstore.MockStore, quotas ...*corev2.ResourceQuota) {
+	s.On("ListResources", mock.Anything, corev2.ResourceQuotasResource, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.ResourceQuota)
+			*list = quotas
+		}).Return(nil)
+}
+
+func TestEnforce(t *testing.T) {
+	tests := []struct {
+		name     string
+		quotas   []*corev2.ResourceQuota
+		existing bool
+		count    int64
+		wantErr  bool
+	}{
+		{
+			name: "no quota",
+		},
+		{
+			name:   "below the limit",
+			quotas: []*corev2.ResourceQuota{corev2.FixtureResourceQuota("quota")},
+			count:  99,
+		},
+		{
+			name:    "at the limit",
+			quotas:  []*corev2.ResourceQuota{corev2.FixtureResourceQuota("quota")},
+			count:   100,
+			wantErr: true,
+		},
+		{
+			name:     "existing check at the limit",
+			quotas:   []*corev2.ResourceQuota{corev2.FixtureResourceQuota("quota")},
+			existing: true,
+			count:    100,
+		},
+		{
+			name: "quota without a check limit",
+			quotas: []*corev2.ResourceQuota{
+				{ObjectMeta: corev2.NewObjectMeta("quota", "default"), Entities: 1},
+			},
+			count: 100,
+		},
+		{
+			name: "lowest quota",
+			quotas: []*corev2.ResourceQuota{
+				corev2.FixtureResourceQuota("quota"),
+				{ObjectMeta: corev2.NewObjectMeta("strict", "default"), Checks: 10},
+			},
+			count:   10,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			mockQuotas(s, tt.quotas...)
+			var err error
+			if !tt.existing {
+				err = &store.ErrNotFound{}
+			}
+			s.On("GetResource", mock.Anything, "check", mock.Anything).Return(err)
+			s.On("CountResources", mock.Anything, "checks").Return(tt.count, nil)
+
+			enforcer := &Enforcer{Store: s}
+			err = enforcer.Enforce(context.Background(), corev2.FixtureCheckConfig("check"))
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			require.IsType(t, &store.ErrQuotaExceeded{}, err)
+			assert.Equal(t, corev2.ChecksResource, err.(*store.ErrQuotaExceeded).Resource)
+		})
+	}
+}
+
+func TestEnforceListing(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockQuotas(s, &corev2.ResourceQuota{ObjectMeta: corev2.NewObjectMeta("quota", "default"), Handlers: 2})
+	s.On("GetResource", mock.Anything, "handler", mock.Anything).Return(&store.ErrNotFound{})
+	s.On("ListResources", mock.Anything, "handlers", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			list := args.Get(2).(*[]*corev2.Handler)
+			*list = []*corev2.Handler{corev2.FixtureHandler("a"), corev2.FixtureHandler("b")}
+		}).Return(nil)
+
+	// Hide the CountResources method of the store
+	enforcer := &Enforcer{Store: struct{ store.Store }{s}}
+	err := enforcer.Enforce(context.Background(), corev2.FixtureHandler("handler"))
+	assert.Equal(t, &store.ErrQuotaExceeded{Quota: "quota", Namespace: "default", Resource: "handlers", Limit: 2}, err)
+}
+
+func TestEnforceIgnoredResource(t *testing.T) {
+	enforcer := &Enforcer{Store: &mockstore.MockStore{}}
+	assert.NoError(t, enforcer.Enforce(context.Background(), corev2.FixtureMutator("mutator")))
+}
+
+func TestEnforceEvent(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockQuotas(s, &corev2.ResourceQuota{ObjectMeta: corev2.NewObjectMeta("quota", "default"), Events: 1})
+	s.On("GetEventByEntityCheck", mock.Anything, "entity", "existing").Return(corev2.FixtureEvent("entity", "existing"), nil)
+	s.On("GetEventByEntityCheck", mock.Anything, "entity", "new").Return((*corev2.Event)(nil), nil)
+	s.On("CountEvents", mock.Anything, mock.Anything).Return(int64(1), nil)
+
+	enforcer := &Enforcer{Store: s, EventStore: s}
+	assert.NoError(t, enforcer.EnforceEvent(context.Background(), corev2.FixtureEvent("entity", "existing")))
+	err := enforcer.EnforceEvent(context.Background(), corev2.FixtureEvent("entity", "new"))
+	assert.IsType(t, &store.ErrQuotaExceeded{}, err)
+}
+
+func TestUsage(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("CountResources", mock.Anything, "checks").Return(int64(3), nil)
+	s.On("CountResources", mock.Anything, "entities").Return(int64(2), nil)
+	s.On("CountResources", mock.Anything, "handlers").Return(int64(1), nil)
+	s.On("CountEvents", mock.Anything, mock.Anything).Return(int64(4), nil)
+
+	enforcer := &Enforcer{Store: s, EventStore: s}
+	usage, err := enforcer.Usage(context.Background(), corev2.FixtureResourceQuota("quota"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]corev2.ResourceUsage{
+		"checks":   {Used: 3, Limit: 100},
+		"entities": {Used: 2, Limit: 1000},
+		"handlers": {Used: 1, Limit: 10},
+		"events":   {Used: 4},
+	}, usage)
+}
+
+func TestStore(t *testing.T) {
+	s := &mockstore.MockStore{}
+	mockQuotas(s, &corev2.ResourceQuota{ObjectMeta: corev2.NewObjectMeta("quota", "default"), Entities: 1})
+	s.On("GetResource", mock.Anything, mock.Anything, mock.Anything).Return(&store.ErrNotFound{})
+	s.On("CountResources", mock.Anything, "entities").Return(int64(1), nil)
+	s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Return(nil)
+
+	quota := NewStore(s)
+	err := quota.CreateOrUpdateResource(context.Background(), corev2.FixtureEntity("entity"))
+	assert.IsType(t, &store.ErrQuotaExceeded{}, err)
+	assert.NoError(t, quota.CreateOrUpdateResource(context.Background(), corev2.FixtureCheckConfig("check")))
+	s.AssertNumberOfCalls(t, "CreateOrUpdateResource", 1)
+}
@@ -0,0 +1,39 @@
+package quota
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// Store is a store that enforces the resource quotas before the resources are
+// created with its CreateResource and CreateOrUpdateResource methods.
+type Store struct {
+	store.Store
+	enforcer *Enforcer
+}
+
+// NewStore returns a store enforcing the resource quotas before writing the
+// resources to the given store.
+func NewStore(s store.Store) store.Store {
+	quota := &Store{Store: s, enforcer: &Enforcer{Store: s}}
+	return store.WrapWatcher(quota, s)
+}
+
+// CreateResource enforces the resource quotas and creates the given resource.
+func (s *Store) CreateResource(ctx context.Context, resource corev2.Resource) error {
+	if err := s.enforcer.Enforce(ctx, resource); err != nil {
+		return err
+	}
+	return s.Store.CreateResource(ctx, resource)
+}
+
+// CreateOrUpdateResource enforces the resource quotas, in case the given
+// resource does not exist yet, and creates or updates it.
+func (s *Store) CreateOrUpdateResource(ctx context.Context, resource corev2.Resource) error {
+	if err := s.enforcer.Enforce(ctx, resource); err != nil {
+		return err
+	}
+	return s.Store.CreateOrUpdateResource(ctx, resource)
+}
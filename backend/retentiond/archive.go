@@ -0,0 +1,235 @@
+package retentiond
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+const (
+	// archiveTimeout is the timeout of the uploads of the archives.
+	archiveTimeout = time.Minute
+
+	// awsSigningAlgorithm is the algorithm of the AWS signature version 4
+	awsSigningAlgorithm = "AWS4-HMAC-SHA256"
+
+	// ndjsonContentType is the content type of the archives.
+	ndjsonContentType = "application/x-ndjson"
+)
+
+// Archiver archives the expired occurrences of the events, encoded as
+// newline-delimited JSON, in an object of the given name.
+type Archiver interface {
+	Archive(ctx context.Context, name string, data []byte) error
+}
+
+// NewArchiver returns the archiver of the given archive. The s3 archives use
+// the static credentials of AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN if they are set, or else the credentials of the ECS task
+// or of the role of the EC2 instance. The gcs archives use the access token of
+// GOOGLE_OAUTH_ACCESS_TOKEN if it is set, or else the one of the service
+// account of the Compute Engine instance. The static credentials are never
+// refreshed, so a static GOOGLE_OAUTH_ACCESS_TOKEN expires after an hour;
+// the other ones are refreshed before they expire. Shared configuration files
+// and service account keys are not supported.
+func NewArchiver(archive *corev2.EventArchive) (Archiver, error) {
+	switch archive.Provider {
+	case corev2.EventArchiveS3:
+		return newS3Archiver(archive)
+	case corev2.EventArchiveGCS:
+		return newGCSArchiver(archive)
+	default:
+		return nil, fmt.Errorf("archive provider %q is not supported", archive.Provider)
+	}
+}
+
+// s3Archiver archives the events in an Amazon S3 bucket, or in a bucket of
+// an S3-compatible storage.
+type s3Archiver struct {
+	client      *http.Client
+	endpoint    string
+	bucket      string
+	region      string
+	credentials awsCredentialsProvider
+	now         func() time.Time
+}
+
+func newS3Archiver(archive *corev2.EventArchive) (*s3Archiver, error) {
+	a := &s3Archiver{
+		client:      http.DefaultClient,
+		endpoint:    strings.TrimSuffix(archive.Endpoint, "/"),
+		bucket:      archive.Bucket,
+		region:      archive.Region,
+		credentials: newAWSCredentialsProvider(http.DefaultClient),
+		now:         time.Now,
+	}
+	if a.region == "" {
+		// The S3-compatible storages without regions expect this one
+		a.region = "us-east-1"
+	}
+	if a.endpoint == "" {
+		a.endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", a.region)
+	}
+	return a, nil
+}
+
+// Archive uploads the data to the object of the given name, with a path-style
+// request.
+func (a *s3Archiver) Archive(ctx context.Context, name string, data []byte) error {
+	u, err := url.Parse(a.endpoint + "/" + awsEscape(a.bucket) + "/" + awsEscape(name))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ndjsonContentType)
+	credentials, err := a.credentials(ctx)
+	if err != nil {
+		return err
+	}
+	a.sign(req, data, credentials, a.now().UTC())
+	return do(ctx, a.client, req)
+}
+
+// sign signs the request with the AWS signature version 4.
+func (a *s3Archiver) sign(req *http.Request, payload []byte, credentials awsCredentials, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := hexSHA256(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	if credentials.Token != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.Token)
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += "x-amz-security-token:" + credentials.Token + "\n"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, a.region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		awsSigningAlgorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), date)
+	key = hmacSHA256(key, a.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigningAlgorithm, credentials.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// gcsArchiver archives the events in a Google Cloud Storage bucket.
+type gcsArchiver struct {
+	client   *http.Client
+	endpoint string
+	bucket   string
+	token    gcpTokenProvider
+}
+
+func newGCSArchiver(archive *corev2.EventArchive) (*gcsArchiver, error) {
+	a := &gcsArchiver{
+		client:   http.DefaultClient,
+		endpoint: strings.TrimSuffix(archive.Endpoint, "/"),
+		bucket:   archive.Bucket,
+		token:    newGCPTokenProvider(http.DefaultClient),
+	}
+	if a.endpoint == "" {
+		a.endpoint = "https://storage.googleapis.com"
+	}
+	return a, nil
+}
+
+// Archive uploads the data to the object of the given name, with a simple
+// upload of the JSON API.
+func (a *gcsArchiver) Archive(ctx context.Context, name string, data []byte) error {
+	query := url.Values{}
+	query.Set("uploadType", "media")
+	query.Set("name", name)
+	u := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?%s", a.endpoint, url.PathEscape(a.bucket), query.Encode())
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	token, err := a.token(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ndjsonContentType)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return do(ctx, a.client, req)
+}
+
+// do performs the upload request.
+func do(ctx context.Context, client *http.Client, req *http.Request) error {
+	ctx, cancel := context.WithTimeout(ctx, archiveTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s returned %s", req.URL.Host, resp.Status)
+	}
+	return nil
+}
+
+// awsEscape escapes the path like the canonical URIs of the AWS signature
+// version 4, all the characters but the unreserved ones and the slashes.
+func awsEscape(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hexSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
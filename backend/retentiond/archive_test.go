@@ -0,0 +1,102 @@
+package retentiond
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setenv(t *testing.T, key, value string) func() {
+	t.Helper()
+	previous, ok := os.LookupEnv(key)
+	require.NoError(t, os.Setenv(key, value))
+	return func() {
+		if ok {
+			_ = os.Setenv(key, previous)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	}
+}
+
+func TestS3Archiver(t *testing.T) {
+	defer setenv(t, "AWS_ACCESS_KEY_ID", "key")()
+	defer setenv(t, "AWS_SECRET_ACCESS_KEY", "secret")()
+
+	var req *http.Request
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer server.Close()
+
+	archiver, err := NewArchiver(&corev2.EventArchive{
+		Provider: corev2.EventArchiveS3,
+		Bucket:   "events",
+		Region:   "eu-west-1",
+		Endpoint: server.URL,
+	})
+	require.NoError(t, err)
+	require.NoError(t, archiver.Archive(context.Background(), "sensu/default/events 1.ndjson", []byte("{}\n")))
+
+	assert.Equal(t, http.MethodPut, req.Method)
+	assert.Equal(t, "/events/sensu/default/events%201.ndjson", req.URL.EscapedPath())
+	assert.Equal(t, "{}\n", body)
+	assert.Equal(t, hexSHA256([]byte("{}\n")), req.Header.Get("X-Amz-Content-Sha256"))
+	auth := req.Header.Get("Authorization")
+	assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=key/"), auth)
+	assert.Contains(t, auth, "/eu-west-1/s3/aws4_request")
+}
+
+func TestGCSArchiver(t *testing.T) {
+	defer setenv(t, "GOOGLE_OAUTH_ACCESS_TOKEN", "token")()
+
+	var req *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+	}))
+	defer server.Close()
+
+	archiver, err := NewArchiver(&corev2.EventArchive{
+		Provider: corev2.EventArchiveGCS,
+		Bucket:   "events",
+		Endpoint: server.URL,
+	})
+	require.NoError(t, err)
+	require.NoError(t, archiver.Archive(context.Background(), "default/events.ndjson", []byte("{}\n")))
+
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "/upload/storage/v1/b/events/o", req.URL.Path)
+	assert.Equal(t, "default/events.ndjson", req.URL.Query().Get("name"))
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+	assert.Equal(t, ndjsonContentType, req.Header.Get("Content-Type"))
+}
+
+func TestArchiverErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "denied", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	// Without credentials in the environment, nor a metadata server
+	defer setenv(t, "GOOGLE_OAUTH_ACCESS_TOKEN", "")()
+	defer setenv(t, "GCE_METADATA_HOST", strings.TrimPrefix(server.URL, "http://"))()
+	archiver, err := NewArchiver(&corev2.EventArchive{Provider: corev2.EventArchiveGCS, Bucket: "events", Endpoint: server.URL})
+	require.NoError(t, err)
+	assert.Error(t, archiver.Archive(context.Background(), "events.ndjson", nil))
+
+	defer setenv(t, "GOOGLE_OAUTH_ACCESS_TOKEN", "token")()
+	archiver, err = NewArchiver(&corev2.EventArchive{Provider: corev2.EventArchiveGCS, Bucket: "events", Endpoint: server.URL})
+	require.NoError(t, err)
+	assert.Error(t, archiver.Archive(context.Background(), "events.ndjson", nil))
+}
@@ -0,0 +1,277 @@
+package retentiond
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// credentialsRefreshMargin is the time before their expiration at which
+	// the credentials of the instances are refreshed.
+	credentialsRefreshMargin = 5 * time.Minute
+
+	// credentialsTimeout is the timeout of the requests to the metadata
+	// services.
+	credentialsTimeout = 10 * time.Second
+
+	// maxCredentialsSize is the maximum size of the responses of the metadata
+	// services.
+	maxCredentialsSize = 64 * 1024
+
+	// awsMetadataEndpoint is the endpoint of the EC2 instance metadata
+	// service.
+	awsMetadataEndpoint = "http://169.254.169.254"
+
+	// awsContainerEndpoint is the endpoint of the ECS task credentials.
+	awsContainerEndpoint = "http://169.254.170.2"
+
+	// awsMetadataTokenTTL is the lifetime in seconds of the session tokens of
+	// the EC2 instance metadata service.
+	awsMetadataTokenTTL = "21600"
+
+	// gcpMetadataHost is the host of the metadata server of the Compute
+	// Engine instances.
+	gcpMetadataHost = "metadata.google.internal"
+)
+
+// awsCredentials are the credentials of the s3 archives. The credentials
+// without expiration never expire.
+type awsCredentials struct {
+	AccessKeyID     string    `json:"AccessKeyId"`
+	SecretAccessKey string    `json:"SecretAccessKey"`
+	Token           string    `json:"Token"`
+	Expiration      time.Time `json:"Expiration"`
+}
+
+// awsCredentialsProvider returns the credentials of the s3 archives.
+type awsCredentialsProvider func(ctx context.Context) (awsCredentials, error)
+
+// newAWSCredentialsProvider returns the static credentials of the environment
+// if AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are set. Otherwise it returns
+// the credentials of the ECS task if AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or
+// AWS_CONTAINER_CREDENTIALS_FULL_URI is set, or of the role of the EC2
+// instance, from the metadata service given by
+// AWS_EC2_METADATA_SERVICE_ENDPOINT or the default one. The credentials of
+// tasks and instances are refreshed before they expire.
+func newAWSCredentialsProvider(client *http.Client) awsCredentialsProvider {
+	accessKey := os.Getenv("AWS_ACCESS_KEY_ID")
+	secretKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKey != "" && secretKey != "" {
+		credentials := awsCredentials{
+			AccessKeyID:     accessKey,
+			SecretAccessKey: secretKey,
+			Token:           os.Getenv("AWS_SESSION_TOKEN"),
+		}
+		return func(context.Context) (awsCredentials, error) {
+			return credentials, nil
+		}
+	}
+
+	var fetch func(context.Context) (awsCredentials, error)
+	if url := awsContainerCredentialsURL(); url != "" {
+		fetch = func(ctx context.Context) (awsCredentials, error) {
+			return fetchAWSContainerCredentials(ctx, client, url)
+		}
+	} else {
+		endpoint := os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT")
+		if endpoint == "" {
+			endpoint = awsMetadataEndpoint
+		}
+		endpoint = strings.TrimSuffix(endpoint, "/")
+		fetch = func(ctx context.Context) (awsCredentials, error) {
+			return fetchAWSInstanceCredentials(ctx, client, endpoint)
+		}
+	}
+	cache := &credentialsCache{now: time.Now}
+	return func(ctx context.Context) (awsCredentials, error) {
+		value, err := cache.get(func() (interface{}, time.Time, error) {
+			credentials, err := fetch(ctx)
+			return credentials, credentials.Expiration, err
+		})
+		if err != nil {
+			return awsCredentials{}, err
+		}
+		return value.(awsCredentials), nil
+	}
+}
+
+// awsContainerCredentialsURL returns the url of the credentials of the ECS
+// task, or an empty string outside of a task.
+func awsContainerCredentialsURL() string {
+	if uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); uri != "" {
+		return awsContainerEndpoint + uri
+	}
+	return os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+}
+
+// fetchAWSInstanceCredentials retrieves the credentials of the role of the
+// EC2 instance from its metadata service, with a session token.
+func fetchAWSInstanceCredentials(ctx context.Context, client *http.Client, endpoint string) (awsCredentials, error) {
+	var credentials awsCredentials
+	req, err := http.NewRequest(http.MethodPut, endpoint+"/latest/api/token", nil)
+	if err != nil {
+		return credentials, err
+	}
+	req.Header.Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", awsMetadataTokenTTL)
+	token, err := metadataRequest(ctx, client, req)
+	if err != nil {
+		return credentials, fmt.Errorf("could not retrieve the instance metadata token: %s", err)
+	}
+
+	get := func(path string) ([]byte, error) {
+		req, err := http.NewRequest(http.MethodGet, endpoint+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Aws-Ec2-Metadata-Token", string(token))
+		return metadataRequest(ctx, client, req)
+	}
+	roles, err := get("/latest/meta-data/iam/security-credentials/")
+	if err != nil {
+		return credentials, fmt.Errorf("could not retrieve the instance role: %s", err)
+	}
+	role := strings.TrimSpace(strings.SplitN(string(roles), "\n", 2)[0])
+	if role == "" {
+		return credentials, errors.New("the instance has no role")
+	}
+	body, err := get("/latest/meta-data/iam/security-credentials/" + role)
+	if err != nil {
+		return credentials, fmt.Errorf("could not retrieve the credentials of the instance role: %s", err)
+	}
+	return decodeAWSCredentials(body)
+}
+
+// fetchAWSContainerCredentials retrieves the credentials of the ECS task.
+func fetchAWSContainerCredentials(ctx context.Context, client *http.Client, url string) (awsCredentials, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return awsCredentials{}, err
+	}
+	body, err := metadataRequest(ctx, client, req)
+	if err != nil {
+		return awsCredentials{}, fmt.Errorf("could not retrieve the credentials of the task: %s", err)
+	}
+	return decodeAWSCredentials(body)
+}
+
+func decodeAWSCredentials(body []byte) (awsCredentials, error) {
+	var credentials awsCredentials
+	if err := json.Unmarshal(body, &credentials); err != nil {
+		return credentials, fmt.Errorf("invalid aws credentials: %s", err)
+	}
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+		return credentials, errors.New("invalid aws credentials: missing access key")
+	}
+	return credentials, nil
+}
+
+// gcpTokenProvider returns the access token of the gcs archives.
+type gcpTokenProvider func(ctx context.Context) (string, error)
+
+// newGCPTokenProvider returns the static access token of the environment if
+// GOOGLE_OAUTH_ACCESS_TOKEN is set, which is never refreshed. Otherwise it
+// returns the access token of the service account of the Compute Engine
+// instance, from the metadata server given by GCE_METADATA_HOST or the default
+// one, which is refreshed before it expires.
+func newGCPTokenProvider(client *http.Client) gcpTokenProvider {
+	if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		return func(context.Context) (string, error) {
+			return token, nil
+		}
+	}
+
+	host := os.Getenv("GCE_METADATA_HOST")
+	if host == "" {
+		host = gcpMetadataHost
+	}
+	url := "http://" + host + "/computeMetadata/v1/instance/service-accounts/default/token"
+	cache := &credentialsCache{now: time.Now}
+	return func(ctx context.Context) (string, error) {
+		value, err := cache.get(func() (interface{}, time.Time, error) {
+			return fetchGCPToken(ctx, client, url, cache.now())
+		})
+		if err != nil {
+			return "", err
+		}
+		return value.(string), nil
+	}
+}
+
+// fetchGCPToken retrieves the access token of the service account of the
+// instance, and returns it with its expiration.
+func fetchGCPToken(ctx context.Context, client *http.Client, url string, now time.Time) (interface{}, time.Time, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	body, err := metadataRequest(ctx, client, req)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("could not retrieve the access token of the instance: %s", err)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid access token: %s", err)
+	}
+	if token.AccessToken == "" {
+		return nil, time.Time{}, errors.New("invalid access token: missing token")
+	}
+	return token.AccessToken, now.Add(time.Duration(token.ExpiresIn) * time.Second), nil
+}
+
+// metadataRequest performs a request to a metadata service and returns the
+// body of its response.
+func metadataRequest(ctx context.Context, client *http.Client, req *http.Request) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialsTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCredentialsSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("%s returned %s", req.URL.Host, resp.Status)
+	}
+	return body, nil
+}
+
+// credentialsCache holds credentials until they are about to expire.
+type credentialsCache struct {
+	mu      sync.Mutex
+	value   interface{}
+	expires time.Time
+	now     func() time.Time
+}
+
+// get returns the cached credentials, or fetches them again if there are none
+// or if they expire within credentialsRefreshMargin. Credentials without
+// expiration are kept forever.
+func (c *credentialsCache) get(fetch func() (interface{}, time.Time, error)) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.value != nil && (c.expires.IsZero() || c.now().Add(credentialsRefreshMargin).Before(c.expires)) {
+		return c.value, nil
+	}
+	value, expires, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.value, c.expires = value, expires
+	return value, nil
+}
@@ -0,0 +1,120 @@
+package retentiond
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAWSInstanceCredentials(t *testing.T) {
+	defer setenv(t, "AWS_ACCESS_KEY_ID", "")()
+	defer setenv(t, "AWS_SECRET_ACCESS_KEY", "")()
+	defer setenv(t, "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "")()
+	defer setenv(t, "AWS_CONTAINER_CREDENTIALS_FULL_URI", "")()
+
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.NotEmpty(t, r.Header.Get("X-Aws-Ec2-Metadata-Token-Ttl-Seconds"))
+			_, _ = w.Write([]byte("session"))
+			return
+		}
+		if r.Header.Get("X-Aws-Ec2-Metadata-Token") != "session" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/latest/meta-data/iam/security-credentials/":
+			_, _ = w.Write([]byte("backend\n"))
+		case "/latest/meta-data/iam/security-credentials/backend":
+			fetches++
+			_, _ = w.Write([]byte(`{"AccessKeyId":"key","SecretAccessKey":"secret","Token":"token","Expiration":"` +
+				time.Now().Add(time.Hour).UTC().Format(time.RFC3339) + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer setenv(t, "AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL+"/")()
+
+	provider := newAWSCredentialsProvider(server.Client())
+	credentials, err := provider(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key", credentials.AccessKeyID)
+	assert.Equal(t, "secret", credentials.SecretAccessKey)
+	assert.Equal(t, "token", credentials.Token)
+
+	// The credentials are cached until they are about to expire
+	_, err = provider(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+}
+
+func TestAWSContainerCredentials(t *testing.T) {
+	defer setenv(t, "AWS_ACCESS_KEY_ID", "")()
+	defer setenv(t, "AWS_SECRET_ACCESS_KEY", "")()
+	defer setenv(t, "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "")()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/credentials", r.URL.Path)
+		_, _ = w.Write([]byte(`{"AccessKeyId":"key","SecretAccessKey":"secret","Token":"token"}`))
+	}))
+	defer server.Close()
+	defer setenv(t, "AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/credentials")()
+
+	credentials, err := newAWSCredentialsProvider(server.Client())(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key", credentials.AccessKeyID)
+	assert.Equal(t, "token", credentials.Token)
+}
+
+func TestGCPMetadataToken(t *testing.T) {
+	defer setenv(t, "GOOGLE_OAUTH_ACCESS_TOKEN", "")()
+
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			http.Error(w, "missing header", http.StatusForbidden)
+			return
+		}
+		assert.Equal(t, "/computeMetadata/v1/instance/service-accounts/default/token", r.URL.Path)
+		fetches++
+		_, _ = w.Write([]byte(`{"access_token":"token","expires_in":3599,"token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	defer setenv(t, "GCE_METADATA_HOST", strings.TrimPrefix(server.URL, "http://"))()
+
+	provider := newGCPTokenProvider(server.Client())
+	token, err := provider(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+	_, err = provider(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+}
+
+func TestCredentialsCacheRefresh(t *testing.T) {
+	now := time.Now()
+	cache := &credentialsCache{now: func() time.Time { return now }}
+	fetches := 0
+	fetch := func() (interface{}, time.Time, error) {
+		fetches++
+		return fetches, now.Add(time.Hour), nil
+	}
+
+	value, err := cache.get(fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	now = now.Add(time.Hour - credentialsRefreshMargin)
+	value, err = cache.get(fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value)
+}
@@ -0,0 +1,257 @@
+// Package retentiond expires the occurrences of the events beyond the limits
+// of the event retention policies of their namespace, archiving them before
+// their deletion if the policies have an archive.
+package retentiond
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// DefaultInterval is the interval at which the event history is
+	// compacted.
+	DefaultInterval = time.Minute
+
+	// lockKey is the key of the lock serializing the compactions of the
+	// backends of the cluster.
+	lockKey = "/sensu.io/retentiond/.lock"
+)
+
+// locker serializes the compactions of the backends of the cluster.
+type locker interface {
+	Lock(ctx context.Context) error
+	Unlock(ctx context.Context) error
+}
+
+// Config configures Retentiond.
+type Config struct {
+	// Store is the store of the event retention policies.
+	Store store.ResourceStore
+
+	// HistoryStore is the store of the event history.
+	HistoryStore store.EventHistoryStore
+
+	// Client is the etcd client of the lock serializing the compactions, if
+	// any.
+	Client *clientv3.Client
+
+	// Interval is the interval at which the event history is compacted,
+	// DefaultInterval if zero.
+	Interval time.Duration
+}
+
+// Retentiond is the event history compaction daemon.
+type Retentiond struct {
+	store        store.ResourceStore
+	historyStore store.EventHistoryStore
+	client       *clientv3.Client
+	interval     time.Duration
+	locker       locker
+	newArchiver  func(*corev2.EventArchive) (Archiver, error)
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	errChan      chan error
+	now          func() time.Time
+}
+
+// New creates a new Retentiond.
+func New(c Config) (*Retentiond, error) {
+	r := &Retentiond{
+		store:        c.Store,
+		historyStore: c.HistoryStore,
+		client:       c.Client,
+		interval:     c.Interval,
+		newArchiver:  NewArchiver,
+		errChan:      make(chan error, 1),
+		now:          time.Now,
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	if r.interval == 0 {
+		r.interval = DefaultInterval
+	}
+	return r, nil
+}
+
+// Start compacts the event history periodically, in the background.
+func (r *Retentiond) Start() error {
+	var session *concurrency.Session
+	if r.client != nil {
+		var err error
+		session, err = concurrency.NewSession(r.client)
+		if err != nil {
+			return fmt.Errorf("failed to start retentiond: %s", err)
+		}
+		r.locker = concurrency.NewMutex(session, lockKey)
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if session != nil {
+			defer session.Close()
+		}
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := r.compact(r.ctx); err != nil && r.ctx.Err() == nil {
+				logger.WithError(err).Error("could not compact the event history")
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops Retentiond.
+func (r *Retentiond) Stop() error {
+	r.cancel()
+	r.wg.Wait()
+	close(r.errChan)
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (r *Retentiond) Err() <-chan error {
+	return r.errChan
+}
+
+// Name returns the daemon name
+func (r *Retentiond) Name() string {
+	return "retentiond"
+}
+
+// compact expires the occurrences of the events of every namespace with an
+// event retention policy.
+func (r *Retentiond) compact(ctx context.Context) error {
+	if r.locker != nil {
+		if err := r.locker.Lock(ctx); err != nil {
+			return err
+		}
+		defer func() {
+			_ = r.locker.Unlock(context.Background())
+		}()
+	}
+
+	var policies []*corev2.EventRetentionPolicy
+	if err := r.store.ListResources(ctx, corev2.EventRetentionPoliciesResource, &policies, &store.SelectionPredicate{}); err != nil {
+		return err
+	}
+
+	byNamespace := make(map[string][]*corev2.EventRetentionPolicy)
+	for _, policy := range policies {
+		byNamespace[policy.Namespace] = append(byNamespace[policy.Namespace], policy)
+	}
+
+	for namespace, policies := range byNamespace {
+		if err := r.compactNamespace(ctx, namespace, policies); err != nil {
+			logger.WithError(err).WithField("namespace", namespace).Error("could not compact the event history")
+		}
+	}
+	return nil
+}
+
+// compactNamespace expires the occurrences of the events of the namespace
+// beyond the limits of its policies. The expired occurrences are only
+// deleted once archived in all the archives of the policies, so they are
+// archived again at the next compaction if an archive fails.
+func (r *Retentiond) compactNamespace(ctx context.Context, namespace string, policies []*corev2.EventRetentionPolicy) error {
+	ctx = store.NamespaceContext(ctx, namespace)
+	history, err := r.historyStore.ListEventHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := r.now()
+	occurrences := expired(history, policies, now)
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	archivers := make(map[*corev2.EventRetentionPolicy]Archiver)
+	for _, policy := range policies {
+		if policy.Archive == nil {
+			continue
+		}
+		archiver, err := r.newArchiver(policy.Archive)
+		if err != nil {
+			return fmt.Errorf("event retention policy %s: %s", policy.Name, err)
+		}
+		archivers[policy] = archiver
+	}
+
+	if len(archivers) > 0 {
+		var data bytes.Buffer
+		encoder := json.NewEncoder(&data)
+		for _, occurrence := range occurrences {
+			if err := encoder.Encode(occurrence); err != nil {
+				return err
+			}
+		}
+		for policy, archiver := range archivers {
+			name := path.Join(policy.Archive.Prefix, namespace, now.UTC().Format("20060102T150405.000000000Z")+".ndjson")
+			if err := archiver.Archive(ctx, name, data.Bytes()); err != nil {
+				return fmt.Errorf("event retention policy %s: could not archive the expired events: %s", policy.Name, err)
+			}
+		}
+	}
+
+	if err := r.historyStore.DeleteEventHistory(ctx, occurrences); err != nil {
+		return err
+	}
+	logger.WithField("namespace", namespace).Infof("expired %d occurrences of the events", len(occurrences))
+	return nil
+}
+
+// expired returns the occurrences of the given history, ordered by entity,
+// check and timestamp, beyond the limits of any of the given policies.
+func expired(history []*corev2.Event, policies []*corev2.EventRetentionPolicy, now time.Time) []*corev2.Event {
+	var maxOccurrences uint32
+	var maxAge uint32
+	for _, policy := range policies {
+		if policy.MaxOccurrences > 0 && (maxOccurrences == 0 || policy.MaxOccurrences < maxOccurrences) {
+			maxOccurrences = policy.MaxOccurrences
+		}
+		if policy.MaxAge > 0 && (maxAge == 0 || policy.MaxAge < maxAge) {
+			maxAge = policy.MaxAge
+		}
+	}
+	oldest := now.Add(-time.Duration(maxAge) * time.Second).Unix()
+
+	var occurrences []*corev2.Event
+	for start := 0; start < len(history); {
+		// The occurrences of an event are consecutive
+		end := start + 1
+		for end < len(history) && sameEvent(history[start], history[end]) {
+			end++
+		}
+		for i := start; i < end; i++ {
+			if (maxOccurrences > 0 && end-i > int(maxOccurrences)) || (maxAge > 0 && history[i].Timestamp < oldest) {
+				occurrences = append(occurrences, history[i])
+			}
+		}
+		start = end
+	}
+	return occurrences
+}
+
+// sameEvent returns true if the given occurrences are occurrences of the
+// same event.
+func sameEvent(a, b *corev2.Event) bool {
+	return a.Entity.Name == b.Entity.Name && a.Check.Name == b.Check.Name
+}
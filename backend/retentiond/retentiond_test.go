@@ -0,0 +1,153 @@
+package retentiond
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeArchiver struct {
+	archives map[string]string
+	err      error
+}
+
+func (a *fakeArchiver) Archive(ctx context.Context, name string, data []byte) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.archives[name] = string(data)
+	return nil
+}
+
+func occurrence(entity, check string, timestamp int64) *corev2.Event {
+	return &corev2.Event{
+		Timestamp: timestamp,
+		Entity:    &corev2.Entity{ObjectMeta: corev2.NewObjectMeta(entity, "default")},
+		Check:     &corev2.Check{ObjectMeta: corev2.NewObjectMeta(check, "default")},
+	}
+}
+
+func policy(maxOccurrences, maxAge uint32) *corev2.EventRetentionPolicy {
+	p := corev2.FixtureEventRetentionPolicy("policy")
+	p.MaxOccurrences = maxOccurrences
+	p.MaxAge = maxAge
+	return p
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+	history := []*corev2.Event{
+		occurrence("entity1", "check1", 100),
+		occurrence("entity1", "check1", 800),
+		occurrence("entity1", "check1", 900),
+		occurrence("entity1", "check2", 950),
+		occurrence("entity2", "check1", 500),
+	}
+
+	tests := []struct {
+		name     string
+		policies []*corev2.EventRetentionPolicy
+		want     []*corev2.Event
+	}{
+		{
+			name:     "max occurrences",
+			policies: []*corev2.EventRetentionPolicy{policy(2, 0)},
+			want:     history[:1],
+		},
+		{
+			name:     "max age",
+			policies: []*corev2.EventRetentionPolicy{policy(0, 300)},
+			want:     []*corev2.Event{history[0], history[4]},
+		},
+		{
+			name:     "strictest limits of the policies",
+			policies: []*corev2.EventRetentionPolicy{policy(2, 0), policy(5, 150)},
+			want:     []*corev2.Event{history[0], history[1], history[4]},
+		},
+		{
+			name:     "within the limits",
+			policies: []*corev2.EventRetentionPolicy{policy(3, 1000)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, expired(history, tt.policies, now))
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	archived := policy(1, 0)
+	archived.Archive = &corev2.EventArchive{Provider: corev2.EventArchiveGCS, Bucket: "events", Prefix: "sensu"}
+	other := policy(1, 0)
+	other.Namespace = "acme"
+	policies := []*corev2.EventRetentionPolicy{archived, other}
+
+	history := []*corev2.Event{
+		occurrence("entity1", "check1", 100),
+		occurrence("entity1", "check1", 200),
+	}
+
+	tests := []struct {
+		name       string
+		archiveErr error
+		deleted    bool
+	}{
+		{
+			name:    "archived and deleted",
+			deleted: true,
+		},
+		{
+			name:       "kept if not archived",
+			archiveErr: errors.New("error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &mockstore.MockStore{}
+			s.On("ListResources", mock.Anything, corev2.EventRetentionPoliciesResource, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					list := args.Get(2).(*[]*corev2.EventRetentionPolicy)
+					*list = policies
+				}).Return(nil)
+			s.On("ListEventHistory", mock.MatchedBy(func(ctx context.Context) bool {
+				return corev2.ContextNamespace(ctx) == "default"
+			})).Return(history, nil)
+			s.On("ListEventHistory", mock.MatchedBy(func(ctx context.Context) bool {
+				return corev2.ContextNamespace(ctx) == "acme"
+			})).Return([]*corev2.Event{}, nil)
+			if tt.deleted {
+				s.On("DeleteEventHistory", mock.Anything, history[:1]).Return(nil)
+			}
+
+			archiver := &fakeArchiver{archives: map[string]string{}, err: tt.archiveErr}
+			r, err := New(Config{Store: s, HistoryStore: s})
+			require.NoError(t, err)
+			r.now = func() time.Time { return time.Unix(1000, 0) }
+			r.newArchiver = func(*corev2.EventArchive) (Archiver, error) {
+				return archiver, nil
+			}
+
+			require.NoError(t, r.compact(context.Background()))
+			s.AssertExpectations(t)
+			if !tt.deleted {
+				s.AssertNotCalled(t, "DeleteEventHistory", mock.Anything, mock.Anything)
+				return
+			}
+
+			data, ok := archiver.archives["sensu/default/19700101T001640.000000000Z.ndjson"]
+			require.True(t, ok, "missing archive")
+			lines := strings.Split(strings.TrimSpace(data), "\n")
+			require.Len(t, lines, 1)
+			assert.Contains(t, lines[0], `"timestamp":100`)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -186,6 +188,82 @@ func (r *Ring) IsEmpty(ctx context.Context) (bool, error) {
 	return len(resp.Kvs) == 0, nil
 }
 
+// Items returns the values of the items in the ring, in ring order.
+func (r *Ring) Items(ctx context.Context) ([]string, error) {
+	var resp *clientv3.GetResponse
+	err := etcd.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = r.client.Get(ctx, r.itemPrefix, clientv3.WithKeysOnly(), clientv3.WithPrefix())
+		return etcd.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		items = append(items, path.Base(string(kv.Key)))
+	}
+	return items, nil
+}
+
+// Trigger is the state of the trigger of a watcher. The ring advances when
+// the lease of the trigger expires.
+type Trigger struct {
+	// Values is the number of values delivered by the watcher.
+	Values int `json:"values"`
+
+	// Schedule is the interval in seconds or the cron schedule of the watcher.
+	Schedule string `json:"schedule"`
+
+	// Next is the next item to be delivered.
+	Next string `json:"next"`
+
+	// LeaseID is the ID of the lease of the trigger.
+	LeaseID int64 `json:"lease_id"`
+
+	// TTL is the number of seconds remaining before the lease expires, or -1
+	// if the lease already expired.
+	TTL int64 `json:"ttl"`
+}
+
+// Triggers returns the active triggers of the watchers named name, across all
+// the clients of the ring.
+func (r *Ring) Triggers(ctx context.Context, name string) ([]Trigger, error) {
+	prefix := path.Join(r.triggerPrefix, name) + "/"
+	var resp *clientv3.GetResponse
+	err := etcd.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = r.client.Get(ctx, prefix, clientv3.WithPrefix())
+		return etcd.RetryRequest(n, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	triggers := make([]Trigger, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		// The trigger keys end with the number of values and the schedule
+		parts := strings.SplitN(strings.TrimPrefix(string(kv.Key), prefix), "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		values, _ := strconv.Atoi(parts[0])
+		trigger := Trigger{
+			Values:   values,
+			Schedule: parts[1],
+			Next:     string(kv.Value),
+			LeaseID:  kv.Lease,
+			TTL:      -1,
+		}
+		if kv.Lease != 0 {
+			lease, err := r.client.TimeToLive(ctx, clientv3.LeaseID(kv.Lease))
+			if err != nil {
+				return nil, err
+			}
+			trigger.TTL = lease.TTL
+		}
+		triggers = append(triggers, trigger)
+	}
+	return triggers, nil
+}
+
 func (w *watcher) grant(ctx context.Context) (*clientv3.LeaseGrantResponse, error) {
 	interval := w.getInterval()
 	lease, err := w.ring.client.Grant(ctx, int64(interval))
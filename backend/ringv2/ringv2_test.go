@@ -450,3 +450,45 @@ func TestMultipleItems(t *testing.T) {
 		t.Fatalf("bad values: got %v, want %v", got, want)
 	}
 }
+
+func TestItemsAndTriggers(t *testing.T) {
+	t.Parallel()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	client := e.NewEmbeddedClient()
+	defer client.Close()
+
+	ring := New(client, t.Name())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wc := ring.Watch(ctx, "test", 1, 5, "")
+
+	if err := ring.Add(ctx, "foo", 600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Drain the add event
+	<-wc
+
+	items, err := ring.Items(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := items, []string{"foo"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("bad items: got %v, want %v", got, want)
+	}
+
+	triggers, err := ring.Triggers(ctx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(triggers) != 1 {
+		t.Fatalf("bad triggers: got %v, want 1 trigger", triggers)
+	}
+	if got, want := triggers[0].Values, 1; got != want {
+		t.Fatalf("bad trigger values: got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package rpcd
+
+import (
+	"context"
+	"strings"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/middlewares"
+	"github.com/sensu/sensu-go/backend/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authenticator authenticates the calls with the credentials of the
+// authorization metadata, which are the same as the ones of the Authorization
+// header of the HTTP API.
+type authenticator struct {
+	store store.Store
+}
+
+func (a *authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "bad credentials")
+	}
+
+	var claims *corev2.Claims
+	switch value := values[0]; {
+	case strings.HasPrefix(value, "Bearer "):
+		var err error
+		claims, err = middlewares.TokenClaims(ctx, strings.TrimPrefix(value, "Bearer "), a.store)
+		if err != nil {
+			logger.WithError(err).Warn("invalid token")
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+	case strings.HasPrefix(value, "Key "):
+		var err error
+		claims, err = middlewares.APIKeyClaims(ctx, strings.TrimPrefix(value, "Key "), a.store)
+		if err != nil {
+			logger.WithError(err).Warn("invalid api key")
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+	default:
+		return nil, status.Error(codes.Unauthenticated, "bad credentials")
+	}
+
+	return context.WithValue(ctx, corev2.ClaimsKey, claims), nil
+}
+
+func (a *authenticator) unary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) stream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticatedStream is a server stream with the claims of its caller in its
+// context.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,113 @@
+// Package rpcd serves the gRPC API of the backend, defined in the rpc
+// package, for the integrations syncing large amounts of resources.
+package rpcd
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/admission"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/authorization/rbac"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/nstemplate"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/rpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "rpcd",
+})
+
+// Config configures Rpcd.
+type Config struct {
+	ListenAddress string
+	Store         store.Store
+	EventStore    store.EventStore
+	Bus           messaging.MessageBus
+	TLS           *corev2.TLSOptions
+}
+
+// Rpcd is the backend gRPC API.
+type Rpcd struct {
+	listenAddress string
+	server        *grpc.Server
+	wg            sync.WaitGroup
+	errChan       chan error
+}
+
+// New creates a new Rpcd.
+func New(c Config) (*Rpcd, error) {
+	// The resources are admitted like the resources of the HTTP API
+	s := admission.NewStore(quota.NewStore(nstemplate.NewStore(c.Store)))
+	auth := &rbac.Authorizer{Store: c.Store}
+
+	authenticator := &authenticator{store: c.Store}
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(authenticator.unary),
+		grpc.StreamInterceptor(authenticator.stream),
+	}
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.ToServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	r := &Rpcd{
+		listenAddress: c.ListenAddress,
+		server:        grpc.NewServer(opts...),
+		errChan:       make(chan error, 1),
+	}
+	rpc.RegisterAPIServer(r.server, &Server{
+		Store:  s,
+		Auth:   auth,
+		Events: api.NewEventClient(c.EventStore, auth, c.Bus),
+		Quota:  &quota.Enforcer{Store: c.Store, EventStore: c.EventStore},
+	})
+	return r, nil
+}
+
+// Start Rpcd.
+func (r *Rpcd) Start() error {
+	logger.Info("starting rpcd on address: ", r.listenAddress)
+	ln, err := net.Listen("tcp", r.listenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to start rpcd: %s", err)
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if err := r.server.Serve(ln); err != nil {
+			r.errChan <- fmt.Errorf("failure while serving grpc api: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop Rpcd. The calls in progress, e.g. the watches, are cancelled.
+func (r *Rpcd) Stop() error {
+	r.server.Stop()
+	r.wg.Wait()
+	close(r.errChan)
+	return nil
+}
+
+// Err returns a channel to listen for terminal errors on.
+func (r *Rpcd) Err() <-chan error {
+	return r.errChan
+}
+
+// Name returns the daemon name
+func (r *Rpcd) Name() string {
+	return "rpcd"
+}
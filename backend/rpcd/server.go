@@ -0,0 +1,331 @@
+package rpcd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/api"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/quota"
+	"github.com/sensu/sensu-go/backend/selector"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/rpc"
+	"github.com/sensu/sensu-go/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listPageSize is the number of resources fetched from the store at once by
+// the List calls.
+const listPageSize = 500
+
+// Server implements the gRPC API. The resources are read and written through
+// the API clients, which authorize the calls. The new events are rejected if
+// they exceed a resource quota, unless Quota is nil.
+type Server struct {
+	Store  store.Store
+	Auth   authorization.Authorizer
+	Events *api.EventClient
+	Quota  *quota.Enforcer
+}
+
+// Get returns a resource.
+func (s *Server) Get(ctx context.Context, req *rpc.GetRequest) (*rpc.Resource, error) {
+	ctx = store.NamespaceContext(ctx, req.Namespace)
+	if isEvent(req.ApiVersion, req.Type) {
+		parts := strings.SplitN(req.Name, ":", 2)
+		if len(parts) != 2 {
+			return nil, status.Error(codes.InvalidArgument, "the name of an event must be <entity>:<check>")
+		}
+		event, err := s.Events.FetchEvent(ctx, parts[0], parts[1])
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if event == nil {
+			return nil, status.Errorf(codes.NotFound, "event %s not found", req.Name)
+		}
+		return encode(event)
+	}
+
+	client, err := s.client(req.ApiVersion, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	resource := newResource(client.Kind)
+	if err := client.Get(ctx, req.Name, resource); err != nil {
+		return nil, toStatus(err)
+	}
+	return encode(resource)
+}
+
+// List streams the resources, fetched from the store by pages so that large
+// collections are never held in memory at once.
+func (s *Server) List(req *rpc.ListRequest, stream rpc.API_ListServer) error {
+	labelSelector, err := selector.Parse(req.LabelSelector)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := store.NamespaceContext(stream.Context(), req.Namespace)
+
+	var list func(*store.SelectionPredicate) ([]corev2.Resource, error)
+	if isEvent(req.ApiVersion, req.Type) {
+		list = func(pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+			events, err := s.Events.ListEvents(ctx, pred)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]corev2.Resource, len(events))
+			for i := range events {
+				resources[i] = events[i]
+			}
+			return resources, nil
+		}
+	} else {
+		client, err := s.client(req.ApiVersion, req.Type)
+		if err != nil {
+			return err
+		}
+		list = func(pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+			return listResources(ctx, client, pred)
+		}
+	}
+
+	pred := &store.SelectionPredicate{Limit: listPageSize}
+	for {
+		resources, err := list(pred)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, resource := range resources {
+			if !labelSelector.Empty() && !labelSelector.Matches(resource.GetObjectMeta().Labels) {
+				continue
+			}
+			msg, err := encode(resource)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+		if pred.Continue == "" {
+			return nil
+		}
+	}
+}
+
+// Watch streams the changes of the resources until the call is cancelled. A
+// watch missing changes ends with the Aborted code, and must be restarted.
+func (s *Server) Watch(req *rpc.WatchRequest, stream rpc.API_WatchServer) error {
+	ctx := store.NamespaceContext(stream.Context(), req.Namespace)
+
+	if isEvent(req.ApiVersion, req.Type) {
+		events, err := s.Events.WatchEvents(ctx)
+		if err != nil {
+			return toStatus(err)
+		}
+		for event := range events {
+			if err := sendWatchEvent(stream, event.Action, event.Event, event.Revision); err != nil {
+				return err
+			}
+		}
+		return stream.Context().Err()
+	}
+
+	client, err := s.client(req.ApiVersion, req.Type)
+	if err != nil {
+		return err
+	}
+	changes, err := client.Watch(ctx, req.Revision)
+	if err != nil {
+		return toStatus(err)
+	}
+	for change := range changes {
+		if err := sendWatchEvent(stream, change.Action, change.Resource, change.Revision); err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+func sendWatchEvent(stream rpc.API_WatchServer, action store.WatchActionType, resource corev2.Resource, revision int64) error {
+	event := &rpc.WatchEvent{Revision: revision}
+	switch action {
+	case store.WatchCreate:
+		event.Action = rpc.WatchEvent_CREATE
+	case store.WatchUpdate:
+		event.Action = rpc.WatchEvent_UPDATE
+	case store.WatchDelete:
+		event.Action = rpc.WatchEvent_DELETE
+	case store.WatchError:
+		return status.Error(codes.Aborted, "changes were missed, the watch must be restarted")
+	default:
+		return nil
+	}
+	if resource != nil && !reflect.ValueOf(resource).IsNil() {
+		msg, err := encode(resource)
+		if err != nil {
+			return err
+		}
+		event.Resource = msg
+	}
+	return stream.Send(event)
+}
+
+// Put creates or replaces a resource. The events are published to the
+// pipeline.
+func (s *Server) Put(ctx context.Context, req *rpc.Resource) (*rpc.PutResponse, error) {
+	resource, err := decode(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resource.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx = store.NamespaceContext(ctx, resource.GetObjectMeta().Namespace)
+
+	if event, ok := resource.(*corev2.Event); ok {
+		if s.Quota != nil {
+			if err := s.Quota.EnforceEvent(ctx, event); err != nil {
+				return nil, toStatus(err)
+			}
+		}
+		if err := s.Events.UpdateEvent(ctx, event); err != nil {
+			return nil, toStatus(err)
+		}
+		return &rpc.PutResponse{}, nil
+	}
+
+	client, err := s.client(req.ApiVersion, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Update(ctx, resource); err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpc.PutResponse{}, nil
+}
+
+// Delete deletes a resource.
+func (s *Server) Delete(ctx context.Context, req *rpc.DeleteRequest) (*rpc.DeleteResponse, error) {
+	ctx = store.NamespaceContext(ctx, req.Namespace)
+	if isEvent(req.ApiVersion, req.Type) {
+		parts := strings.SplitN(req.Name, ":", 2)
+		if len(parts) != 2 {
+			return nil, status.Error(codes.InvalidArgument, "the name of an event must be <entity>:<check>")
+		}
+		if err := s.Events.DeleteEvent(ctx, parts[0], parts[1]); err != nil {
+			return nil, toStatus(err)
+		}
+		return &rpc.DeleteResponse{}, nil
+	}
+
+	client, err := s.client(req.ApiVersion, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Delete(ctx, req.Name); err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpc.DeleteResponse{}, nil
+}
+
+// client returns the API client of the resources of the given type.
+func (s *Server) client(apiVersion, typename string) (*api.GenericClient, error) {
+	client := &api.GenericClient{Store: s.Store, Auth: s.Auth}
+	if err := client.SetTypeMeta(corev2.TypeMeta{Type: typename, APIVersion: apiVersion}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return client, nil
+}
+
+func isEvent(apiVersion, typename string) bool {
+	return typename == "Event" && (apiVersion == "" || apiVersion == "core/v2")
+}
+
+// listResources lists a page of the resources of the client.
+func listResources(ctx context.Context, client *api.GenericClient, pred *store.SelectionPredicate) ([]corev2.Resource, error) {
+	sliceOfResource := reflect.SliceOf(reflect.TypeOf(client.Kind))
+	ptr := reflect.New(sliceOfResource)
+	ptr.Elem().Set(reflect.MakeSlice(sliceOfResource, 0, 0))
+	if err := client.List(ctx, ptr.Interface(), pred); err != nil {
+		return nil, err
+	}
+
+	results := ptr.Elem()
+	resources := make([]corev2.Resource, results.Len())
+	for i := range resources {
+		resources[i] = results.Index(i).Interface().(corev2.Resource)
+	}
+	return resources, nil
+}
+
+func newResource(kind corev2.Resource) corev2.Resource {
+	return reflect.New(reflect.TypeOf(kind).Elem()).Interface().(corev2.Resource)
+}
+
+// encode returns the protobuf encoding of a resource.
+func encode(resource corev2.Resource) (*rpc.Resource, error) {
+	msg, ok := resource.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T can't be encoded with protobuf", resource)
+	}
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	wrapper := types.WrapResource(resource)
+	return &rpc.Resource{
+		Type:       wrapper.Type,
+		ApiVersion: wrapper.APIVersion,
+		Value:      value,
+	}, nil
+}
+
+// decode returns the resource of its protobuf encoding.
+func decode(msg *rpc.Resource) (corev2.Resource, error) {
+	apiVersion := msg.ApiVersion
+	if apiVersion == "" {
+		apiVersion = "core/v2"
+	}
+	kind, err := types.ResolveType(apiVersion, msg.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resource := newResource(kind)
+	pb, ok := resource.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T can't be decoded with protobuf", resource)
+	}
+	if err := proto.Unmarshal(msg.Value, pb); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", msg.Type, err))
+	}
+	return resource, nil
+}
+
+// toStatus returns the gRPC status of an error of the API clients.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if err == authorization.ErrUnauthorized {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	switch err.(type) {
+	case *store.ErrNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case *store.ErrAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case *store.ErrNotValid:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case *store.ErrPreconditionFailed:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case *store.ErrQuotaExceeded:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
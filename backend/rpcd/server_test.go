@@ -0,0 +1,163 @@
+package rpcd
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/jwt"
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/rpc"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// allowAuthorizer authorizes the requests of the given verbs.
+type allowAuthorizer map[string]bool
+
+func (a allowAuthorizer) Authorize(ctx context.Context, attrs *authorization.Attributes) (bool, error) {
+	return a[attrs.Verb], nil
+}
+
+// newTestClient serves the API with the given store and returns a client
+// authenticated with an access token.
+func newTestClient(t *testing.T, s *mockstore.MockStore, auth authorization.Authorizer) (rpc.APIClient, context.Context, func()) {
+	s.On("GetUser", mock.Anything, "foo").Return(corev2.FixtureUser("foo"), nil)
+	authenticator := &authenticator{store: s}
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.unary),
+		grpc.StreamInterceptor(authenticator.stream),
+	)
+	rpc.RegisterAPIServer(server, &Server{Store: s, Auth: auth})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(ln) }()
+
+	conn, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	_, token, err := jwt.AccessToken(corev2.FixtureClaims("foo", nil))
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	return rpc.NewAPIClient(conn), ctx, func() {
+		_ = conn.Close()
+		server.Stop()
+	}
+}
+
+func decodeAsset(t *testing.T, msg *rpc.Resource) *corev2.Asset {
+	assert.Equal(t, "Asset", msg.Type)
+	assert.Equal(t, "core/v2", msg.ApiVersion)
+	var asset corev2.Asset
+	require.NoError(t, proto.Unmarshal(msg.Value, &asset))
+	return &asset
+}
+
+func TestServerGet(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "ruby", mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(2).(*corev2.Asset) = *corev2.FixtureAsset("ruby")
+	}).Return(nil)
+	s.On("GetResource", mock.Anything, "python", mock.Anything).Return(&store.ErrNotFound{Key: "python"})
+	client, ctx, stop := newTestClient(t, s, allowAuthorizer{"get": true})
+	defer stop()
+
+	msg, err := client.Get(ctx, &rpc.GetRequest{Type: "Asset", ApiVersion: "core/v2", Namespace: "default", Name: "ruby"})
+	require.NoError(t, err)
+	assert.Equal(t, "ruby", decodeAsset(t, msg).Name)
+
+	_, err = client.Get(ctx, &rpc.GetRequest{Type: "Asset", ApiVersion: "core/v2", Namespace: "default", Name: "python"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.Get(ctx, &rpc.GetRequest{Type: "Unknown", ApiVersion: "core/v2", Name: "ruby"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Without credentials
+	_, err = client.Get(context.Background(), &rpc.GetRequest{Type: "Asset", ApiVersion: "core/v2", Name: "ruby"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServerList(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("ListResources", mock.Anything, "assets", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		list := args.Get(2).(*[]*corev2.Asset)
+		pred := args.Get(3).(*store.SelectionPredicate)
+		assert.Equal(t, int64(listPageSize), pred.Limit)
+		if pred.Continue == "" {
+			ruby := corev2.FixtureAsset("ruby")
+			ruby.Labels = map[string]string{"lang": "ruby"}
+			*list = []*corev2.Asset{ruby, corev2.FixtureAsset("python")}
+			pred.Continue = "python"
+		} else {
+			perl := corev2.FixtureAsset("perl")
+			perl.Labels = map[string]string{"lang": "perl"}
+			*list = []*corev2.Asset{perl}
+			pred.Continue = ""
+		}
+	}).Return(nil)
+	client, ctx, stop := newTestClient(t, s, allowAuthorizer{"list": true})
+	defer stop()
+
+	names := func(req *rpc.ListRequest) []string {
+		stream, err := client.List(ctx, req)
+		require.NoError(t, err)
+		var names []string
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return names
+			}
+			require.NoError(t, err)
+			names = append(names, decodeAsset(t, msg).Name)
+		}
+	}
+
+	assert.Equal(t, []string{"ruby", "python", "perl"}, names(&rpc.ListRequest{Type: "Asset", ApiVersion: "core/v2"}))
+	assert.Equal(t, []string{"ruby", "perl"}, names(&rpc.ListRequest{Type: "Asset", ApiVersion: "core/v2", LabelSelector: "lang in [ruby, perl]"}))
+}
+
+func TestServerPut(t *testing.T) {
+	s := &mockstore.MockStore{}
+	var stored *corev2.Asset
+	s.On("ListResources", mock.Anything, corev2.AdmissionWebhooksResource, mock.Anything, mock.Anything).Return(nil)
+	s.On("CreateOrUpdateResource", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*corev2.Asset)
+	}).Return(nil)
+	client, ctx, stop := newTestClient(t, s, allowAuthorizer{"update": true})
+	defer stop()
+
+	value, err := proto.Marshal(corev2.FixtureAsset("ruby"))
+	require.NoError(t, err)
+	_, err = client.Put(ctx, &rpc.Resource{Type: "Asset", ApiVersion: "core/v2", Value: value})
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, "ruby", stored.Name)
+
+	// Invalid resource
+	invalid := corev2.FixtureAsset("ruby")
+	invalid.URL = ""
+	value, err = proto.Marshal(invalid)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, &rpc.Resource{Type: "Asset", ApiVersion: "core/v2", Value: value})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServerDeleteUnauthorized(t *testing.T) {
+	client, ctx, stop := newTestClient(t, &mockstore.MockStore{}, allowAuthorizer{})
+	defer stop()
+
+	_, err := client.Delete(ctx, &rpc.DeleteRequest{Type: "Asset", ApiVersion: "core/v2", Namespace: "default", Name: "ruby"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -66,7 +66,7 @@ func newIntervalScheduler(ctx context.Context, t *testing.T, executor string) *T
 	scheduler.msgBus = bus
 	pm := secrets.NewProviderManager()
 
-	scheduler.scheduler = NewIntervalScheduler(ctx, s, scheduler.msgBus, scheduler.check, &cache.Resource{}, pm)
+	scheduler.scheduler = NewIntervalScheduler(ctx, s, scheduler.msgBus, scheduler.check, &cache.Resource{}, pm, false)
 
 	assert.NoError(scheduler.msgBus.Start())
 
@@ -23,10 +23,12 @@ type CheckWatcher struct {
 	ringPool               *ringv2.Pool
 	entityCache            *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
+	roundRobinTracker      *RoundRobinTracker
+	agentSplay             bool
 }
 
 // NewCheckWatcher creates a new ScheduleManager.
-func NewCheckWatcher(ctx context.Context, msgBus messaging.MessageBus, store store.Store, pool *ringv2.Pool, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *CheckWatcher {
+func NewCheckWatcher(ctx context.Context, msgBus messaging.MessageBus, store store.Store, pool *ringv2.Pool, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager, tracker *RoundRobinTracker, agentSplay bool) *CheckWatcher {
 	watcher := &CheckWatcher{
 		store:                  store,
 		items:                  make(map[string]Scheduler),
@@ -35,6 +37,8 @@ func NewCheckWatcher(ctx context.Context, msgBus messaging.MessageBus, store sto
 		ringPool:               pool,
 		entityCache:            cache,
 		secretsProviderManager: secretsProviderManager,
+		roundRobinTracker:      tracker,
+		agentSplay:             agentSplay,
 	}
 
 	return watcher
@@ -64,16 +68,16 @@ func (c *CheckWatcher) startScheduler(check *corev2.CheckConfig) error {
 
 	switch GetSchedulerType(check) {
 	case IntervalType:
-		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager, c.agentSplay)
 	case CronType:
 		scheduler = NewCronScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager)
 	case RoundRobinIntervalType:
-		scheduler = NewRoundRobinIntervalScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewRoundRobinIntervalScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.secretsProviderManager, c.roundRobinTracker)
 	case RoundRobinCronType:
-		scheduler = NewRoundRobinCronScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewRoundRobinCronScheduler(c.ctx, c.store, c.bus, c.ringPool, check, c.entityCache, c.secretsProviderManager, c.roundRobinTracker)
 	default:
 		logger.Error("bad scheduler type, falling back to interval scheduler")
-		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager)
+		scheduler = NewIntervalScheduler(c.ctx, c.store, c.bus, check, c.entityCache, c.secretsProviderManager, c.agentSplay)
 	}
 
 	// Start scheduling check
@@ -39,7 +39,7 @@ func TestCheckWatcherSmoke(t *testing.T) {
 	st.On("GetCheckConfigWatcher", mock.Anything).Return((<-chan store.WatchEventCheckConfig)(watcherChan), nil)
 
 	pm := secrets.NewProviderManager()
-	watcher := NewCheckWatcher(ctx, bus, st, nil, &cache.Resource{}, pm)
+	watcher := NewCheckWatcher(ctx, bus, st, nil, &cache.Resource{}, pm, nil, false)
 	require.NoError(t, watcher.Start())
 
 	checkAA := corev2.FixtureCheckConfig("a")
@@ -2,6 +2,7 @@ package schedulerd
 
 import (
 	"context"
+	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
@@ -32,7 +33,7 @@ func NewCronScheduler(ctx context.Context, store store.Store, bus messaging.Mess
 		store:         store,
 		bus:           bus,
 		check:         check,
-		lastCronState: check.Cron,
+		lastCronState: check.CronSchedule(),
 		interrupt:     make(chan *corev2.CheckConfig),
 		logger: logger.WithFields(logrus.Fields{
 			"name":           check.Name,
@@ -57,6 +58,11 @@ func (s *CronScheduler) schedule(timer *CronTimer, executor *CheckExecutor) {
 
 	s.logger.Debug("check is not subdued")
 
+	if s.check.IsBlackedOut(time.Now()) {
+		s.logger.Debug("check is in a blackout period")
+		return
+	}
+
 	if err := executor.processCheck(s.ctx, s.check); err != nil {
 		logger.Error(err)
 	}
@@ -70,7 +76,7 @@ func (s *CronScheduler) Start() {
 
 func (s *CronScheduler) start() {
 	s.logger.Info("starting new cron scheduler")
-	timer := NewCronTimer(s.check.Name, s.check.Cron)
+	timer := NewCronTimer(s.check.Name, s.check.CronSchedule())
 	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.secretsProviderManager)
 	timer.Start()
 
@@ -111,7 +117,7 @@ func (s *CronScheduler) Stop() error {
 func (s *CronScheduler) toggleSchedule() (stateChanged bool) {
 	defer s.setLastState()
 
-	if s.lastCronState != s.check.Cron {
+	if s.lastCronState != s.check.CronSchedule() {
 		s.logger.Info("cron schedule has changed")
 		return true
 	}
@@ -121,11 +127,11 @@ func (s *CronScheduler) toggleSchedule() (stateChanged bool) {
 }
 
 func (s *CronScheduler) setLastState() {
-	s.lastCronState = s.check.Cron
+	s.lastCronState = s.check.CronSchedule()
 }
 
 func (s *CronScheduler) resetTimer(timer *CronTimer) {
-	timer.SetDuration(s.check.Cron, 0)
+	timer.SetDuration(s.check.CronSchedule(), 0)
 	timer.Next()
 }
 
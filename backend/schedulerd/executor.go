@@ -36,6 +36,10 @@ type CheckExecutor struct {
 	namespace              string
 	entityCache            *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
+
+	// splay spreads the executions of all the interval checks across the
+	// agents, whether agent splay is enabled on the check or not.
+	splay bool
 }
 
 // NewCheckExecutor creates a new check executor
@@ -63,11 +67,17 @@ func (c *CheckExecutor) execute(check *corev2.CheckConfig) error {
 		return nil
 	}
 
+	if check.IsExecutedByBackend() {
+		executeProbe(c.bus, check)
+		return nil
+	}
+
 	var err error
 	request, err := c.buildRequest(check)
 	if err != nil {
 		return err
 	}
+	request.Splay = c.splayWindow(check)
 
 	for _, sub := range check.Subscriptions {
 		topic := messaging.SubscriptionTopic(check.Namespace, sub)
@@ -76,7 +86,7 @@ func (c *CheckExecutor) execute(check *corev2.CheckConfig) error {
 			"topic": topic,
 		}).Debug("sending check request")
 
-		if pubErr := c.bus.Publish(topic, request); pubErr != nil {
+		if pubErr := publishCheckRequest(c.bus, topic, check.Namespace, request); pubErr != nil {
 			logger.WithError(pubErr).Error("error publishing check request")
 			err = pubErr
 		}
@@ -103,13 +113,38 @@ func (c *CheckExecutor) executeOnEntity(check *corev2.CheckConfig, entity string
 		"topic": topic,
 	}).Debug("sending check request")
 
-	return c.bus.Publish(topic, request)
+	return publishCheckRequest(c.bus, topic, check.Namespace, request)
+}
+
+// publishCheckRequest publishes the check request to the topic, and counts
+// it by namespace and status.
+func publishCheckRequest(bus messaging.MessageBus, topic, namespace string, request *corev2.CheckRequest) error {
+	err := bus.Publish(topic, request)
+	status := checkRequestSuccess
+	if err != nil {
+		status = checkRequestFailure
+	}
+	checkRequestCounter.WithLabelValues(namespace, status).Inc()
+	return err
 }
 
 func (c *CheckExecutor) buildRequest(check *corev2.CheckConfig) (*corev2.CheckRequest, error) {
 	return buildRequest(check, c.store, c.secretsProviderManager)
 }
 
+// splayWindow returns the window, in seconds, across which the agents spread
+// the execution of check, or zero if its executions are not splayed. Only the
+// interval checks executed on their subscriptions are splayed.
+func (c *CheckExecutor) splayWindow(check *corev2.CheckConfig) uint32 {
+	if !check.AgentSplay && !c.splay {
+		return 0
+	}
+	if check.Interval == 0 || check.Cron != "" || check.ProxyRequests != nil {
+		return 0
+	}
+	return uint32(float64(check.Interval) * corev2.DefaultSplayCoverage / 100)
+}
+
 func assetIsRelevant(asset *corev2.Asset, assets []string) bool {
 	for _, assetName := range assets {
 		if strings.HasPrefix(asset.Name, assetName) {
@@ -237,6 +272,11 @@ func (a *AdhocRequestExecutor) publishProxyCheckRequests(entities []*corev2.Enti
 }
 
 func (a *AdhocRequestExecutor) execute(check *corev2.CheckConfig) error {
+	if check.IsExecutedByBackend() {
+		executeProbe(a.bus, check)
+		return nil
+	}
+
 	var err error
 	request, err := a.buildRequest(check)
 	if err != nil {
@@ -250,7 +290,7 @@ func (a *AdhocRequestExecutor) execute(check *corev2.CheckConfig) error {
 			"topic": topic,
 		}).Debug("sending check request")
 
-		if pubErr := a.bus.Publish(topic, request); pubErr != nil {
+		if pubErr := publishCheckRequest(a.bus, topic, check.Namespace, request); pubErr != nil {
 			logger.WithError(pubErr).Error("error publishing check request")
 			err = pubErr
 		}
@@ -284,7 +324,14 @@ func publishProxyCheckRequests(e Executor, entities []*corev2.Entity, check *cor
 	return nil
 }
 
+// observeCheckSchedule records the duration of the scheduling of check,
+// which started at start.
+func observeCheckSchedule(check *corev2.CheckConfig, start time.Time) {
+	checkScheduleDuration.WithLabelValues(check.Namespace).Observe(time.Since(start).Seconds())
+}
+
 func processCheck(ctx context.Context, executor Executor, check *corev2.CheckConfig) error {
+	defer observeCheckSchedule(check, time.Now())
 	fields := logrus.Fields{
 		"check":     check.Name,
 		"namespace": check.Namespace,
@@ -310,6 +357,7 @@ func processCheck(ctx context.Context, executor Executor, check *corev2.CheckCon
 }
 
 func processRoundRobinCheck(ctx context.Context, executor *CheckExecutor, check *corev2.CheckConfig, proxyEntities []*corev2.Entity, agentEntities []string) error {
+	defer observeCheckSchedule(check, time.Now())
 	if check.ProxyRequests != nil {
 		return publishRoundRobinProxyCheckRequests(executor, check, proxyEntities, agentEntities)
 	}
@@ -336,3 +336,25 @@ func TestCheckBuildRequestAdhoc_GH2201(t *testing.T) {
 
 	assert.NoError(scheduler.msgBus.Stop())
 }
+
+func TestCheckExecutorSplayWindow(t *testing.T) {
+	executor := &CheckExecutor{}
+	check := corev2.FixtureCheckConfig("check1")
+	check.Interval = 60
+	assert.Equal(t, uint32(0), executor.splayWindow(check))
+
+	check.AgentSplay = true
+	assert.Equal(t, uint32(54), executor.splayWindow(check))
+
+	// the agent splay of all the checks is enabled on the backend
+	check.AgentSplay = false
+	executor.splay = true
+	assert.Equal(t, uint32(54), executor.splayWindow(check))
+
+	// cron checks and proxy checks are not splayed across the agents
+	check.Cron = "* * * * *"
+	assert.Equal(t, uint32(0), executor.splayWindow(check))
+	check.Cron = ""
+	check.ProxyRequests = corev2.FixtureProxyRequests(true)
+	assert.Equal(t, uint32(0), executor.splayWindow(check))
+}
@@ -0,0 +1,31 @@
+package schedulerd
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishCheckRequestMetrics(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{})
+	require.NoError(t, err)
+	request := &corev2.CheckRequest{Config: corev2.FixtureCheckConfig("check")}
+	successes := checkRequestCounter.WithLabelValues("default", checkRequestSuccess)
+	failures := checkRequestCounter.WithLabelValues("default", checkRequestFailure)
+	successesBefore := testutil.ToFloat64(successes)
+	failuresBefore := testutil.ToFloat64(failures)
+
+	require.NoError(t, bus.Start())
+	assert.NoError(t, publishCheckRequest(bus, "topic", "default", request))
+
+	// The bus is no longer running
+	require.NoError(t, bus.Stop())
+	assert.Error(t, publishCheckRequest(bus, "topic", "default", request))
+
+	assert.Equal(t, successesBefore+1, testutil.ToFloat64(successes))
+	assert.Equal(t, failuresBefore+1, testutil.ToFloat64(failures))
+}
@@ -2,6 +2,7 @@ package schedulerd
 
 import (
 	"context"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -23,10 +24,11 @@ type IntervalScheduler struct {
 	interrupt              chan *corev2.CheckConfig
 	entityCache            *cache.Resource
 	secretsProviderManager *secrets.ProviderManager
+	agentSplay             bool
 }
 
 // NewIntervalScheduler initializes an IntervalScheduler
-func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *IntervalScheduler {
+func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, check *corev2.CheckConfig, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager, agentSplay bool) *IntervalScheduler {
 	sched := &IntervalScheduler{
 		store:             store,
 		bus:               bus,
@@ -40,6 +42,7 @@ func NewIntervalScheduler(ctx context.Context, store store.Store, bus messaging.
 		}),
 		entityCache:            cache,
 		secretsProviderManager: secretsProviderManager,
+		agentSplay:             agentSplay,
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
 	sched.ctx = corev2.SetContextFromResource(sched.ctx, check)
@@ -56,6 +59,11 @@ func (s *IntervalScheduler) schedule(timer CheckTimer, executor *CheckExecutor)
 
 	s.logger.Debug("check is not subdued")
 
+	if s.check.IsBlackedOut(time.Now()) {
+		s.logger.Debug("check is in a blackout period")
+		return
+	}
+
 	if err := executor.processCheck(s.ctx, s.check); err != nil {
 		logger.WithError(err).Error("error executing check")
 	}
@@ -71,6 +79,7 @@ func (s *IntervalScheduler) start() {
 	s.logger.Info("starting new interval scheduler")
 	timer := NewIntervalTimer(s.check.Name, uint(s.check.Interval))
 	executor := NewCheckExecutor(s.bus, s.check.Namespace, s.store, s.entityCache, s.secretsProviderManager)
+	executor.splay = s.agentSplay
 
 	timer.Start()
 
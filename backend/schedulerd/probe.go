@@ -0,0 +1,65 @@
+package schedulerd
+
+import (
+	"context"
+
+	time "github.com/echlebek/timeproxy"
+	"github.com/google/uuid"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/backend/prober"
+	"github.com/sirupsen/logrus"
+)
+
+// executeProbe performs the probe of a check executed by the backend in the
+// background, and publishes its result as an event of the proxy entity of the
+// check.
+func executeProbe(bus messaging.MessageBus, check *corev2.CheckConfig) {
+	go func() {
+		event := probeEvent(context.Background(), check)
+		logger.WithFields(logrus.Fields{
+			"check":     check.Name,
+			"entity":    check.ProxyEntityName,
+			"namespace": check.Namespace,
+			"status":    event.Check.Status,
+		}).Debug("check probed by the backend")
+
+		if err := bus.Publish(messaging.TopicEventRaw, event); err != nil {
+			logger.WithError(err).Error("error publishing probe result")
+		}
+	}()
+}
+
+// probeEvent performs the probe of check and returns its result as an event of
+// the proxy entity of the check.
+func probeEvent(ctx context.Context, check *corev2.CheckConfig) *corev2.Event {
+	timeout := prober.DefaultTimeout
+	if check.Timeout > 0 {
+		timeout = time.Duration(check.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	issued := time.Now()
+	result := prober.Probe(ctx, check.Probe)
+
+	// The proxy entity is replaced by the stored one, or created, by eventd
+	entity := &corev2.Entity{
+		ObjectMeta:  corev2.NewObjectMeta(check.ProxyEntityName, check.Namespace),
+		EntityClass: corev2.EntityProxyClass,
+	}
+
+	event := corev2.NewEvent(corev2.NewObjectMeta("", check.Namespace))
+	uid, _ := uuid.NewRandom()
+	event.ID = uid[:]
+	event.Entity = entity
+	event.Check = corev2.NewCheck(check)
+	event.Check.Issued = issued.Unix()
+	event.Check.Executed = issued.Unix()
+	event.Check.Duration = result.Duration.Seconds()
+	event.Check.Status = result.Status
+	event.Check.Output = result.Output
+	event.Timestamp = time.Now().Unix()
+
+	return event
+}
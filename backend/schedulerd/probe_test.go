@@ -0,0 +1,32 @@
+package schedulerd
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeEvent(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	check := corev2.FixtureCheckConfig("check1")
+	check.Executor = corev2.CheckExecutorBackend
+	check.Probe = &corev2.CheckProbe{Type: corev2.ProbeTypeTCP, Target: ln.Addr().String()}
+	check.ProxyEntityName = "db01"
+
+	event := probeEvent(context.Background(), check)
+	assert.NoError(t, event.Validate())
+	assert.Equal(t, "db01", event.Entity.Name)
+	assert.Equal(t, corev2.EntityProxyClass, event.Entity.EntityClass)
+	assert.Equal(t, "check1", event.Check.Name)
+	assert.Equal(t, "db01", event.Check.ProxyEntityName)
+	assert.Equal(t, uint32(0), event.Check.Status)
+	assert.NotEmpty(t, event.Check.Output)
+}
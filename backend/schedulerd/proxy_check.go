@@ -75,7 +75,7 @@ func substituteProxyEntityTokens(entity *corev2.Entity, check *corev2.CheckConfi
 func calculateSplayInterval(check *corev2.CheckConfig, numEntities int) (time.Duration, error) {
 	next := time.Second * time.Duration(check.Interval)
 	if check.Cron != "" {
-		schedule, err := cron.ParseStandard(check.Cron)
+		schedule, err := cron.ParseStandard(check.CronSchedule())
 		if err != nil {
 			return 0, err
 		}
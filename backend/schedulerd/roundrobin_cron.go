@@ -2,6 +2,7 @@ package schedulerd
 
 import (
 	"context"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -27,15 +28,16 @@ type RoundRobinCronScheduler struct {
 	cancels       map[string]ringCancel
 	executor      *CheckExecutor
 	entityCache   *cache.Resource
+	tracker       *RoundRobinTracker
 }
 
 // NewRoundRobinCronScheduler creates a new RoundRobinCronScheduler.
-func NewRoundRobinCronScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, pool *ringv2.Pool, check *corev2.CheckConfig, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *RoundRobinCronScheduler {
+func NewRoundRobinCronScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, pool *ringv2.Pool, check *corev2.CheckConfig, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager, tracker *RoundRobinTracker) *RoundRobinCronScheduler {
 	sched := &RoundRobinCronScheduler{
 		store:         store,
 		bus:           bus,
 		check:         check,
-		lastCronState: check.Cron,
+		lastCronState: check.CronSchedule(),
 		interrupt:     make(chan *corev2.CheckConfig),
 		logger: logger.WithFields(logrus.Fields{
 			"name":           check.Name,
@@ -46,6 +48,7 @@ func NewRoundRobinCronScheduler(ctx context.Context, store store.Store, bus mess
 		cancels:     make(map[string]ringCancel),
 		executor:    NewCheckExecutor(bus, check.Namespace, store, cache, secretsProviderManager),
 		entityCache: cache,
+		tracker:     tracker,
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
 	sched.ctx = corev2.SetContextFromResource(sched.ctx, check)
@@ -58,10 +61,11 @@ func (s *RoundRobinCronScheduler) Start() {
 	go s.start()
 }
 
-func (s *RoundRobinCronScheduler) handleEvent(executor *CheckExecutor, event ringv2.Event, proxyEntities []*corev2.Entity) {
+func (s *RoundRobinCronScheduler) handleEvent(executor *CheckExecutor, subscription string, event ringv2.Event, proxyEntities []*corev2.Entity) {
 	switch event.Type {
 	case ringv2.EventError:
 		s.logger.WithError(event.Err).Error("error scheduling check")
+		s.tracker.skipped(s.check, subscription, "error scheduling check: "+event.Err.Error())
 
 	case ringv2.EventAdd:
 		s.logger.WithFields(
@@ -75,7 +79,7 @@ func (s *RoundRobinCronScheduler) handleEvent(executor *CheckExecutor, event rin
 
 	case ringv2.EventTrigger:
 		s.logger.Info("scheduling check")
-		s.schedule(executor, proxyEntities, event.Values)
+		s.schedule(executor, subscription, proxyEntities, event.Values)
 
 	case ringv2.EventClosing:
 		s.logger.Warn("shutting down scheduler")
@@ -109,9 +113,9 @@ func (s *RoundRobinCronScheduler) start() {
 	}
 }
 
-func (s *RoundRobinCronScheduler) handleEvents(executor *CheckExecutor, ch <-chan ringv2.Event, proxyEntities []*corev2.Entity) {
+func (s *RoundRobinCronScheduler) handleEvents(executor *CheckExecutor, subscription string, ch <-chan ringv2.Event, proxyEntities []*corev2.Entity) {
 	for event := range ch {
-		s.handleEvent(executor, event, proxyEntities)
+		s.handleEvent(executor, subscription, event, proxyEntities)
 	}
 }
 
@@ -124,6 +128,7 @@ func (s *RoundRobinCronScheduler) updateRings() {
 		agentEntitiesRequest = len(proxyEntities)
 		if agentEntitiesRequest == 0 {
 			s.logger.Error("check not published, no matching entities for proxy request")
+			s.tracker.skipped(s.check, "", "no matching entities for proxy request")
 			return
 		}
 	}
@@ -142,9 +147,9 @@ func (s *RoundRobinCronScheduler) updateRings() {
 
 		// Create a new watcher
 		ctx, cancel := context.WithCancel(s.ctx)
-		wc := s.ringPool.Get(key).Watch(ctx, s.check.Name, agentEntitiesRequest, int(s.check.Interval), s.check.Cron)
+		wc := s.ringPool.Get(key).Watch(ctx, s.check.Name, agentEntitiesRequest, int(s.check.Interval), s.check.CronSchedule())
 		val := ringCancel{Cancel: cancel, AgentEntitiesRequest: agentEntitiesRequest}
-		go s.handleEvents(s.executor, wc, proxyEntities)
+		go s.handleEvents(s.executor, sub, wc, proxyEntities)
 		newCancels[key] = val
 	}
 	// clean up any remaining watchers that are no longer valid
@@ -156,24 +161,34 @@ func (s *RoundRobinCronScheduler) updateRings() {
 	s.cancels = newCancels
 }
 
-func (s *RoundRobinCronScheduler) schedule(executor *CheckExecutor, proxyEntities []*corev2.Entity, agentEntities []string) {
+func (s *RoundRobinCronScheduler) schedule(executor *CheckExecutor, subscription string, proxyEntities []*corev2.Entity, agentEntities []string) {
 	if s.check.IsSubdued() {
 		s.logger.Debug("check is subdued")
+		s.tracker.skipped(s.check, subscription, "check is subdued")
 		return
 	}
 
 	s.logger.Debug("check is not subdued")
 
+	if s.check.IsBlackedOut(time.Now()) {
+		s.logger.Debug("check is in a blackout period")
+		s.tracker.skipped(s.check, subscription, "check is in a blackout period")
+		return
+	}
+
 	if err := processRoundRobinCheck(s.ctx, executor, s.check, proxyEntities, agentEntities); err != nil {
 		logger.WithError(err).Error("error executing check")
+		s.tracker.skipped(s.check, subscription, "error executing check: "+err.Error())
+		return
 	}
+	s.tracker.executed(s.check, subscription, agentEntities)
 }
 
 // Indicates a state change in the schedule, and if a timer needs to be reset.
 func (s *RoundRobinCronScheduler) toggleSchedule() (stateChanged bool) {
 	defer s.setLastState()
 
-	if s.lastCronState != s.check.Cron {
+	if s.lastCronState != s.check.CronSchedule() {
 		s.logger.Info("cron schedule has changed")
 		return true
 	}
@@ -183,7 +198,7 @@ func (s *RoundRobinCronScheduler) toggleSchedule() (stateChanged bool) {
 
 // Update the CronScheduler with the last schedule states
 func (s *RoundRobinCronScheduler) setLastState() {
-	s.lastCronState = s.check.Cron
+	s.lastCronState = s.check.CronSchedule()
 }
 
 // Interrupt refreshes the scheduler with a revised check config.
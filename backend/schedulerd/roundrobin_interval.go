@@ -3,6 +3,7 @@ package schedulerd
 import (
 	"context"
 	"reflect"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/messaging"
@@ -34,10 +35,11 @@ type RoundRobinIntervalScheduler struct {
 	executor               *CheckExecutor
 	cancels                map[string]ringCancel
 	entityCache            *cache.Resource
+	tracker                *RoundRobinTracker
 }
 
 // NewRoundRobinIntervalScheduler initializes a RoundRobinIntervalScheduler
-func NewRoundRobinIntervalScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, pool *ringv2.Pool, check *corev2.CheckConfig, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager) *RoundRobinIntervalScheduler {
+func NewRoundRobinIntervalScheduler(ctx context.Context, store store.Store, bus messaging.MessageBus, pool *ringv2.Pool, check *corev2.CheckConfig, cache *cache.Resource, secretsProviderManager *secrets.ProviderManager, tracker *RoundRobinTracker) *RoundRobinIntervalScheduler {
 	sched := &RoundRobinIntervalScheduler{
 		store:             store,
 		bus:               bus,
@@ -53,6 +55,7 @@ func NewRoundRobinIntervalScheduler(ctx context.Context, store store.Store, bus
 		cancels:     make(map[string]ringCancel),
 		executor:    NewCheckExecutor(bus, check.Namespace, store, cache, secretsProviderManager),
 		entityCache: cache,
+		tracker:     tracker,
 	}
 	sched.ctx, sched.cancel = context.WithCancel(ctx)
 	sched.ctx = corev2.SetContextFromResource(sched.ctx, check)
@@ -68,6 +71,7 @@ func (s *RoundRobinIntervalScheduler) updateRings() {
 		agentEntitiesRequest = len(proxyEntities)
 		if agentEntitiesRequest == 0 {
 			s.logger.Error("check not published, no matching entities for proxy request")
+			s.tracker.skipped(s.check, "", "no matching entities for proxy request")
 			return
 		}
 	}
@@ -87,9 +91,9 @@ func (s *RoundRobinIntervalScheduler) updateRings() {
 		// Create a new watcher
 		ctx, cancel := context.WithCancel(s.ctx)
 		ring := s.ringPool.Get(key)
-		wc := ring.Watch(ctx, s.check.Name, agentEntitiesRequest, int(s.check.Interval), s.check.Cron)
+		wc := ring.Watch(ctx, s.check.Name, agentEntitiesRequest, int(s.check.Interval), s.check.CronSchedule())
 		val := ringCancel{Cancel: cancel, AgentEntitiesRequest: agentEntitiesRequest}
-		go s.handleEvents(s.executor, wc, proxyEntities)
+		go s.handleEvents(s.executor, sub, wc, proxyEntities)
 		newCancels[key] = val
 	}
 	// clean up any remaining watchers that are no longer valid
@@ -107,9 +111,9 @@ func (s *RoundRobinIntervalScheduler) Start() {
 	go s.start()
 }
 
-func (s *RoundRobinIntervalScheduler) handleEvents(executor *CheckExecutor, ch <-chan ringv2.Event, proxyEntities []*corev2.Entity) {
+func (s *RoundRobinIntervalScheduler) handleEvents(executor *CheckExecutor, subscription string, ch <-chan ringv2.Event, proxyEntities []*corev2.Entity) {
 	for event := range ch {
-		s.handleEvent(executor, event, proxyEntities)
+		s.handleEvent(executor, subscription, event, proxyEntities)
 	}
 }
 
@@ -121,10 +125,11 @@ func getAgentEntity(event ringv2.Event) string {
 	return entity
 }
 
-func (s *RoundRobinIntervalScheduler) handleEvent(executor *CheckExecutor, event ringv2.Event, proxyEntities []*corev2.Entity) {
+func (s *RoundRobinIntervalScheduler) handleEvent(executor *CheckExecutor, subscription string, event ringv2.Event, proxyEntities []*corev2.Entity) {
 	switch event.Type {
 	case ringv2.EventError:
 		s.logger.WithError(event.Err).Error("error scheduling check")
+		s.tracker.skipped(s.check, subscription, "error scheduling check: "+event.Err.Error())
 
 	case ringv2.EventAdd:
 		s.logger.WithFields(logrus.Fields{
@@ -140,7 +145,7 @@ func (s *RoundRobinIntervalScheduler) handleEvent(executor *CheckExecutor, event
 		// The ring has produced a trigger for the entity, and a check should
 		// be executed.
 		s.logger.WithFields(logrus.Fields{"agents": event.Values}).Info("executing round robin check on agents")
-		s.schedule(executor, proxyEntities, event.Values)
+		s.schedule(executor, subscription, proxyEntities, event.Values)
 
 	case ringv2.EventClosing:
 		s.logger.Warn("shutting down scheduler")
@@ -172,17 +177,27 @@ func (s *RoundRobinIntervalScheduler) start() {
 	}
 }
 
-func (s *RoundRobinIntervalScheduler) schedule(executor *CheckExecutor, proxyEntities []*corev2.Entity, agentEntities []string) {
+func (s *RoundRobinIntervalScheduler) schedule(executor *CheckExecutor, subscription string, proxyEntities []*corev2.Entity, agentEntities []string) {
 	if s.check.IsSubdued() {
 		s.logger.Debug("check is subdued")
+		s.tracker.skipped(s.check, subscription, "check is subdued")
 		return
 	}
 
 	s.logger.Debug("check is not subdued")
 
+	if s.check.IsBlackedOut(time.Now()) {
+		s.logger.Debug("check is in a blackout period")
+		s.tracker.skipped(s.check, subscription, "check is in a blackout period")
+		return
+	}
+
 	if err := processRoundRobinCheck(s.ctx, executor, s.check, proxyEntities, agentEntities); err != nil {
 		logger.WithError(err).Error("error executing check")
+		s.tracker.skipped(s.check, subscription, "error executing check: "+err.Error())
+		return
 	}
+	s.tracker.executed(s.check, subscription, agentEntities)
 }
 
 // Indicates a state change in the schedule, and if a timer needs to be reset.
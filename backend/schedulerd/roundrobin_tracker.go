@@ -0,0 +1,189 @@
+package schedulerd
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/ringv2"
+)
+
+// DefaultRoundRobinHistorySize is the default number of iterations recorded
+// per round robin check.
+const DefaultRoundRobinHistorySize = 100
+
+// RoundRobinIteration is an iteration of a round robin check on one of its
+// subscriptions.
+type RoundRobinIteration struct {
+	// Timestamp is the time of the iteration in seconds since the Epoch.
+	Timestamp int64 `json:"timestamp"`
+
+	// Subscription is the subscription of the ring that triggered the
+	// iteration.
+	Subscription string `json:"subscription,omitempty"`
+
+	// Agents are the agent entities the check was executed on.
+	Agents []string `json:"agents,omitempty"`
+
+	// Skipped is true if the check was not executed.
+	Skipped bool `json:"skipped"`
+
+	// Reason is the reason the check was not executed.
+	Reason string `json:"reason,omitempty"`
+}
+
+// RoundRobinRing is the state of the ring of a subscription of a round robin
+// check.
+type RoundRobinRing struct {
+	// Subscription is the subscription of the ring.
+	Subscription string `json:"subscription"`
+
+	// Agents are the agent entities in the ring.
+	Agents []string `json:"agents"`
+
+	// Triggers are the triggers of the ring watchers of the check. The ring
+	// is stalled if the check has no trigger while agents are in the ring.
+	Triggers []ringv2.Trigger `json:"triggers"`
+}
+
+// RoundRobinStatus is the scheduling status of a round robin check.
+type RoundRobinStatus struct {
+	// Check is the name of the check.
+	Check string `json:"check"`
+
+	// Namespace is the namespace of the check.
+	Namespace string `json:"namespace"`
+
+	// RoundRobin is false if round robin scheduling is not enabled for the
+	// check.
+	RoundRobin bool `json:"round_robin"`
+
+	// Rings are the rings of the subscriptions of the check.
+	Rings []RoundRobinRing `json:"rings"`
+
+	// Iterations are the last iterations of the check observed by this
+	// backend, from the oldest to the most recent.
+	Iterations []RoundRobinIteration `json:"iterations"`
+
+	// LastExecuted is the time of the last iteration the check was executed,
+	// in seconds since the Epoch.
+	LastExecuted int64 `json:"last_executed,omitempty"`
+
+	// Skipped is the number of skipped iterations.
+	Skipped int `json:"skipped"`
+}
+
+// RoundRobinTracker records the iterations of the round robin checks, so that
+// their scheduling can be inspected. The zero value is not usable, but a nil
+// tracker records nothing.
+type RoundRobinTracker struct {
+	ringPool *ringv2.Pool
+	size     int
+
+	mu         sync.Mutex
+	iterations map[string][]RoundRobinIteration
+}
+
+// NewRoundRobinTracker creates a RoundRobinTracker recording up to size
+// iterations per check.
+func NewRoundRobinTracker(pool *ringv2.Pool, size int) *RoundRobinTracker {
+	return &RoundRobinTracker{
+		ringPool:   pool,
+		size:       size,
+		iterations: make(map[string][]RoundRobinIteration),
+	}
+}
+
+// executed records an iteration of check executed on agents.
+func (t *RoundRobinTracker) executed(check *corev2.CheckConfig, subscription string, agents []string) {
+	t.record(check, RoundRobinIteration{
+		Subscription: subscription,
+		Agents:       agents,
+	})
+}
+
+// skipped records an iteration of check that was not executed.
+func (t *RoundRobinTracker) skipped(check *corev2.CheckConfig, subscription string, reason string) {
+	t.record(check, RoundRobinIteration{
+		Subscription: subscription,
+		Skipped:      true,
+		Reason:       reason,
+	})
+}
+
+func (t *RoundRobinTracker) record(check *corev2.CheckConfig, iteration RoundRobinIteration) {
+	if t == nil {
+		return
+	}
+	iteration.Timestamp = time.Now().Unix()
+	key := path.Join(check.Namespace, check.Name)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	iterations := append(t.iterations[key], iteration)
+	if len(iterations) > t.size {
+		iterations = iterations[len(iterations)-t.size:]
+	}
+	t.iterations[key] = iterations
+}
+
+// Iterations returns the iterations recorded for a check, from the oldest to
+// the most recent.
+func (t *RoundRobinTracker) Iterations(namespace, check string) []RoundRobinIteration {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	iterations := t.iterations[path.Join(namespace, check)]
+	result := make([]RoundRobinIteration, len(iterations))
+	copy(result, iterations)
+	return result
+}
+
+// Status returns the scheduling status of check, including the state of the
+// rings of its subscriptions.
+func (t *RoundRobinTracker) Status(ctx context.Context, check *corev2.CheckConfig) (*RoundRobinStatus, error) {
+	status := &RoundRobinStatus{
+		Check:      check.Name,
+		Namespace:  check.Namespace,
+		RoundRobin: check.RoundRobin,
+		Rings:      []RoundRobinRing{},
+		Iterations: t.Iterations(check.Namespace, check.Name),
+	}
+	if status.Iterations == nil {
+		status.Iterations = []RoundRobinIteration{}
+	}
+
+	for _, iteration := range status.Iterations {
+		if iteration.Skipped {
+			status.Skipped++
+		} else {
+			status.LastExecuted = iteration.Timestamp
+		}
+	}
+
+	if t == nil || t.ringPool == nil || !check.RoundRobin {
+		return status, nil
+	}
+
+	for _, sub := range check.Subscriptions {
+		ring := t.ringPool.Get(ringv2.Path(check.Namespace, sub))
+		agents, err := ring.Items(ctx)
+		if err != nil {
+			return nil, err
+		}
+		triggers, err := ring.Triggers(ctx, check.Name)
+		if err != nil {
+			return nil, err
+		}
+		status.Rings = append(status.Rings, RoundRobinRing{
+			Subscription: sub,
+			Agents:       agents,
+			Triggers:     triggers,
+		})
+	}
+	return status, nil
+}
@@ -0,0 +1,48 @@
+package schedulerd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundRobinTracker(t *testing.T) {
+	check := corev2.FixtureCheckConfig("check1")
+	check.RoundRobin = true
+	other := corev2.FixtureCheckConfig("check2")
+
+	tracker := NewRoundRobinTracker(nil, 2)
+	tracker.executed(check, "linux", []string{"agent1"})
+	tracker.skipped(check, "linux", "check is subdued")
+	tracker.executed(check, "linux", []string{"agent2"})
+	tracker.skipped(other, "linux", "check is subdued")
+
+	iterations := tracker.Iterations("default", "check1")
+	require.Len(t, iterations, 2)
+	assert.True(t, iterations[0].Skipped)
+	assert.Equal(t, "check is subdued", iterations[0].Reason)
+	assert.Equal(t, []string{"agent2"}, iterations[1].Agents)
+
+	status, err := tracker.Status(context.Background(), check)
+	require.NoError(t, err)
+	assert.True(t, status.RoundRobin)
+	assert.Equal(t, 1, status.Skipped)
+	assert.Equal(t, iterations[1].Timestamp, status.LastExecuted)
+	assert.Empty(t, status.Rings)
+}
+
+func TestRoundRobinTrackerNil(t *testing.T) {
+	var tracker *RoundRobinTracker
+	check := corev2.FixtureCheckConfig("check1")
+	tracker.executed(check, "linux", []string{"agent1"})
+	tracker.skipped(check, "linux", errors.New("error").Error())
+
+	status, err := tracker.Status(context.Background(), check)
+	require.NoError(t, err)
+	assert.Empty(t, status.Iterations)
+	assert.NotNil(t, status.Iterations)
+}
@@ -42,6 +42,27 @@ var (
 			Help: "Number of active round robin cron check schedulers on this backend.",
 		},
 		[]string{"namespace"})
+
+	checkRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_go_check_requests_published",
+			Help: "The total number of check requests published by this backend, by status",
+		},
+		[]string{"namespace", "status"})
+
+	checkScheduleDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sensu_go_check_schedule_duration_seconds",
+			Help:    "The duration of the scheduling of checks, from the matching of entities to the publication of the requests, in seconds",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"namespace"})
+)
+
+// The statuses of the published check requests.
+const (
+	checkRequestSuccess = "success"
+	checkRequestFailure = "failure"
 )
 
 // Schedulerd handles scheduling check requests for each check's
@@ -71,6 +92,11 @@ type Config struct {
 	Bus                    messaging.MessageBus
 	Client                 *clientv3.Client
 	SecretsProviderManager *secrets.ProviderManager
+	RoundRobinTracker      *RoundRobinTracker
+
+	// AgentSplay spreads the executions of all the interval checks across
+	// the agents.
+	AgentSplay bool
 }
 
 // New creates a new Schedulerd.
@@ -89,7 +115,7 @@ func New(ctx context.Context, c Config, opts ...Option) (*Schedulerd, error) {
 		return nil, err
 	}
 	s.entityCache = cache
-	s.checkWatcher = NewCheckWatcher(s.ctx, c.Bus, c.Store, c.RingPool, cache, s.secretsProviderManager)
+	s.checkWatcher = NewCheckWatcher(s.ctx, c.Bus, c.Store, c.RingPool, cache, s.secretsProviderManager, c.RoundRobinTracker, c.AgentSplay)
 	s.adhocRequestExecutor = NewAdhocRequestExecutor(s.ctx, s.store, s.queueGetter.GetQueue(adhocQueueName), s.bus, s.entityCache, s.secretsProviderManager)
 
 	for _, o := range opts {
@@ -106,6 +132,8 @@ func (s *Schedulerd) Start() error {
 	_ = prometheus.Register(cronCounter)
 	_ = prometheus.Register(rrIntervalCounter)
 	_ = prometheus.Register(rrCronCounter)
+	_ = prometheus.Register(checkRequestCounter)
+	_ = prometheus.Register(checkScheduleDuration)
 	return s.checkWatcher.Start()
 }
 
@@ -0,0 +1,37 @@
+package secrets
+
+import (
+	"fmt"
+	"os"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// EnvProviderName is the name of the built-in env provider, resolving the
+// secrets from the environment variables of the backend.
+const EnvProviderName = "env"
+
+// EnvProvider resolves the secrets from the environment variables of the
+// backend, the secret ID being the name of the variable.
+type EnvProvider struct {
+	*corev2.SecretsProvider
+}
+
+// NewEnvProvider returns the built-in env provider.
+func NewEnvProvider() *EnvProvider {
+	return &EnvProvider{
+		SecretsProvider: &corev2.SecretsProvider{
+			ObjectMeta: corev2.NewObjectMeta(EnvProviderName, ""),
+			Type:       corev2.SecretsProviderTypeEnv,
+		},
+	}
+}
+
+// Get returns the value of the environment variable of the given name.
+func (p *EnvProvider) Get(id string) (string, error) {
+	value, ok := os.LookupEnv(id)
+	if !ok {
+		return "", fmt.Errorf("environment variable %q is not set", id)
+	}
+	return value, nil
+}
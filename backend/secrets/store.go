@@ -0,0 +1,109 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// StoreGetter gets the provider and ID of the Sensu secrets from the secrets
+// stored in the namespace of the context.
+type StoreGetter struct {
+	Store store.ResourceStore
+}
+
+// Get gets the name of the provider and secret ID associated with the Sensu
+// secret name.
+func (g *StoreGetter) Get(ctx context.Context, name string) (string, string, error) {
+	secret := &corev2.SecretConfig{}
+	if err := g.Store.GetResource(ctx, name, secret); err != nil {
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return "", "", fmt.Errorf("secret %q not found", name)
+		}
+		return "", "", err
+	}
+	return secret.Provider, secret.ID, nil
+}
+
+// NewProvider returns the provider configured by the given secrets provider.
+func NewProvider(provider *corev2.SecretsProvider) (Provider, error) {
+	switch provider.Type {
+	case corev2.SecretsProviderTypeEnv:
+		return &EnvProvider{SecretsProvider: provider}, nil
+	case corev2.SecretsProviderTypeVault:
+		return NewVaultProvider(provider)
+	default:
+		return nil, fmt.Errorf("secrets provider type %q is not supported", provider.Type)
+	}
+}
+
+// watchRetryDelay is the delay before the providers are watched again when
+// the watch fails.
+var watchRetryDelay = time.Second
+
+// Watch keeps the providers of the manager in sync with the secrets providers
+// of the store until ctx is done. The built-in env provider is restored when
+// a secrets provider of the same name is deleted.
+func (m *ProviderManager) Watch(ctx context.Context, watcher store.ResourceWatcher) {
+	for {
+		watched := m.watch(ctx, watcher)
+
+		// The deletions could have been missed, so the providers are removed
+		// until they are listed again
+		for name := range watched {
+			m.remove(name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+// watch applies the changes of the secrets providers of the store until the
+// watch fails, and returns the names of the providers added from the store.
+func (m *ProviderManager) watch(ctx context.Context, watcher store.ResourceWatcher) map[string]bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watched := map[string]bool{}
+	changes := watcher.WatchResources(store.NamespaceContext(ctx, ""), corev2.SecretsProvidersResource, &corev2.SecretsProvider{}, 0)
+	for change := range changes {
+		if change.Action == store.WatchError {
+			logger.Warning("secrets providers watch failed, restarting it")
+			return watched
+		}
+		provider, ok := change.Resource.(*corev2.SecretsProvider)
+		if !ok {
+			continue
+		}
+		if change.Action == store.WatchDelete {
+			m.remove(provider.Name)
+			delete(watched, provider.Name)
+			continue
+		}
+		p, err := NewProvider(provider)
+		if err != nil {
+			logger.WithError(err).WithField("provider", provider.Name).Error("invalid secrets provider")
+			continue
+		}
+		m.AddProvider(p)
+		watched[provider.Name] = true
+	}
+	return watched
+}
+
+// remove removes the provider of the given name, restoring the built-in env
+// provider if it is its name.
+func (m *ProviderManager) remove(name string) {
+	if name == EnvProviderName {
+		m.AddProvider(NewEnvProvider())
+		return
+	}
+	_ = m.RemoveProvider(name)
+}
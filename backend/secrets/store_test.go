@@ -0,0 +1,80 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreGetter(t *testing.T) {
+	s := &mockstore.MockStore{}
+	s.On("GetResource", mock.Anything, "github", mock.AnythingOfType("*v2.SecretConfig")).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*corev2.SecretConfig) = *corev2.FixtureSecretConfig("github")
+		}).Return(nil)
+	s.On("GetResource", mock.Anything, "slack", mock.Anything).Return(&store.ErrNotFound{})
+	getter := &StoreGetter{Store: s}
+
+	provider, id, err := getter.Get(context.Background(), "github")
+	require.NoError(t, err)
+	assert.Equal(t, "env", provider)
+	assert.Equal(t, "SENSU_SECRET", id)
+
+	_, _, err = getter.Get(context.Background(), "slack")
+	assert.Error(t, err)
+}
+
+func TestEnvProvider(t *testing.T) {
+	os.Setenv("SENSU_TEST_SECRET", "hunter2")
+	defer os.Unsetenv("SENSU_TEST_SECRET")
+
+	provider := NewEnvProvider()
+	value, err := provider.Get("SENSU_TEST_SECRET")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", value)
+
+	_, err = provider.Get("SENSU_TEST_MISSING_SECRET")
+	assert.Error(t, err)
+}
+
+// chanWatcher emits the changes sent to its channel.
+type chanWatcher chan store.WatchEventResource
+
+func (w chanWatcher) WatchResources(ctx context.Context, prefix string, elem corev2.Resource, revision int64) <-chan store.WatchEventResource {
+	return w
+}
+
+func TestProviderManagerWatch(t *testing.T) {
+	m := NewProviderManager()
+	m.AddProvider(NewEnvProvider())
+	watcher := make(chanWatcher)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.Watch(ctx, watcher)
+
+	vault := corev2.FixtureSecretsProvider("vault")
+	watcher <- store.WatchEventResource{Action: store.WatchCreate, Resource: vault}
+	env := corev2.FixtureSecretsProvider("env")
+	env.Type = corev2.SecretsProviderTypeEnv
+	env.Vault = nil
+	watcher <- store.WatchEventResource{Action: store.WatchCreate, Resource: env}
+	watcher <- store.WatchEventResource{Action: store.WatchDelete, Resource: vault}
+	watcher <- store.WatchEventResource{Action: store.WatchDelete, Resource: env}
+	// Wait for the last change to be applied
+	watcher <- store.WatchEventResource{Action: store.WatchCreate, Resource: corev2.FixtureSecretsProvider("vault-ops")}
+
+	assert.Eventually(t, func() bool {
+		return len(m.Providers()) == 2
+	}, time.Second, 10*time.Millisecond)
+	providers := m.Providers()
+	assert.Equal(t, NewEnvProvider(), providers["env"])
+	assert.IsType(t, &VaultProvider{}, providers["vault-ops"])
+}
@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// defaultVaultTimeout is the timeout of the requests to Vault when the
+// provider does not set one.
+const defaultVaultTimeout = 10 * time.Second
+
+// VaultProvider resolves the secrets from a key/value secrets engine of
+// HashiCorp Vault. The secret IDs are "path#key", e.g. "secret/slack#webhook"
+// for the key "webhook" of the secret "slack" of the engine mounted at
+// "secret".
+type VaultProvider struct {
+	*corev2.SecretsProvider
+	client *http.Client
+	token  string
+}
+
+// NewVaultProvider returns a provider for the given vault secrets provider.
+func NewVaultProvider(provider *corev2.SecretsProvider) (*VaultProvider, error) {
+	config := provider.Vault
+	if config == nil {
+		return nil, fmt.Errorf("secrets provider %q has no vault configuration", provider.Name)
+	}
+
+	timeout := defaultVaultTimeout
+	if config.Timeout > 0 {
+		timeout = time.Duration(config.Timeout) * time.Second
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.TLS != nil {
+		tlsConfig, err := config.TLS.ToClientTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	token := config.Token
+	if token == "" {
+		token = os.Getenv("VAULT_TOKEN")
+	}
+
+	return &VaultProvider{
+		SecretsProvider: provider,
+		client:          &http.Client{Timeout: timeout, Transport: transport},
+		token:           token,
+	}, nil
+}
+
+// vaultResponse is the response of Vault to the read of a secret, or to a
+// failed request.
+type vaultResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []string               `json:"errors"`
+}
+
+// Get returns the value of the key of the secret identified by the given
+// "path#key" ID.
+func (p *VaultProvider) Get(id string) (string, error) {
+	i := strings.LastIndex(id, "#")
+	if i < 0 {
+		return "", fmt.Errorf("vault secret id %q must be path#key", id)
+	}
+	path, key := strings.Trim(id[:i], "/"), id[i+1:]
+	if p.Vault.Version != "v1" {
+		// The secrets of the version 2 of the engine are read under data/
+		parts := strings.SplitN(path, "/", 2)
+		if len(parts) != 2 {
+			return "", fmt.Errorf("vault secret id %q must include the mount of the secrets engine", id)
+		}
+		path = parts[0] + "/data/" + parts[1]
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(p.Vault.Address, "/")+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var body vaultResponse
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("vault secret %q not found", path)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil && resp.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("invalid vault response: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault responded %s: %s", resp.Status, strings.Join(body.Errors, ", "))
+	}
+
+	data := body.Data
+	if p.Vault.Version != "v1" {
+		data, _ = data["data"].(map[string]interface{})
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("vault secret %q has no key %q", path, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	return string(b), err
+}
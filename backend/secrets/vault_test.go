@@ -0,0 +1,79 @@
+package secrets
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVaultServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors": ["permission denied"]}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/slack":
+			fmt.Fprint(w, `{"data": {"data": {"webhook": "https://hooks.slack.com/T0", "port": 443}}}`)
+		case "/v1/kv/slack":
+			fmt.Fprint(w, `{"data": {"webhook": "https://hooks.slack.com/T1"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": []}`)
+		}
+	}))
+}
+
+func TestVaultProvider(t *testing.T) {
+	server := newVaultServer(t)
+	defer server.Close()
+
+	config := corev2.FixtureSecretsProvider("vault")
+	config.Vault.Address = server.URL
+	config.Vault.Token = "s.token"
+	provider, err := NewVaultProvider(config)
+	require.NoError(t, err)
+
+	value, err := provider.Get("secret/slack#webhook")
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.slack.com/T0", value)
+
+	value, err = provider.Get("secret/slack#port")
+	require.NoError(t, err)
+	assert.Equal(t, "443", value)
+
+	_, err = provider.Get("secret/slack#token")
+	assert.Error(t, err)
+
+	_, err = provider.Get("secret/github#token")
+	assert.Error(t, err)
+
+	_, err = provider.Get("secret/slack")
+	assert.Error(t, err)
+
+	config.Vault.Version = "v1"
+	value, err = provider.Get("kv/slack#webhook")
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.slack.com/T1", value)
+}
+
+func TestVaultProviderForbidden(t *testing.T) {
+	server := newVaultServer(t)
+	defer server.Close()
+
+	config := corev2.FixtureSecretsProvider("vault")
+	config.Vault.Address = server.URL
+	config.Vault.Token = "s.expired"
+	provider, err := NewVaultProvider(config)
+	require.NoError(t, err)
+
+	_, err = provider.Get("secret/slack#webhook")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
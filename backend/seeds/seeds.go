@@ -218,6 +218,8 @@ func setupClusterRoles(store store.Store) error {
 				Verbs: []string{"get", "list"},
 				Resources: []string{
 					"namespaces",
+					"resource-quotas",
+					"event-retention-policies",
 				},
 			},
 		},
@@ -240,6 +242,8 @@ func setupClusterRoles(store store.Store) error {
 				Verbs: []string{"get", "list"},
 				Resources: []string{
 					"namespaces",
+					"resource-quotas",
+					"event-retention-policies",
 				},
 			},
 		},
@@ -258,6 +262,8 @@ func setupClusterRoles(store store.Store) error {
 				Verbs: []string{"get", "list"},
 				Resources: append(types.CommonCoreResources, []string{
 					"namespaces",
+					"resource-quotas",
+					"event-retention-policies",
 				}...),
 			},
 		},
@@ -0,0 +1,234 @@
+// Package selector parses and evaluates the label and field selectors used to
+// filter resources, such as:
+//
+//	region == "us-west-1" && app in [web, api] && tier != db
+//
+// A selector is a conjunction of requirements on keys. The operators are ==,
+// !=, in and notin. Values can be quoted, and the in and notin operators take
+// a list of values between square brackets.
+package selector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Operator is the operator of a requirement
+type Operator string
+
+const (
+	// OperatorEqual matches the keys equal to the value
+	OperatorEqual Operator = "=="
+
+	// OperatorNotEqual matches the keys not equal to the value, or missing
+	OperatorNotEqual Operator = "!="
+
+	// OperatorIn matches the keys equal to one of the values
+	OperatorIn Operator = "in"
+
+	// OperatorNotIn matches the keys equal to none of the values, or missing
+	OperatorNotIn Operator = "notin"
+)
+
+// Requirement is a requirement on the value of a key
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches returns true if set satisfies the requirement.
+func (r Requirement) Matches(set map[string]string) bool {
+	value, ok := set[r.Key]
+	switch r.Operator {
+	case OperatorEqual, OperatorIn:
+		return ok && contains(r.Values, value)
+	case OperatorNotEqual, OperatorNotIn:
+		return !ok || !contains(r.Values, value)
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Selector is a conjunction of requirements. The zero value matches
+// everything.
+type Selector struct {
+	Requirements []Requirement
+}
+
+// Matches returns true if set satisfies all the requirements of the selector.
+func (s *Selector) Matches(set map[string]string) bool {
+	if s == nil {
+		return true
+	}
+	for _, r := range s.Requirements {
+		if !r.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if the selector has no requirements.
+func (s *Selector) Empty() bool {
+	return s == nil || len(s.Requirements) == 0
+}
+
+// Parse parses a selector. An empty string is parsed as the empty selector.
+func Parse(s string) (*Selector, error) {
+	p := &parser{}
+	if err := p.lex(s); err != nil {
+		return nil, err
+	}
+	selector := &Selector{}
+	if len(p.tokens) == 0 {
+		return selector, nil
+	}
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %s", s, err)
+		}
+		selector.Requirements = append(selector.Requirements, r)
+		if p.done() {
+			return selector, nil
+		}
+		if tok := p.next(); tok.value != "&&" || tok.quoted {
+			return nil, fmt.Errorf("invalid selector %q: expected && but found %q", s, tok.value)
+		}
+	}
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// lex splits s in tokens: the operators, the brackets and commas of lists,
+// and the keys and values, quoted or not.
+func (p *parser) lex(s string) error {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case strings.HasPrefix(s[i:], "&&"), strings.HasPrefix(s[i:], "=="), strings.HasPrefix(s[i:], "!="):
+			p.tokens = append(p.tokens, token{value: s[i : i+2]})
+			i += 2
+		case c == '[' || c == ']' || c == ',':
+			p.tokens = append(p.tokens, token{value: string(c)})
+			i++
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return fmt.Errorf("invalid selector %q: unterminated string", s)
+			}
+			value := s[i : i+end+2]
+			if c == '"' {
+				unquoted, err := strconv.Unquote(value)
+				if err != nil {
+					return fmt.Errorf("invalid selector %q: %s", s, err)
+				}
+				value = unquoted
+			} else {
+				value = value[1 : len(value)-1]
+			}
+			p.tokens = append(p.tokens, token{value: value, quoted: true})
+			i += end + 2
+		default:
+			j := i
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsAny(s[j:j+1], "[],=!&\"'") {
+				j++
+			}
+			if j == i {
+				return fmt.Errorf("invalid selector %q: unexpected %q", s, s[i:i+1])
+			}
+			p.tokens = append(p.tokens, token{value: s[i:j]})
+			i = j
+		}
+	}
+	return nil
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) next() token {
+	if p.done() {
+		return token{}
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok
+}
+
+func (p *parser) requirement() (Requirement, error) {
+	var r Requirement
+	key := p.next()
+	if key.value == "" {
+		return r, fmt.Errorf("expected a key")
+	}
+	r.Key = key.value
+
+	op := p.next()
+	if op.quoted {
+		return r, fmt.Errorf("expected an operator but found %q", op.value)
+	}
+	switch Operator(op.value) {
+	case OperatorEqual, OperatorNotEqual:
+		r.Operator = Operator(op.value)
+		value := p.next()
+		if !value.quoted && (value.value == "" || strings.ContainsAny(value.value[:1], "[],=!&")) {
+			return r, fmt.Errorf("expected a value for %s but found %q", r.Key, value.value)
+		}
+		r.Values = []string{value.value}
+	case OperatorIn, OperatorNotIn:
+		r.Operator = Operator(op.value)
+		values, err := p.list()
+		if err != nil {
+			return r, err
+		}
+		r.Values = values
+	default:
+		return r, fmt.Errorf("unknown operator %q, must be ==, !=, in or notin", op.value)
+	}
+	return r, nil
+}
+
+func (p *parser) list() ([]string, error) {
+	if tok := p.next(); tok.value != "[" || tok.quoted {
+		return nil, fmt.Errorf("expected [ but found %q", tok.value)
+	}
+	var values []string
+	for {
+		value := p.next()
+		if !value.quoted && (value.value == "" || value.value == "]" || value.value == ",") {
+			return nil, fmt.Errorf("expected a value but found %q", value.value)
+		}
+		values = append(values, value.value)
+		switch tok := p.next(); {
+		case tok.quoted:
+			return nil, fmt.Errorf("expected , or ] but found %q", tok.value)
+		case tok.value == "]":
+			return values, nil
+		case tok.value != ",":
+			return nil, fmt.Errorf("expected , or ] but found %q", tok.value)
+		}
+	}
+}
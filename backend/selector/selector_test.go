@@ -0,0 +1,83 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     []Requirement
+		wantErr  bool
+	}{
+		{selector: "", want: nil},
+		{
+			selector: `region == "us-west-1"`,
+			want:     []Requirement{{Key: "region", Operator: OperatorEqual, Values: []string{"us-west-1"}}},
+		},
+		{
+			selector: "region==us-west-1 && app in [web, 'api server'] && event.check.name notin [a] && tier != db",
+			want: []Requirement{
+				{Key: "region", Operator: OperatorEqual, Values: []string{"us-west-1"}},
+				{Key: "app", Operator: OperatorIn, Values: []string{"web", "api server"}},
+				{Key: "event.check.name", Operator: OperatorNotIn, Values: []string{"a"}},
+				{Key: "tier", Operator: OperatorNotEqual, Values: []string{"db"}},
+			},
+		},
+		{selector: `team == ""`, want: []Requirement{{Key: "team", Operator: OperatorEqual, Values: []string{""}}}},
+		{selector: "region", wantErr: true},
+		{selector: "region =", wantErr: true},
+		{selector: "region == a b", wantErr: true},
+		{selector: "region matches a", wantErr: true},
+		{selector: "region in a", wantErr: true},
+		{selector: "region in [a", wantErr: true},
+		{selector: "region in []", wantErr: true},
+		{selector: `region == "a`, wantErr: true},
+		{selector: "region == a &&", wantErr: true},
+		{selector: "region == && a == b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			s, err := Parse(tt.selector)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Requirements)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"region": "us-west-1", "app": "web"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"region == us-west-1", true},
+		{"region == us-east-1", false},
+		{"region != us-east-1", true},
+		{"tier != db", true},
+		{"tier == db", false},
+		{"app in [api, web] && region == us-west-1", true},
+		{"app in [api, web] && region == us-east-1", false},
+		{"app notin [api, web]", false},
+		{"tier notin [db]", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			s, err := Parse(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Matches(labels))
+		})
+	}
+
+	var s *Selector
+	assert.True(t, s.Matches(labels))
+	assert.True(t, s.Empty())
+}
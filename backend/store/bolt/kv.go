@@ -0,0 +1,421 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// Range gets the keys in the range from the store.
+func (s *Store) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	// Like the gRPC calls to etcd, the requests whose context is done fail
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var resp *pb.RangeResponse
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+		rev := decodeInt(meta.Get(revisionKey))
+		var err error
+		resp, err = rangeKeys(tx, r, rev, decodeInt(meta.Get(compactRevisionKey)))
+		resp.Header = s.header(rev)
+		return err
+	})
+	return resp, err
+}
+
+// Put puts the given key into the store.
+func (s *Store) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var resp *pb.PutResponse
+	header, err := s.update(func(txn *writeTxn) error {
+		var err error
+		resp, err = s.put(txn, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = header
+	return resp, nil
+}
+
+// DeleteRange deletes the keys in the range from the store.
+func (s *Store) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var resp *pb.DeleteRangeResponse
+	header, err := s.update(func(txn *writeTxn) error {
+		var err error
+		resp, err = deleteRange(txn, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = header
+	return resp, nil
+}
+
+// Txn processes multiple requests in a single transaction, at a single
+// revision.
+func (s *Store) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var resp *pb.TxnResponse
+	header := &pb.ResponseHeader{}
+	txnHeader, err := s.update(func(txn *writeTxn) error {
+		var err error
+		resp, err = s.txn(txn, r, header)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The header is shared by the responses of all the operations
+	*header = *txnHeader
+	return resp, nil
+}
+
+// Compact compacts the history of the store up to the given revision.
+func (s *Store) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	header, err := s.update(func(txn *writeTxn) error {
+		if r.Revision <= s.compactRev {
+			return rpctypes.ErrGRPCCompacted
+		}
+		if r.Revision > s.rev {
+			return rpctypes.ErrGRPCFutureRev
+		}
+		txn.onCommit = append(txn.onCommit, func() {
+			s.compactRev = r.Revision
+		})
+		return compactHistory(txn.tx, r.Revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompactionResponse{Header: header}, nil
+}
+
+func (s *Store) put(txn *writeTxn, r *pb.PutRequest) (*pb.PutResponse, error) {
+	value, lease := r.Value, r.Lease
+	if r.IgnoreValue || r.IgnoreLease {
+		prev, err := txn.get(r.Key)
+		if err != nil {
+			return nil, err
+		}
+		if prev == nil {
+			return nil, rpctypes.ErrGRPCKeyNotFound
+		}
+		if r.IgnoreValue {
+			value = prev.Value
+		}
+		if r.IgnoreLease {
+			lease = prev.Lease
+		}
+	}
+	if lease != 0 {
+		if _, ok := s.leases[lease]; !ok {
+			return nil, rpctypes.ErrGRPCLeaseNotFound
+		}
+	}
+	prev, err := txn.put(r.Key, value, lease)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PutResponse{}
+	if r.PrevKv {
+		resp.PrevKv = prev
+	}
+	return resp, nil
+}
+
+func deleteRange(txn *writeTxn, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	kvs, err := scan(txn.tx, r.Key, r.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.DeleteRangeResponse{}
+	for _, kv := range kvs {
+		if _, err := txn.delete(kv.Key); err != nil {
+			return nil, err
+		}
+		resp.Deleted++
+		if r.PrevKv {
+			resp.PrevKvs = append(resp.PrevKvs, kv)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Store) txn(txn *writeTxn, r *pb.TxnRequest, header *pb.ResponseHeader) (*pb.TxnResponse, error) {
+	succeeded := true
+	for _, c := range r.Compare {
+		ok, err := compare(txn.tx, c)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			succeeded = false
+			break
+		}
+	}
+	ops := r.Success
+	if !succeeded {
+		ops = r.Failure
+	}
+	resp := &pb.TxnResponse{Header: header, Succeeded: succeeded}
+	for _, op := range ops {
+		var respOp *pb.ResponseOp
+		switch req := op.Request.(type) {
+		case *pb.RequestOp_RequestRange:
+			// The reads of a transaction see its writes
+			rangeResp, err := rangeKeys(txn.tx, req.RequestRange, 0, 0)
+			if err != nil {
+				return nil, err
+			}
+			rangeResp.Header = header
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseRange{ResponseRange: rangeResp}}
+		case *pb.RequestOp_RequestPut:
+			putResp, err := s.put(txn, req.RequestPut)
+			if err != nil {
+				return nil, err
+			}
+			putResp.Header = header
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponsePut{ResponsePut: putResp}}
+		case *pb.RequestOp_RequestDeleteRange:
+			deleteResp, err := deleteRange(txn, req.RequestDeleteRange)
+			if err != nil {
+				return nil, err
+			}
+			deleteResp.Header = header
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseDeleteRange{ResponseDeleteRange: deleteResp}}
+		case *pb.RequestOp_RequestTxn:
+			txnResp, err := s.txn(txn, req.RequestTxn, header)
+			if err != nil {
+				return nil, err
+			}
+			respOp = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: txnResp}}
+		default:
+			return nil, rpctypes.ErrGRPCNotCapable
+		}
+		resp.Responses = append(resp.Responses, respOp)
+	}
+	return resp, nil
+}
+
+// compare evaluates the comparison c against the keys in its range. All the
+// keys of the range must satisfy the comparison.
+func compare(tx *bbolt.Tx, c *pb.Compare) (bool, error) {
+	kvs, err := scan(tx, c.Key, c.RangeEnd)
+	if err != nil {
+		return false, err
+	}
+	if len(kvs) == 0 {
+		// A missing key has no value, and zero revisions and version
+		if c.Target == pb.Compare_VALUE {
+			return false, nil
+		}
+		return compareKV(c, &mvccpb.KeyValue{}), nil
+	}
+	for _, kv := range kvs {
+		if !compareKV(c, kv) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func compareKV(c *pb.Compare, kv *mvccpb.KeyValue) bool {
+	var result int
+	switch c.Target {
+	case pb.Compare_VALUE:
+		result = bytes.Compare(kv.Value, c.GetValue())
+	case pb.Compare_VERSION:
+		result = compareInt(kv.Version, c.GetVersion())
+	case pb.Compare_CREATE:
+		result = compareInt(kv.CreateRevision, c.GetCreateRevision())
+	case pb.Compare_MOD:
+		result = compareInt(kv.ModRevision, c.GetModRevision())
+	case pb.Compare_LEASE:
+		result = compareInt(kv.Lease, c.GetLease())
+	}
+	switch c.Result {
+	case pb.Compare_EQUAL:
+		return result == 0
+	case pb.Compare_NOT_EQUAL:
+		return result != 0
+	case pb.Compare_GREATER:
+		return result > 0
+	case pb.Compare_LESS:
+		return result < 0
+	}
+	return false
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// rangeKeys gets the keys in the range of r. The keys are read at the revision
+// of r if it is before the current revision rev, using the history of the
+// store, or at the current revision if rev is zero.
+func rangeKeys(tx *bbolt.Tx, r *pb.RangeRequest, rev, compactRev int64) (*pb.RangeResponse, error) {
+	kvs, err := scan(tx, r.Key, r.RangeEnd)
+	if err != nil {
+		return &pb.RangeResponse{}, err
+	}
+	if rev > 0 && r.Revision > 0 && r.Revision != rev {
+		if r.Revision > rev {
+			return &pb.RangeResponse{}, rpctypes.ErrGRPCFutureRev
+		}
+		if r.Revision <= compactRev {
+			return &pb.RangeResponse{}, rpctypes.ErrGRPCCompacted
+		}
+		if kvs, err = rewind(tx, r, kvs); err != nil {
+			return &pb.RangeResponse{}, err
+		}
+	}
+
+	filtered := kvs[:0]
+	for _, kv := range kvs {
+		if (r.MinModRevision > 0 && kv.ModRevision < r.MinModRevision) ||
+			(r.MaxModRevision > 0 && kv.ModRevision > r.MaxModRevision) ||
+			(r.MinCreateRevision > 0 && kv.CreateRevision < r.MinCreateRevision) ||
+			(r.MaxCreateRevision > 0 && kv.CreateRevision > r.MaxCreateRevision) {
+			continue
+		}
+		filtered = append(filtered, kv)
+	}
+	kvs = filtered
+	sortKVs(kvs, r.SortTarget, r.SortOrder)
+
+	resp := &pb.RangeResponse{Count: int64(len(kvs))}
+	if r.CountOnly {
+		return resp, nil
+	}
+	if r.Limit > 0 && int64(len(kvs)) > r.Limit {
+		kvs = kvs[:r.Limit]
+		resp.More = true
+	}
+	if r.KeysOnly {
+		for _, kv := range kvs {
+			kv.Value = nil
+		}
+	}
+	resp.Kvs = kvs
+	return resp, nil
+}
+
+// rewind reverts the changes made to the keys kvs in the range of r after the
+// revision of r, using the history of the store.
+func rewind(tx *bbolt.Tx, r *pb.RangeRequest, kvs []*mvccpb.KeyValue) ([]*mvccpb.KeyValue, error) {
+	values := make(map[string]*mvccpb.KeyValue, len(kvs))
+	for _, kv := range kvs {
+		values[string(kv.Key)] = kv
+	}
+	c := tx.Bucket(historyBucket).Cursor()
+	start := historyKey(r.Revision+1, 0)
+	for k, v := c.Last(); k != nil && bytes.Compare(k, start) >= 0; k, v = c.Prev() {
+		event, err := decodeEvent(v)
+		if err != nil {
+			return nil, err
+		}
+		if !inRange(event.Kv.Key, r.Key, r.RangeEnd) {
+			continue
+		}
+		if event.PrevKv != nil {
+			values[string(event.Kv.Key)] = event.PrevKv
+		} else {
+			delete(values, string(event.Kv.Key))
+		}
+	}
+	kvs = kvs[:0]
+	for _, kv := range values {
+		kvs = append(kvs, kv)
+	}
+	sort.Slice(kvs, func(i, j int) bool {
+		return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0
+	})
+	return kvs, nil
+}
+
+// scan returns the current value of the keys in the range [key, end), in key
+// order.
+func scan(tx *bbolt.Tx, key, end []byte) ([]*mvccpb.KeyValue, error) {
+	bucket := tx.Bucket(kvBucket)
+	if len(end) == 0 {
+		value := bucket.Get(key)
+		if value == nil {
+			return nil, nil
+		}
+		kv, err := decodeKV(value)
+		if err != nil {
+			return nil, err
+		}
+		return []*mvccpb.KeyValue{kv}, nil
+	}
+	var kvs []*mvccpb.KeyValue
+	c := bucket.Cursor()
+	for k, v := c.Seek(key); k != nil && inRange(k, key, end); k, v = c.Next() {
+		kv, err := decodeKV(v)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+// inRange returns true if key is in the range [start, end). Like etcd, an
+// empty end only matches start, and an end of "\x00" matches all the keys
+// from start.
+func inRange(key, start, end []byte) bool {
+	if len(end) == 0 {
+		return bytes.Equal(key, start)
+	}
+	if bytes.Compare(key, start) < 0 {
+		return false
+	}
+	return (len(end) == 1 && end[0] == 0) || bytes.Compare(key, end) < 0
+}
+
+func sortKVs(kvs []*mvccpb.KeyValue, target pb.RangeRequest_SortTarget, order pb.RangeRequest_SortOrder) {
+	if order == pb.RangeRequest_NONE {
+		return
+	}
+	less := func(a, b *mvccpb.KeyValue) bool {
+		switch target {
+		case pb.RangeRequest_VERSION:
+			return a.Version < b.Version
+		case pb.RangeRequest_CREATE:
+			return a.CreateRevision < b.CreateRevision
+		case pb.RangeRequest_MOD:
+			return a.ModRevision < b.ModRevision
+		case pb.RangeRequest_VALUE:
+			return bytes.Compare(a.Value, b.Value) < 0
+		}
+		return bytes.Compare(a.Key, b.Key) < 0
+	}
+	sort.SliceStable(kvs, func(i, j int) bool {
+		if order == pb.RangeRequest_DESCEND {
+			return less(kvs[j], kvs[i])
+		}
+		return less(kvs[i], kvs[j])
+	})
+}
@@ -0,0 +1,189 @@
+package bolt
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+// maxLeaseTTL is the maximum TTL of the leases, like etcd.
+const maxLeaseTTL = 9000000000
+
+// lease is a lease of the store, and the keys attached to it.
+type lease struct {
+	ttl    int64
+	expiry time.Time
+	keys   map[string]struct{}
+}
+
+// remaining returns the remaining TTL of the lease l, in seconds.
+func (l *lease) remaining() int64 {
+	return int64(math.Ceil(time.Until(l.expiry).Seconds()))
+}
+
+// LeaseGrant creates a lease which expires if the store does not receive a
+// keepAlive within a given time to live period.
+func (s *Store) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	if r.TTL > maxLeaseTTL {
+		return nil, rpctypes.ErrGRPCLeaseTTLTooLarge
+	}
+	id := r.ID
+	header, err := s.update(func(txn *writeTxn) error {
+		if id == 0 {
+			for id == 0 || s.leases[id] != nil {
+				id = rand.Int63()
+			}
+		} else if _, ok := s.leases[id]; ok {
+			return rpctypes.ErrGRPCLeaseExist
+		}
+		if err := txn.tx.Bucket(leaseBucket).Put(encodeInt(id), encodeInt(r.TTL)); err != nil {
+			return err
+		}
+		txn.onCommit = append(txn.onCommit, func() {
+			s.leases[id] = &lease{
+				ttl:    r.TTL,
+				expiry: time.Now().Add(time.Duration(r.TTL) * time.Second),
+				keys:   make(map[string]struct{}),
+			}
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LeaseGrantResponse{Header: header, ID: id, TTL: r.TTL}, nil
+}
+
+// LeaseRevoke revokes a lease. All keys attached to the lease will expire and
+// be deleted.
+func (s *Store) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
+	header, err := s.update(func(txn *writeTxn) error {
+		if _, ok := s.leases[r.ID]; !ok {
+			return rpctypes.ErrGRPCLeaseNotFound
+		}
+		return s.revoke(txn, r.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LeaseRevokeResponse{Header: header}, nil
+}
+
+// revoke deletes the lease of the given ID, and the keys attached to it.
+func (s *Store) revoke(txn *writeTxn, id int64) error {
+	for key := range s.leases[id].keys {
+		if _, err := txn.delete([]byte(key)); err != nil {
+			return err
+		}
+	}
+	if err := txn.tx.Bucket(leaseBucket).Delete(encodeInt(id)); err != nil {
+		return err
+	}
+	txn.onCommit = append(txn.onCommit, func() {
+		delete(s.leases, id)
+	})
+	return nil
+}
+
+// LeaseKeepAlive keeps the leases alive by streaming keep alive requests from
+// the client to the store and streaming keep alive responses from the store
+// to the client.
+func (s *Store) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			// The stream is closed by the client
+			return nil
+		}
+		s.mu.Lock()
+		resp := &pb.LeaseKeepAliveResponse{Header: s.header(s.rev), ID: req.ID}
+		if l, ok := s.leases[req.ID]; ok {
+			l.expiry = time.Now().Add(time.Duration(l.ttl) * time.Second)
+			resp.TTL = l.ttl
+		}
+		s.mu.Unlock()
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// LeaseTimeToLive retrieves lease information.
+func (s *Store) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &pb.LeaseTimeToLiveResponse{Header: s.header(s.rev), ID: r.ID, TTL: -1}
+	l, ok := s.leases[r.ID]
+	if !ok {
+		return resp, nil
+	}
+	resp.TTL = l.remaining()
+	resp.GrantedTTL = l.ttl
+	if r.Keys {
+		for key := range l.keys {
+			resp.Keys = append(resp.Keys, []byte(key))
+		}
+	}
+	return resp, nil
+}
+
+// LeaseLeases lists all existing leases.
+func (s *Store) LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &pb.LeaseLeasesResponse{Header: s.header(s.rev)}
+	for id := range s.leases {
+		resp.Leases = append(resp.Leases, &pb.LeaseStatus{ID: id})
+	}
+	return resp, nil
+}
+
+// expireLeases revokes the expired leases periodically, until the store is
+// closed.
+func (s *Store) expireLeases() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(leaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+		if !s.hasExpiredLeases() {
+			continue
+		}
+		_, err := s.update(func(txn *writeTxn) error {
+			now := time.Now()
+			for id, l := range s.leases {
+				if now.Before(l.expiry) {
+					continue
+				}
+				if err := s.revoke(txn, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil && err != errStopped {
+			logger.WithError(err).Error("error revoking the expired leases")
+		}
+	}
+}
+
+// hasExpiredLeases returns true if any lease expired.
+func (s *Store) hasExpiredLeases() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for _, l := range s.leases {
+		if !now.Before(l.expiry) {
+			return true
+		}
+	}
+	return false
+}
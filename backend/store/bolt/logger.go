@@ -0,0 +1,7 @@
+package bolt
+
+import "github.com/sirupsen/logrus"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "store",
+})
@@ -0,0 +1,156 @@
+package bolt
+
+import (
+	"context"
+	"crypto/sha256"
+	"hash/crc32"
+	"io"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// snapshotChunkSize is the size of the chunks of the snapshots sent.
+const snapshotChunkSize = 32 * 1024
+
+// errSingleMember is returned by the operations changing the members of the
+// store, which always has a single member.
+var errSingleMember = status.New(codes.Unimplemented, "bolt store: the store has a single member, which can't be changed").Err()
+
+// MemberAdd adds a member into the cluster, which is not supported.
+func (s *Store) MemberAdd(ctx context.Context, r *pb.MemberAddRequest) (*pb.MemberAddResponse, error) {
+	return nil, errSingleMember
+}
+
+// MemberRemove removes an existing member from the cluster, which is not
+// supported.
+func (s *Store) MemberRemove(ctx context.Context, r *pb.MemberRemoveRequest) (*pb.MemberRemoveResponse, error) {
+	return nil, errSingleMember
+}
+
+// MemberUpdate updates the member configuration, which is not supported.
+func (s *Store) MemberUpdate(ctx context.Context, r *pb.MemberUpdateRequest) (*pb.MemberUpdateResponse, error) {
+	return nil, errSingleMember
+}
+
+// MemberList lists the single member of the store. The member has no client
+// URLs, since it is only reachable through the clients of the store.
+func (s *Store) MemberList(ctx context.Context, r *pb.MemberListRequest) (*pb.MemberListResponse, error) {
+	return &pb.MemberListResponse{
+		Header:  s.currentHeader(),
+		Members: []*pb.Member{{ID: s.memberID, Name: s.name}},
+	}, nil
+}
+
+// Alarm lists the alarms of the store, which never raises any.
+func (s *Store) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmResponse, error) {
+	return &pb.AlarmResponse{Header: s.currentHeader()}, nil
+}
+
+// Status gets the status of the store.
+func (s *Store) Status(ctx context.Context, r *pb.StatusRequest) (*pb.StatusResponse, error) {
+	header := s.currentHeader()
+	resp := &pb.StatusResponse{
+		Header:    header,
+		Leader:    s.memberID,
+		RaftIndex: uint64(header.Revision),
+		RaftTerm:  header.RaftTerm,
+	}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		resp.DbSize = tx.Size()
+		return nil
+	})
+	return resp, err
+}
+
+// Defragment defragments the store, which bbolt does as it goes.
+func (s *Store) Defragment(ctx context.Context, r *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
+	return &pb.DefragmentResponse{Header: s.currentHeader()}, nil
+}
+
+// Hash computes the hash of the whole store.
+func (s *Store) Hash(ctx context.Context, r *pb.HashRequest) (*pb.HashResponse, error) {
+	resp := &pb.HashResponse{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		h := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+		if _, err := tx.WriteTo(h); err != nil {
+			return err
+		}
+		resp.Header = s.header(decodeInt(tx.Bucket(metaBucket).Get(revisionKey)))
+		resp.Hash = h.Sum32()
+		return nil
+	})
+	return resp, err
+}
+
+// HashKV computes the hash of the current keys of the store.
+func (s *Store) HashKV(ctx context.Context, r *pb.HashKVRequest) (*pb.HashKVResponse, error) {
+	resp := &pb.HashKVResponse{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		h := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+		err := tx.Bucket(kvBucket).ForEach(func(k, v []byte) error {
+			_, _ = h.Write(k)
+			_, _ = h.Write(v)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		meta := tx.Bucket(metaBucket)
+		resp.Header = s.header(decodeInt(meta.Get(revisionKey)))
+		resp.CompactRevision = decodeInt(meta.Get(compactRevisionKey))
+		resp.Hash = h.Sum32()
+		return nil
+	})
+	return resp, err
+}
+
+// Snapshot sends a snapshot of the bbolt database of the store, followed by
+// its SHA-256 checksum like etcd.
+func (s *Store) Snapshot(r *pb.SnapshotRequest, stream pb.Maintenance_SnapshotServer) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		header := s.header(decodeInt(tx.Bucket(metaBucket).Get(revisionKey)))
+		remaining := tx.Size()
+		h := sha256.New()
+		pr, pw := io.Pipe()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := tx.WriteTo(pw)
+			_ = pw.CloseWithError(err)
+		}()
+		// The transaction must outlive its writing
+		defer func() {
+			_ = pr.Close()
+			<-done
+		}()
+		buf := make([]byte, snapshotChunkSize)
+		for {
+			n, err := io.ReadFull(pr, buf)
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				return err
+			}
+			if n == 0 {
+				break
+			}
+			remaining -= int64(n)
+			_, _ = h.Write(buf[:n])
+			resp := &pb.SnapshotResponse{
+				Header:         header,
+				RemainingBytes: uint64(remaining),
+				Blob:           append([]byte(nil), buf[:n]...),
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		return stream.Send(&pb.SnapshotResponse{Header: header, Blob: h.Sum(nil)})
+	})
+}
+
+// MoveLeader moves the leadership of the store, which has a single member.
+func (s *Store) MoveLeader(ctx context.Context, r *pb.MoveLeaderRequest) (*pb.MoveLeaderResponse, error) {
+	return nil, errSingleMember
+}
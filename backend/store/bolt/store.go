@@ -0,0 +1,384 @@
+// Package bolt implements the etcd v3 API on top of an embedded bbolt
+// database, for the single-node deployments of the backend that don't want to
+// operate etcd. The store is used through the etcd client returned by
+// NewClient, so the etcd implementation of the sensu store, and every daemon
+// built on etcd, run unchanged on top of it.
+//
+// The store keeps the revisions of the keys like etcd does, but only the
+// history of the last revisions is kept to serve the watchers and the reads at
+// a past revision. It has a single member, which can't be changed.
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/proxy/grpcproxy/adapter"
+	bbolt "go.etcd.io/bbolt"
+)
+
+const (
+	// historyRetention is the number of revisions whose history is kept.
+	historyRetention = 10000
+
+	// leaseCheckInterval is the interval at which the expired leases are
+	// revoked.
+	leaseCheckInterval = 500 * time.Millisecond
+
+	// openTimeout is the time to wait for the lock of the database file, held
+	// by another process, before giving up.
+	openTimeout = 10 * time.Second
+)
+
+var (
+	// kvBucket holds the current value of every key, as a mvccpb.KeyValue.
+	kvBucket = []byte("kv")
+
+	// historyBucket holds the events of the last revisions, as mvccpb.Event
+	// with their previous value, keyed by revision and index.
+	historyBucket = []byte("history")
+
+	// leaseBucket holds the TTL of every lease, keyed by lease ID.
+	leaseBucket = []byte("lease")
+
+	// metaBucket holds the revisions and the identifiers of the store.
+	metaBucket = []byte("meta")
+
+	revisionKey        = []byte("revision")
+	compactRevisionKey = []byte("compactRevision")
+	clusterIDKey       = []byte("clusterID")
+	memberIDKey        = []byte("memberID")
+)
+
+// Store is an embedded, single-node store implementing the KV, Watch, Lease,
+// Cluster and Maintenance services of the etcd v3 API.
+type Store struct {
+	db        *bbolt.DB
+	name      string
+	clusterID uint64
+	memberID  uint64
+
+	// mu serializes the writes, and guards the fields below
+	mu              sync.Mutex
+	rev             int64
+	compactRev      int64
+	leases          map[int64]*lease
+	watchers        map[*watcher]struct{}
+	closed          bool
+	done            chan struct{}
+	wg              sync.WaitGroup
+	closeOnce       sync.Once
+	historyRetained int64
+}
+
+// Open opens, or creates, the store in the database file at path. The name is
+// the name of the single member of the store.
+func Open(path, name string) (*Store, error) {
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		db:              db,
+		name:            name,
+		leases:          make(map[int64]*lease),
+		watchers:        make(map[*watcher]struct{}),
+		done:            make(chan struct{}),
+		historyRetained: historyRetention,
+	}
+	if err := db.Update(s.load); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.expireLeases()
+	return s, nil
+}
+
+// load creates the buckets of a new store, and loads the revisions, the
+// identifiers and the leases of the store.
+func (s *Store) load(tx *bbolt.Tx) error {
+	for _, name := range [][]byte{kvBucket, historyBucket, leaseBucket, metaBucket} {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+	}
+	meta := tx.Bucket(metaBucket)
+	if meta.Get(revisionKey) == nil {
+		// The revision of a new store is 1, like etcd
+		if err := meta.Put(revisionKey, encodeInt(1)); err != nil {
+			return err
+		}
+		if err := meta.Put(compactRevisionKey, encodeInt(0)); err != nil {
+			return err
+		}
+		if err := meta.Put(clusterIDKey, encodeInt(int64(rand.Uint64()>>1))); err != nil {
+			return err
+		}
+		if err := meta.Put(memberIDKey, encodeInt(int64(rand.Uint64()>>1))); err != nil {
+			return err
+		}
+	}
+	s.rev = decodeInt(meta.Get(revisionKey))
+	s.compactRev = decodeInt(meta.Get(compactRevisionKey))
+	s.clusterID = uint64(decodeInt(meta.Get(clusterIDKey)))
+	s.memberID = uint64(decodeInt(meta.Get(memberIDKey)))
+
+	// The leases get their whole TTL back, like when an etcd leader is
+	// elected
+	now := time.Now()
+	err := tx.Bucket(leaseBucket).ForEach(func(k, v []byte) error {
+		ttl := decodeInt(v)
+		s.leases[decodeInt(k)] = &lease{
+			ttl:    ttl,
+			expiry: now.Add(time.Duration(ttl) * time.Second),
+			keys:   make(map[string]struct{}),
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(kvBucket).ForEach(func(k, v []byte) error {
+		kv, err := decodeKV(v)
+		if err != nil {
+			return err
+		}
+		if l, ok := s.leases[kv.Lease]; ok {
+			l.keys[string(kv.Key)] = struct{}{}
+		}
+		return nil
+	})
+}
+
+// Close stops the watchers and the expiration of the leases, and closes the
+// database.
+func (s *Store) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		close(s.done)
+		s.mu.Unlock()
+		s.wg.Wait()
+		err = s.db.Close()
+	})
+	return err
+}
+
+// NewClient returns an etcd client calling the store directly, without going
+// through the network.
+func (s *Store) NewClient() *clientv3.Client {
+	c := clientv3.NewCtxClient(context.Background())
+	c.KV = clientv3.NewKVFromKVClient(adapter.KvServerToKvClient(s), c)
+	c.Lease = clientv3.NewLeaseFromLeaseClient(adapter.LeaseServerToLeaseClient(s), c, time.Second)
+	c.Watcher = clientv3.NewWatchFromWatchClient(adapter.WatchServerToWatchClient(s), c)
+	c.Maintenance = clientv3.NewMaintenanceFromMaintenanceClient(adapter.MaintenanceServerToMaintenanceClient(s), c)
+	c.Cluster = clientv3.NewClusterFromClusterClient(adapter.ClusterServerToClusterClient(s), c)
+	return c
+}
+
+// header returns the response header at the revision rev.
+func (s *Store) header(rev int64) *pb.ResponseHeader {
+	return &pb.ResponseHeader{
+		ClusterId: s.clusterID,
+		MemberId:  s.memberID,
+		Revision:  rev,
+		RaftTerm:  1,
+	}
+}
+
+// currentHeader returns the response header at the current revision.
+func (s *Store) currentHeader() *pb.ResponseHeader {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.header(s.rev)
+}
+
+// errStopped is returned by the writes once the store is closed.
+var errStopped = errors.New("bolt store: closed")
+
+// writeTxn is a write transaction of the store. All the writes of a
+// transaction are done at the same revision.
+type writeTxn struct {
+	tx       *bbolt.Tx
+	rev      int64
+	events   []*mvccpb.Event
+	onCommit []func()
+}
+
+// update runs fn in a write transaction, and once it is committed, updates
+// the leases and notifies the watchers of its events. The revision of the
+// store is incremented if fn wrote any key. The header of the revision of the
+// transaction is returned.
+func (s *Store) update(fn func(*writeTxn) error) (*pb.ResponseHeader, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, errStopped
+	}
+	txn := &writeTxn{rev: s.rev + 1}
+	compactRev := s.compactRev
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		txn.tx = tx
+		if err := fn(txn); err != nil {
+			return err
+		}
+		if len(txn.events) == 0 {
+			return nil
+		}
+		history := tx.Bucket(historyBucket)
+		for i, event := range txn.events {
+			value, err := event.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := history.Put(historyKey(txn.rev, int64(i)), value); err != nil {
+				return err
+			}
+		}
+		meta := tx.Bucket(metaBucket)
+		if err := meta.Put(revisionKey, encodeInt(txn.rev)); err != nil {
+			return err
+		}
+		if txn.rev-compactRev > 2*s.historyRetained {
+			compactRev = txn.rev - s.historyRetained
+			return compactHistory(tx, compactRev)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(txn.events) > 0 {
+		s.rev = txn.rev
+		s.compactRev = compactRev
+		s.attachLeases(txn.events)
+		s.notify(txn.rev, txn.events)
+	}
+	for _, fn := range txn.onCommit {
+		fn()
+	}
+	return s.header(s.rev), nil
+}
+
+// compactHistory removes the history up to the revision rev, included.
+func compactHistory(tx *bbolt.Tx, rev int64) error {
+	c := tx.Bucket(historyBucket).Cursor()
+	end := historyKey(rev+1, 0)
+	for k, _ := c.First(); k != nil && string(k) < string(end); k, _ = c.Next() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(metaBucket).Put(compactRevisionKey, encodeInt(rev))
+}
+
+// attachLeases tracks the keys attached to every lease, given the events of a
+// transaction.
+func (s *Store) attachLeases(events []*mvccpb.Event) {
+	for _, event := range events {
+		key := string(event.Kv.Key)
+		if event.PrevKv != nil {
+			if l, ok := s.leases[event.PrevKv.Lease]; ok {
+				delete(l.keys, key)
+			}
+		}
+		if event.Type == mvccpb.PUT {
+			if l, ok := s.leases[event.Kv.Lease]; ok {
+				l.keys[key] = struct{}{}
+			}
+		}
+	}
+}
+
+// get returns the current value of key, or nil if it doesn't exist.
+func (t *writeTxn) get(key []byte) (*mvccpb.KeyValue, error) {
+	value := t.tx.Bucket(kvBucket).Get(key)
+	if value == nil {
+		return nil, nil
+	}
+	return decodeKV(value)
+}
+
+// put writes the value of key, attached to lease, and returns its previous
+// value, if any.
+func (t *writeTxn) put(key, value []byte, lease int64) (*mvccpb.KeyValue, error) {
+	prev, err := t.get(key)
+	if err != nil {
+		return nil, err
+	}
+	kv := &mvccpb.KeyValue{
+		Key:            key,
+		Value:          value,
+		CreateRevision: t.rev,
+		ModRevision:    t.rev,
+		Version:        1,
+		Lease:          lease,
+	}
+	if prev != nil {
+		kv.CreateRevision = prev.CreateRevision
+		kv.Version = prev.Version + 1
+	}
+	data, err := kv.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := t.tx.Bucket(kvBucket).Put(key, data); err != nil {
+		return nil, err
+	}
+	t.events = append(t.events, &mvccpb.Event{Type: mvccpb.PUT, Kv: kv, PrevKv: prev})
+	return prev, nil
+}
+
+// delete deletes key, and returns its previous value, or nil if it didn't
+// exist.
+func (t *writeTxn) delete(key []byte) (*mvccpb.KeyValue, error) {
+	prev, err := t.get(key)
+	if err != nil || prev == nil {
+		return nil, err
+	}
+	if err := t.tx.Bucket(kvBucket).Delete(key); err != nil {
+		return nil, err
+	}
+	kv := &mvccpb.KeyValue{Key: key, ModRevision: t.rev}
+	t.events = append(t.events, &mvccpb.Event{Type: mvccpb.DELETE, Kv: kv, PrevKv: prev})
+	return prev, nil
+}
+
+func historyKey(rev, index int64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, uint64(rev))
+	binary.BigEndian.PutUint64(key[8:], uint64(index))
+	return key
+}
+
+func encodeInt(i int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(i))
+	return b
+}
+
+func decodeInt(b []byte) int64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func decodeKV(value []byte) (*mvccpb.KeyValue, error) {
+	kv := &mvccpb.KeyValue{}
+	return kv, kv.Unmarshal(value)
+}
+
+func decodeEvent(value []byte) (*mvccpb.Event, error) {
+	event := &mvccpb.Event{}
+	return event, event.Unmarshal(value)
+}
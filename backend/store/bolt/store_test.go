@@ -0,0 +1,299 @@
+package bolt
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) (*Store, *clientv3.Client, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "sensu-bolt")
+	require.NoError(t, err)
+	s, err := Open(filepath.Join(dir, "sensu.db"), "default")
+	require.NoError(t, err)
+	client := s.NewClient()
+	return s, client, func() {
+		_ = client.Close()
+		_ = s.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestKV(t *testing.T) {
+	_, client, cleanup := newTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	put, err := client.Put(ctx, "/a/1", "one")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), put.Header.Revision)
+	_, err = client.Put(ctx, "/a/2", "two")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/b/1", "three")
+	require.NoError(t, err)
+	put, err = client.Put(ctx, "/a/1", "uno", clientv3.WithPrevKV())
+	require.NoError(t, err)
+	require.NotNil(t, put.PrevKv)
+	assert.Equal(t, "one", string(put.PrevKv.Value))
+
+	resp, err := client.Get(ctx, "/a/1")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	kv := resp.Kvs[0]
+	assert.Equal(t, "uno", string(kv.Value))
+	assert.Equal(t, int64(2), kv.CreateRevision)
+	assert.Equal(t, int64(5), kv.ModRevision)
+	assert.Equal(t, int64(2), kv.Version)
+
+	resp, err = client.Get(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithLimit(1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Count)
+	assert.True(t, resp.More)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "/a/1", string(resp.Kvs[0].Key))
+
+	resp, err = client.Get(ctx, "/a/2", clientv3.WithFromKey(), clientv3.WithKeysOnly())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 2)
+	assert.Equal(t, "/b/1", string(resp.Kvs[1].Key))
+	assert.Empty(t, resp.Kvs[1].Value)
+
+	resp, err = client.Get(ctx, "/", clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortDescend))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 3)
+	assert.Equal(t, "/a/1", string(resp.Kvs[0].Key))
+
+	// The past revisions can be read
+	resp, err = client.Get(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithRev(3))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 2)
+	assert.Equal(t, "one", string(resp.Kvs[0].Value))
+
+	del, err := client.Delete(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithPrevKV())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), del.Deleted)
+	assert.Len(t, del.PrevKvs, 2)
+	resp, err = client.Get(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.Count)
+
+	_, err = client.Get(ctx, "/a/1", clientv3.WithRev(100))
+	assert.Equal(t, rpctypes.ErrFutureRev, err)
+	_, err = client.Compact(ctx, 5)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, "/a/1", clientv3.WithRev(4))
+	assert.Equal(t, rpctypes.ErrCompacted, err)
+}
+
+func TestTxn(t *testing.T) {
+	_, client, cleanup := newTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	create := func(value string) (*clientv3.TxnResponse, error) {
+		return client.Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision("key"), "=", 0)).
+			Then(clientv3.OpPut("key", value), clientv3.OpPut("other", value)).
+			Else(clientv3.OpGet("key")).
+			Commit()
+	}
+
+	resp, err := create("first")
+	require.NoError(t, err)
+	assert.True(t, resp.Succeeded)
+	assert.Equal(t, int64(2), resp.Header.Revision)
+	assert.Equal(t, int64(2), resp.Responses[1].GetResponsePut().Header.Revision)
+
+	resp, err = create("second")
+	require.NoError(t, err)
+	assert.False(t, resp.Succeeded)
+	assert.Equal(t, int64(2), resp.Header.Revision)
+	kvs := resp.Responses[0].GetResponseRange().Kvs
+	require.Len(t, kvs, 1)
+	assert.Equal(t, "first", string(kvs[0].Value))
+
+	resp, err = client.Txn(ctx).
+		If(
+			clientv3.Compare(clientv3.Value("key"), "=", "first"),
+			clientv3.Compare(clientv3.Version("other"), ">", 0),
+		).
+		Then(clientv3.OpDelete("key")).
+		Commit()
+	require.NoError(t, err)
+	assert.True(t, resp.Succeeded)
+	assert.Equal(t, int64(3), resp.Header.Revision)
+
+	// Like etcd, the version of the keys can be used as a mutex
+	session, err := concurrency.NewSession(client)
+	require.NoError(t, err)
+	defer session.Close()
+	mu := concurrency.NewMutex(session, "/lock")
+	require.NoError(t, mu.Lock(ctx))
+	require.NoError(t, mu.Unlock(ctx))
+}
+
+func TestWatch(t *testing.T) {
+	_, client, cleanup := newTestStore(t)
+	defer cleanup()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := client.Put(ctx, "/a/1", "one")
+	require.NoError(t, err)
+
+	watch := client.Watch(ctx, "/a/", clientv3.WithPrefix(), clientv3.WithPrevKV())
+	replay := client.Watch(ctx, "/a/1", clientv3.WithRev(1))
+	_, err = client.Put(ctx, "/b/1", "ignored")
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "/a/1", "uno")
+	require.NoError(t, err)
+	_, err = client.Delete(ctx, "/a/1")
+	require.NoError(t, err)
+
+	var events []*clientv3.Event
+	for len(events) < 2 {
+		select {
+		case resp := <-watch:
+			require.NoError(t, resp.Err())
+			events = append(events, resp.Events...)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the events")
+		}
+	}
+	assert.Equal(t, mvccpb.PUT, events[0].Type)
+	assert.Equal(t, "uno", string(events[0].Kv.Value))
+	assert.Equal(t, "one", string(events[0].PrevKv.Value))
+	assert.Equal(t, mvccpb.DELETE, events[1].Type)
+	assert.Equal(t, int64(5), events[1].Kv.ModRevision)
+
+	// The events of the history are replayed from the start revision
+	events = nil
+	for len(events) < 3 {
+		select {
+		case resp := <-replay:
+			require.NoError(t, resp.Err())
+			events = append(events, resp.Events...)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the events")
+		}
+	}
+	assert.Equal(t, int64(2), events[0].Kv.ModRevision)
+	assert.Nil(t, events[0].PrevKv)
+	assert.Equal(t, int64(4), events[1].Kv.ModRevision)
+	assert.Equal(t, int64(5), events[2].Kv.ModRevision)
+
+	// The watchers starting at a compacted revision are canceled
+	_, err = client.Compact(ctx, 4)
+	require.NoError(t, err)
+	resp := <-client.Watch(ctx, "/a/1", clientv3.WithRev(2))
+	assert.Equal(t, rpctypes.ErrCompacted, resp.Err())
+	assert.Equal(t, int64(4), resp.CompactRevision)
+}
+
+func TestLease(t *testing.T) {
+	s, client, cleanup := newTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	lease, err := client.Grant(ctx, 1)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "leased", "value", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "unknown", "value", clientv3.WithLease(lease.ID+1))
+	assert.Equal(t, rpctypes.ErrLeaseNotFound, err)
+
+	ttl, err := client.TimeToLive(ctx, lease.ID, clientv3.WithAttachedKeys())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), ttl.GrantedTTL)
+	require.Len(t, ttl.Keys, 1)
+	assert.Equal(t, "leased", string(ttl.Keys[0]))
+
+	_, err = client.KeepAliveOnce(ctx, lease.ID)
+	require.NoError(t, err)
+
+	// The key is deleted with its lease once expired
+	watch := client.Watch(ctx, "leased")
+	select {
+	case resp := <-watch:
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, mvccpb.DELETE, resp.Events[0].Type)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lease did not expire")
+	}
+	_, err = client.KeepAliveOnce(ctx, lease.ID)
+	assert.Equal(t, rpctypes.ErrLeaseNotFound, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Empty(t, s.leases)
+}
+
+func TestReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensu-bolt")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sensu.db")
+	ctx := context.Background()
+
+	s, err := Open(path, "default")
+	require.NoError(t, err)
+	client := s.NewClient()
+	lease, err := client.Grant(ctx, 60)
+	require.NoError(t, err)
+	_, err = client.Put(ctx, "leased", "value", clientv3.WithLease(lease.ID))
+	require.NoError(t, err)
+	members, err := client.MemberList(ctx)
+	require.NoError(t, err)
+	_ = client.Close()
+	require.NoError(t, s.Close())
+
+	s, err = Open(path, "default")
+	require.NoError(t, err)
+	defer s.Close()
+	client = s.NewClient()
+	defer client.Close()
+
+	resp, err := client.Get(ctx, "leased")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Header.Revision)
+	assert.Equal(t, members.Header.ClusterId, resp.Header.ClusterId)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, int64(lease.ID), resp.Kvs[0].Lease)
+
+	_, err = client.Revoke(ctx, lease.ID)
+	require.NoError(t, err)
+	resp, err = client.Get(ctx, "leased")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Kvs)
+}
+
+func TestCompactHistory(t *testing.T) {
+	s, client, cleanup := newTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	s.historyRetained = 2
+
+	for i := 0; i < 5; i++ {
+		_, err := client.Put(ctx, "key", "value")
+		require.NoError(t, err)
+	}
+	s.mu.Lock()
+	assert.Equal(t, int64(6), s.rev)
+	assert.Equal(t, int64(3), s.compactRev)
+	s.mu.Unlock()
+	_, err := client.Get(ctx, "key", clientv3.WithRev(3))
+	assert.Equal(t, rpctypes.ErrCompacted, err)
+	_, err = client.Get(ctx, "key", clientv3.WithRev(4))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,228 @@
+package bolt
+
+import (
+	"sync"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	bbolt "go.etcd.io/bbolt"
+)
+
+// watchStream is a stream of watch requests, and of the responses of its
+// watchers. The responses are queued without limit, so slow watchers never
+// block the writes.
+type watchStream struct {
+	mu       sync.Mutex
+	pending  []*pb.WatchResponse
+	notify   chan struct{}
+	nextID   int64
+	watchers map[int64]*watcher
+}
+
+// watcher watches the keys in a range, from a revision.
+type watcher struct {
+	id       int64
+	key, end []byte
+	startRev int64
+	prevKV   bool
+	noPut    bool
+	noDelete bool
+	stream   *watchStream
+}
+
+// send queues resp to be sent on the stream.
+func (ws *watchStream) send(resp *pb.WatchResponse) {
+	ws.mu.Lock()
+	ws.pending = append(ws.pending, resp)
+	ws.mu.Unlock()
+	select {
+	case ws.notify <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the responses queued on the stream.
+func (ws *watchStream) take() []*pb.WatchResponse {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	pending := ws.pending
+	ws.pending = nil
+	return pending
+}
+
+// Watch watches for the events happening or that have happened.
+func (s *Store) Watch(stream pb.Watch_WatchServer) error {
+	ws := &watchStream{
+		notify:   make(chan struct{}, 1),
+		watchers: make(map[int64]*watcher),
+	}
+	defer s.closeWatchStream(ws)
+
+	errc := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errc <- err
+				return
+			}
+			switch r := req.RequestUnion.(type) {
+			case *pb.WatchRequest_CreateRequest:
+				if err := s.createWatcher(ws, r.CreateRequest); err != nil {
+					errc <- err
+					return
+				}
+			case *pb.WatchRequest_CancelRequest:
+				s.cancelWatcher(ws, r.CancelRequest.WatchId)
+			case *pb.WatchRequest_ProgressRequest:
+				ws.send(&pb.WatchResponse{Header: s.currentHeader(), WatchId: -1})
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ws.notify:
+			for _, resp := range ws.take() {
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+			}
+		case <-errc:
+			// The stream is closed by the client
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return errStopped
+		}
+	}
+}
+
+// createWatcher creates a watcher on the stream ws, and sends it the events
+// of the history from its start revision.
+func (s *Store) createWatcher(ws *watchStream, r *pb.WatchCreateRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errStopped
+	}
+	w := &watcher{
+		id:       ws.nextID,
+		key:      r.Key,
+		end:      r.RangeEnd,
+		startRev: r.StartRevision,
+		prevKV:   r.PrevKv,
+		stream:   ws,
+	}
+	ws.nextID++
+	for _, filter := range r.Filters {
+		switch filter {
+		case pb.WatchCreateRequest_NOPUT:
+			w.noPut = true
+		case pb.WatchCreateRequest_NODELETE:
+			w.noDelete = true
+		}
+	}
+	ws.send(&pb.WatchResponse{Header: s.header(s.rev), WatchId: w.id, Created: true})
+
+	if w.startRev == 0 {
+		w.startRev = s.rev + 1
+	} else if w.startRev <= s.compactRev {
+		ws.send(&pb.WatchResponse{
+			Header:          s.header(s.rev),
+			WatchId:         w.id,
+			CompactRevision: s.compactRev,
+			Canceled:        true,
+		})
+		return nil
+	}
+	if w.startRev <= s.rev {
+		if err := s.replay(w); err != nil {
+			return err
+		}
+	}
+	ws.watchers[w.id] = w
+	s.watchers[w] = struct{}{}
+	return nil
+}
+
+// replay sends the events of the history from the start revision of the
+// watcher w to w.
+func (s *Store) replay(w *watcher) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(historyBucket).Cursor()
+		var rev int64
+		var events []*mvccpb.Event
+		for k, v := c.Seek(historyKey(w.startRev, 0)); k != nil; k, v = c.Next() {
+			event, err := decodeEvent(v)
+			if err != nil {
+				return err
+			}
+			if event.Kv.ModRevision != rev {
+				w.send(s.header(rev), events)
+				rev, events = event.Kv.ModRevision, nil
+			}
+			events = append(events, event)
+		}
+		w.send(s.header(rev), events)
+		return nil
+	})
+}
+
+// cancelWatcher removes the watcher of the given ID from the stream ws.
+func (s *Store) cancelWatcher(ws *watchStream, id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w, ok := ws.watchers[id]; ok {
+		delete(ws.watchers, id)
+		delete(s.watchers, w)
+	}
+	ws.send(&pb.WatchResponse{Header: s.header(s.rev), WatchId: id, Canceled: true})
+}
+
+// closeWatchStream removes all the watchers of the stream ws.
+func (s *Store) closeWatchStream(ws *watchStream) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, w := range ws.watchers {
+		delete(s.watchers, w)
+	}
+}
+
+// notify sends the events of the revision rev to the watchers.
+func (s *Store) notify(rev int64, events []*mvccpb.Event) {
+	header := s.header(rev)
+	for w := range s.watchers {
+		w.send(header, events)
+	}
+}
+
+// send sends the events of the revision of header matching the watcher w to
+// w.
+func (w *watcher) send(header *pb.ResponseHeader, events []*mvccpb.Event) {
+	if header.Revision < w.startRev {
+		return
+	}
+	var matching []*mvccpb.Event
+	for _, event := range events {
+		if !inRange(event.Kv.Key, w.key, w.end) {
+			continue
+		}
+		if (event.Type == mvccpb.PUT && w.noPut) || (event.Type == mvccpb.DELETE && w.noDelete) {
+			continue
+		}
+		if !w.prevKV && event.PrevKv != nil {
+			event = &mvccpb.Event{Type: event.Type, Kv: event.Kv}
+		}
+		matching = append(matching, event)
+	}
+	if len(matching) == 0 {
+		return
+	}
+	w.stream.send(&pb.WatchResponse{
+		Header:  header,
+		WatchId: w.id,
+		Events:  matching,
+	})
+}
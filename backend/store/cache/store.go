@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/prometheus/client_golang/prometheus"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	// StoreReadsCounterVec is the name of the prometheus counter vec used to
+	// count the reads of the caching store, by resource and result.
+	StoreReadsCounterVec = "sensu_go_store_cache_reads"
+
+	// readHit and readMiss are the results of the reads of the caching
+	// store, served by the cache and by the store respectively.
+	readHit  = "hit"
+	readMiss = "miss"
+)
+
+// StoreReads counts the reads of the caching store, by resource and result,
+// either hit or miss.
+var StoreReads = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: StoreReadsCounterVec,
+		Help: "The total number of reads of the caching store, by resource and result",
+	},
+	[]string{"resource", "result"},
+)
+
+// Store is a store.Store serving the reads of the entities, handlers, event
+// filters and mutators by name from watched caches, so that processing an
+// event doesn't read them from etcd each time. The reads missing the caches,
+// e.g. of the resources just created, and all the other operations are passed
+// through to the wrapped store.
+type Store struct {
+	store.Store
+	entities *Watched
+	handlers *Watched
+	filters  *Watched
+	mutators *Watched
+}
+
+// NewStore creates a new caching store wrapping s. The caches are loaded on
+// creation, and watched until ctx is done.
+func NewStore(ctx context.Context, client *clientv3.Client, s store.Store) (*Store, error) {
+	cs := &Store{Store: s}
+	caches := []struct {
+		cache **Watched
+		elem  corev2.Resource
+	}{
+		{&cs.entities, &corev2.Entity{}},
+		{&cs.handlers, &corev2.Handler{}},
+		{&cs.filters, &corev2.EventFilter{}},
+		{&cs.mutators, &corev2.Mutator{}},
+	}
+	for _, c := range caches {
+		cache, err := NewWatched(ctx, client, c.elem)
+		if err != nil {
+			return nil, err
+		}
+		*c.cache = cache
+	}
+	_ = prometheus.Register(StoreReads)
+	return cs, nil
+}
+
+// lookup returns the cached resource of the given name in the namespace of
+// ctx, and false if it is not cached.
+func lookup(ctx context.Context, cache *Watched, resource, name string) (corev2.Resource, bool) {
+	if name == "" {
+		return nil, false
+	}
+	cached, ok := cache.Lookup(corev2.ContextNamespace(ctx), name)
+	if !ok {
+		StoreReads.WithLabelValues(resource, readMiss).Inc()
+		return nil, false
+	}
+	StoreReads.WithLabelValues(resource, readHit).Inc()
+	return cached, true
+}
+
+// GetEntityByName gets an entity by name, from the cache if possible.
+func (s *Store) GetEntityByName(ctx context.Context, name string) (*corev2.Entity, error) {
+	if cached, ok := lookup(ctx, s.entities, corev2.EntitiesResource, name); ok {
+		return cached.(*corev2.Entity), nil
+	}
+	return s.Store.GetEntityByName(ctx, name)
+}
+
+// GetHandlerByName gets a handler by name, from the cache if possible.
+func (s *Store) GetHandlerByName(ctx context.Context, name string) (*corev2.Handler, error) {
+	if cached, ok := lookup(ctx, s.handlers, corev2.HandlersResource, name); ok {
+		return cached.(*corev2.Handler), nil
+	}
+	return s.Store.GetHandlerByName(ctx, name)
+}
+
+// GetEventFilterByName gets an event filter by name, from the cache if
+// possible.
+func (s *Store) GetEventFilterByName(ctx context.Context, name string) (*corev2.EventFilter, error) {
+	if cached, ok := lookup(ctx, s.filters, corev2.EventFiltersResource, name); ok {
+		return cached.(*corev2.EventFilter), nil
+	}
+	return s.Store.GetEventFilterByName(ctx, name)
+}
+
+// GetMutatorByName gets a mutator by name, from the cache if possible.
+func (s *Store) GetMutatorByName(ctx context.Context, name string) (*corev2.Mutator, error) {
+	if cached, ok := lookup(ctx, s.mutators, corev2.MutatorsResource, name); ok {
+		return cached.(*corev2.Mutator), nil
+	}
+	return s.Store.GetMutatorByName(ctx, name)
+}
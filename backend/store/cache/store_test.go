@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWatched(resources ...corev2.Resource) *Watched {
+	w := &Watched{resources: make(map[string]map[string]corev2.Resource)}
+	for _, resource := range resources {
+		meta := resource.GetObjectMeta()
+		if w.resources[meta.Namespace] == nil {
+			w.resources[meta.Namespace] = make(map[string]corev2.Resource)
+		}
+		w.resources[meta.Namespace][meta.Name] = resource
+	}
+	return w
+}
+
+func TestStoreGetHandlerByName(t *testing.T) {
+	st := &mockstore.MockStore{}
+	s := &Store{Store: st, handlers: newTestWatched(corev2.FixtureHandler("cached"))}
+	ctx := store.NamespaceContext(context.Background(), "default")
+
+	handler, err := s.GetHandlerByName(ctx, "cached")
+	require.NoError(t, err)
+	assert.Equal(t, "cached", handler.Name)
+	st.AssertNotCalled(t, "GetHandlerByName", mock.Anything, mock.Anything)
+
+	// The reads missing the cache are passed through to the store
+	st.On("GetHandlerByName", mock.Anything, "created").Return(corev2.FixtureHandler("created"), nil)
+	handler, err = s.GetHandlerByName(ctx, "created")
+	require.NoError(t, err)
+	assert.Equal(t, "created", handler.Name)
+
+	// The resources are cached by namespace
+	ctx = store.NamespaceContext(context.Background(), "acme")
+	st.On("GetHandlerByName", mock.Anything, "cached").Return((*corev2.Handler)(nil), nil)
+	handler, err = s.GetHandlerByName(ctx, "cached")
+	require.NoError(t, err)
+	assert.Nil(t, handler)
+}
+
+func TestStoreGetEntityByName(t *testing.T) {
+	st := &mockstore.MockStore{}
+	s := &Store{Store: st, entities: newTestWatched(corev2.FixtureEntity("cached"))}
+	ctx := store.NamespaceContext(context.Background(), "default")
+
+	entity, err := s.GetEntityByName(ctx, "cached")
+	require.NoError(t, err)
+	assert.Equal(t, "cached", entity.Name)
+
+	// The entities returned can be modified
+	entity.Subscriptions = nil
+	entity, err = s.GetEntityByName(ctx, "cached")
+	require.NoError(t, err)
+	assert.NotEmpty(t, entity.Subscriptions)
+}
@@ -0,0 +1,187 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd"
+)
+
+// watchRetryDelay is the delay before the resources are reloaded after their
+// watch failed, e.g. because the revisions it was at were compacted.
+const watchRetryDelay = time.Second
+
+// Watched is a cache of all the resources of a type, loaded from the store on
+// creation and kept up to date by a watch of the store, instead of being
+// rebuilt periodically like Resource. If the watch fails, the resources are
+// reloaded, and served as last known in the meantime.
+type Watched struct {
+	client    *clientv3.Client
+	watcher   store.ResourceWatcher
+	elemType  reflect.Type
+	prefix    string
+	resources map[string]map[string]corev2.Resource
+	mu        sync.RWMutex
+}
+
+// NewWatched creates a new cache of the resources of the type of elem. It
+// loads the resources from the store on creation, and watches them until ctx
+// is done.
+func NewWatched(ctx context.Context, client *clientv3.Client, elem corev2.Resource) (*Watched, error) {
+	w := &Watched{
+		client:   client,
+		watcher:  etcd.NewStore(client, ""),
+		elemType: reflect.TypeOf(elem).Elem(),
+		prefix:   elem.StorePrefix(),
+	}
+	revision, err := w.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	go w.run(ctx, revision)
+	return w, nil
+}
+
+// Lookup returns a copy of the resource of the given namespace and name, and
+// false if it is not cached, e.g. because it doesn't exist or because its
+// creation was not watched yet.
+func (w *Watched) Lookup(namespace, name string) (corev2.Resource, bool) {
+	w.mu.RLock()
+	resource, ok := w.resources[namespace][name]
+	w.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(resource.(proto.Message)).(corev2.Resource), true
+}
+
+// Get returns all the cached resources of a namespace, in the order of their
+// names, like Resource.Get. The resources must not be modified.
+func (w *Watched) Get(namespace string) []Value {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	values := make([]Value, 0, len(w.resources[namespace]))
+	for _, resource := range w.resources[namespace] {
+		values = append(values, Value{Resource: resource})
+	}
+	sort.Sort(resourceSlice(values))
+	return values
+}
+
+// run applies the changes of the resources from the given revision until ctx
+// is done, reloading the resources whenever the watch fails.
+func (w *Watched) run(ctx context.Context, revision int64) {
+	for {
+		w.watch(ctx, revision)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchRetryDelay):
+			}
+			var err error
+			if revision, err = w.load(ctx); err == nil {
+				break
+			}
+			logger.WithError(err).Errorf("couldn't reload the cache of %s", w.prefix)
+		}
+	}
+}
+
+// watch applies the changes of the resources made after revision, until the
+// watch fails or ctx is done.
+func (w *Watched) watch(ctx context.Context, revision int64) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes := w.watcher.WatchResources(store.NamespaceContext(ctx, ""), w.prefix, reflect.New(w.elemType).Interface().(corev2.Resource), revision+1)
+	for change := range changes {
+		switch change.Action {
+		case store.WatchError:
+			logger.Warningf("the watch of %s failed, reloading the cache", w.prefix)
+			return
+		case store.WatchDelete:
+			meta := change.Resource.GetObjectMeta()
+			w.mu.Lock()
+			delete(w.resources[meta.Namespace], meta.Name)
+			w.mu.Unlock()
+		default:
+			setDefaults(change.Resource, change.Revision)
+			meta := change.Resource.GetObjectMeta()
+			w.mu.Lock()
+			if w.resources[meta.Namespace] == nil {
+				w.resources[meta.Namespace] = make(map[string]corev2.Resource)
+			}
+			w.resources[meta.Namespace][meta.Name] = change.Resource
+			w.mu.Unlock()
+		}
+	}
+}
+
+// load replaces the cached resources with those of the store, and returns the
+// revision of the store they were loaded at.
+func (w *Watched) load(ctx context.Context) (int64, error) {
+	key := store.NewKeyBuilder(w.prefix).Build("")
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	var resp *clientv3.GetResponse
+	err := etcd.Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = w.client.Get(ctx, key, clientv3.WithPrefix())
+		return etcd.RetryRequest(n, err)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	resources := make(map[string]map[string]corev2.Resource)
+	for _, kv := range resp.Kvs {
+		resource, err := w.decode(kv.Value)
+		if err != nil {
+			logger.WithField("key", string(kv.Key)).WithError(err).Error("unable to unmarshal resource from key")
+			continue
+		}
+		setDefaults(resource, kv.ModRevision)
+		meta := resource.GetObjectMeta()
+		if resources[meta.Namespace] == nil {
+			resources[meta.Namespace] = make(map[string]corev2.Resource)
+		}
+		resources[meta.Namespace][meta.Name] = resource
+	}
+
+	w.mu.Lock()
+	w.resources = resources
+	w.mu.Unlock()
+	return resp.Header.Revision, nil
+}
+
+func (w *Watched) decode(data []byte) (corev2.Resource, error) {
+	resource := reflect.New(w.elemType).Interface().(corev2.Resource)
+	if len(data) > 0 && data[0] == '{' {
+		return resource, json.Unmarshal(data, resource)
+	}
+	return resource, proto.Unmarshal(data, resource.(proto.Message))
+}
+
+// setDefaults sets the resource version of the resource and initializes its
+// labels and annotations, as the store does when the resources are read.
+func setDefaults(resource corev2.Resource, revision int64) {
+	meta := resource.GetObjectMeta()
+	meta.ResourceVersion = strconv.FormatInt(revision, 10)
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	resource.SetObjectMeta(meta)
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/integration"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatched(t *testing.T) {
+	c := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer c.Terminate(t)
+	client := c.RandClient()
+	s := etcd.NewStore(client, "store")
+	require.NoError(t, s.CreateNamespace(context.Background(), types.FixtureNamespace("default")))
+	ctx := store.NamespaceContext(context.Background(), "default")
+
+	require.NoError(t, s.UpdateHandler(ctx, corev2.FixtureHandler("b")))
+
+	cctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watched, err := NewWatched(cctx, client, &corev2.Handler{})
+	require.NoError(t, err)
+
+	// The handlers are loaded on creation
+	cached, ok := watched.Lookup("default", "b")
+	require.True(t, ok)
+	assert.Equal(t, "b", cached.GetObjectMeta().Name)
+	assert.NotEmpty(t, cached.GetObjectMeta().ResourceVersion)
+	_, ok = watched.Lookup("default", "a")
+	assert.False(t, ok)
+
+	// The lookups return copies
+	cached.(*corev2.Handler).Command = "changed"
+	cached, _ = watched.Lookup("default", "b")
+	assert.NotEqual(t, "changed", cached.(*corev2.Handler).Command)
+
+	// The changes are watched
+	require.NoError(t, s.UpdateHandler(ctx, corev2.FixtureHandler("a")))
+	handler := corev2.FixtureHandler("b")
+	handler.Command = "updated"
+	require.NoError(t, s.UpdateHandler(ctx, handler))
+	assert.Eventually(t, func() bool {
+		cached, ok := watched.Lookup("default", "b")
+		return ok && cached.(*corev2.Handler).Command == "updated"
+	}, 5*time.Second, 10*time.Millisecond)
+	values := watched.Get("default")
+	require.Len(t, values, 2)
+	assert.Equal(t, "a", values[0].Resource.GetObjectMeta().Name)
+	assert.Equal(t, "b", values[1].Resource.GetObjectMeta().Name)
+
+	require.NoError(t, s.DeleteHandlerByName(ctx, "a"))
+	assert.Eventually(t, func() bool {
+		_, ok := watched.Lookup("default", "a")
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
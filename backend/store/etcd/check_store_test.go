@@ -50,3 +50,38 @@ func TestCheckConfigStorage(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestWatchCheckConfigs(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), corev2.NamespaceKey, "default"))
+		defer cancel()
+
+		require.NoError(t, s.UpdateCheckConfig(ctx, corev2.FixtureCheckConfig("check1")))
+		watcher := s.WatchResources(ctx, "checks", &corev2.CheckConfig{}, 0)
+
+		// The existing checks are listed first
+		listed := <-watcher
+		assert.Equal(t, store.WatchCreate, listed.Action)
+		assert.Equal(t, "check1", listed.Resource.GetObjectMeta().Name)
+
+		require.NoError(t, s.UpdateCheckConfig(ctx, corev2.FixtureCheckConfig("check2")))
+		require.NoError(t, s.DeleteCheckConfigByName(ctx, "check1"))
+
+		created := <-watcher
+		assert.Equal(t, store.WatchCreate, created.Action)
+		assert.Equal(t, "check2", created.Resource.GetObjectMeta().Name)
+		deleted := <-watcher
+		assert.Equal(t, store.WatchDelete, deleted.Action)
+		assert.Equal(t, "check1", deleted.Resource.GetObjectMeta().Name)
+		assert.True(t, deleted.Revision > created.Revision)
+
+		// The watch can resume from a revision
+		resumed := s.WatchResources(ctx, "checks", &corev2.CheckConfig{}, deleted.Revision)
+		assert.Equal(t, store.WatchDelete, (<-resumed).Action)
+
+		// The channel is closed once the context is done
+		cancel()
+		for range watcher {
+		}
+	})
+}
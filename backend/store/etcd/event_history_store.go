@@ -0,0 +1,150 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+const (
+	eventHistoryPathPrefix = "event-history"
+
+	// eventHistoryDeleteBatchSize is the number of occurrences deleted per
+	// transaction, below the default limit of operations of etcd.
+	eventHistoryDeleteBatchSize = 100
+)
+
+var eventHistoryKeyBuilder = store.NewKeyBuilder(eventHistoryPathPrefix)
+
+// getEventHistoryPath returns the key of an occurrence of an event. The keys
+// of the occurrences of an event sort by timestamp.
+func getEventHistoryPath(event *corev2.Event) string {
+	return path.Join(
+		EtcdRoot,
+		eventHistoryPathPrefix,
+		event.Entity.Namespace,
+		event.Entity.Name,
+		event.Check.Name,
+		fmt.Sprintf("%020d-%s", event.Timestamp, event.GetUUID()),
+	)
+}
+
+// AddEventHistory adds the given event to the history of its entity and
+// check. Like the events, the metrics are not persisted and the check output
+// is truncated to its maximum size. The check history of the event is not
+// kept either, since it's redundant with the event history.
+func (s *Store) AddEventHistory(ctx context.Context, event *corev2.Event) error {
+	if !event.HasCheck() || event.Entity == nil {
+		return &store.ErrNotValid{Err: errors.New("event has no check or entity")}
+	}
+
+	occurrence := *event
+	occurrence.Metrics = nil
+	if occurrence.Timestamp == 0 {
+		occurrence.Timestamp = time.Now().Unix()
+	}
+	check := *event.Check
+	check.History = nil
+	if size := check.MaxOutputSize; size > 0 && int64(len(check.Output)) > size {
+		check.Output = check.Output[:size]
+	}
+	occurrence.Check = &check
+
+	eventBytes, err := proto.Marshal(&occurrence)
+	if err != nil {
+		return &store.ErrEncode{Err: err}
+	}
+
+	key := getEventHistoryPath(&occurrence)
+	return Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		_, err = s.client.Put(ctx, key, string(eventBytes))
+		return RetryRequest(n, err)
+	})
+}
+
+// GetEventHistory returns the occurrences of the event of the given entity
+// and check within the namespace stored in ctx, from the oldest to the most
+// recent.
+func (s *Store) GetEventHistory(ctx context.Context, entity, check string) ([]*corev2.Event, error) {
+	if entity == "" || check == "" {
+		return nil, &store.ErrNotValid{Err: errors.New("must specify entity and check name")}
+	}
+	if corev2.ContextNamespace(ctx) == "" {
+		return nil, &store.ErrNotValid{Err: errors.New("namespace missing from context")}
+	}
+	return s.listEventHistory(ctx, eventHistoryKeyBuilder.WithContext(ctx).WithExactMatch().Build(entity, check))
+}
+
+// ListEventHistory returns the occurrences of all the events within the
+// namespace stored in ctx, ordered by entity, check and timestamp.
+func (s *Store) ListEventHistory(ctx context.Context) ([]*corev2.Event, error) {
+	if corev2.ContextNamespace(ctx) == "" {
+		return nil, &store.ErrNotValid{Err: errors.New("namespace missing from context")}
+	}
+	return s.listEventHistory(ctx, eventHistoryKeyBuilder.WithContext(ctx).Build(""))
+}
+
+// listEventHistory returns the occurrences under the key prefix, reading them
+// by batches.
+func (s *Store) listEventHistory(ctx context.Context, prefix string) ([]*corev2.Event, error) {
+	events := []*corev2.Event{}
+	rangeEnd := clientv3.GetPrefixRangeEnd(prefix)
+	key := prefix
+	for {
+		var resp *clientv3.GetResponse
+		err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+			resp, err = s.client.Get(ctx, key, clientv3.WithRange(rangeEnd), clientv3.WithLimit(eventsBatchSize))
+			return RetryRequest(n, err)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, kv := range resp.Kvs {
+			event := &corev2.Event{}
+			if err := unmarshal(kv.Value, event); err != nil {
+				return nil, &store.ErrDecode{Err: err}
+			}
+			events = append(events, event)
+		}
+
+		if !resp.More {
+			return events, nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// DeleteEventHistory deletes the given occurrences from the history of their
+// events.
+func (s *Store) DeleteEventHistory(ctx context.Context, events []*corev2.Event) error {
+	for len(events) > 0 {
+		n := len(events)
+		if n > eventHistoryDeleteBatchSize {
+			n = eventHistoryDeleteBatchSize
+		}
+		ops := make([]clientv3.Op, 0, n)
+		for _, event := range events[:n] {
+			if !event.HasCheck() || event.Entity == nil {
+				return &store.ErrNotValid{Err: errors.New("event has no check or entity")}
+			}
+			ops = append(ops, clientv3.OpDelete(getEventHistoryPath(event)))
+		}
+		err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+			_, err = s.client.Txn(ctx).Then(ops...).Commit()
+			return RetryRequest(n, err)
+		})
+		if err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHistoryStorage(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := store.NamespaceContext(context.Background(), "default")
+
+		var occurrences []*corev2.Event
+		for _, e := range []struct {
+			entity, check string
+			timestamp     int64
+		}{
+			{"entity1", "check1", 30},
+			{"entity1", "check1", 10},
+			{"entity1", "check2", 20},
+			{"entity10", "check1", 40},
+		} {
+			event := corev2.FixtureEvent(e.entity, e.check)
+			event.Timestamp = e.timestamp
+			event.Check.History = []corev2.CheckHistory{{Status: 0, Executed: e.timestamp}}
+			require.NoError(t, s.AddEventHistory(ctx, event))
+			occurrences = append(occurrences, event)
+		}
+
+		history, err := s.GetEventHistory(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, int64(10), history[0].Timestamp)
+		assert.Equal(t, int64(30), history[1].Timestamp)
+		assert.Empty(t, history[0].Check.History)
+
+		all, err := s.ListEventHistory(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 4)
+		assert.Equal(t, "check2", all[2].Check.Name)
+		assert.Equal(t, "entity10", all[3].Entity.Name)
+
+		// The history of the other namespaces is distinct
+		otherCtx := store.NamespaceContext(context.Background(), "acme")
+		all, err = s.ListEventHistory(otherCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		require.NoError(t, s.DeleteEventHistory(ctx, occurrences[:2]))
+		history, err = s.GetEventHistory(ctx, "entity1", "check1")
+		require.NoError(t, err)
+		assert.Empty(t, history)
+		all, err = s.ListEventHistory(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		_, err = s.GetEventHistory(ctx, "", "check1")
+		assert.Error(t, err)
+	})
+}
@@ -18,6 +18,9 @@ import (
 
 const (
 	eventsPathPrefix = "events"
+	// eventsBatchSize is the number of events read at once when selecting
+	// ordered events
+	eventsBatchSize = 500
 	// Type is the type of an etcd store provider.
 	Type = "etcd"
 )
@@ -77,6 +80,10 @@ func (s *Store) DeleteEventByEntityCheck(ctx context.Context, entityName, checkN
 // GetEvents returns the events for an (optional) namespace. If namespace is the
 // empty string, GetEvents returns all events for all namespaces.
 func (s *Store) GetEvents(ctx context.Context, pred *store.SelectionPredicate) ([]*corev2.Event, error) {
+	if pred.Ordering != "" {
+		return s.getOrderedEvents(ctx, GetEventsPath(ctx, ""), pred)
+	}
+
 	opts := []clientv3.OpOption{
 		clientv3.WithLimit(pred.Limit),
 	}
@@ -138,6 +145,9 @@ func (s *Store) GetEventsByEntity(ctx context.Context, entityName string, pred *
 	if entityName == "" {
 		return nil, &store.ErrNotValid{Err: errors.New("must specify entity name")}
 	}
+	if pred.Ordering != "" {
+		return s.getOrderedEvents(ctx, GetEventsPath(ctx, entityName), pred)
+	}
 
 	opts := []clientv3.OpOption{
 		clientv3.WithLimit(pred.Limit),
@@ -187,6 +197,95 @@ func (s *Store) GetEventsByEntity(ctx context.Context, entityName string, pred *
 	return events, nil
 }
 
+// getOrderedEvents returns the page of the events under keyPrefix selected by
+// a predicate with an ordering. The events are read in batches, of which only
+// the events of the page are kept.
+func (s *Store) getOrderedEvents(ctx context.Context, keyPrefix string, pred *store.SelectionPredicate) ([]*corev2.Event, error) {
+	page, err := store.NewEventPage(pred)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+	key := keyPrefix
+
+	// Within a namespace, the events are stored in the order of their entity
+	// and check names, so the selection can start after the continue token
+	// and stop once the page is full.
+	inKeyOrder := pred.Ordering == store.EventSortEntity && !pred.Descending &&
+		store.NewNamespaceFromContext(ctx) != ""
+	if inKeyOrder && pred.Continue != "" {
+		entity, check, err := store.EventContinueKey(pred.Continue)
+		if err != nil {
+			return nil, err
+		}
+		if next := path.Join(GetEventsPath(ctx, ""), entity, check) + "\x00"; next > key {
+			key = next
+		}
+	}
+
+	opts := []clientv3.OpOption{
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(keyPrefix)),
+		clientv3.WithLimit(eventsBatchSize),
+	}
+	for {
+		var resp *clientv3.GetResponse
+		err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+			resp, err = s.client.Get(ctx, key, opts...)
+			return RetryRequest(n, err)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, kv := range resp.Kvs {
+			event := &corev2.Event{}
+			if err := unmarshal(kv.Value, event); err != nil {
+				return nil, &store.ErrDecode{Err: err}
+			}
+			if event.Labels == nil {
+				event.Labels = make(map[string]string)
+			}
+			if event.Annotations == nil {
+				event.Annotations = make(map[string]string)
+			}
+			page.Add(event)
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 || (inKeyOrder && page.Full()) {
+			break
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+		if len(opts) == 2 {
+			// Read the next batches at the revision of the first one
+			opts = append(opts, clientv3.WithRev(resp.Header.Revision))
+		}
+	}
+
+	return page.Events(pred), nil
+}
+
+// CountEvents returns the number of events in the namespace of ctx, or in all
+// namespaces if empty.
+func (s *Store) CountEvents(ctx context.Context, pred *store.SelectionPredicate) (int64, error) {
+	key := GetEventsPath(ctx, "")
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+
+	var resp *clientv3.GetResponse
+	err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Get(ctx, key, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		return RetryRequest(n, err)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
 // GetEventByEntityCheck gets an event by entity and check name.
 func (s *Store) GetEventByEntityCheck(ctx context.Context, entityName, checkName string) (*corev2.Event, error) {
 	if entityName == "" || checkName == "" {
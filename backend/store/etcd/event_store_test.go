@@ -526,6 +526,77 @@ func testPagination(t *testing.T, ctx context.Context, etcd store.Store, pageSiz
 	}
 }
 
+func TestGetEventsOrdered(t *testing.T) {
+	testWithEtcd(t, func(s store.Store) {
+		ctx := store.NamespaceContext(context.Background(), "default")
+		statuses := []uint32{0, 2, 1, 2, 0, 3, 1}
+		for i, status := range statuses {
+			event := corev2.FixtureEvent(fmt.Sprintf("entity%d", i), "check")
+			event.Check.Status = status
+			event.Timestamp = int64(100 - i)
+			if _, _, err := s.UpdateEvent(ctx, event); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		count, err := s.CountEvents(ctx, &store.SelectionPredicate{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(statuses)), count)
+
+		tests := []struct {
+			name       string
+			ordering   string
+			descending bool
+			expected   []string
+		}{
+			{
+				name:     "entity",
+				ordering: store.EventSortEntity,
+				expected: []string{"entity0", "entity1", "entity2", "entity3", "entity4", "entity5", "entity6"},
+			},
+			{
+				name:       "entity descending",
+				ordering:   store.EventSortEntity,
+				descending: true,
+				expected:   []string{"entity6", "entity5", "entity4", "entity3", "entity2", "entity1", "entity0"},
+			},
+			{
+				name:     "timestamp",
+				ordering: store.EventSortTimestamp,
+				expected: []string{"entity6", "entity5", "entity4", "entity3", "entity2", "entity1", "entity0"},
+			},
+			{
+				name:     "severity",
+				ordering: store.EventSortSeverity,
+				expected: []string{"entity1", "entity3", "entity2", "entity6", "entity5", "entity0", "entity4"},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Select the events in pages of 3 events
+				pred := &store.SelectionPredicate{Limit: 3, Ordering: tt.ordering, Descending: tt.descending}
+				var names []string
+				for i := 0; i < 3; i++ {
+					events, err := s.GetEvents(ctx, pred)
+					require.NoError(t, err)
+					for _, event := range events {
+						names = append(names, event.Entity.Name)
+					}
+					if pred.Continue == "" {
+						break
+					}
+				}
+				assert.Equal(t, tt.expected, names)
+				assert.Empty(t, pred.Continue)
+			})
+		}
+
+		// Invalid ordering
+		_, err = s.GetEvents(ctx, &store.SelectionPredicate{Ordering: "foo"})
+		assert.Error(t, err)
+	})
+}
+
 func TestGetEventsByEntityPagination(t *testing.T) {
 	testWithEtcd(t, func(store store.Store) {
 		// Create a "testing" namespace in the store
@@ -788,3 +859,38 @@ func TestStateLastOK(t *testing.T) {
 	})
 
 }
+
+func TestGetEventWatcher(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		require.NoError(t, s.CreateNamespace(context.Background(), types.FixtureNamespace("default2")))
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), corev2.NamespaceKey, "default"))
+		defer cancel()
+		watcher := s.GetEventWatcher(ctx, 0)
+
+		// The events of the other namespaces are not watched
+		other := corev2.FixtureEvent("entity1", "check1")
+		other.Namespace = "default2"
+		other.Entity.Namespace = "default2"
+		other.Check.Namespace = "default2"
+		_, _, err := s.UpdateEvent(context.WithValue(context.Background(), corev2.NamespaceKey, "default2"), other)
+		require.NoError(t, err)
+
+		event := corev2.FixtureEvent("entity1", "check1")
+		_, _, err = s.UpdateEvent(ctx, event)
+		require.NoError(t, err)
+		require.NoError(t, s.DeleteEventByEntityCheck(ctx, "entity1", "check1"))
+
+		created := <-watcher
+		assert.Equal(t, store.WatchCreate, created.Action)
+		assert.Equal(t, "default", created.Event.Namespace)
+		assert.Equal(t, "check1", created.Event.Check.Name)
+		deleted := <-watcher
+		assert.Equal(t, store.WatchDelete, deleted.Action)
+		assert.Equal(t, "entity1", deleted.Event.Entity.Name)
+		assert.True(t, deleted.Revision > created.Revision)
+
+		// The watch can resume from a revision
+		resumed := s.GetEventWatcher(ctx, deleted.Revision)
+		assert.Equal(t, store.WatchDelete, (<-resumed).Action)
+	})
+}
@@ -15,6 +15,11 @@ import (
 )
 
 func isEmbeddedClient(clientURLs []string) bool {
+	// A member without client URLs, like the one of the bolt store, is only
+	// reachable through the store client.
+	if len(clientURLs) == 0 {
+		return true
+	}
 	// It is assumed that if any of the client URLs have ':0' as their port,
 	// the member is embedded and the client doesn't need to dial.
 	for _, url := range clientURLs {
@@ -42,6 +47,13 @@ func (s *Store) getHealth(ctx context.Context, id uint64, name string, urls []st
 			DialTimeout: 5 * time.Second,
 			TLS:         tls,
 		})
+		if cliErr == nil {
+			// Only close the clients dialed for the health check, not the
+			// client of the store
+			defer func() {
+				_ = cli.Close()
+			}()
+		}
 	}
 
 	if cliErr != nil {
@@ -49,9 +61,6 @@ func (s *Store) getHealth(ctx context.Context, id uint64, name string, urls []st
 		health.Err = cliErr.Error()
 		return health
 	}
-	defer func() {
-		_ = cli.Close()
-	}()
 
 	_, getErr := cli.Get(ctx, "health")
 
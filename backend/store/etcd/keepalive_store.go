@@ -11,6 +11,11 @@ import (
 
 const (
 	keepalivesPathPrefix = "keepalives"
+
+	// maxKeepalivesPerTxn is the number of keepalives updated per transaction,
+	// each of which takes two operations, to stay below the default limit of
+	// etcd of 128 operations per transaction.
+	maxKeepalivesPerTxn = 64
 )
 
 func getKeepalivePath(keepalivesPath string, entity *corev2.Entity) string {
@@ -57,3 +62,73 @@ func (s *Store) UpdateFailingKeepalive(ctx context.Context, entity *corev2.Entit
 	kr := corev2.NewKeepaliveRecord(entity, expiration)
 	return CreateOrUpdate(ctx, s.client, getKeepalivePath(s.keepalivesPath, entity), entity.Namespace, kr)
 }
+
+// UpdateKeepalives updates the given entities and deletes their failing
+// KeepaliveRecords, in transactions of up to maxKeepalivesPerTxn entities.
+func (s *Store) UpdateKeepalives(ctx context.Context, entities []*corev2.Entity) error {
+	for len(entities) > 0 {
+		n := len(entities)
+		if n > maxKeepalivesPerTxn {
+			n = maxKeepalivesPerTxn
+		}
+		if err := s.updateKeepalives(ctx, entities[:n]); err != nil {
+			return err
+		}
+		entities = entities[n:]
+	}
+	return nil
+}
+
+func (s *Store) updateKeepalives(ctx context.Context, entities []*corev2.Entity) error {
+	comparisons := []clientv3.Cmp{}
+	ops := make([]clientv3.Op, 0, 2*len(entities))
+	namespaces := map[string]bool{}
+	for _, entity := range entities {
+		if err := entity.Validate(); err != nil {
+			return &store.ErrNotValid{Err: err}
+		}
+		key := getEntityPath(entity)
+		bytes, err := marshal(entity)
+		if err != nil {
+			return &store.ErrEncode{Key: key, Err: err}
+		}
+		ops = append(ops,
+			clientv3.OpPut(key, string(bytes)),
+			clientv3.OpDelete(getKeepalivePath(s.keepalivesPath, entity)),
+		)
+		// Make sure the namespaces of the entities exist
+		if !namespaces[entity.Namespace] {
+			namespaces[entity.Namespace] = true
+			comparisons = append(comparisons, namespaceFound(entity.Namespace))
+		}
+	}
+
+	var resp *clientv3.TxnResponse
+	err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Txn(ctx).If(comparisons...).Then(ops...).Commit()
+		return RetryRequest(n, err)
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Succeeded {
+		return nil
+	}
+
+	// A namespace is missing, so update the entities one by one, so that those
+	// of the other namespaces are still updated
+	var missingErr error
+	for _, entity := range entities {
+		if err := s.UpdateEntity(ctx, entity); err != nil {
+			if _, ok := err.(*store.ErrNamespaceMissing); ok {
+				missingErr = err
+				continue
+			}
+			return err
+		}
+		if err := s.DeleteFailingKeepalive(ctx, entity); err != nil {
+			return err
+		}
+	}
+	return missingErr
+}
@@ -4,6 +4,7 @@ package etcd
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/sensu/sensu-go/backend/store"
@@ -29,3 +30,43 @@ func TestKeepaliveStorage(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestUpdateKeepalives(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		ctx := context.WithValue(context.Background(), types.NamespaceKey, "default")
+
+		// More entities than fit in a single transaction
+		entities := []*types.Entity{}
+		for i := 0; i < maxKeepalivesPerTxn+1; i++ {
+			entity := types.FixtureEntity(fmt.Sprintf("entity%d", i))
+			entity.LastSeen = int64(i)
+			assert.NoError(t, store.UpdateFailingKeepalive(ctx, entity, 1))
+			entities = append(entities, entity)
+		}
+
+		assert.NoError(t, store.UpdateKeepalives(ctx, entities))
+		for _, entity := range entities {
+			stored, err := store.GetEntityByName(ctx, entity.Name)
+			assert.NoError(t, err)
+			if assert.NotNil(t, stored) {
+				assert.Equal(t, entity.LastSeen, stored.LastSeen)
+			}
+		}
+		records, err := store.GetFailingKeepalives(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+
+		// The entities of the existing namespaces are updated even though a
+		// namespace is missing
+		missing := types.FixtureEntity("missing")
+		missing.Namespace = "missing"
+		entities[0].LastSeen = 42
+		err = store.UpdateKeepalives(ctx, []*types.Entity{missing, entities[0]})
+		assert.Error(t, err)
+		stored, err := store.GetEntityByName(ctx, entities[0].Name)
+		assert.NoError(t, err)
+		if assert.NotNil(t, stored) {
+			assert.Equal(t, int64(42), stored.LastSeen)
+		}
+	})
+}
@@ -3,7 +3,9 @@ package etcd
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/backend/store"
@@ -60,3 +62,22 @@ func (s *Store) ListResources(ctx context.Context, resourcePrefix string, resour
 
 	return List(ctx, s.client, keyBuilderFunc, resources, pred)
 }
+
+// CountResources returns the number of resources of the resourcePrefix type in
+// the namespace of ctx, or in all namespaces if empty.
+func (s *Store) CountResources(ctx context.Context, resourcePrefix string) (int64, error) {
+	key := store.NewKeyBuilder(resourcePrefix).WithContext(ctx).Build("")
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+
+	var resp *clientv3.GetResponse
+	err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = s.client.Get(ctx, key, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		return RetryRequest(n, err)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
@@ -0,0 +1,38 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountResources(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := context.Background()
+		require.NoError(t, s.CreateNamespace(ctx, corev2.FixtureNamespace("default2")))
+		for _, name := range []string{"a", "b", "c"} {
+			require.NoError(t, s.CreateResource(ctx, corev2.FixtureCheckConfig(name)))
+		}
+		check := corev2.FixtureCheckConfig("d")
+		check.Namespace = "default2"
+		require.NoError(t, s.CreateResource(ctx, check))
+
+		count, err := s.CountResources(store.NamespaceContext(ctx, "default"), corev2.ChecksResource)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		count, err = s.CountResources(store.NamespaceContext(ctx, ""), corev2.ChecksResource)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), count)
+
+		count, err = s.CountResources(store.NamespaceContext(ctx, "default"), corev2.HandlersResource)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
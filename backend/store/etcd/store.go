@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -133,11 +134,21 @@ func CreateOrUpdate(ctx context.Context, client *clientv3.Client, key, namespace
 	if namespace != "" {
 		comparisons = append(comparisons, namespaceFound(namespace))
 	}
+	// If a resource version is expected, make sure it did not change
+	versionCmp, err := resourceVersionFound(ctx, key)
+	if err != nil {
+		return err
+	}
+	if versionCmp != nil {
+		comparisons = append(comparisons, *versionCmp)
+	}
 
 	req := clientv3.OpPut(key, string(bytes))
 	var resp *clientv3.TxnResponse
 	err = Backoff(ctx).Retry(func(n int) (done bool, err error) {
-		resp, err = client.Txn(ctx).If(comparisons...).Then(req).Commit()
+		resp, err = client.Txn(ctx).If(comparisons...).Then(req).Else(
+			getNamespace(namespace),
+		).Commit()
 		return RetryRequest(n, err)
 	})
 	if err != nil {
@@ -149,6 +160,11 @@ func CreateOrUpdate(ctx context.Context, client *clientv3.Client, key, namespace
 			return &store.ErrNamespaceMissing{Namespace: namespace}
 		}
 
+		// Check if the resource was modified
+		if versionCmp != nil {
+			return &store.ErrPreconditionFailed{Key: key}
+		}
+
 		// Unknown error
 		return &store.ErrNotValid{
 			Err: fmt.Errorf("could not update the key %s", key),
@@ -160,8 +176,16 @@ func CreateOrUpdate(ctx context.Context, client *clientv3.Client, key, namespace
 
 // Delete the given key
 func Delete(ctx context.Context, client *clientv3.Client, key string) error {
+	versionCmp, err := resourceVersionFound(ctx, key)
+	if err != nil {
+		return err
+	}
+	if versionCmp != nil {
+		return deleteVersion(ctx, client, key, *versionCmp)
+	}
+
 	var resp *clientv3.DeleteResponse
-	err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+	err = Backoff(ctx).Retry(func(n int) (done bool, err error) {
 		resp, err = client.Delete(ctx, key)
 		return RetryRequest(n, err)
 	})
@@ -174,6 +198,28 @@ func Delete(ctx context.Context, client *clientv3.Client, key string) error {
 	return nil
 }
 
+// deleteVersion deletes the given key only if its version matches the given
+// comparison.
+func deleteVersion(ctx context.Context, client *clientv3.Client, key string, versionCmp clientv3.Cmp) error {
+	var resp *clientv3.TxnResponse
+	err := Backoff(ctx).Retry(func(n int) (done bool, err error) {
+		resp, err = client.Txn(ctx).If(versionCmp).Then(
+			clientv3.OpDelete(key),
+		).Else(getKey(key)).Commit()
+		return RetryRequest(n, err)
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		if len(resp.Responses[0].GetResponseRange().Kvs) == 0 {
+			return &store.ErrNotFound{Key: key}
+		}
+		return &store.ErrPreconditionFailed{Key: key}
+	}
+	return nil
+}
+
 // Get retrieves an object with the given key
 func Get(ctx context.Context, client *clientv3.Client, key string, object interface{}) error {
 	// Fetch the key from the store
@@ -195,6 +241,7 @@ func Get(ctx context.Context, client *clientv3.Client, key string, object interf
 	if err := unmarshal(resp.Kvs[0].Value, object); err != nil {
 		return &store.ErrDecode{Key: key, Err: err}
 	}
+	setResourceVersion(object, resp.Kvs[0].ModRevision)
 	return nil
 }
 
@@ -272,6 +319,7 @@ func List(ctx context.Context, client *clientv3.Client, keyBuilder KeyBuilderFn,
 			}
 		}
 
+		setResourceVersion(obj, kv.ModRevision)
 		v.Set(reflect.Append(v, reflect.ValueOf(obj)))
 	}
 
@@ -299,6 +347,14 @@ func Update(ctx context.Context, client *clientv3.Client, key, namespace string,
 	}
 	// Make sure the key already exists
 	comparisons = append(comparisons, keyFound(key))
+	// If a resource version is expected, make sure it did not change
+	versionCmp, err := resourceVersionFound(ctx, key)
+	if err != nil {
+		return err
+	}
+	if versionCmp != nil {
+		comparisons = append(comparisons, *versionCmp)
+	}
 
 	req := clientv3.OpPut(key, string(bytes))
 	var resp *clientv3.TxnResponse
@@ -322,6 +378,11 @@ func Update(ctx context.Context, client *clientv3.Client, key, namespace string,
 			return &store.ErrNotFound{Key: key}
 		}
 
+		// Check if the resource was modified
+		if versionCmp != nil {
+			return &store.ErrPreconditionFailed{Key: key}
+		}
+
 		// Unknown error
 		return &store.ErrNotValid{
 			Err: fmt.Errorf("could not update the key %s", key),
@@ -375,6 +436,33 @@ func namespaceFound(namespace string) clientv3.Cmp {
 	return keyFound(getNamespacePath(namespace))
 }
 
+// resourceVersionFound returns the comparison of the revision of the given key
+// with the resource version expected by the context, if any.
+func resourceVersionFound(ctx context.Context, key string) (*clientv3.Cmp, error) {
+	version := store.ResourceVersionFromContext(ctx)
+	if version == "" {
+		return nil, nil
+	}
+	revision, err := strconv.ParseInt(version, 10, 64)
+	if err != nil || revision <= 0 {
+		return nil, &store.ErrNotValid{Err: fmt.Errorf("invalid resource version %q", version)}
+	}
+	cmp := clientv3.Compare(clientv3.ModRevision(key), "=", revision)
+	return &cmp, nil
+}
+
+// setResourceVersion sets the resource version of the given object, if it is
+// a resource, from the revision of its key.
+func setResourceVersion(object interface{}, revision int64) {
+	resource, ok := object.(corev2.Resource)
+	if !ok {
+		return
+	}
+	meta := resource.GetObjectMeta()
+	meta.ResourceVersion = strconv.FormatInt(revision, 10)
+	resource.SetObjectMeta(meta)
+}
+
 // ComputeContinueToken calculates a continue token based on the given resource
 func ComputeContinueToken(ctx context.Context, r corev2.Resource) string {
 	queriedNamespace := store.NewNamespaceFromContext(ctx)
@@ -447,6 +535,14 @@ func marshal(v interface{}) (bytes []byte, err error) {
 		if !ok {
 			return nil, fmt.Errorf("%T is not proto.Message", v)
 		}
+		// The resource version is the revision of the key, so it's not stored
+		if resource, ok := v.(corev2.Resource); ok && resource.GetObjectMeta().ResourceVersion != "" {
+			meta := resource.GetObjectMeta()
+			defer resource.SetObjectMeta(meta)
+			stripped := meta
+			stripped.ResourceVersion = ""
+			resource.SetObjectMeta(stripped)
+		}
 		bytes, err = proto.Marshal(msg)
 		if err != nil {
 			return nil, err
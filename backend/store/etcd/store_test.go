@@ -170,7 +170,8 @@ func TestGet(t *testing.T) {
 		result := &GenericObject{}
 		err = Get(ctx, store.client, "/default/foo", result)
 		assert.NoError(t, err)
-		assert.Equal(t, obj, result)
+		assert.Equal(t, obj.Revision, result.Revision)
+		assert.NotEmpty(t, result.ResourceVersion)
 
 		// Create a global key
 		obj2 := &GenericObject{Revision: 2}
@@ -182,7 +183,43 @@ func TestGet(t *testing.T) {
 		result2 := &GenericObject{}
 		err = Get(ctx, store.client, "/foo", result2)
 		assert.NoError(t, err)
-		assert.Equal(t, obj2, result2)
+		assert.Equal(t, obj2.Revision, result2.Revision)
+		assert.NotEmpty(t, result2.ResourceVersion)
+	})
+}
+
+func TestResourceVersion(t *testing.T) {
+	testWithEtcdStore(t, func(s *Store) {
+		ctx := store.NamespaceContext(context.Background(), "default")
+		require.NoError(t, CreateOrUpdate(ctx, s.client, "/default/foo", "default", &GenericObject{Revision: 1}))
+
+		first := &GenericObject{}
+		require.NoError(t, Get(ctx, s.client, "/default/foo", first))
+		require.NotEmpty(t, first.ResourceVersion)
+
+		// The resource can be modified with its current version
+		versionCtx := store.ResourceVersionContext(ctx, first.ResourceVersion)
+		first.Revision = 2
+		require.NoError(t, CreateOrUpdate(versionCtx, s.client, "/default/foo", "default", first))
+
+		second := &GenericObject{}
+		require.NoError(t, Get(ctx, s.client, "/default/foo", second))
+		assert.Equal(t, uint32(2), second.Revision)
+		assert.NotEqual(t, first.ResourceVersion, second.ResourceVersion)
+
+		// The resource cannot be modified nor deleted with its previous version
+		first.Revision = 3
+		err := CreateOrUpdate(versionCtx, s.client, "/default/foo", "default", first)
+		assert.IsType(t, &store.ErrPreconditionFailed{}, err)
+		err = Update(versionCtx, s.client, "/default/foo", "default", first)
+		assert.IsType(t, &store.ErrPreconditionFailed{}, err)
+		err = Delete(versionCtx, s.client, "/default/foo")
+		assert.IsType(t, &store.ErrPreconditionFailed{}, err)
+
+		versionCtx = store.ResourceVersionContext(ctx, second.ResourceVersion)
+		require.NoError(t, Delete(versionCtx, s.client, "/default/foo"))
+		err = Delete(versionCtx, s.client, "/default/foo")
+		assert.IsType(t, &store.ErrNotFound{}, err)
 	})
 }
 
@@ -3,6 +3,7 @@ package etcd
 import (
 	"context"
 	"reflect"
+	"strings"
 
 	"github.com/coreos/etcd/clientv3"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -43,6 +44,122 @@ func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEve
 	return ch
 }
 
+// GetEventWatcher returns a channel that emits WatchEventEvent structs
+// notifying the caller that an event was created, updated or deleted in the
+// namespace of ctx, or in all the namespaces. The watch starts at revision if
+// it is not zero.
+func (s *Store) GetEventWatcher(ctx context.Context, revision int64) <-chan store.WatchEventEvent {
+	key := eventKeyBuilder.WithContext(ctx).Build()
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	w := newWatcher(ctx, s.client, key, true)
+	w.revision = revision
+	w.start()
+	ch := make(chan store.WatchEventEvent, 1)
+
+	go func() {
+		defer close(ch)
+		for response := range w.Result() {
+			watchEvent := store.WatchEventEvent{Action: response.Type}
+			if response.Type != store.WatchError {
+				var event corev2.Event
+				if err := unmarshal(response.Object, &event); err != nil {
+					logger.WithField("key", response.Key).WithError(err).Error("unable to unmarshal event from key")
+					continue
+				}
+				watchEvent.Event = &event
+				watchEvent.Revision = response.Revision
+			}
+
+			// The consumer stops receiving once ctx is done
+			select {
+			case ch <- watchEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// WatchResources returns a channel that emits WatchEventResource structs
+// notifying the caller that a resource of the type of elem was created,
+// updated or deleted under the prefix, in the namespace of ctx or in all the
+// namespaces. If revision is zero, the resources stored are first emitted as
+// created, and the watch starts right after them. If revision is negative, the
+// watch starts at the current revision. Otherwise, it starts at revision.
+func (s *Store) WatchResources(ctx context.Context, prefix string, elem corev2.Resource, revision int64) <-chan store.WatchEventResource {
+	key := store.NewKeyBuilder(prefix).WithContext(ctx).Build("")
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	elemType := reflect.TypeOf(elem).Elem()
+	ch := make(chan store.WatchEventResource, 1)
+
+	send := func(e store.WatchEventResource) bool {
+		select {
+		case ch <- e:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	decode := func(key string, object []byte) (corev2.Resource, bool) {
+		resource := reflect.New(elemType).Interface().(corev2.Resource)
+		if err := unmarshal(object, resource); err != nil {
+			logger.WithField("key", key).WithError(err).Error("unable to unmarshal resource from key")
+			return nil, false
+		}
+		return resource, true
+	}
+
+	go func() {
+		defer close(ch)
+		if revision == 0 {
+			resp, err := s.client.Get(ctx, key, clientv3.WithPrefix())
+			if err != nil {
+				logger.WithField("key", key).WithError(err).Error("unable to list the watched resources")
+				send(store.WatchEventResource{Action: store.WatchError})
+				return
+			}
+			for _, kv := range resp.Kvs {
+				resource, ok := decode(string(kv.Key), kv.Value)
+				if !ok {
+					continue
+				}
+				if !send(store.WatchEventResource{Action: store.WatchCreate, Resource: resource, Revision: kv.ModRevision}) {
+					return
+				}
+			}
+			revision = resp.Header.Revision + 1
+		}
+
+		w := newWatcher(ctx, s.client, key, true)
+		if revision > 0 {
+			w.revision = revision
+		}
+		w.start()
+		for response := range w.Result() {
+			watchEvent := store.WatchEventResource{Action: response.Type}
+			if response.Type != store.WatchError {
+				resource, ok := decode(response.Key, response.Object)
+				if !ok {
+					continue
+				}
+				watchEvent.Resource = resource
+				watchEvent.Revision = response.Revision
+			}
+			if !send(watchEvent) {
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
 // GetTessenConfigWatcher returns a channel that emits WatchEventTessenConfig
 // structs notifying the caller that a TessenConfig was updated. If the watcher
 // runs into a terminal error or the context passed is cancelled, then the
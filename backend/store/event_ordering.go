@@ -0,0 +1,207 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// The orderings of the events supported by the event stores.
+const (
+	// EventSortEntity orders the events by entity name, then by check name.
+	EventSortEntity = "ENTITY"
+
+	// EventSortLastOk orders the incidents first, from the most recent time
+	// they last received an OK status.
+	EventSortLastOk = "LASTOK"
+
+	// EventSortSeverity orders the events by the severity of their check
+	// status, from critical to OK, then as EventSortLastOk does.
+	EventSortSeverity = "SEVERITY"
+
+	// EventSortTimestamp orders the events from the oldest to the newest.
+	EventSortTimestamp = "TIMESTAMP"
+)
+
+// EventPage selects the page of events following the continue token of a
+// selection predicate with an ordering, from events added in any order. It
+// only holds the events of the page, so that all the events of a namespace
+// never need to be loaded at once.
+type EventPage struct {
+	less   func(a, b *corev2.Event) bool
+	after  *corev2.Event
+	limit  int
+	events []*corev2.Event
+}
+
+// NewEventPage returns the page of events selected by the given predicate.
+func NewEventPage(pred *SelectionPredicate) (*EventPage, error) {
+	less, err := eventLess(pred.Ordering, pred.Descending)
+	if err != nil {
+		return nil, err
+	}
+	page := &EventPage{less: less, limit: int(pred.Limit)}
+	if pred.Continue != "" {
+		if page.after, err = decodeEventContinueToken(pred.Continue); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// Add adds the given events to the page, if they follow its continue token.
+func (p *EventPage) Add(events ...*corev2.Event) {
+	for _, event := range events {
+		if event == nil || (p.after != nil && !p.less(p.after, event)) {
+			continue
+		}
+		p.events = append(p.events, event)
+	}
+	// Keep one event more than the limit to know if there's a next page
+	if p.limit > 0 && len(p.events) > 2*(p.limit+1) {
+		p.sort()
+		p.events = p.events[:p.limit+1]
+	}
+}
+
+// Full returns true if the page would not change if events following the
+// events already added, in the order of the page, were added.
+func (p *EventPage) Full() bool {
+	return p.limit > 0 && len(p.events) > p.limit
+}
+
+// Events returns the events of the page, and sets the continue token of the
+// given predicate to select the next page.
+func (p *EventPage) Events(pred *SelectionPredicate) []*corev2.Event {
+	p.sort()
+	pred.Continue = ""
+	if p.limit > 0 && len(p.events) > p.limit {
+		p.events = p.events[:p.limit]
+		pred.Continue = encodeEventContinueToken(p.events[p.limit-1])
+	}
+	return p.events
+}
+
+func (p *EventPage) sort() {
+	sort.SliceStable(p.events, func(i, j int) bool {
+		return p.less(p.events[i], p.events[j])
+	})
+}
+
+// EventContinueKey returns the entity and check names of the last event
+// selected before the given continue token of an ordered selection.
+func EventContinueKey(token string) (entity, check string, err error) {
+	event, err := decodeEventContinueToken(token)
+	if err != nil {
+		return "", "", err
+	}
+	return eventEntityName(event), eventCheckName(event), nil
+}
+
+func eventLess(ordering string, descending bool) (func(a, b *corev2.Event) bool, error) {
+	var less func(a, b *corev2.Event) bool
+	switch ordering {
+	case EventSortEntity:
+		less = func(a, b *corev2.Event) bool {
+			return eventKey(a) < eventKey(b)
+		}
+	case EventSortLastOk:
+		less = strictEventLess(corev2.EventsByLastOk)
+	case EventSortSeverity:
+		less = strictEventLess(corev2.EventsBySeverity)
+	case EventSortTimestamp:
+		less = func(a, b *corev2.Event) bool {
+			if a.Timestamp != b.Timestamp {
+				return a.Timestamp < b.Timestamp
+			}
+			return eventKey(a) < eventKey(b)
+		}
+	default:
+		return nil, &ErrNotValid{Err: fmt.Errorf("unknown event ordering %q", ordering)}
+	}
+	if descending {
+		return func(a, b *corev2.Event) bool { return less(b, a) }, nil
+	}
+	return less, nil
+}
+
+// strictEventLess returns the comparison function of the events of given
+// sorter, which may consider equal events less than each other.
+func strictEventLess(sorter func([]*corev2.Event) sort.Interface) func(a, b *corev2.Event) bool {
+	return func(a, b *corev2.Event) bool {
+		events := sorter([]*corev2.Event{a, b})
+		return events.Less(0, 1) && !events.Less(1, 0)
+	}
+}
+
+// eventKey returns the key of the event within its namespace, whose order is
+// the order of the event keys of the stores.
+func eventKey(event *corev2.Event) string {
+	return eventEntityName(event) + "/" + eventCheckName(event)
+}
+
+func eventEntityName(event *corev2.Event) string {
+	if event.Entity == nil {
+		return ""
+	}
+	return event.Entity.Name
+}
+
+func eventCheckName(event *corev2.Event) string {
+	if event.Check == nil {
+		return ""
+	}
+	return event.Check.Name
+}
+
+// eventContinueToken holds the fields of the last event of a page used by
+// the event orderings.
+type eventContinueToken struct {
+	Entity    string `json:"entity"`
+	Check     string `json:"check"`
+	Status    uint32 `json:"status"`
+	LastOK    int64  `json:"last_ok"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func encodeEventContinueToken(event *corev2.Event) string {
+	token := eventContinueToken{
+		Entity:    eventEntityName(event),
+		Check:     eventCheckName(event),
+		Timestamp: event.Timestamp,
+	}
+	if event.Check != nil {
+		token.Status = event.Check.Status
+		token.LastOK = event.Check.LastOK
+	}
+	b, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeEventContinueToken(s string) (*corev2.Event, error) {
+	var token eventContinueToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(b, &token)
+	}
+	if err != nil {
+		return nil, &ErrNotValid{Err: errors.New("invalid continue token")}
+	}
+
+	event := &corev2.Event{
+		Timestamp: token.Timestamp,
+		Entity:    &corev2.Entity{ObjectMeta: corev2.ObjectMeta{Name: token.Entity}},
+	}
+	if token.Check != "" {
+		event.Check = &corev2.Check{
+			ObjectMeta: corev2.ObjectMeta{Name: token.Check},
+			Status:     token.Status,
+			LastOK:     token.LastOK,
+		}
+	}
+	return event, nil
+}
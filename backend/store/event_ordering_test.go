@@ -0,0 +1,55 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventPage(t *testing.T) {
+	var events []*corev2.Event
+	for i := 0; i < 20; i++ {
+		event := corev2.FixtureEvent(fmt.Sprintf("entity%.2d", i), "check")
+		event.Timestamp = int64(i % 4)
+		events = append(events, event)
+	}
+
+	// Select the newest events in pages of 3 events, adding the events in
+	// small batches as the stores do
+	pred := &SelectionPredicate{Limit: 3, Ordering: EventSortTimestamp, Descending: true}
+	var names []string
+	for i := 0; i < 10; i++ {
+		page, err := NewEventPage(pred)
+		require.NoError(t, err)
+		for j := 0; j < len(events); j += 2 {
+			page.Add(events[j], events[j+1])
+		}
+		for _, event := range page.Events(pred) {
+			names = append(names, event.Entity.Name)
+		}
+		if pred.Continue == "" {
+			break
+		}
+	}
+
+	expected := []string{}
+	for timestamp := 3; timestamp >= 0; timestamp-- {
+		for i := 19; i >= 0; i-- {
+			if i%4 == timestamp {
+				expected = append(expected, fmt.Sprintf("entity%.2d", i))
+			}
+		}
+	}
+	assert.Equal(t, expected, names)
+}
+
+func TestNewEventPageErrors(t *testing.T) {
+	_, err := NewEventPage(&SelectionPredicate{Ordering: "foo"})
+	assert.Error(t, err)
+
+	_, err = NewEventPage(&SelectionPredicate{Ordering: EventSortEntity, Continue: "foo/bar"})
+	assert.Error(t, err)
+}
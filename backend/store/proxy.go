@@ -45,6 +45,10 @@ func (e *EventStoreProxy) GetEventsByEntity(ctx context.Context, entity string,
 	return e.do().GetEventsByEntity(ctx, entity, pred)
 }
 
+func (e *EventStoreProxy) CountEvents(ctx context.Context, pred *SelectionPredicate) (int64, error) {
+	return e.do().CountEvents(ctx, pred)
+}
+
 func (e *EventStoreProxy) GetEventByEntityCheck(ctx context.Context, entity, check string) (*types.Event, error) {
 	return e.do().GetEventByEntityCheck(ctx, entity, check)
 }
@@ -87,3 +91,13 @@ func (e *EventStoreProxy) Close() error {
 	}
 	return nil
 }
+
+// GetEventWatcher returns the event watcher of the underlying implementation,
+// or nil if it cannot watch the events.
+func (e *EventStoreProxy) GetEventWatcher(ctx context.Context, revision int64) <-chan WatchEventEvent {
+	watcher, ok := e.do().(EventWatcher)
+	if !ok {
+		return nil
+	}
+	return watcher.GetEventWatcher(ctx, revision)
+}
@@ -25,6 +25,10 @@ func (mockEventStore) GetEventsByEntity(ctx context.Context, entity string, pred
 	return nil, nil
 }
 
+func (mockEventStore) CountEvents(ctx context.Context, pred *SelectionPredicate) (int64, error) {
+	return 0, nil
+}
+
 func (mockEventStore) GetEventByEntityCheck(ctx context.Context, entity, check string) (*types.Event, error) {
 	return nil, nil
 }
@@ -0,0 +1,21 @@
+package store
+
+import "context"
+
+type resourceVersionKey struct{}
+
+// ResourceVersionContext returns a context with which the stores only modify
+// or delete a resource if its current version is the given one, and return an
+// ErrPreconditionFailed error otherwise.
+func ResourceVersionContext(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, resourceVersionKey{}, version)
+}
+
+// ResourceVersionFromContext returns the resource version expected by the
+// given context, or an empty string if any version is accepted.
+func ResourceVersionFromContext(ctx context.Context) string {
+	if value, ok := ctx.Value(resourceVersionKey{}).(string); ok {
+		return value
+	}
+	return ""
+}
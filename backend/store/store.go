@@ -59,6 +59,29 @@ func (e *ErrNotFound) Error() string {
 	return fmt.Sprintf("key %s not found", e.Key)
 }
 
+// ErrPreconditionFailed is returned when an object was not modified because
+// its version is not the expected one
+type ErrPreconditionFailed struct {
+	Key string
+}
+
+func (e *ErrPreconditionFailed) Error() string {
+	return fmt.Sprintf("the key %s was modified by another request", e.Key)
+}
+
+// ErrQuotaExceeded is returned when an object was not created because its
+// namespace reached the limit of a resource quota
+type ErrQuotaExceeded struct {
+	Quota     string
+	Namespace string
+	Resource  string
+	Limit     uint32
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("the resource quota %s of the namespace %s allows at most %d %s", e.Quota, e.Namespace, e.Limit, e.Resource)
+}
+
 // ErrNotValid is returned when an object failed validation
 type ErrNotValid struct {
 	Err error
@@ -93,6 +116,12 @@ type SelectionPredicate struct {
 	Limit int64
 	// Subcollection represents a sub-collection of the primary collection
 	Subcollection string
+	// Ordering indicates the order in which the resources are selected, if
+	// supported by the store. The resources are selected in the order of their
+	// keys if empty.
+	Ordering string
+	// Descending reverses the order of the selection
+	Descending bool
 }
 
 // A WatchEventCheckConfig contains the modified store object and the action that occured
@@ -115,10 +144,59 @@ type WatchEventTessenConfig struct {
 	Action       WatchActionType
 }
 
-// WatchEventResource is a store event about a specific resource
+// WatchEventResource is a store event about a specific resource. The revision
+// is the revision of the store at which the resource was modified.
 type WatchEventResource struct {
 	Resource corev2.Resource
 	Action   WatchActionType
+	Revision int64
+}
+
+// ResourceWatcher is implemented by the stores able to watch the resources.
+type ResourceWatcher interface {
+	// WatchResources returns a channel that emits the resources of the type
+	// of elem created, updated and deleted under prefix, in the namespace of
+	// ctx or in all the namespaces if it is empty. If revision is zero, the
+	// existing resources are first emitted as created. If it is negative, only
+	// the changes made from now on are emitted. Otherwise, the watch starts at
+	// revision. A WatchError action means some changes were missed.
+	// The channel is closed once ctx is done.
+	WatchResources(ctx context.Context, prefix string, elem corev2.Resource, revision int64) <-chan WatchEventResource
+}
+
+// watchingStore is a Store wrapping another one that also watches the
+// resources of the store it wraps.
+type watchingStore struct {
+	Store
+	ResourceWatcher
+}
+
+// WrapWatcher returns the store wrapper, which wraps the store wrapped, and
+// is also a ResourceWatcher if wrapped is one, since the API checks whether
+// its store is a ResourceWatcher to watch the resources.
+func WrapWatcher(wrapper, wrapped Store) Store {
+	if watcher, ok := wrapped.(ResourceWatcher); ok {
+		return &watchingStore{Store: wrapper, ResourceWatcher: watcher}
+	}
+	return wrapper
+}
+
+// WatchEventEvent is a store event about an event. The revision is the
+// revision of the store at which the event was modified.
+type WatchEventEvent struct {
+	Event    *corev2.Event
+	Action   WatchActionType
+	Revision int64
+}
+
+// EventWatcher is implemented by the event stores able to watch the events.
+type EventWatcher interface {
+	// GetEventWatcher returns a channel that emits the events created,
+	// updated and deleted in the namespace of ctx, or in all the namespaces if
+	// it is empty. If revision is not zero, the watch starts at this revision.
+	// A WatchError action means some events were missed. The channel is
+	// closed once ctx is done.
+	GetEventWatcher(ctx context.Context, revision int64) <-chan WatchEventEvent
 }
 
 // Store is used to abstract the durable storage used by the Sensu backend
@@ -364,6 +442,9 @@ type EventStore interface {
 	// namespace. A nil slice with no error is returned if none were found.
 	GetEventsByEntity(ctx context.Context, entity string, pred *SelectionPredicate) ([]*corev2.Event, error)
 
+	// CountEvents returns the number of events in the given ctx's namespace.
+	CountEvents(ctx context.Context, pred *SelectionPredicate) (int64, error)
+
 	// GetEventByEntityCheck returns an event using the given entity and check,
 	// within the namespace stored in ctx. The resulting event
 	// is nil if none was found.
@@ -375,6 +456,27 @@ type EventStore interface {
 	UpdateEvent(ctx context.Context, event *types.Event) (old, new *types.Event, err error)
 }
 
+// EventHistoryStore provides methods for managing the past occurrences of the
+// events
+type EventHistoryStore interface {
+	// AddEventHistory adds the given event to the history of its entity and
+	// check.
+	AddEventHistory(ctx context.Context, event *corev2.Event) error
+
+	// GetEventHistory returns the occurrences of the event of the given entity
+	// and check within the ctx's namespace, from the oldest to the most
+	// recent.
+	GetEventHistory(ctx context.Context, entity, check string) ([]*corev2.Event, error)
+
+	// ListEventHistory returns the occurrences of all the events within the
+	// ctx's namespace, ordered by entity, check and timestamp.
+	ListEventHistory(ctx context.Context) ([]*corev2.Event, error)
+
+	// DeleteEventHistory deletes the given occurrences from the history of
+	// their events.
+	DeleteEventHistory(ctx context.Context, events []*corev2.Event) error
+}
+
 // EventFilterStore provides methods for managing events filters
 type EventFilterStore interface {
 	// DeleteEventFilterByName deletes an event filter using the given name and the
@@ -427,6 +529,11 @@ type KeepaliveStore interface {
 	// UpdateFailingKeepalive updates the given entity keepalive with the given expiration
 	// in unix timestamp format
 	UpdateFailingKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error
+
+	// UpdateKeepalives updates the given entities, whose keepalives were
+	// received, and deletes their failing keepalive records, in as few writes
+	// as possible
+	UpdateKeepalives(ctx context.Context, entities []*types.Entity) error
 }
 
 // MutatorStore provides methods for managing events mutators
@@ -481,6 +588,13 @@ type ResourceStore interface {
 	ListResources(ctx context.Context, kind string, resources interface{}, pred *SelectionPredicate) error
 }
 
+// ResourceCounter counts the resources of a type without retrieving them.
+type ResourceCounter interface {
+	// CountResources returns the number of resources of the given type in the
+	// namespace of ctx.
+	CountResources(ctx context.Context, kind string) (int64, error)
+}
+
 // RoleBindingStore provides methods for managing RBAC role bindings
 type RoleBindingStore interface {
 	// Create a given role binding
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+type mockStore struct {
+	Store
+}
+
+type mockWatchingStore struct {
+	Store
+}
+
+func (mockWatchingStore) WatchResources(ctx context.Context, prefix string, elem corev2.Resource, revision int64) <-chan WatchEventResource {
+	return nil
+}
+
+func TestWrapWatcher(t *testing.T) {
+	wrapper := mockStore{}
+	if _, ok := WrapWatcher(wrapper, mockStore{}).(ResourceWatcher); ok {
+		t.Fatal("the wrapper of a store that does not watch the resources must not be a watcher")
+	}
+	if s := WrapWatcher(wrapper, mockStore{}); s != wrapper {
+		t.Fatal("the wrapper must be returned as is")
+	}
+	if _, ok := WrapWatcher(wrapper, mockWatchingStore{}).(ResourceWatcher); !ok {
+		t.Fatal("the wrapper of a store that watches the resources must be a watcher")
+	}
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"encoding/json"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// AccessReviewPath is the api path for the access reviews.
+var AccessReviewPath = CreateBasePath(coreAPIGroup, coreAPIVersion, corev2.AccessReviewPath)
+
+// ReviewAccess evaluates whether the user of the given review, or the current
+// user if none, is allowed to make the request it describes.
+func (client *RestClient) ReviewAccess(review *corev2.AccessReview) (*corev2.AccessReview, error) {
+	path := AccessReviewPath()
+	request := client.R().SetQueryParams(map[string]string{
+		"verb":     review.Verb,
+		"resource": review.Resource,
+	})
+	if review.ResourceName != "" {
+		request.SetQueryParam("resource_name", review.ResourceName)
+	}
+	if review.Namespace != "" {
+		request.SetQueryParam("namespace", review.Namespace)
+	}
+	if review.User != "" {
+		request.SetQueryParam("user", review.User)
+	}
+
+	res, err := request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+
+	result := &corev2.AccessReview{}
+	err = json.Unmarshal(res.Body(), result)
+	return result, err
+}
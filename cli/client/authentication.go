@@ -8,6 +8,7 @@ import (
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/go-resty/resty/v2"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
 )
 
 // CreateAccessToken returns a new access token given userid and password
@@ -34,6 +35,60 @@ func (client *RestClient) CreateAccessToken(url, userid, password string) (*core
 	return tokens, err
 }
 
+// FetchOIDCConfig returns the configuration of the OIDC provider of the
+// backend at the given URL
+func (client *RestClient) FetchOIDCConfig(url string) (*oidc.Config, error) {
+	client.ClearAuthToken()
+	defer client.Reset()
+
+	res, err := client.R().Get(url + "/auth/oidc")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, errors.New(string(res.Body()))
+	}
+
+	config := &oidc.Config{}
+	if err = json.Unmarshal(res.Body(), config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal response from server: %s", err)
+	}
+
+	return config, nil
+}
+
+// CreateAccessTokenFromIDToken returns a new access token given an ID token
+// issued by the OIDC provider of the backend, along with the nonce of its
+// request and the refresh token issued with it
+func (client *RestClient) CreateAccessTokenFromIDToken(url, idToken, nonce, refreshToken string) (*corev2.Tokens, error) {
+	client.ClearAuthToken()
+	defer client.Reset()
+
+	res, err := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{
+			"id_token":      idToken,
+			"nonce":         nonce,
+			"refresh_token": refreshToken,
+		}).
+		Post(url + "/auth/oidc/token")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, errors.New(string(res.Body()))
+	}
+
+	tokens := &corev2.Tokens{}
+	if err = json.Unmarshal(res.Body(), tokens); err != nil {
+		return nil, fmt.Errorf("could not unmarshal response from server: %s", err)
+	}
+
+	return tokens, nil
+}
+
 // TestCreds checks if the provided User credentials are valid
 func (client *RestClient) TestCreds(userid, password string) error {
 	client.ClearAuthToken()
@@ -4,13 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 )
 
 var clusterMembersPath = CreateBasePath(coreAPIGroup, coreAPIVersion, "cluster", "members")
 var clusterIDPath = CreateBasePath(coreAPIGroup, coreAPIVersion, "cluster", "id")
+var clusterDrainPath = CreateBasePath(coreAPIGroup, coreAPIVersion, "cluster", "drain")
+var clusterSessionsPath = CreateBasePath(coreAPIGroup, coreAPIVersion, "cluster", "sessions")
+var clusterRebalancePath = CreateBasePath(coreAPIGroup, coreAPIVersion, "cluster", "rebalance")
 
 // MemberList lists all members in the cluster.
 func (c *RestClient) MemberList() (*clientv3.MemberListResponse, error) {
@@ -83,3 +89,52 @@ func (c *RestClient) FetchClusterID() (string, error) {
 
 	return string(res.Body()), err
 }
+
+// DrainBackend asks the agents connected to the backend serving the request to
+// reconnect to other backends, each after a random delay up to jitter, and
+// returns their number.
+func (c *RestClient) DrainBackend(jitter time.Duration) (int, error) {
+	values := url.Values{"jitter": {strconv.Itoa(int(jitter / time.Second))}}.Encode()
+	endpoint := fmt.Sprintf("%s?%s", clusterDrainPath(), values)
+	res, err := c.R().Post(endpoint)
+	if err != nil {
+		return 0, fmt.Errorf("POST %q: %s", endpoint, err)
+	}
+	if res.StatusCode() >= 400 {
+		return 0, UnmarshalError(res)
+	}
+	var result actions.AgentDrain
+	if err := json.Unmarshal(res.Body(), &result); err != nil {
+		return 0, err
+	}
+	return result.Sessions, nil
+}
+
+// FetchSessionCounts fetches the number of agent sessions of every backend of
+// the cluster.
+func (c *RestClient) FetchSessionCounts() ([]actions.BackendSessions, error) {
+	path := clusterSessionsPath()
+	res, err := c.R().Get(path)
+	if err != nil {
+		return nil, fmt.Errorf("GET %q: %s", path, err)
+	}
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+	var result []actions.BackendSessions
+	return result, json.Unmarshal(res.Body(), &result)
+}
+
+// Rebalance asks every backend of the cluster to hand off its agent sessions
+// exceeding its fair share to the other backends.
+func (c *RestClient) Rebalance() error {
+	path := clusterRebalancePath()
+	res, err := c.R().Post(path)
+	if err != nil {
+		return fmt.Errorf("POST %q: %s", path, err)
+	}
+	if res.StatusCode() >= 400 {
+		return UnmarshalError(res)
+	}
+	return nil
+}
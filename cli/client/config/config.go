@@ -28,6 +28,16 @@ const (
 	// FormatYAML indicates YAML format for printers. It has the same layout
 	// as wrapped JSON.
 	FormatYAML = "yaml"
+
+	// FormatJSONPath indicates JSONPath template format for printers. The
+	// template follows the format, separated by "=", as in
+	// jsonpath={.metadata.name}.
+	FormatJSONPath = "jsonpath"
+
+	// FormatGoTemplate indicates Go template format for printers. The
+	// template follows the format, separated by "=", as in
+	// go-template={{.metadata.name}}.
+	FormatGoTemplate = "go-template"
 )
 
 // Config is an abstract configuration
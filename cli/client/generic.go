@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strconv"
 
 	"github.com/go-resty/resty/v2"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
@@ -167,15 +168,7 @@ func (client *RestClient) Put(path string, obj interface{}) error {
 func (client *RestClient) PutResource(r types.Wrapper) error {
 	path := r.Value.URIPath()
 
-	// Determine if we should send the wrapped resource or only the resource
-	// itself
-	var bytes []byte
-	var err error
-	if r.APIVersion == "core/v2" {
-		bytes, err = json.Marshal(r.Value)
-	} else {
-		bytes, err = json.Marshal(r)
-	}
+	bytes, err := resourceBody(r)
 	if err != nil {
 		return err
 	}
@@ -189,3 +182,81 @@ func (client *RestClient) PutResource(r types.Wrapper) error {
 	}
 	return nil
 }
+
+// ApplyResource merges the fields of the given resource into the resource at
+// its URIPath. If the resource has a resource version, it is only applied if
+// the resource was not modified since this version.
+func (client *RestClient) ApplyResource(r types.Wrapper) error {
+	path := r.Value.URIPath()
+
+	bytes, err := resourceBody(r)
+	if err != nil {
+		return err
+	}
+
+	request := client.R().SetBody(bytes).SetHeader("Content-Type", "application/merge-patch+json")
+	if version := r.Value.GetObjectMeta().ResourceVersion; version != "" {
+		request.SetHeader("If-Match", strconv.Quote(version))
+	}
+	res, err := request.Patch(path)
+	if err != nil {
+		return fmt.Errorf("PATCH %q: %s", path, err)
+	}
+	if res.StatusCode() >= 400 {
+		return UnmarshalError(res)
+	}
+	return nil
+}
+
+// DryRunResource validates the changes of putting the given resource at its
+// URIPath, or of applying it if apply is true, without persisting them, and
+// returns the resource as it would be persisted.
+func (client *RestClient) DryRunResource(r types.Wrapper, apply bool) (types.Resource, error) {
+	path := r.Value.URIPath()
+
+	bytes, err := resourceBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := reflect.New(reflect.TypeOf(r.Value).Elem()).Interface().(types.Resource)
+	request := client.R().SetBody(bytes).SetQueryParam("dryRun", "true").SetResult(result)
+	method := http.MethodPut
+	if apply {
+		method = http.MethodPatch
+		request.SetHeader("Content-Type", "application/merge-patch+json")
+	}
+	res, err := request.Execute(method, path)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %s", method, path, err)
+	}
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+	return result, nil
+}
+
+// resourceBody returns the body of the requests that send the given resource.
+func resourceBody(r types.Wrapper) ([]byte, error) {
+	// Determine if we should send the wrapped resource or only the resource
+	// itself
+	if r.APIVersion == "core/v2" {
+		return json.Marshal(r.Value)
+	}
+	return json.Marshal(r)
+}
+
+// BulkResources sends the given resources to the bulk endpoint with the given
+// method: POST creates them, PUT creates or replaces them and DELETE deletes
+// them. The results hold the outcome for each resource, in order.
+func (client *RestClient) BulkResources(method string, resources []*types.Wrapper) ([]corev2.BulkResult, error) {
+	var results []corev2.BulkResult
+	res, err := client.R().SetBody(resources).SetResult(&results).Execute(method, "/api/core/v2/bulk")
+	if err != nil {
+		return nil, fmt.Errorf("%s bulk: %s", method, err)
+	}
+	if res.StatusCode() >= 400 {
+		return nil, UnmarshalError(res)
+	}
+	return results, nil
+}
@@ -2,10 +2,13 @@ package client
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/go-resty/resty/v2"
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
 	"github.com/sensu/sensu-go/types"
 )
 
@@ -26,6 +29,7 @@ type ListOptions struct {
 
 // APIClient client methods across the Sensu API
 type APIClient interface {
+	AccessReviewAPIClient
 	APIKeyClient
 	AuthenticationAPIClient
 	AssetAPIClient
@@ -50,6 +54,11 @@ type APIClient interface {
 	LicenseClient
 }
 
+// AccessReviewAPIClient client methods for access reviews
+type AccessReviewAPIClient interface {
+	ReviewAccess(*corev2.AccessReview) (*corev2.AccessReview, error)
+}
+
 // APIKeyClient exposes client methods for api keys.
 type APIKeyClient interface {
 	// PostAPIKey creates an api key and returns the location header.
@@ -73,6 +82,19 @@ type GenericClient interface {
 
 	// PutResource puts a resource according to its URIPath.
 	PutResource(types.Wrapper) error
+
+	// ApplyResource merges the fields of a resource into the resource at its
+	// URIPath.
+	ApplyResource(types.Wrapper) error
+
+	// DryRunResource validates the changes of putting a resource at its
+	// URIPath, or of applying it if apply is true, without persisting them,
+	// and returns the resource as it would be persisted.
+	DryRunResource(r types.Wrapper, apply bool) (types.Resource, error)
+
+	// BulkResources creates, replaces or deletes resources, according to the
+	// given method, in a single request.
+	BulkResources(method string, resources []*types.Wrapper) ([]corev2.BulkResult, error)
 }
 
 // AuthenticationAPIClient client methods for authenticating
@@ -81,6 +103,8 @@ type AuthenticationAPIClient interface {
 	TestCreds(userid string, secret string) error
 	Logout(token string) error
 	RefreshAccessToken(tokens *corev2.Tokens) (*corev2.Tokens, error)
+	FetchOIDCConfig(url string) (*oidc.Config, error)
+	CreateAccessTokenFromIDToken(url, idToken, nonce, refreshToken string) (*corev2.Tokens, error)
 }
 
 // AssetAPIClient client methods for assets
@@ -247,6 +271,16 @@ type ClusterMemberClient interface {
 
 	// FetchClusterID gets the sensu cluster id.
 	FetchClusterID() (string, error)
+
+	// DrainBackend asks the agents connected to the backend to reconnect to
+	// other backends.
+	DrainBackend(jitter time.Duration) (int, error)
+
+	// FetchSessionCounts gets the number of agent sessions of the backends.
+	FetchSessionCounts() ([]actions.BackendSessions, error)
+
+	// Rebalance balances the agent sessions across the backends.
+	Rebalance() error
 }
 
 // LicenseClient specifies the enteprise client methods for license management.
@@ -0,0 +1,9 @@
+package testing
+
+import corev2 "github.com/sensu/sensu-go/api/core/v2"
+
+// ReviewAccess for use with mock lib
+func (c *MockClient) ReviewAccess(review *corev2.AccessReview) (*corev2.AccessReview, error) {
+	args := c.Called(review)
+	return args.Get(0).(*corev2.AccessReview), args.Error(1)
+}
@@ -2,6 +2,7 @@ package testing
 
 import (
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
 )
 
 // CreateAccessToken for use with mock lib
@@ -27,3 +28,15 @@ func (c *MockClient) RefreshAccessToken(tokens *corev2.Tokens) (*corev2.Tokens,
 	args := c.Called(tokens)
 	return args.Get(0).(*corev2.Tokens), args.Error(1)
 }
+
+// FetchOIDCConfig for use with mock lib
+func (c *MockClient) FetchOIDCConfig(url string) (*oidc.Config, error) {
+	args := c.Called(url)
+	return args.Get(0).(*oidc.Config), args.Error(1)
+}
+
+// CreateAccessTokenFromIDToken for use with mock lib
+func (c *MockClient) CreateAccessTokenFromIDToken(url, idToken, nonce, refreshToken string) (*corev2.Tokens, error) {
+	args := c.Called(url, idToken, nonce, refreshToken)
+	return args.Get(0).(*corev2.Tokens), args.Error(1)
+}
@@ -1,6 +1,11 @@
 package testing
 
-import "github.com/coreos/etcd/clientv3"
+import (
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+)
 
 // MemberList ...
 func (c *MockClient) MemberList() (*clientv3.MemberListResponse, error) {
@@ -31,3 +36,21 @@ func (c *MockClient) FetchClusterID() (string, error) {
 	args := c.Called()
 	return args.Get(0).(string), args.Error(1)
 }
+
+// DrainBackend ...
+func (c *MockClient) DrainBackend(jitter time.Duration) (int, error) {
+	args := c.Called(jitter)
+	return args.Int(0), args.Error(1)
+}
+
+// FetchSessionCounts ...
+func (c *MockClient) FetchSessionCounts() ([]actions.BackendSessions, error) {
+	args := c.Called()
+	return args.Get(0).([]actions.BackendSessions), args.Error(1)
+}
+
+// Rebalance ...
+func (c *MockClient) Rebalance() error {
+	args := c.Called()
+	return args.Error(0)
+}
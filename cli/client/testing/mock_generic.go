@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/go-resty/resty/v2"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli/client"
 	"github.com/sensu/sensu-go/types"
 )
@@ -49,3 +50,22 @@ func (c *MockClient) PutResource(r types.Wrapper) error {
 	args := c.Called(r)
 	return args.Error(0)
 }
+
+// ApplyResource ...
+func (c *MockClient) ApplyResource(r types.Wrapper) error {
+	args := c.Called(r)
+	return args.Error(0)
+}
+
+// DryRunResource ...
+func (c *MockClient) DryRunResource(r types.Wrapper, apply bool) (types.Resource, error) {
+	args := c.Called(r, apply)
+	resource, _ := args.Get(0).(types.Resource)
+	return resource, args.Error(1)
+}
+
+// BulkResources ...
+func (c *MockClient) BulkResources(method string, resources []*types.Wrapper) ([]corev2.BulkResult, error) {
+	args := c.Called(method, resources)
+	return args.Get(0).([]corev2.BulkResult), args.Error(1)
+}
@@ -3,12 +3,19 @@ package apikey
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagExpiresIn  = "expires-in"
+	flagNamespaces = "namespaces"
+	flagVerbs      = "verbs"
+)
+
 // GrantCommand adds a command that creates apikeys.
 func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,8 +28,26 @@ func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 				return errors.New("invalid argument(s) received")
 			}
 
+			expiresIn, err := cmd.Flags().GetDuration(flagExpiresIn)
+			if err != nil {
+				return err
+			}
+			namespaces, err := cmd.Flags().GetStringSlice(flagNamespaces)
+			if err != nil {
+				return err
+			}
+			verbs, err := cmd.Flags().GetStringSlice(flagVerbs)
+			if err != nil {
+				return err
+			}
+
 			apikey := &corev2.APIKey{
-				Username: args[0],
+				Username:   args[0],
+				Namespaces: namespaces,
+				Verbs:      verbs,
+			}
+			if expiresIn > 0 {
+				apikey.ExpiresAt = time.Now().Add(expiresIn).Unix()
 			}
 
 			location, err := cli.Client.PostAPIKey(apikey.URIPath(), apikey)
@@ -35,5 +60,9 @@ func GrantCommand(cli *cli.SensuCli) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(flagExpiresIn, 0, "duration after which the api-key expires, e.g. 720h (never expires if 0)")
+	cmd.Flags().StringSlice(flagNamespaces, nil, "comma separated list of the only namespaces the api-key can access")
+	cmd.Flags().StringSlice(flagVerbs, nil, "comma separated list of the only verbs the api-key can use (get, list, create, update, delete)")
+
 	return cmd
 }
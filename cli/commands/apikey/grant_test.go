@@ -2,8 +2,11 @@ package apikey
 
 import (
 	"errors"
+	"reflect"
 	"testing"
+	"time"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
 	"github.com/stretchr/testify/assert"
@@ -64,3 +67,23 @@ func TestGrantCommandServerError(t *testing.T) {
 	assert.Error(err)
 	assert.Equal("err", err.Error())
 }
+
+func TestGrantCommandWithScope(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("PostAPIKey", mock.Anything, mock.MatchedBy(func(apikey *corev2.APIKey) bool {
+		return apikey.Username == "user1" &&
+			apikey.ExpiresAt > time.Now().Add(time.Hour).Unix() &&
+			reflect.DeepEqual(apikey.Namespaces, []string{"dev", "prod"}) &&
+			reflect.DeepEqual(apikey.Verbs, []string{"get", "list"})
+	})).Return("location", nil)
+
+	cmd := GrantCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flagExpiresIn, "2h"))
+	require.NoError(t, cmd.Flags().Set(flagNamespaces, "dev,prod"))
+	require.NoError(t, cmd.Flags().Set(flagVerbs, "get,list"))
+	out, err := test.RunCmd(cmd, []string{"user1"})
+
+	require.NoError(t, err)
+	assert.Regexp(t, "Created: location", out)
+}
@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/commands/timeutil"
 	"github.com/sensu/sensu-go/cli/elements/list"
 	"github.com/spf13/cobra"
 )
@@ -66,8 +68,33 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Created At",
 				Value: time.Unix(r.CreatedAt, 0).String(),
 			},
+			{
+				Label: "Expires At",
+				Value: timeutil.HumanTimestamp(r.ExpiresAt),
+			},
+			{
+				Label: "Namespaces",
+				Value: scopeString(r.Namespaces),
+			},
+			{
+				Label: "Verbs",
+				Value: scopeString(r.Verbs),
+			},
+			{
+				Label: "Last Used At",
+				Value: timeutil.HumanTimestamp(r.LastUsedAt),
+			},
 		},
 	}
 
 	return list.Print(writer, cfg)
 }
+
+// scopeString returns the representation of a scope of an API key, which is
+// not restricted if empty.
+func scopeString(scope []string) string {
+	if len(scope) == 0 {
+		return "all"
+	}
+	return strings.Join(scope, ", ")
+}
@@ -90,6 +90,26 @@ func printToTable(results interface{}, writer io.Writer) {
 				return timeutil.HumanTimestamp(apikey.CreatedAt)
 			},
 		},
+		{
+			Title: "Expires At",
+			CellTransformer: func(data interface{}) string {
+				apikey, ok := data.(corev2.APIKey)
+				if !ok {
+					return cli.TypeError
+				}
+				return timeutil.HumanTimestamp(apikey.ExpiresAt)
+			},
+		},
+		{
+			Title: "Last Used At",
+			CellTransformer: func(data interface{}) string {
+				apikey, ok := data.(corev2.APIKey)
+				if !ok {
+					return cli.TypeError
+				}
+				return timeutil.HumanTimestamp(apikey.LastUsedAt)
+			},
+		},
 	})
 
 	table.Render(writer, results)
@@ -0,0 +1,64 @@
+package apply
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+// ApplyCommand applies generic Sensu resources.
+func ApplyCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply [-r] [[-f URL] ... ]",
+		Short: "apply resources from file or URL (path, file://, http[s]://), or STDIN otherwise. The fields of the resources are merged into the existing resources, which are created if missing.",
+		RunE:  execute(cli),
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs to apply resources from")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("diff", false, "Print the changes the resources make to the cluster, field by field")
+	_ = cmd.Flags().Bool("dry-run", false, "Validate the resources without applying them")
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		client := &http.Client{Transport: t}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		diff, err := cmd.Flags().GetBool("diff")
+		if err != nil {
+			return err
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		processor := resource.Preview(cmd.OutOrStdout(), diff, dryRun, true, resource.NewApplier())
+		if len(inputs) == 0 {
+			return resource.ProcessStdin(cli, client, processor)
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		for _, input := range inputs {
+			if err := resource.Process(cli, client, input, recurse, processor); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/bonsai"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/spf13/cobra"
@@ -13,7 +16,7 @@ import (
 	"github.com/sensu/sensu-go/cli/resource"
 )
 
-var rename string
+const flagRename = "rename"
 
 // AddCommand adds command that allows user to add assets from Bonsai.
 func AddCommand(cli *cli.SensuCli) *cobra.Command {
@@ -23,7 +26,7 @@ func AddCommand(cli *cli.SensuCli) *cobra.Command {
 		RunE:  addCommandExecute(cli),
 	}
 
-	cmd.Flags().StringVarP(&rename, "rename", "r", "", "rename the asset to the provided string after fetching it from Bonsai")
+	cmd.Flags().StringP(flagRename, "r", "", "rename the asset to the provided string after fetching it from Bonsai")
 
 	return cmd
 }
@@ -36,65 +39,90 @@ func addCommandExecute(cli *cli.SensuCli) func(cmd *cobra.Command, args []string
 			return errors.New("invalid argument(s) received")
 		}
 
-		name := args[0]
-
-		bAsset, err := bonsai.NewBaseAsset(name)
+		bAsset, err := bonsai.NewBaseAsset(args[0])
 		if err != nil {
 			return err
 		}
-
-		var version *goversion.Version
-		if bAsset.Version != "" {
-			version, err = goversion.NewVersion(bAsset.Version)
-			if err != nil {
-				return err
-			}
-		}
-
-		bonsaiClient := bonsai.New(bonsai.Config{})
-		bonsaiAsset, err := bonsaiClient.FetchAsset(bAsset.Namespace, bAsset.Name)
+		rename, err := cmd.Flags().GetString(flagRename)
 		if err != nil {
 			return err
 		}
 
-		bonsaiVersion, err := bonsaiAsset.BonsaiVersion(version)
+		bonsaiClient := bonsai.New(bonsai.Config{})
+		return addBonsaiAsset(cli, bonsaiClient, bAsset, rename, cli.Config.Namespace(), cmd.OutOrStdout())
+	}
+}
+
+// addBonsaiAsset fetches the definition of a Bonsai asset, at its requested
+// version or else its latest one, and creates or updates it in the given
+// namespace. The asset is named after the Bonsai asset unless a name is given.
+func addBonsaiAsset(cli *cli.SensuCli, client bonsai.Client, bAsset *bonsai.BaseAsset, name, namespace string, w io.Writer) error {
+	var version *goversion.Version
+	if bAsset.Version != "" {
+		var err error
+		version, err = goversion.NewVersion(bAsset.Version)
 		if err != nil {
 			return err
 		}
+	}
 
-		if version == nil {
-			fmt.Println("no version specified, using latest:", bonsaiVersion.Original())
-		}
+	bonsaiAsset, err := client.FetchAsset(bAsset.Namespace, bAsset.Name)
+	if err != nil {
+		return err
+	}
 
-		fmt.Printf("fetching bonsai asset: %s/%s:%s\n", bAsset.Namespace, bAsset.Name, bonsaiVersion.Original())
+	bonsaiVersion, err := bonsaiAsset.BonsaiVersion(version)
+	if err != nil {
+		return err
+	}
 
-		asset, err := bonsaiClient.FetchAssetVersion(bAsset.Namespace, bAsset.Name, bonsaiVersion.Original())
-		if err != nil {
-			return err
-		}
+	if version == nil {
+		fmt.Fprintln(w, "no version specified, using latest:", bonsaiVersion.Original())
+	}
 
-		resources, err := resource.Parse(bytes.NewReader([]byte(asset)))
-		if err != nil {
-			return err
-		}
-		if err := resource.Validate(resources, cli.Config.Namespace()); err != nil {
-			return err
-		}
-		for i := range resources {
-			meta := resources[i].Value.GetObjectMeta()
-			if rename != "" {
-				meta.Name = rename
-			} else {
-				meta.Name = fmt.Sprintf("%s/%s", bAsset.Namespace, bAsset.Name)
-			}
-			resources[i].Value.SetObjectMeta(meta)
+	fmt.Fprintf(w, "fetching bonsai asset: %s/%s:%s\n", bAsset.Namespace, bAsset.Name, bonsaiVersion.Original())
+
+	asset, err := client.FetchAssetVersion(bAsset.Namespace, bAsset.Name, bonsaiVersion.Original())
+	if err != nil {
+		return err
+	}
+
+	resources, err := resource.Parse(bytes.NewReader([]byte(asset)))
+	if err != nil {
+		return err
+	}
+	if err := resource.Validate(resources, namespace); err != nil {
+		return err
+	}
+	for i := range resources {
+		meta := resources[i].Value.GetObjectMeta()
+		if name != "" {
+			meta.Name = name
+		} else {
+			meta.Name = fmt.Sprintf("%s/%s", bAsset.Namespace, bAsset.Name)
 		}
-		processor := resource.NewPutter()
-		if err := processor.Process(cli.Client, resources); err != nil {
-			return err
+		resources[i].Value.SetObjectMeta(meta)
+		if a, ok := resources[i].Value.(*corev2.Asset); ok {
+			printBuilds(w, a)
 		}
+	}
+	processor := resource.NewPutter()
+	if err := processor.Process(cli.Client, resources); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "added asset: %s/%s:%s\n", bAsset.Namespace, bAsset.Name, bonsaiVersion.Original())
+	return nil
+}
 
-		fmt.Printf("added asset: %s/%s:%s\n", bAsset.Namespace, bAsset.Name, bonsaiVersion.Original())
-		return nil
+// printBuilds prints the builds of an asset, with the filters which select
+// the platforms of the entities they are installed on.
+func printBuilds(w io.Writer, a *corev2.Asset) {
+	for _, build := range assetBuilds(a) {
+		platform := "all platforms"
+		if len(build.Filters) > 0 {
+			platform = strings.Join(build.Filters, " && ")
+		}
+		fmt.Fprintf(w, "  %s: %s\n", platform, build.URL)
 	}
 }
@@ -1,10 +1,17 @@
 package asset
 
 import (
+	"bytes"
 	"testing"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/bonsai"
+	cliClient "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAddCommand(t *testing.T) {
@@ -18,3 +25,76 @@ func TestAddCommand(t *testing.T) {
 	assert.Regexp("add", cmd.Use)
 	assert.Regexp("Bonsai", cmd.Short)
 }
+
+const bonsaiAssetDefinition = `{
+  "type": "Asset",
+  "api_version": "core/v2",
+  "metadata": {
+    "name": "testasset",
+    "annotations": {
+      "io.sensu.bonsai.api_url": "https://bonsai.sensu.io/api/v1/assets/sensu/testasset",
+      "io.sensu.bonsai.version": "0.2.0",
+      "io.sensu.bonsai.namespace": "sensu",
+      "io.sensu.bonsai.name": "testasset"
+    }
+  },
+  "spec": {
+    "builds": [
+      {
+        "url": "https://assets.example.com/testasset_linux_amd64.tar.gz",
+        "sha512": "abc",
+        "filters": ["entity.system.os == 'linux'", "entity.system.arch == 'amd64'"]
+      }
+    ]
+  }
+}`
+
+func TestAddBonsaiAsset(t *testing.T) {
+	testCases := []struct {
+		desc              string
+		version           string
+		name              string
+		namespace         string
+		expectedName      string
+		expectedNamespace string
+		expectedErr       bool
+	}{
+		{"latest version", "", "", "default", "sensu/testasset", "default", false},
+		{"given version", "v0.1.0", "", "default", "sensu/testasset", "default", false},
+		{"renamed", "0.2.0", "foo", "dev", "foo", "dev", false},
+		{"missing version", "0.3.0", "", "default", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cli := test.NewMockCLI()
+			client := cli.Client.(*cliClient.MockClient)
+			client.On("PutResource", mock.MatchedBy(func(w types.Wrapper) bool {
+				a, ok := w.Value.(*corev2.Asset)
+				return ok && a.Name == tc.expectedName && a.Namespace == tc.expectedNamespace
+			})).Return(nil)
+
+			bonsaiClient := &mockedBonsaiClient{}
+			bonsaiClient.On("FetchAsset", "sensu", "testasset").Return(&bonsai.Asset{
+				Name: "sensu/testasset",
+				Versions: []*bonsai.AssetVersionGrouping{
+					{Version: "0.1.0"},
+					{Version: "0.2.0"},
+				},
+			}, nil)
+			bonsaiClient.On("FetchAssetVersion", "sensu", "testasset").Return(bonsaiAssetDefinition, nil)
+
+			bAsset := &bonsai.BaseAsset{Namespace: "sensu", Name: "testasset", Version: tc.version}
+			var out bytes.Buffer
+			err := addBonsaiAsset(cli, bonsaiClient, bAsset, tc.name, tc.namespace, &out)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				client.AssertNotCalled(t, "PutResource", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			client.AssertExpectations(t)
+			assert.Contains(t, out.String(), "entity.system.os == 'linux' && entity.system.arch == 'amd64': https://assets.example.com/testasset_linux_amd64.tar.gz")
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/asset"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/spf13/cobra"
+)
+
+const flagOutputDir = "output-dir"
+
+// BundleCommand adds a command that allows users to pre-package the builds of
+// assets into a bundle directory, which agents without access to the asset
+// URLs can fetch the assets from.
+func BundleCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "bundle [NAME...]",
+		Short:        "downloads the builds of the assets into a bundle directory for offline agents",
+		SilenceUsage: true,
+		RunE:         bundleCommandExecute(cli),
+	}
+
+	cmd.Flags().StringP(flagOutputDir, "o", "sensu-assets", "directory of the bundle, updated if it already exists")
+
+	return cmd
+}
+
+func bundleCommandExecute(cli *cli.SensuCli) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		dir, err := cmd.Flags().GetString(flagOutputDir)
+		if err != nil {
+			return err
+		}
+
+		// Fetch the named assets, or all the assets of the namespace
+		assets := []corev2.Asset{}
+		if len(args) == 0 {
+			var header http.Header
+			err := cli.Client.List(client.AssetsPath(cli.Config.Namespace()), &assets, &client.ListOptions{}, &header)
+			if err != nil {
+				return err
+			}
+		}
+		for _, name := range args {
+			a, err := cli.Client.FetchAsset(name)
+			if err != nil {
+				return err
+			}
+			assets = append(assets, *a)
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		manifest, err := asset.ReadBundleManifest(dir)
+		if os.IsNotExist(err) {
+			manifest, err = &asset.BundleManifest{}, nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, a := range assets {
+			for _, build := range assetBuilds(&a) {
+				fmt.Fprintf(cmd.OutOrStdout(), "bundling asset %s: %s\n", a.Name, build.URL)
+				entry, err := asset.AddToBundle(context.Background(), dir, a.Name, build)
+				if err != nil {
+					return fmt.Errorf("could not bundle asset %s: %s", a.Name, err)
+				}
+				manifest.Builds = addBundleBuild(manifest.Builds, entry)
+			}
+		}
+
+		if err := asset.WriteBundleManifest(dir, manifest); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "bundled %d asset(s) into %s\n", len(assets), dir)
+		return nil
+	}
+}
+
+// assetBuilds returns the builds of an asset, including the build of the
+// assets defined with a single URL.
+func assetBuilds(a *corev2.Asset) []*corev2.AssetBuild {
+	if len(a.Builds) > 0 {
+		return a.Builds
+	}
+	return []*corev2.AssetBuild{{
+		URL:     a.URL,
+		Sha512:  a.Sha512,
+		Filters: a.Filters,
+		Headers: a.Headers,
+	}}
+}
+
+// addBundleBuild adds a build to the builds of a bundle manifest, replacing
+// the build of the same URL, if any.
+func addBundleBuild(builds []asset.BundleBuild, build asset.BundleBuild) []asset.BundleBuild {
+	for i := range builds {
+		if builds[i].URL == build.URL {
+			builds[i] = build
+			return builds
+		}
+	}
+	return append(builds, build)
+}
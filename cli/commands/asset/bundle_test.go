@@ -0,0 +1,80 @@
+package asset
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/asset"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleCommand(t *testing.T) {
+	fixture := filepath.Join("..", "..", "..", "asset", "fixtures", "rubby-on-rails.tar")
+	sha, err := ioutil.ReadFile(fixture + ".sha512")
+	require.NoError(t, err)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, fixture)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "sensuctl-asset-bundle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		assets := args.Get(1).(*[]corev2.Asset)
+		*assets = []corev2.Asset{
+			{
+				ObjectMeta: corev2.ObjectMeta{Name: "rubby"},
+				Builds:     []*corev2.AssetBuild{{URL: ts.URL + "/rubby.tar", Sha512: string(sha)}},
+			},
+			{
+				ObjectMeta: corev2.ObjectMeta{Name: "rails"},
+				URL:        ts.URL + "/rails.tar",
+				Sha512:     string(sha),
+			},
+		}
+	}).Return(nil)
+
+	cmd := BundleCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flagOutputDir, dir))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "bundled 2 asset(s)")
+
+	manifest, err := asset.ReadBundleManifest(dir)
+	require.NoError(t, err)
+	require.Len(t, manifest.Builds, 2)
+	assert.Equal(t, "rubby", manifest.Builds[0].Asset)
+	assert.Equal(t, ts.URL+"/rails.tar", manifest.Builds[1].URL)
+	_, err = os.Stat(filepath.Join(dir, asset.BundleFile(string(sha))))
+	assert.NoError(t, err)
+
+	// Bundling again updates the manifest in place
+	_, err = test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	manifest, err = asset.ReadBundleManifest(dir)
+	require.NoError(t, err)
+	assert.Len(t, manifest.Builds, 2)
+}
+
+func TestBundleCommandFetchError(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchAsset", "rubby").Return((*corev2.Asset)(nil), assert.AnError)
+
+	cmd := BundleCommand(cli)
+	_, err := test.RunCmd(cmd, []string{"rubby"})
+	assert.Error(t, err)
+}
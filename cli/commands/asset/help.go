@@ -21,6 +21,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		DeleteCommand(cli),
 		AddCommand(cli),
 		OutdatedCommand(cli),
+		BundleCommand(cli),
 	)
 	return cmd
 }
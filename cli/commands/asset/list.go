@@ -67,6 +67,7 @@ func ListCommand(cli *cli.SensuCli) *cobra.Command {
 							Sha512:     build.Sha512,
 							Filters:    build.Filters,
 							Headers:    build.Headers,
+							Signature:  build.Signature,
 						}
 						resultsWithBuilds = append(resultsWithBuilds, asset)
 					}
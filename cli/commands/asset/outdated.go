@@ -18,8 +18,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagUpgrade = "upgrade"
+
 // OutdatedCommand adds a command that allows users to list outdated assets
-// that have been added from Bonsai.
+// that have been added from Bonsai, and to upgrade them to their latest
+// version.
 func OutdatedCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "outdated",
@@ -32,6 +35,7 @@ func OutdatedCommand(cli *cli.SensuCli) *cobra.Command {
 	helpers.AddFieldSelectorFlag(cmd.Flags())
 	helpers.AddLabelSelectorFlag(cmd.Flags())
 	helpers.AddChunkSizeFlag(cmd.Flags())
+	cmd.Flags().Bool(flagUpgrade, false, "upgrade the outdated assets to their latest version")
 
 	return cmd
 }
@@ -69,6 +73,10 @@ func outdatedCommandExecute(cli *cli.SensuCli) func(cmd *cobra.Command, args []s
 			return err
 		}
 
+		if upgrade, _ := cmd.Flags().GetBool(flagUpgrade); upgrade {
+			return upgradeAssets(cli, bonsaiClient, outdatedAssets, cmd.OutOrStdout())
+		}
+
 		// Print the results based on user preferences
 		resources := []corev2.Resource{}
 		for _, outdatedAsset := range outdatedAssets {
@@ -121,6 +129,7 @@ func outdatedAssets(assets []corev2.Asset, client bonsai.Client) ([]bonsai.Outda
 					BonsaiName:      bonsaiName,
 					BonsaiNamespace: bonsaiNamespace,
 					AssetName:       asset.Name,
+					AssetNamespace:  asset.Namespace,
 					CurrentVersion:  installedVersion.Original(),
 					LatestVersion:   latestVersion.Original(),
 				})
@@ -131,6 +140,24 @@ func outdatedAssets(assets []corev2.Asset, client bonsai.Client) ([]bonsai.Outda
 	return outdatedAssets, nil
 }
 
+// upgradeAssets updates the outdated assets to the latest version of their
+// Bonsai asset, keeping their name and namespace.
+func upgradeAssets(cli *cli.SensuCli, client bonsai.Client, outdatedAssets []bonsai.OutdatedAsset, w io.Writer) error {
+	for _, outdatedAsset := range outdatedAssets {
+		bAsset := &bonsai.BaseAsset{
+			Namespace: outdatedAsset.BonsaiNamespace,
+			Name:      outdatedAsset.BonsaiName,
+			Version:   outdatedAsset.LatestVersion,
+		}
+		err := addBonsaiAsset(cli, client, bAsset, outdatedAsset.AssetName, outdatedAsset.AssetNamespace, w)
+		if err != nil {
+			return fmt.Errorf("could not upgrade asset %s: %s", outdatedAsset.AssetName, err)
+		}
+	}
+	fmt.Fprintf(w, "upgraded %d asset(s)\n", len(outdatedAssets))
+	return nil
+}
+
 func printOutdatedToTable(results interface{}, writer io.Writer) {
 	table := table.New([]*table.Column{
 		{
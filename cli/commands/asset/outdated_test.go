@@ -1,6 +1,7 @@
 package asset
 
 import (
+	"bytes"
 	"errors"
 	"reflect"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/sensu/sensu-go/bonsai"
 	cliClient "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -280,3 +282,32 @@ func Test_outdatedAssets(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeAssets(t *testing.T) {
+	cli := test.NewMockCLI()
+	client := cli.Client.(*cliClient.MockClient)
+	client.On("PutResource", mock.MatchedBy(func(w types.Wrapper) bool {
+		a, ok := w.Value.(*corev2.Asset)
+		return ok && a.Name == "foo" && a.Namespace == "dev" &&
+			a.Annotations[bonsai.VersionAnnotation] == "0.2.0"
+	})).Return(nil)
+
+	bonsaiClient := &mockedBonsaiClient{}
+	bonsaiClient.On("FetchAsset", "sensu", "testasset").Return(&bonsai.Asset{
+		Versions: []*bonsai.AssetVersionGrouping{{Version: "0.1.0"}, {Version: "0.2.0"}},
+	}, nil)
+	bonsaiClient.On("FetchAssetVersion", "sensu", "testasset").Return(bonsaiAssetDefinition, nil)
+
+	var out bytes.Buffer
+	err := upgradeAssets(cli, bonsaiClient, []bonsai.OutdatedAsset{{
+		BonsaiName:      "testasset",
+		BonsaiNamespace: "sensu",
+		AssetName:       "foo",
+		AssetNamespace:  "dev",
+		CurrentVersion:  "0.1.0",
+		LatestVersion:   "0.2.0",
+	}}, &out)
+	assert.NoError(t, err)
+	client.AssertExpectations(t)
+	assert.Contains(t, out.String(), "upgraded 1 asset(s)")
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+)
+
+// CanICommand adds a command that evaluates whether a user is allowed to make
+// a request.
+func CanICommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "can-i VERB RESOURCE [NAME]",
+		Short: "check whether an action is allowed",
+		Long: `Check whether the current user, or the user given with --as, is allowed to
+perform the VERB on the RESOURCE, e.g. "sensuctl auth can-i create checks -n ops",
+and explain which binding allowed or denied it.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 || len(args) > 3 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			review := &corev2.AccessReview{
+				Verb:      args[0],
+				Resource:  args[1],
+				Namespace: cli.Config.Namespace(),
+			}
+			if len(args) == 3 {
+				review.ResourceName = args[2]
+			}
+			if clusterWide, _ := cmd.Flags().GetBool("cluster-wide"); clusterWide {
+				review.Namespace = ""
+			}
+			review.User, _ = cmd.Flags().GetString("as")
+
+			result, err := cli.Client.ReviewAccess(review)
+			if err != nil {
+				return err
+			}
+
+			flag := helpers.GetChangedStringValueFlag("format", cmd.Flags())
+			format := cli.Config.Format()
+			return helpers.PrintFormatted(flag, format, result, cmd.OutOrStdout(), printReview)
+		},
+	}
+
+	cmd.Flags().String("as", "", "name of the user whose permissions are checked, instead of the current user")
+	cmd.Flags().Bool("cluster-wide", false, "check the action on a cluster-wide resource, outside of any namespace")
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printReview(v interface{}, writer io.Writer) error {
+	r, ok := v.(*corev2.AccessReview)
+	if !ok {
+		return fmt.Errorf("%t is not an AccessReview", v)
+	}
+	answer := "no"
+	if r.Allowed {
+		answer = "yes"
+	}
+	_, err := fmt.Fprintf(writer, "%s (%s)\n", answer, r.Reason)
+	return err
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanICommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := CanICommand(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("can-i", cmd.Use)
+}
+
+func TestCanICommandRunEClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	review := &corev2.AccessReview{Verb: "create", Resource: "checks", Namespace: "default"}
+	client.On("ReviewAccess", review).Return(&corev2.AccessReview{
+		Allowed: true,
+		Reason:  "allowed by a rule of the ClusterRole admin bound by the ClusterRoleBinding admins",
+	}, nil)
+
+	cmd := CanICommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+	out, err := test.RunCmd(cmd, []string{"create", "checks"})
+	require.NoError(t, err)
+	assert.Equal("yes (allowed by a rule of the ClusterRole admin bound by the ClusterRoleBinding admins)\n", out)
+}
+
+func TestCanICommandRunEClosureWithUser(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	review := &corev2.AccessReview{User: "bob", Verb: "delete", Resource: "users", ResourceName: "alice"}
+	client.On("ReviewAccess", review).Return(&corev2.AccessReview{Reason: "no rule allows the request"}, nil)
+
+	cmd := CanICommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "tabular"))
+	require.NoError(t, cmd.Flags().Set("as", "bob"))
+	require.NoError(t, cmd.Flags().Set("cluster-wide", "true"))
+	out, err := test.RunCmd(cmd, []string{"delete", "users", "alice"})
+	require.NoError(t, err)
+	assert.Equal("no (no rule allows the request)\n", out)
+}
+
+func TestCanICommandRunMissingArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := CanICommand(cli)
+	out, err := test.RunCmd(cmd, []string{"create"})
+	require.Error(t, err)
+	assert.Contains(out, "Usage")
+}
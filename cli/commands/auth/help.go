@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new parent
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Inspect authorization",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(
+		CanICommand(cli),
+	)
+
+	return cmd
+}
@@ -83,6 +83,7 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 
 	cmd.Flags().StringP("command", "c", "", "the command the check should run")
 	cmd.Flags().String("cron", "", "the cron schedule at which the check is run")
+	cmd.Flags().String("cron-timezone", "", "the time zone in which the cron schedule is evaluated")
 	cmd.Flags().String("handlers", "", "comma separated list of handlers to invoke when check fails")
 	cmd.Flags().StringP("interval", "i", "", "interval, in seconds, at which the check is run")
 	cmd.Flags().StringP("runtime-assets", "r", "", "comma separated list of assets this check depends on")
@@ -92,11 +93,17 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.Flags().StringP("subscriptions", "s", "", "comma separated list of topics check requests will be sent to")
 	cmd.Flags().StringP("timeout", "t", "", "timeout, in seconds, at which the check has to run")
 	cmd.Flags().String("ttl", "", "time to live in seconds for which a check result is valid")
+	cmd.Flags().String("auto-resolve-after", "", "time in seconds after which a failing event is resolved if no check result is received")
 	cmd.Flags().String("high-flap-threshold", "", "flap detection high threshold (percent state change) for the check")
 	cmd.Flags().String("low-flap-threshold", "", "flap detection low threshold (percent state change) for the check")
 	cmd.Flags().String("output-metric-handlers", "", "comma separated list of handlers to set on output check metrics")
 	cmd.Flags().String("output-metric-format", "", "the output metric format to be used to parse check output for metric extraction")
 	cmd.Flags().Bool("round-robin", false, "enable round-robin scheduling")
+	cmd.Flags().Bool("agent-splay", false, "spread the executions of the check across the agents")
+	cmd.Flags().String("executor", "", "what executes the check, either \"agent\" (default) or \"backend\"")
+	cmd.Flags().String("probe-type", "", "type of the probe performed by the backend, one of \"http\", \"tcp\" or \"icmp\"")
+	cmd.Flags().String("probe-target", "", "URL, host:port address or host probed by the backend")
+	cmd.Flags().String("probe-expected-status", "", "HTTP status code expected from an http probe")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -51,6 +51,10 @@ func printToList(v interface{}, writer io.Writer) error {
 	if !ok {
 		return fmt.Errorf("%t is not a CheckConfig", v)
 	}
+	var probe string
+	if r.Probe != nil {
+		probe = fmt.Sprintf("%s %s", r.Probe.Type, r.Probe.Target)
+	}
 	cfg := &list.Config{
 		Title: r.Name,
 		Rows: []*list.Row{
@@ -66,10 +70,22 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Command",
 				Value: r.Command,
 			},
+			{
+				Label: "Executor",
+				Value: r.Executor,
+			},
+			{
+				Label: "Probe",
+				Value: probe,
+			},
 			{
 				Label: "Cron",
 				Value: r.Cron,
 			},
+			{
+				Label: "Cron Timezone",
+				Value: r.CronTimezone,
+			},
 			{
 				Label: "Timeout",
 				Value: strconv.FormatInt(int64(r.Timeout), 10),
@@ -78,6 +94,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "TTL",
 				Value: strconv.FormatInt(int64(r.Ttl), 10),
 			},
+			{
+				Label: "Auto Resolve After",
+				Value: strconv.FormatInt(r.AutoResolveAfter, 10),
+			},
 			{
 				Label: "Subscriptions",
 				Value: strings.Join(r.Subscriptions, ", "),
@@ -98,6 +118,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Publish?",
 				Value: strconv.FormatBool(r.Publish),
 			},
+			{
+				Label: "Agent Splay?",
+				Value: strconv.FormatBool(r.AgentSplay),
+			},
 			{
 				Label: "Stdin?",
 				Value: strconv.FormatBool(r.Stdin),
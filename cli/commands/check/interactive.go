@@ -3,6 +3,7 @@ package check
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey"
 	cron "github.com/robfig/cron/v3"
@@ -22,6 +23,7 @@ type checkOpts struct {
 	Command              string `survey:"command"`
 	Interval             string `survey:"interval"`
 	Cron                 string `survey:"cron"`
+	CronTimezone         string `survey:"cron-timezone"`
 	Subscriptions        string `survey:"subscriptions"`
 	Handlers             string `survey:"handlers"`
 	RuntimeAssets        string `survey:"assets"`
@@ -31,11 +33,17 @@ type checkOpts struct {
 	Stdin                string `survey:"stdin"`
 	Timeout              string `survey:"timeout"`
 	TTL                  string `survey:"ttl"`
+	AutoResolveAfter     string `survey:"auto-resolve-after"`
 	HighFlapThreshold    string `survey:"high-flap-threshold"`
 	LowFlapThreshold     string `survey:"low-flap-threshold"`
 	OutputMetricFormat   string `survey:"output-metric-format"`
 	OutputMetricHandlers string `survey:"output-metric-handlers"`
 	RoundRobin           string `survey:"round-robin"`
+	AgentSplay           string
+	Executor             string
+	ProbeType            string
+	ProbeTarget          string
+	ProbeExpectedStatus  string
 }
 
 func newCheckOpts() *checkOpts {
@@ -52,17 +60,26 @@ func (opts *checkOpts) withCheck(check *types.CheckConfig) {
 	opts.Command = check.Command
 	opts.Interval = strconv.Itoa(int(check.Interval))
 	opts.Cron = check.Cron
+	opts.CronTimezone = check.CronTimezone
 	opts.Subscriptions = strings.Join(check.Subscriptions, ",")
 	opts.Handlers = strings.Join(check.Handlers, ",")
 	opts.RuntimeAssets = strings.Join(check.RuntimeAssets, ",")
 	opts.ProxyEntityName = check.ProxyEntityName
 	opts.Stdin = strconv.FormatBool(check.Stdin)
 	opts.Timeout = strconv.Itoa(int(check.Timeout))
+	opts.AutoResolveAfter = strconv.FormatInt(check.AutoResolveAfter, 10)
 	opts.HighFlapThreshold = strconv.Itoa(int(check.HighFlapThreshold))
 	opts.LowFlapThreshold = strconv.Itoa(int(check.LowFlapThreshold))
 	opts.OutputMetricFormat = check.OutputMetricFormat
 	opts.OutputMetricHandlers = strings.Join(check.OutputMetricHandlers, ",")
 	opts.RoundRobin = strconv.FormatBool(check.RoundRobin)
+	opts.AgentSplay = strconv.FormatBool(check.AgentSplay)
+	opts.Executor = check.Executor
+	if check.Probe != nil {
+		opts.ProbeType = check.Probe.Type
+		opts.ProbeTarget = check.Probe.Target
+		opts.ProbeExpectedStatus = strconv.Itoa(int(check.Probe.ExpectedStatus))
+	}
 	opts.Publish = strconv.FormatBool(check.Publish)
 }
 
@@ -70,6 +87,7 @@ func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Command, _ = flags.GetString("command")
 	opts.Interval, _ = flags.GetString("interval")
 	opts.Cron, _ = flags.GetString("cron")
+	opts.CronTimezone, _ = flags.GetString("cron-timezone")
 	opts.Subscriptions, _ = flags.GetString("subscriptions")
 	opts.Handlers, _ = flags.GetString("handlers")
 	opts.RuntimeAssets, _ = flags.GetString("runtime-assets")
@@ -79,12 +97,19 @@ func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Stdin, _ = flags.GetString("stdin")
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.TTL, _ = flags.GetString("ttl")
+	opts.AutoResolveAfter, _ = flags.GetString("auto-resolve-after")
 	opts.HighFlapThreshold, _ = flags.GetString("high-flap-threshold")
 	opts.LowFlapThreshold, _ = flags.GetString("low-flap-threshold")
 	opts.OutputMetricFormat, _ = flags.GetString("output-metric-format")
 	opts.OutputMetricHandlers, _ = flags.GetString("output-metric-handlers")
 	roundRobinBool, _ := flags.GetBool("round-robin")
 	opts.RoundRobin = strconv.FormatBool(roundRobinBool)
+	agentSplayBool, _ := flags.GetBool("agent-splay")
+	opts.AgentSplay = strconv.FormatBool(agentSplayBool)
+	opts.Executor, _ = flags.GetString("executor")
+	opts.ProbeType, _ = flags.GetString("probe-type")
+	opts.ProbeTarget, _ = flags.GetString("probe-target")
+	opts.ProbeExpectedStatus, _ = flags.GetString("probe-expected-status")
 
 	if namespace := helpers.GetChangedStringValueFlag("namespace", flags); namespace != "" {
 		opts.Namespace = namespace
@@ -147,6 +172,22 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 				return nil
 			},
 		},
+		{
+			Name: "cron-timezone",
+			Prompt: &survey.Input{
+				Message: "Cron Timezone:",
+				Help:    "Optional IANA time zone name in which the cron schedule is evaluated, instead of the local time of the backend.",
+				Default: opts.CronTimezone,
+			},
+			Validate: func(val interface{}) error {
+				if val.(string) != "" {
+					if _, err := time.LoadLocation(val.(string)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
 		{
 			Name: "timeout",
 			Prompt: &survey.Input{
@@ -162,6 +203,14 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.TTL,
 			},
 		},
+		{
+			Name: "auto-resolve-after",
+			Prompt: &survey.Input{
+				Message: "Auto Resolve After:",
+				Help:    "Time in seconds after which a failing event is resolved if no check result is received",
+				Default: opts.AutoResolveAfter,
+			},
+		},
 		{
 			Name: "subscriptions",
 			Prompt: &survey.Input{
@@ -271,6 +320,7 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	stdin, _ := strconv.ParseBool(opts.Stdin)
 	timeout, _ := strconv.ParseUint(opts.Timeout, 10, 32)
 	ttl, _ := strconv.ParseInt(opts.TTL, 10, 64)
+	autoResolveAfter, _ := strconv.ParseInt(opts.AutoResolveAfter, 10, 64)
 	highFlap, _ := strconv.ParseUint(opts.HighFlapThreshold, 10, 32)
 	lowFlap, _ := strconv.ParseUint(opts.LowFlapThreshold, 10, 32)
 
@@ -279,6 +329,7 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	check.Interval = uint32(interval)
 	check.Command = opts.Command
 	check.Cron = opts.Cron
+	check.CronTimezone = opts.CronTimezone
 	check.Subscriptions = helpers.SafeSplitCSV(opts.Subscriptions)
 	check.Handlers = helpers.SafeSplitCSV(opts.Handlers)
 	check.RuntimeAssets = helpers.SafeSplitCSV(opts.RuntimeAssets)
@@ -287,6 +338,7 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	check.Stdin = stdin
 	check.Timeout = uint32(timeout)
 	check.Ttl = int64(ttl)
+	check.AutoResolveAfter = autoResolveAfter
 	check.HighFlapThreshold = uint32(highFlap)
 	check.LowFlapThreshold = uint32(lowFlap)
 	check.OutputMetricFormat = opts.OutputMetricFormat
@@ -295,4 +347,14 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	}
 	check.OutputMetricHandlers = helpers.SafeSplitCSV(opts.OutputMetricHandlers)
 	check.RoundRobin, _ = strconv.ParseBool(opts.RoundRobin)
+	check.AgentSplay, _ = strconv.ParseBool(opts.AgentSplay)
+	check.Executor = opts.Executor
+	if opts.ProbeType != "" || opts.ProbeTarget != "" {
+		expectedStatus, _ := strconv.ParseUint(opts.ProbeExpectedStatus, 10, 32)
+		check.Probe = &types.CheckProbe{
+			Type:           opts.ProbeType,
+			Target:         opts.ProbeTarget,
+			ExpectedStatus: uint32(expectedStatus),
+		}
+	}
 }
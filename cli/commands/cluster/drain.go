@@ -0,0 +1,43 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// DrainCommand asks the agents connected to the backend to reconnect to other
+// backends
+func DrainCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "drain",
+		Short:        "ask the agents connected to the backend to reconnect to other backends",
+		Long:         "Ask the agents connected to the backend serving the request to reconnect to other backends, each after a random delay up to the jitter, and refuse any new agent connection until the backend is restarted.",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			jitter, err := cmd.Flags().GetDuration("jitter")
+			if err != nil {
+				return err
+			}
+			if jitter < 0 {
+				return fmt.Errorf("invalid jitter: %s", jitter)
+			}
+			sessions, err := cli.Client.DrainBackend(jitter)
+			if err != nil {
+				return fmt.Errorf("error draining the backend: %s", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Asked %d agent(s) to reconnect to other backends\n", sessions)
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration("jitter", 30*time.Second, "maximum delay the agents wait before reconnecting, spreading their reconnections")
+	return cmd
+}
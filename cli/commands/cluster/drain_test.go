@@ -0,0 +1,41 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainCommand(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("DrainBackend", time.Minute).Return(3, nil)
+
+	cmd := DrainCommand(cli)
+	require.NoError(t, cmd.Flags().Set("jitter", "1m"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Asked 3 agent(s) to reconnect to other backends\n", out)
+}
+
+func TestDrainCommandError(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("DrainBackend", 30*time.Second).Return(0, errors.New("error"))
+
+	cmd := DrainCommand(cli)
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
+
+func TestDrainCommandWithArgs(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := DrainCommand(cli)
+	_, err := test.RunCmd(cmd, []string{"arg"})
+	assert.Error(t, err)
+}
@@ -19,6 +19,9 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		MemberRemoveCommand(cli),
 		HealthCommand(cli),
 		IDCommand(cli),
+		DrainCommand(cli),
+		SessionsCommand(cli),
+		RebalanceCommand(cli),
 	)
 
 	return cmd
@@ -0,0 +1,86 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/spf13/cobra"
+)
+
+// SessionsCommand lists the number of agent sessions of every backend
+func SessionsCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "sessions",
+		Short:        "list the number of agent sessions of every backend",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			result, err := cli.Client.FetchSessionCounts()
+			if err != nil {
+				return fmt.Errorf("error listing agent sessions: %s", err)
+			}
+			return helpers.Print(cmd, cli.Config.Format(), printSessionsToTable, nil, result)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printSessionsToTable(result interface{}, w io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Backend",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				sessions, ok := data.(actions.BackendSessions)
+				if !ok {
+					return cli.TypeError
+				}
+				return sessions.Backend
+			},
+		},
+		{
+			Title: "Sessions",
+			CellTransformer: func(data interface{}) string {
+				sessions, ok := data.(actions.BackendSessions)
+				if !ok {
+					return cli.TypeError
+				}
+				return strconv.Itoa(sessions.Sessions)
+			},
+		},
+	})
+
+	table.Render(w, result)
+}
+
+// RebalanceCommand balances the agent sessions across the backends
+func RebalanceCommand(cli *cli.SensuCli) *cobra.Command {
+	return &cobra.Command{
+		Use:          "rebalance",
+		Short:        "ask the backends to hand off the agent sessions exceeding their fair share",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			if err := cli.Client.Rebalance(); err != nil {
+				return fmt.Errorf("error rebalancing agent sessions: %s", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "Requested the rebalancing of the agent sessions")
+			return nil
+		},
+	}
+}
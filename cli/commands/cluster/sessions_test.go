@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionsCommand(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchSessionCounts").Return([]actions.BackendSessions{
+		{Backend: "backend-1", Sessions: 12},
+		{Backend: "backend-2", Sessions: 3},
+	}, nil)
+
+	cmd := SessionsCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "backend-1")
+	assert.Contains(t, out, "12")
+	assert.Contains(t, out, "backend-2")
+}
+
+func TestSessionsCommandJSON(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchSessionCounts").Return([]actions.BackendSessions{
+		{Backend: "backend-1", Sessions: 12},
+	}, nil)
+
+	cmd := SessionsCommand(cli)
+	require.NoError(t, cmd.Flags().Set("format", "json"))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, `"backend": "backend-1"`)
+}
+
+func TestSessionsCommandError(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("FetchSessionCounts").Return([]actions.BackendSessions(nil), errors.New("error"))
+
+	cmd := SessionsCommand(cli)
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
+
+func TestRebalanceCommand(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("Rebalance").Return(nil)
+
+	cmd := RebalanceCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "Requested the rebalancing of the agent sessions\n", out)
+}
+
+func TestRebalanceCommandError(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("Rebalance").Return(errors.New("error"))
+
+	cmd := RebalanceCommand(cli)
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
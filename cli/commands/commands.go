@@ -3,7 +3,9 @@ package commands
 import (
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/apikey"
+	"github.com/sensu/sensu-go/cli/commands/apply"
 	"github.com/sensu/sensu-go/cli/commands/asset"
+	"github.com/sensu/sensu-go/cli/commands/auth"
 	"github.com/sensu/sensu-go/cli/commands/check"
 	"github.com/sensu/sensu-go/cli/commands/cluster"
 	"github.com/sensu/sensu-go/cli/commands/clusterrole"
@@ -15,6 +17,7 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/create"
 	"github.com/sensu/sensu-go/cli/commands/delete"
 	"github.com/sensu/sensu-go/cli/commands/describetype"
+	"github.com/sensu/sensu-go/cli/commands/diff"
 	"github.com/sensu/sensu-go/cli/commands/dump"
 	"github.com/sensu/sensu-go/cli/commands/edit"
 	"github.com/sensu/sensu-go/cli/commands/entity"
@@ -26,10 +29,13 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/logout"
 	"github.com/sensu/sensu-go/cli/commands/mutator"
 	"github.com/sensu/sensu-go/cli/commands/namespace"
+	"github.com/sensu/sensu-go/cli/commands/prune"
+	"github.com/sensu/sensu-go/cli/commands/restore"
 	"github.com/sensu/sensu-go/cli/commands/role"
 	"github.com/sensu/sensu-go/cli/commands/rolebinding"
 	"github.com/sensu/sensu-go/cli/commands/silenced"
 	"github.com/sensu/sensu-go/cli/commands/tessen"
+	"github.com/sensu/sensu-go/cli/commands/top"
 	"github.com/sensu/sensu-go/cli/commands/user"
 	"github.com/spf13/cobra"
 )
@@ -38,12 +44,13 @@ import (
 func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 	rootCmd.AddCommand(
 		configure.Command(cli),
-		completion.Command(rootCmd),
+		completion.Command(rootCmd, cli),
 		env.Command(cli),
 		logout.Command(cli),
 
 		// Management Commands
 		asset.HelpCommand(cli),
+		auth.HelpCommand(cli),
 		apikey.HelpCommand(cli),
 		check.HelpCommand(cli),
 		config.HelpCommand(cli),
@@ -61,14 +68,19 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		user.HelpCommand(cli),
 		silenced.HelpCommand(cli),
 		create.CreateCommand(cli),
+		apply.ApplyCommand(cli),
 		delete.DeleteCommand(cli),
+		diff.Command(cli),
 		//extension.HelpCommand(cli),
 		cluster.HelpCommand(cli),
 		edit.Command(cli),
 		tessen.HelpCommand(cli),
 		dump.Command(cli),
+		restore.Command(cli),
+		prune.Command(cli),
 		command.HelpCommand(cli),
 		describetype.Command(cli),
+		top.Command(cli),
 	)
 
 	for _, cmd := range rootCmd.Commands() {
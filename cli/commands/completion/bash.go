@@ -1,6 +1,10 @@
 package completion
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+
 	"github.com/sensu/sensu-go/cli"
 	"github.com/spf13/cobra"
 )
@@ -23,9 +27,78 @@ source <(` + cli.SensuCmdName + ` completion bash)
 # You can source your ~/.bash_profile or launch a new terminal to utilize completion.
 source ~/.bash_profile
 	`
+
+	// bashNamesFunctions complete the names of resources, listed by the names
+	// command in the namespace given on the command line.
+	bashNamesFunctions = `
+__%[1]s_complete_names()
+{
+    local namespace i names prefix
+    namespace=()
+    for ((i=1; i < ${#words[@]}; i++)); do
+        case "${words[i]}" in
+            --namespace=*)
+                namespace=("${words[i]}")
+                ;;
+            --namespace)
+                namespace=(--namespace "${words[i+1]}")
+                ;;
+        esac
+    done
+    names=$(%[1]s completion names "${namespace[@]}" "$1" 2>/dev/null)
+    COMPREPLY=( $(compgen -W "${names}" -- "${cur}") )
+    # Bash completes the words after the colons of names such as silenced
+    # entries
+    if [[ "${cur}" == *:* && "${COMP_WORDBREAKS}" == *:* ]]; then
+        prefix="${cur%%"${cur##*:}"}"
+        for ((i=0; i < ${#COMPREPLY[@]}; i++)); do
+            COMPREPLY[i]="${COMPREPLY[i]#"${prefix}"}"
+        done
+    fi
+}
+
+__%[1]s_complete_namespaces()
+{
+    __%[1]s_complete_names namespaces
+}
+`
 )
 
 func genBashCompletion(rootCmd *cobra.Command) error {
 	stdout := rootCmd.OutOrStdout()
+	addBashCompletionFunction(rootCmd)
 	return rootCmd.GenBashCompletion(stdout)
 }
+
+// addBashCompletionFunction completes the names of the resources given as
+// arguments of the commands, and as value of the --namespace flag, in the bash
+// completion of rootCmd.
+func addBashCompletionFunction(rootCmd *cobra.Command) {
+	name := rootCmd.Name()
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, bashNamesFunctions, name)
+	fmt.Fprintf(buf, "\n__%s_custom_func()\n{\n    case ${last_command} in\n", name)
+	walk(rootCmd, func(cmd *cobra.Command) {
+		resources := argumentResources(cmd)
+		if len(resources) == 0 {
+			return
+		}
+		// cobra names the commands after their path, as in sensuctl_check_info
+		command := strings.Replace(strings.Replace(cmd.CommandPath(), " ", "_", -1), ":", "__", -1)
+		fmt.Fprintf(buf, "        %s)\n            case ${#nouns[@]} in\n", command)
+		for i, resource := range resources {
+			if resource != "" {
+				fmt.Fprintf(buf, "                %d)\n                    __%s_complete_names %s\n                    ;;\n", i, name, resource)
+			}
+		}
+		buf.WriteString("            esac\n            ;;\n")
+	})
+	buf.WriteString("    esac\n}\n")
+	rootCmd.BashCompletionFunction = buf.String()
+
+	if rootCmd.PersistentFlags().Lookup("namespace") != nil {
+		_ = rootCmd.PersistentFlags().SetAnnotation("namespace", cobra.BashCompCustom, []string{
+			fmt.Sprintf("__%s_complete_namespaces", name),
+		})
+	}
+}
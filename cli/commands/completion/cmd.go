@@ -21,18 +21,32 @@ For help using with ZSH:
 For help using with Bash:
 
     $ ` + cli.SensuCmdName + ` completion bash -h
+
+For help using with fish:
+
+    $ ` + cli.SensuCmdName + ` completion fish -h
+
+For help using with PowerShell:
+
+    $ ` + cli.SensuCmdName + ` completion powershell -h
+
+Besides the commands and their flags, the names of the resources given as
+arguments, such as the checks of '` + cli.SensuCmdName + ` check info', are completed from
+the API. The names are cached for a minute in the cache directory.
 	`
 
-	zshShell  = "zsh"
-	bashShell = "bash"
+	zshShell        = "zsh"
+	bashShell       = "bash"
+	fishShell       = "fish"
+	powershellShell = "powershell"
 )
 
 // Command defines new command to help installing completions in shell
-func Command(rootCmd *cobra.Command) *cobra.Command {
+func Command(rootCmd *cobra.Command, cli *cli.SensuCli) *cobra.Command {
 	exec := &completionExecutor{rootCmd: rootCmd}
 	cmd := &cobra.Command{
 		Use:   "completion",
-		Short: "Output shell completion code for the specified shell (bash or zsh)",
+		Short: "Output shell completion code for the specified shell (bash, zsh, fish or powershell)",
 		RunE:  exec.run,
 		Annotations: map[string]string{
 			// We want to be able to run this command regardless of whether the CLI
@@ -42,6 +56,7 @@ func Command(rootCmd *cobra.Command) *cobra.Command {
 	}
 
 	cmd.SetHelpFunc(exec.runHelp)
+	cmd.AddCommand(namesCommand(cli))
 
 	return cmd
 }
@@ -57,6 +72,10 @@ func (e *completionExecutor) run(cmd *cobra.Command, args []string) error {
 		return genZshCompletion(e.rootCmd)
 	} else if shell == bashShell {
 		return genBashCompletion(e.rootCmd)
+	} else if shell == fishShell {
+		return genFishCompletion(e.rootCmd)
+	} else if shell == powershellShell {
+		return genPowerShellCompletion(e.rootCmd)
 	} else if err != nil {
 		fmt.Fprintf(
 			cmd.OutOrStderr(),
@@ -80,6 +99,10 @@ func (e *completionExecutor) runHelp(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(stdErr, zshUsage)
 	} else if shell == bashShell {
 		fmt.Fprintln(stdErr, bashUsage)
+	} else if shell == fishShell {
+		fmt.Fprintln(stdErr, fishUsage)
+	} else if shell == powershellShell {
+		fmt.Fprintln(stdErr, powershellUsage)
 	} else {
 		fmt.Fprintln(stdErr, longUsage)
 	}
@@ -88,7 +111,7 @@ func (e *completionExecutor) runHelp(cmd *cobra.Command, args []string) {
 func extractShell(args []string, i int) (string, error) {
 	if len(args) > i {
 		shell := args[i]
-		if shell == zshShell || shell == bashShell {
+		if shell == zshShell || shell == bashShell || shell == fishShell || shell == powershellShell {
 			return shell, nil
 		}
 		return shell, fmt.Errorf("unknown shell: %q", shell)
@@ -12,7 +12,7 @@ func TestCommand(t *testing.T) {
 	assert := assert.New(t)
 
 	exCmd := &cobra.Command{}
-	cmd := Command(exCmd)
+	cmd := Command(exCmd, nil)
 
 	assert.NotNil(cmd, "cmd should be returned")
 	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
@@ -34,7 +34,16 @@ func newExecutorTest() *executorTest {
 		Short:        "sensuctl test test tests",
 		SilenceUsage: true,
 	}
-	test.cmd = Command(test.rootCmd)
+	test.rootCmd.PersistentFlags().String("namespace", "default", "namespace in which we perform actions")
+	check := &cobra.Command{Use: "check", Short: "Manage checks"}
+	info := &cobra.Command{Use: "info [NAME]", Short: "show detailed check information", Run: func(*cobra.Command, []string) {}}
+	info.Flags().String("format", "tabular", "format of data returned")
+	check.AddCommand(
+		info,
+		&cobra.Command{Use: "create [NAME]", Short: "create new checks", Run: func(*cobra.Command, []string) {}},
+	)
+	test.rootCmd.AddCommand(check)
+	test.cmd = Command(test.rootCmd, nil)
 	test.exec = &completionExecutor{rootCmd: test.rootCmd}
 
 	test.out = &exWriter{}
@@ -75,11 +84,52 @@ func TestRunWithArgBash(t *testing.T) {
 	assert.Contains(t, out, "_init_completion")
 }
 
-func TestRunWithBadArg(t *testing.T) {
+func TestRunWithArgBashNames(t *testing.T) {
+	test := newExecutorTest()
+	err := test.exec.run(test.cmd, []string{"bash"})
+	out := test.out.result
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "__sensuctl_custom_func")
+	assert.Contains(t, out, "sensuctl_check_info)")
+	assert.Contains(t, out, "__sensuctl_complete_names checks")
+	assert.NotContains(t, out, "sensuctl_check_create)")
+	assert.Contains(t, out, "__sensuctl_complete_namespaces")
+}
+
+func TestRunWithArgFish(t *testing.T) {
 	test := newExecutorTest()
 	err := test.exec.run(test.cmd, []string{"fish"})
 	out := test.out.result
 
+	require.NoError(t, err)
+	assert.Contains(t, out, "function __sensuctl_command_args")
+	assert.Contains(t, out, "set -l value_flags --namespace --format")
+	assert.Contains(t, out, "complete -c sensuctl -n '__sensuctl_command_args  | string match -q 0' -a check -d 'Manage checks'")
+	assert.Contains(t, out, "complete -c sensuctl -n '__sensuctl_command_args check | string match -q 0' -a info")
+	assert.Contains(t, out, "complete -c sensuctl -n '__sensuctl_command_args check info | string match -q 0' -a '(__sensuctl_names checks)'")
+	assert.Contains(t, out, "complete -c sensuctl -n '__sensuctl_command_args check info >/dev/null' -l format -r")
+	assert.Contains(t, out, "complete -c sensuctl -l namespace -x -a '(__sensuctl_names namespaces)'")
+}
+
+func TestRunWithArgPowerShell(t *testing.T) {
+	test := newExecutorTest()
+	err := test.exec.run(test.cmd, []string{"powershell"})
+	out := test.out.result
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Register-ArgumentCompleter -Native -CommandName 'sensuctl'")
+	assert.Contains(t, out, "'check' = @('create', 'info')")
+	assert.Contains(t, out, "'check info' = @('--format')")
+	assert.Contains(t, out, "'check info' = @('checks')")
+	assert.Contains(t, out, "$valueFlags = @('--namespace', '--format')")
+}
+
+func TestRunWithBadArg(t *testing.T) {
+	test := newExecutorTest()
+	err := test.exec.run(test.cmd, []string{"tcsh"})
+	out := test.out.result
+
 	require.NoError(t, err)
 	require.NotEmpty(t, out)
 	assert.Contains(t, out, "unknown shell")
@@ -116,11 +166,29 @@ func TestHelpWithBash(t *testing.T) {
 	assert.Contains(t, out, "bash_profile")
 }
 
-func TestHelpWithBadArg(t *testing.T) {
+func TestHelpWithFish(t *testing.T) {
 	test := newExecutorTest()
 	test.exec.runHelp(test.cmd, []string{"completion", "fish"})
 	out := test.out.result
 
+	require.NotEmpty(t, out)
+	assert.Contains(t, out, "config.fish")
+}
+
+func TestHelpWithPowerShell(t *testing.T) {
+	test := newExecutorTest()
+	test.exec.runHelp(test.cmd, []string{"completion", "powershell"})
+	out := test.out.result
+
+	require.NotEmpty(t, out)
+	assert.Contains(t, out, "$PROFILE")
+}
+
+func TestHelpWithBadArg(t *testing.T) {
+	test := newExecutorTest()
+	test.exec.runHelp(test.cmd, []string{"completion", "tcsh"})
+	out := test.out.result
+
 	require.NotEmpty(t, out)
 	assert.Contains(t, out, "unknown shell")
 	assert.Contains(t, out, "help")
@@ -0,0 +1,152 @@
+package completion
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	fishUsage = `
+# Add the following to your ~/.config/fish/config.fish
+` + cli.SensuCmdName + ` completion fish | source
+
+# Or save the completion in the completions directory of fish
+` + cli.SensuCmdName + ` completion fish > ~/.config/fish/completions/` + cli.SensuCmdName + `.fish
+	`
+
+	fishFunctions = `# __%[1]s_command_args succeeds if the command line runs the command of the
+# given path, and prints the number of its arguments.
+function __%[1]s_command_args
+    set -l value_flags %[2]s
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    set -l words
+    set -l skip
+    for token in $tokens
+        if test -n "$skip"
+            set skip
+        else if contains -- $token $value_flags
+            set skip 1
+        else if not string match -q -- '-*' $token
+            set words $words $token
+        end
+    end
+    test (count $words) -ge (count $argv); or return 1
+    set -l i 1
+    for arg in $argv
+        test "$words[$i]" = "$arg"; or return 1
+        set i (math $i + 1)
+    end
+    math (count $words) - (count $argv)
+end
+
+# __%[1]s_names prints the names of the resources of the given type, in the
+# namespace given on the command line.
+function __%[1]s_names
+    set -l namespace
+    set -l next
+    for token in (commandline -opc)
+        if test -n "$next"
+            set namespace --namespace $token
+            set next
+        else if string match -q -- '--namespace=*' $token
+            set namespace $token
+        else if test "$token" = --namespace
+            set next 1
+        end
+    end
+    %[1]s completion names $namespace $argv 2>/dev/null
+end
+
+complete -c %[1]s -f
+`
+)
+
+func genFishCompletion(rootCmd *cobra.Command) error {
+	name := rootCmd.Name()
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, fishFunctions, name, strings.Join(valueFlags(rootCmd), " "))
+
+	// The flags of the root command apply to every command
+	rootCmd.PersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		fishFlag(buf, name, "", flag)
+	})
+	walk(rootCmd, func(cmd *cobra.Command) {
+		path := commandPath(cmd)
+		args := fmt.Sprintf("__%s_command_args %s", name, path)
+		for _, c := range cmd.Commands() {
+			if c.IsAvailableCommand() {
+				fmt.Fprintf(buf, "complete -c %s -n %s -a %s -d %s\n", name, fishQuote(args+" | string match -q 0"), c.Name(), fishQuote(c.Short))
+			}
+		}
+		for i, resource := range argumentResources(cmd) {
+			if resource != "" {
+				fmt.Fprintf(buf, "complete -c %s -n %s -a %s\n", name, fishQuote(fmt.Sprintf("%s | string match -q %d", args, i)), fishQuote(fmt.Sprintf("(__%s_names %s)", name, resource)))
+			}
+		}
+		if cmd.HasParent() {
+			cmd.NonInheritedFlags().VisitAll(func(flag *pflag.Flag) {
+				fishFlag(buf, name, args+" >/dev/null", flag)
+			})
+		}
+	})
+
+	_, err := buf.WriteTo(rootCmd.OutOrStdout())
+	return err
+}
+
+// fishFlag writes the completion of flag, when the given condition succeeds.
+func fishFlag(buf *bytes.Buffer, name, condition string, flag *pflag.Flag) {
+	if flag.Hidden {
+		return
+	}
+	fmt.Fprintf(buf, "complete -c %s", name)
+	if condition != "" {
+		fmt.Fprintf(buf, " -n %s", fishQuote(condition))
+	}
+	fmt.Fprintf(buf, " -l %s", flag.Name)
+	if flag.Shorthand != "" {
+		fmt.Fprintf(buf, " -s %s", flag.Shorthand)
+	}
+	if flag.Name == "namespace" {
+		fmt.Fprintf(buf, " -x -a %s", fishQuote(fmt.Sprintf("(__%s_names namespaces)", name)))
+	} else if flag.NoOptDefVal == "" {
+		buf.WriteString(" -r")
+	}
+	fmt.Fprintf(buf, " -d %s\n", fishQuote(flag.Usage))
+}
+
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// valueFlags returns the flags of the commands that take a value, such as
+// --namespace, so that the completion scripts skip their values.
+func valueFlags(rootCmd *cobra.Command) []string {
+	seen := map[string]bool{}
+	var result []string
+	add := func(flag *pflag.Flag) {
+		if flag.NoOptDefVal != "" {
+			return
+		}
+		names := []string{"--" + flag.Name}
+		if flag.Shorthand != "" {
+			names = append(names, "-"+flag.Shorthand)
+		}
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				result = append(result, name)
+			}
+		}
+	}
+	walk(rootCmd, func(cmd *cobra.Command) {
+		cmd.NonInheritedFlags().VisitAll(add)
+	})
+	return result
+}
@@ -0,0 +1,183 @@
+package completion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// cacheTTL is the duration during which the names of the resources are read
+// from the cache rather than from the API.
+const cacheTTL = time.Minute
+
+// resourceTypes maps the management commands to the type of the resources
+// they manage.
+var resourceTypes = map[string]string{
+	"api-key":              "apikeys",
+	"asset":                "assets",
+	"check":                "checks",
+	"cluster-role":         "clusterroles",
+	"cluster-role-binding": "clusterrolebindings",
+	"entity":               "entities",
+	"filter":               "filters",
+	"handler":              "handlers",
+	"hook":                 "hooks",
+	"mutator":              "mutators",
+	"namespace":            "namespaces",
+	"role":                 "roles",
+	"role-binding":         "rolebindings",
+	"silenced":             "silenced",
+	"user":                 "users",
+}
+
+// argumentTypes maps the placeholders of the usage lines to the type of the
+// resources they name, other than the resources managed by the parent
+// command.
+var argumentTypes = map[string]string{
+	"CHECK":     "checks",
+	"ENTITY":    "entities",
+	"NAMESPACE": "namespaces",
+	"USERNAME":  "users",
+}
+
+// newResourceCommands are the commands whose arguments name resources that do
+// not exist yet.
+var newResourceCommands = map[string]bool{
+	"add":    true,
+	"bundle": true,
+	"create": true,
+}
+
+// argumentResources returns the types of the resources named by the
+// arguments of cmd, according to the placeholders of its usage line, as in
+// "info [NAME]". The type of the arguments naming no resource is empty.
+func argumentResources(cmd *cobra.Command) []string {
+	if !cmd.HasParent() || newResourceCommands[cmd.Name()] {
+		return nil
+	}
+	var result []string
+	named := false
+	for _, field := range strings.Fields(cmd.Use)[1:] {
+		placeholder := strings.ToUpper(strings.TrimSuffix(strings.TrimPrefix(field, "["), "]"))
+		if strings.IndexFunc(placeholder, func(r rune) bool { return r < 'A' || r > 'Z' }) >= 0 {
+			break
+		}
+		t := argumentTypes[placeholder]
+		if placeholder == "NAME" || placeholder == "ID" {
+			t = resourceTypes[cmd.Parent().Name()]
+		}
+		named = named || t != ""
+		result = append(result, t)
+	}
+	if !named {
+		return nil
+	}
+	return result
+}
+
+// walk calls fn for cmd and its available subcommands, recursively.
+func walk(cmd *cobra.Command, fn func(*cobra.Command)) {
+	fn(cmd)
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() {
+			walk(c, fn)
+		}
+	}
+}
+
+// commandPath returns the path of cmd from the root command, as in
+// "check info".
+func commandPath(cmd *cobra.Command) string {
+	return strings.TrimSpace(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()))
+}
+
+// namesCommand prints the names of resources for the completion scripts.
+func namesCommand(cli *cli.SensuCli) *cobra.Command {
+	return &cobra.Command{
+		Use:    "names [TYPE]",
+		Short:  "Print the names of the resources of the given type, for shell completion",
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			cacheDir := ""
+			if flag := cmd.Flags().Lookup("cache-dir"); flag != nil {
+				cacheDir = flag.Value.String()
+			}
+			names, err := cachedNames(cli, cacheDir, args[0])
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				fmt.Fprintln(cmd.OutOrStdout(), name)
+			}
+			return nil
+		},
+	}
+}
+
+// cachedNames returns the names of the resources of the given type in the
+// configured namespace, from the cache of cacheDir if they were listed less
+// than cacheTTL ago. The names are not cached if cacheDir is empty.
+func cachedNames(cli *cli.SensuCli, cacheDir, resourceType string) ([]string, error) {
+	requests, err := resource.GetResourceRequests(resourceType, resource.All)
+	if err != nil {
+		return nil, err
+	}
+	namespace := cli.Config.Namespace()
+
+	var path string
+	if cacheDir != "" {
+		// The names are cached per cluster and namespace
+		sum := sha256.Sum256([]byte(cli.Config.APIUrl() + "\n" + namespace))
+		var name []string
+		for _, req := range requests {
+			name = append(name, req.RBACName())
+		}
+		path = filepath.Join(cacheDir, "completion", hex.EncodeToString(sum[:8]), strings.Join(name, ","))
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < cacheTTL {
+			if b, err := ioutil.ReadFile(path); err == nil {
+				return strings.Fields(string(b)), nil
+			}
+		}
+	}
+
+	var names []string
+	for _, req := range requests {
+		req.SetNamespace(namespace)
+		val := reflect.New(reflect.SliceOf(reflect.TypeOf(req)))
+		if err := cli.Client.List(req.URIPath(), val.Interface(), &client.ListOptions{}, nil); err != nil {
+			return nil, err
+		}
+		val = reflect.Indirect(val)
+		for i := 0; i < val.Len(); i++ {
+			names = append(names, val.Index(i).Interface().(types.Resource).GetObjectMeta().Name)
+		}
+	}
+	sort.Strings(names)
+
+	if path != "" {
+		// The completion does not depend on the cache, so failing to write it
+		// is ignored
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+			_ = ioutil.WriteFile(path, []byte(strings.Join(names, "\n")), 0600)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,79 @@
+package completion
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgumentResources(t *testing.T) {
+	testCases := []struct {
+		parent   string
+		use      string
+		expected []string
+	}{
+		{"check", "info [NAME]", []string{"checks"}},
+		{"handler", "info [ID]", []string{"handlers"}},
+		{"silenced", "info [Name]", []string{"silenced"}},
+		{"check", "create [NAME]", nil},
+		{"check", "list", nil},
+		{"event", "info [ENTITY] [CHECK]", []string{"entities", "checks"}},
+		{"user", "remove-group [USERNAME] [group]", []string{"users", ""}},
+		{"user", "set-groups USERNAME GROUP1[,GROUP2, ...[,GROUPN]]", []string{"users"}},
+		{"config", "set-format [FORMAT]", nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.parent+" "+tc.use, func(t *testing.T) {
+			parent := &cobra.Command{Use: tc.parent}
+			cmd := &cobra.Command{Use: tc.use}
+			parent.AddCommand(cmd)
+			assert.Equal(t, tc.expected, argumentResources(cmd))
+		})
+	}
+}
+
+func TestNamesCommand(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("List", "/api/core/v2/namespaces/default/checks", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		checks := args.Get(1).(*[]*corev2.CheckConfig)
+		*checks = []*corev2.CheckConfig{corev2.FixtureCheckConfig("check-disk"), corev2.FixtureCheckConfig("check-cpu")}
+	}).Return(nil)
+
+	out, err := test.RunCmd(namesCommand(cli), []string{"checks"})
+	require.NoError(t, err)
+	assert.Equal(t, "check-cpu\ncheck-disk\n", out)
+
+	_, err = test.RunCmd(namesCommand(cli), []string{"unknown"})
+	assert.Error(t, err)
+}
+
+func TestCachedNames(t *testing.T) {
+	cacheDir, remove := testutil.TempDir(t)
+	defer remove()
+
+	cli := test.NewCLI()
+	cli.Config.(*clienttest.MockConfig).On("APIUrl").Return("http://127.0.0.1:8080")
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("List", "/api/core/v2/namespaces", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		namespaces := args.Get(1).(*[]*corev2.Namespace)
+		*namespaces = []*corev2.Namespace{corev2.FixtureNamespace("default"), corev2.FixtureNamespace("dev")}
+	}).Return(nil)
+
+	names, err := cachedNames(cli, cacheDir, "namespaces")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "dev"}, names)
+
+	// The second completion reads the names from the cache
+	names, err = cachedNames(cli, cacheDir, "namespaces")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "dev"}, names)
+	client.AssertNumberOfCalls(t, "List", 1)
+}
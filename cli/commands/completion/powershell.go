@@ -0,0 +1,147 @@
+package completion
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	powershellUsage = `
+# Add the following to your PowerShell profile, as given by $PROFILE
+` + cli.SensuCmdName + ` completion powershell | Out-String | Invoke-Expression
+	`
+
+	powershellScript = `Register-ArgumentCompleter -Native -CommandName '%[1]s' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+
+    # The subcommands, the flags and the types of the resources named by the
+    # arguments of the commands, by command path
+    $commands = @{
+%[2]s    }
+    $flags = @{
+%[3]s    }
+    $resources = @{
+%[4]s    }
+    $valueFlags = %[5]s
+
+    # The words before the word to complete, without the flags and their values
+    $elements = @($commandAst.CommandElements | Select-Object -Skip 1 | ForEach-Object { $_.Extent.Text })
+    if ($wordToComplete -ne '') {
+        $elements = @($elements | Select-Object -SkipLast 1)
+    }
+    $words = @()
+    $namespace = @()
+    $skip = $false
+    $previous = ''
+    foreach ($element in $elements) {
+        if ($skip) {
+            $skip = $false
+            if ($previous -eq '--namespace') {
+                $namespace = @('--namespace', $element)
+            }
+        } elseif ($element -like '--namespace=*') {
+            $namespace = @($element)
+        } elseif ($valueFlags -contains $element) {
+            $skip = $true
+        } elseif (-not $element.StartsWith('-')) {
+            $words += $element
+        }
+        $previous = $element
+    }
+
+    # The command, and the number of its arguments
+    $path = ''
+    $arguments = 0
+    foreach ($word in $words) {
+        if ($arguments -eq 0 -and $commands.ContainsKey($path) -and $commands[$path] -contains $word) {
+            $path = "$path $word".Trim()
+        } else {
+            $arguments++
+        }
+    }
+
+    $candidates = @()
+    if ($skip) {
+        if ($previous -eq '--namespace') {
+            $candidates = @(& '%[1]s' completion names namespaces 2>$null)
+        }
+    } elseif ($wordToComplete.StartsWith('-')) {
+        $candidates = @($flags['']) + @($flags[$path])
+    } else {
+        if ($arguments -eq 0 -and $commands.ContainsKey($path)) {
+            $candidates = @($commands[$path])
+        }
+        if ($resources.ContainsKey($path) -and $arguments -lt $resources[$path].Count -and $resources[$path][$arguments] -ne '') {
+            $candidates += @(& '%[1]s' completion names @namespace $resources[$path][$arguments] 2>$null)
+        }
+    }
+    $candidates | Where-Object { $_ -and $_ -like "$wordToComplete*" } | ForEach-Object {
+        [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)
+    }
+}
+`
+)
+
+func genPowerShellCompletion(rootCmd *cobra.Command) error {
+	commands := new(bytes.Buffer)
+	flags := new(bytes.Buffer)
+	resources := new(bytes.Buffer)
+	walk(rootCmd, func(cmd *cobra.Command) {
+		path := powershellQuote(commandPath(cmd))
+		var subcommands []string
+		for _, c := range cmd.Commands() {
+			if c.IsAvailableCommand() {
+				subcommands = append(subcommands, c.Name())
+			}
+		}
+		if len(subcommands) > 0 {
+			fmt.Fprintf(commands, "        %s = %s\n", path, powershellArray(subcommands))
+		}
+
+		// The flags of the root command, under the empty path, apply to every
+		// command
+		var names []string
+		cmd.NonInheritedFlags().VisitAll(func(flag *pflag.Flag) {
+			if !flag.Hidden {
+				names = append(names, "--"+flag.Name)
+			}
+		})
+		if len(names) > 0 {
+			sort.Strings(names)
+			fmt.Fprintf(flags, "        %s = %s\n", path, powershellArray(names))
+		}
+
+		if types := argumentResources(cmd); len(types) > 0 {
+			fmt.Fprintf(resources, "        %s = %s\n", path, powershellArray(types))
+		}
+	})
+
+	_, err := fmt.Fprintf(
+		rootCmd.OutOrStdout(),
+		powershellScript,
+		rootCmd.Name(),
+		commands,
+		flags,
+		resources,
+		powershellArray(valueFlags(rootCmd)),
+	)
+	return err
+}
+
+func powershellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+func powershellArray(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, powershellQuote(value))
+	}
+	return "@(" + strings.Join(quoted, ", ") + ")"
+}
@@ -167,6 +167,7 @@ func genZshCompletion(rootCmd *cobra.Command) error {
 	stdout := rootCmd.OutOrStdout()
 
 	bashCompletionBuf := new(bytes.Buffer)
+	addBashCompletionFunction(rootCmd)
 	if err := rootCmd.GenBashCompletion(bashCompletionBuf); err != nil {
 		return err
 	}
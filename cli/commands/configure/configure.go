@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey"
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	config "github.com/sensu/sensu-go/cli/client/config"
 	hooks "github.com/sensu/sensu-go/cli/commands/hooks"
@@ -33,7 +34,8 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 		PreRun: func(cmd *cobra.Command, args []string) {
 			flags := cmd.Flags()
 			nonInteractive, _ := flags.GetBool("non-interactive")
-			if nonInteractive {
+			useOIDC, _ := flags.GetBool("oidc")
+			if nonInteractive && !useOIDC {
 				// Mark flags are required for bash-completions
 				_ = cmd.MarkFlagRequired("username")
 				_ = cmd.MarkFlagRequired("password")
@@ -52,12 +54,17 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 				return err
 			}
 
+			useOIDC, err := flags.GetBool("oidc")
+			if err != nil {
+				return err
+			}
+
 			answers := &configureAnswers{}
 
 			if nonInteractive {
 				answers.withFlags(flags)
 			} else {
-				if err = answers.administerQuestionnaire(cli.Config); err != nil {
+				if err = answers.administerQuestionnaire(cli.Config, useOIDC); err != nil {
 					return err
 				}
 			}
@@ -72,9 +79,15 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 			}
 
 			// Authenticate
-			tokens, err := cli.Client.CreateAccessToken(
-				answers.URL, answers.Username, answers.Password,
-			)
+			var tokens *corev2.Tokens
+			if useOIDC {
+				port, _ := flags.GetInt("oidc-callback-port")
+				tokens, err = oidcLogin(cmd.OutOrStdout(), cli.Client, answers.URL, port)
+			} else {
+				tokens, err = cli.Client.CreateAccessToken(
+					answers.URL, answers.Username, answers.Password,
+				)
+			}
 			if err != nil {
 				fmt.Fprintln(cmd.OutOrStderr())
 				return fmt.Errorf("unable to authenticate with error: %s", err)
@@ -160,6 +173,8 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().StringP("url", "", cli.Config.APIUrl(), "the sensu backend url")
 	_ = cmd.Flags().StringP("username", "", "", "username")
 	_ = cmd.Flags().StringP("password", "", "", "password")
+	_ = cmd.Flags().Bool("oidc", false, "log in with the OIDC provider of the backend instead of a username and password")
+	_ = cmd.Flags().Int("oidc-callback-port", 0, "port of the local server receiving the OIDC login callback (random if 0)")
 	_ = cmd.Flags().StringP("format", "", cli.Config.Format(), "preferred output format")
 	_ = cmd.Flags().StringP("namespace", "", cli.Config.Namespace(), "namespace")
 	_ = cmd.Flags().DurationP("timeout", "", cli.Config.Timeout(), "timeout when communicating with backend url")
@@ -167,14 +182,13 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 	return cmd
 }
 
-func (answers *configureAnswers) administerQuestionnaire(c config.Config) error {
-	qs := []*survey.Question{
-		askForURL(c),
-		askForUsername(),
-		askForPassword(),
-		askForNamespace(c),
-		askForDefaultFormat(c),
+func (answers *configureAnswers) administerQuestionnaire(c config.Config, useOIDC bool) error {
+	qs := []*survey.Question{askForURL(c)}
+	// The users of the OIDC provider log in with the provider itself
+	if !useOIDC {
+		qs = append(qs, askForUsername(), askForPassword())
 	}
+	qs = append(qs, askForNamespace(c), askForDefaultFormat(c))
 
 	return survey.Ask(qs, answers)
 }
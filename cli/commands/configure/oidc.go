@@ -0,0 +1,139 @@
+package configure
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/authentication/providers/oidc"
+	"github.com/sensu/sensu-go/cli/client"
+)
+
+// oidcLoginTimeout is the time given to the user to log in with the provider.
+const oidcLoginTimeout = 5 * time.Minute
+
+// oidcLogin logs in with the OIDC provider of the backend at the given URL,
+// using the authorization code flow with PKCE, and exchanges the ID token
+// issued by the provider for Sensu tokens. The provider redirects the browser
+// of the user to a local server listening on the given port, or a random port
+// if 0.
+func oidcLogin(out io.Writer, c client.AuthenticationAPIClient, backendURL string, port int) (*corev2.Tokens, error) {
+	config, err := c.FetchOIDCConfig(backendURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the oidc configuration of the backend: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), oidcLoginTimeout)
+	defer cancel()
+
+	discovery, err := oidc.Discover(ctx, http.DefaultClient, config.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+
+	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("could not listen for the oidc callback: %s", err)
+	}
+	redirectURI := fmt.Sprintf("http://%s/callback", ln.Addr())
+
+	codes := make(chan string, 1)
+	errs := make(chan error, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/callback" {
+				http.NotFound(w, r)
+				return
+			}
+			query := r.URL.Query()
+			if query.Get("state") != state {
+				http.Error(w, "invalid state", http.StatusBadRequest)
+				return
+			}
+			if e := query.Get("error"); e != "" {
+				http.Error(w, "login failed: "+e, http.StatusUnauthorized)
+				select {
+				case errs <- fmt.Errorf("login failed: %s %s", e, query.Get("error_description")):
+				default:
+				}
+				return
+			}
+			fmt.Fprintln(w, "You can close this page and return to sensuctl.")
+			select {
+			case codes <- query.Get("code"):
+			default:
+			}
+		}),
+	}
+	go func() { _ = server.Serve(ln) }()
+	defer server.Close()
+
+	authorizeURL := discovery.AuthorizationEndpoint + "?" + url.Values{
+		"response_type":         {"code"},
+		"client_id":             {config.ClientID},
+		"redirect_uri":          {redirectURI},
+		"scope":                 {strings.Join(config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+	fmt.Fprintf(out, "Open the following URL in your browser to log in:\n\n%s\n\n", authorizeURL)
+
+	var code string
+	select {
+	case code = <-codes:
+	case err := <-errs:
+		return nil, err
+	case <-ctx.Done():
+		return nil, errors.New("timed out waiting for the login")
+	}
+
+	tokens, err := discovery.Exchange(ctx, http.DefaultClient, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {config.ClientID},
+		"code_verifier": {verifier},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the tokens of the provider: %s", err)
+	}
+	if tokens.IDToken == "" {
+		return nil, errors.New("the provider did not issue an id token")
+	}
+
+	return c.CreateAccessTokenFromIDToken(backendURL, tokens.IDToken, nonce, tokens.RefreshToken)
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
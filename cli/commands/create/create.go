@@ -19,6 +19,9 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 
 	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs to create resources from")
 	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("bulk", false, "Create or replace the resources with bulk requests")
+	_ = cmd.Flags().Bool("diff", false, "Print the changes the resources make to the cluster, field by field")
+	_ = cmd.Flags().Bool("dry-run", false, "Validate the resources without creating or replacing them")
 
 	return cmd
 }
@@ -36,7 +39,23 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 		if err != nil {
 			return err
 		}
-		processor := resource.NewPutter()
+		bulk, err := cmd.Flags().GetBool("bulk")
+		if err != nil {
+			return err
+		}
+		var processor resource.Processor = resource.NewPutter()
+		if bulk {
+			processor = resource.NewBulkPutter()
+		}
+		diff, err := cmd.Flags().GetBool("diff")
+		if err != nil {
+			return err
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		processor = resource.Preview(cmd.OutOrStdout(), diff, dryRun, false, processor)
 		if len(inputs) == 0 {
 			return resource.ProcessStdin(cli, client, processor)
 		}
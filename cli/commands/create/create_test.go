@@ -178,3 +178,27 @@ func TestCreateCommandStdin(t *testing.T) {
 	client.AssertCalled(t, "PutResource", mock.Anything)
 	client.AssertCalled(t, "PutResource", mock.Anything)
 }
+
+func TestCreateCommandDryRun(t *testing.T) {
+	cli := cmdtesting.NewMockCLI()
+	client := cli.Client.(*mockclient.MockClient)
+	client.On("Get", mock.Anything, mock.Anything).Return(nil)
+	client.On("DryRunResource", mock.Anything, false).Return(fixtureCheck, nil)
+
+	cmd := CreateCommand(cli)
+	td, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+
+	fp := filepath.Join(td, "input")
+	require.NoError(t, ioutil.WriteFile(fp, []byte(`{"type": "Check", "spec": `+resources.Check+`}`), 0600))
+
+	require.NoError(t, cmd.Flags().Set("file", fp))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+	out, err := cmdtesting.RunCmd(cmd, nil)
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	client.AssertNumberOfCalls(t, "DryRunResource", 1)
+	client.AssertNotCalled(t, "PutResource", mock.Anything)
+}
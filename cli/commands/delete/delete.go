@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/client"
@@ -22,6 +23,7 @@ func DeleteCommand(cli *cli.SensuCli) *cobra.Command {
 	}
 
 	_ = cmd.Flags().StringP("file", "f", "", "File to delete resources from")
+	_ = cmd.Flags().Bool("bulk", false, "Delete the resources with bulk requests")
 
 	return cmd
 }
@@ -51,6 +53,14 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 			return err
 		}
 
+		bulk, err := cmd.Flags().GetBool("bulk")
+		if err != nil {
+			return err
+		}
+		if bulk {
+			return resource.Bulk(cli.Client, http.MethodDelete, resources)
+		}
+
 		return DeleteResources(cli.Client, resources)
 	}
 }
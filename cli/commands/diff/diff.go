@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+var description = `sensuctl diff
+
+Print the changes the resources of files or URLs (path, file://, http[s]://),
+or STDIN otherwise, would make to the cluster, field by field, without making
+them. The resources are validated by the API as if they were created with
+'sensuctl create', or merged with 'sensuctl apply' with --apply. Example:
+$ sensuctl diff -f checks.yml
+~ core/v2.CheckConfig default/check-cpu
+  ~ interval: 60 => 30
++ core/v2.Handler default/slack
+
+The new resources are prefixed with +, the modified resources with ~ and the
+unchanged resources with =.
+`
+
+// Command prints the changes resources would make to the cluster.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "diff [-r] [--apply] [[-f URL] ... ]",
+		Long: description,
+		RunE: execute(cli),
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs of the resources to compare")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().Bool("apply", false, "Compare the resources as merged by sensuctl apply rather than replaced by sensuctl create")
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		client := &http.Client{Transport: t}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		apply, err := cmd.Flags().GetBool("apply")
+		if err != nil {
+			return err
+		}
+		processor := resource.NewDiffer(cmd.OutOrStdout(), apply, nil)
+		if len(inputs) == 0 {
+			return resource.ProcessStdin(cli, client, processor)
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		for _, input := range inputs {
+			if err := resource.Process(cli, client, input, recurse, processor); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("diff", cmd.Use)
+	assert.NotNil(cmd.Flag("file"))
+	assert.NotNil(cmd.Flag("apply"))
+}
+
+func TestDiff(t *testing.T) {
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+	path := filepath.Join(tmpDir, "checks.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`type: CheckConfig
+api_version: core/v2
+metadata:
+  name: check-cpu
+spec:
+  command: command
+  interval: 30
+  subscriptions: [linux]
+`), 0600))
+
+	existing := corev2.FixtureCheckConfig("check-cpu")
+	changed := corev2.FixtureCheckConfig("check-cpu")
+	changed.Interval = 30
+
+	cli := test.NewCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("Get", existing.URIPath(), mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(1).(*corev2.CheckConfig) = *existing
+	}).Return(nil)
+	client.On("DryRunResource", mock.Anything, false).Return(changed, nil)
+
+	cmd := Command(cli)
+	require.NoError(t, cmd.Flags().Set("file", path))
+	out, err := test.RunCmd(cmd, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, "~ core/v2.CheckConfig default/check-cpu\n  ~ interval: 60 => 30\n", out)
+	client.AssertNotCalled(t, "PutResource", mock.Anything)
+}
@@ -35,6 +35,10 @@ $ sensuctl dump core/v2.CheckConfig,core/v2.Entity
 
 You can also use the 'all' qualifier to dump all supported resources:
 $ sensuctl dump all
+
+The resources of every namespace can be dumped to a versioned archive, which
+can be restored into another cluster with 'sensuctl restore':
+$ sensuctl dump all --all-namespaces --archive -f backup.json
 `
 
 // Command dumps generic Sensu resources to a file or STDOUT.
@@ -52,6 +56,7 @@ func Command(cli *cli.SensuCli) *cobra.Command {
 	}
 	_ = cmd.Flags().StringP("format", "", format, fmt.Sprintf(`format of data returned ("%s"|"%s")`, config.FormatWrappedJSON, config.FormatYAML))
 	_ = cmd.Flags().StringP("file", "f", "", "file to dump resources to")
+	_ = cmd.Flags().Bool("archive", false, "dump resources to a versioned archive, which can be restored with sensuctl restore")
 	_ = cmd.Flags().BoolP("types", "t", false, "list supported resource types")
 	_ = cmd.Flags().MarkDeprecated("types", `please use "sensuctl describe-type all" instead`)
 
@@ -111,6 +116,11 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 		default:
 			format = config.FormatYAML
 		}
+		archive, err := cmd.Flags().GetBool("archive")
+		if err != nil {
+			return err
+		}
+		var archived []*types.Wrapper
 
 		// parse the comma separated resource types and match against the defined actions
 		requests, err := resource.GetResourceRequests(args[0], resource.All)
@@ -175,6 +185,14 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 				resources[i] = val.Index(i).Interface().(corev2.Resource)
 			}
 
+			if archive {
+				for _, r := range resources {
+					wrapped := types.WrapResource(r)
+					archived = append(archived, &wrapped)
+				}
+				continue
+			}
+
 			switch format {
 			case config.FormatJSON:
 				err = helpers.PrintJSON(resources, w)
@@ -194,6 +212,9 @@ func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
 			}
 		}
 
+		if archive {
+			return resource.WriteArchive(w, resource.NewArchive(archived))
+		}
 		return nil
 	}
 }
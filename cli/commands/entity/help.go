@@ -19,6 +19,7 @@ func HelpCommand(cli *cli.SensuCli) *cobra.Command {
 		ListCommand(cli),
 		InfoCommand(cli),
 		UpdateCommand(cli),
+		SyncInventoryCommand(cli),
 	)
 
 	return cmd
@@ -96,8 +96,8 @@ func printToList(v interface{}, writer io.Writer) error {
 				Value: globals.BooleanStyleP(r.Deregister),
 			},
 			{
-				Label: "Deregistration Handler",
-				Value: r.Deregistration.Handler,
+				Label: "Deregistration Handlers",
+				Value: strings.Join(r.Deregistration.AllHandlers(), ", "),
 			},
 		},
 	}
@@ -0,0 +1,217 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/inventory"
+	utilstrings "github.com/sensu/sensu-go/util/strings"
+	"github.com/spf13/cobra"
+)
+
+// InventoryLabel is the label of the proxy entities imported from a cloud
+// provider inventory, set to the source of the inventory
+const InventoryLabel = "sensu.io/inventory"
+
+// syncOpts are the options of an inventory sync
+type syncOpts struct {
+	// tags are the instance tags copied to the entity labels, all of them if
+	// empty
+	tags []string
+
+	// subscriptions are the subscriptions of the entities created
+	subscriptions []string
+
+	// prune deletes the entities imported from the inventory whose instance
+	// no longer exists
+	prune bool
+}
+
+// syncResult counts the entities changed by an inventory sync
+type syncResult struct {
+	created int
+	updated int
+	deleted int
+	skipped int
+}
+
+// SyncInventoryCommand adds a command that creates and updates proxy entities
+// from the instances of a cloud provider inventory
+func SyncInventoryCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-inventory",
+		Short: "create and update proxy entities from a cloud provider inventory",
+		Long: "Create and update proxy entities from the running instances of an AWS EC2 region, a GCP project or an Azure subscription.\n" +
+			"The credentials are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN, GOOGLE_OAUTH_ACCESS_TOKEN or AZURE_ACCESS_TOKEN environment variables.",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			var cfg inventory.Config
+			cfg.Provider, _ = cmd.Flags().GetString("provider")
+			cfg.Region, _ = cmd.Flags().GetString("region")
+			cfg.Project, _ = cmd.Flags().GetString("project")
+			cfg.SubscriptionID, _ = cmd.Flags().GetString("subscription-id")
+			cfg.ResourceGroup, _ = cmd.Flags().GetString("resource-group")
+			provider, err := inventory.NewProvider(cfg)
+			if err != nil {
+				return err
+			}
+
+			var opts syncOpts
+			tags, _ := cmd.Flags().GetString("tags")
+			opts.tags = helpers.SafeSplitCSV(tags)
+			subscriptions, _ := cmd.Flags().GetString("subscriptions")
+			opts.subscriptions = helpers.SafeSplitCSV(subscriptions)
+			opts.prune, _ = cmd.Flags().GetBool("prune")
+			interval, _ := cmd.Flags().GetDuration("interval")
+
+			namespace := cli.Config.Namespace()
+			sync := func() error {
+				instances, err := provider.Instances(context.Background())
+				if err != nil {
+					return err
+				}
+				result, err := syncEntities(cli.Client, namespace, provider.Source(), instances, opts)
+				if err != nil {
+					return err
+				}
+				printSyncResult(cmd.OutOrStdout(), provider.Source(), result)
+				return nil
+			}
+
+			if interval <= 0 {
+				return sync()
+			}
+
+			// Keep the entities in sync until interrupted
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				if err := sync(); err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Error: %s\n", err)
+				}
+				<-ticker.C
+			}
+		},
+	}
+
+	cmd.Flags().String("provider", "", "cloud provider of the inventory, one of aws, gcp or azure")
+	cmd.Flags().String("region", "", "AWS region of the instances")
+	cmd.Flags().String("project", "", "GCP project of the instances")
+	cmd.Flags().String("subscription-id", "", "Azure subscription of the virtual machines")
+	cmd.Flags().String("resource-group", "", "Azure resource group of the virtual machines")
+	cmd.Flags().String("tags", "", "comma separated list of instance tags to set as entity labels, all of them if not set")
+	cmd.Flags().String("subscriptions", "", "comma separated list of subscriptions of the entities created")
+	cmd.Flags().Bool("prune", false, "delete the entities imported from the inventory whose instance no longer exists")
+	cmd.Flags().Duration("interval", 0, "interval at which to sync the entities again, e.g. 10m; sync once if not set")
+
+	return cmd
+}
+
+// syncEntities creates or updates the proxy entities of the instances, and
+// deletes the entities of the instances that no longer exist if opts.prune is
+// set. The entities which are not proxy entities are never modified.
+func syncEntities(c client.APIClient, namespace, source string, instances []inventory.Instance, opts syncOpts) (syncResult, error) {
+	var result syncResult
+
+	var entities []corev2.Entity
+	if err := c.List(client.EntitiesPath(namespace), &entities, &client.ListOptions{}, nil); err != nil {
+		return result, err
+	}
+	existing := make(map[string]*corev2.Entity, len(entities))
+	for i := range entities {
+		existing[entities[i].Name] = &entities[i]
+	}
+
+	names := make(map[string]struct{}, len(instances))
+	for _, instance := range instances {
+		name := instance.EntityName()
+		names[name] = struct{}{}
+
+		entity, ok := existing[name]
+		if ok && entity.EntityClass != corev2.EntityProxyClass {
+			result.skipped++
+			continue
+		}
+		if !ok {
+			entity = &corev2.Entity{
+				ObjectMeta:    corev2.NewObjectMeta(name, namespace),
+				EntityClass:   corev2.EntityProxyClass,
+				Subscriptions: append(append([]string{}, opts.subscriptions...), corev2.GetEntitySubscription(name)),
+			}
+		}
+		if entity.Labels == nil {
+			entity.Labels = make(map[string]string)
+		}
+		for key, value := range instanceLabels(source, instance, opts.tags) {
+			entity.Labels[key] = value
+		}
+
+		if err := c.UpdateEntity(entity); err != nil {
+			return result, err
+		}
+		if ok {
+			result.updated++
+		} else {
+			result.created++
+		}
+	}
+
+	if !opts.prune {
+		return result, nil
+	}
+	for _, entity := range entities {
+		if entity.EntityClass != corev2.EntityProxyClass || entity.Labels[InventoryLabel] != source {
+			continue
+		}
+		if _, ok := names[entity.Name]; ok {
+			continue
+		}
+		if err := c.DeleteEntity(namespace, entity.Name); err != nil {
+			return result, err
+		}
+		result.deleted++
+	}
+
+	return result, nil
+}
+
+// instanceLabels returns the entity labels of an instance of the inventory
+// source, with the given tags, or all of them if none is given.
+func instanceLabels(source string, instance inventory.Instance, tags []string) map[string]string {
+	labels := map[string]string{InventoryLabel: source}
+	for key, value := range instance.Tags {
+		if len(tags) == 0 || utilstrings.InArray(key, tags) {
+			labels[key] = value
+		}
+	}
+	if instance.ID != "" {
+		labels["instance_id"] = instance.ID
+	}
+	if instance.Type != "" {
+		labels["instance_type"] = instance.Type
+	}
+	if instance.Region != "" {
+		labels["region"] = instance.Region
+	}
+	if instance.Address != "" {
+		labels["address"] = instance.Address
+	}
+	return labels
+}
+
+func printSyncResult(w io.Writer, source string, result syncResult) {
+	fmt.Fprintf(w, "Synced %s: %d created, %d updated, %d deleted, %d skipped\n",
+		source, result.created, result.updated, result.deleted, result.skipped)
+}
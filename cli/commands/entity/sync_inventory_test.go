@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/cli/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncInventoryCommand(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := SyncInventoryCommand(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "sync-inventory", cmd.Use)
+
+	// The provider is required
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
+
+func TestSyncEntities(t *testing.T) {
+	agent := corev2.FixtureEntity("agent1")
+	existing := corev2.FixtureEntity("web-01")
+	existing.EntityClass = corev2.EntityProxyClass
+	existing.Labels = map[string]string{"owner": "me"}
+	stale := corev2.FixtureEntity("web-03")
+	stale.EntityClass = corev2.EntityProxyClass
+	stale.Labels = map[string]string{InventoryLabel: "aws/us-east-1"}
+	other := corev2.FixtureEntity("web-04")
+	other.EntityClass = corev2.EntityProxyClass
+	other.Labels = map[string]string{InventoryLabel: "aws/eu-west-1"}
+
+	cli := test.NewMockCLI()
+	c := cli.Client.(*client.MockClient)
+	c.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entities := args.Get(1).(*[]corev2.Entity)
+		*entities = []corev2.Entity{*agent, *existing, *stale, *other}
+	}).Return(nil)
+
+	var updated []*corev2.Entity
+	c.On("UpdateEntity", mock.Anything).Run(func(args mock.Arguments) {
+		updated = append(updated, args.Get(0).(*corev2.Entity))
+	}).Return(nil)
+	c.On("DeleteEntity", "default", "web-03").Return(nil)
+
+	instances := []inventory.Instance{
+		{ID: "i-1", Name: "web-01", Type: "t3.micro", Tags: map[string]string{"team": "web", "cost": "1"}},
+		{ID: "i-2", Name: "web-02", Tags: map[string]string{"team": "web"}},
+		{ID: "i-3", Name: "agent1"},
+	}
+	opts := syncOpts{tags: []string{"team"}, subscriptions: []string{"web"}, prune: true}
+
+	result, err := syncEntities(c, "default", "aws/us-east-1", instances, opts)
+	require.NoError(t, err)
+	assert.Equal(t, syncResult{created: 1, updated: 1, deleted: 1, skipped: 1}, result)
+	c.AssertExpectations(t)
+
+	require.Len(t, updated, 2)
+	assert.Equal(t, map[string]string{
+		"owner":         "me",
+		"team":          "web",
+		"instance_id":   "i-1",
+		"instance_type": "t3.micro",
+		InventoryLabel:  "aws/us-east-1",
+	}, updated[0].Labels)
+
+	assert.Equal(t, "web-02", updated[1].Name)
+	assert.Equal(t, corev2.EntityProxyClass, updated[1].EntityClass)
+	assert.Equal(t, []string{"web", "entity:web-02"}, updated[1].Subscriptions)
+}
@@ -68,6 +68,10 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Expressions",
 				Value: strings.Join(filter.Expressions, " && "),
 			},
+			{
+				Label: "WASM Module",
+				Value: filter.WASMModule,
+			},
 			{
 				Label: "RuntimeAssets",
 				Value: strings.Join(filter.RuntimeAssets, ", "),
@@ -64,6 +64,27 @@ func printToList(v interface{}, writer io.Writer) error {
 			handler.Socket.Host,
 			handler.Socket.Port,
 		)
+	case types.HandlerOTLPType:
+		execute = fmt.Sprintf(
+			"%s %s %s",
+			table.TitleStyle("EXPORT:"),
+			handler.Type,
+			handler.OTLP.Endpoint,
+		)
+	case types.HandlerInfluxDBType:
+		execute = fmt.Sprintf(
+			"%s %s %s",
+			table.TitleStyle("WRITE:"),
+			handler.Type,
+			handler.InfluxDB.URL,
+		)
+	case types.HandlerKafkaType:
+		execute = fmt.Sprintf(
+			"%s %s %s",
+			table.TitleStyle("PUBLISH:"),
+			handler.Type,
+			handler.Kafka.Topic,
+		)
 	case types.HandlerPipeType:
 		execute = fmt.Sprintf(
 			"%s  %s",
@@ -99,6 +120,14 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Filters",
 				Value: strings.Join(handler.Filters, ", "),
 			},
+			{
+				Label: "Enrichers",
+				Value: strings.Join(handler.Enrichers, ", "),
+			},
+			{
+				Label: "Correlation",
+				Value: handler.Correlation,
+			},
 			{
 				Label: "Mutator",
 				Value: handler.Mutator,
@@ -136,6 +136,27 @@ func printToTable(results interface{}, writer io.Writer) {
 						handler.Socket.Host,
 						handler.Socket.Port,
 					)
+				case corev2.HandlerOTLPType:
+					return fmt.Sprintf(
+						"%s %s %s",
+						table.TitleStyle("EXPORT:"),
+						handler.Type,
+						handler.OTLP.Endpoint,
+					)
+				case corev2.HandlerInfluxDBType:
+					return fmt.Sprintf(
+						"%s %s %s",
+						table.TitleStyle("WRITE:"),
+						handler.Type,
+						handler.InfluxDB.URL,
+					)
+				case corev2.HandlerKafkaType:
+					return fmt.Sprintf(
+						"%s %s %s",
+						table.TitleStyle("PUBLISH:"),
+						handler.Type,
+						handler.Kafka.Topic,
+					)
 				case corev2.HandlerPipeType:
 					return fmt.Sprintf(
 						"%s  %s",
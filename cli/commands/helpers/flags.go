@@ -21,11 +21,13 @@ func AddFormatFlag(flagSet *pflag.FlagSet) {
 		"format",
 		config.DefaultFormat,
 		fmt.Sprintf(
-			`format of data returned ("%s"|"%s"|"%s"|"%s")`,
+			`format of data returned ("%s"|"%s"|"%s"|"%s"|"%s=TEMPLATE"|"%s=TEMPLATE")`,
 			config.FormatJSON,
 			config.FormatWrappedJSON,
 			config.FormatTabular,
 			config.FormatYAML,
+			config.FormatJSONPath,
+			config.FormatGoTemplate,
 		),
 	)
 }
@@ -42,12 +44,12 @@ func AddInteractiveFlag(flagSet *pflag.FlagSet) {
 
 // AddFieldSelectorFlag adds the '--field-selector' flag to the given command
 func AddFieldSelectorFlag(flagSet *pflag.FlagSet) {
-	flagSet.String(flags.FieldSelector, "", "Only select resources matching this field selector (enterprise only)")
+	flagSet.String(flags.FieldSelector, "", "Only select resources matching this field selector")
 }
 
 // AddLabelSelectorFlag adds the '--label-selector' flag to the given command
 func AddLabelSelectorFlag(flagSet *pflag.FlagSet) {
-	flagSet.String(flags.LabelSelector, "", "Only select resources matching this label selector (enterprise only)")
+	flagSet.String(flags.LabelSelector, "", "Only select resources matching this label selector")
 }
 
 // AddChunkSizeFlag adds the '--chunk-size' flag to the given command
@@ -0,0 +1,439 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// printJSONPath executes the given JSONPath template on v, a value decoded
+// from JSON, and writes the result to w. As with kubectl, the template is text
+// interleaved with expressions between braces:
+//
+//	{.metadata.name}                               a field
+//	{[*].metadata.name}                            a field of every item
+//	{[0].metadata['name']}                         a field of the first item
+//	{[?(@.check.status!=0)].metadata.name}         a field of some items
+//	{range [*]}{.metadata.name}{"\n"}{end}         a field per line
+//
+// The values selected by an expression are separated by spaces. Missing
+// fields select no value.
+func printJSONPath(tmpl string, v interface{}, w io.Writer) error {
+	nodes, err := parseJSONPathTemplate(tmpl)
+	if err != nil {
+		return fmt.Errorf("invalid jsonpath: %s", err)
+	}
+	var buf bytes.Buffer
+	for _, node := range nodes {
+		node.execute(&buf, v)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// jsonPathNode is a node of a parsed JSONPath template.
+type jsonPathNode interface {
+	execute(buf *bytes.Buffer, v interface{})
+}
+
+// jsonPathText is literal text.
+type jsonPathText string
+
+func (t jsonPathText) execute(buf *bytes.Buffer, v interface{}) {
+	buf.WriteString(string(t))
+}
+
+// jsonPathSegment maps the values selected by the previous segments of a path
+// to new values.
+type jsonPathSegment func([]interface{}) []interface{}
+
+// jsonPath prints the values it selects.
+type jsonPath []jsonPathSegment
+
+func (p jsonPath) eval(v interface{}) []interface{} {
+	values := []interface{}{v}
+	for _, segment := range p {
+		values = segment(values)
+	}
+	return values
+}
+
+func (p jsonPath) execute(buf *bytes.Buffer, v interface{}) {
+	for i, value := range p.eval(v) {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(formatJSONValue(value))
+	}
+}
+
+// jsonPathRange executes its body for every value selected by its path, or
+// for every item of the array it selects.
+type jsonPathRange struct {
+	path jsonPath
+	body []jsonPathNode
+}
+
+func (r *jsonPathRange) execute(buf *bytes.Buffer, v interface{}) {
+	values := r.path.eval(v)
+	if len(values) == 1 {
+		if items, ok := values[0].([]interface{}); ok {
+			values = items
+		}
+	}
+	for _, value := range values {
+		for _, node := range r.body {
+			node.execute(buf, value)
+		}
+	}
+}
+
+func parseJSONPathTemplate(tmpl string) ([]jsonPathNode, error) {
+	var nodes []jsonPathNode
+	// The ranges being parsed, the innermost last
+	var ranges []*jsonPathRange
+	add := func(node jsonPathNode) {
+		if len(ranges) > 0 {
+			r := ranges[len(ranges)-1]
+			r.body = append(r.body, node)
+			return
+		}
+		nodes = append(nodes, node)
+	}
+	for len(tmpl) > 0 {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			add(jsonPathText(tmpl))
+			break
+		}
+		if start > 0 {
+			add(jsonPathText(tmpl[:start]))
+		}
+		end := closingIndex(tmpl, start, '{', '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed expression %q", tmpl[start:])
+		}
+		expr := strings.TrimSpace(tmpl[start+1 : end])
+		tmpl = tmpl[end+1:]
+		switch {
+		case expr == "end":
+			if len(ranges) == 0 {
+				return nil, errors.New("end without range")
+			}
+			r := ranges[len(ranges)-1]
+			ranges = ranges[:len(ranges)-1]
+			add(r)
+		case strings.HasPrefix(expr, "range "):
+			path, err := parseJSONPath(strings.TrimSpace(strings.TrimPrefix(expr, "range ")))
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, &jsonPathRange{path: path})
+		case strings.HasPrefix(expr, `"`) || strings.HasPrefix(expr, "'"):
+			text, err := unquote(expr)
+			if err != nil {
+				return nil, err
+			}
+			add(jsonPathText(text))
+		default:
+			path, err := parseJSONPath(expr)
+			if err != nil {
+				return nil, err
+			}
+			add(path)
+		}
+	}
+	if len(ranges) > 0 {
+		return nil, errors.New("range without end")
+	}
+	return nodes, nil
+}
+
+func parseJSONPath(expr string) (jsonPath, error) {
+	s := strings.TrimLeft(expr, "$@")
+	if s == "." {
+		s = ""
+	}
+	var path jsonPath
+	for len(s) > 0 {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			n := strings.IndexAny(s, ".[")
+			if n < 0 {
+				n = len(s)
+			}
+			name := s[:n]
+			s = s[n:]
+			switch name {
+			case "":
+				return nil, fmt.Errorf("missing field name in %q", expr)
+			case "*":
+				path = append(path, jsonPathWildcard)
+			default:
+				path = append(path, jsonPathField(name))
+			}
+		case '[':
+			end := closingIndex(s, 0, '[', ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket in %q", expr)
+			}
+			segment, err := parseJSONPathBracket(strings.TrimSpace(s[1:end]))
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, segment)
+			s = s[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", s[0], expr)
+		}
+	}
+	return path, nil
+}
+
+func parseJSONPathBracket(expr string) (jsonPathSegment, error) {
+	switch {
+	case expr == "*":
+		return jsonPathWildcard, nil
+	case strings.HasPrefix(expr, "?(") && strings.HasSuffix(expr, ")"):
+		return parseJSONPathFilter(strings.TrimSpace(expr[2 : len(expr)-1]))
+	case strings.HasPrefix(expr, `"`) || strings.HasPrefix(expr, "'"):
+		name, err := unquote(expr)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathField(name), nil
+	default:
+		i, err := strconv.Atoi(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %q", expr)
+		}
+		return jsonPathIndex(i), nil
+	}
+}
+
+// parseJSONPathFilter parses a filter of the form @.path, which selects the
+// items of arrays where the path selects a value, or @.path OP literal, which
+// selects the items where the path selects a value comparing to the literal.
+func parseJSONPathFilter(expr string) (jsonPathSegment, error) {
+	left, op := expr, ""
+	var right interface{}
+	if i := strings.IndexAny(expr, "=!<>"); i >= 0 {
+		op = expr[i : i+1]
+		if i+1 < len(expr) && expr[i+1] == '=' {
+			op += "="
+		}
+		switch op {
+		case "==", "!=", "<", "<=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("invalid operator in filter %q", expr)
+		}
+		left = strings.TrimSpace(expr[:i])
+		literal, err := parseJSONPathLiteral(strings.TrimSpace(expr[i+len(op):]))
+		if err != nil {
+			return nil, err
+		}
+		right = literal
+	}
+	if !strings.HasPrefix(left, "@") {
+		return nil, fmt.Errorf("filter %q must start with @", expr)
+	}
+	path, err := parseJSONPath(left)
+	if err != nil {
+		return nil, err
+	}
+	return func(values []interface{}) []interface{} {
+		var result []interface{}
+		for _, v := range values {
+			items, _ := v.([]interface{})
+			for _, item := range items {
+				for _, value := range path.eval(item) {
+					if op == "" || compareJSONValues(value, op, right) {
+						result = append(result, item)
+						break
+					}
+				}
+			}
+		}
+		return result
+	}, nil
+}
+
+func parseJSONPathLiteral(s string) (interface{}, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "'") {
+		return unquote(s)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid literal %q", s)
+	}
+	return f, nil
+}
+
+func jsonPathField(name string) jsonPathSegment {
+	return func(values []interface{}) []interface{} {
+		var result []interface{}
+		for _, v := range values {
+			if m, ok := v.(map[string]interface{}); ok {
+				if field, ok := m[name]; ok {
+					result = append(result, field)
+				}
+			}
+		}
+		return result
+	}
+}
+
+// jsonPathIndex selects the item at the given index of arrays, counted from
+// the end if negative.
+func jsonPathIndex(i int) jsonPathSegment {
+	return func(values []interface{}) []interface{} {
+		var result []interface{}
+		for _, v := range values {
+			items, _ := v.([]interface{})
+			j := i
+			if j < 0 {
+				j += len(items)
+			}
+			if j >= 0 && j < len(items) {
+				result = append(result, items[j])
+			}
+		}
+		return result
+	}
+}
+
+// jsonPathWildcard selects the items of arrays, and the fields of objects
+// sorted by name.
+func jsonPathWildcard(values []interface{}) []interface{} {
+	var result []interface{}
+	for _, v := range values {
+		switch v := v.(type) {
+		case []interface{}:
+			result = append(result, v...)
+		case map[string]interface{}:
+			names := make([]string, 0, len(v))
+			for name := range v {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				result = append(result, v[name])
+			}
+		}
+	}
+	return result
+}
+
+// compareJSONValues compares numbers numerically and other values by their
+// printed form.
+func compareJSONValues(left interface{}, op string, right interface{}) bool {
+	var cmp int
+	l, lok := jsonNumber(left)
+	r, rok := jsonNumber(right)
+	switch {
+	case lok && rok && l < r:
+		cmp = -1
+	case lok && rok && l > r:
+		cmp = 1
+	case lok && rok:
+		cmp = 0
+	default:
+		cmp = strings.Compare(formatJSONValue(left), formatJSONValue(right))
+	}
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+func jsonNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// formatJSONValue prints strings and numbers as they are, and other values as
+// JSON.
+func formatJSONValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// closingIndex returns the index of the delimiter closing the one at the
+// given index of s, skipping the nested and quoted delimiters, or -1.
+func closingIndex(s string, start int, open, closing byte) int {
+	depth := 0
+	var quote byte
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == open:
+			depth++
+		case c == closing:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// unquote unquotes a string quoted with double or single quotes.
+func unquote(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		s = `"` + strings.Replace(s[1:len(s)-1], `"`, `\"`, -1) + `"`
+	}
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	return unquoted, nil
+}
@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintJSONPath(t *testing.T) {
+	value, err := toJSONValue([]map[string]interface{}{
+		{
+			"metadata": map[string]interface{}{"name": "check-cpu", "labels": map[string]string{"region": "us"}},
+			"status":   0,
+			"interval": 60,
+		},
+		{
+			"metadata": map[string]interface{}{"name": "check-disk"},
+			"status":   2,
+			"interval": 1500000000,
+		},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"field of an item", "{[0].metadata.name}", "check-cpu"},
+		{"last item", "{[-1].metadata.name}", "check-disk"},
+		{"every item", "{[*].metadata.name}", "check-cpu check-disk"},
+		{"quoted field", "{[0].metadata['name']}", "check-cpu"},
+		{"large number", "{[1].interval}", "1500000000"},
+		{"object", "{[0].metadata.labels}", `{"region":"us"}`},
+		{"wildcard field", "{[0].metadata.labels.*}", "us"},
+		{"missing field", "{[1].metadata.labels.region}", ""},
+		{"text", "name: {[0].metadata.name}", "name: check-cpu"},
+		{"range", `{range [*]}{.metadata.name}{"\n"}{end}`, "check-cpu\ncheck-disk\n"},
+		{"nested range", `{range [*]}{.metadata.name}:{range .metadata.labels.*}{@}{end}{";"}{end}`, "check-cpu:us;check-disk:;"},
+		{"filter", "{[?(@.status!=0)].metadata.name}", "check-disk"},
+		{"filter comparison", "{[?(@.interval < 100)].metadata.name}", "check-cpu"},
+		{"filter string", `{[?(@.metadata.name=="check-cpu")].status}`, "0"},
+		{"filter existence", "{[?(@.metadata.labels)].metadata.name}", "check-cpu"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			require.NoError(t, printJSONPath(tc.template, value, buf))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintJSONPathInvalid(t *testing.T) {
+	for _, template := range []string{
+		"{.metadata.name",
+		"{range [*]}{.metadata.name}",
+		"{end}",
+		"{metadata}",
+		"{.metadata..name}",
+		"{[a]}",
+		"{[?(@.status=0)]}",
+		"{[?(.status==0)]}",
+	} {
+		t.Run(template, func(t *testing.T) {
+			assert.Error(t, printJSONPath(template, nil, new(bytes.Buffer)))
+		})
+	}
+}
@@ -32,6 +32,9 @@ func Print(cmd *cobra.Command, format string, printTable printTableFunc, objects
 	if f := GetChangedStringValueFlag(flags.Format, cmd.Flags()); f != "" {
 		format = f
 	}
+	if name, tmpl, ok := TemplateFormat(format); ok {
+		return PrintTemplate(name, tmpl, v, cmd.OutOrStdout())
+	}
 	switch format {
 	case config.FormatJSON:
 		return PrintJSON(v, cmd.OutOrStdout())
@@ -58,6 +61,9 @@ func PrintFormatted(flag string, format string, v interface{}, w io.Writer, prin
 	if flag != "" {
 		format = flag
 	}
+	if name, tmpl, ok := TemplateFormat(format); ok {
+		return PrintTemplate(name, tmpl, v, w)
+	}
 	switch format {
 	case config.FormatJSON:
 		return PrintJSON(v, w)
@@ -82,6 +88,9 @@ func PrintTitle(flag string, format string, title string, w io.Writer) error {
 	}
 	// checking the formats exclusively to cover invalid formats
 	// that get defaulted to tabular
+	if _, _, ok := TemplateFormat(format); ok {
+		return nil
+	}
 	if format != config.FormatJSON && format != config.FormatWrappedJSON && format != config.FormatYAML {
 		cfg := &list.Config{
 			Title: title,
@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/sensu/sensu-go/cli/client/config"
+)
+
+// TemplateFormat splits a format of the form jsonpath=TEMPLATE or
+// go-template=TEMPLATE into its name and its template. ok is false if the
+// format is not a template format.
+func TemplateFormat(format string) (name, tmpl string, ok bool) {
+	i := strings.Index(format, "=")
+	if i < 0 {
+		return "", "", false
+	}
+	name, tmpl = format[:i], format[i+1:]
+	if name != config.FormatJSONPath && name != config.FormatGoTemplate {
+		return "", "", false
+	}
+	return name, tmpl, true
+}
+
+// PrintTemplate executes the template of the given template format on the
+// JSON representation of v, as printed with the json format, and writes the
+// result to w.
+func PrintTemplate(name, tmpl string, v interface{}, w io.Writer) error {
+	data, err := toJSONValue(v)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case config.FormatJSONPath:
+		return printJSONPath(tmpl, data, w)
+	case config.FormatGoTemplate:
+		return printGoTemplate(tmpl, data, w)
+	default:
+		return fmt.Errorf("invalid template format %q", name)
+	}
+}
+
+// printGoTemplate executes the given Go template on v and writes the result to
+// w.
+func printGoTemplate(tmpl string, v interface{}, w io.Writer) error {
+	t, err := template.New("format").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("invalid go-template: %s", err)
+	}
+	return t.Execute(w, v)
+}
+
+// toJSONValue converts v to the maps, slices and values decoded from its JSON
+// representation, so that templates refer to the fields by their JSON names.
+// Numbers are kept as json.Number to be printed as they are.
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
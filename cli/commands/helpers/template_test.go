@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli/client/config"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateFormat(t *testing.T) {
+	name, tmpl, ok := TemplateFormat("jsonpath={.metadata.name}")
+	assert.True(t, ok)
+	assert.Equal(t, config.FormatJSONPath, name)
+	assert.Equal(t, "{.metadata.name}", tmpl)
+
+	name, tmpl, ok = TemplateFormat("go-template={{.metadata.name}}={{.interval}}")
+	assert.True(t, ok)
+	assert.Equal(t, config.FormatGoTemplate, name)
+	assert.Equal(t, "{{.metadata.name}}={{.interval}}", tmpl)
+
+	_, _, ok = TemplateFormat(config.FormatJSON)
+	assert.False(t, ok)
+	_, _, ok = TemplateFormat("tabular={.metadata.name}")
+	assert.False(t, ok)
+}
+
+func TestPrintTemplate(t *testing.T) {
+	check := types.FixtureCheckConfig("check")
+	check.Interval = 1500000000
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, PrintTemplate(config.FormatJSONPath, "{.metadata.name} {.interval}", check, buf))
+	assert.Equal(t, "check 1500000000", buf.String())
+
+	buf.Reset()
+	require.NoError(t, PrintTemplate(config.FormatGoTemplate, "{{.metadata.name}} {{.interval}}", check, buf))
+	assert.Equal(t, "check 1500000000", buf.String())
+
+	checks := []*types.CheckConfig{check, types.FixtureCheckConfig("check2")}
+	buf.Reset()
+	require.NoError(t, PrintTemplate(config.FormatGoTemplate, "{{range .}}{{.metadata.name}}\n{{end}}", checks, buf))
+	assert.Equal(t, "check\ncheck2\n", buf.String())
+
+	assert.Error(t, PrintTemplate(config.FormatGoTemplate, "{{.metadata.name", check, buf))
+	assert.Error(t, PrintTemplate(config.FormatJSONPath, "{.metadata.name", check, buf))
+}
+
+func TestPrintFormattedTemplate(t *testing.T) {
+	check := types.FixtureCheckConfig("check")
+	printToList := func(interface{}, io.Writer) error {
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, PrintTitle("jsonpath={.metadata.name}", config.FormatTabular, "title", buf))
+	require.NoError(t, PrintFormatted("jsonpath={.metadata.name}", config.FormatTabular, check, buf, printToList))
+	assert.Equal(t, "check", buf.String())
+}
@@ -57,10 +57,22 @@ func printToList(v interface{}, writer io.Writer) error {
 				Label: "Name",
 				Value: mutator.Name,
 			},
+			{
+				Label: "Type",
+				Value: mutator.Type,
+			},
 			{
 				Label: "Command",
 				Value: mutator.Command,
 			},
+			{
+				Label: "Eval",
+				Value: mutator.Eval,
+			},
+			{
+				Label: "WASM Module",
+				Value: mutator.WASMModule,
+			},
 			{
 				Label: "Timeout",
 				Value: strconv.FormatUint(uint64(mutator.Timeout), 10),
@@ -0,0 +1,183 @@
+package prune
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ChunkSize is the number of resources fetched per page when listing the
+// resources of the cluster.
+var ChunkSize = 100
+
+var description = `sensuctl prune
+
+Delete the resources of the given types which are not defined in the manifests
+of the files, directories or URLs (path, file://, http[s]://), or STDIN
+otherwise, to reconcile the cluster with the manifests. Example:
+$ sensuctl prune -r -f manifests/ --types checks,handlers
+
+The resources pruned can be restricted to the ones matching a label selector,
+or created by some users. The resources which would be pruned are listed with
+--dry-run:
+$ sensuctl prune -f checks.yml --types checks --created-by admin --dry-run
+`
+
+// Command deletes the resources not defined in manifests.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "prune --types TYPE[,TYPE]... [-r] [[-f URL] ... ]",
+		Long: description,
+		RunE: execute(cli),
+	}
+
+	_ = cmd.Flags().StringSliceP("file", "f", nil, "Files, directories, or URLs of the manifests of the resources to keep")
+	_ = cmd.Flags().BoolP("recursive", "r", false, "Follow subdirectories")
+	_ = cmd.Flags().String("types", "", "comma separated types of the resources to prune (e.g. checks,handlers)")
+	_ = cmd.Flags().StringSlice("created-by", nil, "only prune the resources created by these users")
+	_ = cmd.Flags().Bool("dry-run", false, "only list the resources which would be pruned")
+	_ = cmd.Flags().Bool(flags.AllNamespaces, false, "prune the resources of all namespaces")
+	_ = cmd.Flags().String(flags.LabelSelector, "", "only prune the resources matching this label selector")
+
+	return cmd
+}
+
+// collector is a resource.Processor which collects the resources of the
+// manifests instead of sending them to the API.
+type collector struct {
+	resources []*types.Wrapper
+}
+
+func (c *collector) Process(_ client.GenericClient, resources []*types.Wrapper) error {
+	c.resources = append(c.resources, resources...)
+	return nil
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		typesArg, err := cmd.Flags().GetString("types")
+		if err != nil {
+			return err
+		}
+		switch typesArg {
+		case "":
+			return errors.New("the types of the resources to prune must be given with --types")
+		case "all":
+			return errors.New("all the resources can't be pruned, the types of the resources to prune must be listed")
+		}
+		requests, err := resource.GetResourceRequests(typesArg, resource.All)
+		if err != nil {
+			return err
+		}
+		createdBy, err := cmd.Flags().GetStringSlice("created-by")
+		if err != nil {
+			return err
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		allNamespaces, err := cmd.Flags().GetBool(flags.AllNamespaces)
+		if err != nil {
+			return err
+		}
+		labelSelector, err := cmd.Flags().GetString(flags.LabelSelector)
+		if err != nil {
+			return err
+		}
+
+		// Read the resources of the manifests
+		manifests := &collector{}
+		inputs, err := cmd.Flags().GetStringSlice("file")
+		if err != nil {
+			return err
+		}
+		t := &http.Transport{}
+		t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+		httpClient := &http.Client{Transport: t}
+		if len(inputs) == 0 {
+			if err := resource.ProcessStdin(cli, httpClient, manifests); err != nil {
+				return err
+			}
+		}
+		recurse, err := cmd.Flags().GetBool("recursive")
+		if err != nil {
+			return err
+		}
+		for _, input := range inputs {
+			if err := resource.Process(cli, httpClient, input, recurse, manifests); err != nil {
+				return err
+			}
+		}
+		keep := make(map[string]struct{}, len(manifests.resources))
+		for _, r := range manifests.resources {
+			keep[resource.Name(r.Value)] = struct{}{}
+		}
+
+		pruned := 0
+		for _, req := range requests {
+			if allNamespaces {
+				req.SetNamespace(corev2.NamespaceTypeAll)
+			} else {
+				req.SetNamespace(cli.Config.Namespace())
+			}
+
+			val := reflect.New(reflect.SliceOf(reflect.TypeOf(req)))
+			err = cli.Client.List(req.URIPath(), val.Interface(), &client.ListOptions{
+				LabelSelector: labelSelector,
+				ChunkSize:     ChunkSize,
+			}, nil)
+			if err != nil {
+				return fmt.Errorf("API error: %s", err)
+			}
+
+			val = reflect.Indirect(val)
+			for i := 0; i < val.Len(); i++ {
+				r := val.Index(i).Interface().(types.Resource)
+				key := resource.Name(r)
+				if _, ok := keep[key]; ok {
+					continue
+				}
+				if len(createdBy) > 0 && !contains(createdBy, r.GetObjectMeta().CreatedBy) {
+					continue
+				}
+				if dryRun {
+					fmt.Fprintf(cmd.OutOrStdout(), "would prune %s\n", key)
+					continue
+				}
+				if err := cli.Client.Delete(r.URIPath()); err != nil {
+					return fmt.Errorf("error pruning %s: %s", key, err)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "pruned %s\n", key)
+				pruned++
+			}
+		}
+
+		if !dryRun {
+			fmt.Fprintf(cmd.OutOrStdout(), "pruned %d resource(s)\n", pruned)
+		}
+		return nil
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package prune
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("prune", cmd.Use)
+	assert.NotNil(cmd.Flag("types"))
+	assert.NotNil(cmd.Flag("created-by"))
+	assert.NotNil(cmd.Flag("label-selector"))
+	assert.NotNil(cmd.Flag("dry-run"))
+}
+
+func TestCommandArgs(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	// no types
+	_, err := test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	// all types
+	assert.NoError(t, cmd.Flags().Set("types", "all"))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+
+	// invalid types
+	assert.NoError(t, cmd.Flags().Set("types", "check,foo"))
+	_, err = test.RunCmd(cmd, []string{})
+	assert.Error(t, err)
+}
+
+func fixtureCheck(name, createdBy string) *corev2.CheckConfig {
+	check := corev2.FixtureCheckConfig(name)
+	check.CreatedBy = createdBy
+	return check
+}
+
+func TestPrune(t *testing.T) {
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+	path := filepath.Join(tmpDir, "checks.yml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`type: CheckConfig
+api_version: core/v2
+metadata:
+  name: check-cpu
+spec:
+  command: check-cpu.sh
+  interval: 60
+  subscriptions: [linux]
+`), 0600))
+
+	testCases := []struct {
+		desc           string
+		flags          map[string]string
+		expectedPruned []string
+		expectedOutput string
+	}{
+		{
+			desc:           "prune",
+			flags:          map[string]string{"label-selector": "region == us-west-1"},
+			expectedPruned: []string{"check-mem", "check-disk"},
+			expectedOutput: "pruned 2 resource(s)",
+		},
+		{
+			desc:           "created by",
+			flags:          map[string]string{"created-by": "admin"},
+			expectedPruned: []string{"check-mem"},
+			expectedOutput: "pruned 1 resource(s)",
+		},
+		{
+			desc:           "dry run",
+			flags:          map[string]string{"dry-run": "true"},
+			expectedOutput: "would prune core/v2.CheckConfig default/check-disk",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cli := test.NewCLI()
+			mockClient := cli.Client.(*clienttest.MockClient)
+			mockClient.On("List", "/api/core/v2/namespaces/default/checks", mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					checks := args.Get(1).(*[]*corev2.CheckConfig)
+					*checks = []*corev2.CheckConfig{
+						fixtureCheck("check-cpu", "admin"),
+						fixtureCheck("check-mem", "admin"),
+						fixtureCheck("check-disk", "ci"),
+					}
+					assert.Equal(t, tc.flags["label-selector"], args.Get(2).(*client.ListOptions).LabelSelector)
+				}).Return(nil)
+			for _, name := range tc.expectedPruned {
+				mockClient.On("Delete", "/api/core/v2/namespaces/default/checks/"+name).Return(nil)
+			}
+
+			cmd := Command(cli)
+			require.NoError(t, cmd.Flags().Set("file", path))
+			require.NoError(t, cmd.Flags().Set("types", "checks"))
+			for flag, value := range tc.flags {
+				require.NoError(t, cmd.Flags().Set(flag, value))
+			}
+			out, err := test.RunCmd(cmd, []string{})
+			require.NoError(t, err)
+			assert.Contains(t, out, tc.expectedOutput)
+			assert.NotContains(t, out, "check-cpu")
+			mockClient.AssertExpectations(t)
+			mockClient.AssertNumberOfCalls(t, "Delete", len(tc.expectedPruned))
+		})
+	}
+}
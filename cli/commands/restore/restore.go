@@ -0,0 +1,72 @@
+package restore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/resource"
+	"github.com/spf13/cobra"
+)
+
+var description = `sensuctl restore
+
+Restore the resources of an archive written by 'sensuctl dump --archive', or
+STDIN if FILE is -. Example:
+$ sensuctl dump all --all-namespaces --archive -f backup.json
+$ sensuctl restore backup.json
+
+The resources are created or replaced, so an archive can be restored again,
+for instance after a partial failure. The users keep their passwords. Events
+are only restored with --include-events, since they are processed by the
+pipeline of the cluster.
+`
+
+// Command restores the resources of an archive.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "restore FILE",
+		Long: description,
+		RunE: execute(cli),
+	}
+
+	_ = cmd.Flags().Bool("include-events", false, "restore the events of the archive")
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		events, err := cmd.Flags().GetBool("include-events")
+		if err != nil {
+			return err
+		}
+
+		var r io.Reader = cmd.InOrStdin()
+		if args[0] != "-" {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			r = f
+		}
+
+		archive, err := resource.ReadArchive(r)
+		if err != nil {
+			return err
+		}
+		if err := resource.RestoreArchive(cli.Client, archive, events); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), "Archive restored")
+		return err
+	}
+}
@@ -0,0 +1,66 @@
+package restore
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("restore", cmd.Use)
+	assert.NotNil(cmd.Flag("include-events"))
+}
+
+func TestCommandArgs(t *testing.T) {
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	out, err := test.RunCmd(cmd, []string{})
+	assert.NotEmpty(t, out)
+	assert.Error(t, err)
+
+	_, err = test.RunCmd(cmd, []string{"/nonexistent/archive.json"})
+	assert.Error(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	tmpDir, remove := testutil.TempDir(t)
+	defer remove()
+	path := filepath.Join(tmpDir, "archive.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{
+  "version": 1,
+  "resources": [
+    {"type": "Namespace", "api_version": "core/v2", "spec": {"name": "default"}}
+  ]
+}`), 0600))
+
+	cli := test.NewCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("BulkResources", http.MethodPut, mock.Anything).Return([]corev2.BulkResult{{}}, nil)
+	cmd := Command(cli)
+
+	out, err := test.RunCmd(cmd, []string{path})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Archive restored")
+	client.AssertNumberOfCalls(t, "BulkResources", 1)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"version": 99}`), 0600))
+	_, err = test.RunCmd(cmd, []string{path})
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/cli/elements/table"
@@ -87,6 +88,19 @@ func printRulesToTable(v interface{}, io io.Writer) error {
 				return strings.Join(rule.ResourceNames, ",")
 			},
 		},
+		{
+			Title: "Effect",
+			CellTransformer: func(data interface{}) string {
+				rule, ok := data.(types.Rule)
+				if !ok {
+					return cli.TypeError
+				}
+				if rule.Denies() {
+					return corev2.RuleEffectDeny
+				}
+				return corev2.RuleEffectAllow
+			},
+		},
 	})
 
 	table.Render(io, queryResults.Rules)
@@ -0,0 +1,256 @@
+package top
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/elements/globals"
+)
+
+// The scrollable panels of the dashboard, in the order they are displayed and
+// focused with the tab key.
+const (
+	checksPanel = iota
+	keepalivesPanel
+	clusterPanel
+	panelCount
+)
+
+// checkNoise summarizes the events of a check across all entities.
+type checkNoise struct {
+	Name     string
+	Failing  int
+	Entities int
+
+	// StateChanges is the sum of the state changes percentage of the check
+	// history of every entity, the higher the noisier.
+	StateChanges uint32
+}
+
+// snapshot is the state of the cluster displayed by the dashboard.
+type snapshot struct {
+	// Severities counts the events by status: OK, warning, critical and
+	// unknown.
+	Severities [4]int
+	Silenced   int
+	Total      int
+
+	Checks     []checkNoise
+	Keepalives []corev2.Event
+
+	Health    *corev2.HealthResponse
+	HealthErr error
+
+	Time time.Time
+}
+
+// newSnapshot builds a snapshot of the given events and cluster health.
+func newSnapshot(events []corev2.Event, health *corev2.HealthResponse, healthErr error, now time.Time) *snapshot {
+	s := &snapshot{
+		Total:     len(events),
+		Health:    health,
+		HealthErr: healthErr,
+		Time:      now,
+	}
+	checks := make(map[string]*checkNoise)
+	for _, event := range events {
+		if event.Check == nil {
+			continue
+		}
+		s.Severities[severity(event.Check.Status)]++
+		if len(event.Check.Silenced) > 0 {
+			s.Silenced++
+		}
+		if event.Check.Name == corev2.KeepaliveCheckName {
+			if event.Check.Status != 0 {
+				s.Keepalives = append(s.Keepalives, event)
+			}
+			continue
+		}
+		check, ok := checks[event.Check.Name]
+		if !ok {
+			check = &checkNoise{Name: event.Check.Name}
+			checks[event.Check.Name] = check
+		}
+		check.Entities++
+		if event.Check.Status != 0 {
+			check.Failing++
+		}
+		check.StateChanges += event.Check.TotalStateChange
+	}
+	for _, check := range checks {
+		s.Checks = append(s.Checks, *check)
+	}
+	sort.Slice(s.Checks, func(i, j int) bool {
+		a, b := s.Checks[i], s.Checks[j]
+		if a.StateChanges != b.StateChanges {
+			return a.StateChanges > b.StateChanges
+		}
+		if a.Failing != b.Failing {
+			return a.Failing > b.Failing
+		}
+		return a.Name < b.Name
+	})
+	// The entities silent for the longest time come first
+	sort.SliceStable(s.Keepalives, func(i, j int) bool {
+		return s.Keepalives[i].Timestamp < s.Keepalives[j].Timestamp
+	})
+	return s
+}
+
+// severity returns the index of the given check status in the severities of
+// a snapshot.
+func severity(status uint32) int {
+	if status > 2 {
+		return 3
+	}
+	return int(status)
+}
+
+// dashboard renders snapshots and keeps track of the focused panel and of the
+// scroll offset of every panel.
+type dashboard struct {
+	snapshot *snapshot
+	err      error
+
+	focus   int
+	offsets [panelCount]int
+
+	// width and height are the size of the terminal. A height of 0 means the
+	// panels are not truncated.
+	width  int
+	height int
+}
+
+// focusNext focuses the next panel.
+func (d *dashboard) focusNext() {
+	d.focus = (d.focus + 1) % panelCount
+}
+
+// scroll scrolls the focused panel by the given number of rows.
+func (d *dashboard) scroll(n int) {
+	d.offsets[d.focus] += n
+	if d.offsets[d.focus] < 0 {
+		d.offsets[d.focus] = 0
+	}
+}
+
+// lines renders the dashboard, one string per line.
+func (d *dashboard) lines() []string {
+	lines := []string{d.header()}
+	if d.err != nil {
+		lines = append(lines, globals.ErrorTextStyle(d.truncate(d.err.Error())))
+	}
+	if d.snapshot == nil {
+		return lines
+	}
+	lines = append(lines, d.severities()...)
+
+	rows := 0
+	if d.height > 0 {
+		// Every panel takes its title, its header and a blank line on top of
+		// its rows
+		rows = (d.height - len(lines) - panelCount*3) / panelCount
+		if rows < 1 {
+			rows = 1
+		}
+	}
+	for i := 0; i < panelCount; i++ {
+		title, header, body := d.panel(i)
+		lines = append(lines, "", d.title(i, title, len(body)), d.truncate(header))
+		if rows > 0 {
+			if max := len(body) - rows; d.offsets[i] > max {
+				d.offsets[i] = max
+			}
+			if d.offsets[i] < 0 {
+				d.offsets[i] = 0
+			}
+			body = body[d.offsets[i]:]
+			if len(body) > rows {
+				body = body[:rows]
+			}
+		}
+		if len(body) == 0 {
+			lines = append(lines, "  none")
+		}
+		for _, row := range body {
+			lines = append(lines, d.truncate(row))
+		}
+	}
+	return lines
+}
+
+func (d *dashboard) header() string {
+	help := "tab: next panel  j/k: scroll  r: refresh  q: quit"
+	if d.snapshot == nil {
+		return globals.TitleStyle(d.truncate("sensuctl top  " + help))
+	}
+	return globals.TitleStyle(d.truncate(fmt.Sprintf("sensuctl top  %s  %s", d.snapshot.Time.Format(time.RFC3339), help)))
+}
+
+func (d *dashboard) severities() []string {
+	s := d.snapshot
+	return []string{
+		"",
+		fmt.Sprintf("Events: %d  %s  %s  %s  %s  Silenced: %d",
+			s.Total,
+			globals.SuccessStyle(fmt.Sprintf("OK: %d", s.Severities[0])),
+			globals.WarningStyle(fmt.Sprintf("Warning: %d", s.Severities[1])),
+			globals.ErrorTextStyle(fmt.Sprintf("Critical: %d", s.Severities[2])),
+			fmt.Sprintf("Unknown: %d", s.Severities[3]),
+			s.Silenced,
+		),
+	}
+}
+
+func (d *dashboard) title(panel int, title string, count int) string {
+	title = fmt.Sprintf("%s (%d)", title, count)
+	if panel == d.focus {
+		return globals.PrimaryTextStyle(d.truncate("> " + title))
+	}
+	return globals.TitleStyle(d.truncate("  " + title))
+}
+
+// panel returns the title, the header and the rows of the given panel.
+func (d *dashboard) panel(panel int) (string, string, []string) {
+	s := d.snapshot
+	var rows []string
+	switch panel {
+	case checksPanel:
+		for _, check := range s.Checks {
+			rows = append(rows, fmt.Sprintf("  %-40s %8d %8d %14d", check.Name, check.Failing, check.Entities, check.StateChanges))
+		}
+		return "Noisiest checks", fmt.Sprintf("  %-40s %8s %8s %14s", "CHECK", "FAILING", "ENTITIES", "STATE CHANGES"), rows
+	case keepalivesPanel:
+		for _, event := range s.Keepalives {
+			since := s.Time.Sub(time.Unix(event.Timestamp, 0)).Truncate(time.Second)
+			rows = append(rows, fmt.Sprintf("  %-40s %8d %14s", event.Entity.Name, event.Check.Status, since))
+		}
+		return "Keepalive failures", fmt.Sprintf("  %-40s %8s %14s", "ENTITY", "STATUS", "LAST SEEN"), rows
+	default:
+		if s.HealthErr != nil {
+			rows = append(rows, "  "+s.HealthErr.Error())
+		}
+		if s.Health != nil {
+			for _, member := range s.Health.ClusterHealth {
+				rows = append(rows, fmt.Sprintf("  %-40s %8t %s", member.Name, member.Healthy, member.Err))
+			}
+			for _, alarm := range s.Health.Alarms {
+				rows = append(rows, fmt.Sprintf("  %-40x %8s alarm: %s", alarm.GetMemberID(), "-", alarm.Alarm))
+			}
+		}
+		return "Cluster health", fmt.Sprintf("  %-40s %8s %s", "MEMBER", "HEALTHY", "ERROR"), rows
+	}
+}
+
+// truncate cuts the given line to the width of the terminal.
+func (d *dashboard) truncate(line string) string {
+	line = strings.TrimRight(line, " ")
+	if runes := []rune(line); d.width > 0 && len(runes) > d.width {
+		return string(runes[:d.width])
+	}
+	return line
+}
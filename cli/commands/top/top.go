@@ -0,0 +1,186 @@
+package top
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var description = `sensuctl top
+
+Display a live dashboard of the events by severity, the noisiest checks, the
+entities failing their keepalives and the health of the cluster, refreshed
+every --interval. The noisiest checks are the ones whose status changes the
+most across their entities.
+
+Keys:
+  tab          focus the next panel
+  j, down      scroll the focused panel down
+  k, up        scroll the focused panel up
+  r            refresh now
+  q, ctrl-c    quit
+
+When the output is not a terminal, the dashboard is printed once.
+`
+
+// The escape sequences used to draw the dashboard.
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l"
+	exitAltScreen  = "\x1b[?25h\x1b[?1049l"
+	clearScreen    = "\x1b[H\x1b[2J"
+)
+
+// Command displays a live dashboard of the cluster.
+func Command(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "top",
+		Aliases:      []string{"dashboard"},
+		Short:        "display a live dashboard of the events and the cluster health",
+		Long:         description,
+		SilenceUsage: true,
+		RunE:         execute(cli),
+	}
+
+	_ = cmd.Flags().Duration("interval", 2*time.Second, "Interval between refreshes of the dashboard")
+	helpers.AddAllNamespace(cmd.Flags())
+
+	return cmd
+}
+
+func execute(cli *cli.SensuCli) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			_ = cmd.Help()
+			return errors.New("invalid argument(s) received")
+		}
+		interval, err := cmd.Flags().GetDuration("interval")
+		if err != nil {
+			return err
+		}
+		if interval <= 0 {
+			return errors.New("the interval must be positive")
+		}
+		namespace := cli.Config.Namespace()
+		if ok, _ := cmd.Flags().GetBool(flags.AllNamespaces); ok {
+			namespace = corev2.NamespaceTypeAll
+		}
+
+		out, ok := cmd.OutOrStdout().(*os.File)
+		if !ok || !terminal.IsTerminal(int(out.Fd())) || !terminal.IsTerminal(int(os.Stdin.Fd())) {
+			s, err := fetch(cli.Client, namespace)
+			if err != nil {
+				return err
+			}
+			d := &dashboard{snapshot: s}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), strings.Join(d.lines(), "\n"))
+			return err
+		}
+		return run(cli.Client, namespace, interval, out)
+	}
+}
+
+// fetch takes a snapshot of the events of the given namespace and of the
+// cluster health. The cluster health is reported in the snapshot when it
+// cannot be fetched, since it requires cluster wide permissions.
+func fetch(c client.APIClient, namespace string) (*snapshot, error) {
+	var header http.Header
+	events := []corev2.Event{}
+	if err := c.List(client.EventsPath(namespace), &events, &client.ListOptions{}, &header); err != nil {
+		return nil, err
+	}
+	health, err := c.Health()
+	return newSnapshot(events, health, err, time.Now()), nil
+}
+
+// run draws the dashboard on the given terminal until it is quit.
+func run(c client.APIClient, namespace string, interval time.Duration, out *os.File) error {
+	state, err := terminal.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = terminal.Restore(int(os.Stdin.Fd()), state)
+	}()
+	_, _ = io.WriteString(out, enterAltScreen)
+	defer func() {
+		_, _ = io.WriteString(out, exitAltScreen)
+	}()
+
+	keys := make(chan []byte)
+	go readKeys(os.Stdin, keys)
+
+	d := &dashboard{}
+	refresh := func() {
+		s, err := fetch(c, namespace)
+		d.err = err
+		if err == nil {
+			d.snapshot = s
+		}
+	}
+	draw := func() {
+		d.width, d.height, err = terminal.GetSize(int(out.Fd()))
+		if err != nil {
+			d.width, d.height = 0, 0
+		}
+		_, _ = io.WriteString(out, clearScreen+strings.Join(d.lines(), "\r\n"))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	refresh()
+	draw()
+	for {
+		select {
+		case <-ticker.C:
+			refresh()
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			switch string(key) {
+			case "q", "\x03":
+				return nil
+			case "\t":
+				d.focusNext()
+			case "j", "\x1b[B":
+				d.scroll(1)
+			case "k", "\x1b[A":
+				d.scroll(-1)
+			case "r":
+				refresh()
+			}
+		}
+		draw()
+	}
+}
+
+// readKeys sends the key presses read from r to keys, until r is closed.
+// Escape sequences, such as the arrow keys, are read at once from terminals,
+// and are sent as a single key press.
+func readKeys(r io.Reader, keys chan<- []byte) {
+	defer close(keys)
+	buf := make([]byte, 16)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			key := make([]byte, n)
+			copy(key, buf[:n])
+			keys <- key
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package top
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func fixtureEvent(entity, check string, status, stateChanges uint32) corev2.Event {
+	event := corev2.FixtureEvent(entity, check)
+	event.Check.Status = status
+	event.Check.TotalStateChange = stateChanges
+	return *event
+}
+
+func TestCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewCLI()
+	cmd := Command(cli)
+
+	assert.NotNil(cmd, "cmd should be returned")
+	assert.NotNil(cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp("top", cmd.Use)
+	assert.Contains(cmd.Aliases, "dashboard")
+	assert.NotNil(cmd.Flag("interval"))
+	assert.NotNil(cmd.Flag("all-namespaces"))
+}
+
+func TestNewSnapshot(t *testing.T) {
+	events := []corev2.Event{
+		fixtureEvent("entity1", "check-cpu", 0, 10),
+		fixtureEvent("entity2", "check-cpu", 2, 30),
+		fixtureEvent("entity1", "check-disk", 1, 0),
+		fixtureEvent("entity1", "check-mem", 5, 0),
+		fixtureEvent("entity1", corev2.KeepaliveCheckName, 0, 0),
+		fixtureEvent("entity2", corev2.KeepaliveCheckName, 2, 0),
+	}
+	events[1].Check.Silenced = []string{"entity:entity2:check-cpu"}
+
+	s := newSnapshot(events, nil, nil, time.Now())
+	assert.Equal(t, 6, s.Total)
+	assert.Equal(t, [4]int{2, 1, 2, 1}, s.Severities)
+	assert.Equal(t, 1, s.Silenced)
+	assert.Equal(t, []checkNoise{
+		{Name: "check-cpu", Failing: 1, Entities: 2, StateChanges: 40},
+		{Name: "check-disk", Failing: 1, Entities: 1},
+		{Name: "check-mem", Failing: 1, Entities: 1},
+	}, s.Checks)
+	require.Len(t, s.Keepalives, 1)
+	assert.Equal(t, "entity2", s.Keepalives[0].Entity.Name)
+}
+
+func TestDashboardScroll(t *testing.T) {
+	var events []corev2.Event
+	for _, name := range []string{"a", "b", "c", "d"} {
+		events = append(events, fixtureEvent("entity", name, 0, 0))
+	}
+	d := &dashboard{
+		snapshot: newSnapshot(events, nil, nil, time.Now()),
+		height:   15,
+	}
+
+	d.scroll(10)
+	lines := d.lines()
+	assert.Contains(t, lines[6], "  d")
+	assert.Equal(t, 3, d.offsets[checksPanel])
+
+	d.scroll(-10)
+	d.focusNext()
+	d.scroll(1)
+	lines = d.lines()
+	assert.Contains(t, lines[6], "  a")
+	assert.Equal(t, 0, d.offsets[keepalivesPanel])
+}
+
+func TestTopOnce(t *testing.T) {
+	health := corev2.FixtureHealthResponse(true)
+
+	cli := test.NewCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("List", "/api/core/v2/namespaces/default/events", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		events := args.Get(1).(*[]corev2.Event)
+		*events = []corev2.Event{
+			fixtureEvent("entity1", "check-cpu", 2, 25),
+			fixtureEvent("entity2", corev2.KeepaliveCheckName, 2, 0),
+		}
+	}).Return(nil)
+	client.On("Health").Return(health, nil)
+
+	out, err := test.RunCmd(Command(cli), []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "Events: 2")
+	assert.Contains(t, out, "check-cpu")
+	assert.Contains(t, out, "Keepalive failures (1)")
+	assert.Contains(t, out, "entity2")
+	assert.Contains(t, out, "Cluster health")
+}
+
+func TestTopHealthError(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	client.On("Health").Return((*corev2.HealthResponse)(nil), errors.New("forbidden"))
+
+	out, err := test.RunCmd(Command(cli), []string{})
+	require.NoError(t, err)
+	assert.Contains(t, out, "forbidden")
+}
+
+func TestTopListError(t *testing.T) {
+	cli := test.NewCLI()
+	client := cli.Client.(*clienttest.MockClient)
+	client.On("List", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"))
+
+	_, err := test.RunCmd(Command(cli), []string{})
+	assert.Error(t, err)
+}
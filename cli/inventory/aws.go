@@ -0,0 +1,203 @@
+package inventory
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// ec2APIVersion is the version of the EC2 query API
+	ec2APIVersion = "2016-11-15"
+
+	// awsSigningAlgorithm is the algorithm of the AWS signature version 4
+	awsSigningAlgorithm = "AWS4-HMAC-SHA256"
+)
+
+// awsProvider lists the running instances of an EC2 region
+type awsProvider struct {
+	client       *http.Client
+	endpoint     string
+	region       string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	now          func() time.Time
+}
+
+func newAWSProvider(cfg Config) (*awsProvider, error) {
+	if cfg.Region == "" {
+		return nil, errors.New("the region must be set for the aws provider")
+	}
+	p := &awsProvider{
+		client:       http.DefaultClient,
+		endpoint:     fmt.Sprintf("https://ec2.%s.amazonaws.com/", cfg.Region),
+		region:       cfg.Region,
+		accessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+		now:          time.Now,
+	}
+	if p.accessKey == "" || p.secretKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set for the aws provider")
+	}
+	return p, nil
+}
+
+// Source returns the provider and the scope of the inventory
+func (p *awsProvider) Source() string {
+	return fmt.Sprintf("%s/%s", ProviderAWS, p.region)
+}
+
+type ec2Tag struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type ec2Instance struct {
+	InstanceID       string   `xml:"instanceId"`
+	InstanceType     string   `xml:"instanceType"`
+	PrivateIPAddress string   `xml:"privateIpAddress"`
+	AvailabilityZone string   `xml:"placement>availabilityZone"`
+	Tags             []ec2Tag `xml:"tagSet>item"`
+}
+
+type describeInstancesResponse struct {
+	Instances []ec2Instance `xml:"reservationSet>item>instancesSet>item"`
+	NextToken string        `xml:"nextToken"`
+}
+
+// Instances returns the running instances of the region
+func (p *awsProvider) Instances(ctx context.Context) ([]Instance, error) {
+	var instances []Instance
+	var nextToken string
+	for {
+		query := url.Values{}
+		query.Set("Action", "DescribeInstances")
+		query.Set("Version", ec2APIVersion)
+		query.Set("Filter.1.Name", "instance-state-name")
+		query.Set("Filter.1.Value.1", "running")
+		if nextToken != "" {
+			query.Set("NextToken", nextToken)
+		}
+
+		var resp describeInstancesResponse
+		if err := p.get(ctx, query, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, i := range resp.Instances {
+			instance := Instance{
+				ID:      i.InstanceID,
+				Type:    i.InstanceType,
+				Region:  i.AvailabilityZone,
+				Address: i.PrivateIPAddress,
+				Tags:    make(map[string]string, len(i.Tags)),
+			}
+			for _, tag := range i.Tags {
+				instance.Tags[tag.Key] = tag.Value
+			}
+			instance.Name = instance.Tags["Name"]
+			instances = append(instances, instance)
+		}
+
+		if resp.NextToken == "" {
+			return instances, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
+// get performs a signed request of the EC2 query API and decodes the XML
+// response into v.
+func (p *awsProvider) get(ctx context.Context, query url.Values, v interface{}) error {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return err
+	}
+	u.RawQuery = strings.Replace(query.Encode(), "+", "%20", -1)
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	p.sign(req, p.now().UTC())
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s returned %s", p.endpoint, resp.Status)
+	}
+	return xml.NewDecoder(resp.Body).Decode(v)
+}
+
+// sign signs the request with the AWS signature version 4.
+func (p *awsProvider) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	signedHeaders := "host;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" + "x-amz-date:" + amzDate + "\n"
+	if p.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.sessionToken)
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += "x-amz-security-token:" + p.sessionToken + "\n"
+	}
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		hexSHA256(nil),
+	}, "\n")
+
+	scope := strings.Join([]string{date, p.region, "ec2", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		awsSigningAlgorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.secretKey), date)
+	key = hmacSHA256(key, p.region)
+	key = hmacSHA256(key, "ec2")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigningAlgorithm, p.accessKey, scope, signedHeaders, signature))
+}
+
+func hexSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,96 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// azureComputeAPIVersion is the version of the Azure compute API
+const azureComputeAPIVersion = "2021-03-01"
+
+// azureProvider lists the virtual machines of an Azure subscription
+type azureProvider struct {
+	client         *http.Client
+	endpoint       string
+	subscriptionID string
+	resourceGroup  string
+	token          string
+}
+
+func newAzureProvider(cfg Config) (*azureProvider, error) {
+	if cfg.SubscriptionID == "" {
+		return nil, errors.New("the subscription id must be set for the azure provider")
+	}
+	p := &azureProvider{
+		client:         http.DefaultClient,
+		endpoint:       "https://management.azure.com",
+		subscriptionID: cfg.SubscriptionID,
+		resourceGroup:  cfg.ResourceGroup,
+		token:          os.Getenv("AZURE_ACCESS_TOKEN"),
+	}
+	if p.token == "" {
+		return nil, errors.New("AZURE_ACCESS_TOKEN must be set for the azure provider")
+	}
+	return p, nil
+}
+
+// Source returns the provider and the scope of the inventory
+func (p *azureProvider) Source() string {
+	source := fmt.Sprintf("%s/%s", ProviderAzure, p.subscriptionID)
+	if p.resourceGroup != "" {
+		source += "/" + p.resourceGroup
+	}
+	return source
+}
+
+type azureVirtualMachine struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Location   string            `json:"location"`
+	Tags       map[string]string `json:"tags"`
+	Properties struct {
+		VMID            string `json:"vmId"`
+		HardwareProfile struct {
+			VMSize string `json:"vmSize"`
+		} `json:"hardwareProfile"`
+	} `json:"properties"`
+}
+
+type azureVirtualMachineList struct {
+	Value    []azureVirtualMachine `json:"value"`
+	NextLink string                `json:"nextLink"`
+}
+
+// Instances returns the virtual machines of the subscription, or of its
+// resource group if one is configured
+func (p *azureProvider) Instances(ctx context.Context) ([]Instance, error) {
+	u := fmt.Sprintf("%s/subscriptions/%s", p.endpoint, url.PathEscape(p.subscriptionID))
+	if p.resourceGroup != "" {
+		u += "/resourceGroups/" + url.PathEscape(p.resourceGroup)
+	}
+	u += "/providers/Microsoft.Compute/virtualMachines?api-version=" + azureComputeAPIVersion
+
+	var instances []Instance
+	for u != "" {
+		var list azureVirtualMachineList
+		if err := getJSON(ctx, p.client, u, p.token, &list); err != nil {
+			return nil, err
+		}
+
+		for _, vm := range list.Value {
+			instances = append(instances, Instance{
+				ID:     vm.Properties.VMID,
+				Name:   vm.Name,
+				Type:   vm.Properties.HardwareProfile.VMSize,
+				Region: vm.Location,
+				Tags:   vm.Tags,
+			})
+		}
+		u = list.NextLink
+	}
+	return instances, nil
+}
@@ -0,0 +1,101 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+)
+
+// gcpProvider lists the running instances of a Google Compute Engine project
+type gcpProvider struct {
+	client   *http.Client
+	endpoint string
+	project  string
+	token    string
+}
+
+func newGCPProvider(cfg Config) (*gcpProvider, error) {
+	if cfg.Project == "" {
+		return nil, errors.New("the project must be set for the gcp provider")
+	}
+	p := &gcpProvider{
+		client:   http.DefaultClient,
+		endpoint: "https://compute.googleapis.com/compute/v1",
+		project:  cfg.Project,
+		token:    os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"),
+	}
+	if p.token == "" {
+		return nil, errors.New("GOOGLE_OAUTH_ACCESS_TOKEN must be set for the gcp provider")
+	}
+	return p, nil
+}
+
+// Source returns the provider and the scope of the inventory
+func (p *gcpProvider) Source() string {
+	return fmt.Sprintf("%s/%s", ProviderGCP, p.project)
+}
+
+type gceInstance struct {
+	ID                string            `json:"id"`
+	Name              string            `json:"name"`
+	MachineType       string            `json:"machineType"`
+	Zone              string            `json:"zone"`
+	Status            string            `json:"status"`
+	Labels            map[string]string `json:"labels"`
+	NetworkInterfaces []struct {
+		NetworkIP string `json:"networkIP"`
+	} `json:"networkInterfaces"`
+}
+
+type gceAggregatedList struct {
+	Items map[string]struct {
+		Instances []gceInstance `json:"instances"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// Instances returns the running instances of all the zones of the project
+func (p *gcpProvider) Instances(ctx context.Context) ([]Instance, error) {
+	var instances []Instance
+	var pageToken string
+	for {
+		query := url.Values{}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		u := fmt.Sprintf("%s/projects/%s/aggregated/instances?%s", p.endpoint, url.PathEscape(p.project), query.Encode())
+
+		var list gceAggregatedList
+		if err := getJSON(ctx, p.client, u, p.token, &list); err != nil {
+			return nil, err
+		}
+
+		for _, scope := range list.Items {
+			for _, i := range scope.Instances {
+				if i.Status != "RUNNING" {
+					continue
+				}
+				instance := Instance{
+					ID:     i.ID,
+					Name:   i.Name,
+					Type:   path.Base(i.MachineType),
+					Region: path.Base(i.Zone),
+					Tags:   i.Labels,
+				}
+				if len(i.NetworkInterfaces) > 0 {
+					instance.Address = i.NetworkInterfaces[0].NetworkIP
+				}
+				instances = append(instances, instance)
+			}
+		}
+
+		if list.NextPageToken == "" {
+			return instances, nil
+		}
+		pageToken = list.NextPageToken
+	}
+}
@@ -0,0 +1,132 @@
+// Package inventory lists the instances of cloud provider inventories, so
+// that they can be imported as proxy entities.
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// ProviderAWS is the name of the AWS EC2 provider
+	ProviderAWS = "aws"
+
+	// ProviderGCP is the name of the Google Compute Engine provider
+	ProviderGCP = "gcp"
+
+	// ProviderAzure is the name of the Azure virtual machines provider
+	ProviderAzure = "azure"
+
+	// requestTimeout is the timeout of each request made to the provider APIs
+	requestTimeout = 30 * time.Second
+)
+
+// Providers are the supported cloud providers
+var Providers = []string{ProviderAWS, ProviderGCP, ProviderAzure}
+
+// invalidNameChars matches the characters not allowed in entity names
+var invalidNameChars = regexp.MustCompile(`[^\w\.\-\:]+`)
+
+// Instance is a compute instance of a cloud provider inventory
+type Instance struct {
+	// ID is the identifier of the instance
+	ID string
+
+	// Name is the name of the instance, which is its identifier when it has
+	// none
+	Name string
+
+	// Type is the instance type, or machine type or VM size
+	Type string
+
+	// Region is the region, zone or location of the instance
+	Region string
+
+	// Address is the private IP address of the instance
+	Address string
+
+	// Tags are the tags, or labels, of the instance
+	Tags map[string]string
+}
+
+// EntityName returns the name of the instance, with the characters not allowed
+// in entity names replaced with dashes.
+func (i Instance) EntityName() string {
+	name := i.Name
+	if name == "" {
+		name = i.ID
+	}
+	return invalidNameChars.ReplaceAllString(name, "-")
+}
+
+// Provider lists the instances of a cloud provider inventory
+type Provider interface {
+	// Source returns the provider and the scope of the inventory, such as
+	// "aws/us-east-1"
+	Source() string
+
+	// Instances returns the running instances of the inventory
+	Instances(ctx context.Context) ([]Instance, error)
+}
+
+// Config configures a provider. The credentials are read from the
+// environment: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN
+// for AWS, GOOGLE_OAUTH_ACCESS_TOKEN for GCP and AZURE_ACCESS_TOKEN for Azure.
+type Config struct {
+	// Provider is the name of the provider
+	Provider string
+
+	// Region is the AWS region to list the instances of
+	Region string
+
+	// Project is the GCP project to list the instances of
+	Project string
+
+	// SubscriptionID is the Azure subscription to list the virtual machines of
+	SubscriptionID string
+
+	// ResourceGroup optionally restricts the Azure virtual machines to a
+	// resource group
+	ResourceGroup string
+}
+
+// NewProvider returns the provider configured by cfg.
+func NewProvider(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case ProviderAWS:
+		return newAWSProvider(cfg)
+	case ProviderGCP:
+		return newGCPProvider(cfg)
+	case ProviderAzure:
+		return newAzureProvider(cfg)
+	}
+	return nil, fmt.Errorf("unknown provider %q, must be one of %s", cfg.Provider, strings.Join(Providers, ", "))
+}
+
+// getJSON requests url with the bearer token and decodes the JSON response into
+// v.
+func getJSON(ctx context.Context, client *http.Client, url, token string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,133 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const describeInstancesXML = `<?xml version="1.0" encoding="UTF-8"?>
+<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <reservationSet>
+    <item>
+      <instancesSet>
+        <item>
+          <instanceId>i-0123456789</instanceId>
+          <instanceType>t3.micro</instanceType>
+          <placement><availabilityZone>us-east-1a</availabilityZone></placement>
+          <privateIpAddress>10.0.0.12</privateIpAddress>
+          <tagSet>
+            <item><key>Name</key><value>web 01</value></item>
+            <item><key>team</key><value>ops</value></item>
+          </tagSet>
+        </item>
+      </instancesSet>
+    </item>
+  </reservationSet>
+</DescribeInstancesResponse>`
+
+func TestAWSInstances(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DescribeInstances", r.URL.Query().Get("Action"))
+		assert.Equal(t, "20191001T120000Z", r.Header.Get("X-Amz-Date"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"),
+			"AWS4-HMAC-SHA256 Credential=AKID/20191001/us-east-1/ec2/aws4_request, SignedHeaders=host;x-amz-date, Signature="))
+		fmt.Fprint(w, describeInstancesXML)
+	}))
+	defer ts.Close()
+
+	p := &awsProvider{
+		client:    ts.Client(),
+		endpoint:  ts.URL + "/",
+		region:    "us-east-1",
+		accessKey: "AKID",
+		secretKey: "secret",
+		now:       func() time.Time { return time.Date(2019, time.October, 1, 12, 0, 0, 0, time.UTC) },
+	}
+
+	instances, err := p.Instances(context.Background())
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, Instance{
+		ID:      "i-0123456789",
+		Name:    "web 01",
+		Type:    "t3.micro",
+		Region:  "us-east-1a",
+		Address: "10.0.0.12",
+		Tags:    map[string]string{"Name": "web 01", "team": "ops"},
+	}, instances[0])
+	assert.Equal(t, "web-01", instances[0].EntityName())
+	assert.Equal(t, "aws/us-east-1", p.Source())
+}
+
+func TestGCPInstances(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/acme/aggregated/instances", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"items": {"zones/us-central1-a": {"instances": [
+			{"id": "1", "name": "db-01", "status": "RUNNING", "labels": {"team": "dba"},
+			 "machineType": "https://compute.googleapis.com/compute/v1/projects/acme/zones/us-central1-a/machineTypes/n1-standard-1",
+			 "zone": "https://compute.googleapis.com/compute/v1/projects/acme/zones/us-central1-a",
+			 "networkInterfaces": [{"networkIP": "10.128.0.2"}]},
+			{"id": "2", "name": "db-02", "status": "TERMINATED"}
+		]}}}`)
+	}))
+	defer ts.Close()
+
+	p := &gcpProvider{client: ts.Client(), endpoint: ts.URL, project: "acme", token: "token"}
+	instances, err := p.Instances(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Instance{{
+		ID:      "1",
+		Name:    "db-01",
+		Type:    "n1-standard-1",
+		Region:  "us-central1-a",
+		Address: "10.128.0.2",
+		Tags:    map[string]string{"team": "dba"},
+	}}, instances)
+}
+
+func TestAzureInstances(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"value": [{"name": "vm-02", "location": "westeurope", "properties": {"vmId": "b"}}]}`)
+			return
+		}
+		assert.Equal(t, "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines", r.URL.Path)
+		fmt.Fprintf(w, `{"value": [{"name": "vm-01", "location": "westeurope", "tags": {"team": "web"},
+			"properties": {"vmId": "a", "hardwareProfile": {"vmSize": "Standard_B1s"}}}],
+			"nextLink": "%s/next?page=2"}`, ts.URL)
+	}))
+	defer ts.Close()
+
+	p := &azureProvider{client: ts.Client(), endpoint: ts.URL, subscriptionID: "sub", resourceGroup: "rg", token: "token"}
+	instances, err := p.Instances(context.Background())
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+	assert.Equal(t, Instance{
+		ID:     "a",
+		Name:   "vm-01",
+		Type:   "Standard_B1s",
+		Region: "westeurope",
+		Tags:   map[string]string{"team": "web"},
+	}, instances[0])
+	assert.Equal(t, "vm-02", instances[1].Name)
+	assert.Equal(t, "azure/sub/rg", p.Source())
+}
+
+func TestNewProvider(t *testing.T) {
+	_, err := NewProvider(Config{Provider: "oracle"})
+	assert.Error(t, err)
+
+	_, err = NewProvider(Config{Provider: ProviderAWS})
+	assert.Error(t, err)
+}
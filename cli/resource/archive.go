@@ -0,0 +1,128 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/version"
+)
+
+// ArchiveVersion is the version of the archives written by sensuctl dump. It
+// is incremented whenever the format of the archives changes in a way that
+// older versions of sensuctl can't restore.
+const ArchiveVersion = 1
+
+// Archive is a versioned export of the resources of a cluster, which can be
+// restored into another cluster.
+type Archive struct {
+	// Version is the version of the format of the archive.
+	Version int `json:"version"`
+
+	// SensuVersion is the version of sensuctl that wrote the archive.
+	SensuVersion string `json:"sensu_version"`
+
+	// CreatedAt is the time at which the archive was written.
+	CreatedAt int64 `json:"created_at"`
+
+	// Resources are the wrapped resources of the archive, in the order in
+	// which they are restored.
+	Resources []*types.Wrapper `json:"resources"`
+}
+
+// NewArchive returns an archive of the given resources.
+func NewArchive(resources []*types.Wrapper) *Archive {
+	return &Archive{
+		Version:      ArchiveVersion,
+		SensuVersion: version.Semver(),
+		CreatedAt:    time.Now().Unix(),
+		Resources:    resources,
+	}
+}
+
+// WriteArchive writes the archive to w.
+func WriteArchive(w io.Writer, archive *Archive) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(archive)
+}
+
+// ReadArchive reads an archive from r. It returns an error if the archive was
+// written by a newer version of sensuctl.
+func ReadArchive(r io.Reader) (*Archive, error) {
+	var archive Archive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("invalid archive: %s", err)
+	}
+	if archive.Version < 1 {
+		return nil, fmt.Errorf("invalid archive: missing version")
+	}
+	if archive.Version > ArchiveVersion {
+		return nil, fmt.Errorf(
+			"the archive version %d is not supported, it was written by sensuctl %s, which is newer than this sensuctl",
+			archive.Version, archive.SensuVersion,
+		)
+	}
+	for i, resource := range archive.Resources {
+		if resource == nil || resource.Value == nil {
+			return nil, fmt.Errorf("invalid archive: resource #%d is empty", i)
+		}
+	}
+	return &archive, nil
+}
+
+// RestoreArchive creates or replaces the resources of the archive, in order,
+// so that restoring an archive again leaves the cluster unchanged. Events
+// are only restored if events is true, since they are processed by the
+// pipeline of the cluster. All the resources are processed, and the error
+// lists the resources that failed, if any.
+func RestoreArchive(client client.GenericClient, archive *Archive, events bool) error {
+	var failures []string
+	var batch []*types.Wrapper
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := Bulk(client, http.MethodPut, batch); err != nil {
+			failures = append(failures, err.Error())
+		}
+		batch = nil
+	}
+
+	for i, resource := range archive.Resources {
+		switch resource.Value.(type) {
+		case *corev2.Event:
+			if !events {
+				continue
+			}
+		case *corev2.User:
+		default:
+			batch = append(batch, resource)
+			continue
+		}
+
+		// Users and events can't be created with bulk requests, the resources
+		// that precede them are restored first since they can depend on them
+		flush()
+		if err := client.PutResource(*resource); err != nil {
+			meta := resource.Value.GetObjectMeta()
+			failures = append(failures, fmt.Sprintf(
+				"resource #%d with name %q and namespace %q (%s): %s",
+				i, meta.Name, meta.Namespace, resource.Type, err,
+			))
+		}
+	}
+	flush()
+
+	if len(failures) > 0 {
+		return fmt.Errorf("the archive was partially restored:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
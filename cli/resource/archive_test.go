@@ -0,0 +1,81 @@
+package resource
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func archiveFixture() *Archive {
+	var resources []*types.Wrapper
+	for _, r := range []corev2.Resource{
+		corev2.FixtureNamespace("default"),
+		corev2.FixtureUser("foo"),
+		corev2.FixtureCheckConfig("check"),
+		corev2.FixtureEvent("entity", "check"),
+		corev2.FixtureHandler("handler"),
+	} {
+		wrapped := types.WrapResource(r)
+		resources = append(resources, &wrapped)
+	}
+	return NewArchive(resources)
+}
+
+func TestArchive(t *testing.T) {
+	archive := archiveFixture()
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteArchive(&buf, archive))
+	read, err := ReadArchive(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, ArchiveVersion, read.Version)
+	require.Len(t, read.Resources, len(archive.Resources))
+	for i := range archive.Resources {
+		assert.Equal(t, archive.Resources[i].Type, read.Resources[i].Type)
+		assert.Equal(t, archive.Resources[i].Value.GetObjectMeta().Name, read.Resources[i].Value.GetObjectMeta().Name)
+	}
+
+	_, err = ReadArchive(strings.NewReader(`{"version": 2, "resources": []}`))
+	assert.Error(t, err)
+	_, err = ReadArchive(strings.NewReader(`{"resources": []}`))
+	assert.Error(t, err)
+	_, err = ReadArchive(strings.NewReader(`{"version": 1, "resources": [{}]}`))
+	assert.Error(t, err)
+}
+
+func TestRestoreArchive(t *testing.T) {
+	archive := archiveFixture()
+	resources := archive.Resources
+
+	client := &clienttest.MockClient{}
+	client.On("BulkResources", http.MethodPut, resources[:1]).Return([]corev2.BulkResult{{}}, nil).Once()
+	client.On("PutResource", *resources[1]).Return(nil).Once()
+	client.On("BulkResources", http.MethodPut, []*types.Wrapper{resources[2], resources[4]}).Return([]corev2.BulkResult{{}, {}}, nil).Once()
+	require.NoError(t, RestoreArchive(client, archive, false))
+	client.AssertExpectations(t)
+
+	// The events are restored along with the resources that precede them
+	client = &clienttest.MockClient{}
+	client.On("BulkResources", http.MethodPut, resources[:1]).Return([]corev2.BulkResult{{}}, nil).Once()
+	client.On("PutResource", *resources[1]).Return(errors.New("error")).Once()
+	client.On("BulkResources", http.MethodPut, resources[2:3]).Return([]corev2.BulkResult{{}}, nil).Once()
+	client.On("PutResource", *resources[3]).Return(nil).Once()
+	client.On("BulkResources", http.MethodPut, resources[4:]).Return([]corev2.BulkResult{{}}, nil).Once()
+	err := RestoreArchive(client, archive, true)
+	assert.EqualError(t, err, "the archive was partially restored:\nresource #1 with name \"foo\" and namespace \"\" (User): error")
+	client.AssertExpectations(t)
+
+	client = &clienttest.MockClient{}
+	client.On("BulkResources", http.MethodPut, mock.Anything).Return([]corev2.BulkResult(nil), errors.New("error"))
+	client.On("PutResource", mock.Anything).Return(nil)
+	assert.Error(t, RestoreArchive(client, archive, false))
+}
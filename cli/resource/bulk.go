@@ -0,0 +1,58 @@
+package resource
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+)
+
+// BulkBatchSize is the number of resources sent per bulk request, which keeps
+// the requests under the size limit of the API.
+const BulkBatchSize = 500
+
+// BulkPutter is a Processor that puts resources in the API with bulk
+// requests.
+type BulkPutter struct{}
+
+// NewBulkPutter instantiates a new BulkPutter Processor.
+func NewBulkPutter() *BulkPutter {
+	return &BulkPutter{}
+}
+
+// Process puts resources in the API.
+func (p *BulkPutter) Process(client client.GenericClient, resources []*types.Wrapper) error {
+	return Bulk(client, http.MethodPut, resources)
+}
+
+// Bulk creates (POST), creates or replaces (PUT) or deletes (DELETE) the
+// given resources with bulk requests. All the resources are processed, and
+// the error lists the resources that failed, if any.
+func Bulk(client client.GenericClient, method string, resources []*types.Wrapper) error {
+	var failures []string
+	for start := 0; start < len(resources); start += BulkBatchSize {
+		end := start + BulkBatchSize
+		if end > len(resources) {
+			end = len(resources)
+		}
+		results, err := client.BulkResources(method, resources[start:end])
+		if err != nil {
+			return err
+		}
+		for i, result := range results {
+			if result.Error == "" {
+				continue
+			}
+			failures = append(failures, fmt.Sprintf(
+				"resource #%d with name %q and namespace %q (%s): %s",
+				start+i, result.Name, result.Namespace, result.Type, result.Error,
+			))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d resources failed:\n%s", len(failures), len(resources), strings.Join(failures, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBulk(t *testing.T) {
+	var resources []*types.Wrapper
+	for i := 0; i < BulkBatchSize+1; i++ {
+		wrapper := types.WrapResource(corev2.FixtureCheckConfig(fmt.Sprintf("check%d", i)))
+		resources = append(resources, &wrapper)
+	}
+
+	client := &clienttest.MockClient{}
+	client.On("BulkResources", http.MethodPut, resources[:BulkBatchSize]).
+		Return(make([]corev2.BulkResult, BulkBatchSize), nil)
+	client.On("BulkResources", http.MethodPut, resources[BulkBatchSize:]).
+		Return([]corev2.BulkResult{{Name: "foo", Status: http.StatusNotFound, Error: "not found"}}, nil)
+
+	err := Bulk(client, http.MethodPut, resources)
+	assert.EqualError(t, err, fmt.Sprintf(
+		"1 of %d resources failed:\nresource #%d with name \"foo\" and namespace \"\" (): not found",
+		len(resources), BulkBatchSize,
+	))
+	client.AssertNumberOfCalls(t, "BulkResources", 2)
+
+	client = &clienttest.MockClient{}
+	client.On("BulkResources", http.MethodDelete, mock.Anything).
+		Return([]corev2.BulkResult(nil), errors.New("error"))
+	assert.Error(t, Bulk(client, http.MethodDelete, resources))
+	client.AssertNumberOfCalls(t, "BulkResources", 1)
+}
@@ -0,0 +1,212 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Differ is a Processor that prints, field by field, the changes the resources
+// would make to the cluster, as validated by the API without persisting them.
+// The resources are then processed by the next Processor, if any.
+type Differ struct {
+	// Apply previews the changes of applying the resources, i.e. merging
+	// their fields into the existing resources, rather than replacing them.
+	Apply bool
+
+	// Next processes the resources once their changes are printed.
+	Next Processor
+
+	out io.Writer
+}
+
+// NewDiffer instantiates a new Differ Processor, which prints the changes to
+// out and hands the resources to next, if not nil.
+func NewDiffer(out io.Writer, apply bool, next Processor) *Differ {
+	return &Differ{Apply: apply, Next: next, out: out}
+}
+
+// Preview returns the processor of resources which prints their changes to
+// out before processing them with the given processor if diff is true, and
+// only validates them if dryRun is true.
+func Preview(out io.Writer, diff, dryRun, apply bool, processor Processor) Processor {
+	if !diff && !dryRun {
+		return processor
+	}
+	if !diff {
+		out = ioutil.Discard
+	}
+	if dryRun {
+		processor = nil
+	}
+	return NewDiffer(out, apply, processor)
+}
+
+// Process prints the changes of the resources, then processes them with the
+// next Processor.
+func (d *Differ) Process(c client.GenericClient, resources []*types.Wrapper) error {
+	for i, resource := range resources {
+		before, err := getResource(c, resource.Value)
+		if err != nil {
+			return fmt.Errorf("error getting resource #%d with name %q and namespace %q (%s): %s",
+				i, resource.ObjectMeta.Name, resource.ObjectMeta.Namespace, resource.Value.URIPath(), err)
+		}
+		after, err := c.DryRunResource(*resource, d.Apply && before != nil)
+		if err != nil {
+			return fmt.Errorf("error validating resource #%d with name %q and namespace %q (%s): %s",
+				i, resource.ObjectMeta.Name, resource.ObjectMeta.Namespace, resource.Value.URIPath(), err)
+		}
+		if err := d.print(Name(resource.Value), before, after); err != nil {
+			return err
+		}
+	}
+	if d.Next != nil {
+		return d.Next.Process(c, resources)
+	}
+	return nil
+}
+
+func (d *Differ) print(name string, before, after types.Resource) error {
+	if before == nil {
+		_, err := fmt.Fprintf(d.out, "+ %s\n", name)
+		return err
+	}
+	changes, err := diffResources(before, after)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		_, err := fmt.Fprintf(d.out, "= %s\n", name)
+		return err
+	}
+	if _, err := fmt.Fprintf(d.out, "~ %s\n", name); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if _, err := fmt.Fprintf(d.out, "  %s\n", change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Name returns the fully qualified type, namespace and name of a resource,
+// e.g. core/v2.CheckConfig default/check-cpu.
+func Name(r types.Resource) string {
+	wrapped := types.WrapResource(r)
+	meta := r.GetObjectMeta()
+	return fmt.Sprintf("%s.%s %s/%s", wrapped.APIVersion, wrapped.Type, meta.Namespace, meta.Name)
+}
+
+// getResource returns the resource of the cluster at the URIPath of the given
+// resource, or nil if it doesn't exist.
+func getResource(c client.GenericClient, r types.Resource) (types.Resource, error) {
+	existing := reflect.New(reflect.TypeOf(r).Elem()).Interface().(types.Resource)
+	err := c.Get(r.URIPath(), existing)
+	if apiErr, ok := err.(client.APIError); ok && apiErr.Code == uint32(actions.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// A fieldChange is the change of a field of a resource, added if it has no
+// value before, removed if it has no value after.
+type fieldChange struct {
+	path          string
+	before, after interface{}
+	added         bool
+	removed       bool
+}
+
+func (c fieldChange) String() string {
+	switch {
+	case c.added:
+		return fmt.Sprintf("+ %s: %s", c.path, jsonValue(c.after))
+	case c.removed:
+		return fmt.Sprintf("- %s: %s", c.path, jsonValue(c.before))
+	default:
+		return fmt.Sprintf("~ %s: %s => %s", c.path, jsonValue(c.before), jsonValue(c.after))
+	}
+}
+
+func jsonValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// diffResources returns the changes of the fields of a resource, except its
+// resource version, which is set by the store.
+func diffResources(before, after types.Resource) ([]fieldChange, error) {
+	var docs [2]interface{}
+	for i, r := range []types.Resource{before, after} {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &docs[i]); err != nil {
+			return nil, err
+		}
+		if doc, ok := docs[i].(map[string]interface{}); ok {
+			if meta, ok := doc["metadata"].(map[string]interface{}); ok {
+				delete(meta, "resource_version")
+			}
+		}
+	}
+	var changes []fieldChange
+	diffValues("", docs[0], docs[1], &changes)
+	return changes, nil
+}
+
+// diffValues adds the changes between two JSON values to changes. The objects
+// are compared member by member, and the other values as a whole.
+func diffValues(path string, before, after interface{}, changes *[]fieldChange) {
+	beforeObject, ok := before.(map[string]interface{})
+	afterObject, ok2 := after.(map[string]interface{})
+	if !ok || !ok2 {
+		if !reflect.DeepEqual(before, after) {
+			*changes = append(*changes, fieldChange{path: path, before: before, after: after})
+		}
+		return
+	}
+
+	keys := make([]string, 0, len(beforeObject)+len(afterObject))
+	for key := range beforeObject {
+		keys = append(keys, key)
+	}
+	for key := range afterObject {
+		if _, ok := beforeObject[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field := key
+		if path != "" {
+			field = path + "." + key
+		}
+		beforeValue, inBefore := beforeObject[key]
+		afterValue, inAfter := afterObject[key]
+		switch {
+		case !inBefore:
+			*changes = append(*changes, fieldChange{path: field, after: afterValue, added: true})
+		case !inAfter:
+			*changes = append(*changes, fieldChange{path: field, before: beforeValue, removed: true})
+		default:
+			diffValues(field, beforeValue, afterValue, changes)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package resource
+
+import (
+	"bytes"
+	"testing"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/cli/client"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffer(t *testing.T) {
+	existing := corev2.FixtureCheckConfig("check-cpu")
+	existing.ResourceVersion = "42"
+	existing.Labels = map[string]string{"region": "us-west-1"}
+	existing.Ttl = 100
+
+	changed := corev2.FixtureCheckConfig("check-cpu")
+	changed.Interval = 30
+	changed.Labels = map[string]string{"region": "us-west-1", "team": "ops"}
+	changed.Timeout = 10
+
+	unchanged := corev2.FixtureCheckConfig("check-mem")
+	created := corev2.FixtureHandler("slack")
+
+	c := &clienttest.MockClient{}
+	c.On("Get", existing.URIPath(), mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(1).(*corev2.CheckConfig) = *existing
+	}).Return(nil)
+	c.On("Get", unchanged.URIPath(), mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(1).(*corev2.CheckConfig) = *unchanged
+	}).Return(nil)
+	c.On("Get", created.URIPath(), mock.Anything).Return(client.APIError{Code: uint32(actions.NotFound)})
+	c.On("DryRunResource", mock.Anything, true).Return(changed, nil).Once()
+	c.On("DryRunResource", mock.Anything, true).Return(unchanged, nil).Once()
+	c.On("DryRunResource", mock.Anything, false).Return(created, nil).Once()
+
+	resources := []*types.Wrapper{
+		{Value: changed},
+		{Value: unchanged},
+		{Value: created},
+	}
+	c.On("ApplyResource", mock.Anything).Return(nil)
+
+	var out bytes.Buffer
+	differ := NewDiffer(&out, true, NewApplier())
+	require.NoError(t, differ.Process(c, resources))
+	c.AssertExpectations(t)
+	c.AssertNumberOfCalls(t, "ApplyResource", len(resources))
+
+	assert.Equal(t, `~ core/v2.CheckConfig default/check-cpu
+  ~ interval: 60 => 30
+  + metadata.labels.team: "ops"
+  ~ timeout: 0 => 10
+  ~ ttl: 100 => 0
+= core/v2.CheckConfig default/check-mem
++ core/v2.Handler default/slack
+`, out.String())
+}
+
+func TestPreview(t *testing.T) {
+	putter := NewPutter()
+	var out bytes.Buffer
+
+	assert.Equal(t, putter, Preview(&out, false, false, false, putter))
+
+	differ := Preview(&out, true, false, true, putter).(*Differ)
+	assert.Equal(t, putter, differ.Next)
+	assert.True(t, differ.Apply)
+	assert.Equal(t, &out, differ.out)
+
+	differ = Preview(&out, false, true, false, putter).(*Differ)
+	assert.Nil(t, differ.Next)
+	assert.NotEqual(t, &out, differ.out)
+}
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/client"
 	"github.com/sensu/sensu-go/types"
@@ -144,3 +145,29 @@ func (p *Putter) Process(client client.GenericClient, resources []*types.Wrapper
 	}
 	return nil
 }
+
+// Applier is a Processor that applies resources in the API, i.e. merges their
+// fields into the existing resources, and creates the missing ones.
+type Applier struct{}
+
+// NewApplier instantiates a new Applier Processor.
+func NewApplier() *Applier {
+	return &Applier{}
+}
+
+// Process applies resources in the API.
+func (a *Applier) Process(c client.GenericClient, resources []*types.Wrapper) error {
+	for i, resource := range resources {
+		err := c.ApplyResource(*resource)
+		if apiErr, ok := err.(client.APIError); ok && apiErr.Code == uint32(actions.NotFound) {
+			err = c.PutResource(*resource)
+		}
+		if err != nil {
+			return fmt.Errorf(
+				"error applying resource #%d with name %q and namespace %q (%s): %s",
+				i, resource.ObjectMeta.Name, resource.ObjectMeta.Namespace, resource.Value.URIPath(), err,
+			)
+		}
+	}
+	return nil
+}
@@ -11,9 +11,11 @@ import (
 	"testing"
 
 	corev2 "github.com/sensu/sensu-go/api/core/v2"
+	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/client"
 	"github.com/sensu/sensu-go/cli/client/config"
+	clienttest "github.com/sensu/sensu-go/cli/client/testing"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -63,3 +65,21 @@ func TestProcessFile(t *testing.T) {
 	config.On("Namespace").Return("")
 	assert.NoError(t, ProcessFile(&cli.SensuCli{Config: config}, fp, false, processor))
 }
+
+func TestApplier(t *testing.T) {
+	existing := types.WrapResource(corev2.FixtureCheckConfig("existing"))
+	missing := types.WrapResource(corev2.FixtureCheckConfig("missing"))
+
+	c := &clienttest.MockClient{}
+	c.On("ApplyResource", existing).Return(nil)
+	c.On("ApplyResource", missing).Return(client.APIError{Code: uint32(actions.NotFound)})
+	c.On("PutResource", missing).Return(nil)
+
+	require.NoError(t, NewApplier().Process(c, []*types.Wrapper{&existing, &missing}))
+	c.AssertNumberOfCalls(t, "PutResource", 1)
+
+	c = &clienttest.MockClient{}
+	c.On("ApplyResource", existing).Return(client.APIError{Code: uint32(actions.PreconditionFailed)})
+	assert.Error(t, NewApplier().Process(c, []*types.Wrapper{&existing}))
+	c.AssertNotCalled(t, "PutResource", mock.Anything)
+}
@@ -18,6 +18,11 @@ var (
 		&corev2.User{},
 		&corev2.APIKey{},
 		&corev2.TessenConfig{},
+		&corev2.NamespaceTemplate{},
+		&corev2.SecretsProvider{},
+		&corev2.AdmissionWebhook{},
+		&corev2.Replicator{},
+		&corev2.FederatedCluster{},
 		&corev2.Asset{},
 		&corev2.CheckConfig{},
 		&corev2.Entity{},
@@ -29,6 +34,14 @@ var (
 		&corev2.Role{},
 		&corev2.RoleBinding{},
 		&corev2.Silenced{},
+		&corev2.ResourceQuota{},
+		&corev2.KeepalivePolicy{},
+		&corev2.MaintenanceWindow{},
+		&corev2.SecretConfig{},
+		&corev2.Correlation{},
+		&corev2.Enricher{},
+		&corev2.EventRetentionPolicy{},
+		&corev2.Extension{},
 	}
 
 	// synonyms provides user-friendly resource synonyms like checks, entities
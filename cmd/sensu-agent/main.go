@@ -21,6 +21,7 @@ func main() {
 
 	rootCmd.AddCommand(cmd.VersionCommand())
 	rootCmd.AddCommand(cmd.StartCommand(agent.NewAgentContext))
+	rootCmd.AddCommand(cmd.AssetsCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		logger.WithError(err).Fatal("error executing sensu-agent")
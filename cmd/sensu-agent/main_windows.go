@@ -23,6 +23,7 @@ func main() {
 
 	rootCmd.AddCommand(cmd.VersionCommand())
 	rootCmd.AddCommand(cmd.StartCommand(agent.NewAgentContext))
+	rootCmd.AddCommand(cmd.AssetsCommand())
 	rootCmd.AddCommand(cmd.NewWindowsServiceCommand())
 
 	if err := rootCmd.Execute(); err != nil {
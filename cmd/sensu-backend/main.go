@@ -1,3 +1,5 @@
+//+build !windows
+
 package main
 
 import (
@@ -24,6 +26,8 @@ func main() {
 	rootCmd.AddCommand(cmd.StartCommand(backend.Initialize))
 	rootCmd.AddCommand(cmd.VersionCommand())
 	rootCmd.AddCommand(cmd.InitCommand())
+	rootCmd.AddCommand(cmd.SnapshotCommand())
+	rootCmd.AddCommand(cmd.DiagnosticsCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		if err == seeds.ErrAlreadyInitialized {
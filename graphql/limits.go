@@ -0,0 +1,176 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+)
+
+const (
+	// ErrCodeMaxDepthExceeded is the code of the error returned when a query
+	// is deeper than the maximum depth.
+	ErrCodeMaxDepthExceeded = "ERR_MAX_DEPTH_EXCEEDED"
+
+	// ErrCodeMaxCostExceeded is the code of the error returned when the cost
+	// of a query is higher than the maximum cost.
+	ErrCodeMaxCostExceeded = "ERR_MAX_COST_EXCEEDED"
+)
+
+// costMultiplierArgs are the arguments limiting the number of records
+// returned by a field, by which the cost of its selections is multiplied.
+var costMultiplierArgs = []string{"limit", "first", "last"}
+
+// checkLimits returns a result with an error if the depth or the cost of an
+// operation of given document exceeds the limits of the service.
+//
+// The depth of an operation is the maximum nesting of its fields. Its cost is
+// the number of fields it selects, where the cost of the selections of a field
+// is multiplied by its limit, first or last argument, if any. The introspection
+// fields are not counted.
+func (service *Service) checkLimits(doc *ast.Document, variables map[string]interface{}) *Result {
+	if service.MaxDepth <= 0 && service.MaxCost <= 0 {
+		return nil
+	}
+
+	a := &queryAnalyzer{
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+		visiting:  map[string]bool{},
+	}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			a.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		depth, cost := a.analyze(op.SelectionSet)
+		if service.MaxDepth > 0 && depth > service.MaxDepth {
+			msg := fmt.Sprintf("query depth of %d exceeds the maximum depth of %d", depth, service.MaxDepth)
+			return limitResult(msg, map[string]interface{}{
+				"code":     ErrCodeMaxDepthExceeded,
+				"depth":    depth,
+				"maxDepth": service.MaxDepth,
+			})
+		}
+		if service.MaxCost > 0 && cost > service.MaxCost {
+			msg := fmt.Sprintf("query cost of %d exceeds the maximum cost of %d", cost, service.MaxCost)
+			return limitResult(msg, map[string]interface{}{
+				"code":    ErrCodeMaxCostExceeded,
+				"cost":    cost,
+				"maxCost": service.MaxCost,
+			})
+		}
+	}
+	return nil
+}
+
+func limitResult(message string, extensions map[string]interface{}) *Result {
+	err := gqlerrors.FormattedError{
+		Message:    message,
+		Locations:  []location.SourceLocation{},
+		Extensions: extensions,
+	}
+	return &Result{Errors: []gqlerrors.FormattedError{err}}
+}
+
+type queryAnalyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+
+	// visiting holds the fragments being analyzed, to break the cycles of
+	// the documents that were not validated.
+	visiting map[string]bool
+}
+
+// analyze returns the depth and the cost of given selection set.
+func (a *queryAnalyzer) analyze(set *ast.SelectionSet) (depth int, cost int) {
+	if set == nil {
+		return 0, 0
+	}
+	for _, selection := range set.Selections {
+		var d, c int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Name == nil || strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+			d, c = a.analyze(selection.SelectionSet)
+			d++
+			c = 1 + a.multiplier(selection)*c
+		case *ast.InlineFragment:
+			d, c = a.analyze(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			if selection.Name == nil {
+				continue
+			}
+			name := selection.Name.Value
+			fragment, ok := a.fragments[name]
+			if !ok || a.visiting[name] {
+				continue
+			}
+			a.visiting[name] = true
+			d, c = a.analyze(fragment.SelectionSet)
+			a.visiting[name] = false
+		}
+		if d > depth {
+			depth = d
+		}
+		cost += c
+	}
+	return depth, cost
+}
+
+// multiplier returns the number of records the field is limited to, or one.
+func (a *queryAnalyzer) multiplier(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name == nil || !isCostMultiplierArg(arg.Name.Value) {
+			continue
+		}
+		var n int
+		switch value := arg.Value.(type) {
+		case *ast.IntValue:
+			n, _ = strconv.Atoi(value.Value)
+		case *ast.Variable:
+			if value.Name != nil {
+				n = intValue(a.variables[value.Name.Value])
+			}
+		}
+		if n > 1 {
+			return n
+		}
+	}
+	return 1
+}
+
+func isCostMultiplierArg(name string) bool {
+	for _, arg := range costMultiplierArgs {
+		if arg == name {
+			return true
+		}
+	}
+	return false
+}
+
+func intValue(v interface{}) int {
+	switch v := v.(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	return 0
+}
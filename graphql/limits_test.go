@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLimitsService(t *testing.T, maxDepth, maxCost int) *Service {
+	svc := NewService()
+	svc.MaxDepth = maxDepth
+	svc.MaxCost = maxCost
+	svc.RegisterObject(ObjectDesc{
+		Config: func() graphql.ObjectConfig {
+			return graphql.ObjectConfig{
+				Name:       "Node",
+				Interfaces: []*graphql.Interface{},
+				Fields: graphql.Fields{
+					"name": &graphql.Field{Name: "name", Type: graphql.String},
+					"children": &graphql.Field{
+						Name: "children",
+						Type: graphql.NewList(OutputType("Node")),
+						Args: graphql.FieldConfigArgument{
+							"limit": &graphql.ArgumentConfig{Type: graphql.Int},
+						},
+					},
+				},
+			}
+		},
+	}, nil)
+	svc.RegisterObject(ObjectDesc{
+		Config: func() graphql.ObjectConfig {
+			return graphql.ObjectConfig{
+				Name:       "Query",
+				Interfaces: []*graphql.Interface{},
+				Fields:     graphql.Fields{"node": &graphql.Field{Name: "node", Type: OutputType("Node")}},
+			}
+		},
+	}, nil)
+	svc.RegisterSchema(SchemaDesc{
+		Config: func() graphql.SchemaConfig {
+			return graphql.SchemaConfig{Query: Object("Query")}
+		},
+	})
+	require.NoError(t, svc.Regenerate())
+	return svc
+}
+
+func TestServiceMaxDepth(t *testing.T) {
+	svc := newLimitsService(t, 3, 0)
+
+	result := svc.Do(context.Background(), QueryParams{Query: "{ node { children { name } } }"})
+	assert.Empty(t, result.Errors)
+
+	query := "{ node { children { children { name } } } }"
+	result = svc.Do(context.Background(), QueryParams{Query: query})
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "query depth of 4 exceeds the maximum depth of 3", result.Errors[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"code":     ErrCodeMaxDepthExceeded,
+		"depth":    4,
+		"maxDepth": 3,
+	}, result.Errors[0].Extensions)
+
+	// The limits are enforced even if the validation is skipped
+	result = svc.Do(context.Background(), QueryParams{Query: query, SkipValidation: true})
+	assert.Len(t, result.Errors, 1)
+
+	// The fragments are expanded
+	query = "{ node { ...F } } fragment F on Node { children { children { name } } }"
+	result = svc.Do(context.Background(), QueryParams{Query: query})
+	assert.Len(t, result.Errors, 1)
+
+	// The introspection fields are not counted
+	query = "{ __schema { types { fields { type { ofType { name } } } } } }"
+	result = svc.Do(context.Background(), QueryParams{Query: query})
+	assert.Empty(t, result.Errors)
+}
+
+func TestServiceMaxCost(t *testing.T) {
+	svc := newLimitsService(t, 0, 100)
+
+	// 1 + 1 * (1 + 10 * (1 + 1 * 1))
+	result := svc.Do(context.Background(), QueryParams{Query: "{ node { children(limit: 10) { name children { name } } } }"})
+	assert.Empty(t, result.Errors)
+
+	// 1 + 1 * (1 + 10 * (1 + 10 * 1))
+	query := "{ node { children(limit: 10) { children(limit: 10) { name } } } }"
+	result = svc.Do(context.Background(), QueryParams{Query: query})
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "query cost of 112 exceeds the maximum cost of 100", result.Errors[0].Message)
+	assert.Equal(t, ErrCodeMaxCostExceeded, result.Errors[0].Extensions["code"])
+
+	// The limits given as variables are counted
+	query = "query ($n: Int) { node { children(limit: $n) { children(limit: $n) { name } } } }"
+	result = svc.Do(context.Background(), QueryParams{Query: query, Variables: map[string]interface{}{"n": float64(5)}})
+	assert.Empty(t, result.Errors)
+	result = svc.Do(context.Background(), QueryParams{Query: query, Variables: map[string]interface{}{"n": float64(50)}})
+	assert.Len(t, result.Errors, 1)
+
+	// Recursive fragments do not loop forever
+	query = "{ node { ...F } } fragment F on Node { children(limit: 10) { ...F } }"
+	result = svc.Do(context.Background(), QueryParams{Query: query, SkipValidation: true})
+	assert.Empty(t, result.Errors)
+}
+
+func TestServiceNoLimits(t *testing.T) {
+	svc := newLimitsService(t, 0, 0)
+	query := "{ node { children(limit: 1000) { children(limit: 1000) { children { name } } } } }"
+	result := svc.Do(context.Background(), QueryParams{Query: query})
+	assert.Empty(t, result.Errors)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/language/parser"
 	"github.com/graphql-go/graphql/language/source"
 )
@@ -16,6 +17,14 @@ type Service struct {
 	// the default executor is used.
 	Executor func(p graphql.ExecuteParams) *graphql.Result
 
+	// MaxDepth is the maximum depth of the queries executed by the service. A
+	// value of zero disables the limit.
+	MaxDepth int
+
+	// MaxCost is the maximum cost of the queries executed by the service. A
+	// value of zero disables the limit.
+	MaxCost int
+
 	schema graphql.Schema
 	types  *typeRegister
 	mware  []Middleware
@@ -162,6 +171,24 @@ type QueryParams struct {
 
 // Do executes given query.
 func (service *Service) Do(ctx context.Context, p QueryParams) *Result {
+	schema := service.schema
+	AST, result := service.parse(ctx, p)
+	if result != nil {
+		return result
+	}
+
+	// execute query
+	return service.Executor(graphql.ExecuteParams{
+		Schema:  schema,
+		AST:     AST,
+		Args:    p.Variables,
+		Context: ctx,
+	})
+}
+
+// parse parses and validates given query. The result is not nil if the query
+// is invalid.
+func (service *Service) parse(ctx context.Context, p QueryParams) (*ast.Document, *Result) {
 	schema := service.schema
 	params := graphql.Params{
 		Context:        ctx,
@@ -184,7 +211,7 @@ func (service *Service) Do(ctx context.Context, p QueryParams) *Result {
 	AST, err := parser.Parse(parser.ParseParams{Source: source})
 	parseFinishFn(err)
 	if err != nil {
-		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+		return nil, &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
 	}
 
 	// validate document
@@ -193,17 +220,15 @@ func (service *Service) Do(ctx context.Context, p QueryParams) *Result {
 		validationResult := graphql.ValidateDocument(&schema, AST, nil)
 		validationFinishFn(validationResult.Errors)
 		if !validationResult.IsValid {
-			return &graphql.Result{Errors: validationResult.Errors}
+			return nil, &graphql.Result{Errors: validationResult.Errors}
 		}
 	}
 
-	// execute query
-	return service.Executor(graphql.ExecuteParams{
-		Schema:  schema,
-		AST:     AST,
-		Args:    p.Variables,
-		Context: ctx,
-	})
+	// reject the queries exceeding the limits, even when not validated
+	if result := service.checkLimits(AST, p.Variables); result != nil {
+		return nil, result
+	}
+	return AST, nil
 }
 
 type typeRegister struct {
@@ -0,0 +1,151 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// SubscribeFn creates the source stream of a subscription, the channel of the
+// payloads to which its root field resolves. The channel must be closed once
+// ctx is done.
+type SubscribeFn func(ctx context.Context) (<-chan interface{}, error)
+
+// subscribeRoot is the root value of a subscription while its source stream
+// is created.
+type subscribeRoot struct {
+	stream <-chan interface{}
+}
+
+// ResolveSubscription resolves the root field of a subscription. When the
+// subscription starts, fn is called to create its source stream. Then the
+// field resolves to each payload of the stream.
+//
+// == Example implementation
+//
+//	func (r *subscriptionImpl) Clock(p graphql.ResolveParams) (interface{}, error) {
+//	  return graphql.ResolveSubscription(p, func(ctx context.Context) (<-chan interface{}, error) {
+//	    ch := make(chan interface{})
+//	    go tick(ctx, ch)
+//	    return ch, nil
+//	  })
+//	}
+func ResolveSubscription(p ResolveParams, fn SubscribeFn) (interface{}, error) {
+	root, ok := p.Source.(*subscribeRoot)
+	if !ok {
+		return p.Source, nil
+	}
+	stream, err := fn(p.Context)
+	root.stream = stream
+	return nil, err
+}
+
+// Subscribe executes given subscription and returns the channel of its
+// results, one for each payload of its source stream. The subscription is
+// executed with the context returned by execCtx, if not nil, for each payload.
+// The channel is closed once ctx is done or the source stream is closed. If the
+// subscription is invalid or its source stream cannot be created, the channel
+// only receives a result with the errors.
+func (service *Service) Subscribe(ctx context.Context, p QueryParams, execCtx func(context.Context) context.Context) <-chan *Result {
+	results := make(chan *Result, 1)
+	schema := service.schema
+	AST, result := service.parse(ctx, p)
+	if result == nil {
+		result = validateSubscription(AST, p.OperationName)
+	}
+	if result != nil {
+		results <- result
+		close(results)
+		return results
+	}
+
+	// Create the source stream
+	root := &subscribeRoot{}
+	result = service.Executor(graphql.ExecuteParams{
+		Schema:        schema,
+		AST:           AST,
+		Root:          root,
+		OperationName: p.OperationName,
+		Args:          p.Variables,
+		Context:       ctx,
+	})
+	if len(result.Errors) == 0 && root.stream == nil {
+		result.Errors = gqlerrors.FormatErrors(errors.New("the subscription has no source stream"))
+	}
+	if len(result.Errors) > 0 {
+		results <- &Result{Errors: result.Errors}
+		close(results)
+		return results
+	}
+
+	go func() {
+		defer close(results)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-root.stream:
+				if !ok {
+					return
+				}
+				payloadCtx := ctx
+				if execCtx != nil {
+					payloadCtx = execCtx(ctx)
+				}
+				result := service.Executor(graphql.ExecuteParams{
+					Schema:        schema,
+					AST:           AST,
+					Root:          payload,
+					OperationName: p.OperationName,
+					Args:          p.Variables,
+					Context:       payloadCtx,
+				})
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return results
+}
+
+// validateSubscription ensures that the operation of given document is a
+// subscription selecting a single root field.
+func validateSubscription(doc *ast.Document, operationName string) *Result {
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName == "" && operation != nil {
+			return errorResult("must provide the operation name if the query contains multiple operations")
+		}
+		if operationName == "" || (op.Name != nil && op.Name.Value == operationName) {
+			operation = op
+		}
+	}
+	if operation == nil {
+		return errorResult("unknown operation")
+	}
+	if operation.Operation != ast.OperationTypeSubscription {
+		return errorResult("the operation must be a subscription")
+	}
+	if operation.SelectionSet == nil || len(operation.SelectionSet.Selections) != 1 {
+		return errorResult("a subscription must select exactly one root field")
+	}
+	if _, ok := operation.SelectionSet.Selections[0].(*ast.Field); !ok {
+		return errorResult("a subscription must select exactly one root field")
+	}
+	return nil
+}
+
+func errorResult(msg string) *Result {
+	return &Result{Errors: gqlerrors.FormatErrors(errors.New(msg))}
+}
@@ -0,0 +1,106 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countKey struct{}
+
+func newSubscriptionService(t *testing.T, fn SubscribeFn) *Service {
+	svc := NewService()
+	svc.RegisterObject(ObjectDesc{
+		Config: func() graphql.ObjectConfig {
+			return graphql.ObjectConfig{
+				Name:       "Query",
+				Interfaces: []*graphql.Interface{},
+				Fields:     graphql.Fields{"hello": &graphql.Field{Name: "hello", Type: graphql.String}},
+			}
+		},
+	}, nil)
+	svc.RegisterObject(ObjectDesc{
+		Config: func() graphql.ObjectConfig {
+			return graphql.ObjectConfig{
+				Name:       "Subscription",
+				Interfaces: []*graphql.Interface{},
+				Fields: graphql.Fields{
+					"count": &graphql.Field{Name: "count", Type: graphql.Int},
+					"other": &graphql.Field{Name: "other", Type: graphql.Int},
+				},
+			}
+		},
+		FieldHandlers: map[string]FieldHandler{
+			"count": func(interface{}) graphql.FieldResolveFn {
+				return func(p graphql.ResolveParams) (interface{}, error) {
+					v, err := ResolveSubscription(p, fn)
+					if n, ok := v.(int); ok {
+						// The payloads are resolved with the execution context
+						return n * p.Context.Value(countKey{}).(int), nil
+					}
+					return v, err
+				}
+			},
+		},
+	}, nil)
+	svc.RegisterSchema(SchemaDesc{
+		Config: func() graphql.SchemaConfig {
+			return graphql.SchemaConfig{
+				Query:        Object("Query"),
+				Subscription: Object("Subscription"),
+			}
+		},
+	})
+	require.NoError(t, svc.Regenerate())
+	return svc
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := newSubscriptionService(t, func(ctx context.Context) (<-chan interface{}, error) {
+		ch := make(chan interface{}, 3)
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+		close(ch)
+		return ch, nil
+	})
+	execCtx := func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, countKey{}, 10)
+	}
+
+	var counts []interface{}
+	for result := range svc.Subscribe(ctx, QueryParams{Query: "subscription { count }"}, execCtx) {
+		require.Empty(t, result.Errors)
+		counts = append(counts, result.Data.(map[string]interface{})["count"])
+	}
+	assert.Equal(t, []interface{}{10, 20, 30}, counts)
+}
+
+func TestSubscribeErrors(t *testing.T) {
+	svc := newSubscriptionService(t, func(ctx context.Context) (<-chan interface{}, error) {
+		return nil, errors.New("unauthorized")
+	})
+
+	queries := []string{
+		"subscription {",
+		"query { hello }",
+		"subscription { count other }",
+		"subscription a { count } subscription b { count }",
+		"subscription { count }",
+	}
+	for _, query := range queries {
+		var results []*Result
+		for result := range svc.Subscribe(context.Background(), QueryParams{Query: query}, nil) {
+			results = append(results, result)
+		}
+		require.Len(t, results, 1, query)
+		assert.NotEmpty(t, results[0].Errors, query)
+	}
+}
@@ -120,24 +120,45 @@ func addTimeFuncs(vm *otto.Otto) error {
 
 // Evaluate evaluates the javascript expression with parameters applied.
 // If scripts is non-nil, then the scripts will be evaluated in the
-// expression's runtime context before the expression is evaluated.
+// expression's runtime context before the expression is evaluated. The
+// evaluation is interrupted if it exceeds the limits set with SetLimits.
 func Evaluate(expr string, parameters interface{}, assets JavascriptAssets) (bool, error) {
-	jsvm, err := newOttoVM(assets)
+	value, err := evaluate(expr, parameters, assets)
 	if err != nil {
 		return false, err
 	}
+	return value.ToBoolean()
+}
+
+// EvaluateString evaluates the javascript expression with parameters applied,
+// like Evaluate, and returns its result as a string. The result is empty if
+// the expression evaluates to undefined or null.
+func EvaluateString(expr string, parameters interface{}, assets JavascriptAssets) (string, error) {
+	value, err := evaluate(expr, parameters, assets)
+	if err != nil {
+		return "", err
+	}
+	if value.IsUndefined() || value.IsNull() {
+		return "", nil
+	}
+	return value.ToString()
+}
+
+func evaluate(expr string, parameters interface{}, assets JavascriptAssets) (otto.Value, error) {
+	jsvm, err := newOttoVM(assets)
+	if err != nil {
+		return otto.Value{}, err
+	}
 	if params, ok := parameters.(map[string]interface{}); ok {
 		for name, value := range params {
 			if err := jsvm.Set(name, value); err != nil {
-				return false, err
+				return otto.Value{}, err
 			}
 		}
 	}
-	value, err := jsvm.Run(expr)
-	if err != nil {
-		return false, err
-	}
-	return value.ToBoolean()
+	return run(jsvm, func() (otto.Value, error) {
+		return jsvm.Run(expr)
+	})
 }
 
 // EntityFilterResult is returned by EvaluateEntityFilters
@@ -179,7 +200,9 @@ func MatchEntities(expressions []string, entities []interface{}) ([]bool, error)
 		}
 		var filtered bool
 		for _, script := range scripts {
-			result, err := jsvm.Run(script)
+			result, err := run(jsvm, func() (otto.Value, error) {
+				return jsvm.Run(script)
+			})
 			if err != nil {
 				logger.WithError(err).Debugf("error executing entity filter (%s)", script.String())
 				filtered = false
@@ -0,0 +1,25 @@
+package js
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateString(t *testing.T) {
+	parameters := map[string]interface{}{
+		"event": map[string]interface{}{"region": "us-west", "count": 2},
+	}
+
+	result, err := EvaluateString("event.region + '-' + event.count", parameters, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "us-west-2", result)
+
+	result, err = EvaluateString("event.zone", parameters, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", result)
+
+	_, err = EvaluateString("event.zone.name", parameters, nil)
+	assert.Error(t, err)
+}
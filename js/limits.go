@@ -0,0 +1,167 @@
+package js
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/robertkrimen/otto"
+)
+
+const (
+	// DefaultTimeout is the default maximum duration of an evaluation.
+	DefaultTimeout = time.Second
+
+	// EvaluationsInterruptedCounterVec is the name of the prometheus counter
+	// vec used to count the interrupted evaluations.
+	EvaluationsInterruptedCounterVec = "sensu_go_js_evaluations_interrupted"
+
+	// InterruptedLabelName is the name of the label which stores the reason
+	// of the interruptions.
+	InterruptedLabelName = "reason"
+
+	reasonTimeout = "timeout"
+	reasonMemory  = "memory"
+)
+
+var (
+	// ErrTimeout is returned when an evaluation exceeds its time limit.
+	ErrTimeout = errors.New("javascript evaluation timed out")
+
+	// ErrMemoryLimit is returned when an evaluation exceeds its memory limit.
+	ErrMemoryLimit = errors.New("javascript evaluation exceeded its memory limit")
+
+	// EvaluationsInterrupted counts the evaluations interrupted because they
+	// exceeded their limits.
+	EvaluationsInterrupted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: EvaluationsInterruptedCounterVec,
+			Help: "The total number of javascript evaluations interrupted because they exceeded their limits",
+		},
+		[]string{InterruptedLabelName},
+	)
+
+	// memoryCheckInterval is the interval between the checks of the memory
+	// used by an evaluation.
+	memoryCheckInterval = 10 * time.Millisecond
+
+	limitsMu sync.RWMutex
+	limits   = Limits{Timeout: DefaultTimeout}
+)
+
+// Limits are the resource limits of javascript evaluations.
+type Limits struct {
+	// Timeout is the maximum duration of an evaluation. Since the evaluation
+	// of an expression is single threaded, it also bounds its CPU time.
+	// Evaluations are not limited in time if Timeout is zero.
+	Timeout time.Duration
+
+	// MaxMemory is the maximum growth of the heap during an evaluation, in
+	// bytes. The heap is shared with the rest of the process, so the limit is
+	// approximate and is only checked once the evaluation runs for a while.
+	// Evaluations are not limited in memory if MaxMemory is zero.
+	MaxMemory uint64
+}
+
+// SetLimits sets the limits of the evaluations.
+func SetLimits(l Limits) {
+	limitsMu.Lock()
+	defer limitsMu.Unlock()
+	limits = l
+}
+
+// GetLimits returns the limits of the evaluations.
+func GetLimits() Limits {
+	limitsMu.RLock()
+	defer limitsMu.RUnlock()
+	return limits
+}
+
+// interruption is the panic value used to interrupt an evaluation.
+type interruption struct {
+	err    error
+	reason string
+}
+
+// run calls fn, which evaluates javascript with vm, and interrupts the
+// evaluation if it exceeds the limits.
+func run(vm *otto.Otto, fn func() (otto.Value, error)) (value otto.Value, err error) {
+	l := GetLimits()
+	if l.Timeout <= 0 && l.MaxMemory == 0 {
+		return fn()
+	}
+
+	// The buffer prevents the watcher from blocking if the evaluation is over
+	interrupt := make(chan func(), 1)
+	vm.Interrupt = interrupt
+	done := make(chan struct{})
+	defer close(done)
+	defer func() {
+		if caught := recover(); caught != nil {
+			i, ok := caught.(interruption)
+			if !ok {
+				panic(caught)
+			}
+			EvaluationsInterrupted.WithLabelValues(i.reason).Inc()
+			value, err = otto.Value{}, i.err
+		}
+	}()
+
+	go watch(l, interrupt, done)
+	return fn()
+}
+
+// watch interrupts an evaluation if it exceeds l, until done is closed.
+func watch(l Limits, interrupt chan<- func(), done <-chan struct{}) {
+	var timeout <-chan time.Time
+	if l.Timeout > 0 {
+		timer := time.NewTimer(l.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	// Reading the memory statistics stops the world, so the baseline is only
+	// read if the evaluation is still running after the first interval
+	var check <-chan time.Time
+	var baseline uint64
+	if l.MaxMemory > 0 {
+		ticker := time.NewTicker(memoryCheckInterval)
+		defer ticker.Stop()
+		check = ticker.C
+	}
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-timeout:
+			interrupt <- halt(ErrTimeout, reasonTimeout)
+			return
+		case <-check:
+			heap := heapAlloc()
+			if baseline == 0 || heap < baseline {
+				baseline = heap
+				continue
+			}
+			if heap-baseline > l.MaxMemory {
+				interrupt <- halt(ErrMemoryLimit, reasonMemory)
+				return
+			}
+		}
+	}
+}
+
+// halt returns a function interrupting an evaluation with err.
+func halt(err error, reason string) func() {
+	return func() {
+		panic(interruption{err: err, reason: reason})
+	}
+}
+
+func heapAlloc() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
@@ -0,0 +1,57 @@
+package js
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withLimits(l Limits) func() {
+	previous := GetLimits()
+	SetLimits(l)
+	return func() {
+		SetLimits(previous)
+	}
+}
+
+func TestEvaluateTimeout(t *testing.T) {
+	defer withLimits(Limits{Timeout: 50 * time.Millisecond})()
+	before := testutil.ToFloat64(EvaluationsInterrupted.WithLabelValues(reasonTimeout))
+
+	start := time.Now()
+	_, err := Evaluate("while (true) {}", nil, nil)
+	assert.Equal(t, ErrTimeout, err)
+	assert.True(t, time.Since(start) < 5*time.Second)
+	assert.Equal(t, before+1, testutil.ToFloat64(EvaluationsInterrupted.WithLabelValues(reasonTimeout)))
+
+	// The VMs remain usable after an interruption
+	result, err := Evaluate("1 == 1", nil, nil)
+	require.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestEvaluateMemoryLimit(t *testing.T) {
+	defer withLimits(Limits{Timeout: 10 * time.Second, MaxMemory: 1 << 20})()
+
+	_, err := Evaluate("var a = []; while (true) { a.push('sensu' + a.length) }", nil, nil)
+	assert.Equal(t, ErrMemoryLimit, err)
+}
+
+func TestMatchEntitiesTimeout(t *testing.T) {
+	defer withLimits(Limits{Timeout: 50 * time.Millisecond})()
+
+	results, err := MatchEntities([]string{"while (true) {}"}, []interface{}{map[string]interface{}{}})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false}, results)
+}
+
+func TestEvaluateUnlimited(t *testing.T) {
+	defer withLimits(Limits{})()
+
+	result, err := Evaluate("true", nil, nil)
+	require.NoError(t, err)
+	assert.True(t, result)
+}
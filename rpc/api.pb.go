@@ -0,0 +1,828 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api.proto
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type WatchEvent_Action int32
+
+const (
+	WatchEvent_UNKNOWN WatchEvent_Action = 0
+	WatchEvent_CREATE  WatchEvent_Action = 1
+	WatchEvent_UPDATE  WatchEvent_Action = 2
+	WatchEvent_DELETE  WatchEvent_Action = 3
+)
+
+var WatchEvent_Action_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CREATE",
+	2: "UPDATE",
+	3: "DELETE",
+}
+
+var WatchEvent_Action_value = map[string]int32{
+	"UNKNOWN": 0,
+	"CREATE":  1,
+	"UPDATE":  2,
+	"DELETE":  3,
+}
+
+func (x WatchEvent_Action) String() string {
+	return proto.EnumName(WatchEvent_Action_name, int32(x))
+}
+
+func (WatchEvent_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4, 0}
+}
+
+// Resource is a Sensu resource, e.g. a check or an event, encoded with
+// protobuf.
+type Resource struct {
+	// Type is the type of the resource, e.g. CheckConfig.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// APIVersion is the API version of the type, e.g. core/v2.
+	ApiVersion string `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// Value is the protobuf encoding of the resource.
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resource) Reset()         { *m = Resource{} }
+func (m *Resource) String() string { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()    {}
+func (*Resource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *Resource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resource.Unmarshal(m, b)
+}
+func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resource.Marshal(b, m, deterministic)
+}
+func (m *Resource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resource.Merge(m, src)
+}
+func (m *Resource) XXX_Size() int {
+	return xxx_messageInfo_Resource.Size(m)
+}
+func (m *Resource) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resource proto.InternalMessageInfo
+
+func (m *Resource) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Resource) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *Resource) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type GetRequest struct {
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ApiVersion string `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Namespace  string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name is the name of the resource. The name of an event is
+	// <entity>:<check>.
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GetRequest) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *GetRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListRequest struct {
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ApiVersion string `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// Namespace is the namespace of the resources, or empty for all the
+	// namespaces.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// LabelSelector selects the resources by their labels, like the
+	// labelSelector parameter of the HTTP API.
+	LabelSelector        string   `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *ListRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ListRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+type WatchRequest struct {
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ApiVersion string `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Namespace  string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Revision is the store revision to watch from. If zero, the existing
+	// resources are first sent as created, and if negative, only the changes
+	// made from now on are sent.
+	Revision             int64    `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type WatchEvent struct {
+	Action               WatchEvent_Action `protobuf:"varint,1,opt,name=action,proto3,enum=sensu.rpc.WatchEvent_Action" json:"action,omitempty"`
+	Resource             *Resource         `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Revision             int64             `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetAction() WatchEvent_Action {
+	if m != nil {
+		return m.Action
+	}
+	return WatchEvent_UNKNOWN
+}
+
+func (m *WatchEvent) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *WatchEvent) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type PutResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutResponse) Reset()         { *m = PutResponse{} }
+func (m *PutResponse) String() string { return proto.CompactTextString(m) }
+func (*PutResponse) ProtoMessage()    {}
+func (*PutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+func (m *PutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutResponse.Unmarshal(m, b)
+}
+func (m *PutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutResponse.Marshal(b, m, deterministic)
+}
+func (m *PutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutResponse.Merge(m, src)
+}
+func (m *PutResponse) XXX_Size() int {
+	return xxx_messageInfo_PutResponse.Size(m)
+}
+func (m *PutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutResponse proto.InternalMessageInfo
+
+type DeleteRequest struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ApiVersion           string   `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("sensu.rpc.WatchEvent_Action", WatchEvent_Action_name, WatchEvent_Action_value)
+	proto.RegisterType((*Resource)(nil), "sensu.rpc.Resource")
+	proto.RegisterType((*GetRequest)(nil), "sensu.rpc.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "sensu.rpc.ListRequest")
+	proto.RegisterType((*WatchRequest)(nil), "sensu.rpc.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "sensu.rpc.WatchEvent")
+	proto.RegisterType((*PutResponse)(nil), "sensu.rpc.PutResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "sensu.rpc.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "sensu.rpc.DeleteResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdf, 0x6b, 0xd4, 0x40,
+	0x10, 0xbe, 0x5c, 0xee, 0x62, 0x33, 0xe9, 0x1d, 0xc7, 0x68, 0x35, 0x1e, 0x05, 0x4b, 0x40, 0xe8,
+	0x53, 0x94, 0x6b, 0x41, 0xc4, 0x07, 0x39, 0xbd, 0x50, 0xc4, 0x72, 0x1e, 0x6b, 0xcf, 0x82, 0x2f,
+	0x65, 0x1b, 0x06, 0x0c, 0xc4, 0x64, 0xcd, 0x6e, 0x02, 0x3e, 0xf8, 0xec, 0x9f, 0xe2, 0xff, 0xe2,
+	0x5f, 0x25, 0xbb, 0x7b, 0x3f, 0xd2, 0x72, 0xbe, 0x08, 0xf5, 0x6d, 0x76, 0xe6, 0x9b, 0x6f, 0xbe,
+	0xcc, 0x7c, 0x04, 0x7c, 0x2e, 0xb2, 0x58, 0x54, 0xa5, 0x2a, 0xd1, 0x97, 0x54, 0xc8, 0x3a, 0xae,
+	0x44, 0x1a, 0x2d, 0x61, 0x8f, 0x91, 0x2c, 0xeb, 0x2a, 0x25, 0x44, 0xe8, 0xa9, 0xef, 0x82, 0x42,
+	0xe7, 0xc8, 0x39, 0xf6, 0x99, 0x89, 0xf1, 0x09, 0x04, 0x5c, 0x64, 0x57, 0x0d, 0x55, 0x32, 0x2b,
+	0x8b, 0xb0, 0x6b, 0x4a, 0xc0, 0x45, 0xf6, 0xc9, 0x66, 0xf0, 0x01, 0xf4, 0x1b, 0x9e, 0xd7, 0x14,
+	0xba, 0x47, 0xce, 0xf1, 0x3e, 0xb3, 0x8f, 0x48, 0x02, 0x9c, 0x91, 0x62, 0xf4, 0xad, 0x26, 0xa9,
+	0xfe, 0x8d, 0xf8, 0x10, 0xfc, 0x82, 0x7f, 0x25, 0x29, 0x78, 0x6a, 0xc9, 0x7d, 0xb6, 0x4d, 0x68,
+	0x4a, 0xfd, 0x08, 0x7b, 0x96, 0x52, 0xc7, 0xd1, 0x4f, 0x07, 0x82, 0xf3, 0x4c, 0xde, 0xe5, 0xd8,
+	0xa7, 0x30, 0xcc, 0xf9, 0x35, 0xe5, 0x57, 0x92, 0x72, 0x4a, 0x55, 0x59, 0xad, 0x04, 0x0c, 0x4c,
+	0xf6, 0xe3, 0x2a, 0x19, 0xfd, 0x80, 0xfd, 0x4b, 0xae, 0xd2, 0x2f, 0x77, 0xa8, 0x64, 0x0c, 0x7b,
+	0x15, 0x35, 0x99, 0xe9, 0xd5, 0x1a, 0x5c, 0xb6, 0x79, 0x47, 0xbf, 0x1d, 0x00, 0x33, 0x3f, 0x69,
+	0xa8, 0x50, 0x78, 0x0a, 0x1e, 0x4f, 0x95, 0x06, 0xea, 0xf9, 0xc3, 0xc9, 0x61, 0xbc, 0xb9, 0x7f,
+	0xbc, 0x85, 0xc5, 0x53, 0x83, 0x61, 0x2b, 0x2c, 0x3e, 0xd3, 0x03, 0xac, 0x33, 0x8c, 0xb8, 0x60,
+	0x72, 0xbf, 0xd5, 0xb7, 0x36, 0x0d, 0xdb, 0x80, 0x6e, 0x28, 0x72, 0x6f, 0x29, 0x7a, 0x09, 0x9e,
+	0xa5, 0xc7, 0x00, 0xee, 0x2d, 0xe7, 0xef, 0xe7, 0x1f, 0x2e, 0xe7, 0xa3, 0x0e, 0x02, 0x78, 0x6f,
+	0x59, 0x32, 0xbd, 0x48, 0x46, 0x8e, 0x8e, 0x97, 0x8b, 0x99, 0x8e, 0xbb, 0x3a, 0x9e, 0x25, 0xe7,
+	0xc9, 0x45, 0x32, 0x72, 0xa3, 0x01, 0x04, 0x8b, 0x5a, 0x31, 0x92, 0xa2, 0x2c, 0x24, 0x45, 0x0d,
+	0x0c, 0x66, 0x94, 0x93, 0xa2, 0xff, 0x6c, 0xae, 0x11, 0x0c, 0xd7, 0x73, 0xad, 0x92, 0xc9, 0xaf,
+	0x2e, 0xb8, 0xd3, 0xc5, 0x3b, 0x3c, 0x01, 0xf7, 0x8c, 0x14, 0x1e, 0xb4, 0xb6, 0xb3, 0xf5, 0xfe,
+	0x78, 0xd7, 0xd2, 0xa2, 0x0e, 0xbe, 0x80, 0x9e, 0xb6, 0x2a, 0x3e, 0x6c, 0x95, 0x5b, 0xde, 0xfd,
+	0x4b, 0xdb, 0x73, 0x07, 0x5f, 0x41, 0xdf, 0xdc, 0x0c, 0x1f, 0xdd, 0xbe, 0xe2, 0xba, 0xf5, 0x60,
+	0xe7, 0x79, 0x4d, 0xf3, 0x29, 0xb8, 0x8b, 0x5a, 0xe1, 0x2e, 0xf2, 0x71, 0x5b, 0x49, 0x7b, 0xe1,
+	0x1d, 0x7c, 0x0d, 0x9e, 0xfd, 0x74, 0x0c, 0x5b, 0x98, 0x1b, 0x57, 0x18, 0x3f, 0xde, 0x51, 0x59,
+	0x13, 0xbc, 0xe9, 0x7f, 0x76, 0x2b, 0x91, 0x5e, 0x7b, 0xe6, 0xef, 0x73, 0xf2, 0x27, 0x00, 0x00,
+	0xff, 0xff, 0x4d, 0xfc, 0x22, 0xcb, 0x8a, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Resource, error)
+	// List streams the resources, fetched from the store by pages.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (API_ListClient, error)
+	// Watch streams the changes of the resources until the call is cancelled.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error)
+	// Put creates or replaces a resource. The events are processed by the
+	// pipeline like the events of the agents.
+	Put(ctx context.Context, in *Resource, opts ...grpc.CallOption) (*PutResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Resource, error) {
+	out := new(Resource)
+	err := c.cc.Invoke(ctx, "/sensu.rpc.API/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (API_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/sensu.rpc.API/List", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ListClient interface {
+	Recv() (*Resource, error)
+	grpc.ClientStream
+}
+
+type aPIListClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListClient) Recv() (*Resource, error) {
+	m := new(Resource)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/sensu.rpc.API/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type aPIWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Put(ctx context.Context, in *Resource, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/sensu.rpc.API/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/sensu.rpc.API/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServer is the server API for API service.
+type APIServer interface {
+	Get(context.Context, *GetRequest) (*Resource, error)
+	// List streams the resources, fetched from the store by pages.
+	List(*ListRequest, API_ListServer) error
+	// Watch streams the changes of the resources until the call is cancelled.
+	Watch(*WatchRequest, API_WatchServer) error
+	// Put creates or replaces a resource. The events are processed by the
+	// pipeline like the events of the agents.
+	Put(context.Context, *Resource) (*PutResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+}
+
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (*UnimplementedAPIServer) Get(ctx context.Context, req *GetRequest) (*Resource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedAPIServer) List(req *ListRequest, srv API_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedAPIServer) Watch(req *WatchRequest, srv API_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedAPIServer) Put(ctx context.Context, req *Resource) (*PutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedAPIServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensu.rpc.API/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).List(m, &aPIListServer{stream})
+}
+
+type API_ListServer interface {
+	Send(*Resource) error
+	grpc.ServerStream
+}
+
+type aPIListServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListServer) Send(m *Resource) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Watch(m, &aPIWatchServer{stream})
+}
+
+type API_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type aPIWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Resource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensu.rpc.API/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Put(ctx, req.(*Resource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sensu.rpc.API/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sensu.rpc.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _API_Get_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _API_Put_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _API_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _API_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _API_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
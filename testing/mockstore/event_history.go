@@ -0,0 +1,31 @@
+package mockstore
+
+import (
+	"context"
+
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// AddEventHistory ...
+func (s *MockStore) AddEventHistory(ctx context.Context, event *corev2.Event) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+// GetEventHistory ...
+func (s *MockStore) GetEventHistory(ctx context.Context, entityName, checkName string) ([]*corev2.Event, error) {
+	args := s.Called(ctx, entityName, checkName)
+	return args.Get(0).([]*corev2.Event), args.Error(1)
+}
+
+// ListEventHistory ...
+func (s *MockStore) ListEventHistory(ctx context.Context) ([]*corev2.Event, error) {
+	args := s.Called(ctx)
+	return args.Get(0).([]*corev2.Event), args.Error(1)
+}
+
+// DeleteEventHistory ...
+func (s *MockStore) DeleteEventHistory(ctx context.Context, events []*corev2.Event) error {
+	args := s.Called(ctx, events)
+	return args.Error(0)
+}
@@ -25,6 +25,12 @@ func (s *MockStore) GetEventsByEntity(ctx context.Context, entityName string, pr
 	return args.Get(0).([]*corev2.Event), args.Error(1)
 }
 
+// CountEvents ...
+func (s *MockStore) CountEvents(ctx context.Context, pred *store.SelectionPredicate) (int64, error) {
+	args := s.Called(ctx, pred)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // GetEventByEntityCheck ...
 func (s *MockStore) GetEventByEntityCheck(ctx context.Context, entityName, checkID string) (*corev2.Event, error) {
 	args := s.Called(ctx, entityName, checkID)
@@ -23,3 +23,9 @@ func (s *MockStore) UpdateFailingKeepalive(ctx context.Context, entity *types.En
 	args := s.Called(ctx, entity, expiration)
 	return args.Error(0)
 }
+
+// UpdateKeepalives ...
+func (s *MockStore) UpdateKeepalives(ctx context.Context, entities []*types.Entity) error {
+	args := s.Called(ctx, entities)
+	return args.Error(0)
+}
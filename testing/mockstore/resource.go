@@ -36,3 +36,9 @@ func (s *MockStore) ListResources(ctx context.Context, kind string, list interfa
 	args := s.Called(ctx, kind, list, pred)
 	return args.Error(0)
 }
+
+// CountResources ...
+func (s *MockStore) CountResources(ctx context.Context, kind string) (int64, error) {
+	args := s.Called(ctx, kind)
+	return args.Get(0).(int64), args.Error(1)
+}
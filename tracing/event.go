@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	corev2 "github.com/sensu/sensu-go/api/core/v2"
+)
+
+// The attributes of the spans of the events.
+const (
+	AttributeNamespace   = "sensu.namespace"
+	AttributeEntity      = "sensu.entity"
+	AttributeCheck       = "sensu.check"
+	AttributeCheckStatus = "sensu.check.status"
+	AttributeEventID     = "sensu.event.id"
+)
+
+// SetEventAttributes sets the attributes identifying the event on the span.
+func SetEventAttributes(span *Span, event *corev2.Event) {
+	if !span.IsRecording() {
+		return
+	}
+	if len(event.ID) > 0 {
+		span.SetAttribute(AttributeEventID, event.GetUUID().String())
+	}
+	if event.Entity != nil {
+		span.SetAttribute(AttributeNamespace, event.Entity.Namespace)
+		span.SetAttribute(AttributeEntity, event.Entity.Name)
+	}
+	if event.HasCheck() {
+		span.SetAttribute(AttributeCheck, event.Check.Name)
+	}
+}
@@ -0,0 +1,298 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sensu/sensu-go/version"
+)
+
+const (
+	// SpansDroppedCounter is the name of the prometheus counter used to count
+	// the spans which could not be exported.
+	SpansDroppedCounter = "sensu_go_tracing_spans_dropped"
+
+	// tracesPath is the path of the traces of the OTLP/HTTP protocol.
+	tracesPath = "/v1/traces"
+
+	// exportQueueSize is the number of ended spans waiting to be exported,
+	// above which the spans are dropped.
+	exportQueueSize = 2048
+
+	// exportBatchSize is the number of spans exported per request.
+	exportBatchSize = 512
+
+	// exportInterval is the interval at which the spans are exported.
+	exportInterval = 5 * time.Second
+
+	// exportTimeout is the timeout of the export requests.
+	exportTimeout = 10 * time.Second
+
+	// The status codes of the OTLP spans.
+	statusCodeOK    = 1
+	statusCodeError = 2
+
+	// spanKindInternal is the kind of the spans.
+	spanKindInternal = 1
+)
+
+// SpansDropped counts the spans which could not be exported, because the
+// export queue was full or the collector failed.
+var SpansDropped = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: SpansDroppedCounter,
+		Help: "The total number of trace spans which could not be exported",
+	},
+)
+
+// exporter exports the spans in batches to an OTLP/HTTP endpoint, in the
+// JSON encoding of the protocol.
+type exporter struct {
+	url      string
+	resource otlpResource
+	client   *http.Client
+	spans    chan *Span
+	stopping chan struct{}
+	done     chan struct{}
+}
+
+func newExporter(endpoint, serviceName string) (*exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid otlp endpoint: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid otlp endpoint %q: the scheme must be http or https", endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = tracesPath
+	}
+	hostname, _ := os.Hostname()
+	e := &exporter{
+		url: u.String(),
+		resource: otlpResource{
+			Attributes: otlpAttributes(map[string]interface{}{
+				"service.name":    serviceName,
+				"service.version": version.Semver(),
+				"host.name":       hostname,
+			}),
+		},
+		client:   &http.Client{Timeout: exportTimeout},
+		spans:    make(chan *Span, exportQueueSize),
+		stopping: make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	_ = prometheus.Register(SpansDropped)
+	go e.run()
+	return e, nil
+}
+
+// export queues the span to be exported, or drops it if the queue is full.
+func (e *exporter) export(s *Span) {
+	select {
+	case e.spans <- s:
+	default:
+		SpansDropped.Inc()
+	}
+}
+
+// stop exports the queued spans and stops the exporter.
+func (e *exporter) stop() {
+	close(e.stopping)
+	<-e.done
+}
+
+func (e *exporter) run() {
+	defer close(e.done)
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, exportBatchSize)
+	for {
+		select {
+		case <-e.stopping:
+			for {
+				select {
+				case s := <-e.spans:
+					batch = append(batch, s)
+				default:
+					e.post(batch)
+					return
+				}
+			}
+		case s := <-e.spans:
+			batch = append(batch, s)
+			if len(batch) < exportBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+		e.post(batch)
+		batch = batch[:0]
+	}
+}
+
+// post posts the spans to the endpoint, in batches.
+func (e *exporter) post(spans []*Span) {
+	for len(spans) > 0 {
+		n := len(spans)
+		if n > exportBatchSize {
+			n = exportBatchSize
+		}
+		if err := e.postBatch(spans[:n]); err != nil {
+			logger.WithError(err).WithField("spans", n).Warn("could not export trace spans")
+			SpansDropped.Add(float64(n))
+		}
+		spans = spans[n:]
+	}
+}
+
+func (e *exporter) postBatch(spans []*Span) error {
+	request := otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: e.resource,
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "sensu-go", Version: version.Semver()},
+				Spans: make([]otlpSpan, len(spans)),
+			}},
+		}},
+	}
+	for i, s := range spans {
+		request.ResourceSpans[0].ScopeSpans[0].Spans[i] = s.otlp()
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("the otlp endpoint responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+// The messages of the OTLP/HTTP protocol, in their JSON encoding.
+type (
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+	}
+
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes,omitempty"`
+		Status            otlpStatus      `json:"status"`
+	}
+
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// otlp returns the OTLP encoding of the span.
+func (s *Span) otlp() otlpSpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(s.context.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.context.SpanID[:]),
+		Name:              s.name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Attributes:        otlpAttributes(s.attributes),
+		Status:            otlpStatus{Code: statusCodeOK},
+	}
+	if s.parentID != [8]byte{} {
+		span.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	if s.err != "" {
+		span.Status = otlpStatus{Code: statusCodeError, Message: s.err}
+	}
+	return span
+}
+
+// otlpAttributes returns the OTLP encoding of the attributes, by key.
+func otlpAttributes(attributes map[string]interface{}) []otlpAttribute {
+	result := make([]otlpAttribute, 0, len(attributes))
+	for key, value := range attributes {
+		var v otlpValue
+		switch value := value.(type) {
+		case string:
+			v.StringValue = &value
+		case bool:
+			v.BoolValue = &value
+		case int:
+			i := strconv.Itoa(value)
+			v.IntValue = &i
+		case int64:
+			i := strconv.FormatInt(value, 10)
+			v.IntValue = &i
+		case uint32:
+			i := strconv.FormatUint(uint64(value), 10)
+			v.IntValue = &i
+		case float64:
+			v.DoubleValue = &value
+		default:
+			s := fmt.Sprint(value)
+			v.StringValue = &s
+		}
+		result = append(result, otlpAttribute{Key: key, Value: v})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	requests := make(chan otlpRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tracesPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var request otlpRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests <- request
+	}))
+	defer server.Close()
+
+	tracer, err := New(Config{OTLPEndpoint: server.URL, ServiceName: "sensu-backend", SampleRate: 1})
+	require.NoError(t, err)
+
+	parent := tracer.Start(SpanContext{}, "parent")
+	child := tracer.Start(parent.Context(), "child")
+	child.SetAttribute(AttributeCheck, "check-cpu")
+	child.SetError(errors.New("failed"))
+	child.End()
+	child.End()
+	parent.End()
+	tracer.Stop()
+
+	pc := parent.Context()
+	request := <-requests
+	require.Len(t, request.ResourceSpans, 1)
+	assert.Contains(t, request.ResourceSpans[0].Resource.Attributes, otlpAttributes(map[string]interface{}{"service.name": "sensu-backend"})[0])
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, hex.EncodeToString(pc.TraceID[:]), spans[0].TraceID)
+	assert.Equal(t, hex.EncodeToString(pc.SpanID[:]), spans[0].ParentSpanID)
+	assert.Equal(t, otlpStatus{Code: statusCodeError, Message: "failed"}, spans[0].Status)
+	require.Len(t, spans[0].Attributes, 1)
+	assert.Equal(t, AttributeCheck, spans[0].Attributes[0].Key)
+	assert.Equal(t, "check-cpu", *spans[0].Attributes[0].Value.StringValue)
+
+	assert.Equal(t, "parent", spans[1].Name)
+	assert.Empty(t, spans[1].ParentSpanID)
+	assert.Equal(t, otlpStatus{Code: statusCodeOK}, spans[1].Status)
+}
+
+func TestNewDisabled(t *testing.T) {
+	tracer, err := New(Config{})
+	require.NoError(t, err)
+	assert.Nil(t, tracer)
+
+	_, err = New(Config{OTLPEndpoint: "localhost:4318"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidTraceParent is returned when a trace parent is not a valid W3C
+// traceparent.
+var ErrInvalidTraceParent = errors.New("invalid trace parent")
+
+// SpanContext identifies a span and its trace. It is propagated from a span
+// to its children, across the agent and the backend, as a W3C traceparent.
+type SpanContext struct {
+	// TraceID identifies the trace of the span.
+	TraceID [16]byte
+
+	// SpanID identifies the span in its trace.
+	SpanID [8]byte
+
+	// Sampled is true if the spans of the trace are recorded.
+	Sampled bool
+}
+
+// IsValid returns true if the trace ID and the span ID are set.
+func (c SpanContext) IsValid() bool {
+	return c.TraceID != [16]byte{} && c.SpanID != [8]byte{}
+}
+
+// TraceParent returns the W3C traceparent of the span context, or an empty
+// string if it is not valid.
+func (c SpanContext) TraceParent() string {
+	if !c.IsValid() {
+		return ""
+	}
+	flags := 0
+	if c.Sampled {
+		flags = 1
+	}
+	return fmt.Sprintf("00-%s-%s-%02x", hex.EncodeToString(c.TraceID[:]), hex.EncodeToString(c.SpanID[:]), flags)
+}
+
+// ParseTraceParent parses a W3C traceparent, such as
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01.
+func ParseTraceParent(traceParent string) (SpanContext, error) {
+	var c SpanContext
+	parts := strings.Split(traceParent, "-")
+	// Future versions may append fields to the traceparent
+	if len(parts) < 4 || (parts[0] == "00" && len(parts) != 4) || parts[0] == "ff" {
+		return c, ErrInvalidTraceParent
+	}
+	if len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return c, ErrInvalidTraceParent
+	}
+	if _, err := hex.Decode(c.TraceID[:], []byte(parts[1])); err != nil {
+		return c, ErrInvalidTraceParent
+	}
+	if _, err := hex.Decode(c.SpanID[:], []byte(parts[2])); err != nil {
+		return c, ErrInvalidTraceParent
+	}
+	var flags [1]byte
+	if _, err := hex.Decode(flags[:], []byte(parts[3])); err != nil {
+		return c, ErrInvalidTraceParent
+	}
+	if !c.IsValid() {
+		return c, ErrInvalidTraceParent
+	}
+	c.Sampled = flags[0]&1 == 1
+	return c, nil
+}
+
+// Span is an operation of a trace, such as the execution of a check or of a
+// handler. The spans which are not sampled, or whose tracer is disabled, are
+// not recorded, but their context is still propagated. The methods of a nil
+// Span do nothing.
+type Span struct {
+	tracer   *Tracer
+	name     string
+	context  SpanContext
+	parentID [8]byte
+	start    time.Time
+
+	mu         sync.Mutex
+	end        time.Time
+	attributes map[string]interface{}
+	err        string
+	ended      bool
+}
+
+// Context returns the span context of the span, to propagate to its
+// children.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+// IsRecording returns true if the span is exported when it ends.
+func (s *Span) IsRecording() bool {
+	return s != nil && s.tracer != nil && s.context.Sampled
+}
+
+// SetAttribute sets an attribute of the span. The value is a string, a bool,
+// an integer or a float.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if !s.IsRecording() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// SetError marks the span as failed with err, if not nil.
+func (s *Span) SetError(err error) {
+	if err == nil || !s.IsRecording() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err.Error()
+}
+
+// End ends the span, and exports it if it is recording. Only the first call
+// to End has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = time.Now()
+	s.mu.Unlock()
+	if s.IsRecording() {
+		s.tracer.export(s)
+	}
+}
+
+type spanKey struct{}
+
+// ContextWithSpan returns a copy of ctx holding the span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the span held by ctx, or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// StartSpanFromContext starts a child of the span held by ctx, with the
+// tracer of that span, and returns a copy of ctx holding the child. The child
+// is not recorded if ctx holds no span.
+func StartSpanFromContext(ctx context.Context, name string) (context.Context, *Span) {
+	var tracer *Tracer
+	parent := SpanFromContext(ctx)
+	if parent != nil {
+		tracer = parent.tracer
+	}
+	span := tracer.Start(parent.Context(), name)
+	return ContextWithSpan(ctx, span), span
+}
@@ -0,0 +1,132 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceParent string
+		wantErr     bool
+		wantSampled bool
+	}{
+		{
+			name:        "sampled",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantSampled: true,
+		},
+		{
+			name:        "not sampled",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		},
+		{
+			name:        "future version",
+			traceParent: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			wantSampled: true,
+		},
+		{
+			name:        "empty",
+			traceParent: "",
+			wantErr:     true,
+		},
+		{
+			name:        "extra field",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			wantErr:     true,
+		},
+		{
+			name:        "invalid version",
+			traceParent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantErr:     true,
+		},
+		{
+			name:        "zero trace id",
+			traceParent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			wantErr:     true,
+		},
+		{
+			name:        "invalid span id",
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902zz-01",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseTraceParent(tt.traceParent)
+			if tt.wantErr {
+				assert.Equal(t, ErrInvalidTraceParent, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSampled, c.Sampled)
+			if tt.traceParent[:2] == "00" {
+				assert.Equal(t, tt.traceParent, c.TraceParent())
+			}
+		})
+	}
+}
+
+func TestTracerStart(t *testing.T) {
+	tracer := &Tracer{sampleRate: 1}
+
+	root := tracer.Start(SpanContext{}, "root")
+	require.True(t, root.Context().IsValid())
+	assert.True(t, root.IsRecording())
+
+	child := tracer.StartFromTraceParent(root.Context().TraceParent(), "child")
+	assert.Equal(t, root.Context().TraceID, child.Context().TraceID)
+	assert.Equal(t, root.Context().SpanID, child.parentID)
+	assert.NotEqual(t, root.Context().SpanID, child.Context().SpanID)
+	assert.True(t, child.IsRecording())
+}
+
+func TestTracerSampling(t *testing.T) {
+	tracer := &Tracer{sampleRate: 0}
+
+	root := tracer.Start(SpanContext{}, "root")
+	assert.True(t, root.Context().IsValid())
+	assert.False(t, root.IsRecording())
+	assert.Equal(t, "-00", root.Context().TraceParent()[52:])
+
+	// The children follow the sampling of their parent
+	parent, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	child := tracer.Start(parent, "child")
+	assert.True(t, child.IsRecording())
+}
+
+func TestNilTracer(t *testing.T) {
+	var tracer *Tracer
+
+	// A disabled tracer propagates the context of the parent
+	parent, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	span := tracer.Start(parent, "span")
+	assert.Equal(t, parent, span.Context())
+	assert.False(t, span.IsRecording())
+	span.SetAttribute("key", "value")
+	span.End()
+	tracer.Stop()
+
+	span = tracer.Start(SpanContext{}, "span")
+	assert.Equal(t, "", span.Context().TraceParent())
+}
+
+func TestStartSpanFromContext(t *testing.T) {
+	tracer := &Tracer{sampleRate: 1}
+	parent := tracer.Start(SpanContext{}, "parent")
+	ctx := ContextWithSpan(context.Background(), parent)
+
+	ctx, child := StartSpanFromContext(ctx, "child")
+	assert.Equal(t, child, SpanFromContext(ctx))
+	assert.Equal(t, parent.Context().TraceID, child.Context().TraceID)
+	assert.Equal(t, parent.Context().SpanID, child.parentID)
+
+	_, span := StartSpanFromContext(context.Background(), "span")
+	assert.False(t, span.IsRecording())
+}
@@ -0,0 +1,110 @@
+// Package tracing traces the events across the agent and the backend, from
+// the execution of their check to the execution of their handlers, and exports
+// the spans to an OpenTelemetry collector with the OTLP/HTTP protocol.
+//
+// The trace of an event is propagated in its trace parent, which is the W3C
+// traceparent of the span that last handled it.
+package tracing
+
+import (
+	"crypto/rand"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultSampleRate is the default rate of the traces sampled.
+	DefaultSampleRate = 1.0
+)
+
+// Config configures a Tracer.
+type Config struct {
+	// OTLPEndpoint is the URL of the OTLP/HTTP endpoint of the collector,
+	// such as http://localhost:4318. The spans are posted to its /v1/traces
+	// path, unless the URL has a path. Tracing is disabled if empty.
+	OTLPEndpoint string
+
+	// ServiceName is the name of the service of the spans, such as
+	// sensu-backend.
+	ServiceName string
+
+	// SampleRate is the rate, from 0 to 1, of the traces started by the
+	// tracer which are sampled. The children of a span follow its sampling.
+	SampleRate float64
+}
+
+// Tracer starts spans and exports them. A nil Tracer is disabled: its spans
+// are not recorded, and propagate the context of their parent.
+type Tracer struct {
+	sampleRate float64
+	exporter   *exporter
+}
+
+// New returns a new Tracer, which is nil if tracing is disabled. Its spans
+// are exported until it is stopped.
+func New(config Config) (*Tracer, error) {
+	if config.OTLPEndpoint == "" {
+		return nil, nil
+	}
+	exporter, err := newExporter(config.OTLPEndpoint, config.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &Tracer{
+		sampleRate: math.Max(0, math.Min(1, config.SampleRate)),
+		exporter:   exporter,
+	}, nil
+}
+
+// Start starts a span named name, child of the span of parent, or root of a
+// new trace if parent is not valid.
+func (t *Tracer) Start(parent SpanContext, name string) *Span {
+	return t.StartAt(parent, name, time.Now())
+}
+
+// StartFromTraceParent is like Start, for a span child of the span of the W3C
+// traceparent, or root of a new trace if the traceparent is not valid.
+func (t *Tracer) StartFromTraceParent(traceParent, name string) *Span {
+	parent, _ := ParseTraceParent(traceParent)
+	return t.Start(parent, name)
+}
+
+// StartAt is like Start, for a span which started at start.
+func (t *Tracer) StartAt(parent SpanContext, name string, start time.Time) *Span {
+	if t == nil {
+		return &Span{name: name, context: parent, start: start}
+	}
+	span := &Span{tracer: t, name: name, start: start}
+	if parent.IsValid() {
+		span.context.TraceID = parent.TraceID
+		span.context.Sampled = parent.Sampled
+		span.parentID = parent.SpanID
+	} else {
+		_, _ = rand.Read(span.context.TraceID[:])
+		span.context.Sampled = t.sample(span.context.TraceID)
+	}
+	_, _ = rand.Read(span.context.SpanID[:])
+	return span
+}
+
+// Stop exports the spans which ended and stops the tracer.
+func (t *Tracer) Stop() {
+	if t == nil {
+		return
+	}
+	t.exporter.stop()
+}
+
+// sample returns whether the trace is sampled, from the lower bytes of its
+// trace ID, so that the sampling is consistent across tracers.
+func (t *Tracer) sample(traceID [16]byte) bool {
+	var x uint64
+	for _, b := range traceID[8:] {
+		x = x<<8 | uint64(b)
+	}
+	return float64(x>>11)/(1<<53) < t.sampleRate
+}
+
+func (t *Tracer) export(s *Span) {
+	t.exporter.export(s)
+}
@@ -12,9 +12,11 @@ import (
 	"github.com/sensu/sensu-go/types"
 )
 
-// connect establish the connection to a given websocket backend and returns it
-// along with any error encountered
-func connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header, handshakeTimeout int) (*websocket.Conn, http.Header, error) {
+// connect establish the connection to a given websocket backend, through the
+// proxy if not nil or else the proxy of the environment, negotiating the
+// permessage-deflate compression if enabled, and returns it along with any
+// error encountered
+func connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header, handshakeTimeout int, proxy ProxyFunc, compression bool) (*websocket.Conn, http.Header, error) {
 	// TODO(grep): configurable max sendq depth
 	u, err := url.Parse(wsServerURL)
 	if err != nil {
@@ -27,6 +29,11 @@ func connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.H
 	dialer := websocket.Dialer{
 		HandshakeTimeout: time.Second * time.Duration(handshakeTimeout),
 		Proxy:            http.ProxyFromEnvironment,
+		// The backend may not accept the compression, which is then disabled
+		EnableCompression: compression,
+	}
+	if proxy != nil {
+		dialer.Proxy = proxy
 	}
 
 	if tlsOpts != nil {
@@ -57,9 +64,12 @@ func connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.H
 
 // Connect causes the transport Client to connect to a given websocket backend.
 // This is a thin wrapper around a websocket connection that makes the
-// connection safe for concurrent use by multiple goroutines.
-func Connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header, handshakeTimeout int) (Transport, http.Header, error) {
-	conn, resp, err := connect(wsServerURL, tlsOpts, requestHeader, handshakeTimeout)
+// connection safe for concurrent use by multiple goroutines. The connection
+// goes through the given proxy, if not nil, or else through the proxy of the
+// environment, and its messages are compressed if compression is enabled and
+// the backend accepts it.
+func Connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header, handshakeTimeout int, proxy ProxyFunc, compression bool) (Transport, http.Header, error) {
+	conn, resp, err := connect(wsServerURL, tlsOpts, requestHeader, handshakeTimeout, proxy, compression)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -3,6 +3,7 @@ package transport
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"sync"
@@ -30,6 +31,27 @@ const (
 	// MessageTypeEvent is the message type string for events.
 	MessageTypeEvent = "event"
 
+	// MessageTypeDeregistration is the message type sent by agents that
+	// deregister their entity when shutting down.
+	MessageTypeDeregistration = "deregistration"
+
+	// MessageTypeBatch is the message type of the batches of messages, only
+	// sent to the backends advertising HeaderKeyBatching.
+	MessageTypeBatch = "batch"
+
+	// MessageTypeReconnect is the message type sent by backends asking their
+	// agents to reconnect, e.g. to another backend, when shutting down or
+	// drained. Its payload is the delay in milliseconds, as a decimal number,
+	// the agent waits before reconnecting.
+	MessageTypeReconnect = "reconnect"
+
+	// MessageTypeBackpressure is the message type sent by backends asking
+	// their agents to slow down, when the events they receive are processed
+	// slower than they arrive. Its payload is the delay in milliseconds, as a
+	// decimal number, the agent holds its events for. It is only sent to the
+	// agents advertising HeaderKeyBackpressure.
+	MessageTypeBackpressure = "backpressure"
+
 	// HeaderKeyAgentName is the HTTP request header specifying the Agent name
 	HeaderKeyAgentName = "Sensu-AgentName"
 
@@ -41,6 +63,14 @@ const (
 
 	// HeaderKeySubscriptions is the HTTP request header specifying the Agent Subscriptions
 	HeaderKeySubscriptions = "Sensu-Subscriptions"
+
+	// HeaderKeyBatching is the HTTP response header advertising that the
+	// backend accepts batches of messages
+	HeaderKeyBatching = "Sensu-Batching"
+
+	// HeaderKeyBackpressure is the HTTP request header advertising that the
+	// agent handles the backpressure messages
+	HeaderKeyBackpressure = "Sensu-Backpressure"
 )
 
 // A ClosedError is returned when Receive or Send is called on a closed
@@ -82,6 +112,39 @@ func Decode(payload []byte) (string, []byte, error) {
 	return string(msgType), msg, nil
 }
 
+// EncodeBatch encodes the messages into the payload of a batch message, where
+// every encoded message is prefixed with its length as a uvarint.
+func EncodeBatch(messages []*Message) []byte {
+	var buf []byte
+	length := make([]byte, binary.MaxVarintLen64)
+	for _, m := range messages {
+		msg := Encode(m.Type, m.Payload)
+		n := binary.PutUvarint(length, uint64(len(msg)))
+		buf = append(buf, length[:n]...)
+		buf = append(buf, msg...)
+	}
+	return buf
+}
+
+// DecodeBatch decodes the messages of the payload of a batch message.
+func DecodeBatch(payload []byte) ([]*Message, error) {
+	var messages []*Message
+	for len(payload) > 0 {
+		size, n := binary.Uvarint(payload)
+		if n <= 0 || size > uint64(len(payload)-n) {
+			return nil, errors.New("invalid batch message")
+		}
+		payload = payload[n:]
+		msgType, msg, err := Decode(payload[:size])
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &Message{Type: msgType, Payload: msg})
+		payload = payload[size:]
+	}
+	return messages, nil
+}
+
 // A Message is a tuple of a message type (i.e. channel) and a byte-array
 // payload to be sent across the transport.
 type Message struct {
@@ -126,6 +189,10 @@ type WebSocketTransport struct {
 	Connection *websocket.Conn
 	closed     bool
 	mutex      *sync.RWMutex
+
+	// batch holds the messages of the last batch received and not returned
+	// by Receive yet.
+	batch []*Message
 }
 
 // NewTransport creates an initialized Transport and return its pointer.
@@ -219,7 +286,14 @@ func (t *WebSocketTransport) Heartbeat(ctx context.Context, interval, timeout in
 // Receive a message over the websocket connection. Like Send, returns either
 // a ClosedError or a ConnectionError if unable to receive a message. Receive
 // blocks until the connection has a message ready or a timeout is reached.
+// The messages of a batch are returned one at a time.
 func (t *WebSocketTransport) Receive() (*Message, error) {
+	if len(t.batch) > 0 {
+		msg := t.batch[0]
+		t.batch = t.batch[1:]
+		return msg, nil
+	}
+
 	t.mutex.RLock()
 	if t.closed {
 		t.mutex.RUnlock()
@@ -245,6 +319,17 @@ func (t *WebSocketTransport) Receive() (*Message, error) {
 	if err != nil {
 		return nil, err
 	}
+	if msgType == MessageTypeBatch {
+		batch, err := DecodeBatch(payload)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			return nil, errors.New("empty batch message")
+		}
+		t.batch = batch[1:]
+		return batch[0], nil
+	}
 
 	msg := msgPool.Get().(*Message)
 	msg.Type = msgType
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -35,7 +36,7 @@ func TestTransportSendReceive(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	clientTransport, _, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil, 5)
+	clientTransport, _, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil, 5, nil, false)
 	assert.NoError(t, err)
 	msgBytes, err := json.Marshal(testMessage)
 	assert.NoError(t, err)
@@ -60,7 +61,7 @@ func TestClosedWebsocket(t *testing.T) {
 	}))
 	defer ts.Close()
 
-	clientTransport, _, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil, 5)
+	clientTransport, _, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil, 5, nil, false)
 	assert.NoError(t, err)
 	<-done
 	// At this point we should receive a connection closed message.
@@ -77,6 +78,60 @@ func TestClosedWebsocket(t *testing.T) {
 	assert.IsType(t, ClosedError{}, err)
 }
 
+func TestBatch(t *testing.T) {
+	messages := []*Message{
+		{Type: MessageTypeKeepalive, Payload: []byte("keepalive")},
+		{Type: MessageTypeEvent, Payload: []byte("event\nwith newline")},
+		{Type: MessageTypeEvent, Payload: []byte{}},
+	}
+	decoded, err := DecodeBatch(EncodeBatch(messages))
+	require.NoError(t, err)
+	require.Len(t, decoded, 3)
+	for i, msg := range messages {
+		assert.Equal(t, msg.Type, decoded[i].Type)
+		assert.Equal(t, msg.Payload, decoded[i].Payload)
+	}
+
+	payload := EncodeBatch(messages)
+	_, err = DecodeBatch(payload[:len(payload)-1])
+	assert.Error(t, err)
+}
+
+func TestReceiveCompressedBatch(t *testing.T) {
+	received := make(chan []*Message, 1)
+	upgrader := &websocket.Upgrader{EnableCompression: true}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		transport := NewTransport(conn)
+		var messages []*Message
+		for i := 0; i < 3; i++ {
+			msg, err := transport.Receive()
+			require.NoError(t, err)
+			messages = append(messages, &Message{Type: msg.Type, Payload: append([]byte{}, msg.Payload...)})
+		}
+		received <- messages
+	}))
+	defer ts.Close()
+
+	clientTransport, header, err := Connect(strings.Replace(ts.URL, "http", "ws", 1), nil, nil, 5, nil, true)
+	require.NoError(t, err)
+	assert.Contains(t, header.Get("Sec-Websocket-Extensions"), "permessage-deflate")
+
+	batch := EncodeBatch([]*Message{
+		{Type: MessageTypeKeepalive, Payload: []byte("first")},
+		{Type: MessageTypeEvent, Payload: []byte("second")},
+	})
+	require.NoError(t, clientTransport.Send(&Message{Type: MessageTypeBatch, Payload: batch}))
+	require.NoError(t, clientTransport.Send(&Message{Type: MessageTypeEvent, Payload: []byte("third")}))
+
+	messages := <-received
+	assert.Equal(t, MessageTypeKeepalive, messages[0].Type)
+	assert.Equal(t, "first", string(messages[0].Payload))
+	assert.Equal(t, "second", string(messages[1].Payload))
+	assert.Equal(t, "third", string(messages[2].Payload))
+}
+
 // This was all mostly to prove that performance of encoding/decoding was
 // not super-linear.
 
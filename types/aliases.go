@@ -9,6 +9,7 @@ type (
 	Check               = v2.Check
 	CheckConfig         = v2.CheckConfig
 	CheckHistory        = v2.CheckHistory
+	CheckProbe          = v2.CheckProbe
 	CheckRequest        = v2.CheckRequest
 	Claims              = v2.Claims
 	ClusterHealth       = v2.ClusterHealth
@@ -20,6 +21,9 @@ type (
 	EventFilter         = v2.EventFilter
 	Extension           = v2.Extension
 	Handler             = v2.Handler
+	HandlerInfluxDB     = v2.HandlerInfluxDB
+	HandlerKafka        = v2.HandlerKafka
+	HandlerOTLP         = v2.HandlerOTLP
 	HandlerSocket       = v2.HandlerSocket
 	HealthResponse      = v2.HealthResponse
 	Hook                = v2.Hook
@@ -125,6 +129,18 @@ const (
 	// HandlerGRPCType is a special kind of handler that represents an extension
 	HandlerGRPCType = v2.HandlerGRPCType
 
+	// HandlerOTLPType represents handlers that export the metrics of events to
+	// an OpenTelemetry collector
+	HandlerOTLPType = v2.HandlerOTLPType
+
+	// HandlerInfluxDBType represents handlers that write the metrics of events
+	// to InfluxDB
+	HandlerInfluxDBType = v2.HandlerInfluxDBType
+
+	// HandlerKafkaType represents handlers that publish events to a Kafka
+	// topic
+	HandlerKafkaType = v2.HandlerKafkaType
+
 	// EventFilterActionAllow is an action to allow events to pass through to the pipeline
 	EventFilterActionAllow = v2.EventFilterActionAllow
 
@@ -176,6 +192,10 @@ const (
 	// InfluxDB Line
 	InfluxDBOutputMetricFormat = v2.InfluxDBOutputMetricFormat
 
+	// PrometheusOutputMetricFormat is the accepted string to represent the output metric format of
+	// Prometheus Text
+	PrometheusOutputMetricFormat = v2.PrometheusOutputMetricFormat
+
 	// CoreEdition represents the Sensu Core Edition (CE)
 	CoreEdition = v2.CoreEdition
 
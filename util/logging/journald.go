@@ -0,0 +1,135 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// OutputJournald sends logs to the systemd journal.
+const OutputJournald = "journald"
+
+// journaldSocket is the socket of the native journal protocol.
+var journaldSocket = "/run/systemd/journal/socket"
+
+// JournaldHook is a logrus hook that sends every entry to the systemd journal
+// using its native protocol. Every logrus field is sent as a separate journal
+// field, named after the uppercased field name (e.g. check_name becomes
+// CHECK_NAME), so entries can be queried with journalctl.
+type JournaldHook struct {
+	identifier string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewJournaldHook connects to the journal and returns a hook ready to be added
+// to a logger. The identifier is used as the SYSLOG_IDENTIFIER of every entry.
+func NewJournaldHook(identifier string) (*JournaldHook, error) {
+	conn, err := net.Dial("unixgram", journaldSocket)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to journald: %s", err)
+	}
+	return &JournaldHook{identifier: identifier, conn: conn}, nil
+}
+
+// Levels returns all the logging levels, since the level filtering is
+// performed by the logger itself.
+func (h *JournaldHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire sends the entry to the journal.
+func (h *JournaldHook) Fire(entry *logrus.Entry) error {
+	msg := h.format(entry)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.conn.Write(msg)
+	return err
+}
+
+// Close closes the connection to the journal.
+func (h *JournaldHook) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.conn.Close()
+}
+
+func (h *JournaldHook) format(entry *logrus.Entry) []byte {
+	var buf bytes.Buffer
+
+	writeJournaldField(&buf, "MESSAGE", entry.Message)
+	writeJournaldField(&buf, "PRIORITY", fmt.Sprint(syslogSeverity(entry.Level)))
+	if h.identifier != "" {
+		writeJournaldField(&buf, "SYSLOG_IDENTIFIER", h.identifier)
+	}
+
+	// Sort the fields so the output is stable
+	keys := make([]string, 0, len(entry.Data))
+	for key := range entry.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name := journaldFieldName(key)
+		if name == "" {
+			continue
+		}
+		value := entry.Data[key]
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		writeJournaldField(&buf, name, fmt.Sprint(value))
+	}
+
+	return buf.Bytes()
+}
+
+// writeJournaldField serializes a field of the native journal protocol. Values
+// containing a newline use the binary form, which is prefixed with the value
+// length.
+func writeJournaldField(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+	if !strings.ContainsRune(value, '\n') {
+		buf.WriteByte('=')
+		buf.WriteString(value)
+		buf.WriteByte('\n')
+		return
+	}
+	buf.WriteByte('\n')
+	_ = binary.Write(buf, binary.LittleEndian, uint64(len(value)))
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
+
+// journaldFieldName converts a logrus field name to a valid journal field
+// name, which may only contain uppercase letters, digits and underscores, and
+// may not start with an underscore or a digit. An empty string is returned if
+// no valid name can be derived.
+func journaldFieldName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+	name = strings.TrimLeft(name, "_0123456789")
+	switch name {
+	case "MESSAGE", "PRIORITY", "SYSLOG_IDENTIFIER":
+		// Don't let fields override the ones set by the hook
+		return "SENSU_" + name
+	}
+	return name
+}
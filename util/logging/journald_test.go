@@ -0,0 +1,90 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestJournaldFieldName(t *testing.T) {
+	tests := map[string]string{
+		"component":   "COMPONENT",
+		"check_name":  "CHECK_NAME",
+		"entity.name": "ENTITY_NAME",
+		"_private":    "PRIVATE",
+		"1st":         "ST",
+		"message":     "SENSU_MESSAGE",
+		"___":         "",
+	}
+	for key, want := range tests {
+		if got := journaldFieldName(key); got != want {
+			t.Errorf("bad field name for %q: got %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestJournaldHookFormat(t *testing.T) {
+	hook := &JournaldHook{identifier: "sensu-backend"}
+	entry := newTestEntry(logrus.ErrorLevel, "line one\nline two")
+	entry.Data["error"] = errors.New("boom")
+
+	var want bytes.Buffer
+	want.WriteString("MESSAGE\n")
+	_ = binary.Write(&want, binary.LittleEndian, uint64(len("line one\nline two")))
+	want.WriteString("line one\nline two\n")
+	want.WriteString("PRIORITY=3\n")
+	want.WriteString("SYSLOG_IDENTIFIER=sensu-backend\n")
+	want.WriteString("COMPONENT=test\n")
+	want.WriteString("ERROR=boom\n")
+
+	if got := hook.format(entry); !bytes.Equal(got, want.Bytes()) {
+		t.Fatalf("bad journald message:\ngot  %q\nwant %q", got, want.Bytes())
+	}
+}
+
+func TestJournaldHookFire(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journald")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "socket")
+	conn, err := net.ListenPacket("unixgram", socket)
+	if err != nil {
+		t.Skipf("unixgram sockets are not supported: %s", err)
+	}
+	defer conn.Close()
+
+	defer func(s string) { journaldSocket = s }(journaldSocket)
+	journaldSocket = socket
+
+	hook, err := NewJournaldHook("sensu-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(newTestEntry(logrus.InfoLevel, "hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 4096)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MESSAGE=hello\nPRIORITY=6\nSYSLOG_IDENTIFIER=sensu-agent\nCOMPONENT=test\n"
+	if got := string(buf[:n]); got != want {
+		t.Fatalf("bad journald message: got %q, want %q", got, want)
+	}
+}
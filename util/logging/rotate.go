@@ -0,0 +1,151 @@
+package logging
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// rotateTimeFormat is the format of the timestamps suffixed to the archived
+// files. It has a fixed width, so that the archives sort in the order of
+// their rotations.
+const rotateTimeFormat = "20060102T150405.000000000Z"
+
+// RotateFileWriterConfig is the configuration of a RotateFileWriter.
+type RotateFileWriterConfig struct {
+	// Path is the path of the file written.
+	Path string
+
+	// MaxSizeBytes is the size the file can reach before it is rotated. The
+	// file is never rotated if 0.
+	MaxSizeBytes int64
+
+	// RetentionFiles is the number of archived files kept, the oldest ones
+	// being removed. All the archived files are kept if 0.
+	RetentionFiles int
+}
+
+// RotateFileWriter is a writer appending to a file, which is archived once it
+// would grow larger than its maximum size. The archives are named after the
+// file and suffixed with the time of their rotation. The file is only rotated
+// between two writes, so that a single write is never split across files.
+type RotateFileWriter struct {
+	mu     sync.Mutex
+	config RotateFileWriterConfig
+	file   *os.File
+	size   int64
+	now    func() time.Time
+}
+
+// NewRotateFileWriter opens, or creates, the file of the given configuration.
+func NewRotateFileWriter(config RotateFileWriterConfig) (*RotateFileWriter, error) {
+	w := &RotateFileWriter{config: config, now: time.Now}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends p to the file, after rotating it if it would otherwise grow
+// larger than its maximum size.
+func (w *RotateFileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.config.MaxSizeBytes > 0 && w.size > 0 && w.size+int64(len(p)) > w.config.MaxSizeBytes {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes the file.
+func (w *RotateFileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
+
+// RotatedFiles returns the paths of the files archived by a RotateFileWriter
+// writing to path, from the oldest to the newest.
+func RotatedFiles(path string) ([]string, error) {
+	infos, err := ioutil.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	prefix := filepath.Base(path) + "."
+	var archives []string
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasPrefix(info.Name(), prefix) {
+			continue
+		}
+		if _, err := time.Parse(rotateTimeFormat, strings.TrimPrefix(info.Name(), prefix)); err == nil {
+			archives = append(archives, filepath.Join(filepath.Dir(path), info.Name()))
+		}
+	}
+	sort.Strings(archives)
+	return archives, nil
+}
+
+func (w *RotateFileWriter) open() error {
+	file, err := os.OpenFile(w.config.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	w.file = file
+	w.size = info.Size()
+	return nil
+}
+
+// rotate archives the file, opens a new one and removes the archives beyond
+// the retention. The file keeps being written if it cannot be archived, so
+// only the failure to reopen it is returned.
+func (w *RotateFileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	archive := w.config.Path + "." + w.now().UTC().Format(rotateTimeFormat)
+	if err := os.Rename(w.config.Path, archive); err != nil {
+		logrus.WithError(err).WithField("path", w.config.Path).Warn("could not rotate file")
+		return w.open()
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	if err := w.prune(); err != nil {
+		logrus.WithError(err).WithField("path", w.config.Path).Warn("could not remove rotated files")
+	}
+	return nil
+}
+
+// prune removes the oldest archives beyond the retention.
+func (w *RotateFileWriter) prune() error {
+	if w.config.RetentionFiles <= 0 {
+		return nil
+	}
+	archives, err := RotatedFiles(w.config.Path)
+	if err != nil {
+		return err
+	}
+	for len(archives) > w.config.RetentionFiles {
+		if err := os.Remove(archives[0]); err != nil {
+			return err
+		}
+		archives = archives[1:]
+	}
+	return nil
+}
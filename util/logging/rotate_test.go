@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRotateFileWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	w, err := NewRotateFileWriter(RotateFileWriterConfig{
+		Path:           path,
+		MaxSizeBytes:   10,
+		RetentionFiles: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	now := time.Unix(0, 0)
+	w.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for _, line := range []string{"one\n", "two\n", "three\n", "four\n", "five\n", "six\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archives, err := RotatedFiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(archives), 2; got != want {
+		t.Fatalf("got %d archives, want %d", got, want)
+	}
+	// The oldest archive, with one and two, was removed
+	for i, want := range []string{"three\n", "four\nfive\n"} {
+		b, err := ioutil.ReadFile(archives[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("archive %d: got %q, want %q", i, b, want)
+		}
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), "six\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRotateFileWriterLargeWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	w, err := NewRotateFileWriter(RotateFileWriterConfig{Path: path, MaxSizeBytes: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// A write larger than the maximum size is not split, nor rotated on an
+	// empty file
+	if _, err := w.Write([]byte("larger than the maximum\n")); err != nil {
+		t.Fatal(err)
+	}
+	archives, err := RotatedFiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(archives) != 0 {
+		t.Fatalf("got archives %v, want none", archives)
+	}
+}
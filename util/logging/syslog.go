@@ -0,0 +1,339 @@
+package logging
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// OutputStderr sends logs to the standard error.
+	OutputStderr = "stderr"
+
+	// OutputSyslog sends logs to syslog.
+	OutputSyslog = "syslog"
+
+	// DefaultSyslogFacility is the facility used when none is configured.
+	DefaultSyslogFacility = "daemon"
+
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// localSyslogAddresses are the unix sockets that are tried, in order, when no
+// syslog address is configured.
+var localSyslogAddresses = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// SyslogConfig configures a SyslogHook.
+type SyslogConfig struct {
+	// Network is one of "udp", "tcp", "tls" or "unix". The unix sockets are
+	// dialed as datagram sockets first, then as stream sockets, as with
+	// log/syslog. If empty, the local syslog daemon is used.
+	Network string
+
+	// Address is the address of the syslog server (e.g. logs.example.com:514).
+	// It is ignored when Network is empty.
+	Address string
+
+	// Facility is the syslog facility name (e.g. daemon, local0).
+	Facility string
+
+	// Tag is used as the APP-NAME of every message.
+	Tag string
+
+	// TLS is used when Network is "tls". If nil, the system roots are used.
+	TLS *tls.Config
+
+	// CAFile is the path to a PEM-encoded certificate authority used to verify
+	// the certificate of the syslog server when Network is "tls", instead of
+	// the system roots.
+	CAFile string
+}
+
+// ParseSyslogFacility returns the numerical code of a syslog facility name.
+func ParseSyslogFacility(name string) (int, error) {
+	if name == "" {
+		name = DefaultSyslogFacility
+	}
+	facility, ok := syslogFacilities[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("invalid syslog facility %q", name)
+	}
+	return facility, nil
+}
+
+// SyslogHook is a logrus hook that sends every entry to syslog, formatted
+// according to RFC 5424. Messages sent over stream connections (tcp and tls)
+// are framed using octet counting, as described in RFC 6587.
+type SyslogHook struct {
+	config   SyslogConfig
+	facility int
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+	// network is the network of conn, which may differ from the configured
+	// one for unix sockets
+	network string
+}
+
+// NewSyslogHook connects to the syslog server described by config and returns
+// a hook ready to be added to a logger.
+func NewSyslogHook(config SyslogConfig) (*SyslogHook, error) {
+	facility, err := ParseSyslogFacility(config.Facility)
+	if err != nil {
+		return nil, err
+	}
+	switch config.Network {
+	case "", "udp", "tcp", "tls", "unix":
+	default:
+		return nil, fmt.Errorf("invalid syslog network %q", config.Network)
+	}
+	if config.Network != "" && config.Address == "" {
+		return nil, errors.New("a syslog address is required when the network is set")
+	}
+
+	if config.CAFile != "" {
+		tlsConfig, err := syslogTLSConfig(config.TLS, config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.TLS = tlsConfig
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	hook := &SyslogHook{
+		config:   config,
+		facility: facility,
+		hostname: hostname,
+	}
+	if err := hook.connect(); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (h *SyslogHook) connect() error {
+	if h.conn != nil {
+		_ = h.conn.Close()
+		h.conn = nil
+	}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+	switch h.config.Network {
+	case "":
+		for _, address := range localSyslogAddresses {
+			for _, network := range []string{"unixgram", "unix"} {
+				conn, err = net.Dial(network, address)
+				if err == nil {
+					h.conn, h.network = conn, network
+					return nil
+				}
+			}
+		}
+		return errors.New("could not connect to the local syslog daemon")
+	case "tls":
+		conn, err = tls.Dial("tcp", h.config.Address, h.config.TLS)
+	case "unix":
+		for _, network := range []string{"unixgram", "unix"} {
+			conn, err = net.Dial(network, h.config.Address)
+			if err == nil {
+				h.conn, h.network = conn, network
+				return nil
+			}
+		}
+	default:
+		conn, err = net.Dial(h.config.Network, h.config.Address)
+	}
+	if err != nil {
+		return fmt.Errorf("could not connect to syslog: %s", err)
+	}
+	h.conn, h.network = conn, h.config.Network
+	return nil
+}
+
+// syslogTLSConfig returns a copy of config, or a new configuration if nil,
+// verifying the certificates with the certificate authority of caFile.
+func syslogTLSConfig(config *tls.Config, caFile string) (*tls.Config, error) {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the syslog CA file: %s", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in the syslog CA file %s", caFile)
+	}
+	if config == nil {
+		config = &tls.Config{}
+	} else {
+		config = config.Clone()
+	}
+	config.RootCAs = roots
+	return config, nil
+}
+
+// Levels returns all the logging levels, since the level filtering is
+// performed by the logger itself.
+func (h *SyslogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire sends the entry to syslog. If the write fails, the connection is
+// re-established once before giving up.
+func (h *SyslogHook) Fire(entry *logrus.Entry) error {
+	msg, err := h.format(entry)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.conn != nil {
+		if _, err = h.conn.Write(h.frame(msg)); err == nil {
+			return nil
+		}
+	}
+	if err := h.connect(); err != nil {
+		return err
+	}
+	_, err = h.conn.Write(h.frame(msg))
+	return err
+}
+
+// Close closes the connection to syslog.
+func (h *SyslogHook) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.conn == nil {
+		return nil
+	}
+	err := h.conn.Close()
+	h.conn = nil
+	return err
+}
+
+func (h *SyslogHook) format(entry *logrus.Entry) ([]byte, error) {
+	body, err := (&logrus.JSONFormatter{}).Format(entry)
+	if err != nil {
+		return nil, err
+	}
+	body = bytes.TrimRight(body, "\n")
+
+	tag := h.config.Tag
+	if tag == "" {
+		tag = "-"
+	}
+	priority := h.facility*8 + syslogSeverity(entry.Level)
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		priority,
+		entry.Time.Format(syslogTimestampFormat),
+		h.hostname,
+		tag,
+		os.Getpid(),
+		body,
+	)
+	return []byte(msg), nil
+}
+
+// frame delimits msg for the stream connections: with octet counting over
+// tcp and tls, and with a newline over unix stream sockets, as with
+// log/syslog. It must be called with the lock held.
+func (h *SyslogHook) frame(msg []byte) []byte {
+	switch h.network {
+	case "tcp", "tls":
+		return append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	case "unix":
+		return append(msg, '\n')
+	default:
+		return msg
+	}
+}
+
+// syslogSeverity maps a logrus level to a syslog severity.
+func syslogSeverity(level logrus.Level) int {
+	switch level {
+	case logrus.PanicLevel:
+		return 0 // emerg
+	case logrus.FatalLevel:
+		return 2 // crit
+	case logrus.ErrorLevel:
+		return 3 // err
+	case logrus.WarnLevel:
+		return 4 // warning
+	case logrus.InfoLevel:
+		return 6 // info
+	default:
+		return 7 // debug
+	}
+}
+
+// ConfigureOutput configures the standard logrus logger to write to the given
+// output: OutputStderr, OutputSyslog or OutputJournald. OutputStderr leaves the
+// current output of the logger untouched. The syslog tag is used as the
+// journald SYSLOG_IDENTIFIER. When logging to syslog or journald, the returned
+// closer should be closed once logging is no longer required.
+func ConfigureOutput(output string, config SyslogConfig) (io.Closer, error) {
+	var hook interface {
+		logrus.Hook
+		io.Closer
+	}
+	var err error
+
+	switch output {
+	case "", OutputStderr:
+		return nil, nil
+	case OutputSyslog:
+		hook, err = NewSyslogHook(config)
+	case OutputJournald:
+		hook, err = NewJournaldHook(config.Tag)
+	default:
+		return nil, fmt.Errorf("invalid log output %q, expected %q, %q or %q", output, OutputStderr, OutputSyslog, OutputJournald)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.AddHook(hook)
+	logrus.SetOutput(ioutil.Discard)
+	return hook, nil
+}
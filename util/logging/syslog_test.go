@@ -0,0 +1,328 @@
+package logging
+
+import (
+	"bufio"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var rfc5424Pattern = regexp.MustCompile(`^<(\d+)>1 \S+ \S+ sensu-agent \d+ - - (\{.*\})$`)
+
+func newTestEntry(level logrus.Level, msg string) *logrus.Entry {
+	entry := logrus.NewEntry(logrus.New())
+	entry.Level = level
+	entry.Message = msg
+	entry.Time = time.Now()
+	entry.Data = logrus.Fields{"component": "test"}
+	return entry
+}
+
+func TestParseSyslogFacility(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Facility int
+		Error    bool
+	}{
+		{Name: "", Facility: 3},
+		{Name: "daemon", Facility: 3},
+		{Name: "LOCAL0", Facility: 16},
+		{Name: "local7", Facility: 23},
+		{Name: "bogus", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			facility, err := ParseSyslogFacility(test.Name)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got, want := facility, test.Facility; got != want {
+				t.Fatalf("bad facility: got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestSyslogHookUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	hook, err := NewSyslogHook(SyslogConfig{
+		Network:  "udp",
+		Address:  conn.LocalAddr().String(),
+		Facility: "local0",
+		Tag:      "sensu-agent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(newTestEntry(logrus.WarnLevel, "hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 4096)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches := rfc5424Pattern.FindStringSubmatch(string(buf[:n]))
+	if matches == nil {
+		t.Fatalf("message is not RFC 5424: %q", buf[:n])
+	}
+	// local0 (16) * 8 + warning (4)
+	if got, want := matches[1], "132"; got != want {
+		t.Errorf("bad priority: got %s, want %s", got, want)
+	}
+	if !strings.Contains(matches[2], `"msg":"hello"`) || !strings.Contains(matches[2], `"component":"test"`) {
+		t.Errorf("missing fields in message body: %s", matches[2])
+	}
+}
+
+func TestSyslogHookTCPOctetCounting(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil {
+			return
+		}
+		msg := make([]byte, n)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			return
+		}
+		received <- string(msg)
+	}()
+
+	hook, err := NewSyslogHook(SyslogConfig{
+		Network: "tcp",
+		Address: ln.Addr().String(),
+		Tag:     "sensu-agent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(newTestEntry(logrus.ErrorLevel, "boom")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-received:
+		matches := rfc5424Pattern.FindStringSubmatch(msg)
+		if matches == nil {
+			t.Fatalf("message is not RFC 5424: %q", msg)
+		}
+		// daemon (3) * 8 + err (3)
+		if got, want := matches[1], "27"; got != want {
+			t.Errorf("bad priority: got %s, want %s", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestSyslogHookUnixgram(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syslog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	address := filepath.Join(dir, "log")
+	conn, err := net.ListenPacket("unixgram", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	hook, err := NewSyslogHook(SyslogConfig{
+		Network: "unix",
+		Address: address,
+		Tag:     "sensu-agent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(newTestEntry(logrus.InfoLevel, "hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 4096)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rfc5424Pattern.Match(buf[:n]) {
+		t.Fatalf("message is not RFC 5424: %q", buf[:n])
+	}
+}
+
+func TestSyslogHookUnixStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syslog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ln, err := net.Listen("unix", filepath.Join(dir, "log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		msg, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil {
+			return
+		}
+		received <- strings.TrimSuffix(msg, "\n")
+	}()
+
+	hook, err := NewSyslogHook(SyslogConfig{
+		Network: "unix",
+		Address: ln.Addr().String(),
+		Tag:     "sensu-agent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(newTestEntry(logrus.InfoLevel, "hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-received:
+		if !rfc5424Pattern.MatchString(msg) {
+			t.Fatalf("message is not RFC 5424: %q", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestSyslogHookTLSCAFile(t *testing.T) {
+	cert, err := tls.LoadX509KeyPair("../ssl/etcd1.pem", "../ssl/etcd1-key.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil {
+			return
+		}
+		msg := make([]byte, n)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			return
+		}
+		received <- string(msg)
+	}()
+
+	// The test certificates are verified at a time they are valid
+	validTime := func() time.Time {
+		return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	hook, err := NewSyslogHook(SyslogConfig{
+		Network: "tls",
+		Address: ln.Addr().String(),
+		Tag:     "sensu-agent",
+		TLS:     &tls.Config{Time: validTime},
+		CAFile:  "../ssl/ca.pem",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(newTestEntry(logrus.InfoLevel, "hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-received:
+		if !rfc5424Pattern.MatchString(msg) {
+			t.Fatalf("message is not RFC 5424: %q", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestNewSyslogHookInvalidConfig(t *testing.T) {
+	configs := []SyslogConfig{
+		{Network: "udp"},
+		{Network: "carrier-pigeon", Address: "localhost:514"},
+		{Network: "udp", Address: "localhost:514", Facility: "bogus"},
+		{Network: "tls", Address: "localhost:6514", CAFile: "/nonexistent/ca.pem"},
+	}
+	for _, config := range configs {
+		if _, err := NewSyslogHook(config); err == nil {
+			t.Errorf("expected an error for %#v", config)
+		}
+	}
+}
+
+func TestConfigureOutputInvalid(t *testing.T) {
+	if _, err := ConfigureOutput("carrier-pigeon", SyslogConfig{}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,193 @@
+// +build windows
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	logon32LogonService    = 5
+	logon32ProviderDefault = 0
+
+	policyCreateAccount = 0x00000010
+	policyLookupNames   = 0x00000800
+
+	seServiceLogonRight = "SeServiceLogonRight"
+)
+
+var (
+	modadvapi32 = windows.NewLazySystemDLL("advapi32.dll")
+
+	procLogonUserW            = modadvapi32.NewProc("LogonUserW")
+	procLsaOpenPolicy         = modadvapi32.NewProc("LsaOpenPolicy")
+	procLsaAddAccountRights   = modadvapi32.NewProc("LsaAddAccountRights")
+	procLsaClose              = modadvapi32.NewProc("LsaClose")
+	procLsaNtStatusToWinError = modadvapi32.NewProc("LsaNtStatusToWinError")
+)
+
+// builtinServiceAccounts are the accounts managed by Windows, which have no
+// password and already hold the right to log on as a service.
+var builtinServiceAccounts = []string{
+	"LocalSystem",
+	`NT AUTHORITY\LocalService`,
+	`NT AUTHORITY\NetworkService`,
+}
+
+type lsaUnicodeString struct {
+	Length        uint16
+	MaximumLength uint16
+	Buffer        *uint16
+}
+
+type lsaObjectAttributes struct {
+	Length                   uint32
+	RootDirectory            windows.Handle
+	ObjectName               *lsaUnicodeString
+	Attributes               uint32
+	SecurityDescriptor       uintptr
+	SecurityQualityOfService uintptr
+}
+
+func newLSAUnicodeString(s string) (*lsaUnicodeString, error) {
+	buf, err := windows.UTF16FromString(s)
+	if err != nil {
+		return nil, err
+	}
+	// The lengths are in bytes and exclude the terminating null character
+	return &lsaUnicodeString{
+		Length:        uint16((len(buf) - 1) * 2),
+		MaximumLength: uint16(len(buf) * 2),
+		Buffer:        &buf[0],
+	}, nil
+}
+
+// isBuiltinServiceAccount returns true if user is an account managed by
+// Windows, such as LocalSystem.
+func isBuiltinServiceAccount(user string) bool {
+	for _, account := range builtinServiceAccounts {
+		if strings.EqualFold(user, account) {
+			return true
+		}
+	}
+	return false
+}
+
+// isManagedServiceAccount returns true if user is a (group) managed service
+// account, whose password is managed by the domain controller.
+func isManagedServiceAccount(user string) bool {
+	return strings.HasSuffix(user, "$")
+}
+
+// splitAccountName splits an account name in the DOMAIN\user form into its
+// domain and user name. Names in the user@domain form are returned as is,
+// with an empty domain. The "." domain designates the local computer.
+func splitAccountName(account string) (domain, user string) {
+	if i := strings.Index(account, `\`); i >= 0 {
+		return account[:i], account[i+1:]
+	}
+	return "", account
+}
+
+// prepareServiceAccount validates the credentials of the account the service
+// will run as, and grants it the right to log on as a service.
+func prepareServiceAccount(account, password string) error {
+	if isBuiltinServiceAccount(account) {
+		return nil
+	}
+
+	sid, _, _, err := windows.LookupSID("", account)
+	if err != nil {
+		return fmt.Errorf("could not find account %q: %s", account, err)
+	}
+
+	if err := grantServiceLogonRight(sid); err != nil {
+		return fmt.Errorf("could not grant the right to log on as a service to %q: %s", account, err)
+	}
+
+	if isManagedServiceAccount(account) {
+		return nil
+	}
+	if err := validateCredentials(account, password); err != nil {
+		return fmt.Errorf("invalid credentials for %q: %s", account, err)
+	}
+	return nil
+}
+
+// validateCredentials logs on the account the same way the service control
+// manager will.
+func validateCredentials(account, password string) error {
+	domain, user := splitAccountName(account)
+	userp, err := windows.UTF16PtrFromString(user)
+	if err != nil {
+		return err
+	}
+	passwordp, err := windows.UTF16PtrFromString(password)
+	if err != nil {
+		return err
+	}
+	var domainp *uint16
+	if domain != "" {
+		if domainp, err = windows.UTF16PtrFromString(domain); err != nil {
+			return err
+		}
+	}
+
+	var token windows.Token
+	r, _, err := procLogonUserW.Call(
+		uintptr(unsafe.Pointer(userp)),
+		uintptr(unsafe.Pointer(domainp)),
+		uintptr(unsafe.Pointer(passwordp)),
+		logon32LogonService,
+		logon32ProviderDefault,
+		uintptr(unsafe.Pointer(&token)),
+	)
+	if r == 0 {
+		return err
+	}
+	return token.Close()
+}
+
+// grantServiceLogonRight adds the SeServiceLogonRight right to the account
+// identified by sid in the local security policy.
+func grantServiceLogonRight(sid *windows.SID) error {
+	var attrs lsaObjectAttributes
+	attrs.Length = uint32(unsafe.Sizeof(attrs))
+
+	var policy windows.Handle
+	status, _, _ := procLsaOpenPolicy.Call(
+		0,
+		uintptr(unsafe.Pointer(&attrs)),
+		policyCreateAccount|policyLookupNames,
+		uintptr(unsafe.Pointer(&policy)),
+	)
+	if status != 0 {
+		return lsaError(status)
+	}
+	defer procLsaClose.Call(uintptr(policy))
+
+	right, err := newLSAUnicodeString(seServiceLogonRight)
+	if err != nil {
+		return err
+	}
+	status, _, _ = procLsaAddAccountRights.Call(
+		uintptr(policy),
+		uintptr(unsafe.Pointer(sid)),
+		uintptr(unsafe.Pointer(right)),
+		1,
+	)
+	if status != 0 {
+		return lsaError(status)
+	}
+	return nil
+}
+
+// lsaError converts a NTSTATUS returned by the LSA functions to an error.
+func lsaError(status uintptr) error {
+	code, _, _ := procLsaNtStatusToWinError.Call(status)
+	return windows.Errno(code)
+}
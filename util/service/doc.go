@@ -0,0 +1,3 @@
+// Package service installs and removes the Windows services of sensu-agent
+// and sensu-backend.
+package service
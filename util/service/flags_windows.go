@@ -0,0 +1,57 @@
+// +build windows
+
+package service
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagStartType        = "start-type"
+	flagRestartOnFailure = "restart-on-failure"
+	flagRestartDelay     = "restart-delay"
+	flagResetPeriod      = "reset-period"
+	flagServiceUser      = "service-user"
+	flagServicePassword  = "service-password"
+)
+
+// AddInstallFlags adds the flags configuring the Options of a service to
+// flags.
+func AddInstallFlags(flags *pflag.FlagSet) {
+	flags.String(flagStartType, startTypeAuto, "service start type [auto, delayed, manual]")
+	flags.Bool(flagRestartOnFailure, true, "restart the service when it fails")
+	flags.Duration(flagRestartDelay, time.Minute, "time to wait before restarting the service after a failure")
+	flags.Duration(flagResetPeriod, 24*time.Hour, "time without failures after which the service failure count is reset")
+	flags.String(flagServiceUser, DefaultUser, "account the service runs as (e.g. DOMAIN\\user or .\\user for a local account)")
+	flags.String(flagServicePassword, "", "password of the service account")
+}
+
+// InstallOptions returns the Options set with the flags added by
+// AddInstallFlags.
+func InstallOptions(flags *pflag.FlagSet) (Options, error) {
+	var (
+		opts Options
+		err  error
+	)
+	if opts.StartType, err = flags.GetString(flagStartType); err != nil {
+		return opts, err
+	}
+	if opts.RestartOnFailure, err = flags.GetBool(flagRestartOnFailure); err != nil {
+		return opts, err
+	}
+	if opts.RestartDelay, err = flags.GetDuration(flagRestartDelay); err != nil {
+		return opts, err
+	}
+	if opts.ResetPeriod, err = flags.GetDuration(flagResetPeriod); err != nil {
+		return opts, err
+	}
+	if opts.User, err = flags.GetString(flagServiceUser); err != nil {
+		return opts, err
+	}
+	if opts.Password, err = flags.GetString(flagServicePassword); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
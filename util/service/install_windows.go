@@ -0,0 +1,201 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+// ExePath returns the absolute path of the running executable.
+func ExePath() (string, error) {
+	prog := os.Args[0]
+	p, err := filepath.Abs(prog)
+	if err != nil {
+		return "", err
+	}
+	fi, err := os.Stat(p)
+	if err == nil {
+		if !fi.Mode().IsDir() {
+			return p, nil
+		}
+		err = fmt.Errorf("%s is directory", p)
+	}
+	if filepath.Ext(p) == "" {
+		p += ".exe"
+		fi, err := os.Stat(p)
+		if err == nil {
+			if !fi.Mode().IsDir() {
+				return p, nil
+			}
+			err = fmt.Errorf("%s is directory", p)
+		}
+	}
+	return "", err
+}
+
+const (
+	// DefaultUser is the account services run as by default.
+	DefaultUser = "LocalSystem"
+
+	startTypeAuto    = "auto"
+	startTypeDelayed = "delayed"
+	startTypeManual  = "manual"
+)
+
+// Options configures how the service control manager starts the
+// service and how it recovers from failures.
+type Options struct {
+	// User is the account the service runs as. Defaults to LocalSystem.
+	User string
+
+	// Password is the password of User.
+	Password string
+
+	// StartType is one of "auto", "delayed" or "manual".
+	StartType string
+
+	// RestartOnFailure makes the service control manager restart the service
+	// when it terminates unexpectedly.
+	RestartOnFailure bool
+
+	// RestartDelay is the time to wait before restarting the service.
+	RestartDelay time.Duration
+
+	// ResetPeriod is the time without failures after which the failure count
+	// of the service is reset to zero.
+	ResetPeriod time.Duration
+}
+
+// mgrConfig applies the start type of the options to config.
+func (o Options) mgrConfig(config mgr.Config) (mgr.Config, error) {
+	switch o.StartType {
+	case startTypeAuto, "":
+		config.StartType = mgr.StartAutomatic
+	case startTypeDelayed:
+		config.StartType = mgr.StartAutomatic
+		config.DelayedAutoStart = true
+	case startTypeManual:
+		config.StartType = mgr.StartManual
+	default:
+		return config, fmt.Errorf("invalid start type %q, expected %q, %q or %q", o.StartType, startTypeAuto, startTypeDelayed, startTypeManual)
+	}
+	return config, nil
+}
+
+// setRecoveryActions configures the recovery actions of the service s.
+func (o Options) setRecoveryActions(s *mgr.Service) error {
+	if !o.RestartOnFailure {
+		return s.ResetRecoveryActions()
+	}
+	if o.RestartDelay < 0 || o.ResetPeriod < 0 {
+		return errors.New("the restart delay and reset period can't be negative")
+	}
+	// The last action is repeated for every subsequent failure
+	actions := []mgr.RecoveryAction{
+		{Type: mgr.ServiceRestart, Delay: o.RestartDelay},
+	}
+	if err := s.SetRecoveryActions(actions, uint32(o.ResetPeriod/time.Second)); err != nil {
+		return err
+	}
+	// Also recover when the service stops with a non-zero exit code, not only
+	// when its process crashes
+	flag := serviceFailureActionsFlag{FailureActionsOnNonCrashFailures: 1}
+	return windows.ChangeServiceConfig2(s.Handle, windows.SERVICE_CONFIG_FAILURE_ACTIONS_FLAG, (*byte)(unsafe.Pointer(&flag)))
+}
+
+// serviceFailureActionsFlag is the SERVICE_FAILURE_ACTIONS_FLAG structure.
+type serviceFailureActionsFlag struct {
+	FailureActionsOnNonCrashFailures int32
+}
+
+// Install creates the service name, registers it as an event log source and
+// starts it. The service runs the current executable with args.
+func Install(name, displayName, desc string, opts Options, args ...string) error {
+	user := opts.User
+	if user == "" {
+		user = DefaultUser
+	}
+	config, err := opts.mgrConfig(mgr.Config{
+		Description:      desc,
+		DisplayName:      displayName,
+		ServiceStartName: user,
+		Password:         opts.Password,
+	})
+	if err != nil {
+		return err
+	}
+	if err := prepareServiceAccount(user, opts.Password); err != nil {
+		return err
+	}
+
+	exepath, err := ExePath()
+	if err != nil {
+		return err
+	}
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+	s, err := m.OpenService(name)
+	if err == nil {
+		s.Close()
+		return fmt.Errorf("service %s already exists", name)
+	}
+	s, err = m.CreateService(name, exepath, config, args...)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	if err := opts.setRecoveryActions(s); err != nil {
+		s.Delete()
+		return fmt.Errorf("error configuring service recovery: %s", err)
+	}
+	err = eventlog.InstallAsEventCreate(name, eventlog.Error|eventlog.Warning|eventlog.Info)
+	if err != nil {
+		s.Delete()
+		return fmt.Errorf("SetupEventLogSource() failed: %s", err)
+	}
+
+	return s.Start(args...)
+}
+
+// Remove stops and deletes the service name, and removes its event log
+// source.
+func Remove(name string) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+	s, err := m.OpenService(name)
+	if err != nil {
+		return fmt.Errorf("service %s is not installed", name)
+	}
+	defer s.Close()
+
+	// Attempt to stop the service, but don't return the error if it fails.
+	_, _ = s.Control(svc.Stop)
+
+	if err := s.Delete(); err != nil {
+		return fmt.Errorf("error uninstalling service: %s", err)
+	}
+	if err := eventlog.Remove(name); err != nil {
+		return fmt.Errorf("RemoveEventLogSource() failed: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package tlsreload reloads the TLS certificates of the servers when their
+// files are renewed, e.g. by cert-manager or an ACME client, without
+// restarting the servers and dropping their connections.
+package tlsreload
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "tlsreload",
+})
+
+// Reloader serves a certificate and its key, which are reloaded from their
+// files during the handshakes following their modification. The files are
+// checked at most once per interval.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	certTime  time.Time // modification time of the loaded files
+	checkedAt time.Time
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// NewReloader loads the certificate and key of the given files, which are
+// checked for modifications at most once per interval.
+func NewReloader(certFile, keyFile string, interval time.Duration) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+		now:      time.Now,
+	}
+	modTime, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTime); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, reloading it first if its
+// files were modified. The previous certificate is kept if the files can't be
+// loaded, e.g. if the certificate was renewed but not its key yet. It is
+// meant to be used as tls.Config.GetCertificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.checkedAt) < r.interval {
+		return r.cert, nil
+	}
+	r.checkedAt = now
+
+	modTime, err := r.stat()
+	if err != nil {
+		logger.WithError(err).Error("could not check the TLS certificate, keeping the current one")
+		return r.cert, nil
+	}
+	if !modTime.After(r.certTime) {
+		return r.cert, nil
+	}
+	if err := r.load(modTime); err != nil {
+		logger.WithError(err).Error("could not reload the TLS certificate, keeping the current one")
+		return r.cert, nil
+	}
+	logger.WithField("cert_file", r.certFile).Info("reloaded the TLS certificate")
+	return r.cert, nil
+}
+
+// load loads the certificate and key, modified at the given time.
+func (r *Reloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("error loading tls server certificate: %s", err)
+	}
+	r.cert = &cert
+	r.certTime = modTime
+	return nil
+}
+
+// stat returns the latest modification time of the certificate and key
+// files. The files are stat'ed, rather than their symlinks, to detect the
+// renewals of Kubernetes secrets.
+func (r *Reloader) stat() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// Configure makes the server configuration serve the certificate and key of
+// the given files, reloaded when they are modified. It does nothing if the
+// interval is not positive or if the files are not set, leaving the
+// certificates loaded at startup.
+func Configure(cfg *tls.Config, certFile, keyFile string, interval time.Duration) error {
+	if cfg == nil || interval <= 0 || certFile == "" || keyFile == "" {
+		return nil
+	}
+	r, err := NewReloader(certFile, keyFile, interval)
+	if err != nil {
+		return err
+	}
+	cfg.Certificates = nil
+	cfg.NameToCertificate = nil
+	cfg.GetCertificate = r.GetCertificate
+	return nil
+}
@@ -0,0 +1,25 @@
+// Package wasm provides facilities based on the wazero runtime for executing
+// WebAssembly modules as mutators and filters.
+//
+// The modules are executed in a sandbox: every call instantiates the module
+// anew, without access to the filesystem, the network or the environment of
+// the backend. Modules built for WASI can be used, but their standard output
+// and standard error are discarded.
+//
+// The modules must export their memory as "memory", and an allocation
+// function receiving the input:
+//
+//	allocate(size i32) i32
+//
+// which returns the offset of size bytes of memory where the JSON encoding of
+// the event is written. Mutators must then export:
+//
+//	mutate(offset i32, size i32) i64
+//
+// which returns the offset of the mutated event data in the upper 32 bits of
+// its result, and its size in the lower 32 bits. Filters must export:
+//
+//	filter(offset i32, size i32) i32
+//
+// which returns a non-zero value if the event matches the filter.
+package wasm
@@ -0,0 +1,182 @@
+package wasm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+)
+
+const (
+	// DefaultTimeout is the maximum duration of a call when its context has
+	// no deadline.
+	DefaultTimeout = time.Second
+
+	// MemoryLimitPages is the maximum size of the memory of a module, in
+	// pages of 64 KiB.
+	MemoryLimitPages = 256
+
+	allocateFunction = "allocate"
+	mutateFunction   = "mutate"
+	filterFunction   = "filter"
+)
+
+// ErrTimeout is returned when the execution of a module exceeds its deadline.
+var ErrTimeout = errors.New("webassembly module execution timed out")
+
+var (
+	runtimeOnce sync.Once
+	wasmRuntime wazero.Runtime
+	runtimeErr  error
+
+	modulesMu sync.Mutex
+	modules   = make(map[string]wazero.CompiledModule)
+)
+
+// Mutate calls the mutate function of the module at path with input, and
+// returns the mutated data.
+func Mutate(ctx context.Context, path string, input []byte) ([]byte, error) {
+	ctx, cancel := withDefaultTimeout(ctx)
+	defer cancel()
+
+	mod, result, err := call(ctx, path, mutateFunction, input)
+	if err != nil {
+		return nil, err
+	}
+	defer mod.Close(ctx)
+
+	offset, size := uint32(result>>32), uint32(result)
+	output, ok := mod.Memory().Read(offset, size)
+	if !ok {
+		return nil, fmt.Errorf("%s returned out of range memory: offset %d, size %d", mutateFunction, offset, size)
+	}
+	// The memory of the module is released once it is closed
+	return append([]byte(nil), output...), nil
+}
+
+// Filter calls the filter function of the module at path with input, and
+// returns true if the input matches the filter.
+func Filter(ctx context.Context, path string, input []byte) (bool, error) {
+	ctx, cancel := withDefaultTimeout(ctx)
+	defer cancel()
+
+	mod, result, err := call(ctx, path, filterFunction, input)
+	if err != nil {
+		return false, err
+	}
+	defer mod.Close(ctx)
+
+	return uint32(result) != 0, nil
+}
+
+func withDefaultTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, DefaultTimeout)
+}
+
+// call instantiates the module at path, writes input to its memory and calls
+// function with the offset and size of input. The module must be closed by
+// the caller if no error is returned.
+func call(ctx context.Context, path, function string, input []byte) (api.Module, uint64, error) {
+	compiled, err := compile(ctx, path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mod, err := wasmRuntime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, 0, executionError(ctx, err)
+	}
+
+	result, err := invoke(ctx, mod, function, input)
+	if err != nil {
+		_ = mod.Close(ctx)
+		return nil, 0, err
+	}
+	return mod, result, nil
+}
+
+func invoke(ctx context.Context, mod api.Module, function string, input []byte) (uint64, error) {
+	allocate := mod.ExportedFunction(allocateFunction)
+	if allocate == nil {
+		return 0, fmt.Errorf("module does not export %s", allocateFunction)
+	}
+	fn := mod.ExportedFunction(function)
+	if fn == nil {
+		return 0, fmt.Errorf("module does not export %s", function)
+	}
+	if mod.Memory() == nil {
+		return 0, errors.New("module does not export its memory")
+	}
+
+	size := uint64(len(input))
+	results, err := allocate.Call(ctx, size)
+	if err != nil {
+		return 0, executionError(ctx, err)
+	}
+	offset := uint32(results[0])
+	if !mod.Memory().Write(offset, input) {
+		return 0, fmt.Errorf("%s returned out of range memory: offset %d, size %d", allocateFunction, offset, size)
+	}
+
+	results, err = fn.Call(ctx, uint64(offset), size)
+	if err != nil {
+		return 0, executionError(ctx, err)
+	}
+	if len(results) != 1 {
+		return 0, fmt.Errorf("%s must return a single value", function)
+	}
+	return results[0], nil
+}
+
+// executionError returns ErrTimeout if err is caused by the deadline of ctx.
+func executionError(ctx context.Context, err error) error {
+	var exitErr *sys.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == sys.ExitCodeDeadlineExceeded {
+		return ErrTimeout
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrTimeout
+	}
+	return err
+}
+
+// compile returns the compiled module at path. Modules are provided by
+// assets, which never change once installed, so they are compiled once.
+func compile(ctx context.Context, path string) (wazero.CompiledModule, error) {
+	runtimeOnce.Do(func() {
+		config := wazero.NewRuntimeConfig().
+			WithCloseOnContextDone(true).
+			WithMemoryLimitPages(MemoryLimitPages)
+		wasmRuntime = wazero.NewRuntimeWithConfig(context.Background(), config)
+		_, runtimeErr = wasi_snapshot_preview1.Instantiate(context.Background(), wasmRuntime)
+	})
+	if runtimeErr != nil {
+		return nil, fmt.Errorf("error initializing webassembly runtime: %s", runtimeErr)
+	}
+
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+	if compiled, ok := modules[path]; ok {
+		return compiled, nil
+	}
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := wasmRuntime.CompileModule(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling webassembly module %s: %s", path, err)
+	}
+	modules[path] = compiled
+	return compiled, nil
+}
@@ -0,0 +1,136 @@
+package wasm
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The bodies of the test functions, in the WebAssembly binary format.
+var (
+	// allocate(size i32) i32: returns 1024
+	allocateBody = []byte{0x00, 0x41, 0x80, 0x08, 0x0b}
+
+	// mutate(offset i32, size i32) i64: returns its input unchanged
+	identityBody = []byte{0x00, 0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84, 0x0b}
+
+	// filter(offset i32, size i32) i32: returns true if the input starts
+	// with a '{'
+	objectBody = []byte{0x00, 0x20, 0x00, 0x2d, 0x00, 0x00, 0x41, 0xfb, 0x00, 0x46, 0x0b}
+
+	// filter(offset i32, size i32) i32: never returns
+	loopBody = []byte{0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x41, 0x00, 0x0b}
+)
+
+// vector encodes a WebAssembly vector of count entries.
+func vector(count int, entries ...[]byte) []byte {
+	b := []byte{byte(count)}
+	for _, entry := range entries {
+		b = append(b, entry...)
+	}
+	return b
+}
+
+func section(id byte, contents []byte) []byte {
+	return append([]byte{id, byte(len(contents))}, contents...)
+}
+
+func exportEntry(name string, kind, index byte) []byte {
+	return append(append([]byte{byte(len(name))}, name...), kind, index)
+}
+
+// testModule writes a module exporting its memory, allocate, and a function
+// named name with body, then returns its path.
+func testModule(t *testing.T, dir, name string, body []byte) string {
+	typeIndex := byte(2)
+	if name == mutateFunction {
+		typeIndex = 1
+	}
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(1, vector(3,
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e},
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7f},
+	))...)
+	module = append(module, section(3, vector(2, []byte{0x00, typeIndex}))...)
+	module = append(module, section(5, vector(1, []byte{0x00, 0x01}))...)
+	module = append(module, section(7, vector(3,
+		exportEntry("memory", 0x02, 0),
+		exportEntry(allocateFunction, 0x00, 0),
+		exportEntry(name, 0x00, 1),
+	))...)
+	module = append(module, section(10, vector(2,
+		append([]byte{byte(len(allocateBody))}, allocateBody...),
+		append([]byte{byte(len(body))}, body...),
+	))...)
+
+	path := filepath.Join(dir, t.Name()+".wasm")
+	require.NoError(t, ioutil.WriteFile(path, module, 0644))
+	return path
+}
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "sensu-wasm")
+	require.NoError(t, err)
+	return dir, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestMutate(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := testModule(t, dir, mutateFunction, identityBody)
+
+	output, err := Mutate(context.Background(), path, []byte(`{"check":{}}`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"check":{}}`, string(output))
+}
+
+func TestFilter(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := testModule(t, dir, filterFunction, objectBody)
+
+	match, err := Filter(context.Background(), path, []byte(`{}`))
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = Filter(context.Background(), path, []byte(`[]`))
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestFilterTimeout(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := testModule(t, dir, filterFunction, loopBody)
+
+	_, err := Filter(context.Background(), path, []byte(`{}`))
+	assert.Equal(t, ErrTimeout, err)
+}
+
+func TestMissingFunction(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := testModule(t, dir, filterFunction, objectBody)
+
+	_, err := Mutate(context.Background(), path, []byte(`{}`))
+	assert.Error(t, err)
+}
+
+func TestInvalidModule(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+	path := filepath.Join(dir, "invalid.wasm")
+	require.NoError(t, ioutil.WriteFile(path, []byte("invalid"), 0644))
+
+	_, err := Filter(context.Background(), path, []byte(`{}`))
+	assert.Error(t, err)
+
+	_, err = Filter(context.Background(), filepath.Join(dir, "missing.wasm"), []byte(`{}`))
+	assert.Error(t, err)
+}